@@ -40,3 +40,34 @@ func (c *Client) GetUserActivities(ctx context.Context, opts *ActivityListOption
 
 	return c.backlogClient.GetUserRecentUpdates(ctx, params)
 }
+
+// ProjectActivityListOptions はプロジェクトアクティビティ取得オプション
+type ProjectActivityListOptions struct {
+	ActivityTypeIDs []int
+	MinID           int
+	MaxID           int
+	Count           int
+	Order           string // asc, desc
+}
+
+// GetProjectActivities はプロジェクトの最近の活動一覧を取得する
+func (c *Client) GetProjectActivities(ctx context.Context, projectIDOrKey string, opts *ProjectActivityListOptions) ([]backlog.Activity, error) {
+	params := backlog.GetProjectRecentUpdatesParams{ProjectIdOrKey: projectIDOrKey}
+	if opts != nil {
+		params.ActivityTypeId = opts.ActivityTypeIDs
+		if opts.MinID > 0 {
+			params.MinId = backlog.NewOptInt(opts.MinID)
+		}
+		if opts.MaxID > 0 {
+			params.MaxId = backlog.NewOptInt(opts.MaxID)
+		}
+		if opts.Count > 0 {
+			params.Count = backlog.NewOptInt(opts.Count)
+		}
+		if opts.Order != "" {
+			params.Order = backlog.NewOptString(opts.Order)
+		}
+	}
+
+	return c.backlogClient.GetProjectRecentUpdates(ctx, params)
+}
@@ -102,3 +102,42 @@ func TestGetRecentlyViewedIssuesDecodes(t *testing.T) {
 		t.Fatalf("issue.issueKey = %q (ok=%v), want PROJ-7", is.IssueKey.Value, ok)
 	}
 }
+
+func TestGetProjectActivitiesEncodesQuery(t *testing.T) {
+	var capturedURL *url.URL
+
+	client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		capturedURL = req.URL
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body: io.NopCloser(strings.NewReader(
+				`[{"id":7,"type":6,"project":{"id":9,"projectKey":"PROJ"},"content":{"id":30,"name":"Home"},"created":"2026-05-27T01:02:03Z"}]`,
+			)),
+		}, nil
+	})
+
+	activities, err := client.GetProjectActivities(context.Background(), "PROJ", &ProjectActivityListOptions{
+		ActivityTypeIDs: []int{6},
+		MinID:           5,
+		Count:           100,
+		Order:           "asc",
+	})
+	if err != nil {
+		t.Fatalf("GetProjectActivities returned error: %v", err)
+	}
+	if !strings.HasSuffix(capturedURL.Path, "/projects/PROJ/activities") {
+		t.Fatalf("unexpected path: %s", capturedURL.Path)
+	}
+	q := capturedURL.Query()
+	if got := q.Get("minId"); got != "5" {
+		t.Fatalf("minId = %q, want 5", got)
+	}
+	if got := q.Get("order"); got != "asc" {
+		t.Fatalf("order = %q, want asc", got)
+	}
+	if len(activities) != 1 || activities[0].Content.Value.Name.Value != "Home" {
+		t.Fatalf("unexpected activities: %+v", activities)
+	}
+}
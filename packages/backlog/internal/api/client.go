@@ -3,13 +3,14 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ogen-go/ogen/ogenerrors"
@@ -17,6 +18,7 @@ import (
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/relaysig"
 )
 
 // Client は Backlog API クライアント
@@ -31,10 +33,20 @@ type Client struct {
 	apiKey string
 
 	// トークン更新用（OAuth）
+	// tokenMu は並行リクエスト（backlog proxy など）でのトークン更新を直列化する
+	tokenMu       sync.Mutex
 	refreshToken  string
 	expiresAt     time.Time
 	relayServer   string
 	onTokenUpdate func(ctx context.Context, accessToken, refreshToken string, expiresAt time.Time)
+	relaySigner   *relaysig.Signer
+	relayTLS      *tls.Config
+	// serviceToken が true の場合、refreshToken はサービストークンとして扱い
+	// grant_type=service_token でアクセストークンと交換する（ローテーションしない）
+	serviceToken bool
+	// 複数プロセス間でのトークン更新の直列化（WithSharedTokenRefresh）
+	refreshLockPath string
+	latestTokens    func(ctx context.Context) (*TokenSet, error)
 
 	// キャッシュ
 	cache    cache.Cache
@@ -66,6 +78,30 @@ func WithTokenRefresh(refreshToken, relayServer string, expiresAt time.Time, cal
 	}
 }
 
+// WithServiceToken はサービストークンによるアクセストークンの取得を有効にする
+// 有効期限切れ（初回を含む）のたびに中継サーバーでサービストークンを交換する
+func WithServiceToken(serviceToken, relayServer string, accessToken string, expiresAt time.Time, callback func(ctx context.Context, accessToken, serviceToken string, expiresAt time.Time)) ClientOption {
+	return func(c *Client) {
+		WithTokenRefresh(serviceToken, relayServer, expiresAt, callback)(c)
+		c.accessToken = accessToken
+		c.serviceToken = true
+	}
+}
+
+// WithRelaySigner は中継サーバーへのトークン更新リクエストへの署名を有効にする
+func WithRelaySigner(signer *relaysig.Signer) ClientOption {
+	return func(c *Client) {
+		c.relaySigner = signer
+	}
+}
+
+// WithRelayTLS は中継サーバーへのトークン更新リクエストに使う TLS 設定（クライアント証明書）を指定する
+func WithRelayTLS(tlsConfig *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.relayTLS = tlsConfig
+	}
+}
+
 // WithHTTPTimeout はHTTPタイムアウトを設定する
 func WithHTTPTimeout(timeout time.Duration) ClientOption {
 	return func(c *Client) {
@@ -104,11 +140,13 @@ func NewClient(space, accessToken string, opts ...ClientOption) *Client {
 	c.httpClient = &http.Client{
 		Timeout: 30 * time.Second,
 		Transport: &ReadOnlyTransport{
-			Base: &RetryTransport{
-				Base: &LoggingTransport{
-					Base: http.DefaultTransport,
+			Base: &WriteRecorderTransport{
+				Base: &RetryTransport{
+					Base: &LoggingTransport{
+						Base: http.DefaultTransport,
+					},
+					MaxRetries: 5,
 				},
-				MaxRetries: 5,
 			},
 		},
 	}
@@ -118,8 +156,8 @@ func NewClient(space, accessToken string, opts ...ClientOption) *Client {
 	}
 
 	// ogen クライアントの初期化
-	// カスタムHTTPクライアントを使用するように設定
-	bc, err := backlog.NewClient(c.baseURL(), c, backlog.WithClient(c.httpClient))
+	// カスタムHTTPクライアントを使用し、エラーレスポンスは *APIError に変換する
+	bc, err := backlog.NewClient(c.baseURL(), c, backlog.WithClient(&apiErrorDoer{client: c.httpClient}))
 	if err != nil {
 		panic(fmt.Sprintf("failed to create backlog client: %v", err))
 	}
@@ -143,32 +181,21 @@ func (c *Client) OAuth2(ctx context.Context, operationName backlog.OperationName
 		return backlog.OAuth2{}, ogenerrors.ErrSkipClientSecurity
 	}
 
-	if c.accessToken != "" {
+	// サービストークンは初回のアクセストークンを交換で取得するため、空でも更新を試みる
+	if c.bearerToken() != "" || c.serviceToken {
 		// トークンリフレッシュの確認
 		if err := c.ensureValidToken(ctx); err != nil {
 			return backlog.OAuth2{}, fmt.Errorf("token refresh failed: %w", err)
 		}
-		return backlog.OAuth2{Token: c.accessToken}, nil
+		return backlog.OAuth2{Token: c.bearerToken()}, nil
 	}
 	return backlog.OAuth2{}, ogenerrors.ErrSkipClientSecurity
 }
 
 // credentialFromEnv は環境変数から認証情報を取得する。
-// BACKLOG_API_KEY > BACKLOG_ACCESS_TOKEN の優先順位。該当なしなら nil を返す。
+// BACKLOG_API_KEY > BACKLOG_ACCESS_TOKEN > BACKLOG_SERVICE_TOKEN の優先順位。該当なしなら nil を返す。
 func credentialFromEnv() *config.Credential {
-	if key := os.Getenv("BACKLOG_API_KEY"); key != "" {
-		return &config.Credential{
-			AuthType: config.AuthTypeAPIKey,
-			APIKey:   key,
-		}
-	}
-	if token := os.Getenv("BACKLOG_ACCESS_TOKEN"); token != "" {
-		return &config.Credential{
-			AuthType:    config.AuthTypeOAuth,
-			AccessToken: token,
-		}
-	}
-	return nil
+	return config.CredentialFromEnv()
 }
 
 // NewClientFromConfig は設定からクライアントを作成する
@@ -176,14 +203,17 @@ func NewClientFromConfig(cfg *config.Store) (*Client, error) {
 	resolved := cfg.Resolved()
 	profile := resolved.GetActiveProfile()
 	project := cfg.Project()
-	cred := resolved.GetActiveCredential()
-
-	if envCred := credentialFromEnv(); envCred != nil {
-		cred = envCred
-	}
 
-	if cred == nil {
-		return nil, fmt.Errorf("not authenticated")
+	// 環境変数の認証情報があれば credentials.yaml は使わない（暗号化されていても復号しない）
+	ephemeral := false
+	cred := credentialFromEnv()
+	if cred != nil {
+		ephemeral = true
+	} else if cred = cfg.CurrentCredential(); cred == nil {
+		if err := cfg.CredentialError(); err != nil {
+			return nil, err
+		}
+		return nil, ErrNotAuthenticated
 	}
 
 	// space はプロジェクト設定を優先（正規化済みの spaceHost 形式）
@@ -192,24 +222,64 @@ func NewClientFromConfig(cfg *config.Store) (*Client, error) {
 		space = project.Space
 	}
 
+	return newClientForProfile(cfg, resolved.ActiveProfile, profile, space, cred, ephemeral)
+}
+
+// NewClientForProfile は指定プロファイルの設定と認証情報からクライアントを作成する
+// 複数スペースを横断する処理で使う。プロジェクト設定（.backlog.yaml）と環境変数の認証情報は使わない
+func NewClientForProfile(cfg *config.Store, profileName string) (*Client, error) {
+	profile := cfg.Profile(profileName)
+	if profile == nil || profile.Space == "" {
+		return nil, fmt.Errorf("profile %q has no space", profileName)
+	}
+	cred := cfg.Credential(profileName)
+	if cred == nil {
+		if err := cfg.CredentialError(); err != nil {
+			return nil, err
+		}
+		return nil, ErrNotAuthenticated
+	}
+	return newClientForProfile(cfg, profileName, profile, profile.Space, cred, false)
+}
+
+// ephemeral は環境変数の認証情報で動くステートレスモードかどうか
+func newClientForProfile(cfg *config.Store, profileName string, profile *config.ResolvedProfile, space string, cred *config.Credential, ephemeral bool) (*Client, error) {
+	resolved := cfg.Resolved()
+
 	// キャッシュ設定
+	// 環境変数の認証情報で動くステートレスモードでは、明示されない限りディスクに書き込まない
 	var c cache.Cache
 	ttl := time.Duration(resolved.Cache.TTL) * time.Second
-	if resolved.Cache.Enabled {
+	diskEnabled := resolved.Cache.Enabled
+	if ephemeral && !config.CacheExplicitlyConfigured() {
+		diskEnabled = false
+	}
+	if diskEnabled {
 		cacheDir, err := resolved.Cache.GetCacheDir()
 		if err == nil {
-			c, err = cache.NewFileCache(cacheDir)
+			fc, err := cache.NewFileCache(cacheDir)
 			if err != nil {
 				// キャッシュ初期化失敗はログに出さず無視（キャッシュなしで動作）
 			} else {
+				c = fc
 				// バックグラウンドで期限切れキャッシュの削除を実行
 				// プロセス終了とともに終了するため、context.Background()を使用
 				go func() {
-					_ = c.Cleanup(context.Background(), ttl)
+					_ = fc.Cleanup(context.Background(), ttl)
 				}()
 			}
 		}
 	}
+	// インメモリ層はプロセス内で共有し、memory → file → API の順に参照する
+	// ディスクを使わないステートレスモードでもインメモリ層だけは使う
+	if resolved.Cache.Enabled && resolved.Cache.MemoryEntries > 0 {
+		memory := cache.SharedMemoryCache(resolved.Cache.MemoryEntries)
+		if c != nil {
+			c = cache.NewTieredCache(memory, c, ttl)
+		} else {
+			c = memory
+		}
+	}
 
 	// 認証タイプに応じてクライアントを作成
 	switch cred.GetAuthType() {
@@ -226,14 +296,82 @@ func NewClientFromConfig(cfg *config.Store) (*Client, error) {
 		)
 		return client, nil
 
+	case config.AuthTypeServiceToken:
+		// サービストークン認証（中継サーバーで短命のアクセストークンと交換する）
+		httpTimeout := time.Duration(profile.HTTPTimeout) * time.Second
+		relayURL, err := cfg.ResolveRelayURL(profile)
+		if err != nil {
+			return nil, fmt.Errorf("service token requires a relay server: %w", err)
+		}
+		signer, err := config.RelaySignerForProfile(cfg, profile)
+		if err != nil {
+			return nil, err
+		}
+		relayTLS, err := config.RelayTLSConfigForProfile(profile)
+		if err != nil {
+			return nil, err
+		}
+		opts := []ClientOption{
+			WithRelaySigner(signer),
+			WithRelayTLS(relayTLS),
+			WithHTTPTimeout(httpTimeout),
+			WithTokenRefreshMargin(time.Duration(profile.HTTPTokenRefreshMargin) * time.Second),
+			WithCache(c, ttl),
+		}
+		if ephemeral {
+			// ステートレスモードでは交換したアクセストークンを保存しない
+			opts = append(opts, WithServiceToken(cred.ServiceToken, relayURL, "", time.Time{}, nil))
+		} else {
+			opts = append(opts,
+				WithServiceToken(cred.ServiceToken, relayURL, cred.AccessToken, cred.ExpiresAt,
+					func(ctx context.Context, accessToken, serviceToken string, expiresAt time.Time) {
+						if err := cfg.SetCredential(profileName, &config.Credential{
+							AuthType:     config.AuthTypeServiceToken,
+							ServiceToken: serviceToken,
+							AccessToken:  accessToken,
+							ExpiresAt:    expiresAt,
+							UserID:       cred.UserID,
+							UserName:     cred.UserName,
+							UserEmail:    cred.UserEmail,
+							Space:        space,
+						}); err != nil {
+							debug.Log("failed to set credential after service token exchange", "error", err)
+						}
+						if err := cfg.Save(ctx); err != nil {
+							debug.Log("failed to save config after service token exchange", "error", err)
+						}
+					},
+				),
+				WithSharedTokenRefresh(cfg.TokenRefreshLockPath(), func(ctx context.Context) (*TokenSet, error) {
+					latest, err := cfg.LatestCredential(ctx, profileName)
+					if err != nil || latest == nil || latest.GetAuthType() != config.AuthTypeServiceToken {
+						return nil, err
+					}
+					return &TokenSet{
+						AccessToken:  latest.AccessToken,
+						RefreshToken: latest.ServiceToken,
+						ExpiresAt:    latest.ExpiresAt,
+					}, nil
+				}),
+			)
+		}
+		return NewClient(space, "", opts...), nil
+
 	default:
 		// OAuth認証（デフォルト）
-		profileName := resolved.ActiveProfile
 		httpTimeout := time.Duration(profile.HTTPTimeout) * time.Second
 		// relay_url は解決順位（env > bundle > inline relay_server）に従って決定する。
 		// バンドル参照プロファイルでは relay_server が空のため、ここで解決しないと
 		// トークンリフレッシュができず期限切れトークンで 401 になる。
 		relayURL, _ := cfg.ResolveRelayURL(profile)
+		signer, err := config.RelaySignerForProfile(cfg, profile)
+		if err != nil {
+			return nil, err
+		}
+		relayTLS, err := config.RelayTLSConfigForProfile(profile)
+		if err != nil {
+			return nil, err
+		}
 		client := NewClient(
 			space,
 			cred.AccessToken,
@@ -252,6 +390,7 @@ func NewClientFromConfig(cfg *config.Store) (*Client, error) {
 						UserName:     cred.UserName,
 						UserEmail:    cred.UserEmail,
 						Space:        space,
+						SessionID:    cred.SessionID,
 					}); err != nil {
 						debug.Log("failed to set credential after token refresh", "error", err)
 					}
@@ -260,6 +399,19 @@ func NewClientFromConfig(cfg *config.Store) (*Client, error) {
 					}
 				},
 			),
+			WithSharedTokenRefresh(cfg.TokenRefreshLockPath(), func(ctx context.Context) (*TokenSet, error) {
+				latest, err := cfg.LatestCredential(ctx, profileName)
+				if err != nil || latest == nil || latest.GetAuthType() != config.AuthTypeOAuth {
+					return nil, err
+				}
+				return &TokenSet{
+					AccessToken:  latest.AccessToken,
+					RefreshToken: latest.RefreshToken,
+					ExpiresAt:    latest.ExpiresAt,
+				}, nil
+			}),
+			WithRelaySigner(signer),
+			WithRelayTLS(relayTLS),
 			WithHTTPTimeout(httpTimeout),
 			WithTokenRefreshMargin(time.Duration(profile.HTTPTokenRefreshMargin)*time.Second),
 			WithCache(c, ttl),
@@ -277,46 +429,78 @@ func (c *Client) RawBaseURL() string {
 	return fmt.Sprintf("https://%s", c.space)
 }
 
+// bearerToken は現在のアクセストークンを返す
+func (c *Client) bearerToken() string {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.accessToken
+}
+
 // ensureValidToken はトークンが有効か確認し、必要なら更新する
 func (c *Client) ensureValidToken(ctx context.Context) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
 	if c.refreshToken == "" || c.relayServer == "" {
 		return nil // 自動更新なし
 	}
 
 	// 有効期限の5分前に更新
-	if time.Now().Add(c.tokenRefreshMargin).Before(c.expiresAt) {
+	if !c.tokenExpiring(c.expiresAt) {
 		return nil // まだ有効
 	}
 
 	// トークン更新
-	return c.doRefreshToken(ctx)
+	return c.refreshTokenShared(ctx)
+}
+
+// tokenExpiring は有効期限がマージン内（または期限切れ）かどうかを返す
+func (c *Client) tokenExpiring(expiresAt time.Time) bool {
+	return !time.Now().Add(c.tokenRefreshMargin).Before(expiresAt)
 }
 
-func (c *Client) doRefreshToken(ctx context.Context) error {
+// requestTokenRefresh は中継サーバーにリフレッシュトークンを送り、新しいトークンを取得する
+func (c *Client) requestTokenRefresh(ctx context.Context, refreshToken string) (*TokenSet, error) {
 	reqBody := map[string]string{
 		"grant_type":    "refresh_token",
-		"refresh_token": c.refreshToken,
+		"refresh_token": refreshToken,
 		"space":         c.space,
 	}
+	if c.serviceToken {
+		reqBody = map[string]string{
+			"grant_type":    "service_token",
+			"service_token": refreshToken,
+			"space":         c.space,
+		}
+	}
 
 	body, _ := json.Marshal(reqBody)
 
 	req, err := http.NewRequestWithContext(ctx, "POST", c.relayServer+"/auth/token", bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("failed to create token refresh request: %w", err)
+		return nil, fmt.Errorf("failed to create token refresh request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if c.relaySigner != nil {
+		c.relaySigner.Sign(req, body)
+	}
 
 	// relay サーバーへのリクエストは read-only transport を経由させない
-	resp, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	relayClient := &http.Client{Timeout: 30 * time.Second}
+	if c.relayTLS != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = c.relayTLS
+		relayClient.Transport = transport
+	}
+	resp, err := relayClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("token refresh request failed: %w", err)
+		return nil, fmt.Errorf("token refresh request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("token refresh failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("token refresh failed with status %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	var tokenResp struct {
@@ -325,20 +509,19 @@ func (c *Client) doRefreshToken(ctx context.Context) error {
 		ExpiresIn    int    `json:"expires_in"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return fmt.Errorf("failed to parse token response: %w", err)
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
 	}
 
-	// 更新
-	c.accessToken = tokenResp.AccessToken
-	c.refreshToken = tokenResp.RefreshToken
-	c.expiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
-
-	// コールバック（キャンセルを切って値のみ伝播）
-	if c.onTokenUpdate != nil {
-		c.onTokenUpdate(context.WithoutCancel(ctx), c.accessToken, c.refreshToken, c.expiresAt)
+	// サービストークンはローテーションされないため、そのまま次回の交換に使う
+	if c.serviceToken && tokenResp.RefreshToken == "" {
+		tokenResp.RefreshToken = refreshToken
 	}
 
-	return nil
+	return &TokenSet{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second),
+	}, nil
 }
 
 // Request はAPIリクエストを実行する
@@ -379,8 +562,8 @@ func (c *Client) Request(ctx context.Context, method, path string, query url.Val
 	}
 
 	// OAuth認証の場合のみAuthorizationヘッダーを設定
-	if c.apiKey == "" && c.accessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if token := c.bearerToken(); c.apiKey == "" && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
@@ -420,8 +603,8 @@ func (c *Client) PostForm(ctx context.Context, path string, data url.Values) (*h
 	}
 
 	// OAuth認証の場合のみAuthorizationヘッダーを設定
-	if c.apiKey == "" && c.accessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if token := c.bearerToken(); c.apiKey == "" && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
@@ -449,8 +632,8 @@ func (c *Client) PatchForm(ctx context.Context, path string, data url.Values) (*
 	}
 
 	// OAuth認証の場合のみAuthorizationヘッダーを設定
-	if c.apiKey == "" && c.accessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if token := c.bearerToken(); c.apiKey == "" && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
@@ -497,8 +680,8 @@ func (c *Client) RawRequest(ctx context.Context, method, path string, query url.
 	}
 
 	// OAuth認証の場合のみAuthorizationヘッダーを設定
-	if c.apiKey == "" && c.accessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if token := c.bearerToken(); c.apiKey == "" && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	if contentType != "" {
 		req.Header.Set("Content-Type", contentType)
@@ -528,10 +711,43 @@ func (c *Client) DeleteWithForm(ctx context.Context, path string, data url.Value
 	}
 
 	// OAuth認証の場合のみAuthorizationヘッダーを設定
-	if c.apiKey == "" && c.accessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if token := c.bearerToken(); c.apiKey == "" && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	return c.httpClient.Do(req)
 }
+
+// DoJSON は ogen 未生成のエンドポイント向けの汎用ヘルパー。
+// リクエストを送信し、エラーチェックとレスポンスのデコードまでを一括で行う。
+//
+//   - path は /api/v2 からの相対パス（例: "/wikis/123"）
+//   - params はクエリパラメータ（nil 可）
+//   - in は url.Values ならフォーム形式、それ以外の非 nil 値は JSON として送信する
+//   - out はレスポンスのデコード先（nil の場合はステータスのみ検査する）
+func (c *Client) DoJSON(ctx context.Context, method, path string, params url.Values, in, out any) error {
+	var body io.Reader
+	var contentType string
+	switch v := in.(type) {
+	case nil:
+	case url.Values:
+		body = strings.NewReader(v.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	resp, err := c.RawRequest(ctx, method, "/api/v2"+path, params, body, contentType)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return DecodeResponse(resp, out)
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDoJSON(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		in              any
+		wantContentType string
+		wantBody        string
+	}{
+		{name: "no body", method: http.MethodGet},
+		{name: "form body", method: http.MethodPost, in: url.Values{"name": {"Bug"}}, wantContentType: "application/x-www-form-urlencoded", wantBody: "name=Bug"},
+		{name: "json body", method: http.MethodPatch, in: map[string]string{"name": "Bug"}, wantContentType: "application/json", wantBody: `{"name":"Bug"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+			client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				if req.Method != tt.method {
+					t.Errorf("method = %s, want %s", req.Method, tt.method)
+				}
+				if req.URL.Path != "/api/v2/things/1" {
+					t.Errorf("path = %s", req.URL.Path)
+				}
+				if got := req.URL.Query().Get("count"); got != "10" {
+					t.Errorf("count = %q", got)
+				}
+				if got := req.Header.Get("Content-Type"); got != tt.wantContentType {
+					t.Errorf("content-type = %q, want %q", got, tt.wantContentType)
+				}
+				if req.Body != nil {
+					data, _ := io.ReadAll(req.Body)
+					if string(data) != tt.wantBody {
+						t.Errorf("body = %q, want %q", data, tt.wantBody)
+					}
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"id":1}`)),
+				}, nil
+			})
+
+			var out struct {
+				ID int `json:"id"`
+			}
+			err := client.DoJSON(context.Background(), tt.method, "/things/1", url.Values{"count": {"10"}}, tt.in, &out)
+			if err != nil {
+				t.Fatalf("DoJSON() error = %v", err)
+			}
+			if out.ID != 1 {
+				t.Errorf("out.ID = %d, want 1", out.ID)
+			}
+		})
+	}
+}
+
+func TestDoJSONReturnsAPIError(t *testing.T) {
+	client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"errors":[{"message":"No such wiki.","code":6}]}`)),
+		}, nil
+	})
+
+	err := client.DoJSON(context.Background(), http.MethodGet, "/wikis/1", nil, nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("DoJSON() error = %v, want APIError 404", err)
+	}
+}
@@ -2,12 +2,35 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 )
 
+// Backlog API のエラーコード（errors[].code）
+// https://developer.nulab.com/docs/backlog/error-response/
+const (
+	ErrorCodeInternal              = 1
+	ErrorCodeLicence               = 2
+	ErrorCodeLicenceExpired        = 3
+	ErrorCodeAccessDenied          = 4
+	ErrorCodeUnauthorizedOperation = 5
+	ErrorCodeNoResource            = 6
+	ErrorCodeInvalidRequest        = 7
+	ErrorCodeSpaceOverCapacity     = 8
+	ErrorCodeResourceOverflow      = 9
+	ErrorCodeTooLargeFile          = 10
+	ErrorCodeAuthentication        = 11
+	ErrorCodeRequiredMFA           = 12
+	ErrorCodeTooManyRequests       = 13
+)
+
+// ErrNotAuthenticated はプロファイルに認証情報がないことを表す
+var ErrNotAuthenticated = errors.New("not authenticated")
+
 // APIError は Backlog API エラー
+// ogen 生成クライアント経由の呼び出しでも errors.As で取り出せる
 type APIError struct {
 	StatusCode int
 	Errors     []ErrorDetail `json:"errors"`
@@ -27,6 +50,78 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("Backlog API error: status %d", e.StatusCode)
 }
 
+// HasCode はエラー詳細に指定のエラーコードが含まれるかを返す
+func (e *APIError) HasCode(code int) bool {
+	for _, d := range e.Errors {
+		if d.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound はリソースが存在しないエラーかどうかを返す
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.HasCode(ErrorCodeNoResource)
+}
+
+// IsPermissionDenied は権限不足のエラーかどうかを返す
+func IsPermissionDenied(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusForbidden ||
+		apiErr.HasCode(ErrorCodeAccessDenied) ||
+		apiErr.HasCode(ErrorCodeUnauthorizedOperation)
+}
+
+// IsUnauthorized は認証エラーかどうかを返す
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized || apiErr.HasCode(ErrorCodeAuthentication)
+}
+
+// IsValidation はリクエスト内容の不備によるエラーかどうかを返す
+func IsValidation(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusBadRequest || apiErr.HasCode(ErrorCodeInvalidRequest)
+}
+
+// apiErrorDoer は ogen クライアントの HTTP 呼び出しをラップし、
+// エラーステータスのレスポンスを *APIError に変換する。
+// ogen はステータスコードしか持たない UnexpectedStatusCodeError を返し、
+// その時点でボディが閉じられてしまうため、ここでボディを読んでおく。
+// 送信前には withExtraForm で指定されたフォーム値をボディに追記する。
+type apiErrorDoer struct {
+	client *http.Client
+}
+
+func (d *apiErrorDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := appendExtraForm(req); err != nil {
+		return nil, err
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusBadRequest {
+		return resp, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return nil, CheckResponse(resp)
+}
+
 // CheckResponse はレスポンスをチェックし、エラーがあれば返す
 func CheckResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
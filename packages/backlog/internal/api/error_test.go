@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newErrorTestClient(status int, body string) *Client {
+	client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+	return client
+}
+
+func TestGeneratedClientReturnsAPIError(t *testing.T) {
+	client := newErrorTestClient(http.StatusNotFound,
+		`{"errors":[{"message":"No issue.","code":6,"moreInfo":"PROJ-999"}]}`)
+
+	_, err := client.GetIssue(context.Background(), "PROJ-999")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As(*APIError) failed for %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d, want 404", apiErr.StatusCode)
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].MoreInfo != "PROJ-999" {
+		t.Errorf("Errors = %+v", apiErr.Errors)
+	}
+	if !IsNotFound(err) || IsPermissionDenied(err) {
+		t.Errorf("IsNotFound = %v, IsPermissionDenied = %v", IsNotFound(err), IsPermissionDenied(err))
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		denied       bool
+		unauthorized bool
+		validation   bool
+	}{
+		{
+			name:     "404 status",
+			err:      &APIError{StatusCode: http.StatusNotFound},
+			notFound: true,
+		},
+		{
+			name:     "no resource code",
+			err:      &APIError{StatusCode: http.StatusBadRequest, Errors: []ErrorDetail{{Code: ErrorCodeNoResource}}},
+			notFound: true, validation: true,
+		},
+		{
+			name:   "403 status wrapped",
+			err:    fmt.Errorf("failed to update: %w", &APIError{StatusCode: http.StatusForbidden}),
+			denied: true,
+		},
+		{
+			name:   "unauthorized operation code",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Errors: []ErrorDetail{{Code: ErrorCodeUnauthorizedOperation}}},
+			denied: true, validation: true,
+		},
+		{
+			name:         "401 status",
+			err:          &APIError{StatusCode: http.StatusUnauthorized, Errors: []ErrorDetail{{Code: ErrorCodeAuthentication}}},
+			unauthorized: true,
+		},
+		{
+			name:       "invalid request",
+			err:        &APIError{StatusCode: http.StatusBadRequest, Errors: []ErrorDetail{{Code: ErrorCodeInvalidRequest}}},
+			validation: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("boom"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.notFound)
+			}
+			if got := IsPermissionDenied(tt.err); got != tt.denied {
+				t.Errorf("IsPermissionDenied() = %v, want %v", got, tt.denied)
+			}
+			if got := IsUnauthorized(tt.err); got != tt.unauthorized {
+				t.Errorf("IsUnauthorized() = %v, want %v", got, tt.unauthorized)
+			}
+			if got := IsValidation(tt.err); got != tt.validation {
+				t.Errorf("IsValidation() = %v, want %v", got, tt.validation)
+			}
+		})
+	}
+}
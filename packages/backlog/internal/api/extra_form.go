@@ -0,0 +1,54 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// extraFormKey は生成クライアントのリクエストに追加するフォーム値のコンテキストキー
+type extraFormKey struct{}
+
+// withExtraForm は生成クライアントが送るフォームに values を追加するコンテキストを返す。
+// customField_{id} のようにキー名が動的で、OpenAPI 仕様から生成できないフィールドに使う
+func withExtraForm(ctx context.Context, values url.Values) context.Context {
+	if len(values) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, extraFormKey{}, values)
+}
+
+// appendExtraForm はコンテキストに追加のフォーム値があれば、フォーム形式のリクエストボディに追記する
+func appendExtraForm(req *http.Request) error {
+	values, ok := req.Context().Value(extraFormKey{}).(url.Values)
+	if !ok || len(values) == 0 {
+		return nil
+	}
+	if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return nil
+	}
+
+	var body []byte
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return err
+		}
+		body = data
+	}
+	if len(body) > 0 {
+		body = append(body, '&')
+	}
+	body = append(body, values.Encode()...)
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // GitRepository はGitリポジトリ情報
@@ -24,14 +25,9 @@ type GitRepository struct {
 
 // GetGitRepositories はGitリポジトリ一覧を取得する
 func (c *Client) GetGitRepositories(ctx context.Context, projectIDOrKey string) ([]GitRepository, error) {
-	resp, err := c.Get(ctx, fmt.Sprintf("/projects/%s/git/repositories", projectIDOrKey), nil)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
+	path := fmt.Sprintf("/projects/%s/git/repositories", projectIDOrKey)
 	var repos []GitRepository
-	if err := DecodeResponse(resp, &repos); err != nil {
+	if err := c.DoJSON(ctx, http.MethodGet, path, nil, nil, &repos); err != nil {
 		return nil, err
 	}
 
@@ -40,14 +36,9 @@ func (c *Client) GetGitRepositories(ctx context.Context, projectIDOrKey string)
 
 // GetGitRepository はGitリポジトリを取得する
 func (c *Client) GetGitRepository(ctx context.Context, projectIDOrKey, repoIDOrName string) (*GitRepository, error) {
-	resp, err := c.Get(ctx, fmt.Sprintf("/projects/%s/git/repositories/%s", projectIDOrKey, repoIDOrName), nil)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
+	path := fmt.Sprintf("/projects/%s/git/repositories/%s", projectIDOrKey, repoIDOrName)
 	var repo GitRepository
-	if err := DecodeResponse(resp, &repo); err != nil {
+	if err := c.DoJSON(ctx, http.MethodGet, path, nil, nil, &repo); err != nil {
 		return nil, err
 	}
 
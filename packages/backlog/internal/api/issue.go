@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
 )
@@ -284,33 +285,37 @@ func (c *Client) GetIssue(ctx context.Context, issueIDOrKey string) (*backlog.Is
 
 // CreateIssueInput は課題作成の入力
 type CreateIssueInput struct {
-	ProjectID      int
-	Summary        string
-	IssueTypeID    int
-	PriorityID     int
-	Description    string
-	StartDate      string
-	DueDate        string
-	EstimatedHours float64
-	ActualHours    float64
-	CategoryIDs    []int
-	VersionIDs     []int
-	MilestoneIDs   []int
-	AssigneeID     int
-	ParentIssueID  int
-	AttachmentIDs  []int
+	ProjectID       int
+	Summary         string
+	IssueTypeID     int
+	PriorityID      int
+	Description     string
+	StartDate       string
+	DueDate         string
+	EstimatedHours  float64
+	ActualHours     float64
+	CategoryIDs     []int
+	VersionIDs      []int
+	MilestoneIDs    []int
+	AssigneeID      int
+	ParentIssueID   int
+	NotifiedUserIDs []int
+	AttachmentIDs   []int
+	// CustomFields はカスタムフィールド ID ごとの値（リスト形式は選択肢 ID）
+	CustomFields map[int][]string
 }
 
 // CreateIssue は課題を作成する
 func (c *Client) CreateIssue(ctx context.Context, input *CreateIssueInput) (*backlog.Issue, error) {
 	req := backlog.CreateIssueReq{
-		ProjectId:   input.ProjectID,
-		Summary:     input.Summary,
-		IssueTypeId: input.IssueTypeID,
-		PriorityId:  input.PriorityID,
-		CategoryId:  input.CategoryIDs,
-		VersionId:   input.VersionIDs,
-		MilestoneId: input.MilestoneIDs,
+		ProjectId:      input.ProjectID,
+		Summary:        input.Summary,
+		IssueTypeId:    input.IssueTypeID,
+		PriorityId:     input.PriorityID,
+		CategoryId:     input.CategoryIDs,
+		VersionId:      input.VersionIDs,
+		MilestoneId:    input.MilestoneIDs,
+		NotifiedUserId: input.NotifiedUserIDs,
 	}
 
 	if input.Description != "" {
@@ -338,9 +343,22 @@ func (c *Client) CreateIssue(ctx context.Context, input *CreateIssueInput) (*bac
 		req.AttachmentId = input.AttachmentIDs
 	}
 
+	// customField_{id} はキー名が動的で生成クライアントの型に含められないため、送信時にフォームへ追記する
+	ctx = withExtraForm(ctx, customFieldValues(input.CustomFields))
 	return c.backlogClient.CreateIssue(ctx, backlog.NewOptCreateIssueReq(req))
 }
 
+// customFieldValues はカスタムフィールドの値を customField_{id} 形式のフォーム値にする
+func customFieldValues(fields map[int][]string) url.Values {
+	data := url.Values{}
+	for id, values := range fields {
+		for _, v := range values {
+			data.Add(fmt.Sprintf("customField_%d", id), v)
+		}
+	}
+	return data
+}
+
 // UpdateIssueInput は課題更新の入力
 type UpdateIssueInput struct {
 	Summary        *string
@@ -352,6 +370,7 @@ type UpdateIssueInput struct {
 	EstimatedHours *float64
 	ActualHours    *float64
 	AssigneeID     *int
+	ParentIssueID  *int
 	CategoryIDs    []int
 	VersionIDs     []int
 	MilestoneIDs   []int
@@ -398,6 +417,9 @@ func (c *Client) UpdateIssue(ctx context.Context, issueIDOrKey string, input *Up
 	if input.AssigneeID != nil {
 		req.AssigneeId = backlog.NewOptInt(*input.AssigneeID)
 	}
+	if input.ParentIssueID != nil {
+		req.ParentIssueId = backlog.NewOptInt(*input.ParentIssueID)
+	}
 	if input.Comment != nil {
 		req.Comment = backlog.NewOptString(*input.Comment)
 	}
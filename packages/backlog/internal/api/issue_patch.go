@@ -81,6 +81,11 @@ func (c *Client) SafeUpdateIssueDescription(
 	return updated, merged, nil
 }
 
+// GetIssueNoCache はキャッシュを使わずに課題を取得する（更新前の競合検出用）
+func (c *Client) GetIssueNoCache(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error) {
+	return c.getIssueNoCache(ctx, issueIDOrKey)
+}
+
 func (c *Client) getIssueNoCache(ctx context.Context, issueIDOrKey string) (*backlog.Issue, error) {
 	return c.backlogClient.GetIssue(ctx, backlog.GetIssueParams{
 		IssueIdOrKey: issueIDOrKey,
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -124,3 +125,55 @@ func TestUpdateIssueEncodesBracketedArrayFields(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateIssueSendsCustomFields(t *testing.T) {
+	var body string
+
+	client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		body = string(data)
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	})
+
+	_, err := client.CreateIssue(context.Background(), &CreateIssueInput{
+		ProjectID:    1,
+		Summary:      "Login fails",
+		IssueTypeID:  2,
+		PriorityID:   3,
+		DueDate:      "2026-10-31",
+		CategoryIDs:  []int{4, 5},
+		CustomFields: map[int][]string{10: {"101"}, 11: {"111", "112"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssue returned error: %v", err)
+	}
+
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		t.Fatalf("failed to parse request body: %v", err)
+	}
+	for key, want := range map[string][]string{
+		"projectId":      {"1"},
+		"summary":        {"Login fails"},
+		"dueDate":        {"2026-10-31"},
+		"categoryId[]":   {"4", "5"},
+		"customField_10": {"101"},
+		"customField_11": {"111", "112"},
+	} {
+		if got := values[key]; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+	if values.Has("description") || values.Has("assigneeId") {
+		t.Errorf("unset fields were sent: %v", values)
+	}
+}
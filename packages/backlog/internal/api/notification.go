@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -78,14 +79,8 @@ func (c *Client) GetNotifications(ctx context.Context, opts *NotificationListOpt
 		query = opts.ToQuery()
 	}
 
-	resp, err := c.Get(ctx, "/notifications", query)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
 	var notifications []UserNotification
-	if err := DecodeResponse(resp, &notifications); err != nil {
+	if err := c.DoJSON(ctx, http.MethodGet, "/notifications", query, nil, &notifications); err != nil {
 		return nil, err
 	}
 
@@ -94,16 +89,10 @@ func (c *Client) GetNotifications(ctx context.Context, opts *NotificationListOpt
 
 // GetNotificationsCount は未読通知数を取得する
 func (c *Client) GetNotificationsCount(ctx context.Context) (int, error) {
-	resp, err := c.Get(ctx, "/notifications/count", nil)
-	if err != nil {
-		return 0, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
 	var result struct {
 		Count int `json:"count"`
 	}
-	if err := DecodeResponse(resp, &result); err != nil {
+	if err := c.DoJSON(ctx, http.MethodGet, "/notifications/count", nil, nil, &result); err != nil {
 		return 0, err
 	}
 
@@ -123,16 +112,10 @@ func (c *Client) MarkNotificationAsRead(ctx context.Context, notificationID int)
 
 // ResetUnreadNotificationCount は未読通知カウントをリセットする（全て既読）
 func (c *Client) ResetUnreadNotificationCount(ctx context.Context) (int, error) {
-	resp, err := c.PostForm(ctx, "/notifications/markAsRead", nil)
-	if err != nil {
-		return 0, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
 	var result struct {
 		Count int `json:"count"`
 	}
-	if err := DecodeResponse(resp, &result); err != nil {
+	if err := c.DoJSON(ctx, http.MethodPost, "/notifications/markAsRead", nil, url.Values{}, &result); err != nil {
 		return 0, err
 	}
 
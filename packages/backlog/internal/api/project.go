@@ -403,3 +403,79 @@ func (c *Client) GetProjectUsers(ctx context.Context, projectIDOrKey string) ([]
 
 	return users, nil
 }
+
+// AddProjectUser はプロジェクトにユーザーを追加する（userID はユーザーの数値 ID）
+func (c *Client) AddProjectUser(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	return c.backlogClient.AddProjectUser(ctx, backlog.NewOptAddProjectUserReq(backlog.AddProjectUserReq{
+		UserId: userID,
+	}), backlog.AddProjectUserParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
+}
+
+// DeleteProjectUser はプロジェクトからユーザーを削除する
+func (c *Client) DeleteProjectUser(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	return c.backlogClient.DeleteProjectUser(ctx, backlog.NewOptDeleteProjectUserReq(backlog.DeleteProjectUserReq{
+		UserId: userID,
+	}), backlog.DeleteProjectUserParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
+}
+
+// AddProjectAdministrator はユーザーをプロジェクト管理者にする
+func (c *Client) AddProjectAdministrator(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	return c.backlogClient.AddProjectAdministrator(ctx, backlog.NewOptAddProjectAdministratorReq(backlog.AddProjectAdministratorReq{
+		UserId: userID,
+	}), backlog.AddProjectAdministratorParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
+}
+
+// DeleteProjectAdministrator はユーザーのプロジェクト管理者権限を外す
+func (c *Client) DeleteProjectAdministrator(ctx context.Context, projectIDOrKey string, userID int) (*backlog.User, error) {
+	return c.backlogClient.DeleteProjectAdministrator(ctx, backlog.NewOptDeleteProjectAdministratorReq(backlog.DeleteProjectAdministratorReq{
+		UserId: userID,
+	}), backlog.DeleteProjectAdministratorParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
+}
+
+// GetProjectAdministrators はプロジェクト管理者一覧を取得する
+func (c *Client) GetProjectAdministrators(ctx context.Context, projectIDOrKey string) ([]backlog.User, error) {
+	return c.backlogClient.GetProjectAdministrators(ctx, backlog.GetProjectAdministratorsParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
+}
+
+// GetWebhooks はプロジェクトの Webhook 一覧を取得する
+func (c *Client) GetWebhooks(ctx context.Context, projectIDOrKey string) ([]backlog.Webhook, error) {
+	return c.backlogClient.GetWebhooks(ctx, backlog.GetWebhooksParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
+}
+
+// UpdateWebhookInput は Webhook 更新の入力
+type UpdateWebhookInput struct {
+	Name        *string
+	Description *string
+	HookURL     *string
+}
+
+// UpdateWebhook は Webhook を更新する
+func (c *Client) UpdateWebhook(ctx context.Context, projectIDOrKey string, webhookID int, input *UpdateWebhookInput) (*backlog.Webhook, error) {
+	req := backlog.UpdateWebhookReq{}
+	if input.Name != nil {
+		req.Name = backlog.NewOptString(*input.Name)
+	}
+	if input.Description != nil {
+		req.Description = backlog.NewOptString(*input.Description)
+	}
+	if input.HookURL != nil {
+		req.HookUrl = backlog.NewOptString(*input.HookURL)
+	}
+
+	return c.backlogClient.UpdateWebhook(ctx, backlog.NewOptUpdateWebhookReq(req), backlog.UpdateWebhookParams{
+		ProjectIdOrKey: projectIDOrKey,
+		WebhookId:      webhookID,
+	})
+}
@@ -54,3 +54,87 @@ func TestCreateCategoryAcceptsStatusOK(t *testing.T) {
 		t.Fatalf("category.Name = %+v, want %q", category.Name, "Bug")
 	}
 }
+
+func TestDeleteProjectAdministratorSendsUserIDForm(t *testing.T) {
+	var body string
+
+	client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodDelete {
+			t.Fatalf("method = %s, want %s", req.Method, http.MethodDelete)
+		}
+		if req.URL.Path != "/api/v2/projects/PROJ/administrators" {
+			t.Fatalf("path = %s, want %s", req.URL.Path, "/api/v2/projects/PROJ/administrators")
+		}
+
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		body = string(data)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":42,"userId":"alice"}`)),
+		}, nil
+	})
+
+	user, err := client.DeleteProjectAdministrator(context.Background(), "PROJ", 42)
+	if err != nil {
+		t.Fatalf("DeleteProjectAdministrator returned error: %v", err)
+	}
+
+	form, err := url.ParseQuery(body)
+	if err != nil {
+		t.Fatalf("failed to parse request body: %v", err)
+	}
+	if got := form.Get("userId"); got != "42" {
+		t.Fatalf("userId = %q, want %q", got, "42")
+	}
+	if !user.ID.IsSet() || user.ID.Value != 42 {
+		t.Fatalf("user.ID = %+v, want 42", user.ID)
+	}
+}
+
+func TestUpdateWebhookSendsOnlySetFields(t *testing.T) {
+	var body string
+
+	client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("method = %s, want %s", req.Method, http.MethodPatch)
+		}
+		if req.URL.Path != "/api/v2/projects/PROJ/webhooks/7" {
+			t.Fatalf("path = %s, want %s", req.URL.Path, "/api/v2/projects/PROJ/webhooks/7")
+		}
+
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("failed to read request body: %v", err)
+		}
+		body = string(data)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":7}`)),
+		}, nil
+	})
+
+	hookURL := "https://example.com/hook?secret=new"
+	if _, err := client.UpdateWebhook(context.Background(), "PROJ", 7, &UpdateWebhookInput{HookURL: &hookURL}); err != nil {
+		t.Fatalf("UpdateWebhook returned error: %v", err)
+	}
+
+	form, err := url.ParseQuery(body)
+	if err != nil {
+		t.Fatalf("failed to parse request body: %v", err)
+	}
+	if got := form.Get("hookUrl"); got != hookURL {
+		t.Fatalf("hookUrl = %q, want %q", got, hookURL)
+	}
+	if form.Has("name") || form.Has("description") {
+		t.Fatalf("unset fields were sent: %v", form)
+	}
+}
@@ -219,6 +219,14 @@ type PRComment struct {
 	Updated       string         `json:"updated"`
 	Stars         []Star         `json:"stars"`
 	Notifications []Notification `json:"notifications"`
+	// FilePath と Line は差分の行に付けたインラインコメントの位置（通常のコメントでは空）
+	FilePath string `json:"filePath,omitempty"`
+	Line     int    `json:"line,omitempty"`
+}
+
+// IsInline はインラインコメントかどうかを返す
+func (c *PRComment) IsInline() bool {
+	return c.FilePath != ""
 }
 
 // PRCommentListOptions はPRコメント一覧取得オプション
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
 )
@@ -32,3 +33,128 @@ func (c *Client) GetCustomFields(ctx context.Context, projectIDOrKey string) ([]
 		ProjectIdOrKey: projectIDOrKey,
 	})
 }
+
+// GetCustomFieldDefinitions はカスタムフィールド一覧をキャッシュを使って取得する
+// 課題作成時の入力検証のように、キャッシュの有効期間だけ古い定義を使ってもよい用途向け
+func (c *Client) GetCustomFieldDefinitions(ctx context.Context, projectIDOrKey string) ([]backlog.CustomField, error) {
+	key := fmt.Sprintf("customFields:%s:%s", c.space, projectIDOrKey)
+	if c.cache != nil {
+		var fields []backlog.CustomField
+		if ok, _ := c.cache.Get(key, &fields); ok {
+			return fields, nil
+		}
+	}
+
+	fields, err := c.GetCustomFields(ctx, projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cache != nil {
+		_ = c.cache.Set(key, fields, c.cacheTTL)
+	}
+	return fields, nil
+}
+
+// invalidateCustomFieldCache はカスタムフィールド定義のキャッシュを削除する
+// プロジェクトはキーと ID のどちらでも参照されるため、スペース単位で削除する
+func (c *Client) invalidateCustomFieldCache() {
+	if c.cache == nil {
+		return
+	}
+	_ = c.cache.DeleteByPrefix(fmt.Sprintf("customFields:%s:", c.space))
+}
+
+// CreateCustomFieldInput はカスタムフィールド作成の入力
+type CreateCustomFieldInput struct {
+	TypeID               int
+	Name                 string
+	Description          string
+	Required             bool
+	ApplicableIssueTypes []int
+	Items                []string
+	AllowAddItem         *bool
+}
+
+// CreateCustomField はカスタムフィールドを作成する
+func (c *Client) CreateCustomField(ctx context.Context, projectIDOrKey string, input *CreateCustomFieldInput) (*backlog.CustomField, error) {
+	req := backlog.CreateCustomFieldReq{
+		TypeId:               input.TypeID,
+		Name:                 input.Name,
+		ApplicableIssueTypes: input.ApplicableIssueTypes,
+		Items:                input.Items,
+	}
+	if input.Description != "" {
+		req.Description = backlog.NewOptString(input.Description)
+	}
+	if input.Required {
+		req.Required = backlog.NewOptBool(true)
+	}
+	if input.AllowAddItem != nil {
+		req.AllowAddItem = backlog.NewOptBool(*input.AllowAddItem)
+	}
+	field, err := c.backlogClient.CreateCustomField(ctx, backlog.NewOptCreateCustomFieldReq(req), backlog.CreateCustomFieldParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateCustomFieldCache()
+	return field, nil
+}
+
+// UpdateCustomFieldInput はカスタムフィールド更新の入力
+type UpdateCustomFieldInput struct {
+	Description          *string
+	Required             *bool
+	ApplicableIssueTypes []int
+}
+
+// UpdateCustomField はカスタムフィールドを更新する
+func (c *Client) UpdateCustomField(ctx context.Context, projectIDOrKey string, customFieldID int, input *UpdateCustomFieldInput) (*backlog.CustomField, error) {
+	req := backlog.UpdateCustomFieldReq{
+		ApplicableIssueTypes: input.ApplicableIssueTypes,
+	}
+	if input.Description != nil {
+		req.Description = backlog.NewOptString(*input.Description)
+	}
+	if input.Required != nil {
+		req.Required = backlog.NewOptBool(*input.Required)
+	}
+	field, err := c.backlogClient.UpdateCustomField(ctx, backlog.NewOptUpdateCustomFieldReq(req), backlog.UpdateCustomFieldParams{
+		ProjectIdOrKey: projectIDOrKey,
+		CustomFieldId:  customFieldID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateCustomFieldCache()
+	return field, nil
+}
+
+// AddCustomFieldItem はリスト形式のカスタムフィールドに選択肢を追加する
+func (c *Client) AddCustomFieldItem(ctx context.Context, projectIDOrKey string, customFieldID int, name string) (*backlog.CustomField, error) {
+	field, err := c.backlogClient.AddCustomFieldItem(ctx, backlog.NewOptAddCustomFieldItemReq(backlog.AddCustomFieldItemReq{Name: name}), backlog.AddCustomFieldItemParams{
+		ProjectIdOrKey: projectIDOrKey,
+		CustomFieldId:  customFieldID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateCustomFieldCache()
+	return field, nil
+}
+
+// DeleteCustomFieldItem はリスト形式のカスタムフィールドから選択肢を削除する
+func (c *Client) DeleteCustomFieldItem(ctx context.Context, projectIDOrKey string, customFieldID int, itemID int) (*backlog.CustomField, error) {
+	field, err := c.backlogClient.DeleteCustomFieldItem(ctx, backlog.DeleteCustomFieldItemParams{
+		ProjectIdOrKey: projectIDOrKey,
+		CustomFieldId:  customFieldID,
+		ItemId:         itemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateCustomFieldCache()
+	return field, nil
+}
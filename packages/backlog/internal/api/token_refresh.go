@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"golang.org/x/sync/singleflight"
+)
+
+// TokenSet は OAuth のアクセストークン・リフレッシュトークンと有効期限の組
+type TokenSet struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+const (
+	// tokenRefreshLockTimeout は他プロセスのトークン更新を待つ最大時間
+	// 超えた場合はロックなしで更新する（ロックを保持したまま固まったプロセスに引きずられないため）
+	tokenRefreshLockTimeout  = 60 * time.Second
+	tokenRefreshLockInterval = 100 * time.Millisecond
+)
+
+// tokenRefreshGroup はプロセス内のトークン更新を単一飛行化する
+// 同じリフレッシュトークンによる更新は複数のクライアントから同時に要求されても1回だけ行う
+var tokenRefreshGroup singleflight.Group
+
+// WithSharedTokenRefresh は複数プロセス間でトークン更新を直列化する
+// 更新前に lockPath のファイルロックを取得し、latest で保存済みの最新トークンを読み直す。
+// 他のプロセスが更新済みであれば中継サーバーへのリクエストを送らずにそのトークンを使う
+func WithSharedTokenRefresh(lockPath string, latest func(ctx context.Context) (*TokenSet, error)) ClientOption {
+	return func(c *Client) {
+		c.refreshLockPath = lockPath
+		c.latestTokens = latest
+	}
+}
+
+// refreshTokenShared はトークンを更新してクライアントに反映する
+// 呼び出し側は c.tokenMu を保持していること
+func (c *Client) refreshTokenShared(ctx context.Context) error {
+	key := c.relayServer + "\x00" + c.space + "\x00" + c.refreshToken
+	v, err, _ := tokenRefreshGroup.Do(key, func() (any, error) {
+		return c.refreshTokenWithLock(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	tokens := v.(*TokenSet)
+	c.accessToken = tokens.AccessToken
+	c.refreshToken = tokens.RefreshToken
+	c.expiresAt = tokens.ExpiresAt
+	return nil
+}
+
+// refreshTokenWithLock はファイルロックを取得した上で、他プロセスが更新したトークンを取り込むか、
+// 中継サーバーで更新して保存する。ロックは保存が終わるまで保持する
+func (c *Client) refreshTokenWithLock(ctx context.Context) (*TokenSet, error) {
+	if c.refreshLockPath != "" {
+		lockCtx, cancel := context.WithTimeout(ctx, tokenRefreshLockTimeout)
+		release, err := osutil.AcquireLockWait(lockCtx, c.refreshLockPath, tokenRefreshLockInterval)
+		cancel()
+		if err != nil {
+			debug.Log("token refresh lock unavailable, refreshing without lock", "error", err)
+		} else {
+			defer func() { _ = release() }()
+		}
+	}
+
+	refreshToken := c.refreshToken
+	if c.latestTokens != nil {
+		latest, err := c.latestTokens(ctx)
+		switch {
+		case err != nil:
+			debug.Log("failed to reload credential before token refresh", "error", err)
+		case latest == nil || latest.RefreshToken == "":
+		case !c.tokenExpiring(latest.ExpiresAt):
+			debug.Log("using token refreshed by another process")
+			return latest, nil
+		default:
+			// 他プロセスがリフレッシュトークンをローテーションしていれば新しい方で更新する
+			refreshToken = latest.RefreshToken
+		}
+	}
+
+	tokens, err := c.requestTokenRefresh(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// コールバック（キャンセルを切って値のみ伝播）
+	if c.onTokenUpdate != nil {
+		c.onTokenUpdate(context.WithoutCancel(ctx), tokens.AccessToken, tokens.RefreshToken, tokens.ExpiresAt)
+	}
+	return tokens, nil
+}
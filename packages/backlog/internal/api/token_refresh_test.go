@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRefreshServer はリフレッシュ要求の回数と受け取ったリフレッシュトークンを記録する中継サーバーを返す
+func newRefreshServer(t *testing.T, delay time.Duration) (*httptest.Server, *atomic.Int32, *[]string) {
+	t.Helper()
+	var count atomic.Int32
+	var mu sync.Mutex
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		received = append(received, body["refresh_token"])
+		mu.Unlock()
+		count.Add(1)
+		time.Sleep(delay)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "new-access",
+			"refresh_token": "new-refresh",
+			"expires_in":    3600,
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &count, &received
+}
+
+func TestRefreshTokenSingleFlight(t *testing.T) {
+	srv, count, _ := newRefreshServer(t, 50*time.Millisecond)
+	lockPath := filepath.Join(t.TempDir(), "token-refresh.lock")
+
+	var updates atomic.Int32
+	const n = 5
+	clients := make([]*Client, n)
+	for i := range clients {
+		clients[i] = NewClient("example.backlog.jp", "old-access",
+			WithTokenRefresh("shared-refresh", srv.URL, time.Now().Add(-time.Minute),
+				func(context.Context, string, string, time.Time) { updates.Add(1) }),
+			WithSharedTokenRefresh(lockPath, nil),
+		)
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.ensureValidToken(context.Background()); err != nil {
+				t.Errorf("ensureValidToken() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := count.Load(); got != 1 {
+		t.Errorf("refresh requests = %d, want 1", got)
+	}
+	if got := updates.Load(); got != 1 {
+		t.Errorf("token update callbacks = %d, want 1", got)
+	}
+	for i, c := range clients {
+		if got := c.bearerToken(); got != "new-access" {
+			t.Errorf("client %d access token = %q, want new-access", i, got)
+		}
+	}
+}
+
+func TestRefreshTokenUsesLatestCredential(t *testing.T) {
+	tests := []struct {
+		name         string
+		latest       *TokenSet
+		wantRequests int32
+		wantSent     string
+		wantAccess   string
+	}{
+		{
+			name:         "refreshed by another process",
+			latest:       &TokenSet{AccessToken: "other-access", RefreshToken: "other-refresh", ExpiresAt: time.Now().Add(time.Hour)},
+			wantRequests: 0,
+			wantAccess:   "other-access",
+		},
+		{
+			name:         "rotated refresh token is used",
+			latest:       &TokenSet{AccessToken: "stale-access", RefreshToken: "rotated-refresh", ExpiresAt: time.Now().Add(-time.Minute)},
+			wantRequests: 1,
+			wantSent:     "rotated-refresh",
+			wantAccess:   "new-access",
+		},
+		{
+			name:         "no stored credential",
+			wantRequests: 1,
+			wantSent:     "own-refresh",
+			wantAccess:   "new-access",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, count, received := newRefreshServer(t, 0)
+			c := NewClient("example.backlog.jp", "old-access",
+				WithTokenRefresh("own-refresh", srv.URL, time.Now().Add(-time.Minute), nil),
+				WithSharedTokenRefresh(filepath.Join(t.TempDir(), "token-refresh.lock"),
+					func(context.Context) (*TokenSet, error) { return tt.latest, nil }),
+			)
+
+			if err := c.ensureValidToken(context.Background()); err != nil {
+				t.Fatalf("ensureValidToken() error = %v", err)
+			}
+			if got := count.Load(); got != tt.wantRequests {
+				t.Errorf("refresh requests = %d, want %d", got, tt.wantRequests)
+			}
+			if tt.wantSent != "" && (len(*received) != 1 || (*received)[0] != tt.wantSent) {
+				t.Errorf("sent refresh tokens = %v, want [%s]", *received, tt.wantSent)
+			}
+			if got := c.bearerToken(); got != tt.wantAccess {
+				t.Errorf("access token = %q, want %q", got, tt.wantAccess)
+			}
+		})
+	}
+}
+
+func TestServiceTokenExchange(t *testing.T) {
+	var received []map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		received = append(received, body)
+		// サービストークンの交換ではリフレッシュトークンを返さない
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "exchanged-access",
+			"expires_in":   3600,
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	var updatedToken string
+	c := NewClient("example.backlog.jp", "",
+		WithServiceToken("bst_id.secret", srv.URL, "", time.Time{},
+			func(_ context.Context, _, serviceToken string, _ time.Time) { updatedToken = serviceToken }),
+	)
+
+	if err := c.ensureValidToken(context.Background()); err != nil {
+		t.Fatalf("ensureValidToken() error = %v", err)
+	}
+	if len(received) != 1 {
+		t.Fatalf("exchange requests = %d, want 1", len(received))
+	}
+	if received[0]["grant_type"] != "service_token" || received[0]["service_token"] != "bst_id.secret" {
+		t.Errorf("request body = %v, want service_token grant", received[0])
+	}
+	if got := c.bearerToken(); got != "exchanged-access" {
+		t.Errorf("access token = %q, want exchanged-access", got)
+	}
+	if updatedToken != "bst_id.secret" {
+		t.Errorf("service token after exchange = %q, want it kept", updatedToken)
+	}
+
+	// 有効期限内は再交換しない
+	if err := c.ensureValidToken(context.Background()); err != nil {
+		t.Fatalf("ensureValidToken() error = %v", err)
+	}
+	if len(received) != 1 {
+		t.Errorf("exchange requests = %d, want 1", len(received))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"strconv"
 
@@ -40,30 +41,34 @@ func (c *Client) GetWikis(ctx context.Context, projectIDOrKey, keyword string) (
 		query.Set("keyword", keyword)
 	}
 
-	resp, err := c.Get(ctx, "/wikis", query)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
 	var wikis []Wiki
-	if err := DecodeResponse(resp, &wikis); err != nil {
+	if err := c.DoJSON(ctx, http.MethodGet, "/wikis", query, nil, &wikis); err != nil {
 		return nil, err
 	}
 
 	return wikis, nil
 }
 
-// GetWiki はWikiページを取得する
-func (c *Client) GetWiki(ctx context.Context, wikiID int) (*Wiki, error) {
-	resp, err := c.Get(ctx, fmt.Sprintf("/wikis/%d", wikiID), nil)
+// GetWikiTags はプロジェクトで使われている Wiki タグの一覧を取得する
+func (c *Client) GetWikiTags(ctx context.Context, projectIDOrKey string) ([]WikiTag, error) {
+	res, err := c.backlogClient.GetWikiTags(ctx, backlog.GetWikiTagsParams{
+		ProjectIdOrKey: projectIDOrKey,
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = resp.Body.Close() }()
 
+	tags := make([]WikiTag, 0, len(res))
+	for _, t := range res {
+		tags = append(tags, WikiTag{ID: t.ID.Value, Name: t.Name.Value})
+	}
+	return tags, nil
+}
+
+// GetWiki はWikiページを取得する
+func (c *Client) GetWiki(ctx context.Context, wikiID int) (*Wiki, error) {
 	var wiki Wiki
-	if err := DecodeResponse(resp, &wiki); err != nil {
+	if err := c.DoJSON(ctx, http.MethodGet, fmt.Sprintf("/wikis/%d", wikiID), nil, nil, &wiki); err != nil {
 		return nil, err
 	}
 
@@ -112,14 +117,8 @@ func (c *Client) CreateWiki(ctx context.Context, input *CreateWikiInput) (*Wiki,
 		data.Set("mailNotify", "true")
 	}
 
-	resp, err := c.PostForm(ctx, "/wikis", data)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
 	var wiki Wiki
-	if err := DecodeResponse(resp, &wiki); err != nil {
+	if err := c.DoJSON(ctx, http.MethodPost, "/wikis", nil, data, &wiki); err != nil {
 		return nil, err
 	}
 
@@ -146,14 +145,8 @@ func (c *Client) UpdateWiki(ctx context.Context, wikiID int, input *UpdateWikiIn
 		data.Set("mailNotify", "true")
 	}
 
-	resp, err := c.PatchForm(ctx, fmt.Sprintf("/wikis/%d", wikiID), data)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
 	var wiki Wiki
-	if err := DecodeResponse(resp, &wiki); err != nil {
+	if err := c.DoJSON(ctx, http.MethodPatch, fmt.Sprintf("/wikis/%d", wikiID), nil, data, &wiki); err != nil {
 		return nil, err
 	}
 
@@ -162,14 +155,8 @@ func (c *Client) UpdateWiki(ctx context.Context, wikiID int, input *UpdateWikiIn
 
 // DeleteWiki はWikiページを削除する
 func (c *Client) DeleteWiki(ctx context.Context, wikiID int) (*Wiki, error) {
-	resp, err := c.Delete(ctx, fmt.Sprintf("/wikis/%d", wikiID))
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
 	var wiki Wiki
-	if err := DecodeResponse(resp, &wiki); err != nil {
+	if err := c.DoJSON(ctx, http.MethodDelete, fmt.Sprintf("/wikis/%d", wikiID), nil, nil, &wiki); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultWikiFetchConcurrency は Wiki 本文を並列に取得する際の既定の同時リクエスト数
+const DefaultWikiFetchConcurrency = 4
+
+// WikiContentOptions は Wiki 本文の並列取得のオプション
+type WikiContentOptions struct {
+	// Concurrency は同時リクエスト数（0 以下は DefaultWikiFetchConcurrency）
+	Concurrency int
+	// Need は本文が必要なページを選ぶ（nil なら全ページ）
+	// false を返したページは取得せず、一覧 API の情報（Content は空）のまま返す
+	Need func(Wiki) bool
+	// OnFetched は1件取得するごとに呼ばれる（進捗表示用。同時には呼ばれない）
+	OnFetched func(done, total int)
+}
+
+// GetWikisWithContent はプロジェクトの Wiki ページを本文付きで取得する
+// 一覧 API には本文が含まれないため、一覧を取得した後に各ページを並列に取得する。
+func (c *Client) GetWikisWithContent(ctx context.Context, projectIDOrKey string, opts WikiContentOptions) ([]Wiki, error) {
+	wikis, err := c.GetWikis(ctx, projectIDOrKey, "")
+	if err != nil {
+		return nil, err
+	}
+	return c.FetchWikiContents(ctx, wikis, opts)
+}
+
+// FetchWikiContents は一覧 API で取得した Wiki ページの詳細（本文・添付ファイルなど）を並列に取得する
+// 結果は wikis と同じ順序で返す。1件でも失敗した場合は残りの取得を中止してエラーを返す。
+func (c *Client) FetchWikiContents(ctx context.Context, wikis []Wiki, opts WikiContentOptions) ([]Wiki, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultWikiFetchConcurrency
+	}
+
+	result := make([]Wiki, len(wikis))
+	copy(result, wikis)
+
+	var targets []int
+	for i, w := range wikis {
+		if opts.Need == nil || opts.Need(w) {
+			targets = append(targets, i)
+		}
+	}
+
+	var mu sync.Mutex
+	done := 0
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for _, i := range targets {
+		if gctx.Err() != nil {
+			break
+		}
+		g.Go(func() error {
+			full, err := c.GetWiki(gctx, wikis[i].ID)
+			if err != nil {
+				return fmt.Errorf("failed to get wiki %d: %w", wikis[i].ID, err)
+			}
+			result[i] = *full
+			if opts.OnFetched != nil {
+				mu.Lock()
+				done++
+				opts.OnFetched(done, len(targets))
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	// 中断された場合は取得できなかったページが残るため結果を返さない
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+	return result, nil
+}
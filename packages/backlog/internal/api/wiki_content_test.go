@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newWikiContentTestClient(t *testing.T, failID int) (*Client, *atomic.Int32) {
+	t.Helper()
+	var detailCalls atomic.Int32
+	client := NewClient("example.backlog.jp", "", WithAPIKey("test"))
+	client.httpClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		status := http.StatusOK
+		switch path := req.URL.Path; {
+		case path == "/api/v2/wikis":
+			body = `[{"id":1,"name":"Home"},{"id":2,"name":"Guide"},{"id":3,"name":"FAQ"}]`
+		case strings.HasPrefix(path, "/api/v2/wikis/"):
+			detailCalls.Add(1)
+			var id int
+			_, _ = fmt.Sscanf(strings.TrimPrefix(path, "/api/v2/wikis/"), "%d", &id)
+			if id == failID {
+				status = http.StatusNotFound
+				body = `{"errors":[{"message":"No wiki","code":6}]}`
+				break
+			}
+			body = fmt.Sprintf(`{"id":%d,"name":"page%d","content":"content %d"}`, id, id, id)
+		default:
+			t.Fatalf("unexpected path %s", path)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+	return client, &detailCalls
+}
+
+func TestGetWikisWithContent(t *testing.T) {
+	client, calls := newWikiContentTestClient(t, 0)
+
+	var progress []int
+	wikis, err := client.GetWikisWithContent(context.Background(), "PROJ", WikiContentOptions{
+		Concurrency: 2,
+		OnFetched:   func(done, total int) { progress = append(progress, done) },
+	})
+	if err != nil {
+		t.Fatalf("GetWikisWithContent returned error: %v", err)
+	}
+	if len(wikis) != 3 || calls.Load() != 3 {
+		t.Fatalf("got %d wikis with %d detail calls, want 3 and 3", len(wikis), calls.Load())
+	}
+	for i, w := range wikis {
+		if w.ID != i+1 || w.Content != fmt.Sprintf("content %d", i+1) {
+			t.Errorf("wikis[%d] = %+v, want ID %d with content", i, w, i+1)
+		}
+	}
+	if len(progress) != 3 || progress[2] != 3 {
+		t.Errorf("progress = %v, want 3 calls ending with 3", progress)
+	}
+}
+
+func TestFetchWikiContentsNeed(t *testing.T) {
+	client, calls := newWikiContentTestClient(t, 0)
+
+	wikis := []Wiki{{ID: 1, Name: "Home"}, {ID: 2, Name: "Guide"}}
+	got, err := client.FetchWikiContents(context.Background(), wikis, WikiContentOptions{
+		Need: func(w Wiki) bool { return w.ID == 2 },
+	})
+	if err != nil {
+		t.Fatalf("FetchWikiContents returned error: %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("detail calls = %d, want 1", calls.Load())
+	}
+	if got[0].Name != "Home" || got[0].Content != "" {
+		t.Errorf("skipped page = %+v, want list info", got[0])
+	}
+	if got[1].Content != "content 2" {
+		t.Errorf("fetched page = %+v, want content", got[1])
+	}
+}
+
+func TestFetchWikiContentsError(t *testing.T) {
+	client, _ := newWikiContentTestClient(t, 2)
+
+	_, err := client.FetchWikiContents(context.Background(), []Wiki{{ID: 1}, {ID: 2}, {ID: 3}}, WikiContentOptions{})
+	if err == nil || !strings.Contains(err.Error(), "failed to get wiki 2") {
+		t.Fatalf("FetchWikiContents error = %v, want failure of wiki 2", err)
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+)
+
+// WriteRequest は Backlog に送信した書き込みリクエスト（GET/HEAD/OPTIONS 以外）の記録
+type WriteRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+var (
+	writesMu sync.Mutex
+	writes   []WriteRequest
+)
+
+// WriteRecorderTransport は書き込みリクエストをプロセス内に記録する RoundTripper。
+// 記録は監査ログに使う。クエリ文字列（apiKey を含む）は記録しない
+type WriteRecorderTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *WriteRecorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return base.RoundTrip(req)
+	}
+
+	resp, err := base.RoundTrip(req)
+	w := WriteRequest{Method: req.Method, Path: req.URL.Path}
+	if err != nil {
+		w.Error = err.Error()
+	} else {
+		w.Status = resp.StatusCode
+	}
+	writesMu.Lock()
+	writes = append(writes, w)
+	writesMu.Unlock()
+	return resp, err
+}
+
+// RecordedWrites はこのプロセスで送信した書き込みリクエストを送信順に返す
+func RecordedWrites() []WriteRequest {
+	writesMu.Lock()
+	defer writesMu.Unlock()
+	return append([]WriteRequest(nil), writes...)
+}
+
+// ResetRecordedWrites は書き込みリクエストの記録を消去する
+func ResetRecordedWrites() {
+	writesMu.Lock()
+	defer writesMu.Unlock()
+	writes = nil
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteRecorderTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ResetRecordedWrites()
+	t.Cleanup(ResetRecordedWrites)
+	transport := &WriteRecorderTransport{Base: http.DefaultTransport}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodHead, http.MethodDelete} {
+		req, err := http.NewRequest(method, server.URL+"/api/v2/issues?apiKey=secret", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	got := RecordedWrites()
+	want := []WriteRequest{
+		{Method: http.MethodPost, Path: "/api/v2/issues", Status: http.StatusOK},
+		{Method: http.MethodDelete, Path: "/api/v2/issues", Status: http.StatusNotFound},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RecordedWrites() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("RecordedWrites()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
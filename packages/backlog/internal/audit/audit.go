@@ -0,0 +1,72 @@
+// Package audit は書き込み系コマンドの実行履歴を監査ログとして
+// ローカルの JSON Lines ファイルに追記する。
+//
+// 1 行が 1 コマンドの実行に対応し、いつ・誰が・どのコマンドで・
+// どの書き込みリクエストを送ったかと、その結果を記録する。
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+// Entry は監査ログの 1 行
+type Entry struct {
+	Time time.Time `json:"time"`
+	// OSUser はコマンドを実行した OS のユーザー名
+	OSUser string `json:"osUser,omitempty"`
+	// User は認証情報に記録された Backlog のユーザー ID
+	User    string `json:"user,omitempty"`
+	Profile string `json:"profile,omitempty"`
+	Space   string `json:"space,omitempty"`
+	// Command はルートコマンド名を除いたコマンドパス（例: "issue close"）
+	Command string            `json:"command"`
+	Args    []string          `json:"args,omitempty"`
+	Flags   map[string]string `json:"flags,omitempty"`
+	// Requests はコマンドが送信した書き込みリクエスト
+	Requests []api.WriteRequest `json:"requests"`
+	// Result は success / error / denied（ポリシーで拒否）
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Append は監査ログファイルにエントリを 1 行追記する
+// ファイルとディレクトリは本人のみ読み書きできる権限で作成する
+func Append(path string, e Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit log entry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return f.Close()
+}
+
+// sensitiveFlagWords はフラグ名に含まれていれば値を伏せる語
+var sensitiveFlagWords = []string{"token", "key", "secret", "password"}
+
+// RedactFlag は認証情報を含み得るフラグの値を伏せて返す
+func RedactFlag(name, value string) string {
+	lower := strings.ToLower(name)
+	for _, w := range sensitiveFlagWords {
+		if strings.Contains(lower, w) {
+			return "[REDACTED]"
+		}
+	}
+	return value
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "audit.jsonl")
+	entries := []Entry{
+		{
+			Time:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			User:     "alice",
+			Command:  "issue close",
+			Args:     []string{"PROJ-1"},
+			Requests: []api.WriteRequest{{Method: "PATCH", Path: "/api/v2/issues/PROJ-1", Status: 200}},
+			Result:   "success",
+		},
+		{
+			Command:  "issue delete",
+			Requests: []api.WriteRequest{{Method: "DELETE", Path: "/api/v2/issues/PROJ-2", Status: 403}},
+			Result:   "error",
+			Error:    "permission denied",
+		},
+	}
+	for _, e := range entries {
+		if err := Append(path, e); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("file mode = %o, want 600", perm)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+	var got []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("line %q is not JSON: %v", scanner.Text(), err)
+		}
+		got = append(got, e)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d lines, want 2", len(got))
+	}
+	if got[0].Command != "issue close" || got[0].Requests[0].Method != "PATCH" || !got[0].Time.Equal(entries[0].Time) {
+		t.Errorf("first entry = %+v", got[0])
+	}
+	if got[1].Result != "error" || got[1].Error != "permission denied" {
+		t.Errorf("second entry = %+v", got[1])
+	}
+}
+
+func TestRedactFlag(t *testing.T) {
+	tests := []struct {
+		name, value, want string
+	}{
+		{"api-key", "abc", "[REDACTED]"},
+		{"service-token", "abc", "[REDACTED]"},
+		{"client-secret", "abc", "[REDACTED]"},
+		{"body", "hello", "hello"},
+		{"status", "Closed", "Closed"},
+	}
+	for _, tt := range tests {
+		if got := RedactFlag(tt.name, tt.value); got != tt.want {
+			t.Errorf("RedactFlag(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
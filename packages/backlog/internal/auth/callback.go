@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +33,9 @@ type CallbackResult struct {
 // CallbackServerOptions はコールバックサーバーのオプション
 type CallbackServerOptions struct {
 	Port              int
+	ListenHost        string          // 待受アドレス（空の場合は 127.0.0.1）
+	PublicHost        string          // ブラウザから見たホスト（空の場合は localhost）
+	PublicURL         string          // ブラウザから見たベース URL（ポートフォワード時など。PublicHost より優先）
 	State             string          // CLI が生成した state
 	ConfigStore       *config.Store   // 設定の読み書き用
 	Reuse             bool            // true の場合、確認画面をスキップして即座にリダイレクト
@@ -53,6 +57,7 @@ type Session struct {
 // CallbackServer はCLIのローカルコールバックサーバー
 type CallbackServer struct {
 	port              int
+	endpoint          callbackEndpoint // ブラウザから見たコールバックサーバーの所在
 	server            *http.Server
 	result            chan CallbackResult
 	listener          net.Listener
@@ -98,8 +103,13 @@ func (cs *CallbackServer) sessionConfig() *config.ResolvedAuthSession {
 
 // NewCallbackServer は新しいコールバックサーバーを作成する
 func NewCallbackServer(opts CallbackServerOptions) (*CallbackServer, error) {
+	listenHost := opts.ListenHost
+	if listenHost == "" {
+		listenHost = DefaultCallbackListenHost
+	}
+
 	// ポートが0の場合は空きポートを探す
-	addr := fmt.Sprintf("127.0.0.1:%d", opts.Port)
+	addr := net.JoinHostPort(strings.Trim(listenHost, "[]"), strconv.Itoa(opts.Port))
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
@@ -108,6 +118,15 @@ func NewCallbackServer(opts CallbackServerOptions) (*CallbackServer, error) {
 	// 実際のポートを取得
 	actualPort := listener.Addr().(*net.TCPAddr).Port
 
+	endpoint, err := resolveCallbackEndpoint(opts.PublicURL, opts.PublicHost, actualPort)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	if !isLoopbackHost(listenHost) {
+		fmt.Fprintf(os.Stderr, "Warning: callback server listens on %s and is reachable from other hosts.\n", addr)
+	}
+
 	// Contextが指定されていない場合はBackgroundを使用
 	ctx := opts.Ctx
 	if ctx == nil {
@@ -116,6 +135,7 @@ func NewCallbackServer(opts CallbackServerOptions) (*CallbackServer, error) {
 
 	cs := &CallbackServer{
 		port:              actualPort,
+		endpoint:          endpoint,
 		result:            make(chan CallbackResult, 1),
 		listener:          listener,
 		state:             opts.State,
@@ -130,6 +150,7 @@ func NewCallbackServer(opts CallbackServerOptions) (*CallbackServer, error) {
 	debug.Log("callback server created",
 		"port", actualPort,
 		"address", addr,
+		"public_url", endpoint.BaseURL(),
 		"pid", os.Getpid(),
 		"state", stateFingerprint(opts.State),
 	)
@@ -273,6 +294,11 @@ func (cs *CallbackServer) Port() int {
 	return cs.port
 }
 
+// BaseURL はブラウザからコールバックサーバーにアクセスするためのベース URL を返す
+func (cs *CallbackServer) BaseURL() string {
+	return cs.endpoint.BaseURL()
+}
+
 // Start はサーバーを起動する
 func (cs *CallbackServer) Start() error {
 	debug.Log("callback server starting", "port", cs.port)
@@ -527,17 +553,20 @@ func (cs *CallbackServer) redirectToRelay(w http.ResponseWriter, r *http.Request
 	redirectURL := fmt.Sprintf(
 		"%s/auth/start?port=%d&state=%s&space=%s",
 		strings.TrimRight(relayServer, "/"),
-		cs.port,
+		cs.endpoint.Port,
 		url.QueryEscape(cs.state),
 		url.QueryEscape(spaceHost),
 	)
+	if cs.endpoint.Host != defaultCallbackPublicHost {
+		redirectURL += "&host=" + url.QueryEscape(cs.endpoint.relayHost())
+	}
 	if project != "" {
 		redirectURL += "&project=" + url.QueryEscape(project)
 	}
 
 	debug.Log("redirecting to relay server",
 		"url", redirectURL,
-		"port", cs.port,
+		"port", cs.endpoint.Port,
 		"state", stateFingerprint(cs.state),
 	)
 	http.Redirect(w, r, redirectURL, http.StatusFound)
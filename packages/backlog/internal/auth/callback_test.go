@@ -1,11 +1,14 @@
 package auth
 
 import (
+	"context"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/testutil"
 )
 
 func TestGenerateState(t *testing.T) {
@@ -467,3 +470,69 @@ func TestMultipleStreamsConcurrentDisconnect(t *testing.T) {
 	}
 	cs.streamMu.Unlock()
 }
+
+func TestCallbackServerWithFakeRelay(t *testing.T) {
+	config.ResetConfig()
+	defer config.ResetConfig()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	relay := testutil.NewFakeRelay(t)
+
+	cfg, err := config.Load(t.Context())
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	profile := cfg.GetActiveProfile()
+	if err := cfg.SetProfileValue(config.LayerUser, profile, "space", "demo.backlog.jp"); err != nil {
+		t.Fatalf("SetProfileValue(space) error = %v", err)
+	}
+	if err := cfg.SetProfileValue(config.LayerUser, profile, "relay_server", relay.URL); err != nil {
+		t.Fatalf("SetProfileValue(relay_server) error = %v", err)
+	}
+
+	state, err := GenerateState()
+	if err != nil {
+		t.Fatalf("GenerateState() error = %v", err)
+	}
+	cs, err := NewCallbackServer(CallbackServerOptions{State: state, ConfigStore: cfg, Ctx: t.Context()})
+	if err != nil {
+		t.Fatalf("NewCallbackServer() error = %v", err)
+	}
+	go func() { _ = cs.Start() }()
+	defer func() { _ = cs.Shutdown(context.Background()) }()
+
+	// ブラウザの代わりにポップアップを開き、中継サーバー経由でコールバックまでリダイレクトを辿る
+	resp, err := http.Get(cs.BaseURL() + "/auth/popup")
+	if err != nil {
+		t.Fatalf("GET /auth/popup error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	var result CallbackResult
+	select {
+	case result = <-cs.result:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for callback")
+	}
+	if result.Error != nil {
+		t.Fatalf("callback error = %v", result.Error)
+	}
+
+	starts := relay.AuthStarts()
+	if len(starts) != 1 || starts[0].State != state || starts[0].Port != cs.Port() {
+		t.Fatalf("AuthStarts() = %+v", starts)
+	}
+
+	tok, err := NewClient(relay.URL).ExchangeToken(TokenRequest{
+		GrantType: "authorization_code",
+		Code:      result.Code,
+		Space:     "demo.backlog.jp",
+		State:     state,
+	})
+	if err != nil {
+		t.Fatalf("ExchangeToken() error = %v", err)
+	}
+	if tok.AccessToken == "" {
+		t.Error("ExchangeToken() returned empty access token")
+	}
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultCallbackListenHost はコールバックサーバーのデフォルト待受アドレス
+const DefaultCallbackListenHost = "127.0.0.1"
+
+// defaultCallbackPublicHost はブラウザから見たコールバックサーバーのデフォルトホスト
+const defaultCallbackPublicHost = "localhost"
+
+// callbackEndpoint はブラウザから見たコールバックサーバーの所在
+// WSL2 や devcontainer では待受アドレス・ポートと異なる場合がある
+type callbackEndpoint struct {
+	Host string
+	Port int
+}
+
+// resolveCallbackEndpoint はブラウザから見たコールバックサーバーのホストとポートを決定する
+// publicURL（ポートフォワード先の URL）> host > localhost の順に優先し、
+// ポートが指定されていなければ待受ポートを使う。
+func resolveCallbackEndpoint(publicURL, host string, listenPort int) (callbackEndpoint, error) {
+	ep := callbackEndpoint{Host: defaultCallbackPublicHost, Port: listenPort}
+
+	if publicURL != "" {
+		u, err := url.Parse(publicURL)
+		if err != nil || u.Host == "" {
+			return ep, fmt.Errorf("invalid callback URL %q", publicURL)
+		}
+		if u.Scheme != "http" {
+			return ep, fmt.Errorf("callback URL must use http: %q", publicURL)
+		}
+		if u.Path != "" && u.Path != "/" {
+			return ep, fmt.Errorf("callback URL must not contain a path: %q", publicURL)
+		}
+		ep.Host = u.Hostname()
+		if p := u.Port(); p != "" {
+			port, err := strconv.Atoi(p)
+			if err != nil {
+				return ep, fmt.Errorf("invalid callback URL port %q", p)
+			}
+			ep.Port = port
+		}
+	} else if host != "" {
+		if strings.ContainsAny(host, "/:@?#") && !strings.HasPrefix(host, "[") {
+			return ep, fmt.Errorf("invalid callback host %q (specify a host name only)", host)
+		}
+		ep.Host = strings.Trim(host, "[]")
+	}
+
+	if ep.Port < 1024 || ep.Port > 65535 {
+		return ep, fmt.Errorf("callback port must be between 1024 and 65535 (got %d)", ep.Port)
+	}
+	return ep, nil
+}
+
+// BaseURL はブラウザからアクセスするためのベース URL を返す
+func (ep callbackEndpoint) BaseURL() string {
+	return "http://" + net.JoinHostPort(ep.Host, strconv.Itoa(ep.Port))
+}
+
+// relayHost は中継サーバーに渡すホスト表記を返す（IPv6 は角括弧付き）
+func (ep callbackEndpoint) relayHost() string {
+	if strings.Contains(ep.Host, ":") {
+		return "[" + ep.Host + "]"
+	}
+	return ep.Host
+}
+
+// isLoopbackHost は待受アドレスがループバックかどうかを返す
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
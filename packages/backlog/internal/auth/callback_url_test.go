@@ -0,0 +1,61 @@
+package auth
+
+import "testing"
+
+func TestResolveCallbackEndpoint(t *testing.T) {
+	tests := []struct {
+		name          string
+		publicURL     string
+		host          string
+		port          int
+		wantBaseURL   string
+		wantRelayHost string
+		wantErr       bool
+	}{
+		{name: "default", port: 52000, wantBaseURL: "http://localhost:52000", wantRelayHost: "localhost"},
+		{name: "host", host: "host.docker.internal", port: 52000, wantBaseURL: "http://host.docker.internal:52000", wantRelayHost: "host.docker.internal"},
+		{name: "ipv6 host", host: "[::1]", port: 52000, wantBaseURL: "http://[::1]:52000", wantRelayHost: "[::1]"},
+		{name: "forwarded url", publicURL: "http://localhost:18080", port: 52000, wantBaseURL: "http://localhost:18080", wantRelayHost: "localhost"},
+		{name: "url without port", publicURL: "http://host.docker.internal/", port: 52000, wantBaseURL: "http://host.docker.internal:52000", wantRelayHost: "host.docker.internal"},
+		{name: "url wins over host", publicURL: "http://localhost:18080", host: "example", port: 52000, wantBaseURL: "http://localhost:18080", wantRelayHost: "localhost"},
+		{name: "https url", publicURL: "https://localhost:18080", port: 52000, wantErr: true},
+		{name: "url with path", publicURL: "http://localhost:18080/cb", port: 52000, wantErr: true},
+		{name: "host with port", host: "localhost:8080", port: 52000, wantErr: true},
+		{name: "privileged port", publicURL: "http://localhost:80", port: 52000, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ep, err := resolveCallbackEndpoint(tt.publicURL, tt.host, tt.port)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", ep)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := ep.BaseURL(); got != tt.wantBaseURL {
+				t.Errorf("BaseURL() = %q, want %q", got, tt.wantBaseURL)
+			}
+			if got := ep.relayHost(); got != tt.wantRelayHost {
+				t.Errorf("relayHost() = %q, want %q", got, tt.wantRelayHost)
+			}
+		})
+	}
+}
+
+func TestIsLoopbackHost(t *testing.T) {
+	for host, want := range map[string]bool{
+		"127.0.0.1": true,
+		"localhost": true,
+		"::1":       true,
+		"[::1]":     true,
+		"0.0.0.0":   false,
+		"10.0.0.5":  false,
+	} {
+		if got := isLoopbackHost(host); got != want {
+			t.Errorf("isLoopbackHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
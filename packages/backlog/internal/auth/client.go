@@ -2,30 +2,64 @@ package auth
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/relaysig"
 )
 
 // Client は認証クライアント
 type Client struct {
 	relayServer string
 	httpClient  *http.Client
+	signer      *relaysig.Signer
+}
+
+// ClientOption は認証クライアントのオプション
+type ClientOption func(*Client)
+
+// WithRelaySigner はトークンリクエストへの署名を有効にする
+// nil を渡した場合は署名しない。
+func WithRelaySigner(signer *relaysig.Signer) ClientOption {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// WithTLSConfig は中継サーバーへの接続に使う TLS 設定（クライアント証明書）を指定する
+// nil を渡した場合は既定の設定を使う。
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	return func(c *Client) {
+		if tlsConfig == nil {
+			return
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		c.httpClient.Transport = transport
+	}
 }
 
 // NewClient は新しい認証クライアントを作成する
-func NewClient(relayServer string) *Client {
-	return &Client{
+func NewClient(relayServer string, opts ...ClientOption) *Client {
+	c := &Client{
 		// 末尾スラッシュを除去してパス連結時のダブルスラッシュを防止
 		relayServer: strings.TrimRight(relayServer, "/"),
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // WellKnownResponse は well-known のレスポンス
@@ -60,8 +94,9 @@ type TokenRequest struct {
 	GrantType    string `json:"grant_type"`
 	Code         string `json:"code,omitempty"`
 	RefreshToken string `json:"refresh_token,omitempty"`
-	Space        string `json:"space"`           // spaceHost 形式 (例: "myspace.backlog.jp")
-	State        string `json:"state,omitempty"` // セッション追跡用（StartAuthで取得した値）
+	Space        string `json:"space"`            // spaceHost 形式 (例: "myspace.backlog.jp")
+	State        string `json:"state,omitempty"`  // セッション追跡用（StartAuthで取得した値）
+	Device       string `json:"device,omitempty"` // 端末名（中継サーバーのセッション一覧に表示される）
 }
 
 // TokenResponse はトークンレスポンス
@@ -70,6 +105,7 @@ type TokenResponse struct {
 	TokenType    string `json:"token_type"`
 	ExpiresIn    int    `json:"expires_in"`
 	RefreshToken string `json:"refresh_token"`
+	SessionID    string `json:"session_id,omitempty"` // 中継サーバーがセッションを記録している場合のみ
 }
 
 // ExchangeToken は認可コードをトークンに交換する
@@ -106,11 +142,17 @@ func (c *Client) requestToken(req TokenRequest) (*TokenResponse, error) {
 	tokenURL := c.relayServer + "/auth/token"
 	debug.Log("sending token request", "url", tokenURL, "grant_type", req.GrantType)
 
-	resp, err := c.httpClient.Post(
-		tokenURL,
-		"application/json",
-		bytes.NewReader(body),
-	)
+	httpReq, err := http.NewRequest(http.MethodPost, tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.signer != nil {
+		c.signer.Sign(httpReq, body)
+		debug.Log("token request signed", "key_id", c.signer.KeyID())
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
 		debug.Log("token request failed", "error", err)
 		return nil, fmt.Errorf("token request failed: %w", err)
@@ -137,3 +179,95 @@ func (c *Client) requestToken(req TokenRequest) (*TokenResponse, error) {
 	debug.Log("token received", "token_type", result.TokenType, "expires_in", result.ExpiresIn)
 	return &result, nil
 }
+
+// LoginSession は中継サーバーが記録している CLI のログインセッション（端末ごと）
+type LoginSession struct {
+	ID         string `json:"id"`
+	Space      string `json:"space"`
+	UserName   string `json:"userName"`
+	UserEmail  string `json:"userEmail,omitempty"`
+	Device     string `json:"device,omitempty"`
+	CreatedAt  string `json:"createdAt"`
+	LastUsedAt string `json:"lastUsedAt"`
+	LastIP     string `json:"lastIp,omitempty"`
+	UserAgent  string `json:"userAgent,omitempty"`
+	RevokedAt  string `json:"revokedAt,omitempty"`
+}
+
+// ErrSessionsUnsupported は中継サーバーがセッションを記録していない場合のエラー
+var ErrSessionsUnsupported = errors.New("the relay server does not track login sessions")
+
+// ListSessions はアクセストークンの所有者のログインセッション一覧を取得する
+// spaceHost は "myspace.backlog.jp" 形式
+func (c *Client) ListSessions(spaceHost, accessToken string) ([]LoginSession, error) {
+	var result struct {
+		Sessions []LoginSession `json:"sessions"`
+	}
+	if err := c.sessionRequest(http.MethodGet, "/auth/sessions", spaceHost, accessToken, &result); err != nil {
+		return nil, err
+	}
+	return result.Sessions, nil
+}
+
+// RevokeSession はログインセッションを失効させる
+// 失効した端末は次回のトークン更新に失敗し、再ログインが必要になる
+func (c *Client) RevokeSession(spaceHost, accessToken, id string) (*LoginSession, error) {
+	var result LoginSession
+	if err := c.sessionRequest(http.MethodPost, "/auth/sessions/"+url.PathEscape(id)+"/revoke", spaceHost, accessToken, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) sessionRequest(method, path, spaceHost, accessToken string, out any) error {
+	reqURL := c.relayServer + path + "?" + url.Values{"space": {spaceHost}}.Encode()
+	debug.Log("sending session request", "method", method, "url", reqURL)
+
+	httpReq, err := http.NewRequest(method, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create session request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("session request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		if strings.HasSuffix(path, "/revoke") {
+			return fmt.Errorf("session not found")
+		}
+		return ErrSessionsUnsupported
+	default:
+		var errResp struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		if errResp.Error == "" {
+			return fmt.Errorf("session request returned status %d", resp.StatusCode)
+		}
+		if errResp.Description != "" {
+			return fmt.Errorf("%s: %s", errResp.Error, errResp.Description)
+		}
+		return fmt.Errorf("%s", errResp.Error)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to parse session response: %w", err)
+	}
+	return nil
+}
+
+// DeviceName はセッション一覧に表示する端末名（ホスト名）を返す
+func DeviceName() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
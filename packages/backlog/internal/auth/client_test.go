@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientSessions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if got := r.URL.Query().Get("space"); got != "myspace.backlog.jp" {
+			t.Errorf("space = %q", got)
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/sessions":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"sessions": []map[string]any{{"id": "s1", "device": "laptop", "lastUsedAt": "2026-10-16T00:00:00.000Z"}},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/auth/sessions/s1/revoke":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "s1", "revokedAt": "2026-10-16T01:00:00.000Z"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not_found"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL + "/")
+	sessions, err := client.ListSessions("myspace.backlog.jp", "token")
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != "s1" || sessions[0].Device != "laptop" {
+		t.Errorf("ListSessions() = %+v", sessions)
+	}
+
+	revoked, err := client.RevokeSession("myspace.backlog.jp", "token", "s1")
+	if err != nil {
+		t.Fatalf("RevokeSession() error = %v", err)
+	}
+	if revoked.RevokedAt == "" {
+		t.Errorf("RevokeSession() = %+v, want revokedAt", revoked)
+	}
+
+	if _, err := client.RevokeSession("myspace.backlog.jp", "token", "other"); err == nil || errors.Is(err, ErrSessionsUnsupported) {
+		t.Errorf("RevokeSession(unknown) error = %v, want session not found", err)
+	}
+}
+
+func TestClientSessionsUnsupported(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).ListSessions("myspace.backlog.jp", "token")
+	if !errors.Is(err, ErrSessionsUnsupported) {
+		t.Errorf("ListSessions() error = %v, want ErrSessionsUnsupported", err)
+	}
+}
@@ -47,8 +47,7 @@ func (cs *CallbackServer) GetConfig(
 	resp.Msg.Configured = profile.Space != "" && strings.Contains(profile.Space, ".") && relayURL != ""
 
 	// 現在の認証タイプを取得
-	resolved := cs.configStore.Resolved()
-	cred := resolved.GetActiveCredential()
+	cred := cs.configStore.CurrentCredential()
 	if cred != nil {
 		switch cred.GetAuthType() {
 		case config.AuthTypeOAuth:
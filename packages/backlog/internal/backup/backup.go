@@ -0,0 +1,369 @@
+// Package backup はプロジェクト単位のバックアップをディレクトリに構造化して保存する。
+//
+// レイアウト:
+//
+//	manifest.json              バックアップの概要（形式バージョン・対象・完了状態）
+//	project.json               プロジェクト設定
+//	settings.json              種別・カテゴリー・マイルストーン・ステータス・カスタム属性・メンバー
+//	issues/<KEY>.json          課題本体とコメント
+//	issues/<KEY>/<ID>-<name>   課題の添付ファイル
+//	wikis/<ID>.json            Wiki ページ（本文を含む）
+//	wikis/<ID>/<ID>-<name>     Wiki の添付ファイル
+//	restore-state.json         リストアの進捗（再開用）
+//
+// 各ファイルは一時ファイルに書いてから置き換えるため、途中で中断しても
+// 書きかけのファイルは残らず、同じディレクトリに対して再実行すれば続きから再開できる。
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+// FormatVersion はバックアップ形式のバージョン
+const FormatVersion = 1
+
+const (
+	manifestFile     = "manifest.json"
+	projectFile      = "project.json"
+	settingsFile     = "settings.json"
+	restoreStateFile = "restore-state.json"
+	issuesDir        = "issues"
+	wikisDir         = "wikis"
+)
+
+// Manifest はバックアップの概要
+type Manifest struct {
+	Format     int       `json:"format"`
+	Space      string    `json:"space"`
+	ProjectID  int       `json:"projectId"`
+	ProjectKey string    `json:"projectKey"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	// Complete は最後のバックアップが最後まで完了したかどうか
+	Complete    bool `json:"complete"`
+	Issues      int  `json:"issues"`
+	Wikis       int  `json:"wikis"`
+	Attachments int  `json:"attachments"`
+}
+
+// Settings はプロジェクトの設定一式
+type Settings struct {
+	IssueTypes   []api.IssueType       `json:"issueTypes"`
+	Categories   []api.Category        `json:"categories"`
+	Versions     []api.Version         `json:"versions"`
+	Statuses     []api.Status          `json:"statuses"`
+	CustomFields []backlog.CustomField `json:"customFields"`
+	Members      []api.User            `json:"members"`
+}
+
+// IssueRecord は課題 1 件分の保存内容
+type IssueRecord struct {
+	Issue    *backlog.Issue `json:"issue"`
+	Comments []api.Comment  `json:"comments"`
+}
+
+// WikiRecord は Wiki ページ 1 件分の保存内容
+type WikiRecord struct {
+	Wiki *api.Wiki `json:"wiki"`
+}
+
+// RestoreState はリストアの進捗。バックアップ元のキーと作成先の対応を記録する
+type RestoreState struct {
+	// Target はリストア先（"space/PROJECT"）
+	Target string `json:"target"`
+	// Issues はバックアップ元の課題キーから作成した課題への対応
+	Issues map[string]RestoredIssue `json:"issues"`
+	// Wikis はバックアップ元の Wiki ID から作成した Wiki ID への対応
+	Wikis map[string]int `json:"wikis"`
+}
+
+// RestoredIssue はリストアで作成した課題
+type RestoredIssue struct {
+	ID  int    `json:"id"`
+	Key string `json:"key"`
+	// Comments は投稿済みのコメント数（中断後はこの続きから投稿する）
+	Comments int `json:"comments"`
+	// Complete はコメント・ステータスまで反映済みかどうか
+	Complete bool `json:"complete"`
+}
+
+// Dir はバックアップディレクトリ
+type Dir struct {
+	Path string
+}
+
+// Open はバックアップディレクトリを返す（存在は確認しない）
+func Open(path string) *Dir {
+	return &Dir{Path: path}
+}
+
+// IsEmpty はディレクトリが存在しないか空であるかを返す
+func (d *Dir) IsEmpty() (bool, error) {
+	entries, err := os.ReadDir(d.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return len(entries) == 0, nil
+}
+
+// ReadManifest は manifest.json を読み込む
+func (d *Dir) ReadManifest() (*Manifest, error) {
+	var m Manifest
+	if err := d.readJSON(manifestFile, &m); err != nil {
+		return nil, err
+	}
+	if m.Format != FormatVersion {
+		return nil, fmt.Errorf("unsupported backup format %d in %s", m.Format, d.Path)
+	}
+	return &m, nil
+}
+
+// WriteManifest は manifest.json を書き込む
+func (d *Dir) WriteManifest(m *Manifest) error {
+	return d.writeJSON(manifestFile, m)
+}
+
+// ReadProject は project.json を読み込む
+func (d *Dir) ReadProject() (*api.Project, error) {
+	var p api.Project
+	if err := d.readJSON(projectFile, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// WriteProject は project.json を書き込む
+func (d *Dir) WriteProject(p *api.Project) error {
+	return d.writeJSON(projectFile, p)
+}
+
+// ReadSettings は settings.json を読み込む
+func (d *Dir) ReadSettings() (*Settings, error) {
+	var s Settings
+	if err := d.readJSON(settingsFile, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// WriteSettings は settings.json を書き込む
+func (d *Dir) WriteSettings(s *Settings) error {
+	return d.writeJSON(settingsFile, s)
+}
+
+// ReadIssue は課題を読み込む。保存されていない場合は nil を返す
+func (d *Dir) ReadIssue(key string) (*IssueRecord, error) {
+	var r IssueRecord
+	err := d.readJSON(filepath.Join(issuesDir, key+".json"), &r)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// WriteIssue は課題を書き込む
+func (d *Dir) WriteIssue(r *IssueRecord) error {
+	return d.writeJSON(filepath.Join(issuesDir, r.Issue.IssueKey.Value+".json"), r)
+}
+
+// Issues は保存済みの課題をキー番号の昇順で返す
+func (d *Dir) Issues() ([]*IssueRecord, error) {
+	names, err := d.jsonNames(issuesDir)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*IssueRecord, 0, len(names))
+	for _, name := range names {
+		r, err := d.ReadIssue(name)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil && r.Issue != nil {
+			records = append(records, r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Issue.KeyId.Value < records[j].Issue.KeyId.Value
+	})
+	return records, nil
+}
+
+// ReadWiki は Wiki を読み込む。保存されていない場合は nil を返す
+func (d *Dir) ReadWiki(id int) (*WikiRecord, error) {
+	var r WikiRecord
+	err := d.readJSON(filepath.Join(wikisDir, strconv.Itoa(id)+".json"), &r)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// WriteWiki は Wiki を書き込む
+func (d *Dir) WriteWiki(r *WikiRecord) error {
+	return d.writeJSON(filepath.Join(wikisDir, strconv.Itoa(r.Wiki.ID)+".json"), r)
+}
+
+// Wikis は保存済みの Wiki を ID の昇順で返す
+func (d *Dir) Wikis() ([]*WikiRecord, error) {
+	names, err := d.jsonNames(wikisDir)
+	if err != nil {
+		return nil, err
+	}
+	var records []*WikiRecord
+	for _, name := range names {
+		id, err := strconv.Atoi(name)
+		if err != nil {
+			continue
+		}
+		r, err := d.ReadWiki(id)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil && r.Wiki != nil {
+			records = append(records, r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Wiki.ID < records[j].Wiki.ID })
+	return records, nil
+}
+
+// ReadRestoreState はリストアの進捗を読み込む。未保存の場合は空の状態を返し、
+// 別のリストア先の進捗が残っている場合はエラーにする
+func (d *Dir) ReadRestoreState(target string) (*RestoreState, error) {
+	state := &RestoreState{Target: target}
+	err := d.readJSON(restoreStateFile, state)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if state.Target != target {
+		return nil, fmt.Errorf("%s records a restore to %s; remove it to restore to %s", restoreStateFile, state.Target, target)
+	}
+	if state.Issues == nil {
+		state.Issues = make(map[string]RestoredIssue)
+	}
+	if state.Wikis == nil {
+		state.Wikis = make(map[string]int)
+	}
+	return state, nil
+}
+
+// WriteRestoreState はリストアの進捗を書き込む
+func (d *Dir) WriteRestoreState(s *RestoreState) error {
+	return d.writeJSON(restoreStateFile, s)
+}
+
+// IssueAttachmentPath は課題の添付ファイルの保存先
+func (d *Dir) IssueAttachmentPath(key string, attachmentID int, name string) string {
+	return filepath.Join(d.Path, issuesDir, key, attachmentFileName(attachmentID, name))
+}
+
+// WikiAttachmentPath は Wiki の添付ファイルの保存先
+func (d *Dir) WikiAttachmentPath(wikiID, attachmentID int, name string) string {
+	return filepath.Join(d.Path, wikisDir, strconv.Itoa(wikiID), attachmentFileName(attachmentID, name))
+}
+
+// attachmentFileName は添付ファイルの保存名。同名ファイルが衝突しないよう ID を前置する
+func attachmentFileName(id int, name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		if r < 0x20 {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == ".." {
+		name = "file"
+	}
+	return fmt.Sprintf("%d-%s", id, name)
+}
+
+// HasFile は path に size バイトのファイルが保存済みかどうかを返す
+func HasFile(path string, size int64) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular() && info.Size() == size
+}
+
+// SaveStream は download が書き出す内容をストリーミングで path に保存する。
+// 一時ファイルに書いてから置き換えるため、中断しても不完全なファイルは残らない
+func SaveStream(path string, download func(w io.Writer) error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if err := download(tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (d *Dir) readJSON(name string, v any) error {
+	data, err := os.ReadFile(filepath.Join(d.Path, name))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return nil
+}
+
+func (d *Dir) writeJSON(name string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	return SaveStream(filepath.Join(d.Path, name), func(w io.Writer) error {
+		_, err := w.Write(append(data, '\n'))
+		return err
+	})
+}
+
+// jsonNames は dir 直下の JSON ファイル名（拡張子なし）を返す
+func (d *Dir) jsonNames(dir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(d.Path, dir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.Type().IsRegular() && strings.HasSuffix(e.Name(), ".json") {
+			names = append(names, strings.TrimSuffix(e.Name(), ".json"))
+		}
+	}
+	return names, nil
+}
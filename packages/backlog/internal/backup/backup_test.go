@@ -0,0 +1,117 @@
+package backup
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func testIssue(key string, keyID int) *backlog.Issue {
+	return &backlog.Issue{
+		ID:       backlog.NewOptInt(1000 + keyID),
+		IssueKey: backlog.NewOptString(key),
+		KeyId:    backlog.NewOptInt(keyID),
+		Summary:  backlog.NewOptString("summary of " + key),
+		Updated:  backlog.NewOptString("2026-01-02T03:04:05Z"),
+	}
+}
+
+func TestDirIssuesRoundTrip(t *testing.T) {
+	d := Open(filepath.Join(t.TempDir(), "backup"))
+	if empty, err := d.IsEmpty(); err != nil || !empty {
+		t.Fatalf("IsEmpty() = %v, %v; want true", empty, err)
+	}
+	for _, rec := range []*IssueRecord{
+		{Issue: testIssue("PROJ-10", 10)},
+		{Issue: testIssue("PROJ-2", 2), Comments: []api.Comment{{ID: 1, Content: "hello"}}},
+	} {
+		if err := d.WriteIssue(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := d.Issues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Issue.IssueKey.Value != "PROJ-2" || records[1].Issue.IssueKey.Value != "PROJ-10" {
+		t.Fatalf("Issues() not sorted by key number: %+v", records)
+	}
+	if records[0].Comments[0].Content != "hello" || records[0].Issue.Updated.Value != "2026-01-02T03:04:05Z" {
+		t.Errorf("record not restored: %+v", records[0])
+	}
+	if r, err := d.ReadIssue("PROJ-99"); err != nil || r != nil {
+		t.Errorf("ReadIssue(missing) = %v, %v; want nil, nil", r, err)
+	}
+}
+
+func TestDirManifestFormat(t *testing.T) {
+	d := Open(t.TempDir())
+	if err := d.WriteManifest(&Manifest{Format: FormatVersion + 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.ReadManifest(); err == nil {
+		t.Fatal("expected an error for an unsupported format")
+	}
+}
+
+func TestReadRestoreState(t *testing.T) {
+	d := Open(t.TempDir())
+	state, err := d.ReadRestoreState("space/NEW")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.Issues["PROJ-1"] = RestoredIssue{ID: 1, Key: "NEW-1", Complete: true}
+	if err := d.WriteRestoreState(state); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err = d.ReadRestoreState("space/NEW")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Issues["PROJ-1"].Key != "NEW-1" {
+		t.Errorf("state not restored: %+v", state)
+	}
+	if _, err := d.ReadRestoreState("space/OTHER"); err == nil {
+		t.Error("expected an error for another target")
+	}
+}
+
+func TestSaveStream(t *testing.T) {
+	d := Open(t.TempDir())
+	path := d.IssueAttachmentPath("PROJ-1", 5, "a/b.txt")
+	if filepath.Base(path) != "5-a_b.txt" {
+		t.Errorf("unsafe file name: %s", path)
+	}
+
+	err := SaveStream(path, func(w io.Writer) error {
+		_, _ = io.WriteString(w, "partial")
+		return io.ErrUnexpectedEOF
+	})
+	if err == nil {
+		t.Fatal("expected the download error")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("incomplete file must not be left: %v", err)
+	}
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 0 {
+		t.Fatalf("temporary file left: %v", entries)
+	}
+
+	if err := SaveStream(path, func(w io.Writer) error {
+		_, err := io.Copy(w, strings.NewReader("content"))
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !HasFile(path, 7) || HasFile(path, 8) {
+		t.Error("HasFile should match the saved size")
+	}
+}
@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMemoryEntries はインメモリキャッシュの既定の最大エントリ数
+const DefaultMemoryEntries = 256
+
+// MemoryCache はプロセス内で保持する LRU キャッシュ
+// 値は JSON で保持するため、呼び出し側が取得した値を書き換えてもキャッシュには影響しない
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key       string
+	data      []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache は最大 maxEntries 件を保持する MemoryCache を作成する
+// maxEntries が 0 以下の場合は DefaultMemoryEntries を使う
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMemoryEntries
+	}
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+var (
+	sharedMemoryOnce sync.Once
+	sharedMemory     *MemoryCache
+)
+
+// SharedMemoryCache はプロセス内で共有する MemoryCache を返す
+// 同じプロセスで複数のクライアントを作っても（補完や複数プロファイルの横断など）同じ領域を使う
+// キーにはドメインが含まれるため、スペースをまたいで値が混ざることはない
+func SharedMemoryCache(maxEntries int) *MemoryCache {
+	sharedMemoryOnce.Do(func() {
+		sharedMemory = NewMemoryCache(maxEntries)
+	})
+	return sharedMemory
+}
+
+// Get はキャッシュを取得する
+// 期限切れのエントリは削除して false を返す
+func (c *MemoryCache) Get(key string, v interface{}) (bool, error) {
+	c.mu.Lock()
+	el, ok := c.items[key]
+	if !ok {
+		c.mu.Unlock()
+		return false, nil
+	}
+	entry := el.Value.(*memoryEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.removeElement(el)
+		c.mu.Unlock()
+		return false, nil
+	}
+	c.ll.MoveToFront(el)
+	data := entry.data
+	c.mu.Unlock()
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("failed to unmarshal cache data: %w", err)
+	}
+	return true, nil
+}
+
+// Set はキャッシュを保存する。最大件数を超えた場合は最も古く使われたエントリを捨てる
+func (c *MemoryCache) Set(key string, v interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &memoryEntry{key: key, data: data, expiresAt: time.Now().Add(ttl)}
+	if el, ok := c.items[key]; ok {
+		el.Value = entry
+		c.ll.MoveToFront(el)
+		return nil
+	}
+	c.items[key] = c.ll.PushFront(entry)
+	for c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+	}
+	return nil
+}
+
+// Delete は指定キーのキャッシュを削除する
+func (c *MemoryCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+	return nil
+}
+
+// DeleteByPrefix は指定プレフィックスに一致するキャッシュを全て削除する
+func (c *MemoryCache) DeleteByPrefix(prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, el := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(el)
+		}
+	}
+	return nil
+}
+
+// Clear は全てのキャッシュを削除する
+func (c *MemoryCache) Clear() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+	return nil
+}
+
+// Cleanup は期限切れのエントリを削除する
+// ttl は FileCache との互換のための引数で、エントリ自身の有効期限で判定する
+func (c *MemoryCache) Cleanup(ctx context.Context, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for el := c.ll.Back(); el != nil; {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		prev := el.Prev()
+		if now.After(el.Value.(*memoryEntry).expiresAt) {
+			c.removeElement(el)
+		}
+		el = prev
+	}
+	return nil
+}
+
+// Len は保持しているエントリ数を返す
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *MemoryCache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*memoryEntry).key)
+}
+
+// TieredCache はインメモリ層と下位のキャッシュ（FileCache など）を重ねた二段構成のキャッシュ
+// 取得は memory → 下位の順に行い、下位でヒットした値はインメモリ層にも載せる
+// 書き込み・削除は両方の層に反映する
+type TieredCache struct {
+	memory   *MemoryCache
+	next     Cache
+	promoted time.Duration
+}
+
+// NewTieredCache は memory を next の前段に置いた TieredCache を作成する
+// promoteTTL は下位でヒットした値をインメモリ層に載せるときの有効期限
+// （下位の残り期限は取得できないため、設定上の TTL を使う）
+func NewTieredCache(memory *MemoryCache, next Cache, promoteTTL time.Duration) *TieredCache {
+	return &TieredCache{memory: memory, next: next, promoted: promoteTTL}
+}
+
+// Get はキャッシュを取得する
+func (c *TieredCache) Get(key string, v interface{}) (bool, error) {
+	if ok, err := c.memory.Get(key, v); err == nil && ok {
+		return true, nil
+	}
+	ok, err := c.next.Get(key, v)
+	if err != nil || !ok {
+		return ok, err
+	}
+	_ = c.memory.Set(key, v, c.promoted)
+	return true, nil
+}
+
+// Set はキャッシュを両方の層に保存する
+func (c *TieredCache) Set(key string, v interface{}, ttl time.Duration) error {
+	_ = c.memory.Set(key, v, ttl)
+	return c.next.Set(key, v, ttl)
+}
+
+// Delete は指定キーのキャッシュを両方の層から削除する
+func (c *TieredCache) Delete(key string) error {
+	_ = c.memory.Delete(key)
+	return c.next.Delete(key)
+}
+
+// DeleteByPrefix は指定プレフィックスに一致するキャッシュを両方の層から削除する
+func (c *TieredCache) DeleteByPrefix(prefix string) error {
+	_ = c.memory.DeleteByPrefix(prefix)
+	return c.next.DeleteByPrefix(prefix)
+}
+
+// Clear は両方の層のキャッシュを削除する
+func (c *TieredCache) Clear() error {
+	_ = c.memory.Clear()
+	return c.next.Clear()
+}
+
+// Cleanup は両方の層の期限切れキャッシュを削除する
+func (c *TieredCache) Cleanup(ctx context.Context, ttl time.Duration) error {
+	if err := c.memory.Cleanup(ctx, ttl); err != nil {
+		return err
+	}
+	return c.next.Cleanup(ctx, ttl)
+}
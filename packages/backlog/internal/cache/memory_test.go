@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache_LRU(t *testing.T) {
+	c := NewMemoryCache(2)
+
+	for _, k := range []string{"a", "b"} {
+		if err := c.Set(k, k, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// a を参照して b を最も古いエントリにする
+	var v string
+	if ok, _ := c.Get("a", &v); !ok || v != "a" {
+		t.Fatalf("expected cache hit for a, got %q", v)
+	}
+	if err := c.Set("c", "c", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, _ := c.Get("b", &v); ok {
+		t.Fatal("expected b to be evicted")
+	}
+	for _, k := range []string{"a", "c"} {
+		if ok, _ := c.Get(k, &v); !ok {
+			t.Fatalf("expected cache hit for %s", k)
+		}
+	}
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", c.Len())
+	}
+}
+
+func TestMemoryCache_Expire(t *testing.T) {
+	c := NewMemoryCache(10)
+	if err := c.Set("key1", "data", time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set("key2", "data", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	if err := c.Cleanup(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if c.Len() != 1 {
+		t.Fatalf("Len() = %d after Cleanup, want 1", c.Len())
+	}
+	var v string
+	if ok, _ := c.Get("key1", &v); ok {
+		t.Fatal("expected cache miss for expired key")
+	}
+}
+
+func TestMemoryCache_CopiesValue(t *testing.T) {
+	c := NewMemoryCache(10)
+	src := []string{"a", "b"}
+	if err := c.Set("key", src, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	src[0] = "changed"
+
+	var got []string
+	if ok, _ := c.Get("key", &got); !ok {
+		t.Fatal("expected cache hit")
+	}
+	got[1] = "changed"
+
+	var again []string
+	_, _ = c.Get("key", &again)
+	if again[0] != "a" || again[1] != "b" {
+		t.Fatalf("cached value was modified: %v", again)
+	}
+}
+
+func TestMemoryCache_DeleteByPrefix(t *testing.T) {
+	c := NewMemoryCache(10)
+	keys := []string{
+		"comments:backlog.jp:PROJ-1:order=asc",
+		"comments:backlog.jp:PROJ-1:order=desc",
+		"issue:backlog.jp:PROJ-1",
+	}
+	for _, k := range keys {
+		if err := c.Set(k, "data", time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.DeleteByPrefix("comments:backlog.jp:PROJ-1:"); err != nil {
+		t.Fatal(err)
+	}
+	var v string
+	for _, k := range keys[:2] {
+		if ok, _ := c.Get(k, &v); ok {
+			t.Fatalf("expected cache miss for %s after DeleteByPrefix", k)
+		}
+	}
+	if ok, _ := c.Get(keys[2], &v); !ok {
+		t.Fatalf("expected cache hit for %s", keys[2])
+	}
+}
+
+func TestTieredCache(t *testing.T) {
+	fc, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	memory := NewMemoryCache(10)
+	c := NewTieredCache(memory, fc, time.Minute)
+
+	// 下位層だけにある値は取得時にインメモリ層へ載る
+	if err := fc.Set("issue:backlog.jp:PROJ-1", "from-file", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	var v string
+	if ok, err := c.Get("issue:backlog.jp:PROJ-1", &v); err != nil || !ok || v != "from-file" {
+		t.Fatalf("Get() = %q, %v, %v", v, ok, err)
+	}
+	if ok, _ := memory.Get("issue:backlog.jp:PROJ-1", &v); !ok {
+		t.Fatal("expected value to be promoted to memory")
+	}
+
+	// 書き込みと削除は両方の層に反映される
+	if err := c.Set("issue:backlog.jp:PROJ-2", "both", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := fc.Get("issue:backlog.jp:PROJ-2", &v); !ok {
+		t.Fatal("expected Set to write through to file cache")
+	}
+	if err := c.DeleteByPrefix("issue:backlog.jp:"); err != nil {
+		t.Fatal(err)
+	}
+	for _, layer := range []Cache{memory, fc} {
+		if ok, _ := layer.Get("issue:backlog.jp:PROJ-2", &v); ok {
+			t.Fatal("expected DeleteByPrefix to remove from every layer")
+		}
+	}
+}
@@ -194,6 +194,24 @@ func fetchActivities(ctx context.Context, client *api.Client, p *fetchParams) ([
 	return result, nil
 }
 
+// FetchRange は [since, until] に作成されたユーザーのアクティビティを新しい順にすべて取得する。
+// activity フィードは Backlog 側で古い履歴がトリムされるため、古い期間は欠ける場合がある。
+func FetchRange(ctx context.Context, client *api.Client, userID int, typeIDs []int, since, until time.Time) ([]backlog.Activity, error) {
+	return fetchActivities(ctx, client, &fetchParams{
+		userID:   userID,
+		typeIDs:  typeIDs,
+		sinceT:   since,
+		untilT:   until,
+		hasSince: true,
+		hasUntil: true,
+	})
+}
+
+// ParseCreated は activity の created を time.Time にパースする。
+func ParseCreated(a backlog.Activity) (time.Time, bool) {
+	return parseActivityCreated(a)
+}
+
 // parseActivityCreated は activity の created を time.Time にパースする。
 func parseActivityCreated(a backlog.Activity) (time.Time, bool) {
 	if !a.Created.IsSet() || a.Created.Value == "" {
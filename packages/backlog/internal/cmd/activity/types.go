@@ -74,7 +74,7 @@ func ParseTypes(csv string) ([]int, error) {
 		if name == "" {
 			continue
 		}
-		id, ok := nameToID[name]
+		id, ok := lookupTypeName(name)
 		if !ok {
 			// 数値 ID も許容する
 			if n, err := strconv.Atoi(name); err == nil && n >= 1 && n <= 26 {
@@ -95,6 +95,23 @@ func ParseTypes(csv string) ([]int, error) {
 	return ids, nil
 }
 
+// lookupTypeName はセマンティック名を activityTypeId に変換する。
+// issue_updated / issue-commented のようなアンダースコア区切りや過去形の表記も受け付ける。
+func lookupTypeName(name string) (int, bool) {
+	name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	if id, ok := nameToID[name]; ok {
+		return id, true
+	}
+	for _, suffix := range []string{"d", "ed"} {
+		if base, found := strings.CutSuffix(name, suffix); found {
+			if id, ok := nameToID[base]; ok {
+				return id, true
+			}
+		}
+	}
+	return 0, false
+}
+
 // TypeName は activityTypeId を表示用のセマンティック名に変換する。未知の場合は "type-N" を返す。
 func TypeName(id int) string {
 	if name, ok := idToName[id]; ok {
@@ -103,6 +120,12 @@ func TypeName(id int) string {
 	return fmt.Sprintf("type-%d", id)
 }
 
+// TypeID はセマンティック名に対応する activityTypeId を返す（未知の名前は 0）。
+func TypeID(name string) int {
+	id, _ := lookupTypeName(name)
+	return id
+}
+
 // TypeNames は利用可能なセマンティック名を ID 順で返す（ヘルプ表示用）。
 func TypeNames() []string {
 	names := make([]string, 0, len(activityTypes))
@@ -16,6 +16,7 @@ func TestParseTypes(t *testing.T) {
 		{"numeric ids", "1,14", []int{1, 14}, false},
 		{"mixed with spaces", " issue-create , 3 ", []int{1, 3}, false},
 		{"dedup", "issue-create,issue-create,1", []int{1}, false},
+		{"past tense with underscores", "issue_updated,issue_commented,wiki_created,pr_added", []int{2, 3, 5, 18}, false},
 		{"unknown name", "issue-create,bogus", nil, true},
 		{"out of range numeric", "99", nil, true},
 		{"empty", "", nil, true},
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/audit"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// commandPath はルートコマンド名を除いたコマンドパスを返す（例: "issue close"）
+func commandPath(cmd *cobra.Command) string {
+	path := cmd.CommandPath()
+	if root := cmd.Root(); root != nil {
+		path = strings.TrimPrefix(strings.TrimPrefix(path, root.Name()), " ")
+	}
+	return path
+}
+
+// changedFlags は明示的に指定されたフラグ名を返す
+func changedFlags(cmd *cobra.Command) []string {
+	var names []string
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		names = append(names, f.Name)
+	})
+	return names
+}
+
+// checkPolicy はアクティブプロファイルのバンドルのポリシーでコマンドが許可されているか確認する
+func checkPolicy(cmd *cobra.Command, cfg *config.Store) error {
+	policy := config.PolicyForProfile(cfg, cfg.CurrentProfile())
+	return policy.CheckCommand(commandPath(cmd), changedFlags(cmd))
+}
+
+// recordAudit は書き込みを行ったコマンドとポリシーで拒否したコマンドを監査ログに記録する
+// 記録に失敗してもコマンドの結果は変えず、警告のみ表示する
+func recordAudit(cmd *cobra.Command, runErr error) {
+	if cmd == nil {
+		return
+	}
+	cfg, err := config.Load(cmd.Context())
+	if err != nil {
+		return
+	}
+	settings := cfg.Security().Audit
+	policy := config.PolicyForProfile(cfg, cfg.CurrentProfile())
+	if !shouldAudit(settings, policy) {
+		return
+	}
+
+	var violation *config.PolicyViolationError
+	denied := errors.As(runErr, &violation)
+	writes := api.RecordedWrites()
+	if len(writes) == 0 && !denied {
+		return
+	}
+
+	path := settings.Path
+	if path == "" {
+		if path, err = config.AuditLogPath(); err != nil {
+			ui.Warning("failed to write audit log: %v", err)
+			return
+		}
+	}
+	if err := audit.Append(path, newAuditEntry(cmd, cfg, writes, runErr, denied)); err != nil {
+		ui.Warning("%v", err)
+	}
+}
+
+// shouldAudit は監査ログを記録するかどうかを返す
+// ステートレスモードでは、ポリシーで必須の場合と環境変数で有効にした場合だけ記録する
+func shouldAudit(settings config.ResolvedAudit, policy *config.BundlePolicy) bool {
+	if policy != nil && policy.RequireAudit {
+		return true
+	}
+	return settings.Enabled && config.AllowDiskWrite("BACKLOG_SECURITY_AUDIT_ENABLED", "BACKLOG_SECURITY_AUDIT_PATH")
+}
+
+func newAuditEntry(cmd *cobra.Command, cfg *config.Store, writes []api.WriteRequest, runErr error, denied bool) audit.Entry {
+	e := audit.Entry{
+		Time:     time.Now(),
+		Profile:  cfg.GetActiveProfile(),
+		Space:    cmdutil.GetSpace(cfg),
+		Command:  commandPath(cmd),
+		Args:     cmd.Flags().Args(),
+		Requests: writes,
+		Result:   "success",
+	}
+	if e.Requests == nil {
+		e.Requests = []api.WriteRequest{}
+	}
+	if u, err := user.Current(); err == nil {
+		e.OSUser = u.Username
+	} else {
+		e.OSUser = os.Getenv("USER")
+	}
+	if cred := cfg.Credential(cfg.GetActiveProfile()); cred != nil {
+		e.User = cred.UserID
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if e.Flags == nil {
+			e.Flags = make(map[string]string)
+		}
+		e.Flags[f.Name] = audit.RedactFlag(f.Name, f.Value.String())
+	})
+	switch {
+	case denied:
+		e.Result = "denied"
+		e.Error = runErr.Error()
+	case runErr != nil:
+		e.Result = "error"
+		e.Error = runErr.Error()
+	}
+	return e
+}
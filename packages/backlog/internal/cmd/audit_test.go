@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+func TestShouldAudit(t *testing.T) {
+	enabled := config.ResolvedAudit{Enabled: true}
+	required := &config.BundlePolicy{RequireAudit: true}
+	tests := []struct {
+		name     string
+		apiKey   string
+		envAudit string
+		settings config.ResolvedAudit
+		policy   *config.BundlePolicy
+		want     bool
+	}{
+		{name: "disabled", want: false},
+		{name: "enabled", settings: enabled, want: true},
+		{name: "required by policy", policy: required, want: true},
+		{name: "stateless mode", apiKey: "key", settings: enabled, want: false},
+		{name: "stateless mode with explicit env", apiKey: "key", envAudit: "true", settings: enabled, want: true},
+		{name: "stateless mode required by policy", apiKey: "key", policy: required, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(config.EnvAPIKey, tt.apiKey)
+			t.Setenv(config.EnvAccessToken, "")
+			t.Setenv(config.EnvServiceToken, "")
+			t.Setenv("BACKLOG_SECURITY_AUDIT_ENABLED", tt.envAudit)
+			t.Setenv("BACKLOG_SECURITY_AUDIT_PATH", "")
+			if got := shouldAudit(tt.settings, tt.policy); got != tt.want {
+				t.Errorf("shouldAudit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,4 +15,6 @@ func init() {
 	AuthCmd.AddCommand(logoutCmd)
 	AuthCmd.AddCommand(statusCmd)
 	AuthCmd.AddCommand(meCmd)
+	AuthCmd.AddCommand(keygenCmd)
+	AuthCmd.AddCommand(sessionsCmd)
 }
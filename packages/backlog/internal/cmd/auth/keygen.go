@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/relaysig"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	keygenOutput string
+	keygenKeyID  string
+	keygenForce  bool
+)
+
+func init() {
+	keygenCmd.Flags().StringVarP(&keygenOutput, "output", "o", "", "Path to write the private key (default: config directory)")
+	keygenCmd.Flags().StringVar(&keygenKeyID, "kid", "", "Key ID (default: derived from the public key)")
+	keygenCmd.Flags().BoolVar(&keygenForce, "force", false, "Overwrite an existing key file")
+}
+
+var keygenCmd = &cobra.Command{
+	Use:   "keygen",
+	Short: "Generate a key pair for signing relay requests",
+	Long: `Generate an Ed25519 key pair used to sign token requests sent to the relay server.
+
+The private key is saved as a JWK file and registered as relay_signing_key
+of the active profile. The public key is printed to stdout in JWKS format;
+hand it to the relay server administrator to register it in
+request_signature.client_keys.`,
+	Example: `  backlog auth keygen
+  backlog auth keygen --kid alice-laptop -o ~/.config/backlog/alice.json`,
+	RunE: runKeygen,
+}
+
+func runKeygen(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	cfg, err := config.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	profileName := cfg.GetActiveProfile()
+	keyPath := keygenOutput
+	if keyPath == "" {
+		keyPath, err = config.RelaySigningKeyPath(profileName)
+		if err != nil {
+			return fmt.Errorf("failed to resolve key path: %w", err)
+		}
+	}
+	keyPath, err = filepath.Abs(keyPath)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(keyPath); err == nil && !keygenForce {
+		return fmt.Errorf("key file already exists: %s (use --force to overwrite)", keyPath)
+	}
+
+	private, public, err := relaysig.GenerateKey(keygenKeyID)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(private, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(keyPath), 0o700); err != nil {
+		return fmt.Errorf("failed to create key directory: %w", err)
+	}
+	if err := os.WriteFile(keyPath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+
+	if err := cfg.SetProfileValue(config.LayerUser, profileName, "relay_signing_key", keyPath); err != nil {
+		return fmt.Errorf("failed to update profile: %w", err)
+	}
+	if err := cfg.Save(ctx); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	jwks, err := json.MarshalIndent(map[string]any{"keys": []relaysig.JWK{public}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%s Private key written to %s (profile: %s)\n", ui.OKMark(), keyPath, profileName)
+	fmt.Fprintln(os.Stderr, "Register the following public key on the relay server (request_signature.client_keys):")
+	fmt.Println(string(jwks))
+	return nil
+}
@@ -7,17 +7,19 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/auth"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/domain"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -31,24 +33,36 @@ var (
 	loginDomain            string
 	loginSpace             string
 	loginNoBrowser         bool
+	loginBrowser           string
 	loginCallbackPort      int
+	loginCallbackListen    string
+	loginCallbackHost      string
+	loginCallbackURL       string
 	loginTimeout           int
 	loginReuse             bool
 	loginWeb               bool
 	loginWithToken         bool
+	loginServiceToken      bool
 	loginForceBundleUpdate bool
+	loginRelayServer       string
 )
 
 func init() {
 	loginCmd.Flags().StringVar(&loginDomain, "domain", "", "Backlog domain (backlog.jp or backlog.com)")
 	loginCmd.Flags().StringVar(&loginSpace, "space", "", "Backlog space name")
 	loginCmd.Flags().BoolVar(&loginNoBrowser, "no-browser", false, "Don't open browser, just print URL")
+	loginCmd.Flags().StringVar(&loginBrowser, "browser", "", `Browser command to open the login page (e.g. "google-chrome --profile-directory=Work")`)
 	loginCmd.Flags().IntVar(&loginCallbackPort, "callback-port", 0, "Fixed port for callback server")
+	loginCmd.Flags().StringVar(&loginCallbackListen, "callback-listen", "", "Address the callback server listens on (default: 127.0.0.1; e.g. 0.0.0.0 in containers)")
+	loginCmd.Flags().StringVar(&loginCallbackHost, "callback-host", "", "Callback host as seen from the browser (e.g. host.docker.internal)")
+	loginCmd.Flags().StringVar(&loginCallbackURL, "callback-url", "", "Callback base URL as seen from the browser, for port forwarding (e.g. http://localhost:18080)")
 	loginCmd.Flags().IntVar(&loginTimeout, "timeout", 0, "Timeout in seconds (default: 120)")
 	loginCmd.Flags().BoolVar(&loginReuse, "reuse", false, "Reuse previous login settings (method, space, domain) without prompts")
 	loginCmd.Flags().BoolVar(&loginWeb, "web", false, "Use web-based authentication (all prompts in browser)")
 	loginCmd.Flags().BoolVar(&loginWithToken, "with-token", false, "Read API Key from standard input (for non-interactive authentication)")
+	loginCmd.Flags().BoolVar(&loginServiceToken, "service-token", false, "Read a relay-issued service token from standard input (for CI and bots)")
 	loginCmd.Flags().BoolVar(&loginForceBundleUpdate, "force-bundle-update", false, "Force bundle update check (debug)")
+	loginCmd.Flags().StringVar(&loginRelayServer, "relay-server", "", "Relay server URL to use for OAuth 2.0 (saved to the profile)")
 }
 
 var loginCmd = &cobra.Command{
@@ -63,6 +77,14 @@ authentication is available.
 For OAuth 2.0, this command opens a browser window for authentication.
 If the browser cannot be opened, a URL will be displayed for manual access.
 
+The login page is opened with --browser, profile.browser or $BROWSER (in this
+order), falling back to the system default browser. If your space signs in
+through company SSO, choose the browser profile signed in to your work
+account so that the page does not open with a personal account:
+
+  backlog auth login --browser "google-chrome --profile-directory=Work"
+  backlog config set profile.default.browser "firefox -P work"
+
 For API Key authentication, you will be prompted to enter your API Key.
 You can obtain your API Key from your Backlog personal settings page.
 
@@ -73,7 +95,10 @@ Use --reuse (-r) flag to skip prompts and reuse previous login settings
 (authentication method, space, and domain).
 
 Use --web flag to perform all authentication steps in the browser,
-which is useful for automation or when terminal input is not available.`,
+which is useful for automation or when terminal input is not available.
+
+Use --relay-server to log in with OAuth 2.0 through the given relay server.
+The URL is saved to the profile as relay_server.`,
 	RunE: runLogin,
 }
 
@@ -101,11 +126,23 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
+	// --relay-server オプションが指定された場合はプロファイルの中継サーバーを設定する
+	if loginRelayServer != "" {
+		if err := applyLoginRelayServer(cmd.Context(), cfg, loginRelayServer); err != nil {
+			return err
+		}
+	}
+
 	// --with-token オプションが指定された場合は標準入力からAPIキーを読み取る
 	if loginWithToken {
 		return runWithTokenLogin(cmd.Context(), cfg, cmd.InOrStdin())
 	}
 
+	// --service-token オプションが指定された場合は標準入力からサービストークンを読み取る
+	if loginServiceToken {
+		return runServiceTokenLogin(cmd.Context(), cfg, cmd.InOrStdin())
+	}
+
 	// --web オプションが指定された場合はWebベースの認証フローを使用
 	if loginWeb {
 		return runWebLogin(cmd.Context(), cfg)
@@ -120,8 +157,7 @@ func runLogin(cmd *cobra.Command, args []string) error {
 	// --reuse オプションが指定された場合
 	if loginReuse {
 		// 既存のクレデンシャルを取得
-		resolved := cfg.Resolved()
-		cred := resolved.GetActiveCredential()
+		cred := cfg.CurrentCredential()
 		if cred == nil {
 			return fmt.Errorf("no previous login found. Please run 'backlog auth login' without --reuse flag first")
 		}
@@ -137,6 +173,8 @@ func runLogin(cmd *cobra.Command, args []string) error {
 			}
 		case config.AuthTypeAPIKey:
 			authMethod = authMethodAPIKey
+		case config.AuthTypeServiceToken:
+			return fmt.Errorf("service tokens cannot be reused interactively; run 'backlog auth login --service-token' instead")
 		default:
 			return fmt.Errorf("unknown authentication type in previous credentials")
 		}
@@ -144,6 +182,9 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		if authMethod == authMethodOAuth && profile != nil && profile.Space != "" {
 			fmt.Printf("Reusing previous login settings: %s with %s\n", authMethod, profile.Space)
 		}
+	} else if loginRelayServer != "" {
+		// 中継サーバーを指定した場合は OAuth を使う
+		authMethod = authMethodOAuth
 	} else {
 		// 通常の認証方式選択（OAuth は常に選択可能）
 		authMethod, err = ui.Select("Select authentication method:", []string{authMethodOAuth, authMethodAPIKey})
@@ -160,6 +201,32 @@ func runLogin(cmd *cobra.Command, args []string) error {
 	return runOAuthLogin(ctx, cfg)
 }
 
+// applyLoginRelayServer は --relay-server の URL をアクティブなプロファイルに保存する
+// バンドル参照は relay_server より優先されるため、設定されている場合は外す
+func applyLoginRelayServer(ctx context.Context, cfg *config.Store, relayServer string) error {
+	u, err := url.Parse(relayServer)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid --relay-server %q: expected an http(s) URL", relayServer)
+	}
+	relayServer = strings.TrimRight(relayServer, "/")
+
+	profileName := cfg.GetActiveProfile()
+	if profile := cfg.CurrentProfile(); profile != nil && profile.Bundle != "" {
+		if err := cfg.SetProfileValue(config.LayerUser, profileName, "bundle", ""); err != nil {
+			return fmt.Errorf("failed to update profile: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "Profile %s no longer uses bundle %s\n", profileName, profile.Bundle)
+	}
+	if err := cfg.SetProfileValue(config.LayerUser, profileName, "relay_server", relayServer); err != nil {
+		return fmt.Errorf("failed to update profile: %w", err)
+	}
+	// コールバック後に設定を再読み込みするため、ここで保存しておく
+	if err := cfg.Save(ctx); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	return nil
+}
+
 // runAPIKeyLogin はAPI Key認証を実行する
 func runAPIKeyLogin(ctx context.Context, cfg *config.Store) error {
 	// オプションのマージ
@@ -318,6 +385,81 @@ func runWithTokenLogin(ctx context.Context, cfg *config.Store, stdin io.Reader)
 	return nil
 }
 
+// runServiceTokenLogin は標準入力からサービストークンを読み取って認証を実行する
+// サービストークンは中継サーバーの管理者が承認して発行するもので、中継サーバーでアクセストークンと交換する
+func runServiceTokenLogin(ctx context.Context, cfg *config.Store, stdin io.Reader) error {
+	spaceHost := domain.NormalizeSpace(loginSpace, loginDomain)
+	if spaceHost == "" || !strings.Contains(spaceHost, ".") {
+		return fmt.Errorf("--space is required when using --service-token (e.g. --space myspace.backlog.jp)")
+	}
+
+	profile := cfg.CurrentProfile()
+	relayURL, err := cfg.ResolveRelayURL(profile)
+	if err != nil {
+		return fmt.Errorf("--service-token requires a relay server (use --relay-server): %w", err)
+	}
+	signer, err := config.RelaySignerForProfile(cfg, profile)
+	if err != nil {
+		return err
+	}
+	relayTLS, err := config.RelayTLSConfigForProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(stdin)
+	serviceToken, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read service token from stdin: %w", err)
+	}
+	serviceToken = strings.TrimSpace(serviceToken)
+	if serviceToken == "" {
+		return fmt.Errorf("service token is required")
+	}
+
+	// 中継サーバーでアクセストークンと交換し、ユーザー情報の取得で検証する
+	var accessToken string
+	var expiresAt time.Time
+	apiClient := api.NewClient(spaceHost, "",
+		api.WithServiceToken(serviceToken, relayURL, "", time.Time{},
+			func(_ context.Context, token, _ string, expiry time.Time) {
+				accessToken = token
+				expiresAt = expiry
+			}),
+		api.WithRelaySigner(signer),
+		api.WithRelayTLS(relayTLS),
+	)
+	user, err := apiClient.GetCurrentUser(ctx)
+	if err != nil {
+		return fmt.Errorf("service token verification failed: %w", err)
+	}
+
+	profileName := cfg.GetActiveProfile()
+	cred := &config.Credential{
+		AuthType:     config.AuthTypeServiceToken,
+		ServiceToken: serviceToken,
+		AccessToken:  accessToken,
+		ExpiresAt:    expiresAt,
+		UserID:       user.UserId.Value,
+		UserName:     user.Name.Value,
+		UserEmail:    user.MailAddress.Value,
+		Space:        spaceHost,
+	}
+	_ = cfg.SetCredential(profileName, cred)
+
+	_ = cfg.SetProfileValue(config.LayerUser, profileName, "space", spaceHost)
+
+	if err := cfg.Reload(ctx); err != nil {
+		return fmt.Errorf("failed to reload config: %w", err)
+	}
+	if err := cfg.Save(ctx); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("Logged in to %s as %s (service token)\n", spaceHost, user.Name.Value)
+	return nil
+}
+
 // runOAuthLogin はOAuth認証を実行する
 // ブラウザベースの設定UIを使用する新フロー
 func runOAuthLogin(ctx context.Context, cfg *config.Store) error {
@@ -347,6 +489,9 @@ func runOAuthLogin(ctx context.Context, cfg *config.Store) error {
 	debug.Log("creating callback server", "requested_port", opts.callbackPort)
 	callbackServer, err := auth.NewCallbackServer(auth.CallbackServerOptions{
 		Port:              opts.callbackPort,
+		ListenHost:        opts.callbackListen,
+		PublicHost:        opts.callbackHost,
+		PublicURL:         opts.callbackURL,
 		State:             state,
 		ConfigStore:       cfg,
 		Reuse:             opts.reuse,
@@ -364,7 +509,7 @@ func runOAuthLogin(ctx context.Context, cfg *config.Store) error {
 		}
 	}()
 	defer func() {
-		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		shutdownCtx, cancel := context.WithTimeout(cmdutil.CleanupContext(ctx), 5*time.Second)
 		defer cancel()
 		if err := callbackServer.Shutdown(shutdownCtx); err != nil {
 			debug.Log("callback server shutdown error", "error", err)
@@ -372,20 +517,9 @@ func runOAuthLogin(ctx context.Context, cfg *config.Store) error {
 	}()
 
 	// 3. ローカルサーバーの /auth/start を開く
-	localAuthURL := fmt.Sprintf("http://localhost:%d/auth/start", callbackServer.Port())
-
-	fmt.Println()
-	fmt.Println("Open this URL in your browser to log in:")
-	fmt.Println()
-	fmt.Printf("  %s\n", localAuthURL)
-	fmt.Println()
-	fmt.Println("Waiting for authentication... (press Ctrl+C to cancel)")
+	localAuthURL := callbackServer.BaseURL() + "/auth/start"
 
-	if !opts.noBrowser {
-		if err := browser.OpenURL(localAuthURL); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: could not open browser: %v\n", err)
-		}
-	}
+	openAuthPage(localAuthURL, opts)
 
 	// 4. コールバック待機（タイムアウトなし - ロングポーリングベースのフローに対応）
 	debug.Log("waiting for callback (no timeout)")
@@ -414,12 +548,21 @@ func runOAuthLogin(ctx context.Context, cfg *config.Store) error {
 	// 6. トークン交換
 	debug.Log("exchanging authorization code", "code_length", len(result.Code))
 	fmt.Println("Exchanging authorization code...")
-	client := auth.NewClient(currentRelayServer)
+	signer, err := config.RelaySignerForProfile(cfg, profile)
+	if err != nil {
+		return err
+	}
+	relayTLS, err := config.RelayTLSConfigForProfile(profile)
+	if err != nil {
+		return err
+	}
+	client := auth.NewClient(currentRelayServer, auth.WithRelaySigner(signer), auth.WithTLSConfig(relayTLS))
 	tokenResp, err := client.ExchangeToken(auth.TokenRequest{
 		GrantType: "authorization_code",
 		Code:      result.Code,
 		Space:     space,
 		State:     state,
+		Device:    auth.DeviceName(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to exchange token: %w", err)
@@ -449,6 +592,7 @@ func runOAuthLogin(ctx context.Context, cfg *config.Store) error {
 		RefreshToken: tokenResp.RefreshToken,
 		ExpiresAt:    time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second),
 		Space:        space,
+		SessionID:    tokenResp.SessionID,
 	}
 	if user != nil {
 		cred.UserID = user.UserId.Value
@@ -467,12 +611,16 @@ func runOAuthLogin(ctx context.Context, cfg *config.Store) error {
 }
 
 type loginOptions struct {
-	space        string // spaceHost 形式 ("myspace.backlog.jp")
-	noBrowser    bool
-	callbackPort int
-	timeout      int
-	reuse        bool
-	web          bool
+	space          string // spaceHost 形式 ("myspace.backlog.jp")
+	noBrowser      bool
+	browser        string
+	callbackPort   int
+	callbackListen string
+	callbackHost   string
+	callbackURL    string
+	timeout        int
+	reuse          bool
+	web            bool
 }
 
 func mergeLoginOptions(cfg *config.Store) loginOptions {
@@ -480,12 +628,16 @@ func mergeLoginOptions(cfg *config.Store) loginOptions {
 	space := domain.NormalizeSpace(loginSpace, loginDomain)
 
 	opts := loginOptions{
-		space:        space,
-		noBrowser:    loginNoBrowser,
-		callbackPort: loginCallbackPort,
-		timeout:      loginTimeout,
-		reuse:        loginReuse,
-		web:          loginWeb,
+		space:          space,
+		noBrowser:      loginNoBrowser,
+		browser:        loginBrowser,
+		callbackPort:   loginCallbackPort,
+		callbackListen: loginCallbackListen,
+		callbackHost:   loginCallbackHost,
+		callbackURL:    loginCallbackURL,
+		timeout:        loginTimeout,
+		reuse:          loginReuse,
+		web:            loginWeb,
 	}
 
 	// 設定ファイルからの補完
@@ -497,12 +649,22 @@ func mergeLoginOptions(cfg *config.Store) loginOptions {
 		if opts.callbackPort == 0 {
 			opts.callbackPort = profile.AuthCallbackPort
 		}
+		if opts.callbackListen == "" {
+			opts.callbackListen = profile.AuthCallbackListen
+		}
+		if opts.callbackHost == "" && opts.callbackURL == "" {
+			opts.callbackHost = profile.AuthCallbackHost
+			opts.callbackURL = profile.AuthCallbackURL
+		}
 		if opts.timeout == 0 {
 			opts.timeout = profile.AuthTimeout
 		}
 		if !opts.noBrowser {
 			opts.noBrowser = profile.AuthNoBrowser
 		}
+		if opts.browser == "" {
+			opts.browser = cmdutil.ConfiguredBrowser(cfg)
+		}
 	}
 
 	// デフォルト値
@@ -513,6 +675,48 @@ func mergeLoginOptions(cfg *config.Store) loginOptions {
 	return opts
 }
 
+// openAuthPage は認証ページの URL を表示し、ブラウザで開く
+// 会社のスペースを個人アカウントのブラウザで開いてしまわないよう、使うブラウザを明示する
+func openAuthPage(url string, opts loginOptions) {
+	fmt.Println()
+	fmt.Println("Open this URL in your browser to log in:")
+	fmt.Println()
+	fmt.Printf("  %s\n", url)
+	fmt.Println()
+	for _, line := range authBrowserNotice(opts.space, osutil.ResolveBrowser(opts.browser), opts.noBrowser) {
+		fmt.Println(line)
+	}
+	fmt.Println()
+	fmt.Println("Waiting for authentication... (press Ctrl+C to cancel)")
+
+	if !opts.noBrowser {
+		if err := osutil.OpenURL(opts.browser, url); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not open browser: %v\n", err)
+		}
+	}
+}
+
+// authBrowserNotice は企業 SSO 向けの注意と、認証ページを開くブラウザの説明を返す
+// browser は解決済みのブラウザコマンド（空なら OS の既定）
+func authBrowserNotice(space, browser string, noBrowser bool) []string {
+	target := "your space"
+	if space != "" {
+		target = space
+	}
+	lines := []string{
+		fmt.Sprintf("Note: if %s signs in through company SSO, use the browser (profile)", target),
+		"      signed in to your work account, not a personal one.",
+	}
+	switch {
+	case noBrowser:
+	case browser != "":
+		lines = append(lines, fmt.Sprintf("Opening with: %s", browser))
+	default:
+		lines = append(lines, "Opening with the default browser (use --browser or profile.browser to choose one).")
+	}
+	return lines
+}
+
 // runWebLogin はWebベースの認証フローを実行する
 // 認証方式の選択もブラウザで行い、端末での対話を不要にする
 func runWebLogin(ctx context.Context, cfg *config.Store) error {
@@ -542,6 +746,9 @@ func runWebLogin(ctx context.Context, cfg *config.Store) error {
 	debug.Log("creating callback server", "requested_port", opts.callbackPort)
 	callbackServer, err := auth.NewCallbackServer(auth.CallbackServerOptions{
 		Port:              opts.callbackPort,
+		ListenHost:        opts.callbackListen,
+		PublicHost:        opts.callbackHost,
+		PublicURL:         opts.callbackURL,
 		State:             state,
 		ConfigStore:       cfg,
 		Reuse:             false, // --web モードでは常にブラウザで設定
@@ -559,7 +766,7 @@ func runWebLogin(ctx context.Context, cfg *config.Store) error {
 		}
 	}()
 	defer func() {
-		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		shutdownCtx, cancel := context.WithTimeout(cmdutil.CleanupContext(ctx), 5*time.Second)
 		defer cancel()
 		if err := callbackServer.Shutdown(shutdownCtx); err != nil {
 			debug.Log("callback server shutdown error", "error", err)
@@ -567,20 +774,9 @@ func runWebLogin(ctx context.Context, cfg *config.Store) error {
 	}()
 
 	// 3. ローカルサーバーの /auth/method を開く（認証方式選択画面）
-	localAuthURL := fmt.Sprintf("http://localhost:%d/auth/method", callbackServer.Port())
+	localAuthURL := callbackServer.BaseURL() + "/auth/method"
 
-	fmt.Println()
-	fmt.Println("Open this URL in your browser to log in:")
-	fmt.Println()
-	fmt.Printf("  %s\n", localAuthURL)
-	fmt.Println()
-	fmt.Println("Waiting for authentication... (press Ctrl+C to cancel)")
-
-	if !opts.noBrowser {
-		if err := browser.OpenURL(localAuthURL); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: could not open browser: %v\n", err)
-		}
-	}
+	openAuthPage(localAuthURL, opts)
 
 	// 4. コールバック待機
 	debug.Log("waiting for callback (no timeout)")
@@ -618,12 +814,21 @@ func runWebLogin(ctx context.Context, cfg *config.Store) error {
 	// 6. トークン交換
 	debug.Log("exchanging authorization code", "code_length", len(result.Code))
 	fmt.Println("Exchanging authorization code...")
-	client := auth.NewClient(currentRelayServer)
+	signer, err := config.RelaySignerForProfile(cfg, profile)
+	if err != nil {
+		return err
+	}
+	relayTLS, err := config.RelayTLSConfigForProfile(profile)
+	if err != nil {
+		return err
+	}
+	client := auth.NewClient(currentRelayServer, auth.WithRelaySigner(signer), auth.WithTLSConfig(relayTLS))
 	tokenResp, err := client.ExchangeToken(auth.TokenRequest{
 		GrantType: "authorization_code",
 		Code:      result.Code,
 		Space:     space,
 		State:     state,
+		Device:    auth.DeviceName(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to exchange token: %w", err)
@@ -653,6 +858,7 @@ func runWebLogin(ctx context.Context, cfg *config.Store) error {
 		RefreshToken: tokenResp.RefreshToken,
 		ExpiresAt:    time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second),
 		Space:        space,
+		SessionID:    tokenResp.SessionID,
 	}
 	if user != nil {
 		cred.UserID = user.UserId.Value
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAuthBrowserNotice(t *testing.T) {
+	tests := []struct {
+		name      string
+		space     string
+		browser   string
+		noBrowser bool
+		contains  string
+		excludes  string
+	}{
+		{name: "configured browser", space: "corp.backlog.jp", browser: "google-chrome --profile-directory=Work", contains: "Opening with: google-chrome --profile-directory=Work"},
+		{name: "default browser", space: "corp.backlog.jp", contains: "use --browser or profile.browser"},
+		{name: "no browser", space: "corp.backlog.jp", browser: "firefox", noBrowser: true, excludes: "Opening with"},
+		{name: "unknown space", contains: "if your space signs in"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Join(authBrowserNotice(tt.space, tt.browser, tt.noBrowser), "\n")
+			if !strings.Contains(got, "company SSO") {
+				t.Errorf("notice does not mention company SSO: %q", got)
+			}
+			if tt.space != "" && !strings.Contains(got, tt.space) {
+				t.Errorf("notice does not mention the space %q: %q", tt.space, got)
+			}
+			if tt.contains != "" && !strings.Contains(got, tt.contains) {
+				t.Errorf("notice = %q, want to contain %q", got, tt.contains)
+			}
+			if tt.excludes != "" && strings.Contains(got, tt.excludes) {
+				t.Errorf("notice = %q, want not to contain %q", got, tt.excludes)
+			}
+		})
+	}
+}
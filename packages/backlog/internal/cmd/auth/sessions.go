@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/auth"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var sessionsCmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "Manage login sessions recorded by the relay server",
+	Long: `List the devices logged in to Backlog through the relay server and revoke one,
+for example when a laptop is lost.
+
+A revoked device can no longer refresh its access token and has to run
+"backlog auth login" again once the current access token expires (about an
+hour). Sessions are available only when the relay server records them
+(SESSION_STORE=memory).`,
+}
+
+var sessionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your login sessions",
+	Long: `List your login sessions (one per device) recorded by the relay server.
+The session of this device is marked with "*". Revoked sessions are shown
+with --all.
+
+Examples:
+  backlog auth sessions list
+  backlog auth sessions list --all -o json`,
+	Args: cobra.NoArgs,
+	RunE: runSessionsList,
+}
+
+var sessionsRevokeCmd = &cobra.Command{
+	Use:   "revoke <session-id>",
+	Short: "Revoke a login session",
+	Long: `Revoke a login session. The device of the session cannot refresh its access
+token anymore and has to log in again.
+
+Examples:
+  backlog auth sessions revoke Xk2f9aQ1bC3d
+  backlog auth sessions revoke Xk2f9aQ1bC3d --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionsRevoke,
+}
+
+var sessionsListAll bool
+
+func init() {
+	sessionsListCmd.Flags().BoolVar(&sessionsListAll, "all", false, "Include revoked sessions")
+	sessionsCmd.AddCommand(sessionsListCmd)
+	sessionsCmd.AddCommand(sessionsRevokeCmd)
+}
+
+// sessionContext はセッション操作に必要な中継サーバーと認証情報
+type sessionContext struct {
+	client      *auth.Client
+	space       string
+	accessToken string
+	currentID   string
+	profile     *config.ResolvedProfile
+}
+
+func newSessionContext(c *cobra.Command) (*sessionContext, error) {
+	apiClient, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cred := cfg.CurrentCredential()
+	if config.CredentialFromEnv() != nil || cred == nil || cred.GetAuthType() != config.AuthTypeOAuth {
+		return nil, fmt.Errorf("login sessions are available only for OAuth logins through a relay server\nRun 'backlog auth login' first")
+	}
+	profile := cfg.CurrentProfile()
+	relayURL, err := cfg.ResolveRelayURL(profile)
+	if err != nil {
+		return nil, err
+	}
+	relayTLS, err := config.RelayTLSConfigForProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// 期限切れ間近ならここでアクセストークンを更新する
+	token, err := apiClient.OAuth2(c.Context(), "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	return &sessionContext{
+		client:      auth.NewClient(relayURL, auth.WithTLSConfig(relayTLS)),
+		space:       cmdutil.GetSpace(cfg),
+		accessToken: token.Token,
+		currentID:   cred.SessionID,
+		profile:     profile,
+	}, nil
+}
+
+func sessionsError(err error) error {
+	if errors.Is(err, auth.ErrSessionsUnsupported) {
+		return fmt.Errorf("%w (ask the relay administrator to enable SESSION_STORE)", err)
+	}
+	return err
+}
+
+func runSessionsList(c *cobra.Command, args []string) error {
+	sc, err := newSessionContext(c)
+	if err != nil {
+		return err
+	}
+	sessions, err := sc.client.ListSessions(sc.space, sc.accessToken)
+	if err != nil {
+		return sessionsError(err)
+	}
+	if !sessionsListAll {
+		sessions = activeSessions(sessions)
+	}
+
+	if profile := sc.profile; profile.Output == "json" {
+		return cmdutil.OutputJSONFromProfile(sessions, profile.JSONFields, profile.JQ, profile.Template)
+	}
+
+	if len(sessions) == 0 {
+		fmt.Println("No login sessions recorded")
+		return nil
+	}
+	headers := []string{"", "ID", "DEVICE", "LAST USED", "LAST IP", "CREATED"}
+	if sessionsListAll {
+		headers = append(headers, "REVOKED")
+	}
+	table := ui.NewTable(headers...)
+	for _, s := range sessions {
+		mark := ""
+		if s.ID == sc.currentID {
+			mark = "*"
+		}
+		device := s.Device
+		if device == "" {
+			device = ui.Gray("(unknown)")
+		}
+		row := []string{mark, s.ID, device, formatSessionTime(s.LastUsedAt), s.LastIP, formatSessionTime(s.CreatedAt)}
+		if sessionsListAll {
+			row = append(row, formatSessionTime(s.RevokedAt))
+		}
+		table.AddRow(row...)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	return nil
+}
+
+func runSessionsRevoke(c *cobra.Command, args []string) error {
+	sc, err := newSessionContext(c)
+	if err != nil {
+		return err
+	}
+	id := args[0]
+
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog auth sessions revoke",
+				"Use --yes to revoke the session.",
+			)
+		}
+		prompt := fmt.Sprintf("Revoke session %s?", id)
+		if id == sc.currentID {
+			prompt = fmt.Sprintf("Session %s is this device. Revoke it anyway?", id)
+		}
+		ok, err := ui.Confirm(prompt, false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	session, err := sc.client.RevokeSession(sc.space, sc.accessToken, id)
+	if err != nil {
+		return sessionsError(err)
+	}
+	device := session.Device
+	if device == "" {
+		device = "unknown device"
+	}
+	cmdutil.Success("", "Revoked session %s (%s)", session.ID, device)
+	if session.ID == sc.currentID {
+		ui.Warning("This device has to run 'backlog auth login' again once the current access token expires.")
+	}
+	return nil
+}
+
+// activeSessions は失効していないセッションだけを返す
+func activeSessions(sessions []auth.LoginSession) []auth.LoginSession {
+	active := make([]auth.LoginSession, 0, len(sessions))
+	for _, s := range sessions {
+		if s.RevokedAt == "" {
+			active = append(active, s)
+		}
+	}
+	return active
+}
+
+// formatSessionTime は中継サーバーの日時（RFC 3339）をローカル時刻で表示する
+func formatSessionTime(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
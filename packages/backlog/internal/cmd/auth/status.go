@@ -37,7 +37,8 @@ func runStatus(cmd *cobra.Command, args []string) error {
 
 	resolved := cfg.Resolved()
 	credentials := resolved.Credentials
-	if len(credentials) == 0 {
+	envVar := config.CredentialEnvVar()
+	if len(credentials) == 0 && envVar == "" {
 		if statusQuiet {
 			os.Exit(1)
 		}
@@ -55,6 +56,29 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	// プロファイル情報を取得して表示に使用
 	profiles := resolved.Profiles
 
+	// 環境変数の認証情報は設定ファイルの認証情報より優先される
+	if envVar != "" {
+		host := "(not configured)"
+		if profile := cfg.CurrentProfile(); profile != nil && profile.Space != "" {
+			host = profile.Space
+		}
+		fmt.Println("Using credentials from environment:")
+		fmt.Println()
+		fmt.Printf("  [%s] %s\n", envVar, host)
+		switch config.CredentialFromEnv().GetAuthType() {
+		case config.AuthTypeAPIKey:
+			fmt.Println("    Auth: API Key")
+		case config.AuthTypeServiceToken:
+			fmt.Println("    Auth: Service Token")
+		default:
+			fmt.Println("    Auth: OAuth 2.0")
+		}
+		fmt.Println()
+		if len(credentials) == 0 {
+			return nil
+		}
+	}
+
 	fmt.Println("Authenticated accounts:")
 	fmt.Println()
 
@@ -81,6 +105,10 @@ func runStatus(cmd *cobra.Command, args []string) error {
 			fmt.Println("    Auth: OAuth 2.0")
 			// OAuthの場合のみトークン有効期限を表示
 			printTokenStatus(cred.ExpiresAt)
+		case config.AuthTypeServiceToken:
+			fmt.Println("    Auth: Service Token")
+			// アクセストークンはサービストークンから都度交換するため、未取得なら次回取得する
+			printTokenStatus(cred.ExpiresAt)
 		default:
 			fmt.Println("    Auth: OAuth 2.0")
 			printTokenStatus(cred.ExpiresAt)
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/outputs"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// autoSaveSession は実行中のコマンドの出力の自動保存の状態
+type autoSaveSession struct {
+	capture *outputs.Capture
+	dir     string
+	format  string
+	profile string
+	started time.Time
+}
+
+// autoSave は PersistentPreRunE で開始した自動保存（対象外のコマンドでは nil）
+var autoSave *autoSaveSession
+
+// startAutoSave は display.auto_save_dir が設定されていれば対象コマンドの標準出力の記録を始める
+// ステートレスモードでは BACKLOG_DISPLAY_AUTO_SAVE_DIR で明示した場合だけ保存する
+func startAutoSave(cmd *cobra.Command, cfg *config.Store) {
+	if !cmdutil.IsAutoSave(cmd) || cfg.Display().AutoSaveDir == "" || !config.AllowDiskWrite("BACKLOG_DISPLAY_AUTO_SAVE_DIR") {
+		return
+	}
+	dir, err := outputs.ExpandDir(cfg.Display().AutoSaveDir)
+	if err != nil {
+		ui.Warning("failed to resolve display.auto_save_dir: %v", err)
+		return
+	}
+	// os.Stdout はパイプに差し替わるため、色・幅・ページャーの判定は差し替え前の端末で行う
+	ui.SetStdoutTerminal(os.Stdout)
+	capture, err := outputs.StartCapture()
+	if err != nil {
+		ui.SetStdoutTerminal(nil)
+		ui.Warning("failed to start saving output: %v", err)
+		return
+	}
+	autoSave = &autoSaveSession{
+		capture: capture,
+		dir:     dir,
+		format:  autoSaveFormat(cmd, cfg),
+		profile: cfg.GetActiveProfile(),
+		started: time.Now(),
+	}
+}
+
+// autoSaveFormat はコマンドの出力形式を返す（保存するファイルの拡張子に使う）
+func autoSaveFormat(cmd *cobra.Command, cfg *config.Store) string {
+	profile := cfg.CurrentProfile()
+	switch {
+	case profile.Template != "":
+		return "text"
+	case profile.Output == "json":
+		return "json"
+	}
+	// 独自の --format を持つコマンド（markdown detect など）はその値を使う
+	if f := cmd.Flags().Lookup("format"); f != nil && f != cmd.Root().PersistentFlags().Lookup("format") && f.Value.String() != "" {
+		return f.Value.String()
+	}
+	if profile.Output == "" {
+		return "table"
+	}
+	return profile.Output
+}
+
+// finishAutoSave は記録した出力をファイルに保存し、履歴に追記する
+// 失敗したコマンドや出力が空の場合は保存しない。保存に失敗してもコマンドの結果は変えず、警告のみ表示する
+func finishAutoSave(cmd *cobra.Command, runErr error) {
+	if autoSave == nil {
+		return
+	}
+	s := autoSave
+	autoSave = nil
+	data := outputs.StripEscapes(s.capture.Stop())
+	ui.SetStdoutTerminal(nil)
+	if runErr != nil || len(bytes.TrimSpace(data)) == 0 {
+		return
+	}
+
+	path, err := outputs.Save(s.dir, cmdutil.CommandKey(cmd), s.format, s.started, data)
+	if err != nil {
+		ui.Warning("%v", err)
+		return
+	}
+	historyPath, err := config.OutputHistoryPath()
+	if err != nil {
+		ui.Warning("failed to record output history: %v", err)
+		return
+	}
+	entry := outputs.Entry{
+		Time:    s.started,
+		Command: commandPath(cmd),
+		Args:    cmd.Flags().Args(),
+		Profile: s.profile,
+		Format:  s.format,
+		Path:    path,
+		Size:    len(data),
+	}
+	if err := outputs.Append(historyPath, entry); err != nil {
+		ui.Warning("%v", err)
+		return
+	}
+	cmdutil.Progressf("Saved output to %s", path)
+}
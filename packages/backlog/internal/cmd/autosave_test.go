@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+func TestStartAutoSaveInStatelessMode(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	t.Setenv(config.EnvAPIKey, "key")
+	t.Setenv("BACKLOG_DISPLAY_AUTO_SAVE_DIR", t.TempDir())
+	config.ResetConfig()
+	t.Cleanup(config.ResetConfig)
+
+	cfg, err := config.Load(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := &cobra.Command{Use: "report", Annotations: cmdutil.AutoSave()}
+
+	// 環境変数で明示した場合は保存する
+	startAutoSave(cmd, cfg)
+	if autoSave == nil {
+		t.Fatal("auto-save should start when BACKLOG_DISPLAY_AUTO_SAVE_DIR is set")
+	}
+	finishAutoSave(cmd, errors.New("discard"))
+
+	// 設定ファイルの display.auto_save_dir だけではステートレスモードで保存しない
+	if err := cfg.Set("display.auto_save_dir", t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("BACKLOG_DISPLAY_AUTO_SAVE_DIR", "")
+	startAutoSave(cmd, cfg)
+	if autoSave != nil {
+		finishAutoSave(cmd, errors.New("discard"))
+		t.Fatal("auto-save should not start in the stateless mode")
+	}
+}
+
+// レポート・エクスポート系のコマンドはすべて出力の自動保存の対象にする
+// 新しく追加したときは、ここに加えて Annotations: cmdutil.AutoSave() を付ける
+func TestAutoSaveCommands(t *testing.T) {
+	want := []string{
+		"backup create",
+		"changelog",
+		"graph",
+		"issue archive",
+		"markdown detect",
+		"project audit",
+		"relay stats",
+		"user report",
+	}
+	// 名前や --export フラグからレポート・エクスポート系と分かるコマンド
+	reportLike := func(c *cobra.Command) bool {
+		switch c.Name() {
+		case "report", "stats", "audit", "export", "changelog", "graph":
+			return true
+		}
+		return c.LocalFlags().Lookup("export") != nil
+	}
+	var got []string
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		if cmdutil.IsAutoSave(c) {
+			got = append(got, commandPath(c))
+		} else if c.Runnable() && reportLike(c) {
+			t.Errorf("%s looks like a report/export command but is not annotated with cmdutil.AutoSave()", commandPath(c))
+		}
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("auto-save commands = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,20 @@
+package backup
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var BackupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up and restore a project",
+	Long: `Save a complete snapshot of a project (issues, comments, wiki pages,
+attachments and settings) to a local directory, and restore it to another
+space or project.
+
+Use it to keep a copy before cancelling or merging a space.`,
+}
+
+func init() {
+	BackupCmd.AddCommand(createCmd)
+	BackupCmd.AddCommand(restoreCmd)
+}
@@ -0,0 +1,297 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/backup"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Save a project snapshot to a directory",
+	Long: `Save issues (with comments and attachments), wiki pages (with attachments)
+and project settings to a directory as JSON files.
+
+Attachments are streamed to disk, and every file is replaced atomically, so an
+interrupted backup can be resumed with --incremental. --incremental also
+updates an existing backup: only issues and wiki pages updated since they
+were saved are fetched again. Issues and pages deleted on Backlog are kept in
+the backup.
+
+Examples:
+  backlog backup create --project PROJ -o proj-backup/
+  backlog backup create --project PROJ -o proj-backup/ --incremental`,
+	Args:        cobra.NoArgs,
+	RunE:        runCreate,
+	Annotations: cmdutil.AutoSave(),
+}
+
+var (
+	createOutput      string
+	createIncremental bool
+)
+
+func init() {
+	createCmd.Flags().StringVarP(&createOutput, "output", "o", "", "Backup directory (required)")
+	createCmd.Flags().BoolVar(&createIncremental, "incremental", false, "Update or resume an existing backup in the directory")
+	_ = createCmd.MarkFlagRequired("output")
+}
+
+// backupCounter はバックアップで保存した件数
+type backupCounter struct {
+	issues, wikis, attachments, fetched int
+}
+
+func runCreate(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	ctx := c.Context()
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	space := cfg.CurrentProfile().Space
+	dir := backup.Open(createOutput)
+
+	now := time.Now().UTC()
+	manifest := &backup.Manifest{Format: backup.FormatVersion, Space: space, CreatedAt: now}
+	empty, err := dir.IsEmpty()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", createOutput, err)
+	}
+	if !empty {
+		if !createIncremental {
+			return fmt.Errorf("%s is not empty (use --incremental to update an existing backup)", createOutput)
+		}
+		existing, err := dir.ReadManifest()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("%s is not a backup directory (manifest.json not found)", createOutput)
+			}
+			return err
+		}
+		if existing.Space != space || existing.ProjectKey != projectKey {
+			return fmt.Errorf("%s is a backup of %s/%s, not %s/%s", createOutput, existing.Space, existing.ProjectKey, space, projectKey)
+		}
+		manifest = existing
+	}
+
+	project, err := client.GetProject(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	manifest.ProjectID = project.ID
+	manifest.ProjectKey = project.ProjectKey
+	manifest.Complete = false
+	if err := dir.WriteManifest(manifest); err != nil {
+		return err
+	}
+	if err := dir.WriteProject(project); err != nil {
+		return err
+	}
+	settings, err := fetchSettings(ctx, client, projectKey)
+	if err != nil {
+		return err
+	}
+	if err := dir.WriteSettings(settings); err != nil {
+		return err
+	}
+
+	var counter backupCounter
+	if err := backupIssues(ctx, client, dir, project.ID, &counter); err != nil {
+		return err
+	}
+	if project.UseWiki {
+		if err := backupWikis(ctx, client, dir, projectKey, &counter); err != nil {
+			return err
+		}
+	}
+
+	manifest.Complete = true
+	manifest.UpdatedAt = time.Now().UTC()
+	manifest.Issues = counter.issues
+	manifest.Wikis = counter.wikis
+	manifest.Attachments = counter.attachments
+	if err := dir.WriteManifest(manifest); err != nil {
+		return err
+	}
+	cmdutil.Success(createOutput, "Backed up %s to %s: %d issues, %d wiki pages, %d attachments (%d updated)",
+		projectKey, createOutput, counter.issues, counter.wikis, counter.attachments, counter.fetched)
+	return nil
+}
+
+// fetchSettings はプロジェクトの設定一式を取得する
+func fetchSettings(ctx context.Context, client *api.Client, projectKey string) (*backup.Settings, error) {
+	var s backup.Settings
+	var err error
+	if s.IssueTypes, err = client.GetIssueTypes(ctx, projectKey); err != nil {
+		return nil, fmt.Errorf("failed to get issue types: %w", err)
+	}
+	if s.Categories, err = client.GetCategories(ctx, projectKey); err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	if s.Versions, err = client.GetVersions(ctx, projectKey); err != nil {
+		return nil, fmt.Errorf("failed to get milestones: %w", err)
+	}
+	if s.Statuses, err = client.GetStatuses(ctx, projectKey); err != nil {
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+	if s.CustomFields, err = client.GetCustomFields(ctx, projectKey); err != nil {
+		return nil, fmt.Errorf("failed to get custom fields: %w", err)
+	}
+	if s.Members, err = client.GetProjectUsers(ctx, projectKey); err != nil {
+		return nil, fmt.Errorf("failed to get project members: %w", err)
+	}
+	return &s, nil
+}
+
+// backupIssues はプロジェクトの全課題を保存する。
+// 保存済みで更新日時が変わっていない課題は取得し直さない
+func backupIssues(ctx context.Context, client *api.Client, dir *backup.Dir, projectID int, counter *backupCounter) error {
+	const batchSize = 100
+	// 作成日時の昇順で取得し、バックアップ中の更新でページがずれないようにする
+	opts := &api.IssueListOptions{ProjectIDs: []int{projectID}, Sort: "created", Order: "asc", Count: batchSize}
+	for {
+		issues, err := client.GetIssues(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("failed to get issues: %w", err)
+		}
+		for i := range issues {
+			if err := backupIssue(ctx, client, dir, &issues[i], counter); err != nil {
+				return err
+			}
+		}
+		if len(issues) < batchSize {
+			return nil
+		}
+		opts.Offset += len(issues)
+	}
+}
+
+func backupIssue(ctx context.Context, client *api.Client, dir *backup.Dir, issue *backlog.Issue, counter *backupCounter) error {
+	key := issue.IssueKey.Value
+	counter.issues++
+	counter.attachments += len(issue.Attachments)
+	saved, err := dir.ReadIssue(key)
+	if err != nil {
+		return err
+	}
+	if saved != nil && saved.Issue != nil && saved.Issue.Updated.Value == issue.Updated.Value {
+		cmdutil.Verbosef("unchanged: %s", key)
+		return nil
+	}
+
+	// 添付を先に保存し、課題ファイルの存在を保存完了の目印にする
+	for _, a := range issue.Attachments {
+		path := dir.IssueAttachmentPath(key, a.ID.Value, a.Name.Value)
+		if backup.HasFile(path, int64(a.Size.Value)) {
+			continue
+		}
+		err := backup.SaveStream(path, func(w io.Writer) error {
+			_, _, err := client.DownloadIssueAttachment(ctx, key, a.ID.Value, w)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to download %s of %s: %w", a.Name.Value, key, err)
+		}
+	}
+	comments, err := fetchAllComments(ctx, client, key)
+	if err != nil {
+		return fmt.Errorf("failed to get comments of %s: %w", key, err)
+	}
+	if err := dir.WriteIssue(&backup.IssueRecord{Issue: issue, Comments: comments}); err != nil {
+		return err
+	}
+	counter.fetched++
+	cmdutil.Progressf("[%d] %s", counter.issues, key)
+	return nil
+}
+
+// fetchAllComments は課題のコメントを古い順にすべて取得する
+func fetchAllComments(ctx context.Context, client *api.Client, issueKey string) ([]api.Comment, error) {
+	all := make([]api.Comment, 0)
+	minID := 0
+	for {
+		comments, err := client.GetComments(ctx, issueKey, &api.CommentListOptions{
+			MinID: minID,
+			Count: 100,
+			Order: "asc",
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, comments...)
+		if len(comments) < 100 {
+			return all, nil
+		}
+		minID = comments[len(comments)-1].ID + 1
+	}
+}
+
+// backupWikis はプロジェクトの全 Wiki ページを保存する。
+// 保存済みで更新日時が変わっていないページは取得し直さない
+func backupWikis(ctx context.Context, client *api.Client, dir *backup.Dir, projectKey string, counter *backupCounter) error {
+	wikis, err := client.GetWikis(ctx, projectKey, "")
+	if err != nil {
+		return fmt.Errorf("failed to get wiki pages: %w", err)
+	}
+	unchanged := make(map[int]*backup.WikiRecord)
+	for _, w := range wikis {
+		saved, err := dir.ReadWiki(w.ID)
+		if err != nil {
+			return err
+		}
+		if saved != nil && saved.Wiki != nil && saved.Wiki.Updated == w.Updated {
+			unchanged[w.ID] = saved
+		}
+	}
+
+	// 一覧には本文が含まれないため、変更のあったページだけ並列に取得する
+	wikis, err = client.FetchWikiContents(ctx, wikis, api.WikiContentOptions{
+		Need: func(w api.Wiki) bool { return unchanged[w.ID] == nil },
+	})
+	if err != nil {
+		return err
+	}
+	for i := range wikis {
+		wiki := &wikis[i]
+		counter.wikis++
+		if saved := unchanged[wiki.ID]; saved != nil {
+			counter.attachments += len(saved.Wiki.Attachments)
+			cmdutil.Verbosef("unchanged: wiki %s", wiki.Name)
+			continue
+		}
+
+		for _, a := range wiki.Attachments {
+			path := dir.WikiAttachmentPath(wiki.ID, a.ID, a.Name)
+			if backup.HasFile(path, a.Size) {
+				continue
+			}
+			err := backup.SaveStream(path, func(out io.Writer) error {
+				_, _, err := client.DownloadWikiAttachment(ctx, wiki.ID, a.ID, out)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to download %s of wiki %s: %w", a.Name, wiki.Name, err)
+			}
+		}
+		counter.attachments += len(wiki.Attachments)
+		if err := dir.WriteWiki(&backup.WikiRecord{Wiki: wiki}); err != nil {
+			return err
+		}
+		counter.fetched++
+		cmdutil.Progressf("[wiki %d] %s", counter.wikis, wiki.Name)
+	}
+	return nil
+}
@@ -0,0 +1,563 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/backup"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <dir>",
+	Short: "Restore a project snapshot to another space or project",
+	Long: `Restore a backup created by 'backlog backup create' into an existing project.
+The target is the current project (--project) in the current space (--space
+or --profile); it must differ from the backed-up project.
+
+Issue types, categories and milestones missing in the target are created by
+name. Issues are created in the original order with their attachments,
+parent issue, status and comments. Comments are posted by the current user
+with a line naming the original author and date. Custom field values,
+stars and issue/comment authors cannot be restored.
+
+Progress is recorded in restore-state.json in the backup directory, so an
+interrupted restore continues where it stopped when run again.
+
+Examples:
+  # Show what would be created
+  backlog backup restore proj-backup/ --space new-space --project PROJ --dry-run
+
+  backlog backup restore proj-backup/ --space new-space --project PROJ`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRestore,
+}
+
+var restoreDryRun bool
+
+func init() {
+	restoreCmd.Flags().BoolVar(&restoreDryRun, "dry-run", false, "Show what would be restored without making changes")
+}
+
+// defaultPriorityID は優先度を対応付けられなかった場合に使う「中」の ID（全スペース共通）
+const defaultPriorityID = 3
+
+// openStatusID は課題作成時のステータス「未対応」の ID（全プロジェクト共通）
+const openStatusID = 1
+
+// restoreMaps はリストア先の設定の名前から ID への対応
+type restoreMaps struct {
+	issueTypes  map[string]int
+	categories  map[string]int
+	versions    map[string]int
+	statuses    map[string]int
+	priorities  map[string]int
+	resolutions map[string]int
+	// users はリストア先プロジェクトのメンバーのユーザー ID（userId）から ID への対応
+	users map[string]int
+	// defaultIssueType は種別を対応付けられなかった場合に使う種別
+	defaultIssueType int
+}
+
+// restorePlan はリストアで作成するもの
+type restorePlan struct {
+	issueTypes []api.IssueType
+	categories []api.Category
+	versions   []api.Version
+	issues     []*backup.IssueRecord
+	wikis      []*backup.WikiRecord
+	// missingStatuses はリストア先に無いステータス（課題は既定のステータスのまま）
+	missingStatuses []string
+}
+
+func runRestore(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	ctx := c.Context()
+	dir := backup.Open(args[0])
+	manifest, err := dir.ReadManifest()
+	if err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+	if !manifest.Complete {
+		ui.Warning("the backup in %s did not finish; run 'backlog backup create --incremental' to complete it", args[0])
+	}
+	settings, err := dir.ReadSettings()
+	if err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+	issues, err := dir.Issues()
+	if err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+	wikis, err := dir.Wikis()
+	if err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+
+	space := cfg.CurrentProfile().Space
+	project, err := client.GetProject(ctx, cmdutil.GetCurrentProject(cfg))
+	if err != nil {
+		return fmt.Errorf("failed to get target project: %w", err)
+	}
+	if space == manifest.Space && project.ProjectKey == manifest.ProjectKey {
+		return fmt.Errorf("cannot restore %s into itself; choose another project or space", manifest.ProjectKey)
+	}
+	target := space + "/" + project.ProjectKey
+	state, err := dir.ReadRestoreState(target)
+	if err != nil {
+		return err
+	}
+
+	current, err := fetchSettings(ctx, client, project.ProjectKey)
+	if err != nil {
+		return err
+	}
+	plan := newRestorePlan(settings, current, issues, wikis, state)
+	printRestorePlan(target, manifest, plan, len(settings.CustomFields) > 0)
+	if restoreDryRun || (len(plan.issueTypes)+len(plan.categories)+len(plan.versions)+len(plan.issues)+len(plan.wikis)) == 0 {
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog backup restore",
+				"Use --yes to skip the confirmation prompt, or --dry-run to preview.",
+			)
+		}
+		ok, err := ui.Confirm(fmt.Sprintf("Restore into %s?", target), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	r := &restorer{ctx: ctx, client: client, dir: dir, state: state, project: project, issueKeys: make(map[int]string, len(issues))}
+	for _, rec := range issues {
+		r.issueKeys[rec.Issue.ID.Value] = rec.Issue.IssueKey.Value
+	}
+	if err := r.createSettings(plan); err != nil {
+		return err
+	}
+	if err := r.loadMaps(); err != nil {
+		return err
+	}
+	for _, rec := range plan.issues {
+		if err := r.restoreIssue(rec); err != nil {
+			return err
+		}
+	}
+	existingWikis, err := client.GetWikis(ctx, project.ProjectKey, "")
+	if err != nil && len(plan.wikis) > 0 {
+		return fmt.Errorf("failed to get wiki pages: %w", err)
+	}
+	wikiNames := make(map[string]bool, len(existingWikis))
+	for _, w := range existingWikis {
+		wikiNames[w.Name] = true
+	}
+	for _, rec := range plan.wikis {
+		if err := r.restoreWiki(rec, wikiNames); err != nil {
+			return err
+		}
+	}
+
+	cmdutil.Success(target, "Restored %d issues and %d wiki pages to %s", len(plan.issues), len(plan.wikis), target)
+	return nil
+}
+
+// newRestorePlan はバックアップとリストア先の設定を比べ、作成が必要なものを求める
+func newRestorePlan(settings, current *backup.Settings, issues []*backup.IssueRecord, wikis []*backup.WikiRecord, state *backup.RestoreState) *restorePlan {
+	plan := &restorePlan{}
+	have := make(map[string]bool)
+	for _, t := range current.IssueTypes {
+		have[t.Name] = true
+	}
+	for _, t := range settings.IssueTypes {
+		if !have[t.Name] {
+			plan.issueTypes = append(plan.issueTypes, t)
+		}
+	}
+	clear(have)
+	for _, cat := range current.Categories {
+		have[cat.Name] = true
+	}
+	for _, cat := range settings.Categories {
+		if !have[cat.Name] {
+			plan.categories = append(plan.categories, cat)
+		}
+	}
+	clear(have)
+	for _, v := range current.Versions {
+		have[v.Name] = true
+	}
+	for _, v := range settings.Versions {
+		if !have[v.Name] {
+			plan.versions = append(plan.versions, v)
+		}
+	}
+	clear(have)
+	for _, s := range current.Statuses {
+		have[s.Name] = true
+	}
+	for _, s := range settings.Statuses {
+		if !have[s.Name] {
+			plan.missingStatuses = append(plan.missingStatuses, s.Name)
+		}
+	}
+	for _, rec := range issues {
+		if done, ok := state.Issues[rec.Issue.IssueKey.Value]; !ok || !done.Complete {
+			plan.issues = append(plan.issues, rec)
+		}
+	}
+	for _, rec := range wikis {
+		if _, ok := state.Wikis[strconv.Itoa(rec.Wiki.ID)]; !ok {
+			plan.wikis = append(plan.wikis, rec)
+		}
+	}
+	return plan
+}
+
+func printRestorePlan(target string, manifest *backup.Manifest, plan *restorePlan, hasCustomFields bool) {
+	w := os.Stderr
+	_, _ = fmt.Fprintf(w, "Restore %s/%s → %s\n", manifest.Space, manifest.ProjectKey, target)
+	names := func(n int, name func(i int) string) string {
+		parts := make([]string, n)
+		for i := range parts {
+			parts[i] = name(i)
+		}
+		return strings.Join(parts, ", ")
+	}
+	if len(plan.issueTypes) > 0 {
+		_, _ = fmt.Fprintf(w, "  Issue types to create: %s\n", names(len(plan.issueTypes), func(i int) string { return plan.issueTypes[i].Name }))
+	}
+	if len(plan.categories) > 0 {
+		_, _ = fmt.Fprintf(w, "  Categories to create:  %s\n", names(len(plan.categories), func(i int) string { return plan.categories[i].Name }))
+	}
+	if len(plan.versions) > 0 {
+		_, _ = fmt.Fprintf(w, "  Milestones to create:  %s\n", names(len(plan.versions), func(i int) string { return plan.versions[i].Name }))
+	}
+	_, _ = fmt.Fprintf(w, "  Issues to restore:     %d\n", len(plan.issues))
+	_, _ = fmt.Fprintf(w, "  Wiki pages to restore: %d\n", len(plan.wikis))
+	if len(plan.missingStatuses) > 0 {
+		ui.Warning("statuses not found in the target (issues keep the default status): %s", strings.Join(plan.missingStatuses, ", "))
+	}
+	if hasCustomFields {
+		ui.Warning("custom field values are not restored")
+	}
+}
+
+// restorer はリストア先への書き込みと進捗の記録を行う
+type restorer struct {
+	ctx     context.Context
+	client  *api.Client
+	dir     *backup.Dir
+	state   *backup.RestoreState
+	project *api.Project
+	maps    *restoreMaps
+	// issueKeys はバックアップ元の課題 ID から課題キーへの対応（親課題の解決用）
+	issueKeys map[int]string
+}
+
+// createSettings はリストア先に無い種別・カテゴリー・マイルストーンを作成する
+func (r *restorer) createSettings(plan *restorePlan) error {
+	key := r.project.ProjectKey
+	for _, t := range plan.issueTypes {
+		if _, err := r.client.CreateIssueType(r.ctx, key, &api.CreateIssueTypeInput{
+			Name:                t.Name,
+			Color:               t.Color,
+			TemplateSummary:     t.TemplateSummary,
+			TemplateDescription: t.TemplateDescription,
+		}); err != nil {
+			return fmt.Errorf("failed to create issue type %s: %w", t.Name, err)
+		}
+	}
+	for _, cat := range plan.categories {
+		if _, err := r.client.CreateCategory(r.ctx, key, cat.Name); err != nil {
+			return fmt.Errorf("failed to create category %s: %w", cat.Name, err)
+		}
+	}
+	for _, v := range plan.versions {
+		created, err := r.client.CreateVersion(r.ctx, key, &api.CreateVersionInput{
+			Name:           v.Name,
+			Description:    v.Description,
+			StartDate:      dateOnly(v.StartDate),
+			ReleaseDueDate: dateOnly(v.ReleaseDueDate),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create milestone %s: %w", v.Name, err)
+		}
+		if v.Archived {
+			archived := true
+			if _, err := r.client.UpdateVersion(r.ctx, key, created.ID, &api.UpdateVersionInput{Name: v.Name, Archived: &archived}); err != nil {
+				return fmt.Errorf("failed to archive milestone %s: %w", v.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// loadMaps はリストア先の設定を取得し、名前から ID への対応を作る
+func (r *restorer) loadMaps() error {
+	current, err := fetchSettings(r.ctx, r.client, r.project.ProjectKey)
+	if err != nil {
+		return err
+	}
+	m := &restoreMaps{
+		issueTypes:  make(map[string]int),
+		categories:  make(map[string]int),
+		versions:    make(map[string]int),
+		statuses:    make(map[string]int),
+		priorities:  make(map[string]int),
+		resolutions: make(map[string]int),
+		users:       make(map[string]int),
+	}
+	for _, t := range current.IssueTypes {
+		m.issueTypes[t.Name] = t.ID
+		if m.defaultIssueType == 0 {
+			m.defaultIssueType = t.ID
+		}
+	}
+	for _, cat := range current.Categories {
+		m.categories[cat.Name] = cat.ID
+	}
+	for _, v := range current.Versions {
+		m.versions[v.Name] = v.ID
+	}
+	for _, s := range current.Statuses {
+		m.statuses[s.Name] = s.ID
+	}
+	for _, u := range current.Members {
+		if u.UserID != "" {
+			m.users[u.UserID] = u.ID
+		}
+	}
+	priorities, err := r.client.GetPriorities(r.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get priorities: %w", err)
+	}
+	for _, p := range priorities {
+		m.priorities[p.Name.Value] = p.ID.Value
+	}
+	resolutions, err := r.client.GetResolutions(r.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get resolutions: %w", err)
+	}
+	for _, res := range resolutions {
+		m.resolutions[res.Name.Value] = res.ID.Value
+	}
+	r.maps = m
+	return nil
+}
+
+// restoreIssue は課題を作成し、ステータスとコメントを反映する。
+// 作成済みの課題は記録されたコメント数の続きから再開する
+func (r *restorer) restoreIssue(rec *backup.IssueRecord) error {
+	issue := rec.Issue
+	oldKey := issue.IssueKey.Value
+	restored, ok := r.state.Issues[oldKey]
+	if !ok {
+		var attachmentIDs []int
+		for _, a := range issue.Attachments {
+			id, err := r.uploadFile(r.dir.IssueAttachmentPath(oldKey, a.ID.Value, a.Name.Value), a.Name.Value)
+			if err != nil {
+				return fmt.Errorf("failed to upload %s of %s: %w", a.Name.Value, oldKey, err)
+			}
+			if id > 0 {
+				attachmentIDs = append(attachmentIDs, id)
+			}
+		}
+		parentID := 0
+		if issue.ParentIssueId.IsSet() && !issue.ParentIssueId.Null {
+			if parent, ok := r.state.Issues[r.issueKeys[issue.ParentIssueId.Value]]; ok {
+				parentID = parent.ID
+			}
+		}
+		created, err := r.client.CreateIssue(r.ctx, newIssueInput(rec, r.project.ID, r.maps, parentID, attachmentIDs))
+		if err != nil {
+			return fmt.Errorf("failed to restore %s: %w", oldKey, err)
+		}
+		restored = backup.RestoredIssue{ID: created.ID.Value, Key: created.IssueKey.Value}
+		if err := r.saveIssueState(oldKey, restored); err != nil {
+			return err
+		}
+	}
+
+	for i := restored.Comments; i < len(rec.Comments); i++ {
+		comment := rec.Comments[i]
+		if strings.TrimSpace(comment.Content) != "" {
+			if _, err := r.client.AddComment(r.ctx, restored.Key, commentWithAttribution(comment), nil, nil); err != nil {
+				return fmt.Errorf("failed to restore a comment of %s: %w", oldKey, err)
+			}
+		}
+		restored.Comments = i + 1
+		if err := r.saveIssueState(oldKey, restored); err != nil {
+			return err
+		}
+	}
+
+	if update := newStatusUpdate(rec, r.maps); update != nil {
+		if _, err := r.client.UpdateIssue(r.ctx, restored.Key, update); err != nil {
+			return fmt.Errorf("failed to restore the status of %s: %w", oldKey, err)
+		}
+	}
+	restored.Complete = true
+	if err := r.saveIssueState(oldKey, restored); err != nil {
+		return err
+	}
+	cmdutil.Progressf("%s → %s", oldKey, restored.Key)
+	return nil
+}
+
+func (r *restorer) saveIssueState(oldKey string, restored backup.RestoredIssue) error {
+	r.state.Issues[oldKey] = restored
+	return r.dir.WriteRestoreState(r.state)
+}
+
+// restoreWiki は Wiki ページを作成して添付ファイルを付ける。
+// 同名のページがリストア先にある場合は上書きせずにスキップする
+func (r *restorer) restoreWiki(rec *backup.WikiRecord, existing map[string]bool) error {
+	wiki := rec.Wiki
+	if existing[wiki.Name] {
+		ui.Warning("skipped wiki %q: a page with the same name already exists", wiki.Name)
+		return nil
+	}
+	created, err := r.client.CreateWiki(r.ctx, &api.CreateWikiInput{
+		ProjectID: r.project.ID,
+		Name:      wiki.Name,
+		Content:   wiki.Content,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore wiki %s: %w", wiki.Name, err)
+	}
+	for _, a := range wiki.Attachments {
+		path := r.dir.WikiAttachmentPath(wiki.ID, a.ID, a.Name)
+		f, err := os.Open(path)
+		if err != nil {
+			ui.Warning("skipped %s of wiki %s: %v", a.Name, wiki.Name, err)
+			continue
+		}
+		_, err = r.client.UploadWikiAttachment(r.ctx, created.ID, a.Name, f)
+		_ = f.Close()
+		if err != nil {
+			return fmt.Errorf("failed to upload %s of wiki %s: %w", a.Name, wiki.Name, err)
+		}
+	}
+	r.state.Wikis[strconv.Itoa(wiki.ID)] = created.ID
+	if err := r.dir.WriteRestoreState(r.state); err != nil {
+		return err
+	}
+	cmdutil.Progressf("wiki %s", wiki.Name)
+	return nil
+}
+
+// uploadFile は保存済みの添付ファイルをアップロードする。ファイルが無い場合は警告して 0 を返す
+func (r *restorer) uploadFile(path, name string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		ui.Warning("skipped %s: %v", name, err)
+		return 0, nil
+	}
+	defer func() { _ = f.Close() }()
+	up, err := r.client.UploadSpaceAttachment(r.ctx, name, f)
+	if err != nil {
+		return 0, err
+	}
+	return up.ID, nil
+}
+
+// newIssueInput はバックアップした課題から作成の入力を作る。
+// リストア先に無い種別・優先度は既定値にし、カテゴリー等と担当者は対応付けられたものだけ設定する
+func newIssueInput(rec *backup.IssueRecord, projectID int, m *restoreMaps, parentID int, attachmentIDs []int) *api.CreateIssueInput {
+	issue := rec.Issue
+	input := &api.CreateIssueInput{
+		ProjectID:     projectID,
+		Summary:       issue.Summary.Value,
+		Description:   issue.Description.Value,
+		IssueTypeID:   m.defaultIssueType,
+		PriorityID:    defaultPriorityID,
+		ParentIssueID: parentID,
+		AttachmentIDs: attachmentIDs,
+	}
+	if id, ok := m.issueTypes[issue.IssueType.Value.Name.Value]; ok {
+		input.IssueTypeID = id
+	}
+	if id, ok := m.priorities[issue.Priority.Value.Name.Value]; ok {
+		input.PriorityID = id
+	}
+	if !issue.StartDate.Null {
+		input.StartDate = dateOnly(issue.StartDate.Value)
+	}
+	if !issue.DueDate.Null {
+		input.DueDate = dateOnly(issue.DueDate.Value)
+	}
+	if !issue.EstimatedHours.Null {
+		input.EstimatedHours = issue.EstimatedHours.Value
+	}
+	if !issue.ActualHours.Null {
+		input.ActualHours = issue.ActualHours.Value
+	}
+	if !issue.Assignee.Null {
+		input.AssigneeID = m.users[issue.Assignee.Value.UserId.Value]
+	}
+	for _, cat := range issue.Category {
+		if id, ok := m.categories[cat.Name.Value]; ok {
+			input.CategoryIDs = append(input.CategoryIDs, id)
+		}
+	}
+	for _, v := range issue.Versions {
+		if id, ok := m.versions[v.Name.Value]; ok {
+			input.VersionIDs = append(input.VersionIDs, id)
+		}
+	}
+	for _, v := range issue.Milestone {
+		if id, ok := m.versions[v.Name.Value]; ok {
+			input.MilestoneIDs = append(input.MilestoneIDs, id)
+		}
+	}
+	return input
+}
+
+// newStatusUpdate は作成後に反映するステータスと完了理由を返す。
+// 未対応（課題作成時のステータス）のまま、または対応付けられない場合は nil を返す
+func newStatusUpdate(rec *backup.IssueRecord, m *restoreMaps) *api.UpdateIssueInput {
+	status := rec.Issue.Status.Value
+	id, ok := m.statuses[status.Name.Value]
+	if !ok || status.ID.Value == openStatusID {
+		return nil
+	}
+	update := &api.UpdateIssueInput{StatusID: &id}
+	if res := rec.Issue.Resolution; res.IsSet() && !res.Null {
+		if resID, ok := m.resolutions[res.Value.Name.Value]; ok {
+			update.ResolutionID = &resID
+		}
+	}
+	return update
+}
+
+// commentWithAttribution は元の投稿者と日時を先頭に付けたコメント本文を返す
+func commentWithAttribution(c api.Comment) string {
+	return fmt.Sprintf("(%s, %s)\n\n%s", c.CreatedUser.Name, c.Created, c.Content)
+}
+
+// dateOnly は API の日時（2024-01-02T00:00:00Z）を YYYY-MM-DD にする
+func dateOnly(s string) string {
+	if len(s) > 10 {
+		return s[:10]
+	}
+	return s
+}
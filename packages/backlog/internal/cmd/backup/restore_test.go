@@ -0,0 +1,136 @@
+package backup
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/backup"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func testRestoreMaps() *restoreMaps {
+	return &restoreMaps{
+		issueTypes:       map[string]int{"Bug": 11, "Task": 12},
+		categories:       map[string]int{"API": 21},
+		versions:         map[string]int{"v1": 31},
+		statuses:         map[string]int{"未対応": 1, "処理中": 2, "完了": 4},
+		priorities:       map[string]int{"高": 2, "中": 3},
+		resolutions:      map[string]int{"対応済み": 0},
+		users:            map[string]int{"alice": 41},
+		defaultIssueType: 12,
+	}
+}
+
+func TestNewIssueInput(t *testing.T) {
+	issue := &backlog.Issue{
+		IssueKey:    backlog.NewOptString("PROJ-1"),
+		Summary:     backlog.NewOptString("Login fails"),
+		Description: backlog.NewOptString("details"),
+		IssueType:   backlog.NewOptIssueType(backlog.IssueType{Name: backlog.NewOptString("Bug")}),
+		Priority:    backlog.NewOptPriority(backlog.Priority{Name: backlog.NewOptString("高")}),
+		Assignee:    backlog.NewOptNilUser(backlog.User{UserId: backlog.NewOptString("alice")}),
+		Category: []backlog.Category{
+			{Name: backlog.NewOptString("API")},
+			{Name: backlog.NewOptString("Missing")},
+		},
+		Milestone: []backlog.Version{{Name: backlog.NewOptString("v1")}},
+		DueDate:   backlog.NewOptNilString("2026-03-31T00:00:00Z"),
+	}
+	got := newIssueInput(&backup.IssueRecord{Issue: issue}, 100, testRestoreMaps(), 7, []int{9})
+	want := &api.CreateIssueInput{
+		ProjectID:     100,
+		Summary:       "Login fails",
+		Description:   "details",
+		IssueTypeID:   11,
+		PriorityID:    2,
+		DueDate:       "2026-03-31",
+		AssigneeID:    41,
+		CategoryIDs:   []int{21},
+		MilestoneIDs:  []int{31},
+		ParentIssueID: 7,
+		AttachmentIDs: []int{9},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newIssueInput() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestNewIssueInputDefaults(t *testing.T) {
+	issue := &backlog.Issue{
+		Summary:   backlog.NewOptString("s"),
+		IssueType: backlog.NewOptIssueType(backlog.IssueType{Name: backlog.NewOptString("Unknown")}),
+		Assignee:  backlog.NewOptNilUser(backlog.User{UserId: backlog.NewOptString("bob")}),
+	}
+	got := newIssueInput(&backup.IssueRecord{Issue: issue}, 100, testRestoreMaps(), 0, nil)
+	if got.IssueTypeID != 12 || got.PriorityID != defaultPriorityID || got.AssigneeID != 0 {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+}
+
+func TestNewStatusUpdate(t *testing.T) {
+	record := func(id int, name, resolution string) *backup.IssueRecord {
+		issue := &backlog.Issue{Status: backlog.NewOptStatus(backlog.Status{ID: backlog.NewOptInt(id), Name: backlog.NewOptString(name)})}
+		if resolution != "" {
+			issue.Resolution = backlog.NewOptNilResolution(backlog.Resolution{Name: backlog.NewOptString(resolution)})
+		}
+		return &backup.IssueRecord{Issue: issue}
+	}
+	m := testRestoreMaps()
+
+	if u := newStatusUpdate(record(1, "未対応", ""), m); u != nil {
+		t.Errorf("open issue should not be updated: %+v", u)
+	}
+	if u := newStatusUpdate(record(99, "レビュー中", ""), m); u != nil {
+		t.Errorf("unknown status should not be updated: %+v", u)
+	}
+	u := newStatusUpdate(record(4, "完了", "対応済み"), m)
+	if u == nil || *u.StatusID != 4 || u.ResolutionID == nil || *u.ResolutionID != 0 {
+		t.Errorf("unexpected update: %+v", u)
+	}
+}
+
+func TestNewRestorePlan(t *testing.T) {
+	settings := &backup.Settings{
+		IssueTypes: []api.IssueType{{Name: "Bug"}, {Name: "Spike"}},
+		Categories: []api.Category{{Name: "API"}},
+		Versions:   []api.Version{{Name: "v1"}, {Name: "v2"}},
+		Statuses:   []api.Status{{Name: "未対応"}, {Name: "レビュー中"}},
+	}
+	current := &backup.Settings{
+		IssueTypes: []api.IssueType{{Name: "Bug"}},
+		Versions:   []api.Version{{Name: "v1"}},
+		Statuses:   []api.Status{{Name: "未対応"}},
+	}
+	issues := []*backup.IssueRecord{
+		{Issue: &backlog.Issue{IssueKey: backlog.NewOptString("PROJ-1")}},
+		{Issue: &backlog.Issue{IssueKey: backlog.NewOptString("PROJ-2")}},
+		{Issue: &backlog.Issue{IssueKey: backlog.NewOptString("PROJ-3")}},
+	}
+	wikis := []*backup.WikiRecord{{Wiki: &api.Wiki{ID: 5}}, {Wiki: &api.Wiki{ID: 6}}}
+	state := &backup.RestoreState{
+		Issues: map[string]backup.RestoredIssue{
+			"PROJ-1": {Key: "NEW-1", Complete: true},
+			"PROJ-2": {Key: "NEW-2", Comments: 1},
+		},
+		Wikis: map[string]int{"5": 50},
+	}
+
+	plan := newRestorePlan(settings, current, issues, wikis, state)
+	if len(plan.issueTypes) != 1 || plan.issueTypes[0].Name != "Spike" {
+		t.Errorf("issueTypes = %+v", plan.issueTypes)
+	}
+	if len(plan.categories) != 1 || len(plan.versions) != 1 || plan.versions[0].Name != "v2" {
+		t.Errorf("categories = %+v, versions = %+v", plan.categories, plan.versions)
+	}
+	if !reflect.DeepEqual(plan.missingStatuses, []string{"レビュー中"}) {
+		t.Errorf("missingStatuses = %v", plan.missingStatuses)
+	}
+	// 作成途中（PROJ-2）は再開の対象に含める
+	if len(plan.issues) != 2 || plan.issues[0].Issue.IssueKey.Value != "PROJ-2" {
+		t.Errorf("issues = %d", len(plan.issues))
+	}
+	if len(plan.wikis) != 1 || plan.wikis[0].Wiki.ID != 6 {
+		t.Errorf("wikis = %+v", plan.wikis)
+	}
+}
@@ -0,0 +1,194 @@
+package blame
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var BlameCmd = &cobra.Command{
+	Use:   "blame <file>",
+	Short: "Annotate lines of a file with the Backlog issues of their commits",
+	Long: `Run git blame on a file and annotate each line with the Backlog issues
+referenced in the commit message that last changed it (summary and status).
+
+Issue keys are taken from the whole commit message and matched against the
+project keys of the space, so that strings like "UTF-8" are not treated as
+issues. Lines of the same commit are annotated only on the first line of
+each run.
+
+Examples:
+  backlog blame path/to/file.go
+  backlog blame path/to/file.go -L 120,160
+  backlog blame path/to/file.go -L 120,+20 --rev v1.2.0
+  backlog blame path/to/file.go -L :funcName -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBlame,
+}
+
+var (
+	blameRanges []string
+	blameRev    string
+)
+
+func init() {
+	BlameCmd.Flags().StringArrayVarP(&blameRanges, "lines", "L", nil, "Line range passed to git blame -L (e.g. 120,160 or :funcName; repeatable)")
+	BlameCmd.Flags().StringVar(&blameRev, "rev", "", "Blame the file as of this revision")
+}
+
+// IssueRef は行に関連付けた課題
+type IssueRef struct {
+	Key     string `json:"issueKey"`
+	Summary string `json:"summary,omitempty"`
+	Status  string `json:"status,omitempty"`
+	URL     string `json:"url,omitempty"`
+	// Error は課題を取得できなかった場合の理由（削除済み・権限なしなど）
+	Error string `json:"error,omitempty"`
+}
+
+// AnnotatedLine は課題で注釈した git blame の1行
+type AnnotatedLine struct {
+	Line    int        `json:"line"`
+	Commit  string     `json:"commit"`
+	Author  string     `json:"author"`
+	Content string     `json:"content"`
+	Issues  []IssueRef `json:"issues"`
+}
+
+func runBlame(c *cobra.Command, args []string) error {
+	lines, err := runGitBlame(args[0], blameRanges, blameRev)
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	ctx := c.Context()
+	profile := cfg.CurrentProfile()
+
+	projects, err := client.GetProjects(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %w", err)
+	}
+	projectKeys := make(map[string]bool, len(projects))
+	for _, p := range projects {
+		projectKeys[p.ProjectKey] = true
+	}
+
+	var commits []string
+	seenCommit := make(map[string]bool)
+	for _, l := range lines {
+		if l.Commit != uncommittedSHA && !seenCommit[l.Commit] {
+			seenCommit[l.Commit] = true
+			commits = append(commits, l.Commit)
+		}
+	}
+	messages, err := commitMessages(commits)
+	if err != nil {
+		return err
+	}
+
+	keysByCommit := make(map[string][]string, len(commits))
+	for _, commit := range commits {
+		keysByCommit[commit] = extractIssueKeys(messages[commit], projectKeys)
+	}
+	issues := fetchIssues(ctx, client, profile.Space, keysByCommit)
+
+	annotated := annotate(lines, keysByCommit, issues)
+	if profile.Output == "json" {
+		return cmdutil.OutputJSONFromProfile(annotated, profile.JSONFields, profile.JQ, profile.Template)
+	}
+	renderTable(annotated)
+	return nil
+}
+
+// fetchIssues はコミットから抽出した課題を重複なく取得する
+// 取得に失敗した課題はエラー理由を記録し、他の行の表示は続ける
+func fetchIssues(ctx context.Context, client *api.Client, spaceHost string, keysByCommit map[string][]string) map[string]IssueRef {
+	issues := make(map[string]IssueRef)
+	for _, keys := range keysByCommit {
+		for _, key := range keys {
+			if _, ok := issues[key]; ok {
+				continue
+			}
+			issue, err := client.GetIssue(ctx, key)
+			if err != nil {
+				debug.Log("failed to get issue for blame", "issue", key, "error", err)
+				issues[key] = IssueRef{Key: key, Error: err.Error()}
+				continue
+			}
+			issues[key] = IssueRef{
+				Key:     key,
+				Summary: issue.Summary.Value,
+				Status:  issue.Status.Value.Name.Value,
+				URL:     fmt.Sprintf("https://%s/view/%s", spaceHost, key),
+			}
+		}
+	}
+	return issues
+}
+
+// annotate は git blame の各行に課題を関連付ける
+func annotate(lines []blameLine, keysByCommit map[string][]string, issues map[string]IssueRef) []AnnotatedLine {
+	result := make([]AnnotatedLine, 0, len(lines))
+	for _, l := range lines {
+		refs := make([]IssueRef, 0, len(keysByCommit[l.Commit]))
+		for _, key := range keysByCommit[l.Commit] {
+			refs = append(refs, issues[key])
+		}
+		result = append(result, AnnotatedLine{
+			Line:    l.Line,
+			Commit:  l.Commit,
+			Author:  l.Author,
+			Content: l.Content,
+			Issues:  refs,
+		})
+	}
+	return result
+}
+
+// renderTable は行ごとの注釈をテーブルで表示する
+// 同じコミットが続く行は先頭行にだけ注釈を付ける
+func renderTable(lines []AnnotatedLine) {
+	table := ui.NewTable("LINE", "COMMIT", "ISSUE", "STATUS", "SUMMARY", "CODE")
+	prevCommit := ""
+	for _, l := range lines {
+		code := strings.ReplaceAll(l.Content, "\t", "    ")
+		if l.Commit == prevCommit {
+			table.AddRow(strconv.Itoa(l.Line), "", "", "", "", code)
+			continue
+		}
+		prevCommit = l.Commit
+
+		commit := l.Commit[:8]
+		if l.Commit == uncommittedSHA {
+			commit = "(uncommitted)"
+		}
+		key, status, summary := "-", "", ""
+		if len(l.Issues) > 0 {
+			issue := l.Issues[0]
+			key = issue.Key
+			if len(l.Issues) > 1 {
+				key += fmt.Sprintf(" +%d", len(l.Issues)-1)
+			}
+			if issue.Error != "" {
+				summary = "(unavailable)"
+			} else {
+				status = ui.StatusColor(issue.Status)
+				summary = ui.Truncate(issue.Summary, 40)
+			}
+		}
+		table.AddRow(strconv.Itoa(l.Line), commit, key, status, summary, code)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+}
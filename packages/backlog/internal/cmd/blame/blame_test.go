@@ -0,0 +1,91 @@
+package blame
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const porcelain = `1111111111111111111111111111111111111111 10 120 2
+author Alice
+author-mail <alice@example.com>
+summary PROJ-1 fix login
+filename main.go
+	func login() {
+1111111111111111111111111111111111111111 11 121
+author Alice
+author-mail <alice@example.com>
+summary PROJ-1 fix login
+filename main.go
+		return nil
+0000000000000000000000000000000000000000 122 122 1
+author Not Committed Yet
+summary Version of main.go from main.go
+filename main.go
+	}
+`
+
+func TestParseBlamePorcelain(t *testing.T) {
+	lines, err := parseBlamePorcelain(strings.NewReader(porcelain))
+	if err != nil {
+		t.Fatalf("parseBlamePorcelain() error = %v", err)
+	}
+	want := []blameLine{
+		{Line: 120, Commit: strings.Repeat("1", 40), Author: "Alice", Summary: "PROJ-1 fix login", Content: "func login() {"},
+		{Line: 121, Commit: strings.Repeat("1", 40), Author: "Alice", Summary: "PROJ-1 fix login", Content: "\treturn nil"},
+		{Line: 122, Commit: uncommittedSHA, Author: "Not Committed Yet", Summary: "Version of main.go from main.go", Content: "}"},
+	}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("parseBlamePorcelain() = %+v, want %+v", lines, want)
+	}
+}
+
+func TestParseBlamePorcelainInvalid(t *testing.T) {
+	if _, err := parseBlamePorcelain(strings.NewReader("fatal: no such path\n")); err == nil {
+		t.Error("expected error for unexpected output")
+	}
+	truncated := strings.Join(strings.Split(porcelain, "\n")[:3], "\n")
+	if _, err := parseBlamePorcelain(strings.NewReader(truncated)); err == nil {
+		t.Error("expected error for truncated output")
+	}
+}
+
+func TestExtractIssueKeys(t *testing.T) {
+	projectKeys := map[string]bool{"PROJ": true, "OPS_2": true}
+	tests := []struct {
+		message string
+		want    []string
+	}{
+		{message: "PROJ-12 fix login", want: []string{"PROJ-12"}},
+		{message: "Fix UTF-8 handling\n\nRefs: PROJ-3, OPS_2-7, PROJ-3", want: []string{"PROJ-3", "OPS_2-7"}},
+		{message: "OTHER-1 unrelated", want: nil},
+		{message: "PROJ-0 is not a key", want: nil},
+	}
+	for _, tt := range tests {
+		if got := extractIssueKeys(tt.message, projectKeys); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("extractIssueKeys(%q) = %v, want %v", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	lines := []blameLine{
+		{Line: 1, Commit: "a", Content: "x"},
+		{Line: 2, Commit: "b", Content: "y"},
+	}
+	keys := map[string][]string{"a": {"PROJ-1", "PROJ-2"}}
+	issues := map[string]IssueRef{
+		"PROJ-1": {Key: "PROJ-1", Summary: "first"},
+		"PROJ-2": {Key: "PROJ-2", Error: "not found"},
+	}
+	got := annotate(lines, keys, issues)
+	if len(got) != 2 {
+		t.Fatalf("annotate() returned %d lines, want 2", len(got))
+	}
+	if want := []IssueRef{issues["PROJ-1"], issues["PROJ-2"]}; !reflect.DeepEqual(got[0].Issues, want) {
+		t.Errorf("line 1 issues = %+v, want %+v", got[0].Issues, want)
+	}
+	if got[1].Issues == nil || len(got[1].Issues) != 0 {
+		t.Errorf("line 2 issues = %#v, want empty slice", got[1].Issues)
+	}
+}
@@ -0,0 +1,142 @@
+package blame
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// blameLine は git blame の1行分の結果
+type blameLine struct {
+	Line    int
+	Commit  string
+	Author  string
+	Summary string
+	Content string
+}
+
+// uncommittedSHA は未コミットの行に git blame が割り当てるコミット ID
+const uncommittedSHA = "0000000000000000000000000000000000000000"
+
+var (
+	reBlameHeader = regexp.MustCompile(`^([0-9a-f]{40}) \d+ (\d+)(?: \d+)?$`)
+	reIssueKey    = regexp.MustCompile(`\b([A-Z][A-Z0-9_]*-[1-9][0-9]*)\b`)
+)
+
+// runGitBlame は git blame --line-porcelain を実行して行ごとの結果を返す
+func runGitBlame(path string, ranges []string, rev string) ([]blameLine, error) {
+	args := []string{"blame", "--line-porcelain"}
+	for _, r := range ranges {
+		args = append(args, "-L", r)
+	}
+	if rev != "" {
+		args = append(args, rev)
+	}
+	args = append(args, "--", path)
+
+	out, err := runGit(args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseBlamePorcelain(bytes.NewReader(out))
+}
+
+// parseBlamePorcelain は git blame --line-porcelain の出力を解析する
+func parseBlamePorcelain(r io.Reader) ([]blameLine, error) {
+	var lines []blameLine
+	var cur *blameLine
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if cur == nil {
+			m := reBlameHeader.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("unexpected git blame output: %q", text)
+			}
+			lineNo, _ := strconv.Atoi(m[2])
+			cur = &blameLine{Line: lineNo, Commit: m[1]}
+			continue
+		}
+		// 内容行はタブで始まり、1行分のエントリの終わりを示す
+		if strings.HasPrefix(text, "\t") {
+			cur.Content = text[1:]
+			lines = append(lines, *cur)
+			cur = nil
+			continue
+		}
+		key, value, _ := strings.Cut(text, " ")
+		switch key {
+		case "author":
+			cur.Author = value
+		case "summary":
+			cur.Summary = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("truncated git blame output")
+	}
+	return lines, nil
+}
+
+// commitMessages はコミットごとのメッセージ全文を取得する
+// 課題キーがサマリ行ではなく本文（trailer など）に書かれている場合も拾うため
+func commitMessages(commits []string) (map[string]string, error) {
+	messages := make(map[string]string, len(commits))
+	if len(commits) == 0 {
+		return messages, nil
+	}
+	args := append([]string{"show", "-s", "--format=%H%x00%B%x1e"}, commits...)
+	out, err := runGit(args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range strings.Split(string(out), "\x1e") {
+		sha, message, ok := strings.Cut(strings.TrimLeft(entry, "\n"), "\x00")
+		if !ok {
+			continue
+		}
+		messages[sha] = message
+	}
+	return messages, nil
+}
+
+// extractIssueKeys はコミットメッセージから課題キーを出現順に重複なく抽出する
+// projectKeys に含まれるプロジェクトの課題キーだけを対象にして "UTF-8" などの誤検知を避ける
+func extractIssueKeys(message string, projectKeys map[string]bool) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, m := range reIssueKey.FindAllStringSubmatch(message, -1) {
+		key := m[1]
+		prefix := key[:strings.LastIndex(key, "-")]
+		if !projectKeys[prefix] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func runGit(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return nil, fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return out, nil
+}
@@ -0,0 +1,271 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var ChangelogCmd = &cobra.Command{
+	Use:   "changelog",
+	Short: "Generate a changelog from the closed issues of a milestone",
+	Long: `Generate a CHANGELOG-style document from the closed issues of a milestone,
+grouped into sections by issue type (in the project's issue type order).
+
+Issues closed with a resolution other than "Fixed" (Won't Fix, Duplicate,
+Invalid, ...) are left out unless --all-resolutions is given.
+
+--template renders a Go text/template file instead of the built-in layout.
+The template receives:
+  .Milestone   {Name, Description, StartDate, DueDate}
+  .Project     project key
+  .Sections    list of {Name, Issues}
+  .Total       number of issues
+and each issue has {Key, Summary, URL, Type, Assignee, Resolution, Updated}.
+
+Examples:
+  backlog changelog --milestone v2.0
+  backlog changelog --milestone v2.0 --format markdown >> CHANGELOG.md
+  backlog changelog --milestone v2.0 --template changelog.tmpl
+  backlog changelog --milestone v2.0 -o json`,
+	Args:        cobra.NoArgs,
+	RunE:        runChangelog,
+	Annotations: cmdutil.AutoSave(),
+}
+
+var (
+	changelogMilestone      string
+	changelogFormat         string
+	changelogTemplate       string
+	changelogAllResolutions bool
+)
+
+func init() {
+	ChangelogCmd.Flags().StringVarP(&changelogMilestone, "milestone", "m", "", "Milestone ID or name (required)")
+	ChangelogCmd.Flags().StringVar(&changelogFormat, "format", "markdown", "Output format: {markdown|text}")
+	ChangelogCmd.Flags().StringVar(&changelogTemplate, "template", "", "Go text/template file to render instead of --format")
+	ChangelogCmd.Flags().BoolVar(&changelogAllResolutions, "all-resolutions", false, "Include issues closed as Won't Fix, Duplicate, etc.")
+	_ = ChangelogCmd.MarkFlagRequired("milestone")
+}
+
+// fixedResolutionID は完了理由「対応済み」の ID（全スペース共通）
+const fixedResolutionID = 0
+
+// Changelog はテンプレートに渡す変更履歴
+type Changelog struct {
+	Milestone Milestone `json:"milestone"`
+	Project   string    `json:"project"`
+	Sections  []Section `json:"sections"`
+	Total     int       `json:"total"`
+}
+
+// Milestone は変更履歴の対象マイルストーン
+type Milestone struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	DueDate     string `json:"dueDate,omitempty"`
+}
+
+// Section は種別ごとの課題のまとまり
+type Section struct {
+	Name   string  `json:"name"`
+	Issues []Entry `json:"issues"`
+}
+
+// Entry は変更履歴の 1 行
+type Entry struct {
+	Key        string `json:"key"`
+	Summary    string `json:"summary"`
+	URL        string `json:"url"`
+	Type       string `json:"type"`
+	Assignee   string `json:"assignee,omitempty"`
+	Resolution string `json:"resolution,omitempty"`
+	Updated    string `json:"updated,omitempty"`
+}
+
+const markdownTemplate = `## {{.Milestone.Name}}{{with .Milestone.DueDate}} ({{.}}){{end}}
+{{range .Sections}}
+### {{.Name}}
+
+{{range .Issues}}- [{{.Key}}]({{.URL}}) {{.Summary}}
+{{end}}{{end}}`
+
+const textTemplate = `{{.Milestone.Name}}{{with .Milestone.DueDate}} ({{.}}){{end}}
+{{range .Sections}}
+{{.Name}}
+{{range .Issues}}  - {{.Key}} {{.Summary}}
+{{end}}{{end}}`
+
+func runChangelog(c *cobra.Command, args []string) error {
+	tmpl, err := loadTemplate(changelogFormat, changelogTemplate)
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	ctx := c.Context()
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	profile := cfg.CurrentProfile()
+
+	version, err := cmdutil.ResolveMilestone(ctx, client, projectKey, changelogMilestone)
+	if err != nil {
+		return fmt.Errorf("failed to resolve milestone: %w", err)
+	}
+	issueTypes, err := client.GetIssueTypes(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get issue types: %w", err)
+	}
+	closedStatusID, err := cmdutil.ResolveClosedStatusID(ctx, client, projectKey)
+	if err != nil {
+		return err
+	}
+	stop := ui.StartProgress(fmt.Sprintf("Collecting closed issues of %s...", version.Name))
+	issues, err := fetchClosedIssues(ctx, client, version, closedStatusID)
+	stop()
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+
+	cl := buildChangelog(projectKey, version, issueTypes, issues, fmt.Sprintf("https://%s", profile.Space), changelogAllResolutions)
+	if profile.Output == "json" {
+		return cmdutil.OutputJSONFromProfile(cl, profile.JSONFields, profile.JQ, profile.Template)
+	}
+	return tmpl.Execute(os.Stdout, cl)
+}
+
+// loadTemplate は --template のファイル、または --format の既定テンプレートを返す
+func loadTemplate(format, path string) (*template.Template, error) {
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template: %w", err)
+		}
+		tmpl, err := template.New("changelog").Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", path, err)
+		}
+		return tmpl, nil
+	}
+	switch format {
+	case "markdown", "md":
+		return template.Must(template.New("changelog").Parse(markdownTemplate)), nil
+	case "text":
+		return template.Must(template.New("changelog").Parse(textTemplate)), nil
+	}
+	return nil, fmt.Errorf("invalid --format %q (must be markdown or text)", format)
+}
+
+// fetchClosedIssues はマイルストーンに含まれる完了した（closedStatusID の）課題をすべて取得する
+func fetchClosedIssues(ctx context.Context, client *api.Client, version *api.Version, closedStatusID int) ([]backlog.Issue, error) {
+	const batchSize = 100
+	var all []backlog.Issue
+	for offset := 0; ; offset += batchSize {
+		issues, err := client.GetIssues(ctx, &api.IssueListOptions{
+			ProjectIDs:   []int{version.ProjectID},
+			MilestoneIDs: []int{version.ID},
+			StatusIDs:    []int{closedStatusID},
+			Sort:         "updated",
+			Order:        "asc",
+			Count:        batchSize,
+			Offset:       offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, issues...)
+		if len(issues) < batchSize {
+			return all, nil
+		}
+	}
+}
+
+// buildChangelog は課題を種別ごとのセクションに分ける。
+// セクションはプロジェクトの種別の表示順、セクション内は課題キーの番号順に並べる
+func buildChangelog(projectKey string, version *api.Version, issueTypes []api.IssueType, issues []backlog.Issue, baseURL string, allResolutions bool) Changelog {
+	cl := Changelog{
+		Milestone: Milestone{
+			Name:        version.Name,
+			Description: version.Description,
+			StartDate:   dateOnly(version.StartDate),
+			DueDate:     dateOnly(version.ReleaseDueDate),
+		},
+		Project: projectKey,
+	}
+
+	sorted := make([]backlog.Issue, len(issues))
+	copy(sorted, issues)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].KeyId.Value < sorted[j].KeyId.Value })
+
+	sections := make(map[string]*Section)
+	for _, issue := range sorted {
+		res := issue.Resolution
+		resolved := res.IsSet() && !res.Null
+		if !allResolutions && resolved && res.Value.ID.Value != fixedResolutionID {
+			continue
+		}
+		typeName := issue.IssueType.Value.Name.Value
+		s, ok := sections[typeName]
+		if !ok {
+			s = &Section{Name: typeName}
+			sections[typeName] = s
+		}
+		entry := Entry{
+			Key:     issue.IssueKey.Value,
+			Summary: issue.Summary.Value,
+			URL:     fmt.Sprintf("%s/view/%s", baseURL, issue.IssueKey.Value),
+			Type:    typeName,
+			Updated: dateOnly(issue.Updated.Value),
+		}
+		if issue.Assignee.IsSet() && !issue.Assignee.Null {
+			entry.Assignee = issue.Assignee.Value.Name.Value
+		}
+		if resolved {
+			entry.Resolution = res.Value.Name.Value
+		}
+		s.Issues = append(s.Issues, entry)
+		cl.Total++
+	}
+
+	types := make([]api.IssueType, len(issueTypes))
+	copy(types, issueTypes)
+	sort.SliceStable(types, func(i, j int) bool { return types[i].DisplayOrder < types[j].DisplayOrder })
+	for _, t := range types {
+		if s, ok := sections[t.Name]; ok {
+			cl.Sections = append(cl.Sections, *s)
+			delete(sections, t.Name)
+		}
+	}
+	// 種別一覧に無い種別（削除済みなど）は名前順で末尾に置く
+	rest := make([]string, 0, len(sections))
+	for name := range sections {
+		rest = append(rest, name)
+	}
+	sort.Strings(rest)
+	for _, name := range rest {
+		cl.Sections = append(cl.Sections, *sections[name])
+	}
+	return cl
+}
+
+// dateOnly は API の日時（2024-01-02T00:00:00Z）を YYYY-MM-DD にする
+func dateOnly(s string) string {
+	if len(s) > 10 {
+		return s[:10]
+	}
+	return s
+}
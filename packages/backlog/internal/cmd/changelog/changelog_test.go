@@ -0,0 +1,91 @@
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func testIssue(key string, keyID int, typeName string, resolutionID int, resolution string) backlog.Issue {
+	issue := backlog.Issue{
+		IssueKey:  backlog.NewOptString(key),
+		KeyId:     backlog.NewOptInt(keyID),
+		Summary:   backlog.NewOptString("summary of " + key),
+		IssueType: backlog.NewOptIssueType(backlog.IssueType{Name: backlog.NewOptString(typeName)}),
+		Updated:   backlog.NewOptString("2026-02-03T04:05:06Z"),
+	}
+	if resolution != "" {
+		issue.Resolution = backlog.NewOptNilResolution(backlog.Resolution{
+			ID:   backlog.NewOptInt(resolutionID),
+			Name: backlog.NewOptString(resolution),
+		})
+	}
+	return issue
+}
+
+func TestBuildChangelog(t *testing.T) {
+	version := &api.Version{Name: "v2.0", ReleaseDueDate: "2026-03-31T00:00:00Z"}
+	issueTypes := []api.IssueType{
+		{Name: "Task", DisplayOrder: 2},
+		{Name: "Bug", DisplayOrder: 1},
+	}
+	issues := []backlog.Issue{
+		testIssue("PROJ-10", 10, "Bug", 0, "対応済み"),
+		testIssue("PROJ-3", 3, "Task", 0, "対応済み"),
+		testIssue("PROJ-2", 2, "Bug", 0, "対応済み"),
+		testIssue("PROJ-5", 5, "Bug", 1, "対応しない"),
+		testIssue("PROJ-7", 7, "Removed", 0, ""),
+	}
+
+	cl := buildChangelog("PROJ", version, issueTypes, issues, "https://example.backlog.jp", false)
+	if cl.Total != 4 || cl.Milestone.DueDate != "2026-03-31" {
+		t.Fatalf("unexpected changelog: %+v", cl)
+	}
+	var got []string
+	for _, s := range cl.Sections {
+		keys := make([]string, 0, len(s.Issues))
+		for _, e := range s.Issues {
+			keys = append(keys, e.Key)
+		}
+		got = append(got, s.Name+":"+strings.Join(keys, ","))
+	}
+	want := "Bug:PROJ-2,PROJ-10 Task:PROJ-3 Removed:PROJ-7"
+	if strings.Join(got, " ") != want {
+		t.Errorf("sections = %q, want %q", strings.Join(got, " "), want)
+	}
+	if url := cl.Sections[0].Issues[0].URL; url != "https://example.backlog.jp/view/PROJ-2" {
+		t.Errorf("url = %s", url)
+	}
+
+	all := buildChangelog("PROJ", version, issueTypes, issues, "https://example.backlog.jp", true)
+	if all.Total != 5 {
+		t.Errorf("--all-resolutions total = %d, want 5", all.Total)
+	}
+}
+
+func TestMarkdownTemplate(t *testing.T) {
+	tmpl, err := loadTemplate("markdown", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := Changelog{
+		Milestone: Milestone{Name: "v2.0", DueDate: "2026-03-31"},
+		Sections: []Section{
+			{Name: "Bug", Issues: []Entry{{Key: "PROJ-2", Summary: "Fix login", URL: "https://example.backlog.jp/view/PROJ-2"}}},
+		},
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, cl); err != nil {
+		t.Fatal(err)
+	}
+	want := "## v2.0 (2026-03-31)\n\n### Bug\n\n- [PROJ-2](https://example.backlog.jp/view/PROJ-2) Fix login\n"
+	if b.String() != want {
+		t.Errorf("got\n%q\nwant\n%q", b.String(), want)
+	}
+
+	if _, err := loadTemplate("html", ""); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -11,6 +11,11 @@ var completionCmd = &cobra.Command{
 	Short: "Generate completion script",
 	Long: `Generate shell completion script.
 
+Issue key arguments are completed with the summary and status
+(e.g. "PROJ-123 -- Login page bug (Open)") in zsh and fish. Candidates come
+from a local cache of listed/viewed issues, refreshed with the recently
+updated issues of the current project every 10 minutes.
+
 To load completions:
 
 Bash:
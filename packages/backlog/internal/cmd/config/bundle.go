@@ -5,8 +5,8 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
-	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 var (
@@ -56,6 +56,6 @@ func runBundleCreate(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to create bundle: %w", err)
 	}
 
-	ui.Success("Created relay bundle: %s", output)
+	cmdutil.Success(output, "Created relay bundle: %s", output)
 	return nil
 }
@@ -106,7 +106,7 @@ func promptInstallClaudePlugin(cmd *cobra.Command, claudeBin string) {
 		return
 	}
 
-	ui.Success("Claude Code プラグインをインストールしました（反映には Claude Code の再起動が必要な場合があります）")
+	cmdutil.Success("", "Claude Code プラグインをインストールしました（反映には Claude Code の再起動が必要な場合があります）")
 }
 
 // promptUpdateClaudePlugin は導入済み時にプラグインを更新する
@@ -117,7 +117,7 @@ func promptUpdateClaudePlugin(cmd *cobra.Command, claudeBin string, plugin *clau
 		return
 	}
 
-	ui.Success("Claude Code プラグインを更新しました（反映には Claude Code の再起動が必要な場合があります）")
+	cmdutil.Success("", "Claude Code プラグインを更新しました（反映には Claude Code の再起動が必要な場合があります）")
 }
 
 // runClaude は claude サブコマンドを実行し、出力をそのままターミナルへ流す
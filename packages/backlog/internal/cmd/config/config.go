@@ -15,6 +15,7 @@ func init() {
 	ConfigCmd.AddCommand(setCmd)
 	ConfigCmd.AddCommand(listCmd)
 	ConfigCmd.AddCommand(pathCmd)
+	ConfigCmd.AddCommand(whichCmd)
 	ConfigCmd.AddCommand(importCmd)
 	ConfigCmd.AddCommand(bundleCmd)
 	ConfigCmd.AddCommand(hashCmd)
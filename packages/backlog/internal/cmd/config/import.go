@@ -13,28 +13,41 @@ import (
 )
 
 var (
-	noDefaults bool
+	noDefaults     bool
+	createProfiles bool
 )
 
 var importCmd = &cobra.Command{
-	Use:   "import <bundle.zip>",
-	Short: "Import a relay config bundle",
-	Long: `Import a relay config bundle.
+	Use:   "import <bundle.zip>...",
+	Short: "Import relay config bundles",
+	Long: `Import one or more relay config bundles.
+
+With a single bundle, the default profile is set to use it (unless --no-defaults).
+With --create-profiles, a profile named after each bundle is created (or reused
+if a profile already references the bundle) instead, so many spaces can be set up
+at once; log in to each with 'backlog auth login --profile <name>'.
+
+Bundles are imported one by one; a bundle that fails verification is reported
+and the rest are still imported.
 
 Examples:
   backlog config import bundle.zip
   backlog config import --yes bundle.zip
-  backlog config import --no-defaults bundle.zip`,
-	Args: cobra.ExactArgs(1),
+  backlog config import --no-defaults bundle.zip
+  backlog config import --yes --create-profiles ./bundles/*.zip`,
+	Args: cobra.MinimumNArgs(1),
 	RunE: runImport,
 }
 
 func init() {
-	importCmd.Flags().BoolVar(&noDefaults, "no-defaults", false, "Do not update default profile values")
+	importCmd.Flags().BoolVar(&noDefaults, "no-defaults", false, "Do not update default profile values or apply the bundled settings.yaml")
+	importCmd.Flags().BoolVar(&createProfiles, "create-profiles", false, "Create a profile for each imported bundle")
 }
 
 func runImport(cmd *cobra.Command, args []string) error {
-	bundlePath := args[0]
+	if len(args) > 1 && !createProfiles && !noDefaults {
+		return fmt.Errorf("importing multiple bundles requires --create-profiles or --no-defaults")
+	}
 
 	cfg, err := config.Load(cmd.Context())
 	if err != nil {
@@ -63,23 +76,66 @@ func runImport(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	imported, err := config.ImportRelayBundle(cmd.Context(), cfg, bundlePath, config.BundleImportOptions{
+	opts := config.BundleImportOptions{
 		ApprovalHandler: approvalHandler,
-		NoDefaults:      noDefaults,
+		NoDefaults:      noDefaults || createProfiles,
+		NoOrgDefaults:   noDefaults,
 		CacheDir:        cacheDir,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to import bundle: %w", err)
 	}
 
-	if err := cfg.Save(cmd.Context()); err != nil {
-		return fmt.Errorf("failed to save config: %w", err)
+	var failed []string
+	imported := 0
+	for _, bundlePath := range args {
+		bundle, err := config.ImportRelayBundle(cmd.Context(), cfg, bundlePath, opts)
+		if err != nil {
+			if len(args) == 1 {
+				return fmt.Errorf("failed to import bundle: %w", err)
+			}
+			ui.Warning("failed to import %s: %v", bundlePath, err)
+			failed = append(failed, bundlePath)
+			continue
+		}
+		imported++
+
+		cmdutil.Success(bundle.Name, "Imported relay bundle %s", bundle.Name)
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("  Relay URL:   %s\n", bundle.RelayURL)
+			fmt.Printf("  Keys:        %d key(s)\n", len(bundle.RelayKeys))
+			fmt.Printf("  Expires at:  %s\n", bundle.ExpiresAt)
+			fmt.Printf("  Imported at: %s\n", bundle.ImportedAt)
+			if bundle.OrgDefaults {
+				fmt.Println("  Org defaults: applied from settings.yaml")
+			}
+		}
+
+		if createProfiles {
+			profileName, created, err := config.EnsureBundleProfile(cfg, bundle.Name)
+			if err != nil {
+				ui.Warning("bundle %s imported but no profile was created: %v", bundle.Name, err)
+				continue
+			}
+			if created {
+				fmt.Printf("  Profile:     %s (created)\n", profileName)
+			} else {
+				fmt.Printf("  Profile:     %s (existing)\n", profileName)
+			}
+		}
+	}
+
+	if imported > 0 {
+		if err := cfg.Save(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
 	}
 
-	ui.Success("Imported relay bundle %s", imported.Name)
-	fmt.Printf("  Relay URL:   %s\n", imported.RelayURL)
-	fmt.Printf("  Keys:        %d key(s)\n", len(imported.RelayKeys))
-	fmt.Printf("  Expires at:  %s\n", imported.ExpiresAt)
-	fmt.Printf("  Imported at: %s\n", imported.ImportedAt)
+	if len(args) > 1 {
+		fmt.Printf("\nImported %d of %d bundle(s)\n", imported, len(args))
+	}
+	if createProfiles && imported > 0 {
+		fmt.Println("Log in to each profile with: backlog auth login --profile <name>")
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to import %d bundle(s)", len(failed))
+	}
 	return nil
 }
@@ -7,7 +7,10 @@ import (
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 )
 
-var listAllFlag bool
+var (
+	listAllFlag        bool
+	listShowOriginFlag bool
+)
 
 var listCmd = &cobra.Command{
 	Use:   "list",
@@ -15,12 +18,16 @@ var listCmd = &cobra.Command{
 	Long: `List configuration values.
 
 By default, shows only modified values (non-default).
-Use --all to show all configuration values including defaults.`,
+Use --all to show all configuration values including defaults.
+Use --show-origin to show the file each value comes from. Values distributed
+by a relay bundle (settings.yaml) are shown as the "org" layer and can be
+overridden in the user config.`,
 	RunE: runList,
 }
 
 func init() {
 	listCmd.Flags().BoolVarP(&listAllFlag, "all", "a", false, "Show all configuration values including defaults")
+	listCmd.Flags().BoolVar(&listShowOriginFlag, "show-origin", false, "Show the file each value comes from")
 }
 
 func runList(cmd *cobra.Command, _ []string) error {
@@ -30,6 +37,14 @@ func runList(cmd *cobra.Command, _ []string) error {
 	}
 
 	// 設定ファイルのパスを表示
+	orgBundle := cfg.OrgDefaultsBundle()
+	if orgPath := cfg.OrgDefaultsPath(); orgPath != "" {
+		if orgBundle != "" {
+			fmt.Printf("# Org defaults: %s (bundle %s)\n", orgPath, orgBundle)
+		} else {
+			fmt.Printf("# Org defaults: %s\n", orgPath)
+		}
+	}
 	if userPath := cfg.GetUserConfigPath(); userPath != "" {
 		fmt.Printf("# User config: %s\n", userPath)
 	}
@@ -39,12 +54,16 @@ func runList(cmd *cobra.Command, _ []string) error {
 	if projectPath := cfg.GetProjectConfigPath(); projectPath != "" {
 		fmt.Printf("# Project config: %s\n", projectPath)
 	}
+	for _, parent := range parentProjectConfigPaths(cfg) {
+		fmt.Printf("# Parent project config: %s\n", parent)
+	}
 
 	// Walk でフィルタリングしながら収集（ソート済み）
 	type entry struct {
 		path         string
 		value        string
 		layer        string
+		origin       string
 		defaultValue string
 	}
 	var entries []entry
@@ -68,6 +87,7 @@ func runList(cmd *cobra.Command, _ []string) error {
 			path:         e.Path,
 			value:        valueStr,
 			layer:        e.Layer,
+			origin:       e.Origin,
 			defaultValue: defaultStr,
 		})
 		return true
@@ -77,8 +97,11 @@ func runList(cmd *cobra.Command, _ []string) error {
 	for _, e := range entries {
 		line := fmt.Sprintf("%s=%s", e.path, e.value)
 		comment := e.layer
+		if listShowOriginFlag {
+			comment = originLabel(e.layer, e.origin, orgBundle)
+		}
 		if e.defaultValue != "" && e.value != e.defaultValue {
-			comment = fmt.Sprintf("%s, default: %s", e.layer, e.defaultValue)
+			comment = fmt.Sprintf("%s, default: %s", comment, e.defaultValue)
 		}
 		fmt.Printf("%-*s  # %s\n", maxWidth, line, comment)
 	}
@@ -94,3 +117,15 @@ func runList(cmd *cobra.Command, _ []string) error {
 
 	return nil
 }
+
+// originLabel は --show-origin で表示する値の出所を返す
+// 組織デフォルトレイヤーは取り込み元のバンドル名も表示する
+func originLabel(layer, origin, orgBundle string) string {
+	if layer == config.LayerOrg && orgBundle != "" {
+		layer = fmt.Sprintf("%s (bundle %s)", layer, orgBundle)
+	}
+	if origin == "" {
+		return layer
+	}
+	return fmt.Sprintf("%s: %s", layer, origin)
+}
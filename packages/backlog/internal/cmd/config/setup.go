@@ -10,11 +10,12 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/auth"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/relaysig"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 	"golang.org/x/term"
 )
@@ -102,13 +103,13 @@ func runSetupWithToken(cmd *cobra.Command, token string) error {
 	}
 
 	if result.Unchanged {
-		ui.Success("Bundle %s is already up to date.", result.Bundle.ResolvedName())
+		cmdutil.Success(result.Bundle.ResolvedName(), "Bundle %s is already up to date.", result.Bundle.ResolvedName())
 	} else {
 		if err := cfg.Save(cmd.Context()); err != nil {
 			return fmt.Errorf("failed to save config: %w", err)
 		}
 
-		ui.Success("Setup complete for bundle %s", result.Bundle.ResolvedName())
+		cmdutil.Success(result.Bundle.ResolvedName(), "Setup complete for bundle %s", result.Bundle.ResolvedName())
 		fmt.Printf("  Relay URL:   %s\n", result.Bundle.RelayURL)
 		fmt.Printf("  Keys:        %d key(s)\n", len(result.Bundle.RelayKeys))
 		fmt.Printf("  Expires at:  %s\n", result.Bundle.ExpiresAt)
@@ -263,7 +264,7 @@ func finishSetup(cmd *cobra.Command, relayURL, space, provisioningKey string) er
 	}
 
 	if result.Unchanged {
-		ui.Success("Bundle %s is already up to date.", result.Bundle.ResolvedName())
+		cmdutil.Success(result.Bundle.ResolvedName(), "Bundle %s is already up to date.", result.Bundle.ResolvedName())
 	} else {
 		if space != "" {
 			if err := applySpaceDefaults(cfg, space); err != nil {
@@ -275,7 +276,7 @@ func finishSetup(cmd *cobra.Command, relayURL, space, provisioningKey string) er
 			return fmt.Errorf("failed to save config: %w", err)
 		}
 
-		ui.Success("Setup complete for bundle %s", result.Bundle.ResolvedName())
+		cmdutil.Success(result.Bundle.ResolvedName(), "Setup complete for bundle %s", result.Bundle.ResolvedName())
 		fmt.Printf("  Relay URL:   %s\n", result.Bundle.RelayURL)
 		fmt.Printf("  Space:       %s\n", space)
 		fmt.Printf("  Keys:        %d key(s)\n", len(result.Bundle.RelayKeys))
@@ -357,7 +358,7 @@ func runSetupOAuth(ctx context.Context, relayURL, name, space string) (*config.P
 	server := &http.Server{Handler: mux}
 	go func() { _ = server.Serve(listener) }()
 	defer func() {
-		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 2*time.Second)
+		shutdownCtx, cancel := context.WithTimeout(cmdutil.CleanupContext(ctx), 2*time.Second)
 		defer cancel()
 		_ = server.Shutdown(shutdownCtx)
 	}()
@@ -372,7 +373,7 @@ func runSetupOAuth(ctx context.Context, relayURL, name, space string) (*config.P
 	fmt.Println()
 	fmt.Println("Waiting for authentication... (press Ctrl+C to cancel)")
 
-	if err := browser.OpenURL(landingURL); err != nil {
+	if err := osutil.OpenURL("", landingURL); err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "Warning: could not open browser: %v\n", err)
 	}
 
@@ -388,7 +389,21 @@ func runSetupOAuth(ctx context.Context, relayURL, name, space string) (*config.P
 	}
 
 	ui.Info("Exchanging authorization code...")
-	client := auth.NewClient(relayURL)
+	// セットアップ時はプロファイル未作成のため、署名鍵とクライアント証明書は環境変数からのみ受け付ける
+	var signer *relaysig.Signer
+	if keyPath := os.Getenv("BACKLOG_RELAY_SIGNING_KEY"); keyPath != "" {
+		if signer, err = relaysig.LoadSigner(keyPath, ""); err != nil {
+			return nil, err
+		}
+	}
+	relayTLS, err := config.RelayTLSConfigForProfile(&config.ResolvedProfile{
+		RelayClientCert: os.Getenv("BACKLOG_RELAY_CLIENT_CERT"),
+		RelayClientKey:  os.Getenv("BACKLOG_RELAY_CLIENT_KEY"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	client := auth.NewClient(relayURL, auth.WithRelaySigner(signer), auth.WithTLSConfig(relayTLS))
 	tokenResp, err := client.ExchangeToken(auth.TokenRequest{
 		GrantType: "authorization_code",
 		Code:      result.code,
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+var whichCmd = &cobra.Command{
+	Use:   "which [key]",
+	Short: "Show which configuration files are used",
+	Long: `Show which configuration files are used.
+
+Project config files (.backlog.yaml) are searched from the current directory
+up to the filesystem root and merged; the nearest file takes precedence.
+This lets a monorepo keep shared settings at the repository root and
+override the project per subdirectory.
+
+With a key, shows the value and the layer (and file) it comes from.
+
+Examples:
+  backlog config which
+  backlog config which project.name`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWhich,
+}
+
+func runWhich(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if len(args) == 1 {
+		key := args[0]
+		value, src, ok := cfg.Which(key)
+		if !ok {
+			return fmt.Errorf("unknown config key: %s", key)
+		}
+		if src.Layer == config.LayerCredentials {
+			value = config.SensitiveMaskString
+		}
+		fmt.Printf("%s=%v\n", key, value)
+		if src.Path != "" {
+			fmt.Printf("  from: %s (%s)\n", src.Layer, src.Path)
+		} else {
+			fmt.Printf("  from: %s\n", src.Layer)
+		}
+		return nil
+	}
+
+	paths := cfg.ProjectConfigPaths()
+	if len(paths) == 0 {
+		fmt.Println("Project config: (none found)")
+	} else {
+		fmt.Println("Project config (nearest first):")
+		for i, path := range paths {
+			fmt.Printf("  %d. %s\n", i+1, path)
+		}
+	}
+	fmt.Printf("User config:    %s\n", cfg.GetUserConfigPath())
+	fmt.Printf("Credentials:    %s\n", cfg.GetCredentialsPath())
+	if orgPath := cfg.OrgDefaultsPath(); orgPath != "" {
+		fmt.Printf("Org defaults:   %s\n", orgPath)
+	}
+	return nil
+}
+
+// parentProjectConfigPaths は最も近いプロジェクト設定より上位の（親ディレクトリの）設定ファイルを返す
+func parentProjectConfigPaths(cfg *config.Store) []string {
+	paths := cfg.ProjectConfigPaths()
+	if len(paths) <= 1 {
+		return nil
+	}
+	return paths[1:]
+}
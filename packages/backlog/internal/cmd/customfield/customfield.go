@@ -9,9 +9,10 @@ var CustomFieldCmd = &cobra.Command{
 	Use:     "custom-field",
 	Aliases: []string{"cf"},
 	Short:   "Manage custom fields",
-	Long:    `List custom fields in a project.`,
+	Long:    `List custom fields in a project and sync their definitions between projects.`,
 }
 
 func init() {
 	CustomFieldCmd.AddCommand(listCmd)
+	CustomFieldCmd.AddCommand(NewSyncCmd())
 }
@@ -0,0 +1,474 @@
+package customfield
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// 同期モード
+const (
+	syncModeAdd  = "add"
+	syncModeFull = "full"
+)
+
+var (
+	syncFrom   string
+	syncTo     []string
+	syncMode   string
+	syncDryRun bool
+)
+
+// NewSyncCmd はカスタムフィールド定義の同期コマンドを生成する
+// "custom-field sync" と "project customfield sync" の両方から使うため、呼び出しごとに新しいコマンドを返す
+func NewSyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Copy custom field definitions from one project to others",
+		Long: `Copy custom field definitions (name, type and list items) from one project to
+other projects, so that projects sharing a workflow keep the same fields.
+
+Fields are matched by name. Missing fields are created in the target project
+with the same type, description, required flag and list items. Applicable
+issue types are matched by name; issue types missing in the target project are
+reported and left out.
+
+Modes:
+  add   create missing fields and add missing list items (default)
+  full  also remove list items that are not in the source project and update
+        the description, required flag and applicable issue types
+
+Fields whose type differs between the projects are reported and skipped.
+Fields that exist only in the target project are reported but never deleted.
+Removing a list item also clears it from the issues that use it.
+
+Examples:
+  backlog custom-field sync --from PROJ-A --to PROJ-B,PROJ-C --dry-run
+  backlog custom-field sync --from PROJ-A --to PROJ-B --mode full --yes
+  backlog project customfield sync --from PROJ-A --to PROJ-B`,
+		Args: cobra.NoArgs,
+		RunE: runSync,
+	}
+	cmd.Flags().StringVar(&syncFrom, "from", "", "Source project key")
+	cmd.Flags().StringSliceVar(&syncTo, "to", nil, "Target project keys (comma-separated)")
+	cmd.Flags().StringVar(&syncMode, "mode", syncModeAdd, "Sync mode: add or full")
+	cmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "Show the differences without updating")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+// fieldAction はカスタムフィールド1件に対する同期内容の種類
+type fieldAction int
+
+const (
+	fieldUnchanged fieldAction = iota
+	fieldCreate
+	fieldUpdate
+	fieldConflict
+)
+
+// fieldPlan はカスタムフィールド1件の同期内容
+type fieldPlan struct {
+	Action   fieldAction
+	Source   backlog.CustomField
+	TargetID int
+	// TargetTypeID は型が異なる場合の同期先の型
+	TargetTypeID int
+	AddItems     []string
+	RemoveItems  []backlog.CustomFieldItem
+	// Update は full モードで更新する項目（変更が無ければ nil）
+	Update *api.UpdateCustomFieldInput
+	// IssueTypes は同期先での適用課題種別
+	IssueTypes        []int
+	IssueTypesChanged bool
+}
+
+// syncPlan は同期先プロジェクト1件分の同期内容
+type syncPlan struct {
+	ProjectKey string
+	Fields     []fieldPlan
+	// OnlyInTarget は同期先にしか無いフィールド名（削除はしない）
+	OnlyInTarget []string
+	// MissingIssueTypes は同期先に存在しない課題種別名
+	MissingIssueTypes []string
+}
+
+// hasChanges は同期先に変更があるか
+func (p *syncPlan) hasChanges() bool {
+	for _, f := range p.Fields {
+		if f.Action == fieldCreate || f.Action == fieldUpdate {
+			return true
+		}
+	}
+	return false
+}
+
+// isListType はリスト形式（選択肢を持つ）のカスタムフィールド型か
+func isListType(typeID int) bool {
+	return typeID >= 5 && typeID <= 8
+}
+
+// mapIssueTypes は同期元の課題種別 ID を名前で同期先の ID に対応付ける
+// 同期先に存在しない種別名は missing として返す
+func mapIssueTypes(ids []int, sourceTypes, targetTypes []api.IssueType) (mapped []int, missing []string) {
+	names := make(map[int]string, len(sourceTypes))
+	for _, t := range sourceTypes {
+		names[t.ID] = t.Name
+	}
+	targetIDs := make(map[string]int, len(targetTypes))
+	for _, t := range targetTypes {
+		targetIDs[t.Name] = t.ID
+	}
+	for _, id := range ids {
+		name, ok := names[id]
+		if !ok {
+			continue
+		}
+		if targetID, ok := targetIDs[name]; ok {
+			mapped = append(mapped, targetID)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	return mapped, missing
+}
+
+// sameIntSet は順序を問わず同じ要素か
+func sameIntSet(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[int]int, len(a))
+	for _, v := range a {
+		seen[v]++
+	}
+	for _, v := range b {
+		if seen[v] == 0 {
+			return false
+		}
+		seen[v]--
+	}
+	return true
+}
+
+// planSync は同期元と同期先のカスタムフィールドを比較して同期内容を求める
+func planSync(projectKey string, source, target []backlog.CustomField, sourceTypes, targetTypes []api.IssueType, full bool) *syncPlan {
+	plan := &syncPlan{ProjectKey: projectKey}
+
+	targets := make(map[string]backlog.CustomField, len(target))
+	for _, f := range target {
+		targets[f.Name.Value] = f
+	}
+	sourceNames := make(map[string]bool, len(source))
+	missingTypes := make(map[string]bool)
+
+	for _, src := range source {
+		sourceNames[src.Name.Value] = true
+		issueTypes, missing := mapIssueTypes(src.ApplicableIssueTypes, sourceTypes, targetTypes)
+		for _, name := range missing {
+			missingTypes[name] = true
+		}
+
+		dst, ok := targets[src.Name.Value]
+		if !ok {
+			fp := fieldPlan{Action: fieldCreate, Source: src, IssueTypes: issueTypes}
+			for _, item := range src.Items {
+				fp.AddItems = append(fp.AddItems, item.Name.Value)
+			}
+			plan.Fields = append(plan.Fields, fp)
+			continue
+		}
+
+		fp := fieldPlan{Action: fieldUnchanged, Source: src, TargetID: dst.ID.Value, IssueTypes: issueTypes}
+		if dst.TypeId.Value != src.TypeId.Value {
+			fp.Action = fieldConflict
+			fp.TargetTypeID = dst.TypeId.Value
+			plan.Fields = append(plan.Fields, fp)
+			continue
+		}
+
+		if isListType(src.TypeId.Value) {
+			srcItems := make(map[string]bool, len(src.Items))
+			for _, item := range src.Items {
+				srcItems[item.Name.Value] = true
+			}
+			dstItems := make(map[string]bool, len(dst.Items))
+			for _, item := range dst.Items {
+				dstItems[item.Name.Value] = true
+			}
+			for _, item := range src.Items {
+				if !dstItems[item.Name.Value] {
+					fp.AddItems = append(fp.AddItems, item.Name.Value)
+				}
+			}
+			if full {
+				for _, item := range dst.Items {
+					if !srcItems[item.Name.Value] {
+						fp.RemoveItems = append(fp.RemoveItems, item)
+					}
+				}
+			}
+		}
+
+		if full {
+			update := &api.UpdateCustomFieldInput{}
+			changed := false
+			if src.Description.Value != dst.Description.Value {
+				update.Description = &src.Description.Value
+				changed = true
+			}
+			if src.Required.Value != dst.Required.Value {
+				update.Required = &src.Required.Value
+				changed = true
+			}
+			if !sameIntSet(issueTypes, dst.ApplicableIssueTypes) {
+				fp.IssueTypesChanged = true
+				changed = true
+			}
+			if changed {
+				// 適用課題種別は省略すると変更されないため、常に同期元に合わせて送る
+				update.ApplicableIssueTypes = issueTypes
+				fp.Update = update
+			}
+		}
+
+		if len(fp.AddItems) > 0 || len(fp.RemoveItems) > 0 || fp.Update != nil {
+			fp.Action = fieldUpdate
+		}
+		plan.Fields = append(plan.Fields, fp)
+	}
+
+	for _, dst := range target {
+		if !sourceNames[dst.Name.Value] {
+			plan.OnlyInTarget = append(plan.OnlyInTarget, dst.Name.Value)
+		}
+	}
+	for name := range missingTypes {
+		plan.MissingIssueTypes = append(plan.MissingIssueTypes, name)
+	}
+	sort.Strings(plan.MissingIssueTypes)
+	return plan
+}
+
+// printSyncPlan は同期内容を差分形式で表示する
+func printSyncPlan(plan *syncPlan, from string) {
+	fmt.Printf("%s -> %s\n", from, ui.Bold(plan.ProjectKey))
+	unchanged := 0
+	for _, f := range plan.Fields {
+		name := f.Source.Name.Value
+		typ := typeName(f.Source.TypeId.Value)
+		switch f.Action {
+		case fieldUnchanged:
+			unchanged++
+		case fieldCreate:
+			fmt.Printf("  %s %s (%s)\n", ui.Green("+"), name, typ)
+			for _, item := range f.AddItems {
+				fmt.Printf("      %s %s\n", ui.Green("+"), item)
+			}
+		case fieldUpdate:
+			fmt.Printf("  %s %s (%s)\n", ui.Yellow("~"), name, typ)
+			for _, item := range f.AddItems {
+				fmt.Printf("      %s %s\n", ui.Green("+"), item)
+			}
+			for _, item := range f.RemoveItems {
+				fmt.Printf("      %s %s\n", ui.Red("-"), item.Name.Value)
+			}
+			if u := f.Update; u != nil {
+				if u.Description != nil {
+					fmt.Printf("      description: %q\n", truncate(*u.Description, 40))
+				}
+				if u.Required != nil {
+					fmt.Printf("      required: %t\n", *u.Required)
+				}
+				if f.IssueTypesChanged {
+					fmt.Printf("      applicable issue types: %d\n", len(u.ApplicableIssueTypes))
+				}
+			}
+		case fieldConflict:
+			fmt.Printf("  %s %s: type differs (%s in %s, %s in %s), skipped\n",
+				ui.Red("!"), name, typ, from, typeName(f.TargetTypeID), plan.ProjectKey)
+		}
+	}
+	for _, name := range plan.OnlyInTarget {
+		fmt.Printf("  %s %s\n", ui.Gray("?"), ui.Gray(name+" (only in "+plan.ProjectKey+", kept)"))
+	}
+	if len(plan.MissingIssueTypes) > 0 {
+		fmt.Printf("  %s issue types not found in %s: %s\n",
+			ui.Yellow("!"), plan.ProjectKey, strings.Join(plan.MissingIssueTypes, ", "))
+	}
+	if unchanged > 0 {
+		fmt.Printf("  %s\n", ui.Gray(fmt.Sprintf("%d field(s) unchanged", unchanged)))
+	}
+}
+
+// applySyncPlan は同期内容を同期先プロジェクトに反映し、失敗した件数を返す
+func applySyncPlan(ctx context.Context, client *api.Client, plan *syncPlan) int {
+	failed := 0
+	report := func(name string, err error) {
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "%s %s %s: %v\n", ui.FailMark(), plan.ProjectKey, name, err)
+			return
+		}
+		cmdutil.Progressf("%s %s %s", ui.OKMark(), plan.ProjectKey, name)
+	}
+
+	for _, f := range plan.Fields {
+		if cmdutil.Interrupted(ctx) {
+			break
+		}
+		src := f.Source
+		name := src.Name.Value
+		switch f.Action {
+		case fieldCreate:
+			input := &api.CreateCustomFieldInput{
+				TypeID:               src.TypeId.Value,
+				Name:                 name,
+				Description:          src.Description.Value,
+				Required:             src.Required.Value,
+				ApplicableIssueTypes: f.IssueTypes,
+			}
+			if isListType(src.TypeId.Value) {
+				input.Items = f.AddItems
+				if src.AllowAddItem.IsSet() {
+					input.AllowAddItem = &src.AllowAddItem.Value
+				}
+			}
+			_, err := client.CreateCustomField(ctx, plan.ProjectKey, input)
+			report(name, err)
+		case fieldUpdate:
+			for _, item := range f.AddItems {
+				_, err := client.AddCustomFieldItem(ctx, plan.ProjectKey, f.TargetID, item)
+				report(fmt.Sprintf("%s: + %s", name, item), err)
+			}
+			for _, item := range f.RemoveItems {
+				_, err := client.DeleteCustomFieldItem(ctx, plan.ProjectKey, f.TargetID, item.ID.Value)
+				report(fmt.Sprintf("%s: - %s", name, item.Name.Value), err)
+			}
+			if f.Update != nil {
+				_, err := client.UpdateCustomField(ctx, plan.ProjectKey, f.TargetID, f.Update)
+				report(name, err)
+			}
+		}
+	}
+	return failed
+}
+
+func runSync(c *cobra.Command, args []string) error {
+	if syncMode != syncModeAdd && syncMode != syncModeFull {
+		return fmt.Errorf("invalid --mode %q (use add or full)", syncMode)
+	}
+	from := strings.ToUpper(strings.TrimSpace(syncFrom))
+	var targets []string
+	for _, key := range syncTo {
+		key = strings.ToUpper(strings.TrimSpace(key))
+		if key == "" {
+			continue
+		}
+		if key == from {
+			return fmt.Errorf("%s is both the source and a target", key)
+		}
+		targets = append(targets, key)
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("--to requires at least one project key")
+	}
+
+	client, _, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	ctx := c.Context()
+
+	stopProgress := ui.StartProgress("Fetching custom fields...")
+	plans, err := buildSyncPlans(ctx, client, from, targets, syncMode == syncModeFull)
+	stopProgress()
+	if err != nil {
+		return err
+	}
+
+	changed := 0
+	for i, plan := range plans {
+		if i > 0 {
+			fmt.Println()
+		}
+		printSyncPlan(plan, from)
+		if plan.hasChanges() {
+			changed++
+		}
+	}
+	if changed == 0 {
+		fmt.Println("\nCustom fields are already in sync")
+		return nil
+	}
+	if syncDryRun {
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog custom-field sync",
+				"Use --yes to apply the changes, or --dry-run to preview.",
+			)
+		}
+		fmt.Println()
+		ok, err := ui.Confirm(fmt.Sprintf("Apply the changes to %d project(s)?", changed), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, plan := range plans {
+		failed += applySyncPlan(ctx, client, plan)
+	}
+	if cmdutil.Interrupted(ctx) {
+		return cmdutil.ErrInterrupted
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d change(s) failed", failed)
+	}
+	cmdutil.Success("", "Synced custom fields from %s to %d project(s)", from, changed)
+	return nil
+}
+
+// buildSyncPlans は同期元と各同期先の定義を取得して同期内容を求める
+func buildSyncPlans(ctx context.Context, client *api.Client, from string, targets []string, full bool) ([]*syncPlan, error) {
+	source, err := client.GetCustomFields(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom fields of %s: %w", from, err)
+	}
+	sourceTypes, err := client.GetIssueTypes(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue types of %s: %w", from, err)
+	}
+
+	plans := make([]*syncPlan, 0, len(targets))
+	for _, key := range targets {
+		target, err := client.GetCustomFields(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get custom fields of %s: %w", key, err)
+		}
+		targetTypes, err := client.GetIssueTypes(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue types of %s: %w", key, err)
+		}
+		plans = append(plans, planSync(key, source, target, sourceTypes, targetTypes, full))
+	}
+	return plans, nil
+}
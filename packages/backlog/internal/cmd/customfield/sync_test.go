@@ -0,0 +1,113 @@
+package customfield
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func testField(id, typeID int, name string, issueTypes []int, items ...string) backlog.CustomField {
+	f := backlog.CustomField{
+		ID:                   backlog.NewOptInt(id),
+		TypeId:               backlog.NewOptInt(typeID),
+		Name:                 backlog.NewOptString(name),
+		ApplicableIssueTypes: issueTypes,
+	}
+	for i, item := range items {
+		f.Items = append(f.Items, backlog.CustomFieldItem{
+			ID:   backlog.NewOptInt(id*100 + i),
+			Name: backlog.NewOptString(item),
+		})
+	}
+	return f
+}
+
+func TestPlanSync(t *testing.T) {
+	sourceTypes := []api.IssueType{{ID: 1, Name: "Bug"}, {ID: 2, Name: "Task"}, {ID: 3, Name: "Epic"}}
+	targetTypes := []api.IssueType{{ID: 11, Name: "Bug"}, {ID: 12, Name: "Task"}}
+
+	source := []backlog.CustomField{
+		testField(1, 5, "Severity", []int{1, 3}, "High", "Low"),
+		testField(2, 6, "Component", nil, "API", "Web"),
+		testField(3, 3, "Points", nil),
+		testField(4, 1, "Note", nil),
+	}
+	target := []backlog.CustomField{
+		testField(20, 6, "Component", nil, "Web", "Legacy"),
+		testField(30, 1, "Points", nil),
+		testField(40, 1, "Note", nil),
+		testField(50, 1, "Extra", nil),
+	}
+
+	t.Run("add", func(t *testing.T) {
+		plan := planSync("B", source, target, sourceTypes, targetTypes, false)
+		if len(plan.Fields) != 4 {
+			t.Fatalf("fields = %d, want 4", len(plan.Fields))
+		}
+
+		create := plan.Fields[0]
+		if create.Action != fieldCreate || !reflect.DeepEqual(create.AddItems, []string{"High", "Low"}) {
+			t.Errorf("Severity = %+v, want create with items", create)
+		}
+		if !reflect.DeepEqual(create.IssueTypes, []int{11}) {
+			t.Errorf("Severity issue types = %v, want [11]", create.IssueTypes)
+		}
+
+		update := plan.Fields[1]
+		if update.Action != fieldUpdate || update.TargetID != 20 {
+			t.Errorf("Component = %+v, want update of 20", update)
+		}
+		if !reflect.DeepEqual(update.AddItems, []string{"API"}) || len(update.RemoveItems) != 0 || update.Update != nil {
+			t.Errorf("Component add mode = %+v, want only API added", update)
+		}
+
+		if conflict := plan.Fields[2]; conflict.Action != fieldConflict || conflict.TargetTypeID != 1 {
+			t.Errorf("Points = %+v, want conflict", conflict)
+		}
+		if plan.Fields[3].Action != fieldUnchanged {
+			t.Errorf("Note = %+v, want unchanged", plan.Fields[3])
+		}
+
+		if !reflect.DeepEqual(plan.OnlyInTarget, []string{"Extra"}) {
+			t.Errorf("OnlyInTarget = %v", plan.OnlyInTarget)
+		}
+		if !reflect.DeepEqual(plan.MissingIssueTypes, []string{"Epic"}) {
+			t.Errorf("MissingIssueTypes = %v", plan.MissingIssueTypes)
+		}
+		if !plan.hasChanges() {
+			t.Error("hasChanges = false, want true")
+		}
+	})
+
+	t.Run("full", func(t *testing.T) {
+		src := []backlog.CustomField{testField(2, 6, "Component", []int{2}, "API", "Web")}
+		src[0].Description = backlog.NewOptString("Affected component")
+		plan := planSync("B", src, target[:1], sourceTypes, targetTypes, true)
+
+		f := plan.Fields[0]
+		if f.Action != fieldUpdate {
+			t.Fatalf("Action = %v, want update", f.Action)
+		}
+		if len(f.RemoveItems) != 1 || f.RemoveItems[0].Name.Value != "Legacy" {
+			t.Errorf("RemoveItems = %+v, want Legacy", f.RemoveItems)
+		}
+		if f.Update == nil || f.Update.Description == nil || *f.Update.Description != "Affected component" {
+			t.Fatalf("Update = %+v, want description", f.Update)
+		}
+		if f.Update.Required != nil {
+			t.Errorf("Required = %v, want unchanged", *f.Update.Required)
+		}
+		if !f.IssueTypesChanged || !reflect.DeepEqual(f.Update.ApplicableIssueTypes, []int{12}) {
+			t.Errorf("ApplicableIssueTypes = %v, want [12]", f.Update.ApplicableIssueTypes)
+		}
+	})
+
+	t.Run("in sync", func(t *testing.T) {
+		plan := planSync("B", target, target, targetTypes, targetTypes, true)
+		if plan.hasChanges() || len(plan.OnlyInTarget) != 0 {
+			t.Errorf("plan = %+v, want no changes", plan)
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	coverageRoot     string
+	coverageAll      bool
+	coverageMarkdown bool
+)
+
+var apiCoverageCmd = &cobra.Command{
+	Use:   "api-coverage",
+	Short: "Report Backlog API endpoints not yet supported by the CLI",
+	Long: `Compare the public Backlog API endpoints with the operations implemented
+by the CLI and report the endpoints that are not supported yet.
+
+Sources (relative to the repository root):
+  docs/api/cache.json                  public endpoints (scripts/backlog-api-sync.py)
+  docs/api/openapi.yaml                operations generated by ogen
+  packages/backlog/internal/api/*.go   hand-written API wrappers
+
+It also reports operations whose generated code is out of date with
+openapi.yaml, in which case "make generate" needs to be run.
+
+Examples:
+  backlog dev api-coverage
+  backlog dev api-coverage --all
+  backlog dev api-coverage --markdown > docs/api/coverage.md
+  backlog dev api-coverage -o json --jq '.missing[].path'`,
+	Args: cobra.NoArgs,
+	RunE: runAPICoverage,
+}
+
+func init() {
+	apiCoverageCmd.Flags().StringVar(&coverageRoot, "root", "", "Repository root (default: nearest parent directory containing docs/api/cache.json)")
+	apiCoverageCmd.Flags().BoolVar(&coverageAll, "all", false, "List implemented endpoints as well")
+	apiCoverageCmd.Flags().BoolVar(&coverageMarkdown, "markdown", false, "Output all endpoints as a Markdown table")
+}
+
+func runAPICoverage(c *cobra.Command, args []string) error {
+	root := coverageRoot
+	if root == "" {
+		var err error
+		if root, err = findRepoRoot(); err != nil {
+			return err
+		}
+	}
+	coverage, err := loadCoverage(root)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := cmdutil.GetConfigStore(c)
+	if err != nil {
+		return err
+	}
+	profile := cfg.CurrentProfile()
+	switch {
+	case profile.Output == "json":
+		return cmdutil.OutputJSONFromProfile(coverage, profile.JSONFields, profile.JQ, profile.Template)
+	case coverageMarkdown:
+		printCoverageMarkdown(coverage)
+	default:
+		printCoverage(coverage, coverageAll)
+	}
+	return nil
+}
+
+// findRepoRoot はカレントディレクトリから親を辿り docs/api/cache.json を含むディレクトリを返す
+func findRepoRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "docs", "api", "cache.json")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("docs/api/cache.json not found; run in the backlog-cli repository or specify --root")
+		}
+		dir = parent
+	}
+}
+
+func loadCoverage(root string) (Coverage, error) {
+	docs, err := loadDocEndpoints(filepath.Join(root, "docs", "api", "cache.json"))
+	if err != nil {
+		return Coverage{}, fmt.Errorf("failed to load API docs cache: %w", err)
+	}
+	specOps, err := loadSpecOperations(filepath.Join(root, "docs", "api", "openapi.yaml"))
+	if err != nil {
+		return Coverage{}, fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+	internal := filepath.Join(root, "packages", "backlog", "internal")
+	generated, err := loadGeneratedOperations(filepath.Join(internal, "gen", "backlog", "oas_operations_gen.go"))
+	if err != nil {
+		return Coverage{}, fmt.Errorf("failed to load generated operations: %w", err)
+	}
+	client, err := scanClientEndpoints(filepath.Join(internal, "api"))
+	if err != nil {
+		return Coverage{}, fmt.Errorf("failed to scan API client: %w", err)
+	}
+	return buildCoverage(docs, specOps, generated, client), nil
+}
+
+func printCoverage(c Coverage, all bool) {
+	fmt.Printf("Coverage:  %s %.0f%% (%d/%d endpoints)\n\n", ui.ProgressBar(c.Ratio, 30), c.Ratio*100, c.Covered, c.Total)
+
+	if all {
+		fmt.Println(ui.Bold("Implemented"))
+		printEntries(c.Implemented, true)
+		fmt.Println()
+	}
+
+	fmt.Println(ui.Bold(fmt.Sprintf("Not implemented (%d)", len(c.Missing))))
+	printEntries(c.Missing, false)
+
+	if len(c.Undocumented) > 0 {
+		fmt.Println()
+		fmt.Println(ui.Bold(fmt.Sprintf("Not in the API docs (%d)", len(c.Undocumented))))
+		printEntries(c.Undocumented, true)
+	}
+
+	if len(c.StaleOperations) > 0 {
+		fmt.Println()
+		ui.Warning("generated client is out of date with docs/api/openapi.yaml: %s", strings.Join(c.StaleOperations, ", "))
+		fmt.Fprintln(os.Stderr, "Run 'make generate' to regenerate it.")
+	}
+}
+
+func printEntries(entries []CoverageEntry, withSource bool) {
+	if len(entries) == 0 {
+		fmt.Println("  (none)")
+		return
+	}
+	headers := []string{"METHOD", "PATH", "TITLE"}
+	if withSource {
+		headers = append(headers, "SOURCE")
+	}
+	table := ui.NewTable(headers...)
+	for _, e := range entries {
+		row := []string{e.Method, e.Path, e.Title}
+		if withSource {
+			row = append(row, strings.Join(e.Source, ","))
+		}
+		table.AddRow(row...)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+}
+
+// printCoverageMarkdown はドキュメント生成用に全エンドポイントの対応表を Markdown で出力する
+func printCoverageMarkdown(c Coverage) {
+	fmt.Printf("# Backlog API coverage\n\n")
+	fmt.Printf("%d / %d endpoints (%.0f%%)\n\n", c.Covered, c.Total, c.Ratio*100)
+	fmt.Println("| Method | Path | Title | Supported |")
+	fmt.Println("|---|---|---|---|")
+	entries := append(append([]CoverageEntry{}, c.Implemented...), c.Missing...)
+	sortEntries(entries)
+	for _, e := range entries {
+		title := e.Title
+		if e.URL != "" {
+			title = fmt.Sprintf("[%s](%s)", e.Title, e.URL)
+		}
+		supported := ""
+		if len(e.Source) > 0 {
+			supported = "✓"
+		}
+		fmt.Printf("| %s | `%s` | %s | %s |\n", e.Method, e.Path, title, supported)
+	}
+}
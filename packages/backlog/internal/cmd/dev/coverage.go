@@ -0,0 +1,404 @@
+package dev
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Endpoint は HTTP メソッドと正規化したパスの組
+type Endpoint struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+func (e Endpoint) String() string {
+	return e.Method + " " + e.Path
+}
+
+// docEndpoint は docs/api/cache.json に記録された公開 API
+type docEndpoint struct {
+	Endpoint
+	Title string
+	URL   string
+}
+
+// CoverageEntry はエンドポイントごとの対応状況
+type CoverageEntry struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Title  string `json:"title,omitempty"`
+	URL    string `json:"url,omitempty"`
+	// Source は実装箇所（openapi: ogen 生成クライアント, client: internal/api の手書きラッパー）
+	Source []string `json:"source,omitempty"`
+}
+
+// Coverage は API coverage レポート
+type Coverage struct {
+	Total       int             `json:"total"`
+	Covered     int             `json:"covered"`
+	Ratio       float64         `json:"ratio"`
+	Implemented []CoverageEntry `json:"implemented"`
+	Missing     []CoverageEntry `json:"missing"`
+	// Undocumented は実装済みだが公開 API 一覧に無いエンドポイント（廃止・パス誤りの可能性）
+	Undocumented []CoverageEntry `json:"undocumented"`
+	// StaleOperations は openapi.yaml と生成コードで operationId が一致しないもの（make generate が必要）
+	StaleOperations []string `json:"staleOperations"`
+}
+
+var (
+	// pathParamPattern は {issueIdOrKey} や :id 形式のパスパラメーター
+	pathParamPattern = regexp.MustCompile(`\{[^}]*\}|:[A-Za-z]+`)
+	// formatVerbPattern は fmt.Sprintf のフォーマット指定子
+	formatVerbPattern = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z]`)
+)
+
+// normalizePath はパスパラメーターを {} に揃え、比較できる形にする
+func normalizePath(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	path = strings.TrimPrefix(path, "/api/v2")
+	path = formatVerbPattern.ReplaceAllString(path, "{}")
+	path = pathParamPattern.ReplaceAllString(path, "{}")
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+// loadDocEndpoints は docs/api/cache.json から公開 API の一覧を読み込む
+func loadDocEndpoints(path string) ([]docEndpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cache struct {
+		Endpoints map[string]struct {
+			Title  string `json:"title"`
+			Method string `json:"method"`
+			Path   string `json:"path"`
+		} `json:"endpoints"`
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	endpoints := make([]docEndpoint, 0, len(cache.Endpoints))
+	for url, e := range cache.Endpoints {
+		endpoints = append(endpoints, docEndpoint{
+			Endpoint: Endpoint{Method: strings.ToUpper(e.Method), Path: normalizePath(e.Path)},
+			Title:    e.Title,
+			URL:      url,
+		})
+	}
+	return endpoints, nil
+}
+
+// specOperation は openapi.yaml に定義された操作
+type specOperation struct {
+	Endpoint
+	OperationID string
+}
+
+// loadSpecOperations は docs/api/openapi.yaml から ogen の生成対象の操作を読み込む
+func loadSpecOperations(path string) ([]specOperation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec struct {
+		Paths map[string]map[string]struct {
+			OperationID string `yaml:"operationId"`
+		} `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	var ops []specOperation
+	for p, methods := range spec.Paths {
+		for method, op := range methods {
+			switch method {
+			case "get", "post", "put", "patch", "delete":
+			default:
+				// parameters など操作以外のキー
+				continue
+			}
+			ops = append(ops, specOperation{
+				Endpoint:    Endpoint{Method: strings.ToUpper(method), Path: normalizePath(p)},
+				OperationID: op.OperationID,
+			})
+		}
+	}
+	return ops, nil
+}
+
+// loadGeneratedOperations は ogen の生成コード（oas_operations_gen.go）から operationId を読み込む
+func loadGeneratedOperations(path string) (map[string]bool, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	ops := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Values) != len(spec.Names) {
+			return true
+		}
+		for i, v := range spec.Values {
+			if lit, ok := v.(*ast.BasicLit); ok && lit.Kind == token.STRING && strings.HasSuffix(spec.Names[i].Name, "Operation") {
+				if s, err := strconv.Unquote(lit.Value); err == nil {
+					ops[s] = true
+				}
+			}
+		}
+		return true
+	})
+	return ops, nil
+}
+
+// fixedMethodCalls は HTTP メソッドが固定の api.Client のヘルパー（第2引数がパス）
+var fixedMethodCalls = map[string]string{
+	"Get":            "GET",
+	"downloadRaw":    "GET",
+	"Post":           "POST",
+	"PostForm":       "POST",
+	"Patch":          "PATCH",
+	"PatchForm":      "PATCH",
+	"Delete":         "DELETE",
+	"DeleteWithForm": "DELETE",
+}
+
+// scanClientEndpoints は internal/api の手書きラッパーが呼び出すエンドポイントをソースから抽出する。
+// c.Get(ctx, "/path") のような固定メソッドのヘルパーと、
+// c.DoJSON(ctx, http.MethodGet, "/path", ...) のようにメソッドを引数で渡す呼び出しを対象にする
+func scanClientEndpoints(dir string) ([]Endpoint, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	seen := make(map[Endpoint]bool)
+	var endpoints []Endpoint
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			for _, e := range scanFunc(fn) {
+				if !seen[e] {
+					seen[e] = true
+					endpoints = append(endpoints, e)
+				}
+			}
+		}
+	}
+	sortEndpoints(endpoints)
+	return endpoints, nil
+}
+
+func scanFunc(fn *ast.FuncDecl) []Endpoint {
+	var endpoints []Endpoint
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		var method string
+		var pathArg ast.Expr
+		if m, ok := fixedMethodCalls[sel.Sel.Name]; ok {
+			method, pathArg = m, call.Args[1]
+		} else if len(call.Args) >= 3 {
+			if m := httpMethod(call.Args[1]); m != "" {
+				method, pathArg = m, call.Args[2]
+			}
+		}
+		if method == "" {
+			return true
+		}
+		for _, p := range resolvePaths(fn, pathArg) {
+			endpoints = append(endpoints, Endpoint{Method: method, Path: normalizePath(p)})
+		}
+		return true
+	})
+	return endpoints
+}
+
+// httpMethod は http.MethodGet や "GET" を HTTP メソッド名に変換する
+func httpMethod(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok && pkg.Name == "http" && strings.HasPrefix(e.Sel.Name, "Method") {
+			return strings.ToUpper(strings.TrimPrefix(e.Sel.Name, "Method"))
+		}
+	case *ast.BasicLit:
+		if s, err := strconv.Unquote(e.Value); err == nil {
+			switch s {
+			case "GET", "POST", "PUT", "PATCH", "DELETE":
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// resolvePaths はパス引数の式をパス文字列に解決する。
+// 変数の場合は関数内の代入を辿る（関数の引数は呼び出し側で解決されるため対象外）
+func resolvePaths(fn *ast.FuncDecl, expr ast.Expr) []string {
+	if ident, ok := expr.(*ast.Ident); ok {
+		var paths []string
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != len(assign.Rhs) {
+				return true
+			}
+			for i, lhs := range assign.Lhs {
+				if l, ok := lhs.(*ast.Ident); ok && l.Name == ident.Name {
+					if p, ok := pathString(assign.Rhs[i]); ok {
+						paths = append(paths, p)
+					}
+				}
+			}
+			return true
+		})
+		return paths
+	}
+	if p, ok := pathString(expr); ok {
+		return []string{p}
+	}
+	return nil
+}
+
+// pathString は文字列リテラル・fmt.Sprintf・文字列連結をパスに変換する（不明な部分は {}）
+func pathString(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(e.Value)
+		if err != nil || !strings.HasPrefix(s, "/") {
+			return "", false
+		}
+		return s, true
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Sprintf" || len(e.Args) == 0 {
+			return "", false
+		}
+		return pathString(e.Args[0])
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+		left, ok := pathString(e.X)
+		if !ok {
+			return "", false
+		}
+		right := "{}"
+		if lit, ok := e.Y.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if s, err := strconv.Unquote(lit.Value); err == nil {
+				right = s
+			}
+		}
+		return left + right, true
+	}
+	return "", false
+}
+
+func sortEndpoints(endpoints []Endpoint) {
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Path != endpoints[j].Path {
+			return endpoints[i].Path < endpoints[j].Path
+		}
+		return endpoints[i].Method < endpoints[j].Method
+	})
+}
+
+// buildCoverage は公開 API と実装済みのエンドポイントを突き合わせる
+func buildCoverage(docs []docEndpoint, specOps []specOperation, generated map[string]bool, client []Endpoint) Coverage {
+	sources := make(map[Endpoint][]string)
+	for _, op := range specOps {
+		sources[op.Endpoint] = append(sources[op.Endpoint], "openapi")
+	}
+	for _, e := range client {
+		sources[e] = append(sources[e], "client")
+	}
+
+	c := Coverage{
+		Total:           len(docs),
+		Implemented:     []CoverageEntry{},
+		Missing:         []CoverageEntry{},
+		Undocumented:    []CoverageEntry{},
+		StaleOperations: []string{},
+	}
+	documented := make(map[Endpoint]bool, len(docs))
+	for _, d := range docs {
+		documented[d.Endpoint] = true
+		entry := CoverageEntry{Method: d.Method, Path: d.Path, Title: d.Title, URL: d.URL, Source: sources[d.Endpoint]}
+		if len(entry.Source) > 0 {
+			c.Implemented = append(c.Implemented, entry)
+		} else {
+			c.Missing = append(c.Missing, entry)
+		}
+	}
+	c.Covered = len(c.Implemented)
+	if c.Total > 0 {
+		c.Ratio = float64(c.Covered) / float64(c.Total)
+	}
+	for e, src := range sources {
+		if !documented[e] {
+			c.Undocumented = append(c.Undocumented, CoverageEntry{Method: e.Method, Path: e.Path, Source: src})
+		}
+	}
+
+	specIDs := make(map[string]bool, len(specOps))
+	for _, op := range specOps {
+		if op.OperationID == "" {
+			continue
+		}
+		specIDs[op.OperationID] = true
+		if !generated[op.OperationID] {
+			c.StaleOperations = append(c.StaleOperations, op.OperationID)
+		}
+	}
+	for id := range generated {
+		if !specIDs[id] {
+			c.StaleOperations = append(c.StaleOperations, id)
+		}
+	}
+
+	for _, list := range [][]CoverageEntry{c.Implemented, c.Missing, c.Undocumented} {
+		sortEntries(list)
+	}
+	sort.Strings(c.StaleOperations)
+	return c
+}
+
+func sortEntries(entries []CoverageEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Path != entries[j].Path {
+			return entries[i].Path < entries[j].Path
+		}
+		return entries[i].Method < entries[j].Method
+	})
+}
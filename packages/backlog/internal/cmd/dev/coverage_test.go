@@ -0,0 +1,112 @@
+package dev
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizePath(t *testing.T) {
+	tests := map[string]string{
+		"/issues/{issueIdOrKey}/comments": "/issues/{}/comments",
+		"/api/v2/issues/:issueIdOrKey":    "/issues/{}",
+		"/projects/%s/versions/%d":        "/projects/{}/versions/{}",
+		"/notifications?count=20":         "/notifications",
+		"/space/":                         "/space",
+	}
+	for in, want := range tests {
+		if got := normalizePath(in); got != want {
+			t.Errorf("normalizePath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+const sampleClient = `package api
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetThing(ctx context.Context, id int) error {
+	_, err := c.Get(ctx, fmt.Sprintf("/things/%d", id), nil)
+	return err
+}
+
+func (c *Client) AddThing(ctx context.Context) error {
+	_, err := c.PostForm(ctx, "/things", nil)
+	return err
+}
+
+func (c *Client) ListSubThings(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/things/%s/subs", id)
+	return c.DoJSON(ctx, http.MethodGet, path, nil, nil, nil)
+}
+
+func (c *Client) DeleteThing(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, "/things/"+id)
+	return err
+}
+
+func (c *Client) download(ctx context.Context, path string) error {
+	_, err := c.Get(ctx, path, nil)
+	return err
+}
+`
+
+func TestScanClientEndpoints(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "thing.go"), []byte(sampleClient), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "thing_test.go"), []byte("package api\nfunc x() { c.Get(ctx, \"/ignored\", nil) }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := scanClientEndpoints(dir)
+	if err != nil {
+		t.Fatalf("scanClientEndpoints() error = %v", err)
+	}
+	want := []Endpoint{
+		{Method: "POST", Path: "/things"},
+		{Method: "DELETE", Path: "/things/{}"},
+		{Method: "GET", Path: "/things/{}"},
+		{Method: "GET", Path: "/things/{}/subs"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("scanClientEndpoints() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildCoverage(t *testing.T) {
+	docs := []docEndpoint{
+		{Endpoint: Endpoint{Method: "GET", Path: "/issues"}, Title: "課題一覧の取得"},
+		{Endpoint: Endpoint{Method: "POST", Path: "/issues"}, Title: "課題の追加"},
+		{Endpoint: Endpoint{Method: "GET", Path: "/stars"}, Title: "スター一覧"},
+		{Endpoint: Endpoint{Method: "POST", Path: "/stars"}, Title: "スターの追加"},
+	}
+	specOps := []specOperation{
+		{Endpoint: Endpoint{Method: "GET", Path: "/issues"}, OperationID: "GetIssues"},
+		{Endpoint: Endpoint{Method: "POST", Path: "/issues"}, OperationID: "CreateIssue"},
+	}
+	generated := map[string]bool{"GetIssues": true, "RemovedOp": true}
+	client := []Endpoint{
+		{Method: "POST", Path: "/stars"},
+		{Method: "GET", Path: "/legacy"},
+	}
+
+	c := buildCoverage(docs, specOps, generated, client)
+	if c.Total != 4 || c.Covered != 3 || c.Ratio != 0.75 {
+		t.Errorf("Total/Covered/Ratio = %d/%d/%v, want 4/3/0.75", c.Total, c.Covered, c.Ratio)
+	}
+	if len(c.Missing) != 1 || c.Missing[0].Path != "/stars" || c.Missing[0].Method != "GET" {
+		t.Errorf("Missing = %+v", c.Missing)
+	}
+	if len(c.Undocumented) != 1 || c.Undocumented[0].Path != "/legacy" {
+		t.Errorf("Undocumented = %+v", c.Undocumented)
+	}
+	if want := []string{"CreateIssue", "RemovedOp"}; !reflect.DeepEqual(c.StaleOperations, want) {
+		t.Errorf("StaleOperations = %v, want %v", c.StaleOperations, want)
+	}
+}
@@ -0,0 +1,19 @@
+package dev
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var DevCmd = &cobra.Command{
+	Use:    "dev",
+	Short:  "Tools for backlog-cli developers",
+	Hidden: true,
+	Long: `Tools for developing backlog-cli itself.
+
+These commands read files in a checkout of the backlog-cli repository
+and are not needed for everyday use.`,
+}
+
+func init() {
+	DevCmd.AddCommand(apiCoverageCmd)
+}
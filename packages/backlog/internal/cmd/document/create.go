@@ -113,9 +113,11 @@ func runCreate(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(doc)
 	default:
-		fmt.Printf("%s Document created: %s (ID: %s)\n", ui.Green("✓"), doc.Title, doc.ID)
+		cmdutil.Success(doc.ID, "Document created: %s (ID: %s)", doc.Title, doc.ID)
 		url := fmt.Sprintf("https://%s/document/%s", profile.Space, doc.ID)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
@@ -77,7 +77,7 @@ func runDelete(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(deleted)
 	default:
-		ui.Success("Deleted document: %s (ID: %s)", deleted.Title, deleted.ID)
+		cmdutil.Success(deleted.ID, "Deleted document: %s (ID: %s)", deleted.Title, deleted.ID)
 		return nil
 	}
 }
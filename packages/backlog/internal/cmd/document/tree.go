@@ -69,13 +69,7 @@ func runTree(c *cobra.Command, args []string) error {
 
 func printTree(nodes []api.DocumentTreeNode, prefix string) {
 	for i, node := range nodes {
-		isLast := i == len(nodes)-1
-		connector := "├─"
-		childPrefix := prefix + "│  "
-		if isLast {
-			connector = "└─"
-			childPrefix = prefix + "   "
-		}
+		connector, childPrefix := ui.TreeBranch(prefix, i == len(nodes)-1)
 
 		label := node.Name
 		if node.Emoji != "" {
@@ -4,12 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"strings"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -48,7 +47,7 @@ func runView(c *cobra.Command, args []string) error {
 
 	if viewWeb {
 		url := fmt.Sprintf("https://%s/document/%s", profile.Space, documentID)
-		return browser.OpenURL(url)
+		return osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
 	}
 
 	doc, err := client.GetDocument(c.Context(), documentID)
@@ -76,7 +75,7 @@ func renderDocumentDetail(doc *api.DocumentDetail, space string) error {
 		title = doc.Emoji + " " + title
 	}
 	fmt.Printf("%s\n", ui.Bold(title))
-	fmt.Println(strings.Repeat("─", 60))
+	fmt.Println(ui.Rule(60))
 
 	if len(doc.Tags) > 0 {
 		fmt.Printf("Tags:    %s\n", ui.Cyan(joinTags(doc.Tags)))
@@ -99,7 +98,7 @@ func renderDocumentDetail(doc *api.DocumentDetail, space string) error {
 	if doc.Plain != "" {
 		fmt.Println()
 		fmt.Println(ui.Bold("Content"))
-		fmt.Println(strings.Repeat("─", 60))
+		fmt.Println(ui.Rule(60))
 		fmt.Println(doc.Plain)
 	}
 
@@ -0,0 +1,34 @@
+package draft
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	internaldraft "github.com/yacchi/backlog-cli/packages/backlog/internal/draft"
+)
+
+var DraftCmd = &cobra.Command{
+	Use:   "draft",
+	Short: "Manage comment drafts that could not be posted",
+	Long: `Manage comment drafts saved locally.
+
+When "issue comment" cannot post a comment because the editor exited
+abnormally or the API returned an error, the text is saved as a draft in
+~/.local/state/backlog/drafts ($XDG_STATE_HOME/backlog/drafts) instead of
+being lost. Run "backlog draft resume" to edit and post it again.`,
+}
+
+func init() {
+	DraftCmd.AddCommand(listCmd)
+	DraftCmd.AddCommand(resumeCmd)
+	DraftCmd.AddCommand(dropCmd)
+}
+
+func openDrafts() (*internaldraft.Dir, error) {
+	path, err := config.DraftsDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve drafts directory: %w", err)
+	}
+	return internaldraft.Open(path), nil
+}
@@ -0,0 +1,91 @@
+package draft
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	internaldraft "github.com/yacchi/backlog-cli/packages/backlog/internal/draft"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var dropCmd = &cobra.Command{
+	Use:     "drop [id...]",
+	Aliases: []string{"rm"},
+	Short:   "Remove drafts without posting them",
+	Long: `Remove drafts without posting them.
+
+Examples:
+  backlog draft drop 3
+  backlog draft drop --all --yes`,
+	RunE: runDrop,
+}
+
+var dropAll bool
+
+func init() {
+	dropCmd.Flags().BoolVar(&dropAll, "all", false, "Remove all drafts")
+}
+
+func runDrop(c *cobra.Command, args []string) error {
+	if dropAll == (len(args) > 0) {
+		return fmt.Errorf("specify draft IDs or --all")
+	}
+
+	d, err := openDrafts()
+	if err != nil {
+		return err
+	}
+	var targets []internaldraft.Draft
+	if dropAll {
+		if targets, err = d.List(); err != nil {
+			return err
+		}
+	} else {
+		for _, arg := range args {
+			id, err := strconv.Atoi(arg)
+			if err != nil {
+				return fmt.Errorf("invalid draft ID %q", arg)
+			}
+			dr, err := d.Get(id)
+			if err != nil {
+				return err
+			}
+			targets = append(targets, *dr)
+		}
+	}
+	if len(targets) == 0 {
+		fmt.Println("No drafts.")
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog draft drop",
+				"Use --yes to skip the confirmation prompt.",
+			)
+		}
+		for _, dr := range targets {
+			fmt.Printf("  #%d %s %s\n", dr.ID, dr.IssueKey, dr.Summary())
+		}
+		ok, err := ui.Confirm(fmt.Sprintf("Remove %d draft(s)?", len(targets)), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	for _, dr := range targets {
+		if err := d.Delete(dr.ID); err != nil {
+			return err
+		}
+	}
+	cmdutil.Success("", "Removed %d draft(s)", len(targets))
+	return nil
+}
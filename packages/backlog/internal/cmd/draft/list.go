@@ -0,0 +1,57 @@
+package draft
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List saved drafts",
+	Long: `List comment drafts saved locally.
+
+Examples:
+  backlog draft list
+  backlog draft list --output json`,
+	Args: cobra.NoArgs,
+	RunE: runList,
+}
+
+func runList(c *cobra.Command, args []string) error {
+	d, err := openDrafts()
+	if err != nil {
+		return err
+	}
+	drafts, err := d.List()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := cmdutil.GetConfigStore(c)
+	if err != nil {
+		return err
+	}
+	if cfg.CurrentProfile().Output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(drafts)
+	}
+
+	if len(drafts) == 0 {
+		fmt.Println("No drafts.")
+		return nil
+	}
+	table := ui.NewTable("ID", "SPACE", "ISSUE", "SAVED", "COMMENT", "REASON")
+	for _, dr := range drafts {
+		table.AddRow(strconv.Itoa(dr.ID), dr.Space, dr.IssueKey, dr.SavedAt.Local().Format("2006-01-02 15:04"), dr.Summary(), dr.Reason)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	return nil
+}
@@ -0,0 +1,170 @@
+package draft
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	internaldraft "github.com/yacchi/backlog-cli/packages/backlog/internal/draft"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume [id]",
+	Short: "Edit a draft and post it as a comment",
+	Long: `Open a draft in the editor and post it as a comment on its issue.
+
+Without an ID, the most recent draft for the current space is resumed.
+The draft is removed once the comment is posted. If the editor exits
+abnormally or posting fails again, the edited text is kept in the draft.
+
+Examples:
+  backlog draft resume
+  backlog draft resume 3
+  backlog draft resume 3 --no-edit`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runResume,
+}
+
+var resumeNoEdit bool
+
+func init() {
+	resumeCmd.Flags().BoolVar(&resumeNoEdit, "no-edit", false, "Post the draft as-is without opening the editor")
+}
+
+func runResume(c *cobra.Command, args []string) error {
+	d, err := openDrafts()
+	if err != nil {
+		return err
+	}
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	profile := cfg.CurrentProfile()
+
+	var dr *internaldraft.Draft
+	if len(args) == 1 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid draft ID %q", args[0])
+		}
+		if dr, err = d.Get(id); err != nil {
+			return err
+		}
+	} else {
+		drafts, err := d.List()
+		if err != nil {
+			return err
+		}
+		if dr = latestDraft(drafts, profile.Space); dr == nil {
+			return fmt.Errorf("no drafts for %s", profile.Space)
+		}
+	}
+	if dr.Space != profile.Space {
+		return fmt.Errorf("draft #%d is for %s; switch profile with --profile to resume it", dr.ID, dr.Space)
+	}
+
+	message := dr.Content
+	if !resumeNoEdit {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--no-edit is required when not running interactively",
+				"backlog draft resume",
+				"Use --no-edit to post the draft without opening the editor.",
+			)
+		}
+		message, err = cmdutil.EditorFunc(cfg)(dr.Content)
+		if err != nil {
+			keepDraft(d, dr, message, err)
+			return fmt.Errorf("failed to open editor: %w", err)
+		}
+	}
+	message = cmdutil.ExpandEmoji(cfg.Display(), message)
+	if message == "" {
+		return fmt.Errorf("comment is empty; draft #%d is kept (remove it with 'backlog draft drop %d')", dr.ID, dr.ID)
+	}
+	if err := cmdutil.CheckSecrets(cfg, "comment", message); err != nil {
+		keepDraft(d, dr, message, err)
+		return err
+	}
+
+	ctx := c.Context()
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "comment", hookEvent(dr.IssueKey, profile.Space, nil)); err != nil {
+		keepDraft(d, dr, message, err)
+		return err
+	}
+	comment, err := client.AddComment(ctx, dr.IssueKey, message, nil, nil)
+	if err != nil {
+		keepDraft(d, dr, message, err)
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "comment", hookEvent(dr.IssueKey, profile.Space, comment))
+
+	if err := d.Delete(dr.ID); err != nil {
+		ui.Warning("Comment was posted but draft #%d could not be removed: %v", dr.ID, err)
+	}
+
+	switch profile.Output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(comment)
+	default:
+		cmdutil.Success(strconv.Itoa(comment.ID), "Added comment #%d to %s from draft #%d", comment.ID, dr.IssueKey, dr.ID)
+		url := fmt.Sprintf("https://%s/view/%s#comment-%d", profile.Space, dr.IssueKey, comment.ID)
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
+		return nil
+	}
+}
+
+// latestDraft は space の下書きのうち最後に保存したものを返す（無ければ nil）
+func latestDraft(drafts []internaldraft.Draft, space string) *internaldraft.Draft {
+	var latest *internaldraft.Draft
+	for i := range drafts {
+		dr := &drafts[i]
+		if dr.Space != space {
+			continue
+		}
+		if latest == nil || !dr.SavedAt.Before(latest.SavedAt) {
+			latest = dr
+		}
+	}
+	return latest
+}
+
+// keepDraft は再開中に編集した内容を下書きに書き戻す
+// 書き戻せなくても元の下書きは残っているため、警告にとどめる
+func keepDraft(d *internaldraft.Dir, dr *internaldraft.Draft, content string, cause error) {
+	if content == "" {
+		return
+	}
+	dr.Content = content
+	dr.SavedAt = time.Now()
+	dr.Reason = cause.Error()
+	if err := d.Save(*dr); err != nil {
+		ui.Warning("Failed to update draft #%d: %v", dr.ID, err)
+		return
+	}
+	ui.Warning("Kept the comment in draft #%d", dr.ID)
+}
+
+// hookEvent は課題フックに渡すイベントを組み立てる
+func hookEvent(key, space string, result any) cmdutil.HookEvent {
+	projectKey, _, _ := cmdutil.ParseIssueKey(key)
+	ev := cmdutil.HookEvent{
+		Key:        key,
+		ProjectKey: projectKey,
+		URL:        fmt.Sprintf("https://%s/view/%s", space, key),
+	}
+	if result != nil {
+		ev.JSON, _ = json.Marshal(result)
+	}
+	return ev
+}
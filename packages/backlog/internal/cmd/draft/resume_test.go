@@ -0,0 +1,27 @@
+package draft
+
+import (
+	"testing"
+	"time"
+
+	internaldraft "github.com/yacchi/backlog-cli/packages/backlog/internal/draft"
+)
+
+func TestLatestDraft(t *testing.T) {
+	base := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	drafts := []internaldraft.Draft{
+		{ID: 1, Space: "a.backlog.jp", SavedAt: base},
+		{ID: 2, Space: "b.backlog.jp", SavedAt: base.Add(2 * time.Hour)},
+		{ID: 3, Space: "a.backlog.jp", SavedAt: base.Add(time.Hour)},
+	}
+
+	if got := latestDraft(drafts, "a.backlog.jp"); got == nil || got.ID != 3 {
+		t.Errorf("latestDraft(a) = %+v, want #3", got)
+	}
+	if got := latestDraft(drafts, "b.backlog.jp"); got == nil || got.ID != 2 {
+		t.Errorf("latestDraft(b) = %+v, want #2", got)
+	}
+	if got := latestDraft(drafts, "c.backlog.jp"); got != nil {
+		t.Errorf("latestDraft(c) = %+v, want nil", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -18,6 +19,8 @@ const (
 	ExitAuth     ExitCode = 2
 	ExitNotFound ExitCode = 3
 	ExitConfig   ExitCode = 4
+	// ExitInterrupted は Ctrl+C（SIGINT）で中断された場合の終了コード（128 + SIGINT）
+	ExitInterrupted ExitCode = 130
 )
 
 // HandleError はエラーを処理して適切なメッセージを表示する
@@ -26,6 +29,11 @@ func HandleError(err error) ExitCode {
 		return ExitOK
 	}
 
+	if errors.Is(err, cmdutil.ErrInterrupted) {
+		ui.Error("Interrupted")
+		return ExitInterrupted
+	}
+
 	var apiErr *api.APIError
 	if errors.As(err, &apiErr) {
 		return handleAPIError(apiErr)
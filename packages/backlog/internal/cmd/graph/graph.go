@@ -0,0 +1,247 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/links"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+var GraphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Output issue and pull request relations as a graph",
+	Long: `Output the relations between issues and pull requests of a project as a
+Graphviz dot or Mermaid flowchart document.
+
+Edges:
+  parent    parent issue -> child issue
+  mentions  issue -> issue key referenced in its description (dashed)
+  pr        pull request -> related issue
+
+Issues outside the selection (e.g. a parent in another milestone) are drawn
+as dashed nodes. Use -o json to get the nodes and edges as JSON.
+
+Examples:
+  backlog graph --project PROJ --milestone v2 --format dot | dot -Tsvg -o graph.svg
+  backlog graph --project PROJ --milestone v2 --format mermaid > graph.mmd
+  backlog graph --project PROJ --no-prs`,
+	Args:        cobra.NoArgs,
+	RunE:        runGraph,
+	Annotations: cmdutil.AutoSave(),
+}
+
+var (
+	graphMilestone string
+	graphFormat    string
+	graphNoPRs     bool
+)
+
+func init() {
+	GraphCmd.Flags().StringVarP(&graphMilestone, "milestone", "m", "", "Milestone IDs or names (comma-separated)")
+	GraphCmd.Flags().StringVar(&graphFormat, "format", "dot", "Graph format: {dot|mermaid}")
+	GraphCmd.Flags().BoolVar(&graphNoPRs, "no-prs", false, "Do not include pull requests")
+}
+
+func runGraph(c *cobra.Command, args []string) error {
+	switch graphFormat {
+	case "dot", "mermaid":
+	default:
+		return fmt.Errorf("invalid --format %q (must be dot or mermaid)", graphFormat)
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+
+	ctx := c.Context()
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	profile := cfg.CurrentProfile()
+
+	project, err := client.GetProject(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	opts := &api.IssueListOptions{ProjectIDs: []int{project.ID}}
+	if graphMilestone != "" {
+		milestoneIDs, err := cmdutil.ResolveMilestoneIDs(ctx, client, projectKey, graphMilestone)
+		if err != nil {
+			return fmt.Errorf("failed to resolve milestones: %w", err)
+		}
+		opts.MilestoneIDs = milestoneIDs
+	}
+	issues, err := fetchAllIssues(ctx, client, opts)
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+
+	projects, err := client.GetProjects(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %w", err)
+	}
+	projectKeys := make(map[string]bool, len(projects))
+	for _, p := range projects {
+		projectKeys[p.ProjectKey] = true
+	}
+
+	var prs []repoPullRequest
+	if !graphNoPRs && len(issues) > 0 {
+		prs, err = fetchRelatedPullRequests(ctx, client, projectKey, issues)
+		if err != nil {
+			return err
+		}
+	}
+
+	g := buildGraph(issues, prs, projectKeys, profile.Space, func(id int) (*backlog.Issue, error) {
+		return client.GetIssue(ctx, strconv.Itoa(id))
+	})
+
+	switch {
+	case profile.Output == "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Nodes []Node `json:"nodes"`
+			Edges []Edge `json:"edges"`
+		}{g.Nodes(), g.Edges()})
+	case graphFormat == "mermaid":
+		return writeMermaid(os.Stdout, g)
+	default:
+		return writeDot(os.Stdout, g)
+	}
+}
+
+// buildGraph は課題とプルリクエストからグラフを組み立てる
+// 一覧外の親課題は getIssue で取得して外部ノードとして加える（取得に失敗した場合は ID だけで表示する）
+func buildGraph(issues []backlog.Issue, prs []repoPullRequest, projectKeys map[string]bool, spaceHost string, getIssue func(id int) (*backlog.Issue, error)) *Graph {
+	g := newGraph()
+	keyByID := make(map[int]string, len(issues))
+	for _, issue := range issues {
+		g.addNode(issueNode(issue, false))
+		keyByID[issue.ID.Value] = issue.IssueKey.Value
+	}
+
+	for _, issue := range issues {
+		key := issue.IssueKey.Value
+		if parentID, ok := issue.ParentIssueId.Get(); ok {
+			parentKey, found := keyByID[parentID]
+			if !found {
+				parentKey = fmt.Sprintf("#%d", parentID)
+				if parent, err := getIssue(parentID); err == nil && parent.IssueKey.Value != "" {
+					parentKey = parent.IssueKey.Value
+					g.addNode(issueNode(*parent, true))
+				} else {
+					g.addNode(Node{ID: parentKey, Kind: nodeIssue, External: true})
+				}
+				keyByID[parentID] = parentKey
+			}
+			g.addEdge(parentKey, key, edgeParent)
+		}
+
+		for _, ref := range links.ExtractRefs(issue.Description.Value, projectKeys, spaceHost) {
+			if ref.Kind != links.RefIssue || ref.Target == key {
+				continue
+			}
+			if !g.hasNode(ref.Target) {
+				g.addNode(Node{ID: ref.Target, Kind: nodeIssue, External: true})
+			}
+			g.addEdge(key, ref.Target, edgeMentions)
+		}
+	}
+
+	for _, pr := range prs {
+		if pr.Issue == nil {
+			continue
+		}
+		issueKey, ok := keyByID[pr.Issue.ID]
+		if !ok {
+			continue
+		}
+		id := fmt.Sprintf("%s#%d", pr.Repo, pr.Number)
+		g.addNode(Node{ID: id, Kind: nodePR, Label: pr.Summary, Status: pr.Status.Name})
+		g.addEdge(id, issueKey, edgePR)
+	}
+	return g
+}
+
+func issueNode(issue backlog.Issue, external bool) Node {
+	return Node{
+		ID:       issue.IssueKey.Value,
+		Kind:     nodeIssue,
+		Label:    issue.Summary.Value,
+		Status:   issue.Status.Value.Name.Value,
+		External: external,
+	}
+}
+
+// repoPullRequest はリポジトリ名付きのプルリクエスト（ノード ID は リポジトリ名#番号）
+type repoPullRequest struct {
+	Repo string
+	api.PullRequest
+}
+
+func fetchAllIssues(ctx context.Context, client *api.Client, opts *api.IssueListOptions) ([]backlog.Issue, error) {
+	all := make([]backlog.Issue, 0)
+	for offset := 0; ; offset += 100 {
+		page := *opts
+		page.Offset = offset
+		page.Count = 100
+		page.Order = "asc"
+		issues, err := client.GetIssues(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, issues...)
+		if len(issues) < 100 {
+			return all, nil
+		}
+	}
+}
+
+// fetchRelatedPullRequests は課題に関連付けられたプルリクエストを全リポジトリから取得する
+func fetchRelatedPullRequests(ctx context.Context, client *api.Client, projectKey string, issues []backlog.Issue) ([]repoPullRequest, error) {
+	repos, err := client.GetRepositories(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+	issueIDs := make([]int, 0, len(issues))
+	for _, issue := range issues {
+		issueIDs = append(issueIDs, issue.ID.Value)
+	}
+
+	var prs []repoPullRequest
+	for _, repo := range repos {
+		// issueId[] が多すぎると URL が長くなるため分割して問い合わせる
+		for start := 0; start < len(issueIDs); start += 50 {
+			end := min(start+50, len(issueIDs))
+			for offset := 0; ; offset += 100 {
+				page, err := client.GetPullRequests(ctx, projectKey, repo.Name, &api.PRListOptions{
+					IssueIDs: issueIDs[start:end],
+					Offset:   offset,
+					Count:    100,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to get pull requests of %s: %w", repo.Name, err)
+				}
+				for _, pr := range page {
+					prs = append(prs, repoPullRequest{Repo: repo.Name, PullRequest: pr})
+				}
+				if len(page) < 100 {
+					break
+				}
+			}
+		}
+	}
+	return prs, nil
+}
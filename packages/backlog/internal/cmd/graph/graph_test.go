@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func testIssue(id int, key, summary, status, description string, parentID int) backlog.Issue {
+	issue := backlog.Issue{
+		ID:          backlog.NewOptInt(id),
+		IssueKey:    backlog.NewOptString(key),
+		Summary:     backlog.NewOptString(summary),
+		Description: backlog.NewOptString(description),
+		Status:      backlog.NewOptStatus(backlog.Status{Name: backlog.NewOptString(status)}),
+	}
+	if parentID != 0 {
+		issue.ParentIssueId = backlog.NewOptNilInt(parentID)
+	}
+	return issue
+}
+
+func TestBuildGraph(t *testing.T) {
+	issues := []backlog.Issue{
+		testIssue(1, "PROJ-1", "Epic", "処理中", "", 0),
+		testIssue(2, "PROJ-2", "Child", "未対応", "depends on PROJ-3 and PROJ-2", 1),
+		testIssue(3, "PROJ-3", "Other", "完了", "", 99),
+	}
+	prs := []repoPullRequest{
+		{Repo: "app", PullRequest: api.PullRequest{Number: 7, Summary: "Fix", Status: api.PRStatus{Name: "Open"}, Issue: &api.Issue{ID: 2}}},
+		{Repo: "app", PullRequest: api.PullRequest{Number: 8, Summary: "Unrelated", Issue: &api.Issue{ID: 500}}},
+		{Repo: "app", PullRequest: api.PullRequest{Number: 9, Summary: "No issue"}},
+	}
+	getIssue := func(id int) (*backlog.Issue, error) {
+		if id == 99 {
+			issue := testIssue(99, "OTHER-1", "Outside", "未対応", "", 0)
+			return &issue, nil
+		}
+		return nil, errors.New("not found")
+	}
+
+	g := buildGraph(issues, prs, map[string]bool{"PROJ": true, "OTHER": true}, "", getIssue)
+
+	wantEdges := []Edge{
+		{From: "OTHER-1", To: "PROJ-3", Kind: edgeParent},
+		{From: "PROJ-1", To: "PROJ-2", Kind: edgeParent},
+		{From: "PROJ-2", To: "PROJ-3", Kind: edgeMentions},
+		{From: "app#7", To: "PROJ-2", Kind: edgePR},
+	}
+	gotEdges := g.Edges()
+	if len(gotEdges) != len(wantEdges) {
+		t.Fatalf("Edges() = %v, want %v", gotEdges, wantEdges)
+	}
+	for i := range wantEdges {
+		if gotEdges[i] != wantEdges[i] {
+			t.Errorf("Edges()[%d] = %v, want %v", i, gotEdges[i], wantEdges[i])
+		}
+	}
+
+	nodes := make(map[string]Node)
+	for _, n := range g.Nodes() {
+		nodes[n.ID] = n
+	}
+	if len(nodes) != 5 {
+		t.Errorf("Nodes() has %d nodes, want 5: %v", len(nodes), g.Nodes())
+	}
+	if n := nodes["OTHER-1"]; !n.External || n.Label != "Outside" {
+		t.Errorf("OTHER-1 = %+v, want external node with label", n)
+	}
+	if n := nodes["PROJ-3"]; n.External {
+		t.Errorf("PROJ-3 should not be external")
+	}
+	if n := nodes["app#7"]; n.Kind != nodePR || n.Status != "Open" {
+		t.Errorf("app#7 = %+v, want pr node", n)
+	}
+}
+
+func TestBuildGraphUnknownParent(t *testing.T) {
+	issues := []backlog.Issue{testIssue(2, "PROJ-2", "Child", "", "", 42)}
+	g := buildGraph(issues, nil, nil, "", func(int) (*backlog.Issue, error) {
+		return nil, errors.New("forbidden")
+	})
+	edges := g.Edges()
+	if len(edges) != 1 || edges[0] != (Edge{From: "#42", To: "PROJ-2", Kind: edgeParent}) {
+		t.Errorf("Edges() = %v", edges)
+	}
+	if !g.nodes["#42"].External {
+		t.Error("unknown parent should be an external node")
+	}
+}
+
+func TestAddNodeExternalOverride(t *testing.T) {
+	g := newGraph()
+	g.addNode(Node{ID: "PROJ-1", Kind: nodeIssue, External: true})
+	g.addNode(Node{ID: "PROJ-1", Kind: nodeIssue, Label: "Real"})
+	g.addNode(Node{ID: "PROJ-1", Kind: nodeIssue, Label: "Ignored", External: true})
+	nodes := g.Nodes()
+	if len(nodes) != 1 || nodes[0].External || nodes[0].Label != "Real" {
+		t.Errorf("Nodes() = %+v", nodes)
+	}
+}
+
+func TestWriteFormats(t *testing.T) {
+	g := newGraph()
+	g.addNode(Node{ID: "PROJ-1", Kind: nodeIssue, Label: `Say "hi"`})
+	g.addNode(Node{ID: "PROJ-2", Kind: nodeIssue, External: true})
+	g.addNode(Node{ID: "app#1", Kind: nodePR, Label: "PR"})
+	g.addEdge("PROJ-1", "PROJ-2", edgeMentions)
+	g.addEdge("app#1", "PROJ-1", edgePR)
+
+	tests := []struct {
+		name  string
+		write func(*strings.Builder, *Graph) error
+		want  []string
+	}{
+		{
+			name:  "dot",
+			write: func(b *strings.Builder, g *Graph) error { return writeDot(b, g) },
+			want: []string{
+				"digraph backlog {",
+				`"PROJ-1" [label="PROJ-1: Say \"hi\""];`,
+				`"PROJ-2" [label="PROJ-2", style=dashed];`,
+				`"app#1" [label="app#1: PR", shape=ellipse];`,
+				`"PROJ-1" -> "PROJ-2" [label="mentions", style=dashed];`,
+				`"app#1" -> "PROJ-1" [label="pr"];`,
+			},
+		},
+		{
+			name:  "mermaid",
+			write: func(b *strings.Builder, g *Graph) error { return writeMermaid(b, g) },
+			want: []string{
+				"flowchart LR",
+				`n1["PROJ-1: Say #quot;hi#quot;"]`,
+				"class n2 external",
+				`n3(["app#1: PR"])`,
+				"n1 -.->|mentions| n2",
+				"n3 -->|pr| n1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := tt.write(&b, g); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(b.String(), want) {
+					t.Errorf("output missing %q:\n%s", want, b.String())
+				}
+			}
+		})
+	}
+}
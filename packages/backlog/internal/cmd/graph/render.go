@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ノードの種類
+const (
+	nodeIssue = "issue"
+	nodePR    = "pr"
+)
+
+// エッジの種類
+const (
+	edgeParent   = "parent"   // 親課題 → 子課題
+	edgeMentions = "mentions" // 本文で言及した課題 → 言及された課題
+	edgePR       = "pr"       // プルリクエスト → 関連課題
+)
+
+// Node はグラフのノード（課題またはプルリクエスト）
+type Node struct {
+	ID     string `json:"id"`
+	Kind   string `json:"kind"`
+	Label  string `json:"label"`
+	Status string `json:"status,omitempty"`
+	// External は対象の課題一覧に含まれない（マイルストーン外などの）ノード
+	External bool `json:"external,omitempty"`
+}
+
+// Edge はノード間の関係
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+// Graph は課題の相互参照グラフ
+type Graph struct {
+	nodes map[string]*Node
+	order []string
+	edges map[Edge]bool
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		nodes: make(map[string]*Node),
+		edges: make(map[Edge]bool),
+	}
+}
+
+// addNode はノードを追加する。既にある外部ノードは対象ノードで上書きする
+func (g *Graph) addNode(n Node) {
+	if existing, ok := g.nodes[n.ID]; ok {
+		if existing.External && !n.External {
+			*existing = n
+		}
+		return
+	}
+	g.nodes[n.ID] = &n
+	g.order = append(g.order, n.ID)
+}
+
+func (g *Graph) hasNode(id string) bool {
+	_, ok := g.nodes[id]
+	return ok
+}
+
+// addEdge はエッジを追加する（自己ループと重複は無視する）
+func (g *Graph) addEdge(from, to, kind string) {
+	if from == to {
+		return
+	}
+	g.edges[Edge{From: from, To: to, Kind: kind}] = true
+}
+
+// Nodes は追加順のノード一覧を返す
+func (g *Graph) Nodes() []Node {
+	out := make([]Node, 0, len(g.order))
+	for _, id := range g.order {
+		out = append(out, *g.nodes[id])
+	}
+	return out
+}
+
+// Edges は出力が安定するよう並べたエッジ一覧を返す
+func (g *Graph) Edges() []Edge {
+	out := make([]Edge, 0, len(g.edges))
+	for e := range g.edges {
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].From != out[j].From {
+			return out[i].From < out[j].From
+		}
+		if out[i].To != out[j].To {
+			return out[i].To < out[j].To
+		}
+		return out[i].Kind < out[j].Kind
+	})
+	return out
+}
+
+// nodeText はノードの表示テキスト（ID と件名）を返す
+func nodeText(n Node) string {
+	text := n.ID
+	if n.Label != "" {
+		text += ": " + n.Label
+	}
+	if n.Status != "" {
+		text += " [" + n.Status + "]"
+	}
+	return text
+}
+
+// writeDot は Graphviz dot 形式で出力する
+func writeDot(w io.Writer, g *Graph) error {
+	var b strings.Builder
+	b.WriteString("digraph backlog {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, n := range g.Nodes() {
+		attrs := []string{"label=" + dotQuote(nodeText(n))}
+		if n.Kind == nodePR {
+			attrs = append(attrs, "shape=ellipse")
+		}
+		if n.External {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(n.ID), strings.Join(attrs, ", "))
+	}
+	for _, e := range g.Edges() {
+		attrs := []string{"label=" + dotQuote(e.Kind)}
+		if e.Kind == edgeMentions {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(e.From), dotQuote(e.To), strings.Join(attrs, ", "))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// writeMermaid は Mermaid flowchart 形式で出力する
+// Mermaid の ID に使えない文字を避けるため、ノードには連番の ID を振る
+func writeMermaid(w io.Writer, g *Graph) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	ids := make(map[string]string, len(g.order))
+	for i, n := range g.Nodes() {
+		id := fmt.Sprintf("n%d", i+1)
+		ids[n.ID] = id
+		text := mermaidEscape(nodeText(n))
+		if n.Kind == nodePR {
+			fmt.Fprintf(&b, "  %s([\"%s\"])\n", id, text)
+		} else {
+			fmt.Fprintf(&b, "  %s[\"%s\"]\n", id, text)
+		}
+		if n.External {
+			fmt.Fprintf(&b, "  class %s external\n", id)
+		}
+	}
+	for _, e := range g.Edges() {
+		arrow := "-->"
+		if e.Kind == edgeMentions {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "  %s %s|%s| %s\n", ids[e.From], arrow, e.Kind, ids[e.To])
+	}
+	b.WriteString("  classDef external stroke-dasharray: 5 5\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func mermaidEscape(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return s
+}
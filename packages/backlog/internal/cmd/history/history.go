@@ -0,0 +1,20 @@
+package history
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// HistoryCmd is the root command for local history
+var HistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show local history of command outputs",
+	Long: `Show the history recorded locally by the CLI.
+
+When display.auto_save_dir is set, the output of report commands
+(user report, relay stats, markdown detect) is saved there with a
+timestamped file name. "backlog history outputs" lists and shows them.`,
+}
+
+func init() {
+	HistoryCmd.AddCommand(outputsCmd)
+}
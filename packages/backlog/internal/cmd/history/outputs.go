@@ -0,0 +1,134 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/outputs"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var outputsCmd = &cobra.Command{
+	Use:   "outputs [id]",
+	Short: "List or show saved command outputs",
+	Long: `List the outputs saved by display.auto_save_dir, newest first.
+
+With an ID, print the saved output again. Use --path to print only the file
+path (e.g. to open it in another tool).
+
+Examples:
+  backlog config set display.auto_save_dir ~/backlog-reports
+  backlog history outputs
+  backlog history outputs --command "user report" --limit 5
+  backlog history outputs 12
+  backlog history outputs 12 --path`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runOutputs,
+}
+
+var (
+	outputsCommand string
+	outputsLimit   int
+	outputsPath    bool
+)
+
+func init() {
+	outputsCmd.Flags().StringVar(&outputsCommand, "command", "", "Only list outputs of this command (e.g. \"user report\")")
+	outputsCmd.Flags().IntVarP(&outputsLimit, "limit", "L", 20, "Maximum number of outputs to list (0 for all)")
+	outputsCmd.Flags().BoolVar(&outputsPath, "path", false, "Print the file path instead of the content")
+}
+
+func runOutputs(c *cobra.Command, args []string) error {
+	historyPath, err := config.OutputHistoryPath()
+	if err != nil {
+		return fmt.Errorf("failed to resolve output history: %w", err)
+	}
+	entries, err := outputs.Load(historyPath)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid output ID: %s", args[0])
+		}
+		e, ok := outputs.Find(entries, id)
+		if !ok {
+			return fmt.Errorf("output %d not found (run 'backlog history outputs' to list saved outputs)", id)
+		}
+		return showOutput(os.Stdout, e, outputsPath)
+	}
+
+	entries = filterEntries(entries, outputsCommand, outputsLimit)
+
+	cfg, err := cmdutil.GetConfigStore(c)
+	if err != nil {
+		return err
+	}
+	if cfg.CurrentProfile().Output == "json" {
+		if entries == nil {
+			entries = []outputs.Entry{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		if cfg.Display().AutoSaveDir == "" {
+			fmt.Println("No saved outputs. Set display.auto_save_dir to save the output of report commands.")
+		} else {
+			fmt.Println("No saved outputs.")
+		}
+		return nil
+	}
+	table := ui.NewTable("ID", "SAVED", "COMMAND", "FORMAT", "SIZE", "PATH")
+	for _, e := range entries {
+		path := e.Path
+		if _, err := os.Stat(e.Path); err != nil {
+			path += " (missing)"
+		}
+		table.AddRow(strconv.Itoa(e.ID), e.Time.Local().Format("2006-01-02 15:04"), e.Command, e.Format, strconv.Itoa(e.Size), path)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	return nil
+}
+
+// filterEntries はコマンドで絞り込み、新しい順に最大 limit 件を返す（0 以下は全件）
+func filterEntries(entries []outputs.Entry, command string, limit int) []outputs.Entry {
+	var result []outputs.Entry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if command != "" && entries[i].Command != command {
+			continue
+		}
+		result = append(result, entries[i])
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result
+}
+
+// showOutput は保存した出力（--path ならファイルのパス）を w に書き出す
+func showOutput(w io.Writer, e outputs.Entry, pathOnly bool) error {
+	if pathOnly {
+		_, err := fmt.Fprintln(w, e.Path)
+		return err
+	}
+	data, err := os.ReadFile(e.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("output %d was removed: %s", e.ID, e.Path)
+		}
+		return fmt.Errorf("failed to read output %d: %w", e.ID, err)
+	}
+	_, err = w.Write(data)
+	return err
+}
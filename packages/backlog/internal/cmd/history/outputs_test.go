@@ -0,0 +1,49 @@
+package history
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/outputs"
+)
+
+func TestFilterEntries(t *testing.T) {
+	entries := []outputs.Entry{
+		{ID: 1, Command: "user report"},
+		{ID: 2, Command: "relay stats"},
+		{ID: 3, Command: "user report"},
+		{ID: 4, Command: "user report"},
+	}
+
+	got := filterEntries(entries, "user report", 2)
+	if len(got) != 2 || got[0].ID != 4 || got[1].ID != 3 {
+		t.Errorf("filterEntries(user report, 2) = %+v, want IDs 4, 3", got)
+	}
+	if got := filterEntries(entries, "", 0); len(got) != 4 || got[0].ID != 4 {
+		t.Errorf("filterEntries(all) = %+v, want 4 entries newest first", got)
+	}
+}
+
+func TestShowOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "user_report_20240601-093000.txt")
+	if err := os.WriteFile(path, []byte("MONTH  USER\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := showOutput(&buf, outputs.Entry{ID: 1, Path: path}, false); err != nil || buf.String() != "MONTH  USER\n" {
+		t.Errorf("showOutput() = %q, %v", buf.String(), err)
+	}
+	buf.Reset()
+	if err := showOutput(&buf, outputs.Entry{ID: 1, Path: path}, true); err != nil || buf.String() != path+"\n" {
+		t.Errorf("showOutput(path) = %q, %v", buf.String(), err)
+	}
+
+	err := showOutput(&buf, outputs.Entry{ID: 2, Path: path + ".removed"}, false)
+	if err == nil || !strings.Contains(err.Error(), "output 2 was removed") {
+		t.Errorf("showOutput(removed) error = %v", err)
+	}
+}
@@ -0,0 +1,273 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var InboxCmd = &cobra.Command{
+	Use:   "inbox",
+	Short: "Show notifications grouped by issue",
+	Long: `Show your notifications grouped by issue, with the number of unread
+notifications, the reasons and the senders of each issue.
+
+Issues muted with "backlog inbox mute" are hidden (their later notifications
+too) until unmuted. Notifications not related to an issue (pull requests,
+project membership, ...) are shown by "backlog notification list".
+
+Examples:
+  backlog inbox
+  backlog inbox --reason assigned,commented
+  backlog inbox --all -L 300
+  backlog inbox read PROJ-123 PROJ-124
+  backlog inbox mute PROJ-99
+  backlog inbox -o json`,
+	Args: cobra.NoArgs,
+	RunE: runInbox,
+}
+
+var (
+	inboxLimit   int
+	inboxAll     bool
+	inboxMuted   bool
+	inboxReasons []string
+)
+
+func init() {
+	InboxCmd.Flags().IntVarP(&inboxLimit, "limit", "L", 100, "Maximum number of notifications to fetch")
+	InboxCmd.Flags().BoolVar(&inboxAll, "all", false, "Include issues whose notifications are all read")
+	InboxCmd.Flags().BoolVar(&inboxMuted, "muted", false, "Show muted issues instead of hiding them")
+	InboxCmd.Flags().StringSliceVar(&inboxReasons, "reason", nil, "Filter by reason: "+strings.Join(reasonNames(), ", "))
+
+	InboxCmd.AddCommand(readCmd)
+	InboxCmd.AddCommand(muteCmd)
+	InboxCmd.AddCommand(unmuteCmd)
+}
+
+// reasons は通知理由 (reason) の ID と名前
+var reasons = []struct {
+	ID   int
+	Name string
+}{
+	{1, "assigned"},
+	{2, "commented"},
+	{3, "created"},
+	{4, "updated"},
+	{5, "file-added"},
+	{6, "project-user-added"},
+	{9, "other"},
+}
+
+func reasonNames() []string {
+	names := make([]string, len(reasons))
+	for i, r := range reasons {
+		names[i] = r.Name
+	}
+	return names
+}
+
+func reasonName(id int) string {
+	for _, r := range reasons {
+		if r.ID == id {
+			return r.Name
+		}
+	}
+	return "other"
+}
+
+// parseReasons は --reason の値を通知理由 ID の集合にする
+func parseReasons(values []string) (map[int]bool, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	ids := make(map[int]bool, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		found := false
+		for _, r := range reasons {
+			if r.Name == v || strconv.Itoa(r.ID) == v {
+				ids[r.ID] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid --reason %q (must be one of: %s)", v, strings.Join(reasonNames(), ", "))
+		}
+	}
+	return ids, nil
+}
+
+// Group は課題ごとにまとめた通知
+type Group struct {
+	IssueKey        string   `json:"issueKey"`
+	Summary         string   `json:"summary"`
+	ProjectKey      string   `json:"projectKey"`
+	Unread          int      `json:"unread"`
+	Total           int      `json:"total"`
+	Reasons         []string `json:"reasons"`
+	Senders         []string `json:"senders"`
+	Latest          string   `json:"latest"`
+	Muted           bool     `json:"muted,omitempty"`
+	NotificationIDs []int    `json:"notificationIds"`
+	unreadIDs       []int
+}
+
+// groupNotifications は課題に関する通知を課題ごとにまとめる
+// グループは最新の通知が新しい順に並べる。理由と送信者は出現順（新しい順）で重複を除く
+func groupNotifications(notifications []api.UserNotification, reasonFilter map[int]bool) []Group {
+	index := make(map[string]int)
+	var groups []Group
+	for _, n := range notifications {
+		if n.Issue == nil || n.Issue.IssueKey == "" {
+			continue
+		}
+		if reasonFilter != nil && !reasonFilter[n.Reason] {
+			continue
+		}
+		i, ok := index[n.Issue.IssueKey]
+		if !ok {
+			i = len(groups)
+			index[n.Issue.IssueKey] = i
+			groups = append(groups, Group{
+				IssueKey:   n.Issue.IssueKey,
+				Summary:    n.Issue.Summary,
+				ProjectKey: n.Project.ProjectKey,
+			})
+		}
+		g := &groups[i]
+		g.Total++
+		g.NotificationIDs = append(g.NotificationIDs, n.ID)
+		if !n.AlreadyRead {
+			g.Unread++
+			g.unreadIDs = append(g.unreadIDs, n.ID)
+		}
+		if n.Created > g.Latest {
+			g.Latest = n.Created
+		}
+		g.Reasons = appendUnique(g.Reasons, reasonName(n.Reason))
+		if n.Sender.Name != "" {
+			g.Senders = appendUnique(g.Senders, n.Sender.Name)
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Latest > groups[j].Latest })
+	return groups
+}
+
+func appendUnique(values []string, v string) []string {
+	for _, existing := range values {
+		if existing == v {
+			return values
+		}
+	}
+	return append(values, v)
+}
+
+// fetchNotifications は新しい順に最大 limit 件の通知を取得する
+func fetchNotifications(ctx context.Context, client *api.Client, limit int) ([]api.UserNotification, error) {
+	const batchSize = 100
+	var all []api.UserNotification
+	maxID := 0
+	for len(all) < limit {
+		count := min(batchSize, limit-len(all))
+		notifications, err := client.GetNotifications(ctx, &api.NotificationListOptions{
+			Count: count,
+			Order: "desc",
+			MaxID: maxID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, notifications...)
+		if len(notifications) < count {
+			break
+		}
+		maxID = notifications[len(notifications)-1].ID
+	}
+	return all, nil
+}
+
+func runInbox(c *cobra.Command, args []string) error {
+	reasonFilter, err := parseReasons(inboxReasons)
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	profile := cfg.CurrentProfile()
+	display := cfg.Display()
+	ctx := c.Context()
+
+	store, err := openMuteStore()
+	if err != nil {
+		return err
+	}
+	mutes, err := store.Load()
+	if err != nil {
+		return err
+	}
+	muted := mutedKeys(mutes, profile.Space)
+
+	notifications, err := fetchNotifications(ctx, client, inboxLimit)
+	if err != nil {
+		return fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	var groups []Group
+	hidden := 0
+	for _, g := range groupNotifications(notifications, reasonFilter) {
+		if !inboxAll && g.Unread == 0 {
+			continue
+		}
+		if muted[g.IssueKey] {
+			if !inboxMuted {
+				hidden++
+				continue
+			}
+			g.Muted = true
+		}
+		groups = append(groups, g)
+	}
+
+	if profile.Output == "json" {
+		if groups == nil {
+			groups = []Group{}
+		}
+		return cmdutil.OutputJSONFromProfile(groups, profile.JSONFields, profile.JQ, profile.Template)
+	}
+
+	if len(groups) == 0 {
+		fmt.Println("Inbox is empty")
+	} else {
+		ui.SetHyperlinkEnabled(display.Hyperlink)
+		formatter := ui.NewFieldFormatter(display.Timezone, display.DateTimeFormat, nil)
+		for _, g := range groups {
+			marker := " "
+			if g.Unread > 0 {
+				marker = ui.Yellow("●")
+			}
+			url := fmt.Sprintf("https://%s/view/%s", profile.Space, g.IssueKey)
+			count := fmt.Sprintf("%d/%d unread", g.Unread, g.Total)
+			if g.Muted {
+				count += ", muted"
+			}
+			fmt.Printf("%s %s %s %s\n", marker, ui.Bold(ui.Hyperlink(url, g.IssueKey)), ui.Truncate(g.Summary, 50), ui.Gray("("+count+")"))
+			fmt.Printf("    %s  %s  %s\n", strings.Join(g.Reasons, ", "), ui.Cyan(strings.Join(g.Senders, ", ")), ui.Gray(formatter.FormatDateTime(g.Latest, "updated")))
+		}
+	}
+	if hidden > 0 {
+		fmt.Fprintf(os.Stderr, "%s\n", ui.Gray(fmt.Sprintf("%d muted issue(s) hidden (--muted to show)", hidden)))
+	}
+	return nil
+}
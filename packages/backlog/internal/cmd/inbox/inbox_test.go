@@ -0,0 +1,106 @@
+package inbox
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func notification(id int, key string, reason int, read bool, sender, created string) api.UserNotification {
+	n := api.UserNotification{
+		ID:          id,
+		AlreadyRead: read,
+		Reason:      reason,
+		Project:     api.Project{ProjectKey: "PROJ"},
+		Sender:      api.User{Name: sender},
+		Created:     created,
+	}
+	if key != "" {
+		n.Issue = &api.NotificationIssue{IssueKey: key, Summary: "summary of " + key}
+	}
+	return n
+}
+
+func TestGroupNotifications(t *testing.T) {
+	notifications := []api.UserNotification{
+		notification(6, "PROJ-2", 2, false, "bob", "2024-06-03T00:00:00Z"),
+		notification(5, "PROJ-1", 2, false, "alice", "2024-06-02T00:00:00Z"),
+		notification(4, "", 10, false, "alice", "2024-06-02T00:00:00Z"), // プルリクエスト
+		notification(3, "PROJ-1", 1, true, "bob", "2024-06-01T00:00:00Z"),
+		notification(2, "PROJ-1", 2, false, "alice", "2024-05-31T00:00:00Z"),
+	}
+
+	groups := groupNotifications(notifications, nil)
+	if len(groups) != 2 {
+		t.Fatalf("len(groups) = %d, want 2", len(groups))
+	}
+	if groups[0].IssueKey != "PROJ-2" || groups[1].IssueKey != "PROJ-1" {
+		t.Errorf("order = %s, %s, want PROJ-2, PROJ-1", groups[0].IssueKey, groups[1].IssueKey)
+	}
+	g := groups[1]
+	if g.Unread != 2 || g.Total != 3 {
+		t.Errorf("PROJ-1 unread/total = %d/%d, want 2/3", g.Unread, g.Total)
+	}
+	if !reflect.DeepEqual(g.Reasons, []string{"commented", "assigned"}) {
+		t.Errorf("Reasons = %v", g.Reasons)
+	}
+	if !reflect.DeepEqual(g.Senders, []string{"alice", "bob"}) {
+		t.Errorf("Senders = %v", g.Senders)
+	}
+	if !reflect.DeepEqual(g.unreadIDs, []int{5, 2}) {
+		t.Errorf("unreadIDs = %v, want [5 2]", g.unreadIDs)
+	}
+	if g.Latest != "2024-06-02T00:00:00Z" {
+		t.Errorf("Latest = %q", g.Latest)
+	}
+
+	filter, err := parseReasons([]string{"assigned"})
+	if err != nil {
+		t.Fatalf("parseReasons() error: %v", err)
+	}
+	groups = groupNotifications(notifications, filter)
+	if len(groups) != 1 || groups[0].IssueKey != "PROJ-1" || groups[0].Total != 1 {
+		t.Errorf("filtered groups = %+v", groups)
+	}
+
+	if _, err := parseReasons([]string{"starred"}); err == nil {
+		t.Error("parseReasons(starred) should fail")
+	}
+}
+
+func TestMuteStore(t *testing.T) {
+	store := &muteStore{path: filepath.Join(t.TempDir(), "state", "inbox-mutes.json")}
+
+	mutes, err := store.Load()
+	if err != nil || mutes != nil {
+		t.Fatalf("Load() on missing file = (%v, %v), want (nil, nil)", mutes, err)
+	}
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mutes, added := addMutes(mutes, "a.backlog.jp", []string{"PROJ-1", "PROJ-2", "PROJ-1"}, now)
+	if !reflect.DeepEqual(added, []string{"PROJ-1", "PROJ-2"}) {
+		t.Errorf("added = %v", added)
+	}
+	mutes, _ = addMutes(mutes, "b.backlog.jp", []string{"PROJ-1"}, now)
+	if err := store.Save(mutes); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	loaded, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	loaded, removed := removeMutes(loaded, "a.backlog.jp", []string{"PROJ-1", "PROJ-3"})
+	if !reflect.DeepEqual(removed, []string{"PROJ-1"}) {
+		t.Errorf("removed = %v", removed)
+	}
+	if keys := mutedKeys(loaded, "a.backlog.jp"); !reflect.DeepEqual(keys, map[string]bool{"PROJ-2": true}) {
+		t.Errorf("mutedKeys(a) = %v", keys)
+	}
+	if keys := mutedKeys(loaded, "b.backlog.jp"); !keys["PROJ-1"] {
+		t.Errorf("mutedKeys(b) = %v, want PROJ-1 muted", keys)
+	}
+}
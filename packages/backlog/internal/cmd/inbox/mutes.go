@@ -0,0 +1,116 @@
+package inbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+// mute はミュートした課題（スペースごと）
+type mute struct {
+	Space    string    `json:"space"`
+	IssueKey string    `json:"issueKey"`
+	MutedAt  time.Time `json:"mutedAt"`
+}
+
+// muteStore はミュートした課題をローカルファイルに保存する
+type muteStore struct {
+	path string
+}
+
+type muteFileContent struct {
+	Mutes []mute `json:"mutes"`
+}
+
+// openMuteStore はミュートリストのファイルを開く
+func openMuteStore() (*muteStore, error) {
+	path, err := config.InboxMutesPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve inbox mutes path: %w", err)
+	}
+	return &muteStore{path: path}, nil
+}
+
+// Load はミュートリストを返す
+func (s *muteStore) Load() ([]mute, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read inbox mutes: %w", err)
+	}
+	var content muteFileContent
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("parse inbox mutes %s: %w", s.path, err)
+	}
+	return content.Mutes, nil
+}
+
+// Save はミュートリストを書き込む
+func (s *muteStore) Save(mutes []mute) error {
+	data, err := json.MarshalIndent(muteFileContent{Mutes: mutes}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode inbox mutes: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create inbox mutes directory: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write inbox mutes: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("write inbox mutes: %w", err)
+	}
+	return nil
+}
+
+// mutedKeys はスペースでミュートしている課題キーの集合を返す
+func mutedKeys(mutes []mute, space string) map[string]bool {
+	keys := make(map[string]bool)
+	for _, m := range mutes {
+		if m.Space == space {
+			keys[m.IssueKey] = true
+		}
+	}
+	return keys
+}
+
+// addMutes は課題をミュートリストに加え、新たに加えた課題キーを返す
+func addMutes(mutes []mute, space string, keys []string, now time.Time) ([]mute, []string) {
+	existing := mutedKeys(mutes, space)
+	var added []string
+	for _, key := range keys {
+		if existing[key] {
+			continue
+		}
+		existing[key] = true
+		mutes = append(mutes, mute{Space: space, IssueKey: key, MutedAt: now})
+		added = append(added, key)
+	}
+	return mutes, added
+}
+
+// removeMutes は課題をミュートリストから外し、外した課題キーを返す
+func removeMutes(mutes []mute, space string, keys []string) ([]mute, []string) {
+	target := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		target[key] = true
+	}
+	kept := mutes[:0]
+	var removed []string
+	for _, m := range mutes {
+		if m.Space == space && target[m.IssueKey] {
+			removed = append(removed, m.IssueKey)
+			continue
+		}
+		kept = append(kept, m)
+	}
+	return kept, removed
+}
@@ -0,0 +1,72 @@
+package inbox
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var readCmd = &cobra.Command{
+	Use:   "read <issue-key>...",
+	Short: "Mark all notifications of issues as read",
+	Long: `Mark every unread notification of the given issues as read.
+
+Only the latest notifications (see --limit) are searched.
+
+Examples:
+  backlog inbox read PROJ-123
+  backlog inbox read 123 124   # uses configured project`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runRead,
+}
+
+var readLimit int
+
+func init() {
+	readCmd.Flags().IntVarP(&readLimit, "limit", "L", 100, "Maximum number of notifications to search")
+}
+
+func runRead(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	ctx := c.Context()
+	keys := resolveIssueKeys(args, cmdutil.GetCurrentProject(cfg))
+
+	notifications, err := fetchNotifications(ctx, client, readLimit)
+	if err != nil {
+		return fmt.Errorf("failed to get notifications: %w", err)
+	}
+	groups := make(map[string]Group)
+	for _, g := range groupNotifications(notifications, nil) {
+		groups[g.IssueKey] = g
+	}
+
+	for _, key := range keys {
+		g := groups[key]
+		if len(g.unreadIDs) == 0 {
+			ui.Warning("%s has no unread notifications", key)
+			continue
+		}
+		for _, id := range g.unreadIDs {
+			if err := client.MarkNotificationAsRead(ctx, id); err != nil {
+				return fmt.Errorf("failed to mark notification %d of %s as read: %w", id, key, err)
+			}
+		}
+		cmdutil.Success("", "Marked %d notification(s) of %s as read", len(g.unreadIDs), key)
+	}
+	return nil
+}
+
+// resolveIssueKeys は引数の課題キーを正規化する（数字だけなら設定のプロジェクトを補う）
+func resolveIssueKeys(args []string, projectKey string) []string {
+	keys := make([]string, len(args))
+	for i, arg := range args {
+		keys[i], _ = cmdutil.ResolveIssueKey(arg, projectKey)
+	}
+	return keys
+}
@@ -0,0 +1,350 @@
+package issue
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Export old issues and close them in bulk",
+	Long: `Export issues that have not been updated since a given date, then close them
+or set a resolution.
+
+Target issues are those in the current project whose status is not closed and
+whose date field (updated by default) is before --before. Exported issues are
+appended to --export as JSON Lines. Each issue is exported before it is changed,
+so an interrupted run can be resumed by running the same command again: issues
+that are already closed no longer match, and issues already in the export file
+are not written twice.
+
+Examples:
+  # Preview the target issues
+  backlog issue archive --project PROJ --before 2022-01-01 --dry-run
+
+  # Export and close
+  backlog issue archive --project PROJ --before 2022-01-01 --export archive.jsonl --close
+
+  # Export only
+  backlog issue archive --before 2022-01-01 --export archive.jsonl
+
+  # Set a resolution without changing the status
+  backlog issue archive --before 2022-01-01 --export archive.jsonl --resolution 3`,
+	Args:        cobra.NoArgs,
+	RunE:        runArchive,
+	Annotations: cmdutil.AutoSave(),
+}
+
+var (
+	archiveBefore     string
+	archiveDateField  string
+	archiveExport     string
+	archiveClose      bool
+	archiveResolution int
+	archiveComment    string
+	archiveDryRun     bool
+)
+
+func init() {
+	archiveCmd.Flags().StringVar(&archiveBefore, "before", "", "Target issues whose date is before this date (YYYY-MM-DD, required)")
+	archiveCmd.Flags().StringVar(&archiveDateField, "date-field", "updated", "Date field compared with --before (updated, created)")
+	archiveCmd.Flags().StringVar(&archiveExport, "export", "", "Append target issues to this file as JSON Lines")
+	archiveCmd.Flags().BoolVar(&archiveClose, "close", false, "Close target issues after export")
+	archiveCmd.Flags().IntVar(&archiveResolution, "resolution", 0, "Resolution ID to set on target issues")
+	archiveCmd.Flags().StringVarP(&archiveComment, "comment", "c", "", "Comment to add when updating issues")
+	archiveCmd.Flags().BoolVar(&archiveDryRun, "dry-run", false, "Show target issues without exporting or updating")
+	_ = archiveCmd.MarkFlagRequired("before")
+}
+
+// archiveUntil は --before の日付から Backlog API の *Until パラメータ（当日を含む）を求める
+func archiveUntil(before string) (string, error) {
+	t, err := time.Parse("2006-01-02", before)
+	if err != nil {
+		return "", fmt.Errorf("invalid --before %q (expected YYYY-MM-DD)", before)
+	}
+	return t.AddDate(0, 0, -1).Format("2006-01-02"), nil
+}
+
+// archiveNeedsUpdate は課題がまだ更新対象かどうかを返す
+// 再開時に処理済みの課題を飛ばすために使う
+func archiveNeedsUpdate(issue *backlog.Issue, closedStatusID, resolutionID int) bool {
+	if closedStatusID > 0 && issue.Status.Value.ID.Value != closedStatusID {
+		return true
+	}
+	if resolutionID > 0 {
+		r := issue.Resolution
+		if !r.Set || r.Null || r.Value.ID.Value != resolutionID {
+			return true
+		}
+	}
+	return false
+}
+
+// readExportedIssueKeys はエクスポート済みファイルから課題キーを読み込む
+func readExportedIssueKeys(path string) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return keys, nil
+		}
+		return nil, fmt.Errorf("open export file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var entry struct {
+			IssueKey string `json:"issueKey"`
+		}
+		if err := json.Unmarshal([]byte(text), &entry); err != nil {
+			// 中断時に書きかけになった最終行は無視し、再エクスポートする
+			ui.Warning("%s:%d: skipping unreadable line: %v", path, line, err)
+			continue
+		}
+		if entry.IssueKey != "" {
+			keys[entry.IssueKey] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read export file: %w", err)
+	}
+	return keys, nil
+}
+
+// appendArchiveExport は未エクスポートの課題をファイルに追記し、追記件数を返す
+func appendArchiveExport(w io.Writer, issues []backlog.Issue, exported map[string]bool) (int, error) {
+	bw := bufio.NewWriter(w)
+	n := 0
+	for i := range issues {
+		key := issues[i].IssueKey.Value
+		if exported[key] {
+			continue
+		}
+		data, err := json.Marshal(&issues[i])
+		if err != nil {
+			return n, fmt.Errorf("encode %s: %w", key, err)
+		}
+		if _, err := bw.Write(append(data, '\n')); err != nil {
+			return n, fmt.Errorf("write export file: %w", err)
+		}
+		exported[key] = true
+		n++
+	}
+	if err := bw.Flush(); err != nil {
+		return n, fmt.Errorf("write export file: %w", err)
+	}
+	return n, nil
+}
+
+func runArchive(c *cobra.Command, args []string) error {
+	until, err := archiveUntil(archiveBefore)
+	if err != nil {
+		return err
+	}
+	if archiveDateField != "updated" && archiveDateField != "created" {
+		return fmt.Errorf("invalid --date-field %q (allowed: updated, created)", archiveDateField)
+	}
+	update := archiveClose || archiveResolution > 0
+	if !archiveDryRun && archiveExport == "" && !update {
+		return fmt.Errorf("nothing to do: specify --export, --close or --resolution")
+	}
+	if archiveComment != "" && !update {
+		return fmt.Errorf("--comment requires --close or --resolution")
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	ctx := c.Context()
+
+	project, err := client.GetProject(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project %q: %w", projectKey, err)
+	}
+	statuses, err := client.GetStatuses(ctx, strconv.Itoa(project.ID))
+	if err != nil {
+		return fmt.Errorf("failed to get statuses: %w", err)
+	}
+	closedStatusID, err := cmdutil.FindClosedStatusID(statuses)
+	if err != nil {
+		return err
+	}
+
+	// 完了済みの課題は対象外（--close の再開時は処理済みの課題がここで除外される）
+	opts := &api.IssueListOptions{
+		ProjectIDs: []int{project.ID},
+		Sort:       archiveDateField,
+		Order:      "asc",
+	}
+	for _, s := range statuses {
+		if s.ID != closedStatusID {
+			opts.StatusIDs = append(opts.StatusIDs, s.ID)
+		}
+	}
+	if archiveDateField == "created" {
+		opts.CreatedUntil = until
+	} else {
+		opts.UpdatedUntil = until
+	}
+
+	stopProgress := ui.StartProgress("Fetching target issues...")
+	issues, err := paginateIssues(ctx, client, opts, 0)
+	stopProgress()
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+
+	targetStatusID := 0
+	if archiveClose {
+		targetStatusID = closedStatusID
+	}
+
+	if archiveDryRun {
+		fmt.Printf("%d issue(s) in %s with %s date before %s\n", len(issues), projectKey, archiveDateField, archiveBefore)
+		for _, issue := range issues {
+			fmt.Printf("  %s\t%s\t%s\t%s\n", issue.IssueKey.Value, issue.Status.Value.Name.Value,
+				formatArchiveDate(issue, archiveDateField), issue.Summary.Value)
+		}
+		return nil
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("No issues to archive.")
+		return nil
+	}
+
+	// エクスポートは更新より先に行い、中断しても未エクスポートのまま更新されることがないようにする
+	if archiveExport != "" {
+		exported, err := readExportedIssueKeys(archiveExport)
+		if err != nil {
+			return err
+		}
+		file, err := os.OpenFile(archiveExport, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("open export file: %w", err)
+		}
+		n, err := appendArchiveExport(file, issues, exported)
+		if err == nil {
+			err = file.Sync()
+		}
+		if cerr := file.Close(); err == nil && cerr != nil {
+			err = fmt.Errorf("close export file: %w", cerr)
+		}
+		if err != nil {
+			return err
+		}
+		cmdutil.Success(archiveExport, "Exported %d issue(s) to %s (%d already exported)", n, archiveExport, len(issues)-n)
+	}
+
+	if !update {
+		return nil
+	}
+
+	var pending []backlog.Issue
+	for i := range issues {
+		if archiveNeedsUpdate(&issues[i], targetStatusID, archiveResolution) {
+			pending = append(pending, issues[i])
+		}
+	}
+	if len(pending) == 0 {
+		fmt.Println("All target issues are already up to date.")
+		return nil
+	}
+
+	action := "close"
+	if !archiveClose {
+		action = "set resolution on"
+	}
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog issue archive",
+				"Use --yes to skip the confirmation prompt, or --dry-run to preview.",
+			)
+		}
+		ok, err := ui.Confirm(fmt.Sprintf("%s %d issue(s) in %s?", capitalize(action), len(pending), projectKey), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	failed := 0
+	for i := range pending {
+		key := pending[i].IssueKey.Value
+		input := &api.UpdateIssueInput{}
+		if archiveClose {
+			input.StatusID = &closedStatusID
+		}
+		if archiveResolution > 0 {
+			input.ResolutionID = &archiveResolution
+		}
+		if archiveComment != "" {
+			input.Comment = &archiveComment
+		}
+		prefix := fmt.Sprintf("[%d/%d]", i+1, len(pending))
+		if _, err := client.UpdateIssue(ctx, key, input); err != nil {
+			if cmdutil.Interrupted(ctx) {
+				return cmdutil.ErrInterrupted
+			}
+			failed++
+			fmt.Fprintf(os.Stderr, "%s %s %s: %v\n", prefix, ui.FailMark(), key, err)
+			continue
+		}
+		cmdutil.Progressf("%s %s %s", prefix, ui.OKMark(), key)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to %s %d of %d issue(s); run the same command again to retry", action, failed, len(pending))
+	}
+	cmdutil.Success("", "Archived %d issue(s)", len(pending))
+	return nil
+}
+
+func formatArchiveDate(issue backlog.Issue, field string) string {
+	value := issue.Updated.Value
+	if field == "created" {
+		value = issue.Created.Value
+	}
+	if len(value) >= len("2006-01-02") {
+		return value[:len("2006-01-02")]
+	}
+	return value
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,98 @@
+package issue
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestArchiveUntil(t *testing.T) {
+	got, err := archiveUntil("2022-01-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "2021-12-31" {
+		t.Errorf("archiveUntil() = %q, want %q", got, "2021-12-31")
+	}
+	if _, err := archiveUntil("2022/01/01"); err == nil {
+		t.Error("expected error for invalid date")
+	}
+}
+
+func archiveTestIssue(key string, statusID, resolutionID int) backlog.Issue {
+	issue := backlog.Issue{
+		IssueKey: backlog.NewOptString(key),
+		Status:   backlog.NewOptStatus(backlog.Status{ID: backlog.NewOptInt(statusID)}),
+	}
+	if resolutionID > 0 {
+		issue.Resolution = backlog.NewOptNilResolution(backlog.Resolution{ID: backlog.NewOptInt(resolutionID)})
+	}
+	return issue
+}
+
+func TestArchiveNeedsUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		issue        backlog.Issue
+		closedID     int
+		resolutionID int
+		want         bool
+	}{
+		{name: "open issue to close", issue: archiveTestIssue("P-1", 1, 0), closedID: 4, want: true},
+		{name: "already closed", issue: archiveTestIssue("P-1", 4, 0), closedID: 4, want: false},
+		{name: "resolution missing", issue: archiveTestIssue("P-1", 1, 0), resolutionID: 3, want: true},
+		{name: "resolution differs", issue: archiveTestIssue("P-1", 1, 1), resolutionID: 3, want: true},
+		{name: "resolution already set", issue: archiveTestIssue("P-1", 1, 3), resolutionID: 3, want: false},
+		{name: "closed but resolution missing", issue: archiveTestIssue("P-1", 4, 0), closedID: 4, resolutionID: 3, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := archiveNeedsUpdate(&tt.issue, tt.closedID, tt.resolutionID); got != tt.want {
+				t.Errorf("archiveNeedsUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArchiveExportResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "archive.jsonl")
+	// 前回の実行で 1 件目を書き込み、2 件目の途中で中断した状態
+	if err := os.WriteFile(path, []byte(`{"issueKey":"P-1","summary":"a"}`+"\n"+`{"issueKey":"P-2","su`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := readExportedIssueKeys(path)
+	if err != nil {
+		t.Fatalf("readExportedIssueKeys() error: %v", err)
+	}
+	if !exported["P-1"] || exported["P-2"] {
+		t.Fatalf("exported = %v, want only P-1", exported)
+	}
+
+	var buf bytes.Buffer
+	issues := []backlog.Issue{archiveTestIssue("P-1", 1, 0), archiveTestIssue("P-2", 1, 0)}
+	n, err := appendArchiveExport(&buf, issues, exported)
+	if err != nil {
+		t.Fatalf("appendArchiveExport() error: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("appended = %d, want 1", n)
+	}
+	if !strings.Contains(buf.String(), `"issueKey":"P-2"`) || strings.Contains(buf.String(), `"issueKey":"P-1"`) {
+		t.Errorf("unexpected export output: %s", buf.String())
+	}
+	if !exported["P-2"] {
+		t.Error("P-2 should be marked as exported")
+	}
+}
+
+func TestReadExportedIssueKeysMissingFile(t *testing.T) {
+	keys, err := readExportedIssueKeys(filepath.Join(t.TempDir(), "missing.jsonl"))
+	if err != nil || len(keys) != 0 {
+		t.Errorf("readExportedIssueKeys() = (%v, %v), want empty", keys, err)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -29,8 +31,9 @@ var issueAttachmentListCmd = &cobra.Command{
 Examples:
   backlog issue attachment list PROJ-123
   backlog issue attachment list PROJ-123 --json id,name,size`,
-	Args: cobra.ExactArgs(1),
-	RunE: runIssueAttachmentList,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueAttachmentList,
 }
 
 func runIssueAttachmentList(c *cobra.Command, args []string) error {
@@ -40,6 +43,7 @@ func runIssueAttachmentList(c *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	atts, err := client.ListIssueAttachments(c.Context(), issueKey)
 	if err != nil {
@@ -77,8 +81,9 @@ Examples:
   backlog issue attachment download PROJ-123 42
   backlog issue attachment download PROJ-123 42 -o report.pdf
   backlog issue attachment download PROJ-123 42 -o -`,
-	Args: cobra.ExactArgs(2),
-	RunE: runIssueAttachmentDownload,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueAttachmentDownload,
 }
 
 var (
@@ -96,6 +101,7 @@ func init() {
 	attachmentCmd.AddCommand(issueAttachmentDownloadCmd)
 	attachmentCmd.AddCommand(issueAttachmentDeleteCmd)
 	attachmentCmd.AddCommand(issueAttachmentUploadCmd)
+	attachmentCmd.AddCommand(issueAttachmentReplaceCmd)
 }
 
 func runIssueAttachmentDownload(c *cobra.Command, args []string) error {
@@ -105,10 +111,11 @@ func runIssueAttachmentDownload(c *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid attachment ID: %s", args[1])
 	}
 
-	client, _, err := cmdutil.GetAPIClient(c)
+	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	fallback := fmt.Sprintf("attachment-%d", attachmentID)
 	apiPath := fmt.Sprintf("/issues/%s/attachments/%d", issueKey, attachmentID)
@@ -128,8 +135,9 @@ var issueAttachmentDeleteCmd = &cobra.Command{
 Examples:
   backlog issue attachment delete PROJ-123 42
   backlog issue attachment delete PROJ-123 42 --yes`,
-	Args: cobra.ExactArgs(2),
-	RunE: runIssueAttachmentDelete,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueAttachmentDelete,
 }
 
 func init() {
@@ -142,10 +150,11 @@ func runIssueAttachmentDelete(c *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid attachment ID: %s", args[1])
 	}
 
-	client, _, err := cmdutil.GetAPIClient(c)
+	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	if !cmdutil.SkipConfirmation(c) {
 		if !ui.IsInteractiveInput() {
@@ -174,7 +183,7 @@ func runIssueAttachmentDelete(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to delete attachment: %w", err)
 	}
 
-	ui.Success("Deleted attachment: %s", att.Name)
+	cmdutil.Success("", "Deleted attachment: %s", att.Name)
 	return nil
 }
 
@@ -188,21 +197,23 @@ var issueAttachmentUploadCmd = &cobra.Command{
 Examples:
   backlog issue attachment upload PROJ-123 report.pdf
   backlog issue attachment upload PROJ-123 img1.png img2.png`,
-	Args: cobra.MinimumNArgs(2),
-	RunE: runIssueAttachmentUpload,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueAttachmentUpload,
 }
 
 func runIssueAttachmentUpload(c *cobra.Command, args []string) error {
 	issueKey := args[0]
 	files := args[1:]
 
-	client, _, err := cmdutil.GetAPIClient(c)
+	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	ctx := c.Context()
-	attachmentIDs, err := cmdutil.UploadFiles(ctx, client, files)
+	attachmentIDs, err := cmdutil.UploadFiles(ctx, client, cfg, files)
 	if err != nil {
 		return err
 	}
@@ -214,10 +225,147 @@ func runIssueAttachmentUpload(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to attach files to issue: %w", err)
 	}
 
-	ui.Success("Attached %d file(s) to %s", len(attachmentIDs), issueKey)
+	cmdutil.Success("", "Attached %d file(s) to %s", len(attachmentIDs), issueKey)
+	return nil
+}
+
+// --- replace ---
+
+var issueAttachmentReplaceCmd = &cobra.Command{
+	Use:   "replace <issue-key> <old-attachment> <new-file>",
+	Short: "Replace an issue attachment with a new file and record it in a comment",
+	Long: `Upload a new file, attach it to the issue with a comment recording the
+replacement, and delete the old attachment.
+
+The old attachment is specified by ID or file name. The old attachment is
+deleted only after the new file has been attached; use --keep to leave it.
+The comment is posted with the new file attached and contains the --comment
+text followed by a line describing the replacement. Use --no-comment to
+attach the file without a comment.
+
+The issue description refers to images by file name. When the new file has a
+different name and the description refers to the old one, a warning is shown
+so the reference can be updated.
+
+Examples:
+  backlog issue attachment replace PROJ-123 old.png new.png --comment "図を更新"
+  backlog issue attachment replace PROJ-123 42 spec-v2.pdf --keep
+  backlog issue attachment replace PROJ-123 diagram.png diagram.png --no-comment`,
+	Args:              cobra.ExactArgs(3),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueAttachmentReplace,
+}
+
+var (
+	issueAttachmentReplaceComment   string
+	issueAttachmentReplaceKeep      bool
+	issueAttachmentReplaceNoComment bool
+)
+
+func init() {
+	issueAttachmentReplaceCmd.Flags().StringVarP(&issueAttachmentReplaceComment, "comment", "m", "", "Comment text posted with the new file")
+	issueAttachmentReplaceCmd.Flags().BoolVar(&issueAttachmentReplaceKeep, "keep", false, "Keep the old attachment instead of deleting it")
+	issueAttachmentReplaceCmd.Flags().BoolVar(&issueAttachmentReplaceNoComment, "no-comment", false, "Attach the new file without posting a comment")
+}
+
+func runIssueAttachmentReplace(c *cobra.Command, args []string) error {
+	issueKey, oldRef, newFile := args[0], args[1], args[2]
+	if issueAttachmentReplaceNoComment && issueAttachmentReplaceComment != "" {
+		return fmt.Errorf("cannot use --comment and --no-comment together")
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
+	ctx := c.Context()
+
+	atts, err := client.ListIssueAttachments(ctx, issueKey)
+	if err != nil {
+		return fmt.Errorf("failed to list attachments: %w", err)
+	}
+	old, err := findIssueAttachment(atts, oldRef)
+	if err != nil {
+		return err
+	}
+
+	attachmentIDs, err := cmdutil.UploadFiles(ctx, client, cfg, []string{newFile})
+	if err != nil {
+		return err
+	}
+	newName := filepath.Base(newFile)
+
+	if issueAttachmentReplaceNoComment {
+		if _, err := client.UpdateIssue(ctx, issueKey, &api.UpdateIssueInput{AttachmentIDs: attachmentIDs}); err != nil {
+			return fmt.Errorf("failed to attach file to issue: %w", err)
+		}
+	} else {
+		content := replaceAttachmentComment(cmdutil.ExpandEmoji(cfg.Display(), issueAttachmentReplaceComment), old.Name, newName, issueAttachmentReplaceKeep)
+		if _, err := client.AddComment(ctx, issueKey, content, nil, attachmentIDs); err != nil {
+			return fmt.Errorf("failed to post comment with the new file: %w", err)
+		}
+	}
+	cmdutil.Success("", "Attached %s to %s", newName, issueKey)
+
+	// 古い添付の削除は新しい添付が成功した後に行う
+	if !issueAttachmentReplaceKeep {
+		if _, err := client.DeleteIssueAttachment(ctx, issueKey, old.ID); err != nil {
+			return fmt.Errorf("failed to delete old attachment %d (%s): %w", old.ID, old.Name, err)
+		}
+		cmdutil.Success("", "Deleted old attachment: %s (%d)", old.Name, old.ID)
+	}
+
+	if newName != old.Name {
+		if issue, err := client.GetIssue(ctx, issueKey); err == nil && strings.Contains(issue.Description.Value, old.Name) {
+			ui.Warning("The description of %s still refers to %s; update it to %s", issueKey, old.Name, newName)
+		}
+	}
 	return nil
 }
 
+// findIssueAttachment は ID またはファイル名で添付を探す
+// 同名の添付が複数ある場合は ID での指定を求める
+func findIssueAttachment(atts []api.Attachment, ref string) (*api.Attachment, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		for i := range atts {
+			if atts[i].ID == id {
+				return &atts[i], nil
+			}
+		}
+	}
+	var matched []*api.Attachment
+	for i := range atts {
+		if atts[i].Name == ref {
+			matched = append(matched, &atts[i])
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("attachment not found: %s", ref)
+	case 1:
+		return matched[0], nil
+	default:
+		ids := make([]string, len(matched))
+		for i, a := range matched {
+			ids[i] = strconv.Itoa(a.ID)
+		}
+		return nil, fmt.Errorf("multiple attachments are named %s (IDs: %s); specify the attachment ID", ref, strings.Join(ids, ", "))
+	}
+}
+
+// replaceAttachmentComment は差し替えを記録するコメント本文を組み立てる
+func replaceAttachmentComment(message, oldName, newName string, kept bool) string {
+	record := fmt.Sprintf("Replaced attachment: %s → %s", oldName, newName)
+	if kept {
+		record = fmt.Sprintf("Added attachment: %s (replaces %s)", newName, oldName)
+	}
+	if strings.TrimSpace(message) == "" {
+		return record
+	}
+	return strings.TrimRight(message, "\n") + "\n\n" + record
+}
+
 // --- helpers ---
 
 func formatBytes(size int64) string {
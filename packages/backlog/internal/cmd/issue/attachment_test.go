@@ -0,0 +1,54 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestFindIssueAttachment(t *testing.T) {
+	atts := []api.Attachment{
+		{ID: 1, Name: "old.png"},
+		{ID: 2, Name: "dup.png"},
+		{ID: 3, Name: "dup.png"},
+	}
+	tests := []struct {
+		ref     string
+		wantID  int
+		wantErr bool
+	}{
+		{ref: "old.png", wantID: 1},
+		{ref: "3", wantID: 3},
+		{ref: "dup.png", wantErr: true},
+		{ref: "missing.png", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := findIssueAttachment(atts, tt.ref)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("findIssueAttachment(%q) expected error, got %+v", tt.ref, got)
+			}
+			continue
+		}
+		if err != nil || got.ID != tt.wantID {
+			t.Errorf("findIssueAttachment(%q) = %+v, %v, want ID %d", tt.ref, got, err, tt.wantID)
+		}
+	}
+}
+
+func TestReplaceAttachmentComment(t *testing.T) {
+	tests := []struct {
+		message string
+		kept    bool
+		want    string
+	}{
+		{message: "", want: "Replaced attachment: old.png → new.png"},
+		{message: "図を更新\n", want: "図を更新\n\nReplaced attachment: old.png → new.png"},
+		{message: "", kept: true, want: "Added attachment: new.png (replaces old.png)"},
+	}
+	for _, tt := range tests {
+		if got := replaceAttachmentComment(tt.message, "old.png", "new.png", tt.kept); got != tt.want {
+			t.Errorf("replaceAttachmentComment(%q, %v) = %q, want %q", tt.message, tt.kept, got, tt.want)
+		}
+	}
+}
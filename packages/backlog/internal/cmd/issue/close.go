@@ -17,22 +17,31 @@ var closeCmd = &cobra.Command{
 	Short: "Close an issue",
 	Long: `Close an issue by changing its status to "Closed".
 
+Before closing, open child issues and open issues referenced in the
+description or comments are listed and a confirmation is requested, so that
+a parent issue is not closed before its work is done. Use --force to skip
+the check (required when not running interactively).
+
 Examples:
   backlog issue close PROJ-123
   backlog issue close PROJ-123 --resolution 0
-  backlog issue close PROJ-123 --comment "Fixed in v1.2"`,
-	Args: cobra.ExactArgs(1),
-	RunE: runClose,
+  backlog issue close PROJ-123 --comment "Fixed in v1.2"
+  backlog issue close PROJ-123 --force`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runClose,
 }
 
 var (
 	closeResolutionID int
 	closeComment      string
+	closeForce        bool
 )
 
 func init() {
 	closeCmd.Flags().IntVar(&closeResolutionID, "resolution", 0, "Resolution ID")
 	closeCmd.Flags().StringVarP(&closeComment, "comment", "c", "", "Comment to add")
+	closeCmd.Flags().BoolVar(&closeForce, "force", false, "Close even if child or related issues are still open")
 }
 
 func runClose(c *cobra.Command, args []string) error {
@@ -42,6 +51,7 @@ func runClose(c *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	// 現在の課題を取得
 	ctx := c.Context()
@@ -49,6 +59,29 @@ func runClose(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get issue: %w", err)
 	}
+	profile := cfg.CurrentProfile()
+
+	// 未完了の子課題・関連課題がある場合は確認する（親課題だけ先に閉じる事故を防ぐ）
+	if !closeForce {
+		blockers, err := findCloseBlockers(ctx, client, issue, profile.Space)
+		if err != nil {
+			return err
+		}
+		if len(blockers) > 0 {
+			ok, err := confirmCloseBlockers(issue.IssueKey.Value, blockers)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Cancelled")
+				return nil
+			}
+		}
+	}
+
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "close", issueHookEvent(issue, profile.Space, nil)); err != nil {
+		return err
+	}
 
 	// プロジェクトのステータスを取得してCloseステータスを探す
 	statuses, err := client.GetStatuses(ctx, strconv.Itoa(issue.ProjectId.Value))
@@ -56,22 +89,9 @@ func runClose(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get statuses: %w", err)
 	}
 
-	var closedStatusID int
-	for _, s := range statuses {
-		// "完了" または "Closed" を探す
-		if s.Name == "完了" || s.Name == "Closed" || s.Name == "Done" {
-			closedStatusID = s.ID
-			break
-		}
-	}
-
-	if closedStatusID == 0 {
-		// 見つからない場合は最後のステータスを使用
-		if len(statuses) > 0 {
-			closedStatusID = statuses[len(statuses)-1].ID
-		} else {
-			return fmt.Errorf("could not find closed status")
-		}
+	closedStatusID, err := cmdutil.FindClosedStatusID(statuses)
+	if err != nil {
+		return err
 	}
 
 	input := &api.UpdateIssueInput{
@@ -89,17 +109,19 @@ func runClose(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to close issue: %w", err)
 	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "close", issueHookEvent(issue, profile.Space, issue))
 
-	profile := cfg.CurrentProfile()
 	switch profile.Output {
 	case "json":
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(issue)
 	default:
-		ui.Success("Closed %s", issue.IssueKey.Value)
+		cmdutil.Success(issue.IssueKey.Value, "Closed %s", issue.IssueKey.Value)
 		url := fmt.Sprintf("https://%s/view/%s", profile.Space, issue.IssueKey.Value)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
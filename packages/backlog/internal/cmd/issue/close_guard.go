@@ -0,0 +1,138 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/links"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// closeBlocker は課題を閉じる前に確認が必要な未完了の課題
+type closeBlocker struct {
+	// Relation は閉じる課題との関係（child: 子課題, related: 本文・コメントで言及した課題）
+	Relation string
+	Key      string
+	Summary  string
+	Status   string
+}
+
+// fixedClosedStatusID は Backlog の「完了」ステータスの ID（全プロジェクト共通で削除できない）
+const fixedClosedStatusID = 4
+
+// isIssueClosed は課題が完了しているかどうかを返す
+func isIssueClosed(issue *backlog.Issue) bool {
+	status := issue.Status.Value
+	return status.ID.Value == fixedClosedStatusID || cmdutil.IsClosedStatusName(status.Name.Value)
+}
+
+// findCloseBlockers は未完了の子課題と、本文・コメントで言及した未完了の課題を返す
+// 言及先が存在しない・参照できない場合は無視する
+func findCloseBlockers(ctx context.Context, client *api.Client, issue *backlog.Issue, spaceHost string) ([]closeBlocker, error) {
+	key := issue.IssueKey.Value
+	children, err := client.GetIssues(ctx, &api.IssueListOptions{
+		ProjectIDs:     []int{issue.ProjectId.Value},
+		ParentIssueIDs: []int{issue.ID.Value},
+		Count:          100,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child issues: %w", err)
+	}
+
+	projects, err := client.GetProjects(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	projectKeys := make(map[string]bool, len(projects))
+	for _, p := range projects {
+		projectKeys[p.ProjectKey] = true
+	}
+
+	comments, err := client.GetComments(ctx, key, &api.CommentListOptions{Count: 100, Order: "desc"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	texts := []string{issue.Description.Value}
+	for _, cm := range comments {
+		texts = append(texts, cm.Content)
+	}
+
+	exclude := map[string]bool{key: true}
+	blockers := openChildBlockers(children)
+	for _, child := range children {
+		exclude[child.IssueKey.Value] = true
+	}
+	for _, ref := range mentionedIssueKeys(texts, projectKeys, spaceHost, exclude) {
+		related, err := client.GetIssue(ctx, ref)
+		if err != nil {
+			if api.IsNotFound(err) || api.IsPermissionDenied(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s: %w", ref, err)
+		}
+		if !isIssueClosed(related) {
+			blockers = append(blockers, newCloseBlocker("related", related))
+		}
+	}
+	return blockers, nil
+}
+
+// openChildBlockers は未完了の子課題を返す
+func openChildBlockers(children []backlog.Issue) []closeBlocker {
+	var blockers []closeBlocker
+	for i := range children {
+		if !isIssueClosed(&children[i]) {
+			blockers = append(blockers, newCloseBlocker("child", &children[i]))
+		}
+	}
+	return blockers
+}
+
+// mentionedIssueKeys は本文・コメントで言及された課題キーを出現順に重複なく返す
+func mentionedIssueKeys(texts []string, projectKeys map[string]bool, spaceHost string, exclude map[string]bool) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, ref := range links.ExtractRefs(text, projectKeys, spaceHost) {
+			if ref.Kind != links.RefIssue || exclude[ref.Target] || seen[ref.Target] {
+				continue
+			}
+			seen[ref.Target] = true
+			keys = append(keys, ref.Target)
+		}
+	}
+	return keys
+}
+
+func newCloseBlocker(relation string, issue *backlog.Issue) closeBlocker {
+	return closeBlocker{
+		Relation: relation,
+		Key:      issue.IssueKey.Value,
+		Summary:  issue.Summary.Value,
+		Status:   issue.Status.Value.Name.Value,
+	}
+}
+
+// confirmCloseBlockers は未完了の関連課題を表示し、閉じてよいか確認する
+// 非対話モードでは --force が無い限りエラーにする
+func confirmCloseBlockers(issueKey string, blockers []closeBlocker) (bool, error) {
+	ui.Warning("%s has %d open child or related issue(s):", issueKey, len(blockers))
+	table := ui.NewTable("RELATION", "KEY", "STATUS", "SUMMARY")
+	for _, b := range blockers {
+		table.AddRow(b.Relation, b.Key, b.Status, b.Summary)
+	}
+	table.RenderWithColor(os.Stderr, ui.IsColorEnabled())
+
+	if !ui.IsInteractiveInput() {
+		return false, cmdutil.NonInteractiveFlagError(
+			"--force is required to close an issue with open child or related issues when not running interactively",
+			"backlog issue close",
+			"Close the listed issues first, or use --force to close it anyway.",
+		)
+	}
+	return ui.Confirm(fmt.Sprintf("Close %s anyway?", issueKey), false)
+}
@@ -0,0 +1,58 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func closeGuardIssue(key string, statusID int, statusName string) backlog.Issue {
+	return backlog.Issue{
+		IssueKey: backlog.NewOptString(key),
+		Summary:  backlog.NewOptString("summary of " + key),
+		Status:   backlog.NewOptStatus(backlog.Status{ID: backlog.NewOptInt(statusID), Name: backlog.NewOptString(statusName)}),
+	}
+}
+
+func TestOpenChildBlockers(t *testing.T) {
+	children := []backlog.Issue{
+		closeGuardIssue("PROJ-2", 1, "未対応"),
+		closeGuardIssue("PROJ-3", 4, "完了"),
+		closeGuardIssue("PROJ-4", 3, "処理済み"),
+	}
+	got := openChildBlockers(children)
+	want := []closeBlocker{
+		{Relation: "child", Key: "PROJ-2", Summary: "summary of PROJ-2", Status: "未対応"},
+		{Relation: "child", Key: "PROJ-4", Summary: "summary of PROJ-4", Status: "処理済み"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("openChildBlockers() = %+v, want %+v", got, want)
+	}
+}
+
+func TestIsIssueClosed(t *testing.T) {
+	closedByName := closeGuardIssue("PROJ-1", 99, "Closed")
+	if !isIssueClosed(&closedByName) {
+		t.Error("status named Closed should be closed")
+	}
+	open := closeGuardIssue("PROJ-1", 2, "処理中")
+	if isIssueClosed(&open) {
+		t.Error("status 処理中 should be open")
+	}
+}
+
+func TestMentionedIssueKeys(t *testing.T) {
+	projectKeys := map[string]bool{"PROJ": true, "OTHER": true}
+	texts := []string{
+		"Depends on PROJ-10 and OTHER-3. UTF-8 is not an issue.",
+		"See https://example.backlog.jp/view/PROJ-11 and PROJ-10 again",
+		"Self reference PROJ-1, child PROJ-2",
+	}
+	exclude := map[string]bool{"PROJ-1": true, "PROJ-2": true}
+	got := mentionedIssueKeys(texts, projectKeys, "example.backlog.jp", exclude)
+	want := []string{"PROJ-10", "OTHER-3", "PROJ-11"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mentionedIssueKeys() = %v, want %v", got, want)
+	}
+}
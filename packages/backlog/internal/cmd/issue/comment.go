@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -45,8 +47,9 @@ Examples:
   # Edit your last comment on the issue
   backlog issue comment PROJ-123 --edit-last --body "Updated comment"
   backlog issue comment PROJ-123 --edit-last --editor`,
-	Args: cobra.ExactArgs(1),
-	RunE: runComment,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runComment,
 }
 
 var (
@@ -99,6 +102,7 @@ func runAddComment(c *cobra.Command, issueKey string) error {
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 	interactive := ui.IsInteractiveInput()
 	if !interactive && commentBody == "" && commentBodyFile == "" && !commentEditor && len(commentAttachFiles) == 0 {
 		return cmdutil.NonInteractiveFlagError(
@@ -119,38 +123,63 @@ func runAddComment(c *cobra.Command, issueKey string) error {
 		commentBody,
 		commentBodyFile,
 		commentEditor,
-		openEditor,
+		cmdutil.EditorFunc(cfg),
 		interactiveCommentInput,
 	)
 	if err != nil {
+		// エディタが異常終了した場合は書きかけの内容を下書きに残す
+		saveCommentDraft(cfg, issueKey, message, err)
 		return fmt.Errorf("failed to get comment: %w", err)
 	}
+	message = cmdutil.ExpandEmoji(cfg.Display(), message)
 
 	if message == "" && len(commentAttachFiles) == 0 {
 		return fmt.Errorf("comment cannot be empty")
 	}
+	if err := cmdutil.CheckSecrets(cfg, "comment", message); err != nil {
+		return err
+	}
+
+	profile := cfg.CurrentProfile()
+	if err := cmdutil.RunIssuePreHook(c.Context(), cfg, "comment", issueKeyHookEvent(issueKey, profile.Space, nil)); err != nil {
+		return err
+	}
 
 	// 添付ファイルのアップロード
-	attachmentIDs, err := cmdutil.UploadFiles(c.Context(), client, commentAttachFiles)
+	attachmentIDs, err := cmdutil.UploadFiles(c.Context(), client, cfg, commentAttachFiles)
 	if err != nil {
+		saveCommentDraft(cfg, issueKey, message, err)
 		return err
 	}
 
 	comment, err := client.AddComment(c.Context(), issueKey, message, nil, attachmentIDs)
 	if err != nil {
+		// 添付付きのコメントはアップロード済みの添付を再送できないため保留しない
+		if len(attachmentIDs) == 0 {
+			if queued, qerr := enqueueOffline(cfg, queue.Entry{Kind: queue.KindIssueComment, IssueKey: issueKey, Comment: message}, err); queued {
+				if qerr != nil {
+					saveCommentDraft(cfg, issueKey, message, err)
+				}
+				return qerr
+			}
+		}
+		// 長文が失われないよう、投稿できなかったコメントは下書きに残す
+		saveCommentDraft(cfg, issueKey, message, err)
 		return fmt.Errorf("failed to add comment: %w", err)
 	}
+	cmdutil.RunIssuePostHook(c.Context(), cfg, "comment", issueKeyHookEvent(issueKey, profile.Space, comment))
 
-	profile := cfg.CurrentProfile()
 	switch profile.Output {
 	case "json":
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(comment)
 	default:
-		ui.Success("Added comment #%d to %s", comment.ID, issueKey)
+		cmdutil.Success(strconv.Itoa(comment.ID), "Added comment #%d to %s", comment.ID, issueKey)
 		url := fmt.Sprintf("https://%s/view/%s#comment-%d", profile.Space, issueKey, comment.ID)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
@@ -161,6 +190,7 @@ func runEditComment(c *cobra.Command, issueKey string) error {
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	ctx := c.Context()
 	var targetCommentID int
@@ -190,7 +220,7 @@ func runEditComment(c *cobra.Command, issueKey string) error {
 	// 新しいコンテンツを取得（エディタの場合は既存のコンテンツを初期値に）
 	var message string
 	if commentEditor {
-		message, err = openEditor(existingComment.Content)
+		message, err = cmdutil.EditorFunc(cfg)(existingComment.Content)
 		if err != nil {
 			return fmt.Errorf("failed to open editor: %w", err)
 		}
@@ -219,6 +249,7 @@ func runEditComment(c *cobra.Command, issueKey string) error {
 			return fmt.Errorf("failed to get comment: %w", err)
 		}
 	}
+	message = cmdutil.ExpandEmoji(cfg.Display(), message)
 
 	if message == "" {
 		return fmt.Errorf("comment cannot be empty")
@@ -229,6 +260,9 @@ func runEditComment(c *cobra.Command, issueKey string) error {
 		ui.Warning("No changes made to comment #%d", targetCommentID)
 		return nil
 	}
+	if err := cmdutil.CheckSecrets(cfg, "comment", message); err != nil {
+		return err
+	}
 
 	// コメントを更新
 	comment, err := client.UpdateComment(ctx, issueKey, targetCommentID, message)
@@ -243,9 +277,11 @@ func runEditComment(c *cobra.Command, issueKey string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(comment)
 	default:
-		ui.Success("Updated comment #%d on %s", comment.ID, issueKey)
+		cmdutil.Success(strconv.Itoa(comment.ID), "Updated comment #%d on %s", comment.ID, issueKey)
 		url := fmt.Sprintf("https://%s/view/%s#comment-%d", profile.Space, issueKey, comment.ID)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
@@ -275,6 +311,7 @@ func runDeleteLastComment(c *cobra.Command, issueKey string) error {
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	ctx := c.Context()
 
@@ -330,7 +367,7 @@ func runDeleteLastComment(c *cobra.Command, issueKey string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(deletedComment)
 	default:
-		ui.Success("Deleted comment #%d from %s", comment.ID, issueKey)
+		cmdutil.Success(strconv.Itoa(comment.ID), "Deleted comment #%d from %s", comment.ID, issueKey)
 		return nil
 	}
 }
@@ -0,0 +1,253 @@
+package issue
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var commentAllCmd = &cobra.Command{
+	Use:   "comment-all",
+	Short: "Post the same comment to all issues matching a query",
+	Long: `Post the same comment to every issue in the current project that matches
+--query (same syntax as "issue list --query"). Without a state or status
+condition, closed issues are excluded.
+
+The target issues are listed first. Without --auto, you confirm each issue:
+  y  post the comment
+  n  skip this issue (also Enter / Space)
+  a  post to this and all remaining issues
+  q  quit
+
+With --auto, a single confirmation is shown (skip it with --yes).
+
+Examples:
+  # Preview the target issues
+  backlog issue comment-all --query 'milestone:v1.0 status:Open' -b "The release is postponed" --dry-run
+
+  # Confirm each issue
+  backlog issue comment-all --query 'milestone:v1.0 status:Open' --body-file notice.md
+
+  # Post to all matching issues without prompts
+  backlog issue comment-all --query 'milestone:v1.0' -b "The release is postponed" --auto --yes`,
+	Args: cobra.NoArgs,
+	RunE: runCommentAll,
+}
+
+var (
+	commentAllQuery    string
+	commentAllBody     string
+	commentAllBodyFile string
+	commentAllEditor   bool
+	commentAllDryRun   bool
+	commentAllAuto     bool
+)
+
+func init() {
+	commentAllCmd.Flags().StringVarP(&commentAllQuery, "query", "q", "", "Search query selecting the target issues (required)")
+	commentAllCmd.Flags().StringVarP(&commentAllBody, "body", "b", "", "The comment body text")
+	commentAllCmd.Flags().StringVarP(&commentAllBodyFile, "body-file", "F", "", "Read body text from file (use \"-\" to read from standard input)")
+	commentAllCmd.Flags().BoolVarP(&commentAllEditor, "editor", "e", false, "Open editor to write the comment")
+	commentAllCmd.Flags().BoolVar(&commentAllDryRun, "dry-run", false, "Show target issues without posting")
+	commentAllCmd.Flags().BoolVar(&commentAllAuto, "auto", false, "Post to all target issues without confirming each one")
+	_ = commentAllCmd.MarkFlagRequired("query")
+}
+
+// commentAllAction は1件ごとの確認で選ばれた操作
+type commentAllAction int
+
+const (
+	commentAllPost commentAllAction = iota
+	commentAllSkip
+	commentAllPostRest
+	commentAllQuit
+)
+
+// commentAllKeyAction は確認時のキー入力を操作に変換する（対象外のキーは false）
+func commentAllKeyAction(key rune) (commentAllAction, bool) {
+	switch key {
+	case 'y', 'Y':
+		return commentAllPost, true
+	case 'n', 'N', '\r', '\n', ' ':
+		return commentAllSkip, true
+	case 'a', 'A':
+		return commentAllPostRest, true
+	case 'q', 'Q':
+		return commentAllQuit, true
+	}
+	return 0, false
+}
+
+func runCommentAll(c *cobra.Command, args []string) error {
+	interactive := ui.IsInteractiveInput()
+	if !commentAllDryRun && !interactive {
+		if !commentAllAuto || !cmdutil.SkipConfirmation(c) {
+			return cmdutil.NonInteractiveFlagError(
+				"--auto and --yes are required when not running interactively",
+				"backlog issue comment-all",
+				"Use --auto --yes to post without prompts, or --dry-run to preview.",
+			)
+		}
+		if commentAllBody == "" && commentAllBodyFile == "" {
+			return cmdutil.NonInteractiveFlagError(
+				"--body or --body-file is required when not running interactively",
+				"backlog issue comment-all",
+				"Use --body <text> or --body-file <path>.",
+			)
+		}
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	ctx := c.Context()
+
+	// 対象の取得前に本文を確定し、エディタを閉じた後に一覧を確認できるようにする
+	var message string
+	if !commentAllDryRun {
+		var interactiveCommentInput func() (string, error)
+		if interactive {
+			interactiveCommentInput = func() (string, error) {
+				return ui.Input("Comment:", "")
+			}
+		}
+		message, err = cmdutil.ResolveBody(commentAllBody, commentAllBodyFile, commentAllEditor, cmdutil.EditorFunc(cfg), interactiveCommentInput)
+		if err != nil {
+			return fmt.Errorf("failed to get comment: %w", err)
+		}
+		if message == "" {
+			return fmt.Errorf("comment cannot be empty")
+		}
+		if err := cmdutil.CheckSecrets(cfg, "comment", message); err != nil {
+			return err
+		}
+	}
+
+	opts, err := issueQueryListOptions(ctx, client, projectKey, commentAllQuery, time.Now())
+	if err != nil {
+		return err
+	}
+	stopProgress := ui.StartProgress("Fetching target issues...")
+	issues, err := paginateIssues(ctx, client, opts, 0)
+	stopProgress()
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+	if len(issues) == 0 {
+		fmt.Println("No issues match the query.")
+		return nil
+	}
+
+	fmt.Printf("%d issue(s) in %s match %q\n", len(issues), projectKey, commentAllQuery)
+	for _, issue := range issues {
+		fmt.Printf("  %s\t%s\t%s\n", issue.IssueKey.Value, issue.Status.Value.Name.Value, issue.Summary.Value)
+	}
+	if commentAllDryRun {
+		return nil
+	}
+
+	if commentAllAuto && !cmdutil.SkipConfirmation(c) {
+		ok, err := ui.Confirm(fmt.Sprintf("Post the comment to %d issue(s)?", len(issues)), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	space := cfg.CurrentProfile().Space
+	postRest := commentAllAuto
+	posted, skipped, failed := 0, 0, 0
+	for i := range issues {
+		issue := &issues[i]
+		prefix := fmt.Sprintf("[%d/%d]", i+1, len(issues))
+
+		if !postRest {
+			action, err := promptCommentAll(prefix, issue)
+			if err != nil {
+				return err
+			}
+			switch action {
+			case commentAllSkip:
+				skipped++
+				continue
+			case commentAllQuit:
+				skipped += len(issues) - i
+				printCommentAllSummary(posted, skipped, failed)
+				return nil
+			case commentAllPostRest:
+				postRest = true
+			}
+		}
+
+		key := issue.IssueKey.Value
+		if err := cmdutil.RunIssuePreHook(ctx, cfg, "comment", issueHookEvent(issue, space, nil)); err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "%s %s %s: %v\n", prefix, ui.FailMark(), key, err)
+			continue
+		}
+		comment, err := client.AddComment(ctx, key, message, nil, nil)
+		if err != nil {
+			if cmdutil.Interrupted(ctx) {
+				printCommentAllSummary(posted, skipped+len(issues)-i, failed)
+				return cmdutil.ErrInterrupted
+			}
+			failed++
+			fmt.Fprintf(os.Stderr, "%s %s %s: %v\n", prefix, ui.FailMark(), key, err)
+			continue
+		}
+		cmdutil.RunIssuePostHook(ctx, cfg, "comment", issueHookEvent(issue, space, comment))
+		posted++
+		cmdutil.Progressf("%s %s %s #%d", prefix, ui.OKMark(), key, comment.ID)
+	}
+
+	printCommentAllSummary(posted, skipped, failed)
+	if failed > 0 {
+		return fmt.Errorf("failed to post the comment to %d of %d issue(s)", failed, len(issues))
+	}
+	return nil
+}
+
+// promptCommentAll は1件分の確認を行う
+func promptCommentAll(prefix string, issue *backlog.Issue) (commentAllAction, error) {
+	for {
+		fmt.Printf("%s %s %s %s ", prefix, ui.Bold(issue.IssueKey.Value), issue.Summary.Value,
+			ui.Gray("[y]es [n]o [a]ll [q]uit >"))
+		key, err := ui.ReadKey()
+		fmt.Println()
+		if errors.Is(err, terminal.InterruptErr) {
+			return commentAllQuit, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if action, ok := commentAllKeyAction(key); ok {
+			return action, nil
+		}
+	}
+}
+
+func printCommentAllSummary(posted, skipped, failed int) {
+	msg := fmt.Sprintf("Posted the comment to %d issue(s)", posted)
+	if skipped > 0 {
+		msg += fmt.Sprintf(", skipped %d", skipped)
+	}
+	if failed > 0 {
+		msg += fmt.Sprintf(", failed %d", failed)
+	}
+	cmdutil.Success("", "%s", msg)
+}
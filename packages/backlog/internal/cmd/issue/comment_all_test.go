@@ -0,0 +1,24 @@
+package issue
+
+import "testing"
+
+func TestCommentAllKeyAction(t *testing.T) {
+	tests := []struct {
+		key    rune
+		want   commentAllAction
+		wantOK bool
+	}{
+		{key: 'y', want: commentAllPost, wantOK: true},
+		{key: 'N', want: commentAllSkip, wantOK: true},
+		{key: '\r', want: commentAllSkip, wantOK: true},
+		{key: 'a', want: commentAllPostRest, wantOK: true},
+		{key: 'q', want: commentAllQuit, wantOK: true},
+		{key: 'x', wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := commentAllKeyAction(tt.key)
+		if ok != tt.wantOK || (ok && got != tt.want) {
+			t.Errorf("commentAllKeyAction(%q) = (%v, %v), want (%v, %v)", tt.key, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
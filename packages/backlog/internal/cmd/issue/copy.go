@@ -0,0 +1,112 @@
+package issue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+)
+
+var copyCmd = &cobra.Command{
+	Use:   "copy <issue-key>...",
+	Short: "Print a link text of issues for pasting into chat",
+	Long: `Print a short text with the issue key, summary and URL for pasting into chat
+or documents. With --clipboard the text is copied to the clipboard instead.
+
+Formats:
+  plain          [PROJ-123] Summary https://example.backlog.jp/view/PROJ-123
+  slack          <https://example.backlog.jp/view/PROJ-123|[PROJ-123] Summary>
+  markdown-link  [PROJ-123 Summary](https://example.backlog.jp/view/PROJ-123)
+
+Multiple issues are printed one per line.
+
+The clipboard is written with pbcopy (macOS), PowerShell (Windows), or
+wl-copy / xclip / xsel (Linux; clip.exe on WSL).
+
+Examples:
+  backlog issue copy PROJ-123
+  backlog issue copy PROJ-123 --format slack --clipboard
+  backlog issue copy 123 124 --format markdown-link`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runCopy,
+}
+
+var (
+	copyFormat    string
+	copyClipboard bool
+)
+
+// issue copy の出力形式
+const (
+	copyFormatPlain        = "plain"
+	copyFormatSlack        = "slack"
+	copyFormatMarkdownLink = "markdown-link"
+)
+
+func init() {
+	copyCmd.Flags().StringVar(&copyFormat, "format", copyFormatPlain, "Text format: {plain|slack|markdown-link}")
+	copyCmd.Flags().BoolVar(&copyClipboard, "clipboard", false, "Copy the text to the clipboard instead of printing it")
+}
+
+// slackEscaper は Slack の mrkdwn で制御文字になる記号をエスケープする
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// markdownLinkEscaper は Markdown のリンクテキストを閉じてしまう記号をエスケープする
+var markdownLinkEscaper = strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`)
+
+// formatIssueCopy は課題1件分の共有用テキストを作る
+func formatIssueCopy(format, key, summary, url string) (string, error) {
+	switch format {
+	case copyFormatPlain:
+		return fmt.Sprintf("[%s] %s %s", key, summary, url), nil
+	case copyFormatSlack:
+		return fmt.Sprintf("<%s|[%s] %s>", url, key, slackEscaper.Replace(summary)), nil
+	case copyFormatMarkdownLink:
+		return fmt.Sprintf("[%s %s](%s)", key, markdownLinkEscaper.Replace(summary), url), nil
+	default:
+		return "", fmt.Errorf("invalid format %q (must be plain, slack or markdown-link)", format)
+	}
+}
+
+func runCopy(c *cobra.Command, args []string) error {
+	// API を呼ぶ前に形式を検証する
+	if _, err := formatIssueCopy(copyFormat, "", "", ""); err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	space := cfg.CurrentProfile().Space
+	projectKey := cmdutil.GetCurrentProject(cfg)
+
+	lines := make([]string, 0, len(args))
+	for _, arg := range args {
+		key, _ := cmdutil.ResolveIssueKey(arg, projectKey)
+		issue, err := client.GetIssue(c.Context(), key)
+		if err != nil {
+			return fmt.Errorf("failed to get issue %s: %w", key, err)
+		}
+		key = issue.IssueKey.Value
+		line, err := formatIssueCopy(copyFormat, key, issue.Summary.Value, fmt.Sprintf("https://%s/view/%s", space, key))
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line)
+	}
+	text := strings.Join(lines, "\n")
+
+	if !copyClipboard {
+		fmt.Println(text)
+		return nil
+	}
+	if err := osutil.CopyToClipboard(text); err != nil {
+		return fmt.Errorf("failed to copy to the clipboard: %w", err)
+	}
+	cmdutil.Success("", "Copied %d issue link(s) to the clipboard", len(lines))
+	return nil
+}
@@ -0,0 +1,35 @@
+package issue
+
+import "testing"
+
+func TestFormatIssueCopy(t *testing.T) {
+	const url = "https://example.backlog.jp/view/PROJ-123"
+	tests := []struct {
+		format  string
+		summary string
+		want    string
+		wantErr bool
+	}{
+		{format: "plain", summary: "ログインできない", want: "[PROJ-123] ログインできない " + url},
+		{format: "slack", summary: "A < B & C", want: "<" + url + "|[PROJ-123] A &lt; B &amp; C>"},
+		{format: "markdown-link", summary: "[UI] ボタンが効かない", want: `[PROJ-123 \[UI\] ボタンが効かない](` + url + ")"},
+		{format: "html", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := formatIssueCopy(tt.format, "PROJ-123", tt.summary, url)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("formatIssueCopy(%q) error = nil, want error", tt.format)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("formatIssueCopy(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,10 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
@@ -43,7 +43,36 @@ Examples:
   backlog issue create --title "Bug" --editor
 
   # Assign to yourself
-  backlog issue create -t "Task" -a @me`,
+  backlog issue create -t "Task" -a @me
+
+  # Notify users about the new issue
+  backlog issue create -t "Release checklist" --notify alice,bob
+
+  # Set custom fields
+  backlog issue create -t "Login fails" --field "Severity=High" --field "Browsers=Chrome,Safari"
+
+  # Skip creation if an issue with the same title was created in the last 24h
+  backlog issue create -t "Disk full on web-1" --type Bug --priority 2 \
+    --dedupe-window 24h --dedupe-key title
+
+With --dedupe-window, the command searches issues created within the window
+and, when one matches the --dedupe-key fields (title, type, assignee,
+description; title is always compared), prints the existing issue instead of
+creating a new one.
+
+When --type, --priority or --notify is omitted, issue_defaults in the
+configuration (e.g. .backlog.yaml) is used:
+
+  issue_defaults:
+    type: Task
+    priority: Normal
+    notify: [team-lead]
+    require_due: true
+
+Before creating the issue, required custom fields of the project (for the
+selected issue type) and the due date when issue_defaults.require_due is set
+are checked. Missing values are prompted for interactively; otherwise the
+command fails listing the --field / --due flags to add.`,
 	RunE: runCreate,
 }
 
@@ -59,6 +88,10 @@ var (
 	createMilestones  string
 	createCategories  string
 	createAttachFiles []string
+	createNotify      string
+	createDedupeWin   string
+	createDedupeKey   string
+	createFields      []string
 )
 
 type createPromptState struct {
@@ -78,7 +111,11 @@ func init() {
 	createCmd.Flags().BoolVarP(&createEditor, "editor", "e", false, "Open editor to write the body")
 	createCmd.Flags().StringVarP(&createMilestones, "milestone", "m", "", "Milestone IDs or names (comma-separated)")
 	createCmd.Flags().StringVar(&createCategories, "category", "", "Category IDs or names (comma-separated)")
+	createCmd.Flags().StringVar(&createNotify, "notify", "", "Users to notify (comma-separated user IDs, userIds, display names, or @me)")
 	createCmd.Flags().StringArrayVar(&createAttachFiles, "attach", nil, "Attach local file(s) by path (can be specified multiple times)")
+	createCmd.Flags().StringVar(&createDedupeWin, "dedupe-window", "", "Return an existing issue created within this period instead of creating a duplicate (e.g. 24h, 7d)")
+	createCmd.Flags().StringArrayVar(&createFields, "field", nil, "Custom field value as NAME=VALUE (list items by name or ID, comma-separated for multiple; can be specified multiple times)")
+	createCmd.Flags().StringVar(&createDedupeKey, "dedupe-key", "title", "Fields compared for --dedupe-window: {title|type|assignee|description} (comma-separated)")
 }
 
 func runCreate(c *cobra.Command, args []string) error {
@@ -94,6 +131,19 @@ func runCreate(c *cobra.Command, args []string) error {
 	projectKey := cmdutil.GetCurrentProject(cfg)
 	ctx := c.Context()
 
+	var dedupeWindow time.Duration
+	var dedupeKeys []string
+	if createDedupeWin != "" {
+		if dedupeWindow, err = cmdutil.ParseDurationFlag("--dedupe-window", createDedupeWin); err != nil {
+			return err
+		}
+		if dedupeKeys, err = parseDedupeKeys(createDedupeKey); err != nil {
+			return err
+		}
+	} else if c.Flags().Changed("dedupe-key") {
+		return fmt.Errorf("--dedupe-key requires --dedupe-window")
+	}
+
 	// プロジェクト情報取得
 	project, err := client.GetProject(ctx, projectKey)
 	if err != nil {
@@ -106,12 +156,31 @@ func runCreate(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get issue types: %w", err)
 	}
 
+	// フラグで指定されなかった項目には issue_defaults を適用する
+	defaults := cfg.IssueDefaults()
+	issueType := createType
+	if issueType == "" {
+		issueType = defaults.Type
+	}
+	priorityID := createPriority
+	if priorityID == 0 && defaults.Priority != "" {
+		priorityIDs, err := cmdutil.ResolvePriorityIDs(ctx, client, defaults.Priority)
+		if err != nil {
+			return fmt.Errorf("failed to resolve issue_defaults.priority: %w", err)
+		}
+		priorityID = priorityIDs[0]
+	}
+	notify := defaults.Notify
+	if c.Flags().Changed("notify") {
+		notify = strings.Split(createNotify, ",")
+	}
+
 	interactive := ui.IsInteractiveInput()
 	if !interactive {
 		if err := validateNonInteractiveCreateFlags(createPromptState{
 			Title:    createTitle,
-			Type:     createType,
-			Priority: createPriority,
+			Type:     issueType,
+			Priority: priorityID,
 		}, issueTypes); err != nil {
 			return err
 		}
@@ -138,8 +207,8 @@ func runCreate(c *cobra.Command, args []string) error {
 	}
 
 	// 課題種別
-	if createType != "" {
-		issueTypeIDs, err := cmdutil.ResolveIssueTypeIDs(ctx, client, projectKey, createType)
+	if issueType != "" {
+		issueTypeIDs, err := cmdutil.ResolveIssueTypeIDs(ctx, client, projectKey, issueType)
 		if err != nil {
 			return fmt.Errorf("failed to resolve issue type: %w", err)
 		}
@@ -163,8 +232,8 @@ func runCreate(c *cobra.Command, args []string) error {
 	}
 
 	// 優先度
-	if createPriority > 0 {
-		input.PriorityID = createPriority
+	if priorityID > 0 {
+		input.PriorityID = priorityID
 	} else if interactive {
 		// デフォルトは「中」(ID=3)
 		priorityOpts := []ui.SelectOption{
@@ -221,18 +290,27 @@ func runCreate(c *cobra.Command, args []string) error {
 		createBody,
 		createBodyFile,
 		createEditor,
-		openEditor,
+		cmdutil.EditorFunc(cfg),
 		interactiveBodyInput,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to get body: %w", err)
 	}
+	input.Description = cmdutil.ExpandEmoji(cfg.Display(), input.Description)
+	if err := cmdutil.CheckSecrets(cfg, "issue body", input.Description); err != nil {
+		return err
+	}
 
 	// 期限
 	if createDueDate != "" {
 		input.DueDate = createDueDate
 	}
 
+	// カスタムフィールドと必須項目の事前検証（API エラーになる前に検出する）
+	if err := applyRequiredFields(ctx, client, defaults, projectKey, input, createFields, interactive); err != nil {
+		return err
+	}
+
 	// マイルストーン
 	if createMilestones != "" {
 		milestoneIDs, err := cmdutil.ResolveMilestoneIDs(ctx, client, projectKey, createMilestones)
@@ -251,9 +329,47 @@ func runCreate(c *cobra.Command, args []string) error {
 		input.CategoryIDs = categoryIDs
 	}
 
+	// 通知先
+	for _, user := range notify {
+		user = strings.TrimSpace(user)
+		if user == "" {
+			continue
+		}
+		userID, err := cmdutil.ResolveProjectAssigneeID(ctx, client, projectKey, user)
+		if err != nil {
+			return fmt.Errorf("failed to resolve user to notify: %w", err)
+		}
+		input.NotifiedUserIDs = append(input.NotifiedUserIDs, userID)
+	}
+
+	profile := cfg.CurrentProfile()
+
+	// 重複起票の検知（添付ファイルのアップロード前に行う）
+	if dedupeWindow > 0 {
+		existing, err := findDuplicateIssue(ctx, client, input, dedupeKeys, dedupeWindow, time.Now())
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			if profile.Output == "json" {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(existing)
+			}
+			fmt.Printf("%s Issue already exists: %s (created %s)\n", ui.Yellow("!"), existing.IssueKey.Value, existing.Created.Value)
+			url := fmt.Sprintf("https://%s/view/%s", profile.Space, existing.IssueKey.Value)
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+			return nil
+		}
+	}
+
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "create", cmdutil.HookEvent{ProjectKey: projectKey, Summary: input.Summary}); err != nil {
+		return err
+	}
+
 	// 添付ファイルのアップロード
 	if len(createAttachFiles) > 0 {
-		attachmentIDs, err := cmdutil.UploadFiles(ctx, client, createAttachFiles)
+		attachmentIDs, err := cmdutil.UploadFiles(ctx, client, cfg, createAttachFiles)
 		if err != nil {
 			return err
 		}
@@ -261,7 +377,6 @@ func runCreate(c *cobra.Command, args []string) error {
 	}
 
 	// 作成
-	profile := cfg.CurrentProfile()
 	if profile.Output != "json" {
 		fmt.Println("Creating issue...")
 	}
@@ -269,6 +384,7 @@ func runCreate(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to create issue: %w", err)
 	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "create", issueHookEvent(issue, profile.Space, issue))
 
 	switch profile.Output {
 	case "json":
@@ -276,9 +392,11 @@ func runCreate(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(issue)
 	default:
-		ui.Success("Created issue %s", issue.IssueKey.Value)
+		cmdutil.Success(issue.IssueKey.Value, "Created issue %s", issue.IssueKey.Value)
 		url := fmt.Sprintf("https://%s/view/%s", profile.Space, issue.IssueKey.Value)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
@@ -340,40 +458,3 @@ func joinCreateFlags(flags []string) string {
 		return strings.Join(flags[:len(flags)-1], ", ") + ", and " + flags[len(flags)-1]
 	}
 }
-
-func openEditor(initial string) (string, error) {
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		editor = "vi"
-	}
-
-	// 一時ファイル作成
-	tmpfile, err := os.CreateTemp("", "backlog-*.md")
-	if err != nil {
-		return "", err
-	}
-	defer func() { _ = os.Remove(tmpfile.Name()) }()
-
-	if initial != "" {
-		_, _ = tmpfile.WriteString(initial)
-	}
-	_ = tmpfile.Close()
-
-	// エディタ起動
-	editorCmd := exec.Command(editor, tmpfile.Name())
-	editorCmd.Stdin = os.Stdin
-	editorCmd.Stdout = os.Stdout
-	editorCmd.Stderr = os.Stderr
-
-	if err := editorCmd.Run(); err != nil {
-		return "", err
-	}
-
-	// 内容読み込み
-	content, err := os.ReadFile(tmpfile.Name())
-	if err != nil {
-		return "", err
-	}
-
-	return strings.TrimSpace(string(content)), nil
-}
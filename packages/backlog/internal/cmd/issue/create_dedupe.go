@@ -0,0 +1,96 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+// dedupeFields は --dedupe-key に指定できる比較項目
+var dedupeFields = []string{"title", "type", "assignee", "description"}
+
+// dedupeSearchCount は重複候補として取得する課題の上限
+const dedupeSearchCount = 100
+
+// parseDedupeKeys は --dedupe-key（カンマ区切り）を解析する
+// title は常に比較対象に含める。
+func parseDedupeKeys(value string) ([]string, error) {
+	keys := []string{"title"}
+	for _, k := range strings.Split(value, ",") {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k == "" || k == "title" {
+			continue
+		}
+		valid := false
+		for _, f := range dedupeFields {
+			if k == f {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid --dedupe-key %q (must be one of %s)", k, strings.Join(dedupeFields, ", "))
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// matchesDedupe は既存課題が作成しようとしている課題と重複するかを判定する
+func matchesDedupe(issue backlog.Issue, input *api.CreateIssueInput, keys []string, cutoff time.Time) bool {
+	created, err := time.Parse(time.RFC3339, issue.Created.Value)
+	if err != nil || created.Before(cutoff) {
+		return false
+	}
+	for _, k := range keys {
+		switch k {
+		case "title":
+			if strings.TrimSpace(issue.Summary.Value) != strings.TrimSpace(input.Summary) {
+				return false
+			}
+		case "type":
+			if issue.IssueType.Value.ID.Value != input.IssueTypeID {
+				return false
+			}
+		case "assignee":
+			assigneeID := 0
+			if issue.Assignee.Set && !issue.Assignee.Null {
+				assigneeID = issue.Assignee.Value.ID.Value
+			}
+			if assigneeID != input.AssigneeID {
+				return false
+			}
+		case "description":
+			if strings.TrimSpace(issue.Description.Value) != strings.TrimSpace(input.Description) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// findDuplicateIssue は直近 window 内に作成された重複課題を探す（最新のものを返す）
+func findDuplicateIssue(ctx context.Context, client *api.Client, input *api.CreateIssueInput, keys []string, window time.Duration, now time.Time) (*backlog.Issue, error) {
+	cutoff := now.Add(-window)
+	issues, err := client.GetIssues(ctx, &api.IssueListOptions{
+		ProjectIDs:   []int{input.ProjectID},
+		Keyword:      input.Summary,
+		CreatedSince: cutoff.Format("2006-01-02"),
+		Sort:         "created",
+		Order:        "desc",
+		Count:        dedupeSearchCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search duplicate issues: %w", err)
+	}
+	for i := range issues {
+		if matchesDedupe(issues[i], input, keys, cutoff) {
+			return &issues[i], nil
+		}
+	}
+	return nil, nil
+}
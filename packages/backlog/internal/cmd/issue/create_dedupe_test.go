@@ -0,0 +1,82 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestParseDedupeKeys(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{in: "title", want: []string{"title"}},
+		{in: "", want: []string{"title"}},
+		{in: "type, Assignee", want: []string{"title", "type", "assignee"}},
+		{in: "title,priority", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseDedupeKeys(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDedupeKeys(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseDedupeKeys(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesDedupe(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-24 * time.Hour)
+	existing := backlog.Issue{
+		Summary:   backlog.NewOptString("Disk full on web-1"),
+		Created:   backlog.NewOptString("2026-10-16T03:00:00Z"),
+		IssueType: backlog.NewOptIssueType(backlog.IssueType{ID: backlog.NewOptInt(1)}),
+		Assignee:  backlog.NewOptNilUser(backlog.User{ID: backlog.NewOptInt(7)}),
+	}
+	input := &api.CreateIssueInput{Summary: " Disk full on web-1 ", IssueTypeID: 1, AssigneeID: 7}
+
+	tests := []struct {
+		name   string
+		issue  func() backlog.Issue
+		input  func() *api.CreateIssueInput
+		keys   []string
+		expect bool
+	}{
+		{name: "same title", keys: []string{"title"}, expect: true},
+		{name: "different title", keys: []string{"title"}, expect: false,
+			input: func() *api.CreateIssueInput { in := *input; in.Summary = "Disk full on web-2"; return &in }},
+		{name: "outside window", keys: []string{"title"}, expect: false,
+			issue: func() backlog.Issue {
+				i := existing
+				i.Created = backlog.NewOptString("2026-10-15T11:00:00Z")
+				return i
+			}},
+		{name: "type and assignee", keys: []string{"title", "type", "assignee"}, expect: true},
+		{name: "different assignee", keys: []string{"title", "assignee"}, expect: false,
+			input: func() *api.CreateIssueInput { in := *input; in.AssigneeID = 0; return &in }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := existing
+			if tt.issue != nil {
+				issue = tt.issue()
+			}
+			in := input
+			if tt.input != nil {
+				in = tt.input()
+			}
+			if got := matchesDedupe(issue, in, tt.keys, cutoff); got != tt.expect {
+				t.Errorf("matchesDedupe() = %v, want %v", got, tt.expect)
+			}
+		})
+	}
+}
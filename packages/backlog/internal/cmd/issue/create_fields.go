@@ -0,0 +1,292 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// カスタムフィールドの種別 ID
+const (
+	customFieldTypeNumeric      = 3
+	customFieldTypeDate         = 4
+	customFieldTypeSingleList   = 5
+	customFieldTypeMultipleList = 6
+	customFieldTypeCheckbox     = 7
+	customFieldTypeRadio        = 8
+)
+
+// fieldAssignment は --field NAME=VALUE の1件分
+type fieldAssignment struct {
+	Name  string
+	Value string
+}
+
+// parseFieldFlags は --field の値を解析する
+func parseFieldFlags(flags []string) ([]fieldAssignment, error) {
+	assigns := make([]fieldAssignment, 0, len(flags))
+	for _, f := range flags {
+		name, value, ok := strings.Cut(f, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --field %q (expected NAME=VALUE)", f)
+		}
+		assigns = append(assigns, fieldAssignment{Name: name, Value: strings.TrimSpace(value)})
+	}
+	return assigns, nil
+}
+
+// customFieldApplies はカスタムフィールドが課題種別に適用されるかを返す（指定なしは全種別）
+func customFieldApplies(f backlog.CustomField, issueTypeID int) bool {
+	if len(f.ApplicableIssueTypes) == 0 {
+		return true
+	}
+	for _, id := range f.ApplicableIssueTypes {
+		if id == issueTypeID {
+			return true
+		}
+	}
+	return false
+}
+
+// isMultipleCustomField は複数の値を持てるカスタムフィールドかを返す
+func isMultipleCustomField(f backlog.CustomField) bool {
+	t := f.TypeId.Value
+	return t == customFieldTypeMultipleList || t == customFieldTypeCheckbox
+}
+
+// isListCustomField は選択肢から選ぶカスタムフィールドかを返す
+func isListCustomField(f backlog.CustomField) bool {
+	t := f.TypeId.Value
+	return t >= customFieldTypeSingleList && t <= customFieldTypeRadio
+}
+
+// findCustomField は名前（大文字小文字を区別しない）または ID でカスタムフィールドを探す
+func findCustomField(fields []backlog.CustomField, name string) (backlog.CustomField, bool) {
+	for _, f := range fields {
+		if strings.EqualFold(f.Name.Value, name) || strconv.Itoa(f.ID.Value) == name {
+			return f, true
+		}
+	}
+	return backlog.CustomField{}, false
+}
+
+// convertCustomFieldValue は入力値を API に送る値に変換する
+// リスト形式は選択肢の名前または ID を選択肢 ID に変換し、複数選択はカンマ区切りを受け付ける
+func convertCustomFieldValue(f backlog.CustomField, value string) ([]string, error) {
+	name := f.Name.Value
+	if isListCustomField(f) {
+		parts := []string{value}
+		if isMultipleCustomField(f) {
+			parts = strings.Split(value, ",")
+		}
+		var ids []string
+		for _, p := range parts {
+			p = strings.TrimSpace(p)
+			if p == "" {
+				continue
+			}
+			id, ok := findCustomFieldItem(f, p)
+			if !ok {
+				return nil, fmt.Errorf("%q is not an item of %s (items: %s)", p, name, customFieldItemNames(f))
+			}
+			ids = append(ids, id)
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("%s requires a value", name)
+		}
+		return ids, nil
+	}
+	if value == "" {
+		return nil, fmt.Errorf("%s requires a value", name)
+	}
+	switch f.TypeId.Value {
+	case customFieldTypeNumeric:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%s must be a number: %q", name, value)
+		}
+	case customFieldTypeDate:
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, fmt.Errorf("%s must be a date (YYYY-MM-DD): %q", name, value)
+		}
+	}
+	return []string{value}, nil
+}
+
+func findCustomFieldItem(f backlog.CustomField, nameOrID string) (string, bool) {
+	for _, item := range f.Items {
+		if strings.EqualFold(item.Name.Value, nameOrID) || strconv.Itoa(item.ID.Value) == nameOrID {
+			return strconv.Itoa(item.ID.Value), true
+		}
+	}
+	return "", false
+}
+
+func customFieldItemNames(f backlog.CustomField) string {
+	names := make([]string, len(f.Items))
+	for i, item := range f.Items {
+		names[i] = item.Name.Value
+	}
+	return strings.Join(names, ", ")
+}
+
+// resolveCustomFieldValues は --field の指定をカスタムフィールド ID ごとの値に変換する
+func resolveCustomFieldValues(fields []backlog.CustomField, assigns []fieldAssignment, issueTypeID int) (map[int][]string, error) {
+	values := make(map[int][]string, len(assigns))
+	for _, a := range assigns {
+		f, ok := findCustomField(fields, a.Name)
+		if !ok {
+			return nil, fmt.Errorf("custom field %q not found in this project", a.Name)
+		}
+		if !customFieldApplies(f, issueTypeID) {
+			return nil, fmt.Errorf("custom field %q is not available for the selected issue type", a.Name)
+		}
+		v, err := convertCustomFieldValue(f, a.Value)
+		if err != nil {
+			return nil, err
+		}
+		values[f.ID.Value] = v
+	}
+	return values, nil
+}
+
+// missingRequiredFields は課題種別に適用される必須カスタムフィールドのうち、値が無いものを返す
+func missingRequiredFields(fields []backlog.CustomField, issueTypeID int, values map[int][]string) []backlog.CustomField {
+	var missing []backlog.CustomField
+	for _, f := range fields {
+		if !f.Required.Value || !customFieldApplies(f, issueTypeID) {
+			continue
+		}
+		if len(values[f.ID.Value]) == 0 {
+			missing = append(missing, f)
+		}
+	}
+	return missing
+}
+
+// requiredFieldsError は非対話モードで必須項目が足りないときのエラーを作る
+func requiredFieldsError(missing []backlog.CustomField, dueMissing bool) error {
+	var names []string
+	if dueMissing {
+		names = append(names, "--due")
+	}
+	for _, f := range missing {
+		names = append(names, f.Name.Value)
+	}
+	lines := []string{fmt.Sprintf("required fields are missing: %s", strings.Join(names, ", "))}
+	if dueMissing {
+		lines = append(lines, "", "This project requires a due date (issue_defaults.require_due):", "  --due YYYY-MM-DD")
+	}
+	if len(missing) > 0 {
+		lines = append(lines, "", "Set the required custom fields with --field:")
+		for _, f := range missing {
+			hint := "VALUE"
+			switch {
+			case isListCustomField(f):
+				hint = customFieldItemNames(f)
+				if isMultipleCustomField(f) {
+					hint += " (comma-separated)"
+				}
+				hint = "{" + hint + "}"
+			case f.TypeId.Value == customFieldTypeNumeric:
+				hint = "NUMBER"
+			case f.TypeId.Value == customFieldTypeDate:
+				hint = "YYYY-MM-DD"
+			}
+			lines = append(lines, fmt.Sprintf("  --field %q", f.Name.Value+"="+hint))
+		}
+	}
+	return errors.New(strings.Join(lines, "\n"))
+}
+
+// promptCustomField は必須カスタムフィールドの値を対話的に入力させる
+func promptCustomField(f backlog.CustomField) ([]string, error) {
+	name := f.Name.Value
+	if isListCustomField(f) && !isMultipleCustomField(f) {
+		opts := make([]ui.SelectOption, len(f.Items))
+		for i, item := range f.Items {
+			opts[i] = ui.SelectOption{Value: strconv.Itoa(item.ID.Value), Description: item.Name.Value}
+		}
+		selected, err := ui.SelectWithDesc(name+":", opts)
+		if err != nil {
+			return nil, err
+		}
+		return []string{selected}, nil
+	}
+
+	message := name + ":"
+	switch {
+	case isMultipleCustomField(f):
+		message = fmt.Sprintf("%s (comma-separated: %s):", name, customFieldItemNames(f))
+	case f.TypeId.Value == customFieldTypeDate:
+		message = name + " (YYYY-MM-DD):"
+	}
+	value, err := ui.Input(message, "")
+	if err != nil {
+		return nil, err
+	}
+	return convertCustomFieldValue(f, strings.TrimSpace(value))
+}
+
+// applyRequiredFields は --field の値を設定し、プロジェクトで必須の項目を API を呼ぶ前に検証する
+// 対話モードでは足りない項目の入力を促し、非対話モードでは足りない項目をまとめてエラーにする
+func applyRequiredFields(ctx context.Context, client *api.Client, defaults *config.ResolvedIssueDefaults, projectKey string, input *api.CreateIssueInput, fieldFlags []string, interactive bool) error {
+	assigns, err := parseFieldFlags(fieldFlags)
+	if err != nil {
+		return err
+	}
+
+	// 定義はキャッシュを使って取得する。取得できなくても --field が無ければ検証を省いて起票を続ける
+	fields, err := client.GetCustomFieldDefinitions(ctx, projectKey)
+	if err != nil {
+		if len(assigns) > 0 {
+			return fmt.Errorf("failed to get custom fields: %w", err)
+		}
+		debug.Log("skip required field validation", "error", err)
+		fields = nil
+	}
+
+	values, err := resolveCustomFieldValues(fields, assigns, input.IssueTypeID)
+	if err != nil {
+		return err
+	}
+	missing := missingRequiredFields(fields, input.IssueTypeID, values)
+	dueMissing := defaults.RequireDue && input.DueDate == ""
+
+	if (len(missing) > 0 || dueMissing) && !interactive {
+		return requiredFieldsError(missing, dueMissing)
+	}
+
+	if dueMissing {
+		due, err := ui.Input("Due date (YYYY-MM-DD):", "")
+		if err != nil {
+			return err
+		}
+		due = strings.TrimSpace(due)
+		if _, err := time.Parse("2006-01-02", due); err != nil {
+			return fmt.Errorf("due date is required (YYYY-MM-DD): %q", due)
+		}
+		input.DueDate = due
+	}
+	for _, f := range missing {
+		v, err := promptCustomField(f)
+		if err != nil {
+			return err
+		}
+		values[f.ID.Value] = v
+	}
+
+	if len(values) > 0 {
+		input.CustomFields = values
+	}
+	return nil
+}
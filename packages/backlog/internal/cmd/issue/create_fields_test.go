@@ -0,0 +1,96 @@
+package issue
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func testCustomFields() []backlog.CustomField {
+	item := func(id int, name string) backlog.CustomFieldItem {
+		return backlog.CustomFieldItem{ID: backlog.NewOptInt(id), Name: backlog.NewOptString(name)}
+	}
+	return []backlog.CustomField{
+		{
+			ID: backlog.NewOptInt(1), TypeId: backlog.NewOptInt(customFieldTypeSingleList),
+			Name: backlog.NewOptString("Severity"), Required: backlog.NewOptBool(true),
+			Items: []backlog.CustomFieldItem{item(11, "High"), item(12, "Low")},
+		},
+		{
+			ID: backlog.NewOptInt(2), TypeId: backlog.NewOptInt(customFieldTypeCheckbox),
+			Name:  backlog.NewOptString("Browsers"),
+			Items: []backlog.CustomFieldItem{item(21, "Chrome"), item(22, "Safari")},
+		},
+		{
+			ID: backlog.NewOptInt(3), TypeId: backlog.NewOptInt(customFieldTypeDate),
+			Name: backlog.NewOptString("Release"), Required: backlog.NewOptBool(true),
+			ApplicableIssueTypes: []int{100},
+		},
+	}
+}
+
+func TestResolveCustomFieldValues(t *testing.T) {
+	fields := testCustomFields()
+	assigns, err := parseFieldFlags([]string{"severity=High", "Browsers=Chrome, Safari"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := resolveCustomFieldValues(fields, assigns, 200)
+	if err != nil {
+		t.Fatalf("resolveCustomFieldValues() error = %v", err)
+	}
+	want := map[int][]string{1: {"11"}, 2: {"21", "22"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolveCustomFieldValues() = %v, want %v", got, want)
+	}
+
+	for _, tt := range []struct {
+		flag    string
+		issueID int
+		wantErr string
+	}{
+		{flag: "Severity=Middle", issueID: 200, wantErr: `"Middle" is not an item of Severity`},
+		{flag: "Unknown=1", issueID: 200, wantErr: `custom field "Unknown" not found`},
+		{flag: "Release=2026-10-16", issueID: 200, wantErr: "not available for the selected issue type"},
+		{flag: "Release=next week", issueID: 100, wantErr: "must be a date"},
+	} {
+		assigns, _ := parseFieldFlags([]string{tt.flag})
+		if _, err := resolveCustomFieldValues(fields, assigns, tt.issueID); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("resolveCustomFieldValues(%q) error = %v, want %q", tt.flag, err, tt.wantErr)
+		}
+	}
+
+	if _, err := parseFieldFlags([]string{"Severity"}); err == nil {
+		t.Error("parseFieldFlags() accepted a value without '='")
+	}
+}
+
+func TestMissingRequiredFields(t *testing.T) {
+	fields := testCustomFields()
+
+	// 適用されない課題種別の必須項目は対象外
+	missing := missingRequiredFields(fields, 200, map[int][]string{})
+	if len(missing) != 1 || missing[0].Name.Value != "Severity" {
+		t.Fatalf("missingRequiredFields(200) = %v, want Severity", missing)
+	}
+
+	missing = missingRequiredFields(fields, 100, map[int][]string{1: {"11"}})
+	if len(missing) != 1 || missing[0].Name.Value != "Release" {
+		t.Fatalf("missingRequiredFields(100) = %v, want Release", missing)
+	}
+
+	err := requiredFieldsError(missingRequiredFields(fields, 100, nil), true)
+	for _, want := range []string{
+		"required fields are missing: --due, Severity, Release",
+		`--field "Severity={High, Low}"`,
+		`--field "Release=YYYY-MM-DD"`,
+		"--due YYYY-MM-DD",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("requiredFieldsError() = %q, want to contain %q", err, want)
+		}
+	}
+}
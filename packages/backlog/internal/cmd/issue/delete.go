@@ -21,8 +21,9 @@ This action cannot be undone. You must have administrator or project administrat
 Examples:
   backlog issue delete PROJ-123
   backlog issue delete PROJ-123 --yes  # Skip confirmation`,
-	Args: cobra.ExactArgs(1),
-	RunE: runDelete,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runDelete,
 }
 
 func init() {
@@ -35,6 +36,7 @@ func runDelete(c *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	ctx := c.Context()
 
@@ -67,21 +69,26 @@ func runDelete(c *cobra.Command, args []string) error {
 		}
 	}
 
+	profile := cfg.CurrentProfile()
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "delete", issueHookEvent(issue, profile.Space, nil)); err != nil {
+		return err
+	}
+
 	// 課題を削除
 	deletedIssue, err := client.DeleteIssue(ctx, issueKey)
 	if err != nil {
 		return fmt.Errorf("failed to delete issue: %w", err)
 	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "delete", issueHookEvent(deletedIssue, profile.Space, deletedIssue))
 
 	// 出力
-	profile := cfg.CurrentProfile()
 	switch profile.Output {
 	case "json":
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(deletedIssue)
 	default:
-		ui.Success("Deleted %s: %s", deletedIssue.IssueKey.Value, deletedIssue.Summary.Value)
+		cmdutil.Success(deletedIssue.IssueKey.Value, "Deleted %s: %s", deletedIssue.IssueKey.Value, deletedIssue.Summary.Value)
 		return nil
 	}
 }
@@ -0,0 +1,180 @@
+package issue
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"gopkg.in/yaml.v3"
+)
+
+// issueDocument は issue pull/push で扱うローカルファイル（フロントマター＋本文）の内容
+type issueDocument struct {
+	Key            string   `yaml:"key"`
+	Summary        string   `yaml:"summary"`
+	Type           string   `yaml:"type,omitempty"`
+	Status         string   `yaml:"status,omitempty"`
+	Priority       string   `yaml:"priority,omitempty"`
+	Assignee       string   `yaml:"assignee,omitempty"`
+	StartDate      string   `yaml:"start_date,omitempty"`
+	DueDate        string   `yaml:"due_date,omitempty"`
+	EstimatedHours *float64 `yaml:"estimated_hours,omitempty"`
+	ActualHours    *float64 `yaml:"actual_hours,omitempty"`
+	Categories     []string `yaml:"categories,omitempty"`
+	Milestones     []string `yaml:"milestones,omitempty"`
+	Versions       []string `yaml:"versions,omitempty"`
+	// Updated は pull 時点の課題の更新日時（push 時の競合検出に使う）
+	Updated string `yaml:"updated"`
+
+	Body string `yaml:"-"`
+}
+
+const frontMatterDelimiter = "---"
+
+// newIssueDocument は課題からローカルファイルの内容を作る
+func newIssueDocument(issue *backlog.Issue) *issueDocument {
+	doc := &issueDocument{
+		Key:     issue.IssueKey.Value,
+		Summary: issue.Summary.Value,
+		Updated: issue.Updated.Value,
+		Body:    issue.Description.Value,
+	}
+	if issue.IssueType.IsSet() {
+		doc.Type = issue.IssueType.Value.Name.Value
+	}
+	if issue.Status.IsSet() {
+		doc.Status = issue.Status.Value.Name.Value
+	}
+	if issue.Priority.IsSet() {
+		doc.Priority = issue.Priority.Value.Name.Value
+	}
+	if issue.Assignee.IsSet() && !issue.Assignee.IsNull() {
+		doc.Assignee = issue.Assignee.Value.UserId.Value
+		if doc.Assignee == "" {
+			doc.Assignee = issue.Assignee.Value.Name.Value
+		}
+	}
+	if issue.StartDate.IsSet() && !issue.StartDate.IsNull() {
+		doc.StartDate = triageDate(issue.StartDate.Value)
+	}
+	if issue.DueDate.IsSet() && !issue.DueDate.IsNull() {
+		doc.DueDate = triageDate(issue.DueDate.Value)
+	}
+	if issue.EstimatedHours.IsSet() && !issue.EstimatedHours.IsNull() {
+		v := issue.EstimatedHours.Value
+		doc.EstimatedHours = &v
+	}
+	if issue.ActualHours.IsSet() && !issue.ActualHours.IsNull() {
+		v := issue.ActualHours.Value
+		doc.ActualHours = &v
+	}
+	for _, c := range issue.Category {
+		doc.Categories = append(doc.Categories, c.Name.Value)
+	}
+	for _, m := range issue.Milestone {
+		doc.Milestones = append(doc.Milestones, m.Name.Value)
+	}
+	for _, v := range issue.Versions {
+		doc.Versions = append(doc.Versions, v.Name.Value)
+	}
+	return doc
+}
+
+// marshalIssueDocument はフロントマター付き Markdown に変換する
+func marshalIssueDocument(doc *issueDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(frontMatterDelimiter + "\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode front matter: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode front matter: %w", err)
+	}
+	buf.WriteString(frontMatterDelimiter + "\n")
+	buf.WriteString(doc.Body)
+	if doc.Body != "" && !strings.HasSuffix(doc.Body, "\n") {
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// parseIssueDocument はフロントマター付き Markdown を読み込む
+func parseIssueDocument(data []byte) (*issueDocument, error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if !strings.HasPrefix(text, frontMatterDelimiter+"\n") {
+		return nil, fmt.Errorf("front matter not found (file must start with %q)", frontMatterDelimiter)
+	}
+	rest := text[len(frontMatterDelimiter)+1:]
+	end := strings.Index(rest, "\n"+frontMatterDelimiter+"\n")
+	var header, body string
+	switch {
+	case end >= 0:
+		header, body = rest[:end], rest[end+len(frontMatterDelimiter)+2:]
+	case strings.HasSuffix(rest, "\n"+frontMatterDelimiter):
+		header = strings.TrimSuffix(rest, "\n"+frontMatterDelimiter)
+	default:
+		return nil, fmt.Errorf("front matter is not closed with %q", frontMatterDelimiter)
+	}
+
+	doc := &issueDocument{}
+	if err := yaml.Unmarshal([]byte(header), doc); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	if doc.Key == "" {
+		return nil, fmt.Errorf("front matter must contain key")
+	}
+	// marshal 時に補った末尾の改行を取り除く
+	doc.Body = strings.TrimSuffix(body, "\n")
+	return doc, nil
+}
+
+// issueDocumentChange はローカルファイルと課題の差分1件
+type issueDocumentChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// diffIssueDocument はリモート（remote）からローカル（local）への変更点を返す
+// 本文は末尾の空白の違いを無視する
+func diffIssueDocument(remote, local *issueDocument) []issueDocumentChange {
+	var changes []issueDocumentChange
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, issueDocumentChange{Field: field, From: from, To: to})
+		}
+	}
+	add("summary", remote.Summary, local.Summary)
+	add("type", remote.Type, local.Type)
+	add("status", remote.Status, local.Status)
+	add("priority", remote.Priority, local.Priority)
+	add("assignee", remote.Assignee, local.Assignee)
+	add("start_date", remote.StartDate, local.StartDate)
+	add("due_date", remote.DueDate, local.DueDate)
+	add("estimated_hours", formatOptionalHours(remote.EstimatedHours), formatOptionalHours(local.EstimatedHours))
+	add("actual_hours", formatOptionalHours(remote.ActualHours), formatOptionalHours(local.ActualHours))
+	add("categories", joinSorted(remote.Categories), joinSorted(local.Categories))
+	add("milestones", joinSorted(remote.Milestones), joinSorted(local.Milestones))
+	add("versions", joinSorted(remote.Versions), joinSorted(local.Versions))
+	if strings.TrimRight(remote.Body, " \n") != strings.TrimRight(local.Body, " \n") {
+		changes = append(changes, issueDocumentChange{Field: "description"})
+	}
+	return changes
+}
+
+func formatOptionalHours(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return formatHours(*v)
+}
+
+func joinSorted(values []string) string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,92 @@
+package issue
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestIssueDocumentRoundTrip(t *testing.T) {
+	issue := &backlog.Issue{
+		IssueKey:    backlog.NewOptString("PROJ-123"),
+		Summary:     backlog.NewOptString("Login fails: timeout"),
+		Description: backlog.NewOptString("## Steps\n\n1. open\n---\n2. wait"),
+		Updated:     backlog.NewOptString("2024-05-01T10:00:00Z"),
+		Status:      backlog.NewOptStatus(backlog.Status{Name: backlog.NewOptString("処理中")}),
+		DueDate:     backlog.NewOptNilString("2024-05-31T00:00:00Z"),
+		Category:    []backlog.Category{{Name: backlog.NewOptString("API")}},
+	}
+	doc := newIssueDocument(issue)
+	data, err := marshalIssueDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "---\nkey: PROJ-123\n") {
+		t.Errorf("unexpected header:\n%s", data)
+	}
+
+	parsed, err := parseIssueDocument(data)
+	if err != nil {
+		t.Fatalf("parseIssueDocument() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed, doc) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", parsed, doc)
+	}
+	if parsed.DueDate != "2024-05-31" {
+		t.Errorf("DueDate = %q, want 2024-05-31", parsed.DueDate)
+	}
+}
+
+func TestParseIssueDocumentErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "no front matter", in: "hello", want: "front matter not found"},
+		{name: "not closed", in: "---\nkey: A-1\nbody", want: "not closed"},
+		{name: "missing key", in: "---\nsummary: x\n---\nbody", want: "must contain key"},
+		{name: "invalid yaml", in: "---\nkey: [\n---\n", want: "invalid front matter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseIssueDocument([]byte(tt.in))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("parseIssueDocument() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	doc, err := parseIssueDocument([]byte("---\r\nkey: A-1\r\n---"))
+	if err != nil || doc.Key != "A-1" || doc.Body != "" {
+		t.Errorf("CRLF without body: doc = %+v, err = %v", doc, err)
+	}
+}
+
+func TestDiffIssueDocument(t *testing.T) {
+	hours := 3.5
+	remote := &issueDocument{
+		Key: "A-1", Summary: "s", Status: "未対応", Categories: []string{"b", "a"}, Body: "text\n",
+	}
+	local := &issueDocument{
+		Key: "A-1", Summary: "s", Status: "処理中", Categories: []string{"a", "b"}, Body: "text",
+		EstimatedHours: &hours, Milestones: []string{"v1"},
+	}
+	got := diffIssueDocument(remote, local)
+	want := []issueDocumentChange{
+		{Field: "status", From: "未対応", To: "処理中"},
+		{Field: "estimated_hours", From: "", To: "3.5h"},
+		{Field: "milestones", From: "", To: "v1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffIssueDocument() = %+v, want %+v", got, want)
+	}
+
+	local.Body = "changed"
+	got = diffIssueDocument(remote, local)
+	if last := got[len(got)-1]; last.Field != "description" {
+		t.Errorf("last change = %+v, want description", last)
+	}
+}
@@ -0,0 +1,34 @@
+package issue
+
+import (
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/draft"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// saveCommentDraft は投稿できなかったコメントを下書きとして保存する
+// 内容が空の場合とステートレスモードでは保存しない。保存に失敗した場合は cause を失わないよう警告にとどめる
+func saveCommentDraft(cfg *config.Store, issueKey, content string, cause error) {
+	if content == "" || config.IsEphemeral() {
+		return
+	}
+	dir, err := config.DraftsDir()
+	if err != nil {
+		ui.Warning("Failed to save the comment as a draft: %v", err)
+		return
+	}
+	saved, err := draft.Open(dir).Add(draft.Draft{
+		Space:    cfg.CurrentProfile().Space,
+		IssueKey: issueKey,
+		Content:  content,
+		SavedAt:  time.Now(),
+		Reason:   cause.Error(),
+	})
+	if err != nil {
+		ui.Warning("Failed to save the comment as a draft: %v", err)
+		return
+	}
+	ui.Warning("Saved the comment as draft #%d. Run 'backlog draft resume %d' to continue", saved.ID, saved.ID)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -45,8 +46,9 @@ Examples:
 
   # Safe body replacement with conflict detection
   backlog issue edit PROJ-123 --body "New body" --safe`,
-	Args: cobra.ExactArgs(1),
-	RunE: runEdit,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runEdit,
 }
 
 var (
@@ -123,6 +125,9 @@ func runEdit(c *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to read body: %w", err)
 		}
+		if err := cmdutil.CheckSecrets(cfg, "issue body", body); err != nil {
+			return err
+		}
 		if body != "" {
 			input.Description = &body
 			hasUpdate = true
@@ -242,7 +247,7 @@ func runEdit(c *cobra.Command, args []string) error {
 
 	// 添付ファイルのアップロード
 	if len(editAttachFiles) > 0 {
-		attachmentIDs, err := cmdutil.UploadFiles(ctx, client, editAttachFiles)
+		attachmentIDs, err := cmdutil.UploadFiles(ctx, client, cfg, editAttachFiles)
 		if err != nil {
 			return err
 		}
@@ -254,10 +259,21 @@ func runEdit(c *cobra.Command, args []string) error {
 		return fmt.Errorf("no updates specified")
 	}
 
+	space := cfg.CurrentProfile().Space
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "edit", issueKeyHookEvent(resolvedKey, space, nil)); err != nil {
+		return err
+	}
+
 	issue, err := client.UpdateIssue(ctx, issueKey, input)
 	if err != nil {
+		if len(input.AttachmentIDs) == 0 {
+			if queued, qerr := enqueueOffline(cfg, queue.Entry{Kind: queue.KindIssueEdit, IssueKey: resolvedKey, Update: input}, err); queued {
+				return qerr
+			}
+		}
 		return fmt.Errorf("failed to update issue: %w", err)
 	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "edit", issueHookEvent(issue, space, issue))
 
 	return printIssueEditResult(cfg, issue, false)
 }
@@ -296,16 +312,32 @@ func runEditWithPatch(c *cobra.Command, args []string) error {
 		fullReplace = body
 	}
 
+	for _, text := range []string{fullReplace, editPrepend, editAppend} {
+		if err := cmdutil.CheckSecrets(cfg, "issue body", text); err != nil {
+			return err
+		}
+	}
+	for _, op := range patchOps {
+		if err := cmdutil.CheckSecrets(cfg, "issue body", op.Replace); err != nil {
+			return err
+		}
+	}
+
 	patchFn, err := cmdutil.BuildPatchFn(patchOps, editPrepend, editAppend, fullReplace)
 	if err != nil {
 		return err
 	}
 
+	space := cfg.CurrentProfile().Space
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "edit", issueKeyHookEvent(resolvedKey, space, nil)); err != nil {
+		return err
+	}
+
 	issue, merged, err := client.SafeUpdateIssueDescription(ctx, resolvedKey, patchFn)
 	if err != nil {
 		var conflictErr *api.ConflictError
 		if errors.As(err, &conflictErr) {
-			fmt.Fprintf(os.Stderr, "%s %s\n", ui.Red("✗"), conflictErr.Error())
+			fmt.Fprintf(os.Stderr, "%s %s\n", ui.FailMark(), conflictErr.Error())
 			fmt.Fprintf(os.Stderr, "  Hint: resolve the conflict manually, or use --body without --safe to force overwrite.\n")
 			return err
 		}
@@ -360,7 +392,7 @@ func runEditWithPatch(c *cobra.Command, args []string) error {
 			input.CategoryIDs = categoryIDs
 		}
 		if len(editAttachFiles) > 0 {
-			attachmentIDs, err := cmdutil.UploadFiles(ctx, client, editAttachFiles)
+			attachmentIDs, err := cmdutil.UploadFiles(ctx, client, cfg, editAttachFiles)
 			if err != nil {
 				return err
 			}
@@ -372,6 +404,7 @@ func runEditWithPatch(c *cobra.Command, args []string) error {
 			return fmt.Errorf("description patched but failed to update other fields: %w", err)
 		}
 	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "edit", issueHookEvent(issue, space, issue))
 
 	return printIssueEditResult(cfg, issue, merged)
 }
@@ -385,12 +418,14 @@ func printIssueEditResult(cfg *config.Store, issue *backlog.Issue, merged bool)
 		return enc.Encode(issue)
 	default:
 		if merged {
-			ui.Success("Updated %s (auto-merged)", issue.IssueKey.Value)
+			cmdutil.Success(issue.IssueKey.Value, "Updated %s (auto-merged)", issue.IssueKey.Value)
 		} else {
-			ui.Success("Updated %s", issue.IssueKey.Value)
+			cmdutil.Success(issue.IssueKey.Value, "Updated %s", issue.IssueKey.Value)
 		}
 		url := fmt.Sprintf("https://%s/view/%s", profile.Space, issue.IssueKey.Value)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
@@ -0,0 +1,406 @@
+package issue
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	estimateMilestone string
+	estimateQuery     string
+	estimateAll       bool
+	estimateFromCSV   string
+	estimateDryRun    bool
+	estimateSummary   bool
+)
+
+// NewEstimateCmd は見積り入力コマンドを生成する
+// "issue estimate" とトップレベルの "estimate" の両方から使うため、呼び出しごとに新しいコマンドを返す
+func NewEstimateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "Enter estimated hours for issues one after another",
+		Long: `Show the target issues one at a time and enter the estimated hours for each,
+for example right after a planning poker session.
+
+The target issues are selected with --milestone and/or --query (same syntax as
+"issue list --query"). Without a state or status condition, closed issues are
+excluded. Issues that already have an estimate are skipped unless --all is given.
+
+At the prompt:
+  <hours>  set the estimate (e.g. 3, 1.5, 2h)
+  Enter    skip this issue
+  q        quit
+
+A summary with the total estimated hours is printed at the end.
+
+With --from-csv, estimates are read from a CSV file with "issueKey,hours" rows
+(a header row is optional; use "-" to read from standard input).
+
+Examples:
+  backlog estimate --milestone v2.0
+  backlog estimate --milestone v2.0 --query 'type:Task' --all
+  backlog estimate --milestone v2.0 --summary
+  backlog estimate --from-csv poker.csv --dry-run
+  backlog issue estimate --from-csv poker.csv --yes`,
+		Args: cobra.NoArgs,
+		RunE: runEstimate,
+	}
+	cmd.Flags().StringVarP(&estimateMilestone, "milestone", "m", "", "Milestone of the target issues (ID or name)")
+	cmd.Flags().StringVarP(&estimateQuery, "query", "q", "", "Search query selecting the target issues")
+	cmd.Flags().BoolVar(&estimateAll, "all", false, "Also prompt for issues that already have an estimate")
+	cmd.Flags().StringVar(&estimateFromCSV, "from-csv", "", "Read estimates from a CSV file of \"issueKey,hours\" rows")
+	cmd.Flags().BoolVar(&estimateDryRun, "dry-run", false, "Show the estimates from --from-csv without updating")
+	cmd.Flags().BoolVar(&estimateSummary, "summary", false, "Only print the summary of the target issues")
+	cmd.MarkFlagsMutuallyExclusive("from-csv", "milestone")
+	cmd.MarkFlagsMutuallyExclusive("from-csv", "query")
+	cmd.MarkFlagsMutuallyExclusive("from-csv", "summary")
+	return cmd
+}
+
+func runEstimate(c *cobra.Command, args []string) error {
+	if estimateFromCSV != "" {
+		return runEstimateFromCSV(c)
+	}
+	if estimateMilestone == "" && estimateQuery == "" {
+		return fmt.Errorf("--milestone, --query or --from-csv is required")
+	}
+	if !estimateSummary && !ui.IsInteractiveInput() {
+		return cmdutil.NonInteractiveFlagError(
+			"estimate requires an interactive terminal",
+			"backlog estimate",
+			"Use --from-csv <file> to set estimates, or --summary to print the totals.",
+		)
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	ctx := c.Context()
+
+	opts, err := issueQueryListOptions(ctx, client, projectKey, estimateQueryString(estimateMilestone, estimateQuery), time.Now())
+	if err != nil {
+		return err
+	}
+	stopProgress := ui.StartProgress("Fetching target issues...")
+	issues, err := paginateIssues(ctx, client, opts, 0)
+	stopProgress()
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+	if len(issues) == 0 {
+		fmt.Println("No issues match the conditions.")
+		return nil
+	}
+	if estimateSummary {
+		printEstimateSummary(issues)
+		return nil
+	}
+
+	var targets []int
+	for i := range issues {
+		if estimateAll || !hasEstimate(&issues[i]) {
+			targets = append(targets, i)
+		}
+	}
+	if len(targets) == 0 {
+		fmt.Println("All target issues already have an estimate (use --all to revise them).")
+		printEstimateSummary(issues)
+		return nil
+	}
+
+	profile := cfg.CurrentProfile()
+	updated := 0
+	for n, i := range targets {
+		issue := &issues[i]
+		printEstimateIssue(issue, n+1, len(targets), profile.Space)
+		hours, ok, err := promptEstimateHours(issue)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// q で中断
+			break
+		}
+		if hours == nil {
+			continue
+		}
+		result, err := client.UpdateIssue(ctx, issue.IssueKey.Value, &api.UpdateIssueInput{EstimatedHours: hours})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s %s: %v\n", ui.FailMark(), issue.IssueKey.Value, err)
+			continue
+		}
+		*issue = *result
+		updated++
+	}
+
+	fmt.Printf("\nUpdated the estimate of %d issue(s).\n", updated)
+	printEstimateSummary(issues)
+	return nil
+}
+
+// estimateQueryString は --milestone と --query を1つの検索クエリにまとめる
+func estimateQueryString(milestone, q string) string {
+	var parts []string
+	if milestone != "" {
+		parts = append(parts, `milestone:"`+milestone+`"`)
+	}
+	if q != "" {
+		parts = append(parts, q)
+	}
+	return strings.Join(parts, " ")
+}
+
+func hasEstimate(issue *backlog.Issue) bool {
+	return issue.EstimatedHours.IsSet() && !issue.EstimatedHours.IsNull()
+}
+
+func formatHours(h float64) string {
+	return strconv.FormatFloat(h, 'f', -1, 64) + "h"
+}
+
+func printEstimateIssue(issue *backlog.Issue, index, total int, space string) {
+	key := issue.IssueKey.Value
+	issueURL := fmt.Sprintf("https://%s/view/%s", space, key)
+
+	fmt.Println()
+	fmt.Printf("%s %s %s\n", ui.Gray(fmt.Sprintf("[%d/%d]", index, total)), ui.Bold(ui.Hyperlink(issueURL, key)), issue.Summary.Value)
+	current := ui.Gray("(none)")
+	if hasEstimate(issue) {
+		current = formatHours(issue.EstimatedHours.Value)
+	}
+	fmt.Printf("Type: %s  Assignee: %s  Estimate: %s\n", issue.IssueType.Value.Name.Value, estimateAssignee(issue), current)
+}
+
+// promptEstimateHours は見積り時間を入力させる
+// 空入力はスキップ（hours=nil）、q は中断（ok=false）
+func promptEstimateHours(issue *backlog.Issue) (*float64, bool, error) {
+	for {
+		value, err := ui.Input("Estimated hours (Enter to skip, q to quit):", "")
+		if err != nil {
+			return nil, false, err
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(value) {
+		case "":
+			return nil, true, nil
+		case "q":
+			return nil, false, nil
+		}
+		hours, err := parseEstimateHours(value)
+		if err != nil {
+			ui.Warning("%v", err)
+			continue
+		}
+		if hasEstimate(issue) && issue.EstimatedHours.Value == hours {
+			return nil, true, nil
+		}
+		return &hours, true, nil
+	}
+}
+
+// parseEstimateHours は見積り時間（"3", "1.5", "2h"）をパースする
+func parseEstimateHours(value string) (float64, error) {
+	s := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(value)), "h")
+	hours, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || hours < 0 || math.IsNaN(hours) || math.IsInf(hours, 0) {
+		return 0, fmt.Errorf("invalid hours %q (expected a non-negative number such as 3, 1.5 or 2h)", value)
+	}
+	return hours, nil
+}
+
+// estimateRow は CSV の1行分の見積り
+type estimateRow struct {
+	Line     int
+	IssueKey string
+	Hours    float64
+}
+
+// parseEstimateCSV は "issueKey,hours" 形式の CSV を読み込む
+// 先頭行の hours が数値でない場合は見出し行として読み飛ばす
+func parseEstimateCSV(r io.Reader) ([]estimateRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []estimateRow
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected \"issueKey,hours\"", line)
+		}
+		key := strings.TrimSpace(record[0])
+		hours, err := parseEstimateHours(record[1])
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if key == "" {
+			return nil, fmt.Errorf("line %d: issue key is empty", line)
+		}
+		rows = append(rows, estimateRow{Line: line, IssueKey: key, Hours: hours})
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no estimates found in CSV")
+	}
+	return rows, nil
+}
+
+func runEstimateFromCSV(c *cobra.Command) error {
+	content, err := cmdutil.ReadBodyFromFile(estimateFromCSV)
+	if err != nil {
+		return err
+	}
+	rows, err := parseEstimateCSV(strings.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	for i := range rows {
+		rows[i].IssueKey, _ = cmdutil.ResolveIssueKey(rows[i].IssueKey, projectKey)
+	}
+
+	total := 0.0
+	for _, row := range rows {
+		fmt.Printf("  %s\t%s\n", row.IssueKey, formatHours(row.Hours))
+		total += row.Hours
+	}
+	fmt.Printf("%d estimate(s), total %s\n", len(rows), formatHours(total))
+	if estimateDryRun {
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog estimate --from-csv",
+				"Use --yes to apply the estimates, or --dry-run to preview.",
+			)
+		}
+		ok, err := ui.Confirm(fmt.Sprintf("Update the estimate of %d issue(s)?", len(rows)), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	ctx := c.Context()
+	failed := 0
+	for _, row := range rows {
+		hours := row.Hours
+		if _, err := client.UpdateIssue(ctx, row.IssueKey, &api.UpdateIssueInput{EstimatedHours: &hours}); err != nil {
+			if cmdutil.Interrupted(ctx) {
+				return cmdutil.ErrInterrupted
+			}
+			failed++
+			fmt.Fprintf(os.Stderr, "%s %s: %v\n", ui.FailMark(), row.IssueKey, err)
+			continue
+		}
+		cmdutil.Progressf("%s %s %s", ui.OKMark(), row.IssueKey, formatHours(hours))
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to update %d of %d estimate(s)", failed, len(rows))
+	}
+	cmdutil.Success("", "Updated the estimate of %d issue(s)", len(rows))
+	return nil
+}
+
+func estimateAssignee(issue *backlog.Issue) string {
+	if issue.Assignee.IsSet() && !issue.Assignee.IsNull() && issue.Assignee.Value.Name.IsSet() {
+		return issue.Assignee.Value.Name.Value
+	}
+	return "(unassigned)"
+}
+
+// estimateTotals は見積りの集計
+type estimateTotals struct {
+	Issues    int
+	Estimated int
+	Hours     float64
+}
+
+// summarizeEstimates は見積りを全体と担当者ごとに集計する
+func summarizeEstimates(issues []backlog.Issue) (estimateTotals, map[string]*estimateTotals) {
+	var total estimateTotals
+	byAssignee := make(map[string]*estimateTotals)
+	for i := range issues {
+		issue := &issues[i]
+		name := estimateAssignee(issue)
+		t, ok := byAssignee[name]
+		if !ok {
+			t = &estimateTotals{}
+			byAssignee[name] = t
+		}
+		total.Issues++
+		t.Issues++
+		if hasEstimate(issue) {
+			total.Estimated++
+			total.Hours += issue.EstimatedHours.Value
+			t.Estimated++
+			t.Hours += issue.EstimatedHours.Value
+		}
+	}
+	return total, byAssignee
+}
+
+func printEstimateSummary(issues []backlog.Issue) {
+	total, byAssignee := summarizeEstimates(issues)
+
+	names := make([]string, 0, len(byAssignee))
+	for name := range byAssignee {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Println()
+	table := ui.NewTable("ASSIGNEE", "ISSUES", "ESTIMATED", "HOURS")
+	for _, name := range names {
+		t := byAssignee[name]
+		table.AddRow(name, strconv.Itoa(t.Issues), strconv.Itoa(t.Estimated), formatHours(t.Hours))
+	}
+	table.Render(os.Stdout)
+	fmt.Printf("\nTotal: %s for %d of %d issue(s)", formatHours(total.Hours), total.Estimated, total.Issues)
+	if missing := total.Issues - total.Estimated; missing > 0 {
+		fmt.Printf(", %s", ui.Yellow(fmt.Sprintf("%d without estimate", missing)))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,120 @@
+package issue
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestParseEstimateHours(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "3", want: 3},
+		{input: "1.5", want: 1.5},
+		{input: " 2h ", want: 2},
+		{input: "0.5H", want: 0.5},
+		{input: "0", want: 0},
+		{input: "-1", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "NaN", wantErr: true},
+		{input: "h", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseEstimateHours(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseEstimateHours(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseEstimateHours(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEstimateCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []estimateRow
+		wantErr string
+	}{
+		{
+			name:  "with header",
+			input: "issueKey,hours\nPROJ-1,3\nPROJ-2, 1.5h\n",
+			want: []estimateRow{
+				{Line: 2, IssueKey: "PROJ-1", Hours: 3},
+				{Line: 3, IssueKey: "PROJ-2", Hours: 1.5},
+			},
+		},
+		{
+			name:  "without header and blank line",
+			input: "12,2\n\n13,5,extra\n",
+			want: []estimateRow{
+				{Line: 1, IssueKey: "12", Hours: 2},
+				{Line: 2, IssueKey: "13", Hours: 5},
+			},
+		},
+		{name: "invalid hours", input: "PROJ-1,3\nPROJ-2,x\n", wantErr: "line 2"},
+		{name: "missing column", input: "PROJ-1\n", wantErr: "line 1"},
+		{name: "header only", input: "key,hours\n", wantErr: "no estimates"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEstimateCSV(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseEstimateCSV() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseEstimateCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseEstimateCSV() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEstimateQueryString(t *testing.T) {
+	tests := []struct {
+		milestone, query, want string
+	}{
+		{"v2.0", "", `milestone:"v2.0"`},
+		{"Sprint 3", "type:Task", `milestone:"Sprint 3" type:Task`},
+		{"", "status:Open", "status:Open"},
+	}
+	for _, tt := range tests {
+		if got := estimateQueryString(tt.milestone, tt.query); got != tt.want {
+			t.Errorf("estimateQueryString(%q, %q) = %q, want %q", tt.milestone, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSummarizeEstimates(t *testing.T) {
+	alice := backlog.NewOptNilUser(backlog.User{Name: backlog.NewOptString("Alice")})
+	issues := []backlog.Issue{
+		{Assignee: alice, EstimatedHours: backlog.NewOptNilFloat64(3)},
+		{Assignee: alice, EstimatedHours: backlog.NewOptNilFloat64(1.5)},
+		{Assignee: alice},
+		{EstimatedHours: backlog.NewOptNilFloat64(2)},
+	}
+
+	total, byAssignee := summarizeEstimates(issues)
+	if want := (estimateTotals{Issues: 4, Estimated: 3, Hours: 6.5}); total != want {
+		t.Errorf("total = %+v, want %+v", total, want)
+	}
+	if got, want := *byAssignee["Alice"], (estimateTotals{Issues: 3, Estimated: 2, Hours: 4.5}); got != want {
+		t.Errorf("Alice = %+v, want %+v", got, want)
+	}
+	if got, want := *byAssignee["(unassigned)"], (estimateTotals{Issues: 1, Estimated: 1, Hours: 2}); got != want {
+		t.Errorf("unassigned = %+v, want %+v", got, want)
+	}
+}
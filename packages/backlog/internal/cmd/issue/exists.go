@@ -0,0 +1,83 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+var existsCmd = &cobra.Command{
+	Use:   "exists <issue-key>...",
+	Short: "Check whether issues exist",
+	Long: `Check whether issues exist, for use in scripts.
+
+Exits with code 0 if all issues exist and 1 if any of them does not exist
+(or is not accessible). Missing keys are printed to stderr unless --quiet is
+given. Other errors (authentication, network) are reported as usual.
+
+Existing issues are answered from the cache when possible, so repeated calls
+for the same key do not hit the API until the cache expires.
+
+Examples:
+  backlog issue exists PROJ-123
+  if backlog issue exists PROJ-123 -q; then echo found; fi
+  backlog issue exists 123 --project PROJ`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runExists,
+}
+
+func runExists(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, len(args))
+	for i, arg := range args {
+		keys[i], _ = cmdutil.ResolveIssueKey(arg, cmdutil.GetCurrentProject(cfg))
+	}
+
+	missing, err := missingIssues(c.Context(), client.GetIssue, keys)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		for _, key := range keys {
+			cmdutil.Verbosef("%s exists", key)
+		}
+		return nil
+	}
+	if !cmdutil.IsQuiet() {
+		for _, key := range missing {
+			fmt.Fprintf(os.Stderr, "%s does not exist\n", key)
+		}
+	}
+	os.Exit(1)
+	return nil
+}
+
+// missingIssues は存在しない（またはアクセスできない）課題キーを返す
+// 存在確認は GetIssue で行い、取得済みの課題はキャッシュから返る
+func missingIssues(ctx context.Context, getIssue func(context.Context, string) (*backlog.Issue, error), keys []string) ([]string, error) {
+	var missing []string
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if _, err := getIssue(ctx, key); err != nil {
+			if api.IsNotFound(err) || api.IsPermissionDenied(err) {
+				missing = append(missing, key)
+				continue
+			}
+			return nil, fmt.Errorf("failed to check %s: %w", key, err)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,44 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestMissingIssues(t *testing.T) {
+	calls := map[string]int{}
+	getIssue := func(_ context.Context, key string) (*backlog.Issue, error) {
+		calls[key]++
+		switch key {
+		case "PROJ-1":
+			return &backlog.Issue{}, nil
+		case "PROJ-2":
+			return nil, &api.APIError{StatusCode: http.StatusNotFound}
+		case "SECRET-1":
+			return nil, &api.APIError{StatusCode: http.StatusForbidden}
+		default:
+			return nil, errors.New("network down")
+		}
+	}
+
+	missing, err := missingIssues(context.Background(), getIssue, []string{"PROJ-1", "PROJ-2", "SECRET-1", "PROJ-2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"PROJ-2", "SECRET-1"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missingIssues() = %v, want %v", missing, want)
+	}
+	if calls["PROJ-2"] != 1 {
+		t.Errorf("duplicate key was checked %d times, want 1", calls["PROJ-2"])
+	}
+
+	if _, err := missingIssues(context.Background(), getIssue, []string{"PROJ-9"}); err == nil {
+		t.Error("missingIssues() with a network error = nil, want error")
+	}
+}
@@ -0,0 +1,32 @@
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+// issueHookEvent は課題からフックに渡す値を作る
+// result はコマンド結果で、post フックに JSON として渡す（pre フックでは nil）
+func issueHookEvent(issue *backlog.Issue, space string, result any) cmdutil.HookEvent {
+	ev := issueKeyHookEvent(issue.IssueKey.Value, space, result)
+	ev.ID = issue.ID.Value
+	ev.Summary = issue.Summary.Value
+	return ev
+}
+
+// issueKeyHookEvent は課題キーだけが分かっている場合のフックに渡す値を作る
+func issueKeyHookEvent(key, space string, result any) cmdutil.HookEvent {
+	projectKey, _, _ := cmdutil.ParseIssueKey(key)
+	ev := cmdutil.HookEvent{
+		Key:        key,
+		ProjectKey: projectKey,
+		URL:        fmt.Sprintf("https://%s/view/%s", space, key),
+	}
+	if result != nil {
+		ev.JSON, _ = json.Marshal(result)
+	}
+	return ev
+}
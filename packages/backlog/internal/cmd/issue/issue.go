@@ -13,13 +13,23 @@ var IssueCmd = &cobra.Command{
 func init() {
 	IssueCmd.AddCommand(listCmd)
 	IssueCmd.AddCommand(viewCmd)
+	IssueCmd.AddCommand(copyCmd)
+	IssueCmd.AddCommand(existsCmd)
 	IssueCmd.AddCommand(createCmd)
 	IssueCmd.AddCommand(editCmd)
 	IssueCmd.AddCommand(closeCmd)
+	IssueCmd.AddCommand(archiveCmd)
 	IssueCmd.AddCommand(reopenCmd)
 	IssueCmd.AddCommand(commentCmd)
+	IssueCmd.AddCommand(commentAllCmd)
 	IssueCmd.AddCommand(deleteCmd)
+	IssueCmd.AddCommand(reparentCmd)
 	IssueCmd.AddCommand(statusCmd)
 	IssueCmd.AddCommand(attachmentCmd)
 	IssueCmd.AddCommand(sharedFileCmd)
+	IssueCmd.AddCommand(pullCmd)
+	IssueCmd.AddCommand(pushCmd)
+	IssueCmd.AddCommand(NewTriageCmd())
+	IssueCmd.AddCommand(NewEstimateCmd())
+	IssueCmd.AddCommand(NewNoteCmd())
 }
@@ -4,16 +4,19 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/summary"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
@@ -46,6 +49,10 @@ Examples:
   # Search issues
   backlog issue list --search "bug fix"
 
+  # Search with the query DSL (combinable with other flags)
+  backlog issue list --query 'status:Open assignee:@me due:<7d priority:High'
+  backlog issue list -q 'updated:>=-1w has:attachment "login error"'
+
   # Filter by issue type
   backlog issue list --type Bug
 
@@ -70,6 +77,22 @@ Examples:
   # Open issue list in browser
   backlog issue list --web
 
+  # Show long summaries on up to 2 lines instead of truncating them
+  backlog issue list --wrap summary=2
+
+  # Wrap every column instead of truncating
+  backlog issue list --no-truncate
+
+  # Tab-separated output with a fixed column schema for scripts
+  backlog issue list -o tsv --schema v1 | cut -f1,3
+
+  # Show only changes since the result saved at least 8 hours ago
+  backlog issue list --assignee @me --diff-since 8h
+
+  # Browse all issues in a pager (loads pages while scrolling;
+  # Enter/v: view, c: comment, e: edit, o: open in browser)
+  backlog issue list -L 0 --interactive
+
 Available JSON fields (--json):
   id, issueKey, keyId, projectId, issueType, summary, description,
   resolution, priority, status, assignee, category, versions, milestone,
@@ -99,6 +122,7 @@ var (
 	listMarkdownWarn        bool
 	listMarkdownCache       bool
 	listCount               bool
+	listInteractive         bool
 	listCategory            string
 	listMilestone           string
 	listIssueType           string
@@ -124,10 +148,16 @@ var (
 	listInvolved            string
 	listIncludeCommented    bool
 	listViewed              bool
+	listDiffSince           string
 	// gh-compatible aliases
 	listSince   string
 	listKeyword string
-	listQuery   string
+	// -o tsv の出力スキーマバージョン
+	listSchema string
+	listQuery  string
+	// テーブル表示の折り返し
+	listNoTruncate bool
+	listWrap       []string
 )
 
 func init() {
@@ -145,13 +175,14 @@ func init() {
 	listCmd.Flags().BoolVar(&listRaw, "raw", false, "Render raw content without markdown conversion")
 	listCmd.Flags().BoolVar(&listMarkdownWarn, "markdown-warn", false, "Show markdown conversion warnings")
 	listCmd.Flags().BoolVar(&listMarkdownCache, "markdown-cache", false, "Cache markdown conversion analysis data")
+	listCmd.Flags().String("conversion-profile", "", "Markdown conversion rule preset: safe, standard, aggressive (default: display.markdown_conversion_profile)")
 	listCmd.Flags().BoolVar(&listCount, "count", false, "Show only the count of issues")
 	listCmd.Flags().StringVarP(&listCategory, "category", "l", "", "Filter by category IDs or names (comma-separated, like gh --label)")
 	listCmd.Flags().StringVarP(&listMilestone, "milestone", "m", "", "Filter by milestone IDs or names (comma-separated)")
 	listCmd.Flags().StringVarP(&listIssueType, "type", "T", "", "Filter by issue type IDs or names (e.g., 1, Bug, タスク)")
 	listCmd.Flags().StringVar(&listSort, "sort", "updated", "Sort field: created, updated, issueType, category, priority, dueDate, etc.")
 	listCmd.Flags().StringVar(&listOrder, "order", "desc", "Sort order: asc or desc")
-	listCmd.Flags().StringVar(&listStatus, "status", "", "Filter by status IDs or names (comma-separated, e.g. 処理中,完了)")
+	listCmd.Flags().StringVar(&listStatus, "status", "", "Filter by status IDs or names (comma-separated, e.g. 処理中,完了 or \"In Progress\",closed)")
 	listCmd.Flags().StringVar(&listPriority, "priority", "", "Filter by priority IDs or names (comma-separated, e.g. 高)")
 	listCmd.Flags().StringVar(&listResolution, "resolution", "", "Filter by resolution IDs or names (comma-separated)")
 	listCmd.Flags().StringVar(&listVersion, "version", "", "Filter by affected version IDs or names (comma-separated)")
@@ -171,13 +202,17 @@ func init() {
 	listCmd.Flags().StringVar(&listInvolved, "involved", "", "Show issues the user is involved in (assignee ∪ author); accepts @me, user ID, userId, or display name")
 	listCmd.Flags().BoolVar(&listIncludeCommented, "include-commented", false, "With --involved, also scan comments to include comment-only involvement (slower, opt-in)")
 	listCmd.Flags().BoolVar(&listViewed, "viewed", false, "Show recently viewed issues (opt-in; ignores other filters)")
+	listCmd.Flags().BoolVar(&listInteractive, "interactive", false, "Browse issues in a scrollable pager and open, comment on or edit the selected issue")
+	listCmd.Flags().StringVar(&listDiffSince, "diff-since", "", "Show only New/Updated/Closed issues compared with the saved result from at least this long ago (e.g. 8h, 2d)")
 
 	// gh-compatible aliases
 	listCmd.Flags().StringVar(&listSince, "since", "", "Filter by created date since (YYYY-MM-DD) — alias for --created-since")
 	listCmd.Flags().StringVar(&listKeyword, "keyword", "", "")
-	listCmd.Flags().StringVarP(&listQuery, "query", "q", "", "")
+	listCmd.Flags().StringVarP(&listQuery, "query", "q", "", "Search query (e.g. 'status:Open assignee:@me due:<7d priority:High')")
 	_ = listCmd.Flags().MarkHidden("keyword")
-	_ = listCmd.Flags().MarkHidden("query")
+	listCmd.Flags().StringVar(&listSchema, "schema", "", "Column schema version for -o tsv (e.g. v1; default: latest)")
+	listCmd.Flags().BoolVar(&listNoTruncate, "no-truncate", false, "Wrap long values instead of truncating them")
+	listCmd.Flags().StringSliceVar(&listWrap, "wrap", nil, "Wrap the given columns onto multiple lines: FIELD[=LINES] (e.g. summary=2)")
 }
 
 // Backlog の標準ステータスID（全プロジェクト共通）
@@ -240,8 +275,10 @@ func runList(c *cobra.Command, args []string) error {
 	if err := mergeStringAlias(c, "keyword", &listKeyword, "search", &listSearch); err != nil {
 		return err
 	}
-	if err := mergeStringAlias(c, "query", &listQuery, "search", &listSearch); err != nil {
-		return err
+	if listQuery != "" {
+		if err := applyIssueListQuery(c, listQuery, time.Now()); err != nil {
+			return err
+		}
 	}
 
 	// 日付フラグのバリデーション（YYYY-MM-DD形式のみ受け付ける）
@@ -260,6 +297,19 @@ func runList(c *cobra.Command, args []string) error {
 		}
 	}
 
+	if listSchema != "" {
+		if profile.Output != "tsv" {
+			return fmt.Errorf("--schema requires -o tsv")
+		}
+		if _, err := issueTSVColumns(listSchema); err != nil {
+			return err
+		}
+	}
+
+	if _, err := parseWrapSpecs(listWrap); err != nil {
+		return err
+	}
+
 	// --involved / --viewed の併用ルール
 	if listViewed && listInvolved != "" {
 		return fmt.Errorf("--viewed cannot be combined with --involved")
@@ -271,6 +321,22 @@ func runList(c *cobra.Command, args []string) error {
 		return fmt.Errorf("--include-commented requires --involved")
 	}
 
+	// --diff-since は通常の一覧取得結果を保存・比較するため、別モードとは併用できない
+	if listDiffSince != "" {
+		if listViewed || listCount || listWeb {
+			return fmt.Errorf("--diff-since cannot be combined with --viewed/--count/--web")
+		}
+		if _, err := parseDiffSince(listDiffSince); err != nil {
+			return err
+		}
+	}
+
+	if listInteractive {
+		if err := validateInteractiveList(profile); err != nil {
+			return err
+		}
+	}
+
 	// --viewed は単独パス（プロジェクトや他フィルタを必要としない）
 	if listViewed {
 		issues, err := fetchViewedIssues(ctx, client)
@@ -309,7 +375,7 @@ func runList(c *cobra.Command, args []string) error {
 			// 横断/複数指定はスペース全体の検索画面を開く
 			url = fmt.Sprintf("https://%s/find", profile.Space)
 		}
-		return browser.OpenURL(url)
+		return osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
 	}
 
 	// プロジェクト固有フィルタが単一プロジェクトを要求することを保証する
@@ -508,7 +574,7 @@ func runList(c *cobra.Command, args []string) error {
 					var openStatusIDs []int
 					for _, s := range statuses {
 						// "完了" または "Closed" 以外を含める
-						if s.Name != "完了" && s.Name != "Closed" && s.Name != "Done" {
+						if !cmdutil.IsClosedStatusName(s.Name) {
 							openStatusIDs = append(openStatusIDs, s.ID)
 						}
 					}
@@ -527,7 +593,7 @@ func runList(c *cobra.Command, args []string) error {
 				statuses, err := client.GetStatuses(ctx, singleProjectKey)
 				if err == nil {
 					for _, s := range statuses {
-						if s.Name == "完了" || s.Name == "Closed" || s.Name == "Done" {
+						if cmdutil.IsClosedStatusName(s.Name) {
 							opts.StatusIDs = []int{s.ID}
 							break
 						}
@@ -553,6 +619,14 @@ func runList(c *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// ページャーは全件の取得を待たずにページ単位で読み込みながら表示する
+	if listInteractive {
+		return runInteractiveList(c, ctx, client, cfg, profile, opts, singleProjectKey)
+	}
+
+	// 差分表示用のクエリキー（ページング前の条件で作る）
+	queryKey := listSnapshotQueryKey(profile.Space, opts, listInvolved, fmt.Sprint(listIncludeCommented), fmt.Sprint(listLimit))
+
 	// 課題集合の決定
 	var issues []backlog.Issue
 	if listInvolved != "" {
@@ -578,6 +652,13 @@ func runList(c *cobra.Command, args []string) error {
 		}
 	} else {
 		issues, err = paginateIssues(ctx, client, opts, listLimit)
+		// Ctrl+C で中断された場合は取得済みの課題を表示してから終了する
+		if cmdutil.PartialResult(ctx, err, len(issues), "issues") {
+			if err := renderIssueList(c, ctx, client, cfg, profile, issues, singleProjectKey); err != nil {
+				return err
+			}
+			return cmdutil.ErrInterrupted
+		}
 		if err != nil {
 			return fmt.Errorf("failed to get issues: %w", err)
 		}
@@ -588,10 +669,15 @@ func runList(c *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if listDiffSince != "" {
+		return runListDiff(cfg, profile, issues, queryKey, strings.Join(os.Args[1:], " "))
+	}
+
 	return renderIssueList(c, ctx, client, cfg, profile, issues, singleProjectKey)
 }
 
 // paginateIssues は opts に従って課題を取得する（limit 件まで、0 は全件）。
+// エラーの場合も取得済みの課題を返す（中断時に部分結果を表示するため）。
 func paginateIssues(ctx context.Context, client *api.Client, opts *api.IssueListOptions, limit int) ([]backlog.Issue, error) {
 	const batchSize = 100
 	var allIssues []backlog.Issue
@@ -610,7 +696,7 @@ func paginateIssues(ctx context.Context, client *api.Client, opts *api.IssueList
 
 		batch, err := client.GetIssues(ctx, opts)
 		if err != nil {
-			return nil, err
+			return allIssues, err
 		}
 
 		allIssues = append(allIssues, batch...)
@@ -631,10 +717,18 @@ func paginateIssues(ctx context.Context, client *api.Client, opts *api.IssueList
 
 // renderIssueList は課題リストを profile の出力形式（json/table）で出力する。
 func renderIssueList(c *cobra.Command, ctx context.Context, client *api.Client, cfg *config.Store, profile *config.ResolvedProfile, issues []backlog.Issue, projectKey string) error {
+	// 表示した課題はシェル補完の候補にする
+	cmdutil.RememberIssues(cfg, issues)
 	display := cfg.Display()
 	switch profile.Output {
 	case "json":
 		return cmdutil.OutputJSONFromProfile(issues, profile.JSONFields, profile.JQ, profile.Template)
+	case "tsv":
+		columns, err := issueTSVColumns(listSchema)
+		if err != nil {
+			return err
+		}
+		return writeIssueTSV(os.Stdout, issues, columns, fmt.Sprintf("https://%s", profile.Space))
 	default:
 		if len(issues) == 0 {
 			fmt.Println("No issues found")
@@ -662,14 +756,17 @@ func outputTable(ctx context.Context, client *api.Client, issues []backlog.Issue
 	}
 
 	// フィールドリストをコピーして操作
-	fields := make([]string, len(display.IssueListFields))
-	copy(fields, display.IssueListFields)
+	listFields := display.CommandFields("issue_list", display.IssueListFields)
+	fields := make([]string, len(listFields))
+	copy(fields, listFields)
 
 	if listSummary {
 		fields = append(fields, "ai_summary")
 	}
 
-	fieldConfig := display.IssueFieldConfig
+	// 折り返す列は max_width で切り詰めない（--wrap は runList で検証済み）
+	wrapSpecs, _ := parseWrapSpecs(listWrap)
+	fieldConfig := untruncatedFieldConfig(display.IssueFieldConfig, wrapSpecs, listNoTruncate)
 
 	// ハイパーリンク設定
 	ui.SetHyperlinkEnabled(display.Hyperlink)
@@ -689,6 +786,15 @@ func outputTable(ctx context.Context, client *api.Client, issues []backlog.Issue
 	}
 
 	table := ui.NewTable(headers...)
+	// 端末幅に合わせて列幅を調整し、収まらない値は切り詰めるか折り返す
+	table.SetMaxWidth(ui.TerminalWidth())
+	for i, f := range fields {
+		if lines, ok := wrapSpecs[f]; ok {
+			table.SetWrap(i, lines)
+		} else if listNoTruncate {
+			table.SetWrap(i, 0)
+		}
+	}
 
 	// フィールドフォーマッターを作成
 	formatter := ui.NewFieldFormatter(display.Timezone, display.DateTimeFormat, fieldConfig)
@@ -702,10 +808,16 @@ func outputTable(ctx context.Context, client *api.Client, issues []backlog.Issue
 		summaryMap = fetchAISummaries(ctx, client, issues, cfg, summaryCommentCount, listSummaryWithComments, projectKey, baseURL, markdownOpts)
 	}
 
+	// コメント数は課題ごとに API 呼び出しが必要なため、列が選択されたときだけ取得する
+	var commentCounts map[string]int
+	if slices.Contains(fields, "comments") {
+		commentCounts = fetchCommentCounts(ctx, client, issues)
+	}
+
 	for _, issue := range issues {
 		row := make([]string, len(fields))
 		for i, f := range fields {
-			row[i] = getIssueFieldValue(ctx, client, issue, f, formatter, baseURL, summaryMap, projectKey, markdownOpts)
+			row[i] = getIssueFieldValue(ctx, client, issue, f, formatter, baseURL, summaryMap, commentCounts, projectKey, markdownOpts)
 		}
 		table.AddRow(row...)
 	}
@@ -713,6 +825,76 @@ func outputTable(ctx context.Context, client *api.Client, issues []backlog.Issue
 	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
 }
 
+// parseWrapSpecs は --wrap の値（FIELD[=LINES]）をフィールド名と最大行数（0 = 無制限）に変換する
+func parseWrapSpecs(values []string) (map[string]int, error) {
+	specs := make(map[string]int, len(values))
+	for _, v := range values {
+		field, linesStr, hasLines := strings.Cut(strings.TrimSpace(v), "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		if field == "" {
+			return nil, fmt.Errorf("invalid --wrap value %q: expected FIELD[=LINES]", v)
+		}
+		lines := 0
+		if hasLines {
+			n, err := strconv.Atoi(strings.TrimSpace(linesStr))
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid --wrap value %q: LINES must be a positive integer", v)
+			}
+			lines = n
+		}
+		specs[field] = lines
+	}
+	return specs, nil
+}
+
+// untruncatedFieldConfig は折り返して表示する列の max_width を無効にした設定を返す
+func untruncatedFieldConfig(fieldConfig map[string]config.ResolvedFieldConfig, wrapSpecs map[string]int, noTruncate bool) map[string]config.ResolvedFieldConfig {
+	if !noTruncate && len(wrapSpecs) == 0 {
+		return fieldConfig
+	}
+	result := make(map[string]config.ResolvedFieldConfig, len(fieldConfig))
+	for field, cfg := range fieldConfig {
+		if _, ok := wrapSpecs[field]; ok || noTruncate {
+			cfg.MaxWidth = 0
+		}
+		result[field] = cfg
+	}
+	return result
+}
+
+// commentCountConcurrency はコメント数を取得する際の同時リクエスト数
+const commentCountConcurrency = 5
+
+// fetchCommentCounts は課題ごとのコメント数を並列に取得する（取得に失敗した課題は含まない）
+func fetchCommentCounts(ctx context.Context, client *api.Client, issues []backlog.Issue) map[string]int {
+	counts := make(map[string]int, len(issues))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, commentCountConcurrency)
+
+	stopProgress := ui.StartProgress("Counting comments...")
+	defer stopProgress()
+	for _, issue := range issues {
+		key := issue.IssueKey.Value
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			n, err := client.GetCommentsCount(ctx, key)
+			if err != nil {
+				debug.Log("comment count failed", "issue_key", key, "error", err)
+				return
+			}
+			mu.Lock()
+			counts[key] = n
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return counts
+}
+
 // fetchAISummaries はAI要約を一括取得する
 func fetchAISummaries(ctx context.Context, client *api.Client, issues []backlog.Issue, cfg *config.Store, summaryCommentCount int, withComments bool, projectKey, baseURL string, markdownOpts cmdutil.MarkdownViewOptions) map[string]string {
 	aiCfg := cfg.AISummary()
@@ -804,7 +986,7 @@ func fetchAISummaries(ctx context.Context, client *api.Client, issues []backlog.
 	return result
 }
 
-func getIssueFieldValue(ctx context.Context, client *api.Client, issue backlog.Issue, field string, f *ui.FieldFormatter, baseURL string, summaryMap map[string]string, projectKey string, markdownOpts cmdutil.MarkdownViewOptions) string {
+func getIssueFieldValue(ctx context.Context, client *api.Client, issue backlog.Issue, field string, f *ui.FieldFormatter, baseURL string, summaryMap map[string]string, commentCounts map[string]int, projectKey string, markdownOpts cmdutil.MarkdownViewOptions) string {
 	switch field {
 	case "key":
 		key := issue.IssueKey.Value
@@ -894,6 +1076,15 @@ func getIssueFieldValue(ctx context.Context, client *api.Client, issue backlog.I
 		return "-"
 	case "url":
 		return fmt.Sprintf("%s/view/%s", baseURL, issue.IssueKey.Value)
+	case "attachments":
+		return strconv.Itoa(len(issue.Attachments))
+	case "comments":
+		if n, ok := commentCounts[issue.IssueKey.Value]; ok {
+			return strconv.Itoa(n)
+		}
+		return "-"
+	case "stars":
+		return strconv.Itoa(len(issue.Stars))
 	default:
 		return "-"
 	}
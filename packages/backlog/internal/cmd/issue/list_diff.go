@@ -0,0 +1,292 @@
+package issue
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+const (
+	// listSnapshotDir はキャッシュディレクトリ配下の保存先
+	listSnapshotDir = "issue-list"
+	// listSnapshotMaxEntries はクエリごとに保持するスナップショット数
+	listSnapshotMaxEntries = 20
+	// listSnapshotMaxAge はスナップショットを保持する期間
+	listSnapshotMaxAge = 14 * 24 * time.Hour
+)
+
+// listSnapshotIssue は差分判定に必要な課題の状態
+type listSnapshotIssue struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	Status  string `json:"status"`
+	Created string `json:"created,omitempty"`
+	Updated string `json:"updated"`
+}
+
+// listSnapshot はある時点の issue list の結果
+type listSnapshot struct {
+	TakenAt time.Time           `json:"takenAt"`
+	Issues  []listSnapshotIssue `json:"issues"`
+}
+
+// listSnapshotFile はクエリ単位で保存するスナップショット履歴（古い順）
+type listSnapshotFile struct {
+	Query     string         `json:"query"`
+	Snapshots []listSnapshot `json:"snapshots"`
+}
+
+// listDiffChange は差分の 1 件
+type listDiffChange struct {
+	Key          string `json:"key"`
+	Summary      string `json:"summary"`
+	Status       string `json:"status"`
+	BeforeStatus string `json:"beforeStatus,omitempty"`
+	Updated      string `json:"updated,omitempty"`
+}
+
+// listDiffResult は --diff-since の結果
+type listDiffResult struct {
+	// Baseline は比較元スナップショットの取得時刻（スナップショットがない場合は期間の起点）
+	Baseline time.Time `json:"baseline"`
+	// FromSnapshot は保存済みスナップショットと比較したかどうか
+	FromSnapshot bool             `json:"fromSnapshot"`
+	New          []listDiffChange `json:"new"`
+	Updated      []listDiffChange `json:"updated"`
+	Closed       []listDiffChange `json:"closed"`
+}
+
+// parseDiffSince は --diff-since の値を解析する
+func parseDiffSince(value string) (time.Duration, error) {
+	return cmdutil.ParseDurationFlag("--diff-since", value)
+}
+
+// listSnapshotQueryKey は課題の取得条件からスナップショットのキーを作る
+// ページング用のフィールドは実行ごとに変わるため除外する。
+func listSnapshotQueryKey(space string, opts *api.IssueListOptions, extra ...string) string {
+	q := *opts
+	q.Count = 0
+	q.Offset = 0
+	data, _ := json.Marshal(struct {
+		Space string
+		Opts  api.IssueListOptions
+		Extra []string
+	}{space, q, extra})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:12])
+}
+
+func toListSnapshot(issues []backlog.Issue, now time.Time) listSnapshot {
+	s := listSnapshot{TakenAt: now, Issues: make([]listSnapshotIssue, 0, len(issues))}
+	for _, issue := range issues {
+		s.Issues = append(s.Issues, listSnapshotIssue{
+			Key:     issue.IssueKey.Value,
+			Summary: issue.Summary.Value,
+			Status:  issue.Status.Value.Name.Value,
+			Created: issue.Created.Value,
+			Updated: issue.Updated.Value,
+		})
+	}
+	return s
+}
+
+// selectBaseline は now-since 以前に取得された最新のスナップショットを返す
+// 該当がなければ最も古いスナップショットを返す。履歴が空なら nil。
+func selectBaseline(snapshots []listSnapshot, now time.Time, since time.Duration) *listSnapshot {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	cutoff := now.Add(-since)
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if !snapshots[i].TakenAt.After(cutoff) {
+			return &snapshots[i]
+		}
+	}
+	return &snapshots[0]
+}
+
+// diffListSnapshots はスナップショット同士を比較する
+// 一覧から消えた課題（完了して --state open の対象外になった等）と
+// 完了ステータスへ遷移した課題は Closed として扱う。
+func diffListSnapshots(before, after listSnapshot) listDiffResult {
+	result := listDiffResult{Baseline: before.TakenAt, FromSnapshot: true}
+	prev := make(map[string]listSnapshotIssue, len(before.Issues))
+	for _, issue := range before.Issues {
+		prev[issue.Key] = issue
+	}
+	seen := make(map[string]bool, len(after.Issues))
+	for _, issue := range after.Issues {
+		seen[issue.Key] = true
+		old, ok := prev[issue.Key]
+		change := listDiffChange{Key: issue.Key, Summary: issue.Summary, Status: issue.Status, Updated: issue.Updated}
+		switch {
+		case !ok:
+			result.New = append(result.New, change)
+		case cmdutil.IsClosedStatusName(issue.Status) && !cmdutil.IsClosedStatusName(old.Status):
+			change.BeforeStatus = old.Status
+			result.Closed = append(result.Closed, change)
+		case issue.Updated != old.Updated:
+			if issue.Status != old.Status {
+				change.BeforeStatus = old.Status
+			}
+			result.Updated = append(result.Updated, change)
+		}
+	}
+	for _, old := range before.Issues {
+		if seen[old.Key] {
+			continue
+		}
+		result.Closed = append(result.Closed, listDiffChange{Key: old.Key, Summary: old.Summary, Status: old.Status, Updated: old.Updated})
+	}
+	sortChanges(result.Closed)
+	return result
+}
+
+// diffListByTime はスナップショットがない場合に作成日時・更新日時で近似する
+// 一覧から消えた課題は検出できないため Closed は完了ステータスの課題のみ。
+func diffListByTime(after listSnapshot, cutoff time.Time) listDiffResult {
+	result := listDiffResult{Baseline: cutoff}
+	for _, issue := range after.Issues {
+		change := listDiffChange{Key: issue.Key, Summary: issue.Summary, Status: issue.Status, Updated: issue.Updated}
+		updated, err := time.Parse(time.RFC3339, issue.Updated)
+		if err != nil || updated.Before(cutoff) {
+			continue
+		}
+		created, err := time.Parse(time.RFC3339, issue.Created)
+		switch {
+		case err == nil && !created.Before(cutoff):
+			result.New = append(result.New, change)
+		case cmdutil.IsClosedStatusName(issue.Status):
+			result.Closed = append(result.Closed, change)
+		default:
+			result.Updated = append(result.Updated, change)
+		}
+	}
+	return result
+}
+
+func sortChanges(changes []listDiffChange) {
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+}
+
+func listSnapshotPath(cacheDir, key string) string {
+	return filepath.Join(cacheDir, listSnapshotDir, key+".json")
+}
+
+func loadListSnapshots(path string) (*listSnapshotFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &listSnapshotFile{}, nil
+		}
+		return nil, err
+	}
+	var f listSnapshotFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		// 壊れたファイルは履歴なしとして扱い、次回保存で上書きする
+		return &listSnapshotFile{}, nil
+	}
+	return &f, nil
+}
+
+func saveListSnapshots(path string, f *listSnapshotFile, now time.Time) error {
+	kept := f.Snapshots[:0]
+	for _, s := range f.Snapshots {
+		if now.Sub(s.TakenAt) <= listSnapshotMaxAge {
+			kept = append(kept, s)
+		}
+	}
+	if len(kept) > listSnapshotMaxEntries {
+		kept = kept[len(kept)-listSnapshotMaxEntries:]
+	}
+	f.Snapshots = kept
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// runListDiff は前回以前の保存結果と比較して New / Updated / Closed を表示する
+func runListDiff(cfg *config.Store, profile *config.ResolvedProfile, issues []backlog.Issue, queryKey, query string) error {
+	since, err := parseDiffSince(listDiffSince)
+	if err != nil {
+		return err
+	}
+	cacheDir, err := cfg.GetCacheDir()
+	if err != nil {
+		return fmt.Errorf("failed to resolve cache dir: %w", err)
+	}
+
+	now := time.Now()
+	path := listSnapshotPath(cacheDir, queryKey)
+	history, err := loadListSnapshots(path)
+	if err != nil {
+		return fmt.Errorf("failed to read issue list snapshot: %w", err)
+	}
+
+	current := toListSnapshot(issues, now)
+	var result listDiffResult
+	if baseline := selectBaseline(history.Snapshots, now, since); baseline != nil {
+		result = diffListSnapshots(*baseline, current)
+	} else {
+		result = diffListByTime(current, now.Add(-since))
+	}
+
+	history.Query = query
+	history.Snapshots = append(history.Snapshots, current)
+	if err := saveListSnapshots(path, history, now); err != nil {
+		fmt.Fprintf(os.Stderr, "%s failed to save issue list snapshot: %v\n", ui.Yellow("!"), err)
+	}
+
+	if profile.Output == "json" {
+		return cmdutil.OutputJSONFromProfile(result, profile.JSONFields, profile.JQ, profile.Template)
+	}
+
+	display := cfg.Display()
+	formatter := ui.NewFieldFormatter(display.Timezone, display.DateTimeFormat, display.IssueFieldConfig)
+	baseURL := fmt.Sprintf("https://%s", profile.Space)
+	if result.FromSnapshot {
+		fmt.Printf("Changes since %s\n", formatter.FormatDateTime(result.Baseline.Format(time.RFC3339), "updated"))
+	} else {
+		fmt.Printf("No saved result older than %s; showing changes since %s based on created/updated time\n",
+			listDiffSince, formatter.FormatDateTime(result.Baseline.Format(time.RFC3339), "updated"))
+	}
+
+	printSection := func(title string, color func(string) string, changes []listDiffChange) {
+		fmt.Println()
+		fmt.Printf("%s (%d)\n", ui.Bold(color(title)), len(changes))
+		if len(changes) == 0 {
+			return
+		}
+		table := ui.NewTable("KEY", "STATUS", "UPDATED", "SUMMARY")
+		for _, ch := range changes {
+			status := ch.Status
+			if ch.BeforeStatus != "" && ch.BeforeStatus != ch.Status {
+				status = ch.BeforeStatus + " → " + ch.Status
+			}
+			key := ui.Hyperlink(fmt.Sprintf("%s/view/%s", baseURL, ch.Key), ch.Key)
+			table.AddRow(key, status, formatter.FormatDateTime(ch.Updated, "updated"), ch.Summary)
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	}
+	printSection("New", ui.Green, result.New)
+	printSection("Updated", ui.Yellow, result.Updated)
+	printSection("Closed", ui.Cyan, result.Closed)
+	return nil
+}
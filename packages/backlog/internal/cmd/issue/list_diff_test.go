@@ -0,0 +1,142 @@
+package issue
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestParseDiffSince(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "8h", want: 8 * time.Hour},
+		{in: "30m", want: 30 * time.Minute},
+		{in: "2d", want: 48 * time.Hour},
+		{in: "0h", wantErr: true},
+		{in: "-1h", wantErr: true},
+		{in: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseDiffSince(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDiffSince(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseDiffSince(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectBaseline(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	snapshots := []listSnapshot{
+		{TakenAt: now.Add(-30 * time.Hour)},
+		{TakenAt: now.Add(-14 * time.Hour)},
+		{TakenAt: now.Add(-1 * time.Hour)},
+	}
+
+	if got := selectBaseline(snapshots, now, 8*time.Hour); !got.TakenAt.Equal(snapshots[1].TakenAt) {
+		t.Errorf("8h baseline = %v, want %v", got.TakenAt, snapshots[1].TakenAt)
+	}
+	// 期間より古いスナップショットがなければ最古を使う
+	if got := selectBaseline(snapshots, now, 48*time.Hour); !got.TakenAt.Equal(snapshots[0].TakenAt) {
+		t.Errorf("48h baseline = %v, want %v", got.TakenAt, snapshots[0].TakenAt)
+	}
+	if got := selectBaseline(nil, now, time.Hour); got != nil {
+		t.Errorf("empty history baseline = %v, want nil", got)
+	}
+}
+
+func TestDiffListSnapshots(t *testing.T) {
+	before := listSnapshot{Issues: []listSnapshotIssue{
+		{Key: "P-1", Status: "未対応", Updated: "2026-10-15T10:00:00Z"},
+		{Key: "P-2", Status: "処理中", Updated: "2026-10-15T10:00:00Z"},
+		{Key: "P-3", Status: "処理中", Updated: "2026-10-15T10:00:00Z"},
+		{Key: "P-4", Status: "処理中", Updated: "2026-10-15T10:00:00Z"},
+	}}
+	after := listSnapshot{Issues: []listSnapshotIssue{
+		{Key: "P-1", Status: "未対応", Updated: "2026-10-15T10:00:00Z"},
+		{Key: "P-2", Status: "処理済み", Updated: "2026-10-16T08:00:00Z"},
+		{Key: "P-3", Status: "完了", Updated: "2026-10-16T08:00:00Z"},
+		{Key: "P-5", Status: "未対応", Updated: "2026-10-16T08:00:00Z"},
+	}}
+
+	got := diffListSnapshots(before, after)
+	assertKeys(t, "new", got.New, "P-5")
+	assertKeys(t, "updated", got.Updated, "P-2")
+	assertKeys(t, "closed", got.Closed, "P-3", "P-4")
+	if got.Updated[0].BeforeStatus != "処理中" {
+		t.Errorf("updated beforeStatus = %q", got.Updated[0].BeforeStatus)
+	}
+}
+
+func TestDiffListByTime(t *testing.T) {
+	cutoff := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	after := listSnapshot{Issues: []listSnapshotIssue{
+		{Key: "P-1", Status: "未対応", Created: "2026-10-01T00:00:00Z", Updated: "2026-10-01T00:00:00Z"},
+		{Key: "P-2", Status: "処理中", Created: "2026-10-01T00:00:00Z", Updated: "2026-10-16T08:00:00Z"},
+		{Key: "P-3", Status: "完了", Created: "2026-10-01T00:00:00Z", Updated: "2026-10-16T08:00:00Z"},
+		{Key: "P-4", Status: "未対応", Created: "2026-10-16T07:00:00Z", Updated: "2026-10-16T07:00:00Z"},
+	}}
+
+	got := diffListByTime(after, cutoff)
+	assertKeys(t, "new", got.New, "P-4")
+	assertKeys(t, "updated", got.Updated, "P-2")
+	assertKeys(t, "closed", got.Closed, "P-3")
+}
+
+func TestListSnapshotsRoundTrip(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "issue-list", "key.json")
+
+	f, err := loadListSnapshots(path)
+	if err != nil || len(f.Snapshots) != 0 {
+		t.Fatalf("loadListSnapshots(missing) = %v, %v", f, err)
+	}
+	f.Snapshots = []listSnapshot{
+		{TakenAt: now.Add(-30 * 24 * time.Hour)}, // 保持期間外
+		{TakenAt: now.Add(-time.Hour)},
+	}
+	if err := saveListSnapshots(path, f, now); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := loadListSnapshots(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Snapshots) != 1 || !loaded.Snapshots[0].TakenAt.Equal(now.Add(-time.Hour)) {
+		t.Errorf("snapshots after prune = %+v", loaded.Snapshots)
+	}
+}
+
+func TestListSnapshotQueryKeyIgnoresPaging(t *testing.T) {
+	a := &api.IssueListOptions{ProjectIDs: []int{1}, AssigneeIDs: []int{2}, Count: 100, Offset: 200}
+	b := &api.IssueListOptions{ProjectIDs: []int{1}, AssigneeIDs: []int{2}}
+	c := &api.IssueListOptions{ProjectIDs: []int{1}, AssigneeIDs: []int{3}}
+
+	if listSnapshotQueryKey("s", a) != listSnapshotQueryKey("s", b) {
+		t.Error("paging fields should not affect the key")
+	}
+	if listSnapshotQueryKey("s", a) == listSnapshotQueryKey("s", c) {
+		t.Error("different filters should produce different keys")
+	}
+}
+
+func assertKeys(t *testing.T, name string, changes []listDiffChange, want ...string) {
+	t.Helper()
+	if len(changes) != len(want) {
+		t.Fatalf("%s = %+v, want keys %v", name, changes, want)
+	}
+	for i, ch := range changes {
+		if ch.Key != want[i] {
+			t.Errorf("%s[%d] = %s, want %s", name, i, ch.Key, want[i])
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+	"golang.org/x/term"
+)
+
+// interactivePageSize はページャーが 1 回に読み込む課題数（API の上限）
+const interactivePageSize = 100
+
+// interactiveActions はページャーで選択した課題に対する操作
+var interactiveActions = []ui.PagerAction{
+	{Key: 'v', Label: "view"},
+	{Key: 'c', Label: "comment"},
+	{Key: 'e', Label: "edit"},
+	{Key: 'o', Label: "open"},
+}
+
+// validateInteractiveList は --interactive と併用できない指定を検出する
+func validateInteractiveList(profile *config.ResolvedProfile) error {
+	if listCount || listWeb || listViewed || listInvolved != "" || listDiffSince != "" || listSummary || listSummaryWithComments {
+		return fmt.Errorf("--interactive cannot be combined with --count/--web/--viewed/--involved/--diff-since/--summary")
+	}
+	if profile.Output == "json" || profile.Output == "tsv" {
+		return fmt.Errorf("--interactive cannot be combined with -o %s", profile.Output)
+	}
+	if ui.IsAccessible() {
+		return fmt.Errorf("--interactive is not available in accessible mode")
+	}
+	if !ui.IsInteractiveInput() || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return cmdutil.NonInteractiveFlagError(
+			"--interactive requires a terminal",
+			"backlog issue list",
+			"Run it in a terminal, or drop --interactive to print the list.",
+		)
+	}
+	return nil
+}
+
+// issuePageFetcher は offset 行目以降の課題を 1 ページずつ取得する。
+// 取得した課題は行と同じ順序で保持し、選択行の課題を引けるようにする
+type issuePageFetcher struct {
+	get   func(opts *api.IssueListOptions) ([]backlog.Issue, error)
+	opts  api.IssueListOptions
+	limit int
+	row   func(issue backlog.Issue) []string
+
+	mu     sync.Mutex
+	issues []backlog.Issue
+}
+
+// Fetch は ui.ListPager から呼ばれる読み込み処理（limit 件まで、0 は全件）
+func (f *issuePageFetcher) Fetch(offset int) (ui.PagerPage, error) {
+	count := interactivePageSize
+	if f.limit > 0 {
+		count = min(count, f.limit-offset)
+		if count <= 0 {
+			return ui.PagerPage{Done: true}, nil
+		}
+	}
+	opts := f.opts
+	opts.Offset = offset
+	opts.Count = count
+	batch, err := f.get(&opts)
+	if err != nil {
+		return ui.PagerPage{}, fmt.Errorf("failed to get issues: %w", err)
+	}
+
+	page := ui.PagerPage{Done: len(batch) < count || (f.limit > 0 && offset+len(batch) >= f.limit)}
+	for _, issue := range batch {
+		page.Rows = append(page.Rows, f.row(issue))
+	}
+	f.mu.Lock()
+	f.issues = append(f.issues, batch...)
+	f.mu.Unlock()
+	return page, nil
+}
+
+// issueAt は index 行目の課題キーを返す
+func (f *issuePageFetcher) issueAt(index int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if index < 0 || index >= len(f.issues) {
+		return ""
+	}
+	return f.issues[index].IssueKey.Value
+}
+
+// runInteractiveList は課題一覧をページャーで表示し、選択した課題を view/comment/edit する
+func runInteractiveList(c *cobra.Command, ctx context.Context, client *api.Client, cfg *config.Store, profile *config.ResolvedProfile, opts *api.IssueListOptions, projectKey string) error {
+	display := cfg.Display()
+	cacheDir, cacheErr := cfg.GetCacheDir()
+	markdownOpts := cmdutil.ResolveMarkdownViewOptions(c, display, cacheDir)
+	if markdownOpts.Cache && cacheErr != nil {
+		return fmt.Errorf("failed to resolve cache dir: %w", cacheErr)
+	}
+
+	// AI 要約・コメント数は課題ごとの取得が必要なため、ページャーでは表示しない
+	var fields []string
+	for _, f := range display.CommandFields("issue_list", display.IssueListFields) {
+		if f != "ai_summary" && f != "comments" {
+			fields = append(fields, f)
+		}
+	}
+	// 列幅はページャーが端末幅に合わせて調整する
+	fieldConfig := untruncatedFieldConfig(display.IssueFieldConfig, nil, true)
+	headers := make([]string, len(fields))
+	for i, f := range fields {
+		if fc, ok := fieldConfig[f]; ok && fc.Header != "" {
+			headers[i] = fc.Header
+		} else {
+			headers[i] = strings.ToUpper(f)
+		}
+	}
+	ui.SetHyperlinkEnabled(display.Hyperlink)
+	formatter := ui.NewFieldFormatter(display.Timezone, display.DateTimeFormat, fieldConfig)
+	baseURL := fmt.Sprintf("https://%s", profile.Space)
+
+	fetcher := &issuePageFetcher{
+		get: func(o *api.IssueListOptions) ([]backlog.Issue, error) {
+			return client.GetIssues(ctx, o)
+		},
+		opts:  *opts,
+		limit: listLimit,
+		row: func(issue backlog.Issue) []string {
+			row := make([]string, len(fields))
+			for i, f := range fields {
+				row[i] = getIssueFieldValue(ctx, client, issue, f, formatter, baseURL, nil, nil, projectKey, markdownOpts)
+			}
+			return row
+		},
+	}
+
+	title := "Issues"
+	if projectKey != "" {
+		title = fmt.Sprintf("Issues in %s", projectKey)
+	}
+	pager := &ui.ListPager{
+		Title:   title,
+		Headers: headers,
+		Fetch:   fetcher.Fetch,
+		Actions: interactiveActions,
+	}
+
+	for {
+		action, index, err := pager.Run()
+		if err != nil {
+			return err
+		}
+		if action == 0 {
+			return nil
+		}
+		key := fetcher.issueAt(index)
+		if key == "" {
+			continue
+		}
+		if action == 'o' {
+			// ブラウザで開く場合はページャーに留まる
+			if err := osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), fmt.Sprintf("%s/view/%s", baseURL, key)); err != nil {
+				ui.Warning("failed to open browser: %v", err)
+			}
+			continue
+		}
+
+		var sub string
+		switch action {
+		case 'v':
+			sub = "view"
+		case 'c':
+			sub = "comment"
+		case 'e':
+			sub = "edit"
+		}
+		if err := runIssueSubcommand(cfg.GetActiveProfile(), sub, key); err != nil {
+			ui.Warning("backlog issue %s %s: %v", sub, key, err)
+		}
+		fmt.Fprint(os.Stderr, ui.Gray("Press any key to return to the list..."))
+		_, err = ui.ReadKey()
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return nil
+		}
+	}
+}
+
+// runIssueSubcommand は課題のサブコマンドを別プロセスで実行する。
+// 各コマンドのフラグ状態を汚さないよう、自身の実行ファイルを同じプロファイルで起動する
+func runIssueSubcommand(profileName, sub, key string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	args := []string{"issue", sub, key}
+	if profileName != "" {
+		args = append(args, "--profile", profileName)
+	}
+	cmd := exec.Command(exe, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
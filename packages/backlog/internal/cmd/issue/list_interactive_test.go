@@ -0,0 +1,82 @@
+package issue
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func newTestPageFetcher(total, limit int, calls *[]api.IssueListOptions) *issuePageFetcher {
+	return &issuePageFetcher{
+		get: func(o *api.IssueListOptions) ([]backlog.Issue, error) {
+			*calls = append(*calls, *o)
+			var batch []backlog.Issue
+			for i := o.Offset; i < total && i < o.Offset+o.Count; i++ {
+				batch = append(batch, backlog.Issue{IssueKey: backlog.NewOptString(fmt.Sprintf("PROJ-%d", i+1))})
+			}
+			return batch, nil
+		},
+		opts:  api.IssueListOptions{ProjectIDs: []int{1}},
+		limit: limit,
+		row: func(issue backlog.Issue) []string {
+			return []string{issue.IssueKey.Value}
+		},
+	}
+}
+
+func TestIssuePageFetcherUnlimited(t *testing.T) {
+	var calls []api.IssueListOptions
+	f := newTestPageFetcher(150, 0, &calls)
+
+	page, err := f.Fetch(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Rows) != 100 || page.Done {
+		t.Fatalf("first page: rows=%d done=%v", len(page.Rows), page.Done)
+	}
+	page, err = f.Fetch(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Rows) != 50 || !page.Done {
+		t.Fatalf("second page: rows=%d done=%v", len(page.Rows), page.Done)
+	}
+	if calls[1].Offset != 100 || calls[1].Count != 100 || len(calls[1].ProjectIDs) != 1 {
+		t.Errorf("unexpected options: %+v", calls[1])
+	}
+	if got := f.issueAt(120); got != "PROJ-121" {
+		t.Errorf("issueAt(120) = %q", got)
+	}
+	if got := f.issueAt(150); got != "" {
+		t.Errorf("issueAt(150) = %q, want empty", got)
+	}
+}
+
+func TestIssuePageFetcherLimit(t *testing.T) {
+	var calls []api.IssueListOptions
+	f := newTestPageFetcher(500, 130, &calls)
+
+	if _, err := f.Fetch(0); err != nil {
+		t.Fatal(err)
+	}
+	page, err := f.Fetch(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Rows) != 30 || !page.Done {
+		t.Fatalf("rows=%d done=%v", len(page.Rows), page.Done)
+	}
+	if calls[1].Count != 30 {
+		t.Errorf("count = %d, want 30", calls[1].Count)
+	}
+	page, err = f.Fetch(130)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !page.Done || len(calls) != 2 {
+		t.Errorf("fetch beyond limit should not call the API: done=%v calls=%d", page.Done, len(calls))
+	}
+}
@@ -0,0 +1,246 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/query"
+)
+
+// issueQueryValueKeys は --query のキーと、同じ条件を指定する既存フラグの対応
+var issueQueryValueKeys = []struct {
+	key  string
+	flag string
+	dest *string
+}{
+	{"status", "status", &listStatus},
+	{"priority", "priority", &listPriority},
+	{"assignee", "assignee", &listAssignee},
+	{"author", "author", &listAuthor},
+	{"type", "type", &listIssueType},
+	{"category", "category", &listCategory},
+	{"milestone", "milestone", &listMilestone},
+	{"resolution", "resolution", &listResolution},
+	{"version", "version", &listVersion},
+	{"parent", "parent", &listParent},
+	{"id", "id", &listID},
+	{"state", "state", &listState},
+}
+
+// issueQueryDateKeys は日付条件のキーと since/until フラグの対応
+var issueQueryDateKeys = []struct {
+	key       string
+	sinceFlag string
+	since     *string
+	untilFlag string
+	until     *string
+}{
+	{"due", "due-since", &listDueSince, "due-until", &listDueUntil},
+	{"created", "created-since", &listCreatedSince, "created-until", &listCreatedUntil},
+	{"updated", "updated-since", &listUpdatedSince, "updated-until", &listUpdatedUntil},
+	{"start", "start-since", &listStartSince, "start-until", &listStartUntil},
+}
+
+// issueQueryKeys は issue list --query で使えるキーを返す
+func issueQueryKeys() []string {
+	keys := []string{"has"}
+	for _, k := range issueQueryValueKeys {
+		keys = append(keys, k.key)
+	}
+	for _, k := range issueQueryDateKeys {
+		keys = append(keys, k.key)
+	}
+	return keys
+}
+
+// applyIssueListQuery は --query の条件を issue list の各フラグに反映する
+func applyIssueListQuery(c *cobra.Command, input string, now time.Time) error {
+	q, err := query.Parse(input)
+	if err != nil {
+		return err
+	}
+	if err := q.Validate(issueQueryKeys()...); err != nil {
+		return fmt.Errorf("invalid --query: %w", err)
+	}
+
+	for _, k := range issueQueryValueKeys {
+		if err := cmdutil.ApplyQueryValues(c, q, k.key, k.flag, k.dest); err != nil {
+			return err
+		}
+	}
+	for _, k := range issueQueryDateKeys {
+		if err := cmdutil.ApplyQueryDateRange(c, q, k.key, now, k.sinceFlag, k.since, k.untilFlag, k.until); err != nil {
+			return err
+		}
+	}
+
+	has, err := q.Values("has")
+	if err != nil {
+		return fmt.Errorf("invalid --query: %w", err)
+	}
+	for _, v := range has {
+		switch v {
+		case "attachment":
+			listHasAttachment = true
+		case "shared-file", "sharedfile":
+			listHasSharedFile = true
+		default:
+			return fmt.Errorf("invalid --query: has:%s (allowed: attachment, shared-file)", v)
+		}
+	}
+
+	return cmdutil.ApplyQueryKeyword(c, q, "search", &listSearch)
+}
+
+// issueQueryListOptions は --query を単一プロジェクトの課題一覧取得オプションに変換する
+// issue list 以外の一括操作コマンドで使う。state も status も指定がなければ未完了の課題に絞る
+func issueQueryListOptions(ctx context.Context, client *api.Client, projectKey, input string, now time.Time) (*api.IssueListOptions, error) {
+	q, err := query.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.Validate(issueQueryKeys()...); err != nil {
+		return nil, fmt.Errorf("invalid --query: %w", err)
+	}
+
+	project, err := client.GetProject(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %q: %w", projectKey, err)
+	}
+	opts := &api.IssueListOptions{
+		ProjectIDs: []int{project.ID},
+		Keyword:    q.Keyword(),
+		Sort:       "created",
+		Order:      "asc",
+	}
+
+	values := make(map[string]string)
+	for _, k := range issueQueryValueKeys {
+		v, err := q.Values(k.key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --query: %w", err)
+		}
+		if len(v) > 0 {
+			values[k.key] = strings.Join(v, ",")
+		}
+	}
+
+	type resolver func(context.Context, *api.Client, string, string) ([]int, error)
+	projectResolvers := []struct {
+		key     string
+		resolve resolver
+		dest    *[]int
+	}{
+		{"status", cmdutil.ResolveStatusIDs, &opts.StatusIDs},
+		{"type", cmdutil.ResolveIssueTypeIDs, &opts.IssueTypeIDs},
+		{"category", cmdutil.ResolveCategoryIDs, &opts.CategoryIDs},
+		{"milestone", cmdutil.ResolveMilestoneIDs, &opts.MilestoneIDs},
+		{"version", cmdutil.ResolveVersionIDs, &opts.VersionIDs},
+	}
+	for _, r := range projectResolvers {
+		if values[r.key] == "" {
+			continue
+		}
+		ids, err := r.resolve(ctx, client, projectKey, values[r.key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s: %w", r.key, err)
+		}
+		*r.dest = ids
+	}
+	if v := values["priority"]; v != "" {
+		if opts.PriorityIDs, err = cmdutil.ResolvePriorityIDs(ctx, client, v); err != nil {
+			return nil, fmt.Errorf("failed to resolve priority: %w", err)
+		}
+	}
+	if v := values["resolution"]; v != "" {
+		if opts.ResolutionIDs, err = cmdutil.ResolveResolutionIDs(ctx, client, v); err != nil {
+			return nil, fmt.Errorf("failed to resolve resolution: %w", err)
+		}
+	}
+	if v := values["parent"]; v != "" {
+		if opts.ParentIssueIDs, err = cmdutil.ResolveIssueIDs(ctx, client, v); err != nil {
+			return nil, fmt.Errorf("failed to resolve parent: %w", err)
+		}
+	}
+	if v := values["id"]; v != "" {
+		if opts.IDs, err = cmdutil.ResolveIssueIDs(ctx, client, v); err != nil {
+			return nil, fmt.Errorf("failed to resolve id: %w", err)
+		}
+	}
+	if v := values["assignee"]; v != "" {
+		id, err := cmdutil.ResolveProjectAssigneeID(ctx, client, projectKey, v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve assignee: %w", err)
+		}
+		opts.AssigneeIDs = []int{id}
+	}
+	if v := values["author"]; v != "" {
+		id, err := cmdutil.ResolveProjectAuthorID(ctx, client, projectKey, v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve author: %w", err)
+		}
+		opts.CreatedUserIDs = []int{id}
+	}
+
+	for _, k := range []struct {
+		key          string
+		since, until *string
+	}{
+		{"due", &opts.DueDateSince, &opts.DueDateUntil},
+		{"created", &opts.CreatedSince, &opts.CreatedUntil},
+		{"updated", &opts.UpdatedSince, &opts.UpdatedUntil},
+		{"start", &opts.StartDateSince, &opts.StartDateUntil},
+	} {
+		if *k.since, *k.until, err = q.DateRange(k.key, now); err != nil {
+			return nil, fmt.Errorf("invalid --query: %w", err)
+		}
+	}
+
+	has, err := q.Values("has")
+	if err != nil {
+		return nil, fmt.Errorf("invalid --query: %w", err)
+	}
+	for _, v := range has {
+		enabled := true
+		switch v {
+		case "attachment":
+			opts.Attachment = &enabled
+		case "shared-file", "sharedfile":
+			opts.SharedFile = &enabled
+		default:
+			return nil, fmt.Errorf("invalid --query: has:%s (allowed: attachment, shared-file)", v)
+		}
+	}
+
+	// status 指定時は state より優先する（issue list と同じ）
+	if values["status"] == "" {
+		state := values["state"]
+		if state == "" {
+			state = "open"
+		}
+		if state != "all" {
+			if state != "open" && state != "closed" {
+				return nil, fmt.Errorf("invalid --query: state:%s (must be open, closed, or all)", state)
+			}
+			statuses, err := client.GetStatuses(ctx, projectKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get statuses: %w", err)
+			}
+			closedStatusID, err := cmdutil.FindClosedStatusID(statuses)
+			if err != nil {
+				return nil, err
+			}
+			for _, st := range statuses {
+				if (st.ID == closedStatusID) == (state == "closed") {
+					opts.StatusIDs = append(opts.StatusIDs, st.ID)
+				}
+			}
+		}
+	}
+	return opts, nil
+}
@@ -1,8 +1,14 @@
 package issue
 
 import (
+	"context"
 	"reflect"
 	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 func TestParseProjectScope(t *testing.T) {
@@ -81,3 +87,79 @@ func TestParseProjectScope(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIssueFieldValueCounts(t *testing.T) {
+	issue := backlog.Issue{
+		IssueKey:    backlog.NewOptString("PROJ-1"),
+		Attachments: make([]backlog.Attachment, 2),
+		Stars:       make([]backlog.Star, 3),
+	}
+	f := ui.NewFieldFormatter("", "", nil)
+	counts := map[string]int{"PROJ-1": 5}
+
+	tests := []struct {
+		field  string
+		counts map[string]int
+		want   string
+	}{
+		{field: "attachments", want: "2"},
+		{field: "stars", want: "3"},
+		{field: "comments", counts: counts, want: "5"},
+		{field: "comments", want: "-"},
+	}
+	for _, tt := range tests {
+		got := getIssueFieldValue(context.Background(), nil, issue, tt.field, f, "https://example.backlog.jp", nil, tt.counts, "PROJ", cmdutil.MarkdownViewOptions{})
+		if got != tt.want {
+			t.Errorf("getIssueFieldValue(%q) = %q, want %q", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestParseWrapSpecs(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    map[string]int
+		wantErr bool
+	}{
+		{name: "empty", values: nil, want: map[string]int{}},
+		{name: "with lines", values: []string{"summary=2"}, want: map[string]int{"summary": 2}},
+		{name: "unlimited", values: []string{"Summary", "milestone=3"}, want: map[string]int{"summary": 0, "milestone": 3}},
+		{name: "zero lines", values: []string{"summary=0"}, wantErr: true},
+		{name: "not a number", values: []string{"summary=x"}, wantErr: true},
+		{name: "missing field", values: []string{"=2"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWrapSpecs(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWrapSpecs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseWrapSpecs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUntruncatedFieldConfig(t *testing.T) {
+	base := map[string]config.ResolvedFieldConfig{
+		"summary":   {Header: "SUMMARY", MaxWidth: 50},
+		"milestone": {Header: "MILESTONE", MaxWidth: 30},
+	}
+
+	got := untruncatedFieldConfig(base, map[string]int{"summary": 2}, false)
+	if got["summary"].MaxWidth != 0 || got["milestone"].MaxWidth != 30 {
+		t.Errorf("wrap summary: got %+v", got)
+	}
+	if got["summary"].Header != "SUMMARY" {
+		t.Errorf("header changed: %q", got["summary"].Header)
+	}
+	got = untruncatedFieldConfig(base, nil, true)
+	if got["summary"].MaxWidth != 0 || got["milestone"].MaxWidth != 0 {
+		t.Errorf("no-truncate: got %+v", got)
+	}
+	if base["summary"].MaxWidth != 50 {
+		t.Error("base config was modified")
+	}
+}
@@ -0,0 +1,135 @@
+package issue
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+// tsvColumn は TSV 出力の1列
+type tsvColumn struct {
+	Name  string
+	Value func(issue backlog.Issue, baseURL string) string
+}
+
+// issueTSVSchemas は issue list -o tsv の出力スキーマ（バージョンごとの列と順序）
+//
+// スクリプトから安定して読めるよう、公開したバージョンの列は変更・削除・並べ替えをしない。
+// 列を追加するときは新しいバージョンを末尾に追加し、既存のバージョンはそのまま残す。
+var issueTSVSchemas = []struct {
+	Version string
+	Columns []tsvColumn
+}{
+	{
+		Version: "v1",
+		Columns: []tsvColumn{
+			{"key", func(i backlog.Issue, _ string) string { return i.IssueKey.Value }},
+			{"type", func(i backlog.Issue, _ string) string { return i.IssueType.Value.Name.Value }},
+			{"status", func(i backlog.Issue, _ string) string { return i.Status.Value.Name.Value }},
+			{"priority", func(i backlog.Issue, _ string) string { return i.Priority.Value.Name.Value }},
+			{"assignee", func(i backlog.Issue, _ string) string {
+				if i.Assignee.IsNull() {
+					return ""
+				}
+				return i.Assignee.Value.Name.Value
+			}},
+			{"assignee_id", func(i backlog.Issue, _ string) string {
+				if i.Assignee.IsNull() {
+					return ""
+				}
+				return i.Assignee.Value.UserId.Value
+			}},
+			{"start_date", func(i backlog.Issue, _ string) string { return triageDate(i.StartDate.Value) }},
+			{"due_date", func(i backlog.Issue, _ string) string { return triageDate(i.DueDate.Value) }},
+			{"estimated_hours", func(i backlog.Issue, _ string) string {
+				return tsvHours(i.EstimatedHours.IsSet() && !i.EstimatedHours.IsNull(), i.EstimatedHours.Value)
+			}},
+			{"actual_hours", func(i backlog.Issue, _ string) string {
+				return tsvHours(i.ActualHours.IsSet() && !i.ActualHours.IsNull(), i.ActualHours.Value)
+			}},
+			{"category", func(i backlog.Issue, _ string) string {
+				names := make([]string, 0, len(i.Category))
+				for _, c := range i.Category {
+					names = append(names, c.Name.Value)
+				}
+				return strings.Join(names, ",")
+			}},
+			{"milestone", func(i backlog.Issue, _ string) string {
+				names := make([]string, 0, len(i.Milestone))
+				for _, m := range i.Milestone {
+					names = append(names, m.Name.Value)
+				}
+				return strings.Join(names, ",")
+			}},
+			{"version", func(i backlog.Issue, _ string) string {
+				names := make([]string, 0, len(i.Versions))
+				for _, v := range i.Versions {
+					names = append(names, v.Name.Value)
+				}
+				return strings.Join(names, ",")
+			}},
+			{"created", func(i backlog.Issue, _ string) string { return i.Created.Value }},
+			{"updated", func(i backlog.Issue, _ string) string { return i.Updated.Value }},
+			{"summary", func(i backlog.Issue, _ string) string { return i.Summary.Value }},
+			{"url", func(i backlog.Issue, baseURL string) string {
+				return fmt.Sprintf("%s/view/%s", baseURL, i.IssueKey.Value)
+			}},
+		},
+	},
+}
+
+// latestIssueTSVSchema は --schema 省略時に使う最新のスキーマバージョンを返す
+func latestIssueTSVSchema() string {
+	return issueTSVSchemas[len(issueTSVSchemas)-1].Version
+}
+
+// issueTSVColumns はスキーマバージョンの列定義を返す
+func issueTSVColumns(version string) ([]tsvColumn, error) {
+	if version == "" {
+		version = latestIssueTSVSchema()
+	}
+	versions := make([]string, 0, len(issueTSVSchemas))
+	for _, s := range issueTSVSchemas {
+		if s.Version == version {
+			return s.Columns, nil
+		}
+		versions = append(versions, s.Version)
+	}
+	return nil, fmt.Errorf("unknown TSV schema %q (available: %s)", version, strings.Join(versions, ", "))
+}
+
+// writeIssueTSV は課題リストを TSV で出力する（1行目は列名のヘッダー）
+// 色やハイパーリンク、表示設定（display.*）は適用しない
+func writeIssueTSV(w io.Writer, issues []backlog.Issue, columns []tsvColumn, baseURL string) error {
+	bw := bufio.NewWriter(w)
+	row := make([]string, len(columns))
+	for i, col := range columns {
+		row[i] = col.Name
+	}
+	_, _ = bw.WriteString(strings.Join(row, "\t") + "\n")
+	for _, issue := range issues {
+		for i, col := range columns {
+			row[i] = escapeTSV(col.Value(issue, baseURL))
+		}
+		_, _ = bw.WriteString(strings.Join(row, "\t") + "\n")
+	}
+	return bw.Flush()
+}
+
+var tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// escapeTSV は値の中のタブ・改行・バックスラッシュをエスケープする
+func escapeTSV(value string) string {
+	return tsvEscaper.Replace(value)
+}
+
+func tsvHours(set bool, value float64) string {
+	if !set {
+		return ""
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,77 @@
+package issue
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+// v1 の列は公開済みのため変更してはならない（列を増やす場合は v2 を追加する）
+func TestIssueTSVSchemaV1IsStable(t *testing.T) {
+	columns, err := issueTSVColumns("v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	want := "key,type,status,priority,assignee,assignee_id,start_date,due_date,estimated_hours,actual_hours,category,milestone,version,created,updated,summary,url"
+	if got := strings.Join(names, ","); got != want {
+		t.Errorf("v1 columns = %s\nwant %s", got, want)
+	}
+}
+
+func TestIssueTSVColumns(t *testing.T) {
+	tests := []struct {
+		version string
+		wantErr bool
+	}{
+		{version: ""},
+		{version: "v1"},
+		{version: "v0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			_, err := issueTSVColumns(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("issueTSVColumns(%q) error = %v, wantErr %v", tt.version, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteIssueTSV(t *testing.T) {
+	issue := backlog.Issue{
+		IssueKey:       backlog.NewOptString("PROJ-1"),
+		Summary:        backlog.NewOptString("Fix\tlogin\nbug"),
+		Status:         backlog.NewOptStatus(backlog.Status{Name: backlog.NewOptString("Open")}),
+		Assignee:       backlog.NewOptNilUser(backlog.User{Name: backlog.NewOptString("Alice"), UserId: backlog.NewOptString("alice")}),
+		DueDate:        backlog.NewOptNilString("2026-10-20T00:00:00Z"),
+		EstimatedHours: backlog.NewOptNilFloat64(1.5),
+		Category: []backlog.Category{
+			{Name: backlog.NewOptString("API")},
+			{Name: backlog.NewOptString(`C:\web`)},
+		},
+	}
+	columns := []tsvColumn{}
+	all, _ := issueTSVColumns("v1")
+	for _, c := range all {
+		switch c.Name {
+		case "key", "status", "assignee", "assignee_id", "due_date", "estimated_hours", "actual_hours", "category", "summary", "url":
+			columns = append(columns, c)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := writeIssueTSV(&buf, []backlog.Issue{issue}, columns, "https://example.backlog.jp"); err != nil {
+		t.Fatal(err)
+	}
+	want := "key\tstatus\tassignee\tassignee_id\tdue_date\testimated_hours\tactual_hours\tcategory\tsummary\turl\n" +
+		"PROJ-1\tOpen\tAlice\talice\t2026-10-20\t1.5\t\tAPI,C:\\\\web\tFix\\tlogin\\nbug\thttps://example.backlog.jp/view/PROJ-1\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeIssueTSV() =\n%q\nwant\n%q", got, want)
+	}
+}
@@ -0,0 +1,108 @@
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var noteDefaultIssue string
+
+// NewNoteCmd は課題へのメモ追加コマンドを作成する
+// issue note とトップレベルの note の両方に登録するため、呼び出しごとに新しいコマンドを返す
+func NewNoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "note <text>...",
+		Short: "Quickly add a one-line note to an issue as a comment",
+		Long: `Add a note to an issue as a comment with the shortest possible input.
+
+The issue key (or issue URL) is taken from the first word of the text and the
+rest is posted as the comment. When the text does not start with an issue
+key, the note is added to the default note issue (--default-issue or
+issue_defaults.note_issue in the config).
+
+Examples:
+  backlog note "PROJ-123 本番環境でも再現"
+  backlog note PROJ-123 fixed by restarting the worker
+  backlog note "調査メモ: キャッシュの TTL を確認"   # added to the default note issue
+  backlog note --default-issue PROJ-1 "daily memo"`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runNote,
+	}
+	cmd.Flags().StringVar(&noteDefaultIssue, "default-issue", "", "Issue to add the note to when the text has no issue key (default: issue_defaults.note_issue)")
+	return cmd
+}
+
+var noteIssueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-[0-9]+$`)
+
+// splitNote はメモの先頭の単語が課題キー（または課題の URL）ならキーと本文に分ける
+// 先頭が課題キーでなければ key は空で、text 全体を本文として返す
+func splitNote(text string) (key, body string) {
+	text = strings.TrimSpace(text)
+	first, rest, _ := strings.Cut(text, " ")
+	if normalized := cmdutil.NormalizeIssueKey(first); noteIssueKeyPattern.MatchString(normalized) {
+		return normalized, strings.TrimSpace(rest)
+	}
+	return "", text
+}
+
+func runNote(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+
+	issueKey, message := splitNote(strings.Join(args, " "))
+	if issueKey == "" {
+		issueKey = noteDefaultIssue
+		if issueKey == "" {
+			issueKey = cfg.IssueDefaults().NoteIssue
+		}
+		if issueKey == "" {
+			return fmt.Errorf("no issue key in the note and no default note issue\nStart the note with an issue key (e.g. PROJ-123), or set --default-issue / issue_defaults.note_issue")
+		}
+		issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
+	}
+	if message == "" {
+		return fmt.Errorf("note cannot be empty")
+	}
+	message = cmdutil.ExpandEmoji(cfg.Display(), message)
+	if err := cmdutil.CheckSecrets(cfg, "comment", message); err != nil {
+		return err
+	}
+
+	profile := cfg.CurrentProfile()
+	if err := cmdutil.RunIssuePreHook(c.Context(), cfg, "comment", issueKeyHookEvent(issueKey, profile.Space, nil)); err != nil {
+		return err
+	}
+	comment, err := client.AddComment(c.Context(), issueKey, message, nil, nil)
+	if err != nil {
+		if queued, qerr := enqueueOffline(cfg, queue.Entry{Kind: queue.KindIssueComment, IssueKey: issueKey, Comment: message}, err); queued {
+			return qerr
+		}
+		return fmt.Errorf("failed to add note: %w", err)
+	}
+	cmdutil.RunIssuePostHook(c.Context(), cfg, "comment", issueKeyHookEvent(issueKey, profile.Space, comment))
+
+	switch profile.Output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(comment)
+	default:
+		cmdutil.Success(strconv.Itoa(comment.ID), "Added note #%d to %s", comment.ID, issueKey)
+		url := fmt.Sprintf("https://%s/view/%s#comment-%d", profile.Space, issueKey, comment.ID)
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
+		return nil
+	}
+}
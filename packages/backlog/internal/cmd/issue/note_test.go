@@ -0,0 +1,24 @@
+package issue
+
+import "testing"
+
+func TestSplitNote(t *testing.T) {
+	tests := []struct {
+		text     string
+		wantKey  string
+		wantBody string
+	}{
+		{"PROJ-123 本番環境でも再現", "PROJ-123", "本番環境でも再現"},
+		{"  proj-123   fixed  ", "PROJ-123", "fixed"},
+		{"https://example.backlog.jp/view/PROJ-9 see log", "PROJ-9", "see log"},
+		{"PROJ-123", "PROJ-123", ""},
+		{"調査メモ: PROJ-1 の件", "", "調査メモ: PROJ-1 の件"},
+		{"123 only number", "", "123 only number"},
+	}
+	for _, tt := range tests {
+		key, body := splitNote(tt.text)
+		if key != tt.wantKey || body != tt.wantBody {
+			t.Errorf("splitNote(%q) = (%q, %q), want (%q, %q)", tt.text, key, body, tt.wantKey, tt.wantBody)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package issue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// enqueueOffline はネットワークに接続できなかった書き込み操作をキューに保存する
+// cause がネットワークエラーでない場合とステートレスモードでは保存せず false を返す
+func enqueueOffline(cfg *config.Store, entry queue.Entry, cause error) (bool, error) {
+	if !queue.IsNetworkError(cause) || config.IsEphemeral() {
+		return false, nil
+	}
+	path, err := config.OfflineQueuePath()
+	if err != nil {
+		return true, fmt.Errorf("failed to resolve queue path: %w", err)
+	}
+	entry.Space = cfg.CurrentProfile().Space
+	entry.QueuedAt = time.Now()
+	saved, err := queue.Open(path).Add(entry)
+	if err != nil {
+		return true, fmt.Errorf("network unavailable and failed to queue the operation: %w (original error: %v)", err, cause)
+	}
+	ui.Warning("Network unavailable; queued as #%d. Run 'backlog queue flush' when back online", saved.ID)
+	return true, nil
+}
@@ -0,0 +1,34 @@
+package issue
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
+)
+
+// ステートレスモードではキューや下書きをディスクに書き込まない
+func TestOfflineFallbacksInStatelessMode(t *testing.T) {
+	configHome := t.TempDir()
+	stateHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	t.Setenv("XDG_STATE_HOME", stateHome)
+	t.Setenv(config.EnvAPIKey, "key")
+
+	cause := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	queued, err := enqueueOffline(nil, queue.Entry{Kind: queue.KindIssueComment, IssueKey: "PROJ-1", Comment: "hi"}, cause)
+	if queued || err != nil {
+		t.Errorf("enqueueOffline() = %v, %v, want false, nil", queued, err)
+	}
+	saveCommentDraft(nil, "PROJ-1", "hi", cause)
+
+	for _, dir := range []string{configHome, stateHome} {
+		if _, err := os.Stat(filepath.Join(dir, config.AppName)); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("%s should not be written: %v", dir, err)
+		}
+	}
+}
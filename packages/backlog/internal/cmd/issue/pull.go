@@ -0,0 +1,76 @@
+package issue
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+)
+
+var pullCmd = &cobra.Command{
+	Use:   "pull <issue-key>",
+	Short: "Save an issue to a local Markdown file for editing",
+	Long: `Save an issue as a Markdown file with YAML front matter (fields) and the
+description as the body. Edit the file and apply it with 'backlog issue push'.
+
+The front matter records when the issue was last updated; push uses it to
+detect changes made on Backlog after the pull.
+
+Examples:
+  backlog issue pull PROJ-123                  # writes ./PROJ-123.md
+  backlog issue pull PROJ-123 -o ./PROJ-123.md
+  backlog issue pull PROJ-123 -o -             # print to stdout`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runPull,
+}
+
+var (
+	pullOutput string
+	pullForce  bool
+)
+
+func init() {
+	pullCmd.Flags().StringVarP(&pullOutput, "output", "o", "", "Output file path (default: <issue-key>.md, use \"-\" for stdout)")
+	pullCmd.Flags().BoolVar(&pullForce, "force", false, "Overwrite the output file if it exists")
+}
+
+func runPull(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	issueKey, _ := cmdutil.ResolveIssueKey(args[0], cmdutil.GetCurrentProject(cfg))
+
+	issue, err := client.GetIssueNoCache(c.Context(), issueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	data, err := marshalIssueDocument(newIssueDocument(issue))
+	if err != nil {
+		return err
+	}
+
+	path := pullOutput
+	if path == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if path == "" {
+		path = issue.IssueKey.Value + ".md"
+	}
+	if !pullForce {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists (use --force to overwrite)", path)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to check %s: %w", path, err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	cmdutil.Success(path, "Pulled %s to %s", issue.IssueKey.Value, path)
+	return nil
+}
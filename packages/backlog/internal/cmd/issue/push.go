@@ -0,0 +1,229 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var pushCmd = &cobra.Command{
+	Use:   "push <file>",
+	Short: "Apply a locally edited issue file to Backlog",
+	Long: `Apply a Markdown file created by 'backlog issue pull' to Backlog.
+
+Only the fields and description that differ from the current issue are sent.
+If the issue was updated on Backlog after the pull, push stops with a conflict;
+pull again (or use --force to overwrite). On success the file's front matter is
+refreshed so it can be edited and pushed again.
+
+Clearing the assignee or estimated/actual hours is not supported; use
+'backlog issue edit' for those.
+
+Examples:
+  backlog issue push ./PROJ-123.md
+  backlog issue push ./PROJ-123.md --dry-run
+  backlog issue push ./PROJ-123.md --comment "Updated the spec"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPush,
+}
+
+var (
+	pushDryRun  bool
+	pushForce   bool
+	pushComment string
+)
+
+func init() {
+	pushCmd.Flags().BoolVar(&pushDryRun, "dry-run", false, "Show the changes without applying them")
+	pushCmd.Flags().BoolVar(&pushForce, "force", false, "Apply even if the issue was updated after the pull")
+	pushCmd.Flags().StringVarP(&pushComment, "comment", "c", "", "Comment to add with the update")
+}
+
+func runPush(c *cobra.Command, args []string) error {
+	path := args[0]
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	local, err := parseIssueDocument(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	issueKey, projectKey := cmdutil.ResolveIssueKey(local.Key, cmdutil.GetCurrentProject(cfg))
+	ctx := c.Context()
+
+	current, err := client.GetIssueNoCache(ctx, issueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	if current.Updated.Value != local.Updated && !pushForce {
+		updatedBy := ""
+		if current.UpdatedUser.IsSet() {
+			updatedBy = " by " + current.UpdatedUser.Value.Name.Value
+		}
+		return fmt.Errorf("%s was updated on Backlog after the pull (%s%s)\n"+
+			"Run 'backlog issue pull %s --force -o %s' to refresh the file, or push with --force to overwrite",
+			issueKey, current.Updated.Value, updatedBy, issueKey, path)
+	}
+
+	changes := diffIssueDocument(newIssueDocument(current), local)
+	if len(changes) == 0 && pushComment == "" {
+		fmt.Println("No changes")
+		return nil
+	}
+	printIssueDocumentChanges(issueKey, changes)
+	if pushDryRun {
+		return nil
+	}
+
+	input, err := buildPushInput(ctx, client, projectKey, local, changes)
+	if err != nil {
+		return err
+	}
+	if input.Description != nil {
+		if err := cmdutil.CheckSecrets(cfg, "issue body", *input.Description); err != nil {
+			return err
+		}
+	}
+	if pushComment != "" {
+		input.Comment = &pushComment
+	}
+
+	space := cfg.CurrentProfile().Space
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "edit", issueKeyHookEvent(issueKey, space, nil)); err != nil {
+		return err
+	}
+	issue, err := client.UpdateIssue(ctx, issueKey, input)
+	if err != nil {
+		return fmt.Errorf("failed to update issue: %w", err)
+	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "edit", issueHookEvent(issue, space, issue))
+
+	// 続けて編集・push できるようにフロントマターを更新後の内容で書き直す
+	refreshed, err := marshalIssueDocument(newIssueDocument(issue))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, refreshed, 0o644); err != nil {
+		return fmt.Errorf("updated %s but failed to refresh %s: %w", issueKey, path, err)
+	}
+	return printIssueEditResult(cfg, issue, false)
+}
+
+// printIssueDocumentChanges は push で適用する変更点を表示する
+func printIssueDocumentChanges(issueKey string, changes []issueDocumentChange) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Printf("Changes to %s:\n", issueKey)
+	for _, ch := range changes {
+		if ch.Field == "description" {
+			fmt.Printf("  %s: (changed)\n", ch.Field)
+			continue
+		}
+		fmt.Printf("  %s: %s → %s\n", ch.Field, displayEmpty(ch.From), displayEmpty(ch.To))
+	}
+}
+
+func displayEmpty(s string) string {
+	if s == "" {
+		return ui.Gray("(none)")
+	}
+	return s
+}
+
+// buildPushInput は変更のあったフィールドだけを更新内容に変換する
+func buildPushInput(ctx context.Context, client *api.Client, projectKey string, local *issueDocument, changes []issueDocumentChange) (*api.UpdateIssueInput, error) {
+	input := &api.UpdateIssueInput{}
+	for _, ch := range changes {
+		switch ch.Field {
+		case "summary":
+			if strings.TrimSpace(local.Summary) == "" {
+				return nil, fmt.Errorf("summary must not be empty")
+			}
+			input.Summary = &local.Summary
+		case "type":
+			issueType, err := cmdutil.ResolveIssueType(ctx, client, projectKey, local.Type)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve type: %w", err)
+			}
+			input.IssueTypeID = &issueType.ID
+		case "status":
+			ids, err := cmdutil.ResolveStatusIDs(ctx, client, projectKey, local.Status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve status: %w", err)
+			}
+			input.StatusID = &ids[0]
+		case "priority":
+			ids, err := cmdutil.ResolvePriorityIDs(ctx, client, local.Priority)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve priority: %w", err)
+			}
+			input.PriorityID = &ids[0]
+		case "assignee":
+			if local.Assignee == "" {
+				return nil, fmt.Errorf("clearing the assignee is not supported by push")
+			}
+			id, err := cmdutil.ResolveProjectAssigneeID(ctx, client, projectKey, local.Assignee)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve assignee: %w", err)
+			}
+			input.AssigneeID = &id
+		case "start_date":
+			input.StartDate = &local.StartDate
+		case "due_date":
+			input.DueDate = &local.DueDate
+		case "estimated_hours":
+			if local.EstimatedHours == nil {
+				return nil, fmt.Errorf("clearing estimated_hours is not supported by push")
+			}
+			input.EstimatedHours = local.EstimatedHours
+		case "actual_hours":
+			if local.ActualHours == nil {
+				return nil, fmt.Errorf("clearing actual_hours is not supported by push")
+			}
+			input.ActualHours = local.ActualHours
+		case "categories":
+			ids, err := resolvePushNames(ctx, client, projectKey, local.Categories, cmdutil.ResolveCategoryIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve categories: %w", err)
+			}
+			input.CategoryIDs = ids
+		case "milestones":
+			ids, err := resolvePushNames(ctx, client, projectKey, local.Milestones, cmdutil.ResolveMilestoneIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve milestones: %w", err)
+			}
+			input.MilestoneIDs = ids
+		case "versions":
+			ids, err := resolvePushNames(ctx, client, projectKey, local.Versions, cmdutil.ResolveVersionIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve versions: %w", err)
+			}
+			input.VersionIDs = ids
+		case "description":
+			input.Description = &local.Body
+		}
+	}
+	return input, nil
+}
+
+// resolvePushNames は名前の一覧を ID に変換する（空の場合は解除を表す空スライス）
+func resolvePushNames(ctx context.Context, client *api.Client, projectKey string, names []string,
+	resolve func(context.Context, *api.Client, string, string) ([]int, error)) ([]int, error) {
+	if len(names) == 0 {
+		return []int{}, nil
+	}
+	return resolve(ctx, client, projectKey, strings.Join(names, ","))
+}
@@ -20,8 +20,9 @@ var reopenCmd = &cobra.Command{
 Examples:
   backlog issue reopen PROJ-123
   backlog issue reopen PROJ-123 --comment "Reopening for further investigation"`,
-	Args: cobra.ExactArgs(1),
-	RunE: runReopen,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runReopen,
 }
 
 var reopenComment string
@@ -47,6 +48,10 @@ func runReopen(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get issue: %w", err)
 	}
+	profile := cfg.CurrentProfile()
+	if err := cmdutil.RunIssuePreHook(ctx, cfg, "reopen", issueHookEvent(issue, profile.Space, nil)); err != nil {
+		return err
+	}
 
 	// プロジェクトのステータスを取得してOpenステータスを探す
 	statuses, err := client.GetStatuses(ctx, strconv.Itoa(issue.ProjectId.Value))
@@ -57,7 +62,7 @@ func runReopen(c *cobra.Command, args []string) error {
 	var openStatusID int
 	for _, s := range statuses {
 		// "未対応" または "Open" を探す
-		if s.Name == "未対応" || s.Name == "Open" || s.Name == "To Do" {
+		if cmdutil.IsOpenStatusName(s.Name) {
 			openStatusID = s.ID
 			break
 		}
@@ -84,17 +89,19 @@ func runReopen(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to reopen issue: %w\n\nThis may be caused by the project's workflow settings.\nBacklog restricts status transitions based on the configured workflow.\nCheck the project settings at: Settings > General > Workflow", err)
 	}
+	cmdutil.RunIssuePostHook(ctx, cfg, "reopen", issueHookEvent(issue, profile.Space, issue))
 
-	profile := cfg.CurrentProfile()
 	switch profile.Output {
 	case "json":
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(issue)
 	default:
-		ui.Success("Reopened %s", issue.IssueKey.Value)
+		cmdutil.Success(issue.IssueKey.Value, "Reopened %s", issue.IssueKey.Value)
 		url := fmt.Sprintf("https://%s/view/%s", profile.Space, issue.IssueKey.Value)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
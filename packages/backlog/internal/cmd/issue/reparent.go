@@ -0,0 +1,236 @@
+package issue
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var reparentCmd = &cobra.Command{
+	Use:   "reparent [<child-key>...]",
+	Short: "Set the parent issue of many issues at once",
+	Long: `Set the parent issue of many issues at once, for example when reorganizing
+issues after splitting an epic.
+
+With --parent, all issues given as arguments become children of that issue.
+With --from-csv, parent-child pairs are read from a CSV file with
+"childKey,parentKey" rows (a header row is optional; use "-" to read from
+standard input).
+
+Backlog allows only one level of nesting, so a parent issue must not itself be
+a child issue. Such rows are reported before anything is updated.
+
+Examples:
+  backlog issue reparent --parent PROJ-100 PROJ-101 PROJ-102 PROJ-103
+  backlog issue reparent --parent 100 101 102 --dry-run
+  backlog issue reparent --from-csv epics.csv --dry-run
+  backlog issue reparent --from-csv epics.csv --yes`,
+	Args: func(c *cobra.Command, args []string) error {
+		if reparentFromCSV != "" {
+			return cobra.NoArgs(c, args)
+		}
+		return cobra.MinimumNArgs(1)(c, args)
+	},
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runReparent,
+}
+
+var (
+	reparentParent  string
+	reparentFromCSV string
+	reparentDryRun  bool
+)
+
+func init() {
+	reparentCmd.Flags().StringVar(&reparentParent, "parent", "", "Parent issue key for all issues given as arguments")
+	reparentCmd.Flags().StringVar(&reparentFromCSV, "from-csv", "", "Read pairs from a CSV file of \"childKey,parentKey\" rows")
+	reparentCmd.Flags().BoolVar(&reparentDryRun, "dry-run", false, "Show the changes without updating")
+	reparentCmd.MarkFlagsMutuallyExclusive("parent", "from-csv")
+	reparentCmd.MarkFlagsOneRequired("parent", "from-csv")
+}
+
+// reparentRow は親課題を設定する1件分の組
+type reparentRow struct {
+	Line      int
+	IssueKey  string
+	ParentKey string
+}
+
+// issueRefPattern は課題キー（PROJ-123）または課題番号（123）の形式
+var issueRefPattern = regexp.MustCompile(`^([A-Z][A-Z0-9_]*-)?[0-9]+$`)
+
+// parseReparentCSV は "childKey,parentKey" 形式の CSV を読み込む
+// 先頭行が課題キーの組でない場合は見出し行として読み飛ばす
+func parseReparentCSV(r io.Reader) ([]reparentRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []reparentRow
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected \"childKey,parentKey\"", line)
+		}
+		child := cmdutil.NormalizeIssueKey(record[0])
+		parent := cmdutil.NormalizeIssueKey(record[1])
+		if !issueRefPattern.MatchString(child) || !issueRefPattern.MatchString(parent) {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid issue key pair %q, %q", line, record[0], record[1])
+		}
+		rows = append(rows, reparentRow{Line: line, IssueKey: child, ParentKey: parent})
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no parent-child pairs found in CSV")
+	}
+	return rows, nil
+}
+
+// reparentRowsFromArgs は --parent と引数の課題から組を作る
+func reparentRowsFromArgs(parent string, children []string) []reparentRow {
+	rows := make([]reparentRow, 0, len(children))
+	for _, child := range children {
+		rows = append(rows, reparentRow{IssueKey: child, ParentKey: parent})
+	}
+	return rows
+}
+
+// validateReparentRows は課題キーを補完し、自分自身を親にする組と重複した子課題を検出する
+func validateReparentRows(rows []reparentRow, projectKey string) error {
+	seen := make(map[string]string, len(rows))
+	for i := range rows {
+		rows[i].IssueKey, _ = cmdutil.ResolveIssueKey(rows[i].IssueKey, projectKey)
+		rows[i].ParentKey, _ = cmdutil.ResolveIssueKey(rows[i].ParentKey, projectKey)
+		row := rows[i]
+		if row.IssueKey == row.ParentKey {
+			return fmt.Errorf("%s cannot be its own parent", row.IssueKey)
+		}
+		if prev, ok := seen[row.IssueKey]; ok && prev != row.ParentKey {
+			return fmt.Errorf("%s is given two parents (%s and %s)", row.IssueKey, prev, row.ParentKey)
+		}
+		seen[row.IssueKey] = row.ParentKey
+	}
+	for _, row := range rows {
+		if _, ok := seen[row.ParentKey]; ok {
+			return fmt.Errorf("%s cannot be a parent because it is also given a parent (only one level of nesting is allowed)", row.ParentKey)
+		}
+	}
+	return nil
+}
+
+// resolveParentIDs は親課題を取得して ID を求める
+// 既に子課題である課題は親にできないためエラーにする
+func resolveParentIDs(ctx context.Context, client *api.Client, rows []reparentRow) (map[string]int, error) {
+	ids := make(map[string]int)
+	for _, row := range rows {
+		if _, ok := ids[row.ParentKey]; ok {
+			continue
+		}
+		parent, err := client.GetIssue(ctx, row.ParentKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent issue %s: %w", row.ParentKey, err)
+		}
+		if parent.ParentIssueId.IsSet() && !parent.ParentIssueId.Null {
+			return nil, fmt.Errorf("%s cannot be a parent because it is a child issue (only one level of nesting is allowed)", row.ParentKey)
+		}
+		ids[row.ParentKey] = parent.ID.Value
+	}
+	return ids, nil
+}
+
+func runReparent(c *cobra.Command, args []string) error {
+	var rows []reparentRow
+	if reparentFromCSV != "" {
+		content, err := cmdutil.ReadBodyFromFile(reparentFromCSV)
+		if err != nil {
+			return err
+		}
+		if rows, err = parseReparentCSV(strings.NewReader(content)); err != nil {
+			return err
+		}
+	} else {
+		rows = reparentRowsFromArgs(reparentParent, args)
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := validateReparentRows(rows, cmdutil.GetCurrentProject(cfg)); err != nil {
+		return err
+	}
+
+	ctx := c.Context()
+	stopProgress := ui.StartProgress("Fetching parent issues...")
+	parentIDs, err := resolveParentIDs(ctx, client, rows)
+	stopProgress()
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		fmt.Printf("  %s\t-> %s\n", row.IssueKey, row.ParentKey)
+	}
+	fmt.Printf("%d issue(s) under %d parent(s)\n", len(rows), len(parentIDs))
+	if reparentDryRun {
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog issue reparent",
+				"Use --yes to set the parent issues, or --dry-run to preview.",
+			)
+		}
+		ok, err := ui.Confirm(fmt.Sprintf("Set the parent issue of %d issue(s)?", len(rows)), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, row := range rows {
+		parentID := parentIDs[row.ParentKey]
+		if _, err := client.UpdateIssue(ctx, row.IssueKey, &api.UpdateIssueInput{ParentIssueID: &parentID}); err != nil {
+			if cmdutil.Interrupted(ctx) {
+				return cmdutil.ErrInterrupted
+			}
+			failed++
+			fmt.Fprintf(os.Stderr, "%s %s: %v\n", ui.FailMark(), row.IssueKey, err)
+			continue
+		}
+		cmdutil.Progressf("%s %s -> %s", ui.OKMark(), row.IssueKey, row.ParentKey)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to update %d of %d issue(s)", failed, len(rows))
+	}
+	cmdutil.Success("", "Set the parent issue of %d issue(s)", len(rows))
+	return nil
+}
@@ -0,0 +1,109 @@
+package issue
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseReparentCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []reparentRow
+		wantErr string
+	}{
+		{
+			name:  "with header",
+			input: "child,parent\nPROJ-101,PROJ-100\nproj-102, PROJ-100\n",
+			want: []reparentRow{
+				{Line: 2, IssueKey: "PROJ-101", ParentKey: "PROJ-100"},
+				{Line: 3, IssueKey: "PROJ-102", ParentKey: "PROJ-100"},
+			},
+		},
+		{
+			name:  "without header and blank line",
+			input: "101,100\n\n102,200,extra\n",
+			want: []reparentRow{
+				{Line: 1, IssueKey: "101", ParentKey: "100"},
+				{Line: 2, IssueKey: "102", ParentKey: "200"},
+			},
+		},
+		{name: "invalid key", input: "PROJ-1,PROJ-2\nPROJ-3,epic\n", wantErr: "line 2"},
+		{name: "missing column", input: "PROJ-1\n", wantErr: "line 1"},
+		{name: "header only", input: "child,parent\n", wantErr: "no parent-child pairs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseReparentCSV(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseReparentCSV() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseReparentCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseReparentCSV() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateReparentRows(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    []reparentRow
+		wantErr string
+	}{
+		{
+			name:    "own parent",
+			rows:    reparentRowsFromArgs("PROJ-100", []string{"100"}),
+			wantErr: "its own parent",
+		},
+		{
+			name: "two parents",
+			rows: []reparentRow{
+				{IssueKey: "PROJ-1", ParentKey: "PROJ-10"},
+				{IssueKey: "PROJ-1", ParentKey: "PROJ-20"},
+			},
+			wantErr: "two parents",
+		},
+		{
+			name: "nested",
+			rows: []reparentRow{
+				{IssueKey: "PROJ-1", ParentKey: "PROJ-10"},
+				{IssueKey: "PROJ-10", ParentKey: "PROJ-20"},
+			},
+			wantErr: "one level of nesting",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateReparentRows(tt.rows, "PROJ")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("validateReparentRows() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateReparentRows() error = %v", err)
+			}
+		})
+	}
+
+	rows := reparentRowsFromArgs("100", []string{"101", "OTHER-5"})
+	if err := validateReparentRows(rows, "PROJ"); err != nil {
+		t.Fatal(err)
+	}
+	want := []reparentRow{
+		{IssueKey: "PROJ-101", ParentKey: "PROJ-100"},
+		{IssueKey: "OTHER-5", ParentKey: "PROJ-100"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("validateReparentRows() rows = %+v, want %+v", rows, want)
+	}
+}
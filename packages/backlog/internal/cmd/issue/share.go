@@ -0,0 +1,55 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/share"
+)
+
+// shareIssue は課題のサマリを Slack / Teams の Webhook に送信する
+func shareIssue(ctx context.Context, target share.Target, webhookURL string, issue *backlog.Issue, space string) error {
+	client := &http.Client{Timeout: 10 * time.Second}
+	if err := share.Send(ctx, client, target, webhookURL, issueShareMessage(issue, space)); err != nil {
+		return fmt.Errorf("failed to share %s to %s: %w", issue.IssueKey.Value, target.DisplayName(), err)
+	}
+	cmdutil.Success("", "Shared %s to %s", issue.IssueKey.Value, target.DisplayName())
+	return nil
+}
+
+// issueShareMessage は課題から共有用のメッセージを作る
+func issueShareMessage(issue *backlog.Issue, space string) share.Message {
+	key := issue.IssueKey.Value
+	msg := share.Message{
+		Title: fmt.Sprintf("%s %s", key, issue.Summary.Value),
+		URL:   fmt.Sprintf("https://%s/view/%s", space, key),
+		Text:  issue.Description.Value,
+	}
+	addField := func(name, value string) {
+		if value != "" {
+			msg.Fields = append(msg.Fields, share.Field{Name: name, Value: value})
+		}
+	}
+	if issue.Status.IsSet() {
+		addField("Status", issue.Status.Value.Name.Value)
+	}
+	if issue.Priority.IsSet() {
+		addField("Priority", issue.Priority.Value.Name.Value)
+	}
+	if issue.IssueType.IsSet() {
+		addField("Type", issue.IssueType.Value.Name.Value)
+	}
+	assignee := "(unassigned)"
+	if issue.Assignee.IsSet() && !issue.Assignee.IsNull() && issue.Assignee.Value.Name.IsSet() {
+		assignee = issue.Assignee.Value.Name.Value
+	}
+	addField("Assignee", assignee)
+	if issue.DueDate.IsSet() && !issue.DueDate.IsNull() {
+		addField("Due", triageDate(issue.DueDate.Value))
+	}
+	return msg
+}
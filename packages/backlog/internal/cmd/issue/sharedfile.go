@@ -26,8 +26,9 @@ var issueSharedFileListCmd = &cobra.Command{
 
 Examples:
   backlog issue sharedfile list PROJ-123`,
-	Args: cobra.ExactArgs(1),
-	RunE: runIssueSharedFileList,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueSharedFileList,
 }
 
 func runIssueSharedFileList(c *cobra.Command, args []string) error {
@@ -37,6 +38,7 @@ func runIssueSharedFileList(c *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	files, err := client.ListIssueSharedFiles(c.Context(), issueKey)
 	if err != nil {
@@ -73,8 +75,9 @@ var issueSharedFileLinkCmd = &cobra.Command{
 Examples:
   backlog issue sharedfile link PROJ-123 456
   backlog issue sharedfile link PROJ-123 456 789`,
-	Args: cobra.MinimumNArgs(2),
-	RunE: runIssueSharedFileLink,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueSharedFileLink,
 }
 
 func runIssueSharedFileLink(c *cobra.Command, args []string) error {
@@ -90,17 +93,18 @@ func runIssueSharedFileLink(c *cobra.Command, args []string) error {
 		fileIDs = append(fileIDs, id)
 	}
 
-	client, _, err := cmdutil.GetAPIClient(c)
+	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	files, err := client.LinkIssueSharedFiles(c.Context(), issueKey, fileIDs)
 	if err != nil {
 		return fmt.Errorf("failed to link shared files: %w", err)
 	}
 
-	ui.Success("Linked %d shared file(s) to %s", len(files), issueKey)
+	cmdutil.Success("", "Linked %d shared file(s) to %s", len(files), issueKey)
 	return nil
 }
 
@@ -114,8 +118,9 @@ var issueSharedFileUnlinkCmd = &cobra.Command{
 Examples:
   backlog issue sharedfile unlink PROJ-123 456
   backlog issue sharedfile unlink PROJ-123 456 --yes`,
-	Args: cobra.ExactArgs(2),
-	RunE: runIssueSharedFileUnlink,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runIssueSharedFileUnlink,
 }
 
 func init() {
@@ -131,10 +136,11 @@ func runIssueSharedFileUnlink(c *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid shared file ID: %s", args[1])
 	}
 
-	client, _, err := cmdutil.GetAPIClient(c)
+	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
 	}
+	issueKey, _ = cmdutil.ResolveIssueKey(issueKey, cmdutil.GetCurrentProject(cfg))
 
 	if !cmdutil.SkipConfirmation(c) {
 		if !ui.IsInteractiveInput() {
@@ -163,6 +169,6 @@ func runIssueSharedFileUnlink(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to unlink shared file: %w", err)
 	}
 
-	ui.Success("Unlinked shared file: %s", f.Name)
+	cmdutil.Success("", "Unlinked shared file: %s", f.Name)
 	return nil
 }
@@ -63,7 +63,7 @@ func runStatus(c *cobra.Command, args []string) error {
 	}
 	var openStatusIDs []int
 	for _, s := range statuses {
-		if s.Name != "完了" && s.Name != "Closed" && s.Name != "Done" {
+		if !cmdutil.IsClosedStatusName(s.Name) {
 			openStatusIDs = append(openStatusIDs, s.ID)
 		}
 	}
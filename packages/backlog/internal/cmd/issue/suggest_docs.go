@@ -0,0 +1,238 @@
+package issue
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+const (
+	// suggestDocsKeywords は関連ドキュメントの検索に使うキーワード数の上限
+	suggestDocsKeywords = 5
+	// suggestDocsLimit は表示する関連ドキュメント数の上限
+	suggestDocsLimit = 5
+)
+
+// relatedDoc は課題に関連しそうな Wiki・ドキュメント
+type relatedDoc struct {
+	Kind    string // "Wiki" または "Document"
+	Title   string
+	URL     string
+	Updated string
+	Score   int
+}
+
+// printRelatedDocs は課題のタイトル・本文のキーワードで同じプロジェクトの Wiki とドキュメントを検索し、
+// 関連しそうなものを表示する
+func printRelatedDocs(ctx context.Context, client *api.Client, issue *backlog.Issue, space string, out io.Writer) {
+	keywords := extractDocKeywords(issue.Summary.Value, issue.Description.Value, suggestDocsKeywords)
+	docs := searchRelatedDocs(ctx, client, issue.ProjectId.Value, issueProjectKey(issue.IssueKey.Value), space, keywords)
+
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, ui.Bold("Related documents"))
+	_, _ = fmt.Fprintln(out, ui.Rule(60))
+	if len(docs) == 0 {
+		_, _ = fmt.Fprintln(out, ui.Gray("(No related wiki pages or documents found)"))
+		return
+	}
+	for _, d := range docs {
+		_, _ = fmt.Fprintf(out, "%-9s %s  %s\n", d.Kind, ui.Hyperlink(d.URL, d.Title), ui.Gray(d.URL))
+	}
+	_, _ = fmt.Fprintln(out, ui.Gray("Keywords: "+strings.Join(keywords, ", ")))
+}
+
+// searchRelatedDocs はキーワードごとに Wiki とドキュメントを検索して関連度順に返す
+// 一致したキーワードが多いほど、またタイトルに含むほど上位にする
+// ドキュメント機能が無効なスペースなど、検索に失敗した場合はその結果を無視する
+func searchRelatedDocs(ctx context.Context, client *api.Client, projectID int, projectKey, space string, keywords []string) []relatedDoc {
+	found := make(map[string]*relatedDoc)
+	add := func(doc relatedDoc, keyword string) {
+		score := 1
+		if strings.Contains(strings.ToLower(doc.Title), strings.ToLower(keyword)) {
+			score = 2
+		}
+		if existing, ok := found[doc.URL]; ok {
+			existing.Score += score
+			return
+		}
+		doc.Score = score
+		found[doc.URL] = &doc
+	}
+
+	for _, keyword := range keywords {
+		wikis, err := client.GetWikis(ctx, projectKey, keyword)
+		if err != nil {
+			debug.Log("suggest docs: wiki search failed", "keyword", keyword, "error", err)
+		}
+		for _, w := range wikis {
+			add(relatedDoc{
+				Kind:    "Wiki",
+				Title:   w.Name,
+				URL:     fmt.Sprintf("https://%s/alias/wiki/%d", space, w.ID),
+				Updated: w.Updated,
+			}, keyword)
+		}
+
+		if projectID == 0 {
+			continue
+		}
+		documents, err := client.GetDocuments(ctx, &api.DocumentListOptions{ProjectIDs: []int{projectID}, Keyword: keyword})
+		if err != nil {
+			debug.Log("suggest docs: document search failed", "keyword", keyword, "error", err)
+		}
+		for _, d := range documents {
+			add(relatedDoc{
+				Kind:    "Document",
+				Title:   d.Title,
+				URL:     fmt.Sprintf("https://%s/document/%s", space, d.ID),
+				Updated: d.Updated,
+			}, keyword)
+		}
+	}
+
+	docs := make([]relatedDoc, 0, len(found))
+	for _, d := range found {
+		docs = append(docs, *d)
+	}
+	return rankRelatedDocs(docs, suggestDocsLimit)
+}
+
+// rankRelatedDocs は関連度（同点なら更新日時の新しい順）で並べて上位 limit 件を返す
+func rankRelatedDocs(docs []relatedDoc, limit int) []relatedDoc {
+	slices.SortFunc(docs, func(a, b relatedDoc) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Updated, a.Updated); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Title, b.Title)
+	})
+	if len(docs) > limit {
+		docs = docs[:limit]
+	}
+	return docs
+}
+
+// issueProjectKey は課題キー（PROJ-123）からプロジェクトキーを取り出す
+func issueProjectKey(issueKey string) string {
+	if i := strings.LastIndex(issueKey, "-"); i > 0 {
+		return issueKey[:i]
+	}
+	return issueKey
+}
+
+// docKeywordStopwords は検索キーワードにしない語（小文字）
+var docKeywordStopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "from": true, "this": true, "that": true,
+	"are": true, "was": true, "not": true, "when": true, "into": true, "have": true, "has": true,
+	"http": true, "https": true, "www": true, "com": true, "jp": true, "html": true,
+}
+
+// extractDocKeywords は課題のタイトルと本文から検索キーワードを抽出する
+// タイトルの語を優先し、残りは本文での出現回数の多い順に補う
+func extractDocKeywords(summary, description string, limit int) []string {
+	seen := make(map[string]bool)
+	var keywords []string
+	accept := func(term string) bool {
+		key := strings.ToLower(term)
+		if seen[key] || docKeywordStopwords[key] {
+			return false
+		}
+		seen[key] = true
+		return true
+	}
+
+	for _, term := range splitDocTerms(summary) {
+		if len(keywords) >= limit {
+			return keywords
+		}
+		if accept(term) {
+			keywords = append(keywords, term)
+		}
+	}
+
+	terms := splitDocTerms(description)
+	counts := make(map[string]int)
+	var order []string
+	for _, term := range terms {
+		if counts[term] == 0 {
+			order = append(order, term)
+		}
+		counts[term]++
+	}
+	slices.SortStableFunc(order, func(a, b string) int { return cmp.Compare(counts[b], counts[a]) })
+	for _, term := range order {
+		if len(keywords) >= limit {
+			break
+		}
+		if accept(term) {
+			keywords = append(keywords, term)
+		}
+	}
+	return keywords
+}
+
+type runeClass int
+
+const (
+	classSeparator runeClass = iota
+	classWord                // 英数字など
+	classHan
+	classKatakana
+	classHiragana
+)
+
+func classifyRune(r rune) runeClass {
+	switch {
+	case unicode.Is(unicode.Han, r):
+		return classHan
+	case unicode.Is(unicode.Katakana, r), r == 'ー':
+		return classKatakana
+	case unicode.Is(unicode.Hiragana, r):
+		return classHiragana
+	case unicode.IsLetter(r), unicode.IsDigit(r), r == '_':
+		return classWord
+	default:
+		return classSeparator
+	}
+}
+
+// splitDocTerms はテキストを検索に使える語に分割する
+// 日本語は分かち書きされないため、文字種（漢字・カタカナ・英数字）の切れ目で区切り、
+// 助詞などになりやすいひらがなの並びは捨てる。2文字未満の語と数字だけの語も捨てる
+func splitDocTerms(text string) []string {
+	var terms []string
+	var current []rune
+	currentClass := classSeparator
+	flush := func() {
+		if currentClass != classSeparator && currentClass != classHiragana && len(current) >= 2 {
+			term := string(current)
+			if strings.IndexFunc(term, func(r rune) bool { return !unicode.IsDigit(r) }) >= 0 {
+				terms = append(terms, term)
+			}
+		}
+		current = current[:0]
+	}
+	for _, r := range text {
+		class := classifyRune(r)
+		if class != currentClass {
+			flush()
+			currentClass = class
+		}
+		if class != classSeparator {
+			current = append(current, r)
+		}
+	}
+	flush()
+	return terms
+}
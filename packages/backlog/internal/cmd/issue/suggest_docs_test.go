@@ -0,0 +1,97 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitDocTerms(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "english", text: "Fix login error on Safari", want: []string{"Fix", "login", "error", "on", "Safari"}},
+		{name: "japanese", text: "ログイン画面でエラーが発生する", want: []string{"ログイン", "画面", "エラー", "発生"}},
+		{name: "mixed", text: "API認証のtimeout設定", want: []string{"API", "認証", "timeout", "設定"}},
+		{name: "drop short and digits", text: "a 12 v2 x", want: []string{"v2"}},
+		{name: "empty", text: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitDocTerms(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitDocTerms(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractDocKeywords(t *testing.T) {
+	tests := []struct {
+		name        string
+		summary     string
+		description string
+		limit       int
+		want        []string
+	}{
+		{
+			name:    "summary first",
+			summary: "ログイン画面のエラー",
+			limit:   5,
+			want:    []string{"ログイン", "画面", "エラー"},
+		},
+		{
+			name:        "description by frequency",
+			summary:     "決済エラー",
+			description: "Stripe webhook fails. Stripe returns 500. webhook retry. Stripe",
+			limit:       3,
+			want:        []string{"決済", "エラー", "Stripe"},
+		},
+		{
+			name:        "dedupe case insensitive and stopwords",
+			summary:     "Cache the cache",
+			description: "see https://example.com cache",
+			limit:       5,
+			want:        []string{"Cache", "see", "example"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractDocKeywords(tt.summary, tt.description, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("extractDocKeywords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankRelatedDocs(t *testing.T) {
+	docs := []relatedDoc{
+		{Title: "old", Score: 2, Updated: "2024-01-01T00:00:00Z"},
+		{Title: "top", Score: 3, Updated: "2023-01-01T00:00:00Z"},
+		{Title: "new", Score: 2, Updated: "2025-01-01T00:00:00Z"},
+		{Title: "low", Score: 1, Updated: "2026-01-01T00:00:00Z"},
+	}
+	got := rankRelatedDocs(docs, 3)
+	var titles []string
+	for _, d := range got {
+		titles = append(titles, d.Title)
+	}
+	want := []string{"top", "new", "old"}
+	if !reflect.DeepEqual(titles, want) {
+		t.Errorf("rankRelatedDocs() titles = %v, want %v", titles, want)
+	}
+}
+
+func TestIssueProjectKey(t *testing.T) {
+	tests := map[string]string{
+		"PROJ-123":  "PROJ",
+		"MY_PROJ-1": "MY_PROJ",
+		"NOHYPHEN":  "NOHYPHEN",
+	}
+	for in, want := range tests {
+		if got := issueProjectKey(in); got != want {
+			t.Errorf("issueProjectKey(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
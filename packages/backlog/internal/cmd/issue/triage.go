@@ -0,0 +1,353 @@
+package issue
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	triageFilter string
+	triageLimit  int
+)
+
+// NewTriageCmd は課題トリアージコマンドを生成する
+// "issue triage" とトップレベルの "triage" の両方から使うため、呼び出しごとに新しいコマンドを返す
+func NewTriageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triage",
+		Short: "Triage issues one by one with single-key actions",
+		Long: `Show matching issues one at a time and set the assignee, priority or due date
+with a single key.
+
+Keys:
+  a  set assignee
+  p  set priority
+  d  set due date (YYYY-MM-DD)
+  s  skip to the next issue (also Enter / Space)
+  q  quit
+
+The filter uses the same query syntax as "issue list --query":
+  field:value       value matches (comma-separated values match any)
+  -field:value      value does not match
+  no:field          field is not set
+  has:field         field is set
+  due:<7d           due date comparison (<, <=, >, >=)
+  text              keyword search
+
+Fields: status, priority, type, assignee, category, milestone
+(no: / has: accept assignee, category, milestone, due).
+Values accept IDs or names; assignee also accepts @me. Quote values that
+contain spaces. Without a status condition, closed issues are excluded.
+
+Examples:
+  backlog triage --filter "status:Open no:assignee"
+  backlog triage --filter "-priority:High no:due" --limit 20
+  backlog issue triage --filter 'type:Bug no:milestone due:<=7d'`,
+		Args: cobra.NoArgs,
+		RunE: runTriage,
+	}
+	cmd.Flags().StringVar(&triageFilter, "filter", "", "Filter query (e.g. \"status:Open no:assignee\")")
+	cmd.Flags().IntVarP(&triageLimit, "limit", "L", 100, "Maximum number of issues to triage (0 for all)")
+	return cmd
+}
+
+// triageSession はトリアージ中に使う選択肢をキャッシュする
+type triageSession struct {
+	users      []api.User
+	priorities []backlog.Priority
+}
+
+func runTriage(c *cobra.Command, args []string) error {
+	if !ui.IsInteractiveInput() {
+		return fmt.Errorf("triage requires an interactive terminal; use 'backlog issue list' and 'backlog issue edit' in scripts")
+	}
+
+	filter, err := parseTriageFilter(triageFilter, time.Now())
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	ctx := c.Context()
+
+	project, err := client.GetProject(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project %q: %w", projectKey, err)
+	}
+	criteria, err := resolveTriageConditions(ctx, client, projectKey, filter.Conditions)
+	if err != nil {
+		return err
+	}
+
+	opts := &api.IssueListOptions{
+		ProjectIDs:   []int{project.ID},
+		Keyword:      filter.Keyword,
+		DueDateSince: filter.DueSince,
+		DueDateUntil: filter.DueUntil,
+		Sort:         "created",
+		Order:        "asc",
+	}
+	applyTriageCriteria(opts, criteria)
+	if !hasTriageField(criteria, "status") {
+		statuses, err := client.GetStatuses(ctx, strconv.Itoa(project.ID))
+		if err != nil {
+			return fmt.Errorf("failed to get statuses: %w", err)
+		}
+		closedStatusID, err := cmdutil.FindClosedStatusID(statuses)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			if s.ID != closedStatusID {
+				opts.StatusIDs = append(opts.StatusIDs, s.ID)
+			}
+		}
+	}
+
+	stopProgress := ui.StartProgress("Fetching issues...")
+	fetched, err := paginateIssues(ctx, client, opts, 0)
+	stopProgress()
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+	var issues []backlog.Issue
+	for i := range fetched {
+		if matchTriageCriteria(&fetched[i], criteria) {
+			issues = append(issues, fetched[i])
+			if triageLimit > 0 && len(issues) >= triageLimit {
+				break
+			}
+		}
+	}
+	if len(issues) == 0 {
+		fmt.Println("No issues to triage.")
+		return nil
+	}
+
+	profile := cfg.CurrentProfile()
+	session := &triageSession{}
+	updated := 0
+	for i := range issues {
+		issue := &issues[i]
+		changed := false
+	keyLoop:
+		for {
+			printTriageIssue(issue, i+1, len(issues), profile.Space)
+			fmt.Printf("%s ", ui.Gray("[a]ssign [p]riority [d]ue [s]kip [q]uit >"))
+			key, err := ui.ReadKey()
+			fmt.Println()
+			if errors.Is(err, terminal.InterruptErr) {
+				key = 'q'
+			} else if err != nil {
+				return err
+			}
+
+			input := &api.UpdateIssueInput{}
+			switch key {
+			case 'a', 'A':
+				id, ok, err := session.selectAssignee(c, client, projectKey)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				input.AssigneeID = &id
+			case 'p', 'P':
+				id, ok, err := session.selectPriority(c, client)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				input.PriorityID = &id
+			case 'd', 'D':
+				due, ok, err := promptTriageDueDate(issue)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				input.DueDate = &due
+			case 's', 'S', '\r', '\n', ' ':
+				break keyLoop
+			case 'q', 'Q':
+				if changed {
+					updated++
+				}
+				printTriageSummary(updated, i, len(issues))
+				return nil
+			default:
+				continue
+			}
+
+			result, err := client.UpdateIssue(ctx, issue.IssueKey.Value, input)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s %s: %v\n", ui.FailMark(), issue.IssueKey.Value, err)
+				continue
+			}
+			*issue = *result
+			changed = true
+			cmdutil.Success(issue.IssueKey.Value, "Updated %s", issue.IssueKey.Value)
+		}
+		if changed {
+			updated++
+		}
+	}
+
+	printTriageSummary(updated, len(issues), len(issues))
+	return nil
+}
+
+func hasTriageField(criteria []triageCriterion, field string) bool {
+	for _, c := range criteria {
+		if c.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func printTriageIssue(issue *backlog.Issue, index, total int, space string) {
+	key := issue.IssueKey.Value
+	issueURL := fmt.Sprintf("https://%s/view/%s", space, key)
+
+	fmt.Println()
+	fmt.Printf("%s %s %s\n", ui.Gray(fmt.Sprintf("[%d/%d]", index, total)), ui.Bold(ui.Hyperlink(issueURL, key)), issue.Summary.Value)
+	fmt.Println(ui.Rule(60))
+
+	assignee := ui.Gray("(unassigned)")
+	if issue.Assignee.IsSet() && !issue.Assignee.IsNull() && issue.Assignee.Value.Name.IsSet() {
+		assignee = issue.Assignee.Value.Name.Value
+	}
+	due := ui.Gray("(none)")
+	if issue.DueDate.IsSet() && !issue.DueDate.IsNull() && issue.DueDate.Value != "" {
+		due = triageDate(issue.DueDate.Value)
+	}
+	fmt.Printf("Type: %s  Status: %s  Priority: %s\n",
+		issue.IssueType.Value.Name.Value,
+		ui.StatusColor(issue.Status.Value.Name.Value),
+		ui.PriorityColor(issue.Priority.Value.Name.Value))
+	fmt.Printf("Assignee: %s  Due: %s\n", assignee, due)
+
+	if desc := strings.TrimSpace(issue.Description.Value); desc != "" {
+		lines := strings.Split(desc, "\n")
+		const maxLines = 5
+		if len(lines) > maxLines {
+			lines = append(lines[:maxLines], "…")
+		}
+		fmt.Println()
+		for _, line := range lines {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+}
+
+func printTriageSummary(updated, seen, total int) {
+	fmt.Printf("\nTriaged %d of %d issue(s), %d updated.\n", seen, total, updated)
+}
+
+// selectAssignee は担当者を選択させる（キャンセル時は ok=false）
+func (s *triageSession) selectAssignee(c *cobra.Command, client *api.Client, projectKey string) (int, bool, error) {
+	if s.users == nil {
+		users, err := client.GetProjectUsers(c.Context(), projectKey)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to get project users: %w", err)
+		}
+		s.users = users
+	}
+	options := make([]string, 0, len(s.users)+1)
+	options = append(options, triageCancelOption)
+	for _, u := range s.users {
+		options = append(options, fmt.Sprintf("%s (%s)", u.Name, u.UserID))
+	}
+	selected, err := ui.Select("Assignee:", options)
+	if err != nil {
+		return 0, false, err
+	}
+	for i, opt := range options[1:] {
+		if opt == selected {
+			return s.users[i].ID, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// selectPriority は優先度を選択させる（キャンセル時は ok=false）
+func (s *triageSession) selectPriority(c *cobra.Command, client *api.Client) (int, bool, error) {
+	if s.priorities == nil {
+		priorities, err := client.GetPriorities(c.Context())
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to get priorities: %w", err)
+		}
+		s.priorities = priorities
+	}
+	options := make([]string, 0, len(s.priorities)+1)
+	options = append(options, triageCancelOption)
+	for _, p := range s.priorities {
+		options = append(options, p.Name.Value)
+	}
+	selected, err := ui.Select("Priority:", options)
+	if err != nil {
+		return 0, false, err
+	}
+	for i, opt := range options[1:] {
+		if opt == selected {
+			return s.priorities[i].ID.Value, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+const triageCancelOption = "(cancel)"
+
+// promptTriageDueDate は期日を入力させる（空入力でキャンセル）
+func promptTriageDueDate(issue *backlog.Issue) (string, bool, error) {
+	current := ""
+	if issue.DueDate.IsSet() && !issue.DueDate.IsNull() {
+		current = triageDate(issue.DueDate.Value)
+	}
+	for {
+		value, err := ui.Input("Due date (YYYY-MM-DD, empty to cancel):", current)
+		if err != nil {
+			return "", false, err
+		}
+		value = strings.TrimSpace(value)
+		if value == "" || value == current {
+			return "", false, nil
+		}
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			ui.Warning("invalid date %q (expected YYYY-MM-DD)", value)
+			continue
+		}
+		return value, true, nil
+	}
+}
+
+// triageDate は API の日時文字列から日付部分（YYYY-MM-DD）を取り出す
+func triageDate(value string) string {
+	if len(value) >= len("2006-01-02") {
+		return value[:len("2006-01-02")]
+	}
+	return value
+}
@@ -0,0 +1,241 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/query"
+)
+
+// triageOp はフィルタ条件の演算子
+type triageOp int
+
+const (
+	triageOpEq triageOp = iota
+	triageOpNe
+	triageOpEmpty
+	triageOpNotEmpty
+)
+
+// triageValueFields は値で絞り込めるフィールド（issue list --query と同じキー名）
+// -field:value で否定できる
+var triageValueFields = []string{"status", "priority", "type", "assignee", "category", "milestone"}
+
+// triagePresenceFields は has: / no: で有無を判定できるフィールド
+var triagePresenceFields = []string{"assignee", "category", "milestone", "due"}
+
+// triageCondition はパース済みのフィルタ条件
+type triageCondition struct {
+	Field string
+	Op    triageOp
+	Value string
+}
+
+// triageQuery はパース済みの --filter
+type triageQuery struct {
+	Conditions []triageCondition
+	// Keyword は key: を持たない語（キーワード検索）
+	Keyword string
+	// DueSince / DueUntil は due の比較条件（YYYY-MM-DD、API 側で絞り込む）
+	DueSince string
+	DueUntil string
+}
+
+// parseTriageFilter は `status:Open no:assignee` 形式のフィルタを internal/query でパースする
+//
+// 構文は issue list --query と同じで、次の条件を使える。
+//
+//	field:value    値が一致（カンマ区切りでいずれかに一致）
+//	-field:value   値が一致しない
+//	no:field       フィールドが未設定
+//	has:field      フィールドが設定済み
+//	due:<7d        期日の比較
+func parseTriageFilter(input string, now time.Time) (*triageQuery, error) {
+	q, err := query.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	allowed := append(slices.Clone(triageValueFields), "due", "has", "no")
+	if err := q.ValidateNegatable(allowed, triageValueFields); err != nil {
+		return nil, fmt.Errorf("invalid --filter: %w", err)
+	}
+
+	tq := &triageQuery{Keyword: q.Keyword()}
+	for _, t := range q.Terms {
+		if t.Key == "due" {
+			continue
+		}
+		if t.Op != query.OpEq {
+			return nil, fmt.Errorf("invalid --filter: %s does not support %q (use %s:value)", t.Key, t.Op, t.Key)
+		}
+		switch t.Key {
+		case "has", "no":
+			op := triageOpNotEmpty
+			if t.Key == "no" {
+				op = triageOpEmpty
+			}
+			for _, v := range strings.Split(t.Value, ",") {
+				field := strings.ToLower(strings.TrimSpace(v))
+				if !slices.Contains(triagePresenceFields, field) {
+					return nil, fmt.Errorf("invalid --filter: %s:%s (allowed: %s)", t.Key, v, strings.Join(triagePresenceFields, ", "))
+				}
+				tq.Conditions = append(tq.Conditions, triageCondition{Field: field, Op: op})
+			}
+		default:
+			op := triageOpEq
+			if t.Negated {
+				op = triageOpNe
+			}
+			tq.Conditions = append(tq.Conditions, triageCondition{Field: t.Key, Op: op, Value: t.Value})
+		}
+	}
+	if tq.DueSince, tq.DueUntil, err = q.DateRange("due", now); err != nil {
+		return nil, fmt.Errorf("invalid --filter: %w", err)
+	}
+	return tq, nil
+}
+
+// triageCriterion は値を ID に解決したフィルタ条件
+type triageCriterion struct {
+	Field string
+	Op    triageOp
+	IDs   map[int]bool
+}
+
+// resolveTriageConditions はフィルタ条件の値を ID に解決する
+func resolveTriageConditions(ctx context.Context, client *api.Client, projectKey string, conds []triageCondition) ([]triageCriterion, error) {
+	criteria := make([]triageCriterion, 0, len(conds))
+	for _, cond := range conds {
+		criterion := triageCriterion{Field: cond.Field, Op: cond.Op}
+		if cond.Op == triageOpEq || cond.Op == triageOpNe {
+			var ids []int
+			var err error
+			switch cond.Field {
+			case "status":
+				ids, err = cmdutil.ResolveStatusIDs(ctx, client, projectKey, cond.Value)
+			case "priority":
+				ids, err = cmdutil.ResolvePriorityIDs(ctx, client, cond.Value)
+			case "type":
+				ids, err = cmdutil.ResolveIssueTypeIDs(ctx, client, projectKey, cond.Value)
+			case "assignee":
+				ids, err = cmdutil.ResolveProjectUserIDs(ctx, client, projectKey, cond.Value)
+			case "category":
+				ids, err = cmdutil.ResolveCategoryIDs(ctx, client, projectKey, cond.Value)
+			case "milestone":
+				ids, err = cmdutil.ResolveMilestoneIDs(ctx, client, projectKey, cond.Value)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("filter %s: %w", cond.Field, err)
+			}
+			criterion.IDs = make(map[int]bool, len(ids))
+			for _, id := range ids {
+				criterion.IDs[id] = true
+			}
+		}
+		criteria = append(criteria, criterion)
+	}
+	return criteria, nil
+}
+
+// applyTriageCriteria は API 側で絞り込める等価条件を一覧取得オプションに反映する
+// 最終的な判定は matchTriageCriteria で行うため、ここでは候補を減らすだけでよい
+func applyTriageCriteria(opts *api.IssueListOptions, criteria []triageCriterion) {
+	for _, c := range criteria {
+		if c.Op != triageOpEq {
+			continue
+		}
+		ids := make([]int, 0, len(c.IDs))
+		for id := range c.IDs {
+			ids = append(ids, id)
+		}
+		switch c.Field {
+		case "status":
+			opts.StatusIDs = ids
+		case "priority":
+			opts.PriorityIDs = ids
+		case "type":
+			opts.IssueTypeIDs = ids
+		case "assignee":
+			opts.AssigneeIDs = ids
+		case "category":
+			opts.CategoryIDs = ids
+		case "milestone":
+			opts.MilestoneIDs = ids
+		}
+	}
+}
+
+// matchTriageCriteria は課題がすべての条件を満たすかどうかを返す
+func matchTriageCriteria(issue *backlog.Issue, criteria []triageCriterion) bool {
+	for _, c := range criteria {
+		values := triageFieldIDs(issue, c.Field)
+		switch c.Op {
+		case triageOpEmpty:
+			if len(values) > 0 {
+				return false
+			}
+		case triageOpNotEmpty:
+			if len(values) == 0 {
+				return false
+			}
+		case triageOpEq, triageOpNe:
+			found := false
+			for _, v := range values {
+				if c.IDs[v] {
+					found = true
+					break
+				}
+			}
+			if found != (c.Op == triageOpEq) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// triageFieldIDs は課題のフィールド値を ID の一覧として返す（未設定なら空）
+func triageFieldIDs(issue *backlog.Issue, field string) []int {
+	switch field {
+	case "status":
+		if issue.Status.Set {
+			return []int{issue.Status.Value.ID.Value}
+		}
+	case "priority":
+		if issue.Priority.Set {
+			return []int{issue.Priority.Value.ID.Value}
+		}
+	case "type":
+		if issue.IssueType.Set {
+			return []int{issue.IssueType.Value.ID.Value}
+		}
+	case "assignee":
+		if issue.Assignee.Set && !issue.Assignee.Null {
+			return []int{issue.Assignee.Value.ID.Value}
+		}
+	case "category":
+		ids := make([]int, 0, len(issue.Category))
+		for _, c := range issue.Category {
+			ids = append(ids, c.ID.Value)
+		}
+		return ids
+	case "milestone":
+		ids := make([]int, 0, len(issue.Milestone))
+		for _, m := range issue.Milestone {
+			ids = append(ids, m.ID.Value)
+		}
+		return ids
+	case "due":
+		if issue.DueDate.Set && !issue.DueDate.Null && issue.DueDate.Value != "" {
+			// 期日は有無のみを判定する
+			return []int{1}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestParseTriageFilter(t *testing.T) {
+	now := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		input   string
+		want    *triageQuery
+		wantErr bool
+	}{
+		{name: "empty", input: "  ", want: &triageQuery{}},
+		{
+			name:  "equal and empty",
+			input: "status:Open no:assignee",
+			want: &triageQuery{Conditions: []triageCondition{
+				{Field: "status", Op: triageOpEq, Value: "Open"},
+				{Field: "assignee", Op: triageOpEmpty},
+			}},
+		},
+		{
+			name:  "negation, presence and case",
+			input: "-Priority:High has:due type:Bug,Task",
+			want: &triageQuery{Conditions: []triageCondition{
+				{Field: "priority", Op: triageOpNe, Value: "High"},
+				{Field: "due", Op: triageOpNotEmpty},
+				{Field: "type", Op: triageOpEq, Value: "Bug,Task"},
+			}},
+		},
+		{
+			name:  "quoted value, keyword and due range",
+			input: `milestone:"Sprint and Beyond" no:category,milestone due:<=7d login`,
+			want: &triageQuery{
+				Conditions: []triageCondition{
+					{Field: "milestone", Op: triageOpEq, Value: "Sprint and Beyond"},
+					{Field: "category", Op: triageOpEmpty},
+					{Field: "milestone", Op: triageOpEmpty},
+				},
+				Keyword:  "login",
+				DueUntil: "2024-01-17",
+			},
+		},
+		{name: "unknown field", input: "owner:me", wantErr: true},
+		{name: "unknown presence field", input: "no:status", wantErr: true},
+		{name: "negated presence", input: "-no:assignee", wantErr: true},
+		{name: "comparison on value field", input: "priority:>2", wantErr: true},
+		{name: "missing value", input: "status:", wantErr: true},
+		{name: "invalid due", input: "due:<soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTriageFilter(tt.input, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTriageFilter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchTriageCriteria(t *testing.T) {
+	assigned := backlog.Issue{
+		Status:    backlog.NewOptStatus(backlog.Status{ID: backlog.NewOptInt(1)}),
+		Priority:  backlog.NewOptPriority(backlog.Priority{ID: backlog.NewOptInt(3)}),
+		Assignee:  backlog.NewOptNilUser(backlog.User{ID: backlog.NewOptInt(10)}),
+		DueDate:   backlog.NewOptNilString("2024-01-31T00:00:00Z"),
+		Milestone: []backlog.Version{{ID: backlog.NewOptInt(7)}},
+	}
+	unassigned := backlog.Issue{
+		Status:   backlog.NewOptStatus(backlog.Status{ID: backlog.NewOptInt(1)}),
+		Priority: backlog.NewOptPriority(backlog.Priority{ID: backlog.NewOptInt(2)}),
+	}
+	ids := func(v ...int) map[int]bool {
+		m := make(map[int]bool)
+		for _, id := range v {
+			m[id] = true
+		}
+		return m
+	}
+
+	tests := []struct {
+		name     string
+		criteria []triageCriterion
+		issue    backlog.Issue
+		want     bool
+	}{
+		{name: "no criteria", issue: unassigned, want: true},
+		{name: "assignee empty", criteria: []triageCriterion{{Field: "assignee", Op: triageOpEmpty}}, issue: unassigned, want: true},
+		{name: "assignee empty mismatch", criteria: []triageCriterion{{Field: "assignee", Op: triageOpEmpty}}, issue: assigned, want: false},
+		{name: "due not empty", criteria: []triageCriterion{{Field: "due", Op: triageOpNotEmpty}}, issue: assigned, want: true},
+		{name: "due empty", criteria: []triageCriterion{{Field: "due", Op: triageOpEmpty}}, issue: unassigned, want: true},
+		{name: "status any of", criteria: []triageCriterion{{Field: "status", Op: triageOpEq, IDs: ids(1, 2)}}, issue: unassigned, want: true},
+		{name: "priority not equal", criteria: []triageCriterion{{Field: "priority", Op: triageOpNe, IDs: ids(3)}}, issue: assigned, want: false},
+		{name: "milestone equal", criteria: []triageCriterion{{Field: "milestone", Op: triageOpEq, IDs: ids(7)}}, issue: assigned, want: true},
+		{
+			name: "all conditions must match",
+			criteria: []triageCriterion{
+				{Field: "status", Op: triageOpEq, IDs: ids(1)},
+				{Field: "assignee", Op: triageOpEmpty},
+				{Field: "priority", Op: triageOpNe, IDs: ids(2)},
+			},
+			issue: unassigned,
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchTriageCriteria(&tt.issue, tt.criteria); got != tt.want {
+				t.Errorf("matchTriageCriteria() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
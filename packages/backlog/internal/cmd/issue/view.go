@@ -6,15 +6,20 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/emoji"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/share"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/summary"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/textmerge"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/translate"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -26,9 +31,12 @@ var viewCmd = &cobra.Command{
 If project is configured, you can omit the project prefix:
   backlog issue view 123       # equivalent to PROJ-123 when project=PROJ
 
+The issue URL is also accepted (https://example.backlog.jp/view/PROJ-123).
+
 Examples:
   backlog issue view PROJ-123
   backlog issue view 123       # uses configured project
+  backlog issue view https://example.backlog.jp/view/PROJ-123
   backlog issue view PROJ-123 -c             # show comments (default: 20)
   backlog issue view PROJ-123 -c=50          # show 50 comments
   backlog issue view PROJ-123 -c=all         # show all comments
@@ -36,11 +44,24 @@ Examples:
   backlog issue view PROJ-123 --summary
   backlog issue view PROJ-123 -c --comments-order asc    # oldest first
   backlog issue view PROJ-123 -c=all --comments-since 12345  # comments after ID 12345
+  backlog issue view PROJ-123 -c --author user1 --since 2024-06-01 --until 2024-06-30
+  backlog issue view PROJ-123 -c --changelog-diff          # show description changes as diff
+  backlog issue view PROJ-123 --suggest-docs               # show related wiki pages and documents
+  backlog issue view PROJ-123 --share slack --webhook "$SLACK_WEBHOOK_URL"
+  backlog issue view PROJ-123 --share teams --webhook "$TEAMS_WEBHOOK_URL"
+  backlog issue view PROJ-123 -c --translate en --translator "deepl-cli --to {lang}"
 
 Note: -c accepts an optional value. Use '=' to pass a value: -c=50, -c=all.
-      -c without a value shows the default number of comments (20).`,
-	Args: cobra.RangeArgs(1, 2),
-	RunE: runView,
+      -c without a value shows the default number of comments (20).
+      --author / --since / --until filter comments (all comments are searched;
+      without -c every matching comment is shown).
+      --translate pipes the description and shown comments to the translator
+      command (stdin -> stdout) and prints each translation after the original.
+      --translator accepts a name from translate.translators or a command;
+      {lang} in the command is replaced with the target language.`,
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runView,
 }
 
 var (
@@ -56,6 +77,15 @@ var (
 	viewMarkdownCache       bool
 	viewCommentsOrder       string
 	viewCommentsSince       int
+	viewCommentsAuthor      string
+	viewCommentsFrom        string
+	viewCommentsUntil       string
+	viewChangelogDiff       bool
+	viewShare               string
+	viewShareWebhook        string
+	viewSuggestDocs         bool
+	viewTranslate           string
+	viewTranslator          string
 )
 
 func init() {
@@ -70,8 +100,18 @@ func init() {
 	viewCmd.Flags().BoolVar(&viewRaw, "raw", false, "Render raw content without markdown conversion")
 	viewCmd.Flags().BoolVar(&viewMarkdownWarn, "markdown-warn", false, "Show markdown conversion warnings")
 	viewCmd.Flags().BoolVar(&viewMarkdownCache, "markdown-cache", false, "Cache markdown conversion analysis data")
+	viewCmd.Flags().String("conversion-profile", "", "Markdown conversion rule preset: safe, standard, aggressive (default: display.markdown_conversion_profile)")
 	viewCmd.Flags().StringVar(&viewCommentsOrder, "comments-order", "desc", "Comment sort order: asc or desc")
 	viewCmd.Flags().IntVar(&viewCommentsSince, "comments-since", 0, "Show comments after this comment ID")
+	viewCmd.Flags().StringVar(&viewCommentsAuthor, "author", "", "Show only comments by this user (@me, user ID, userId, or display name)")
+	viewCmd.Flags().StringVar(&viewCommentsFrom, "since", "", "Show only comments created on or after this date (YYYY-MM-DD)")
+	viewCmd.Flags().StringVar(&viewCommentsUntil, "until", "", "Show only comments created on or before this date (YYYY-MM-DD)")
+	viewCmd.Flags().BoolVar(&viewChangelogDiff, "changelog-diff", false, "Show description changes in comments as unified diff")
+	viewCmd.Flags().StringVar(&viewShare, "share", "", "Send the issue summary to a chat webhook: slack or teams")
+	viewCmd.Flags().StringVar(&viewShareWebhook, "webhook", "", "Incoming webhook URL used with --share")
+	viewCmd.Flags().BoolVar(&viewSuggestDocs, "suggest-docs", false, "Suggest related wiki pages and documents in the same project")
+	viewCmd.Flags().StringVar(&viewTranslate, "translate", "", "Translate the description and comments into this language (e.g. en, ja)")
+	viewCmd.Flags().StringVar(&viewTranslator, "translator", "", "Translator name from translate.translators or a command (default: translate.command)")
 }
 
 func runView(c *cobra.Command, args []string) error {
@@ -85,6 +125,26 @@ func runView(c *cobra.Command, args []string) error {
 	}
 	issueKey := args[0]
 
+	var shareTarget share.Target
+	if viewShare != "" {
+		target, err := share.ParseTarget(viewShare)
+		if err != nil {
+			return err
+		}
+		if viewShareWebhook == "" {
+			return fmt.Errorf("--webhook is required with --share")
+		}
+		if err := share.ValidateWebhookURL(viewShareWebhook); err != nil {
+			return err
+		}
+		shareTarget = target
+	} else if viewShareWebhook != "" {
+		return fmt.Errorf("--webhook requires --share")
+	}
+	if viewTranslator != "" && viewTranslate == "" {
+		return fmt.Errorf("--translator requires --translate")
+	}
+
 	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
@@ -99,7 +159,7 @@ func runView(c *cobra.Command, args []string) error {
 	// ブラウザで開く
 	if viewWeb {
 		url := fmt.Sprintf("https://%s/view/%s", profile.Space, issueKey)
-		return browser.OpenURL(url)
+		return osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
 	}
 
 	// 課題取得
@@ -108,6 +168,20 @@ func runView(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get issue: %w", err)
 	}
+	cmdutil.RememberIssues(cfg, []backlog.Issue{*issue})
+
+	if shareTarget != "" {
+		return shareIssue(ctx, shareTarget, viewShareWebhook, issue, profile.Space)
+	}
+
+	// コメントの絞り込み条件（指定時は -c が無くても該当コメントをすべて表示する）
+	filter, err := resolveCommentFilter(ctx, client, issue.IssueKey.Value, display.Timezone)
+	if err != nil {
+		return err
+	}
+	if filter.active() && viewComments == "" {
+		viewComments = "all"
+	}
 
 	// コメント取得条件の決定
 	showComments := viewComments != ""
@@ -139,7 +213,17 @@ func runView(c *cobra.Command, args []string) error {
 
 	// コメント取得
 	var comments []api.Comment
-	if fetchAll {
+	if filter.active() && showComments {
+		// 絞り込みは取得後に行うため全件から探し、件数指定は絞り込み後に適用する
+		all, err := fetchAllComments(ctx, client, issueKey, viewCommentsOrder, viewCommentsSince)
+		if err != nil {
+			return fmt.Errorf("failed to get comments: %w", err)
+		}
+		comments = filter.apply(all)
+		if !fetchAll && fetchCount > 0 && len(comments) > fetchCount {
+			comments = comments[:fetchCount]
+		}
+	} else if fetchAll {
 		comments, _ = fetchAllComments(ctx, client, issueKey, viewCommentsOrder, viewCommentsSince)
 	} else if fetchCount > 0 {
 		if fetchCount > 100 {
@@ -155,13 +239,28 @@ func runView(c *cobra.Command, args []string) error {
 		comments, _ = client.GetComments(ctx, issueKey, opts)
 	}
 
+	// 翻訳
+	var translation *issueTranslation
+	if viewTranslate != "" && !viewBrief {
+		translator, err := translate.New(cfg, viewTranslator, viewTranslate)
+		if err != nil {
+			return err
+		}
+		stop := ui.StartProgress(fmt.Sprintf("Translating into %s...", viewTranslate))
+		translation, err = translateIssue(ctx, translator, issue, comments)
+		stop()
+		if err != nil {
+			return err
+		}
+	}
+
 	// 出力
 	switch profile.Output {
 	case "json":
 		if viewBrief {
 			return outputBriefJSON(issue, profile)
 		}
-		return outputIssueJSON(issue, comments, showComments, profile)
+		return outputIssueJSON(issue, comments, showComments, translation, profile)
 	default:
 		if viewBrief {
 			return renderIssueBrief(issue, profile)
@@ -172,7 +271,17 @@ func runView(c *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to resolve cache dir: %w", cacheErr)
 		}
 		projectKey := cmdutil.GetCurrentProject(cfg)
-		return renderIssueDetail(issue, comments, showComments, profile, display, cfg, projectKey, markdownOpts, c.OutOrStdout())
+		commentCount, err := client.GetCommentsCount(ctx, issueKey)
+		if err != nil {
+			commentCount = -1
+		}
+		if err := renderIssueDetail(issue, comments, commentCount, showComments, translation, profile, display, cfg, projectKey, markdownOpts, c.OutOrStdout()); err != nil {
+			return err
+		}
+		if viewSuggestDocs {
+			printRelatedDocs(ctx, client, issue, profile.Space, c.OutOrStdout())
+		}
+		return nil
 	}
 }
 
@@ -242,22 +351,67 @@ func outputBriefJSON(issue *backlog.Issue, profile *config.ResolvedProfile) erro
 
 // IssueWithComments is a wrapper for issue with comments for JSON output
 type IssueWithComments struct {
-	Issue    *backlog.Issue `json:"issue"`
-	Comments []api.Comment  `json:"comments,omitempty"`
+	Issue       *backlog.Issue    `json:"issue"`
+	Comments    []api.Comment     `json:"comments,omitempty"`
+	Translation *issueTranslation `json:"translation,omitempty"`
+}
+
+// issueTranslation は --translate による説明とコメントの訳文
+type issueTranslation struct {
+	Language    string         `json:"language"`
+	Description string         `json:"description,omitempty"`
+	Comments    map[int]string `json:"comments,omitempty"` // キーはコメント ID
+}
+
+// translateIssue は課題の説明とコメントを翻訳する
+func translateIssue(ctx context.Context, translator *translate.Translator, issue *backlog.Issue, comments []api.Comment) (*issueTranslation, error) {
+	tr := &issueTranslation{Language: translator.Language}
+	if issue.Description.IsSet() && issue.Description.Value != "" {
+		text, err := translator.Translate(ctx, issue.Description.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate description: %w", err)
+		}
+		tr.Description = text
+	}
+	for _, comment := range comments {
+		if strings.TrimSpace(comment.Content) == "" {
+			continue
+		}
+		text, err := translator.Translate(ctx, comment.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate comment %d: %w", comment.ID, err)
+		}
+		if tr.Comments == nil {
+			tr.Comments = make(map[int]string)
+		}
+		tr.Comments[comment.ID] = text
+	}
+	return tr, nil
+}
+
+// printTranslation は原文の後に訳文を表示する
+func printTranslation(language, text string) {
+	if text == "" {
+		return
+	}
+	fmt.Println()
+	fmt.Println(ui.Gray(fmt.Sprintf("Translation (%s)", language)))
+	fmt.Println(text)
 }
 
 // outputIssueJSON outputs issue with optional comments as JSON
-func outputIssueJSON(issue *backlog.Issue, comments []api.Comment, showComments bool, profile *config.ResolvedProfile) error {
-	if showComments {
+func outputIssueJSON(issue *backlog.Issue, comments []api.Comment, showComments bool, translation *issueTranslation, profile *config.ResolvedProfile) error {
+	if showComments || translation != nil {
 		return cmdutil.OutputJSONFromProfile(IssueWithComments{
-			Issue:    issue,
-			Comments: comments,
+			Issue:       issue,
+			Comments:    comments,
+			Translation: translation,
 		}, profile.JSONFields, profile.JQ, profile.Template)
 	}
 	return cmdutil.OutputJSONFromProfile(issue, profile.JSONFields, profile.JQ, profile.Template)
 }
 
-func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComments bool, profile *config.ResolvedProfile, display *config.ResolvedDisplay, cfg *config.Store, projectKey string, markdownOpts cmdutil.MarkdownViewOptions, out io.Writer) error {
+func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, commentCount int, showComments bool, translation *issueTranslation, profile *config.ResolvedProfile, display *config.ResolvedDisplay, cfg *config.Store, projectKey string, markdownOpts cmdutil.MarkdownViewOptions, out io.Writer) error {
 	// フラグの調整: summary-with-comments が指定されたら summary も有効にする
 	if viewSummaryWithComments {
 		viewSummary = true
@@ -277,9 +431,15 @@ func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComment
 	}
 	issueURL := fmt.Sprintf("https://%s/view/%s", profile.Space, key)
 
+	// 絵文字を表示できない端末では :shortcode: に戻して表示する
+	showText := func(s string) string { return s }
+	if cmdutil.EmojiFallback(display) {
+		showText = emoji.Shorten
+	}
+
 	// ヘッダー（キーをハイパーリンク化）
-	fmt.Printf("%s %s\n", ui.Bold(ui.Hyperlink(issueURL, key)), issue.Summary.Value)
-	fmt.Println(strings.Repeat("─", 60))
+	fmt.Printf("%s %s\n", ui.Bold(ui.Hyperlink(issueURL, key)), showText(issue.Summary.Value))
+	fmt.Println(ui.Rule(60))
 
 	// メタ情報
 	if issue.Status.IsSet() && issue.Status.Value.Name.IsSet() {
@@ -335,6 +495,9 @@ func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComment
 		fmt.Printf("Milestone:  %s\n", strings.Join(milestones, ", "))
 	}
 
+	// コメント・添付ファイル・スターの件数
+	fmt.Printf("Activity:   %s\n", formatIssueActivity(commentCount, len(issue.Attachments), len(issue.Stars)))
+
 	// AI要約用のコメント数設定
 	summaryCommentCount := display.SummaryCommentCount
 	if viewSummaryCommentCount >= 0 {
@@ -345,7 +508,7 @@ func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComment
 	if viewSummary {
 		fmt.Println()
 		fmt.Println(ui.Bold("AI Summary"))
-		fmt.Println(strings.Repeat("─", 60))
+		fmt.Println(ui.Rule(60))
 
 		aiCfg := cfg.AISummary()
 		if !aiCfg.Enabled {
@@ -410,8 +573,8 @@ func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComment
 	if issue.Description.IsSet() && issue.Description.Value != "" {
 		fmt.Println()
 		fmt.Println(ui.Bold("Description"))
-		fmt.Println(strings.Repeat("─", 60))
-		content := issue.Description.Value
+		fmt.Println(ui.Rule(60))
+		content := showText(issue.Description.Value)
 		if markdownOpts.Enable {
 			attachments := issueAttachmentNames(issue.Attachments)
 			rendered, err := cmdutil.RenderMarkdownContent(content, markdownOpts, "issue", issueID, 0, projectKey, key, issueURL, attachments, out)
@@ -421,6 +584,9 @@ func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComment
 			content = rendered
 		}
 		fmt.Println(content)
+		if translation != nil {
+			printTranslation(translation.Language, translation.Description)
+		}
 	}
 
 	// URL（常に表示、ハイパーリンク化）
@@ -431,13 +597,13 @@ func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComment
 	if showComments && len(comments) > 0 {
 		fmt.Println()
 		fmt.Println(ui.Bold("Comments"))
-		fmt.Println(strings.Repeat("─", 60))
+		fmt.Println(ui.Rule(60))
 
 		// 表示件数はオプションで制御されていないが、APIで20件取ってきているのでそれを表示
 		// 元のコードは10件固定だったが、要約のために20件にしたので、表示も20件になる
 		for _, comment := range comments {
 			fmt.Printf("\n%s %s\n", ui.Bold(comment.CreatedUser.Name), ui.Gray(formatter.FormatDateTime(comment.Created, "created")))
-			content := comment.Content
+			content := showText(comment.Content)
 			if markdownOpts.Enable {
 				commentURL := fmt.Sprintf("%s#comment-%d", issueURL, comment.ID)
 				rendered, err := cmdutil.RenderMarkdownContent(content, markdownOpts, "comment", comment.ID, issueID, projectKey, key, commentURL, nil, out)
@@ -447,12 +613,63 @@ func renderIssueDetail(issue *backlog.Issue, comments []api.Comment, showComment
 				content = rendered
 			}
 			fmt.Println(content)
+			if translation != nil {
+				printTranslation(translation.Language, translation.Comments[comment.ID])
+			}
+			if viewChangelogDiff {
+				printChangeLogDiff(comment.ChangeLog)
+			}
 		}
 	}
 
 	return nil
 }
 
+// formatIssueActivity は課題のコメント数・添付ファイル数・スター数を1行にまとめる
+// commentCount が負の場合（取得失敗）はコメント数を省略する
+func formatIssueActivity(commentCount, attachments, stars int) string {
+	var parts []string
+	if commentCount >= 0 {
+		parts = append(parts, pluralize(commentCount, "comment"))
+	}
+	parts = append(parts, pluralize(attachments, "attachment"), pluralize(stars, "star"))
+	return strings.Join(parts, ", ")
+}
+
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// printChangeLogDiff はコメントの変更履歴のうち詳細（description）の変更を unified diff で表示する
+func printChangeLogDiff(changes []api.ChangeLog) {
+	for _, ch := range changes {
+		if ch.Field != "description" {
+			continue
+		}
+		diff := textmerge.UnifiedDiff(ch.OriginalValue, ch.NewValue, "description (before)", "description (after)", 3)
+		if diff == "" {
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+			switch {
+			case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+				fmt.Println(ui.Bold(line))
+			case strings.HasPrefix(line, "@@"):
+				fmt.Println(ui.Cyan(line))
+			case strings.HasPrefix(line, "+"):
+				fmt.Println(ui.Green(line))
+			case strings.HasPrefix(line, "-"):
+				fmt.Println(ui.Red(line))
+			default:
+				fmt.Println(line)
+			}
+		}
+	}
+}
+
 // fetchAllComments は課題の全コメントをページネーションで取得する
 func fetchAllComments(ctx context.Context, client *api.Client, issueKey string, order string, sinceID int) ([]api.Comment, error) {
 	const batchSize = 100
@@ -496,6 +713,80 @@ func fetchAllComments(ctx context.Context, client *api.Client, issueKey string,
 	return allComments, nil
 }
 
+// commentFilter はコメントの投稿者・投稿日による絞り込み条件
+type commentFilter struct {
+	authorID int
+	since    time.Time
+	until    time.Time
+}
+
+// resolveCommentFilter は --author / --since / --until から絞り込み条件を作る
+// 日付は表示用タイムゾーンの日付として解釈し、--until は当日いっぱいを含める
+func resolveCommentFilter(ctx context.Context, client *api.Client, issueKey, timezone string) (commentFilter, error) {
+	var f commentFilter
+	loc := time.Local
+	if timezone != "" {
+		if l, err := time.LoadLocation(timezone); err == nil {
+			loc = l
+		}
+	}
+	if viewCommentsFrom != "" {
+		t, err := time.ParseInLocation("2006-01-02", viewCommentsFrom, loc)
+		if err != nil {
+			return f, fmt.Errorf("invalid --since %q (expected YYYY-MM-DD)", viewCommentsFrom)
+		}
+		f.since = t
+	}
+	if viewCommentsUntil != "" {
+		t, err := time.ParseInLocation("2006-01-02", viewCommentsUntil, loc)
+		if err != nil {
+			return f, fmt.Errorf("invalid --until %q (expected YYYY-MM-DD)", viewCommentsUntil)
+		}
+		f.until = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	if !f.since.IsZero() && !f.until.IsZero() && f.until.Before(f.since) {
+		return f, fmt.Errorf("--until must not be before --since")
+	}
+	if viewCommentsAuthor != "" {
+		projectKey, _, _ := cmdutil.ParseIssueKey(issueKey)
+		id, err := cmdutil.ResolveProjectAuthorID(ctx, client, projectKey, viewCommentsAuthor)
+		if err != nil {
+			return f, fmt.Errorf("failed to resolve --author: %w", err)
+		}
+		f.authorID = id
+	}
+	return f, nil
+}
+
+func (f commentFilter) active() bool {
+	return f.authorID != 0 || !f.since.IsZero() || !f.until.IsZero()
+}
+
+// apply は条件に合うコメントだけを順序を保って返す
+// 投稿日時を解釈できないコメントは期間指定がある場合に除外する
+func (f commentFilter) apply(comments []api.Comment) []api.Comment {
+	var matched []api.Comment
+	for _, c := range comments {
+		if f.authorID != 0 && c.CreatedUser.ID != f.authorID {
+			continue
+		}
+		if !f.since.IsZero() || !f.until.IsZero() {
+			created, err := time.Parse(time.RFC3339, c.Created)
+			if err != nil {
+				continue
+			}
+			if !f.since.IsZero() && created.Before(f.since) {
+				continue
+			}
+			if !f.until.IsZero() && created.After(f.until) {
+				continue
+			}
+		}
+		matched = append(matched, c)
+	}
+	return matched
+}
+
 func isValidCommentsValue(s string) bool {
 	if s == "all" || s == "0" {
 		return true
@@ -1,12 +1,18 @@
 package issue
 
 import (
+	"context"
 	"fmt"
+	"runtime"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/translate"
 )
 
 func newViewTestCmd() (*cobra.Command, *string) {
@@ -116,3 +122,77 @@ func TestViewCmdCommentsAbsorption(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatIssueActivity(t *testing.T) {
+	tests := []struct {
+		comments, attachments, stars int
+		want                         string
+	}{
+		{comments: 3, attachments: 1, stars: 0, want: "3 comments, 1 attachment, 0 stars"},
+		{comments: 1, attachments: 0, stars: 2, want: "1 comment, 0 attachments, 2 stars"},
+		{comments: -1, attachments: 2, stars: 1, want: "2 attachments, 1 star"},
+	}
+	for _, tt := range tests {
+		if got := formatIssueActivity(tt.comments, tt.attachments, tt.stars); got != tt.want {
+			t.Errorf("formatIssueActivity(%d, %d, %d) = %q, want %q", tt.comments, tt.attachments, tt.stars, got, tt.want)
+		}
+	}
+}
+
+func TestCommentFilterApply(t *testing.T) {
+	comments := []api.Comment{
+		{ID: 1, CreatedUser: api.User{ID: 10}, Created: "2024-05-31T23:00:00Z"},
+		{ID: 2, CreatedUser: api.User{ID: 10}, Created: "2024-06-15T12:00:00Z"},
+		{ID: 3, CreatedUser: api.User{ID: 20}, Created: "2024-06-20T12:00:00Z"},
+		{ID: 4, CreatedUser: api.User{ID: 10}, Created: "2024-06-30T23:59:59Z"},
+		{ID: 5, CreatedUser: api.User{ID: 10}, Created: "2024-07-01T00:00:00Z"},
+		{ID: 6, CreatedUser: api.User{ID: 10}, Created: "invalid"},
+	}
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	endOfJune := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+
+	tests := []struct {
+		name   string
+		filter commentFilter
+		want   []int
+	}{
+		{name: "author", filter: commentFilter{authorID: 20}, want: []int{3}},
+		{name: "period", filter: commentFilter{since: june, until: endOfJune}, want: []int{2, 3, 4}},
+		{name: "author and since", filter: commentFilter{authorID: 10, since: june}, want: []int{2, 4, 5}},
+		{name: "no filter keeps unparsable dates", filter: commentFilter{}, want: []int{1, 2, 3, 4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, c := range tt.filter.apply(comments) {
+				got = append(got, c.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslateIssue(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+	translator := &translate.Translator{Command: "tr a-z A-Z", Language: "en", Timeout: 5 * time.Second}
+	issue := &backlog.Issue{Description: backlog.NewOptString("fix the login page")}
+	comments := []api.Comment{
+		{ID: 1, Content: "looks good"},
+		{ID: 2, Content: ""}, // 変更履歴のみのコメント
+	}
+
+	tr, err := translateIssue(context.Background(), translator, issue, comments)
+	if err != nil {
+		t.Fatalf("translateIssue() error: %v", err)
+	}
+	if tr.Language != "en" || tr.Description != "FIX THE LOGIN PAGE" {
+		t.Errorf("translateIssue() = %+v", tr)
+	}
+	if len(tr.Comments) != 1 || tr.Comments[1] != "LOOKS GOOD" {
+		t.Errorf("Comments = %v, want map[1:LOOKS GOOD]", tr.Comments)
+	}
+}
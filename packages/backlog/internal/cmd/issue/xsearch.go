@@ -0,0 +1,269 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// XSearchCmd は複数スペースを横断する課題検索コマンド（トップレベルの "xsearch"）
+var XSearchCmd = &cobra.Command{
+	Use:   "xsearch",
+	Short: "Search issues across multiple spaces",
+	Long: `Search issues in every project of several profiles (spaces) at once and
+show them in a single table with a PROFILE column.
+
+Each profile is queried in parallel with its own credentials. Profiles that are
+not logged in or whose credentials have expired are skipped with a warning.
+
+Status filtering uses the standard statuses shared by all projects
+(open: 未対応/処理中/処理済み, closed: 完了); custom statuses are not matched.
+
+Examples:
+  # My open issues in the "work" and "oss" profiles
+  backlog xsearch --profiles work,oss --assignee @me
+
+  # Keyword search in all logged-in profiles
+  backlog xsearch --search "login" --state all
+
+  # JSON output includes "profile" and "space"
+  backlog xsearch --profiles work,oss --assignee @me -o json`,
+	Args: cobra.NoArgs,
+	RunE: runXSearch,
+}
+
+var (
+	xsearchProfiles string
+	xsearchAssignee string
+	xsearchState    string
+	xsearchSearch   string
+	xsearchLimit    int
+)
+
+func init() {
+	XSearchCmd.Flags().StringVar(&xsearchProfiles, "profiles", "", "Comma-separated profiles to search (default: all profiles with a space)")
+	XSearchCmd.Flags().StringVarP(&xsearchAssignee, "assignee", "a", "", "Filter by assignee (@me or user ID)")
+	XSearchCmd.Flags().StringVarP(&xsearchState, "state", "s", "open", "Filter by state: {open|closed|all}")
+	XSearchCmd.Flags().StringVarP(&xsearchSearch, "search", "S", "", "Search issues with keyword")
+	XSearchCmd.Flags().IntVarP(&xsearchLimit, "limit", "L", 30, "Maximum number of issues to fetch per profile (0 for all)")
+}
+
+// xsearchResult は1プロファイル分の検索結果
+type xsearchResult struct {
+	Profile string
+	Space   string
+	Issues  []backlog.Issue
+	Err     error
+}
+
+func runXSearch(c *cobra.Command, args []string) error {
+	cfg, err := cmdutil.GetConfigStore(c)
+	if err != nil {
+		return err
+	}
+	profiles, err := xsearchTargetProfiles(cfg.Profiles(), xsearchProfiles)
+	if err != nil {
+		return err
+	}
+
+	base := &api.IssueListOptions{Keyword: xsearchSearch}
+	switch xsearchState {
+	case "open":
+		base.StatusIDs = standardOpenStatusIDs
+	case "closed":
+		base.StatusIDs = standardClosedStatusIDs
+	case "all":
+	default:
+		return fmt.Errorf("invalid state: %s (must be open, closed, or all)", xsearchState)
+	}
+
+	ctx := c.Context()
+	results := make([]xsearchResult, len(profiles))
+	stopProgress := ui.StartProgress(fmt.Sprintf("Searching %d profile(s)...", len(profiles)))
+	var wg sync.WaitGroup
+	for i, name := range profiles {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = searchProfileIssues(ctx, cfg, name, *base)
+		}()
+	}
+	wg.Wait()
+	stopProgress()
+
+	var merged []xsearchRow
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			ui.Warning("skipped profile %s: %s", r.Profile, xsearchErrorMessage(r.Profile, r.Err))
+			continue
+		}
+		for i := range r.Issues {
+			merged = append(merged, xsearchRow{Profile: r.Profile, Space: r.Space, Issue: &r.Issues[i]})
+		}
+	}
+	if failed == len(results) {
+		return fmt.Errorf("no profile could be searched")
+	}
+	sortXSearchRows(merged)
+
+	profile := cfg.CurrentProfile()
+	if profile.Output == "json" {
+		data, err := xsearchJSON(merged)
+		if err != nil {
+			return err
+		}
+		return cmdutil.OutputJSONFromProfile(data, profile.JSONFields, profile.JQ, profile.Template)
+	}
+	if len(merged) == 0 {
+		fmt.Println("No issues found")
+		return nil
+	}
+
+	ui.SetHyperlinkEnabled(cfg.Display().Hyperlink)
+	table := ui.NewTable("PROFILE", "KEY", "STATUS", "PRIORITY", "ASSIGNEE", "SUMMARY", "UPDATED")
+	for _, row := range merged {
+		issue := row.Issue
+		key := issue.IssueKey.Value
+		assignee := "-"
+		if issue.Assignee.IsSet() && !issue.Assignee.IsNull() && issue.Assignee.Value.Name.IsSet() {
+			assignee = issue.Assignee.Value.Name.Value
+		}
+		table.AddRow(
+			row.Profile,
+			ui.Hyperlink(fmt.Sprintf("https://%s/view/%s", row.Space, key), key),
+			ui.StatusColor(issue.Status.Value.Name.Value),
+			ui.PriorityColor(issue.Priority.Value.Name.Value),
+			assignee,
+			issue.Summary.Value,
+			triageDate(issue.Updated.Value),
+		)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	return nil
+}
+
+// xsearchTargetProfiles は検索対象のプロファイル名を返す
+// spec が空の場合は space が設定されたすべてのプロファイル
+func xsearchTargetProfiles(all map[string]*config.ResolvedProfile, spec string) ([]string, error) {
+	var names []string
+	if strings.TrimSpace(spec) == "" {
+		for name, p := range all {
+			if p != nil && p.Space != "" {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no profile has a space\nRun 'backlog auth login' first")
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		p, ok := all[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown profile %q", name)
+		}
+		if p == nil || p.Space == "" {
+			return nil, fmt.Errorf("profile %q has no space", name)
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("--profiles is empty")
+	}
+	return names, nil
+}
+
+// searchProfileIssues は1プロファイルのスペースで課題を検索する
+func searchProfileIssues(ctx context.Context, cfg *config.Store, name string, opts api.IssueListOptions) xsearchResult {
+	result := xsearchResult{Profile: name}
+	if p := cfg.Profile(name); p != nil {
+		result.Space = p.Space
+	}
+	client, err := api.NewClientForProfile(cfg, name)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if xsearchAssignee != "" {
+		id, err := cmdutil.ResolveUserID(ctx, client, xsearchAssignee)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		opts.AssigneeIDs = []int{id}
+	}
+	opts.Sort = "updated"
+	opts.Order = "desc"
+	result.Issues, result.Err = paginateIssues(ctx, client, &opts, xsearchLimit)
+	return result
+}
+
+// xsearchErrorMessage はスキップしたプロファイルの理由を返す（認証切れの場合は再ログインを促す）
+func xsearchErrorMessage(profile string, err error) string {
+	if errors.Is(err, api.ErrNotAuthenticated) || api.IsUnauthorized(err) {
+		return fmt.Sprintf("authentication required (run 'backlog auth login --profile %s')", profile)
+	}
+	return err.Error()
+}
+
+// xsearchRow は統合した検索結果の1行
+type xsearchRow struct {
+	Profile string
+	Space   string
+	Issue   *backlog.Issue
+}
+
+// sortXSearchRows は更新日時の新しい順に並べる（同じ場合はプロファイル・課題キー順）
+func sortXSearchRows(rows []xsearchRow) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Issue.Updated.Value != b.Issue.Updated.Value {
+			return a.Issue.Updated.Value > b.Issue.Updated.Value
+		}
+		if a.Profile != b.Profile {
+			return a.Profile < b.Profile
+		}
+		return a.Issue.IssueKey.Value < b.Issue.IssueKey.Value
+	})
+}
+
+// xsearchJSON は課題の JSON に profile と space を加える
+func xsearchJSON(rows []xsearchRow) ([]map[string]any, error) {
+	data := make([]map[string]any, 0, len(rows))
+	for _, row := range rows {
+		b, err := json.Marshal(row.Issue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode issue: %w", err)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, fmt.Errorf("failed to encode issue: %w", err)
+		}
+		m["profile"] = row.Profile
+		m["space"] = row.Space
+		data = append(data, m)
+	}
+	return data, nil
+}
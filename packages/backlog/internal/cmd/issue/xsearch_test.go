@@ -0,0 +1,104 @@
+package issue
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestXSearchTargetProfiles(t *testing.T) {
+	all := map[string]*config.ResolvedProfile{
+		"default": {Space: "a.backlog.jp"},
+		"work":    {Space: "work.backlog.com"},
+		"empty":   {},
+	}
+	tests := []struct {
+		name    string
+		spec    string
+		want    []string
+		wantErr string
+	}{
+		{name: "all with space", spec: "", want: []string{"default", "work"}},
+		{name: "explicit order and duplicates", spec: "work, default,work", want: []string{"work", "default"}},
+		{name: "unknown", spec: "work,oss", wantErr: `unknown profile "oss"`},
+		{name: "no space", spec: "empty", wantErr: `profile "empty" has no space`},
+		{name: "only commas", spec: " , ", wantErr: "--profiles is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xsearchTargetProfiles(all, tt.spec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("xsearchTargetProfiles() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("xsearchTargetProfiles() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("xsearchTargetProfiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortXSearchRows(t *testing.T) {
+	issue := func(key, updated string) *backlog.Issue {
+		return &backlog.Issue{IssueKey: backlog.NewOptString(key), Updated: backlog.NewOptString(updated)}
+	}
+	rows := []xsearchRow{
+		{Profile: "work", Issue: issue("W-1", "2024-01-01T00:00:00Z")},
+		{Profile: "oss", Issue: issue("O-2", "2024-03-01T00:00:00Z")},
+		{Profile: "work", Issue: issue("W-2", "2024-03-01T00:00:00Z")},
+		{Profile: "oss", Issue: issue("O-1", "2024-02-01T00:00:00Z")},
+	}
+	sortXSearchRows(rows)
+	var got []string
+	for _, r := range rows {
+		got = append(got, r.Issue.IssueKey.Value)
+	}
+	if want := []string{"O-2", "W-2", "O-1", "W-1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+}
+
+func TestXSearchJSON(t *testing.T) {
+	rows := []xsearchRow{{
+		Profile: "work",
+		Space:   "work.backlog.com",
+		Issue:   &backlog.Issue{IssueKey: backlog.NewOptString("W-1")},
+	}}
+	data, err := xsearchJSON(rows)
+	if err != nil {
+		t.Fatalf("xsearchJSON() error = %v", err)
+	}
+	if len(data) != 1 || data[0]["profile"] != "work" || data[0]["space"] != "work.backlog.com" || data[0]["issueKey"] != "W-1" {
+		t.Errorf("xsearchJSON() = %v", data)
+	}
+}
+
+func TestXSearchErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "not logged in", err: api.ErrNotAuthenticated, want: "backlog auth login --profile work"},
+		{name: "expired", err: fmt.Errorf("failed: %w", &api.APIError{StatusCode: 401}), want: "backlog auth login --profile work"},
+		{name: "other", err: errors.New("boom"), want: "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := xsearchErrorMessage("work", tt.err); !strings.Contains(got, tt.want) {
+				t.Errorf("xsearchErrorMessage() = %q, want containing %q", got, tt.want)
+			}
+		})
+	}
+}
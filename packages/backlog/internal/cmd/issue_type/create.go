@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -166,7 +167,7 @@ func runIssueTypeCreate(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(issueType)
 	default:
-		ui.Success("種別を作成しました: %s (ID: %d)", issueType.Name, issueType.ID)
+		cmdutil.Success(strconv.Itoa(issueType.ID), "種別を作成しました: %s (ID: %d)", issueType.Name, issueType.ID)
 		return nil
 	}
 }
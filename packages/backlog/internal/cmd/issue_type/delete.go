@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -171,7 +172,7 @@ func runIssueTypeDelete(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(deletedIssueType)
 	default:
-		ui.Success("種別を削除しました: %s (ID: %d)", deletedIssueType.Name, deletedIssueType.ID)
+		cmdutil.Success(strconv.Itoa(deletedIssueType.ID), "種別を削除しました: %s (ID: %d)", deletedIssueType.Name, deletedIssueType.ID)
 		return nil
 	}
 }
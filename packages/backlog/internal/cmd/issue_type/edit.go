@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -167,7 +168,7 @@ func runIssueTypeEdit(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(updatedIssueType)
 	default:
-		ui.Success("種別を更新しました: %s (ID: %d)", updatedIssueType.Name, updatedIssueType.ID)
+		cmdutil.Success(strconv.Itoa(updatedIssueType.ID), "種別を更新しました: %s (ID: %d)", updatedIssueType.Name, updatedIssueType.ID)
 		return nil
 	}
 }
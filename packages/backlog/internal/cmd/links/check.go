@@ -0,0 +1,299 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Find broken internal links in issues and wiki pages",
+	Long: `Scan issue descriptions and wiki pages of the project and report
+Backlog internal references that no longer resolve:
+
+  - issue keys (PROJ-123) and issue URLs of deleted issues
+  - wiki links ([[Page]], wiki URLs) to renamed or deleted pages
+  - attachment references (#image(), #thumbnail(), #attach(), ![alt][file])
+    to files that are not attached to the page/issue
+
+Exits with a non-zero status when broken links are found, so it can be
+used to verify a markdown migration or in CI.
+
+Examples:
+  backlog links check --project PROJ
+  backlog links check --scope wiki
+  backlog links check -o json`,
+	RunE: runCheck,
+}
+
+var checkScope string
+
+func init() {
+	checkCmd.Flags().StringVar(&checkScope, "scope", "all", "What to scan: all, issue, wiki")
+}
+
+// BrokenLink は解決できなかった参照
+type BrokenLink struct {
+	SourceType string `json:"sourceType"`
+	Source     string `json:"source"`
+	URL        string `json:"url"`
+	Ref
+	Reason string `json:"reason"`
+}
+
+// document は検査対象の本文
+type document struct {
+	sourceType  string
+	source      string
+	url         string
+	content     string
+	attachments map[string]bool
+}
+
+func runCheck(c *cobra.Command, args []string) error {
+	switch checkScope {
+	case "all", "issue", "wiki":
+	default:
+		return fmt.Errorf("invalid --scope %q (must be all, issue or wiki)", checkScope)
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+
+	ctx := c.Context()
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	profile := cfg.CurrentProfile()
+	baseURL := fmt.Sprintf("https://%s", profile.Space)
+
+	project, err := client.GetProject(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	projects, err := client.GetProjects(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %w", err)
+	}
+	projectKeys := make(map[string]bool, len(projects))
+	for _, p := range projects {
+		projectKeys[p.ProjectKey] = true
+	}
+
+	r := &resolver{
+		client:     client,
+		projectKey: projectKey,
+		issueKeys:  make(map[string]bool),
+		issueCache: make(map[string]error),
+		wikiNames:  make(map[string]map[string]bool),
+		wikiErrors: make(map[string]error),
+	}
+
+	issues, err := fetchAllIssues(ctx, client, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+	for _, issue := range issues {
+		r.issueKeys[issue.IssueKey.Value] = true
+	}
+
+	var docs []document
+	if checkScope != "wiki" {
+		for _, issue := range issues {
+			docs = append(docs, document{
+				sourceType:  "issue",
+				source:      issue.IssueKey.Value,
+				url:         fmt.Sprintf("%s/view/%s", baseURL, issue.IssueKey.Value),
+				content:     issue.Description.Value,
+				attachments: attachmentNames(issue.Attachments),
+			})
+		}
+	}
+	if checkScope != "issue" {
+		wikis, err := client.GetWikisWithContent(ctx, projectKey, api.WikiContentOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get wiki pages: %w", err)
+		}
+		names := make(map[string]bool, len(wikis))
+		for _, w := range wikis {
+			names[w.Name] = true
+		}
+		r.wikiNames[projectKey] = names
+
+		for _, page := range wikis {
+			atts := make(map[string]bool, len(page.Attachments))
+			for _, a := range page.Attachments {
+				atts[a.Name] = true
+			}
+			docs = append(docs, document{
+				sourceType:  "wiki",
+				source:      page.Name,
+				url:         fmt.Sprintf("%s/alias/wiki/%d", baseURL, page.ID),
+				content:     page.Content,
+				attachments: atts,
+			})
+		}
+	}
+
+	broken := make([]BrokenLink, 0)
+	for _, doc := range docs {
+		for _, ref := range ExtractRefs(doc.content, projectKeys, profile.Space) {
+			if reason := r.resolve(ctx, doc, ref); reason != "" {
+				broken = append(broken, BrokenLink{
+					SourceType: doc.sourceType,
+					Source:     doc.source,
+					URL:        doc.url,
+					Ref:        ref,
+					Reason:     reason,
+				})
+			}
+		}
+	}
+
+	switch profile.Output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(broken); err != nil {
+			return err
+		}
+	default:
+		if len(broken) == 0 {
+			cmdutil.Success("", "No broken links found (%d documents checked)", len(docs))
+			return nil
+		}
+		table := ui.NewTable("SOURCE", "LINE", "KIND", "TARGET", "REASON")
+		for _, b := range broken {
+			target := b.Target
+			if b.Project != "" && b.Project != projectKey {
+				target = b.Project + ":" + target
+			}
+			table.AddRow(b.Source, strconv.Itoa(b.Line), string(b.Kind), target, b.Reason)
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	}
+
+	if len(broken) > 0 {
+		return fmt.Errorf("%d broken link(s) found in %d documents", len(broken), len(docs))
+	}
+	return nil
+}
+
+// resolver は参照先の存在確認を行う（API 呼び出し結果をキャッシュする）
+type resolver struct {
+	client     *api.Client
+	projectKey string
+	issueKeys  map[string]bool
+	issueCache map[string]error
+	wikiNames  map[string]map[string]bool
+	wikiErrors map[string]error
+}
+
+// resolve は参照が解決できない場合にその理由を返す
+func (r *resolver) resolve(ctx context.Context, doc document, ref Ref) string {
+	switch ref.Kind {
+	case RefIssue:
+		if r.issueKeys[ref.Target] {
+			return ""
+		}
+		err, ok := r.issueCache[ref.Target]
+		if !ok {
+			_, err = r.client.GetIssue(ctx, ref.Target)
+			r.issueCache[ref.Target] = err
+		}
+		switch {
+		case err == nil:
+			return ""
+		case api.IsNotFound(err):
+			return "issue not found"
+		case api.IsPermissionDenied(err):
+			return "issue not accessible"
+		default:
+			return fmt.Sprintf("cannot check issue: %v", err)
+		}
+
+	case RefWiki:
+		project := ref.Project
+		if project == "" {
+			project = r.projectKey
+		}
+		names, err := r.wikiNamesFor(ctx, project)
+		if err != nil {
+			return fmt.Sprintf("cannot list wiki pages of %s: %v", project, err)
+		}
+		if !names[ref.Target] {
+			return "wiki page not found"
+		}
+		return ""
+
+	case RefAttachment:
+		if !doc.attachments[ref.Target] {
+			return "attachment not found"
+		}
+		return ""
+	}
+	return ""
+}
+
+func (r *resolver) wikiNamesFor(ctx context.Context, projectKey string) (map[string]bool, error) {
+	if names, ok := r.wikiNames[projectKey]; ok {
+		return names, nil
+	}
+	if err, ok := r.wikiErrors[projectKey]; ok {
+		return nil, err
+	}
+	wikis, err := r.client.GetWikis(ctx, projectKey, "")
+	if err != nil {
+		r.wikiErrors[projectKey] = err
+		return nil, err
+	}
+	names := make(map[string]bool, len(wikis))
+	for _, w := range wikis {
+		names[w.Name] = true
+	}
+	r.wikiNames[projectKey] = names
+	return names, nil
+}
+
+func attachmentNames(attachments []backlog.Attachment) map[string]bool {
+	names := make(map[string]bool, len(attachments))
+	for _, a := range attachments {
+		names[a.Name.Value] = true
+	}
+	return names
+}
+
+func fetchAllIssues(ctx context.Context, client *api.Client, projectID int) ([]backlog.Issue, error) {
+	all := make([]backlog.Issue, 0)
+	offset := 0
+	for {
+		issues, err := client.GetIssues(ctx, &api.IssueListOptions{
+			ProjectIDs: []int{projectID},
+			Offset:     offset,
+			Count:      100,
+			Order:      "asc",
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, issues...)
+		if len(issues) < 100 {
+			break
+		}
+		offset += 100
+	}
+	return all, nil
+}
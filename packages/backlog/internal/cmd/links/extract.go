@@ -0,0 +1,122 @@
+package links
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// RefKind は参照の種類
+type RefKind string
+
+const (
+	RefIssue      RefKind = "issue"
+	RefWiki       RefKind = "wiki"
+	RefAttachment RefKind = "attachment"
+)
+
+// Ref は本文中の Backlog 内部参照
+type Ref struct {
+	Kind RefKind `json:"kind"`
+	// Project は参照先のプロジェクトキー（wiki のみ。同一プロジェクトなら空）
+	Project string `json:"project,omitempty"`
+	Target  string `json:"target"`
+	Line    int    `json:"line"`
+}
+
+var (
+	reIssueKey    = regexp.MustCompile(`\b([A-Z][A-Z0-9_]*-[1-9][0-9]*)\b`)
+	reWikiBracket = regexp.MustCompile(`\[\[([^\]]+?)\]\]`)
+	reAttachMacro = regexp.MustCompile(`#(?:image|thumbnail|attach)\(([^)]+)\)`)
+	reMarkdownImg = regexp.MustCompile(`!\[[^\]]*\]\[([^\]]+)\]`)
+	reCodeSpan    = regexp.MustCompile("`[^`]*`")
+)
+
+// ExtractRefs は本文から課題キー・Wiki 名・添付参照を抽出する
+// projectKeys は存在するプロジェクトキーの集合で、課題キーの誤検知
+// （"UTF-8" など）を避けるためにプレフィックスの照合に使う。
+// spaceHost を指定すると、そのスペースの課題/Wiki URL も参照として扱う。
+func ExtractRefs(text string, projectKeys map[string]bool, spaceHost string) []Ref {
+	var refs []Ref
+	seen := make(map[Ref]bool)
+	add := func(r Ref) {
+		if !seen[r] {
+			seen[r] = true
+			refs = append(refs, r)
+		}
+	}
+
+	var urlPattern *regexp.Regexp
+	if spaceHost != "" {
+		urlPattern = regexp.MustCompile(`https?://` + regexp.QuoteMeta(spaceHost) + `/(view|wiki)/([^\s)\]>"']+)`)
+	}
+
+	inCode := false
+	for i, line := range strings.Split(text, "\n") {
+		lineNo := i + 1
+		trimmed := strings.TrimSpace(line)
+		// コードブロック内の記述は参照とみなさない
+		if strings.HasPrefix(trimmed, "```") || trimmed == "{code}" || strings.HasPrefix(trimmed, "{code:") || trimmed == "{/code}" {
+			inCode = !inCode && trimmed != "{/code}"
+			continue
+		}
+		if inCode {
+			continue
+		}
+		line = reCodeSpan.ReplaceAllString(line, "")
+
+		if urlPattern != nil {
+			for _, m := range urlPattern.FindAllStringSubmatch(line, -1) {
+				switch m[1] {
+				case "view":
+					key := strings.SplitN(m[2], "#", 2)[0]
+					add(Ref{Kind: RefIssue, Target: key, Line: lineNo})
+				case "wiki":
+					parts := strings.SplitN(m[2], "/", 2)
+					if len(parts) != 2 || parts[1] == "" {
+						continue
+					}
+					name, err := url.PathUnescape(strings.SplitN(parts[1], "#", 2)[0])
+					if err != nil {
+						continue
+					}
+					add(Ref{Kind: RefWiki, Project: parts[0], Target: name, Line: lineNo})
+				}
+			}
+			line = urlPattern.ReplaceAllString(line, "")
+		}
+
+		for _, m := range reWikiBracket.FindAllStringSubmatch(line, -1) {
+			target := m[1]
+			// [[別名>ページ名]] / [[別名:URL]] 形式
+			if idx := strings.LastIndex(target, ">"); idx >= 0 {
+				target = target[idx+1:]
+			}
+			target = strings.TrimSpace(target)
+			if target == "" || strings.Contains(target, "://") {
+				continue
+			}
+			add(Ref{Kind: RefWiki, Target: target, Line: lineNo})
+		}
+		line = reWikiBracket.ReplaceAllString(line, "")
+
+		for _, re := range []*regexp.Regexp{reAttachMacro, reMarkdownImg} {
+			for _, m := range re.FindAllStringSubmatch(line, -1) {
+				name := strings.TrimSpace(m[1])
+				if name == "" || strings.Contains(name, "://") {
+					continue
+				}
+				add(Ref{Kind: RefAttachment, Target: name, Line: lineNo})
+			}
+		}
+
+		for _, m := range reIssueKey.FindAllStringSubmatch(line, -1) {
+			key := m[1]
+			prefix := key[:strings.LastIndex(key, "-")]
+			if projectKeys[prefix] {
+				add(Ref{Kind: RefIssue, Target: key, Line: lineNo})
+			}
+		}
+	}
+	return refs
+}
@@ -0,0 +1,73 @@
+package links
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractRefs(t *testing.T) {
+	projects := map[string]bool{"PROJ": true, "OTHER": true}
+
+	tests := []struct {
+		name string
+		text string
+		want []Ref
+	}{
+		{
+			name: "issue keys",
+			text: "See PROJ-12 and OTHER-3.\nUTF-8 is not an issue",
+			want: []Ref{
+				{Kind: RefIssue, Target: "PROJ-12", Line: 1},
+				{Kind: RefIssue, Target: "OTHER-3", Line: 1},
+			},
+		},
+		{
+			name: "wiki brackets",
+			text: "[[Home]] and [[手順>Setup/Guide]] and [[site:https://example.com]]",
+			want: []Ref{
+				{Kind: RefWiki, Target: "Home", Line: 1},
+				{Kind: RefWiki, Target: "Setup/Guide", Line: 1},
+			},
+		},
+		{
+			name: "attachments",
+			text: "#image(shot.png)\n![capture][diagram.png]\n#attach(spec.pdf)",
+			want: []Ref{
+				{Kind: RefAttachment, Target: "shot.png", Line: 1},
+				{Kind: RefAttachment, Target: "diagram.png", Line: 2},
+				{Kind: RefAttachment, Target: "spec.pdf", Line: 3},
+			},
+		},
+		{
+			name: "space urls",
+			text: "https://example.backlog.jp/view/PROJ-5#comment-1\nhttps://example.backlog.jp/wiki/OTHER/Release%20Notes",
+			want: []Ref{
+				{Kind: RefIssue, Target: "PROJ-5", Line: 1},
+				{Kind: RefWiki, Project: "OTHER", Target: "Release Notes", Line: 2},
+			},
+		},
+		{
+			name: "code is ignored",
+			text: "```\nPROJ-1\n```\n`PROJ-2` PROJ-3",
+			want: []Ref{
+				{Kind: RefIssue, Target: "PROJ-3", Line: 4},
+			},
+		},
+		{
+			name: "duplicates on same line",
+			text: "PROJ-1 PROJ-1",
+			want: []Ref{
+				{Kind: RefIssue, Target: "PROJ-1", Line: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractRefs(tt.text, projects, "example.backlog.jp")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractRefs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package links
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var LinksCmd = &cobra.Command{
+	Use:   "links",
+	Short: "Inspect links in issues and wiki pages",
+	Long:  "Work with Backlog internal links contained in issue descriptions and wiki pages.",
+}
+
+func init() {
+	LinksCmd.AddCommand(checkCmd)
+}
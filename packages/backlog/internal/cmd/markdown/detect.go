@@ -0,0 +1,216 @@
+package markdown
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/markdown"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	detectFormat string
+	detectTypes  []string
+)
+
+var detectCmd = &cobra.Command{
+	Use:   "detect",
+	Short: "Report the notation of all issues and wiki pages in a project",
+	Long: `Detect whether each issue description and wiki page of the project is
+written in Backlog notation or Markdown, and report the detected mode, score,
+the conversion rules that would be applied and the warnings.
+
+Only read APIs are used and no migration workspace is created, so it can be
+run before "markdown migrate init" to estimate the migration effort.
+
+Examples:
+  backlog markdown detect --project PROJ
+  backlog markdown detect --project PROJ --format csv > detect.csv
+  backlog markdown detect --project PROJ --types wiki -o json`,
+	Args:        cobra.NoArgs,
+	RunE:        runDetect,
+	Annotations: cmdutil.AutoSave(),
+}
+
+func init() {
+	detectCmd.Flags().StringVar(&detectFormat, "format", "table", "Report format: {table|csv}")
+	detectCmd.Flags().StringSliceVar(&detectTypes, "types", nil, "Target types (issue,wiki). Default: all")
+	MarkdownCmd.AddCommand(detectCmd)
+}
+
+// detectRow は1件の記法判定結果
+type detectRow struct {
+	ItemType string                       `json:"item_type"`
+	ItemKey  string                       `json:"item_key"`
+	URL      string                       `json:"url"`
+	Mode     markdown.Mode                `json:"mode"`
+	Score    int                          `json:"score"`
+	Lines    int                          `json:"lines"`
+	Rules    []markdown.RuleID            `json:"rules"`
+	Warnings map[markdown.WarningType]int `json:"warnings"`
+}
+
+func runDetect(cmd *cobra.Command, args []string) error {
+	if detectFormat != "table" && detectFormat != "csv" {
+		return fmt.Errorf("invalid --format %q (must be table or csv)", detectFormat)
+	}
+	allowedTypes := normalizeTypes(detectTypes)
+	for t := range allowedTypes {
+		if t != "issue" && t != "wiki" {
+			return fmt.Errorf("invalid --types %q (must be issue or wiki)", t)
+		}
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(cmd)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	baseURL := fmt.Sprintf("https://%s", cfg.CurrentProfile().Space)
+	rules, err := resolveRuleProfile(cfg)
+	if err != nil {
+		return err
+	}
+
+	rows := make([]detectRow, 0)
+	if typeAllowed(allowedTypes, "issue") {
+		project, err := client.GetProject(ctx, projectKey)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+		stop := ui.StartProgress("Fetching issues...")
+		issues, err := fetchAllIssues(ctx, client, project.ID)
+		stop()
+		if err != nil {
+			return fmt.Errorf("failed to get issues: %w", err)
+		}
+		for _, issue := range issues {
+			key := optStringValue(issue.IssueKey)
+			if key == "" {
+				continue
+			}
+			rows = append(rows, detectItem("issue", key, fmt.Sprintf("%s/view/%s", baseURL, key), optStringValue(issue.Description), rules))
+		}
+	}
+	if typeAllowed(allowedTypes, "wiki") {
+		stop := ui.StartProgress("Fetching wiki pages...")
+		wikis, err := client.GetWikisWithContent(ctx, projectKey, api.WikiContentOptions{})
+		stop()
+		if err != nil {
+			return fmt.Errorf("failed to get wikis: %w", err)
+		}
+		for _, w := range wikis {
+			rows = append(rows, detectItem("wiki", w.Name, fmt.Sprintf("%s/alias/wiki/%d", baseURL, w.ID), w.Content, rules))
+		}
+	}
+
+	if cfg.CurrentProfile().Output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+	if detectFormat == "csv" {
+		if err := writeDetectCSV(os.Stdout, rows); err != nil {
+			return err
+		}
+	} else {
+		table := ui.NewTable("TYPE", "ITEM", "MODE", "SCORE", "LINES", "RULES", "WARNINGS")
+		for _, r := range rows {
+			table.AddRow(r.ItemType, r.ItemKey, string(r.Mode), strconv.Itoa(r.Score), strconv.Itoa(r.Lines), joinRules(r.Rules), formatDetectWarnings(r.Warnings))
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	}
+	if !cmdutil.IsQuiet() {
+		fmt.Fprintln(os.Stderr, summarizeDetect(rows))
+	}
+	return nil
+}
+
+// detectItem は本文の記法を判定し、変換時に適用されるルールと警告を集める
+// 変換結果は捨て、判定結果だけを返す
+func detectItem(itemType, itemKey, url, content string, rules *markdown.RuleProfile) detectRow {
+	opts := markdown.ConvertOptions{ItemType: itemType, ItemKey: itemKey, URL: url}
+	rules.Apply(&opts)
+	result := markdown.Convert(content, opts)
+	lines := 0
+	if content != "" {
+		lines = strings.Count(content, "\n") + 1
+	}
+	return detectRow{
+		ItemType: itemType,
+		ItemKey:  itemKey,
+		URL:      url,
+		Mode:     result.Mode,
+		Score:    result.Score,
+		Lines:    lines,
+		Rules:    result.Rules,
+		Warnings: result.Warnings,
+	}
+}
+
+// writeDetectCSV は判定結果を CSV で書き出す（ルール・警告は ";" 区切り）
+func writeDetectCSV(out io.Writer, rows []detectRow) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"type", "key", "url", "mode", "score", "lines", "rules", "warnings"}); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err := w.Write([]string{
+			r.ItemType,
+			r.ItemKey,
+			r.URL,
+			string(r.Mode),
+			strconv.Itoa(r.Score),
+			strconv.Itoa(r.Lines),
+			joinRules(r.Rules),
+			formatDetectWarnings(r.Warnings),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func joinRules(rules []markdown.RuleID) string {
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = string(r)
+	}
+	return strings.Join(names, ";")
+}
+
+// formatDetectWarnings は警告を "name=count" の ";" 区切りで返す（名前順）
+func formatDetectWarnings(warnings map[markdown.WarningType]int) string {
+	parts := make([]string, 0, len(warnings))
+	for name, count := range warnings {
+		if count > 0 {
+			parts = append(parts, fmt.Sprintf("%s=%d", name, count))
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+// summarizeDetect は記法ごとの件数を1行にまとめる
+func summarizeDetect(rows []detectRow) string {
+	counts := make(map[markdown.Mode]int)
+	for _, r := range rows {
+		counts[r.Mode]++
+	}
+	return fmt.Sprintf("%d items: backlog %d, markdown %d, unknown %d",
+		len(rows), counts[markdown.ModeBacklog], counts[markdown.ModeMarkdown], counts[markdown.ModeUnknown])
+}
@@ -0,0 +1,56 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/markdown"
+)
+
+func TestDetectItem(t *testing.T) {
+	row := detectItem("wiki", "Spec", "https://example.backlog.jp/alias/wiki/1", "* Title\n{code}x{/code}\n''bold''", nil)
+	if row.Mode != markdown.ModeBacklog {
+		t.Fatalf("Mode = %s, want backlog", row.Mode)
+	}
+	if row.Lines != 3 {
+		t.Errorf("Lines = %d, want 3", row.Lines)
+	}
+	if !strings.Contains(joinRules(row.Rules), string(markdown.RuleHeadingAsterisk)) {
+		t.Errorf("Rules = %v, want heading_asterisk", row.Rules)
+	}
+
+	empty := detectItem("issue", "PROJ-1", "", "", nil)
+	if empty.Lines != 0 || empty.Mode != markdown.ModeUnknown {
+		t.Errorf("empty = %+v, want 0 lines and unknown mode", empty)
+	}
+}
+
+func TestWriteDetectCSV(t *testing.T) {
+	rows := []detectRow{{
+		ItemType: "issue",
+		ItemKey:  "PROJ-1",
+		URL:      "https://example.backlog.jp/view/PROJ-1",
+		Mode:     markdown.ModeBacklog,
+		Score:    4,
+		Lines:    10,
+		Rules:    []markdown.RuleID{markdown.RuleHeadingAsterisk, markdown.RuleCodeBlock},
+		Warnings: map[markdown.WarningType]int{markdown.WarningTableHeaderH: 1, markdown.WarningColorMacro: 2, markdown.WarningTableCellMerge: 0},
+	}}
+	var buf bytes.Buffer
+	if err := writeDetectCSV(&buf, rows); err != nil {
+		t.Fatal(err)
+	}
+	want := "type,key,url,mode,score,lines,rules,warnings\n" +
+		"issue,PROJ-1,https://example.backlog.jp/view/PROJ-1,backlog,4,10,heading_asterisk;code_block,color_macro=2;table_header_h=1\n"
+	if buf.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestSummarizeDetect(t *testing.T) {
+	rows := []detectRow{{Mode: markdown.ModeBacklog}, {Mode: markdown.ModeBacklog}, {Mode: markdown.ModeMarkdown}}
+	if got, want := summarizeDetect(rows), "3 items: backlog 2, markdown 1, unknown 0"; got != want {
+		t.Errorf("summarizeDetect() = %q, want %q", got, want)
+	}
+}
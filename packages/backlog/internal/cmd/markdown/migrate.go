@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -21,6 +22,7 @@ import (
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/markdown"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -33,15 +35,22 @@ This command suite supports init/apply/rollback steps to control API usage and
 resume safely. Workspace data is stored in
 the current directory unless --dir (or -w) is provided.
 
+The workspace history is kept in a git repository. By default the git command
+is used when it is in PATH, and the built-in implementation otherwise
+(--git external|embedded selects one explicitly).
+
 Examples:
   backlog markdown migrate init <projectKey>
+  backlog markdown migrate check
+  backlog markdown migrate preview --serve
   backlog markdown migrate apply
   backlog markdown migrate rollback
   backlog markdown migrate list
   backlog markdown migrate logs
   backlog markdown migrate status
   backlog markdown migrate clean
-  backlog markdown migrate snapshot --append`,
+  backlog markdown migrate snapshot --append
+  backlog markdown migrate single PROJ-123 --dry-run`,
 }
 
 var migrateInitCmd = &cobra.Command{
@@ -56,6 +65,10 @@ var (
 	applyAuto      bool
 	applyTypes     []string
 	applyDryRun    bool
+
+	applyProgressFlag     bool
+	applyProgressLog      string
+	applyProgressInterval time.Duration
 )
 
 var migrateApplyCmd = &cobra.Command{
@@ -115,6 +128,8 @@ var migrateSnapshotCmd = &cobra.Command{
 }
 
 var listDiff bool
+var listStatuses []string
+var listTypes []string
 var migrateLogsLimit int
 var migrateWorkspaceDir string
 var migrateCleanForce bool
@@ -123,15 +138,21 @@ var snapshotAppend bool
 
 func init() {
 	migrateCmd.PersistentFlags().StringVarP(&migrateWorkspaceDir, "dir", "w", "", "Migration workspace directory (defaults to current directory)")
+	migrateCmd.PersistentFlags().StringVar(&migrateGitMode, "git", gitModeAuto, "Git backend for the workspace history: auto, external (git command) or embedded (built-in)")
 	migrateApplyCmd.Flags().BoolVar(&applyForceLock, "force-lock", false, "Remove existing lock and retry")
 	migrateApplyCmd.Flags().BoolVar(&applyAuto, "auto", false, "Apply changes without confirmation")
 	migrateApplyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "Show diffs without applying changes")
-	migrateApplyCmd.Flags().StringSliceVar(&applyTypes, "types", nil, "Apply target types (issue,wiki,issue_type). Default: all")
+	migrateApplyCmd.Flags().BoolVar(&applyProgressFlag, "progress", false, "Show processed/total count, average time per item and ETA on stderr")
+	migrateApplyCmd.Flags().StringVar(&applyProgressLog, "progress-log", "", "Append periodic progress snapshots to a JSON Lines file")
+	migrateApplyCmd.Flags().DurationVar(&applyProgressInterval, "progress-interval", 30*time.Second, "Interval between --progress-log snapshots")
+	migrateApplyCmd.Flags().StringSliceVar(&applyTypes, "types", nil, "Apply target types (issue,wiki,issue_type,issue_type_description,issue_type_summary). Default: all")
 	migrateRollbackCmd.Flags().BoolVar(&rollbackForceLock, "force-lock", false, "Remove existing lock and retry")
 	migrateRollbackCmd.Flags().BoolVar(&rollbackAuto, "auto", false, "Rollback without confirmation")
 	migrateRollbackCmd.Flags().StringSliceVar(&rollbackTargets, "targets", nil, "Rollback target item keys (issue key, wiki id, issue type id)")
-	migrateRollbackCmd.Flags().StringSliceVar(&rollbackTypes, "types", nil, "Rollback target types (issue,wiki,issue_type). Default: all")
+	migrateRollbackCmd.Flags().StringSliceVar(&rollbackTypes, "types", nil, "Rollback target types (issue,wiki,issue_type,issue_type_description,issue_type_summary). Default: all")
 	migrateListCmd.Flags().BoolVar(&listDiff, "diff", false, "Show diffs for changed items")
+	migrateListCmd.Flags().StringSliceVar(&listStatuses, "status", nil, "Filter by status (pending,applied,error,rolled_back)")
+	migrateListCmd.Flags().StringSliceVar(&listTypes, "type", nil, "Filter by item type (issue,wiki,issue_type,issue_type_description,issue_type_summary)")
 	migrateLogsCmd.Flags().IntVar(&migrateLogsLimit, "limit", 0, "Limit number of log entries (0 = all)")
 	migrateLogsCmd.Flags().BoolVar(&migrateLogsAll, "all", false, "Include no-change entries")
 	migrateCleanCmd.Flags().BoolVar(&migrateCleanForce, "force", false, "Remove workspace without confirmation")
@@ -149,6 +170,10 @@ func init() {
 }
 
 func runMigrateInit(cmd *cobra.Command, args []string) error {
+	// Backlog から取得し始める前に、ワークスペースの管理に使う git バックエンドが使えるか確認する
+	if err := requireGit(); err != nil {
+		return err
+	}
 	client, cfg, err := cmdutil.GetAPIClient(cmd)
 	if err != nil {
 		return err
@@ -234,17 +259,20 @@ func runMigrateInit(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	if _, err := os.Stat(filepath.Join(dir, "items.jsonl")); err == nil {
+	if itemsStoreExists(dir) {
 		fmt.Printf("Workspace already initialized: %s\n", dir)
 		return nil
-	} else if !errors.Is(err, os.ErrNotExist) {
-		return fmt.Errorf("stat items file: %w", err)
 	}
 
 	baseURL := fmt.Sprintf("https://%s", cfg.CurrentProfile().Space)
 	fmt.Printf("Snapshotting issues and wikis from %s...\n", baseURL)
 	items, err := snapshotAll(cmd.Context(), client, projectKey, project.ID, dir, baseURL)
 	if err != nil {
+		// items.db は書き込まないため、再実行すると最初からスナップショットを取り直す
+		if cmdutil.Interrupted(cmd.Context()) {
+			ui.Warning("Snapshot interrupted; run the same command again to restart it")
+			return cmdutil.ErrInterrupted
+		}
 		return err
 	}
 	fmt.Printf("Snapshot complete: %d items\n", len(items))
@@ -252,7 +280,7 @@ func runMigrateInit(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	fmt.Println("Writing items metadata...")
-	if err := gitAdd(dir, "items.jsonl", "metadata.json", "issue", "wiki", "issue-type"); err != nil {
+	if err := gitAdd(dir, itemsDBFile, "metadata.json", "issue", "wiki", "issue-type"); err != nil {
 		return err
 	}
 	if gitHasChanges(dir) {
@@ -284,7 +312,10 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("metadata missing project key")
 	}
 	ctx := cmd.Context()
-	unsafeRules := buildUnsafeRuleSet(cfg.Display().MarkdownUnsafeRules)
+	rules, err := resolveRuleProfile(cfg)
+	if err != nil {
+		return err
+	}
 
 	baseBranch, err := ensureMigrationRepo(dir, true)
 	if err != nil {
@@ -357,6 +388,33 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 	allowedTypes := normalizeTypes(applyTypes)
 	anyChanges := false
 
+	total := 0
+	for _, item := range items {
+		if item.ItemType != "comment" && typeAllowed(allowedTypes, item.ItemType) {
+			total++
+		}
+	}
+	var progressOut io.Writer
+	if applyProgressFlag {
+		progressOut = os.Stderr
+	}
+	var progressLog io.Writer
+	if applyProgressLog != "" {
+		file, err := os.OpenFile(applyProgressLog, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("open progress log: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		progressLog = file
+	}
+	progress := newApplyProgress(total, progressOut, progressLog, applyProgressInterval)
+	defer progress.close()
+	// recordApply は項目の処理結果を所要時間付きでログに記録し、進捗を更新する
+	recordApply := func(entry migrateLogEntry) {
+		entry.DurationMs = progress.finish(entry).Milliseconds()
+		_ = appendMigrateLog(dir, entry)
+	}
+
 	for i := range items {
 		item := &items[i]
 		if item.ItemType == "comment" {
@@ -365,6 +423,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 		if !typeAllowed(allowedTypes, item.ItemType) {
 			continue
 		}
+		progress.begin()
 
 		path, err := resolveItemPath(dir, item)
 		if err != nil {
@@ -378,7 +437,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 		current, err := fetchCurrentItem(ctx, client, item)
 		if err != nil {
 			errMsg := err.Error()
-			_ = appendMigrateLog(dir, migrateLogEntry{
+			recordApply(migrateLogEntry{
 				Action:   "apply",
 				Status:   "error",
 				ItemType: item.ItemType,
@@ -391,6 +450,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 		}
 
 		nameChanged := item.ItemType == "wiki" && current.Name != "" && current.Name != item.ItemKey
+		item.Attachments = current.Attachments
 
 		raw := current.Content
 		if hashHex(raw) != item.InputHash {
@@ -409,10 +469,10 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 					return err
 				}
 			}
-			if err := writeItems(dir, items); err != nil {
+			if err := saveItem(dir, item); err != nil {
 				return err
 			}
-			addPaths := []string{path, "items.jsonl"}
+			addPaths := []string{path, itemsDBFile}
 			if item.ItemType == "wiki" {
 				addPaths = append(addPaths, filepath.Join("wiki", fmt.Sprintf("%d", item.ItemID), "metadata.json"))
 			}
@@ -434,10 +494,10 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 					return err
 				}
 			}
-			if err := writeItems(dir, items); err != nil {
+			if err := saveItem(dir, item); err != nil {
 				return err
 			}
-			addPaths := []string{"items.jsonl"}
+			addPaths := []string{itemsDBFile}
 			if item.ItemType == "wiki" {
 				addPaths = append(addPaths, filepath.Join("wiki", fmt.Sprintf("%d", item.ItemID), "metadata.json"))
 			}
@@ -452,10 +512,10 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		converted, changed, err := applyConversion(item, raw, current.Attachments, unsafeRules)
+		converted, changed, err := applyConversion(item, raw, current.Attachments, rules)
 		if err != nil {
 			errMsg := err.Error()
-			_ = appendMigrateLog(dir, migrateLogEntry{
+			recordApply(migrateLogEntry{
 				Action:   "apply",
 				Status:   "error",
 				ItemType: item.ItemType,
@@ -467,7 +527,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 			continue
 		}
 		if !changed || converted == currentDisk {
-			_ = appendMigrateLog(dir, migrateLogEntry{
+			recordApply(migrateLogEntry{
 				Action:   "apply",
 				Status:   "no_change",
 				ItemType: item.ItemType,
@@ -478,7 +538,23 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		if !applyAuto {
+		// preview で同じ変換内容をレビュー済みなら、その判断に従う
+		review := item.reviewDecision(hashHex(raw), hashHex(converted))
+		if review == reviewRejected {
+			recordApply(migrateLogEntry{
+				Action:   "apply",
+				Status:   "rejected",
+				ItemType: item.ItemType,
+				ItemKey:  item.ItemKey,
+				URL:      item.URL,
+				Message:  "rejected in preview",
+			})
+			skipped++
+			continue
+		}
+		if !applyAuto && review == reviewApproved {
+			fmt.Printf("%s %s: approved in preview\n", item.ItemType, item.ItemKey)
+		} else if !applyAuto {
 			if err := printContentDiff(currentDisk, converted); err != nil {
 				return err
 			}
@@ -493,7 +569,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 			switch choice {
 			case "approve":
 			case "reject":
-				_ = appendMigrateLog(dir, migrateLogEntry{
+				recordApply(migrateLogEntry{
 					Action:   "apply",
 					Status:   "rejected",
 					ItemType: item.ItemType,
@@ -503,7 +579,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 				skipped++
 				continue
 			case "skip":
-				_ = appendMigrateLog(dir, migrateLogEntry{
+				recordApply(migrateLogEntry{
 					Action:   "apply",
 					Status:   "skipped",
 					ItemType: item.ItemType,
@@ -540,7 +616,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 				if !applyAuto {
 					fmt.Printf("Failed %s %s: %s\n", item.ItemType, item.ItemKey, errMsg)
 				}
-				_ = appendMigrateLog(dir, migrateLogEntry{
+				recordApply(migrateLogEntry{
 					Action:   "apply",
 					Status:   "error",
 					ItemType: item.ItemType,
@@ -570,10 +646,10 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 			item.AppliedAt = time.Now().Format(time.RFC3339)
 		}
 		item.ApplyError = ""
-		if err := writeItems(dir, items); err != nil {
+		if err := saveItem(dir, item); err != nil {
 			return err
 		}
-		if err := gitAdd(dir, path, "items.jsonl"); err != nil {
+		if err := gitAdd(dir, path, itemsDBFile); err != nil {
 			return err
 		}
 		if gitHasChanges(dir) {
@@ -588,7 +664,7 @@ func runMigrateApply(cmd *cobra.Command, args []string) error {
 		} else {
 			applied++
 		}
-		_ = appendMigrateLog(dir, migrateLogEntry{
+		recordApply(migrateLogEntry{
 			Action:   "apply",
 			Status:   status,
 			ItemType: item.ItemType,
@@ -833,10 +909,10 @@ func runMigrateRollback(cmd *cobra.Command, args []string) error {
 			item.UpdatedAt = updatedAt
 		}
 
-		if err := writeItems(dir, items); err != nil {
+		if err := saveItem(dir, item); err != nil {
 			return err
 		}
-		if err := gitAdd(dir, path, "items.jsonl"); err != nil {
+		if err := gitAdd(dir, path, itemsDBFile); err != nil {
 			return err
 		}
 		if gitHasChanges(dir) {
@@ -875,7 +951,12 @@ func runMigrateList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("load metadata: %w", err)
 	}
 
-	items, err := readItems(dir)
+	filter, err := newMigrateListFilter(listStatuses, listTypes)
+	if err != nil {
+		return err
+	}
+	where, whereArgs := filter.where(!listDiff)
+	items, err := queryItems(dir, where, whereArgs)
 	if err != nil {
 		return err
 	}
@@ -887,20 +968,11 @@ func runMigrateList(cmd *cobra.Command, args []string) error {
 
 	for i := range items {
 		item := &items[i]
-		if item.ItemType == "comment" {
-			continue
-		}
-		if !listDiff && !item.Changed {
-			continue
-		}
 		path, err := resolveItemPath(dir, item)
 		if err != nil {
 			return err
 		}
-		status := "pending"
-		if item.Applied {
-			status = "applied"
-		}
+		status := migrateItemStatus(item)
 		fmt.Printf("%s\t%s\t%s\t%s\n", status, item.ItemType, item.ItemKey, item.URL)
 		if listDiff {
 			if err := printGitDiff(dir, path); err != nil {
@@ -960,7 +1032,12 @@ func runMigrateLogs(cmd *cobra.Command, args []string) error {
 
 	for _, entry := range entries {
 		ts := entry.TS.Format(time.RFC3339)
-		fmt.Printf("%s\t%s\t%s\t%s\t%s\n", ts, entry.Action, entry.Status, entry.ItemType, entry.ItemKey)
+		if entry.DurationMs > 0 {
+			took := time.Duration(entry.DurationMs) * time.Millisecond
+			fmt.Printf("%s\t%s\t%s\t%s\t%s\t%s\n", ts, entry.Action, entry.Status, entry.ItemType, entry.ItemKey, formatProgressDuration(took))
+		} else {
+			fmt.Printf("%s\t%s\t%s\t%s\t%s\n", ts, entry.Action, entry.Status, entry.ItemType, entry.ItemKey)
+		}
 		if entry.URL != "" {
 			fmt.Printf("  %s\n", entry.URL)
 		}
@@ -1117,7 +1194,7 @@ func runMigrateSnapshot(cmd *cobra.Command, args []string) error {
 	items, err := readItems(dir)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			return fmt.Errorf("items.jsonl not found; run init first")
+			return fmt.Errorf("%s not found; run init first", itemsDBFile)
 		}
 		return err
 	}
@@ -1129,121 +1206,138 @@ func runMigrateSnapshot(cmd *cobra.Command, args []string) error {
 	}
 	baseURL := fmt.Sprintf("https://%s", cfg.CurrentProfile().Space)
 
+	ctx := cmd.Context()
 	newItems := make([]migrateItem, 0)
-	issues, err := fetchAllIssues(cmd.Context(), client, project.ID)
-	if err != nil {
-		return err
-	}
-	for _, issue := range issues {
-		if !issue.IssueKey.IsSet() || issue.IssueKey.Value == "" {
-			continue
-		}
-		issueKey := issue.IssueKey.Value
-		if existing[identityKey("issue", issueKey, 0)] {
-			continue
-		}
-		detail, err := client.GetIssue(cmd.Context(), issueKey)
+	// Ctrl+C で中断された場合も取得済みの項目は保存し、再実行で続きから追記できるようにする
+	fetchErr := func() error {
+		issues, err := fetchAllIssues(ctx, client, project.ID)
 		if err != nil {
-			return fmt.Errorf("failed to get issue %s: %w", issueKey, err)
-		}
-		if !detail.ID.IsSet() {
-			return fmt.Errorf("issue %s has no id", issueKey)
-		}
-		content := optStringValue(detail.Description)
-		path := itemContentPath(dir, "issue", issueKey, detail.ID.Value)
-		if err := writeItemContent(path, content); err != nil {
 			return err
 		}
-		url := fmt.Sprintf("%s/view/%s", baseURL, issueKey)
-		item := migrateItem{
-			ItemType:   "issue",
-			ItemID:     detail.ID.Value,
-			ItemKey:    issueKey,
-			URL:        url,
-			ProjectKey: meta.ProjectKey,
-			Path:       path,
-			FetchedAt:  time.Now().Format(time.RFC3339),
-			UpdatedAt:  optStringValue(detail.Updated),
-			InputHash:  hashHex(content),
-		}
-		items = append(items, item)
-		newItems = append(newItems, item)
-		existing[identityKey(item.ItemType, item.ItemKey, item.ItemID)] = true
-	}
-
-	wikis, err := client.GetWikis(cmd.Context(), meta.ProjectKey, "")
-	if err != nil {
-		return fmt.Errorf("failed to get wikis: %w", err)
-	}
-	for _, wiki := range wikis {
-		key := identityKey("wiki", "", wiki.ID)
-		if existing[key] {
-			continue
+		for _, issue := range issues {
+			if !issue.IssueKey.IsSet() || issue.IssueKey.Value == "" {
+				continue
+			}
+			issueKey := issue.IssueKey.Value
+			if existing[identityKey("issue", issueKey, 0)] {
+				continue
+			}
+			detail, err := client.GetIssue(ctx, issueKey)
+			if err != nil {
+				return fmt.Errorf("failed to get issue %s: %w", issueKey, err)
+			}
+			if !detail.ID.IsSet() {
+				return fmt.Errorf("issue %s has no id", issueKey)
+			}
+			content := optStringValue(detail.Description)
+			path := itemContentPath(dir, "issue", issueKey, detail.ID.Value)
+			if err := writeItemContent(path, content); err != nil {
+				return err
+			}
+			url := fmt.Sprintf("%s/view/%s", baseURL, issueKey)
+			item := migrateItem{
+				ItemType:   "issue",
+				ItemID:     detail.ID.Value,
+				ItemKey:    issueKey,
+				URL:        url,
+				ProjectKey: meta.ProjectKey,
+				Path:       path,
+				FetchedAt:  time.Now().Format(time.RFC3339),
+				UpdatedAt:  optStringValue(detail.Updated),
+				InputHash:  hashHex(content),
+			}
+			items = append(items, item)
+			newItems = append(newItems, item)
+			existing[identityKey(item.ItemType, item.ItemKey, item.ItemID)] = true
 		}
-		full, err := client.GetWiki(cmd.Context(), wiki.ID)
+
+		wikis, err := client.GetWikis(ctx, meta.ProjectKey, "")
 		if err != nil {
-			return fmt.Errorf("failed to get wiki %d: %w", wiki.ID, err)
-		}
-		content := full.Content
-		path := itemContentPath(dir, "wiki", full.Name, full.ID)
-		if err := writeItemContent(path, content); err != nil {
-			return err
+			return fmt.Errorf("failed to get wikis: %w", err)
 		}
-		url := fmt.Sprintf("%s/alias/wiki/%d", baseURL, wiki.ID)
-		if err := writeWikiMetadata(dir, full.ID, full.Name, url, full.Updated); err != nil {
+		// スナップショットに無いページだけ本文を取得する
+		wikis, err = client.FetchWikiContents(ctx, wikis, api.WikiContentOptions{
+			Need: func(w api.Wiki) bool { return !existing[identityKey("wiki", "", w.ID)] },
+		})
+		if err != nil {
 			return err
 		}
-		item := migrateItem{
-			ItemType:   "wiki",
-			ItemID:     full.ID,
-			ItemKey:    full.Name,
-			URL:        url,
-			ProjectKey: meta.ProjectKey,
-			Path:       path,
-			FetchedAt:  time.Now().Format(time.RFC3339),
-			UpdatedAt:  full.Updated,
-			InputHash:  hashHex(content),
+		for _, full := range wikis {
+			key := identityKey("wiki", "", full.ID)
+			if existing[key] {
+				continue
+			}
+			content := full.Content
+			path := itemContentPath(dir, "wiki", full.Name, full.ID)
+			if err := writeItemContent(path, content); err != nil {
+				return err
+			}
+			url := fmt.Sprintf("%s/alias/wiki/%d", baseURL, full.ID)
+			if err := writeWikiMetadata(dir, full.ID, full.Name, url, full.Updated); err != nil {
+				return err
+			}
+			item := migrateItem{
+				ItemType:   "wiki",
+				ItemID:     full.ID,
+				ItemKey:    full.Name,
+				URL:        url,
+				ProjectKey: meta.ProjectKey,
+				Path:       path,
+				FetchedAt:  time.Now().Format(time.RFC3339),
+				UpdatedAt:  full.Updated,
+				InputHash:  hashHex(content),
+			}
+			items = append(items, item)
+			newItems = append(newItems, item)
+			existing[identityKey(item.ItemType, item.ItemKey, item.ItemID)] = true
 		}
-		items = append(items, item)
-		newItems = append(newItems, item)
-		existing[identityKey(item.ItemType, item.ItemKey, item.ItemID)] = true
-	}
 
-	issueTypes, err := client.GetIssueTypes(cmd.Context(), meta.ProjectKey)
-	if err != nil {
-		return fmt.Errorf("failed to get issue types: %w", err)
-	}
-	for _, issueType := range issueTypes {
-		key := identityKey("issue_type_description", "", issueType.ID)
-		if existing[key] {
-			continue
-		}
-		content := issueType.TemplateDescription
-		path := itemContentPath(dir, "issue_type_description", issueType.Name, issueType.ID)
-		if err := writeItemContent(path, content); err != nil {
-			return err
-		}
-		url := fmt.Sprintf("%s/EditIssueType.action?projectId=%d", baseURL, project.ID)
-		if err := writeIssueTypeMetadata(dir, issueType.ID, issueType.Name, url, ""); err != nil {
-			return err
+		issueTypes, err := client.GetIssueTypes(ctx, meta.ProjectKey)
+		if err != nil {
+			return fmt.Errorf("failed to get issue types: %w", err)
 		}
-		item := migrateItem{
-			ItemType:   "issue_type_description",
-			ItemID:     issueType.ID,
-			ItemKey:    issueType.Name,
-			URL:        url,
-			ProjectKey: meta.ProjectKey,
-			Path:       path,
-			FetchedAt:  time.Now().Format(time.RFC3339),
-			UpdatedAt:  "",
-			InputHash:  hashHex(content),
+		for _, issueType := range issueTypes {
+			for _, itemType := range issueTypeItemTypes {
+				key := identityKey(itemType, "", issueType.ID)
+				if existing[key] {
+					continue
+				}
+				content := issueTypeTemplate(issueType, itemType)
+				path := itemContentPath(dir, itemType, issueType.Name, issueType.ID)
+				if err := writeItemContent(path, content); err != nil {
+					return err
+				}
+				url := fmt.Sprintf("%s/EditIssueType.action?projectId=%d", baseURL, project.ID)
+				if err := writeIssueTypeMetadata(dir, issueType.ID, issueType.Name, url, ""); err != nil {
+					return err
+				}
+				item := migrateItem{
+					ItemType:   itemType,
+					ItemID:     issueType.ID,
+					ItemKey:    issueType.Name,
+					URL:        url,
+					ProjectKey: meta.ProjectKey,
+					Path:       path,
+					FetchedAt:  time.Now().Format(time.RFC3339),
+					UpdatedAt:  "",
+					InputHash:  hashHex(content),
+				}
+				items = append(items, item)
+				newItems = append(newItems, item)
+				existing[identityKey(item.ItemType, item.ItemKey, item.ItemID)] = true
+			}
 		}
-		items = append(items, item)
-		newItems = append(newItems, item)
-		existing[identityKey(item.ItemType, item.ItemKey, item.ItemID)] = true
+		return nil
+	}()
+	interrupted := fetchErr != nil && cmdutil.Interrupted(ctx)
+	if fetchErr != nil && !interrupted {
+		return fetchErr
 	}
 
 	if len(newItems) == 0 {
+		if interrupted {
+			return cmdutil.ErrInterrupted
+		}
 		fmt.Println("No new items to append.")
 		return nil
 	}
@@ -1254,7 +1348,7 @@ func runMigrateSnapshot(cmd *cobra.Command, args []string) error {
 	if err := touchMetadata(dir); err != nil {
 		return err
 	}
-	if err := gitAdd(dir, "items.jsonl", "metadata.json", "issue", "wiki", "issue-type"); err != nil {
+	if err := gitAdd(dir, itemsDBFile, "metadata.json", "issue", "wiki", "issue-type"); err != nil {
 		return err
 	}
 	if gitHasChanges(dir) {
@@ -1264,6 +1358,10 @@ func runMigrateSnapshot(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("Appended %d items.\n", len(newItems))
+	if interrupted {
+		ui.Warning("Snapshot interrupted; run 'backlog markdown migrate snapshot --append' again to continue")
+		return cmdutil.ErrInterrupted
+	}
 	return nil
 }
 
@@ -1312,6 +1410,8 @@ type migrateLogEntry struct {
 	ItemKey  string    `json:"item_key,omitempty"`
 	URL      string    `json:"url,omitempty"`
 	Message  string    `json:"message,omitempty"`
+	// DurationMs は apply における項目ごとの所要時間（ミリ秒）
+	DurationMs int64 `json:"duration_ms,omitempty"`
 }
 
 type migrateItem struct {
@@ -1340,12 +1440,10 @@ type migrateItem struct {
 	ApplyCommit    string                         `json:"apply_commit,omitempty"`
 	RollbackAt     string                         `json:"rollback_at,omitempty"`
 	RollbackError  string                         `json:"rollback_error,omitempty"`
-}
-
-type lockInfo struct {
-	PID  int    `json:"pid"`
-	Time string `json:"time"`
-	Cmd  string `json:"cmd"`
+	// Attachments は取得時点の添付ファイル名（preview で apply と同じ変換をするために記録する）
+	Attachments []string `json:"attachments,omitempty"`
+	// Review は migrate preview で記録したレビュー結果
+	Review *migrateReview `json:"review,omitempty"`
 }
 
 type currentItem struct {
@@ -1393,22 +1491,33 @@ func ensureMigrationRepo(dir string, ensureGitignoreFlag bool) (string, error) {
 
 func ensureGitignore(dir string) error {
 	path := filepath.Join(dir, ".gitignore")
-	entry := "logs.jsonl"
+	entries := append([]string{"logs.jsonl"}, itemsDBSidecars...)
 	data, err := os.ReadFile(path)
-	if err == nil {
-		if strings.Contains(string(data), entry) {
-			return nil
-		}
-	}
 	if err != nil && !errors.Is(err, os.ErrNotExist) {
 		return fmt.Errorf("read gitignore: %w", err)
 	}
+	existing := make(map[string]bool)
+	for _, line := range strings.Split(string(data), "\n") {
+		existing[strings.TrimSpace(line)] = true
+	}
+	missing := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !existing[entry] {
+			missing = append(missing, entry)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
 	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
 	if err != nil {
 		return fmt.Errorf("write gitignore: %w", err)
 	}
 	defer func() { _ = f.Close() }()
-	if _, err := fmt.Fprintln(f, entry); err != nil {
+	if len(data) > 0 && !strings.HasSuffix(string(data), "\n") {
+		missing[0] = "\n" + missing[0]
+	}
+	if _, err := fmt.Fprintln(f, strings.Join(missing, "\n")); err != nil {
 		return fmt.Errorf("append gitignore: %w", err)
 	}
 	return nil
@@ -1434,6 +1543,9 @@ func itemContentPath(dir, itemType, itemKey string, itemID int) string {
 	if itemType == "issue_type_description" && itemID > 0 {
 		return filepath.Join(dir, "issue-type", fmt.Sprintf("%d", itemID), "description.md")
 	}
+	if itemType == "issue_type_summary" && itemID > 0 {
+		return filepath.Join(dir, "issue-type", fmt.Sprintf("%d", itemID), "summary.md")
+	}
 	return filepath.Join(dir, itemType, safePath(itemKey), "content.md")
 }
 
@@ -1532,7 +1644,7 @@ func finalizeApplyState(dir string, items []migrateItem) error {
 	if err := touchMetadata(dir); err != nil {
 		return err
 	}
-	if err := gitAdd(dir, "items.jsonl", "metadata.json"); err != nil {
+	if err := gitAdd(dir, itemsDBFile, "metadata.json"); err != nil {
 		return err
 	}
 	if gitHasChanges(dir) {
@@ -1568,27 +1680,24 @@ func snapshotAll(ctx context.Context, client *api.Client, projectKey string, pro
 			return nil, err
 		}
 		items = append(items, migrateItem{
-			ItemType:   "issue",
-			ItemID:     detail.ID.Value,
-			ItemKey:    issueKey,
-			URL:        url,
-			ProjectKey: projectKey,
-			Path:       path,
-			FetchedAt:  time.Now().Format(time.RFC3339),
-			UpdatedAt:  optStringValue(detail.Updated),
-			InputHash:  hashHex(content),
+			ItemType:    "issue",
+			ItemID:      detail.ID.Value,
+			ItemKey:     issueKey,
+			URL:         url,
+			ProjectKey:  projectKey,
+			Path:        path,
+			FetchedAt:   time.Now().Format(time.RFC3339),
+			UpdatedAt:   optStringValue(detail.Updated),
+			InputHash:   hashHex(content),
+			Attachments: attachmentNamesFromBacklog(detail.Attachments),
 		})
 	}
-	wikis, err := client.GetWikis(ctx, projectKey, "")
+	wikis, err := client.GetWikisWithContent(ctx, projectKey, api.WikiContentOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get wikis: %w", err)
 	}
-	for _, wiki := range wikis {
-		full, err := client.GetWiki(ctx, wiki.ID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get wiki %d: %w", wiki.ID, err)
-		}
-		url := fmt.Sprintf("%s/alias/wiki/%d", baseURL, wiki.ID)
+	for _, full := range wikis {
+		url := fmt.Sprintf("%s/alias/wiki/%d", baseURL, full.ID)
 		content := full.Content
 		path := itemContentPath(dir, "wiki", full.Name, full.ID)
 		if err := writeItemContent(path, content); err != nil {
@@ -1598,15 +1707,16 @@ func snapshotAll(ctx context.Context, client *api.Client, projectKey string, pro
 			return nil, err
 		}
 		items = append(items, migrateItem{
-			ItemType:   "wiki",
-			ItemID:     full.ID,
-			ItemKey:    full.Name,
-			URL:        url,
-			ProjectKey: projectKey,
-			Path:       path,
-			FetchedAt:  time.Now().Format(time.RFC3339),
-			UpdatedAt:  full.Updated,
-			InputHash:  hashHex(content),
+			ItemType:    "wiki",
+			ItemID:      full.ID,
+			ItemKey:     full.Name,
+			URL:         url,
+			ProjectKey:  projectKey,
+			Path:        path,
+			FetchedAt:   time.Now().Format(time.RFC3339),
+			UpdatedAt:   full.Updated,
+			InputHash:   hashHex(content),
+			Attachments: attachmentNamesFromAPI(full.Attachments),
 		})
 	}
 	issueTypes, err := client.GetIssueTypes(ctx, projectKey)
@@ -1615,25 +1725,27 @@ func snapshotAll(ctx context.Context, client *api.Client, projectKey string, pro
 	}
 	for _, issueType := range issueTypes {
 		url := fmt.Sprintf("%s/EditIssueType.action?projectId=%d", baseURL, projectID)
-		description := issueType.TemplateDescription
-		descriptionPath := itemContentPath(dir, "issue_type_description", issueType.Name, issueType.ID)
-		if err := writeItemContent(descriptionPath, description); err != nil {
-			return nil, err
-		}
 		if err := writeIssueTypeMetadata(dir, issueType.ID, issueType.Name, url, ""); err != nil {
 			return nil, err
 		}
-		items = append(items, migrateItem{
-			ItemType:   "issue_type_description",
-			ItemID:     issueType.ID,
-			ItemKey:    issueType.Name,
-			URL:        url,
-			ProjectKey: projectKey,
-			Path:       descriptionPath,
-			FetchedAt:  time.Now().Format(time.RFC3339),
-			UpdatedAt:  "",
-			InputHash:  hashHex(description),
-		})
+		for _, itemType := range issueTypeItemTypes {
+			content := issueTypeTemplate(issueType, itemType)
+			path := itemContentPath(dir, itemType, issueType.Name, issueType.ID)
+			if err := writeItemContent(path, content); err != nil {
+				return nil, err
+			}
+			items = append(items, migrateItem{
+				ItemType:   itemType,
+				ItemID:     issueType.ID,
+				ItemKey:    issueType.Name,
+				URL:        url,
+				ProjectKey: projectKey,
+				Path:       path,
+				FetchedAt:  time.Now().Format(time.RFC3339),
+				UpdatedAt:  "",
+				InputHash:  hashHex(content),
+			})
+		}
 	}
 	return items, nil
 }
@@ -1663,88 +1775,6 @@ func appendMigrateLog(dir string, entry migrateLogEntry) error {
 	return nil
 }
 
-func gitInit(dir string) error {
-	_, err := runGit(dir, "init")
-	return err
-}
-
-func gitCurrentBranch(dir string) (string, error) {
-	out, err := runGit(dir, "rev-parse", "--abbrev-ref", "HEAD")
-	if err != nil {
-		return "", err
-	}
-	return strings.TrimSpace(out), nil
-}
-
-func gitHasChanges(dir string) bool {
-	out, err := runGit(dir, "status", "--porcelain")
-	if err != nil {
-		return false
-	}
-	return strings.TrimSpace(out) != ""
-}
-
-func gitAdd(dir string, paths ...string) error {
-	args := append([]string{"add", "--"}, paths...)
-	_, err := runGit(dir, args...)
-	return err
-}
-
-func gitCommit(dir, message string) error {
-	_, err := runGit(dir, "commit", "-m", message)
-	return err
-}
-
-func gitCommitAllowEmpty(dir, message string) error {
-	_, err := runGit(dir, "commit", "--allow-empty", "-m", message)
-	return err
-}
-
-func gitCheckout(dir, branch string) error {
-	_, err := runGit(dir, "checkout", branch)
-	return err
-}
-
-func gitCheckoutNewBranch(dir, branch string) error {
-	_, err := runGit(dir, "checkout", "-b", branch)
-	return err
-}
-
-func gitMergeSquash(dir, branch string) error {
-	_, err := runGit(dir, "merge", "--squash", branch)
-	return err
-}
-
-func gitMergeNoFF(dir, branch string) error {
-	_, err := runGit(dir, "merge", "--no-ff", branch)
-	return err
-}
-
-func gitResetSoftTo(dir, ref string) error {
-	_, err := runGit(dir, "reset", "--soft", ref)
-	return err
-}
-
-func gitCheckoutNewBranchFrom(dir, branch, startPoint string) error {
-	_, err := runGit(dir, "checkout", "-b", branch, startPoint)
-	return err
-}
-
-func gitDeleteBranch(dir, branch string) error {
-	_, err := runGit(dir, "branch", "-D", branch)
-	return err
-}
-
-func gitBranchExists(dir, branch string) bool {
-	_, err := runGit(dir, "show-ref", "--verify", fmt.Sprintf("refs/heads/%s", branch))
-	return err == nil
-}
-
-func gitHasCommits(dir string) bool {
-	_, err := runGit(dir, "rev-parse", "--verify", "HEAD")
-	return err == nil
-}
-
 func resolveBaseBranch(dir, preferred string) (string, error) {
 	if preferred != "" && gitBranchExists(dir, preferred) {
 		return preferred, nil
@@ -1812,86 +1842,12 @@ func matchesRollbackTarget(item *migrateItem, targets map[string]bool) bool {
 	if item.ItemType == "wiki" {
 		return targets[fmt.Sprintf("%d", item.ItemID)]
 	}
-	if item.ItemType == "issue_type_description" {
+	if isIssueTypeItem(item.ItemType) {
 		return targets[fmt.Sprintf("%d", item.ItemID)]
 	}
 	return false
 }
 
-func buildUnsafeRuleSet(values []string) map[markdown.RuleID]bool {
-	if len(values) == 0 {
-		return nil
-	}
-	allowed := make(map[markdown.RuleID]bool, len(values))
-	for _, rule := range values {
-		rule = strings.TrimSpace(rule)
-		if rule == "" {
-			continue
-		}
-		allowed[markdown.RuleID(rule)] = true
-	}
-	return allowed
-}
-
-func gitFileCommits(dir, path string, limit int) ([]string, error) {
-	args := []string{"log", "--pretty=format:%H", "--"}
-	if limit > 0 {
-		args = append([]string{"log", "--pretty=format:%H", fmt.Sprintf("-%d", limit), "--"}, path)
-	} else {
-		args = append(args, path)
-	}
-	out, err := runGit(dir, args...)
-	if err != nil {
-		return nil, err
-	}
-	lines := strings.Fields(out)
-	return lines, nil
-}
-
-func gitDiff(dir, from, to, path string) error {
-	cmd := exec.Command("git", "diff", from, to, "--", path)
-	cmd.Dir = dir
-	out, err := cmd.CombinedOutput()
-	if len(out) > 0 {
-		fmt.Print(string(out))
-	}
-	if err == nil {
-		return nil
-	}
-	var exitErr *exec.ExitError
-	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
-		return nil
-	}
-	return fmt.Errorf("git diff failed: %w", err)
-}
-
-func gitFirstCommitForFile(dir, path string) (string, error) {
-	out, err := runGit(dir, "log", "--reverse", "--pretty=format:%H", "--", path)
-	if err != nil {
-		return "", err
-	}
-	lines := strings.Fields(out)
-	if len(lines) == 0 {
-		return "", nil
-	}
-	return lines[0], nil
-}
-
-func gitCheckoutFile(dir, commit, path string) error {
-	_, err := runGit(dir, "checkout", commit, "--", path)
-	return err
-}
-
-func runGit(dir string, args ...string) (string, error) {
-	cmd := exec.Command("git", args...)
-	cmd.Dir = dir
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return string(out), fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
-	}
-	return string(out), nil
-}
-
 func ensureMetadata(dir, projectKey, projectName, baseBranch string) error {
 	path := filepath.Join(dir, "metadata.json")
 	if _, err := os.Stat(path); err == nil {
@@ -1939,87 +1895,7 @@ func touchMetadata(dir string) error {
 }
 
 func acquireLock(dir string, force bool) (func() error, error) {
-	path := filepath.Join(dir, "lock")
-	if force {
-		_ = os.Remove(path)
-	}
-	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
-	if err != nil {
-		if errors.Is(err, os.ErrExist) {
-			return nil, fmt.Errorf("lock already exists: %s", path)
-		}
-		return nil, fmt.Errorf("create lock: %w", err)
-	}
-
-	info := lockInfo{PID: os.Getpid(), Time: time.Now().Format(time.RFC3339), Cmd: strings.Join(os.Args, " ")}
-	data, _ := json.Marshal(info)
-	_, _ = file.Write(append(data, '\n'))
-	_ = file.Close()
-
-	return func() error { return os.Remove(path) }, nil
-}
-
-func writeItems(dir string, items []migrateItem) error {
-	path := filepath.Join(dir, "items.jsonl")
-	tmp := path + ".tmp"
-	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
-	if err != nil {
-		return fmt.Errorf("open items file: %w", err)
-	}
-	enc := json.NewEncoder(file)
-	for _, item := range items {
-		if err := enc.Encode(item); err != nil {
-			return fmt.Errorf("write items: %w", err)
-		}
-	}
-	if err := file.Close(); err != nil {
-		return fmt.Errorf("close items file: %w", err)
-	}
-	if err := os.Rename(tmp, path); err != nil {
-		return fmt.Errorf("replace items file: %w", err)
-	}
-	return nil
-}
-
-func readItems(dir string) ([]migrateItem, error) {
-	path := filepath.Join(dir, "items.jsonl")
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("open items file: %w", err)
-	}
-	defer func() { _ = file.Close() }()
-
-	scanner := bufio.NewScanner(file)
-	buf := make([]byte, 0, 64*1024)
-	scanner.Buffer(buf, 1024*1024)
-
-	items := make([]migrateItem, 0)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-		var item migrateItem
-		if err := json.Unmarshal([]byte(line), &item); err != nil {
-			return nil, fmt.Errorf("decode item: %w", err)
-		}
-		items = append(items, item)
-	}
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("scan items: %w", err)
-	}
-	return items, nil
-}
-
-func readItemsIfExists(dir string) ([]migrateItem, error) {
-	path := filepath.Join(dir, "items.jsonl")
-	if _, err := os.Stat(path); err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("stat items file: %w", err)
-	}
-	return readItems(dir)
+	return osutil.AcquireLock(filepath.Join(dir, "lock"), force)
 }
 
 func fetchAllIssues(ctx context.Context, client *api.Client, projectID int) ([]backlog.Issue, error) {
@@ -2112,13 +1988,13 @@ func fetchCurrentItem(ctx context.Context, client *api.Client, item *migrateItem
 			Attachments: attachmentNamesFromAPI(wiki.Attachments),
 			Name:        wiki.Name,
 		}, nil
-	case "issue_type_description":
+	case "issue_type_description", "issue_type_summary":
 		issueType, err := getIssueType(ctx, client, item.ProjectKey, item.ItemID)
 		if err != nil {
 			return nil, err
 		}
 		return &currentItem{
-			Content:     issueType.TemplateDescription,
+			Content:     issueTypeTemplate(issueType, item.ItemType),
 			Updated:     "",
 			Attachments: nil,
 		}, nil
@@ -2127,6 +2003,22 @@ func fetchCurrentItem(ctx context.Context, client *api.Client, item *migrateItem
 	}
 }
 
+// issueTypeItemTypes は課題種別のうち変換対象とするテンプレート項目
+var issueTypeItemTypes = []string{"issue_type_description", "issue_type_summary"}
+
+// isIssueTypeItem は課題種別のテンプレート項目かどうかを返す
+func isIssueTypeItem(itemType string) bool {
+	return strings.HasPrefix(strings.ToLower(itemType), "issue_type_")
+}
+
+// issueTypeTemplate は項目の種類に対応する課題種別のテンプレートを返す
+func issueTypeTemplate(issueType api.IssueType, itemType string) string {
+	if itemType == "issue_type_summary" {
+		return issueType.TemplateSummary
+	}
+	return issueType.TemplateDescription
+}
+
 var issueTypeCache = map[string]map[int]api.IssueType{}
 
 func getIssueType(ctx context.Context, client *api.Client, projectKey string, issueTypeID int) (api.IssueType, error) {
@@ -2185,6 +2077,9 @@ func applyItem(ctx context.Context, client *api.Client, item *migrateItem, conte
 	case "issue_type_description":
 		_, err := client.UpdateIssueType(ctx, item.ProjectKey, item.ItemID, &api.UpdateIssueTypeInput{TemplateDescription: &content})
 		return "", err
+	case "issue_type_summary":
+		_, err := client.UpdateIssueType(ctx, item.ProjectKey, item.ItemID, &api.UpdateIssueTypeInput{TemplateSummary: &content})
+		return "", err
 	default:
 		return "", fmt.Errorf("unknown item type: %s", item.ItemType)
 	}
@@ -2343,9 +2238,9 @@ func formatRules(rules []markdown.RuleID) string {
 	return strings.Join(keys, ", ")
 }
 
-func applyConversion(item *migrateItem, content string, attachments []string, unsafeRules map[markdown.RuleID]bool) (string, bool, error) {
+func applyConversion(item *migrateItem, content string, attachments []string, rules *markdown.RuleProfile) (string, bool, error) {
 	force := item.ConvertForce
-	result := markdown.Convert(content, markdown.ConvertOptions{
+	opts := markdown.ConvertOptions{
 		Force:           force,
 		ItemType:        item.ItemType,
 		ItemID:          item.ItemID,
@@ -2354,8 +2249,9 @@ func applyConversion(item *migrateItem, content string, attachments []string, un
 		ItemKey:         item.ItemKey,
 		URL:             item.URL,
 		AttachmentNames: attachments,
-		UnsafeRules:     unsafeRules,
-	})
+	}
+	rules.Apply(&opts)
+	result := markdown.Convert(content, opts)
 
 	changed := result.Output != content
 	item.DetectedMode = result.Mode
@@ -2406,8 +2302,8 @@ func identityKey(itemType, itemKey string, itemID int) string {
 		return "issue:" + itemKey
 	case "wiki":
 		return "wiki:" + fmt.Sprintf("%d", itemID)
-	case "issue_type_description":
-		return "issue_type_description:" + fmt.Sprintf("%d", itemID)
+	case "issue_type_description", "issue_type_summary":
+		return strings.ToLower(itemType) + ":" + fmt.Sprintf("%d", itemID)
 	default:
 		return strings.ToLower(itemType) + ":" + itemKey
 	}
@@ -2422,6 +2318,7 @@ func inheritApplyState(item migrateItem, existing migrateItem) migrateItem {
 	item.ApplyError = existing.ApplyError
 	item.RollbackAt = existing.RollbackAt
 	item.RollbackError = existing.RollbackError
+	item.Review = existing.Review
 	return item
 }
 
@@ -2455,11 +2352,13 @@ func normalizeTargets(values []string) map[string]bool {
 	return targets
 }
 
+// typeAllowed は --types の指定に項目の種類が含まれるかを返す
+// issue_type は課題種別のテンプレート項目すべて、issue_type_summary などは個別の項目に一致する
 func typeAllowed(allowed map[string]bool, itemType string) bool {
 	if len(allowed) == 0 {
 		return true
 	}
-	return allowed[normalizedItemType(itemType)]
+	return allowed[strings.ToLower(itemType)] || allowed[normalizedItemType(itemType)]
 }
 
 func promptApplyDecision() (string, error) {
@@ -2518,8 +2417,6 @@ func safePath(value string) string {
 }
 
 var _ = []any{
-	gitMergeSquash,
-	gitResetSoftTo,
 	readItemsIfExists,
 	fetchAllComments,
 	isSourceMatch,
@@ -0,0 +1,126 @@
+package markdown
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/markdown"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var checkTypes []string
+
+var migrateCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check attachment references before apply",
+	Long: `Convert each item with its current attachments and report problems
+that would remain after apply:
+
+  - missing_attachment: ![alt][name] whose name is not an attachment of the
+    issue/wiki (and has no link definition)
+  - unresolved_macro:   #image() / #thumbnail() / #attach() left unconverted
+
+The workspace is not modified. Exits with a non-zero status when problems
+are found.
+
+Examples:
+  backlog markdown migrate check
+  backlog markdown migrate check --types wiki
+  backlog markdown migrate check -o json`,
+	Args: cobra.NoArgs,
+	RunE: runMigrateCheck,
+}
+
+func init() {
+	migrateCheckCmd.Flags().StringSliceVar(&checkTypes, "types", nil, "Check target types (issue,wiki,issue_type,issue_type_description,issue_type_summary). Default: all")
+	migrateCmd.AddCommand(migrateCheckCmd)
+}
+
+// migrateRefIssue は apply 前検証で見つかった添付参照の問題
+type migrateRefIssue struct {
+	ItemType string `json:"item_type"`
+	ItemKey  string `json:"item_key"`
+	URL      string `json:"url"`
+	markdown.RefIssue
+}
+
+func runMigrateCheck(cmd *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	dir, err := migrationDir()
+	if err != nil {
+		return err
+	}
+	if _, err := loadMetadata(dir); err != nil {
+		return fmt.Errorf("load metadata: %w", err)
+	}
+	items, err := readItems(dir)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	rules, err := resolveRuleProfile(cfg)
+	if err != nil {
+		return err
+	}
+	allowedTypes := normalizeTypes(checkTypes)
+
+	issues := make([]migrateRefIssue, 0)
+	checked := 0
+	for i := range items {
+		// 変換結果の統計を items.jsonl に書き戻さないようコピーを使う
+		item := items[i]
+		if item.ItemType == "comment" || !typeAllowed(allowedTypes, item.ItemType) {
+			continue
+		}
+		current, err := fetchCurrentItem(ctx, client, &item)
+		if err != nil {
+			return fmt.Errorf("fetch %s %s: %w", item.ItemType, item.ItemKey, err)
+		}
+		converted, _, err := applyConversion(&item, current.Content, current.Attachments, rules)
+		if err != nil {
+			return err
+		}
+		checked++
+		for _, ref := range markdown.CheckAttachmentRefs(converted, current.Attachments) {
+			issues = append(issues, migrateRefIssue{
+				ItemType: item.ItemType,
+				ItemKey:  item.ItemKey,
+				URL:      item.URL,
+				RefIssue: ref,
+			})
+		}
+	}
+
+	switch cfg.CurrentProfile().Output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(issues); err != nil {
+			return err
+		}
+	default:
+		if len(issues) == 0 {
+			cmdutil.Success("", "No attachment reference problems found (%d items checked)", checked)
+			return nil
+		}
+		table := ui.NewTable("TYPE", "ITEM", "LINE", "PROBLEM", "TEXT")
+		for _, issue := range issues {
+			table.AddRow(issue.ItemType, issue.ItemKey, strconv.Itoa(issue.Line), string(issue.Type), issue.Text)
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("%d attachment reference problem(s) found in %d items", len(issues), checked)
+	}
+	return nil
+}
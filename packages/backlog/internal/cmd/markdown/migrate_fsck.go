@@ -0,0 +1,430 @@
+package markdown
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	fsckFix       bool
+	fsckForceLock bool
+)
+
+var migrateFsckCmd = &cobra.Command{
+	Use:   "fsck",
+	Short: "Check workspace consistency and repair it",
+	Long: `Check that items.db, the content files on disk and the git history of
+the workspace agree with each other, and propose a repair for each problem:
+
+  - path_mismatch: the path recorded in items.db differs from the expected path
+  - missing_file:  the content file of an item does not exist
+  - hash_mismatch: the content file differs from the hash recorded in items.db
+  - orphan_file:   a content file that no item refers to
+  - untracked:     a content file whose current state is not committed to git
+
+Without --fix nothing is modified. With --fix the proposed repairs are applied
+and committed as a single "fsck" commit. Content is restored from the git
+history when a version matching items.db exists; otherwise the problem is
+reported as requiring manual action (e.g. re-run "migrate snapshot").
+
+Exits with a non-zero status when unresolved problems remain.
+
+Examples:
+  backlog markdown migrate fsck
+  backlog markdown migrate fsck --fix
+  backlog markdown migrate fsck -o json`,
+	Args: cobra.NoArgs,
+	RunE: runMigrateFsck,
+}
+
+func init() {
+	migrateFsckCmd.Flags().BoolVar(&fsckFix, "fix", false, "Apply the proposed repairs and commit them")
+	migrateFsckCmd.Flags().BoolVar(&fsckForceLock, "force-lock", false, "Remove existing lock and retry")
+	migrateCmd.AddCommand(migrateFsckCmd)
+}
+
+// fsck で検出する不整合の種類
+const (
+	fsckPathMismatch = "path_mismatch"
+	fsckMissingFile  = "missing_file"
+	fsckHashMismatch = "hash_mismatch"
+	fsckOrphanFile   = "orphan_file"
+	fsckUntracked    = "untracked"
+)
+
+// fsckHistoryLimit はハッシュが一致する版を探すときに遡るコミット数の上限
+const fsckHistoryLimit = 100
+
+// migrateFsckProblem はワークスペースの不整合と修復案
+type migrateFsckProblem struct {
+	Kind     string `json:"kind"`
+	ItemType string `json:"item_type,omitempty"`
+	ItemKey  string `json:"item_key,omitempty"`
+	// Path はワークスペースからの相対パス
+	Path   string `json:"path"`
+	Detail string `json:"detail"`
+	// Fix は修復案。空の場合は手動での対応が必要
+	Fix   string `json:"fix,omitempty"`
+	Fixed bool   `json:"fixed"`
+
+	itemIndex     int
+	fromPath      string // path_mismatch で移動する元ファイル（絶対パス）
+	restoreCommit string // 内容を復元するコミット
+	restorePath   string // restoreCommit 内のパス（相対パス）
+}
+
+func runMigrateFsck(cmd *cobra.Command, args []string) error {
+	cfg, err := cmdutil.GetConfigStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	dir, err := migrationDir()
+	if err != nil {
+		return err
+	}
+	if _, err := loadMetadata(dir); err != nil {
+		return fmt.Errorf("load metadata: %w", err)
+	}
+	if fsckFix {
+		release, err := acquireLock(dir, fsckForceLock)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = release() }()
+	}
+	items, err := readItems(dir)
+	if err != nil {
+		return err
+	}
+
+	problems, err := fsckWorkspace(dir, items)
+	if err != nil {
+		return err
+	}
+	if fsckFix && len(problems) > 0 {
+		if err := fsckRepair(dir, items, problems); err != nil {
+			return err
+		}
+	}
+
+	unresolved := 0
+	for _, p := range problems {
+		if !p.Fixed {
+			unresolved++
+		}
+	}
+
+	switch cfg.CurrentProfile().Output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(problems); err != nil {
+			return err
+		}
+	default:
+		if len(problems) == 0 {
+			cmdutil.Success("", "Workspace is consistent")
+			return nil
+		}
+		table := ui.NewTable("PROBLEM", "TYPE", "ITEM", "PATH", "DETAIL", "FIX")
+		for _, p := range problems {
+			fix := p.Fix
+			switch {
+			case p.Fixed:
+				fix = "fixed: " + fix
+			case fix == "":
+				fix = "manual"
+			}
+			table.AddRow(p.Kind, p.ItemType, p.ItemKey, p.Path, p.Detail, fix)
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+		if !fsckFix && hasFsckFix(problems) {
+			fmt.Println("\nRun with --fix to apply the proposed repairs.")
+		}
+	}
+
+	if unresolved > 0 {
+		return fmt.Errorf("%d workspace problem(s) remain", unresolved)
+	}
+	return nil
+}
+
+func hasFsckFix(problems []migrateFsckProblem) bool {
+	for _, p := range problems {
+		if p.Fix != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// fsckWorkspace は items.db・ディスク上のコンテンツ・git 履歴の整合性を検査する
+func fsckWorkspace(dir string, items []migrateItem) ([]migrateFsckProblem, error) {
+	uncommitted, err := gitUncommittedPaths(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := make([]migrateFsckProblem, 0)
+	referenced := make(map[string]bool)
+	for i := range items {
+		item := &items[i]
+		if item.ItemType == "comment" {
+			continue
+		}
+		expected := itemContentPath(dir, item.ItemType, item.ItemKey, item.ItemID)
+		rel := workspaceRelPath(dir, expected)
+		referenced[rel] = true
+		base := migrateFsckProblem{ItemType: item.ItemType, ItemKey: item.ItemKey, Path: rel, itemIndex: i}
+
+		// source は内容を検査するファイル。移動予定の場合は移動元を見る
+		source := expected
+		sourceExists := fileExists(expected)
+		if item.Path != expected {
+			p := base
+			p.Kind = fsckPathMismatch
+			p.Detail = fmt.Sprintf("recorded %s", workspaceRelPath(dir, item.Path))
+			p.Fix = "update path in items.db"
+			if !sourceExists && fileExists(item.Path) {
+				p.fromPath = item.Path
+				p.Fix = "move file to expected path"
+				source = item.Path
+				sourceExists = true
+				referenced[workspaceRelPath(dir, source)] = true
+			}
+			problems = append(problems, p)
+		}
+
+		wantHash := fsckExpectedHash(item)
+		if !sourceExists {
+			p := base
+			p.Kind = fsckMissingFile
+			p.Detail = "content file not found"
+			if commit := findCommitWithHash(dir, rel, wantHash); commit != "" {
+				p.restoreCommit, p.restorePath = commit, rel
+				p.Fix = "restore from " + shortCommit(commit)
+			}
+			problems = append(problems, p)
+			continue
+		}
+
+		sourceRel := workspaceRelPath(dir, source)
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("read content: %w", err)
+		}
+		if wantHash != "" && hashHex(string(content)) != wantHash {
+			p := base
+			p.Kind = fsckHashMismatch
+			p.Detail = "content differs from items.db"
+			if commit := findCommitWithHash(dir, sourceRel, wantHash); commit != "" {
+				p.restoreCommit, p.restorePath = commit, sourceRel
+				p.Fix = "restore from " + shortCommit(commit)
+			}
+			problems = append(problems, p)
+			continue
+		}
+		if uncommitted[sourceRel] {
+			p := base
+			p.Kind = fsckUntracked
+			p.Detail = "not committed to git"
+			p.Fix = "commit current content"
+			problems = append(problems, p)
+		}
+	}
+
+	orphans, err := findOrphanContentFiles(dir, referenced)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range orphans {
+		problems = append(problems, migrateFsckProblem{
+			Kind:      fsckOrphanFile,
+			Path:      rel,
+			Detail:    "not referenced by items.db",
+			Fix:       "remove file (kept in git history)",
+			itemIndex: -1,
+		})
+	}
+	return problems, nil
+}
+
+// fsckRepair は修復案のある不整合を修復し、まとめてコミットする
+func fsckRepair(dir string, items []migrateItem, problems []migrateFsckProblem) error {
+	paths := make([]string, 0)
+	itemsChanged := false
+	for i := range problems {
+		p := &problems[i]
+		if p.Fix == "" {
+			continue
+		}
+		target := filepath.Join(dir, filepath.FromSlash(p.Path))
+		switch p.Kind {
+		case fsckPathMismatch:
+			item := &items[p.itemIndex]
+			if p.fromPath != "" {
+				if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+					return fmt.Errorf("create content dir: %w", err)
+				}
+				if err := os.Rename(p.fromPath, target); err != nil {
+					return fmt.Errorf("move content: %w", err)
+				}
+				paths = append(paths, workspaceRelPath(dir, p.fromPath))
+			}
+			item.Path = target
+			itemsChanged = true
+		case fsckMissingFile, fsckHashMismatch:
+			content, err := gitShow(dir, p.restoreCommit, p.restorePath)
+			if err != nil {
+				return err
+			}
+			if err := writeItemContent(target, content); err != nil {
+				return err
+			}
+		case fsckOrphanFile:
+			if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("remove orphan file: %w", err)
+			}
+			_ = os.Remove(filepath.Dir(target)) // 空になったディレクトリのみ削除される
+		}
+		paths = append(paths, p.Path)
+		p.Fixed = true
+	}
+
+	if itemsChanged {
+		if err := writeItems(dir, items); err != nil {
+			return err
+		}
+		paths = append(paths, itemsDBFile)
+	}
+	// 削除済みかつ未追跡のパスを渡すと git add が失敗するため除外する
+	stage := make([]string, 0, len(paths))
+	for _, rel := range paths {
+		if fileExists(filepath.Join(dir, filepath.FromSlash(rel))) || gitTracked(dir, rel) {
+			stage = append(stage, rel)
+		}
+	}
+	if len(stage) == 0 {
+		return nil
+	}
+	if err := gitAdd(dir, stage...); err != nil {
+		return err
+	}
+	// ロックファイルなど未追跡のファイルが残っていてもステージした修復だけを見る
+	if gitHasStagedChanges(dir) {
+		if err := gitCommit(dir, fmt.Sprintf("fsck: repair %d problem(s)", countFixed(problems))); err != nil {
+			return err
+		}
+	}
+	_ = appendMigrateLog(dir, migrateLogEntry{
+		Action:  "fsck",
+		Status:  "fixed",
+		Message: fmt.Sprintf("%d problem(s) fixed", countFixed(problems)),
+	})
+	return nil
+}
+
+func countFixed(problems []migrateFsckProblem) int {
+	n := 0
+	for _, p := range problems {
+		if p.Fixed {
+			n++
+		}
+	}
+	return n
+}
+
+// fsckExpectedHash はディスク上のコンテンツが持つべきハッシュを返す
+// apply（dry-run を含む）後は変換結果、それ以外は取得した元の内容になる
+func fsckExpectedHash(item *migrateItem) string {
+	if item.OutputHash != "" {
+		return item.OutputHash
+	}
+	return item.InputHash
+}
+
+// findCommitWithHash は path の履歴を新しい順に辿り、内容のハッシュが want と一致するコミットを返す
+func findCommitWithHash(dir, rel, want string) string {
+	if want == "" {
+		return ""
+	}
+	commits, err := gitFileCommits(dir, rel, fsckHistoryLimit)
+	if err != nil {
+		return ""
+	}
+	for _, commit := range commits {
+		content, err := gitShow(dir, commit, rel)
+		if err != nil {
+			continue
+		}
+		if hashHex(content) == want {
+			return commit
+		}
+	}
+	return ""
+}
+
+// findOrphanContentFiles はどの項目からも参照されていないコンテンツファイルを返す
+func findOrphanContentFiles(dir string, referenced map[string]bool) ([]string, error) {
+	orphans := make([]string, 0)
+	for _, root := range []string{"issue", "wiki", "issue-type"} {
+		err := filepath.WalkDir(filepath.Join(dir, root), func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() || (d.Name() != "content.md" && d.Name() != "description.md" && d.Name() != "summary.md") {
+				return nil
+			}
+			rel := workspaceRelPath(dir, path)
+			if !referenced[rel] {
+				orphans = append(orphans, rel)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("scan content files: %w", err)
+		}
+	}
+	sort.Strings(orphans)
+	return orphans, nil
+}
+
+// workspaceRelPath はワークスペースからの相対パスを git と同じ / 区切りで返す
+func workspaceRelPath(dir, path string) string {
+	if path == "" {
+		return ""
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+func fileExists(path string) bool {
+	if path == "" {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
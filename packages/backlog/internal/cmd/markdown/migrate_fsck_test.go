@@ -0,0 +1,218 @@
+package markdown
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// newFsckWorkspace は git 初期化済みのワークスペースに項目を書き込んでコミットする
+func newFsckWorkspace(t *testing.T, contents map[string]string) (string, []migrateItem) {
+	t.Helper()
+	t.Setenv("GIT_AUTHOR_NAME", "test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	dir := t.TempDir()
+	if err := gitInit(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := ensureGitignore(dir); err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 0, len(contents))
+	for key := range contents {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]migrateItem, 0, len(contents))
+	for i, key := range keys {
+		path := itemContentPath(dir, "issue", key, i+1)
+		if err := writeItemContent(path, contents[key]); err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, migrateItem{ItemType: "issue", ItemID: i + 1, ItemKey: key, Path: path, InputHash: hashHex(contents[key])})
+	}
+	if err := writeItems(dir, items); err != nil {
+		t.Fatal(err)
+	}
+	if err := gitAdd(dir, "."); err != nil {
+		t.Fatal(err)
+	}
+	if err := gitCommit(dir, "snapshot"); err != nil {
+		t.Fatal(err)
+	}
+	return dir, items
+}
+
+func fsckKinds(problems []migrateFsckProblem) []string {
+	kinds := make([]string, 0, len(problems))
+	for _, p := range problems {
+		kinds = append(kinds, p.Kind+":"+p.Path)
+	}
+	return kinds
+}
+
+func TestFsckWorkspace(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(t *testing.T, dir string, items []migrateItem)
+		want    []string
+	}{
+		{
+			name:    "consistent",
+			corrupt: func(t *testing.T, dir string, items []migrateItem) {},
+			want:    []string{},
+		},
+		{
+			name: "missing file",
+			corrupt: func(t *testing.T, dir string, items []migrateItem) {
+				_ = os.Remove(items[0].Path)
+			},
+			want: []string{"missing_file:issue/PROJ-1/content.md"},
+		},
+		{
+			name: "hash mismatch",
+			corrupt: func(t *testing.T, dir string, items []migrateItem) {
+				_ = os.WriteFile(items[1].Path, []byte("edited"), 0o644)
+			},
+			want: []string{"hash_mismatch:issue/PROJ-2/content.md"},
+		},
+		{
+			name: "orphan file",
+			corrupt: func(t *testing.T, dir string, items []migrateItem) {
+				_ = writeItemContent(filepath.Join(dir, "issue", "PROJ-9", "content.md"), "orphan")
+			},
+			want: []string{"orphan_file:issue/PROJ-9/content.md"},
+		},
+		{
+			name: "path mismatch",
+			corrupt: func(t *testing.T, dir string, items []migrateItem) {
+				items[0].Path = filepath.Join("/moved", "issue", "PROJ-1", "content.md")
+			},
+			want: []string{"path_mismatch:issue/PROJ-1/content.md"},
+		},
+		{
+			name: "untracked",
+			corrupt: func(t *testing.T, dir string, items []migrateItem) {
+				_ = os.WriteFile(items[0].Path, []byte("new"), 0o644)
+				items[0].InputHash = hashHex("new")
+			},
+			want: []string{"untracked:issue/PROJ-1/content.md"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, items := newFsckWorkspace(t, map[string]string{"PROJ-1": "one", "PROJ-2": "two"})
+			tt.corrupt(t, dir, items)
+			problems, err := fsckWorkspace(dir, items)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := fsckKinds(problems)
+			if len(got) != len(tt.want) {
+				t.Fatalf("problems = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("problems = %v, want %v", got, tt.want)
+				}
+			}
+			for _, p := range problems {
+				if p.Fix == "" {
+					t.Errorf("%s has no fix proposal", p.Kind)
+				}
+			}
+		})
+	}
+}
+
+func TestFsckRepair(t *testing.T) {
+	for _, mode := range gitTestModes() {
+		t.Run(mode, func(t *testing.T) {
+			useGitMode(t, mode)
+			testFsckRepair(t)
+		})
+	}
+}
+
+func testFsckRepair(t *testing.T) {
+	dir, items := newFsckWorkspace(t, map[string]string{"PROJ-1": "one", "PROJ-2": "two"})
+	_ = os.Remove(items[0].Path)
+	_ = os.WriteFile(items[1].Path, []byte("edited"), 0o644)
+	orphan := filepath.Join(dir, "issue", "PROJ-9", "content.md")
+	_ = writeItemContent(orphan, "orphan")
+
+	problems, err := fsckWorkspace(dir, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fsckRepair(dir, items, problems); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range problems {
+		if !p.Fixed {
+			t.Errorf("%s %s not fixed", p.Kind, p.Path)
+		}
+	}
+
+	if got, _ := readFileIfExists(items[0].Path); got != "one" {
+		t.Errorf("restored content = %q, want %q", got, "one")
+	}
+	if got, _ := readFileIfExists(items[1].Path); got != "two" {
+		t.Errorf("restored content = %q, want %q", got, "two")
+	}
+	if fileExists(orphan) {
+		t.Error("orphan file was not removed")
+	}
+	if gitHasChanges(dir) {
+		t.Error("repairs were not committed")
+	}
+	after, err := fsckWorkspace(dir, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after) != 0 {
+		t.Errorf("problems after repair = %v", fsckKinds(after))
+	}
+}
+
+func TestFsckRepairMovesFile(t *testing.T) {
+	dir, items := newFsckWorkspace(t, map[string]string{"PROJ-1": "one"})
+	// 手動でディレクトリ名を変えてしまったケース
+	moved := filepath.Join(dir, "issue", "old", "content.md")
+	if err := os.MkdirAll(filepath.Dir(moved), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(items[0].Path, moved); err != nil {
+		t.Fatal(err)
+	}
+	items[0].Path = moved
+
+	problems, err := fsckWorkspace(dir, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fsckKinds(problems); len(got) != 2 || got[0] != "path_mismatch:issue/PROJ-1/content.md" || got[1] != "untracked:issue/PROJ-1/content.md" {
+		t.Fatalf("problems = %v", got)
+	}
+	if err := fsckRepair(dir, items, problems); err != nil {
+		t.Fatal(err)
+	}
+	want := itemContentPath(dir, "issue", "PROJ-1", 1)
+	if items[0].Path != want {
+		t.Errorf("item path = %q, want %q", items[0].Path, want)
+	}
+	if got, _ := readFileIfExists(want); got != "one" {
+		t.Errorf("moved content = %q, want %q", got, "one")
+	}
+	saved, err := readItems(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved[0].Path != want {
+		t.Errorf("saved path = %q, want %q", saved[0].Path, want)
+	}
+}
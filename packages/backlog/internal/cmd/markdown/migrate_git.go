@@ -0,0 +1,404 @@
+package markdown
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// migrate のワークスペース（変換前後の履歴）は git リポジトリとして管理する。
+// git の操作はこのファイルの関数に集約し、実際の処理は --git で選んだバックエンドに委ねる。
+//   - external: 外部の git コマンドを引数リストで直接起動する（シェルを経由しない）
+//   - embedded: go-git による組み込み実装（git の無い環境でも動く）
+//   - auto（既定）: PATH に git があれば external、無ければ embedded
+
+// migrateGitMode は --git で指定されたバックエンド
+var migrateGitMode string
+
+const (
+	gitModeAuto     = "auto"
+	gitModeExternal = "external"
+	gitModeEmbedded = "embedded"
+)
+
+// errGitNotFound は git コマンドが見つからない場合のエラー
+var errGitNotFound = errors.New("markdown migrate --git external needs the git command to manage the workspace history, but git was not found in PATH\n" +
+	"Install git (e.g. apt-get install git / apk add git) in the environment, such as the CI container, or use --git embedded")
+
+// gitBackend はワークスペースの履歴管理に使う git 操作
+// パスはワークスペース（dir）からの相対パスか、ワークスペース内の絶対パスを受け付ける
+type gitBackend interface {
+	Init(dir string) error
+	CurrentBranch(dir string) (string, error)
+	HasChanges(dir string) bool
+	HasStagedChanges(dir string) bool
+	UncommittedPaths(dir string) (map[string]bool, error)
+	// Add は paths の追加・変更・削除をステージする
+	Add(dir string, paths ...string) error
+	Commit(dir, message string, allowEmpty bool) error
+	Checkout(dir, branch string) error
+	// CheckoutNewBranch は startPoint（空なら HEAD）から branch を作って切り替える
+	CheckoutNewBranch(dir, branch, startPoint string) error
+	MergeNoFF(dir, branch string) error
+	DeleteBranch(dir, branch string) error
+	BranchExists(dir, branch string) bool
+	HasCommits(dir string) bool
+	Tracked(dir, path string) bool
+	// FileCommits は path を変更したコミットを新しい順に返す（limit が 0 以下なら全件）
+	FileCommits(dir, path string, limit int) ([]string, error)
+	Show(dir, commit, path string) (string, error)
+	// CheckoutFile は commit 時点の path をワークツリーに戻してステージする
+	CheckoutFile(dir, commit, path string) error
+	// Diff は from から to への path の差分を標準出力に表示する
+	Diff(dir, from, to, path string) error
+}
+
+// workspaceGit は --git の指定に応じたバックエンドを返す
+func workspaceGit() (gitBackend, error) {
+	switch migrateGitMode {
+	case "", gitModeAuto:
+		if _, err := exec.LookPath("git"); err != nil {
+			return embeddedGit{}, nil
+		}
+		return externalGit{}, nil
+	case gitModeExternal:
+		if _, err := exec.LookPath("git"); err != nil {
+			return nil, errGitNotFound
+		}
+		return externalGit{}, nil
+	case gitModeEmbedded:
+		return embeddedGit{}, nil
+	default:
+		return nil, fmt.Errorf("invalid --git value %q (expected %s, %s or %s)", migrateGitMode, gitModeAuto, gitModeExternal, gitModeEmbedded)
+	}
+}
+
+// requireGit はワークスペースの管理に使う git バックエンドが使えるかを確認する
+func requireGit() error {
+	_, err := workspaceGit()
+	return err
+}
+
+func gitInit(dir string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.Init(dir)
+}
+
+func gitCurrentBranch(dir string) (string, error) {
+	g, err := workspaceGit()
+	if err != nil {
+		return "", err
+	}
+	return g.CurrentBranch(dir)
+}
+
+func gitHasChanges(dir string) bool {
+	g, err := workspaceGit()
+	return err == nil && g.HasChanges(dir)
+}
+
+func gitHasStagedChanges(dir string) bool {
+	g, err := workspaceGit()
+	return err == nil && g.HasStagedChanges(dir)
+}
+
+// gitUncommittedPaths は未コミットの変更がある（未追跡を含む）ファイルの相対パスを返す
+func gitUncommittedPaths(dir string) (map[string]bool, error) {
+	g, err := workspaceGit()
+	if err != nil {
+		return nil, err
+	}
+	return g.UncommittedPaths(dir)
+}
+
+func gitAdd(dir string, paths ...string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.Add(dir, paths...)
+}
+
+func gitCommit(dir, message string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.Commit(dir, message, false)
+}
+
+func gitCommitAllowEmpty(dir, message string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.Commit(dir, message, true)
+}
+
+func gitCheckout(dir, branch string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.Checkout(dir, branch)
+}
+
+func gitCheckoutNewBranch(dir, branch string) error {
+	return gitCheckoutNewBranchFrom(dir, branch, "")
+}
+
+func gitCheckoutNewBranchFrom(dir, branch, startPoint string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.CheckoutNewBranch(dir, branch, startPoint)
+}
+
+func gitMergeNoFF(dir, branch string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.MergeNoFF(dir, branch)
+}
+
+func gitDeleteBranch(dir, branch string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.DeleteBranch(dir, branch)
+}
+
+func gitBranchExists(dir, branch string) bool {
+	g, err := workspaceGit()
+	return err == nil && g.BranchExists(dir, branch)
+}
+
+func gitHasCommits(dir string) bool {
+	g, err := workspaceGit()
+	return err == nil && g.HasCommits(dir)
+}
+
+func gitTracked(dir, rel string) bool {
+	g, err := workspaceGit()
+	return err == nil && g.Tracked(dir, rel)
+}
+
+func gitFileCommits(dir, path string, limit int) ([]string, error) {
+	g, err := workspaceGit()
+	if err != nil {
+		return nil, err
+	}
+	return g.FileCommits(dir, path, limit)
+}
+
+func gitShow(dir, commit, path string) (string, error) {
+	g, err := workspaceGit()
+	if err != nil {
+		return "", err
+	}
+	return g.Show(dir, commit, path)
+}
+
+func gitDiff(dir, from, to, path string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.Diff(dir, from, to, path)
+}
+
+func gitFirstCommitForFile(dir, path string) (string, error) {
+	commits, err := gitFileCommits(dir, path, 0)
+	if err != nil {
+		return "", err
+	}
+	if len(commits) == 0 {
+		return "", nil
+	}
+	return commits[len(commits)-1], nil
+}
+
+func gitCheckoutFile(dir, commit, path string) error {
+	g, err := workspaceGit()
+	if err != nil {
+		return err
+	}
+	return g.CheckoutFile(dir, commit, path)
+}
+
+// externalGit は外部の git コマンドを使うバックエンド
+type externalGit struct{}
+
+func (externalGit) Init(dir string) error {
+	if _, err := runGit(dir, "init"); err != nil {
+		return err
+	}
+	// Backlog から取得した内容をそのまま比較できるよう改行コードの自動変換を無効にし、
+	// Windows では MAX_PATH（260文字）を超えるパスも扱えるようにする
+	settings := [][2]string{{"core.autocrlf", "false"}}
+	if runtime.GOOS == "windows" {
+		settings = append(settings, [2]string{"core.longpaths", "true"})
+	}
+	for _, kv := range settings {
+		if _, err := runGit(dir, "config", kv[0], kv[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (externalGit) CurrentBranch(dir string) (string, error) {
+	out, err := runGit(dir, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+func (externalGit) HasChanges(dir string) bool {
+	out, err := runGit(dir, "status", "--porcelain")
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(out) != ""
+}
+
+func (externalGit) HasStagedChanges(dir string) bool {
+	// 差分があると終了コード 1 になる
+	_, err := runGit(dir, "diff", "--cached", "--quiet")
+	return err != nil
+}
+
+func (externalGit) UncommittedPaths(dir string) (map[string]bool, error) {
+	out, err := runGit(dir, "-c", "core.quotepath=false", "status", "--porcelain", "-z", "--untracked-files=all")
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]bool)
+	entries := strings.Split(out, "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 4 {
+			continue
+		}
+		paths[entry[3:]] = true
+		// リネーム・コピーは移動元のパスが続く
+		if entry[0] == 'R' || entry[0] == 'C' {
+			i++
+		}
+	}
+	return paths, nil
+}
+
+func (externalGit) Add(dir string, paths ...string) error {
+	args := append([]string{"add", "-A", "--"}, paths...)
+	_, err := runGit(dir, args...)
+	return err
+}
+
+func (externalGit) Commit(dir, message string, allowEmpty bool) error {
+	args := []string{"commit", "-m", message}
+	if allowEmpty {
+		args = []string{"commit", "--allow-empty", "-m", message}
+	}
+	_, err := runGit(dir, args...)
+	return err
+}
+
+func (externalGit) Checkout(dir, branch string) error {
+	_, err := runGit(dir, "checkout", branch)
+	return err
+}
+
+func (externalGit) CheckoutNewBranch(dir, branch, startPoint string) error {
+	args := []string{"checkout", "-b", branch}
+	if startPoint != "" {
+		args = append(args, startPoint)
+	}
+	_, err := runGit(dir, args...)
+	return err
+}
+
+func (externalGit) MergeNoFF(dir, branch string) error {
+	_, err := runGit(dir, "merge", "--no-ff", branch)
+	return err
+}
+
+func (externalGit) DeleteBranch(dir, branch string) error {
+	_, err := runGit(dir, "branch", "-D", branch)
+	return err
+}
+
+func (externalGit) BranchExists(dir, branch string) bool {
+	_, err := runGit(dir, "show-ref", "--verify", fmt.Sprintf("refs/heads/%s", branch))
+	return err == nil
+}
+
+func (externalGit) HasCommits(dir string) bool {
+	_, err := runGit(dir, "rev-parse", "--verify", "HEAD")
+	return err == nil
+}
+
+func (externalGit) Tracked(dir, path string) bool {
+	_, err := runGit(dir, "ls-files", "--error-unmatch", "--", path)
+	return err == nil
+}
+
+func (externalGit) FileCommits(dir, path string, limit int) ([]string, error) {
+	args := []string{"log", "--pretty=format:%H"}
+	if limit > 0 {
+		args = append(args, fmt.Sprintf("-%d", limit))
+	}
+	out, err := runGit(dir, append(args, "--", path)...)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(out), nil
+}
+
+func (externalGit) Show(dir, commit, path string) (string, error) {
+	return runGit(dir, "show", commit+":"+path)
+}
+
+func (externalGit) CheckoutFile(dir, commit, path string) error {
+	_, err := runGit(dir, "checkout", commit, "--", path)
+	return err
+}
+
+func (externalGit) Diff(dir, from, to, path string) error {
+	cmd := exec.Command("git", "diff", from, to, "--", path)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if len(out) > 0 {
+		fmt.Print(string(out))
+	}
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return nil
+	}
+	return fmt.Errorf("git diff failed: %w", err)
+}
+
+func runGit(dir string, args ...string) (string, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return "", errGitNotFound
+	}
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,448 @@
+package markdown
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// embeddedGit は go-git による組み込みのバックエンド
+// 外部コマンドを起動しないため、git の無い CI コンテナでも使え、引数のクォートも OS に依存しない
+type embeddedGit struct{}
+
+// embeddedGitAuthor は git の設定に user.name / user.email が無い場合のコミット作成者
+var embeddedGitAuthor = object.Signature{Name: "backlog-cli", Email: "backlog-cli@localhost"}
+
+func (embeddedGit) Init(dir string) error {
+	repo, err := git.PlainInit(dir, false)
+	if errors.Is(err, git.ErrRepositoryAlreadyExists) {
+		repo, err = git.PlainOpen(dir)
+	}
+	if err != nil {
+		return fmt.Errorf("git init: %w", err)
+	}
+	// 後から外部の git で操作しても内容が変わらないよう、改行コードの自動変換を無効にしておく
+	cfg, err := repo.Config()
+	if err != nil {
+		return fmt.Errorf("git init: %w", err)
+	}
+	cfg.Raw.Section("core").SetOption("autocrlf", "false")
+	if err := repo.SetConfig(cfg); err != nil {
+		return fmt.Errorf("git init: %w", err)
+	}
+	return nil
+}
+
+func (embeddedGit) CurrentBranch(dir string) (string, error) {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return "", err
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse HEAD: %w", err)
+	}
+	// git rev-parse --abbrev-ref HEAD と同じく、デタッチ状態では HEAD を返す
+	if !head.Name().IsBranch() {
+		return "HEAD", nil
+	}
+	return head.Name().Short(), nil
+}
+
+func (embeddedGit) HasChanges(dir string) bool {
+	status, err := workspaceStatus(dir)
+	return err == nil && !status.IsClean()
+}
+
+func (embeddedGit) HasStagedChanges(dir string) bool {
+	status, err := workspaceStatus(dir)
+	if err != nil {
+		return false
+	}
+	for _, s := range status {
+		if s.Staging != git.Unmodified && s.Staging != git.Untracked {
+			return true
+		}
+	}
+	return false
+}
+
+func (embeddedGit) UncommittedPaths(dir string) (map[string]bool, error) {
+	status, err := workspaceStatus(dir)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]bool)
+	for path, s := range status {
+		if s.Staging != git.Unmodified || s.Worktree != git.Unmodified {
+			paths[path] = true
+		}
+	}
+	return paths, nil
+}
+
+func (embeddedGit) Add(dir string, paths ...string) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		rel := workspaceGitPath(dir, path)
+		// 存在するファイルは状態の確認を省いてそのまま追加する（ディレクトリと削除は状態から判断される）
+		info, statErr := os.Lstat(filepath.Join(dir, filepath.FromSlash(rel)))
+		opts := &git.AddOptions{Path: rel, SkipStatus: statErr == nil && !info.IsDir()}
+		if err := wt.AddWithOptions(opts); err != nil {
+			return fmt.Errorf("git add %s: %w", rel, err)
+		}
+	}
+	return nil
+}
+
+func (embeddedGit) Commit(dir, message string, allowEmpty bool) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	return commitWorkspace(repo, message, &git.CommitOptions{AllowEmptyCommits: allowEmpty})
+}
+
+func (embeddedGit) Checkout(dir, branch string) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	if err := wt.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(branch)}); err != nil {
+		return fmt.Errorf("git checkout %s: %w", branch, err)
+	}
+	return nil
+}
+
+func (embeddedGit) CheckoutNewBranch(dir, branch, startPoint string) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	opts := &git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(branch), Create: true}
+	if startPoint != "" {
+		hash, err := repo.ResolveRevision(plumbing.Revision(startPoint))
+		if err != nil {
+			return fmt.Errorf("git checkout -b %s %s: %w", branch, startPoint, err)
+		}
+		opts.Hash = *hash
+	}
+	if err := wt.Checkout(opts); err != nil {
+		return fmt.Errorf("git checkout -b %s: %w", branch, err)
+	}
+	return nil
+}
+
+// MergeNoFF は branch をマージコミットとして取り込む
+// apply は現在のブランチから作った作業ブランチを戻すだけなので、現在のブランチが branch の祖先である場合のみ扱う
+func (embeddedGit) MergeNoFF(dir, branch string) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return fmt.Errorf("git merge %s: %w", branch, err)
+	}
+	theirs, err := repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err != nil {
+		return fmt.Errorf("git merge %s: %w", branch, err)
+	}
+	if head.Hash() == theirs.Hash() {
+		return nil
+	}
+	ours, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return fmt.Errorf("git merge %s: %w", branch, err)
+	}
+	target, err := repo.CommitObject(theirs.Hash())
+	if err != nil {
+		return fmt.Errorf("git merge %s: %w", branch, err)
+	}
+	if ok, err := ours.IsAncestor(target); err != nil {
+		return fmt.Errorf("git merge %s: %w", branch, err)
+	} else if !ok {
+		return fmt.Errorf("git merge %s: %s has diverged; the embedded git backend cannot merge diverged branches (use --git external)", branch, head.Name().Short())
+	}
+
+	// インデックスとワークツリーを branch の内容にしてから、両方を親に持つコミットを作る
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	if err := wt.Reset(&git.ResetOptions{Commit: target.Hash, Mode: git.MergeReset}); err != nil {
+		return fmt.Errorf("git merge %s: %w", branch, err)
+	}
+	return commitWorkspace(repo, fmt.Sprintf("Merge branch '%s'", branch), &git.CommitOptions{
+		AllowEmptyCommits: true,
+		Parents:           []plumbing.Hash{ours.Hash, target.Hash},
+	})
+}
+
+func (embeddedGit) DeleteBranch(dir, branch string) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	name := plumbing.NewBranchReferenceName(branch)
+	if _, err := repo.Reference(name, false); err != nil {
+		return fmt.Errorf("git branch -D %s: %w", branch, err)
+	}
+	if err := repo.Storer.RemoveReference(name); err != nil {
+		return fmt.Errorf("git branch -D %s: %w", branch, err)
+	}
+	return nil
+}
+
+func (embeddedGit) BranchExists(dir, branch string) bool {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return false
+	}
+	_, err = repo.Reference(plumbing.NewBranchReferenceName(branch), false)
+	return err == nil
+}
+
+func (embeddedGit) HasCommits(dir string) bool {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return false
+	}
+	_, err = repo.Head()
+	return err == nil
+}
+
+func (embeddedGit) Tracked(dir, path string) bool {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return false
+	}
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return false
+	}
+	_, err = idx.Entry(workspaceGitPath(dir, path))
+	return err == nil
+}
+
+// FileCommits は git log -- path と同じく、マージコミットでは path が同じ親だけを辿る
+func (embeddedGit) FileCommits(dir, path string, limit int) ([]string, error) {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return nil, err
+	}
+	rel := workspaceGitPath(dir, path)
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("git log %s: %w", rel, err)
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("git log %s: %w", rel, err)
+	}
+	commits := make([]string, 0)
+	for commit != nil && (limit <= 0 || len(commits) < limit) {
+		blob := fileBlobHash(commit, rel)
+		var next *object.Commit
+		changed := true
+		err := commit.Parents().ForEach(func(parent *object.Commit) error {
+			if next == nil {
+				next = parent
+			}
+			if fileBlobHash(parent, rel) == blob {
+				next, changed = parent, false
+				return storer.ErrStop
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("git log %s: %w", rel, err)
+		}
+		if changed && (next != nil || !blob.IsZero()) {
+			commits = append(commits, commit.Hash.String())
+		}
+		commit = next
+	}
+	return commits, nil
+}
+
+// fileBlobHash は commit 時点の path の内容のハッシュを返す（存在しなければゼロ値）
+func fileBlobHash(commit *object.Commit, path string) plumbing.Hash {
+	file, err := commit.File(path)
+	if err != nil {
+		return plumbing.ZeroHash
+	}
+	return file.Hash
+}
+
+func (embeddedGit) Show(dir, commit, path string) (string, error) {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return "", err
+	}
+	return showFile(repo, commit, workspaceGitPath(dir, path))
+}
+
+func (embeddedGit) CheckoutFile(dir, commit, path string) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	rel := workspaceGitPath(dir, path)
+	content, err := showFile(repo, commit, rel)
+	if err != nil {
+		return err
+	}
+	target := filepath.Join(dir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("git checkout %s -- %s: %w", commit, rel, err)
+	}
+	if err := os.WriteFile(target, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("git checkout %s -- %s: %w", commit, rel, err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	if _, err := wt.Add(rel); err != nil {
+		return fmt.Errorf("git checkout %s -- %s: %w", commit, rel, err)
+	}
+	return nil
+}
+
+func (embeddedGit) Diff(dir, from, to, path string) error {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return err
+	}
+	rel := workspaceGitPath(dir, path)
+	fromTree, err := commitTree(repo, from)
+	if err != nil {
+		return fmt.Errorf("git diff failed: %w", err)
+	}
+	toTree, err := commitTree(repo, to)
+	if err != nil {
+		return fmt.Errorf("git diff failed: %w", err)
+	}
+	changes, err := object.DiffTree(fromTree, toTree)
+	if err != nil {
+		return fmt.Errorf("git diff failed: %w", err)
+	}
+	selected := make(object.Changes, 0, 1)
+	for _, change := range changes {
+		if change.From.Name == rel || change.To.Name == rel {
+			selected = append(selected, change)
+		}
+	}
+	if len(selected) == 0 {
+		return nil
+	}
+	patch, err := selected.Patch()
+	if err != nil {
+		return fmt.Errorf("git diff failed: %w", err)
+	}
+	fmt.Print(patch.String())
+	return nil
+}
+
+func openWorkspaceRepo(dir string) (*git.Repository, error) {
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		return nil, fmt.Errorf("open workspace repository %s: %w", dir, err)
+	}
+	return repo, nil
+}
+
+func workspaceStatus(dir string) (git.Status, error) {
+	repo, err := openWorkspaceRepo(dir)
+	if err != nil {
+		return nil, err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return nil, fmt.Errorf("git status: %w", err)
+	}
+	return status, nil
+}
+
+// workspaceGitPath はワークスペース内のパスを go-git が扱うスラッシュ区切りの相対パスにする
+func workspaceGitPath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return workspaceRelPath(dir, path)
+	}
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "./")
+}
+
+// commitWorkspace は git の設定（user.name / user.email）の作成者でコミットする
+func commitWorkspace(repo *git.Repository, message string, opts *git.CommitOptions) error {
+	author := embeddedGitAuthor
+	if cfg, err := repo.ConfigScoped(config.SystemScope); err == nil && cfg.User.Name != "" && cfg.User.Email != "" {
+		author = object.Signature{Name: cfg.User.Name, Email: cfg.User.Email}
+	}
+	author.When = time.Now()
+	opts.Author = &author
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	if _, err := wt.Commit(message, opts); err != nil {
+		return fmt.Errorf("git commit: %w", err)
+	}
+	return nil
+}
+
+func commitTree(repo *git.Repository, rev string) (*object.Tree, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, err
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, err
+	}
+	return commit.Tree()
+}
+
+func showFile(repo *git.Repository, commit, rel string) (string, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(commit))
+	if err != nil {
+		return "", fmt.Errorf("git show %s:%s: %w", commit, rel, err)
+	}
+	c, err := repo.CommitObject(*hash)
+	if err != nil {
+		return "", fmt.Errorf("git show %s:%s: %w", commit, rel, err)
+	}
+	file, err := c.File(rel)
+	if err != nil {
+		return "", fmt.Errorf("git show %s:%s: %w", commit, rel, err)
+	}
+	return file.Contents()
+}
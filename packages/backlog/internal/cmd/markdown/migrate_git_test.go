@@ -0,0 +1,175 @@
+package markdown
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// gitTestModes はテストで使えるバックエンド（git が無い環境では embedded のみ）
+func gitTestModes() []string {
+	if _, err := exec.LookPath("git"); err != nil {
+		return []string{gitModeEmbedded}
+	}
+	return []string{gitModeExternal, gitModeEmbedded}
+}
+
+func useGitMode(t *testing.T, mode string) {
+	t.Helper()
+	prev := migrateGitMode
+	migrateGitMode = mode
+	t.Cleanup(func() { migrateGitMode = prev })
+}
+
+func TestRunGitWithoutGit(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	if _, err := runGit(t.TempDir(), "status"); !errors.Is(err, errGitNotFound) {
+		t.Errorf("runGit() without git = %v, want errGitNotFound", err)
+	}
+
+	useGitMode(t, gitModeExternal)
+	if err := requireGit(); !errors.Is(err, errGitNotFound) {
+		t.Errorf("requireGit() with --git external = %v, want errGitNotFound", err)
+	}
+	useGitMode(t, gitModeAuto)
+	if g, err := workspaceGit(); err != nil {
+		t.Errorf("workspaceGit() with --git auto = %v", err)
+	} else if _, ok := g.(embeddedGit); !ok {
+		t.Errorf("workspaceGit() with --git auto = %T, want embeddedGit", g)
+	}
+	useGitMode(t, "svn")
+	if err := requireGit(); err == nil {
+		t.Error("requireGit() with an unknown --git value should fail")
+	}
+}
+
+// TestGitBackends は apply / rollback / fsck が使う一連の操作を各バックエンドで確認する
+func TestGitBackends(t *testing.T) {
+	for _, mode := range gitTestModes() {
+		t.Run(mode, func(t *testing.T) {
+			useGitMode(t, mode)
+			t.Setenv("GIT_AUTHOR_NAME", "test")
+			t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+			t.Setenv("GIT_COMMITTER_NAME", "test")
+			t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+			dir := t.TempDir()
+			if err := gitInit(dir); err != nil {
+				t.Fatal(err)
+			}
+			if gitHasCommits(dir) {
+				t.Fatal("new repository should have no commits")
+			}
+			if err := gitCommitAllowEmpty(dir, "init: workspace"); err != nil {
+				t.Fatal(err)
+			}
+			base, err := gitCurrentBranch(dir)
+			if err != nil || base == "" || base == "HEAD" {
+				t.Fatalf("gitCurrentBranch() = %q, %v", base, err)
+			}
+
+			path := filepath.Join(dir, "issue", "PROJ-1", "content.md")
+			if err := writeItemContent(path, "one"); err != nil {
+				t.Fatal(err)
+			}
+			if !gitHasChanges(dir) {
+				t.Error("untracked file should be reported as a change")
+			}
+			if paths, err := gitUncommittedPaths(dir); err != nil || !paths["issue/PROJ-1/content.md"] {
+				t.Errorf("gitUncommittedPaths() = %v, %v", paths, err)
+			}
+			if err := gitAdd(dir, path); err != nil {
+				t.Fatal(err)
+			}
+			if !gitHasStagedChanges(dir) || !gitTracked(dir, "issue/PROJ-1/content.md") {
+				t.Error("added file should be staged and tracked")
+			}
+			if err := gitCommit(dir, "snapshot: issue PROJ-1"); err != nil {
+				t.Fatal(err)
+			}
+			if gitHasChanges(dir) {
+				t.Error("workspace should be clean after commit")
+			}
+
+			// apply と同じく作業ブランチで変換してから --no-ff で取り込む
+			if err := gitCheckoutNewBranchFrom(dir, "apply-1", base); err != nil {
+				t.Fatal(err)
+			}
+			if err := writeItemContent(path, "two"); err != nil {
+				t.Fatal(err)
+			}
+			if err := gitAdd(dir, path); err != nil {
+				t.Fatal(err)
+			}
+			if err := gitCommit(dir, "apply: issue PROJ-1"); err != nil {
+				t.Fatal(err)
+			}
+			if err := gitCheckout(dir, base); err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := readFileIfExists(path); got != "one" {
+				t.Errorf("content on %s = %q, want %q", base, got, "one")
+			}
+			if err := gitMergeNoFF(dir, "apply-1"); err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := readFileIfExists(path); got != "two" {
+				t.Errorf("content after merge = %q, want %q", got, "two")
+			}
+			if err := gitDeleteBranch(dir, "apply-1"); err != nil {
+				t.Fatal(err)
+			}
+			if gitBranchExists(dir, "apply-1") || !gitBranchExists(dir, base) {
+				t.Error("apply-1 should be deleted and the base branch kept")
+			}
+
+			commits, err := gitFileCommits(dir, path, 0)
+			if err != nil || len(commits) != 2 {
+				t.Fatalf("gitFileCommits() = %v, %v", commits, err)
+			}
+			if latest, err := gitFileCommits(dir, "issue/PROJ-1/content.md", 1); err != nil || len(latest) != 1 || latest[0] != commits[0] {
+				t.Errorf("gitFileCommits(limit=1) = %v, %v", latest, err)
+			}
+			if got, err := gitShow(dir, commits[1], "issue/PROJ-1/content.md"); err != nil || got != "one" {
+				t.Errorf("gitShow() = %q, %v", got, err)
+			}
+			if err := gitDiff(dir, commits[1], commits[0], path); err != nil {
+				t.Errorf("gitDiff() = %v", err)
+			}
+
+			// rollback と同じく最初のスナップショットに戻す
+			first, err := gitFirstCommitForFile(dir, path)
+			if err != nil || first != commits[1] {
+				t.Fatalf("gitFirstCommitForFile() = %q, %v", first, err)
+			}
+			if err := gitCheckoutFile(dir, first, path); err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := readFileIfExists(path); got != "one" {
+				t.Errorf("content after rollback = %q, want %q", got, "one")
+			}
+			if !gitHasStagedChanges(dir) {
+				t.Error("restored file should be staged")
+			}
+			if err := gitCommit(dir, "rollback: issue PROJ-1"); err != nil {
+				t.Fatal(err)
+			}
+
+			// 削除もステージできる
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+			if err := gitAdd(dir, path); err != nil {
+				t.Fatal(err)
+			}
+			if err := gitCommit(dir, "remove"); err != nil {
+				t.Fatal(err)
+			}
+			if gitTracked(dir, path) || gitHasChanges(dir) {
+				t.Error("removed file should be committed")
+			}
+		})
+	}
+}
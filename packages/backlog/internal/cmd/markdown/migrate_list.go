@@ -0,0 +1,108 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+)
+
+// migrate list で扱うアイテムの状態
+const (
+	migrateStatusPending    = "pending"
+	migrateStatusApplied    = "applied"
+	migrateStatusError      = "error"
+	migrateStatusRolledBack = "rolled_back"
+)
+
+var migrateListStatuses = []string{migrateStatusPending, migrateStatusApplied, migrateStatusError, migrateStatusRolledBack}
+
+var migrateListTypes = []string{"issue", "wiki", "issue_type", "issue_type_description", "issue_type_summary"}
+
+// migrateItemStatus はアイテムの適用状態を返す
+// apply / rollback のいずれかでエラーが記録されていれば error を優先する
+func migrateItemStatus(item *migrateItem) string {
+	switch {
+	case item.ApplyError != "" || item.RollbackError != "":
+		return migrateStatusError
+	case item.Applied:
+		return migrateStatusApplied
+	case item.RollbackAt != "":
+		return migrateStatusRolledBack
+	default:
+		return migrateStatusPending
+	}
+}
+
+// migrateListFilter は migrate list の --status / --type による絞り込み条件
+type migrateListFilter struct {
+	statuses map[string]bool
+	types    map[string]bool
+}
+
+func newMigrateListFilter(statuses, types []string) (*migrateListFilter, error) {
+	statusSet, err := normalizeListFilterValues("status", statuses, migrateListStatuses)
+	if err != nil {
+		return nil, err
+	}
+	typeSet, err := normalizeListFilterValues("type", types, migrateListTypes)
+	if err != nil {
+		return nil, err
+	}
+	return &migrateListFilter{statuses: statusSet, types: typeSet}, nil
+}
+
+// where は条件を items.db の検索条件（WHERE 句と引数）にする
+// changedOnly が true なら変換で内容が変わる項目だけに絞る（コメントは常に除く）
+func (f *migrateListFilter) where(changedOnly bool) (string, []any) {
+	clauses := []string{"item_type <> 'comment'"}
+	args := make([]any, 0)
+	if changedOnly {
+		clauses = append(clauses, "changed = 1")
+	}
+	if len(f.types) > 0 {
+		types := make([]string, 0, len(migrateListTypes))
+		for _, t := range migrateListTypes {
+			if typeAllowed(f.types, t) {
+				types = append(types, t)
+			}
+		}
+		clauses = append(clauses, "item_type IN ("+sqlPlaceholders(len(types))+")")
+		for _, t := range types {
+			args = append(args, t)
+		}
+	}
+	if len(f.statuses) > 0 {
+		clauses = append(clauses, "status IN ("+sqlPlaceholders(len(f.statuses))+")")
+		for _, s := range migrateListStatuses {
+			if f.statuses[s] {
+				args = append(args, s)
+			}
+		}
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+func sqlPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func normalizeListFilterValues(name string, values, allowed []string) (map[string]bool, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, v := range allowed {
+		allowedSet[v] = true
+	}
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v == "" {
+			continue
+		}
+		if !allowedSet[v] {
+			return nil, fmt.Errorf("invalid --%s value %q (allowed: %s)", name, v, strings.Join(allowed, ","))
+		}
+		set[v] = true
+	}
+	return set, nil
+}
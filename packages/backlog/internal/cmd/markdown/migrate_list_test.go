@@ -0,0 +1,103 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMigrateItemStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		item migrateItem
+		want string
+	}{
+		{name: "pending", item: migrateItem{}, want: "pending"},
+		{name: "applied", item: migrateItem{Applied: true}, want: "applied"},
+		{name: "apply error", item: migrateItem{ApplyError: "boom"}, want: "error"},
+		{name: "rollback error", item: migrateItem{Applied: true, RollbackError: "boom"}, want: "error"},
+		{name: "rolled back", item: migrateItem{RollbackAt: "2024-01-01T00:00:00Z"}, want: "rolled_back"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := migrateItemStatus(&tt.item); got != tt.want {
+				t.Errorf("migrateItemStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrateListFilter(t *testing.T) {
+	dir := t.TempDir()
+	items := []migrateItem{
+		{ItemType: "wiki", ItemID: 1, ItemKey: "wiki-error", ApplyError: "x", Changed: true},
+		{ItemType: "wiki", ItemID: 2, ItemKey: "wiki-applied", Applied: true, Changed: true},
+		{ItemType: "issue", ItemID: 3, ItemKey: "issue-error", ApplyError: "x", Changed: true},
+		{ItemType: "issue", ItemID: 4, ItemKey: "issue-unchanged"},
+		{ItemType: "issue_type_description", ItemID: 5, ItemKey: "type-desc", Changed: true},
+		{ItemType: "comment", ItemID: 6, ItemKey: "comment", Changed: true},
+	}
+	if err := writeItems(dir, items); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		statuses    []string
+		types       []string
+		changedOnly bool
+		want        []string
+	}{
+		{name: "wiki error", statuses: []string{"Error"}, types: []string{"wiki"}, want: []string{"wiki-error"}},
+		{name: "error", statuses: []string{"error"}, want: []string{"wiki-error", "issue-error"}},
+		{name: "issue_type includes sub types", types: []string{"issue_type"}, want: []string{"type-desc"}},
+		{name: "changed only", types: []string{"issue"}, changedOnly: true, want: []string{"issue-error"}},
+		{name: "empty filter", want: []string{"wiki-error", "wiki-applied", "issue-error", "issue-unchanged", "type-desc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newMigrateListFilter(tt.statuses, tt.types)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			where, args := filter.where(tt.changedOnly)
+			got, err := queryItems(dir, where, args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			keys := make([]string, 0, len(got))
+			for _, item := range got {
+				keys = append(keys, item.ItemKey)
+			}
+			if strings.Join(keys, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("items = %v, want %v", keys, tt.want)
+			}
+		})
+	}
+
+	if _, err := newMigrateListFilter([]string{"unknown"}, nil); err == nil {
+		t.Error("expected error for invalid status")
+	}
+}
+
+func TestTypeAllowedIssueTypeItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		types    []string
+		itemType string
+		want     bool
+	}{
+		{name: "issue_type matches description", types: []string{"issue_type"}, itemType: "issue_type_description", want: true},
+		{name: "issue_type matches summary", types: []string{"issue_type"}, itemType: "issue_type_summary", want: true},
+		{name: "summary only", types: []string{"issue_type_summary"}, itemType: "issue_type_summary", want: true},
+		{name: "summary excludes description", types: []string{"issue_type_summary"}, itemType: "issue_type_description", want: false},
+		{name: "wiki excludes issue type", types: []string{"wiki"}, itemType: "issue_type_summary", want: false},
+		{name: "no filter", types: nil, itemType: "issue_type_summary", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := typeAllowed(normalizeTypes(tt.types), tt.itemType); got != tt.want {
+				t.Errorf("typeAllowed(%v, %q) = %v, want %v", tt.types, tt.itemType, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package markdown
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/markdown"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+	"github.com/yacchi/backlog-cli/packages/web"
+)
+
+// レビュー結果
+const (
+	reviewApproved = "approved"
+	reviewRejected = "rejected"
+)
+
+// migratePreviewPath は SPA の migrate preview 画面のパス
+const migratePreviewPath = "/migrate/preview"
+
+var (
+	previewServe     bool
+	previewPort      int
+	previewNoBrowser bool
+	previewTypes     []string
+)
+
+var migratePreviewCmd = &cobra.Command{
+	Use:   "preview",
+	Short: "Review conversions side by side in the browser",
+	Long: `Show the workspace content before and after conversion side by side in a
+local web UI, and record approve/reject for each item in items.db.
+
+Reviews are bound to the exact content that was reviewed: "migrate apply"
+skips the confirmation prompt for approved items and skips rejected items
+(also with --auto) as long as neither the Backlog content nor the conversion
+result has changed since the review. Otherwise the item is asked again.
+
+The preview converts the workspace content with the attachment names
+recorded at snapshot time. Run "migrate check" to verify attachment
+references against the current attachments.
+
+Without --serve, the review state of each changed item is listed.
+
+Examples:
+  backlog markdown migrate preview --serve
+  backlog markdown migrate preview --serve --types wiki --port 8080
+  backlog markdown migrate preview -o json`,
+	Args: cobra.NoArgs,
+	RunE: runMigratePreview,
+}
+
+func init() {
+	migratePreviewCmd.Flags().BoolVar(&previewServe, "serve", false, "Start a local web UI to review conversions")
+	migratePreviewCmd.Flags().IntVar(&previewPort, "port", 0, "Port for the preview server (default: random)")
+	migratePreviewCmd.Flags().BoolVar(&previewNoBrowser, "no-browser", false, "Do not open the browser automatically")
+	migratePreviewCmd.Flags().StringSliceVar(&previewTypes, "types", nil, "Preview target types (issue,wiki,issue_type,issue_type_description,issue_type_summary). Default: all")
+	migrateCmd.AddCommand(migratePreviewCmd)
+}
+
+// migrateReview は migrate preview で記録したレビュー結果
+// InputHash / OutputHash はレビューした変換前後の内容で、どちらかが変わればレビューは無効になる
+type migrateReview struct {
+	Decision   string `json:"decision"`
+	InputHash  string `json:"input_hash"`
+	OutputHash string `json:"output_hash"`
+	ReviewedAt string `json:"reviewed_at"`
+}
+
+// reviewDecision は変換前後の内容がレビュー時と同じ場合にレビュー結果を返す
+func (item *migrateItem) reviewDecision(inputHash, outputHash string) string {
+	if item.Review == nil || item.Review.InputHash != inputHash || item.Review.OutputHash != outputHash {
+		return ""
+	}
+	return item.Review.Decision
+}
+
+// previewItem は migrate preview で一覧表示する項目
+type previewItem struct {
+	ID         string                       `json:"id"`
+	ItemType   string                       `json:"item_type"`
+	ItemKey    string                       `json:"item_key"`
+	URL        string                       `json:"url"`
+	Status     string                       `json:"status"`
+	Score      int                          `json:"score"`
+	Rules      []markdown.RuleID            `json:"rules,omitempty"`
+	Warnings   map[markdown.WarningType]int `json:"warnings,omitempty"`
+	Review     string                       `json:"review,omitempty"`
+	ReviewedAt string                       `json:"reviewed_at,omitempty"`
+	// Stale はレビュー後に変換前後の内容が変わり、レビュー結果が apply で使われないことを表す
+	Stale bool `json:"stale,omitempty"`
+}
+
+// previewDetail は変換前後の内容を含む項目の詳細
+type previewDetail struct {
+	previewItem
+	Before       string                         `json:"before"`
+	After        string                         `json:"after"`
+	WarningLines map[markdown.WarningType][]int `json:"warning_lines,omitempty"`
+}
+
+// previewConversion はワークスペースの内容を変換した結果
+type previewConversion struct {
+	item       *migrateItem
+	converted  migrateItem
+	before     string
+	after      string
+	inputHash  string
+	outputHash string
+}
+
+// convertForPreview はワークスペースの内容を取得時点の添付ファイル名で変換する
+func convertForPreview(dir string, item *migrateItem, rules *markdown.RuleProfile) (*previewConversion, error) {
+	// applyConversion は項目の統計を更新するため、コピーに対して変換する
+	converted := *item
+	path, err := resolveItemPath(dir, &converted)
+	if err != nil {
+		return nil, err
+	}
+	before, err := readFileIfExists(path)
+	if err != nil {
+		return nil, fmt.Errorf("read content: %w", err)
+	}
+	after, _, err := applyConversion(&converted, before, item.Attachments, rules)
+	if err != nil {
+		return nil, err
+	}
+	return &previewConversion{
+		item:       item,
+		converted:  converted,
+		before:     before,
+		after:      after,
+		inputHash:  hashHex(before),
+		outputHash: hashHex(after),
+	}, nil
+}
+
+func (c *previewConversion) summary() previewItem {
+	p := previewItem{
+		ID:       identityKey(c.item.ItemType, c.item.ItemKey, c.item.ItemID),
+		ItemType: c.item.ItemType,
+		ItemKey:  c.item.ItemKey,
+		URL:      c.item.URL,
+		Status:   migrateItemStatus(c.item),
+		Score:    c.converted.Score,
+		Rules:    c.converted.Rules,
+		Warnings: c.converted.Warnings,
+	}
+	if c.item.Review != nil {
+		p.Review = c.item.Review.Decision
+		p.ReviewedAt = c.item.Review.ReviewedAt
+		p.Stale = c.item.reviewDecision(c.inputHash, c.outputHash) == ""
+	}
+	return p
+}
+
+func (c *previewConversion) detail() *previewDetail {
+	return &previewDetail{
+		previewItem:  c.summary(),
+		Before:       c.before,
+		After:        c.after,
+		WarningLines: c.converted.WarningLines,
+	}
+}
+
+// isPreviewTarget は preview でレビューできる未適用の項目かどうかを返す
+func isPreviewTarget(item *migrateItem, allowedTypes map[string]bool) bool {
+	return item.ItemType != "comment" && !item.Applied && typeAllowed(allowedTypes, item.ItemType)
+}
+
+// collectPreviewItems は変換で内容が変わる未適用の項目を一覧にする
+func collectPreviewItems(dir string, items []migrateItem, allowedTypes map[string]bool, rules *markdown.RuleProfile) ([]previewItem, error) {
+	list := make([]previewItem, 0)
+	for i := range items {
+		if !isPreviewTarget(&items[i], allowedTypes) {
+			continue
+		}
+		conv, err := convertForPreview(dir, &items[i], rules)
+		if err != nil {
+			return nil, err
+		}
+		if conv.after == conv.before {
+			continue
+		}
+		list = append(list, conv.summary())
+	}
+	return list, nil
+}
+
+func runMigratePreview(cmd *cobra.Command, args []string) error {
+	cfg, err := cmdutil.GetConfigStore(cmd)
+	if err != nil {
+		return err
+	}
+	dir, err := migrationDir()
+	if err != nil {
+		return err
+	}
+	meta, err := loadMetadata(dir)
+	if err != nil {
+		return fmt.Errorf("load metadata: %w", err)
+	}
+	allowedTypes := normalizeTypes(previewTypes)
+	rules, err := resolveRuleProfile(cfg)
+	if err != nil {
+		return err
+	}
+
+	if !previewServe {
+		items, err := readItems(dir)
+		if err != nil {
+			return err
+		}
+		list, err := collectPreviewItems(dir, items, allowedTypes, rules)
+		if err != nil {
+			return err
+		}
+		profile := cfg.CurrentProfile()
+		if profile.Output == "json" {
+			return cmdutil.OutputJSONFromProfile(list, profile.JSONFields, profile.JQ, profile.Template)
+		}
+		if len(list) == 0 {
+			fmt.Println("No items to review.")
+			return nil
+		}
+		table := ui.NewTable("TYPE", "KEY", "REVIEW", "URL")
+		for _, p := range list {
+			review := p.Review
+			switch {
+			case review == "":
+				review = "-"
+			case p.Stale:
+				review += " (stale)"
+			}
+			table.AddRow(p.ItemType, p.ItemKey, review, p.URL)
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+		return nil
+	}
+
+	assets, err := web.Assets()
+	if err != nil {
+		return fmt.Errorf("web UI assets are not available: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", previewPort))
+	if err != nil {
+		return fmt.Errorf("failed to start preview server: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	ps := &migratePreviewServer{
+		dir:          dir,
+		projectKey:   meta.ProjectKey,
+		allowedTypes: allowedTypes,
+		rules:        rules,
+	}
+	mux := http.NewServeMux()
+	ps.register(mux)
+	mux.Handle("/", ui.SPAHandler(assets))
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	url := "http://" + listener.Addr().String() + migratePreviewPath
+	fmt.Fprintf(os.Stderr, "%s Reviewing %s at %s (Ctrl+C to stop)\n", ui.OKMark(), dir, ui.Cyan(url))
+	if !previewNoBrowser {
+		_ = osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
+	}
+
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// migratePreviewServer は migrate preview の API を提供する
+// items.db はリクエストごとに読み直し、apply などと並行して使っても古い内容で上書きしない
+type migratePreviewServer struct {
+	dir          string
+	projectKey   string
+	allowedTypes map[string]bool
+	rules        *markdown.RuleProfile
+
+	// mu はレビューの記録（items.db の読み書きと git commit）を直列化する
+	mu sync.Mutex
+}
+
+func (ps *migratePreviewServer) register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/migrate/items", ps.handleList)
+	mux.HandleFunc("GET /api/v1/migrate/items/{id}", ps.handleDetail)
+	mux.HandleFunc("POST /api/v1/migrate/items/{id}/review", ps.handleReview)
+}
+
+// previewList は GET /api/v1/migrate/items のレスポンス
+type previewList struct {
+	ProjectKey string        `json:"project_key"`
+	Items      []previewItem `json:"items"`
+}
+
+func (ps *migratePreviewServer) handleList(w http.ResponseWriter, r *http.Request) {
+	items, err := readItems(ps.dir)
+	if err != nil {
+		writePreviewError(w, http.StatusInternalServerError, err)
+		return
+	}
+	list, err := collectPreviewItems(ps.dir, items, ps.allowedTypes, ps.rules)
+	if err != nil {
+		writePreviewError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writePreviewJSON(w, http.StatusOK, previewList{ProjectKey: ps.projectKey, Items: list})
+}
+
+func (ps *migratePreviewServer) handleDetail(w http.ResponseWriter, r *http.Request) {
+	items, err := readItems(ps.dir)
+	if err != nil {
+		writePreviewError(w, http.StatusInternalServerError, err)
+		return
+	}
+	item := ps.findItem(items, r.PathValue("id"))
+	if item == nil {
+		writePreviewError(w, http.StatusNotFound, fmt.Errorf("item not found: %s", r.PathValue("id")))
+		return
+	}
+	conv, err := convertForPreview(ps.dir, item, ps.rules)
+	if err != nil {
+		writePreviewError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writePreviewJSON(w, http.StatusOK, conv.detail())
+}
+
+// previewReviewRequest は POST /api/v1/migrate/items/{id}/review のリクエスト
+// Decision が空の場合はレビュー結果を取り消す
+type previewReviewRequest struct {
+	Decision string `json:"decision"`
+}
+
+func (ps *migratePreviewServer) handleReview(w http.ResponseWriter, r *http.Request) {
+	// JSON 以外を拒否し、他のサイトのフォームから記録されないようにする（JSON はプリフライトが必要になる）
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		writePreviewError(w, http.StatusUnsupportedMediaType, errors.New("content type must be application/json"))
+		return
+	}
+	var req previewReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writePreviewError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		return
+	}
+	switch req.Decision {
+	case reviewApproved, reviewRejected, "":
+	default:
+		writePreviewError(w, http.StatusBadRequest, fmt.Errorf("invalid decision %q (allowed: %s, %s)", req.Decision, reviewApproved, reviewRejected))
+		return
+	}
+
+	detail, err := ps.recordReview(r.PathValue("id"), req.Decision)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errPreviewItemNotFound) {
+			status = http.StatusNotFound
+		}
+		writePreviewError(w, status, err)
+		return
+	}
+	writePreviewJSON(w, http.StatusOK, detail)
+}
+
+var errPreviewItemNotFound = errors.New("item not found")
+
+// recordReview はレビュー結果を items.db に書き込んでコミットする
+// apply / rollback の実行中はロックが取れないためエラーになる
+func (ps *migratePreviewServer) recordReview(id, decision string) (*previewDetail, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	release, err := acquireLock(ps.dir, false)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	items, err := readItems(ps.dir)
+	if err != nil {
+		return nil, err
+	}
+	item := ps.findItem(items, id)
+	if item == nil {
+		return nil, fmt.Errorf("%w: %s", errPreviewItemNotFound, id)
+	}
+	conv, err := convertForPreview(ps.dir, item, ps.rules)
+	if err != nil {
+		return nil, err
+	}
+
+	if decision == "" {
+		item.Review = nil
+	} else {
+		item.Review = &migrateReview{
+			Decision:   decision,
+			InputHash:  conv.inputHash,
+			OutputHash: conv.outputHash,
+			ReviewedAt: time.Now().Format(time.RFC3339),
+		}
+	}
+	if err := saveItem(ps.dir, item); err != nil {
+		return nil, err
+	}
+	// apply のブランチ切り替えを妨げないよう、レビューの記録もコミットしておく
+	if err := gitAdd(ps.dir, itemsDBFile); err != nil {
+		return nil, err
+	}
+	if gitHasChanges(ps.dir) {
+		message := fmt.Sprintf("review: %s %s %s", item.ItemType, item.ItemKey, decision)
+		if decision == "" {
+			message = fmt.Sprintf("review: %s %s cleared", item.ItemType, item.ItemKey)
+		}
+		if err := gitCommit(ps.dir, message); err != nil {
+			return nil, err
+		}
+	}
+
+	return conv.detail(), nil
+}
+
+func (ps *migratePreviewServer) findItem(items []migrateItem, id string) *migrateItem {
+	for i := range items {
+		item := &items[i]
+		if isPreviewTarget(item, ps.allowedTypes) && identityKey(item.ItemType, item.ItemKey, item.ItemID) == id {
+			return item
+		}
+	}
+	return nil
+}
+
+func writePreviewJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writePreviewError(w http.ResponseWriter, status int, err error) {
+	writePreviewJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,112 @@
+package markdown
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReviewDecision(t *testing.T) {
+	item := migrateItem{Review: &migrateReview{Decision: reviewApproved, InputHash: "in", OutputHash: "out"}}
+	if got := item.reviewDecision("in", "out"); got != reviewApproved {
+		t.Errorf("reviewDecision() = %q, want approved", got)
+	}
+	if got := item.reviewDecision("changed", "out"); got != "" {
+		t.Errorf("reviewDecision() with changed input = %q, want empty", got)
+	}
+	if got := item.reviewDecision("in", "changed"); got != "" {
+		t.Errorf("reviewDecision() with changed output = %q, want empty", got)
+	}
+	if got := (&migrateItem{}).reviewDecision("in", "out"); got != "" {
+		t.Errorf("reviewDecision() without review = %q, want empty", got)
+	}
+}
+
+func TestMigratePreviewServer(t *testing.T) {
+	dir, _ := newFsckWorkspace(t, map[string]string{
+		"PROJ-1": "* Heading\n\nbody\n",
+		"PROJ-2": "plain text\n",
+	})
+	ps := &migratePreviewServer{dir: dir, projectKey: "PROJ"}
+	mux := http.NewServeMux()
+	ps.register(mux)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodGet, "/api/v1/migrate/items", "")
+	var list previewList
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("decode list: %v (%s)", err, rec.Body.String())
+	}
+	if len(list.Items) != 1 || list.Items[0].ID != "issue:PROJ-1" {
+		t.Fatalf("list = %+v, want only the changed item", list.Items)
+	}
+
+	rec = do(http.MethodGet, "/api/v1/migrate/items/issue:PROJ-1", "")
+	var detail previewDetail
+	if err := json.Unmarshal(rec.Body.Bytes(), &detail); err != nil {
+		t.Fatalf("decode detail: %v (%s)", err, rec.Body.String())
+	}
+	if detail.Before != "* Heading\n\nbody\n" || !strings.HasPrefix(detail.After, "# Heading") {
+		t.Errorf("detail before/after = %q / %q", detail.Before, detail.After)
+	}
+
+	if rec := do(http.MethodPost, "/api/v1/migrate/items/issue:PROJ-1/review", ""); rec.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("review without JSON content type = %d, want 415", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/api/v1/migrate/items/issue:PROJ-1/review", `{"decision":"maybe"}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("review with invalid decision = %d, want 400", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/api/v1/migrate/items/issue:PROJ-9/review", `{"decision":"approved"}`); rec.Code != http.StatusNotFound {
+		t.Errorf("review of unknown item = %d, want 404", rec.Code)
+	}
+
+	rec = do(http.MethodPost, "/api/v1/migrate/items/issue:PROJ-1/review", `{"decision":"approved"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("review = %d (%s)", rec.Code, rec.Body.String())
+	}
+	items, err := readItems(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reviewed := items[0]
+	if reviewed.Review == nil {
+		t.Fatal("review was not recorded in items.db")
+	}
+	if got := reviewed.reviewDecision(hashHex(detail.Before), hashHex(detail.After)); got != reviewApproved {
+		t.Errorf("recorded review = %q, want approved", got)
+	}
+	if gitHasChanges(dir) {
+		t.Error("review was not committed")
+	}
+
+	// 内容が変わった項目のレビューは無効になる
+	if err := writeItemContent(reviewed.Path, "* Heading\n\nedited\n"); err != nil {
+		t.Fatal(err)
+	}
+	rec = do(http.MethodGet, "/api/v1/migrate/items", "")
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Review != reviewApproved || !list.Items[0].Stale {
+		t.Errorf("list after edit = %+v, want stale approved review", list.Items)
+	}
+
+	rec = do(http.MethodPost, "/api/v1/migrate/items/issue:PROJ-1/review", `{"decision":""}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("clear review = %d (%s)", rec.Code, rec.Body.String())
+	}
+	if items, _ := readItems(dir); items[0].Review != nil {
+		t.Errorf("review was not cleared: %+v", items[0].Review)
+	}
+}
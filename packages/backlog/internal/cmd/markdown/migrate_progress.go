@@ -0,0 +1,132 @@
+package markdown
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// applyProgress は migrate apply の進捗（処理件数・平均処理時間・推定残り時間）を追跡する
+type applyProgress struct {
+	total     int
+	done      int
+	counts    map[string]int
+	started   time.Time
+	itemStart time.Time
+
+	// out は項目ごとの進捗行の出力先（nil の場合は表示しない）
+	out io.Writer
+	// log は途中経過を JSON Lines で書き出す先（nil の場合は書き出さない）
+	log      io.Writer
+	interval time.Duration
+	lastLog  time.Time
+
+	now func() time.Time
+}
+
+// applyProgressSnapshot は途中経過ログの1行
+type applyProgressSnapshot struct {
+	TS         time.Time      `json:"ts"`
+	Processed  int            `json:"processed"`
+	Total      int            `json:"total"`
+	Statuses   map[string]int `json:"statuses"`
+	ElapsedSec float64        `json:"elapsed_sec"`
+	AvgSec     float64        `json:"avg_sec"`
+	ETASec     float64        `json:"eta_sec"`
+	Final      bool           `json:"final,omitempty"`
+}
+
+func newApplyProgress(total int, out, log io.Writer, interval time.Duration) *applyProgress {
+	p := &applyProgress{
+		total:    total,
+		counts:   make(map[string]int),
+		out:      out,
+		log:      log,
+		interval: interval,
+		now:      time.Now,
+	}
+	p.started = p.now()
+	p.lastLog = p.started
+	return p
+}
+
+// begin は1項目の処理開始を記録する
+func (p *applyProgress) begin() {
+	p.itemStart = p.now()
+}
+
+// finish は1項目の処理完了を記録し、その項目の所要時間を返す
+func (p *applyProgress) finish(entry migrateLogEntry) time.Duration {
+	now := p.now()
+	took := now.Sub(p.itemStart)
+	p.done++
+	p.counts[entry.Status]++
+
+	if p.out != nil {
+		_, _ = fmt.Fprintf(p.out, "[%d/%d] %s %s %s (%s) avg %s, ETA %s\n",
+			p.done, p.total, entry.Status, entry.ItemType, entry.ItemKey,
+			formatProgressDuration(took), formatProgressDuration(p.average(now)), formatProgressDuration(p.eta(now)))
+	}
+	if p.log != nil && (p.interval <= 0 || now.Sub(p.lastLog) >= p.interval) {
+		p.writeSnapshot(now, false)
+	}
+	return took
+}
+
+// close は最終的な途中経過を書き出す
+func (p *applyProgress) close() {
+	if p.log != nil {
+		p.writeSnapshot(p.now(), true)
+	}
+}
+
+// average は開始からの経過時間を処理済み件数で割った1項目あたりの平均時間
+func (p *applyProgress) average(now time.Time) time.Duration {
+	if p.done == 0 {
+		return 0
+	}
+	return now.Sub(p.started) / time.Duration(p.done)
+}
+
+// eta は平均時間から推定した残り時間
+func (p *applyProgress) eta(now time.Time) time.Duration {
+	remaining := p.total - p.done
+	if remaining <= 0 {
+		return 0
+	}
+	return p.average(now) * time.Duration(remaining)
+}
+
+func (p *applyProgress) writeSnapshot(now time.Time, final bool) {
+	p.lastLog = now
+	statuses := make(map[string]int, len(p.counts))
+	for k, v := range p.counts {
+		statuses[k] = v
+	}
+	snap := applyProgressSnapshot{
+		TS:         now,
+		Processed:  p.done,
+		Total:      p.total,
+		Statuses:   statuses,
+		ElapsedSec: now.Sub(p.started).Seconds(),
+		AvgSec:     p.average(now).Seconds(),
+		ETASec:     p.eta(now).Seconds(),
+		Final:      final,
+	}
+	_ = json.NewEncoder(p.log).Encode(snap)
+}
+
+// formatProgressDuration は所要時間を 0.8s / 1m05s / 2h03m の形式にする
+func formatProgressDuration(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%.1fs", d.Seconds())
+	case d < time.Hour:
+		d = d.Round(time.Second)
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		d = d.Round(time.Minute)
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
@@ -0,0 +1,76 @@
+package markdown
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApplyProgress(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var out, log bytes.Buffer
+	p := newApplyProgress(4, &out, &log, time.Minute)
+	p.now = func() time.Time { return clock }
+	p.started = clock
+	p.lastLog = clock
+
+	steps := []struct {
+		took   time.Duration
+		status string
+	}{
+		{took: 2 * time.Second, status: "applied"},
+		{took: 4 * time.Second, status: "no_change"},
+		{took: 90 * time.Second, status: "applied"},
+	}
+	for i, step := range steps {
+		p.begin()
+		clock = clock.Add(step.took)
+		got := p.finish(migrateLogEntry{Status: step.status, ItemType: "issue", ItemKey: "PROJ-" + string(rune('1'+i))})
+		if got != step.took {
+			t.Errorf("finish() = %v, want %v", got, step.took)
+		}
+	}
+	p.close()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("progress lines = %d, want 3: %q", len(lines), out.String())
+	}
+	if want := "[1/4] applied issue PROJ-1 (2.0s) avg 2.0s, ETA 6.0s"; lines[0] != want {
+		t.Errorf("line[0] = %q, want %q", lines[0], want)
+	}
+	if want := "[3/4] applied issue PROJ-3 (1m30s) avg 32.0s, ETA 32.0s"; lines[2] != want {
+		t.Errorf("line[2] = %q, want %q", lines[2], want)
+	}
+
+	// 3件目の完了時（経過 96s）と close 時に書き出される
+	snaps := strings.Split(strings.TrimSpace(log.String()), "\n")
+	if len(snaps) != 2 {
+		t.Fatalf("snapshots = %d, want 2: %q", len(snaps), log.String())
+	}
+	var last applyProgressSnapshot
+	if err := json.Unmarshal([]byte(snaps[1]), &last); err != nil {
+		t.Fatal(err)
+	}
+	if !last.Final || last.Processed != 3 || last.Total != 4 || last.Statuses["applied"] != 2 || last.ETASec != 32 {
+		t.Errorf("final snapshot = %+v", last)
+	}
+}
+
+func TestFormatProgressDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{in: 800 * time.Millisecond, want: "0.8s"},
+		{in: 65 * time.Second, want: "1m05s"},
+		{in: 2*time.Hour + 3*time.Minute + 20*time.Second, want: "2h03m"},
+	}
+	for _, tt := range tests {
+		if got := formatProgressDuration(tt.in); got != tt.want {
+			t.Errorf("formatProgressDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package markdown
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	singleWiki   bool
+	singleDryRun bool
+)
+
+var migrateSingleCmd = &cobra.Command{
+	Use:   "single <issue-key|wiki-id>",
+	Short: "Convert a single issue or wiki page without a workspace",
+	Long: `Convert the description of a single issue (or the content of a single wiki
+page with --wiki) to GFM and show the diff, then apply it after confirmation.
+
+Unlike init/apply, no workspace is created and nothing is written locally,
+so the change cannot be undone with 'migrate rollback'. Use the issue or wiki
+history in Backlog to restore the previous content if needed.
+
+The item is fetched again before applying; if it was updated while waiting
+for confirmation, nothing is applied.
+
+Examples:
+  backlog markdown migrate single PROJ-123 --dry-run
+  backlog markdown migrate single PROJ-123
+  backlog markdown migrate single 456 --wiki --yes`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runMigrateSingle,
+}
+
+func init() {
+	migrateSingleCmd.Flags().BoolVar(&singleWiki, "wiki", false, "Treat the argument as a wiki page ID")
+	migrateSingleCmd.Flags().BoolVar(&singleDryRun, "dry-run", false, "Show the diff without applying")
+	migrateCmd.AddCommand(migrateSingleCmd)
+}
+
+// newSingleItem はワークスペースを使わずに変換する1件分の項目を作る
+func newSingleItem(arg string, wiki bool, projectKey, space string) (*migrateItem, error) {
+	baseURL := fmt.Sprintf("https://%s", space)
+	if wiki {
+		id, err := strconv.Atoi(arg)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid wiki ID: %s", arg)
+		}
+		return &migrateItem{
+			ItemType:   "wiki",
+			ItemID:     id,
+			ItemKey:    arg,
+			ProjectKey: projectKey,
+			URL:        fmt.Sprintf("%s/alias/wiki/%d", baseURL, id),
+		}, nil
+	}
+	key, issueProject := cmdutil.ResolveIssueKey(arg, projectKey)
+	if issueProject == "" {
+		return nil, fmt.Errorf("invalid issue key: %s (specify PROJ-123, or 123 with a current project)", arg)
+	}
+	return &migrateItem{
+		ItemType:   "issue",
+		ItemKey:    key,
+		ProjectKey: issueProject,
+		URL:        fmt.Sprintf("%s/view/%s", baseURL, key),
+	}, nil
+}
+
+func runMigrateSingle(cmd *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(cmd)
+	if err != nil {
+		return err
+	}
+	rules, err := resolveRuleProfile(cfg)
+	if err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+
+	item, err := newSingleItem(args[0], singleWiki, cmdutil.GetCurrentProject(cfg), cfg.CurrentProfile().Space)
+	if err != nil {
+		return err
+	}
+
+	current, err := fetchCurrentItem(ctx, client, item)
+	if err != nil {
+		return fmt.Errorf("failed to get %s %s: %w", item.ItemType, item.ItemKey, err)
+	}
+	if current.Name != "" {
+		item.ItemKey = current.Name
+	}
+	item.InputHash = hashHex(current.Content)
+	item.UpdatedAt = current.Updated
+
+	converted, changed, err := applyConversion(item, current.Content, current.Attachments, rules)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		fmt.Printf("No changes: %s %s\n", item.ItemType, item.ItemKey)
+		return nil
+	}
+
+	if err := printContentDiff(current.Content, converted); err != nil {
+		return err
+	}
+	fmt.Println(item.URL)
+	fmt.Println()
+	printChangeSummary(*item)
+
+	if singleDryRun {
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(cmd) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"confirmation is required when not running interactively",
+				"backlog markdown migrate single",
+				"Use --dry-run to only show the diff.",
+			)
+		}
+		ok, err := ui.Confirm(fmt.Sprintf("Apply this change to %s %s?", item.ItemType, item.ItemKey), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Canceled.")
+			return nil
+		}
+	}
+
+	return applySingleItem(ctx, client, item, converted)
+}
+
+// applySingleItem は取得時から変更されていないことを確かめてから変換結果を反映する
+func applySingleItem(ctx context.Context, client *api.Client, item *migrateItem, converted string) error {
+	latest, err := fetchCurrentItem(ctx, client, item)
+	if err != nil {
+		return fmt.Errorf("failed to get %s %s: %w", item.ItemType, item.ItemKey, err)
+	}
+	if !isSourceMatch(item, latest.Content, latest.Updated) {
+		return fmt.Errorf("%s %s was updated after the diff was shown; run the command again", item.ItemType, item.ItemKey)
+	}
+	if _, err := applyItem(ctx, client, item, converted); err != nil {
+		return fmt.Errorf("failed to apply %s %s: %w", item.ItemType, item.ItemKey, err)
+	}
+	cmdutil.Success(item.ItemKey, "Converted %s %s", item.ItemType, item.ItemKey)
+	return nil
+}
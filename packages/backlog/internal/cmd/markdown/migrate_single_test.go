@@ -0,0 +1,34 @@
+package markdown
+
+import "testing"
+
+func TestNewSingleItem(t *testing.T) {
+	item, err := newSingleItem("123", false, "PROJ", "example.backlog.jp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ItemType != "issue" || item.ItemKey != "PROJ-123" || item.ProjectKey != "PROJ" || item.URL != "https://example.backlog.jp/view/PROJ-123" {
+		t.Errorf("issue item = %+v", item)
+	}
+
+	// 課題キーのプロジェクトを優先する
+	item, err = newSingleItem("OTHER-7", false, "PROJ", "example.backlog.jp")
+	if err != nil || item.ProjectKey != "OTHER" {
+		t.Errorf("issue item = %+v, %v, want project OTHER", item, err)
+	}
+
+	item, err = newSingleItem("456", true, "PROJ", "example.backlog.jp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ItemType != "wiki" || item.ItemID != 456 || item.URL != "https://example.backlog.jp/alias/wiki/456" {
+		t.Errorf("wiki item = %+v", item)
+	}
+
+	if _, err := newSingleItem("123", false, "", "example.backlog.jp"); err == nil {
+		t.Error("expected error for an issue number without a project")
+	}
+	if _, err := newSingleItem("Home", true, "PROJ", "example.backlog.jp"); err == nil {
+		t.Error("expected error for a non-numeric wiki ID")
+	}
+}
@@ -0,0 +1,287 @@
+package markdown
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+// ワークスペースの項目は SQLite のデータベース（items.db）に保存する。
+// 状態や種別で絞り込めるようにし、preview と apply のように複数のコマンドから同時に読み書きしても
+// SQLite のロックで直列化されるようにする。
+// 以前の items.jsonl のワークスペースは、最初に開いたときに items.db へ移行してコミットする。
+// コマンドごとに開いて閉じるため、git add の時点ではジャーナルの無い完全なファイルになっている。
+
+const (
+	itemsDBFile     = "items.db"
+	legacyItemsFile = "items.jsonl"
+)
+
+// itemsDBSidecars は SQLite が一時的に作るファイル（.gitignore に追加する）
+var itemsDBSidecars = []string{itemsDBFile + "-journal", itemsDBFile + "-wal", itemsDBFile + "-shm"}
+
+const itemsSchema = `
+CREATE TABLE IF NOT EXISTS items (
+	seq       INTEGER PRIMARY KEY,
+	item_type TEXT    NOT NULL,
+	item_id   INTEGER NOT NULL,
+	item_key  TEXT    NOT NULL,
+	status    TEXT    NOT NULL,
+	changed   INTEGER NOT NULL,
+	data      TEXT    NOT NULL
+);
+CREATE INDEX IF NOT EXISTS items_identity ON items (item_type, item_id);
+CREATE INDEX IF NOT EXISTS items_status ON items (status, item_type);
+`
+
+func itemsDBPath(dir string) string {
+	return filepath.Join(dir, itemsDBFile)
+}
+
+// itemsStoreExists はワークスペースに項目が保存されているか（移行前の items.jsonl を含む）を返す
+func itemsStoreExists(dir string) bool {
+	return fileExists(itemsDBPath(dir)) || fileExists(filepath.Join(dir, legacyItemsFile))
+}
+
+// openItemsDB は items.db を開く
+// 無い場合は items.jsonl から移行し、どちらも無ければ create が true のときだけ作る
+func openItemsDB(dir string, create bool) (*sql.DB, error) {
+	path := itemsDBPath(dir)
+	if !fileExists(path) {
+		if fileExists(filepath.Join(dir, legacyItemsFile)) {
+			if err := migrateLegacyItems(dir); err != nil {
+				return nil, err
+			}
+		} else if !create {
+			return nil, fmt.Errorf("open items database: %w", os.ErrNotExist)
+		}
+	}
+	return openItemsDBFile(path)
+}
+
+func openItemsDBFile(path string) (*sql.DB, error) {
+	// 他のコマンドが書き込み中でも待つよう busy_timeout を設定し、書き込みは開始時にロックを取る
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(10000)&_txlock=immediate")
+	if err != nil {
+		return nil, fmt.Errorf("open items database: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(itemsSchema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("initialize items database: %w", err)
+	}
+	return db, nil
+}
+
+// migrateLegacyItems は items.jsonl の内容で items.db を作り、items.jsonl を削除してコミットする
+// 一時ファイルに書いてから rename するため、途中で失敗しても items.jsonl は残り、次回やり直せる
+func migrateLegacyItems(dir string) error {
+	legacy := filepath.Join(dir, legacyItemsFile)
+	items, err := readLegacyItems(legacy)
+	if err != nil {
+		return err
+	}
+	tmp := itemsDBPath(dir) + ".tmp"
+	_ = os.Remove(tmp)
+	db, err := openItemsDBFile(tmp)
+	if err != nil {
+		return err
+	}
+	if err := replaceItems(db, items); err != nil {
+		_ = db.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := db.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("close items database: %w", err)
+	}
+	if err := os.Rename(tmp, itemsDBPath(dir)); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("replace items database: %w", err)
+	}
+	if err := os.Remove(legacy); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove %s: %w", legacyItemsFile, err)
+	}
+	if err := ensureGitignore(dir); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, ".git")); err != nil {
+		return nil
+	}
+	paths := []string{itemsDBFile, ".gitignore"}
+	if gitTracked(dir, legacyItemsFile) {
+		paths = append(paths, legacyItemsFile)
+	}
+	if err := gitAdd(dir, paths...); err != nil {
+		return err
+	}
+	if gitHasStagedChanges(dir) {
+		if err := gitCommit(dir, fmt.Sprintf("store: migrate %s to %s", legacyItemsFile, itemsDBFile)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readLegacyItems(path string) ([]migrateItem, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open items file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	buf := make([]byte, 0, 64*1024)
+	scanner.Buffer(buf, 1024*1024)
+
+	items := make([]migrateItem, 0)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var item migrateItem
+		if err := json.Unmarshal([]byte(line), &item); err != nil {
+			return nil, fmt.Errorf("decode item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan items: %w", err)
+	}
+	return items, nil
+}
+
+// writeItems は項目の一覧で items.db の内容を置き換える
+func writeItems(dir string, items []migrateItem) error {
+	db, err := openItemsDB(dir, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+	return replaceItems(db, items)
+}
+
+func replaceItems(db *sql.DB, items []migrateItem) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("write items: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.Exec(`DELETE FROM items`); err != nil {
+		return fmt.Errorf("write items: %w", err)
+	}
+	stmt, err := tx.Prepare(`INSERT INTO items (seq, item_type, item_id, item_key, status, changed, data) VALUES (?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return fmt.Errorf("write items: %w", err)
+	}
+	defer func() { _ = stmt.Close() }()
+	for i := range items {
+		item := &items[i]
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encode item: %w", err)
+		}
+		if _, err := stmt.Exec(i+1, item.ItemType, item.ItemID, item.ItemKey, migrateItemStatus(item), item.Changed, string(data)); err != nil {
+			return fmt.Errorf("write items: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("write items: %w", err)
+	}
+	return nil
+}
+
+// saveItem は 1 件の項目だけを更新する（未登録なら末尾に追加する）
+// apply / rollback は項目ごとにコミットするため、全件を書き直さずに済むようにする
+func saveItem(dir string, item *migrateItem) error {
+	db, err := openItemsDB(dir, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("encode item: %w", err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("save item: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	res, err := tx.Exec(`UPDATE items SET item_key = ?, status = ?, changed = ?, data = ? WHERE item_type = ? AND item_id = ?`,
+		item.ItemKey, migrateItemStatus(item), item.Changed, string(data), item.ItemType, item.ItemID)
+	if err != nil {
+		return fmt.Errorf("save item: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("save item: %w", err)
+	} else if n == 0 {
+		if _, err := tx.Exec(`INSERT INTO items (seq, item_type, item_id, item_key, status, changed, data)
+			VALUES ((SELECT COALESCE(MAX(seq), 0) + 1 FROM items), ?, ?, ?, ?, ?, ?)`,
+			item.ItemType, item.ItemID, item.ItemKey, migrateItemStatus(item), item.Changed, string(data)); err != nil {
+			return fmt.Errorf("save item: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("save item: %w", err)
+	}
+	return nil
+}
+
+func readItems(dir string) ([]migrateItem, error) {
+	return queryItems(dir, "", nil)
+}
+
+// queryItems は条件（SQL の WHERE 句）に一致する項目を保存順に返す
+func queryItems(dir, where string, args []any) ([]migrateItem, error) {
+	db, err := openItemsDB(dir, false)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = db.Close() }()
+
+	query := `SELECT data FROM items`
+	if where != "" {
+		query += ` WHERE ` + where
+	}
+	rows, err := db.Query(query+` ORDER BY seq`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("read items: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := make([]migrateItem, 0)
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("read items: %w", err)
+		}
+		var item migrateItem
+		if err := json.Unmarshal([]byte(data), &item); err != nil {
+			return nil, fmt.Errorf("decode item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read items: %w", err)
+	}
+	return items, nil
+}
+
+func readItemsIfExists(dir string) ([]migrateItem, error) {
+	if !itemsStoreExists(dir) {
+		return nil, nil
+	}
+	return readItems(dir)
+}
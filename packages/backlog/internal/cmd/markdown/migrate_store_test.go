@@ -0,0 +1,136 @@
+package markdown
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestItemsStoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := readItems(dir); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("readItems() on an empty workspace = %v, want ErrNotExist", err)
+	}
+	if items, err := readItemsIfExists(dir); err != nil || items != nil {
+		t.Fatalf("readItemsIfExists() = %v, %v", items, err)
+	}
+
+	items := []migrateItem{
+		{ItemType: "issue", ItemID: 2, ItemKey: "PROJ-2", Changed: true},
+		{ItemType: "issue", ItemID: 1, ItemKey: "PROJ-1", Review: &migrateReview{Decision: "approved"}},
+	}
+	if err := writeItems(dir, items); err != nil {
+		t.Fatal(err)
+	}
+
+	items[0].Applied = true
+	if err := saveItem(dir, &items[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveItem(dir, &migrateItem{ItemType: "wiki", ItemID: 1, ItemKey: "Home"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readItems(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0].ItemKey != "PROJ-2" || got[1].ItemKey != "PROJ-1" || got[2].ItemKey != "Home" {
+		t.Fatalf("items = %+v, want the saved order with the new item last", got)
+	}
+	if !got[0].Applied || got[1].Review == nil || got[1].Review.Decision != "approved" {
+		t.Errorf("item fields were not preserved: %+v", got)
+	}
+	if applied, err := queryItems(dir, "status = ?", []any{migrateStatusApplied}); err != nil || len(applied) != 1 {
+		t.Errorf("status column was not updated by saveItem: %+v, %v", applied, err)
+	}
+}
+
+func TestItemsStoreMigratesLegacyFile(t *testing.T) {
+	dir, items := newFsckWorkspace(t, map[string]string{"PROJ-1": "one", "PROJ-2": "two"})
+
+	// items.db 導入前のワークスペースを再現する
+	legacy := filepath.Join(dir, legacyItemsFile)
+	file, err := os.Create(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := json.NewEncoder(file)
+	for _, item := range items {
+		if err := enc.Encode(item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = file.Close()
+	if err := os.Remove(itemsDBPath(dir)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gitAdd(dir, itemsDBFile, legacyItemsFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := gitCommit(dir, "legacy workspace"); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readItems(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(items) || got[0].ItemKey != items[0].ItemKey || got[1].InputHash != items[1].InputHash {
+		t.Errorf("migrated items = %+v, want %+v", got, items)
+	}
+	if fileExists(legacy) {
+		t.Error("items.jsonl should be removed after the migration")
+	}
+	if !fileExists(itemsDBPath(dir)) {
+		t.Error("items.db was not created")
+	}
+	if gitHasChanges(dir) || gitTracked(dir, legacyItemsFile) || !gitTracked(dir, itemsDBFile) {
+		t.Error("the migration should be committed")
+	}
+}
+
+func TestItemsStoreConcurrentWrites(t *testing.T) {
+	dir := t.TempDir()
+	const n = 20
+	items := make([]migrateItem, n)
+	for i := range items {
+		items[i] = migrateItem{ItemType: "issue", ItemID: i + 1, ItemKey: fmt.Sprintf("PROJ-%d", i+1)}
+	}
+	if err := writeItems(dir, items); err != nil {
+		t.Fatal(err)
+	}
+
+	// preview と apply のように別々の接続から同時に書き込んでも失われない
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := range items {
+		wg.Add(1)
+		go func(item migrateItem) {
+			defer wg.Done()
+			item.Applied = true
+			errs <- saveItem(dir, &item)
+		}(items[i])
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := readItems(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range got {
+		if !item.Applied {
+			t.Errorf("%s lost its update", item.ItemKey)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package markdown
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/markdown"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules [profile]",
+	Short: "Show the conversion rules of each conversion profile",
+	Long: `Show which conversion rules each conversion profile applies.
+
+Safe rules only match Backlog-specific notation and are always applied.
+Unsafe rules may also change text that is already Markdown, so they are
+applied only to content detected as Backlog notation (aggressive also applies
+them to content whose notation cannot be detected).
+
+Select a profile with --conversion-profile (markdown detect/migrate, issue view,
+wiki view, ...) or display.markdown_conversion_profile. Without a profile,
+display.markdown_unsafe_rules is used ("custom").
+
+Examples:
+  backlog markdown rules
+  backlog markdown rules safe
+  backlog markdown rules -o json`,
+	Args: cobra.MaximumNArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return markdown.RuleProfileNames(), cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: runRules,
+}
+
+// conversionProfile は --conversion-profile の値（空なら設定を使う）
+var conversionProfile string
+
+func init() {
+	detectCmd.Flags().StringVar(&conversionProfile, "conversion-profile", "", "Conversion rule preset: "+strings.Join(markdown.RuleProfileNames(), ", ")+" (default: display.markdown_conversion_profile)")
+	migrateCmd.PersistentFlags().StringVar(&conversionProfile, "conversion-profile", "", "Conversion rule preset: "+strings.Join(markdown.RuleProfileNames(), ", ")+" (default: display.markdown_conversion_profile)")
+	MarkdownCmd.AddCommand(rulesCmd)
+}
+
+// resolveRuleProfile は --conversion-profile または設定から変換ルールのプリセットを返す
+func resolveRuleProfile(cfg *config.Store) (*markdown.RuleProfile, error) {
+	display := cfg.Display()
+	name := display.MarkdownConversionProfile
+	if conversionProfile != "" {
+		name = conversionProfile
+	}
+	return markdown.ResolveRuleProfile(name, display.MarkdownUnsafeRules)
+}
+
+// ruleProfileRow は JSON 出力用のプリセット
+type ruleProfileRow struct {
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	ConvertUnknown bool              `json:"convertUnknown"`
+	Rules          []markdown.RuleID `json:"rules"`
+}
+
+func runRules(cmd *cobra.Command, args []string) error {
+	cfg, err := cmdutil.GetConfigStore(cmd)
+	if err != nil {
+		return err
+	}
+	display := cfg.Display()
+	profile := cfg.CurrentProfile()
+
+	presets := make([]markdown.RuleProfile, 0, len(markdown.RuleProfiles)+1)
+	if len(args) == 1 {
+		p, err := markdown.ResolveRuleProfile(args[0], nil)
+		if err != nil {
+			return err
+		}
+		presets = append(presets, *p)
+	} else {
+		presets = append(presets, markdown.RuleProfiles...)
+		if display.MarkdownConversionProfile == "" {
+			presets = append(presets, *markdown.CustomRuleProfile(display.MarkdownUnsafeRules))
+		}
+	}
+
+	allRules := append(append([]markdown.RuleID{}, markdown.SafeRules...), markdown.UnsafeRules...)
+
+	if profile.Output == "json" {
+		rows := make([]ruleProfileRow, len(presets))
+		for i, p := range presets {
+			rows[i] = ruleProfileRow{Name: p.Name, Description: p.Description, ConvertUnknown: p.ConvertUnknown, Rules: []markdown.RuleID{}}
+			for _, r := range allRules {
+				if p.Allows(r) {
+					rows[i].Rules = append(rows[i].Rules, r)
+				}
+			}
+		}
+		return cmdutil.OutputJSONFromProfile(rows, profile.JSONFields, profile.JQ, profile.Template)
+	}
+
+	active := display.MarkdownConversionProfile
+	if active == "" {
+		active = "custom"
+	}
+	headers := []string{"RULE", "KIND"}
+	for _, p := range presets {
+		name := p.Name
+		if name == active {
+			name += "*"
+		}
+		headers = append(headers, strings.ToUpper(name))
+	}
+	table := ui.NewTable(headers...)
+	for _, r := range allRules {
+		kind := "unsafe"
+		for _, safe := range markdown.SafeRules {
+			if safe == r {
+				kind = "safe"
+			}
+		}
+		row := []string{string(r), kind}
+		for _, p := range presets {
+			mark := "-"
+			if p.Allows(r) {
+				mark = "✓"
+			}
+			row = append(row, mark)
+		}
+		table.AddRow(row...)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+
+	fmt.Println()
+	for _, p := range presets {
+		fmt.Printf("%s: %s\n", ui.Bold(p.Name), p.Description)
+	}
+	fmt.Println(ui.Gray("* = current setting (display.markdown_conversion_profile)"))
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -89,8 +90,7 @@ func runCreate(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(version)
 	default:
-		fmt.Printf("%s Milestone created: %s (ID: %d)\n",
-			ui.Green("✓"), version.Name, version.ID)
+		cmdutil.Success(strconv.Itoa(version.ID), "Milestone created: %s (ID: %d)", version.Name, version.ID)
 		return nil
 	}
 }
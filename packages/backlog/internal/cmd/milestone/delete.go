@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -84,7 +85,7 @@ func runDelete(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(deleted)
 	default:
-		fmt.Printf("%s Milestone deleted: %s\n", ui.Green("✓"), deleted.Name)
+		cmdutil.Success(strconv.Itoa(deleted.ID), "Milestone deleted: %s", deleted.Name)
 		return nil
 	}
 }
@@ -4,11 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
-	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 var editCmd = &cobra.Command{
@@ -110,8 +110,7 @@ func runEdit(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(updated)
 	default:
-		fmt.Printf("%s Milestone updated: %s (ID: %d)\n",
-			ui.Green("✓"), updated.Name, updated.ID)
+		cmdutil.Success(strconv.Itoa(updated.ID), "Milestone updated: %s (ID: %d)", updated.Name, updated.ID)
 		return nil
 	}
 }
@@ -14,6 +14,7 @@ var MilestoneCmd = &cobra.Command{
 func init() {
 	MilestoneCmd.AddCommand(listCmd)
 	MilestoneCmd.AddCommand(viewCmd)
+	MilestoneCmd.AddCommand(statusCmd)
 	MilestoneCmd.AddCommand(createCmd)
 	MilestoneCmd.AddCommand(editCmd)
 	MilestoneCmd.AddCommand(deleteCmd)
@@ -0,0 +1,193 @@
+package milestone
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status <id-or-name>",
+	Short: "Show the progress of a milestone",
+	Long: `Show the progress of a milestone for stand-ups.
+
+Displays the completion rate (closed / total issues) with a progress bar,
+the estimated, actual and remaining hours (estimated hours of open issues),
+and the number of days until the due date.
+
+Examples:
+  backlog milestone status v2.0
+  backlog milestone status 123 --project PROJ
+  backlog milestone status v2.0 -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStatus,
+}
+
+// progressBarWidth は進捗バーの幅（文字数）
+const progressBarWidth = 30
+
+// Status はマイルストーンの進捗
+type Status struct {
+	ID             int     `json:"id"`
+	Name           string  `json:"name"`
+	StartDate      string  `json:"startDate,omitempty"`
+	DueDate        string  `json:"dueDate,omitempty"`
+	DaysLeft       *int    `json:"daysLeft,omitempty"`
+	Total          int     `json:"total"`
+	Closed         int     `json:"closed"`
+	Open           int     `json:"open"`
+	Progress       float64 `json:"progress"`
+	EstimatedHours float64 `json:"estimatedHours"`
+	ActualHours    float64 `json:"actualHours"`
+	RemainingHours float64 `json:"remainingHours"`
+	// Unestimated は見積りが無い未完了の課題数（残工数に含まれない）
+	Unestimated int `json:"unestimated"`
+}
+
+func runStatus(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	ctx := c.Context()
+	projectKey := cmdutil.GetCurrentProject(cfg)
+
+	version, err := cmdutil.ResolveMilestone(ctx, client, projectKey, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve milestone: %w", err)
+	}
+
+	closedStatusID, err := cmdutil.ResolveClosedStatusID(ctx, client, projectKey)
+	if err != nil {
+		return err
+	}
+
+	stop := ui.StartProgress(fmt.Sprintf("Collecting issues of %s...", version.Name))
+	issues, err := fetchMilestoneIssues(ctx, client, version)
+	stop()
+	if err != nil {
+		return fmt.Errorf("failed to get issues: %w", err)
+	}
+
+	loc := time.Local
+	if tz := cfg.Display().Timezone; tz != "" {
+		if l, err := time.LoadLocation(tz); err == nil {
+			loc = l
+		}
+	}
+	status := summarizeMilestone(version, issues, closedStatusID, time.Now().In(loc))
+
+	profile := cfg.CurrentProfile()
+	if profile.Output == "json" {
+		return cmdutil.OutputJSONFromProfile(status, profile.JSONFields, profile.JQ, profile.Template)
+	}
+	printStatus(status)
+	return nil
+}
+
+// fetchMilestoneIssues はマイルストーンに含まれる課題をすべて取得する
+func fetchMilestoneIssues(ctx context.Context, client *api.Client, version *api.Version) ([]backlog.Issue, error) {
+	const batchSize = 100
+	var all []backlog.Issue
+	for offset := 0; ; offset += batchSize {
+		issues, err := client.GetIssues(ctx, &api.IssueListOptions{
+			ProjectIDs:   []int{version.ProjectID},
+			MilestoneIDs: []int{version.ID},
+			Count:        batchSize,
+			Offset:       offset,
+			Order:        "asc",
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, issues...)
+		if len(issues) < batchSize {
+			return all, nil
+		}
+	}
+}
+
+// summarizeMilestone は課題の完了数・工数と期限までの日数を集計する
+// closedStatusID のステータスの課題を完了として数える
+// 日数は now の暦日で数え、期限当日は 0、期限切れは負の値になる
+func summarizeMilestone(version *api.Version, issues []backlog.Issue, closedStatusID int, now time.Time) Status {
+	s := Status{
+		ID:        version.ID,
+		Name:      version.Name,
+		StartDate: formatDate(version.StartDate),
+		DueDate:   formatDate(version.ReleaseDueDate),
+		Total:     len(issues),
+	}
+	for i := range issues {
+		issue := &issues[i]
+		estimated, hasEstimate := issue.EstimatedHours.Get()
+		hasEstimate = hasEstimate && !issue.EstimatedHours.IsNull()
+		if hasEstimate {
+			s.EstimatedHours += estimated
+		}
+		if actual, ok := issue.ActualHours.Get(); ok && !issue.ActualHours.IsNull() {
+			s.ActualHours += actual
+		}
+		if issue.Status.Value.ID.Value == closedStatusID {
+			s.Closed++
+			continue
+		}
+		s.Open++
+		if hasEstimate {
+			s.RemainingHours += estimated
+		} else {
+			s.Unestimated++
+		}
+	}
+	if s.Total > 0 {
+		s.Progress = float64(s.Closed) / float64(s.Total)
+	}
+	if s.DueDate != "" {
+		if due, err := time.ParseInLocation("2006-01-02", s.DueDate, now.Location()); err == nil {
+			today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			days := int(math.Round(due.Sub(today).Hours() / 24))
+			s.DaysLeft = &days
+		}
+	}
+	return s
+}
+
+func printStatus(s Status) {
+	fmt.Printf("%s\n\n", ui.Bold(s.Name))
+
+	fmt.Printf("Progress:  %s %.0f%% (%d/%d closed)\n", ui.ProgressBar(s.Progress, progressBarWidth), s.Progress*100, s.Closed, s.Total)
+
+	remaining := formatHours(s.RemainingHours)
+	if s.Unestimated > 0 {
+		remaining += ui.Yellow(fmt.Sprintf(" (%d open issue(s) without estimate)", s.Unestimated))
+	}
+	fmt.Printf("Remaining: %s\n", remaining)
+	fmt.Printf("Hours:     %s estimated / %s actual\n", formatHours(s.EstimatedHours), formatHours(s.ActualHours))
+
+	switch {
+	case s.DaysLeft == nil:
+		fmt.Printf("Due Date:  -\n")
+	case *s.DaysLeft < 0:
+		fmt.Printf("Due Date:  %s %s\n", s.DueDate, ui.Red(fmt.Sprintf("(overdue by %d day(s))", -*s.DaysLeft)))
+	case *s.DaysLeft == 0:
+		fmt.Printf("Due Date:  %s %s\n", s.DueDate, ui.Yellow("(due today)"))
+	default:
+		fmt.Printf("Due Date:  %s (%d day(s) left)\n", s.DueDate, *s.DaysLeft)
+	}
+}
+
+// formatHours は工数を表示用に整形する（合計の浮動小数点誤差を避けるため小数第2位で丸める）
+func formatHours(h float64) string {
+	return strconv.FormatFloat(math.Round(h*100)/100, 'f', -1, 64) + "h"
+}
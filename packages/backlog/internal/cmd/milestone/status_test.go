@@ -0,0 +1,70 @@
+package milestone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func statusIssue(statusID int, estimated, actual *float64) backlog.Issue {
+	issue := backlog.Issue{Status: backlog.NewOptStatus(backlog.Status{ID: backlog.NewOptInt(statusID)})}
+	if estimated != nil {
+		issue.EstimatedHours = backlog.NewOptNilFloat64(*estimated)
+	}
+	if actual != nil {
+		issue.ActualHours = backlog.NewOptNilFloat64(*actual)
+	}
+	return issue
+}
+
+func hours(h float64) *float64 { return &h }
+
+// closedStatusID はテスト用の完了ステータスの ID
+const closedStatusID = 12
+
+func TestSummarizeMilestone(t *testing.T) {
+	version := &api.Version{ID: 10, Name: "v2.0", ReleaseDueDate: "2024-06-30T00:00:00Z"}
+	issues := []backlog.Issue{
+		statusIssue(closedStatusID, hours(3), hours(4)),
+		statusIssue(closedStatusID, hours(2), nil),
+		statusIssue(2, hours(5), hours(1.5)),
+		statusIssue(1, nil, nil),
+	}
+	now := time.Date(2024, 6, 25, 18, 0, 0, 0, time.UTC)
+
+	s := summarizeMilestone(version, issues, closedStatusID, now)
+	if s.Total != 4 || s.Closed != 2 || s.Open != 2 {
+		t.Errorf("counts = %d/%d/%d, want 4/2/2", s.Total, s.Closed, s.Open)
+	}
+	if s.Progress != 0.5 {
+		t.Errorf("Progress = %v, want 0.5", s.Progress)
+	}
+	if s.EstimatedHours != 10 || s.ActualHours != 5.5 || s.RemainingHours != 5 {
+		t.Errorf("hours = %v/%v/%v, want 10/5.5/5", s.EstimatedHours, s.ActualHours, s.RemainingHours)
+	}
+	if s.Unestimated != 1 {
+		t.Errorf("Unestimated = %d, want 1", s.Unestimated)
+	}
+	if s.DaysLeft == nil || *s.DaysLeft != 5 {
+		t.Errorf("DaysLeft = %v, want 5", s.DaysLeft)
+	}
+
+	// 期限当日は 0、期限切れは負の値
+	if s := summarizeMilestone(version, nil, closedStatusID, time.Date(2024, 6, 30, 23, 0, 0, 0, time.UTC)); s.DaysLeft == nil || *s.DaysLeft != 0 || s.Progress != 0 {
+		t.Errorf("on due date: DaysLeft = %v, Progress = %v", s.DaysLeft, s.Progress)
+	}
+	if s := summarizeMilestone(version, nil, closedStatusID, time.Date(2024, 7, 2, 9, 0, 0, 0, time.UTC)); s.DaysLeft == nil || *s.DaysLeft != -2 {
+		t.Errorf("overdue: DaysLeft = %v, want -2", s.DaysLeft)
+	}
+	if s := summarizeMilestone(&api.Version{Name: "no due"}, nil, closedStatusID, now); s.DaysLeft != nil {
+		t.Errorf("without due date: DaysLeft = %v, want nil", *s.DaysLeft)
+	}
+}
+
+func TestFormatHours(t *testing.T) {
+	if got := formatHours(0.1 + 0.2); got != "0.3h" {
+		t.Errorf("formatHours(0.1+0.2) = %q, want 0.3h", got)
+	}
+}
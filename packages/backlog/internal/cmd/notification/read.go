@@ -6,7 +6,6 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
-	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 var readCmd = &cobra.Command{
@@ -40,7 +39,7 @@ func runRead(c *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to mark all as read: %w", err)
 		}
-		fmt.Printf("%s Marked %d notifications as read\n", ui.Green("✓"), count)
+		cmdutil.Success("", "Marked %d notifications as read", count)
 		return nil
 	}
 
@@ -58,6 +57,6 @@ func runRead(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to mark as read: %w", err)
 	}
 
-	fmt.Printf("%s Notification %d marked as read\n", ui.Green("✓"), notificationID)
+	cmdutil.Success("", "Notification %d marked as read", notificationID)
 	return nil
 }
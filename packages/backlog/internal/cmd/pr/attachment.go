@@ -210,7 +210,7 @@ func runPRAttachmentDelete(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to delete attachment: %w", err)
 	}
 
-	ui.Success("Deleted attachment: %s", att.Name)
+	cmdutil.Success("", "Deleted attachment: %s", att.Name)
 	return nil
 }
 
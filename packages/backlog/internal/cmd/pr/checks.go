@@ -0,0 +1,191 @@
+package pr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// checkState は CI ステータスの状態
+type checkState string
+
+const (
+	checkPending checkState = "pending"
+	checkSuccess checkState = "success"
+	checkFailure checkState = "failure"
+)
+
+// checksMaxConsecutiveErrors はチェックコマンドの連続失敗をいくつまで許容するか
+const checksMaxConsecutiveErrors = 3
+
+// parseCheckState はチェックコマンドの出力から状態を判定する
+// 出力の最後の空でない行の先頭の語を状態として扱う
+func parseCheckState(output string) (checkState, string, bool) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			continue
+		}
+		word, detail, _ := strings.Cut(line, " ")
+		detail = strings.TrimSpace(detail)
+		switch strings.ToLower(strings.Trim(word, ":")) {
+		case "pending", "queued", "running", "in_progress", "waiting":
+			return checkPending, detail, true
+		case "success", "passed", "ok", "completed":
+			return checkSuccess, detail, true
+		case "failure", "failed", "error", "cancelled", "canceled":
+			return checkFailure, detail, true
+		}
+		return "", line, false
+	}
+	return "", "", false
+}
+
+// checkTarget はチェックコマンドに渡す PR の情報
+type checkTarget struct {
+	ProjectKey string
+	Repo       string
+	Number     int
+	Branch     string
+	Base       string
+	URL        string
+}
+
+func (t checkTarget) env() []string {
+	return []string{
+		"BACKLOG_PROJECT=" + t.ProjectKey,
+		"BACKLOG_REPO=" + t.Repo,
+		fmt.Sprintf("BACKLOG_PR_NUMBER=%d", t.Number),
+		"BACKLOG_PR_BRANCH=" + t.Branch,
+		"BACKLOG_PR_BASE=" + t.Base,
+		"BACKLOG_PR_URL=" + t.URL,
+	}
+}
+
+// checkRunner はチェックコマンドを 1 回実行し、その出力を返す
+type checkRunner func(ctx context.Context) (string, error)
+
+// newShellCheckRunner はシェル経由でチェックコマンドを実行する checkRunner を返す
+func newShellCheckRunner(command string, target checkTarget) checkRunner {
+	return func(ctx context.Context) (string, error) {
+		var stdout, stderr bytes.Buffer
+		if err := cmdutil.RunShellIO(ctx, command, target.env(), nil, &stdout, &stderr); err != nil {
+			// 状態を出力したうえで非 0 終了するコマンドも受け付ける
+			if _, _, ok := parseCheckState(stdout.String()); ok {
+				return stdout.String(), nil
+			}
+			if detail := strings.TrimSpace(stderr.String()); detail != "" {
+				return "", fmt.Errorf("%w: %s", err, detail)
+			}
+			return "", err
+		}
+		return stdout.String(), nil
+	}
+}
+
+// checksWatcher は CI ステータスを完了までポーリングする
+type checksWatcher struct {
+	Run      checkRunner
+	Interval time.Duration
+	Timeout  time.Duration
+	Out      io.Writer
+	Sleep    func(ctx context.Context, d time.Duration) error
+}
+
+// Watch は成功なら nil、失敗・タイムアウトならエラーを返す
+func (w *checksWatcher) Watch(ctx context.Context) error {
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+	sleep := w.Sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+
+	errCount := 0
+	var last string
+	for {
+		output, err := w.Run(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return checksContextError(ctx, w.Timeout)
+			}
+			errCount++
+			_, _ = fmt.Fprintf(w.Out, "%s checks command failed: %v\n", ui.Yellow("!"), err)
+			if errCount >= checksMaxConsecutiveErrors {
+				return fmt.Errorf("checks command failed %d times in a row: %w", errCount, err)
+			}
+		} else {
+			errCount = 0
+			state, detail, ok := parseCheckState(output)
+			if !ok {
+				return fmt.Errorf("unrecognized checks status: %q (expected pending, success or failure)", detail)
+			}
+			line := formatCheckLine(state, detail)
+			switch state {
+			case checkSuccess:
+				_, _ = fmt.Fprintln(w.Out, line)
+				return nil
+			case checkFailure:
+				_, _ = fmt.Fprintln(w.Out, line)
+				if detail != "" {
+					return fmt.Errorf("checks failed: %s", detail)
+				}
+				return errors.New("checks failed")
+			}
+			// 状態が変わったときだけ表示する
+			if line != last {
+				_, _ = fmt.Fprintln(w.Out, line)
+				last = line
+			}
+		}
+
+		if err := sleep(ctx, w.Interval); err != nil {
+			return checksContextError(ctx, w.Timeout)
+		}
+	}
+}
+
+func formatCheckLine(state checkState, detail string) string {
+	var mark string
+	switch state {
+	case checkSuccess:
+		mark = ui.OKMark()
+	case checkFailure:
+		mark = ui.FailMark()
+	default:
+		mark = ui.Yellow("*")
+	}
+	if detail == "" {
+		return fmt.Sprintf("%s Checks %s", mark, state)
+	}
+	return fmt.Sprintf("%s Checks %s: %s", mark, state, detail)
+}
+
+func checksContextError(ctx context.Context, timeout time.Duration) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for checks after %s", timeout)
+	}
+	return ctx.Err()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
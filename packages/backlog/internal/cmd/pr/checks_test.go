@@ -0,0 +1,122 @@
+package pr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCheckState(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		wantState  checkState
+		wantDetail string
+		wantOK     bool
+	}{
+		{name: "pending", output: "pending\n", wantState: checkPending, wantOK: true},
+		{name: "running with detail", output: "running build #12", wantState: checkPending, wantDetail: "build #12", wantOK: true},
+		{name: "last line wins", output: "pending\nsuccess all green\n\n", wantState: checkSuccess, wantDetail: "all green", wantOK: true},
+		{name: "failure case insensitive", output: "FAILED: lint", wantState: checkFailure, wantDetail: "lint", wantOK: true},
+		{name: "unknown", output: "hello", wantDetail: "hello", wantOK: false},
+		{name: "empty", output: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, detail, ok := parseCheckState(tt.output)
+			if state != tt.wantState || detail != tt.wantDetail || ok != tt.wantOK {
+				t.Errorf("parseCheckState(%q) = (%q, %q, %v), want (%q, %q, %v)",
+					tt.output, state, detail, ok, tt.wantState, tt.wantDetail, tt.wantOK)
+			}
+		})
+	}
+}
+
+func newTestWatcher(outputs []string, errs []error) (*checksWatcher, *bytes.Buffer, *int) {
+	calls := 0
+	var out bytes.Buffer
+	w := &checksWatcher{
+		Run: func(ctx context.Context) (string, error) {
+			i := calls
+			calls++
+			if i >= len(outputs) {
+				i = len(outputs) - 1
+			}
+			var err error
+			if i < len(errs) {
+				err = errs[i]
+			}
+			return outputs[i], err
+		},
+		Interval: time.Second,
+		Out:      &out,
+		Sleep:    func(ctx context.Context, d time.Duration) error { return ctx.Err() },
+	}
+	return w, &out, &calls
+}
+
+func TestChecksWatcher(t *testing.T) {
+	t.Run("success after pending", func(t *testing.T) {
+		w, out, calls := newTestWatcher([]string{"pending", "pending", "success"}, nil)
+		if err := w.Watch(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if *calls != 3 {
+			t.Errorf("calls = %d, want 3", *calls)
+		}
+		// 同じ状態は 1 度だけ表示する
+		if got := strings.Count(out.String(), "pending"); got != 1 {
+			t.Errorf("pending printed %d times, want 1: %q", got, out.String())
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		w, _, _ := newTestWatcher([]string{"failure unit tests"}, nil)
+		err := w.Watch(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "unit tests") {
+			t.Fatalf("expected failure error, got %v", err)
+		}
+	})
+
+	t.Run("transient errors are retried", func(t *testing.T) {
+		boom := errors.New("boom")
+		w, _, _ := newTestWatcher([]string{"", "success"}, []error{boom})
+		if err := w.Watch(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("consecutive errors abort", func(t *testing.T) {
+		boom := errors.New("boom")
+		w, _, calls := newTestWatcher([]string{"", "", ""}, []error{boom, boom, boom})
+		if err := w.Watch(context.Background()); !errors.Is(err, boom) {
+			t.Fatalf("expected boom, got %v", err)
+		}
+		if *calls != checksMaxConsecutiveErrors {
+			t.Errorf("calls = %d, want %d", *calls, checksMaxConsecutiveErrors)
+		}
+	})
+
+	t.Run("unrecognized output", func(t *testing.T) {
+		w, _, _ := newTestWatcher([]string{"???"}, nil)
+		if err := w.Watch(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		w, _, _ := newTestWatcher([]string{"pending"}, nil)
+		w.Timeout = time.Nanosecond
+		w.Sleep = func(ctx context.Context, d time.Duration) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		err := w.Watch(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "timed out") {
+			t.Fatalf("expected timeout error, got %v", err)
+		}
+	})
+}
@@ -112,8 +112,7 @@ func runClose(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(closed)
 	default:
-		fmt.Printf("%s Pull request closed: #%d %s\n",
-			ui.Green("✓"), closed.Number, closed.Summary)
+		cmdutil.Success(strconv.Itoa(closed.Number), "Pull request closed: #%d %s", closed.Number, closed.Summary)
 		return nil
 	}
 }
@@ -116,10 +116,12 @@ func runComment(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(comment)
 	default:
-		fmt.Printf("%s Comment added to PR #%d\n", ui.Green("✓"), prNumber)
+		cmdutil.Success(strconv.Itoa(comment.ID), "Comment added to PR #%d", prNumber)
 		url := fmt.Sprintf("https://%s/git/%s/%s/pullRequests/%d#comment-%d",
 			profile.Space, projectKey, commentRepo, prNumber, comment.ID)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
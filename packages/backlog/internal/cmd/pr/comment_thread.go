@@ -0,0 +1,128 @@
+package pr
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+// prCommentThread は同じファイル・行に付いたインラインコメントのまとまり
+// FilePath が空のスレッドは通常のコメントをまとめたもの
+type prCommentThread struct {
+	FilePath string
+	Line     int
+	Comments []api.PRComment
+}
+
+// groupPRCommentThreads はコメントを通常のコメントとファイル・行ごとのスレッドに分ける
+// 通常のコメントを先頭に置き、インラインコメントはファイルパス・行番号順に並べる
+// 各スレッド内は元の順序（投稿順）を保つ
+func groupPRCommentThreads(comments []api.PRComment) []prCommentThread {
+	type position struct {
+		path string
+		line int
+	}
+	var general []api.PRComment
+	inline := make(map[position]*prCommentThread)
+	var keys []position
+	for _, c := range comments {
+		if !c.IsInline() {
+			general = append(general, c)
+			continue
+		}
+		key := position{c.FilePath, c.Line}
+		t, ok := inline[key]
+		if !ok {
+			t = &prCommentThread{FilePath: c.FilePath, Line: c.Line}
+			inline[key] = t
+			keys = append(keys, key)
+		}
+		t.Comments = append(t.Comments, c)
+	}
+
+	threads := make([]prCommentThread, 0, len(keys)+1)
+	if len(general) > 0 {
+		threads = append(threads, prCommentThread{Comments: general})
+	}
+	inlineThreads := make([]prCommentThread, 0, len(keys))
+	for _, key := range keys {
+		inlineThreads = append(inlineThreads, *inline[key])
+	}
+	sort.SliceStable(inlineThreads, func(i, j int) bool {
+		if inlineThreads[i].FilePath != inlineThreads[j].FilePath {
+			return inlineThreads[i].FilePath < inlineThreads[j].FilePath
+		}
+		return inlineThreads[i].Line < inlineThreads[j].Line
+	})
+	return append(threads, inlineThreads...)
+}
+
+// filterPRCommentsByFiles は指定したファイルに付いたインラインコメントだけを返す
+func filterPRCommentsByFiles(comments []api.PRComment, files []string) []api.PRComment {
+	filtered := make([]api.PRComment, 0, len(comments))
+	for _, c := range comments {
+		if !c.IsInline() {
+			continue
+		}
+		for _, pattern := range files {
+			if matchPRCommentFile(c.FilePath, pattern) {
+				filtered = append(filtered, c)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// matchPRCommentFile はファイルパスが --files の指定に一致するかを返す
+// 完全一致のほか、ディレクトリ指定（src/ 配下）と glob パターン（*.go）を受け付ける
+func matchPRCommentFile(filePath, pattern string) bool {
+	filePath = strings.TrimPrefix(filePath, "/")
+	pattern = strings.TrimPrefix(strings.TrimSpace(pattern), "/")
+	if pattern == "" {
+		return false
+	}
+	if filePath == pattern {
+		return true
+	}
+	if dir := strings.TrimSuffix(pattern, "/"); strings.HasPrefix(filePath, dir+"/") {
+		return true
+	}
+	if ok, err := path.Match(pattern, filePath); err == nil && ok {
+		return true
+	}
+	// ディレクトリを含まないパターン（*.go）はファイル名に対しても照合する
+	if !strings.Contains(pattern, "/") {
+		if ok, err := path.Match(pattern, path.Base(filePath)); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// prCommentPageSize はコメント取得 1 回あたりの件数（API の上限）
+const prCommentPageSize = 100
+
+// fetchAllPRComments はプルリクエストのコメントを投稿順にすべて取得する
+func fetchAllPRComments(ctx context.Context, client *api.Client, projectKey, repo string, number int) ([]api.PRComment, error) {
+	var all []api.PRComment
+	minID := 0
+	for {
+		batch, err := client.GetPullRequestComments(ctx, projectKey, repo, number, &api.PRCommentListOptions{
+			MinID: minID,
+			Count: prCommentPageSize,
+			Order: "asc",
+		})
+		if err != nil {
+			return all, err
+		}
+		all = append(all, batch...)
+		if len(batch) < prCommentPageSize {
+			return all, nil
+		}
+		minID = batch[len(batch)-1].ID + 1
+	}
+}
@@ -0,0 +1,93 @@
+package pr
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestGroupPRCommentThreads(t *testing.T) {
+	comments := []api.PRComment{
+		{ID: 1, Content: "general 1"},
+		{ID: 2, FilePath: "src/foo.go", Line: 42},
+		{ID: 3, FilePath: "src/bar.go", Line: 10},
+		{ID: 4, Content: "general 2"},
+		{ID: 5, FilePath: "src/foo.go", Line: 42},
+		{ID: 6, FilePath: "src/foo.go", Line: 7},
+	}
+	threads := groupPRCommentThreads(comments)
+
+	type summary struct {
+		Path string
+		Line int
+		IDs  []int
+	}
+	got := make([]summary, 0, len(threads))
+	for _, th := range threads {
+		s := summary{Path: th.FilePath, Line: th.Line}
+		for _, c := range th.Comments {
+			s.IDs = append(s.IDs, c.ID)
+		}
+		got = append(got, s)
+	}
+	want := []summary{
+		{IDs: []int{1, 4}},
+		{Path: "src/bar.go", Line: 10, IDs: []int{3}},
+		{Path: "src/foo.go", Line: 7, IDs: []int{6}},
+		{Path: "src/foo.go", Line: 42, IDs: []int{2, 5}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groupPRCommentThreads() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMatchPRCommentFile(t *testing.T) {
+	tests := []struct {
+		filePath string
+		pattern  string
+		want     bool
+	}{
+		{filePath: "src/foo.go", pattern: "src/foo.go", want: true},
+		{filePath: "/src/foo.go", pattern: "src/foo.go", want: true},
+		{filePath: "src/foo.go", pattern: "src", want: true},
+		{filePath: "src/foo.go", pattern: "src/", want: true},
+		{filePath: "srcx/foo.go", pattern: "src", want: false},
+		{filePath: "src/foo.go", pattern: "*.go", want: true},
+		{filePath: "src/foo.go", pattern: "src/*.go", want: true},
+		{filePath: "src/foo.ts", pattern: "*.go", want: false},
+		{filePath: "src/foo.go", pattern: "foo", want: false},
+		{filePath: "src/foo.go", pattern: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := matchPRCommentFile(tt.filePath, tt.pattern); got != tt.want {
+			t.Errorf("matchPRCommentFile(%q, %q) = %v, want %v", tt.filePath, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestFilterPRCommentsByFiles(t *testing.T) {
+	comments := []api.PRComment{
+		{ID: 1},
+		{ID: 2, FilePath: "src/foo.go", Line: 1},
+		{ID: 3, FilePath: "web/app.ts", Line: 2},
+		{ID: 4, FilePath: "docs/readme.md", Line: 3},
+	}
+	got := filterPRCommentsByFiles(comments, []string{"src/foo.go", "*.ts"})
+	var ids []int
+	for _, c := range got {
+		ids = append(ids, c.ID)
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("filterPRCommentsByFiles() ids = %v, want %v", ids, want)
+	}
+}
+
+func TestIndentLines(t *testing.T) {
+	if got := indentLines("a\n\nb", "  "); got != "  a\n\n  b" {
+		t.Errorf("indentLines() = %q", got)
+	}
+	if got := indentLines("a\nb", ""); got != "a\nb" {
+		t.Errorf("indentLines() without indent = %q", got)
+	}
+}
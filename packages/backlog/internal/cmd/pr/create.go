@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -31,8 +33,28 @@ Examples:
   # Interactive mode
   backlog pr create --repo myrepo
 
+  # Use the current branch as head and the default branch of origin as base,
+  # pushing the branch first if needed
+  backlog pr create --repo myrepo --title "My PR" --push
+
   # Minimal (will prompt for missing fields)
-  backlog pr create --repo myrepo --base main --head feature/xxx`,
+  backlog pr create --repo myrepo --base main --head feature/xxx
+
+  # Wait for CI after creation (the command prints pending/success/failure)
+  backlog pr create --repo myrepo --base main --head feature/xxx --title "My PR" \
+    --watch-checks --checks-command './scripts/ci-status.sh'
+
+When --head is omitted, the current git branch is used. When --base is omitted,
+the default branch of the remote (--remote, default: origin) is used. If the head
+branch is the current branch and has not been pushed (or has unpushed commits),
+the command offers to run "git push -u <remote> HEAD" before creating the pull
+request. --push pushes without asking, --no-push skips the check.
+
+Backlog does not provide CI statuses, so --watch-checks polls the command given
+by --checks-command. The command receives BACKLOG_PROJECT, BACKLOG_REPO,
+BACKLOG_PR_NUMBER, BACKLOG_PR_BRANCH, BACKLOG_PR_BASE and BACKLOG_PR_URL, and
+must print "pending", "success" or "failure" (optionally followed by details)
+on its last output line. The exit code is 0 on success and 1 on failure or timeout.`,
 	RunE: runCreate,
 }
 
@@ -46,6 +68,14 @@ var (
 	createIssueID   int
 	createAssignee  string
 	createReviewers string
+	createRemote    string
+	createPush      bool
+	createNoPush    bool
+
+	createWatchChecks    bool
+	createChecksCommand  string
+	createChecksInterval time.Duration
+	createChecksTimeout  time.Duration
 )
 
 func init() {
@@ -58,6 +88,13 @@ func init() {
 	createCmd.Flags().IntVar(&createIssueID, "issue", 0, "Related issue ID")
 	createCmd.Flags().StringVar(&createAssignee, "assignee", "", "Assignee (user ID, userId, display name, or @me)")
 	createCmd.Flags().StringVar(&createReviewers, "reviewer", "", "Reviewer IDs, userIds, or display names (comma-separated)")
+	createCmd.Flags().StringVar(&createRemote, "remote", "origin", "Git remote used to detect the base branch and to push the head branch")
+	createCmd.Flags().BoolVar(&createPush, "push", false, "Push the current branch without asking if it is not pushed")
+	createCmd.Flags().BoolVar(&createNoPush, "no-push", false, "Do not check whether the head branch is pushed")
+	createCmd.Flags().BoolVar(&createWatchChecks, "watch-checks", false, "Wait for CI checks after creation and reflect the result in the exit code")
+	createCmd.Flags().StringVar(&createChecksCommand, "checks-command", "", "Command that prints the CI status (pending, success or failure)")
+	createCmd.Flags().DurationVar(&createChecksInterval, "checks-interval", 10*time.Second, "Polling interval for --watch-checks")
+	createCmd.Flags().DurationVar(&createChecksTimeout, "checks-timeout", 30*time.Minute, "Give up waiting for checks after this duration (0 = no limit)")
 	_ = createCmd.MarkFlagRequired("repo")
 }
 
@@ -75,6 +112,33 @@ func runCreate(c *cobra.Command, args []string) error {
 	profile := cfg.CurrentProfile()
 	interactive := ui.IsInteractiveInput()
 
+	if createWatchChecks && createChecksCommand == "" {
+		return fmt.Errorf("--watch-checks requires --checks-command")
+	}
+	if createWatchChecks && createChecksInterval <= 0 {
+		return fmt.Errorf("--checks-interval must be positive")
+	}
+	if createPush && createNoPush {
+		return fmt.Errorf("--push and --no-push cannot be used together")
+	}
+
+	// --head / --base 未指定時はローカルの git リポジトリから補完する
+	if createHead == "" {
+		if branch, err := currentBranch(); err == nil {
+			createHead = branch
+			cmdutil.Progressf("Head branch: %s (current branch)", branch)
+		}
+	}
+	if createBase == "" {
+		if branch, err := defaultBranch(createRemote); err == nil {
+			createBase = branch
+			cmdutil.Progressf("Base branch: %s (default branch of %s)", branch, createRemote)
+		}
+	}
+	if createBase != "" && createBase == createHead {
+		return fmt.Errorf("head branch %q is the same as the base branch; check out a topic branch or use --head", createHead)
+	}
+
 	if !interactive {
 		var missing []string
 		if createBase == "" {
@@ -165,6 +229,10 @@ func runCreate(c *cobra.Command, args []string) error {
 		}
 	}
 
+	if err := ensureHeadPushed(c, createHead, interactive); err != nil {
+		return err
+	}
+
 	// PR作成
 	input := &api.CreatePullRequestInput{
 		Summary:         createTitle,
@@ -181,18 +249,77 @@ func runCreate(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create pull request: %w", err)
 	}
 
+	url := fmt.Sprintf("https://%s/git/%s/%s/pullRequests/%d",
+		profile.Space, projectKey, createRepo, pr.Number)
+
 	// 出力
 	switch profile.Output {
 	case "json":
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		return enc.Encode(pr)
+		if err := enc.Encode(pr); err != nil {
+			return err
+		}
 	default:
-		fmt.Printf("%s Pull request created: #%d %s\n",
-			ui.Green("✓"), pr.Number, pr.Summary)
-		url := fmt.Sprintf("https://%s/git/%s/%s/pullRequests/%d",
-			profile.Space, projectKey, createRepo, pr.Number)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		cmdutil.Success(strconv.Itoa(pr.Number), "Pull request created: #%d %s", pr.Number, pr.Summary)
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
+	}
+
+	if !createWatchChecks {
+		return nil
+	}
+
+	// JSON 出力を汚さないよう、進捗は標準エラー出力に出す
+	target := checkTarget{
+		ProjectKey: projectKey,
+		Repo:       createRepo,
+		Number:     pr.Number,
+		Branch:     createHead,
+		Base:       createBase,
+		URL:        url,
+	}
+	watcher := &checksWatcher{
+		Run:      newShellCheckRunner(createChecksCommand, target),
+		Interval: createChecksInterval,
+		Timeout:  createChecksTimeout,
+		Out:      os.Stderr,
+	}
+	return watcher.Watch(c.Context())
+}
+
+// ensureHeadPushed は head がカレントブランチで未 push の場合に git push -u <remote> HEAD を行う
+// --push / --yes なら確認せずに push し、対話できない場合はエラーにする
+func ensureHeadPushed(c *cobra.Command, head string, interactive bool) error {
+	if createNoPush {
+		return nil
+	}
+	state, err := branchState()
+	if err != nil || state.Branch != head || !state.needsPush() {
+		// git 管理外や別ブランチを head に指定した場合は確認しない
 		return nil
 	}
+
+	reason := fmt.Sprintf("Branch %s is not pushed to %s", head, createRemote)
+	if state.Upstream != "" {
+		reason = fmt.Sprintf("Branch %s has %d commit(s) not pushed to %s", head, state.Ahead, state.Upstream)
+	}
+	if !createPush && !cmdutil.SkipConfirmation(c) {
+		if !interactive {
+			return cmdutil.NonInteractiveFlagError(
+				reason+".",
+				"backlog pr create",
+				fmt.Sprintf("Use --push to run 'git push -u %s HEAD' first, or --no-push to create the pull request anyway.", createRemote),
+			)
+		}
+		push, err := ui.Confirm(fmt.Sprintf("%s. Push it now (git push -u %s HEAD)?", reason, createRemote), true)
+		if err != nil {
+			return err
+		}
+		if !push {
+			return nil
+		}
+	}
+	return pushCurrentBranch(createRemote)
 }
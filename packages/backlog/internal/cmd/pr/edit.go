@@ -9,7 +9,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
-	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 var editCmd = &cobra.Command{
@@ -112,8 +111,7 @@ func runEdit(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(pr)
 	default:
-		fmt.Printf("%s Pull request updated: #%d %s\n",
-			ui.Green("✓"), pr.Number, pr.Summary)
+		cmdutil.Success(strconv.Itoa(pr.Number), "Pull request updated: #%d %s", pr.Number, pr.Summary)
 		return nil
 	}
 }
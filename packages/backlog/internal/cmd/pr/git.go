@@ -0,0 +1,116 @@
+package pr
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// localBranchState はローカルブランチとリモートの同期状態
+type localBranchState struct {
+	Branch   string // カレントブランチ名
+	Upstream string // 追跡ブランチ（"origin/feature/xxx"）。未設定なら空
+	Ahead    int    // 追跡ブランチに push されていないコミット数
+}
+
+// needsPush は PR 作成前に push が必要かを返す
+func (s localBranchState) needsPush() bool {
+	return s.Upstream == "" || s.Ahead > 0
+}
+
+// currentBranch はカレントブランチ名を返す（detached HEAD や git 管理外ではエラー）
+func currentBranch() (string, error) {
+	out, err := runGit("symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// defaultBranch はリモートのデフォルトブランチ名を返す
+// ローカルの refs/remotes/<remote>/HEAD を優先し、無ければ git ls-remote でリモートに問い合わせる
+func defaultBranch(remote string) (string, error) {
+	if out, err := runGit("symbolic-ref", "--quiet", "--short", "refs/remotes/"+remote+"/HEAD"); err == nil {
+		if branch, ok := strings.CutPrefix(strings.TrimSpace(string(out)), remote+"/"); ok && branch != "" {
+			return branch, nil
+		}
+	}
+	out, err := runGit("ls-remote", "--symref", remote, "HEAD")
+	if err != nil {
+		return "", err
+	}
+	if branch := parseSymrefHead(string(out)); branch != "" {
+		return branch, nil
+	}
+	return "", fmt.Errorf("could not detect the default branch of %s", remote)
+}
+
+// parseSymrefHead は git ls-remote --symref <remote> HEAD の出力から HEAD が指すブランチ名を取り出す
+//
+//	ref: refs/heads/main	HEAD
+//	0123abcd...	HEAD
+func parseSymrefHead(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		ref, target, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if !ok || target != "HEAD" {
+			continue
+		}
+		if branch, ok := strings.CutPrefix(ref, "ref: refs/heads/"); ok {
+			return branch
+		}
+	}
+	return ""
+}
+
+// branchState はカレントブランチの追跡ブランチと未 push のコミット数を調べる
+func branchState() (localBranchState, error) {
+	branch, err := currentBranch()
+	if err != nil {
+		return localBranchState{}, err
+	}
+	state := localBranchState{Branch: branch}
+	out, err := runGit("rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{upstream}")
+	if err != nil {
+		// 追跡ブランチが未設定（未 push）
+		return state, nil
+	}
+	state.Upstream = strings.TrimSpace(string(out))
+	out, err = runGit("rev-list", "--count", "@{upstream}..HEAD")
+	if err != nil {
+		return state, err
+	}
+	state.Ahead, err = strconv.Atoi(strings.TrimSpace(string(out)))
+	if err != nil {
+		return state, fmt.Errorf("unexpected git rev-list output: %q", out)
+	}
+	return state, nil
+}
+
+// pushCurrentBranch は git push -u <remote> HEAD を実行する
+// git の出力は JSON 出力を汚さないよう標準エラー出力に流す
+func pushCurrentBranch(remote string) error {
+	cmd := exec.Command("git", "push", "-u", remote, "HEAD")
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git push -u %s HEAD: %w", remote, err)
+	}
+	return nil
+}
+
+func runGit(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return nil, fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return out, nil
+}
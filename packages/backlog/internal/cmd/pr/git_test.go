@@ -0,0 +1,70 @@
+package pr
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseSymrefHead(t *testing.T) {
+	out := "ref: refs/heads/develop\tHEAD\n0123456789abcdef0123456789abcdef01234567\tHEAD\n"
+	if got := parseSymrefHead(out); got != "develop" {
+		t.Errorf("parseSymrefHead() = %q, want develop", got)
+	}
+	if got := parseSymrefHead("0123456789abcdef0123456789abcdef01234567\tHEAD\n"); got != "" {
+		t.Errorf("parseSymrefHead() without symref = %q, want empty", got)
+	}
+}
+
+func TestBranchDetection(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	root := t.TempDir()
+	remote := filepath.Join(root, "remote.git")
+	work := filepath.Join(root, "work")
+	git := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	git(root, "init", "--bare", "--initial-branch=trunk", remote)
+	git(root, "clone", remote, work)
+	git(work, "commit", "--allow-empty", "-m", "init")
+	git(work, "push", "origin", "HEAD")
+	git(work, "checkout", "-b", "feature/x")
+	git(work, "commit", "--allow-empty", "-m", "work")
+	t.Chdir(work)
+
+	if got, err := defaultBranch("origin"); err != nil || got != "trunk" {
+		t.Errorf("defaultBranch() = %q, %v, want trunk", got, err)
+	}
+
+	state, err := branchState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Branch != "feature/x" || state.Upstream != "" || !state.needsPush() {
+		t.Errorf("state before push = %+v, want unpushed feature/x", state)
+	}
+
+	if err := pushCurrentBranch("origin"); err != nil {
+		t.Fatal(err)
+	}
+	if state, err = branchState(); err != nil || state.needsPush() {
+		t.Errorf("state after push = %+v, %v, want pushed", state, err)
+	}
+
+	git(work, "commit", "--allow-empty", "-m", "more")
+	if state, err = branchState(); err != nil || state.Upstream != "origin/feature/x" || state.Ahead != 1 {
+		t.Errorf("state with a new commit = %+v, %v, want 1 commit ahead of origin/feature/x", state, err)
+	}
+}
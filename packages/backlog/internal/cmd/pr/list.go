@@ -1,15 +1,19 @@
 package pr
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/query"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -32,8 +36,25 @@ Examples:
   # Filter by linked issue
   backlog pr list --repo myrepo --issue PROJ-123
 
+  # Search with the query DSL (keys: state, author, assignee, issue)
+  backlog pr list --repo myrepo --query 'state:merged author:@me'
+
   # Open PR list in browser
-  backlog pr list --repo myrepo --web`,
+  backlog pr list --repo myrepo --web
+
+  # Open pull requests not updated for 14 days, as a Slack reminder
+  backlog pr list --repo myrepo --stale 14d
+  backlog pr list --repo myrepo --stale 14d --format slack
+
+--stale fetches all open pull requests and keeps those whose last update is
+older than the given duration (e.g. 14d, 72h), oldest first. With --format
+slack a reminder text mentioning each assignee (or the author when
+unassigned) as @userId is printed; nothing is printed when no pull request
+is stale, so it can be piped to a Slack webhook from a scheduled job. Any
+other --format value is used as a Go template for JSON output.
+
+PRs with unread notifications are marked with "●" ("●@" when you are
+mentioned). Use --no-notifications to skip the notification lookup.`,
 	RunE: runList,
 }
 
@@ -46,6 +67,10 @@ var (
 	listAuthor   string
 	listAssignee string
 	listIssue    string
+	listNoNotify bool
+	listQuery    string
+	listStale    string
+	listFormat   string
 )
 
 func init() {
@@ -57,10 +82,35 @@ func init() {
 	listCmd.Flags().StringVarP(&listAuthor, "author", "A", "", "Filter by author (user ID, userId, display name, or @me)")
 	listCmd.Flags().StringVarP(&listAssignee, "assignee", "a", "", "Filter by assignee (user ID, userId, display name, or @me)")
 	listCmd.Flags().StringVar(&listIssue, "issue", "", "Filter by linked issue IDs or keys (comma-separated)")
+	listCmd.Flags().BoolVar(&listNoNotify, "no-notifications", false, "Do not mark pull requests with unread notifications")
+	listCmd.Flags().StringVarP(&listQuery, "query", "q", "", "Search query (e.g. 'state:merged author:@me issue:PROJ-1')")
+	listCmd.Flags().StringVar(&listStale, "stale", "", "Show only open pull requests not updated for this long (e.g. 14d, 72h)")
+	listCmd.Flags().StringVarP(&listFormat, "format", "f", "", "Output format: slack (reminder text, requires --stale) or a Go template for JSON output")
 	_ = listCmd.MarkFlagRequired("repo")
 }
 
 func runList(c *cobra.Command, args []string) error {
+	if listQuery != "" {
+		if err := applyPRListQuery(c, listQuery); err != nil {
+			return err
+		}
+	}
+
+	var staleFor time.Duration
+	if listStale != "" {
+		d, err := cmdutil.ParseDurationFlag("--stale", listStale)
+		if err != nil {
+			return err
+		}
+		if listState != "open" {
+			return fmt.Errorf("--stale only applies to open pull requests")
+		}
+		staleFor = d
+	}
+	if listFormat == listFormatSlack && listStale == "" {
+		return fmt.Errorf("--format slack requires --stale")
+	}
+
 	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
@@ -77,7 +127,7 @@ func runList(c *cobra.Command, args []string) error {
 	if listWeb {
 		url := fmt.Sprintf("https://%s/git/%s/%s/pullRequests",
 			profile.Space, projectKey, listRepo)
-		return browser.OpenURL(url)
+		return osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
 	}
 
 	opts := &api.PRListOptions{
@@ -127,6 +177,30 @@ func runList(c *cobra.Command, args []string) error {
 		opts.IssueIDs = issueIDs
 	}
 
+	// 古い PR の抽出（--limit は抽出後の件数に適用する）
+	if staleFor > 0 {
+		now := time.Now()
+		prs, err := fetchStalePRs(ctx, client, projectKey, listRepo, *opts, staleFor, now)
+		if err != nil {
+			return fmt.Errorf("failed to get pull requests: %w", err)
+		}
+		if listCount {
+			fmt.Println(len(prs))
+			return nil
+		}
+		if listLimit > 0 && len(prs) > listLimit {
+			prs = prs[:listLimit]
+		}
+		if listFormat == listFormatSlack {
+			if len(prs) > 0 {
+				baseURL := fmt.Sprintf("https://%s/git/%s/%s/pullRequests", profile.Space, projectKey, listRepo)
+				fmt.Println(formatStaleReminder(prs, projectKey, listRepo, baseURL, listStale, now))
+			}
+			return nil
+		}
+		return outputPRList(ctx, client, cfg, prs, projectKey)
+	}
+
 	// 件数のみ表示
 	if listCount {
 		count, err := client.GetPullRequestsCount(ctx, projectKey, listRepo, opts)
@@ -141,8 +215,17 @@ func runList(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get pull requests: %w", err)
 	}
+	return outputPRList(ctx, client, cfg, prs, projectKey)
+}
+
+// outputPRList はプロファイルの出力形式に従って PR 一覧を表示する
+func outputPRList(ctx context.Context, client *api.Client, cfg *config.Store, prs []api.PullRequest, projectKey string) error {
+	profile := cfg.CurrentProfile()
+	// 独自の --format がグローバルの --format を隠すため、テンプレートはここで扱う
+	if listFormat != "" {
+		return cmdutil.OutputJSONFromProfile(prs, profile.JSONFields, profile.JQ, listFormat)
+	}
 
-	// 出力
 	display := cfg.Display()
 	switch profile.Output {
 	case "json":
@@ -152,13 +235,21 @@ func runList(c *cobra.Command, args []string) error {
 			fmt.Println("No pull requests found")
 			return nil
 		}
-		outputPRTable(prs, profile, display, projectKey, listRepo)
+		var unread map[int]*prUnread
+		if !listNoNotify {
+			// 通知の取得に失敗しても一覧表示は続行する
+			var err error
+			if unread, err = fetchUnreadPRNotifications(ctx, client); err != nil {
+				debug.Log("skip unread notification marks", "error", err)
+			}
+		}
+		outputPRTable(prs, profile, display, projectKey, listRepo, unread)
 		return nil
 	}
 }
 
-func outputPRTable(prs []api.PullRequest, profile *config.ResolvedProfile, display *config.ResolvedDisplay, projectKey, repo string) {
-	fields := display.PRListFields
+func outputPRTable(prs []api.PullRequest, profile *config.ResolvedProfile, display *config.ResolvedDisplay, projectKey, repo string, unread map[int]*prUnread) {
+	fields := display.CommandFields("pr_list", display.PRListFields)
 	fieldConfig := display.PRFieldConfig
 
 	// ハイパーリンク設定
@@ -187,6 +278,11 @@ func outputPRTable(prs []api.PullRequest, profile *config.ResolvedProfile, displ
 		row := make([]string, len(fields))
 		for i, f := range fields {
 			row[i] = getPRFieldValue(pr, f, formatter, baseURL)
+			if f == "number" {
+				if mark := formatUnreadMark(unread[pr.ID]); mark != "" {
+					row[i] = mark + " " + row[i]
+				}
+			}
 		}
 		table.AddRow(row...)
 	}
@@ -200,16 +296,7 @@ func getPRFieldValue(pr api.PullRequest, field string, f *ui.FieldFormatter, bas
 		url := fmt.Sprintf("%s/%d", baseURL, pr.Number)
 		return ui.Hyperlink(url, fmt.Sprintf("%d", pr.Number))
 	case "status":
-		switch pr.Status.ID {
-		case 1:
-			return ui.Green("Open")
-		case 2:
-			return ui.Red("Closed")
-		case 3:
-			return ui.Blue("Merged")
-		default:
-			return pr.Status.Name
-		}
+		return ui.PRStatusColor(pr.Status.ID, pr.Status.Name)
 	case "author":
 		return f.FormatString(pr.CreatedUser.Name, field)
 	case "branch":
@@ -228,3 +315,32 @@ func getPRFieldValue(pr api.PullRequest, field string, f *ui.FieldFormatter, bas
 		return "-"
 	}
 }
+
+// applyPRListQuery は --query の条件を pr list の各フラグに反映する
+// プルリクエスト一覧 API にはキーワード検索がないため、キーワードはエラーにする
+func applyPRListQuery(c *cobra.Command, input string) error {
+	q, err := query.Parse(input)
+	if err != nil {
+		return err
+	}
+	if err := q.Validate("state", "author", "assignee", "issue"); err != nil {
+		return fmt.Errorf("invalid --query: %w", err)
+	}
+	if len(q.Text) > 0 {
+		return fmt.Errorf("invalid --query: keyword search is not supported for pull requests: %s", q.Keyword())
+	}
+	for _, k := range []struct {
+		key  string
+		dest *string
+	}{
+		{"state", &listState},
+		{"author", &listAuthor},
+		{"assignee", &listAssignee},
+		{"issue", &listIssue},
+	} {
+		if err := cmdutil.ApplyQueryValues(c, q, k.key, k.key, k.dest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -105,8 +105,7 @@ func runMerge(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(merged)
 	default:
-		fmt.Printf("%s Pull request merged: #%d %s\n",
-			ui.Green("✓"), merged.Number, merged.Summary)
+		cmdutil.Success(strconv.Itoa(merged.Number), "Pull request merged: #%d %s", merged.Number, merged.Summary)
 		return nil
 	}
 }
@@ -0,0 +1,64 @@
+package pr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+const (
+	// notificationFetchCount は未読判定のために取得する通知件数（API の上限）
+	notificationFetchCount = 100
+	// notificationReasonMentioned は自分への言及による通知の理由 ID
+	notificationReasonMentioned = 4
+	// unreadMark は未読通知がある PR に付ける印
+	unreadMark = "●"
+)
+
+// prUnread は PR ごとの未読通知
+type prUnread struct {
+	NotificationIDs []int
+	Mentioned       bool
+}
+
+// groupUnreadPRNotifications は未読の PR 通知を PR ID ごとにまとめる
+func groupUnreadPRNotifications(notifications []api.UserNotification) map[int]*prUnread {
+	result := make(map[int]*prUnread)
+	for _, n := range notifications {
+		if n.AlreadyRead || n.PullRequest == nil {
+			continue
+		}
+		u, ok := result[n.PullRequest.ID]
+		if !ok {
+			u = &prUnread{}
+			result[n.PullRequest.ID] = u
+		}
+		u.NotificationIDs = append(u.NotificationIDs, n.ID)
+		if n.Reason == notificationReasonMentioned {
+			u.Mentioned = true
+		}
+	}
+	return result
+}
+
+// fetchUnreadPRNotifications は直近の通知から未読の PR 通知を取得する
+func fetchUnreadPRNotifications(ctx context.Context, client *api.Client) (map[int]*prUnread, error) {
+	notifications, err := client.GetNotifications(ctx, &api.NotificationListOptions{Count: notificationFetchCount})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	return groupUnreadPRNotifications(notifications), nil
+}
+
+// formatUnreadMark は未読の印を返す（自分への言及を含む場合は強調する）
+func formatUnreadMark(u *prUnread) string {
+	if u == nil {
+		return ""
+	}
+	if u.Mentioned {
+		return ui.Bold(ui.Yellow(unreadMark + "@"))
+	}
+	return ui.Cyan(unreadMark)
+}
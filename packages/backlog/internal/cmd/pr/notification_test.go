@@ -0,0 +1,32 @@
+package pr
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestGroupUnreadPRNotifications(t *testing.T) {
+	notifications := []api.UserNotification{
+		{ID: 1, Reason: 2, PullRequest: &api.NotificationPR{ID: 10, Number: 1}},
+		{ID: 2, Reason: 4, PullRequest: &api.NotificationPR{ID: 10, Number: 1}},
+		{ID: 3, Reason: 2, AlreadyRead: true, PullRequest: &api.NotificationPR{ID: 20, Number: 2}},
+		{ID: 4, Reason: 1, Issue: &api.NotificationIssue{ID: 99}},
+		{ID: 5, Reason: 3, PullRequest: &api.NotificationPR{ID: 30, Number: 3}},
+	}
+
+	got := groupUnreadPRNotifications(notifications)
+	if len(got) != 2 {
+		t.Fatalf("groupUnreadPRNotifications() = %v, want 2 PRs", got)
+	}
+	if !reflect.DeepEqual(got[10].NotificationIDs, []int{1, 2}) || !got[10].Mentioned {
+		t.Errorf("PR 10 = %+v", got[10])
+	}
+	if !reflect.DeepEqual(got[30].NotificationIDs, []int{5}) || got[30].Mentioned {
+		t.Errorf("PR 30 = %+v", got[30])
+	}
+	if _, ok := got[20]; ok {
+		t.Error("already read notifications should be ignored")
+	}
+}
@@ -0,0 +1,86 @@
+package pr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+const (
+	// staleFetchCount は古い PR を探すときの1ページの取得件数（API の上限）
+	staleFetchCount = 100
+	// listFormatSlack は Slack に投稿するリマインド文面の出力形式
+	listFormatSlack = "slack"
+)
+
+// prSlackEscaper は Slack の mrkdwn で制御文字になる記号をエスケープする
+var prSlackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// fetchStalePRs は条件に合う PR をすべて取得し、最終更新から d 以上経過したものを返す
+// 古い PR ほど後ろのページにあるため、--limit とは関係なく全件を取得する。
+func fetchStalePRs(ctx context.Context, client *api.Client, projectKey, repo string, opts api.PRListOptions, d time.Duration, now time.Time) ([]api.PullRequest, error) {
+	var all []api.PullRequest
+	opts.Count = staleFetchCount
+	for offset := 0; ; offset += staleFetchCount {
+		opts.Offset = offset
+		prs, err := client.GetPullRequests(ctx, projectKey, repo, &opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, prs...)
+		if len(prs) < staleFetchCount {
+			break
+		}
+	}
+	return filterStalePRs(all, d, now), nil
+}
+
+// filterStalePRs は最終更新から d 以上経過した PR を古い順に返す
+func filterStalePRs(prs []api.PullRequest, d time.Duration, now time.Time) []api.PullRequest {
+	threshold := now.Add(-d)
+	var stale []api.PullRequest
+	for _, pr := range prs {
+		updated, err := time.Parse(time.RFC3339, pr.Updated)
+		if err != nil || updated.After(threshold) {
+			continue
+		}
+		stale = append(stale, pr)
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].Updated < stale[j].Updated
+	})
+	return stale
+}
+
+// staleMention は PR のリマインド先を返す（担当者、いなければ作成者）
+// Backlog のユーザー ID を Slack の表示名と揃えている運用を想定し @userId で書く
+func staleMention(pr api.PullRequest) string {
+	user := &pr.CreatedUser
+	if pr.Assignee != nil && pr.Assignee.ID != 0 {
+		user = pr.Assignee
+	}
+	if user.UserID != "" {
+		return "@" + user.UserID
+	}
+	return user.Name
+}
+
+// formatStaleReminder は古い PR のリマインド文面を Slack の mrkdwn で作る
+func formatStaleReminder(prs []api.PullRequest, projectKey, repo, baseURL, stale string, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, ":hourglass: %d pull request(s) in %s/%s have not been updated for %s or more\n",
+		len(prs), projectKey, repo, stale)
+	for _, pr := range prs {
+		line := fmt.Sprintf("• <%s/%d|#%d %s> %s", baseURL, pr.Number, pr.Number, prSlackEscaper.Replace(pr.Summary), staleMention(pr))
+		if updated, err := time.Parse(time.RFC3339, pr.Updated); err == nil {
+			days := int(now.Sub(updated).Hours() / 24)
+			line += fmt.Sprintf(" (last updated %s, %d day(s) ago)", updated.Format("2006-01-02"), days)
+		}
+		b.WriteString(line + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
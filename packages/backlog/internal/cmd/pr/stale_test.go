@@ -0,0 +1,49 @@
+package pr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestFilterStalePRs(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	prs := []api.PullRequest{
+		{Number: 1, Updated: "2026-10-10T09:00:00Z"},
+		{Number: 2, Updated: "2026-09-20T09:00:00Z"},
+		{Number: 3, Updated: "2026-10-15T09:00:00Z"},
+		{Number: 4, Updated: "2026-09-01T09:00:00Z"},
+		{Number: 5, Updated: ""},
+	}
+
+	got := filterStalePRs(prs, 5*24*time.Hour, now)
+	want := []int{4, 2, 1}
+	if len(got) != len(want) {
+		t.Fatalf("filterStalePRs() = %d PRs, want %d", len(got), len(want))
+	}
+	for i, pr := range got {
+		if pr.Number != want[i] {
+			t.Errorf("filterStalePRs()[%d] = #%d, want #%d", i, pr.Number, want[i])
+		}
+	}
+}
+
+func TestFormatStaleReminder(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	prs := []api.PullRequest{
+		{Number: 2, Summary: "Fix <script> & escape", Updated: "2026-09-30T09:00:00Z",
+			CreatedUser: api.User{ID: 1, UserID: "hanako"},
+			Assignee:    &api.User{ID: 2, UserID: "taro"}},
+		{Number: 5, Summary: "Add docs", Updated: "2026-10-01T09:00:00Z",
+			CreatedUser: api.User{ID: 1, UserID: "hanako"}},
+	}
+
+	got := formatStaleReminder(prs, "PROJ", "app", "https://example.backlog.jp/git/PROJ/app/pullRequests", "14d", now)
+	want := ":hourglass: 2 pull request(s) in PROJ/app have not been updated for 14d or more\n" +
+		"• <https://example.backlog.jp/git/PROJ/app/pullRequests/2|#2 Fix &lt;script&gt; &amp; escape> @taro (last updated 2026-09-30, 16 day(s) ago)\n" +
+		"• <https://example.backlog.jp/git/PROJ/app/pullRequests/5|#5 Add docs> @hanako (last updated 2026-10-01, 15 day(s) ago)"
+	if got != want {
+		t.Errorf("formatStaleReminder() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -8,11 +8,12 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -23,7 +24,16 @@ var viewCmd = &cobra.Command{
 
 Examples:
   backlog pr view 123 --repo myrepo
-  backlog pr view 123 --repo myrepo --web`,
+  backlog pr view 123 --repo myrepo --web
+
+  # Show comments; inline comments are grouped by file and line
+  backlog pr view 123 --repo myrepo --comments
+
+  # Show only inline comments on the given files (glob and directories allowed)
+  backlog pr view 123 --repo myrepo --files src/foo.go,'*.ts'
+
+  # Mark unread notifications for the pull request as read
+  backlog pr view 123 --repo myrepo --mark-read`,
 	Args: cobra.ExactArgs(1),
 	RunE: runView,
 }
@@ -36,6 +46,8 @@ var (
 	viewRaw           bool
 	viewMarkdownWarn  bool
 	viewMarkdownCache bool
+	viewMarkRead      bool
+	viewFiles         []string
 )
 
 func init() {
@@ -46,6 +58,9 @@ func init() {
 	viewCmd.Flags().BoolVar(&viewRaw, "raw", false, "Render raw content without markdown conversion")
 	viewCmd.Flags().BoolVar(&viewMarkdownWarn, "markdown-warn", false, "Show markdown conversion warnings")
 	viewCmd.Flags().BoolVar(&viewMarkdownCache, "markdown-cache", false, "Cache markdown conversion analysis data")
+	viewCmd.Flags().String("conversion-profile", "", "Markdown conversion rule preset: safe, standard, aggressive (default: display.markdown_conversion_profile)")
+	viewCmd.Flags().BoolVar(&viewMarkRead, "mark-read", false, "Mark unread notifications for this pull request as read")
+	viewCmd.Flags().StringSliceVar(&viewFiles, "files", nil, "Show only inline comments on these files (implies --comments)")
 	_ = viewCmd.MarkFlagRequired("repo")
 }
 
@@ -72,7 +87,7 @@ func runView(c *cobra.Command, args []string) error {
 	if viewWeb {
 		url := fmt.Sprintf("https://%s/git/%s/%s/pullRequests/%d",
 			profile.Space, projectKey, viewRepo, number)
-		return browser.OpenURL(url)
+		return osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
 	}
 
 	ctx := c.Context()
@@ -85,14 +100,39 @@ func runView(c *cobra.Command, args []string) error {
 
 	// コメント取得（オプション指定時）
 	var comments []api.PRComment
+	if len(viewFiles) > 0 {
+		viewComments = true
+	}
 	if viewComments {
-		comments, err = client.GetPullRequestComments(ctx, projectKey, viewRepo, number, &api.PRCommentListOptions{
-			Count: 100,
-			Order: "asc",
-		})
+		comments, err = fetchAllPRComments(ctx, client, projectKey, viewRepo, number)
 		if err != nil {
 			return fmt.Errorf("failed to get pull request comments: %w", err)
 		}
+		if len(viewFiles) > 0 {
+			comments = filterPRCommentsByFiles(comments, viewFiles)
+		}
+	}
+
+	// 未読通知（表示用の取得失敗は無視し、--mark-read 指定時のみエラーにする）
+	var unread *prUnread
+	if viewMarkRead || profile.Output != "json" {
+		all, err := fetchUnreadPRNotifications(ctx, client)
+		if err != nil {
+			if viewMarkRead {
+				return err
+			}
+			debug.Log("skip unread notifications", "error", err)
+		}
+		unread = all[pr.ID]
+	}
+	if viewMarkRead && unread != nil {
+		for _, id := range unread.NotificationIDs {
+			if err := client.MarkNotificationAsRead(ctx, id); err != nil {
+				return fmt.Errorf("failed to mark notification %d as read: %w", id, err)
+			}
+		}
+		fmt.Fprintf(os.Stderr, "%s Marked %d notification(s) as read\n", ui.OKMark(), len(unread.NotificationIDs))
+		unread = nil
 	}
 
 	// 出力
@@ -114,11 +154,11 @@ func runView(c *cobra.Command, args []string) error {
 		if markdownOpts.Cache && cacheErr != nil {
 			return fmt.Errorf("failed to resolve cache dir: %w", cacheErr)
 		}
-		return renderPRDetail(pr, comments, profile, display, projectKey, markdownOpts, c.OutOrStdout())
+		return renderPRDetail(pr, comments, unread, profile, display, projectKey, markdownOpts, c.OutOrStdout())
 	}
 }
 
-func renderPRDetail(pr *api.PullRequest, comments []api.PRComment, profile *config.ResolvedProfile, display *config.ResolvedDisplay, projectKey string, markdownOpts cmdutil.MarkdownViewOptions, out io.Writer) error {
+func renderPRDetail(pr *api.PullRequest, comments []api.PRComment, unread *prUnread, profile *config.ResolvedProfile, display *config.ResolvedDisplay, projectKey string, markdownOpts cmdutil.MarkdownViewOptions, out io.Writer) error {
 	// ハイパーリンク設定
 	ui.SetHyperlinkEnabled(display.Hyperlink)
 
@@ -131,19 +171,19 @@ func renderPRDetail(pr *api.PullRequest, comments []api.PRComment, profile *conf
 
 	// ヘッダー（PR番号をハイパーリンク化）
 	fmt.Printf("%s %s\n", ui.Hyperlink(prURL, fmt.Sprintf("#%d", pr.Number)), ui.Bold(pr.Summary))
-	fmt.Println(strings.Repeat("─", 60))
+	fmt.Println(ui.Rule(60))
 
 	// ステータス
-	status := pr.Status.Name
-	switch pr.Status.ID {
-	case 1:
-		status = ui.Green("Open")
-	case 2:
-		status = ui.Red("Closed")
-	case 3:
-		status = ui.Blue("Merged")
+	fmt.Printf("Status:   %s\n", ui.PRStatusColor(pr.Status.ID, pr.Status.Name))
+
+	// 未読通知
+	if unread != nil {
+		note := fmt.Sprintf("%d notification(s)", len(unread.NotificationIDs))
+		if unread.Mentioned {
+			note += " (mentioned)"
+		}
+		fmt.Printf("Unread:   %s %s\n", formatUnreadMark(unread), note)
 	}
-	fmt.Printf("Status:   %s\n", status)
 
 	// ブランチ情報
 	fmt.Printf("Branch:   %s -> %s\n", ui.Cyan(pr.Branch), ui.Cyan(pr.Base))
@@ -169,7 +209,7 @@ func renderPRDetail(pr *api.PullRequest, comments []api.PRComment, profile *conf
 	if pr.Description != "" {
 		fmt.Println()
 		fmt.Println(ui.Bold("Description"))
-		fmt.Println(strings.Repeat("─", 60))
+		fmt.Println(ui.Rule(60))
 		content := pr.Description
 		if markdownOpts.Enable {
 			rendered, err := cmdutil.RenderMarkdownContent(content, markdownOpts, "pr", pr.Number, 0, projectKey, fmt.Sprintf("#%d", pr.Number), prURL, nil, out)
@@ -181,40 +221,32 @@ func renderPRDetail(pr *api.PullRequest, comments []api.PRComment, profile *conf
 		fmt.Println(content)
 	}
 
-	// コメント表示
+	// コメント表示（インラインコメントはファイル・行ごとのスレッドにまとめる）
 	if len(comments) > 0 {
 		fmt.Println()
 		fmt.Println(ui.Bold(fmt.Sprintf("Comments (%d)", len(comments))))
-		fmt.Println(strings.Repeat("─", 60))
-		for i, comment := range comments {
+		fmt.Println(ui.Rule(60))
+		for i, thread := range groupPRCommentThreads(comments) {
 			if i > 0 {
 				fmt.Println()
 			}
-			// コメントヘッダー
-			fmt.Printf("%s - %s\n", ui.Bold(comment.CreatedUser.Name), ui.Gray(formatter.FormatDateTime(comment.Created, "created")))
-			// コメント内容
-			if comment.Content != "" {
-				content := comment.Content
-				if markdownOpts.Enable {
-					rendered, err := cmdutil.RenderMarkdownContent(content, markdownOpts, "pr_comment", pr.Number, comment.ID, projectKey, fmt.Sprintf("#%d", pr.Number), prURL, nil, out)
-					if err != nil {
-						return err
-					}
-					content = rendered
-				}
-				fmt.Println(content)
+			indent := ""
+			if thread.FilePath != "" {
+				fmt.Println(ui.Cyan(formatPRCommentPosition(thread.FilePath, thread.Line)))
+				indent = "  "
 			}
-			// 変更ログがある場合は表示
-			for _, cl := range comment.ChangeLog {
-				if cl.Field != "" {
-					if cl.OriginalValue != "" {
-						fmt.Printf("  %s: %s -> %s\n", ui.Gray(cl.Field), cl.OriginalValue, cl.NewValue)
-					} else {
-						fmt.Printf("  %s: %s\n", ui.Gray(cl.Field), cl.NewValue)
-					}
+			for j, comment := range thread.Comments {
+				if j > 0 {
+					fmt.Println()
+				}
+				if err := renderPRComment(comment, indent, formatter, pr.Number, projectKey, prURL, markdownOpts, out); err != nil {
+					return err
 				}
 			}
 		}
+	} else if len(viewFiles) > 0 {
+		fmt.Println()
+		fmt.Println(ui.Gray("No inline comments on the specified files"))
 	}
 
 	// URL（常に表示、ハイパーリンク化）
@@ -223,3 +255,54 @@ func renderPRDetail(pr *api.PullRequest, comments []api.PRComment, profile *conf
 
 	return nil
 }
+
+// renderPRComment はコメント 1 件（ヘッダー・本文・変更ログ）を出力する
+func renderPRComment(comment api.PRComment, indent string, formatter *ui.FieldFormatter, number int, projectKey, prURL string, markdownOpts cmdutil.MarkdownViewOptions, out io.Writer) error {
+	// コメントヘッダー
+	fmt.Printf("%s%s - %s\n", indent, ui.Bold(comment.CreatedUser.Name), ui.Gray(formatter.FormatDateTime(comment.Created, "created")))
+	// コメント内容
+	if comment.Content != "" {
+		content := comment.Content
+		if markdownOpts.Enable {
+			rendered, err := cmdutil.RenderMarkdownContent(content, markdownOpts, "pr_comment", number, comment.ID, projectKey, fmt.Sprintf("#%d", number), prURL, nil, out)
+			if err != nil {
+				return err
+			}
+			content = rendered
+		}
+		fmt.Println(indentLines(content, indent))
+	}
+	// 変更ログがある場合は表示
+	for _, cl := range comment.ChangeLog {
+		if cl.Field != "" {
+			if cl.OriginalValue != "" {
+				fmt.Printf("%s  %s: %s -> %s\n", indent, ui.Gray(cl.Field), cl.OriginalValue, cl.NewValue)
+			} else {
+				fmt.Printf("%s  %s: %s\n", indent, ui.Gray(cl.Field), cl.NewValue)
+			}
+		}
+	}
+	return nil
+}
+
+// formatPRCommentPosition はインラインコメントの位置を path:line 形式で返す
+func formatPRCommentPosition(filePath string, line int) string {
+	if line > 0 {
+		return fmt.Sprintf("%s:%d", filePath, line)
+	}
+	return filePath
+}
+
+// indentLines は各行の先頭に indent を付ける
+func indentLines(s, indent string) string {
+	if indent == "" {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = indent + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
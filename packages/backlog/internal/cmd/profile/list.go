@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"os"
 	"sort"
-	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 var listCmd = &cobra.Command{
@@ -63,22 +63,23 @@ func runList(cmd *cobra.Command, args []string) error {
 		return enc.Encode(entries)
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "NAME\tSPACE\tPRIMARY\tACTIVE")
+	// プレーン出力モードでは "*" の代わりに読み上げやすい "yes" を使う
+	mark := "*"
+	if ui.IsAccessible() {
+		mark = "yes"
+	}
+	table := ui.NewTable("NAME", "SPACE", "PRIMARY", "ACTIVE")
 	for _, e := range entries {
-		host := ""
-		if e.Space != "" {
-			host = e.Space
-		}
 		primary := ""
 		if e.Primary {
-			primary = "*"
+			primary = mark
 		}
 		active := ""
 		if e.Active {
-			active = "*"
+			active = mark
 		}
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Name, host, primary, active)
+		table.AddRow(e.Name, e.Space, primary, active)
 	}
-	return w.Flush()
+	table.Render(os.Stdout)
+	return nil
 }
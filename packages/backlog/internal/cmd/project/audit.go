@@ -0,0 +1,354 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit [project-key]",
+	Short: "Take an audit snapshot of project settings",
+	Long: `Take a snapshot of project settings for periodic access reviews.
+
+The snapshot contains members (with space role and project administrator flag),
+categories, issue types, statuses and webhooks. Save it with --output and
+compare it with a previous snapshot with --compare to see what changed.
+
+If no project key is provided, uses the default project.
+
+Examples:
+  backlog project audit PROJ -o audit.json
+  backlog project audit PROJ --compare audit.json
+  backlog project audit PROJ --compare audit.json -o audit-new.json`,
+	Args:        cobra.MaximumNArgs(1),
+	RunE:        runAudit,
+	Annotations: cmdutil.AutoSave(),
+}
+
+var (
+	auditOutput  string
+	auditCompare string
+)
+
+func init() {
+	auditCmd.Flags().StringVarP(&auditOutput, "output", "o", "", "Write the snapshot to a file (use \"-\" for stdout)")
+	auditCmd.Flags().StringVar(&auditCompare, "compare", "", "Compare with a previous snapshot file and show differences")
+}
+
+// AuditSnapshot はプロジェクト設定の監査スナップショット
+// 比較しやすいよう、各一覧は名前順にソートし ID などの揺れやすい値は含めない。
+type AuditSnapshot struct {
+	Project     string         `json:"project"`
+	Space       string         `json:"space"`
+	GeneratedAt string         `json:"generatedAt"`
+	Members     []AuditMember  `json:"members"`
+	Categories  []string       `json:"categories"`
+	IssueTypes  []string       `json:"issueTypes"`
+	Statuses    []string       `json:"statuses"`
+	Webhooks    []AuditWebhook `json:"webhooks"`
+	// Warnings は取得できなかった項目（権限不足など）
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// AuditMember はプロジェクトメンバー
+type AuditMember struct {
+	UserID       string `json:"userId"`
+	Name         string `json:"name"`
+	Role         string `json:"role"`
+	ProjectAdmin bool   `json:"projectAdmin"`
+}
+
+// AuditWebhook は Webhook 設定
+type AuditWebhook struct {
+	Name            string `json:"name"`
+	HookURL         string `json:"hookUrl"`
+	AllEvent        bool   `json:"allEvent"`
+	ActivityTypeIDs []int  `json:"activityTypeIds"`
+}
+
+// AuditChange はスナップショット間の差分
+type AuditChange struct {
+	Section string `json:"section"`
+	Kind    string `json:"kind"` // added, removed, changed
+	Item    string `json:"item"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+func runAudit(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+
+	profile := cfg.CurrentProfile()
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	if len(args) > 0 {
+		projectKey = args[0]
+	}
+	if projectKey == "" {
+		return fmt.Errorf("project key is required")
+	}
+
+	snapshot, err := takeAuditSnapshot(c.Context(), client, projectKey)
+	if err != nil {
+		return err
+	}
+	snapshot.Space = profile.Space
+	for _, w := range snapshot.Warnings {
+		fmt.Fprintf(os.Stderr, "%s %s\n", ui.Yellow("!"), w)
+	}
+
+	if auditOutput != "" {
+		if err := writeAuditSnapshot(auditOutput, snapshot); err != nil {
+			return err
+		}
+		if auditOutput != "-" {
+			cmdutil.Success(auditOutput, "Audit snapshot written to %s", auditOutput)
+		}
+	}
+
+	if auditCompare != "" {
+		data, err := os.ReadFile(auditCompare)
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		var previous AuditSnapshot
+		if err := json.Unmarshal(data, &previous); err != nil {
+			return fmt.Errorf("failed to parse snapshot %s: %w", auditCompare, err)
+		}
+		changes := DiffAuditSnapshots(&previous, snapshot)
+		if profile.Output == "json" {
+			return cmdutil.OutputJSONFromProfile(changes, profile.JSONFields, profile.JQ, profile.Template)
+		}
+		printAuditChanges(previous.GeneratedAt, changes)
+		return nil
+	}
+
+	if auditOutput == "" {
+		return writeAuditSnapshot("-", snapshot)
+	}
+	return nil
+}
+
+func takeAuditSnapshot(ctx context.Context, client *api.Client, projectKey string) (*AuditSnapshot, error) {
+	snapshot := &AuditSnapshot{
+		Project:     projectKey,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	users, err := client.GetProjectUsers(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project members: %w", err)
+	}
+	admins := make(map[string]bool)
+	if list, err := client.GetProjectAdministrators(ctx, projectKey); err != nil {
+		snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("project administrators unavailable: %v", err))
+	} else {
+		for _, u := range list {
+			admins[u.UserId.Value] = true
+		}
+	}
+	for _, u := range users {
+		snapshot.Members = append(snapshot.Members, AuditMember{
+			UserID:       u.UserID,
+			Name:         u.Name,
+			Role:         auditRoleName(u.RoleType),
+			ProjectAdmin: admins[u.UserID],
+		})
+	}
+	slices.SortFunc(snapshot.Members, func(a, b AuditMember) int { return strings.Compare(a.UserID, b.UserID) })
+
+	categories, err := client.GetCategories(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	for _, cat := range categories {
+		snapshot.Categories = append(snapshot.Categories, cat.Name)
+	}
+
+	issueTypes, err := client.GetIssueTypes(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue types: %w", err)
+	}
+	for _, it := range issueTypes {
+		snapshot.IssueTypes = append(snapshot.IssueTypes, it.Name)
+	}
+
+	statuses, err := client.GetStatuses(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+	for _, st := range statuses {
+		snapshot.Statuses = append(snapshot.Statuses, st.Name)
+	}
+
+	// Webhook の取得にはプロジェクト管理者権限が必要なため、失敗しても続行する
+	if hooks, err := client.GetWebhooks(ctx, projectKey); err != nil {
+		snapshot.Warnings = append(snapshot.Warnings, fmt.Sprintf("webhooks unavailable: %v", err))
+	} else {
+		snapshot.Webhooks = make([]AuditWebhook, 0, len(hooks))
+		for _, h := range hooks {
+			ids := slices.Clone(h.ActivityTypeIds)
+			slices.Sort(ids)
+			snapshot.Webhooks = append(snapshot.Webhooks, AuditWebhook{
+				Name:            h.Name.Value,
+				HookURL:         h.HookUrl.Value,
+				AllEvent:        h.AllEvent.Value,
+				ActivityTypeIDs: ids,
+			})
+		}
+		slices.SortFunc(snapshot.Webhooks, func(a, b AuditWebhook) int { return strings.Compare(a.Name, b.Name) })
+	}
+
+	slices.Sort(snapshot.Categories)
+	slices.Sort(snapshot.IssueTypes)
+	slices.Sort(snapshot.Statuses)
+	return snapshot, nil
+}
+
+func writeAuditSnapshot(path string, snapshot *AuditSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if path == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// DiffAuditSnapshots は 2 つのスナップショットの差分を返す
+// 一方で取得できなかったセクション（nil）は比較しない。
+func DiffAuditSnapshots(before, after *AuditSnapshot) []AuditChange {
+	changes := make([]AuditChange, 0)
+
+	oldMembers := make(map[string]AuditMember, len(before.Members))
+	for _, m := range before.Members {
+		oldMembers[m.UserID] = m
+	}
+	newMembers := make(map[string]AuditMember, len(after.Members))
+	for _, m := range after.Members {
+		newMembers[m.UserID] = m
+		old, ok := oldMembers[m.UserID]
+		switch {
+		case !ok:
+			changes = append(changes, AuditChange{Section: "members", Kind: "added", Item: m.UserID, Detail: memberDetail(m)})
+		case old.Role != m.Role || old.ProjectAdmin != m.ProjectAdmin:
+			changes = append(changes, AuditChange{Section: "members", Kind: "changed", Item: m.UserID, Detail: memberDetail(old) + " -> " + memberDetail(m)})
+		}
+	}
+	for _, m := range before.Members {
+		if _, ok := newMembers[m.UserID]; !ok {
+			changes = append(changes, AuditChange{Section: "members", Kind: "removed", Item: m.UserID, Detail: memberDetail(m)})
+		}
+	}
+
+	changes = append(changes, diffNames("categories", before.Categories, after.Categories)...)
+	changes = append(changes, diffNames("issueTypes", before.IssueTypes, after.IssueTypes)...)
+	changes = append(changes, diffNames("statuses", before.Statuses, after.Statuses)...)
+
+	if before.Webhooks != nil && after.Webhooks != nil {
+		oldHooks := make(map[string]AuditWebhook, len(before.Webhooks))
+		for _, h := range before.Webhooks {
+			oldHooks[h.Name] = h
+		}
+		newHooks := make(map[string]bool, len(after.Webhooks))
+		for _, h := range after.Webhooks {
+			newHooks[h.Name] = true
+			old, ok := oldHooks[h.Name]
+			switch {
+			case !ok:
+				changes = append(changes, AuditChange{Section: "webhooks", Kind: "added", Item: h.Name, Detail: h.HookURL})
+			case old.HookURL != h.HookURL:
+				changes = append(changes, AuditChange{Section: "webhooks", Kind: "changed", Item: h.Name, Detail: old.HookURL + " -> " + h.HookURL})
+			case old.AllEvent != h.AllEvent || !slices.Equal(old.ActivityTypeIDs, h.ActivityTypeIDs):
+				changes = append(changes, AuditChange{Section: "webhooks", Kind: "changed", Item: h.Name, Detail: "events changed"})
+			}
+		}
+		for _, h := range before.Webhooks {
+			if !newHooks[h.Name] {
+				changes = append(changes, AuditChange{Section: "webhooks", Kind: "removed", Item: h.Name, Detail: h.HookURL})
+			}
+		}
+	}
+
+	return changes
+}
+
+func diffNames(section string, before, after []string) []AuditChange {
+	var changes []AuditChange
+	for _, name := range after {
+		if !slices.Contains(before, name) {
+			changes = append(changes, AuditChange{Section: section, Kind: "added", Item: name})
+		}
+	}
+	for _, name := range before {
+		if !slices.Contains(after, name) {
+			changes = append(changes, AuditChange{Section: section, Kind: "removed", Item: name})
+		}
+	}
+	return changes
+}
+
+func memberDetail(m AuditMember) string {
+	if m.ProjectAdmin {
+		return m.Role + ", project admin"
+	}
+	return m.Role
+}
+
+func printAuditChanges(since string, changes []AuditChange) {
+	if len(changes) == 0 {
+		cmdutil.Success("", "No changes since %s", since)
+		return
+	}
+	table := ui.NewTable("SECTION", "CHANGE", "ITEM", "DETAIL")
+	for _, ch := range changes {
+		kind := ch.Kind
+		switch ch.Kind {
+		case "added":
+			kind = ui.Green(kind)
+		case "removed":
+			kind = ui.Red(kind)
+		case "changed":
+			kind = ui.Yellow(kind)
+		}
+		table.AddRow(ch.Section, kind, ch.Item, ch.Detail)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+}
+
+// auditRoleName はスペースのロール種別を名前に変換する
+func auditRoleName(roleType int) string {
+	switch roleType {
+	case 1:
+		return "Administrator"
+	case 2:
+		return "Normal User"
+	case 3:
+		return "Reporter"
+	case 4:
+		return "Viewer"
+	case 5:
+		return "Guest Reporter"
+	case 6:
+		return "Guest Viewer"
+	default:
+		return fmt.Sprintf("Unknown(%d)", roleType)
+	}
+}
@@ -0,0 +1,56 @@
+package project
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffAuditSnapshots(t *testing.T) {
+	before := &AuditSnapshot{
+		Members: []AuditMember{
+			{UserID: "alice", Role: "Normal User"},
+			{UserID: "bob", Role: "Normal User"},
+		},
+		Categories: []string{"API", "UI"},
+		IssueTypes: []string{"Bug"},
+		Statuses:   []string{"Open"},
+		Webhooks: []AuditWebhook{
+			{Name: "ci", HookURL: "https://ci.example.com/hook", ActivityTypeIDs: []int{1}},
+		},
+	}
+	after := &AuditSnapshot{
+		Members: []AuditMember{
+			{UserID: "alice", Role: "Normal User", ProjectAdmin: true},
+			{UserID: "carol", Role: "Guest Viewer"},
+		},
+		Categories: []string{"API", "Docs"},
+		IssueTypes: []string{"Bug"},
+		Statuses:   []string{"Open"},
+		Webhooks: []AuditWebhook{
+			{Name: "ci", HookURL: "https://evil.example.com/hook", ActivityTypeIDs: []int{1}},
+		},
+	}
+
+	want := []AuditChange{
+		{Section: "members", Kind: "changed", Item: "alice", Detail: "Normal User -> Normal User, project admin"},
+		{Section: "members", Kind: "added", Item: "carol", Detail: "Guest Viewer"},
+		{Section: "members", Kind: "removed", Item: "bob", Detail: "Normal User"},
+		{Section: "categories", Kind: "added", Item: "Docs"},
+		{Section: "categories", Kind: "removed", Item: "UI"},
+		{Section: "webhooks", Kind: "changed", Item: "ci", Detail: "https://ci.example.com/hook -> https://evil.example.com/hook"},
+	}
+
+	got := DiffAuditSnapshots(before, after)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffAuditSnapshots() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestDiffAuditSnapshotsSkipsUnavailableWebhooks(t *testing.T) {
+	before := &AuditSnapshot{Webhooks: []AuditWebhook{{Name: "ci"}}}
+	after := &AuditSnapshot{Webhooks: nil}
+
+	if got := DiffAuditSnapshots(before, after); len(got) != 0 {
+		t.Errorf("DiffAuditSnapshots() = %+v, want no changes", got)
+	}
+}
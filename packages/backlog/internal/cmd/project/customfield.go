@@ -0,0 +1,16 @@
+package project
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/customfield"
+)
+
+var customFieldCmd = &cobra.Command{
+	Use:     "customfield",
+	Aliases: []string{"custom-field", "cf"},
+	Short:   "Manage custom field definitions across projects",
+}
+
+func init() {
+	customFieldCmd.AddCommand(customfield.NewSyncCmd())
+}
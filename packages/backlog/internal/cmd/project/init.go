@@ -116,7 +116,10 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
 
-	ui.Success("Created %s", configPath)
+	cmdutil.Success(configPath, "Created %s", configPath)
+	if cmdutil.IsQuiet() {
+		return nil
+	}
 
 	// 内容表示
 	fmt.Println()
@@ -72,7 +72,11 @@ func outputProjectTable(projects []api.Project, currentProject string) {
 	for _, p := range projects {
 		key := p.ProjectKey
 		if key == currentProject {
-			key = ui.Green(key + " ✓")
+			if ui.IsAccessible() {
+				key += " (current)"
+			} else {
+				key = ui.Green(key + " ✓")
+			}
 		}
 
 		status := "active"
@@ -0,0 +1,385 @@
+package project
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var memberCmd = &cobra.Command{
+	Use:   "member",
+	Short: "Manage project members",
+}
+
+var memberImportCmd = &cobra.Command{
+	Use:   "import <csv-file>",
+	Short: "Add, update or remove project members from a CSV file",
+	Long: `Add, update or remove project members in bulk from a CSV file.
+
+Each row has the user (email address or user ID) and the role:
+
+  admin   add the user and make them a project administrator
+  member  add the user as a member (removes the administrator role if set)
+  remove  remove the user from the project
+
+The role column may be omitted (defaults to member). A header row such as
+"user,role" or "email,role" is detected automatically. Use "-" to read from
+standard input.
+
+With --sync, members who are not listed in the CSV are removed from the
+project (your own account is never removed).
+
+All users are resolved before any change is made. The planned changes are
+shown and confirmed before they are applied.
+
+Examples:
+  backlog project member import members.csv --project PROJ
+  backlog project member import members.csv --project PROJ --sync --dry-run
+  backlog project member import members.csv --project PROJ --sync --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMemberImport,
+}
+
+var (
+	memberImportSync   bool
+	memberImportDryRun bool
+)
+
+func init() {
+	memberImportCmd.Flags().BoolVar(&memberImportSync, "sync", false, "Remove members who are not listed in the CSV")
+	memberImportCmd.Flags().BoolVar(&memberImportDryRun, "dry-run", false, "Show the planned changes without applying them")
+	memberCmd.AddCommand(memberImportCmd)
+}
+
+// CSV で指定できるロール
+const (
+	memberRoleAdmin  = "admin"
+	memberRoleMember = "member"
+	memberRoleRemove = "remove"
+)
+
+// メンバー変更の種類
+const (
+	memberActionAdd     = "add"
+	memberActionPromote = "promote"
+	memberActionDemote  = "demote"
+	memberActionRemove  = "remove"
+)
+
+// memberEntry は CSV の 1 行
+type memberEntry struct {
+	Line int
+	User string
+	Role string
+}
+
+// memberUser はスペースのユーザー
+type memberUser struct {
+	ID     int
+	UserID string
+	Name   string
+	Email  string
+}
+
+// memberChange はプロジェクトメンバーに対する 1 件の変更
+type memberChange struct {
+	Action string
+	User   memberUser
+}
+
+func runMemberImport(c *cobra.Command, args []string) error {
+	if !memberImportDryRun && !ui.IsInteractiveInput() && !cmdutil.SkipConfirmation(c) {
+		return cmdutil.NonInteractiveFlagError(
+			"--yes is required when not running interactively",
+			"backlog project member import",
+			"Use --yes to apply without a prompt, or --dry-run to preview.",
+		)
+	}
+
+	entries, err := readMemberCSVFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	ctx := c.Context()
+
+	spaceUsers, err := client.GetUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get users: %w", err)
+	}
+	users := make([]memberUser, 0, len(spaceUsers))
+	for _, u := range spaceUsers {
+		users = append(users, memberUser{ID: u.ID.Value, UserID: u.UserId.Value, Name: u.Name.Value, Email: u.MailAddress.Value})
+	}
+
+	projectUsers, err := client.GetProjectUsers(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project users: %w", err)
+	}
+	members := make(map[int]bool, len(projectUsers))
+	for _, u := range projectUsers {
+		members[u.ID] = true
+	}
+	adminList, err := client.GetProjectAdministrators(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project administrators: %w", err)
+	}
+	admins := make(map[int]bool, len(adminList))
+	for _, u := range adminList {
+		admins[u.ID.Value] = true
+	}
+
+	self := 0
+	if memberImportSync {
+		me, err := client.GetCurrentUser(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get current user: %w", err)
+		}
+		self = me.ID.Value
+	}
+
+	changes, err := planMemberChanges(entries, users, members, admins, memberImportSync, self)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Printf("Members of %s are already up to date.\n", projectKey)
+		return nil
+	}
+
+	fmt.Printf("%d change(s) to the members of %s:\n", len(changes), projectKey)
+	table := ui.NewTable("ACTION", "USER", "NAME")
+	for _, ch := range changes {
+		table.AddRow(ch.Action, ch.User.label(), ch.User.Name)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	if memberImportDryRun {
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(c) {
+		ok, err := ui.Confirm(fmt.Sprintf("Apply %d change(s)?", len(changes)), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, ch := range changes {
+		var err error
+		switch ch.Action {
+		case memberActionAdd:
+			_, err = client.AddProjectUser(ctx, projectKey, ch.User.ID)
+		case memberActionPromote:
+			_, err = client.AddProjectAdministrator(ctx, projectKey, ch.User.ID)
+		case memberActionDemote:
+			_, err = client.DeleteProjectAdministrator(ctx, projectKey, ch.User.ID)
+		case memberActionRemove:
+			_, err = client.DeleteProjectUser(ctx, projectKey, ch.User.ID)
+		}
+		if err != nil {
+			if cmdutil.Interrupted(ctx) {
+				return cmdutil.ErrInterrupted
+			}
+			failed++
+			ui.Warning("Failed to %s %s: %v", ch.Action, ch.User.label(), err)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d change(s) failed", failed, len(changes))
+	}
+	cmdutil.Success("", "Applied %d change(s) to the members of %s", len(changes), projectKey)
+	return nil
+}
+
+func (u memberUser) label() string {
+	if u.UserID != "" {
+		return u.UserID
+	}
+	if u.Email != "" {
+		return u.Email
+	}
+	return fmt.Sprintf("#%d", u.ID)
+}
+
+func readMemberCSVFile(path string) ([]memberEntry, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open CSV: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+	return parseMemberCSV(r)
+}
+
+// parseMemberCSV は CSV からメンバー指定を読み取る
+// 1 列目がユーザー（メールアドレスまたはユーザー ID）、2 列目がロール（省略時は member）
+// 先頭行が user / email / userId などの列名ならヘッダーとして扱い、列の位置も列名から決める
+func parseMemberCSV(r io.Reader) ([]memberEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	userCol, roleCol := 0, 1
+	entries := make([]memberEntry, 0)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		if first {
+			// Excel で保存した CSV の BOM を除く
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+			if u, r, ok := memberCSVHeader(record); ok {
+				userCol, roleCol = u, r
+				continue
+			}
+		}
+
+		user := csvField(record, userCol)
+		if user == "" {
+			if strings.TrimSpace(strings.Join(record, "")) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: user is empty", line)
+		}
+		role := strings.ToLower(csvField(record, roleCol))
+		switch role {
+		case "":
+			role = memberRoleMember
+		case memberRoleAdmin, memberRoleMember, memberRoleRemove:
+		default:
+			return nil, fmt.Errorf("line %d: invalid role %q (must be admin, member or remove)", line, role)
+		}
+		entries = append(entries, memberEntry{Line: line, User: user, Role: role})
+	}
+	return entries, nil
+}
+
+// memberCSVHeader はヘッダー行ならユーザー列とロール列の位置を返す（ロール列が無ければ -1）
+func memberCSVHeader(record []string) (userCol, roleCol int, ok bool) {
+	userCol, roleCol = -1, -1
+	for i, name := range record {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "user", "email", "mail", "mailaddress", "userid", "user_id":
+			if userCol < 0 {
+				userCol = i
+			}
+		case "role":
+			roleCol = i
+		}
+	}
+	return userCol, roleCol, userCol >= 0
+}
+
+func csvField(record []string, col int) string {
+	if col < 0 || col >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[col])
+}
+
+// planMemberChanges は CSV の指定と現在のメンバーから必要な変更を求める
+// 存在しないユーザーや重複があれば、変更を始める前にまとめてエラーにする
+// sync のときは CSV に無いメンバー（self を除く）を削除する
+func planMemberChanges(entries []memberEntry, users []memberUser, members, admins map[int]bool, sync bool, self int) ([]memberChange, error) {
+	byKey := make(map[string]memberUser, len(users)*2)
+	for _, u := range users {
+		if u.UserID != "" {
+			byKey[strings.ToLower(u.UserID)] = u
+		}
+		if u.Email != "" {
+			byKey[strings.ToLower(u.Email)] = u
+		}
+	}
+
+	var problems []string
+	listed := make(map[int]int) // ユーザー ID → CSV の行番号
+	changes := make([]memberChange, 0)
+	for _, e := range entries {
+		u, ok := byKey[strings.ToLower(e.User)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("line %d: user %q not found in the space", e.Line, e.User))
+			continue
+		}
+		if prev, dup := listed[u.ID]; dup {
+			problems = append(problems, fmt.Sprintf("line %d: user %q is already listed on line %d", e.Line, e.User, prev))
+			continue
+		}
+		listed[u.ID] = e.Line
+
+		switch e.Role {
+		case memberRoleAdmin:
+			if !members[u.ID] {
+				changes = append(changes, memberChange{Action: memberActionAdd, User: u})
+			}
+			if !admins[u.ID] {
+				changes = append(changes, memberChange{Action: memberActionPromote, User: u})
+			}
+		case memberRoleMember:
+			if !members[u.ID] {
+				changes = append(changes, memberChange{Action: memberActionAdd, User: u})
+			} else if admins[u.ID] {
+				changes = append(changes, memberChange{Action: memberActionDemote, User: u})
+			}
+		case memberRoleRemove:
+			if members[u.ID] {
+				changes = append(changes, memberChange{Action: memberActionRemove, User: u})
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, errors.New(strings.Join(problems, "\n"))
+	}
+
+	if sync {
+		byID := make(map[int]memberUser, len(users))
+		for _, u := range users {
+			byID[u.ID] = u
+		}
+		var removed []memberChange
+		for id := range members {
+			if _, ok := listed[id]; ok || id == self {
+				continue
+			}
+			u, ok := byID[id]
+			if !ok {
+				u = memberUser{ID: id}
+			}
+			removed = append(removed, memberChange{Action: memberActionRemove, User: u})
+		}
+		sort.Slice(removed, func(i, j int) bool { return removed[i].User.label() < removed[j].User.label() })
+		changes = append(changes, removed...)
+	}
+	return changes, nil
+}
@@ -0,0 +1,134 @@
+package project
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMemberCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []memberEntry
+		wantErr string
+	}{
+		{
+			name:  "no header",
+			input: "alice@example.com,admin\nbob\n",
+			want: []memberEntry{
+				{Line: 1, User: "alice@example.com", Role: "admin"},
+				{Line: 2, User: "bob", Role: "member"},
+			},
+		},
+		{
+			name:  "header with reordered columns and BOM",
+			input: "\ufeffrole,email\nREMOVE,carol@example.com\n\n# comment\nmember,dave@example.com\n",
+			want: []memberEntry{
+				{Line: 2, User: "carol@example.com", Role: "remove"},
+				{Line: 5, User: "dave@example.com", Role: "member"},
+			},
+		},
+		{
+			name:  "header without role column",
+			input: "userId\nalice\n",
+			want:  []memberEntry{{Line: 2, User: "alice", Role: "member"}},
+		},
+		{name: "invalid role", input: "alice,owner\n", wantErr: `line 1: invalid role "owner"`},
+		{name: "empty user", input: ",admin\n", wantErr: "line 1: user is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMemberCSV(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseMemberCSV() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMemberCSV() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanMemberChanges(t *testing.T) {
+	users := []memberUser{
+		{ID: 1, UserID: "alice", Email: "alice@example.com"},
+		{ID: 2, UserID: "bob", Email: "bob@example.com"},
+		{ID: 3, UserID: "carol", Email: "carol@example.com"},
+		{ID: 4, UserID: "dave", Email: "dave@example.com"},
+		{ID: 5, UserID: "me", Email: "me@example.com"},
+	}
+	members := map[int]bool{2: true, 3: true, 4: true, 5: true}
+	admins := map[int]bool{3: true}
+
+	actions := func(changes []memberChange) []string {
+		out := make([]string, 0, len(changes))
+		for _, c := range changes {
+			out = append(out, c.Action+":"+c.User.UserID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		entries []memberEntry
+		sync    bool
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "add, promote, demote and remove",
+			entries: []memberEntry{
+				{Line: 1, User: "ALICE@example.com", Role: "admin"},
+				{Line: 2, User: "bob", Role: "admin"},
+				{Line: 3, User: "carol", Role: "member"},
+				{Line: 4, User: "dave", Role: "remove"},
+			},
+			want: []string{"add:alice", "promote:alice", "promote:bob", "demote:carol", "remove:dave"},
+		},
+		{
+			name:    "no changes",
+			entries: []memberEntry{{Line: 1, User: "bob", Role: "member"}, {Line: 2, User: "carol", Role: "admin"}},
+			want:    []string{},
+		},
+		{
+			name:    "sync removes unlisted members except self",
+			entries: []memberEntry{{Line: 1, User: "bob", Role: "member"}},
+			sync:    true,
+			want:    []string{"remove:carol", "remove:dave"},
+		},
+		{
+			name:    "unknown user",
+			entries: []memberEntry{{Line: 1, User: "nobody", Role: "member"}},
+			wantErr: `line 1: user "nobody" not found`,
+		},
+		{
+			name:    "duplicate user",
+			entries: []memberEntry{{Line: 1, User: "bob", Role: "member"}, {Line: 2, User: "bob@example.com", Role: "admin"}},
+			wantErr: "already listed on line 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planMemberChanges(tt.entries, users, members, admins, tt.sync, 5)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("planMemberChanges() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g := actions(got); !reflect.DeepEqual(g, tt.want) {
+				t.Errorf("planMemberChanges() = %v, want %v", g, tt.want)
+			}
+		})
+	}
+}
@@ -15,4 +15,7 @@ func init() {
 	ProjectCmd.AddCommand(viewCmd)
 	ProjectCmd.AddCommand(initCmd)
 	ProjectCmd.AddCommand(currentCmd)
+	ProjectCmd.AddCommand(auditCmd)
+	ProjectCmd.AddCommand(memberCmd)
+	ProjectCmd.AddCommand(customFieldCmd)
 }
@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -61,7 +61,7 @@ func runView(c *cobra.Command, args []string) error {
 	// ブラウザで開く
 	if viewWeb {
 		url := fmt.Sprintf("https://%s/projects/%s", profile.Space, projectKey)
-		return browser.OpenURL(url)
+		return osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
 	}
 
 	// プロジェクト情報取得
@@ -101,7 +101,7 @@ func runView(c *cobra.Command, args []string) error {
 func renderProjectDetail(detail *ProjectDetail, profile *config.ResolvedProfile) error {
 	// ヘッダー
 	fmt.Printf("%s %s\n", ui.Bold(detail.ProjectKey), detail.Name)
-	fmt.Println(strings.Repeat("─", 60))
+	fmt.Println(ui.Rule(60))
 
 	// プロジェクトステータス
 	if detail.Archived {
@@ -134,7 +134,7 @@ func renderProjectDetail(detail *ProjectDetail, profile *config.ResolvedProfile)
 	// メタデータ
 	fmt.Println()
 	fmt.Println(ui.Bold("Metadata"))
-	fmt.Println(strings.Repeat("─", 60))
+	fmt.Println(ui.Rule(60))
 
 	// ステータス一覧
 	if len(detail.Statuses) > 0 {
@@ -167,7 +167,7 @@ func renderProjectDetail(detail *ProjectDetail, profile *config.ResolvedProfile)
 	if detail.VersionCount > 0 || detail.MemberCount > 0 {
 		fmt.Println()
 		fmt.Println(ui.Bold("Statistics"))
-		fmt.Println(strings.Repeat("─", 60))
+		fmt.Println(ui.Rule(60))
 		if detail.VersionCount > 0 {
 			fmt.Printf("Versions: %d\n", detail.VersionCount)
 		}
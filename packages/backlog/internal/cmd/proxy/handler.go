@@ -0,0 +1,245 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cache"
+)
+
+// rawRequester は認証付きで Backlog API にリクエストする（*api.Client が満たす）
+type rawRequester interface {
+	RawRequest(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Response, error)
+}
+
+// cachePrefix はプロキシが保存するキャッシュキーの接頭辞
+const cachePrefix = "proxy:"
+
+// cachedResponse はキャッシュに保存するレスポンス
+type cachedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// hopHeaders は転送しないホップバイホップヘッダー
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// TokenHeader はプロキシのトークンを送るヘッダー
+// ヘッダーを付けられないクライアントは、パスの先頭にトークンを付けて /<token>/api/v2/... としてもよい
+const TokenHeader = "X-Backlog-Proxy-Token"
+
+// handler はローカルのリクエストに認証を付与して Backlog API へ転送する
+type handler struct {
+	client   rawRequester
+	readOnly bool
+	// token は起動ごとのトークン。ブラウザ上の他サイトから認証情報を使われないよう、全リクエストに要求する
+	token string
+	// hosts は受け付ける Host ヘッダー（host:port）。DNS リバインディングによる読み取りを防ぐ
+	hosts    []string
+	cache    cache.Cache
+	cacheTTL time.Duration
+	// sem は Backlog への同時リクエスト数を制限する
+	sem chan struct{}
+	log io.Writer
+	now func() time.Time
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := h.now()
+	status := h.serve(w, r)
+	if h.log != nil {
+		_, _ = fmt.Fprintf(h.log, "%s %s %d %s\n", r.Method, r.URL.Path, status, h.now().Sub(start).Round(time.Millisecond))
+	}
+}
+
+func (h *handler) serve(w http.ResponseWriter, r *http.Request) int {
+	if status, msg := h.checkAccess(r); status != 0 {
+		return writeProxyError(w, status, msg)
+	}
+	path, ok := h.authorize(r)
+	if !ok {
+		return writeProxyError(w, http.StatusUnauthorized, "missing or invalid proxy token (send "+TokenHeader+" or prefix the path with the token)")
+	}
+	if !strings.HasPrefix(path, "/api/") {
+		return writeProxyError(w, http.StatusNotFound, "only /api/ paths are proxied")
+	}
+	isRead := r.Method == http.MethodGet || r.Method == http.MethodHead
+	if h.readOnly && !isRead {
+		return writeProxyError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s is not allowed in read-only mode", r.Method))
+	}
+
+	// クライアントが付けた認証情報は使わず、CLI の認証情報で置き換える
+	query := r.URL.Query()
+	query.Del("apiKey")
+
+	cacheKey := ""
+	if h.cache != nil && r.Method == http.MethodGet {
+		cacheKey = proxyCacheKey(path, query)
+		var cached cachedResponse
+		if ok, _ := h.cache.Get(cacheKey, &cached); ok {
+			w.Header().Set("X-Backlog-Proxy-Cache", "HIT")
+			return writeResponse(w, cached.Status, cached.Header, bytes.NewReader(cached.Body))
+		}
+	}
+
+	select {
+	case h.sem <- struct{}{}:
+		defer func() { <-h.sem }()
+	case <-r.Context().Done():
+		return writeProxyError(w, http.StatusServiceUnavailable, "request canceled")
+	}
+
+	var body io.Reader
+	if !isRead {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return writeProxyError(w, http.StatusBadRequest, fmt.Sprintf("failed to read request body: %v", err))
+		}
+		// RetryTransport が 429 時に再送できるよう、メモリに読み込んでから渡す
+		body = bytes.NewReader(data)
+	}
+
+	resp, err := h.client.RawRequest(r.Context(), r.Method, path, query, body, r.Header.Get("Content-Type"))
+	if err != nil {
+		return writeProxyError(w, http.StatusBadGateway, err.Error())
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if cacheKey != "" && resp.StatusCode == http.StatusOK {
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return writeProxyError(w, http.StatusBadGateway, fmt.Sprintf("failed to read response: %v", err))
+		}
+		header := cloneResponseHeader(resp.Header)
+		_ = h.cache.Set(cacheKey, cachedResponse{Status: resp.StatusCode, Header: header, Body: data}, h.cacheTTL)
+		w.Header().Set("X-Backlog-Proxy-Cache", "MISS")
+		return writeResponse(w, resp.StatusCode, header, bytes.NewReader(data))
+	}
+
+	// 更新系リクエストが成功したらキャッシュを破棄する（古い一覧を返さないため）
+	if h.cache != nil && !isRead && resp.StatusCode < 400 {
+		_ = h.cache.DeleteByPrefix(cachePrefix)
+	}
+	return writeResponse(w, resp.StatusCode, cloneResponseHeader(resp.Header), resp.Body)
+}
+
+// checkAccess はブラウザ経由の他サイトからのリクエストを拒否する
+// Host がループバックの host:port でないもの（DNS リバインディング）と、
+// 他のオリジンから送られたもの（Origin / Sec-Fetch-Site）を拒否し、拒否する場合はステータスを返す
+func (h *handler) checkAccess(r *http.Request) (int, string) {
+	if !h.allowedHost(r.Host) {
+		return http.StatusForbidden, fmt.Sprintf("host %q is not allowed", r.Host)
+	}
+	if origin := r.Header.Get("Origin"); origin != "" {
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || !h.allowedHost(u.Host) {
+			return http.StatusForbidden, fmt.Sprintf("cross-origin request from %q is not allowed", origin)
+		}
+	}
+	switch r.Header.Get("Sec-Fetch-Site") {
+	case "", "same-origin", "none":
+	default:
+		return http.StatusForbidden, "cross-site request is not allowed"
+	}
+	return 0, ""
+}
+
+func (h *handler) allowedHost(host string) bool {
+	for _, allowed := range h.hosts {
+		if strings.EqualFold(host, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize はトークンを確認し、転送するパス（トークンの接頭辞を除いたもの）を返す
+func (h *handler) authorize(r *http.Request) (string, bool) {
+	if got := r.Header.Get(TokenHeader); got != "" {
+		return r.URL.Path, tokenEqual(got, h.token)
+	}
+	rest, ok := strings.CutPrefix(r.URL.Path, "/")
+	if !ok {
+		return "", false
+	}
+	got, path, ok := strings.Cut(rest, "/")
+	if !ok || !tokenEqual(got, h.token) {
+		return "", false
+	}
+	return "/" + path, true
+}
+
+func tokenEqual(got, want string) bool {
+	return want != "" && subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
+
+// proxyCacheKey はパスとクエリから決定的なキャッシュキーを作る
+func proxyCacheKey(path string, query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(cachePrefix)
+	b.WriteString(path)
+	for i, k := range keys {
+		if i == 0 {
+			b.WriteByte('?')
+		} else {
+			b.WriteByte('&')
+		}
+		b.WriteString(url.QueryEscape(k))
+		b.WriteByte('=')
+		b.WriteString(url.QueryEscape(strings.Join(query[k], ",")))
+	}
+	return b.String()
+}
+
+func cloneResponseHeader(src http.Header) http.Header {
+	header := src.Clone()
+	for _, h := range hopHeaders {
+		header.Del(h)
+	}
+	return header
+}
+
+func writeResponse(w http.ResponseWriter, status int, header http.Header, body io.Reader) int {
+	for k, values := range header {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+	w.WriteHeader(status)
+	_, _ = io.Copy(w, body)
+	return status
+}
+
+// writeProxyError はプロキシ自身のエラーを Backlog API と同じ形式で返す
+func writeProxyError(w http.ResponseWriter, status int, message string) int {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{"message": message, "code": 0, "moreInfo": "backlog proxy"}},
+	})
+	return status
+}
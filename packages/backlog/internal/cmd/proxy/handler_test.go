@@ -0,0 +1,248 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cache"
+)
+
+type fakeRequest struct {
+	method      string
+	path        string
+	query       url.Values
+	body        string
+	contentType string
+}
+
+type fakeRequester struct {
+	requests []fakeRequest
+	status   int
+	body     string
+}
+
+func (f *fakeRequester) RawRequest(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	req := fakeRequest{method: method, path: path, query: query, contentType: contentType}
+	if body != nil {
+		data, _ := io.ReadAll(body)
+		req.body = string(data)
+	}
+	f.requests = append(f.requests, req)
+	status := f.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("Connection", "keep-alive")
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(f.body)),
+	}, nil
+}
+
+const (
+	testToken = "test-token"
+	testHost  = "127.0.0.1:8081"
+)
+
+func newTestHandler(client rawRequester) *handler {
+	return &handler{
+		client: client,
+		token:  testToken,
+		hosts:  allowedHosts("127.0.0.1", 8081),
+		sem:    make(chan struct{}, 1),
+		now:    time.Now,
+	}
+}
+
+// newProxyRequest はループバックの Host とトークンを付けたリクエストを作る
+func newProxyRequest(method, target string, body io.Reader) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	req.Host = testHost
+	req.Header.Set(TokenHeader, testToken)
+	return req
+}
+
+func TestHandler_Forward(t *testing.T) {
+	fake := &fakeRequester{body: `{"id":1}`}
+	h := newTestHandler(fake)
+
+	req := newProxyRequest(http.MethodPost, "/api/v2/issues?apiKey=leak&projectId=1", strings.NewReader("summary=test"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer client-token")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if rec.Body.String() != `{"id":1}` {
+		t.Errorf("body = %q", rec.Body.String())
+	}
+	if rec.Header().Get("Connection") != "" {
+		t.Errorf("hop-by-hop header should not be forwarded")
+	}
+	if len(fake.requests) != 1 {
+		t.Fatalf("requests = %d, want 1", len(fake.requests))
+	}
+	got := fake.requests[0]
+	if got.method != http.MethodPost || got.path != "/api/v2/issues" {
+		t.Errorf("forwarded %s %s", got.method, got.path)
+	}
+	if got.query.Has("apiKey") {
+		t.Errorf("apiKey from client should be removed: %v", got.query)
+	}
+	if got.query.Get("projectId") != "1" {
+		t.Errorf("projectId = %q, want 1", got.query.Get("projectId"))
+	}
+	if got.body != "summary=test" || got.contentType != "application/x-www-form-urlencoded" {
+		t.Errorf("body = %q, content-type = %q", got.body, got.contentType)
+	}
+}
+
+func TestHandler_Rejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		readOnly bool
+		modify   func(r *http.Request)
+		want     int
+	}{
+		{name: "non api path", method: http.MethodGet, path: "/favicon.ico", want: http.StatusNotFound},
+		{name: "write in read-only", method: http.MethodPatch, path: "/api/v2/issues/1", readOnly: true, want: http.StatusMethodNotAllowed},
+		{name: "get in read-only", method: http.MethodGet, path: "/api/v2/issues/1", readOnly: true, want: http.StatusOK},
+		{
+			name: "foreign host", method: http.MethodGet, path: "/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Host = "attacker.example:8081" },
+			want:   http.StatusForbidden,
+		},
+		{
+			name: "loopback host on another port", method: http.MethodGet, path: "/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Host = "localhost:9999" },
+			want:   http.StatusForbidden,
+		},
+		{
+			name: "localhost alias", method: http.MethodGet, path: "/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Host = "localhost:8081" },
+			want:   http.StatusOK,
+		},
+		{
+			name: "cross-site origin", method: http.MethodPost, path: "/api/v2/issues",
+			modify: func(r *http.Request) { r.Header.Set("Origin", "https://attacker.example") },
+			want:   http.StatusForbidden,
+		},
+		{
+			name: "same origin", method: http.MethodPost, path: "/api/v2/issues",
+			modify: func(r *http.Request) { r.Header.Set("Origin", "http://127.0.0.1:8081") },
+			want:   http.StatusOK,
+		},
+		{
+			name: "cross-site fetch", method: http.MethodGet, path: "/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Header.Set("Sec-Fetch-Site", "cross-site") },
+			want:   http.StatusForbidden,
+		},
+		{
+			name: "same-site fetch", method: http.MethodGet, path: "/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Header.Set("Sec-Fetch-Site", "same-site") },
+			want:   http.StatusForbidden,
+		},
+		{
+			name: "missing token", method: http.MethodGet, path: "/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Header.Del(TokenHeader) },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name: "wrong token", method: http.MethodGet, path: "/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Header.Set(TokenHeader, "wrong") },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name: "wrong token prefix", method: http.MethodGet, path: "/wrong/api/v2/users/myself",
+			modify: func(r *http.Request) { r.Header.Del(TokenHeader) },
+			want:   http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeRequester{body: "{}"}
+			h := newTestHandler(fake)
+			h.readOnly = tt.readOnly
+
+			req := newProxyRequest(tt.method, tt.path, nil)
+			if tt.modify != nil {
+				tt.modify(req)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if tt.want != http.StatusOK && len(fake.requests) != 0 {
+				t.Errorf("rejected request should not be forwarded")
+			}
+		})
+	}
+}
+
+func TestHandler_TokenPathPrefix(t *testing.T) {
+	fake := &fakeRequester{body: "{}"}
+	h := newTestHandler(fake)
+
+	req := newProxyRequest(http.MethodGet, "/"+testToken+"/api/v2/users/myself", nil)
+	req.Header.Del(TokenHeader)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if len(fake.requests) != 1 || fake.requests[0].path != "/api/v2/users/myself" {
+		t.Errorf("token prefix should be stripped before forwarding: %+v", fake.requests)
+	}
+}
+
+func TestHandler_Cache(t *testing.T) {
+	fc, err := cache.NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := &fakeRequester{body: `[{"id":1}]`}
+	h := newTestHandler(fake)
+	h.cache = fc
+	h.cacheTTL = time.Minute
+
+	get := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newProxyRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	if rec := get("/api/v2/issues?b=2&a=1"); rec.Header().Get("X-Backlog-Proxy-Cache") != "MISS" {
+		t.Errorf("first request should miss")
+	}
+	rec := get("/api/v2/issues?a=1&b=2")
+	if rec.Header().Get("X-Backlog-Proxy-Cache") != "HIT" {
+		t.Errorf("same query in different order should hit")
+	}
+	if rec.Body.String() != `[{"id":1}]` {
+		t.Errorf("cached body = %q", rec.Body.String())
+	}
+	if len(fake.requests) != 1 {
+		t.Errorf("requests = %d, want 1", len(fake.requests))
+	}
+
+	// 更新系リクエストの成功でキャッシュが破棄される
+	h.ServeHTTP(httptest.NewRecorder(), newProxyRequest(http.MethodPost, "/api/v2/issues", strings.NewReader("")))
+	if rec := get("/api/v2/issues?a=1&b=2"); rec.Header().Get("X-Backlog-Proxy-Cache") != "MISS" {
+		t.Errorf("cache should be invalidated after a write")
+	}
+}
@@ -0,0 +1,167 @@
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cache"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var ProxyCmd = &cobra.Command{
+	Use:   "proxy",
+	Short: "Run a local proxy that forwards requests to the Backlog API",
+	Long: `Start a local HTTP server that forwards requests to the Backlog API
+with the CLI's credentials.
+
+Requests to http://HOST:PORT/api/v2/... are sent to the current space with the
+OAuth token (refreshed automatically) or API key of the active profile, so that
+other tools and scripts can call the API without handling authentication.
+The apiKey query parameter and Authorization header sent by clients are ignored.
+
+Every request must carry the token printed at startup, either in the
+X-Backlog-Proxy-Token header or as a path prefix (http://HOST:PORT/TOKEN/api/v2/...).
+A new random token is generated on each run unless --token is given.
+Requests with a Host other than the listening address, or sent cross-site from
+a browser (Origin / Sec-Fetch-Site), are rejected.
+
+Examples:
+  backlog proxy
+  backlog proxy --port 9000 --read-only
+  backlog proxy --cache
+  curl -H "X-Backlog-Proxy-Token: TOKEN" http://127.0.0.1:8081/api/v2/users/myself`,
+	Args: cobra.NoArgs,
+	RunE: runProxy,
+}
+
+var (
+	proxyPort           int
+	proxyHost           string
+	proxyReadOnly       bool
+	proxyCache          bool
+	proxyMaxConcurrency int
+	proxyToken          string
+)
+
+func init() {
+	ProxyCmd.Flags().IntVar(&proxyPort, "port", 8081, "Port to listen on")
+	ProxyCmd.Flags().StringVar(&proxyHost, "host", "127.0.0.1", "Address to listen on")
+	ProxyCmd.Flags().BoolVar(&proxyReadOnly, "read-only", false, "Reject requests other than GET and HEAD")
+	ProxyCmd.Flags().BoolVar(&proxyCache, "cache", false, "Cache successful GET responses (uses the cache TTL setting)")
+	ProxyCmd.Flags().IntVar(&proxyMaxConcurrency, "max-concurrency", 4, "Maximum number of concurrent requests to Backlog")
+	ProxyCmd.Flags().StringVar(&proxyToken, "token", "", "Token required from clients (default: random per run)")
+}
+
+func runProxy(c *cobra.Command, args []string) error {
+	if proxyMaxConcurrency < 1 {
+		return fmt.Errorf("--max-concurrency must be at least 1")
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+
+	token := proxyToken
+	if token == "" {
+		if token, err = newToken(); err != nil {
+			return err
+		}
+	}
+
+	h := &handler{
+		client:   client,
+		readOnly: proxyReadOnly,
+		token:    token,
+		sem:      make(chan struct{}, proxyMaxConcurrency),
+		log:      os.Stderr,
+		now:      time.Now,
+	}
+	if proxyCache {
+		resolved := cfg.Resolved()
+		cacheDir, err := resolved.Cache.GetCacheDir()
+		if err != nil {
+			return fmt.Errorf("failed to get cache directory: %w", err)
+		}
+		// スペースごとにディレクトリを分け、更新時の破棄が他スペースに及ばないようにする
+		space := strings.TrimPrefix(client.RawBaseURL(), "https://")
+		fc, err := cache.NewFileCache(filepath.Join(cacheDir, "proxy", space))
+		if err != nil {
+			return fmt.Errorf("failed to initialize cache: %w", err)
+		}
+		h.cache = fc
+		h.cacheTTL = time.Duration(resolved.Cache.TTL) * time.Second
+	}
+
+	if ip := net.ParseIP(proxyHost); proxyHost != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		ui.Warning("listening on %s: anyone who can reach this address can use your Backlog credentials", proxyHost)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(proxyHost, fmt.Sprint(proxyPort)))
+	if err != nil {
+		return fmt.Errorf("failed to start proxy server: %w", err)
+	}
+
+	h.hosts = allowedHosts(proxyHost, listener.Addr().(*net.TCPAddr).Port)
+
+	ctx, stop := signal.NotifyContext(c.Context(), os.Interrupt)
+	defer stop()
+
+	srv := &http.Server{Handler: h, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	mode := ""
+	if proxyReadOnly {
+		mode = " (read-only)"
+	}
+	fmt.Fprintf(os.Stderr, "%s Proxying %s at %s%s (Ctrl+C to stop)\n",
+		ui.OKMark(), client.RawBaseURL(), ui.Cyan("http://"+listener.Addr().String()), mode)
+	fmt.Fprintf(os.Stderr, "  Token: %s\n", token)
+	fmt.Fprintf(os.Stderr, "  Send it as the %s header or as a path prefix: http://%s/%s/api/v2/...\n",
+		TokenHeader, listener.Addr().String(), token)
+
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// newToken は起動ごとのランダムなトークンを作る
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate proxy token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// allowedHosts は Host ヘッダーとして受け付ける host:port を返す
+// ループバックの別名に加え、--host で指定されたアドレスも受け付ける
+func allowedHosts(host string, port int) []string {
+	names := []string{"127.0.0.1", "localhost", "::1"}
+	if host != "" && host != "0.0.0.0" && host != "::" {
+		names = append(names, host)
+	}
+	hosts := make([]string, 0, len(names))
+	for _, name := range names {
+		hosts = append(hosts, net.JoinHostPort(name, fmt.Sprint(port)))
+	}
+	return hosts
+}
@@ -0,0 +1,98 @@
+package queue
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	internalqueue "github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var dropCmd = &cobra.Command{
+	Use:     "drop [id...]",
+	Aliases: []string{"rm"},
+	Short:   "Remove queued operations without sending them",
+	Long: `Remove queued operations without sending them.
+
+Examples:
+  backlog queue drop 3
+  backlog queue drop --all --yes`,
+	RunE: runDrop,
+}
+
+var dropAll bool
+
+func init() {
+	dropCmd.Flags().BoolVar(&dropAll, "all", false, "Remove all queued operations")
+}
+
+func runDrop(c *cobra.Command, args []string) error {
+	if dropAll == (len(args) > 0) {
+		return fmt.Errorf("specify queue IDs or --all")
+	}
+	ids := make(map[int]bool, len(args))
+	for _, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return fmt.Errorf("invalid queue ID %q", arg)
+		}
+		ids[id] = true
+	}
+
+	q, err := openQueue()
+	if err != nil {
+		return err
+	}
+	entries, err := q.Load()
+	if err != nil {
+		return err
+	}
+
+	var kept, dropped []internalqueue.Entry
+	for _, e := range entries {
+		if dropAll || ids[e.ID] {
+			dropped = append(dropped, e)
+			delete(ids, e.ID)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	for _, arg := range args {
+		if id, _ := strconv.Atoi(arg); ids[id] {
+			return fmt.Errorf("queued operation #%d not found", id)
+		}
+	}
+	if len(dropped) == 0 {
+		fmt.Println("No queued operations.")
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(c) {
+		if !ui.IsInteractiveInput() {
+			return cmdutil.NonInteractiveFlagError(
+				"--yes is required when not running interactively",
+				"backlog queue drop",
+				"Use --yes to skip the confirmation prompt.",
+			)
+		}
+		for _, e := range dropped {
+			fmt.Printf("  #%d %s %s\n", e.ID, e.IssueKey, e.Summary())
+		}
+		ok, err := ui.Confirm(fmt.Sprintf("Remove %d queued operation(s)?", len(dropped)), false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	if err := q.Save(kept); err != nil {
+		return fmt.Errorf("failed to update queue: %w", err)
+	}
+	cmdutil.Success("", "Removed %d queued operation(s)", len(dropped))
+	return nil
+}
@@ -0,0 +1,199 @@
+package queue
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	internalqueue "github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var flushCmd = &cobra.Command{
+	Use:   "flush [id...]",
+	Short: "Send queued operations",
+	Long: `Send queued operations for the current space in the order they were queued.
+
+Before sending, each target issue is fetched. If it was updated after the
+operation was queued, the operation is skipped as a conflict and kept in the
+queue; review the issue and run again with --force, or remove the operation
+with "backlog queue drop". Operations that fail are also kept with the error.
+Issue hooks are not run when sending queued operations.
+
+Examples:
+  backlog queue flush
+  backlog queue flush 3 4
+  backlog queue flush 3 --force`,
+	RunE: runFlush,
+}
+
+var flushForce bool
+
+func init() {
+	flushCmd.Flags().BoolVar(&flushForce, "force", false, "Send operations even if the issue was updated after they were queued")
+}
+
+func runFlush(c *cobra.Command, args []string) error {
+	ids := make(map[int]bool, len(args))
+	for _, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return fmt.Errorf("invalid queue ID %q", arg)
+		}
+		ids[id] = true
+	}
+
+	q, err := openQueue()
+	if err != nil {
+		return err
+	}
+	entries, err := q.Load()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		fmt.Println("No queued operations.")
+		return nil
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	ctx := c.Context()
+	space := cfg.CurrentProfile().Space
+
+	var targets []int
+	otherSpace := 0
+	for i, e := range entries {
+		if len(ids) > 0 && !ids[e.ID] {
+			continue
+		}
+		if e.Space != space {
+			otherSpace++
+			continue
+		}
+		targets = append(targets, i)
+	}
+	if otherSpace > 0 {
+		ui.Warning("%d operation(s) for other spaces are kept; switch profile with --profile to send them", otherSpace)
+	}
+	if len(targets) == 0 {
+		fmt.Println("No queued operations to send for " + space + ".")
+		return nil
+	}
+
+	// 送信前に対象の課題をまとめて取得し、競合を判定する
+	// 送信後に取得すると、このコマンド自身の更新を競合と誤判定してしまう
+	updated := make(map[string]string)
+	fetchErrors := make(map[string]error)
+	for _, i := range targets {
+		key := entries[i].IssueKey
+		if _, ok := updated[key]; ok || fetchErrors[key] != nil {
+			continue
+		}
+		issue, err := client.GetIssue(ctx, key)
+		if err != nil {
+			if internalqueue.IsNetworkError(err) {
+				return fmt.Errorf("still offline; nothing was sent: %w", err)
+			}
+			fetchErrors[key] = err
+			continue
+		}
+		updated[key] = issue.Updated.Value
+	}
+
+	sent := make(map[int]bool)
+	conflicts, failed := 0, 0
+	for n, i := range targets {
+		e := &entries[i]
+		prefix := fmt.Sprintf("[%d/%d]", n+1, len(targets))
+		label := fmt.Sprintf("#%d %s %s", e.ID, e.IssueKey, e.Summary())
+
+		if err := fetchErrors[e.IssueKey]; err != nil {
+			failed++
+			e.LastError = err.Error()
+			fmt.Fprintf(os.Stderr, "%s %s %s: %v\n", prefix, ui.FailMark(), label, err)
+			continue
+		}
+		if !flushForce && e.Conflicts(updated[e.IssueKey]) {
+			conflicts++
+			e.LastError = fmt.Sprintf("conflict: issue updated at %s after queued", updated[e.IssueKey])
+			fmt.Fprintf(os.Stderr, "%s %s %s: skipped, issue was updated at %s (queued at %s)\n",
+				prefix, ui.Yellow("!"), label, updated[e.IssueKey], e.QueuedAt.Format("2006-01-02T15:04:05Z07:00"))
+			continue
+		}
+
+		if err := send(c, client, e); err != nil {
+			if internalqueue.IsNetworkError(err) {
+				// 接続が切れた場合は残りも送れないため、ここまでの結果を保存して終了する
+				if serr := saveRemaining(q, entries, sent); serr != nil {
+					return serr
+				}
+				return fmt.Errorf("connection lost after sending %d operation(s): %w", len(sent), err)
+			}
+			if cmdutil.Interrupted(c.Context()) {
+				// Ctrl+C の場合も送信済みの操作だけをキューから取り除いて終了する
+				if serr := saveRemaining(q, entries, sent); serr != nil {
+					return serr
+				}
+				return cmdutil.ErrInterrupted
+			}
+			failed++
+			e.LastError = err.Error()
+			fmt.Fprintf(os.Stderr, "%s %s %s: %v\n", prefix, ui.FailMark(), label, err)
+			continue
+		}
+		sent[e.ID] = true
+		cmdutil.Progressf("%s %s %s", prefix, ui.OKMark(), label)
+		// 二重送信を防ぐため、1件ごとにキューから取り除く
+		if err := saveRemaining(q, entries, sent); err != nil {
+			return err
+		}
+	}
+	if err := saveRemaining(q, entries, sent); err != nil {
+		return err
+	}
+
+	cmdutil.Success("", "Sent %d operation(s)", len(sent))
+	if conflicts > 0 {
+		ui.Warning("%d operation(s) skipped due to conflicts; review the issues and run 'backlog queue flush --force', or remove them with 'backlog queue drop'", conflicts)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to send %d operation(s); see 'backlog queue list'", failed)
+	}
+	return nil
+}
+
+func send(c *cobra.Command, client *api.Client, e *internalqueue.Entry) error {
+	switch e.Kind {
+	case internalqueue.KindIssueComment:
+		_, err := client.AddComment(c.Context(), e.IssueKey, e.Comment, nil, nil)
+		return err
+	case internalqueue.KindIssueEdit:
+		if e.Update == nil {
+			return fmt.Errorf("no update content")
+		}
+		_, err := client.UpdateIssue(c.Context(), e.IssueKey, e.Update)
+		return err
+	default:
+		return fmt.Errorf("unsupported operation %q", e.Kind)
+	}
+}
+
+// saveRemaining は送信済みの操作を除いてキューを保存する
+func saveRemaining(q *internalqueue.File, entries []internalqueue.Entry, sent map[int]bool) error {
+	remaining := make([]internalqueue.Entry, 0, len(entries))
+	for _, e := range entries {
+		if !sent[e.ID] {
+			remaining = append(remaining, e)
+		}
+	}
+	if err := q.Save(remaining); err != nil {
+		return fmt.Errorf("failed to update queue: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List queued operations",
+	Long: `List write operations waiting to be sent.
+
+Examples:
+  backlog queue list
+  backlog queue list --output json`,
+	Args: cobra.NoArgs,
+	RunE: runList,
+}
+
+func runList(c *cobra.Command, args []string) error {
+	q, err := openQueue()
+	if err != nil {
+		return err
+	}
+	entries, err := q.Load()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := cmdutil.GetConfigStore(c)
+	if err != nil {
+		return err
+	}
+	if cfg.CurrentProfile().Output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No queued operations.")
+		return nil
+	}
+	table := ui.NewTable("ID", "SPACE", "ISSUE", "QUEUED", "OPERATION", "LAST ERROR")
+	for _, e := range entries {
+		table.AddRow(strconv.Itoa(e.ID), e.Space, e.IssueKey, e.QueuedAt.Local().Format("2006-01-02 15:04"), e.Summary(), e.LastError)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	return nil
+}
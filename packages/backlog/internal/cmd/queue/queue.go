@@ -0,0 +1,33 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	internalqueue "github.com/yacchi/backlog-cli/packages/backlog/internal/queue"
+)
+
+var QueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Manage write operations queued while offline",
+	Long: `Manage write operations saved while the network was unavailable.
+
+When "issue comment" or "issue edit" cannot reach Backlog, the operation is
+saved to a local queue instead of failing. Run "backlog queue flush" once you
+are back online to send them.`,
+}
+
+func init() {
+	QueueCmd.AddCommand(listCmd)
+	QueueCmd.AddCommand(flushCmd)
+	QueueCmd.AddCommand(dropCmd)
+}
+
+func openQueue() (*internalqueue.File, error) {
+	path, err := config.OfflineQueuePath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve queue path: %w", err)
+	}
+	return internalqueue.Open(path), nil
+}
@@ -0,0 +1,323 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var oauthCheckCmd = &cobra.Command{
+	Use:   "oauth-check",
+	Short: "Check the Backlog OAuth app settings of the relay server",
+	Long: `Check that the Backlog OAuth app configured for the relay server works.
+
+Reads client_id / client_secret of the domain from server.backlog and sends
+preflight requests to a Backlog space:
+
+  - authorize: requests the authorize endpoint with the client_id and the
+               redirect URI, and reports errors returned by Backlog
+               (unknown client, redirect URI mismatch)
+  - token:     exchanges a dummy authorization code; Backlog answers
+               "invalid_client" when client_id / client_secret are wrong and
+               "invalid_grant" when they are accepted
+
+No token is issued by this check. The redirect URI defaults to
+server.base_url + "/auth/callback", the same value the relay sends.
+When Backlog redirects the authorize request to its login page, the redirect
+URI cannot be verified without signing in; open the printed URL in a browser
+to finish the check.
+
+Examples:
+  backlog relay oauth-check --domain backlog.jp
+  backlog relay oauth-check --domain backlog.com --space myspace
+  backlog relay oauth-check --domain backlog.jp --redirect-uri https://relay.example.com/auth/callback`,
+	Args: cobra.NoArgs,
+	RunE: runOAuthCheck,
+}
+
+var (
+	oauthCheckDomain      string
+	oauthCheckSpace       string
+	oauthCheckRedirectURI string
+)
+
+func init() {
+	oauthCheckCmd.Flags().StringVar(&oauthCheckDomain, "domain", "", "Backlog domain of the OAuth app (backlog.jp or backlog.com)")
+	oauthCheckCmd.Flags().StringVar(&oauthCheckSpace, "space", "", "Space used for the preflight requests (default: space of the current profile)")
+	oauthCheckCmd.Flags().StringVar(&oauthCheckRedirectURI, "redirect-uri", "", "Redirect URI registered for the app (default: server.base_url + /auth/callback)")
+	_ = oauthCheckCmd.MarkFlagRequired("domain")
+}
+
+// oauthCheckStatus は診断項目の結果
+type oauthCheckStatus string
+
+const (
+	oauthCheckOK   oauthCheckStatus = "ok"
+	oauthCheckWarn oauthCheckStatus = "warn"
+	oauthCheckFail oauthCheckStatus = "fail"
+)
+
+// oauthCheckResult は診断項目ごとの結果
+type oauthCheckResult struct {
+	Name   string           `json:"name"`
+	Status oauthCheckStatus `json:"status"`
+	Detail string           `json:"detail"`
+	URL    string           `json:"url,omitempty"`
+}
+
+// oauthApp は診断対象の OAuth アプリ設定
+type oauthApp struct {
+	SpaceURL     string // https://myspace.backlog.jp
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+}
+
+func runOAuthCheck(cmd *cobra.Command, args []string) error {
+	cfg, err := cmdutil.GetConfigStore(cmd)
+	if err != nil {
+		return err
+	}
+	profile := cfg.CurrentProfile()
+
+	app := cfg.BacklogApp(oauthCheckDomain)
+	if app == nil {
+		return fmt.Errorf("no Backlog app is configured for %s (set server.backlog.<name>.domain, client_id and client_secret)", oauthCheckDomain)
+	}
+
+	space := oauthCheckSpace
+	if space == "" && (profile.Domain == "" || profile.Domain == oauthCheckDomain) {
+		space = profile.Space
+	}
+	if space == "" {
+		return fmt.Errorf("space is required: use --space")
+	}
+
+	redirectURI := oauthCheckRedirectURI
+	if redirectURI == "" {
+		baseURL := strings.TrimRight(cfg.Server().BaseURL, "/")
+		if baseURL == "" {
+			return fmt.Errorf("redirect URI is unknown: use --redirect-uri or set server.base_url")
+		}
+		redirectURI = baseURL + "/auth/callback"
+	}
+
+	target := oauthApp{
+		SpaceURL:     "https://" + oauthSpaceHost(space, oauthCheckDomain),
+		ClientID:     app.ClientID(),
+		ClientSecret: app.ClientSecret(),
+		RedirectURI:  redirectURI,
+	}
+	stop := ui.StartProgress("Checking the OAuth app...")
+	results := checkOAuthApp(cmd.Context(), &http.Client{Timeout: 15 * time.Second}, target)
+	stop()
+
+	if profile.Output == "json" {
+		if err := cmdutil.OutputJSONFromProfile(results, profile.JSONFields, profile.JQ, profile.Template); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("%s %s\n", ui.Bold("Space:"), target.SpaceURL)
+		fmt.Printf("%s %s\n", ui.Bold("Client ID:"), target.ClientID)
+		fmt.Printf("%s %s\n\n", ui.Bold("Redirect URI:"), target.RedirectURI)
+		for _, r := range results {
+			fmt.Println(formatOAuthCheck(r))
+		}
+	}
+
+	for _, r := range results {
+		if r.Status == oauthCheckFail {
+			return fmt.Errorf("OAuth app check failed")
+		}
+	}
+	return nil
+}
+
+// oauthSpaceHost は --space の値（スペース名またはスペースホスト）をホスト名にする
+func oauthSpaceHost(space, domain string) string {
+	if strings.Contains(space, ".") {
+		return space
+	}
+	return space + "." + domain
+}
+
+func formatOAuthCheck(r oauthCheckResult) string {
+	var mark string
+	switch r.Status {
+	case oauthCheckOK:
+		mark = ui.OKMark()
+	case oauthCheckFail:
+		mark = ui.FailMark()
+	default:
+		mark = ui.Yellow("!")
+	}
+	line := fmt.Sprintf("%s %s: %s", mark, r.Name, r.Detail)
+	if r.URL != "" {
+		line += "\n    " + ui.Cyan(r.URL)
+	}
+	return line
+}
+
+// checkOAuthApp は設定の確認と authorize / token エンドポイントへの事前検証を行う
+func checkOAuthApp(ctx context.Context, client *http.Client, app oauthApp) []oauthCheckResult {
+	var missing []string
+	if app.ClientID == "" {
+		missing = append(missing, "client_id")
+	}
+	if app.ClientSecret == "" {
+		missing = append(missing, "client_secret")
+	}
+	if len(missing) > 0 {
+		return []oauthCheckResult{{Name: "config", Status: oauthCheckFail, Detail: strings.Join(missing, ", ") + " is not set"}}
+	}
+	results := []oauthCheckResult{{Name: "config", Status: oauthCheckOK, Detail: "client_id and client_secret are set"}}
+	if u, err := url.Parse(app.RedirectURI); err != nil || u.Scheme == "" || u.Host == "" {
+		results = append(results, oauthCheckResult{Name: "redirect_uri", Status: oauthCheckFail, Detail: fmt.Sprintf("%q is not an absolute URL", app.RedirectURI)})
+		return results
+	} else if u.Scheme != "https" && u.Hostname() != "localhost" {
+		results = append(results, oauthCheckResult{Name: "redirect_uri", Status: oauthCheckWarn, Detail: "redirect URI is not https; Backlog may reject it"})
+	}
+	results = append(results, checkAuthorize(ctx, client, app))
+	results = append(results, checkToken(ctx, client, app))
+	return results
+}
+
+// checkAuthorize は authorize エンドポイントにリクエストし、Backlog の応答から設定の誤りを判定する
+// リダイレクトは追わず、リダイレクト先が redirect URI かログイン画面かで判定する
+func checkAuthorize(ctx context.Context, client *http.Client, app oauthApp) oauthCheckResult {
+	authURL := app.SpaceURL + "/OAuth2AccessRequest.action?" + url.Values{
+		"response_type": {"code"},
+		"client_id":     {app.ClientID},
+		"redirect_uri":  {app.RedirectURI},
+		"state":         {"oauth-check"},
+	}.Encode()
+	result := oauthCheckResult{Name: "authorize"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, authURL, nil)
+	if err != nil {
+		result.Status, result.Detail = oauthCheckFail, err.Error()
+		return result
+	}
+	noRedirect := *client
+	noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err := noRedirect.Do(req)
+	if err != nil {
+		result.Status, result.Detail = oauthCheckFail, fmt.Sprintf("request failed: %v", err)
+		return result
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode >= 300 && resp.StatusCode < 400:
+		location := resp.Header.Get("Location")
+		if strings.HasPrefix(location, app.RedirectURI) {
+			loc, _ := url.Parse(location)
+			if errCode := loc.Query().Get("error"); errCode != "" {
+				result.Status = oauthCheckFail
+				result.Detail = oauthErrorDetail(errCode, loc.Query().Get("error_description"))
+				return result
+			}
+			result.Status, result.Detail = oauthCheckOK, "Backlog accepted the client_id and redirect URI"
+			return result
+		}
+		// 未ログインの場合はログイン画面に送られ、redirect URI の照合はログイン後になる
+		result.Status = oauthCheckWarn
+		result.Detail = "redirected to the login page; sign in with this URL in a browser to verify the redirect URI"
+		result.URL = authURL
+		return result
+	case resp.StatusCode == http.StatusOK:
+		// 同意画面かエラー画面かは本文からは判別できないため、ブラウザでの確認を促す
+		result.Status = oauthCheckWarn
+		result.Detail = "Backlog returned a page instead of redirecting; open this URL in a browser to check it"
+		result.URL = authURL
+		return result
+	default:
+		result.Status = oauthCheckFail
+		result.Detail = fmt.Sprintf("Backlog returned HTTP %d (unknown client_id or redirect URI mismatch)%s", resp.StatusCode, bodyExcerpt(body))
+		result.URL = authURL
+		return result
+	}
+}
+
+// checkToken はダミーの認可コードで token エンドポイントを呼び、クライアント認証の結果を判定する
+// client_id / client_secret が正しければ invalid_grant（コードが無効）、誤っていれば invalid_client が返る
+func checkToken(ctx context.Context, client *http.Client, app oauthApp) oauthCheckResult {
+	result := oauthCheckResult{Name: "token"}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {"oauth-check-dummy-code"},
+		"redirect_uri":  {app.RedirectURI},
+		"client_id":     {app.ClientID},
+		"client_secret": {app.ClientSecret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, app.SpaceURL+"/api/v2/oauth2/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		result.Status, result.Detail = oauthCheckFail, err.Error()
+		return result
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := client.Do(req)
+	if err != nil {
+		result.Status, result.Detail = oauthCheckFail, fmt.Sprintf("request failed: %v", err)
+		return result
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	var oauthErr struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+		Errors           []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	_ = json.Unmarshal(body, &oauthErr)
+
+	switch {
+	case oauthErr.Error == "invalid_client" || oauthErr.Error == "unauthorized_client" || resp.StatusCode == http.StatusUnauthorized:
+		result.Status = oauthCheckFail
+		result.Detail = "client_id or client_secret is invalid: " + oauthErrorDetail(oauthErr.Error, oauthErr.ErrorDescription)
+	case oauthErr.Error == "invalid_grant":
+		result.Status, result.Detail = oauthCheckOK, "client_id and client_secret were accepted"
+	case oauthErr.Error != "":
+		result.Status = oauthCheckWarn
+		result.Detail = "unexpected response: " + oauthErrorDetail(oauthErr.Error, oauthErr.ErrorDescription)
+	case len(oauthErr.Errors) > 0:
+		result.Status = oauthCheckWarn
+		result.Detail = fmt.Sprintf("unexpected response (HTTP %d): %s", resp.StatusCode, oauthErr.Errors[0].Message)
+	default:
+		result.Status = oauthCheckWarn
+		result.Detail = fmt.Sprintf("unexpected response (HTTP %d)%s", resp.StatusCode, bodyExcerpt(body))
+	}
+	return result
+}
+
+func oauthErrorDetail(code, description string) string {
+	if description == "" {
+		return code
+	}
+	return fmt.Sprintf("%s (%s)", code, description)
+}
+
+// bodyExcerpt はエラー表示用にレスポンス本文の先頭を返す
+func bodyExcerpt(body []byte) string {
+	text := strings.Join(strings.Fields(string(body)), " ")
+	if text == "" {
+		return ""
+	}
+	runes := []rune(text)
+	if len(runes) > 120 {
+		text = string(runes[:120]) + "..."
+	}
+	return ": " + text
+}
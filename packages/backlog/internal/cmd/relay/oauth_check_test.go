@@ -0,0 +1,127 @@
+package relay
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// fakeBacklogOAuth は authorize / token エンドポイントを模したサーバー
+func fakeBacklogOAuth(t *testing.T, clientID, clientSecret, redirectURI string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/OAuth2AccessRequest.action", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case q.Get("client_id") != clientID:
+			http.Error(w, "invalid client", http.StatusBadRequest)
+		case q.Get("redirect_uri") != redirectURI:
+			http.Redirect(w, r, q.Get("redirect_uri")+"?error=invalid_request&error_description=redirect_uri+mismatch", http.StatusFound)
+		default:
+			http.Redirect(w, r, "/LoginDisplay.action", http.StatusFound)
+		}
+	})
+	mux.HandleFunc("/api/v2/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		if r.PostForm.Get("client_id") != clientID || r.PostForm.Get("client_secret") != clientSecret {
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"code is invalid"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func statuses(results []oauthCheckResult) map[string]oauthCheckStatus {
+	m := make(map[string]oauthCheckStatus, len(results))
+	for _, r := range results {
+		m[r.Name] = r.Status
+	}
+	return m
+}
+
+func TestCheckOAuthApp(t *testing.T) {
+	const redirect = "https://relay.example.com/auth/callback"
+	srv := fakeBacklogOAuth(t, "id", "secret", redirect)
+
+	tests := []struct {
+		name string
+		app  oauthApp
+		want map[string]oauthCheckStatus
+	}{
+		{
+			name: "valid",
+			app:  oauthApp{ClientID: "id", ClientSecret: "secret", RedirectURI: redirect},
+			// 未ログインではログイン画面に送られるため redirect URI は確認できない
+			want: map[string]oauthCheckStatus{"config": oauthCheckOK, "authorize": oauthCheckWarn, "token": oauthCheckOK},
+		},
+		{
+			name: "wrong secret",
+			app:  oauthApp{ClientID: "id", ClientSecret: "wrong", RedirectURI: redirect},
+			want: map[string]oauthCheckStatus{"config": oauthCheckOK, "authorize": oauthCheckWarn, "token": oauthCheckFail},
+		},
+		{
+			name: "unknown client",
+			app:  oauthApp{ClientID: "other", ClientSecret: "secret", RedirectURI: redirect},
+			want: map[string]oauthCheckStatus{"config": oauthCheckOK, "authorize": oauthCheckFail, "token": oauthCheckFail},
+		},
+		{
+			name: "redirect mismatch",
+			app:  oauthApp{ClientID: "id", ClientSecret: "secret", RedirectURI: "https://other.example.com/auth/callback"},
+			want: map[string]oauthCheckStatus{"config": oauthCheckOK, "authorize": oauthCheckFail, "token": oauthCheckOK},
+		},
+		{
+			name: "missing secret",
+			app:  oauthApp{ClientID: "id", RedirectURI: redirect},
+			want: map[string]oauthCheckStatus{"config": oauthCheckFail},
+		},
+		{
+			name: "relative redirect",
+			app:  oauthApp{ClientID: "id", ClientSecret: "secret", RedirectURI: "/auth/callback"},
+			want: map[string]oauthCheckStatus{"config": oauthCheckOK, "redirect_uri": oauthCheckFail},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.app.SpaceURL = srv.URL
+			got := statuses(checkOAuthApp(context.Background(), srv.Client(), tt.app))
+			if len(got) != len(tt.want) {
+				t.Fatalf("results = %v, want %v", got, tt.want)
+			}
+			for name, want := range tt.want {
+				if got[name] != want {
+					t.Errorf("%s = %q, want %q (all: %v)", name, got[name], want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckAuthorizeLoginURL(t *testing.T) {
+	const redirect = "https://relay.example.com/auth/callback"
+	srv := fakeBacklogOAuth(t, "id", "secret", redirect)
+
+	r := checkAuthorize(context.Background(), srv.Client(), oauthApp{SpaceURL: srv.URL, ClientID: "id", ClientSecret: "secret", RedirectURI: redirect})
+	u, err := url.Parse(r.URL)
+	if err != nil || r.URL == "" {
+		t.Fatalf("URL = %q, want the authorize URL", r.URL)
+	}
+	if u.Query().Get("redirect_uri") != redirect || u.Query().Get("client_id") != "id" {
+		t.Errorf("URL query = %v", u.Query())
+	}
+}
+
+func TestOAuthSpaceHost(t *testing.T) {
+	if got := oauthSpaceHost("myspace", "backlog.jp"); got != "myspace.backlog.jp" {
+		t.Errorf("oauthSpaceHost(myspace) = %q", got)
+	}
+	if got := oauthSpaceHost("myspace.backlog.com", "backlog.jp"); got != "myspace.backlog.com" {
+		t.Errorf("oauthSpaceHost(host) = %q", got)
+	}
+}
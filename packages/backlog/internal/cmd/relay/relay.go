@@ -0,0 +1,22 @@
+package relay
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// RelayCmd is the root command for relay server operations
+var RelayCmd = &cobra.Command{
+	Use:   "relay",
+	Short: "Manage the OAuth relay server",
+	Long: `Commands for OAuth relay server administrators.
+
+Examples:
+  backlog relay stats
+  backlog relay stats --since 30d
+  backlog relay oauth-check --domain backlog.jp`,
+}
+
+func init() {
+	RelayCmd.AddCommand(statsCmd)
+	RelayCmd.AddCommand(oauthCheckCmd)
+}
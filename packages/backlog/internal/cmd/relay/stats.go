@@ -0,0 +1,119 @@
+package relay
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show token usage of a relay tenant",
+	Long: `Show token usage of a relay tenant (admin token required).
+
+Displays the number of tokens issued, active users and the last time each
+user obtained a token through the relay server. The admin token is the value
+whose SHA-256 digest is configured as tenants[].admin_token_hash on the relay.
+
+Examples:
+  BACKLOG_RELAY_ADMIN_TOKEN=xxx backlog relay stats
+  backlog relay stats --tenant acme --since 30d --admin-token xxx
+  backlog relay stats --output json`,
+	RunE:        runStats,
+	Annotations: cmdutil.AutoSave(),
+}
+
+var (
+	statsTenant     string
+	statsSince      string
+	statsSpace      string
+	statsAdminToken string
+)
+
+func init() {
+	statsCmd.Flags().StringVar(&statsTenant, "tenant", "", "Relay tenant name (default: bundle of the current profile)")
+	statsCmd.Flags().StringVar(&statsSince, "since", "7d", "Aggregation period (e.g. 24h, 7d)")
+	statsCmd.Flags().StringVar(&statsSpace, "space", "", "Backlog space host to aggregate (default: tenant default space)")
+	statsCmd.Flags().StringVar(&statsAdminToken, "admin-token", "", "Relay admin token (or set "+config.EnvRelayAdminToken+")")
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	cfg, err := cmdutil.GetConfigStore(cmd)
+	if err != nil {
+		return err
+	}
+	profile := cfg.CurrentProfile()
+
+	since, err := cmdutil.ParseDurationFlag("--since", statsSince)
+	if err != nil {
+		return err
+	}
+
+	tenant := statsTenant
+	if tenant == "" {
+		tenant = profile.Bundle
+	}
+	if tenant == "" {
+		return fmt.Errorf("tenant is required: use --tenant or a profile imported from a relay bundle")
+	}
+
+	token := statsAdminToken
+	if token == "" {
+		token = os.Getenv(config.EnvRelayAdminToken)
+	}
+	if token == "" {
+		return fmt.Errorf("admin token is required: use --admin-token or set %s", config.EnvRelayAdminToken)
+	}
+
+	relayURL, err := cfg.ResolveRelayURL(profile)
+	if err != nil {
+		return err
+	}
+
+	stats, err := config.FetchRelayStats(cmd.Context(), relayURL, tenant, token, config.RelayStatsOptions{
+		Since: since,
+		Space: statsSpace,
+	})
+	if err != nil {
+		return err
+	}
+
+	if profile.Output == "json" {
+		return cmdutil.OutputJSONFromProfile(stats, profile.JSONFields, profile.JQ, profile.Template)
+	}
+
+	display := cfg.Display()
+	formatter := ui.NewFieldFormatter(display.Timezone, display.DateTimeFormat, nil)
+
+	fmt.Printf("%s %s\n", ui.Bold("Tenant:"), stats.Tenant)
+	if stats.Space != "" {
+		fmt.Printf("%s %s\n", ui.Bold("Space:"), stats.Space)
+	}
+	fmt.Printf("%s %s - %s\n", ui.Bold("Period:"),
+		formatter.FormatDateTime(stats.StartTime, "updated"),
+		formatter.FormatDateTime(stats.EndTime, "updated"))
+	fmt.Printf("%s %d (exchange: %d, refresh: %d)\n", ui.Bold("Tokens issued:"),
+		stats.TokenIssued, stats.TokenExchanges, stats.TokenRefreshes)
+	fmt.Printf("%s %d\n", ui.Bold("Active users:"), stats.ActiveUsers)
+
+	if len(stats.Users) == 0 {
+		return nil
+	}
+	fmt.Println()
+	table := ui.NewTable("USER", "EMAIL", "SPACE", "TOKENS", "LAST USED")
+	for _, u := range stats.Users {
+		name := u.UserName
+		if name == "" {
+			name = u.UserID
+		}
+		table.AddRow(name, u.UserEmail, u.Space, strconv.Itoa(u.TokenCount),
+			formatter.FormatDateTime(u.LastUsedAt, "updated"))
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	return nil
+}
@@ -0,0 +1,210 @@
+package resolve
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+)
+
+var ResolveCmd = &cobra.Command{
+	Use:   "resolve <url>",
+	Short: "Identify the Backlog item a URL points to",
+	Long: `Parse a Backlog URL copied from the browser and print what it points to
+as JSON: the type (issue, wiki, pull-request, file, document), the project,
+keys and IDs contained in the URL, and the CLI command that opens it.
+
+The URL is parsed locally without calling the API, so only the identifiers
+present in the URL are returned (e.g. a wiki URL by page name has no ID).
+
+Supported URLs:
+  /view/PROJ-123[#comment-1]                  issue
+  /alias/wiki/123, /wiki/PROJ/Page            wiki
+  /git/PROJ/repo/pullRequests/12[#comment-1]  pull-request
+  /file/PROJ/path/to/file                     file
+  /document/ID, /document/PROJ/ID             document
+
+Examples:
+  backlog resolve https://example.backlog.jp/view/PROJ-123
+  backlog resolve "$URL" --jq .key
+  eval "$(backlog resolve "$URL" --format '{{.command}}')"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runResolve,
+}
+
+// Target は URL が指す Backlog の対象
+type Target struct {
+	Type       string `json:"type"`
+	Space      string `json:"space"`
+	ProjectKey string `json:"projectKey,omitempty"`
+	// Key は課題キー（PROJ-123）
+	Key        string `json:"key,omitempty"`
+	ID         int    `json:"id,omitempty"`
+	Number     int    `json:"number,omitempty"`
+	Repository string `json:"repository,omitempty"`
+	// Name は Wiki のページ名
+	Name string `json:"name,omitempty"`
+	// Path はファイルのパス
+	Path      string `json:"path,omitempty"`
+	CommentID int    `json:"commentId,omitempty"`
+	URL       string `json:"url"`
+	// Command は対象を開く CLI コマンド
+	Command string `json:"command,omitempty"`
+}
+
+// 対象の種別
+const (
+	TypeIssue       = "issue"
+	TypeWiki        = "wiki"
+	TypePullRequest = "pull-request"
+	TypeFile        = "file"
+	TypeDocument    = "document"
+)
+
+func runResolve(c *cobra.Command, args []string) error {
+	target, err := ParseURL(args[0])
+	if err != nil {
+		return err
+	}
+	cfg, err := cmdutil.GetConfigStore(c)
+	if err != nil {
+		return err
+	}
+	profile := cfg.CurrentProfile()
+	return cmdutil.OutputJSONFromProfile(target, profile.JSONFields, profile.JQ, profile.Template)
+}
+
+var (
+	issueKeyPattern  = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)-([0-9]+)$`)
+	commentIDPattern = regexp.MustCompile(`^comment-([0-9]+)$`)
+)
+
+// ParseURL は Backlog の URL を解析して対象を返す
+func ParseURL(raw string) (*Target, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("not a URL: %s", raw)
+	}
+	// ページ名やファイル名に含まれる "/" を区別するため、エスケープされたままのパスを分割する
+	var segments []string
+	for _, s := range strings.Split(strings.Trim(u.EscapedPath(), "/"), "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	t := &Target{Space: u.Host, URL: u.String()}
+	if m := commentIDPattern.FindStringSubmatch(u.Fragment); m != nil {
+		t.CommentID, _ = strconv.Atoi(m[1])
+	}
+
+	unsupported := fmt.Errorf("unsupported Backlog URL: %s", raw)
+	if len(segments) < 2 {
+		return nil, unsupported
+	}
+	switch segments[0] {
+	case "view":
+		m := issueKeyPattern.FindStringSubmatch(segments[1])
+		if m == nil {
+			return nil, unsupported
+		}
+		t.Type = TypeIssue
+		t.ProjectKey = strings.ToUpper(m[1])
+		t.Key = t.ProjectKey + "-" + m[2]
+		t.Number, _ = strconv.Atoi(m[2])
+		t.Command = "backlog issue view " + t.Key
+	case "alias":
+		if len(segments) < 3 || segments[1] != "wiki" {
+			return nil, unsupported
+		}
+		id, err := strconv.Atoi(segments[2])
+		if err != nil {
+			return nil, unsupported
+		}
+		t.Type = TypeWiki
+		t.ID = id
+		t.Command = fmt.Sprintf("backlog wiki view %d", id)
+	case "wiki":
+		if len(segments) < 3 {
+			return nil, unsupported
+		}
+		name, err := unescapeJoin(segments[2:])
+		if err != nil {
+			return nil, unsupported
+		}
+		t.Type = TypeWiki
+		t.ProjectKey = segments[1]
+		t.Name = name
+		t.Command = "backlog wiki view " + shellQuote(name) + " -p " + t.ProjectKey
+	case "git":
+		// /git/PROJ/repo/pullRequests/12 以降の /diff などは無視する
+		if len(segments) < 5 || segments[3] != "pullRequests" {
+			return nil, unsupported
+		}
+		number, err := strconv.Atoi(segments[4])
+		if err != nil {
+			return nil, unsupported
+		}
+		repo, err := url.PathUnescape(segments[2])
+		if err != nil {
+			return nil, unsupported
+		}
+		t.Type = TypePullRequest
+		t.ProjectKey = segments[1]
+		t.Repository = repo
+		t.Number = number
+		t.Command = fmt.Sprintf("backlog pr view %d --repo %s -p %s", number, shellQuote(repo), t.ProjectKey)
+	case "file":
+		if len(segments) < 3 {
+			return nil, unsupported
+		}
+		p, err := unescapeJoin(segments[2:])
+		if err != nil {
+			return nil, unsupported
+		}
+		t.Type = TypeFile
+		t.ProjectKey = segments[1]
+		t.Path = "/" + p
+		t.Command = "backlog file list " + shellQuote(path.Dir(t.Path)) + " -p " + t.ProjectKey
+	case "document":
+		t.Type = TypeDocument
+		docID := segments[1]
+		if len(segments) >= 3 {
+			t.ProjectKey = segments[1]
+			docID = segments[2]
+		}
+		t.Key = docID
+		t.Command = "backlog document view " + shellQuote(docID)
+	default:
+		return nil, unsupported
+	}
+	return t, nil
+}
+
+// unescapeJoin はパスの各要素をアンエスケープして "/" で連結する
+func unescapeJoin(segments []string) (string, error) {
+	parts := make([]string, len(segments))
+	for i, s := range segments {
+		p, err := url.PathUnescape(s)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = p
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+// shellQuote はシェルで1引数として扱われるよう必要に応じてシングルクォートで囲む
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r == '-' || r == '_' || r == '.' || r == '/' || r == ':' ||
+			(r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
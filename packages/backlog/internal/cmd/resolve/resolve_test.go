@@ -0,0 +1,70 @@
+package resolve
+
+import (
+	"testing"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want Target
+	}{
+		{
+			name: "issue with comment",
+			url:  "https://example.backlog.jp/view/proj-123#comment-456",
+			want: Target{Type: TypeIssue, ProjectKey: "PROJ", Key: "PROJ-123", Number: 123, CommentID: 456, Command: "backlog issue view PROJ-123"},
+		},
+		{
+			name: "wiki by id",
+			url:  "https://example.backlog.jp/alias/wiki/789",
+			want: Target{Type: TypeWiki, ID: 789, Command: "backlog wiki view 789"},
+		},
+		{
+			name: "wiki by name",
+			url:  "https://example.backlog.jp/wiki/PROJ/Design%2FAPI%20Overview",
+			want: Target{Type: TypeWiki, ProjectKey: "PROJ", Name: "Design/API Overview", Command: "backlog wiki view 'Design/API Overview' -p PROJ"},
+		},
+		{
+			name: "pull request diff",
+			url:  "https://example.backlog.com/git/PROJ/app/pullRequests/12/diff#comment-3",
+			want: Target{Type: TypePullRequest, ProjectKey: "PROJ", Repository: "app", Number: 12, CommentID: 3, Command: "backlog pr view 12 --repo app -p PROJ"},
+		},
+		{
+			name: "file",
+			url:  "https://example.backlog.jp/file/PROJ/docs/spec.pdf",
+			want: Target{Type: TypeFile, ProjectKey: "PROJ", Path: "/docs/spec.pdf", Command: "backlog file list /docs -p PROJ"},
+		},
+		{
+			name: "document",
+			url:  "https://example.backlog.jp/document/PROJ/0192abcd",
+			want: Target{Type: TypeDocument, ProjectKey: "PROJ", Key: "0192abcd", Command: "backlog document view 0192abcd"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseURL(tt.url)
+			if err != nil {
+				t.Fatalf("ParseURL() error = %v", err)
+			}
+			got.Space, got.URL = "", ""
+			if *got != tt.want {
+				t.Errorf("ParseURL() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseURLErrors(t *testing.T) {
+	for _, raw := range []string{
+		"PROJ-123",
+		"https://example.backlog.jp/",
+		"https://example.backlog.jp/projects/PROJ",
+		"https://example.backlog.jp/view/not-a-key",
+		"https://example.backlog.jp/git/PROJ/app",
+	} {
+		if _, err := ParseURL(raw); err == nil {
+			t.Errorf("ParseURL(%q) expected error", raw)
+		}
+	}
+}
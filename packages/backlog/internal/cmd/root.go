@@ -1,21 +1,31 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"strings"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/activity"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/ai"
 	apicmd "github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/auth"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/backup"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/blame"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/category"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/changelog"
 	configcmd "github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/customfield"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/dev"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/document"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/draft"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/file"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/graph"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/history"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/inbox"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/issue"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/issue_type"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/links"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/markdown"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/milestone"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/notification"
@@ -23,13 +33,20 @@ import (
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/priority"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/profile"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/project"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/proxy"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/queue"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/relay"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/repo"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/resolution"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/resolve"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/space"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/status"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/user"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/watch"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/watching"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/webhook"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/wiki"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
@@ -55,6 +72,10 @@ Work with issues, pull requests, wikis, and more, all from the command line.`,
 		if debugFlag, _ := cmd.Flags().GetBool("debug"); debugFlag {
 			debug.Enable()
 		}
+		// --quiet / --verbose による出力量の設定
+		if err := cmdutil.ApplyOutputLevel(cmd); err != nil {
+			return err
+		}
 
 		ctx := cmd.Context()
 		cfg, err := config.Load(ctx)
@@ -93,16 +114,33 @@ Work with issues, pull requests, wikis, and more, all from the command line.`,
 			}
 		}
 
-		// カラー設定
+		// スクリーンリーダー向けのプレーン出力（--accessible / BACKLOG_ACCESSIBLE）。色の設定より優先する
+		accessible, _ := cmd.Flags().GetBool("accessible")
+		ui.SetAccessible(accessible || os.Getenv("BACKLOG_ACCESSIBLE") != "")
+
+		// カラー設定（優先順: --no-color > --color > profile.color。auto は NO_COLOR と端末判定に従う）
+		colorMode := ""
+		if profile := cfg.CurrentProfile(); profile != nil {
+			colorMode = profile.Color
+		}
+		if cmd.Flags().Changed("color") {
+			colorMode, _ = cmd.Flags().GetString("color")
+		}
 		if noColor, _ := cmd.Flags().GetBool("no-color"); noColor {
-			ui.SetColorEnabled(false)
-		} else if profile := cfg.CurrentProfile(); profile != nil {
-			switch strings.ToLower(profile.Color) {
-			case "never":
-				ui.SetColorEnabled(false)
-			case "always":
-				ui.SetColorEnabled(true)
-			}
+			colorMode = "never"
+		}
+		if err := ui.ApplyColorMode(colorMode); err != nil {
+			return err
+		}
+		display := cfg.Display()
+		if err := ui.SetStatusColors(display.Colors.Status); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		if err := ui.SetPriorityColors(display.Colors.Priority); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		if err := ui.SetPRStatusColors(display.Colors.PRStatus); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
 		}
 
 		// グローバルフラグを取得してArgsレイヤーに適用
@@ -136,20 +174,45 @@ Work with issues, pull requests, wikis, and more, all from the command line.`,
 		}
 
 		// formatフラグはGo templateを使ったJSON出力を有効にする
-		if tmpl, _ := cmd.Flags().GetString("format"); tmpl != "" {
+		// 独自の --format を持つサブコマンド（graph など）ではグローバルフラグが隠れるため対象外
+		if f := cmd.Flags().Lookup("format"); f != nil && f == cmd.Root().PersistentFlags().Lookup("format") && f.Value.String() != "" {
+			tmpl := f.Value.String()
 			activeProfile := cfg.GetActiveProfile()
 			setOptions = append(setOptions, jubako.String(config.PathProfileOutput(activeProfile), "json"))
 			setOptions = append(setOptions, jubako.String(config.PathProfileTemplate(activeProfile), tmpl))
 		}
 
 		if len(setOptions) > 0 {
-			return cfg.SetFlagsLayer(setOptions)
+			if err := cfg.SetFlagsLayer(setOptions); err != nil {
+				return err
+			}
 		}
 
+		// バンドルで配布されたチームポリシー（禁止コマンド・禁止フラグ）
+		if err := checkPolicy(cmd, cfg); err != nil {
+			return err
+		}
+
+		// display.commands のコマンド単位の既定値（--limit など）
+		cmdutil.ApplyCommandDefaults(cmd, cfg.Display())
+
+		if profile := cfg.CurrentProfile(); profile != nil {
+			cmdutil.Verbosef("profile: %s (space: %s, project: %s)", cfg.GetActiveProfile(), valueOrNone(profile.Space), valueOrNone(cmdutil.GetCurrentProject(cfg)))
+		}
+
+		// display.auto_save_dir によるレポート系コマンドの出力の自動保存
+		startAutoSave(cmd, cfg)
 		return nil
 	},
 }
 
+func valueOrNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
 func isAuthCommand(cmd *cobra.Command) bool {
 	for c := cmd; c != nil; c = c.Parent() {
 		if c.Name() == "auth" {
@@ -161,7 +224,14 @@ func isAuthCommand(cmd *cobra.Command) bool {
 
 func Execute() error {
 	rootCmd.Version = Version
-	return rootCmd.Execute()
+	// Ctrl+C で全コマンドの context をキャンセルし、実行中の HTTP リクエストを中断する
+	ctx, stop := cmdutil.NotifyInterrupt(context.Background())
+	defer stop()
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	err = cmdutil.InterruptedError(ctx, err)
+	finishAutoSave(cmd, err)
+	recordAudit(cmd, err)
+	return err
 }
 
 func init() {
@@ -174,7 +244,9 @@ func init() {
 	rootCmd.PersistentFlags().Lookup("json").NoOptDefVal = "*"
 	rootCmd.PersistentFlags().String("jq", "", "Filter JSON output using a jq expression")
 	rootCmd.PersistentFlags().StringP("format", "f", "", "Format JSON output using a Go template (e.g. '{{.summary}}')")
-	rootCmd.PersistentFlags().Bool("no-color", false, "Disable color output")
+	rootCmd.PersistentFlags().String("color", "", "When to use color output: {auto|always|never} (default from profile color)")
+	rootCmd.PersistentFlags().Bool("no-color", false, "Disable color output (same as --color never)")
+	rootCmd.PersistentFlags().Bool("accessible", false, "Plain output for screen readers: no colors, rules or spinners; tables as \"label: value\" lines (env: BACKLOG_ACCESSIBLE)")
 	rootCmd.PersistentFlags().Bool("debug", false, "Enable debug logging")
 	rootCmd.PersistentFlags().BoolP("yes", "y", false, "Skip confirmation prompts (env: BACKLOG_ASSUME_YES)")
 
@@ -183,13 +255,22 @@ func init() {
 	rootCmd.AddCommand(ai.AICmd)
 	rootCmd.AddCommand(apicmd.APICmd)
 	rootCmd.AddCommand(auth.AuthCmd)
+	rootCmd.AddCommand(backup.BackupCmd)
+	rootCmd.AddCommand(blame.BlameCmd)
 	rootCmd.AddCommand(category.CategoryCmd)
+	rootCmd.AddCommand(changelog.ChangelogCmd)
 	rootCmd.AddCommand(configcmd.ConfigCmd)
 	rootCmd.AddCommand(customfield.CustomFieldCmd)
 	rootCmd.AddCommand(document.DocumentCmd)
+	rootCmd.AddCommand(dev.DevCmd)
+	rootCmd.AddCommand(draft.DraftCmd)
 	rootCmd.AddCommand(file.FileCmd)
+	rootCmd.AddCommand(graph.GraphCmd)
+	rootCmd.AddCommand(history.HistoryCmd)
+	rootCmd.AddCommand(inbox.InboxCmd)
 	rootCmd.AddCommand(issue.IssueCmd)
 	rootCmd.AddCommand(issue_type.IssueTypeCmd)
+	rootCmd.AddCommand(links.LinksCmd)
 	rootCmd.AddCommand(markdown.MarkdownCmd)
 	rootCmd.AddCommand(milestone.MilestoneCmd)
 	rootCmd.AddCommand(notification.NotificationCmd)
@@ -197,12 +278,18 @@ func init() {
 	rootCmd.AddCommand(priority.PriorityCmd)
 	rootCmd.AddCommand(profile.ProfileCmd)
 	rootCmd.AddCommand(project.ProjectCmd)
+	rootCmd.AddCommand(proxy.ProxyCmd)
+	rootCmd.AddCommand(queue.QueueCmd)
+	rootCmd.AddCommand(relay.RelayCmd)
 	rootCmd.AddCommand(repo.RepoCmd)
 	rootCmd.AddCommand(resolution.ResolutionCmd)
+	rootCmd.AddCommand(resolve.ResolveCmd)
 	rootCmd.AddCommand(space.SpaceCmd)
 	rootCmd.AddCommand(status.StatusCmd)
 	rootCmd.AddCommand(user.UserCmd)
+	rootCmd.AddCommand(watch.WatchCmd)
 	rootCmd.AddCommand(watching.WatchingCmd)
+	rootCmd.AddCommand(webhook.WebhookCmd)
 	rootCmd.AddCommand(wiki.WikiCmd)
 
 	// whoami: top-level alias for "auth me"
@@ -219,4 +306,19 @@ Examples:
 		RunE: auth.RunMe,
 	}
 	rootCmd.AddCommand(whoamiCmd)
+
+	// triage: top-level alias for "issue triage"
+	rootCmd.AddCommand(issue.NewTriageCmd())
+
+	// estimate: top-level alias for "issue estimate"
+	rootCmd.AddCommand(issue.NewEstimateCmd())
+
+	// note: top-level alias for "issue note"
+	rootCmd.AddCommand(issue.NewNoteCmd())
+
+	// xsearch: issue search across profiles (spaces)
+	rootCmd.AddCommand(issue.XSearchCmd)
+
+	// 全サブコマンド共通の --quiet / --verbose（サブコマンド登録後に行う）
+	cmdutil.AddOutputLevelFlags(rootCmd)
 }
@@ -36,11 +36,15 @@ func init() {
 func runSpaceAttachmentUpload(c *cobra.Command, args []string) error {
 	filePath := args[0]
 
-	client, _, err := cmdutil.GetAPIClient(c)
+	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
 	}
 
+	if err := cmdutil.CheckAttachmentPolicy(c.Context(), cmdutil.AttachmentPolicy(cfg), []string{filePath}); err != nil {
+		return err
+	}
+
 	f, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to open %s: %w", filePath, err)
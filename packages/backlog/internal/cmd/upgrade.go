@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/selfupdate"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var (
+	upgradeVersion  string
+	upgradeForce    bool
+	upgradeRollback bool
+)
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Update backlog to the latest release",
+	Long: `Download the latest release from GitHub and replace the running binary.
+
+The archive for the current OS/architecture is verified against checksums.txt
+before installation. The previous binary is kept next to the executable with
+a ".old" suffix and can be restored with --rollback.
+
+Binaries installed with Homebrew should be updated with 'brew upgrade'.
+
+Environment variables:
+  BACKLOG_UPDATE_REPO     GitHub repository to update from (default: yacchi/backlog-cli)
+  BACKLOG_UPDATE_API_URL  GitHub API base URL (for GitHub Enterprise)
+  GITHUB_TOKEN            Token for GitHub API requests (optional)
+
+Examples:
+  backlog upgrade
+  backlog upgrade --version v0.31.0
+  backlog upgrade --rollback`,
+	RunE: runUpgrade,
+}
+
+func init() {
+	upgradeCmd.Flags().StringVar(&upgradeVersion, "version", "", "Install a specific version instead of the latest")
+	upgradeCmd.Flags().BoolVar(&upgradeForce, "force", false, "Reinstall even if already up to date")
+	upgradeCmd.Flags().BoolVar(&upgradeRollback, "rollback", false, "Restore the binary replaced by the previous upgrade")
+	rootCmd.AddCommand(upgradeCmd)
+}
+
+func runUpgrade(cmd *cobra.Command, args []string) error {
+	exePath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate executable: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exePath); err == nil {
+		exePath = resolved
+	}
+
+	if upgradeRollback {
+		if err := selfupdate.Rollback(exePath); err != nil {
+			if errors.Is(err, selfupdate.ErrNoBackup) {
+				return fmt.Errorf("%w (%s)", err, selfupdate.BackupPath(exePath))
+			}
+			return err
+		}
+		cmdutil.Success("", "Rolled back %s", exePath)
+		return nil
+	}
+
+	if selfupdate.IsHomebrewInstall(exePath) && !upgradeForce {
+		return fmt.Errorf("backlog is installed with Homebrew; run 'brew upgrade backlog-cli' instead (or use --force)")
+	}
+
+	updater := selfupdate.New()
+	var rel *selfupdate.Release
+	if upgradeVersion != "" {
+		rel, err = updater.ReleaseByTag(cmd.Context(), upgradeVersion)
+	} else {
+		rel, err = updater.LatestRelease(cmd.Context())
+	}
+	if err != nil {
+		return err
+	}
+
+	if !upgradeForce && upgradeVersion == "" && !selfupdate.IsDevVersion(Version) &&
+		selfupdate.CompareVersions(Version, rel.Version()) >= 0 {
+		cmdutil.Success("", "Already up to date (%s)", Version)
+		return nil
+	}
+
+	if !cmdutil.SkipConfirmation(cmd) {
+		ok, err := ui.Confirm(fmt.Sprintf("Update %s from %s to %s?", exePath, Version, rel.Version()), true)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Downloading %s...\n", updater.ArchiveName(rel.Version()))
+	binary, err := updater.DownloadBinary(cmd.Context(), rel)
+	if err != nil {
+		return err
+	}
+	if err := selfupdate.Apply(exePath, binary); err != nil {
+		return err
+	}
+	cmdutil.Success(rel.Version(), "Updated backlog %s → %s", Version, rel.Version())
+	fmt.Fprintf(os.Stderr, "Previous binary saved to %s (restore with 'backlog upgrade --rollback')\n", selfupdate.BackupPath(exePath))
+	return nil
+}
@@ -0,0 +1,382 @@
+package user
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/activity"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Summarize a user's monthly activity",
+	Long: `Count issues created, issues closed, comments and pull requests of a user
+for each month.
+
+  - issues_created: issues created by the user (from the issue list)
+  - issues_closed:  status changes to the closed status made by the user
+                    (from the change logs of issues closed in the period)
+  - comments:       issue comments posted by the user (from the activity feed)
+  - pull_requests:  pull requests created by the user (from the activity feed)
+
+Backlog trims old entries of the activity feed, so comments and pull requests
+of old months may be undercounted.
+
+--month accepts a month (YYYY-MM) or a range (YYYY-MM..YYYY-MM). The report
+covers all projects unless --project is given explicitly. Use -o csv for
+spreadsheets.
+
+Examples:
+  backlog user report --user someone --month 2024-06
+  backlog user report --month 2024-04..2024-09 -o csv > report.csv
+  backlog user report --user @me -p PROJ -o json`,
+	Args:        cobra.NoArgs,
+	RunE:        runReport,
+	Annotations: cmdutil.AutoSave(),
+}
+
+var (
+	reportUser  string
+	reportMonth string
+)
+
+func init() {
+	reportCmd.Flags().StringVarP(&reportUser, "user", "u", "@me", "Target user (@me, user ID, userId, or display name)")
+	reportCmd.Flags().StringVar(&reportMonth, "month", "", "Month (YYYY-MM) or range (YYYY-MM..YYYY-MM) (default: current month)")
+}
+
+// MonthlyReport はユーザーの1か月分の集計
+type MonthlyReport struct {
+	Month         string `json:"month"`
+	UserID        int    `json:"userId"`
+	UserName      string `json:"userName"`
+	IssuesCreated int    `json:"issuesCreated"`
+	IssuesClosed  int    `json:"issuesClosed"`
+	Comments      int    `json:"comments"`
+	PullRequests  int    `json:"pullRequests"`
+}
+
+// reportMonthRange は集計対象の1か月（表示タイムゾーンでの [start, end)）
+type reportMonthRange struct {
+	label string
+	start time.Time
+	end   time.Time
+}
+
+func runReport(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	ctx := c.Context()
+
+	loc := time.Local
+	if tz := cfg.Display().Timezone; tz != "" {
+		if l, err := time.LoadLocation(tz); err == nil {
+			loc = l
+		}
+	}
+	months, err := parseReportMonths(reportMonth, time.Now().In(loc), loc)
+	if err != nil {
+		return err
+	}
+
+	userID, err := cmdutil.ResolveUserID(ctx, client, reportUser)
+	if err != nil {
+		return err
+	}
+	user, err := client.GetUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// --project を明示したときだけプロジェクトで絞り込む（既定はユーザーの全活動）
+	var projectID int
+	var projectKey string
+	if c.Flags().Changed("project") {
+		projectKey = cmdutil.GetCurrentProject(cfg)
+		if projectKey != "" && projectKey != "all" {
+			project, err := client.GetProject(ctx, projectKey)
+			if err != nil {
+				return fmt.Errorf("failed to get project: %w", err)
+			}
+			projectID = project.ID
+		} else {
+			projectKey = ""
+		}
+	}
+
+	reports := make([]MonthlyReport, len(months))
+	for i, m := range months {
+		reports[i] = MonthlyReport{Month: m.label, UserID: userID, UserName: user.Name.Value}
+	}
+
+	stopProgress := ui.StartProgress(fmt.Sprintf("Collecting activity of %s...", user.Name.Value))
+	err = collectReport(ctx, client, reports, months, userID, projectID, projectKey)
+	stopProgress()
+	if err != nil {
+		return err
+	}
+
+	profile := cfg.CurrentProfile()
+	switch profile.Output {
+	case "json":
+		return cmdutil.OutputJSONFromProfile(reports, profile.JSONFields, profile.JQ, profile.Template)
+	case "csv":
+		return writeReportCSV(os.Stdout, reports)
+	default:
+		table := ui.NewTable("MONTH", "USER", "CREATED", "CLOSED", "COMMENTS", "PULL REQUESTS")
+		for _, r := range reports {
+			table.AddRow(r.Month, r.UserName, strconv.Itoa(r.IssuesCreated), strconv.Itoa(r.IssuesClosed), strconv.Itoa(r.Comments), strconv.Itoa(r.PullRequests))
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+		return nil
+	}
+}
+
+// collectReport は各月の起票数・クローズ数・コメント数・PR 数を集計する
+func collectReport(ctx context.Context, client *api.Client, reports []MonthlyReport, months []reportMonthRange, userID, projectID int, projectKey string) error {
+	start, end := months[0].start, months[len(months)-1].end
+	var projectIDs []int
+	if projectID > 0 {
+		projectIDs = []int{projectID}
+	}
+
+	// 起票数は課題ストアから正確に数える
+	for i, m := range months {
+		count, err := client.GetIssuesCount(ctx, &api.IssueListOptions{
+			ProjectIDs:     projectIDs,
+			CreatedUserIDs: []int{userID},
+			CreatedSince:   m.start.Format("2006-01-02"),
+			CreatedUntil:   m.end.AddDate(0, 0, -1).Format("2006-01-02"),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count created issues: %w", err)
+		}
+		reports[i].IssuesCreated = count
+	}
+
+	// クローズ数は期間内に更新された完了課題の変更履歴から数える
+	statusIDs, err := closedStatusIDs(ctx, client, projectKey)
+	if err != nil {
+		return err
+	}
+	candidates, err := fetchClosedCandidates(ctx, client, projectIDs, statusIDs, start)
+	if err != nil {
+		return err
+	}
+	for _, key := range candidates {
+		closedAt, err := closedByUser(ctx, client, key, userID, start, end)
+		if err != nil {
+			return err
+		}
+		for _, t := range closedAt {
+			if i := monthIndex(months, t); i >= 0 {
+				reports[i].IssuesClosed++
+			}
+		}
+	}
+
+	// コメント数・PR 数は activity フィードから数える
+	commentType, prType := activity.TypeID("issue-comment"), activity.TypeID("pr-add")
+	activities, err := activity.FetchRange(ctx, client, userID, []int{commentType, prType}, start, end.Add(-time.Nanosecond))
+	if err != nil {
+		return fmt.Errorf("failed to get activities: %w", err)
+	}
+	for _, a := range activities {
+		if projectKey != "" && a.Project.Value.ProjectKey.Value != projectKey {
+			continue
+		}
+		created, ok := activity.ParseCreated(a)
+		if !ok {
+			continue
+		}
+		i := monthIndex(months, created)
+		if i < 0 {
+			continue
+		}
+		switch a.Type.Value {
+		case commentType:
+			reports[i].Comments++
+		case prType:
+			reports[i].PullRequests++
+		}
+	}
+	return nil
+}
+
+// closedStatusIDs は完了ステータスの ID を名前で探して返す
+// projectKey が空なら参加している全プロジェクトを対象にする
+func closedStatusIDs(ctx context.Context, client *api.Client, projectKey string) ([]int, error) {
+	var projectKeys []string
+	if projectKey != "" {
+		projectKeys = []string{projectKey}
+	} else {
+		projects, err := client.GetProjects(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get projects: %w", err)
+		}
+		for _, p := range projects {
+			projectKeys = append(projectKeys, p.ProjectKey)
+		}
+	}
+
+	var ids []int
+	seen := map[int]bool{}
+	for _, key := range projectKeys {
+		id, err := cmdutil.ResolveClosedStatusID(ctx, client, key)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// fetchClosedCandidates は since 以降に更新された完了課題のキーを返す
+// 期間内にクローズされた課題はクローズ時に更新されるため、この中に含まれる
+func fetchClosedCandidates(ctx context.Context, client *api.Client, projectIDs, statusIDs []int, since time.Time) ([]string, error) {
+	if len(statusIDs) == 0 {
+		return nil, nil
+	}
+	const batchSize = 100
+	var keys []string
+	for offset := 0; ; offset += batchSize {
+		issues, err := client.GetIssues(ctx, &api.IssueListOptions{
+			ProjectIDs:   projectIDs,
+			StatusIDs:    statusIDs,
+			UpdatedSince: since.Format("2006-01-02"),
+			Count:        batchSize,
+			Offset:       offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get closed issues: %w", err)
+		}
+		for _, is := range issues {
+			keys = append(keys, is.IssueKey.Value)
+		}
+		if len(issues) < batchSize {
+			return keys, nil
+		}
+	}
+}
+
+// closedByUser は課題の変更履歴から、ユーザーが [since, until) にステータスを完了にした日時を返す
+// コメント API は desc（新しい順）で取得し、created が since より前になった時点で打ち切る。
+func closedByUser(ctx context.Context, client *api.Client, issueKey string, userID int, since, until time.Time) ([]time.Time, error) {
+	const batchSize = 100
+	var result []time.Time
+	maxID := 0
+	for {
+		opts := &api.CommentListOptions{Count: batchSize, Order: "desc"}
+		if maxID > 0 {
+			opts.MaxID = maxID
+		}
+		comments, err := client.GetComments(ctx, issueKey, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get comments of %s: %w", issueKey, err)
+		}
+		for _, cm := range comments {
+			created, err := time.Parse(time.RFC3339, cm.Created)
+			if err != nil {
+				continue
+			}
+			if created.Before(since) {
+				return result, nil
+			}
+			if cm.CreatedUser.ID == userID && created.Before(until) && changesToClosed(cm.ChangeLog) {
+				result = append(result, created)
+			}
+		}
+		if len(comments) < batchSize {
+			return result, nil
+		}
+		maxID = comments[len(comments)-1].ID - 1
+		if maxID <= 0 {
+			return result, nil
+		}
+	}
+}
+
+// changesToClosed は変更履歴にステータスを完了にする変更が含まれるかを返す
+func changesToClosed(changes []api.ChangeLog) bool {
+	for _, ch := range changes {
+		if ch.Field == "status" && cmdutil.IsClosedStatusName(ch.NewValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseReportMonths は --month の値（YYYY-MM または YYYY-MM..YYYY-MM）を月ごとの範囲に変換する
+func parseReportMonths(value string, now time.Time, loc *time.Location) ([]reportMonthRange, error) {
+	from, to, isRange := strings.Cut(value, "..")
+	if value == "" {
+		from = now.Format("2006-01")
+		to = from
+	} else if !isRange {
+		to = from
+	}
+	start, err := time.ParseInLocation("2006-01", strings.TrimSpace(from), loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --month %q (expected YYYY-MM or YYYY-MM..YYYY-MM)", value)
+	}
+	last, err := time.ParseInLocation("2006-01", strings.TrimSpace(to), loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --month %q (expected YYYY-MM or YYYY-MM..YYYY-MM)", value)
+	}
+	if last.Before(start) {
+		return nil, fmt.Errorf("invalid --month %q: the end month is before the start month", value)
+	}
+	var months []reportMonthRange
+	for m := start; !m.After(last); m = m.AddDate(0, 1, 0) {
+		months = append(months, reportMonthRange{label: m.Format("2006-01"), start: m, end: m.AddDate(0, 1, 0)})
+	}
+	return months, nil
+}
+
+// monthIndex は t を含む月のインデックスを返す（範囲外は -1）
+func monthIndex(months []reportMonthRange, t time.Time) int {
+	for i, m := range months {
+		if !t.Before(m.start) && t.Before(m.end) {
+			return i
+		}
+	}
+	return -1
+}
+
+// writeReportCSV は集計結果を CSV で書き出す
+func writeReportCSV(out io.Writer, reports []MonthlyReport) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"month", "user_id", "user_name", "issues_created", "issues_closed", "comments", "pull_requests"}); err != nil {
+		return err
+	}
+	for _, r := range reports {
+		if err := w.Write([]string{
+			r.Month,
+			strconv.Itoa(r.UserID),
+			r.UserName,
+			strconv.Itoa(r.IssuesCreated),
+			strconv.Itoa(r.IssuesClosed),
+			strconv.Itoa(r.Comments),
+			strconv.Itoa(r.PullRequests),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestParseReportMonths(t *testing.T) {
+	loc := time.FixedZone("JST", 9*60*60)
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, loc)
+
+	months, err := parseReportMonths("", now, loc)
+	if err != nil || len(months) != 1 || months[0].label != "2024-06" {
+		t.Fatalf("parseReportMonths(\"\") = %+v, %v", months, err)
+	}
+
+	months, err = parseReportMonths("2023-11..2024-02", now, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var labels []string
+	for _, m := range months {
+		labels = append(labels, m.label)
+	}
+	if len(labels) != 4 || labels[0] != "2023-11" || labels[3] != "2024-02" {
+		t.Errorf("labels = %v", labels)
+	}
+	if !months[3].end.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, loc)) {
+		t.Errorf("end of range = %v", months[3].end)
+	}
+
+	for _, value := range []string{"2024/06", "2024-06..2024-01", "2024-13"} {
+		if _, err := parseReportMonths(value, now, loc); err == nil {
+			t.Errorf("parseReportMonths(%q) expected error", value)
+		}
+	}
+}
+
+func TestMonthIndex(t *testing.T) {
+	months, _ := parseReportMonths("2024-05..2024-06", time.Now(), time.UTC)
+	tests := []struct {
+		t    time.Time
+		want int
+	}{
+		{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2024, 6, 30, 23, 59, 59, 0, time.UTC), 1},
+		{time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), -1},
+		{time.Date(2024, 4, 30, 23, 59, 59, 0, time.UTC), -1},
+	}
+	for _, tt := range tests {
+		if got := monthIndex(months, tt.t); got != tt.want {
+			t.Errorf("monthIndex(%v) = %d, want %d", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestChangesToClosed(t *testing.T) {
+	if !changesToClosed([]api.ChangeLog{{Field: "assigner"}, {Field: "status", NewValue: "完了"}}) {
+		t.Error("status change to 完了 should count as closed")
+	}
+	if !changesToClosed([]api.ChangeLog{{Field: "status", NewValue: "Closed"}}) {
+		t.Error("status change to Closed should count as closed")
+	}
+	if changesToClosed([]api.ChangeLog{{Field: "status", NewValue: "処理中"}}) {
+		t.Error("status change to 処理中 should not count as closed")
+	}
+}
+
+func TestWriteReportCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeReportCSV(&buf, []MonthlyReport{
+		{Month: "2024-06", UserID: 1, UserName: "Yamada, Taro", IssuesCreated: 3, IssuesClosed: 2, Comments: 10, PullRequests: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "month,user_id,user_name,issues_created,issues_closed,comments,pull_requests\n" +
+		"2024-06,1,\"Yamada, Taro\",3,2,10,1\n"
+	if buf.String() != want {
+		t.Errorf("writeReportCSV() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
@@ -14,4 +14,5 @@ var UserCmd = &cobra.Command{
 func init() {
 	UserCmd.AddCommand(listCmd)
 	UserCmd.AddCommand(viewCmd)
+	UserCmd.AddCommand(reportCmd)
 }
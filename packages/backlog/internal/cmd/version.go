@@ -4,18 +4,53 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/selfupdate"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
+var versionCheck bool
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print the version information",
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: `Print the version information.
+
+With --check, compares the running version with the latest GitHub Release
+and reports whether an update is available.
+
+Examples:
+  backlog version
+  backlog version --check`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		fmt.Printf("backlog version %s\n", Version)
 		fmt.Printf("  commit: %s\n", Commit)
 		fmt.Printf("  built:  %s\n", BuildDate)
+		if !versionCheck {
+			return nil
+		}
+
+		rel, err := selfupdate.New().LatestRelease(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to check for updates: %w", err)
+		}
+		fmt.Println()
+		switch {
+		case selfupdate.IsDevVersion(Version):
+			fmt.Printf("Latest release: %s (development build, not compared)\n", rel.Version())
+		case selfupdate.CompareVersions(Version, rel.Version()) < 0:
+			fmt.Printf("%s A new version is available: %s → %s\n", ui.Yellow("!"), Version, rel.Version())
+			if rel.HTMLURL != "" {
+				fmt.Printf("  %s\n", rel.HTMLURL)
+			}
+			fmt.Println("  Run 'backlog upgrade' to update.")
+		default:
+			fmt.Printf("%s You are using the latest version (%s)\n", ui.OKMark(), Version)
+		}
+		return nil
 	},
 }
 
 func init() {
+	versionCmd.Flags().BoolVar(&versionCheck, "check", false, "Check GitHub Releases for a newer version")
 	rootCmd.AddCommand(versionCmd)
 }
@@ -0,0 +1,384 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmd/activity"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var execCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Run a command for each new project activity",
+	Long: `Poll the activities of the current project and run a command for each new
+activity that matches --types.
+
+The --exec value is a Go template rendered for each activity and run with the
+shell. Template fields are shell-quoted automatically, so they can be used as
+arguments as-is:
+
+  .ID .Type .TypeName .ProjectKey .IssueKey .Summary .Description
+  .WikiID .WikiName .User .Created .URL
+
+On Windows the command runs with cmd.exe, where values containing % ^ & | < >
+" or ! cannot be quoted safely. Such an activity is skipped with a warning if
+the template uses the value; read it from the environment variables instead.
+
+The same values are also passed as environment variables (BACKLOG_ACTIVITY_ID,
+BACKLOG_ACTIVITY_TYPE, BACKLOG_ISSUE_KEY, BACKLOG_WIKI_NAME, ...), and the raw
+activity is available as BACKLOG_ACTIVITY_JSON.
+
+The ID of the last processed activity is saved in a state file, so restarting
+the command continues from where it stopped. On the first run, only activities
+after the start are processed.
+
+Activity types (--types) accept the names of 'backlog activity list --type';
+underscores and past tense (e.g. issue_updated) are also accepted.
+
+Examples:
+  backlog watch exec --project PROJ --types issue_updated --exec './sync.sh {{.IssueKey}}'
+
+  # Poll once (e.g. from cron) and print the commands without running them
+  backlog watch exec --types wiki-update --exec 'echo {{.WikiName}}' --once --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runExec,
+}
+
+var (
+	execTypes     string
+	execCommand   string
+	execInterval  time.Duration
+	execOnce      bool
+	execDryRun    bool
+	execStatePath string
+)
+
+func init() {
+	execCmd.Flags().StringVar(&execTypes, "types", "issue-create,issue-update,issue-comment", "Activity types to react to (comma-separated)")
+	execCmd.Flags().StringVar(&execCommand, "exec", "", "Command template to run for each activity (required)")
+	execCmd.Flags().DurationVar(&execInterval, "interval", time.Minute, "Polling interval")
+	execCmd.Flags().BoolVar(&execOnce, "once", false, "Poll once and exit")
+	execCmd.Flags().BoolVar(&execDryRun, "dry-run", false, "Print commands instead of running them")
+	execCmd.Flags().StringVar(&execStatePath, "state", "", "State file that records the last processed activity (default: cache directory)")
+	_ = execCmd.MarkFlagRequired("exec")
+}
+
+// activityEvent はテンプレートと環境変数に渡すアクティビティの情報
+type activityEvent struct {
+	ID          int
+	Type        int
+	TypeName    string
+	ProjectKey  string
+	IssueKey    string
+	Summary     string
+	Description string
+	WikiID      int
+	WikiName    string
+	User        string
+	Created     string
+	URL         string
+}
+
+// watchState は処理済みアクティビティの位置
+type watchState struct {
+	LastID    int       `json:"last_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func runExec(c *cobra.Command, args []string) error {
+	if execInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	typeIDs, err := activity.ParseTypes(execTypes)
+	if err != nil {
+		return err
+	}
+	tmpl, err := parseExecTemplate(execCommand)
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	profile := cfg.CurrentProfile()
+
+	statePath := execStatePath
+	if statePath == "" {
+		cacheDir, err := cfg.GetCacheDir()
+		if err != nil {
+			return fmt.Errorf("failed to resolve cache dir: %w", err)
+		}
+		statePath = filepath.Join(cacheDir, "watch", stateFileName(profile.Space, projectKey, typeIDs))
+	}
+
+	ctx, stop := signal.NotifyContext(c.Context(), os.Interrupt)
+	defer stop()
+
+	state, err := loadWatchState(statePath)
+	if err != nil {
+		return err
+	}
+	if state.LastID == 0 {
+		// 初回は過去のアクティビティに反応しないよう、最新位置から監視を始める
+		latest, err := client.GetProjectActivities(ctx, projectKey, &api.ProjectActivityListOptions{Count: 1, Order: "desc"})
+		if err != nil {
+			return fmt.Errorf("failed to get activities: %w", err)
+		}
+		if len(latest) > 0 {
+			state.LastID = latest[0].ID.Value
+		}
+		if err := saveWatchState(statePath, state); err != nil {
+			return err
+		}
+	}
+
+	baseURL := fmt.Sprintf("https://%s", profile.Space)
+	fmt.Fprintf(os.Stderr, "Watching %s activities after #%d (%s)\n", projectKey, state.LastID, strings.Join(activityTypeNames(typeIDs), ","))
+
+	for {
+		err := pollOnce(ctx, client, projectKey, typeIDs, state, func(a backlog.Activity) error {
+			ev := newActivityEvent(a, baseURL)
+			command, err := renderExecCommand(tmpl, ev)
+			var unsafeErr *cmdutil.UnsafeShellValueError
+			if errors.As(err, &unsafeErr) {
+				// 他のユーザーが書いた値で実行できないだけなので、監視は止めずにこのアクティビティを飛ばす
+				ui.Warning("skip activity #%d: %v", ev.ID, err)
+			} else if err != nil {
+				return err
+			} else if execDryRun {
+				fmt.Println(command)
+			} else {
+				fmt.Fprintf(os.Stderr, "%s #%d %s %s\n", ui.Cyan("▶"), ev.ID, ev.TypeName, eventTarget(ev))
+				if err := cmdutil.RunShell(ctx, command, eventEnv(ev, a), os.Stdout); err != nil {
+					// コマンドの失敗で監視は止めない
+					ui.Warning("command for activity #%d failed: %v", ev.ID, err)
+				}
+			}
+			state.LastID = ev.ID
+			return saveWatchState(statePath, state)
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if execOnce {
+				return err
+			}
+			ui.Warning("%v", err)
+		}
+		if execOnce {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(execInterval):
+		}
+	}
+}
+
+// pollOnce は state.LastID より新しいアクティビティを古い順に handle へ渡す
+func pollOnce(ctx context.Context, client *api.Client, projectKey string, typeIDs []int, state *watchState, handle func(backlog.Activity) error) error {
+	const batchSize = 100
+	for {
+		batch, err := client.GetProjectActivities(ctx, projectKey, &api.ProjectActivityListOptions{
+			ActivityTypeIDs: typeIDs,
+			MinID:           state.LastID,
+			Count:           batchSize,
+			Order:           "asc",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get activities: %w", err)
+		}
+		for _, a := range newActivities(batch, state.LastID) {
+			if err := handle(a); err != nil {
+				return err
+			}
+		}
+		if len(batch) < batchSize {
+			return nil
+		}
+	}
+}
+
+// newActivities は lastID より新しいアクティビティを ID の昇順で返す
+func newActivities(batch []backlog.Activity, lastID int) []backlog.Activity {
+	result := make([]backlog.Activity, 0, len(batch))
+	for _, a := range batch {
+		if a.ID.Value > lastID {
+			result = append(result, a)
+		}
+	}
+	// order=asc を指定していても念のため並べ替える
+	sort.SliceStable(result, func(i, j int) bool { return result[i].ID.Value < result[j].ID.Value })
+	return result
+}
+
+func newActivityEvent(a backlog.Activity, baseURL string) activityEvent {
+	ev := activityEvent{
+		ID:       a.ID.Value,
+		Type:     a.Type.Value,
+		TypeName: activity.TypeName(a.Type.Value),
+		Created:  a.Created.Value,
+	}
+	if p, ok := a.Project.Get(); ok {
+		ev.ProjectKey = p.ProjectKey.Value
+	}
+	if u, ok := a.CreatedUser.Get(); ok {
+		ev.User = u.Name.Value
+	}
+	content, ok := a.Content.Get()
+	if !ok {
+		return ev
+	}
+	switch {
+	case strings.HasPrefix(ev.TypeName, "wiki-"):
+		ev.WikiID = content.ID.Value
+		ev.WikiName = content.Name.Value
+		if ev.WikiID > 0 {
+			ev.URL = fmt.Sprintf("%s/alias/wiki/%d", baseURL, ev.WikiID)
+		}
+	default:
+		if keyID, ok := content.KeyID.Get(); ok && ev.ProjectKey != "" {
+			ev.IssueKey = fmt.Sprintf("%s-%d", ev.ProjectKey, keyID)
+			ev.URL = fmt.Sprintf("%s/view/%s", baseURL, ev.IssueKey)
+		}
+		ev.Summary = content.Summary.Value
+		ev.Description = content.Description.Value
+	}
+	return ev
+}
+
+func eventTarget(ev activityEvent) string {
+	switch {
+	case ev.IssueKey != "":
+		return ev.IssueKey
+	case ev.WikiName != "":
+		return ev.WikiName
+	default:
+		return ""
+	}
+}
+
+func parseExecTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("exec").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --exec template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderExecCommand はテンプレートを展開する
+// 文字列フィールドはシェルでそのまま引数として使えるようクォートする
+func renderExecCommand(tmpl *template.Template, ev activityEvent) (string, error) {
+	data, unsafe := cmdutil.ShellTemplateData(map[string]string{
+		"TypeName":    ev.TypeName,
+		"ProjectKey":  ev.ProjectKey,
+		"IssueKey":    ev.IssueKey,
+		"Summary":     ev.Summary,
+		"Description": ev.Description,
+		"WikiName":    ev.WikiName,
+		"User":        ev.User,
+		"Created":     ev.Created,
+		"URL":         ev.URL,
+	})
+	data["ID"] = ev.ID
+	data["Type"] = ev.Type
+	data["WikiID"] = ev.WikiID
+	command, err := cmdutil.ExecuteShellTemplate(tmpl, data, unsafe)
+	if err != nil {
+		return "", fmt.Errorf("render --exec template: %w", err)
+	}
+	return command, nil
+}
+
+func eventEnv(ev activityEvent, a backlog.Activity) []string {
+	raw, _ := json.Marshal(&a)
+	return []string{
+		"BACKLOG_ACTIVITY_ID=" + strconv.Itoa(ev.ID),
+		"BACKLOG_ACTIVITY_TYPE=" + ev.TypeName,
+		"BACKLOG_ACTIVITY_USER=" + ev.User,
+		"BACKLOG_ACTIVITY_CREATED=" + ev.Created,
+		"BACKLOG_ACTIVITY_URL=" + ev.URL,
+		"BACKLOG_ACTIVITY_JSON=" + string(raw),
+		"BACKLOG_PROJECT=" + ev.ProjectKey,
+		"BACKLOG_ISSUE_KEY=" + ev.IssueKey,
+		"BACKLOG_ISSUE_SUMMARY=" + ev.Summary,
+		"BACKLOG_WIKI_ID=" + strconv.Itoa(ev.WikiID),
+		"BACKLOG_WIKI_NAME=" + ev.WikiName,
+	}
+}
+
+func activityTypeNames(ids []int) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, activity.TypeName(id))
+	}
+	return names
+}
+
+// stateFileName は監視条件ごとの状態ファイル名を返す
+func stateFileName(space, projectKey string, typeIDs []int) string {
+	parts := make([]string, 0, len(typeIDs))
+	for _, id := range typeIDs {
+		parts = append(parts, strconv.Itoa(id))
+	}
+	name := fmt.Sprintf("%s_%s_%s.json", space, projectKey, strings.Join(parts, "-"))
+	return strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(name)
+}
+
+func loadWatchState(path string) (*watchState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &watchState{}, nil
+		}
+		return nil, fmt.Errorf("read state file: %w", err)
+	}
+	var s watchState
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("decode state file %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+func saveWatchState(path string, s *watchState) error {
+	s.UpdatedAt = time.Now()
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create state dir: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write state file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write state file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package watch
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func testActivity(id, typ, keyID int, summary string) backlog.Activity {
+	return backlog.Activity{
+		ID:      backlog.NewOptInt(id),
+		Type:    backlog.NewOptInt(typ),
+		Project: backlog.NewOptNilProject(backlog.Project{ProjectKey: backlog.NewOptString("PROJ")}),
+		Content: backlog.NewOptNilActivityContent(backlog.ActivityContent{
+			ID:      backlog.NewOptNilInt(100 + id),
+			KeyID:   backlog.NewOptNilInt(keyID),
+			Summary: backlog.NewOptNilString(summary),
+		}),
+	}
+}
+
+func TestNewActivities(t *testing.T) {
+	batch := []backlog.Activity{testActivity(12, 2, 1, ""), testActivity(10, 2, 1, ""), testActivity(11, 2, 1, "")}
+	got := newActivities(batch, 10)
+	if len(got) != 2 || got[0].ID.Value != 11 || got[1].ID.Value != 12 {
+		t.Fatalf("newActivities() = %v, want ids [11 12]", got)
+	}
+}
+
+func TestNewActivityEvent(t *testing.T) {
+	ev := newActivityEvent(testActivity(5, 2, 42, "Fix login"), "https://example.backlog.jp")
+	if ev.IssueKey != "PROJ-42" || ev.TypeName != "issue-update" || ev.URL != "https://example.backlog.jp/view/PROJ-42" {
+		t.Errorf("unexpected issue event: %+v", ev)
+	}
+
+	wiki := backlog.Activity{
+		ID:      backlog.NewOptInt(6),
+		Type:    backlog.NewOptInt(6),
+		Project: backlog.NewOptNilProject(backlog.Project{ProjectKey: backlog.NewOptString("PROJ")}),
+		Content: backlog.NewOptNilActivityContent(backlog.ActivityContent{
+			ID:   backlog.NewOptNilInt(30),
+			Name: backlog.NewOptNilString("Home"),
+		}),
+	}
+	ev = newActivityEvent(wiki, "https://example.backlog.jp")
+	if ev.WikiID != 30 || ev.WikiName != "Home" || ev.IssueKey != "" || ev.URL != "https://example.backlog.jp/alias/wiki/30" {
+		t.Errorf("unexpected wiki event: %+v", ev)
+	}
+}
+
+func TestRenderExecCommand(t *testing.T) {
+	tmpl, err := parseExecTemplate("./sync.sh {{.IssueKey}} {{.Summary}} {{.ID}}")
+	if err != nil {
+		t.Fatalf("parseExecTemplate() error: %v", err)
+	}
+	got, err := renderExecCommand(tmpl, activityEvent{ID: 7, IssueKey: "PROJ-1", Summary: "it's $(rm -rf ~)"})
+	if err != nil {
+		t.Fatalf("renderExecCommand() error: %v", err)
+	}
+	want := `./sync.sh PROJ-1 'it'\''s $(rm -rf ~)' 7`
+	if got != want {
+		t.Errorf("renderExecCommand() = %q, want %q", got, want)
+	}
+
+	if _, err := parseExecTemplate("{{.IssueKey"); err == nil {
+		t.Error("expected parse error")
+	}
+	tmpl, _ = parseExecTemplate("{{.Unknown}}")
+	if _, err := renderExecCommand(tmpl, activityEvent{}); err == nil {
+		t.Error("expected error for unknown field")
+	}
+}
+
+func TestWatchStateRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watch", "state.json")
+	s, err := loadWatchState(path)
+	if err != nil || s.LastID != 0 {
+		t.Fatalf("loadWatchState() on missing file = (%+v, %v)", s, err)
+	}
+	s.LastID = 99
+	if err := saveWatchState(path, s); err != nil {
+		t.Fatalf("saveWatchState() error: %v", err)
+	}
+	loaded, err := loadWatchState(path)
+	if err != nil || loaded.LastID != 99 {
+		t.Fatalf("loadWatchState() = (%+v, %v), want LastID 99", loaded, err)
+	}
+}
+
+func TestStateFileName(t *testing.T) {
+	if got := stateFileName("example.backlog.jp", "PROJ", []int{2, 3}); got != "example.backlog.jp_PROJ_2-3.json" {
+		t.Errorf("stateFileName() = %q", got)
+	}
+}
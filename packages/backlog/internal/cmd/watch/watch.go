@@ -0,0 +1,19 @@
+package watch
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// WatchCmd はアクティビティを監視するコマンドのルート
+var WatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch project activities",
+	Long: `Watch project activities by polling and react to them.
+
+This is a polling alternative for environments where Backlog webhooks cannot
+reach the receiver (e.g. behind a firewall or on a developer machine).`,
+}
+
+func init() {
+	WatchCmd.AddCommand(execCmd)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
-	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 var addCmd = &cobra.Command{
@@ -19,8 +18,9 @@ var addCmd = &cobra.Command{
 Examples:
   backlog watching add PROJ-123
   backlog watching add PROJ-123 --note "Track progress"`,
-	Args: cobra.ExactArgs(1),
-	RunE: runAdd,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runAdd,
 }
 
 var addNote string
@@ -59,8 +59,7 @@ func runAdd(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(watching)
 	default:
-		fmt.Printf("%s Added to watchings: %s\n",
-			ui.Green("✓"), watching.Issue.IssueKey)
+		cmdutil.Success(watching.Issue.IssueKey, "Added to watchings: %s", watching.Issue.IssueKey)
 		return nil
 	}
 }
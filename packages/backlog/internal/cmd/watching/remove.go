@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
-	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 var removeCmd = &cobra.Command{
@@ -19,8 +18,9 @@ var removeCmd = &cobra.Command{
 
 Examples:
   backlog watching remove PROJ-123`,
-	Args: cobra.ExactArgs(1),
-	RunE: runRemove,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: cmdutil.CompleteIssueKeys,
+	RunE:              runRemove,
 }
 
 func runRemove(c *cobra.Command, args []string) error {
@@ -75,8 +75,7 @@ func runRemove(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(deleted)
 	default:
-		fmt.Printf("%s Removed from watchings: %s\n",
-			ui.Green("✓"), deleted.Issue.IssueKey)
+		cmdutil.Success(deleted.Issue.IssueKey, "Removed from watchings: %s", deleted.Issue.IssueKey)
 		return nil
 	}
 }
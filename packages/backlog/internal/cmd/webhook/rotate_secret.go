@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var rotateSecretCmd = &cobra.Command{
+	Use:   "rotate-secret",
+	Short: "Regenerate the secrets of the project's webhooks",
+	Long: `Regenerate the secret embedded in the hook URL of every webhook of the
+project (or those given with --webhook) and update Backlog.
+
+The previous secret stays recorded until the grace period (--grace) ends so
+that receivers can accept both secrets while they are being updated. Use
+"backlog webhook secrets" to see which previous secrets can be retired.
+
+For a zero-downtime rotation, run with --prepare first: new secrets are
+generated and listed without changing Backlog, so receivers can start
+accepting them. Then run again without --prepare to switch Backlog to the
+prepared secrets.
+
+Webhooks whose hook URL has no secret parameter (--param) are skipped.
+The table masks secrets; use --show-secrets or --output json for the list
+to hand over to receivers.
+
+Examples:
+  backlog webhook rotate-secret --prepare -o json > new-secrets.json
+  backlog webhook rotate-secret --grace 48h
+  backlog webhook rotate-secret --webhook 12,deploy-hook --dry-run
+  backlog webhook rotate-secret --param token`,
+	Args: cobra.NoArgs,
+	RunE: runRotateSecret,
+}
+
+var (
+	rotatePrepare     bool
+	rotateGrace       time.Duration
+	rotateWebhooks    []string
+	rotateDryRun      bool
+	rotateShowSecrets bool
+)
+
+func init() {
+	rotateSecretCmd.Flags().BoolVar(&rotatePrepare, "prepare", false, "Generate and list new secrets without updating Backlog")
+	rotateSecretCmd.Flags().DurationVar(&rotateGrace, "grace", 72*time.Hour, "How long receivers should keep accepting the previous secret")
+	rotateSecretCmd.Flags().StringSliceVar(&rotateWebhooks, "webhook", nil, "Webhook IDs or names to rotate (comma-separated, default: all)")
+	rotateSecretCmd.Flags().BoolVar(&rotateDryRun, "dry-run", false, "Show the webhooks that would be rotated without changing anything")
+	rotateSecretCmd.Flags().BoolVar(&rotateShowSecrets, "show-secrets", false, "Show secrets in the table instead of masking them")
+}
+
+// Rotation はシークレットのローテーション結果（受信側の更新に使う一覧の1行）
+type Rotation struct {
+	WebhookID int    `json:"webhookId"`
+	Name      string `json:"name"`
+	HookURL   string `json:"hookUrl"`
+	Param     string `json:"param"`
+	// Status は rotated / prepared / dry-run / skipped / failed のいずれか
+	Status             string     `json:"status"`
+	Secret             string     `json:"secret,omitempty"`
+	PreviousSecret     string     `json:"previousSecret,omitempty"`
+	PreviousValidUntil *time.Time `json:"previousValidUntil,omitempty"`
+	Error              string     `json:"error,omitempty"`
+}
+
+func runRotateSecret(c *cobra.Command, args []string) error {
+	if rotatePrepare && rotateDryRun {
+		return fmt.Errorf("cannot use --prepare and --dry-run together")
+	}
+	if rotateGrace < 0 {
+		return fmt.Errorf("--grace must not be negative")
+	}
+
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	profile := cfg.CurrentProfile()
+	ctx := c.Context()
+
+	hooks, err := client.GetWebhooks(ctx, projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	targets, err := selectWebhooks(hooks, rotateWebhooks)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		fmt.Println("No webhooks found")
+		return nil
+	}
+
+	store, err := openSecretStore()
+	if err != nil {
+		return err
+	}
+	states, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	rotations := make([]Rotation, 0, len(targets))
+	failed := 0
+	for _, h := range targets {
+		r := Rotation{
+			WebhookID: h.ID.Value,
+			Name:      h.Name.Value,
+			HookURL:   maskHookURL(h.HookUrl.Value, webhookSecretParam),
+			Param:     webhookSecretParam,
+		}
+		current, ok := hookSecret(h.HookUrl.Value, webhookSecretParam)
+		if !ok {
+			r.Status = "skipped"
+			r.Error = fmt.Sprintf("hook URL has no %q parameter", webhookSecretParam)
+			rotations = append(rotations, r)
+			continue
+		}
+		r.PreviousSecret = current
+
+		var st *secretState
+		states, st = findSecretState(states, profile.Space, projectKey, h.ID.Value)
+		st.Name = h.Name.Value
+		st.Param = webhookSecretParam
+
+		secret := st.Pending
+		if secret == "" {
+			if secret, err = generateSecret(); err != nil {
+				return err
+			}
+		}
+		r.Secret = secret
+
+		switch {
+		case rotateDryRun:
+			r.Status = "dry-run"
+		case rotatePrepare:
+			st.Pending = secret
+			r.Status = "prepared"
+		default:
+			newURL, err := withHookSecret(h.HookUrl.Value, webhookSecretParam, secret)
+			if err != nil {
+				return err
+			}
+			// 失敗した場合も次回同じシークレットで再試行できるよう Pending に残す
+			st.Pending = secret
+			if _, err := client.UpdateWebhook(ctx, projectKey, h.ID.Value, &api.UpdateWebhookInput{HookURL: &newURL}); err != nil {
+				failed++
+				r.Status = "failed"
+				r.Error = err.Error()
+				rotations = append(rotations, r)
+				continue
+			}
+			until := now.Add(rotateGrace)
+			rotatedAt := now
+			st.Previous = current
+			st.PreviousValidUntil = &until
+			st.Current = secret
+			st.Pending = ""
+			st.RotatedAt = &rotatedAt
+			r.Status = "rotated"
+			r.PreviousValidUntil = &until
+		}
+		rotations = append(rotations, r)
+	}
+
+	if !rotateDryRun {
+		if err := store.Save(states); err != nil {
+			return err
+		}
+	}
+
+	if profile.Output == "json" {
+		if err := cmdutil.OutputJSONFromProfile(rotations, profile.JSONFields, profile.JQ, profile.Template); err != nil {
+			return err
+		}
+	} else if !cmdutil.IsQuiet() {
+		renderRotations(rotations, rotateShowSecrets)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to rotate %d of %d webhook secret(s); run the same command again to retry", failed, len(targets))
+	}
+	if profile.Output != "json" {
+		switch {
+		case rotatePrepare:
+			cmdutil.Success("", "Prepared new secrets. Update the receivers, then run again without --prepare")
+		case !rotateDryRun:
+			cmdutil.Success("", "Rotated webhook secrets. Receivers should accept the previous secrets until %s", now.Add(rotateGrace).Local().Format("2006-01-02 15:04"))
+		}
+	}
+	return nil
+}
+
+// selectWebhooks は --webhook で指定された Webhook（ID または名前）を選ぶ
+func selectWebhooks(hooks []backlog.Webhook, selectors []string) ([]backlog.Webhook, error) {
+	if len(selectors) == 0 {
+		return hooks, nil
+	}
+	var selected []backlog.Webhook
+	for _, sel := range selectors {
+		sel = strings.TrimSpace(sel)
+		idx := slices.IndexFunc(hooks, func(h backlog.Webhook) bool {
+			return strconv.Itoa(h.ID.Value) == sel || strings.EqualFold(h.Name.Value, sel)
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("webhook not found: %s", sel)
+		}
+		if !slices.ContainsFunc(selected, func(h backlog.Webhook) bool { return h.ID.Value == hooks[idx].ID.Value }) {
+			selected = append(selected, hooks[idx])
+		}
+	}
+	return selected, nil
+}
+
+func renderRotations(rotations []Rotation, showSecrets bool) {
+	secret := maskSecret
+	if showSecrets {
+		secret = func(s string) string { return s }
+	}
+	table := ui.NewTable("ID", "NAME", "STATUS", "NEW SECRET", "PREVIOUS SECRET", "PREVIOUS VALID UNTIL")
+	for _, r := range rotations {
+		status := r.Status
+		if r.Error != "" {
+			status += ": " + r.Error
+		}
+		until := "-"
+		if r.PreviousValidUntil != nil {
+			until = r.PreviousValidUntil.Local().Format("2006-01-02 15:04")
+		}
+		newSecret, previous := "-", "-"
+		if r.Secret != "" {
+			newSecret = secret(r.Secret)
+		}
+		if r.PreviousSecret != "" {
+			previous = secret(r.PreviousSecret)
+		}
+		table.AddRow(strconv.Itoa(r.WebhookID), r.Name, status, newSecret, previous, until)
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+}
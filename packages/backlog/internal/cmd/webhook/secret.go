@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// secretState は Webhook 1件分のシークレットのローテーション状態
+// 受信側が新旧両方のシークレットを受け付ける併用期間を管理するために記録する
+type secretState struct {
+	Space      string `json:"space"`
+	ProjectKey string `json:"projectKey"`
+	WebhookID  int    `json:"webhookId"`
+	Name       string `json:"name"`
+	Param      string `json:"param"`
+	// Current は Backlog に設定済みのシークレット
+	Current string `json:"current,omitempty"`
+	// Previous は直前のシークレット。PreviousValidUntil までは受信側で受け付ける
+	Previous           string     `json:"previous,omitempty"`
+	PreviousValidUntil *time.Time `json:"previousValidUntil,omitempty"`
+	// Pending は --prepare で発行し、まだ Backlog に設定していないシークレット
+	Pending   string     `json:"pending,omitempty"`
+	RotatedAt *time.Time `json:"rotatedAt,omitempty"`
+}
+
+// previousExpired は旧シークレットの併用期間が終わっているかどうかを返す
+func (s *secretState) previousExpired(now time.Time) bool {
+	return s.Previous != "" && s.PreviousValidUntil != nil && !now.Before(*s.PreviousValidUntil)
+}
+
+// secretStore は Webhook シークレットの状態ファイル
+type secretStore struct {
+	path string
+}
+
+type secretFileContent struct {
+	Webhooks []secretState `json:"webhooks"`
+}
+
+// Load は記録済みの状態を返す
+func (s *secretStore) Load() ([]secretState, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read webhook secrets: %w", err)
+	}
+	var content secretFileContent
+	if err := json.Unmarshal(data, &content); err != nil {
+		// 上書きすると併用期間中の旧シークレットが失われるため、エラーにして手当てを促す
+		return nil, fmt.Errorf("parse webhook secrets %s: %w", s.path, err)
+	}
+	return content.Webhooks, nil
+}
+
+// Save は状態を書き込む
+func (s *secretStore) Save(states []secretState) error {
+	data, err := json.MarshalIndent(secretFileContent{Webhooks: states}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode webhook secrets: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create webhook secrets directory: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write webhook secrets: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("write webhook secrets: %w", err)
+	}
+	return nil
+}
+
+// findSecretState は Webhook の状態を返す（なければ追加する）
+func findSecretState(states []secretState, space, projectKey string, webhookID int) ([]secretState, *secretState) {
+	for i := range states {
+		if states[i].Space == space && states[i].ProjectKey == projectKey && states[i].WebhookID == webhookID {
+			return states, &states[i]
+		}
+	}
+	states = append(states, secretState{Space: space, ProjectKey: projectKey, WebhookID: webhookID})
+	return states, &states[len(states)-1]
+}
+
+// generateSecret は URL にそのまま埋め込めるランダムなシークレットを生成する
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hookSecret は Hook URL のクエリパラメータ param からシークレットを取り出す
+func hookSecret(hookURL, param string) (string, bool) {
+	u, err := url.Parse(hookURL)
+	if err != nil {
+		return "", false
+	}
+	values, ok := u.Query()[param]
+	if !ok || len(values) == 0 || values[0] == "" {
+		return "", false
+	}
+	return values[0], true
+}
+
+// withHookSecret は Hook URL のクエリパラメータ param を secret に置き換える
+func withHookSecret(hookURL, param, secret string) (string, error) {
+	u, err := url.Parse(hookURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid hook URL %q: %w", hookURL, err)
+	}
+	q := u.Query()
+	q.Set(param, secret)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// maskHookURL はシークレットを伏せた Hook URL を返す
+func maskHookURL(hookURL, param string) string {
+	if _, ok := hookSecret(hookURL, param); !ok {
+		return hookURL
+	}
+	masked, err := withHookSecret(hookURL, param, "xxxxx")
+	if err != nil {
+		return hookURL
+	}
+	return masked
+}
+
+// maskSecret は表示用にシークレットの先頭だけを残す
+func maskSecret(secret string) string {
+	if secret == "" {
+		return "-"
+	}
+	if len(secret) <= 6 {
+		return "******"
+	}
+	return secret[:4] + "******"
+}
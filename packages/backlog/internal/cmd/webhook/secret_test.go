@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func TestHookSecret(t *testing.T) {
+	tests := []struct {
+		url    string
+		want   string
+		wantOK bool
+	}{
+		{url: "https://example.com/hook?secret=abc&x=1", want: "abc", wantOK: true},
+		{url: "https://example.com/hook?x=1", wantOK: false},
+		{url: "https://example.com/hook?secret=", wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := hookSecret(tt.url, "secret")
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("hookSecret(%q) = %q, %v, want %q, %v", tt.url, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestWithHookSecret(t *testing.T) {
+	got, err := withHookSecret("https://example.com/hook?x=1&secret=old", "secret", "new")
+	if err != nil {
+		t.Fatalf("withHookSecret() error = %v", err)
+	}
+	if secret, _ := hookSecret(got, "secret"); secret != "new" {
+		t.Errorf("secret in %q = %q, want new", got, secret)
+	}
+	if x, _ := hookSecret(got, "x"); x != "1" {
+		t.Errorf("other parameters were not kept: %q", got)
+	}
+
+	if got := maskHookURL("https://example.com/hook?secret=abc", "secret"); got != "https://example.com/hook?secret=xxxxx" {
+		t.Errorf("maskHookURL() = %q", got)
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := generateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := generateSecret()
+	if len(a) != 43 || a == b {
+		t.Errorf("generateSecret() = %q, %q", a, b)
+	}
+}
+
+func TestSelectWebhooks(t *testing.T) {
+	hooks := []backlog.Webhook{
+		{ID: backlog.NewOptInt(1), Name: backlog.NewOptString("deploy")},
+		{ID: backlog.NewOptInt(2), Name: backlog.NewOptString("chat")},
+	}
+	got, err := selectWebhooks(hooks, []string{"2", "Deploy", "chat"})
+	if err != nil {
+		t.Fatalf("selectWebhooks() error = %v", err)
+	}
+	if len(got) != 2 || got[0].ID.Value != 2 || got[1].ID.Value != 1 {
+		t.Errorf("selectWebhooks() = %+v", got)
+	}
+	if _, err := selectWebhooks(hooks, []string{"unknown"}); err == nil {
+		t.Error("expected error for unknown webhook")
+	}
+}
+
+func TestSecretStore(t *testing.T) {
+	store := &secretStore{path: filepath.Join(t.TempDir(), "webhook-secrets.json")}
+	states, err := store.Load()
+	if err != nil || states != nil {
+		t.Fatalf("Load() on missing file = %v, %v", states, err)
+	}
+
+	until := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	states, st := findSecretState(states, "example.backlog.jp", "PROJ", 1)
+	st.Current = "new"
+	st.Previous = "old"
+	st.PreviousValidUntil = &until
+	states, again := findSecretState(states, "example.backlog.jp", "PROJ", 1)
+	if len(states) != 1 || again.Current != "new" {
+		t.Fatalf("findSecretState() did not return the existing state: %+v", states)
+	}
+	if err := store.Save(states); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded, states) {
+		t.Errorf("Load() = %+v, want %+v", loaded, states)
+	}
+
+	if loaded[0].previousExpired(until.Add(-time.Second)) {
+		t.Error("previous secret expired before the grace period ended")
+	}
+	if !loaded[0].previousExpired(until) {
+		t.Error("previous secret should expire at the end of the grace period")
+	}
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var secretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Show the rotation state of webhook secrets",
+	Long: `Show the secrets recorded by "backlog webhook rotate-secret" for the project:
+the current secret, secrets prepared with --prepare, and previous secrets with
+the end of their grace period.
+
+Previous secrets whose grace period has ended can be removed from receivers.
+Use --prune to forget them.
+
+Examples:
+  backlog webhook secrets
+  backlog webhook secrets --output json
+  backlog webhook secrets --prune`,
+	Args: cobra.NoArgs,
+	RunE: runSecrets,
+}
+
+var (
+	secretsPrune       bool
+	secretsShowSecrets bool
+)
+
+func init() {
+	secretsCmd.Flags().BoolVar(&secretsPrune, "prune", false, "Forget previous secrets whose grace period has ended")
+	secretsCmd.Flags().BoolVar(&secretsShowSecrets, "show-secrets", false, "Show secrets in the table instead of masking them")
+}
+
+func runSecrets(c *cobra.Command, args []string) error {
+	cfg, err := cmdutil.GetConfigStore(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+	profile := cfg.CurrentProfile()
+
+	store, err := openSecretStore()
+	if err != nil {
+		return err
+	}
+	states, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	pruned := 0
+	var project []secretState
+	for i := range states {
+		st := &states[i]
+		if st.Space != profile.Space || st.ProjectKey != projectKey {
+			continue
+		}
+		if secretsPrune && st.previousExpired(now) {
+			st.Previous = ""
+			st.PreviousValidUntil = nil
+			pruned++
+		}
+		project = append(project, *st)
+	}
+	if pruned > 0 {
+		if err := store.Save(states); err != nil {
+			return err
+		}
+	}
+
+	if profile.Output == "json" {
+		if project == nil {
+			project = []secretState{}
+		}
+		return cmdutil.OutputJSONFromProfile(project, profile.JSONFields, profile.JQ, profile.Template)
+	}
+
+	if len(project) == 0 {
+		fmt.Println("No rotated webhook secrets.")
+		return nil
+	}
+	secret := maskSecret
+	if secretsShowSecrets {
+		secret = func(s string) string {
+			if s == "" {
+				return "-"
+			}
+			return s
+		}
+	}
+	table := ui.NewTable("ID", "NAME", "CURRENT", "PENDING", "PREVIOUS", "PREVIOUS STATE")
+	for _, st := range project {
+		table.AddRow(strconv.Itoa(st.WebhookID), st.Name, secret(st.Current), secret(st.Pending), secret(st.Previous), previousState(&st, now))
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	if secretsPrune {
+		cmdutil.Success("", "Pruned %d expired previous secret(s)", pruned)
+	}
+	return nil
+}
+
+// previousState は旧シークレットを受信側から削除してよいかを表す
+func previousState(st *secretState, now time.Time) string {
+	switch {
+	case st.Previous == "":
+		return "-"
+	case st.previousExpired(now):
+		return ui.Yellow("expired: remove from receivers")
+	case st.PreviousValidUntil != nil:
+		return "accept until " + st.PreviousValidUntil.Local().Format("2006-01-02 15:04")
+	default:
+		return "accept"
+	}
+}
@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// WebhookCmd is the root command for webhook operations
+var WebhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Manage project webhooks",
+	Long: `List project webhooks and rotate the secrets embedded in their URLs.
+
+Backlog does not sign webhook requests, so receivers usually verify a secret
+passed as a query parameter of the hook URL (e.g. https://example.com/hook?secret=...).
+Managing webhooks requires project administrator permission.`,
+}
+
+var webhookSecretParam string
+
+func init() {
+	WebhookCmd.PersistentFlags().StringVar(&webhookSecretParam, "param", "secret", "Query parameter of the hook URL that carries the secret")
+
+	WebhookCmd.AddCommand(listCmd)
+	WebhookCmd.AddCommand(rotateSecretCmd)
+	WebhookCmd.AddCommand(secretsCmd)
+}
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List webhooks",
+	Long: `List webhooks of the project. Secrets in hook URLs are masked.
+
+Examples:
+  backlog webhook list
+  backlog webhook list -p PROJECT_KEY --output json`,
+	Args: cobra.NoArgs,
+	RunE: runList,
+}
+
+func runList(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+
+	hooks, err := client.GetWebhooks(c.Context(), projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	profile := cfg.CurrentProfile()
+	if profile.Output == "json" {
+		return cmdutil.OutputJSONFromProfile(hooks, profile.JSONFields, profile.JQ, profile.Template)
+	}
+	if len(hooks) == 0 {
+		fmt.Println("No webhooks found")
+		return nil
+	}
+	table := ui.NewTable("ID", "NAME", "URL", "EVENTS")
+	for _, h := range hooks {
+		table.AddRow(strconv.Itoa(h.ID.Value), h.Name.Value, maskHookURL(h.HookUrl.Value, webhookSecretParam), webhookEvents(h))
+	}
+	table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+	return nil
+}
+
+func webhookEvents(h backlog.Webhook) string {
+	if h.AllEvent.Value {
+		return "all"
+	}
+	return fmt.Sprintf("%d type(s)", len(h.ActivityTypeIds))
+}
+
+// openSecretStore は Webhook シークレットの状態ファイルを開く
+func openSecretStore() (*secretStore, error) {
+	path, err := config.WebhookSecretsPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve webhook secrets path: %w", err)
+	}
+	return &secretStore{path: path}, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
@@ -71,17 +72,26 @@ func runWikiAttachmentList(c *cobra.Command, args []string) error {
 // --- upload ---
 
 var wikiAttachmentUploadCmd = &cobra.Command{
-	Use:   "upload <wiki-id> <file> [<file>...]",
-	Short: "Upload and attach file(s) to a wiki page",
+	Use:     "upload <wiki-id> <file> [<file>...]",
+	Aliases: []string{"add"},
+	Short:   "Upload and attach file(s) to a wiki page",
 	Long: `Upload local file(s) and attach them to the wiki page.
 
+With --replace, existing attachments that have the same file name as an
+uploaded file are deleted after the new files are attached. Wiki content
+refers to attached images by file name, so the page shows the new files
+without editing.
+
 Examples:
   backlog wiki attachment upload 100 report.pdf
-  backlog wiki attachment upload 100 img1.png img2.png`,
+  backlog wiki attachment add 100 img1.png img2.png
+  backlog wiki attachment upload 100 screenshot.png --replace`,
 	Args: cobra.MinimumNArgs(2),
 	RunE: runWikiAttachmentUpload,
 }
 
+var wikiAttachmentUploadReplace bool
+
 func runWikiAttachmentUpload(c *cobra.Command, args []string) error {
 	wikiID, err := strconv.Atoi(args[0])
 	if err != nil {
@@ -89,13 +99,23 @@ func runWikiAttachmentUpload(c *cobra.Command, args []string) error {
 	}
 	files := args[1:]
 
-	client, _, err := cmdutil.GetAPIClient(c)
+	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
 	}
 
 	ctx := c.Context()
-	attachmentIDs, err := cmdutil.UploadFiles(ctx, client, files)
+
+	// 置き換え対象は添付前の一覧から決める（新しい添付を誤って消さないため）
+	var existing []api.Attachment
+	if wikiAttachmentUploadReplace {
+		existing, err = client.ListWikiAttachments(ctx, wikiID)
+		if err != nil {
+			return fmt.Errorf("failed to list attachments: %w", err)
+		}
+	}
+
+	attachmentIDs, err := cmdutil.UploadFiles(ctx, client, cfg, files)
 	if err != nil {
 		return err
 	}
@@ -105,10 +125,35 @@ func runWikiAttachmentUpload(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to attach files to wiki: %w", err)
 	}
 
-	ui.Success("Attached %d file(s) to wiki %d", len(atts), wikiID)
+	cmdutil.Success("", "Attached %d file(s) to wiki %d", len(atts), wikiID)
+
+	// 古い添付の削除は新しい添付が成功した後に行う
+	for _, old := range replacedWikiAttachments(existing, atts) {
+		if _, err := client.DeleteWikiAttachment(ctx, wikiID, old.ID); err != nil {
+			return fmt.Errorf("failed to delete replaced attachment %d (%s): %w", old.ID, old.Name, err)
+		}
+		cmdutil.Success("", "Replaced attachment: %s (%d)", old.Name, old.ID)
+	}
 	return nil
 }
 
+// replacedWikiAttachments は新しく添付したファイルと同名の既存添付を返す
+func replacedWikiAttachments(existing, attached []api.Attachment) []api.Attachment {
+	names := make(map[string]bool, len(attached))
+	newIDs := make(map[int]bool, len(attached))
+	for _, a := range attached {
+		names[a.Name] = true
+		newIDs[a.ID] = true
+	}
+	var replaced []api.Attachment
+	for _, a := range existing {
+		if names[a.Name] && !newIDs[a.ID] {
+			replaced = append(replaced, a)
+		}
+	}
+	return replaced
+}
+
 // --- download ---
 
 var wikiAttachmentDownloadCmd = &cobra.Command{
@@ -130,6 +175,7 @@ var (
 )
 
 func init() {
+	wikiAttachmentUploadCmd.Flags().BoolVar(&wikiAttachmentUploadReplace, "replace", false, "Delete existing attachments with the same file name after attaching")
 	wikiAttachmentDownloadCmd.Flags().StringVarP(&wikiAttachmentDownloadOutput, "output", "o", "", "Output file path (use \"-\" for stdout)")
 	wikiAttachmentDownloadCmd.Flags().BoolVar(&wikiAttachmentDownloadLink, "link", false, "Output download link as JSON {file, headers, url} instead of file content")
 	if !cmdutil.IsMCPMode() {
@@ -167,14 +213,14 @@ func runWikiAttachmentDownload(c *cobra.Command, args []string) error {
 // --- delete ---
 
 var wikiAttachmentDeleteCmd = &cobra.Command{
-	Use:   "delete <wiki-id> <attachment-id>",
-	Short: "Delete a wiki attachment",
-	Long: `Delete an attachment from a wiki page.
+	Use:   "delete <wiki-id> <attachment-id> [<attachment-id>...]",
+	Short: "Delete wiki attachments",
+	Long: `Delete one or more attachments from a wiki page.
 
 Examples:
   backlog wiki attachment delete 100 42
-  backlog wiki attachment delete 100 42 --yes`,
-	Args: cobra.ExactArgs(2),
+  backlog wiki attachment delete 100 42 43 44 --yes`,
+	Args: cobra.MinimumNArgs(2),
 	RunE: runWikiAttachmentDelete,
 }
 
@@ -183,9 +229,13 @@ func runWikiAttachmentDelete(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("invalid wiki ID: %s", args[0])
 	}
-	attachmentID, err := strconv.Atoi(args[1])
-	if err != nil {
-		return fmt.Errorf("invalid attachment ID: %s", args[1])
+	attachmentIDs := make([]int, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return fmt.Errorf("invalid attachment ID: %s", arg)
+		}
+		attachmentIDs = append(attachmentIDs, id)
 	}
 
 	client, _, err := cmdutil.GetAPIClient(c)
@@ -203,7 +253,7 @@ func runWikiAttachmentDelete(c *cobra.Command, args []string) error {
 		}
 		var confirm bool
 		prompt := &survey.Confirm{
-			Message: fmt.Sprintf("Delete attachment %d from wiki %d?", attachmentID, wikiID),
+			Message: fmt.Sprintf("Delete %d attachment(s) from wiki %d?", len(attachmentIDs), wikiID),
 			Default: false,
 		}
 		if err := survey.AskOne(prompt, &confirm); err != nil {
@@ -215,12 +265,13 @@ func runWikiAttachmentDelete(c *cobra.Command, args []string) error {
 		}
 	}
 
-	att, err := client.DeleteWikiAttachment(c.Context(), wikiID, attachmentID)
-	if err != nil {
-		return fmt.Errorf("failed to delete attachment: %w", err)
+	for _, attachmentID := range attachmentIDs {
+		att, err := client.DeleteWikiAttachment(c.Context(), wikiID, attachmentID)
+		if err != nil {
+			return fmt.Errorf("failed to delete attachment %d: %w", attachmentID, err)
+		}
+		cmdutil.Success("", "Deleted attachment: %s", att.Name)
 	}
-
-	ui.Success("Deleted attachment: %s", att.Name)
 	return nil
 }
 
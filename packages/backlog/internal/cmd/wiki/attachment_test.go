@@ -0,0 +1,57 @@
+package wiki
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestReplacedWikiAttachments(t *testing.T) {
+	existing := []api.Attachment{
+		{ID: 1, Name: "logo.png"},
+		{ID: 2, Name: "spec.pdf"},
+		{ID: 3, Name: "logo.png"},
+	}
+	tests := []struct {
+		name     string
+		existing []api.Attachment
+		attached []api.Attachment
+		want     []int
+	}{
+		{
+			name:     "same name is replaced",
+			existing: existing,
+			attached: []api.Attachment{{ID: 10, Name: "logo.png"}},
+			want:     []int{1, 3},
+		},
+		{
+			name:     "different name is kept",
+			existing: existing,
+			attached: []api.Attachment{{ID: 10, Name: "diagram.png"}},
+			want:     nil,
+		},
+		{
+			name:     "newly attached file is not deleted",
+			existing: append(existing, api.Attachment{ID: 10, Name: "spec.pdf"}),
+			attached: []api.Attachment{{ID: 10, Name: "spec.pdf"}},
+			want:     []int{2},
+		},
+		{
+			name:     "no existing attachments",
+			attached: []api.Attachment{{ID: 10, Name: "logo.png"}},
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, a := range replacedWikiAttachments(tt.existing, tt.attached) {
+				got = append(got, a.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("replacedWikiAttachments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -96,6 +97,9 @@ func runCreate(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get content: %w", err)
 	}
+	if err := cmdutil.CheckSecrets(cfg, "wiki content", createContent); err != nil {
+		return err
+	}
 
 	// Wiki作成
 	input := &api.CreateWikiInput{
@@ -112,7 +116,7 @@ func runCreate(c *cobra.Command, args []string) error {
 
 	// 添付ファイルのアップロード（Wiki作成APIは添付に非対応のため、作成後に紐付ける）
 	if len(createAttachFiles) > 0 {
-		attachmentIDs, err := cmdutil.UploadFiles(ctx, client, createAttachFiles)
+		attachmentIDs, err := cmdutil.UploadFiles(ctx, client, cfg, createAttachFiles)
 		if err != nil {
 			return err
 		}
@@ -129,11 +133,12 @@ func runCreate(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(wiki)
 	default:
-		fmt.Printf("%s Wiki page created: %s (ID: %d)\n",
-			ui.Green("✓"), wiki.Name, wiki.ID)
+		cmdutil.Success(strconv.Itoa(wiki.ID), "Wiki page created: %s (ID: %d)", wiki.Name, wiki.ID)
 		url := fmt.Sprintf("https://%s/alias/wiki/%d",
 			profile.Space, wiki.ID)
-		fmt.Printf("URL: %s\n", ui.Cyan(url))
+		if !cmdutil.IsQuiet() {
+			fmt.Printf("URL: %s\n", ui.Cyan(url))
+		}
 		return nil
 	}
 }
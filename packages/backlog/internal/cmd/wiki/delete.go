@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -87,7 +88,7 @@ func runDelete(c *cobra.Command, args []string) error {
 		enc.SetIndent("", "  ")
 		return enc.Encode(deletedWiki)
 	default:
-		ui.Success("Deleted wiki page: %s (ID: %d)", deletedWiki.Name, deletedWiki.ID)
+		cmdutil.Success(strconv.Itoa(deletedWiki.ID), "Deleted wiki page: %s (ID: %d)", deletedWiki.Name, deletedWiki.ID)
 		return nil
 	}
 }
@@ -37,6 +37,12 @@ Modes:
     backlog wiki edit 123 --append "Text to add at end"
     backlog wiki edit 123 --prepend "Text to add at start"
 
+  Tags:
+    backlog wiki edit 123 --add-tag design --remove-tag draft
+
+Tags are written as "[tag]" prefixes of the page name, because the Backlog API
+has no endpoint to edit wiki tags directly.
+
 Patch modes (--patch, --append, --prepend, --safe) use Read-Modify-Write
 with conflict detection. If another user modified the page concurrently,
 a three-way merge is attempted automatically.`,
@@ -54,6 +60,8 @@ var (
 	editPatchFile   string
 	editAppend      string
 	editPrepend     string
+	editAddTags     []string
+	editRemoveTags  []string
 )
 
 func init() {
@@ -66,6 +74,8 @@ func init() {
 	editCmd.Flags().StringVar(&editPatchFile, "patch-file", "", "Read patch JSON from file (use \"-\" for stdin)")
 	editCmd.Flags().StringVar(&editAppend, "append", "", "Text to append to current content")
 	editCmd.Flags().StringVar(&editPrepend, "prepend", "", "Text to prepend to current content")
+	editCmd.Flags().StringArrayVar(&editAddTags, "add-tag", nil, "Add a tag (can be specified multiple times)")
+	editCmd.Flags().StringArrayVar(&editRemoveTags, "remove-tag", nil, "Remove a tag (can be specified multiple times)")
 }
 
 func runEdit(c *cobra.Command, args []string) error {
@@ -74,7 +84,6 @@ func runEdit(c *cobra.Command, args []string) error {
 		return err
 	}
 
-	profile := cfg.CurrentProfile()
 	idOrName := args[0]
 	ctx := c.Context()
 
@@ -86,8 +95,10 @@ func runEdit(c *cobra.Command, args []string) error {
 	hasPatchFlags := editPatch != "" || editPatchFile != "" || editAppend != "" || editPrepend != ""
 	hasContentFlags := c.Flags().Changed("content") || editContentFile != ""
 
-	if !hasPatchFlags && !hasContentFlags && !c.Flags().Changed("name") && !editSafe {
-		return fmt.Errorf("no changes specified. Use --content, --patch, --append, or --prepend")
+	hasTagFlags := len(editAddTags) > 0 || len(editRemoveTags) > 0
+
+	if !hasPatchFlags && !hasContentFlags && !c.Flags().Changed("name") && !hasTagFlags && !editSafe {
+		return fmt.Errorf("no changes specified. Use --content, --patch, --append, --prepend, or --add-tag/--remove-tag")
 	}
 
 	if hasPatchFlags && hasContentFlags && !editSafe {
@@ -96,21 +107,25 @@ func runEdit(c *cobra.Command, args []string) error {
 
 	// Patch mode: use SafeUpdateWiki
 	if hasPatchFlags || editSafe {
-		return runEditPatch(ctx, client, profile, wikiID, c)
+		return runEditPatch(ctx, client, cfg, wikiID, c)
 	}
 
 	// Direct mode: existing behavior (last-write-wins)
-	return runEditDirect(ctx, client, profile, wikiID, c)
+	return runEditDirect(ctx, client, cfg, wikiID, c)
 }
 
-func runEditDirect(ctx context.Context, client *api.Client, profile *config.ResolvedProfile, wikiID int, c *cobra.Command) error {
+func runEditDirect(ctx context.Context, client *api.Client, cfg *config.Store, wikiID int, c *cobra.Command) error {
 	input := &api.UpdateWikiInput{
 		MailNotify: editMailNotify,
 	}
 	hasChanges := false
 
-	if c.Flags().Changed("name") {
-		input.Name = &editName
+	name, err := resolveEditName(ctx, client, wikiID, c)
+	if err != nil {
+		return err
+	}
+	if name != nil {
+		input.Name = name
 		hasChanges = true
 	}
 	if c.Flags().Changed("content") || editContentFile != "" {
@@ -118,6 +133,9 @@ func runEditDirect(ctx context.Context, client *api.Client, profile *config.Reso
 		if err != nil {
 			return fmt.Errorf("failed to read content: %w", err)
 		}
+		if err := cmdutil.CheckSecrets(cfg, "wiki content", content); err != nil {
+			return err
+		}
 		if content != "" {
 			input.Content = &content
 			hasChanges = true
@@ -125,7 +143,11 @@ func runEditDirect(ctx context.Context, client *api.Client, profile *config.Reso
 	}
 
 	if !hasChanges {
-		return fmt.Errorf("no changes specified. Use --name, --content, or --content-file")
+		if len(editAddTags) > 0 || len(editRemoveTags) > 0 {
+			cmdutil.Success("", "Wiki page tags are already up to date (ID: %d)", wikiID)
+			return nil
+		}
+		return fmt.Errorf("no changes specified. Use --name, --content, --content-file, or --add-tag/--remove-tag")
 	}
 
 	wiki, err := client.UpdateWiki(ctx, wikiID, input)
@@ -133,10 +155,10 @@ func runEditDirect(ctx context.Context, client *api.Client, profile *config.Reso
 		return fmt.Errorf("failed to update wiki page: %w", err)
 	}
 
-	return printEditResult(profile, wiki, false)
+	return printEditResult(cfg.CurrentProfile(), wiki, false)
 }
 
-func runEditPatch(ctx context.Context, client *api.Client, profile *config.ResolvedProfile, wikiID int, c *cobra.Command) error {
+func runEditPatch(ctx context.Context, client *api.Client, cfg *config.Store, wikiID int, c *cobra.Command) error {
 	// Parse patch ops
 	var patchOps []api.PatchOp
 	if editPatch != "" || editPatchFile != "" {
@@ -160,6 +182,17 @@ func runEditPatch(ctx context.Context, client *api.Client, profile *config.Resol
 		fullReplace = content
 	}
 
+	for _, text := range []string{fullReplace, editPrepend, editAppend} {
+		if err := cmdutil.CheckSecrets(cfg, "wiki content", text); err != nil {
+			return err
+		}
+	}
+	for _, op := range patchOps {
+		if err := cmdutil.CheckSecrets(cfg, "wiki content", op.Replace); err != nil {
+			return err
+		}
+	}
+
 	patchFn, err := cmdutil.BuildPatchFn(patchOps, editPrepend, editAppend, fullReplace)
 	if err != nil {
 		return err
@@ -169,17 +202,21 @@ func runEditPatch(ctx context.Context, client *api.Client, profile *config.Resol
 	if err != nil {
 		var conflictErr *api.ConflictError
 		if errors.As(err, &conflictErr) {
-			fmt.Fprintf(os.Stderr, "%s %s\n", ui.Red("✗"), conflictErr.Error())
+			fmt.Fprintf(os.Stderr, "%s %s\n", ui.FailMark(), conflictErr.Error())
 			fmt.Fprintf(os.Stderr, "  Hint: resolve the conflict manually, or use --content without --safe to force overwrite.\n")
 			return err
 		}
 		return fmt.Errorf("failed to update wiki page: %w", err)
 	}
 
-	// Handle --name update separately (not part of content patching)
-	if c.Flags().Changed("name") {
+	// Handle --name and tag updates separately (not part of content patching)
+	name, err := resolveEditName(ctx, client, wikiID, c)
+	if err != nil {
+		return err
+	}
+	if name != nil {
 		updated, err := client.UpdateWiki(ctx, wikiID, &api.UpdateWikiInput{
-			Name:       &editName,
+			Name:       name,
 			MailNotify: editMailNotify,
 		})
 		if err != nil {
@@ -188,7 +225,32 @@ func runEditPatch(ctx context.Context, client *api.Client, profile *config.Resol
 		result.Wiki = updated
 	}
 
-	return printEditResult(profile, result.Wiki, result.Merged)
+	return printEditResult(cfg.CurrentProfile(), result.Wiki, result.Merged)
+}
+
+// resolveEditName は --name と --add-tag/--remove-tag から新しいページ名を決める
+// 名前を変えない場合は nil を返す
+func resolveEditName(ctx context.Context, client *api.Client, wikiID int, c *cobra.Command) (*string, error) {
+	if len(editAddTags) == 0 && len(editRemoveTags) == 0 {
+		if c.Flags().Changed("name") {
+			return &editName, nil
+		}
+		return nil, nil
+	}
+
+	wiki, err := client.GetWiki(ctx, wikiID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wiki page: %w", err)
+	}
+	base := wiki.Name
+	if c.Flags().Changed("name") {
+		base = editName
+	}
+	name := applyWikiTagChanges(base, wiki.Tags, editAddTags, editRemoveTags)
+	if name == wiki.Name {
+		return nil, nil
+	}
+	return &name, nil
 }
 
 func printEditResult(profile *config.ResolvedProfile, wiki *api.Wiki, merged bool) error {
@@ -199,11 +261,9 @@ func printEditResult(profile *config.ResolvedProfile, wiki *api.Wiki, merged boo
 		return enc.Encode(wiki)
 	default:
 		if merged {
-			fmt.Printf("%s Wiki page updated (auto-merged): %s (ID: %d)\n",
-				ui.Green("✓"), wiki.Name, wiki.ID)
+			cmdutil.Success(strconv.Itoa(wiki.ID), "Wiki page updated (auto-merged): %s (ID: %d)", wiki.Name, wiki.ID)
 		} else {
-			fmt.Printf("%s Wiki page updated: %s (ID: %d)\n",
-				ui.Green("✓"), wiki.Name, wiki.ID)
+			cmdutil.Success(strconv.Itoa(wiki.ID), "Wiki page updated: %s (ID: %d)", wiki.Name, wiki.ID)
 		}
 		return nil
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/query"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -20,6 +21,9 @@ Examples:
   backlog wiki list
   backlog wiki list --project MYPROJECT
   backlog wiki list --search "release notes"
+  backlog wiki list --tag design
+  backlog wiki list --tag design --tag api
+  backlog wiki list --query '"release notes" 2026'
   backlog wiki list --count`,
 	RunE: runList,
 }
@@ -27,14 +31,32 @@ Examples:
 var (
 	wikiListCount  bool
 	wikiListSearch string
+	wikiListQuery  string
+	wikiListTags   []string
 )
 
 func init() {
 	listCmd.Flags().BoolVar(&wikiListCount, "count", false, "Show only the count of wiki pages")
 	listCmd.Flags().StringVarP(&wikiListSearch, "search", "S", "", "Search wiki pages by keyword (name and content)")
+	listCmd.Flags().StringVarP(&wikiListQuery, "query", "q", "", "Search query (keywords only; same syntax as issue list --query)")
+	listCmd.Flags().StringArrayVar(&wikiListTags, "tag", nil, "Show only pages with the tag (can be specified multiple times; all must match)")
 }
 
 func runList(c *cobra.Command, args []string) error {
+	if wikiListQuery != "" {
+		// Wiki 一覧 API が絞り込めるのはキーワードのみ
+		q, err := query.Parse(wikiListQuery)
+		if err != nil {
+			return err
+		}
+		if err := q.Validate(); err != nil {
+			return fmt.Errorf("invalid --query: %w", err)
+		}
+		if err := cmdutil.ApplyQueryKeyword(c, q, "search", &wikiListSearch); err != nil {
+			return err
+		}
+	}
+
 	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
@@ -48,14 +70,14 @@ func runList(c *cobra.Command, args []string) error {
 
 	// 件数のみ表示
 	if wikiListCount {
-		// Backlog の /wikis/count は keyword を無視するため、
-		// --search 併用時は一覧を取得してクライアント側で数える。
-		if wikiListSearch != "" {
+		// Backlog の /wikis/count は keyword を無視しタグでも絞り込めないため、
+		// --search / --tag 併用時は一覧を取得してクライアント側で数える。
+		if wikiListSearch != "" || len(wikiListTags) > 0 {
 			wikis, err := client.GetWikis(c.Context(), projectKey, wikiListSearch)
 			if err != nil {
 				return fmt.Errorf("failed to get wiki pages: %w", err)
 			}
-			fmt.Println(len(wikis))
+			fmt.Println(len(filterWikisByTags(wikis, wikiListTags)))
 			return nil
 		}
 		count, err := client.GetWikisCount(c.Context(), projectKey, wikiListSearch)
@@ -70,6 +92,8 @@ func runList(c *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get wiki pages: %w", err)
 	}
+	// Wiki 一覧 API はタグで絞り込めないため、クライアント側で絞り込む
+	wikis = filterWikisByTags(wikis, wikiListTags)
 
 	// 出力
 	profile := cfg.CurrentProfile()
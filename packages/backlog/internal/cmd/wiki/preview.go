@@ -0,0 +1,252 @@
+package wiki
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/markdown"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+//go:embed preview
+var previewAssets embed.FS
+
+// previewPollInterval はファイル変更を確認する間隔
+const previewPollInterval = 300 * time.Millisecond
+
+var previewCmd = &cobra.Command{
+	Use:   "preview <file>",
+	Short: "Preview a markdown wiki page locally",
+	Long: `Render a markdown file close to how Backlog displays it (GFM, emoji
+shortcodes and issue key links).
+
+Without --serve, the rendered HTML fragment is written to standard output.
+With --serve, a local HTTP server is started and the page is reloaded in the
+browser every time the file is saved.
+
+Examples:
+  backlog wiki preview page.md
+  backlog wiki preview page.md --serve
+  backlog wiki preview page.md --serve --port 8080 --no-browser`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPreview,
+}
+
+var (
+	previewServe     bool
+	previewPort      int
+	previewNoBrowser bool
+)
+
+func init() {
+	previewCmd.Flags().BoolVar(&previewServe, "serve", false, "Start a local preview server with live reload")
+	previewCmd.Flags().IntVar(&previewPort, "port", 0, "Port for the preview server (default: random)")
+	previewCmd.Flags().BoolVar(&previewNoBrowser, "no-browser", false, "Do not open the browser automatically")
+}
+
+func runPreview(c *cobra.Command, args []string) error {
+	path := args[0]
+
+	// スペースは課題キーのリンク生成にのみ使う（未設定でもプレビューは可能）
+	var space, browser string
+	if cfg, err := cmdutil.GetConfigStore(c); err == nil {
+		space = cmdutil.GetSpace(cfg)
+		browser = cmdutil.ConfiguredBrowser(cfg)
+	}
+
+	if !previewServe {
+		page, err := renderPreviewFile(path, space)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(c.OutOrStdout(), page.HTML)
+		return err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", previewPort))
+	if err != nil {
+		return fmt.Errorf("failed to start preview server: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(c.Context(), os.Interrupt)
+	defer stop()
+
+	ps := newPreviewServer(path, space)
+	go ps.watch(ctx)
+
+	srv := &http.Server{Handler: ps.handler(), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	url := "http://" + listener.Addr().String() + "/"
+	fmt.Fprintf(os.Stderr, "%s Previewing %s at %s (Ctrl+C to stop)\n", ui.OKMark(), path, ui.Cyan(url))
+	if !previewNoBrowser {
+		_ = osutil.OpenURL(browser, url)
+	}
+
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// previewPage は /api/preview のレスポンス
+type previewPage struct {
+	Title   string    `json:"title"`
+	HTML    string    `json:"html"`
+	Updated time.Time `json:"updated"`
+}
+
+// renderPreviewFile はファイルを読み込んで HTML に変換する
+// タイトルは最初の見出し、なければファイル名を使う。
+func renderPreviewFile(path, space string) (*previewPage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	content := string(data)
+	title := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			title = strings.TrimSpace(strings.TrimPrefix(line, "# "))
+			break
+		}
+	}
+	return &previewPage{
+		Title:   title,
+		HTML:    markdown.RenderHTML(content, markdown.HTMLOptions{Space: space}),
+		Updated: time.Now(),
+	}, nil
+}
+
+// previewServer はプレビューページとライブリロード用のイベントを配信する
+type previewServer struct {
+	path  string
+	space string
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func newPreviewServer(path, space string) *previewServer {
+	return &previewServer{path: path, space: space, subscribers: make(map[chan struct{}]struct{})}
+}
+
+func (ps *previewServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/preview", ps.handlePreview)
+	mux.HandleFunc("/api/events", ps.handleEvents)
+	assets, _ := fs.Sub(previewAssets, "preview")
+	mux.Handle("/", ui.SPAHandler(assets))
+	return mux
+}
+
+func (ps *previewServer) handlePreview(w http.ResponseWriter, r *http.Request) {
+	page, err := renderPreviewFile(ps.path, ps.space)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+// handleEvents は Server-Sent Events でファイル更新を通知する
+func (ps *previewServer) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	flusher.Flush()
+
+	ch := ps.subscribe()
+	defer ps.unsubscribe(ch)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ch:
+			_, _ = fmt.Fprint(w, "event: reload\ndata: {}\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+func (ps *previewServer) subscribe() chan struct{} {
+	ch := make(chan struct{}, 1)
+	ps.mu.Lock()
+	ps.subscribers[ch] = struct{}{}
+	ps.mu.Unlock()
+	return ch
+}
+
+func (ps *previewServer) unsubscribe(ch chan struct{}) {
+	ps.mu.Lock()
+	delete(ps.subscribers, ch)
+	ps.mu.Unlock()
+}
+
+// notify は購読中のクライアントに更新を通知する（未処理の通知があれば間引く）
+func (ps *previewServer) notify() {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for ch := range ps.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// watch はファイルの更新時刻とサイズをポーリングし、変化があれば通知する
+func (ps *previewServer) watch(ctx context.Context) {
+	var lastMod time.Time
+	var lastSize int64 = -1
+	if info, err := os.Stat(ps.path); err == nil {
+		lastMod, lastSize = info.ModTime(), info.Size()
+	}
+	ticker := time.NewTicker(previewPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err := os.Stat(ps.path)
+			if err != nil {
+				continue
+			}
+			if !info.ModTime().Equal(lastMod) || info.Size() != lastSize {
+				lastMod, lastSize = info.ModTime(), info.Size()
+				ps.notify()
+			}
+		}
+	}
+}
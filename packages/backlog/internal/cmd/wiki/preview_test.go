@@ -0,0 +1,37 @@
+package wiki
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPreviewServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "page.md")
+	if err := os.WriteFile(path, []byte("# Release notes\n\nFixed PROJ-1"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h := newPreviewServer(path, "example.backlog.jp").handler()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/api/preview", nil))
+	var page previewPage
+	if err := json.NewDecoder(rec.Body).Decode(&page); err != nil {
+		t.Fatal(err)
+	}
+	if page.Title != "Release notes" {
+		t.Errorf("title = %q", page.Title)
+	}
+	if !strings.Contains(page.HTML, "https://example.backlog.jp/view/PROJ-1") {
+		t.Errorf("html = %s", page.HTML)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if !strings.Contains(rec.Body.String(), "/api/events") {
+		t.Errorf("index page not served: %s", rec.Body.String())
+	}
+}
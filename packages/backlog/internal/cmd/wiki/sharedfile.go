@@ -106,7 +106,7 @@ func runWikiSharedFileLink(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to link shared files: %w", err)
 	}
 
-	ui.Success("Linked %d shared file(s) to wiki %d", len(files), wikiID)
+	cmdutil.Success("", "Linked %d shared file(s) to wiki %d", len(files), wikiID)
 	return nil
 }
 
@@ -172,6 +172,6 @@ func runWikiSharedFileUnlink(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to unlink shared file: %w", err)
 	}
 
-	ui.Success("Unlinked shared file: %s", f.Name)
+	cmdutil.Success("", "Unlinked shared file: %s", f.Name)
 	return nil
 }
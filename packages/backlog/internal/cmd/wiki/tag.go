@@ -0,0 +1,197 @@
+package wiki
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+var wikiTagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Manage wiki tags",
+	Long: `Work with wiki tags.
+
+Backlog sets wiki tags from "[tag]" prefixes of the page name. Use
+"wiki list --tag" to filter pages and "wiki edit --add-tag/--remove-tag"
+to change the tags of a page.`,
+}
+
+var wikiTagListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List wiki tags in the project",
+	Long: `List wiki tags in the project with the number of pages using each tag.
+
+Examples:
+  backlog wiki tag list
+  backlog wiki tag list --project MYPROJECT -o json`,
+	Args: cobra.NoArgs,
+	RunE: runWikiTagList,
+}
+
+func init() {
+	wikiTagCmd.AddCommand(wikiTagListCmd)
+}
+
+// wikiTagUsage は Wiki タグとそのタグが付いたページ数
+type wikiTagUsage struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Pages int    `json:"pages"`
+}
+
+func runWikiTagList(c *cobra.Command, args []string) error {
+	client, cfg, err := cmdutil.GetAPIClient(c)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireProject(cfg); err != nil {
+		return err
+	}
+	projectKey := cmdutil.GetCurrentProject(cfg)
+
+	tags, err := client.GetWikiTags(c.Context(), projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get wiki tags: %w", err)
+	}
+	wikis, err := client.GetWikis(c.Context(), projectKey, "")
+	if err != nil {
+		return fmt.Errorf("failed to get wiki pages: %w", err)
+	}
+	usages := countWikiTags(tags, wikis)
+
+	switch cfg.CurrentProfile().Output {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(usages)
+	default:
+		if len(usages) == 0 {
+			fmt.Println("No wiki tags found")
+			return nil
+		}
+		table := ui.NewTable("ID", "NAME", "PAGES")
+		for _, u := range usages {
+			table.AddRow(strconv.Itoa(u.ID), u.Name, strconv.Itoa(u.Pages))
+		}
+		table.RenderWithColor(os.Stdout, ui.IsColorEnabled())
+		return nil
+	}
+}
+
+// countWikiTags はタグごとにそのタグが付いたページ数を数える
+// 使われていないタグも整理の対象になるため 0 件のまま残す
+func countWikiTags(tags []api.WikiTag, wikis []api.Wiki) []wikiTagUsage {
+	counts := make(map[int]int)
+	for _, w := range wikis {
+		for _, t := range w.Tags {
+			counts[t.ID]++
+		}
+	}
+	usages := make([]wikiTagUsage, 0, len(tags))
+	for _, t := range tags {
+		usages = append(usages, wikiTagUsage{ID: t.ID, Name: t.Name, Pages: counts[t.ID]})
+	}
+	return usages
+}
+
+// filterWikisByTags は tags をすべて持つページだけを返す（タグ名は大文字小文字を区別しない）
+func filterWikisByTags(wikis []api.Wiki, tags []string) []api.Wiki {
+	if len(tags) == 0 {
+		return wikis
+	}
+	var filtered []api.Wiki
+	for _, w := range wikis {
+		matched := true
+		for _, want := range tags {
+			if !wikiHasTag(w, want) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, w)
+		}
+	}
+	return filtered
+}
+
+func wikiHasTag(w api.Wiki, name string) bool {
+	for _, t := range w.Tags {
+		if strings.EqualFold(t.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitWikiTagPrefix はページ名先頭の "[tag]" をタグ名と残りのページ名に分ける
+//
+//	"[design][api] Overview" → ["design", "api"], "Overview"
+func splitWikiTagPrefix(name string) ([]string, string) {
+	var tags []string
+	rest := strings.TrimLeft(name, " ")
+	for strings.HasPrefix(rest, "[") {
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			break
+		}
+		if tag := strings.TrimSpace(rest[1:end]); tag != "" {
+			tags = append(tags, tag)
+		}
+		rest = strings.TrimLeft(rest[end+1:], " ")
+	}
+	return tags, rest
+}
+
+// applyWikiTagChanges はタグを追加・削除したページ名を返す
+// Backlog API にはタグを直接編集するエンドポイントが無いため、ページ名の "[tag]" 接頭辞として表現する。
+// current は現在のタグ（API の tags とページ名の接頭辞）で、name は接頭辞を含んでよい
+func applyWikiTagChanges(name string, current []api.WikiTag, add, remove []string) string {
+	prefixTags, rest := splitWikiTagPrefix(name)
+
+	var tags []string
+	seen := make(map[string]bool)
+	appendTag := func(tag string) {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		tags = append(tags, tag)
+	}
+	for _, t := range current {
+		appendTag(t.Name)
+	}
+	for _, t := range prefixTags {
+		appendTag(t)
+	}
+	for _, t := range add {
+		appendTag(t)
+	}
+
+	removed := make(map[string]bool, len(remove))
+	for _, t := range remove {
+		removed[strings.ToLower(strings.TrimSpace(t))] = true
+	}
+
+	var b strings.Builder
+	for _, t := range tags {
+		if removed[strings.ToLower(t)] {
+			continue
+		}
+		b.WriteString("[" + t + "]")
+	}
+	if b.Len() > 0 && rest != "" {
+		b.WriteString(" ")
+	}
+	b.WriteString(rest)
+	return b.String()
+}
@@ -0,0 +1,71 @@
+package wiki
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestSplitWikiTagPrefix(t *testing.T) {
+	tags, rest := splitWikiTagPrefix("[design][ api ] Overview [draft]")
+	if want := []string{"design", "api"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("tags = %v, want %v", tags, want)
+	}
+	if rest != "Overview [draft]" {
+		t.Errorf("rest = %q, want %q", rest, "Overview [draft]")
+	}
+	if tags, rest := splitWikiTagPrefix("[unclosed Overview"); tags != nil || rest != "[unclosed Overview" {
+		t.Errorf("unclosed prefix = %v, %q", tags, rest)
+	}
+}
+
+func TestApplyWikiTagChanges(t *testing.T) {
+	current := []api.WikiTag{{ID: 1, Name: "design"}, {ID: 2, Name: "draft"}}
+	tests := []struct {
+		name   string
+		page   string
+		add    []string
+		remove []string
+		want   string
+	}{
+		{name: "add and remove", page: "Overview", add: []string{"api"}, remove: []string{"draft"}, want: "[design][api] Overview"},
+		{name: "prefix in name is kept once", page: "[design] Overview", add: []string{"Design"}, want: "[design][draft] Overview"},
+		{name: "remove is case-insensitive", page: "Overview", remove: []string{"DESIGN", "draft"}, want: "Overview"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyWikiTagChanges(tt.page, current, tt.add, tt.remove); got != tt.want {
+				t.Errorf("applyWikiTagChanges() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterWikisByTagsAndCount(t *testing.T) {
+	design := api.WikiTag{ID: 1, Name: "design"}
+	apiTag := api.WikiTag{ID: 2, Name: "api"}
+	unused := api.WikiTag{ID: 3, Name: "old"}
+	wikis := []api.Wiki{
+		{ID: 10, Tags: []api.WikiTag{design, apiTag}},
+		{ID: 11, Tags: []api.WikiTag{design}},
+		{ID: 12},
+	}
+
+	var ids []int
+	for _, w := range filterWikisByTags(wikis, []string{"Design", "api"}) {
+		ids = append(ids, w.ID)
+	}
+	if want := []int{10}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("filterWikisByTags() = %v, want %v", ids, want)
+	}
+	if got := filterWikisByTags(wikis, nil); len(got) != 3 {
+		t.Errorf("filterWikisByTags() without tags = %d pages, want 3", len(got))
+	}
+
+	usages := countWikiTags([]api.WikiTag{design, apiTag, unused}, wikis)
+	want := []wikiTagUsage{{ID: 1, Name: "design", Pages: 2}, {ID: 2, Name: "api", Pages: 1}, {ID: 3, Name: "old", Pages: 0}}
+	if !reflect.DeepEqual(usages, want) {
+		t.Errorf("countWikiTags() = %+v, want %+v", usages, want)
+	}
+}
@@ -6,13 +6,13 @@ import (
 	"io"
 	"os"
 	"strconv"
-	"strings"
 
-	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/translate"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
@@ -27,7 +27,13 @@ the CLI searches the project's wiki pages and uses the match if unique.
 Examples:
   backlog wiki view 12345
   backlog wiki view "Getting Started" -p PROJ
-  backlog wiki view 12345 --web`,
+  backlog wiki view 12345 --web
+  backlog wiki view 12345 --translate en --translator "deepl-cli --to {lang}"
+
+--translate pipes the page content to the translator command (stdin -> stdout)
+and prints the translation after the original. --translator accepts a name
+from translate.translators or a command; {lang} is replaced with the target
+language.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runView,
 }
@@ -38,6 +44,8 @@ var (
 	viewRaw           bool
 	viewMarkdownWarn  bool
 	viewMarkdownCache bool
+	viewTranslate     string
+	viewTranslator    string
 )
 
 func init() {
@@ -46,9 +54,16 @@ func init() {
 	viewCmd.Flags().BoolVar(&viewRaw, "raw", false, "Render raw content without markdown conversion")
 	viewCmd.Flags().BoolVar(&viewMarkdownWarn, "markdown-warn", false, "Show markdown conversion warnings")
 	viewCmd.Flags().BoolVar(&viewMarkdownCache, "markdown-cache", false, "Cache markdown conversion analysis data")
+	viewCmd.Flags().String("conversion-profile", "", "Markdown conversion rule preset: safe, standard, aggressive (default: display.markdown_conversion_profile)")
+	viewCmd.Flags().StringVar(&viewTranslate, "translate", "", "Translate the content into this language (e.g. en, ja)")
+	viewCmd.Flags().StringVar(&viewTranslator, "translator", "", "Translator name from translate.translators or a command (default: translate.command)")
 }
 
 func runView(c *cobra.Command, args []string) error {
+	if viewTranslator != "" && viewTranslate == "" {
+		return fmt.Errorf("--translator requires --translate")
+	}
+
 	client, cfg, err := cmdutil.GetAPIClient(c)
 	if err != nil {
 		return err
@@ -85,7 +100,7 @@ func runView(c *cobra.Command, args []string) error {
 	if viewWeb {
 		url := fmt.Sprintf("https://%s/alias/wiki/%d",
 			profile.Space, wikiID)
-		return browser.OpenURL(url)
+		return osutil.OpenURL(cmdutil.ConfiguredBrowser(cfg), url)
 	}
 
 	// Wiki取得
@@ -94,11 +109,30 @@ func runView(c *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get wiki page: %w", err)
 	}
 
+	// 翻訳
+	var translation *wikiTranslation
+	if viewTranslate != "" {
+		translator, err := translate.New(cfg, viewTranslator, viewTranslate)
+		if err != nil {
+			return err
+		}
+		stop := ui.StartProgress(fmt.Sprintf("Translating into %s...", viewTranslate))
+		text, err := translator.Translate(ctx, wiki.Content)
+		stop()
+		if err != nil {
+			return fmt.Errorf("failed to translate content: %w", err)
+		}
+		translation = &wikiTranslation{Language: viewTranslate, Content: text}
+	}
+
 	// 出力
 	switch profile.Output {
 	case "json":
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
+		if translation != nil {
+			return enc.Encode(wikiWithTranslation{Wiki: wiki, Translation: translation})
+		}
 		return enc.Encode(wiki)
 	default:
 		display := cfg.Display()
@@ -108,14 +142,26 @@ func runView(c *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to resolve cache dir: %w", cacheErr)
 		}
 		projectKey := cmdutil.GetCurrentProject(cfg)
-		return renderWikiDetail(wiki, profile, projectKey, markdownOpts, c.OutOrStdout())
+		return renderWikiDetail(wiki, translation, profile, projectKey, markdownOpts, c.OutOrStdout())
 	}
 }
 
-func renderWikiDetail(wiki *api.Wiki, profile *config.ResolvedProfile, projectKey string, markdownOpts cmdutil.MarkdownViewOptions, out io.Writer) error {
+// wikiTranslation は --translate による内容の訳文
+type wikiTranslation struct {
+	Language string `json:"language"`
+	Content  string `json:"content"`
+}
+
+// wikiWithTranslation は JSON 出力で Wiki に訳文を加える
+type wikiWithTranslation struct {
+	*api.Wiki
+	Translation *wikiTranslation `json:"translation"`
+}
+
+func renderWikiDetail(wiki *api.Wiki, translation *wikiTranslation, profile *config.ResolvedProfile, projectKey string, markdownOpts cmdutil.MarkdownViewOptions, out io.Writer) error {
 	// ヘッダー
 	fmt.Printf("%s\n", ui.Bold(wiki.Name))
-	fmt.Println(strings.Repeat("─", 60))
+	fmt.Println(ui.Rule(60))
 
 	// タグ
 	if len(wiki.Tags) > 0 {
@@ -148,7 +194,7 @@ func renderWikiDetail(wiki *api.Wiki, profile *config.ResolvedProfile, projectKe
 	if wiki.Content != "" {
 		fmt.Println()
 		fmt.Println(ui.Bold("Content"))
-		fmt.Println(strings.Repeat("─", 60))
+		fmt.Println(ui.Rule(60))
 		content := wiki.Content
 		if markdownOpts.Enable {
 			attachments := wikiAttachmentNames(wiki.Attachments)
@@ -159,6 +205,11 @@ func renderWikiDetail(wiki *api.Wiki, profile *config.ResolvedProfile, projectKe
 			content = rendered
 		}
 		fmt.Println(content)
+		if translation != nil && translation.Content != "" {
+			fmt.Println()
+			fmt.Println(ui.Gray(fmt.Sprintf("Translation (%s)", translation.Language)))
+			fmt.Println(translation.Content)
+		}
 	}
 
 	// URL
@@ -16,6 +16,8 @@ func init() {
 	WikiCmd.AddCommand(createCmd)
 	WikiCmd.AddCommand(editCmd)
 	WikiCmd.AddCommand(deleteCmd)
+	WikiCmd.AddCommand(previewCmd)
 	WikiCmd.AddCommand(wikiAttachmentCmd)
 	WikiCmd.AddCommand(wikiSharedFileCmd)
+	WikiCmd.AddCommand(wikiTagCmd)
 }
@@ -0,0 +1,166 @@
+package cmdutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// AttachmentViolation は添付ファイルのポリシー違反
+type AttachmentViolation struct {
+	Path   string
+	Reason string
+	// Sensitive は秘密情報ファイルの疑いによる違反であることを示す
+	// 対話モードではユーザー確認により続行できる
+	Sensitive bool
+}
+
+// CheckAttachmentPolicy はアップロード前に添付ファイルをポリシーに照らして検査する
+// 秘密情報ファイルの疑いがある場合は対話モードでのみ確認のうえ続行でき、
+// それ以外の違反（サイズ超過・禁止拡張子・スキャン検出）は常にエラーとする。
+func CheckAttachmentPolicy(ctx context.Context, policy *config.ResolvedAttachmentPolicy, filePaths []string) error {
+	if policy == nil || !policy.Enabled || len(filePaths) == 0 {
+		return nil
+	}
+
+	var blocking []AttachmentViolation
+	var sensitive []AttachmentViolation
+	for _, fp := range filePaths {
+		for _, v := range EvaluateAttachment(policy, fp) {
+			if v.Sensitive {
+				sensitive = append(sensitive, v)
+			} else {
+				blocking = append(blocking, v)
+			}
+		}
+	}
+	if len(blocking) > 0 {
+		return formatAttachmentViolations(blocking)
+	}
+
+	if len(sensitive) > 0 {
+		if !ui.IsInteractiveInput() {
+			return formatAttachmentViolations(sensitive)
+		}
+		for _, v := range sensitive {
+			ui.Warning("%s: %s", v.Path, v.Reason)
+		}
+		ok, err := ui.Confirm("Upload these files anyway?", false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("upload cancelled")
+		}
+	}
+
+	// スキャンは他のチェックを通過したファイルのみを対象にする
+	if policy.ScanCommand != "" {
+		for _, fp := range filePaths {
+			if err := scanAttachment(ctx, policy, fp); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AttachmentPolicy は設定から添付ファイルのポリシーを取得する
+// プロジェクト設定の scan_command は無視し、その旨を警告する
+func AttachmentPolicy(cfg *config.Store) *config.ResolvedAttachmentPolicy {
+	policy, scanIgnored := cfg.AttachmentPolicy()
+	if scanIgnored {
+		ui.Warning("security.attachment.scan_command in %s is ignored (scan commands are read only from user config)", cfg.GetProjectConfigPath())
+	}
+	return policy
+}
+
+// EvaluateAttachment はスキャン以外の静的ポリシー（サイズ・拡張子・ファイル名）を評価する
+func EvaluateAttachment(policy *config.ResolvedAttachmentPolicy, filePath string) []AttachmentViolation {
+	var violations []AttachmentViolation
+	name := filepath.Base(filePath)
+
+	if limit := policy.MaxSizeBytes(); limit > 0 {
+		if info, err := os.Stat(filePath); err == nil && info.Size() > limit {
+			violations = append(violations, AttachmentViolation{
+				Path:   filePath,
+				Reason: fmt.Sprintf("file size %d bytes exceeds limit of %d MB", info.Size(), policy.MaxSizeMB),
+			})
+		}
+	}
+
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, blocked := range policy.BlockedExtensions {
+		blocked = strings.ToLower(strings.TrimSpace(blocked))
+		if blocked == "" {
+			continue
+		}
+		if !strings.HasPrefix(blocked, ".") {
+			blocked = "." + blocked
+		}
+		if ext == blocked {
+			violations = append(violations, AttachmentViolation{
+				Path:   filePath,
+				Reason: fmt.Sprintf("extension %s is blocked by security.attachment.blocked_extensions", ext),
+			})
+			break
+		}
+	}
+
+	lowerName := strings.ToLower(name)
+	for _, pattern := range policy.SensitivePatterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "" {
+			continue
+		}
+		if matched, _ := path.Match(pattern, lowerName); matched {
+			violations = append(violations, AttachmentViolation{
+				Path:      filePath,
+				Reason:    fmt.Sprintf("file name matches sensitive pattern %q and may contain secrets", pattern),
+				Sensitive: true,
+			})
+			break
+		}
+	}
+
+	return violations
+}
+
+func scanAttachment(ctx context.Context, policy *config.ResolvedAttachmentPolicy, filePath string) error {
+	args := append(append([]string{}, policy.ScanArgs...), filePath)
+	cmd := exec.CommandContext(ctx, policy.ScanCommand, args...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		detail := strings.TrimSpace(out.String())
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			if detail != "" {
+				return fmt.Errorf("attachment scan rejected %s:\n%s", filePath, detail)
+			}
+			return fmt.Errorf("attachment scan rejected %s: %w", filePath, err)
+		}
+		return fmt.Errorf("failed to run attachment scan command %q: %w", policy.ScanCommand, err)
+	}
+	return nil
+}
+
+func formatAttachmentViolations(violations []AttachmentViolation) error {
+	lines := []string{"attachment policy check failed:"}
+	for _, v := range violations {
+		lines = append(lines, fmt.Sprintf("  %s: %s", v.Path, v.Reason))
+	}
+	lines = append(lines, "", "Adjust security.attachment.* with 'backlog config set' if this is intended.")
+	return errors.New(strings.Join(lines, "\n"))
+}
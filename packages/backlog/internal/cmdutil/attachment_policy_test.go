@@ -0,0 +1,82 @@
+package cmdutil
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+func TestEvaluateAttachment(t *testing.T) {
+	dir := t.TempDir()
+	large := filepath.Join(dir, "large.bin")
+	if err := os.WriteFile(large, make([]byte, 2*1024*1024), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := &config.ResolvedAttachmentPolicy{
+		Enabled:           true,
+		MaxSizeMB:         1,
+		BlockedExtensions: []string{"exe", ".BAT"},
+		SensitivePatterns: []string{".env", ".env.*", "*.pem"},
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		wantReasons   []string
+		wantSensitive bool
+	}{
+		{name: "plain file", path: filepath.Join(dir, "report.pdf")},
+		{name: "too large", path: large, wantReasons: []string{"exceeds limit"}},
+		{name: "blocked extension without dot", path: "setup.EXE", wantReasons: []string{"extension .exe"}},
+		{name: "blocked extension with dot", path: "run.bat", wantReasons: []string{"extension .bat"}},
+		{name: "dotenv", path: "app/.env", wantReasons: []string{"sensitive pattern"}, wantSensitive: true},
+		{name: "dotenv variant", path: ".env.production", wantReasons: []string{"sensitive pattern"}, wantSensitive: true},
+		{name: "pem", path: "certs/server.PEM", wantReasons: []string{"sensitive pattern"}, wantSensitive: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EvaluateAttachment(policy, tt.path)
+			if len(got) != len(tt.wantReasons) {
+				t.Fatalf("EvaluateAttachment(%q) = %v, want %d violations", tt.path, got, len(tt.wantReasons))
+			}
+			for i, want := range tt.wantReasons {
+				if !strings.Contains(got[i].Reason, want) {
+					t.Errorf("reason = %q, want containing %q", got[i].Reason, want)
+				}
+				if got[i].Sensitive != tt.wantSensitive {
+					t.Errorf("sensitive = %v, want %v", got[i].Sensitive, tt.wantSensitive)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckAttachmentPolicyDisabled(t *testing.T) {
+	policy := &config.ResolvedAttachmentPolicy{
+		Enabled:           false,
+		BlockedExtensions: []string{".exe"},
+	}
+	if err := CheckAttachmentPolicy(context.Background(), policy, []string{"setup.exe"}); err != nil {
+		t.Fatalf("disabled policy should not fail: %v", err)
+	}
+}
+
+func TestCheckAttachmentPolicyBlocked(t *testing.T) {
+	policy := &config.ResolvedAttachmentPolicy{
+		Enabled:           true,
+		BlockedExtensions: []string{".exe"},
+	}
+	err := CheckAttachmentPolicy(context.Background(), policy, []string{"setup.exe"})
+	if err == nil {
+		t.Fatal("expected error for blocked extension")
+	}
+	if !strings.Contains(err.Error(), "setup.exe") {
+		t.Errorf("error should mention file: %v", err)
+	}
+}
@@ -0,0 +1,16 @@
+package cmdutil
+
+import "github.com/spf13/cobra"
+
+// AutoSaveAnnotation は display.auto_save_dir による出力の自動保存の対象であることを示す注釈
+const AutoSaveAnnotation = "backlog/auto-save"
+
+// AutoSave は出力の自動保存の対象であることを示す cobra.Command.Annotations を返す
+func AutoSave() map[string]string {
+	return map[string]string{AutoSaveAnnotation: "true"}
+}
+
+// IsAutoSave はコマンドが出力の自動保存の対象かを返す
+func IsAutoSave(cmd *cobra.Command) bool {
+	return cmd.Annotations[AutoSaveAnnotation] == "true"
+}
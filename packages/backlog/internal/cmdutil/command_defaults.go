@@ -0,0 +1,33 @@
+package cmdutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+// CommandKey は display.commands のキーになるコマンド名を返す
+// ルートを除いたコマンドパスを "_" でつなぐ（"backlog issue list" → "issue_list"）
+func CommandKey(cmd *cobra.Command) string {
+	var names []string
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		names = append([]string{c.Name()}, names...)
+	}
+	return strings.Join(names, "_")
+}
+
+// ApplyCommandDefaults は display.commands のコマンド設定をフラグの既定値に反映する
+// フラグが明示されていれば何もしない（優先順位: フラグ > コマンド設定 > 全体設定）
+func ApplyCommandDefaults(cmd *cobra.Command, display *config.ResolvedDisplay) {
+	limit := display.CommandLimit(CommandKey(cmd))
+	if limit <= 0 {
+		return
+	}
+	if f := cmd.Flags().Lookup("limit"); f != nil && !f.Changed {
+		if err := f.Value.Set(strconv.Itoa(limit)); err == nil {
+			Verbosef("limit: %d (display.commands.%s.limit)", limit, CommandKey(cmd))
+		}
+	}
+}
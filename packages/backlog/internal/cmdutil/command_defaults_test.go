@@ -0,0 +1,72 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+func newCommandTree() (*cobra.Command, *cobra.Command, *int) {
+	root := &cobra.Command{Use: "backlog"}
+	issue := &cobra.Command{Use: "issue"}
+	var limit int
+	list := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}}
+	list.Flags().IntVarP(&limit, "limit", "L", 30, "")
+	root.AddCommand(issue)
+	issue.AddCommand(list)
+	return root, list, &limit
+}
+
+func TestCommandKey(t *testing.T) {
+	root, list, _ := newCommandTree()
+	if got := CommandKey(list); got != "issue_list" {
+		t.Errorf("CommandKey() = %q, want issue_list", got)
+	}
+	if got := CommandKey(root); got != "" {
+		t.Errorf("CommandKey(root) = %q, want empty", got)
+	}
+}
+
+func TestApplyCommandDefaults(t *testing.T) {
+	display := &config.ResolvedDisplay{Commands: map[string]config.ResolvedCommandDisplay{
+		"issue_list": {Limit: 50},
+	}}
+
+	_, list, limit := newCommandTree()
+	ApplyCommandDefaults(list, display)
+	if *limit != 50 {
+		t.Errorf("limit = %d, want 50 from the command setting", *limit)
+	}
+
+	_, list, limit = newCommandTree()
+	if err := list.Flags().Set("limit", "10"); err != nil {
+		t.Fatal(err)
+	}
+	ApplyCommandDefaults(list, display)
+	if *limit != 10 {
+		t.Errorf("limit = %d, want 10 from the flag", *limit)
+	}
+
+	_, list, limit = newCommandTree()
+	ApplyCommandDefaults(list, &config.ResolvedDisplay{})
+	if *limit != 30 {
+		t.Errorf("limit = %d, want the flag default 30", *limit)
+	}
+}
+
+func TestCommandFields(t *testing.T) {
+	display := &config.ResolvedDisplay{
+		PRListFields: []string{"number", "summary"},
+		Commands: map[string]config.ResolvedCommandDisplay{
+			"pr_list":    {Fields: []string{"number", "status"}},
+			"issue_list": {Limit: 50},
+		},
+	}
+	if got := display.CommandFields("pr_list", display.PRListFields); len(got) != 2 || got[1] != "status" {
+		t.Errorf("CommandFields(pr_list) = %v, want the command fields", got)
+	}
+	if got := display.CommandFields("issue_list", []string{"key"}); len(got) != 1 || got[0] != "key" {
+		t.Errorf("CommandFields(issue_list) = %v, want the fallback", got)
+	}
+}
@@ -0,0 +1,205 @@
+package cmdutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+// IssueCompletion は課題キー補完の候補
+type IssueCompletion struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	Status  string `json:"status"`
+}
+
+// issueCompletionCache はスペースごとに保存する補完候補
+type issueCompletionCache struct {
+	// SyncedAt は最近更新された課題を API から取得した日時
+	SyncedAt time.Time         `json:"syncedAt"`
+	Issues   []IssueCompletion `json:"issues"`
+}
+
+const (
+	// issueCompletionTTL を過ぎるまではキャッシュだけで補完する
+	issueCompletionTTL = 10 * time.Minute
+	// issueCompletionTimeout はキャッシュの更新を待つ上限（超えたら古い候補で補完する）
+	issueCompletionTimeout = 2 * time.Second
+	// issueCompletionMax はキャッシュに残す候補数
+	issueCompletionMax = 500
+)
+
+// CompleteIssueKeys は課題キーの引数を「PROJ-123	件名 (ステータス)」の説明付きで補完する
+// cobra の ValidArgsFunction として使う。候補はキャッシュから返し、
+// 期限切れの場合だけ最近更新された課題を取得し直す（2 つ目以降の引数はファイル補完）
+func CompleteIssueKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	cfg, err := config.Load(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if profile, _ := cmd.Flags().GetString("profile"); profile != "" {
+		cfg.SetActiveProfile(profile)
+	}
+	projectKey, _ := cmd.Flags().GetString("project")
+	if projectKey == "" {
+		projectKey = GetCurrentProject(cfg)
+	}
+	// 別プロジェクトのキーを入力中ならそのプロジェクトを補完する
+	if prefix, _, ok := strings.Cut(toComplete, "-"); ok && prefix != "" {
+		projectKey = strings.ToUpper(prefix)
+	}
+
+	path, err := issueCompletionCachePath(cfg)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	cache := readIssueCompletionCache(path)
+	if projectKey != "" && time.Since(cache.SyncedAt) > issueCompletionTTL {
+		if issues, err := fetchRecentIssues(cmd.Context(), cfg, projectKey); err == nil {
+			cache = mergeIssueCompletions(cache, issues)
+			cache.SyncedAt = time.Now()
+			writeIssueCompletionCache(path, cache)
+		} else {
+			debug.Log("completion refresh failed", "error", err)
+		}
+	}
+	return filterIssueCompletions(cache.Issues, projectKey, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// RememberIssues は表示した課題を補完候補のキャッシュに加える（失敗しても無視する）
+func RememberIssues(cfg *config.Store, issues []backlog.Issue) {
+	if len(issues) == 0 {
+		return
+	}
+	path, err := issueCompletionCachePath(cfg)
+	if err != nil {
+		return
+	}
+	writeIssueCompletionCache(path, mergeIssueCompletions(readIssueCompletionCache(path), issues))
+}
+
+// filterIssueCompletions は入力中の文字列に前方一致する候補を説明付きで返す。
+// 未入力の場合は現在のプロジェクトの候補に絞る
+func filterIssueCompletions(issues []IssueCompletion, projectKey, toComplete string) []string {
+	prefix := strings.ToUpper(toComplete)
+	if prefix == "" && projectKey != "" {
+		prefix = strings.ToUpper(projectKey) + "-"
+	}
+	var candidates []string
+	for _, issue := range issues {
+		if !strings.HasPrefix(issue.Key, prefix) {
+			continue
+		}
+		desc := strings.Join(strings.Fields(issue.Summary), " ")
+		if issue.Status != "" {
+			desc = fmt.Sprintf("%s (%s)", desc, issue.Status)
+		}
+		candidates = append(candidates, issue.Key+"\t"+desc)
+	}
+	return candidates
+}
+
+// mergeIssueCompletions は新しい課題を先頭にして重複を除き、上限までの候補にまとめる
+func mergeIssueCompletions(cache issueCompletionCache, issues []backlog.Issue) issueCompletionCache {
+	merged := make([]IssueCompletion, 0, len(issues)+len(cache.Issues))
+	seen := make(map[string]bool, cap(merged))
+	for _, issue := range issues {
+		key := issue.IssueKey.Value
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged = append(merged, IssueCompletion{
+			Key:     key,
+			Summary: issue.Summary.Value,
+			Status:  issue.Status.Value.Name.Value,
+		})
+	}
+	for _, c := range cache.Issues {
+		if !seen[c.Key] {
+			seen[c.Key] = true
+			merged = append(merged, c)
+		}
+	}
+	if len(merged) > issueCompletionMax {
+		merged = merged[:issueCompletionMax]
+	}
+	cache.Issues = merged
+	return cache
+}
+
+// fetchRecentIssues はプロジェクトの最近更新された課題を取得する
+func fetchRecentIssues(ctx context.Context, cfg *config.Store, projectKey string) ([]backlog.Issue, error) {
+	client, err := api.NewClientFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, issueCompletionTimeout)
+	defer cancel()
+	project, err := client.GetProject(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetIssues(ctx, &api.IssueListOptions{
+		ProjectIDs: []int{project.ID},
+		Sort:       "updated",
+		Order:      "desc",
+		Count:      100,
+	})
+}
+
+// issueCompletionCachePath は現在のスペースの補完候補の保存先
+func issueCompletionCachePath(cfg *config.Store) (string, error) {
+	profile := cfg.CurrentProfile()
+	if profile == nil || profile.Space == "" {
+		return "", fmt.Errorf("space is not configured")
+	}
+	dir, err := cfg.GetCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "completion", profile.Space+".json"), nil
+}
+
+func readIssueCompletionCache(path string) issueCompletionCache {
+	var cache issueCompletionCache
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		debug.Log("completion cache is broken", "path", path, "error", err)
+		return issueCompletionCache{}
+	}
+	return cache
+}
+
+func writeIssueCompletionCache(path string, cache issueCompletionCache) {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		debug.Log("failed to save completion cache", "path", path, "error", err)
+	}
+}
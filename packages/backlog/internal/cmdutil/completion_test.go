@@ -0,0 +1,86 @@
+package cmdutil
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/gen/backlog"
+)
+
+func completionIssue(key, summary, status string) backlog.Issue {
+	return backlog.Issue{
+		IssueKey: backlog.NewOptString(key),
+		Summary:  backlog.NewOptString(summary),
+		Status:   backlog.NewOptStatus(backlog.Status{Name: backlog.NewOptString(status)}),
+	}
+}
+
+func TestFilterIssueCompletions(t *testing.T) {
+	issues := []IssueCompletion{
+		{Key: "PROJ-123", Summary: "ログイン画面の\tバグ", Status: "Open"},
+		{Key: "PROJ-45", Summary: "Docs"},
+		{Key: "OTHER-1", Summary: "x", Status: "完了"},
+	}
+	tests := []struct {
+		name       string
+		project    string
+		toComplete string
+		want       []string
+	}{
+		{"current project when empty", "PROJ", "", []string{"PROJ-123\tログイン画面の バグ (Open)", "PROJ-45\tDocs"}},
+		{"prefix", "PROJ", "proj-12", []string{"PROJ-123\tログイン画面の バグ (Open)"}},
+		{"other project", "PROJ", "OTHER-", []string{"OTHER-1\tx (完了)"}},
+		{"no project", "", "", []string{"PROJ-123\tログイン画面の バグ (Open)", "PROJ-45\tDocs", "OTHER-1\tx (完了)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterIssueCompletions(issues, tt.project, tt.toComplete)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeIssueCompletions(t *testing.T) {
+	cache := issueCompletionCache{Issues: []IssueCompletion{
+		{Key: "PROJ-1", Summary: "old", Status: "Open"},
+		{Key: "PROJ-2", Summary: "kept"},
+	}}
+	merged := mergeIssueCompletions(cache, []backlog.Issue{
+		completionIssue("PROJ-3", "new", "Open"),
+		completionIssue("PROJ-1", "renamed", "Closed"),
+	})
+	var keys []string
+	for _, c := range merged.Issues {
+		keys = append(keys, c.Key)
+	}
+	if !reflect.DeepEqual(keys, []string{"PROJ-3", "PROJ-1", "PROJ-2"}) {
+		t.Fatalf("keys = %v", keys)
+	}
+	if merged.Issues[1].Summary != "renamed" || merged.Issues[1].Status != "Closed" {
+		t.Errorf("newer values should win: %+v", merged.Issues[1])
+	}
+
+	var many []backlog.Issue
+	for i := range issueCompletionMax + 10 {
+		many = append(many, completionIssue(fmt.Sprintf("PROJ-%d", i+10), "s", ""))
+	}
+	if got := len(mergeIssueCompletions(cache, many).Issues); got != issueCompletionMax {
+		t.Errorf("len = %d, want %d", got, issueCompletionMax)
+	}
+}
+
+func TestIssueCompletionCacheFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "completion", "example.backlog.jp.json")
+	if got := readIssueCompletionCache(path); len(got.Issues) != 0 {
+		t.Fatalf("missing cache should be empty: %+v", got)
+	}
+	want := issueCompletionCache{Issues: []IssueCompletion{{Key: "PROJ-1", Summary: "s", Status: "Open"}}}
+	writeIssueCompletionCache(path, want)
+	if got := readIssueCompletionCache(path); !reflect.DeepEqual(got.Issues, want.Issues) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,27 @@
+package cmdutil
+
+import (
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/emoji"
+)
+
+// ExpandEmoji は display.emoji が有効な場合に本文中の :shortcode: を絵文字に変換する
+func ExpandEmoji(display *config.ResolvedDisplay, text string) string {
+	if !display.Emoji {
+		return text
+	}
+	return emoji.Expand(text)
+}
+
+// EmojiFallback は表示時に絵文字を :shortcode: に戻すかどうかを返す
+// display.emoji_fallback が auto（既定）の場合は端末が絵文字を表示できるかで判断する
+func EmojiFallback(display *config.ResolvedDisplay) bool {
+	switch display.EmojiFallback {
+	case "always":
+		return true
+	case "never":
+		return false
+	default:
+		return !emoji.TerminalSupported()
+	}
+}
@@ -3,12 +3,18 @@ package cmdutil
 import (
 	"fmt"
 	"io"
+	"net/url"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/osutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 // SkipConfirmation reports whether confirmation prompts should be skipped.
@@ -39,12 +45,12 @@ func GetAPIClient(cmd *cobra.Command) (*api.Client, *config.Store, error) {
 
 	space := GetSpace(cfg)
 	if space == "" {
-		return nil, nil, fmt.Errorf("space is required\nRun 'backlog auth login' first")
+		return nil, nil, fmt.Errorf("space is required\nRun 'backlog auth login' first, or set BACKLOG_SPACE and BACKLOG_DOMAIN")
 	}
 
 	client, err := api.NewClientFromConfig(cfg)
 	if err != nil {
-		return nil, nil, fmt.Errorf("authentication required\nRun 'backlog auth login' first")
+		return nil, nil, fmt.Errorf("authentication required\nRun 'backlog auth login' first, or set BACKLOG_API_KEY")
 	}
 
 	return client, cfg, nil
@@ -104,7 +110,32 @@ func ParseIssueKey(issueKey string) (projectKey, issueNumber string, hasProject
 	return issueKey[:idx], issueKey[idx+1:], true
 }
 
+// issueKeyPattern は課題キー（PROJ-123）の形式
+var issueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+
+// NormalizeIssueKey はコマンド引数の課題指定を課題キー（または番号）に正規化する
+// 形式:
+//   - "https://example.backlog.jp/view/PROJ-123#comment-1" -> "PROJ-123"
+//   - "proj-123" -> "PROJ-123"（プロジェクトキーは大文字に揃える）
+//   - "#123" -> "123"
+func NormalizeIssueKey(input string) string {
+	key := strings.TrimSpace(input)
+	if strings.HasPrefix(key, "http://") || strings.HasPrefix(key, "https://") {
+		if u, err := url.Parse(key); err == nil {
+			if idx := strings.Index(u.Path, "/view/"); idx >= 0 {
+				key = strings.Trim(u.Path[idx+len("/view/"):], "/")
+			}
+		}
+	}
+	key = strings.TrimPrefix(key, "#")
+	if issueKeyPattern.MatchString(key) {
+		key = strings.ToUpper(key)
+	}
+	return key
+}
+
 // ResolveIssueKey は課題キーを解決し、必要に応じてプロジェクトキーを補完または抽出する
+// issueKey は NormalizeIssueKey で正規化してから解決するため、課題の URL も受け付ける。
 // 戻り値:
 //   - resolvedKey: 解決済みの課題キー（PROJECT-123形式）
 //   - projectKey: 課題キーから抽出または補完に使用したプロジェクトキー
@@ -115,6 +146,7 @@ func ParseIssueKey(issueKey string) (projectKey, issueNumber string, hasProject
 //   - "123" + configProject="PROJ" -> resolvedKey="PROJ-123", projectKey="PROJ"（補完）
 //   - "123" + configProject="" -> resolvedKey="123", projectKey=""（補完不可）
 func ResolveIssueKey(issueKey, configProject string) (resolvedKey, projectKey string) {
+	issueKey = NormalizeIssueKey(issueKey)
 	parsed, _, hasProject := ParseIssueKey(issueKey)
 
 	if hasProject {
@@ -153,6 +185,57 @@ func ReadBodyFromFile(filePath string) (string, error) {
 	return strings.TrimSpace(string(content)), nil
 }
 
+// EditorFunc はプロファイルの editor 設定（未設定なら $VISUAL / $EDITOR / OS の既定）で
+// テキストを編集する関数を返す。ResolveBody の openEditorFn に渡せる
+// エディタが異常終了した場合も、書きかけの内容をエラーと一緒に返す
+// プロジェクト設定 (.backlog.yaml) の editor は無視し、その旨を警告する
+func EditorFunc(cfg *config.Store) func(string) (string, error) {
+	var configured string
+	if cfg != nil {
+		editor, ignored := cfg.ProfileEditor()
+		if ignored {
+			ui.Warning("profile.editor in %s is ignored (editor commands are read only from user config)", cfg.GetProjectConfigPath())
+		}
+		configured = editor
+	}
+	editor := osutil.ResolveEditor(configured)
+	return func(initial string) (string, error) {
+		content, err := osutil.EditText(editor, initial, "backlog-*.md")
+		return strings.TrimSpace(content), err
+	}
+}
+
+// ConfiguredBrowser はプロファイルの browser 設定を返す（未設定・設定なしなら空文字）
+// osutil.OpenURL に渡すと、空のときは $BROWSER か OS の既定で開く
+// プロジェクト設定 (.backlog.yaml) の browser は無視し、その旨を警告する
+func ConfiguredBrowser(cfg *config.Store) string {
+	if cfg == nil {
+		return ""
+	}
+	browser, ignored := cfg.ProfileBrowser()
+	if ignored {
+		ui.Warning("profile.browser in %s is ignored (browser commands are read only from user config)", cfg.GetProjectConfigPath())
+	}
+	return browser
+}
+
+// ParseDurationFlag は期間指定のフラグ値を解析する
+// time.ParseDuration の書式に加えて日数（例: 2d）を受け付ける。0 以下の期間はエラーにする
+func ParseDurationFlag(flag, value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err == nil && days > 0 {
+			return time.Duration(days) * 24 * time.Hour, nil
+		}
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s %q (e.g. 8h, 30m, 2d)", flag, value)
+	}
+	return d, nil
+}
+
 // ResolveBody はbody, bodyFile, editorの優先順位でボディテキストを解決する
 // 優先順位: body > bodyFile > editor > interactive
 // openEditorFn: エディタを開く関数（nil可）
@@ -1,6 +1,9 @@
 package cmdutil
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestParseIssueKey(t *testing.T) {
 	tests := []struct {
@@ -56,6 +59,30 @@ func TestParseIssueKey(t *testing.T) {
 	}
 }
 
+func TestNormalizeIssueKey(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "PROJ-123", want: "PROJ-123"},
+		{input: " proj-123 ", want: "PROJ-123"},
+		{input: "123", want: "123"},
+		{input: "#123", want: "123"},
+		{input: "https://example.backlog.jp/view/PROJ-123", want: "PROJ-123"},
+		{input: "https://example.backlog.jp/view/PROJ-123#comment-456", want: "PROJ-123"},
+		{input: "https://example.backlog.com/view/MY_PROJ-9?foo=bar", want: "MY_PROJ-9"},
+		{input: "https://example.backlog.jp/projects/PROJ", want: "https://example.backlog.jp/projects/PROJ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := NormalizeIssueKey(tt.input); got != tt.want {
+				t.Errorf("NormalizeIssueKey(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestResolveIssueKey(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -92,6 +119,13 @@ func TestResolveIssueKey(t *testing.T) {
 			wantResolved:   "123",
 			wantProjectKey: "",
 		},
+		{
+			name:           "issue URL",
+			issueKey:       "https://example.backlog.jp/view/PROJ-123",
+			configProject:  "OTHER",
+			wantResolved:   "PROJ-123",
+			wantProjectKey: "PROJ",
+		},
 		{
 			name:           "project key with hyphen",
 			issueKey:       "MY-PROJECT-456",
@@ -113,3 +147,29 @@ func TestResolveIssueKey(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDurationFlag(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "24h", want: 24 * time.Hour},
+		{in: " 30m ", want: 30 * time.Minute},
+		{in: "7d", want: 7 * 24 * time.Hour},
+		{in: "0d", wantErr: true},
+		{in: "-1h", wantErr: true},
+		{in: "week", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDurationFlag("--since", tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDurationFlag(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDurationFlag(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package cmdutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/template"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// HookEvent はフックのテンプレートと環境変数に渡す値
+// pre フックでは課題作成前など、まだ決まっていない値は空になる
+type HookEvent struct {
+	Key        string
+	ID         int
+	ProjectKey string
+	Summary    string
+	URL        string
+	// JSON はコマンド結果の JSON（post フックのみ、標準入力で渡す）
+	// 環境変数では大きな結果で引数長の上限（E2BIG）を超えるため使わない
+	JSON []byte
+}
+
+// RunIssuePreHook は hooks.issue.<command>.pre を実行する
+// フックが失敗した場合はエラーを返し、呼び出し側はコマンドを中止する
+func RunIssuePreHook(ctx context.Context, cfg *config.Store, command string, ev HookEvent) error {
+	return runIssueHook(ctx, cfg, command, "pre", ev)
+}
+
+// RunIssuePostHook は hooks.issue.<command>.post を実行する
+// コマンド自体は完了しているため、失敗は警告のみとする
+func RunIssuePostHook(ctx context.Context, cfg *config.Store, command string, ev HookEvent) {
+	if err := runIssueHook(ctx, cfg, command, "post", ev); err != nil {
+		ui.Warning("%v", err)
+	}
+}
+
+func runIssueHook(ctx context.Context, cfg *config.Store, command, phase string, ev HookEvent) error {
+	hook, ignored := cfg.IssueHook(command, phase)
+	if ignored {
+		ui.Warning("hooks.issue.%s.%s in %s is ignored (hooks are read only from user config)", command, phase, cfg.GetProjectConfigPath())
+	}
+	if hook == "" {
+		return nil
+	}
+
+	name := fmt.Sprintf("hooks.issue.%s.%s", command, phase)
+	rendered, err := renderHookCommand(name, hook, "issue "+command, ev)
+	if err != nil {
+		return err
+	}
+
+	if timeout := cfg.Hooks().TimeoutDuration(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	var stdin io.Reader
+	if len(ev.JSON) > 0 {
+		stdin = bytes.NewReader(ev.JSON)
+	}
+	// フックの標準出力はコマンド本来の出力（--json 等）を壊さないよう標準エラーに出す
+	if err := RunShellIO(ctx, rendered, hookEnv("issue "+command, phase, ev), stdin, os.Stderr, os.Stderr); err != nil {
+		return fmt.Errorf("%s failed: %w", name, err)
+	}
+	return nil
+}
+
+// renderHookCommand はフックのテンプレートを展開する
+// 文字列フィールドはシェルでそのまま引数として使えるようクォートする
+func renderHookCommand(name, text, command string, ev HookEvent) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	data, unsafe := ShellTemplateData(map[string]string{
+		"Command":    command,
+		"Key":        ev.Key,
+		"ProjectKey": ev.ProjectKey,
+		"Summary":    ev.Summary,
+		"URL":        ev.URL,
+	})
+	data["ID"] = ev.ID
+	rendered, err := ExecuteShellTemplate(tmpl, data, unsafe)
+	if err != nil {
+		return "", fmt.Errorf("render %s template: %w", name, err)
+	}
+	return rendered, nil
+}
+
+func hookEnv(command, phase string, ev HookEvent) []string {
+	env := []string{
+		"BACKLOG_HOOK_COMMAND=" + command,
+		"BACKLOG_HOOK_PHASE=" + phase,
+		"BACKLOG_PROJECT=" + ev.ProjectKey,
+		"BACKLOG_ISSUE_KEY=" + ev.Key,
+		"BACKLOG_ISSUE_ID=" + strconv.Itoa(ev.ID),
+		"BACKLOG_ISSUE_SUMMARY=" + ev.Summary,
+		"BACKLOG_ISSUE_URL=" + ev.URL,
+	}
+	return env
+}
@@ -0,0 +1,72 @@
+package cmdutil
+
+import "testing"
+
+func TestShellQuote(t *testing.T) {
+	tests := map[string]string{
+		"":            "''",
+		"PROJ-1":      "PROJ-1",
+		"a b":         "'a b'",
+		"it's":        `'it'\''s'`,
+		"https://x/y": "https://x/y",
+	}
+	for in, want := range tests {
+		if got := ShellQuote(in); got != want {
+			t.Errorf("ShellQuote(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRenderHookCommand(t *testing.T) {
+	ev := HookEvent{
+		Key:        "PROJ-1",
+		ID:         100,
+		ProjectKey: "PROJ",
+		Summary:    "Fix it's broken",
+		URL:        "https://example.backlog.jp/view/PROJ-1",
+	}
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "key and summary",
+			text: "./notify.sh {{.Key}} {{.Summary}}",
+			want: `./notify.sh PROJ-1 'Fix it'\''s broken'`,
+		},
+		{
+			name: "id and url",
+			text: "log {{.ID}} {{.URL}} {{.Command}}",
+			want: "log 100 https://example.backlog.jp/view/PROJ-1 'issue create'",
+		},
+		{
+			name:    "unknown field",
+			text:    "echo {{.Unknown}}",
+			wantErr: true,
+		},
+		{
+			name:    "invalid template",
+			text:    "echo {{.Key",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderHookCommand("hooks.issue.create.post", tt.text, "issue create", ev)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("renderHookCommand() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renderHookCommand() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("renderHookCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
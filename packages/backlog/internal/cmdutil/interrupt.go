@@ -0,0 +1,104 @@
+package cmdutil
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// ErrInterrupted は Ctrl+C などのシグナルでコマンドが中断されたことを表す
+var ErrInterrupted = errors.New("interrupted")
+
+// interruptSignals は中断として扱うシグナル
+var interruptSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// cleanupKey は NotifyInterrupt が ctx に保持する後始末用の context のキー
+type cleanupKey struct{}
+
+// NotifyInterrupt は SIGINT/SIGTERM を受けるとキャンセルされる context を返す
+// コマンドの実行ごとに signal.NotifyContext で登録し、stop で登録を解除する。
+// 1回目のシグナルで context を ErrInterrupted でキャンセルし、実行中の HTTP リクエストを中断する。
+// 2回目のシグナルでは CleanupContext の context もキャンセルし、後始末を打ち切ってコマンドから戻れるようにする。
+// プロセスは終了しないため、ロックの解放などの defer は実行される。
+func NotifyInterrupt(parent context.Context) (context.Context, context.CancelFunc) {
+	first, stopFirst := signal.NotifyContext(parent, interruptSignals...)
+	cleanup, cancelCleanup := context.WithCancelCause(context.WithoutCancel(parent))
+	ctx, cancel := context.WithCancelCause(context.WithValue(parent, cleanupKey{}, cleanup))
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-first.Done():
+		case <-done:
+			return
+		}
+		if parent.Err() != nil {
+			return
+		}
+		// 2回目のシグナルを取りこぼさないよう、1回目の登録を解除する前に登録する
+		second, stopSecond := signal.NotifyContext(cleanup, interruptSignals...)
+		defer stopSecond()
+		stopFirst()
+		cancel(ErrInterrupted)
+		select {
+		case <-second.Done():
+			cancelCleanup(ErrInterrupted)
+		case <-done:
+		}
+	}()
+
+	return ctx, func() {
+		close(done)
+		stopFirst()
+		cancel(context.Canceled)
+		cancelCleanup(context.Canceled)
+	}
+}
+
+// CleanupContext は中断後の後始末（サーバーの停止待ちなど）に使う context を返す
+// ctx の値を引き継ぎ、1回目のシグナルではキャンセルされず、2回目のシグナルでキャンセルされる。
+// NotifyInterrupt 以外の ctx では context.WithoutCancel と同じ。
+func CleanupContext(ctx context.Context) context.Context {
+	cleanup, ok := ctx.Value(cleanupKey{}).(context.Context)
+	if !ok {
+		return context.WithoutCancel(ctx)
+	}
+	c, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+	if cleanup.Err() != nil {
+		cancel(context.Cause(cleanup))
+		return c
+	}
+	context.AfterFunc(cleanup, func() { cancel(context.Cause(cleanup)) })
+	return c
+}
+
+// Interrupted は ctx がシグナルで中断されたかを返す
+func Interrupted(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrInterrupted)
+}
+
+// InterruptedError は ctx がシグナルで中断されていれば err を ErrInterrupted に置き換える
+// 中断で失敗した HTTP リクエストの "context canceled" をそのまま表示しないために使う。
+func InterruptedError(ctx context.Context, err error) error {
+	if err == nil || !Interrupted(ctx) || errors.Is(err, ErrInterrupted) {
+		return err
+	}
+	return ErrInterrupted
+}
+
+// PartialResult は自動ページネーションなどが中断された場合に、取得済みの結果を使うかを判定する
+// 中断されていれば取得済みの件数を stderr に表示して true を返す。
+// 呼び出し側は取得済みの結果を出力したあと ErrInterrupted を返す。
+func PartialResult(ctx context.Context, err error, fetched int, unit string) bool {
+	if err == nil || !Interrupted(ctx) {
+		return false
+	}
+	if !IsQuiet() {
+		ui.Warning("Interrupted: showing %d %s fetched so far", fetched, unit)
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package cmdutil
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNotifyInterrupt(t *testing.T) {
+	ctx, stop := NotifyInterrupt(context.Background())
+	defer stop()
+	cleanup := CleanupContext(ctx)
+
+	self, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := self.Signal(os.Interrupt); err != nil {
+		t.Skipf("cannot send os.Interrupt on this platform: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("context was not canceled by the signal")
+	}
+	if !Interrupted(ctx) {
+		t.Errorf("Interrupted = false, cause = %v", context.Cause(ctx))
+	}
+	if cleanup.Err() != nil {
+		t.Fatal("cleanup context should survive the first signal")
+	}
+
+	// 2回目のシグナルではプロセスを終了せず、後始末の context をキャンセルする
+	if err := self.Signal(os.Interrupt); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-cleanup.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("second signal did not cancel the cleanup context")
+	}
+	if !Interrupted(cleanup) {
+		t.Errorf("cleanup cause = %v, want ErrInterrupted", context.Cause(cleanup))
+	}
+}
+
+func TestCleanupContextWithoutNotify(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cleanup := CleanupContext(ctx)
+	cancel()
+	if cleanup.Err() != nil {
+		t.Error("CleanupContext() should not be canceled with its parent")
+	}
+}
+
+func TestNotifyInterruptStop(t *testing.T) {
+	ctx, stop := NotifyInterrupt(context.Background())
+	stop()
+	if ctx.Err() == nil {
+		t.Fatal("context is not canceled after stop")
+	}
+	if Interrupted(ctx) {
+		t.Error("Interrupted = true after stop, want false")
+	}
+	if CleanupContext(ctx).Err() == nil {
+		t.Error("cleanup context is not canceled after stop")
+	}
+}
+
+func TestInterruptedError(t *testing.T) {
+	apiErr := errors.New("Get \"https://example.backlog.jp/api/v2/issues\": context canceled")
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	if got := InterruptedError(ctx, apiErr); got != apiErr {
+		t.Errorf("not interrupted: got %v, want the original error", got)
+	}
+	cancel(ErrInterrupted)
+	if got := InterruptedError(ctx, apiErr); !errors.Is(got, ErrInterrupted) {
+		t.Errorf("interrupted: got %v, want ErrInterrupted", got)
+	}
+	if got := InterruptedError(ctx, nil); got != nil {
+		t.Errorf("nil error: got %v, want nil", got)
+	}
+	if !PartialResult(ctx, apiErr, 3, "issues") {
+		t.Error("PartialResult = false, want true")
+	}
+	if PartialResult(ctx, nil, 3, "issues") {
+		t.Error("PartialResult without error = true, want false")
+	}
+}
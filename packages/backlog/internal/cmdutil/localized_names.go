@@ -0,0 +1,70 @@
+package cmdutil
+
+import "strings"
+
+// Equivalent names of Backlog's built-in statuses, priorities and issue types.
+// Spaces show these names in their display language (e.g. "処理中" in Japanese
+// spaces, "In Progress" in English spaces), so each group lists the Japanese
+// name, the English name, and common shorthands. Any name in a group resolves
+// to whichever one the space actually uses, which keeps scripts portable.
+var (
+	statusNameGroups = [][]string{
+		{"未対応", "Open", "To Do", "todo", "new"},
+		{"処理中", "In Progress", "in-progress", "wip", "doing"},
+		{"処理済み", "Resolved", "fixed"},
+		{"完了", "Closed", "Done", "close", "complete", "completed"},
+	}
+	priorityNameGroups = [][]string{
+		{"高", "High"},
+		{"中", "Normal", "Medium"},
+		{"低", "Low"},
+	}
+	issueTypeNameGroups = [][]string{
+		{"バグ", "Bug"},
+		{"タスク", "Task"},
+		{"要望", "Request", "Feature", "Enhancement"},
+		{"その他", "Other"},
+		{"障害対応", "Incident"},
+	}
+)
+
+// localizedAliases returns the other names in the group that contains name.
+func localizedAliases(groups [][]string, name string) []string {
+	for _, group := range groups {
+		if !containsFold(group, name) {
+			continue
+		}
+		aliases := make([]string, 0, len(group)-1)
+		for _, alias := range group {
+			if !strings.EqualFold(alias, name) {
+				aliases = append(aliases, alias)
+			}
+		}
+		return aliases
+	}
+	return nil
+}
+
+// StatusAliases returns the equivalent names of a built-in status name.
+func StatusAliases(name string) []string {
+	return localizedAliases(statusNameGroups, name)
+}
+
+// IsOpenStatusName reports whether name is the built-in "Open" status in any language.
+func IsOpenStatusName(name string) bool {
+	return containsFold(statusNameGroups[0], name)
+}
+
+// IsClosedStatusName reports whether name is the built-in "Closed" status in any language.
+func IsClosedStatusName(name string) bool {
+	return containsFold(statusNameGroups[len(statusNameGroups)-1], name)
+}
+
+func containsFold(values []string, name string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, name) {
+			return true
+		}
+	}
+	return false
+}
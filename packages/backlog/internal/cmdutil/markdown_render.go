@@ -11,11 +11,11 @@ import (
 
 // RenderMarkdownContent converts content and optionally prints warnings and caches.
 func RenderMarkdownContent(content string, opts MarkdownViewOptions, itemType string, itemID int, parentID int, projectKey string, itemKey string, url string, attachments []string, warnWriter io.Writer) (string, error) {
-	unsafeRules := map[markdown.RuleID]bool{}
-	for _, rule := range opts.UnsafeRules {
-		unsafeRules[markdown.RuleID(rule)] = true
+	profile, err := markdown.ResolveRuleProfile(opts.ConversionProfile, opts.UnsafeRules)
+	if err != nil {
+		return content, err
 	}
-	result := markdown.Convert(content, markdown.ConvertOptions{
+	convertOpts := markdown.ConvertOptions{
 		ItemType:        itemType,
 		ItemID:          itemID,
 		ParentID:        parentID,
@@ -23,8 +23,9 @@ func RenderMarkdownContent(content string, opts MarkdownViewOptions, itemType st
 		ItemKey:         itemKey,
 		URL:             url,
 		AttachmentNames: attachments,
-		UnsafeRules:     unsafeRules,
-	})
+	}
+	profile.Apply(&convertOpts)
+	result := markdown.Convert(content, convertOpts)
 
 	output := result.Output
 	if opts.Warn {
@@ -15,6 +15,8 @@ type MarkdownViewOptions struct {
 	CacheExcerpt int
 	CacheDir     string
 	UnsafeRules  []string
+	// ConversionProfile は変換ルールのプリセット名（空なら UnsafeRules を使う）
+	ConversionProfile string
 }
 
 // ResolveMarkdownViewOptions resolves markdown view flags and config.
@@ -27,6 +29,8 @@ func ResolveMarkdownViewOptions(cmd *cobra.Command, display *config.ResolvedDisp
 		CacheExcerpt: display.MarkdownCacheExcerpt,
 		CacheDir:     cacheDir,
 		UnsafeRules:  display.MarkdownUnsafeRules,
+
+		ConversionProfile: display.MarkdownConversionProfile,
 	}
 
 	if cmd.Flags().Changed("markdown") {
@@ -50,6 +54,12 @@ func ResolveMarkdownViewOptions(cmd *cobra.Command, display *config.ResolvedDisp
 		}
 	}
 
+	if cmd.Flags().Changed("conversion-profile") {
+		if v, err := cmd.Flags().GetString("conversion-profile"); err == nil {
+			opts.ConversionProfile = v
+		}
+	}
+
 	if opts.Raw {
 		opts.Enable = false
 	}
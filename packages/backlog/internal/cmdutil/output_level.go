@@ -0,0 +1,136 @@
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// OutputLevel は成功時のメッセージ・追加情報・進捗表示の出力量
+type OutputLevel int
+
+const (
+	// OutputQuiet は成功時にキー/ID だけを出力し、進捗表示を抑制する（--quiet）
+	OutputQuiet OutputLevel = -1
+	// OutputNormal は通常の出力
+	OutputNormal OutputLevel = 0
+	// OutputVerbose は追加情報も stderr に出力する（--verbose）
+	OutputVerbose OutputLevel = 1
+)
+
+var outputLevel = OutputNormal
+
+// outputLevelFlagAnnotation は AddOutputLevelFlags が登録したフラグに付ける注釈
+// 同名の既存フラグ（auth status --quiet など）と区別するために使う
+const outputLevelFlagAnnotation = "backlog/output-level"
+
+// AddOutputLevelFlags は実行可能な全サブコマンドに --quiet / --verbose を登録する
+// ルートの永続フラグにすると --query (-q) などの既存の短縮形と衝突するため、コマンドごとに登録し、
+// 短縮形が使われているコマンドでは長い形式だけを登録する。
+// 同名のフラグを持つコマンド（auth status --quiet など）は既存の定義をそのまま使い、出力レベルには反映しない
+func AddOutputLevelFlags(root *cobra.Command) {
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		if c != root && c.Runnable() {
+			addOutputLevelFlag(c, "quiet", "q", "Print only keys/IDs on success and hide progress output")
+			addOutputLevelFlag(c, "verbose", "v", "Print additional information to stderr")
+		}
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(root)
+}
+
+func addOutputLevelFlag(c *cobra.Command, name, shorthand, usage string) {
+	flags := c.Flags()
+	if flags.Lookup(name) != nil {
+		return
+	}
+	if flags.ShorthandLookup(shorthand) != nil {
+		shorthand = ""
+	}
+	flags.BoolP(name, shorthand, false, usage)
+	_ = flags.SetAnnotation(name, outputLevelFlagAnnotation, []string{"true"})
+}
+
+// outputLevelFlag は AddOutputLevelFlags が登録した name のフラグの値を返す
+// コマンド固有の同名フラグは出力レベルのフラグとして扱わない
+func outputLevelFlag(cmd *cobra.Command, name string) (bool, error) {
+	f := cmd.Flags().Lookup(name)
+	if f == nil || f.Annotations[outputLevelFlagAnnotation] == nil {
+		return false, nil
+	}
+	return cmd.Flags().GetBool(name)
+}
+
+// ApplyOutputLevel はコマンドの --quiet / --verbose を出力レベルに反映する
+func ApplyOutputLevel(cmd *cobra.Command) error {
+	quiet, err := outputLevelFlag(cmd, "quiet")
+	if err != nil {
+		return err
+	}
+	verbose, err := outputLevelFlag(cmd, "verbose")
+	if err != nil {
+		return err
+	}
+	if quiet && verbose {
+		return fmt.Errorf("cannot use --quiet and --verbose together")
+	}
+	switch {
+	case quiet:
+		SetOutputLevel(OutputQuiet)
+	case verbose:
+		SetOutputLevel(OutputVerbose)
+	default:
+		SetOutputLevel(OutputNormal)
+	}
+	return nil
+}
+
+// SetOutputLevel は出力レベルを設定する
+// quiet ではスピナーやプログレスバーも表示しない
+func SetOutputLevel(level OutputLevel) {
+	outputLevel = level
+	ui.SetProgressEnabled(level != OutputQuiet)
+}
+
+// IsQuiet は --quiet が指定されているかを返す
+func IsQuiet() bool {
+	return outputLevel == OutputQuiet
+}
+
+// IsVerbose は --verbose が指定されているかを返す
+func IsVerbose() bool {
+	return outputLevel == OutputVerbose
+}
+
+// Success は成功メッセージを出力する
+// quiet では id だけを出力する（空なら何も出力しない）。スクリプトで作成した課題キーなどを受け取るため
+func Success(id, format string, args ...any) {
+	if IsQuiet() {
+		if id != "" {
+			fmt.Println(id)
+		}
+		return
+	}
+	ui.Success(format, args...)
+}
+
+// Progressf は一括処理の1件ごとの進捗行を stderr に出力する（quiet では出力しない）
+func Progressf(format string, args ...any) {
+	if IsQuiet() {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+// Verbosef は --verbose の場合だけ追加情報を stderr に出力する
+func Verbosef(format string, args ...any) {
+	if !IsVerbose() {
+		return
+	}
+	fmt.Fprintln(os.Stderr, ui.Gray(fmt.Sprintf(format, args...)))
+}
@@ -0,0 +1,83 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestAddOutputLevelFlags(t *testing.T) {
+	root := &cobra.Command{Use: "backlog"}
+	plain := &cobra.Command{Use: "create", Run: func(*cobra.Command, []string) {}}
+	query := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}}
+	query.Flags().StringP("query", "q", "", "")
+	existing := &cobra.Command{Use: "status", Run: func(*cobra.Command, []string) {}}
+	existing.Flags().BoolP("quiet", "q", false, "existing")
+	group := &cobra.Command{Use: "issue"}
+	group.AddCommand(plain, query)
+	root.AddCommand(group, existing)
+
+	AddOutputLevelFlags(root)
+
+	if f := plain.Flags().Lookup("quiet"); f == nil || f.Shorthand != "q" {
+		t.Errorf("create --quiet = %+v, want shorthand q", f)
+	}
+	if f := plain.Flags().Lookup("verbose"); f == nil || f.Shorthand != "v" {
+		t.Errorf("create --verbose = %+v, want shorthand v", f)
+	}
+	if f := query.Flags().Lookup("quiet"); f == nil || f.Shorthand != "" {
+		t.Errorf("list --quiet = %+v, want no shorthand", f)
+	}
+	if f := existing.Flags().Lookup("quiet"); f == nil || f.Usage != "existing" {
+		t.Errorf("status --quiet was replaced: %+v", f)
+	}
+	if group.Flags().Lookup("quiet") != nil || root.Flags().Lookup("quiet") != nil {
+		t.Error("non-runnable commands should not get --quiet")
+	}
+}
+
+func TestApplyOutputLevel(t *testing.T) {
+	t.Cleanup(func() { SetOutputLevel(OutputNormal) })
+
+	newCmd := func(args ...string) *cobra.Command {
+		c := &cobra.Command{Use: "create", Run: func(*cobra.Command, []string) {}}
+		root := &cobra.Command{Use: "backlog"}
+		root.AddCommand(c)
+		AddOutputLevelFlags(root)
+		if err := c.Flags().Parse(args); err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	if err := ApplyOutputLevel(newCmd("--quiet")); err != nil || !IsQuiet() {
+		t.Errorf("--quiet: err = %v, quiet = %v", err, IsQuiet())
+	}
+	if err := ApplyOutputLevel(newCmd("--verbose")); err != nil || !IsVerbose() {
+		t.Errorf("--verbose: err = %v, verbose = %v", err, IsVerbose())
+	}
+	if err := ApplyOutputLevel(newCmd()); err != nil || IsQuiet() || IsVerbose() {
+		t.Errorf("default: err = %v, quiet = %v, verbose = %v", err, IsQuiet(), IsVerbose())
+	}
+	if err := ApplyOutputLevel(newCmd("--quiet", "--verbose")); err == nil {
+		t.Error("expected error for --quiet with --verbose")
+	}
+}
+
+func TestApplyOutputLevelIgnoresCommandFlags(t *testing.T) {
+	t.Cleanup(func() { SetOutputLevel(OutputNormal) })
+
+	root := &cobra.Command{Use: "backlog"}
+	status := &cobra.Command{Use: "status", Run: func(*cobra.Command, []string) {}}
+	status.Flags().BoolP("quiet", "q", false, "Exit with status only")
+	status.Flags().String("verbose", "", "Detail level")
+	root.AddCommand(status)
+	AddOutputLevelFlags(root)
+
+	if err := status.Flags().Parse([]string{"--quiet", "--verbose", "full"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ApplyOutputLevel(status); err != nil || IsQuiet() || IsVerbose() {
+		t.Errorf("command flags: err = %v, quiet = %v, verbose = %v", err, IsQuiet(), IsVerbose())
+	}
+}
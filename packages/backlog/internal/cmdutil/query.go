@@ -0,0 +1,65 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/query"
+)
+
+// ApplyQueryValues は --query の key:value を既存フラグの変数にカンマ区切りで反映する
+// 既存フラグと併用できるよう、同じ条件をフラグでも指定した場合のみエラーにする
+func ApplyQueryValues(c *cobra.Command, q *query.Query, key, flag string, target *string) error {
+	values, err := q.Values(key)
+	if err != nil {
+		return fmt.Errorf("invalid --query: %w", err)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	if c.Flags().Changed(flag) {
+		return queryConflictError(flag, key)
+	}
+	*target = strings.Join(values, ",")
+	return nil
+}
+
+// ApplyQueryDateRange は --query の日付条件（due:<7d など）を since/until フラグの変数に反映する
+func ApplyQueryDateRange(c *cobra.Command, q *query.Query, key string, now time.Time, sinceFlag string, since *string, untilFlag string, until *string) error {
+	from, to, err := q.DateRange(key, now)
+	if err != nil {
+		return fmt.Errorf("invalid --query: %w", err)
+	}
+	if from != "" {
+		if c.Flags().Changed(sinceFlag) {
+			return queryConflictError(sinceFlag, key)
+		}
+		*since = from
+	}
+	if to != "" {
+		if c.Flags().Changed(untilFlag) {
+			return queryConflictError(untilFlag, key)
+		}
+		*until = to
+	}
+	return nil
+}
+
+// ApplyQueryKeyword は --query のキーワード部分を検索フラグの変数に反映する
+func ApplyQueryKeyword(c *cobra.Command, q *query.Query, flag string, target *string) error {
+	keyword := q.Keyword()
+	if keyword == "" {
+		return nil
+	}
+	if c.Flags().Changed(flag) {
+		return fmt.Errorf("--%s cannot be combined with keywords in --query", flag)
+	}
+	*target = keyword
+	return nil
+}
+
+func queryConflictError(flag, key string) error {
+	return fmt.Errorf("--%s cannot be combined with %s: in --query", flag, key)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
 )
@@ -26,7 +27,7 @@ func (o NamedResolverOption) displayText() string {
 }
 
 // ResolveNamedID resolves a single ID, exact name, alias, or fuzzy match.
-// Resolution order: exact match → prefix match → contains match.
+// Resolution order: exact match (label, then alias) → prefix match → contains match.
 // If exactly one candidate matches at any stage, it is used.
 // If multiple candidates match, they are presented as suggestions.
 func ResolveNamedID(input, singular, plural string, options []NamedResolverOption) (int, error) {
@@ -40,16 +41,21 @@ func ResolveNamedID(input, singular, plural string, options []NamedResolverOptio
 	}
 
 	// Stage 1: exact match (case-insensitive)
+	// A label match wins over alias matches so that a custom status named
+	// "Done" is preferred to the built-in status whose alias is "done".
 	var exactMatches []NamedResolverOption
 	for _, option := range options {
 		if strings.EqualFold(option.Label, value) {
 			exactMatches = append(exactMatches, option)
-			continue
 		}
-		for _, alias := range option.Aliases {
-			if strings.EqualFold(alias, value) {
-				exactMatches = append(exactMatches, option)
-				break
+	}
+	if len(exactMatches) == 0 {
+		for _, option := range options {
+			for _, alias := range option.Aliases {
+				if strings.EqualFold(alias, value) {
+					exactMatches = append(exactMatches, option)
+					break
+				}
 			}
 		}
 	}
@@ -256,15 +262,6 @@ func ResolveMilestone(ctx context.Context, client *api.Client, projectKey, input
 	return nil, nil
 }
 
-// issueTypeAliases maps Japanese issue type names to common English equivalents.
-var issueTypeAliases = map[string][]string{
-	"バグ":   {"bug"},
-	"タスク":  {"task"},
-	"要望":   {"feature", "enhancement", "request"},
-	"その他":  {"other"},
-	"障害対応": {"incident"},
-}
-
 // ResolveIssueTypeIDs resolves issue type IDs or exact names for a project.
 func ResolveIssueTypeIDs(ctx context.Context, client *api.Client, projectKey, input string) ([]int, error) {
 	if projectKey == "" && hasNonNumericToken(input) {
@@ -281,7 +278,7 @@ func ResolveIssueTypeIDs(ctx context.Context, client *api.Client, projectKey, in
 		options[i] = NamedResolverOption{
 			ID:      issueType.ID,
 			Label:   issueType.Name,
-			Aliases: issueTypeAliases[issueType.Name],
+			Aliases: localizedAliases(issueTypeNameGroups, issueType.Name),
 		}
 	}
 
@@ -300,7 +297,7 @@ func ResolveIssueType(ctx context.Context, client *api.Client, projectKey, input
 		options[i] = NamedResolverOption{
 			ID:      issueType.ID,
 			Label:   issueType.Name,
-			Aliases: issueTypeAliases[issueType.Name],
+			Aliases: localizedAliases(issueTypeNameGroups, issueType.Name),
 		}
 	}
 
@@ -331,19 +328,74 @@ func ResolveStatusIDs(ctx context.Context, client *api.Client, projectKey, input
 	options := make([]NamedResolverOption, len(statuses))
 	for i, status := range statuses {
 		options[i] = NamedResolverOption{
-			ID:    status.ID,
-			Label: status.Name,
+			ID:      status.ID,
+			Label:   status.Name,
+			Aliases: StatusAliases(status.Name),
 		}
 	}
 
 	return ResolveNamedIDs(input, "status", "statuses", options)
 }
 
-// priorityAliases maps Japanese priority names to common English equivalents.
-var priorityAliases = map[string][]string{
-	"高": {"high"},
-	"中": {"medium", "normal"},
-	"低": {"low"},
+// builtinClosedStatusID is the ID of Backlog's built-in "Closed" status.
+// Every project has it and it cannot be deleted, only renamed.
+const builtinClosedStatusID = 4
+
+// FindClosedStatusID returns the ID of the built-in "Closed" status.
+// It matches ID 4 first, then falls back to the built-in name, then to the last status.
+func FindClosedStatusID(statuses []api.Status) (int, error) {
+	for _, s := range statuses {
+		if s.ID == builtinClosedStatusID {
+			return s.ID, nil
+		}
+	}
+	for _, s := range statuses {
+		if IsClosedStatusName(s.Name) {
+			return s.ID, nil
+		}
+	}
+	if len(statuses) > 0 {
+		return statuses[len(statuses)-1].ID, nil
+	}
+	return 0, fmt.Errorf("could not find closed status")
+}
+
+// closedStatusKey identifies a project of a space; the client stands for the space.
+type closedStatusKey struct {
+	client  *api.Client
+	project string
+}
+
+// closedStatusIDs caches resolved closed status IDs for the lifetime of the process.
+var closedStatusIDs = struct {
+	sync.Mutex
+	ids map[closedStatusKey]int
+}{ids: make(map[closedStatusKey]int)}
+
+// ResolveClosedStatusID fetches the statuses of a project and returns its closed status ID.
+// Results are cached per project so repeated lookups don't refetch the statuses.
+func ResolveClosedStatusID(ctx context.Context, client *api.Client, projectKey string) (int, error) {
+	return cachedClosedStatusID(closedStatusKey{client: client, project: projectKey}, func() ([]api.Status, error) {
+		return client.GetStatuses(ctx, projectKey)
+	})
+}
+
+func cachedClosedStatusID(key closedStatusKey, fetch func() ([]api.Status, error)) (int, error) {
+	closedStatusIDs.Lock()
+	defer closedStatusIDs.Unlock()
+	if id, ok := closedStatusIDs.ids[key]; ok {
+		return id, nil
+	}
+	statuses, err := fetch()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get statuses: %w", err)
+	}
+	id, err := FindClosedStatusID(statuses)
+	if err != nil {
+		return 0, err
+	}
+	closedStatusIDs.ids[key] = id
+	return id, nil
 }
 
 // ResolvePriorityIDs resolves priority IDs or exact names (space-scoped).
@@ -358,7 +410,7 @@ func ResolvePriorityIDs(ctx context.Context, client *api.Client, input string) (
 		options[i] = NamedResolverOption{
 			ID:      priority.ID.Value,
 			Label:   priority.Name.Value,
-			Aliases: priorityAliases[priority.Name.Value],
+			Aliases: localizedAliases(priorityNameGroups, priority.Name.Value),
 		}
 	}
 
@@ -251,3 +251,112 @@ func TestNonInteractiveFlagError(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveNamedIDLocalizedStatusAliases(t *testing.T) {
+	ja := []NamedResolverOption{
+		{ID: 1, Label: "未対応", Aliases: StatusAliases("未対応")},
+		{ID: 2, Label: "処理中", Aliases: StatusAliases("処理中")},
+		{ID: 3, Label: "処理済み", Aliases: StatusAliases("処理済み")},
+		{ID: 4, Label: "完了", Aliases: StatusAliases("完了")},
+	}
+	en := []NamedResolverOption{
+		{ID: 1, Label: "Open", Aliases: StatusAliases("Open")},
+		{ID: 2, Label: "In Progress", Aliases: StatusAliases("In Progress")},
+		{ID: 3, Label: "Resolved", Aliases: StatusAliases("Resolved")},
+		{ID: 4, Label: "Closed", Aliases: StatusAliases("Closed")},
+	}
+
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"In Progress", 2},
+		{"wip", 2},
+		{"処理中", 2},
+		{"open", 1},
+		{"close", 4},
+		{"完了", 4},
+		{"resolved", 3},
+	}
+	for _, tt := range tests {
+		for name, options := range map[string][]NamedResolverOption{"ja": ja, "en": en} {
+			got, err := ResolveNamedID(tt.input, "status", "statuses", options)
+			if err != nil {
+				t.Errorf("%s: ResolveNamedID(%q) error = %v", name, tt.input, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s: ResolveNamedID(%q) = %d, want %d", name, tt.input, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestResolveNamedIDPrefersLabelOverAlias(t *testing.T) {
+	options := []NamedResolverOption{
+		{ID: 4, Label: "完了", Aliases: StatusAliases("完了")},
+		{ID: 5, Label: "Done"},
+	}
+
+	id, err := ResolveNamedID("done", "status", "statuses", options)
+	if err != nil {
+		t.Fatalf("ResolveNamedID returned error: %v", err)
+	}
+	if id != 5 {
+		t.Fatalf("id = %d, want %d", id, 5)
+	}
+}
+
+func TestLocalizedAliases(t *testing.T) {
+	if got := localizedAliases(priorityNameGroups, "Normal"); !containsFold(got, "中") || containsFold(got, "Normal") {
+		t.Errorf("priority aliases of Normal = %v", got)
+	}
+	if got := localizedAliases(issueTypeNameGroups, "バグ"); !containsFold(got, "bug") {
+		t.Errorf("issue type aliases of バグ = %v", got)
+	}
+	if got := StatusAliases("レビュー中"); got != nil {
+		t.Errorf("custom status aliases = %v, want nil", got)
+	}
+	if !IsClosedStatusName("closed") || IsClosedStatusName("処理済み") {
+		t.Error("IsClosedStatusName mismatch")
+	}
+}
+
+func TestFindClosedStatusID(t *testing.T) {
+	id, err := FindClosedStatusID([]api.Status{{ID: 1, Name: "未対応"}, {ID: 4, Name: "完了"}, {ID: 9, Name: "Archived"}})
+	if err != nil || id != 4 {
+		t.Errorf("FindClosedStatusID() = (%d, %v), want 4", id, err)
+	}
+	id, err = FindClosedStatusID([]api.Status{{ID: 1, Name: "Open"}, {ID: 7, Name: "Finished"}})
+	if err != nil || id != 7 {
+		t.Errorf("FindClosedStatusID() fallback = (%d, %v), want 7", id, err)
+	}
+	// 名前を変更・追加していても ID 4 を優先する
+	id, err = FindClosedStatusID([]api.Status{{ID: 4, Name: "Done"}, {ID: 8, Name: "Closed"}})
+	if err != nil || id != 4 {
+		t.Errorf("FindClosedStatusID() renamed = (%d, %v), want 4", id, err)
+	}
+	if _, err := FindClosedStatusID(nil); err == nil {
+		t.Error("expected error for empty statuses")
+	}
+}
+
+func TestCachedClosedStatusID(t *testing.T) {
+	calls := 0
+	fetch := func() ([]api.Status, error) {
+		calls++
+		return []api.Status{{ID: 1, Name: "Open"}, {ID: 4, Name: "Closed"}}, nil
+	}
+	key := closedStatusKey{project: "CACHE_TEST"}
+	for range 3 {
+		if id, err := cachedClosedStatusID(key, fetch); err != nil || id != 4 {
+			t.Fatalf("cachedClosedStatusID() = (%d, %v), want 4", id, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("statuses fetched %d times, want 1", calls)
+	}
+	if _, err := cachedClosedStatusID(closedStatusKey{project: "CACHE_TEST_OTHER"}, fetch); err != nil || calls != 2 {
+		t.Errorf("another project should be fetched separately: calls = %d, err = %v", calls, err)
+	}
+}
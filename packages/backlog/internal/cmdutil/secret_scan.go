@@ -0,0 +1,45 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/secretscan"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
+)
+
+// CheckSecrets は送信前の本文に秘密情報らしき文字列が含まれていないかを検査する
+// security.secret_scan が warn の場合は標準エラーに警告を出して続行し、
+// block の場合はエラーを返す。label はメッセージ中の対象名（例: "issue body"）。
+func CheckSecrets(cfg *config.Store, label, text string) error {
+	mode := secretscan.ParseMode(cfg.Security().SecretScan)
+	if mode == secretscan.ModeOff {
+		return nil
+	}
+
+	findings := secretscan.Scan(text)
+	if len(findings) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(findings))
+	for _, f := range findings {
+		lines = append(lines, fmt.Sprintf("  line %d: %s (%s)", f.Line, f.Rule, f.Match))
+	}
+
+	if mode == secretscan.ModeBlock {
+		msg := []string{fmt.Sprintf("possible secrets detected in %s:", label)}
+		msg = append(msg, lines...)
+		msg = append(msg, "", "Remove the secrets, or set security.secret_scan to warn/off to send anyway.")
+		return errors.New(strings.Join(msg, "\n"))
+	}
+
+	fmt.Fprintf(os.Stderr, "%s possible secrets detected in %s:\n", ui.Yellow("!"), label)
+	for _, l := range lines {
+		fmt.Fprintln(os.Stderr, l)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package cmdutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+// ShellQuote は値を POSIX シェルの単一引用符で囲む
+// 英数字と一部の記号だけからなる値はそのまま返す
+func ShellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	safe := true
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:@%+=,", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// cmdUnsafeChars は cmd.exe では二重引用符の中でも解釈される、または引用符を閉じてしまうため
+// 安全にクォートできない文字
+const cmdUnsafeChars = "%^&|<>\"!\r\n"
+
+// UnsafeShellValueError は cmd.exe で安全にクォートできない値を含むテンプレートのフィールドを表す
+type UnsafeShellValueError struct {
+	Fields []string
+}
+
+func (e *UnsafeShellValueError) Error() string {
+	return fmt.Sprintf("%s cannot be quoted safely for cmd.exe (contains one of %% ^ & | < > \" !); use the BACKLOG_* environment variables instead", strings.Join(e.Fields, ", "))
+}
+
+// ShellTemplateData はコマンドテンプレートに渡す文字列の値を RunShell のシェルの引数としてクォートする
+// POSIX シェルでは単一引用符で囲む。cmd.exe では単一引用符が効かず、% などは引用符の中でも
+// 解釈されるため、それらを含む値はデータに入れず名前を unsafe で返す（参照されたときだけエラーにする）
+func ShellTemplateData(values map[string]string) (data map[string]any, unsafe []string) {
+	return shellTemplateData(runtime.GOOS == "windows", values)
+}
+
+func shellTemplateData(cmdExe bool, values map[string]string) (map[string]any, []string) {
+	data := make(map[string]any, len(values))
+	var unsafe []string
+	for name, v := range values {
+		switch {
+		case !cmdExe:
+			data[name] = ShellQuote(v)
+		case strings.ContainsAny(v, cmdUnsafeChars):
+			unsafe = append(unsafe, name)
+		default:
+			data[name] = `"` + v + `"`
+		}
+	}
+	sort.Strings(unsafe)
+	return data, unsafe
+}
+
+// ExecuteShellTemplate はコマンドテンプレートを展開する
+// 安全にクォートできない値を参照している場合は、展開する前に *UnsafeShellValueError を返す
+func ExecuteShellTemplate(tmpl *template.Template, data map[string]any, unsafe []string) (string, error) {
+	if len(unsafe) > 0 {
+		refs := make(map[string]bool)
+		for _, t := range tmpl.Templates() {
+			if t.Tree != nil {
+				collectFieldRefs(t.Tree.Root, refs)
+			}
+		}
+		var used []string
+		for _, name := range unsafe {
+			if refs[name] {
+				used = append(used, name)
+			}
+		}
+		if len(used) > 0 {
+			return "", &UnsafeShellValueError{Fields: used}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// collectFieldRefs はテンプレートの構文木から参照しているフィールド名（.Name / $.Name の先頭）を集める
+// with や range でドットが変わった先の参照も含むが、安全側に倒すため区別しない
+func collectFieldRefs(node parse.Node, refs map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			collectFieldRefs(c, refs)
+		}
+	case *parse.ActionNode:
+		collectFieldRefs(n.Pipe, refs)
+	case *parse.IfNode:
+		collectBranchRefs(&n.BranchNode, refs)
+	case *parse.RangeNode:
+		collectBranchRefs(&n.BranchNode, refs)
+	case *parse.WithNode:
+		collectBranchRefs(&n.BranchNode, refs)
+	case *parse.TemplateNode:
+		collectFieldRefs(n.Pipe, refs)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Cmds {
+			collectFieldRefs(c, refs)
+		}
+	case *parse.CommandNode:
+		for _, a := range n.Args {
+			collectFieldRefs(a, refs)
+		}
+	case *parse.ChainNode:
+		collectFieldRefs(n.Node, refs)
+	case *parse.FieldNode:
+		if len(n.Ident) > 0 {
+			refs[n.Ident[0]] = true
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			refs[n.Ident[1]] = true
+		}
+	}
+}
+
+func collectBranchRefs(n *parse.BranchNode, refs map[string]bool) {
+	collectFieldRefs(n.Pipe, refs)
+	collectFieldRefs(n.List, refs)
+	collectFieldRefs(n.ElseList, refs)
+}
+
+// RunShell はコマンド文字列をシェル（Windows では cmd）で実行する
+// env は現在の環境変数に追加される。標準エラーは os.Stderr に出力する
+func RunShell(ctx context.Context, command string, env []string, stdout io.Writer) error {
+	return RunShellIO(ctx, command, env, nil, stdout, os.Stderr)
+}
+
+// RunShellIO は RunShell と同様にコマンドを実行し、標準入力と標準エラーの接続先も指定する
+// stdin が nil の場合は標準入力を空にする
+func RunShellIO(ctx context.Context, command string, env []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
@@ -0,0 +1,41 @@
+package cmdutil
+
+import (
+	"errors"
+	"testing"
+	"text/template"
+)
+
+func TestShellTemplateDataCmdExe(t *testing.T) {
+	data, unsafe := shellTemplateData(true, map[string]string{
+		"Key":     "PROJ-1",
+		"Summary": "it's & calc.exe",
+		"URL":     "https://example.backlog.jp/view/PROJ-1?a=%PATH%",
+	})
+	if data["Key"] != `"PROJ-1"` {
+		t.Errorf("Key = %v, want double-quoted", data["Key"])
+	}
+	if len(unsafe) != 2 || unsafe[0] != "Summary" || unsafe[1] != "URL" {
+		t.Fatalf("unsafe = %v, want [Summary URL]", unsafe)
+	}
+
+	tmpl := template.Must(template.New("hook").Option("missingkey=error").Parse("notify {{.Key}}"))
+	if got, err := ExecuteShellTemplate(tmpl, data, unsafe); err != nil || got != `notify "PROJ-1"` {
+		t.Errorf("ExecuteShellTemplate() = %q, %v", got, err)
+	}
+
+	// 安全にクォートできない値を参照するとコマンドを組み立てない
+	tmpl = template.Must(template.New("hook").Option("missingkey=error").Parse("notify {{.Key}} {{.Summary}}"))
+	got, err := ExecuteShellTemplate(tmpl, data, unsafe)
+	var unsafeErr *UnsafeShellValueError
+	if !errors.As(err, &unsafeErr) || len(unsafeErr.Fields) != 1 || unsafeErr.Fields[0] != "Summary" || got != "" {
+		t.Errorf("ExecuteShellTemplate() = %q, %v, want UnsafeShellValueError for Summary", got, err)
+	}
+
+	// 分岐の中や $ 経由の参照も検出し、参照されていないものは含めない
+	tmpl = template.Must(template.New("hook").Option("missingkey=error").Parse(`{{if .Key}}{{with $.URL}}{{.}}{{end}}{{end}}`))
+	got, err = ExecuteShellTemplate(tmpl, data, unsafe)
+	if !errors.As(err, &unsafeErr) || len(unsafeErr.Fields) != 1 || unsafeErr.Fields[0] != "URL" || got != "" {
+		t.Errorf("ExecuteShellTemplate() = %q, %v, want UnsafeShellValueError for URL", got, err)
+	}
+}
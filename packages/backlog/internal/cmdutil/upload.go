@@ -9,16 +9,22 @@ import (
 	"sync"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/ui"
 )
 
 // UploadFiles は複数ファイルを並行アップロードし、添付IDのスライスを返す
+// アップロード前に security.attachment のポリシーチェックを行う。
 // 入力順と同じ順序で結果を返す。いずれかのファイルが失敗した場合はエラーを返す。
-func UploadFiles(ctx context.Context, client *api.Client, filePaths []string) ([]int, error) {
+func UploadFiles(ctx context.Context, client *api.Client, cfg *config.Store, filePaths []string) ([]int, error) {
 	if len(filePaths) == 0 {
 		return nil, nil
 	}
 
+	if err := CheckAttachmentPolicy(ctx, AttachmentPolicy(cfg), filePaths); err != nil {
+		return nil, err
+	}
+
 	if len(filePaths) == 1 {
 		id, err := uploadSingleFile(ctx, client, filePaths[0])
 		if err != nil {
@@ -17,6 +17,8 @@ const (
 	AuthTypeOAuth AuthType = "oauth"
 	// AuthTypeAPIKey はAPI Key認証
 	AuthTypeAPIKey AuthType = "apikey"
+	// AuthTypeServiceToken は中継サーバーが発行するサービストークン認証（CI・bot 向け）
+	AuthTypeServiceToken AuthType = "service_token"
 )
 
 // CredentialBackend は認証情報の保存先バックエンド
@@ -46,7 +48,7 @@ func NormalizeCredentialBackend(value string) (CredentialBackend, error) {
 // 親の Credentials フィールドが jubako:"sensitive" なので、
 // センシティブでないフィールドは !sensitive でオプトアウトする
 type Credential struct {
-	// AuthType は認証タイプ（oauth / apikey）
+	// AuthType は認証タイプ（oauth / apikey / service_token）
 	// 空の場合は後方互換性のためoauthとして扱う
 	AuthType AuthType `yaml:"auth_type,omitempty" json:"auth_type,omitempty"`
 
@@ -58,12 +60,19 @@ type Credential struct {
 	// API Key認証用（センシティブ - 親から継承）
 	APIKey string `yaml:"api_key,omitempty" json:"api_key,omitempty" jubako:"sensitive" storage:"keyring"`
 
+	// サービストークン認証用（センシティブ - 親から継承）
+	// 中継サーバーで短命のアクセストークンに交換する。AccessToken / ExpiresAt に交換結果を保持する
+	ServiceToken string `yaml:"service_token,omitempty" json:"service_token,omitempty" jubako:"sensitive" storage:"keyring"`
+
 	// 共通（センシティブでない）
 	UserID    string `yaml:"user_id,omitempty" json:"user_id,omitempty"`
 	UserName  string `yaml:"user_name,omitempty" json:"user_name,omitempty"`
 	UserEmail string `yaml:"user_email,omitempty" json:"user_email,omitempty"`
 	Space     string `yaml:"space,omitempty" json:"space,omitempty"`
 	Domain    string `yaml:"domain,omitempty" json:"domain,omitempty"`
+
+	// 中継サーバーが記録するログインセッションの ID（auth sessions で現在の端末を示すのに使う）
+	SessionID string `yaml:"session_id,omitempty" json:"session_id,omitempty"`
 }
 
 // GetAuthType は認証タイプを返す（後方互換性対応）
@@ -7,6 +7,7 @@ import (
 // レイヤー名定数（後方互換用）
 const (
 	LayerDefaults       = "defaults"
+	LayerOrg            = "org"
 	LayerUser           = "user"
 	LayerProject        = "project"
 	LayerEnv            = "env"
@@ -0,0 +1,292 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"filippo.io/age"
+	"github.com/yacchi/jubako/source"
+	"github.com/yacchi/jubako/types"
+)
+
+// CredentialEncryption は credentials.yaml のファイル暗号化方式
+type CredentialEncryption string
+
+const (
+	CredentialEncryptionNone CredentialEncryption = "none"
+	CredentialEncryptionAge  CredentialEncryption = "age"
+)
+
+// NormalizeCredentialEncryption は暗号化方式を正規化する
+func NormalizeCredentialEncryption(value string) (CredentialEncryption, error) {
+	switch CredentialEncryption(strings.ToLower(strings.TrimSpace(value))) {
+	case "", CredentialEncryptionNone:
+		return CredentialEncryptionNone, nil
+	case CredentialEncryptionAge:
+		return CredentialEncryptionAge, nil
+	default:
+		return "", fmt.Errorf("unsupported credential encryption %q (must be none or age)", value)
+	}
+}
+
+const (
+	// EnvCredentialAgeIdentity は age identity（AGE-SECRET-KEY-1...）または
+	// identity ファイルのパスを指定する環境変数
+	EnvCredentialAgeIdentity = "BACKLOG_CREDENTIAL_AGE_IDENTITY"
+
+	// credentialAgeIdentityRef は keyring に保存する age identity の参照名
+	credentialAgeIdentityRef = "credential-encryption:age"
+)
+
+// credentialIdentityLoader は age identity を取得する
+// create が true で identity が見つからない場合は生成して keyring に保存する。
+type credentialIdentityLoader func(create bool) (*age.X25519Identity, error)
+
+// loadCredentialAgeIdentity は環境変数、keyring の順で age identity を探す
+func loadCredentialAgeIdentity(create bool) (*age.X25519Identity, error) {
+	if value := strings.TrimSpace(os.Getenv(EnvCredentialAgeIdentity)); value != "" {
+		if !strings.HasPrefix(strings.ToUpper(value), "AGE-SECRET-KEY-") {
+			data, err := os.ReadFile(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", EnvCredentialAgeIdentity, err)
+			}
+			value = string(data)
+		}
+		return parseAgeIdentity(value)
+	}
+
+	store := newCredentialSecretStore()
+	if store == nil || !store.IsAvailable() {
+		return nil, fmt.Errorf("age identity not found: set %s or enable an OS keyring", EnvCredentialAgeIdentity)
+	}
+	secret, err := store.Get(credentialAgeIdentityRef)
+	if err == nil {
+		return parseAgeIdentity(secret)
+	}
+	if !errors.Is(err, errCredentialSecretNotFound) {
+		return nil, fmt.Errorf("failed to read age identity from keyring: %w", err)
+	}
+	if !create {
+		return nil, fmt.Errorf("age identity not found in keyring: set %s", EnvCredentialAgeIdentity)
+	}
+
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate age identity: %w", err)
+	}
+	meta := credentialSecretMetadata{ProfileName: credentialAgeIdentityRef}
+	if err := store.Set(credentialAgeIdentityRef, id.String(), meta); err != nil {
+		return nil, fmt.Errorf("failed to save age identity to keyring: %w", err)
+	}
+	return id, nil
+}
+
+// parseAgeIdentity は identity 文字列を解析する
+// age-keygen の鍵ファイルのようにコメント行を含んでいてもよいが、X25519 の identity に限る
+func parseAgeIdentity(value string) (*age.X25519Identity, error) {
+	identities, err := age.ParseIdentities(strings.NewReader(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid age identity: %w", err)
+	}
+	if len(identities) != 1 {
+		return nil, fmt.Errorf("invalid age identity: expected exactly one identity, got %d", len(identities))
+	}
+	id, ok := identities[0].(*age.X25519Identity)
+	if !ok {
+		return nil, fmt.Errorf("invalid age identity: only X25519 identities are supported")
+	}
+	return id, nil
+}
+
+// ageHeader は age 形式（バイナリ）のファイル先頭
+const ageHeader = "age-encryption.org/v1\n"
+
+func isAgeEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(ageHeader))
+}
+
+func ageEncrypt(plaintext []byte, id *age.X25519Identity) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, id.Recipient())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt credentials file: %w", err)
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		return nil, fmt.Errorf("failed to encrypt credentials file: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encrypt credentials file: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func ageDecrypt(data []byte, id *age.X25519Identity) ([]byte, error) {
+	r, err := age.Decrypt(bytes.NewReader(data), id)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// encryptedFileSource は credentials.yaml をファイル全体で暗号化する source ラッパー
+//
+// 読み込み時は age ヘッダーの有無で判定するため、設定に関係なく暗号化済み/平文のどちらのファイルも読める。
+// 暗号化済みのファイルは Unlock されるまで復号せず空として扱う。identity の取得（keyring へのアクセスを含む）を
+// クレデンシャルを実際に使うときまで遅らせ、identity が無くても auth login や config コマンドを動かせるようにする。
+// 書き込み時は暗号化が有効、または既存ファイルが暗号化済みの場合に暗号化する（設定を戻しても勝手に平文化しない）。
+type encryptedFileSource struct {
+	inner        source.Source
+	loadIdentity credentialIdentityLoader
+
+	mu        sync.Mutex
+	enabled   bool
+	encrypted bool
+	unlocked  bool
+	locked    bool
+	identity  *age.X25519Identity
+}
+
+var _ source.Source = (*encryptedFileSource)(nil)
+
+func newEncryptedFileSource(inner source.Source, loader credentialIdentityLoader) *encryptedFileSource {
+	return &encryptedFileSource{inner: inner, loadIdentity: loader}
+}
+
+// SetEnabled は書き込み時に暗号化するかどうかを設定する
+func (s *encryptedFileSource) SetEnabled(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enabled = enabled
+}
+
+// Unlock は以降の読み込みで暗号化済みのファイルを復号するようにする
+// 前回の読み込みで復号を見送っていた場合は true を返す（呼び出し側で読み直す）
+func (s *encryptedFileSource) Unlock() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.unlocked {
+		return false, nil
+	}
+	if s.locked {
+		if _, err := s.identityFor(false); err != nil {
+			return false, fmt.Errorf("credentials file is encrypted: %w", err)
+		}
+	}
+	s.unlocked = true
+	return s.locked, nil
+}
+
+func (s *encryptedFileSource) identityFor(create bool) (*age.X25519Identity, error) {
+	if s.identity != nil {
+		return s.identity, nil
+	}
+	id, err := s.loadIdentity(create)
+	if err != nil {
+		return nil, err
+	}
+	s.identity = id
+	return id, nil
+}
+
+func (s *encryptedFileSource) decode(data []byte) ([]byte, error) {
+	if !isAgeEncrypted(data) {
+		return data, nil
+	}
+	id, err := s.identityFor(false)
+	if err != nil {
+		return nil, fmt.Errorf("credentials file is encrypted: %w", err)
+	}
+	plain, err := ageDecrypt(data, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credentials file: %w", err)
+	}
+	return plain, nil
+}
+
+func (s *encryptedFileSource) Type() source.SourceType {
+	return s.inner.Type()
+}
+
+func (s *encryptedFileSource) Load(ctx context.Context) ([]byte, error) {
+	data, err := s.inner.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.encrypted = isAgeEncrypted(data)
+	s.locked = s.encrypted && !s.unlocked
+	if s.locked {
+		return []byte{}, nil
+	}
+	return s.decode(data)
+}
+
+func (s *encryptedFileSource) Save(ctx context.Context, updateFunc source.UpdateFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inner.Save(ctx, func(current []byte) ([]byte, error) {
+		wasEncrypted := isAgeEncrypted(current)
+		plain, err := s.decode(current)
+		if err != nil {
+			return nil, err
+		}
+		next, err := updateFunc(plain)
+		if err != nil {
+			return nil, err
+		}
+		if !s.enabled && !wasEncrypted {
+			s.encrypted = false
+			return next, nil
+		}
+		id, err := s.identityFor(true)
+		if err != nil {
+			return nil, err
+		}
+		s.encrypted = true
+		return ageEncrypt(next, id)
+	})
+}
+
+func (s *encryptedFileSource) CanSave() bool {
+	return s.inner.CanSave()
+}
+
+func (s *encryptedFileSource) CanNotExist() bool {
+	if nc, ok := s.inner.(source.NotExistCapable); ok {
+		return nc.CanNotExist()
+	}
+	return false
+}
+
+func (s *encryptedFileSource) FillDetails(d *types.Details) {
+	if f, ok := s.inner.(types.DetailsFiller); ok {
+		f.FillDetails(d)
+	}
+}
+
+// EncryptIfNeeded は暗号化が有効で既存ファイルが平文の場合に暗号化して書き戻す
+// ファイルが存在しない場合は何もしない。
+func (s *encryptedFileSource) EncryptIfNeeded(ctx context.Context) error {
+	s.mu.Lock()
+	needed := s.enabled && !s.encrypted
+	s.mu.Unlock()
+	if !needed {
+		return nil
+	}
+	if _, err := s.inner.Load(ctx); err != nil {
+		if errors.Is(err, source.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	return s.Save(ctx, func(current []byte) ([]byte, error) {
+		return current, nil
+	})
+}
@@ -0,0 +1,226 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+)
+
+func TestNormalizeCredentialEncryption(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    CredentialEncryption
+		wantErr bool
+	}{
+		{in: "", want: CredentialEncryptionNone},
+		{in: "none", want: CredentialEncryptionNone},
+		{in: " AGE ", want: CredentialEncryptionAge},
+		{in: "gpg", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeCredentialEncryption(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("NormalizeCredentialEncryption(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeCredentialEncryption(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCredentialEncryptionAgeRoundTrip(t *testing.T) {
+	mockStore := useMockCredentialSecretStore(t, true)
+	prepareCredentialStoreTest(t)
+	t.Setenv(EnvCredentialAgeIdentity, "")
+	t.Setenv("BACKLOG_CREDENTIAL_ENCRYPTION", "age")
+	t.Setenv("BACKLOG_AUTH_CREDENTIAL_BACKEND", "file")
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore() error = %v", err)
+	}
+	if err := store.LoadAll(t.Context()); err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	if err := store.SetCredential(DefaultProfile, &Credential{
+		AuthType:  AuthTypeAPIKey,
+		APIKey:    "encrypted-api-key",
+		Space:     "alice-space",
+		Domain:    "backlog.jp",
+		UserName:  "Alice",
+		UserEmail: "alice@example.com",
+	}); err != nil {
+		t.Fatalf("SetCredential() error = %v", err)
+	}
+	if err := store.Save(t.Context()); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	raw, err := os.ReadFile(store.GetCredentialsPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isAgeEncrypted(raw) {
+		t.Fatalf("credentials file is not encrypted:\n%s", raw)
+	}
+	if strings.Contains(string(raw), "encrypted-api-key") {
+		t.Fatal("credentials file contains plaintext secret")
+	}
+	if _, ok := mockStore.records[credentialAgeIdentityRef]; !ok {
+		t.Fatal("age identity was not stored in keyring")
+	}
+
+	// 暗号化を無効化しても既存の暗号化ファイルは読める
+	t.Setenv("BACKLOG_CREDENTIAL_ENCRYPTION", "none")
+	reloaded, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore() reload error = %v", err)
+	}
+	if err := reloaded.LoadAll(t.Context()); err != nil {
+		t.Fatalf("LoadAll() reload error = %v", err)
+	}
+	cred := reloaded.Credential(DefaultProfile)
+	if cred == nil || cred.APIKey != "encrypted-api-key" {
+		t.Fatalf("Credential() = %+v, want decrypted api key", cred)
+	}
+}
+
+func TestCredentialEncryptionMigratesPlaintextWithEnvIdentity(t *testing.T) {
+	useMockCredentialSecretStore(t, false)
+	xdgHome := prepareCredentialStoreTest(t)
+
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPath := filepath.Join(xdgHome, "age.key")
+	if err := os.WriteFile(keyPath, []byte("# test key\n"+id.String()+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvCredentialAgeIdentity, keyPath)
+
+	credPath := filepath.Join(xdgHome, "backlog", "credentials.yaml")
+	if err := os.MkdirAll(filepath.Dir(credPath), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	plain := "credential:\n  default:\n    auth_type: apikey\n    api_key: plain-api-key\n"
+	if err := os.WriteFile(credPath, []byte(plain), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("BACKLOG_CREDENTIAL_ENCRYPTION", "age")
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore() error = %v", err)
+	}
+	if store.GetCredentialsPath() != credPath {
+		t.Fatalf("credentials path = %q, want %q", store.GetCredentialsPath(), credPath)
+	}
+	if err := store.LoadAll(t.Context()); err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+
+	// 平文のファイルは、クレデンシャルを使う時点で暗号化して書き戻す
+	if raw, _ := os.ReadFile(credPath); string(raw) != plain {
+		t.Fatalf("LoadAll() should not rewrite the credentials file:\n%s", raw)
+	}
+	if cred := store.Credential(DefaultProfile); cred == nil || cred.APIKey != "plain-api-key" {
+		t.Fatalf("Credential() = %+v", cred)
+	}
+
+	raw, err := os.ReadFile(credPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := ageDecrypt(raw, id)
+	if err != nil {
+		t.Fatalf("credentials file was not encrypted with the env identity: %v", err)
+	}
+	if string(decrypted) != plain {
+		t.Errorf("decrypted content = %q, want %q", decrypted, plain)
+	}
+}
+
+func TestCredentialEncryptionMissingIdentityIsDeferred(t *testing.T) {
+	useMockCredentialSecretStore(t, false)
+	xdgHome := prepareCredentialStoreTest(t)
+
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := ageEncrypt([]byte("credential:\n  default:\n    auth_type: apikey\n    api_key: secret-api-key\n"), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credPath := filepath.Join(xdgHome, "backlog", "credentials.yaml")
+	if err := os.MkdirAll(filepath.Dir(credPath), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(credPath, encrypted, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvCredentialAgeIdentity, "")
+
+	// identity が無くても設定の読み書き（config コマンド）はできる
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore() error = %v", err)
+	}
+	if err := store.LoadAll(t.Context()); err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	if err := store.Set("credential.encryption", "age"); err != nil {
+		t.Fatalf("Set(credential.encryption) error = %v", err)
+	}
+	if got := store.Get("credential.encryption"); got != "age" {
+		t.Errorf("Get(credential.encryption) = %v, want age", got)
+	}
+	if got := store.Resolved().CredentialEncryption; got != "age" {
+		t.Errorf("Resolved().CredentialEncryption = %q, want age", got)
+	}
+
+	// クレデンシャルを使う時点で復号できないことを報告する
+	if cred := store.Credential(DefaultProfile); cred != nil {
+		t.Fatalf("Credential() = %+v, want nil without identity", cred)
+	}
+	if err := store.CredentialError(); err == nil || !strings.Contains(err.Error(), EnvCredentialAgeIdentity) {
+		t.Fatalf("CredentialError() = %v, want missing identity", err)
+	}
+	if err := store.SetCredential(DefaultProfile, &Credential{AuthType: AuthTypeAPIKey, APIKey: "other"}); err == nil {
+		t.Error("SetCredential() should fail instead of overwriting the encrypted file")
+	}
+
+	// identity を渡せば同じファイルを復号できる
+	t.Setenv(EnvCredentialAgeIdentity, id.String())
+	reloaded, err := newConfigStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reloaded.LoadAll(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if cred := reloaded.Credential(DefaultProfile); cred == nil || cred.APIKey != "secret-api-key" {
+		t.Fatalf("Credential() = %+v, want decrypted api key", cred)
+	}
+}
+
+func TestCredentialEncryptionInvalidValueIsDeferred(t *testing.T) {
+	useMockCredentialSecretStore(t, false)
+	prepareCredentialStoreTest(t)
+	t.Setenv("BACKLOG_CREDENTIAL_ENCRYPTION", "gpg")
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore() error = %v", err)
+	}
+	if err := store.LoadAll(t.Context()); err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	if err := store.CredentialError(); err == nil || !strings.Contains(err.Error(), "credential.encryption") {
+		t.Errorf("CredentialError() = %v, want invalid credential.encryption", err)
+	}
+}
@@ -19,16 +19,20 @@ const (
 	credentialRefPath           = "/secret_ref"
 )
 
-func newCredentialLayer(credentialsPath string) (layer.Layer, error) {
+func newCredentialLayer(credentialsPath string) (layer.Layer, *encryptedFileSource, error) {
+	fileSource := newEncryptedFileSource(
+		fs.New(credentialsPath, fs.WithFileMode(0600)),
+		loadCredentialAgeIdentity,
+	)
 	metadata := layer.New(
 		credentialMetadataLayerName,
-		fs.New(credentialsPath, fs.WithFileMode(0600)),
+		fileSource,
 		yaml.New(),
 	)
 	secretStore := newCredentialKeyringStore(newCredentialSecretStore())
 	keyringAvailable := secretStore.IsAvailable()
 
-	return externalstore.NewMap[*Credential](LayerCredentials, externalstore.MapConfig[*Credential]{
+	l, err := externalstore.NewMap[*Credential](LayerCredentials, externalstore.MapConfig[*Credential]{
 		RootPath:         PathCredential,
 		Metadata:         optionalLoadLayer{Layer: metadata},
 		External:         secretStore,
@@ -52,6 +56,7 @@ func newCredentialLayer(credentialsPath string) (layer.Layer, error) {
 			}, nil
 		},
 	})
+	return l, fileSource, err
 }
 
 func resolveCredentialBackend(logical map[string]any, keyringAvailable bool) (CredentialBackend, error) {
@@ -0,0 +1,73 @@
+package config
+
+import "os"
+
+// 環境変数で認証情報を直接指定する場合の変数名
+const (
+	EnvAPIKey       = "BACKLOG_API_KEY"
+	EnvAccessToken  = "BACKLOG_ACCESS_TOKEN"
+	EnvServiceToken = "BACKLOG_SERVICE_TOKEN"
+)
+
+// CredentialFromEnv は環境変数から認証情報を取得する。
+// BACKLOG_API_KEY > BACKLOG_ACCESS_TOKEN > BACKLOG_SERVICE_TOKEN の優先順位。該当なしなら nil を返す。
+func CredentialFromEnv() *Credential {
+	if key := os.Getenv(EnvAPIKey); key != "" {
+		return &Credential{
+			AuthType: AuthTypeAPIKey,
+			APIKey:   key,
+		}
+	}
+	if token := os.Getenv(EnvAccessToken); token != "" {
+		return &Credential{
+			AuthType:    AuthTypeOAuth,
+			AccessToken: token,
+		}
+	}
+	if token := os.Getenv(EnvServiceToken); token != "" {
+		return &Credential{
+			AuthType:     AuthTypeServiceToken,
+			ServiceToken: token,
+		}
+	}
+	return nil
+}
+
+// CredentialEnvVar は認証情報を供給している環境変数名を返す（未設定なら空文字）
+func CredentialEnvVar() string {
+	switch {
+	case os.Getenv(EnvAPIKey) != "":
+		return EnvAPIKey
+	case os.Getenv(EnvAccessToken) != "":
+		return EnvAccessToken
+	case os.Getenv(EnvServiceToken) != "":
+		return EnvServiceToken
+	}
+	return ""
+}
+
+// IsEphemeral は環境変数だけで認証するステートレスモードかどうかを返す。
+// このモードでは API レスポンスのキャッシュなど、ディスクへの書き込みを行わない。
+// BACKLOG_CACHE_ENABLED / BACKLOG_CACHE_DIR を明示した場合はキャッシュを使う。
+func IsEphemeral() bool {
+	return CredentialEnvVar() != ""
+}
+
+// AllowDiskWrite は監査ログや出力の自動保存などのディスクへの書き込みを行ってよいかを返す。
+// ステートレスモードでは、envVars のいずれかで明示された場合だけ書き込む。
+func AllowDiskWrite(envVars ...string) bool {
+	if !IsEphemeral() {
+		return true
+	}
+	for _, name := range envVars {
+		if os.Getenv(name) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// CacheExplicitlyConfigured はキャッシュ設定が環境変数で明示されているかを返す
+func CacheExplicitlyConfigured() bool {
+	return os.Getenv("BACKLOG_CACHE_ENABLED") != "" || os.Getenv("BACKLOG_CACHE_DIR") != ""
+}
@@ -0,0 +1,65 @@
+package config
+
+import "testing"
+
+func TestCredentialEnvVar(t *testing.T) {
+	tests := []struct {
+		name         string
+		apiKey       string
+		accessToken  string
+		serviceToken string
+		want         string
+	}{
+		{name: "none", want: ""},
+		{name: "api key", apiKey: "key", want: EnvAPIKey},
+		{name: "access token", accessToken: "token", want: EnvAccessToken},
+		{name: "api key takes precedence", apiKey: "key", accessToken: "token", want: EnvAPIKey},
+		{name: "service token", serviceToken: "bst_id.secret", want: EnvServiceToken},
+		{name: "access token takes precedence over service token", accessToken: "token", serviceToken: "bst_id.secret", want: EnvAccessToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvAPIKey, tt.apiKey)
+			t.Setenv(EnvAccessToken, tt.accessToken)
+			t.Setenv(EnvServiceToken, tt.serviceToken)
+			if got := CredentialEnvVar(); got != tt.want {
+				t.Errorf("CredentialEnvVar() = %q, want %q", got, tt.want)
+			}
+			if got := IsEphemeral(); got != (tt.want != "") {
+				t.Errorf("IsEphemeral() = %v, want %v", got, tt.want != "")
+			}
+		})
+	}
+}
+
+func TestCacheExplicitlyConfigured(t *testing.T) {
+	t.Setenv("BACKLOG_CACHE_ENABLED", "")
+	t.Setenv("BACKLOG_CACHE_DIR", "")
+	if CacheExplicitlyConfigured() {
+		t.Error("expected false when no cache env is set")
+	}
+
+	t.Setenv("BACKLOG_CACHE_DIR", "/tmp/backlog-cache")
+	if !CacheExplicitlyConfigured() {
+		t.Error("expected true when BACKLOG_CACHE_DIR is set")
+	}
+}
+
+func TestAllowDiskWrite(t *testing.T) {
+	t.Setenv(EnvAPIKey, "")
+	t.Setenv(EnvAccessToken, "")
+	t.Setenv(EnvServiceToken, "")
+	t.Setenv("BACKLOG_DISPLAY_AUTO_SAVE_DIR", "")
+	if !AllowDiskWrite("BACKLOG_DISPLAY_AUTO_SAVE_DIR") {
+		t.Error("expected true outside the stateless mode")
+	}
+
+	t.Setenv(EnvAPIKey, "key")
+	if AllowDiskWrite() || AllowDiskWrite("BACKLOG_DISPLAY_AUTO_SAVE_DIR") {
+		t.Error("expected false in the stateless mode")
+	}
+	t.Setenv("BACKLOG_DISPLAY_AUTO_SAVE_DIR", "/tmp/backlog-outputs")
+	if !AllowDiskWrite("BACKLOG_DISPLAY_AUTO_SAVE_DIR") {
+		t.Error("expected true when the env var is set explicitly")
+	}
+}
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// relayBundleSettingsName はバンドルに同梱する組織推奨の CLI 設定のファイル名
+const relayBundleSettingsName = "settings.yaml"
+
+// orgDefaultsFileName は取り込んだ組織推奨設定の保存先ファイル名
+const orgDefaultsFileName = "org-defaults.yaml"
+
+// orgDefaultsBundlePrefix は組織推奨設定ファイルの先頭に書く取り込み元バンドルのコメント
+const orgDefaultsBundlePrefix = "# bundle: "
+
+// orgSettingsAllowedKeys は settings.yaml に書ける最上位のキー
+// 認証情報や中継サーバーの信頼設定（trusted_bundles など）は配布できないようにする
+var orgSettingsAllowedKeys = []string{"display", "profile"}
+
+// orgDefaultsPath は組織推奨設定の保存先を返す (~/.config/backlog/org-defaults.yaml)
+func orgDefaultsPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, orgDefaultsFileName), nil
+}
+
+// ValidateOrgSettings は settings.yaml を検証する
+// display と profile 以外の最上位キーや、マッピングでない値はエラーにする
+func ValidateOrgSettings(data []byte) error {
+	var settings map[string]any
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return fmt.Errorf("invalid %s: %w", relayBundleSettingsName, err)
+	}
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !slices.Contains(orgSettingsAllowedKeys, key) {
+			return fmt.Errorf("invalid %s: %q cannot be set (allowed: %s)", relayBundleSettingsName, key, strings.Join(orgSettingsAllowedKeys, ", "))
+		}
+		if _, ok := settings[key].(map[string]any); !ok {
+			return fmt.Errorf("invalid %s: %s must be a mapping", relayBundleSettingsName, key)
+		}
+	}
+	if profiles, ok := settings["profile"].(map[string]any); ok {
+		for name, p := range profiles {
+			if _, ok := p.(map[string]any); !ok {
+				return fmt.Errorf("invalid %s: profile.%s must be a mapping", relayBundleSettingsName, name)
+			}
+		}
+	}
+	return nil
+}
+
+// readRelayBundleSettings はバンドルに同梱された settings.yaml を取り出す（無ければ nil）
+func readRelayBundleSettings(files map[string][]byte, refs []RelayBundleFileRef) ([]byte, error) {
+	data, ok := files[relayBundleSettingsName]
+	if !ok {
+		return nil, nil
+	}
+	listed := false
+	for _, ref := range refs {
+		if ref.Name == relayBundleSettingsName {
+			listed = true
+			break
+		}
+	}
+	if !listed {
+		return nil, fmt.Errorf("%s must be listed in the manifest files", relayBundleSettingsName)
+	}
+	if err := ValidateOrgSettings(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// writeOrgDefaults はバンドルの settings.yaml を組織デフォルトレイヤーとして保存する
+// 取り込み元のバンドル名を先頭のコメントに記録する
+func writeOrgDefaults(path, bundleName string, settings []byte) error {
+	var buf bytes.Buffer
+	buf.WriteString(orgDefaultsBundlePrefix + bundleName + "\n")
+	buf.WriteString("# Managed by 'backlog config import'. Override these values in config.yaml.\n")
+	buf.Write(settings)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// readOrgDefaultsBundle は組織推奨設定の取り込み元バンドル名を返す（ファイルが無ければ空文字）
+func readOrgDefaultsBundle(path string) (string, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	if scanner.Scan() {
+		if name, ok := strings.CutPrefix(scanner.Text(), orgDefaultsBundlePrefix); ok {
+			return strings.TrimSpace(name), nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+// applyOrgDefaults はバンドルの組織推奨設定を保存する
+// settings.yaml が無くなったバンドルを取り込み直した場合は、そのバンドル由来の設定を削除する
+func applyOrgDefaults(path, bundleName string, settings []byte) (bool, error) {
+	if settings != nil {
+		return true, writeOrgDefaults(path, bundleName, settings)
+	}
+	current, err := readOrgDefaultsBundle(path)
+	if err != nil {
+		return false, err
+	}
+	if current == bundleName {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return false, fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+	}
+	return false, nil
+}
+
+// OrgDefaultsPath は組織推奨設定ファイルのパスを返す（取り込まれていなければ空文字）
+func (s *Store) OrgDefaultsPath() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	info := s.store.GetLayerInfo(LayerOrg)
+	if info == nil {
+		return ""
+	}
+	if _, err := os.Stat(info.Path()); err != nil {
+		return ""
+	}
+	return info.Path()
+}
+
+// OrgDefaultsBundle は組織推奨設定の取り込み元バンドル名を返す（取り込まれていなければ空文字）
+func (s *Store) OrgDefaultsBundle() string {
+	path := s.OrgDefaultsPath()
+	if path == "" {
+		return ""
+	}
+	name, _ := readOrgDefaultsBundle(path)
+	return name
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateOrgSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "display and profile", data: "display:\n  timezone: Asia/Tokyo\nprofile:\n  default:\n    space: example.backlog.jp\n"},
+		{name: "empty", data: ""},
+		{name: "credentials", data: "credentials:\n  default:\n    api_key: secret\n", wantErr: `"credentials" cannot be set`},
+		{name: "trusted bundles", data: "trusted_bundles: []\n", wantErr: `"trusted_bundles" cannot be set`},
+		{name: "scalar display", data: "display: compact\n", wantErr: "display must be a mapping"},
+		{name: "scalar profile", data: "profile:\n  default: x\n", wantErr: "profile.default must be a mapping"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrgSettings([]byte(tt.data))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateOrgSettings() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateOrgSettings() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyOrgDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), orgDefaultsFileName)
+	settings := []byte("display:\n  timezone: Asia/Tokyo\n")
+
+	applied, err := applyOrgDefaults(path, "example", settings)
+	if err != nil || !applied {
+		t.Fatalf("applyOrgDefaults() = %v, %v", applied, err)
+	}
+	if name, err := readOrgDefaultsBundle(path); err != nil || name != "example" {
+		t.Fatalf("readOrgDefaultsBundle() = %q, %v", name, err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(data), string(settings)) {
+		t.Errorf("org defaults = %q, want settings appended", data)
+	}
+
+	// 別のバンドルに settings.yaml が無くても既存の組織デフォルトは消さない
+	if applied, err := applyOrgDefaults(path, "other", nil); err != nil || applied {
+		t.Fatalf("applyOrgDefaults(other) = %v, %v", applied, err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("org defaults removed by another bundle: %v", err)
+	}
+
+	// 同じバンドルから settings.yaml が無くなったら削除する
+	if _, err := applyOrgDefaults(path, "example", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("org defaults still exists: %v", err)
+	}
+	if name, err := readOrgDefaultsBundle(path); err != nil || name != "" {
+		t.Errorf("readOrgDefaultsBundle(missing) = %q, %v", name, err)
+	}
+}
+
+func TestReadRelayBundleSettings(t *testing.T) {
+	data := []byte("display:\n  timezone: Asia/Tokyo\n")
+	ref := RelayBundleFileRef{Name: relayBundleSettingsName}
+
+	got, err := readRelayBundleSettings(map[string][]byte{relayBundleSettingsName: data}, []RelayBundleFileRef{ref})
+	if err != nil || string(got) != string(data) {
+		t.Fatalf("readRelayBundleSettings() = %q, %v", got, err)
+	}
+	// 署名対象に含まれない設定は受け付けない
+	if _, err := readRelayBundleSettings(map[string][]byte{relayBundleSettingsName: data}, nil); err == nil {
+		t.Error("expected error for unlisted settings")
+	}
+	if got, err := readRelayBundleSettings(map[string][]byte{}, nil); got != nil || err != nil {
+		t.Errorf("no settings = %q, %v", got, err)
+	}
+}
@@ -52,13 +52,109 @@ func defaultCacheDir() (string, error) {
 	return filepath.Join(userCacheDir, AppName), nil
 }
 
+// dotPathAliases maps dot-separated keys whose JSON Pointer differs from the plain conversion.
+// "credential.<profile>" is reserved for credentials, so "credential.encryption" is stored at /credential_encryption.
+var dotPathAliases = map[string]string{
+	"credential.encryption": PathCredentialEncryption,
+}
+
 // DotToPointer converts a dot-separated path to a JSON Pointer.
 // If the path already starts with "/", it is returned as-is (already a JSON Pointer).
 // Example: "profile.default.space" -> "/profile/default/space"
 // Example: "/ai_summary/prompts/issue_list" -> "/ai_summary/prompts/issue_list"
+// Example: "credential.encryption" -> "/credential_encryption"
 func DotToPointer(dotPath string) string {
 	if strings.HasPrefix(dotPath, "/") {
 		return dotPath
 	}
+	if pointer, ok := dotPathAliases[dotPath]; ok {
+		return pointer
+	}
 	return "/" + strings.ReplaceAll(dotPath, ".", "/")
 }
+
+// RelaySigningKeyPath は中継サーバー署名鍵のデフォルト保存先を返す
+// (~/.config/backlog/relay-signing-{profile}.json)
+func RelaySigningKeyPath(profileName string) (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "relay-signing-"+profileName+".json"), nil
+}
+
+// OfflineQueuePath はオフライン時に保留した書き込み操作の保存先を返す
+// (~/.config/backlog/queue.json)
+// キャッシュの削除で保留中の操作が失われないよう、キャッシュディレクトリではなく設定ディレクトリに置く
+func OfflineQueuePath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "queue.json"), nil
+}
+
+// WebhookSecretsPath は Webhook シークレットのローテーション状態の保存先を返す
+// (~/.config/backlog/webhook-secrets.json)
+// 新旧併用期間中の旧シークレットを失わないよう、キャッシュディレクトリではなく設定ディレクトリに置く
+func WebhookSecretsPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "webhook-secrets.json"), nil
+}
+
+// stateDir はローカル状態の保存先ディレクトリを返す（~/.local/state/backlog）
+// XDG_STATE_HOME が設定されていればそれを優先する
+func stateDir() (string, error) {
+	if xdgStateHome := os.Getenv("XDG_STATE_HOME"); xdgStateHome != "" {
+		return filepath.Join(xdgStateHome, AppName), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".local", "state", AppName), nil
+}
+
+// DraftsDir はコメントの下書きの保存先を返す
+// (~/.local/state/backlog/drafts)
+// キャッシュの削除で書きかけの文章が失われないよう、キャッシュディレクトリではなく状態ディレクトリに置く
+func DraftsDir() (string, error) {
+	dir, err := stateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "drafts"), nil
+}
+
+// AuditLogPath は書き込み系コマンドの監査ログの既定の保存先を返す
+// (~/.local/state/backlog/audit.jsonl)
+func AuditLogPath() (string, error) {
+	dir, err := stateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "audit.jsonl"), nil
+}
+
+// InboxMutesPath は backlog inbox でミュートした課題の保存先を返す
+// (~/.local/state/backlog/inbox-mutes.json)
+func InboxMutesPath() (string, error) {
+	dir, err := stateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "inbox-mutes.json"), nil
+}
+
+// OutputHistoryPath は自動保存した出力の履歴の保存先を返す
+// (~/.local/state/backlog/outputs.jsonl)
+func OutputHistoryPath() (string, error) {
+	dir, err := stateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "outputs.jsonl"), nil
+}
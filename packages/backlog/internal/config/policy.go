@@ -0,0 +1,122 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// relayBundlePolicyName はバンドルに同梱するチームポリシーのファイル名
+const relayBundlePolicyName = "policy.yaml"
+
+// BundlePolicy はバンドルで配布するチームポリシー。
+// バンドルを参照するプロファイルで CLI を使うときに適用される。
+type BundlePolicy struct {
+	// DenyCommands は実行を禁止するコマンド（例: "issue delete"）。
+	// サブコマンドを省略すると配下のすべてのコマンドが対象になる（例: "repo"）
+	DenyCommands []string `json:"deny_commands,omitempty" yaml:"deny_commands,omitempty"`
+
+	// DenyFlags は指定を禁止するフラグ（例: "--auto", "issue comment-all --auto"）。
+	// フラグの前にコマンドを書くとそのコマンド配下に限定される
+	DenyFlags []string `json:"deny_flags,omitempty" yaml:"deny_flags,omitempty"`
+
+	// RequireAudit は書き込み系コマンドの監査ログ（security.audit）を強制する
+	RequireAudit bool `json:"require_audit,omitempty" yaml:"require_audit,omitempty"`
+}
+
+// ParseBundlePolicy は policy.yaml を解析する。未知のキーはタイプミスとみなしてエラーにする
+func ParseBundlePolicy(data []byte) (*BundlePolicy, error) {
+	var policy BundlePolicy
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", relayBundlePolicyName, err)
+	}
+	for _, rule := range policy.DenyCommands {
+		if len(strings.Fields(rule)) == 0 {
+			return nil, fmt.Errorf("invalid %s: deny_commands must not contain empty entries", relayBundlePolicyName)
+		}
+	}
+	for _, rule := range policy.DenyFlags {
+		if _, _, err := parseFlagRule(rule); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", relayBundlePolicyName, err)
+		}
+	}
+	return &policy, nil
+}
+
+// parseFlagRule は "[command ...] --flag" 形式のルールをコマンドとフラグ名に分ける
+func parseFlagRule(rule string) ([]string, string, error) {
+	fields := strings.Fields(rule)
+	if len(fields) == 0 {
+		return nil, "", errors.New("deny_flags must not contain empty entries")
+	}
+	flag := fields[len(fields)-1]
+	if !strings.HasPrefix(flag, "--") || len(flag) == 2 {
+		return nil, "", fmt.Errorf("deny_flags entry %q must end with a long flag (e.g. --auto)", rule)
+	}
+	return fields[:len(fields)-1], strings.TrimPrefix(flag, "--"), nil
+}
+
+// PolicyViolationError はチームポリシーで禁止された操作を表す
+type PolicyViolationError struct {
+	Message string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return e.Message + " by the team policy"
+}
+
+// CheckCommand はコマンドの実行がポリシーで許可されているかを確認する。
+// commandPath はルートコマンド名を除いたコマンドパス（例: "issue delete"）、
+// flags は明示的に指定されたフラグ名
+func (p *BundlePolicy) CheckCommand(commandPath string, flags []string) error {
+	if p == nil {
+		return nil
+	}
+	path := strings.Fields(commandPath)
+	for _, rule := range p.DenyCommands {
+		if hasCommandPrefix(path, strings.Fields(rule)) {
+			return &PolicyViolationError{Message: fmt.Sprintf("'%s' is not allowed", commandPath)}
+		}
+	}
+	for _, rule := range p.DenyFlags {
+		prefix, name, err := parseFlagRule(rule)
+		if err != nil || !hasCommandPrefix(path, prefix) {
+			continue
+		}
+		for _, f := range flags {
+			if f == name {
+				return &PolicyViolationError{Message: fmt.Sprintf("--%s is not allowed for '%s'", name, commandPath)}
+			}
+		}
+	}
+	return nil
+}
+
+func hasCommandPrefix(path, prefix []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// PolicyForProfile はプロファイルが参照するバンドルのポリシーを返す。
+// バンドルを参照していない、またはポリシーが無い場合は nil を返す
+func PolicyForProfile(store *Store, profile *ResolvedProfile) *BundlePolicy {
+	if profile == nil {
+		return nil
+	}
+	if b := FindTrustedBundleByName(store, profile.Bundle); b != nil {
+		return b.Policy
+	}
+	return nil
+}
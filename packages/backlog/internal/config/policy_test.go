@@ -0,0 +1,92 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestParseBundlePolicy(t *testing.T) {
+	policy, err := ParseBundlePolicy([]byte(`
+deny_commands:
+  - issue delete
+  - repo
+deny_flags:
+  - --auto
+  - issue comment-all --notify
+require_audit: true
+`))
+	if err != nil {
+		t.Fatalf("ParseBundlePolicy() error = %v", err)
+	}
+	if len(policy.DenyCommands) != 2 || len(policy.DenyFlags) != 2 || !policy.RequireAudit {
+		t.Errorf("policy = %+v", policy)
+	}
+
+	invalid := map[string]string{
+		"unknown key":       "deny_command: [issue]\n",
+		"flag without dash": "deny_flags: [auto]\n",
+		"empty command":     "deny_commands: [\" \"]\n",
+	}
+	for name, data := range invalid {
+		if _, err := ParseBundlePolicy([]byte(data)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestBundlePolicyCheckCommand(t *testing.T) {
+	policy := &BundlePolicy{
+		DenyCommands: []string{"issue delete", "repo"},
+		DenyFlags:    []string{"--auto", "issue comment-all --notify"},
+	}
+	tests := []struct {
+		path    string
+		flags   []string
+		allowed bool
+	}{
+		{"issue delete", nil, false},
+		{"issue list", nil, true},
+		{"repo clone", nil, false},
+		{"markdown migrate apply", []string{"auto"}, false},
+		{"markdown migrate apply", []string{"dry-run"}, true},
+		{"issue comment-all", []string{"notify"}, false},
+		{"issue comment", []string{"notify"}, true},
+	}
+	for _, tt := range tests {
+		err := policy.CheckCommand(tt.path, tt.flags)
+		if tt.allowed && err != nil {
+			t.Errorf("CheckCommand(%q, %v) error = %v, want allowed", tt.path, tt.flags, err)
+		}
+		var violation *PolicyViolationError
+		if !tt.allowed && !errors.As(err, &violation) {
+			t.Errorf("CheckCommand(%q, %v) error = %v, want PolicyViolationError", tt.path, tt.flags, err)
+		}
+	}
+
+	var none *BundlePolicy
+	if err := none.CheckCommand("issue delete", nil); err != nil {
+		t.Errorf("nil policy error = %v", err)
+	}
+}
+
+func TestReadRelayBundlePolicy(t *testing.T) {
+	data := []byte("deny_commands: [issue delete]\n")
+	sum := sha256.Sum256(data)
+	ref := RelayBundleFileRef{Name: relayBundlePolicyName, SHA256: hex.EncodeToString(sum[:])}
+
+	policy, err := readRelayBundlePolicy(map[string][]byte{relayBundlePolicyName: data}, []RelayBundleFileRef{ref})
+	if err != nil || policy == nil || policy.DenyCommands[0] != "issue delete" {
+		t.Fatalf("readRelayBundlePolicy() = %+v, %v", policy, err)
+	}
+
+	// 署名対象に含まれないポリシーは受け付けない
+	if _, err := readRelayBundlePolicy(map[string][]byte{relayBundlePolicyName: data}, nil); err == nil {
+		t.Error("expected error for unlisted policy")
+	}
+
+	if policy, err := readRelayBundlePolicy(map[string][]byte{}, nil); policy != nil || err != nil {
+		t.Errorf("no policy = %+v, %v", policy, err)
+	}
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // ProjectConfigFiles は検索するファイル名の優先順
@@ -16,31 +18,48 @@ var ProjectConfigFiles = []string{
 // DefaultProjectConfigFile はデフォルトのプロジェクト設定ファイル名
 const DefaultProjectConfigFile = ".backlog.yaml"
 
-// findProjectConfigPath はカレントディレクトリから上に向かって
-// .backlog.yaml を検索し、パスを返す
-func findProjectConfigPath() (string, error) {
+// findProjectConfigPaths はカレントディレクトリから上に向かって
+// .backlog.yaml を検索し、見つかったすべてのパスを近い順に返す
+// 各ディレクトリでは ProjectConfigFiles の優先順で最初に見つかったファイルのみを使う
+func findProjectConfigPaths() ([]string, error) {
 	dir, err := os.Getwd()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return projectConfigPathsFrom(dir), nil
+}
 
+func projectConfigPathsFrom(dir string) []string {
+	var paths []string
 	for {
 		for _, name := range ProjectConfigFiles {
 			path := filepath.Join(dir, name)
 			if _, err := os.Stat(path); err == nil {
-				return path, nil
+				paths = append(paths, path)
+				break
 			}
 		}
 
 		parent := filepath.Dir(dir)
 		if parent == dir {
-			// ルートに到達、見つからず
-			return "", nil
+			// ルートに到達
+			return paths
 		}
 		dir = parent
 	}
 }
 
+// projectParentLayerName は親ディレクトリのプロジェクト設定レイヤー名を返す
+// depth は最も近いプロジェクト設定からの距離（1 = 1つ上のファイル）
+func projectParentLayerName(depth int) string {
+	return fmt.Sprintf("%s:%d", LayerProject, depth)
+}
+
+// IsProjectLayer はレイヤー名がプロジェクト設定（親ディレクトリのものを含む）かどうかを返す
+func IsProjectLayer(name string) bool {
+	return name == LayerProject || strings.HasPrefix(name, LayerProject+":")
+}
+
 // findGitRoot はカレントディレクトリから上に向かって
 // .git ディレクトリを検索し、見つかったディレクトリを返す
 func findGitRoot() (string, error) {
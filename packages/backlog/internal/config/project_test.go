@@ -0,0 +1,119 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProjectConfigPathsFrom(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, ".backlog.yaml"), "")
+	writeTestFile(t, filepath.Join(root, "services", "api", ".backlog.yml"), "")
+	// 同じディレクトリでは ProjectConfigFiles の優先順で1つだけ使う
+	writeTestFile(t, filepath.Join(root, "services", "api", ".backlog-project.yaml"), "")
+	sub := filepath.Join(root, "services", "api", "internal")
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := projectConfigPathsFrom(sub)
+	// t.TempDir の上位にある設定ファイルは対象外として比較する
+	if len(got) >= 2 {
+		got = got[:2]
+	}
+	want := []string{
+		filepath.Join(root, "services", "api", ".backlog.yml"),
+		filepath.Join(root, ".backlog.yaml"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("projectConfigPathsFrom() = %v, want %v", got, want)
+	}
+}
+
+func TestIsProjectLayer(t *testing.T) {
+	tests := map[string]bool{
+		LayerProject:              true,
+		projectParentLayerName(2): true,
+		LayerUser:                 false,
+		"projects":                false,
+	}
+	for name, want := range tests {
+		if got := IsProjectLayer(name); got != want {
+			t.Errorf("IsProjectLayer(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestProjectConfigHierarchicalMerge(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("BACKLOG_PROJECT", "")
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, ".backlog.yaml"), `project:
+  name: ROOT
+display:
+  default_issue_limit: 50
+hooks:
+  issue:
+    create:
+      post: ./from-root.sh
+issue_defaults:
+  type: Task
+  priority: Normal
+  notify: [team-lead]
+`)
+	writeTestFile(t, filepath.Join(root, "svc", ".backlog.yaml"), `project:
+  name: SVC
+issue_defaults:
+  type: Bug
+`)
+	t.Chdir(filepath.Join(root, "svc"))
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore failed: %v", err)
+	}
+	if err := store.LoadAll(t.Context()); err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+
+	if got := store.Project().Name; got != "SVC" {
+		t.Errorf("Project().Name = %q, want SVC (nearest file wins)", got)
+	}
+	if got := store.Display().DefaultIssueLimit; got != 50 {
+		t.Errorf("Display().DefaultIssueLimit = %d, want 50 (inherited from parent)", got)
+	}
+	if hook, ignored := store.IssueHook("create", "post"); hook != "" || !ignored {
+		t.Errorf("IssueHook(create, post) = (%q, %v), want parent project hook ignored", hook, ignored)
+	}
+
+	defaults := store.IssueDefaults()
+	if defaults.Type != "Bug" || defaults.Priority != "Normal" || !reflect.DeepEqual(defaults.Notify, []string{"team-lead"}) {
+		t.Errorf("IssueDefaults() = %+v, want type from nearest file and the rest inherited", defaults)
+	}
+
+	paths := store.ProjectConfigPaths()
+	if len(paths) < 2 || paths[0] != filepath.Join(root, "svc", ".backlog.yaml") || paths[1] != filepath.Join(root, ".backlog.yaml") {
+		t.Errorf("ProjectConfigPaths() = %v", paths)
+	}
+
+	_, src, ok := store.Which("display.default_issue_limit")
+	if !ok || src.Layer != projectParentLayerName(1) || src.Path != filepath.Join(root, ".backlog.yaml") {
+		t.Errorf("Which(display.default_issue_limit) = %+v, %v", src, ok)
+	}
+	_, src, ok = store.Which("project.name")
+	if !ok || src.Layer != LayerProject {
+		t.Errorf("Which(project.name) = %+v, %v", src, ok)
+	}
+}
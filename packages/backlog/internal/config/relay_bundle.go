@@ -16,8 +16,10 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
 	jwkutil "github.com/yacchi/backlog-cli/packages/backlog/internal/jwk"
@@ -33,8 +35,10 @@ const (
 type BundleImportOptions struct {
 	ApprovalHandler BundleApprovalHandler
 	NoDefaults      bool
-	HTTPClient      *http.Client
-	Now             time.Time
+	// settings.yaml（組織推奨の設定）を組織デフォルトレイヤーに取り込まない
+	NoOrgDefaults bool
+	HTTPClient    *http.Client
+	Now           time.Time
 	// キャッシュディレクトリ（空の場合はキャッシュ無効）
 	CacheDir string
 }
@@ -202,6 +206,15 @@ func ImportRelayBundle(ctx context.Context, store *Store, bundlePath string, opt
 	}
 	debug.Log("bundle files verified")
 
+	policy, err := readRelayBundlePolicy(files, manifest.Files)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := readRelayBundleSettings(files, manifest.Files)
+	if err != nil {
+		return nil, err
+	}
+
 	bundleSHA, err := sha256File(bundlePath)
 	if err != nil {
 		return nil, err
@@ -238,6 +251,7 @@ func ImportRelayBundle(ctx context.Context, store *Store, bundlePath string, opt
 			SHA256:   bundleSHA,
 		},
 		ImportedAt: now.Format(time.RFC3339),
+		Policy:     policy,
 	}
 
 	debug.Log("upserting trusted bundle", "name", trusted.Name)
@@ -245,6 +259,17 @@ func ImportRelayBundle(ctx context.Context, store *Store, bundlePath string, opt
 		return nil, err
 	}
 
+	if !opts.NoOrgDefaults {
+		path, err := orgDefaultsPath()
+		if err != nil {
+			return nil, err
+		}
+		debug.Log("applying organization defaults", "path", path, "present", settings != nil)
+		if trusted.OrgDefaults, err = applyOrgDefaults(path, trusted.Name, settings); err != nil {
+			return nil, err
+		}
+	}
+
 	if !opts.NoDefaults {
 		debug.Log("applying default profile values")
 		if err := applyRelayBundleDefaults(store, manifest); err != nil {
@@ -583,6 +608,26 @@ func verifyRelayBundleFiles(files map[string][]byte, refs []RelayBundleFileRef)
 	return nil
 }
 
+// readRelayBundlePolicy は同梱された policy.yaml を読み込む。
+// 改ざんを防ぐため、manifest の files に含まれ署名で保護されている場合のみ受け付ける
+func readRelayBundlePolicy(files map[string][]byte, refs []RelayBundleFileRef) (*BundlePolicy, error) {
+	data, ok := files[relayBundlePolicyName]
+	if !ok {
+		return nil, nil
+	}
+	listed := false
+	for _, ref := range refs {
+		if ref.Name == relayBundlePolicyName {
+			listed = true
+			break
+		}
+	}
+	if !listed {
+		return nil, fmt.Errorf("%s must be listed in the manifest files", relayBundlePolicyName)
+	}
+	return ParseBundlePolicy(data)
+}
+
 func sha256File(path string) (string, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -632,3 +677,40 @@ func upsertTrustedBundle(store *Store, bundle TrustedBundle) error {
 func applyRelayBundleDefaults(store *Store, manifest RelayBundleManifest) error {
 	return store.Set("profile.default.bundle", manifest.BundleName())
 }
+
+// EnsureBundleProfile はバンドルを参照するプロファイルを返す。
+// 既に bundle が一致するプロファイルがあればそれを使い、なければバンドル名の
+// プロファイルを作成する（space はログイン時に選択するため設定しない）。
+// 同名のプロファイルが別のバンドルを参照している場合はエラーにする。
+func EnsureBundleProfile(store *Store, bundleName string) (profileName string, created bool, err error) {
+	profiles := store.Profiles()
+	var matches []string
+	for name, p := range profiles {
+		if p != nil && p.Bundle == bundleName {
+			matches = append(matches, name)
+		}
+	}
+	if len(matches) > 0 {
+		sort.Strings(matches)
+		return matches[0], false, nil
+	}
+
+	profileName = bundleProfileName(bundleName)
+	if existing, ok := profiles[profileName]; ok && existing != nil && (existing.Bundle != "" || existing.Space != "") {
+		return "", false, fmt.Errorf("profile %q already exists and is not linked to bundle %q", profileName, bundleName)
+	}
+	if err := store.SetProfileValue(LayerUser, profileName, "bundle", bundleName); err != nil {
+		return "", false, fmt.Errorf("failed to create profile: %w", err)
+	}
+	return profileName, true, nil
+}
+
+// bundleProfileName はバンドル名からプロファイル名を作る（パス区切りや空白を - に置き換える）
+func bundleProfileName(bundleName string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || unicode.IsSpace(r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(bundleName))
+}
@@ -405,6 +405,17 @@ func buildBundleFiles(paths []string) ([]RelayBundleFileRef, map[string][]byte,
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to read %s: %w", path, err)
 		}
+		// 配布後にインポートで失敗しないよう、ポリシーは作成時に検証する
+		if name == relayBundlePolicyName {
+			if _, err := ParseBundlePolicy(contents); err != nil {
+				return nil, nil, err
+			}
+		}
+		if name == relayBundleSettingsName {
+			if err := ValidateOrgSettings(contents); err != nil {
+				return nil, nil, err
+			}
+		}
 		sum := sha256.Sum256(contents)
 		refs = append(refs, RelayBundleFileRef{
 			Name:   name,
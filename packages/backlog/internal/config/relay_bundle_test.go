@@ -301,3 +301,46 @@ func TestRelayBundleSignatureVerification(t *testing.T) {
 		t.Fatalf("verifyRelayBundleSignature should fail for tampered payload")
 	}
 }
+
+func TestEnsureBundleProfile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Chdir(t.TempDir())
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore failed: %v", err)
+	}
+	if err := store.LoadAll(t.Context()); err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+	if err := store.SetProfileValue(LayerUser, "work", "bundle", "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetProfileValue(LayerUser, "other", "space", "other.backlog.jp"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		bundle      string
+		wantProfile string
+		wantCreated bool
+		wantErr     bool
+	}{
+		{bundle: "acme", wantProfile: "work"},
+		{bundle: "team a/b", wantProfile: "team-a-b", wantCreated: true},
+		{bundle: "team a/b", wantProfile: "team-a-b"},
+		{bundle: "other", wantErr: true},
+	}
+	for _, tt := range tests {
+		name, created, err := EnsureBundleProfile(store, tt.bundle)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("EnsureBundleProfile(%q) error = %v, wantErr %v", tt.bundle, err, tt.wantErr)
+		}
+		if name != tt.wantProfile || created != tt.wantCreated {
+			t.Errorf("EnsureBundleProfile(%q) = (%q, %v), want (%q, %v)", tt.bundle, name, created, tt.wantProfile, tt.wantCreated)
+		}
+	}
+	if got := store.Profile("team-a-b").Bundle; got != "team a/b" {
+		t.Errorf("profile team-a-b bundle = %q", got)
+	}
+}
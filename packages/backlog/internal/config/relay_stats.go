@@ -0,0 +1,125 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvRelayAdminToken は relay の管理 API に使う管理者トークンを指定する環境変数
+const EnvRelayAdminToken = "BACKLOG_RELAY_ADMIN_TOKEN"
+
+// RelayUserStats はユーザーごとのトークン使用状況
+type RelayUserStats struct {
+	UserID     string `json:"user_id,omitempty"`
+	UserName   string `json:"user_name,omitempty"`
+	UserEmail  string `json:"user_email,omitempty"`
+	Space      string `json:"space,omitempty"`
+	TokenCount int    `json:"token_count"`
+	LastUsedAt string `json:"last_used_at"`
+}
+
+// RelayTenantStats は /v1/relay/tenants/{name}/stats のレスポンス
+type RelayTenantStats struct {
+	Tenant         string           `json:"tenant"`
+	Space          string           `json:"space,omitempty"`
+	StartTime      string           `json:"start_time"`
+	EndTime        string           `json:"end_time"`
+	TokenIssued    int              `json:"token_issued"`
+	TokenExchanges int              `json:"token_exchanges"`
+	TokenRefreshes int              `json:"token_refreshes"`
+	ActiveUsers    int              `json:"active_users"`
+	Users          []RelayUserStats `json:"users"`
+}
+
+// RelayStatsOptions は stats 取得のオプション
+type RelayStatsOptions struct {
+	HTTPClient *http.Client
+	// Since は集計期間（0 の場合はサーバーのデフォルト）
+	Since time.Duration
+	// Space は集計対象のスペース（空の場合はテナントの default_space）
+	Space string
+}
+
+// BuildRelayStatsURL は stats API の URL を組み立てる
+func BuildRelayStatsURL(relayURL, name string, opts RelayStatsOptions) (string, error) {
+	if relayURL == "" {
+		return "", errors.New("relay_url is empty")
+	}
+	if _, err := url.Parse(relayURL); err != nil {
+		return "", fmt.Errorf("invalid relay_url: %w", err)
+	}
+	u, err := url.JoinPath(relayURL, "/v1/relay/tenants/"+name+"/stats")
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{}
+	if opts.Since > 0 {
+		hours := int((opts.Since + time.Hour - 1) / time.Hour)
+		q.Set("hours", strconv.Itoa(hours))
+	}
+	if opts.Space != "" {
+		q.Set("space", opts.Space)
+	}
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	return u, nil
+}
+
+// FetchRelayStats はテナントのトークン使用状況を取得する（管理者トークン必須）
+func FetchRelayStats(ctx context.Context, relayURL, name, adminToken string, opts RelayStatsOptions) (*RelayTenantStats, error) {
+	if strings.TrimSpace(adminToken) == "" {
+		return nil, errors.New("admin token is required")
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("name is required")
+	}
+
+	statsURL, err := BuildRelayStatsURL(relayURL, name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, statsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stats request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stats: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		var body struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(data, &body) == nil && body.Description != "" {
+			return nil, fmt.Errorf("stats request failed: %s: %s", resp.Status, body.Description)
+		}
+		return nil, fmt.Errorf("stats request failed: %s", resp.Status)
+	}
+
+	var stats RelayTenantStats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("failed to parse stats: %w", err)
+	}
+	return &stats, nil
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildRelayStatsURL(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RelayStatsOptions
+		want string
+	}{
+		{name: "default", want: "https://relay.example.com/v1/relay/tenants/acme/stats"},
+		{name: "since days", opts: RelayStatsOptions{Since: 48 * time.Hour}, want: "https://relay.example.com/v1/relay/tenants/acme/stats?hours=48"},
+		{name: "round up", opts: RelayStatsOptions{Since: 90 * time.Minute}, want: "https://relay.example.com/v1/relay/tenants/acme/stats?hours=2"},
+		{name: "space", opts: RelayStatsOptions{Space: "acme.backlog.jp"}, want: "https://relay.example.com/v1/relay/tenants/acme/stats?space=acme.backlog.jp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildRelayStatsURL("https://relay.example.com", "acme", tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("BuildRelayStatsURL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchRelayStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"unauthorized","error_description":"valid admin token is required"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"tenant":"acme","token_issued":3,"active_users":1,"users":[{"user_id":"1","token_count":3,"last_used_at":"2026-10-15T00:00:00Z"}]}`))
+	}))
+	defer srv.Close()
+
+	stats, err := FetchRelayStats(context.Background(), srv.URL, "acme", "secret", RelayStatsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TokenIssued != 3 || stats.ActiveUsers != 1 || len(stats.Users) != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	_, err = FetchRelayStats(context.Background(), srv.URL, "acme", "wrong", RelayStatsOptions{})
+	if err == nil || !strings.Contains(err.Error(), "valid admin token is required") {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
@@ -20,6 +20,10 @@ type ResolvedConfig struct {
 	// sensitive タグにより、このフィールドとその子はセンシティブレイヤーにのみ書き込み可能
 	Credentials map[string]*Credential `json:"credential" jubako:"/credential"`
 
+	// クレデンシャルファイル（credentials.yaml）の暗号化方式（none / age）
+	// キーは credential.encryption（/credential 以下はプロファイル名で使うため、保存先は credential_encryption）
+	CredentialEncryption string `json:"credential_encryption" jubako:"/credential_encryption,env:CREDENTIAL_ENCRYPTION"`
+
 	// クライアント設定
 	Client ResolvedClient `json:"client"`
 
@@ -40,6 +44,18 @@ type ResolvedConfig struct {
 
 	// AI要約設定
 	AISummary ResolvedAISummary `json:"ai_summary"`
+
+	// セキュリティ設定
+	Security ResolvedSecurity `json:"security"`
+
+	// フック設定
+	Hooks ResolvedHooks `json:"hooks"`
+
+	// 課題作成時の既定値
+	IssueDefaults ResolvedIssueDefaults `json:"issue_defaults"`
+
+	// 翻訳設定
+	Translate ResolvedTranslate `json:"translate"`
 }
 
 // ResolvedCache はマージ済みのキャッシュ設定
@@ -49,6 +65,8 @@ type ResolvedCache struct {
 	Enabled bool   `json:"enabled" jubako:"/cache/enabled,env:CACHE_ENABLED"`
 	Dir     string `json:"dir" jubako:"/cache/dir,env:CACHE_DIR"`
 	TTL     int    `json:"ttl" jubako:"/cache/ttl,env:CACHE_TTL"`
+	// MemoryEntries はプロセス内のインメモリ層に保持する最大件数（0 以下で無効）
+	MemoryEntries int `json:"memory_entries" jubako:"/cache/memory_entries,env:CACHE_MEMORY_ENTRIES"`
 }
 
 // GetCacheDir returns the cache directory.
@@ -76,6 +94,7 @@ var envShortcuts = map[string]string{
 	"BACKLOG_NO_BROWSER":        "BACKLOG_PROFILE_default_NO_BROWSER",
 	"BACKLOG_SKIP_CONFIRMATION": "BACKLOG_PROFILE_default_SKIP_CONFIRMATION",
 	"BACKLOG_PRIMARY":           "BACKLOG_PROFILE_default_PRIMARY",
+	"BACKLOG_RELAY_SIGNING_KEY": "BACKLOG_PROFILE_default_RELAY_SIGNING_KEY",
 }
 
 // expandEnvShortcuts は環境変数のショートカットを展開した環境変数リストを返す
@@ -118,11 +137,17 @@ type ResolvedProfile struct {
 	Editor                 string `json:"editor" jubako:",env:PROFILE_{key}_EDITOR"`
 	Browser                string `json:"browser" jubako:",env:PROFILE_{key}_BROWSER"`
 	AuthCallbackPort       int    `json:"auth_callback_port" jubako:",env:PROFILE_{key}_CALLBACK_PORT"`
+	AuthCallbackListen     string `json:"auth_callback_listen" jubako:",env:PROFILE_{key}_CALLBACK_LISTEN"`
+	AuthCallbackHost       string `json:"auth_callback_host" jubako:",env:PROFILE_{key}_CALLBACK_HOST"`
+	AuthCallbackURL        string `json:"auth_callback_url" jubako:",env:PROFILE_{key}_CALLBACK_URL"`
 	AuthTimeout            int    `json:"auth_timeout" jubako:",env:PROFILE_{key}_AUTH_TIMEOUT"`
 	AuthNoBrowser          bool   `json:"auth_no_browser" jubako:",env:PROFILE_{key}_NO_BROWSER"`
 	AuthSkipConfirmation   bool   `json:"auth_skip_confirmation" jubako:",env:PROFILE_{key}_SKIP_CONFIRMATION"`
 	HTTPTimeout            int    `json:"http_timeout" jubako:",env:PROFILE_{key}_HTTP_TIMEOUT"`
 	HTTPTokenRefreshMargin int    `json:"http_token_refresh_margin" jubako:",env:PROFILE_{key}_HTTP_TOKEN_REFRESH_MARGIN"`
+	RelaySigningKey        string `json:"relay_signing_key" jubako:",env:PROFILE_{key}_RELAY_SIGNING_KEY"`
+	RelayClientCert        string `json:"relay_client_cert" jubako:",env:PROFILE_{key}_RELAY_CLIENT_CERT"`
+	RelayClientKey         string `json:"relay_client_key" jubako:",env:PROFILE_{key}_RELAY_CLIENT_KEY"`
 }
 
 // ResolvedProject はマージ済みのプロジェクト設定
@@ -153,11 +178,21 @@ type ResolvedServer struct {
 	// 例: "*.lambda-url.*.on.aws;*.run.app"
 	AllowedHostPatterns string `json:"allowed_host_patterns" jubako:"/server/allowed_host_patterns,env:ALLOWED_HOST_PATTERNS"`
 
+	// OAuth コールバック先として追加で許可するホスト（セミコロン区切り、ワイルドカード対応）
+	AllowedCallbackHosts string `json:"allowed_callback_hosts" jubako:"/server/allowed_callback_hosts,env:ALLOWED_CALLBACK_HOSTS"`
+
 	// HTTP設定 (server.http.*)
 	HTTPReadTimeout  int `json:"http_read_timeout" jubako:"/server/http/read_timeout,env:HTTP_READ_TIMEOUT"`
 	HTTPWriteTimeout int `json:"http_write_timeout" jubako:"/server/http/write_timeout,env:HTTP_WRITE_TIMEOUT"`
 	HTTPIdleTimeout  int `json:"http_idle_timeout" jubako:"/server/http/idle_timeout,env:HTTP_IDLE_TIMEOUT"`
 
+	// TLS設定 (server.tls.*)
+	// 常駐デプロイで中継サーバー自身が HTTPS を終端する場合の PEM ファイルパス
+	// TLSClientCA を指定するとクライアント証明書を検証し、AllowedCIDRs 外からのトークン交換は証明書を必須にする
+	TLSCertFile string `json:"tls_cert_file" jubako:"/server/tls/cert_file,env:SERVER_TLS_CERT_FILE"`
+	TLSKeyFile  string `json:"tls_key_file" jubako:"/server/tls/key_file,env:SERVER_TLS_KEY_FILE"`
+	TLSClientCA string `json:"tls_client_ca" jubako:"/server/tls/client_ca,env:SERVER_TLS_CLIENT_CA"`
+
 	// JWT設定 (server.jwt.*)
 	JWTExpiry int `json:"jwt_expiry" jubako:"/server/jwt/expiry,env:JWT_EXPIRY"`
 
@@ -230,6 +265,8 @@ type ResolvedDisplay struct {
 	DateFormat           string                         `json:"date_format" jubako:"/display/date_format,env:DISPLAY_DATE_FORMAT"`
 	DateTimeFormat       string                         `json:"datetime_format" jubako:"/display/datetime_format,env:DISPLAY_DATETIME_FORMAT"`
 	Hyperlink            bool                           `json:"hyperlink" jubako:"/display/hyperlink,env:DISPLAY_HYPERLINK"`
+	Emoji                bool                           `json:"emoji" jubako:"/display/emoji,env:DISPLAY_EMOJI"`
+	EmojiFallback        string                         `json:"emoji_fallback" jubako:"/display/emoji_fallback,env:DISPLAY_EMOJI_FALLBACK"`
 	MarkdownView         bool                           `json:"markdown_view" jubako:"/display/markdown_view,env:DISPLAY_MARKDOWN_VIEW"`
 	MarkdownWarn         bool                           `json:"markdown_warn" jubako:"/display/markdown_warn,env:DISPLAY_MARKDOWN_WARN"`
 	MarkdownCache        bool                           `json:"markdown_cache" jubako:"/display/markdown_cache,env:DISPLAY_MARKDOWN_CACHE"`
@@ -240,6 +277,43 @@ type ResolvedDisplay struct {
 	IssueFieldConfig     map[string]ResolvedFieldConfig `json:"issue_field_config" jubako:"/display/issue_field_config"`
 	PRListFields         []string                       `json:"pr_list_fields" jubako:"/display/pr_list_fields"`
 	PRFieldConfig        map[string]ResolvedFieldConfig `json:"pr_field_config" jubako:"/display/pr_field_config"`
+	Colors               ResolvedDisplayColors          `json:"colors" jubako:"/display/colors"`
+	// 変換ルールのプリセット（safe / standard / aggressive、空なら markdown_unsafe_rules を使う）
+	MarkdownConversionProfile string `json:"markdown_conversion_profile" jubako:"/display/markdown_conversion_profile,env:DISPLAY_MARKDOWN_CONVERSION_PROFILE"`
+	// コマンド単位の既定値（キーは "issue_list" のようにサブコマンドを "_" でつないだ名前）
+	Commands map[string]ResolvedCommandDisplay `json:"commands" jubako:"/display/commands"`
+	// レポート系コマンドの出力を自動保存するディレクトリ（空なら保存しない）
+	AutoSaveDir string `json:"auto_save_dir" jubako:"/display/auto_save_dir,env:DISPLAY_AUTO_SAVE_DIR"`
+}
+
+// ResolvedCommandDisplay はコマンド単位で上書きする表示設定
+// 優先順位はフラグ > コマンド設定 > 全体設定
+type ResolvedCommandDisplay struct {
+	// Limit は --limit の既定値（0 = 上書きしない）
+	Limit int `json:"limit"`
+	// Fields は一覧の表示フィールド（空 = 全体設定を使う）
+	Fields []string `json:"fields"`
+}
+
+// CommandFields はコマンドの表示フィールドを返す（コマンド設定が無ければ fallback）
+func (d *ResolvedDisplay) CommandFields(command string, fallback []string) []string {
+	if c, ok := d.Commands[command]; ok && len(c.Fields) > 0 {
+		return c.Fields
+	}
+	return fallback
+}
+
+// CommandLimit はコマンドの --limit の既定値を返す（未設定は 0）
+func (d *ResolvedDisplay) CommandLimit(command string) int {
+	return d.Commands[command].Limit
+}
+
+// ResolvedDisplayColors はステータス・優先度の表示色の設定
+// キーは名前（大文字小文字を区別しない）、値は色名または #RRGGBB
+type ResolvedDisplayColors struct {
+	Status   map[string]string `json:"status" jubako:"/display/colors/status"`
+	Priority map[string]string `json:"priority" jubako:"/display/colors/priority"`
+	PRStatus map[string]string `json:"pr_status" jubako:"/display/colors/pr_status"`
 }
 
 // ResolvedFieldConfig はマージ済みのフィールド設定
@@ -304,6 +378,91 @@ func (k *ResolvedAuthKeepalive) GracePeriodDuration() time.Duration {
 	return time.Duration(k.GracePeriod) * time.Second
 }
 
+// ResolvedSecurity はマージ済みのセキュリティ設定
+// jubako tagでsecurity.*からマッピング
+type ResolvedSecurity struct {
+	// 本文の秘密情報検出モード (warn, block, off)
+	SecretScan string                   `json:"secret_scan" jubako:"/security/secret_scan,env:SECURITY_SECRET_SCAN"`
+	Attachment ResolvedAttachmentPolicy `json:"attachment" jubako:"/security/attachment"`
+	Audit      ResolvedAudit            `json:"audit" jubako:"/security/audit"`
+}
+
+// ResolvedAudit は書き込み系コマンドの監査ログ設定
+// env: ディレクティブで環境変数からの自動マッピングを定義
+type ResolvedAudit struct {
+	Enabled bool `json:"enabled" jubako:"/security/audit/enabled,env:SECURITY_AUDIT_ENABLED"`
+	// 監査ログの保存先（空 = ~/.local/state/backlog/audit.jsonl）
+	Path string `json:"path" jubako:"/security/audit/path,env:SECURITY_AUDIT_PATH"`
+}
+
+// ResolvedAttachmentPolicy は添付ファイルのアップロード前チェック設定
+// env: ディレクティブで環境変数からの自動マッピングを定義
+type ResolvedAttachmentPolicy struct {
+	Enabled           bool     `json:"enabled" jubako:"/security/attachment/enabled,env:SECURITY_ATTACHMENT_ENABLED"`
+	MaxSizeMB         int      `json:"max_size_mb" jubako:"/security/attachment/max_size_mb,env:SECURITY_ATTACHMENT_MAX_SIZE_MB"`
+	BlockedExtensions []string `json:"blocked_extensions" jubako:"/security/attachment/blocked_extensions"`
+	SensitivePatterns []string `json:"sensitive_patterns" jubako:"/security/attachment/sensitive_patterns"`
+	ScanCommand       string   `json:"scan_command" jubako:"/security/attachment/scan_command,env:SECURITY_ATTACHMENT_SCAN_COMMAND"`
+	ScanArgs          []string `json:"scan_args" jubako:"/security/attachment/scan_args"`
+}
+
+// MaxSizeBytes は添付ファイルの上限サイズをバイト数で返す（0 = 無制限）
+func (a *ResolvedAttachmentPolicy) MaxSizeBytes() int64 {
+	return int64(a.MaxSizeMB) * 1024 * 1024
+}
+
+// ResolvedHooks はマージ済みのフック設定
+// jubako tagでhooks.*からマッピング
+type ResolvedHooks struct {
+	// フックのタイムアウト (秒, 0 = 無制限)
+	Timeout int `json:"timeout" jubako:"/hooks/timeout,env:HOOKS_TIMEOUT"`
+	// issue サブコマンドのフック（キーはサブコマンド名: create, edit, close, ...）
+	Issue map[string]ResolvedHook `json:"issue" jubako:"/hooks/issue"`
+}
+
+// ResolvedHook はコマンド実行前後に実行するシェルコマンド
+type ResolvedHook struct {
+	Pre  string `json:"pre" jubako:"pre"`
+	Post string `json:"post" jubako:"post"`
+}
+
+// TimeoutDuration はタイムアウトをtime.Durationで返す
+func (h *ResolvedHooks) TimeoutDuration() time.Duration {
+	return time.Duration(h.Timeout) * time.Second
+}
+
+// ResolvedIssueDefaults は issue create / note で使う既定値
+// jubako tagでissue_defaults.*からマッピング
+// チームの起票ルールを .backlog.yaml で共有する用途を想定し、フラグ指定があればフラグを優先する
+type ResolvedIssueDefaults struct {
+	// 課題種別（ID または名前）
+	Type string `json:"type" jubako:"/issue_defaults/type,env:ISSUE_DEFAULTS_TYPE"`
+	// 優先度（ID または名前）
+	Priority string `json:"priority" jubako:"/issue_defaults/priority,env:ISSUE_DEFAULTS_PRIORITY"`
+	// 通知先ユーザー（ユーザー ID、userId、表示名、@me）
+	Notify []string `json:"notify" jubako:"/issue_defaults/notify"`
+	// backlog note で課題キーを省略したときの追記先課題
+	NoteIssue string `json:"note_issue" jubako:"/issue_defaults/note_issue,env:ISSUE_DEFAULTS_NOTE_ISSUE"`
+	// issue create で期限を必須にする（Backlog にはない起票ルールを CLI 側で検証する）
+	RequireDue bool `json:"require_due" jubako:"/issue_defaults/require_due,env:ISSUE_DEFAULTS_REQUIRE_DUE"`
+}
+
+// ResolvedTranslate は issue view / wiki view --translate で使う翻訳コマンドの設定
+// jubako tagでtranslate.*からマッピング
+type ResolvedTranslate struct {
+	// --translator 省略時に使う翻訳コマンド
+	Command string `json:"command" jubako:"/translate/command,env:TRANSLATE_COMMAND"`
+	// 翻訳コマンドのタイムアウト (秒, 0 = 既定値)
+	Timeout int `json:"timeout" jubako:"/translate/timeout,env:TRANSLATE_TIMEOUT"`
+	// 名前付きの翻訳コマンド（--translator <名前> で選択する）
+	Translators map[string]string `json:"translators" jubako:"/translate/translators"`
+}
+
+// TimeoutDuration はタイムアウトをtime.Durationで返す
+func (t *ResolvedTranslate) TimeoutDuration() time.Duration {
+	return time.Duration(t.Timeout) * time.Second
+}
+
 // NewResolvedConfig は空のResolvedConfigを作成する
 func NewResolvedConfig() *ResolvedConfig {
 	return &ResolvedConfig{
@@ -318,10 +477,21 @@ func NewResolvedConfig() *ResolvedConfig {
 		Display: ResolvedDisplay{
 			IssueFieldConfig: make(map[string]ResolvedFieldConfig),
 			PRFieldConfig:    make(map[string]ResolvedFieldConfig),
+			Colors: ResolvedDisplayColors{
+				Status:   make(map[string]string),
+				Priority: make(map[string]string),
+				PRStatus: make(map[string]string),
+			},
 		},
 		AISummary: ResolvedAISummary{
 			Providers: make(map[string]ResolvedAISummaryProvider),
 		},
+		Hooks: ResolvedHooks{
+			Issue: make(map[string]ResolvedHook),
+		},
+		Translate: ResolvedTranslate{
+			Translators: make(map[string]string),
+		},
 	}
 }
 
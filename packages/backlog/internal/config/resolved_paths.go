@@ -16,6 +16,7 @@ const (
 	PathActiveProfile                              = "/active_profile"
 	PathProfile                                    = "/profile"
 	PathCredential                                 = "/credential"
+	PathCredentialEncryption                       = "/credential_encryption"
 	PathClientTrustBundles                         = "/client/trust/bundles"
 	PathProjectProfile                             = "/project/profile"
 	PathProjectSpace                               = "/project/space"
@@ -25,9 +26,13 @@ const (
 	PathServerPort                                 = "/server/port"
 	PathServerBaseUrl                              = "/server/base_url"
 	PathServerAllowedHostPatterns                  = "/server/allowed_host_patterns"
+	PathServerAllowedCallbackHosts                 = "/server/allowed_callback_hosts"
 	PathServerHttpReadTimeout                      = "/server/http/read_timeout"
 	PathServerHttpWriteTimeout                     = "/server/http/write_timeout"
 	PathServerHttpIdleTimeout                      = "/server/http/idle_timeout"
+	PathServerTlsCertFile                          = "/server/tls/cert_file"
+	PathServerTlsKeyFile                           = "/server/tls/key_file"
+	PathServerTlsClientCa                          = "/server/tls/client_ca"
 	PathServerJwtExpiry                            = "/server/jwt/expiry"
 	PathServerCacheShortTtl                        = "/server/cache/short_ttl"
 	PathServerCacheLongTtl                         = "/server/cache/long_ttl"
@@ -55,6 +60,8 @@ const (
 	PathDisplayDateFormat                          = "/display/date_format"
 	PathDisplayDatetimeFormat                      = "/display/datetime_format"
 	PathDisplayHyperlink                           = "/display/hyperlink"
+	PathDisplayEmoji                               = "/display/emoji"
+	PathDisplayEmojiFallback                       = "/display/emoji_fallback"
 	PathDisplayMarkdownView                        = "/display/markdown_view"
 	PathDisplayMarkdownWarn                        = "/display/markdown_warn"
 	PathDisplayMarkdownCache                       = "/display/markdown_cache"
@@ -65,6 +72,12 @@ const (
 	PathDisplayIssueFieldConfig                    = "/display/issue_field_config"
 	PathDisplayPrListFields                        = "/display/pr_list_fields"
 	PathDisplayPrFieldConfig                       = "/display/pr_field_config"
+	PathDisplayColorsStatus                        = "/display/colors/status"
+	PathDisplayColorsPriority                      = "/display/colors/priority"
+	PathDisplayColorsPrStatus                      = "/display/colors/pr_status"
+	PathDisplayMarkdownConversionProfile           = "/display/markdown_conversion_profile"
+	PathDisplayCommands                            = "/display/commands"
+	PathDisplayAutoSaveDir                         = "/display/auto_save_dir"
 	PathAuthCredentialBackend                      = "/auth/credential_backend"
 	PathAuthMinCallbackPort                        = "/auth/min_callback_port"
 	PathAuthMaxCallbackPort                        = "/auth/max_callback_port"
@@ -77,6 +90,7 @@ const (
 	PathCacheEnabled                               = "/cache/enabled"
 	PathCacheDir                                   = "/cache/dir"
 	PathCacheTtl                                   = "/cache/ttl"
+	PathCacheMemoryEntries                         = "/cache/memory_entries"
 	PathAiSummaryEnabled                           = "/ai_summary/enabled"
 	PathAiSummaryProvider                          = "/ai_summary/provider"
 	PathAiSummaryTimeout                           = "/ai_summary/timeout"
@@ -96,6 +110,25 @@ const (
 	PathAiSummaryOptimizationTargetProjects        = "/ai_summary/optimization/target_projects"
 	PathAiSummaryOptimizationOutputModelContext    = "/ai_summary/optimization/output_model_context"
 	PathAiSummaryOptimizationPromptEngineeringTips = "/ai_summary/optimization/prompt_engineering_tips"
+	PathSecuritySecretScan                         = "/security/secret_scan"
+	PathSecurityAttachmentEnabled                  = "/security/attachment/enabled"
+	PathSecurityAttachmentMaxSizeMb                = "/security/attachment/max_size_mb"
+	PathSecurityAttachmentBlockedExtensions        = "/security/attachment/blocked_extensions"
+	PathSecurityAttachmentSensitivePatterns        = "/security/attachment/sensitive_patterns"
+	PathSecurityAttachmentScanCommand              = "/security/attachment/scan_command"
+	PathSecurityAttachmentScanArgs                 = "/security/attachment/scan_args"
+	PathSecurityAuditEnabled                       = "/security/audit/enabled"
+	PathSecurityAuditPath                          = "/security/audit/path"
+	PathHooksTimeout                               = "/hooks/timeout"
+	PathHooksIssue                                 = "/hooks/issue"
+	PathIssueDefaultsType                          = "/issue_defaults/type"
+	PathIssueDefaultsPriority                      = "/issue_defaults/priority"
+	PathIssueDefaultsNotify                        = "/issue_defaults/notify"
+	PathIssueDefaultsNoteIssue                     = "/issue_defaults/note_issue"
+	PathIssueDefaultsRequireDue                    = "/issue_defaults/require_due"
+	PathTranslateCommand                           = "/translate/command"
+	PathTranslateTimeout                           = "/translate/timeout"
+	PathTranslateTranslators                       = "/translate/translators"
 )
 
 // PathProfileRelayServer returns the JSONPointer path.
@@ -182,6 +215,24 @@ func PathProfileAuthCallbackPort(key string) string {
 	return "/profile/" + jsonptr.Escape(key) + "/auth_callback_port"
 }
 
+// PathProfileAuthCallbackListen returns the JSONPointer path.
+// Path pattern: /profile/{key}/auth_callback_listen
+func PathProfileAuthCallbackListen(key string) string {
+	return "/profile/" + jsonptr.Escape(key) + "/auth_callback_listen"
+}
+
+// PathProfileAuthCallbackHost returns the JSONPointer path.
+// Path pattern: /profile/{key}/auth_callback_host
+func PathProfileAuthCallbackHost(key string) string {
+	return "/profile/" + jsonptr.Escape(key) + "/auth_callback_host"
+}
+
+// PathProfileAuthCallbackUrl returns the JSONPointer path.
+// Path pattern: /profile/{key}/auth_callback_url
+func PathProfileAuthCallbackUrl(key string) string {
+	return "/profile/" + jsonptr.Escape(key) + "/auth_callback_url"
+}
+
 // PathProfileAuthTimeout returns the JSONPointer path.
 // Path pattern: /profile/{key}/auth_timeout
 func PathProfileAuthTimeout(key string) string {
@@ -212,6 +263,24 @@ func PathProfileHttpTokenRefreshMargin(key string) string {
 	return "/profile/" + jsonptr.Escape(key) + "/http_token_refresh_margin"
 }
 
+// PathProfileRelaySigningKey returns the JSONPointer path.
+// Path pattern: /profile/{key}/relay_signing_key
+func PathProfileRelaySigningKey(key string) string {
+	return "/profile/" + jsonptr.Escape(key) + "/relay_signing_key"
+}
+
+// PathProfileRelayClientCert returns the JSONPointer path.
+// Path pattern: /profile/{key}/relay_client_cert
+func PathProfileRelayClientCert(key string) string {
+	return "/profile/" + jsonptr.Escape(key) + "/relay_client_cert"
+}
+
+// PathProfileRelayClientKey returns the JSONPointer path.
+// Path pattern: /profile/{key}/relay_client_key
+func PathProfileRelayClientKey(key string) string {
+	return "/profile/" + jsonptr.Escape(key) + "/relay_client_key"
+}
+
 // PathCredentialAuthType returns the JSONPointer path.
 // Path pattern: /credential/{key}/auth_type
 func PathCredentialAuthType(key string) string {
@@ -242,6 +311,12 @@ func PathCredentialApiKey(key string) string {
 	return "/credential/" + jsonptr.Escape(key) + "/api_key"
 }
 
+// PathCredentialServiceToken returns the JSONPointer path.
+// Path pattern: /credential/{key}/service_token
+func PathCredentialServiceToken(key string) string {
+	return "/credential/" + jsonptr.Escape(key) + "/service_token"
+}
+
 // PathCredentialUserId returns the JSONPointer path.
 // Path pattern: /credential/{key}/user_id
 func PathCredentialUserId(key string) string {
@@ -272,6 +347,12 @@ func PathCredentialDomain(key string) string {
 	return "/credential/" + jsonptr.Escape(key) + "/domain"
 }
 
+// PathCredentialSessionId returns the JSONPointer path.
+// Path pattern: /credential/{key}/session_id
+func PathCredentialSessionId(key string) string {
+	return "/credential/" + jsonptr.Escape(key) + "/session_id"
+}
+
 // PathClientTrustBundlesName returns the JSONPointer path.
 // Path pattern: /client/trust/bundles/{index}/name
 func PathClientTrustBundlesName(index int) string {
@@ -416,6 +497,24 @@ func PathDisplayIssueFieldConfigTimeFormat(key string) string {
 	return "/display/issue_field_config/" + jsonptr.Escape(key) + "/time_format"
 }
 
+// PathDisplayColorsStatusKey returns the JSONPointer path.
+// Path pattern: /display/colors/status/{key}
+func PathDisplayColorsStatusKey(key string) string {
+	return "/display/colors/status/" + jsonptr.Escape(key)
+}
+
+// PathDisplayColorsPriorityKey returns the JSONPointer path.
+// Path pattern: /display/colors/priority/{key}
+func PathDisplayColorsPriorityKey(key string) string {
+	return "/display/colors/priority/" + jsonptr.Escape(key)
+}
+
+// PathDisplayColorsPrStatusKey returns the JSONPointer path.
+// Path pattern: /display/colors/pr_status/{key}
+func PathDisplayColorsPrStatusKey(key string) string {
+	return "/display/colors/pr_status/" + jsonptr.Escape(key)
+}
+
 // PathDisplayPrFieldConfigHeader returns the JSONPointer path.
 // Path pattern: /display/pr_field_config/{key}/header
 func PathDisplayPrFieldConfigHeader(key string) string {
@@ -434,6 +533,18 @@ func PathDisplayPrFieldConfigTimeFormat(key string) string {
 	return "/display/pr_field_config/" + jsonptr.Escape(key) + "/time_format"
 }
 
+// PathDisplayCommandsLimit returns the JSONPointer path.
+// Path pattern: /display/commands/{key}/limit
+func PathDisplayCommandsLimit(key string) string {
+	return "/display/commands/" + jsonptr.Escape(key) + "/limit"
+}
+
+// PathDisplayCommandsFields returns the JSONPointer path.
+// Path pattern: /display/commands/{key}/fields
+func PathDisplayCommandsFields(key string) string {
+	return "/display/commands/" + jsonptr.Escape(key) + "/fields"
+}
+
 // PathAiSummaryProvidersCommand returns the JSONPointer path.
 // Path pattern: /ai_summary/providers/{key}/command
 func PathAiSummaryProvidersCommand(key string) string {
@@ -469,3 +580,21 @@ func PathAiSummaryProvidersBatchSize(key string) string {
 func PathAiSummaryProvidersConcurrency(key string) string {
 	return "/ai_summary/providers/" + jsonptr.Escape(key) + "/concurrency"
 }
+
+// PathHooksIssuePre returns the JSONPointer path.
+// Path pattern: /hooks/issue/{key}/pre
+func PathHooksIssuePre(key string) string {
+	return "/hooks/issue/" + jsonptr.Escape(key) + "/pre"
+}
+
+// PathHooksIssuePost returns the JSONPointer path.
+// Path pattern: /hooks/issue/{key}/post
+func PathHooksIssuePost(key string) string {
+	return "/hooks/issue/" + jsonptr.Escape(key) + "/post"
+}
+
+// PathTranslateTranslatorsKey returns the JSONPointer path.
+// Path pattern: /translate/translators/{key}
+func PathTranslateTranslatorsKey(key string) string {
+	return "/translate/translators/" + jsonptr.Escape(key)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
@@ -33,9 +34,16 @@ type Store struct {
 
 	// プロジェクト設定ファイルのパス
 	projectConfigPath string
+	// projectConfigPaths は見つかったプロジェクト設定ファイルのパス（近い順、先頭が projectConfigPath）
+	projectConfigPaths []string
 
 	// クレデンシャルファイルのパス
 	credentialsPath string
+
+	// クレデンシャルファイルの暗号化ソース（credential.encryption を反映する）
+	credentialSource *encryptedFileSource
+	// credentialErr はクレデンシャルの復号・暗号化に失敗した理由（CredentialError で返す）
+	credentialErr error
 }
 
 // SensitiveMaskString はセンシティブフィールドのマスク文字列
@@ -62,6 +70,25 @@ func newConfigStore() (*Store, error) {
 		return nil, err
 	}
 
+	// Layer 1.5: Organization defaults (~/.config/backlog/org-defaults.yaml)
+	// リレーバンドルの settings.yaml から取り込んだ組織推奨の設定。ユーザー設定で上書きできる
+	orgPath, err := orgDefaultsPath()
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Add(
+		layer.New(
+			LayerOrg,
+			fs.New(orgPath),
+			yaml.New(),
+		),
+		jubako.WithReadOnly(),
+		jubako.WithOptional(),
+		jubako.WithNoWatch(),
+	); err != nil {
+		return nil, err
+	}
+
 	// Layer 2: User config (~/.config/backlog/config.yaml)
 	userConfigPath, err := configPath()
 	if err != nil {
@@ -88,7 +115,7 @@ func newConfigStore() (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
-	credentialsLayer, err := newCredentialLayer(credentialsPath)
+	credentialsLayer, credentialSource, err := newCredentialLayer(credentialsPath)
 	if err != nil {
 		return nil, err
 	}
@@ -101,10 +128,27 @@ func newConfigStore() (*Store, error) {
 	}
 
 	// Layer 4: Project config (.backlog.yaml)
-	// 見つかったらそのパス、なければカレントディレクトリの .backlog.yaml をデフォルト
-	projectConfigPath, _ := findProjectConfigPath()
-	if projectConfigPath == "" {
-		projectConfigPath = ".backlog.yaml"
+	// カレントから親へ遡って見つかったファイルを階層マージする（近いファイルほど優先）
+	// 親ディレクトリのファイルは読み取り専用で、書き込みは最も近いファイルに行う
+	// 見つからなければカレントディレクトリの .backlog.yaml をデフォルト
+	projectConfigPaths, _ := findProjectConfigPaths()
+	projectConfigPath := ".backlog.yaml"
+	if len(projectConfigPaths) > 0 {
+		projectConfigPath = projectConfigPaths[0]
+	}
+	for depth := len(projectConfigPaths) - 1; depth >= 1; depth-- {
+		if err := store.Add(
+			layer.New(
+				layer.Name(projectParentLayerName(depth)),
+				fs.New(projectConfigPaths[depth]),
+				yaml.New(),
+			),
+			jubako.WithReadOnly(),
+			jubako.WithOptional(),
+			jubako.WithNoWatch(),
+		); err != nil {
+			return nil, err
+		}
 	}
 	if err := store.Add(
 		layer.New(
@@ -138,10 +182,12 @@ func newConfigStore() (*Store, error) {
 	}
 
 	return &Store{
-		store:             store,
-		activeProfile:     DefaultProfile,
-		projectConfigPath: projectConfigPath,
-		credentialsPath:   credentialsPath,
+		store:              store,
+		activeProfile:      DefaultProfile,
+		projectConfigPath:  projectConfigPath,
+		projectConfigPaths: projectConfigPaths,
+		credentialsPath:    credentialsPath,
+		credentialSource:   credentialSource,
 	}, nil
 }
 
@@ -163,9 +209,55 @@ func (s *Store) LoadAll(ctx context.Context) error {
 		s.activeProfile = resolved.Project.Profile
 	}
 
+	s.applyCredentialEncryption(resolved.CredentialEncryption)
 	return nil
 }
 
+// applyCredentialEncryption は credential.encryption を credentials.yaml の書き込みに反映する
+// 復号や既存ファイルの暗号化はクレデンシャルを読み書きするとき（unlockCredentials）まで行わない。
+// 設定値の誤りもその時点で報告し、config コマンドで直せるようにする。
+func (s *Store) applyCredentialEncryption(value string) {
+	s.credentialErr = nil
+	if s.credentialSource == nil {
+		return
+	}
+	encryption, err := NormalizeCredentialEncryption(value)
+	if err != nil {
+		s.credentialErr = fmt.Errorf("invalid credential.encryption: %w", err)
+		return
+	}
+	s.credentialSource.SetEnabled(encryption == CredentialEncryptionAge)
+}
+
+// unlockCredentials は暗号化された credentials.yaml を復号して読み直す
+// 暗号化が有効で平文のファイルが残っていれば、ここで暗号化して書き戻す。
+// 失敗した場合はクレデンシャルを未設定として扱い、理由を CredentialError で返す。
+// 呼び出し側で s.mu をロックしていること。
+func (s *Store) unlockCredentials(ctx context.Context) error {
+	if s.credentialSource == nil || s.credentialErr != nil {
+		return s.credentialErr
+	}
+	reload, err := s.credentialSource.Unlock()
+	if err == nil && reload {
+		err = s.store.Reload(ctx)
+	}
+	if err == nil {
+		if encErr := s.credentialSource.EncryptIfNeeded(ctx); encErr != nil {
+			err = fmt.Errorf("failed to encrypt credentials file: %w", encErr)
+		}
+	}
+	s.credentialErr = err
+	return err
+}
+
+// CredentialError は credentials.yaml を復号・暗号化できなかった理由を返す（問題がなければ nil）
+// identity が見つからない場合など。Credential や CurrentCredential が nil を返したときの原因の確認に使う
+func (s *Store) CredentialError() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.unlockCredentials(context.Background())
+}
+
 // SetFlagsLayer はコマンドラインフラグからのオーバーライドを設定する
 // LayerArgs は静的に追加済みなので、SetTo で値を設定する
 func (s *Store) SetFlagsLayer(options []jubako.SetOption) error {
@@ -183,6 +275,7 @@ func (s *Store) Reload(ctx context.Context) error {
 		return err
 	}
 
+	s.applyCredentialEncryption(s.store.Get().CredentialEncryption)
 	return nil
 }
 
@@ -434,12 +527,16 @@ func (s *Store) ResolveRelayURL(profile *ResolvedProfile) (string, error) {
 }
 
 // Credential は指定プロファイルのクレデンシャルを取得する
+// credentials.yaml が暗号化されていればここで復号する。復号できない場合は nil を返す（理由は CredentialError）
 func (s *Store) Credential(profileName string) *Credential {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if profileName == "" {
 		profileName = DefaultProfile
 	}
+	if s.unlockCredentials(context.Background()) != nil {
+		return nil
+	}
 	resolved := s.materialized()
 	if resolved.Credentials == nil {
 		return nil
@@ -447,10 +544,47 @@ func (s *Store) Credential(profileName string) *Credential {
 	return resolved.Credentials[profileName]
 }
 
+// LatestCredential は保存先（credentials.yaml / keyring）から指定プロファイルの
+// クレデンシャルを読み直して返す。他のプロセスが更新したトークンを取り込むために使う
+// 読み込んだ値はこのストアにも反映する（保存はしない）
+func (s *Store) LatestCredential(ctx context.Context, profileName string) (*Credential, error) {
+	if profileName == "" {
+		profileName = DefaultProfile
+	}
+	fresh, err := newConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	if err := fresh.LoadAll(ctx); err != nil {
+		return nil, err
+	}
+	cred := fresh.Credential(profileName)
+	if cred == nil {
+		return nil, fresh.CredentialError()
+	}
+	if err := s.SetCredential(profileName, cred); err != nil {
+		return nil, err
+	}
+	return cred, nil
+}
+
+// TokenRefreshLockPath はトークン更新を複数プロセス間で直列化するロックファイルのパスを返す
+func (s *Store) TokenRefreshLockPath() string {
+	path := s.GetCredentialsPath()
+	if path == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(path), "token-refresh.lock")
+}
+
 // CurrentCredential はアクティブプロファイルのクレデンシャルを取得する
+// 復号については Credential を参照
 func (s *Store) CurrentCredential() *Credential {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.unlockCredentials(context.Background()) != nil {
+		return nil
+	}
 	resolved := s.materialized()
 	if resolved.Credentials == nil {
 		return nil
@@ -482,6 +616,144 @@ func (s *Store) AISummary() *ResolvedAISummary {
 	return &resolved.AISummary
 }
 
+// Hooks はフック設定を取得する
+func (s *Store) Hooks() *ResolvedHooks {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	resolved := s.store.Get()
+	return &resolved.Hooks
+}
+
+// IssueDefaults は課題作成の既定値を取得する
+func (s *Store) IssueDefaults() *ResolvedIssueDefaults {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	resolved := s.store.Get()
+	return &resolved.IssueDefaults
+}
+
+// Translate は翻訳設定を取得する
+func (s *Store) Translate() *ResolvedTranslate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	resolved := s.store.Get()
+	return &resolved.Translate
+}
+
+// TranslateCommand は翻訳コマンドを返す。name が空なら translate.command、
+// それ以外は translate.translators.<name> を参照する（未定義なら ok に false を返す）
+// フックと同様に、プロジェクト設定 (.backlog.yaml) で定義されたコマンドは無視し、ignored に true を返す
+func (s *Store) TranslateCommand(name string) (command string, ok, ignored bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	path := PathTranslateCommand
+	if name != "" {
+		path = PathTranslateTranslatorsKey(name)
+	}
+	rv := s.store.GetAt(path)
+	if !rv.Exists {
+		return "", false, false
+	}
+	value, _ := rv.Value.(string)
+	if value == "" {
+		return "", false, false
+	}
+	if rv.Layer != nil && IsProjectLayer(string(rv.Layer.Name())) {
+		return "", true, true
+	}
+	return value, true, false
+}
+
+// IssueHook は issue サブコマンドのフック（phase は "pre" または "post"）を返す
+// プロジェクト設定 (.backlog.yaml) で定義されたフックは、リポジトリを clone しただけで
+// 任意のコマンドが実行されないよう無視し、ignored に true を返す
+func (s *Store) IssueHook(command, phase string) (hook string, ignored bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	path := PathHooksIssuePost(command)
+	if phase == "pre" {
+		path = PathHooksIssuePre(command)
+	}
+	rv := s.store.GetAt(path)
+	if !rv.Exists {
+		return "", false
+	}
+	value, _ := rv.Value.(string)
+	if value == "" {
+		return "", false
+	}
+	if rv.Layer != nil && IsProjectLayer(string(rv.Layer.Name())) {
+		return "", true
+	}
+	return value, false
+}
+
+// ProfileBrowser は現在のプロファイルの browser 設定を返す
+// フックと同様に、プロジェクト設定 (.backlog.yaml) で定義された値は
+// リポジトリを clone しただけで任意のコマンドが実行されないよう無視し、ignored に true を返す
+func (s *Store) ProfileBrowser() (browser string, ignored bool) {
+	return s.profileCommand(PathProfileBrowser)
+}
+
+// ProfileEditor は現在のプロファイルの editor 設定を返す
+// browser と同様に、プロジェクト設定 (.backlog.yaml) で定義された値は無視し、ignored に true を返す
+func (s *Store) ProfileEditor() (editor string, ignored bool) {
+	return s.profileCommand(PathProfileEditor)
+}
+
+// profileCommand は現在のプロファイルのコマンド設定を返す。プロジェクト設定で定義された値は無視する
+func (s *Store) profileCommand(path func(key string) string) (command string, ignored bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	name := s.activeProfile
+	if _, ok := s.store.Get().Profiles[name]; name == "" || !ok {
+		name = DefaultProfile
+	}
+	rv := s.store.GetAt(path(name))
+	if !rv.Exists {
+		return "", false
+	}
+	value, _ := rv.Value.(string)
+	if value == "" {
+		return "", false
+	}
+	if rv.Layer != nil && IsProjectLayer(string(rv.Layer.Name())) {
+		return "", true
+	}
+	return value, false
+}
+
+// AttachmentPolicy は添付ファイルのポリシーを返す
+// フックと同様に、プロジェクト設定 (.backlog.yaml) で定義された security.attachment.scan_command は
+// リポジトリを clone しただけで任意のコマンドが実行されないよう無視し、scanIgnored に true を返す
+func (s *Store) AttachmentPolicy() (policy *ResolvedAttachmentPolicy, scanIgnored bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resolved := s.store.Get()
+	p := resolved.Security.Attachment
+	if p.ScanCommand != "" {
+		rv := s.store.GetAt(PathSecurityAttachmentScanCommand)
+		if rv.Layer != nil && IsProjectLayer(string(rv.Layer.Name())) {
+			p.ScanCommand = ""
+			p.ScanArgs = nil
+			scanIgnored = true
+		}
+	}
+	return &p, scanIgnored
+}
+
+// Security はセキュリティ設定を取得する
+func (s *Store) Security() *ResolvedSecurity {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	resolved := s.store.Get()
+	return &resolved.Security
+}
+
 // Auth は認証設定を取得する
 func (s *Store) Auth() *ResolvedAuth {
 	s.mu.RLock()
@@ -505,6 +777,36 @@ func (s *Store) GetProjectConfigPath() string {
 	return s.projectConfigPath
 }
 
+// ProjectConfigPaths はマージ対象のプロジェクト設定ファイルのパスを優先度の高い（近い）順に返す
+// SetProjectConfigPath で書き込み先が変更されていても、読み込み時に見つかったファイルを返す
+func (s *Store) ProjectConfigPaths() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.projectConfigPaths)
+}
+
+// ValueSource は設定値の出所
+type ValueSource struct {
+	Layer string // レイヤー名（project:1 は1つ上のディレクトリのプロジェクト設定）
+	Path  string // ファイルパス（ファイル以外のレイヤーは空）
+}
+
+// Which は指定キーの値と、その値を提供したレイヤー・ファイルを返す（CLIコマンド用）
+func (s *Store) Which(key string) (any, ValueSource, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rv := s.store.GetAt(DotToPointer(key))
+	if !rv.Exists || rv.Layer == nil {
+		return nil, ValueSource{}, false
+	}
+	src := ValueSource{Layer: string(rv.Layer.Name())}
+	if info := s.store.GetLayerInfo(rv.Layer.Name()); info != nil {
+		src.Path = info.Path()
+	}
+	return rv.Value, src, true
+}
+
 // GetUserConfigPath はユーザー設定ファイルのパスを返す
 func (s *Store) GetUserConfigPath() string {
 	s.mu.RLock()
@@ -575,6 +877,10 @@ func (s *Store) SetCredential(profileName string, cred *Credential) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if err := s.unlockCredentials(context.Background()); err != nil {
+		return err
+	}
+
 	return s.store.Set(LayerCredentials,
 		jubako.Struct(PathCredential+"/"+profileName, cred),
 		jubako.SkipZeroValues(),
@@ -589,6 +895,9 @@ func (s *Store) DeleteCredential(profileName string) error {
 	if s.store.GetLayer(LayerCredentials) == nil {
 		return nil // クレデンシャルレイヤーがなければ何もしない
 	}
+	if err := s.unlockCredentials(context.Background()); err != nil {
+		return err
+	}
 
 	// コンテナパスで一括削除
 	return s.store.DeleteFrom(LayerCredentials, PathCredential+"/"+profileName)
@@ -698,6 +1007,7 @@ type WalkEntry struct {
 	Path         string // ドット区切りのパス
 	Value        any    // マスク済みの値
 	Layer        string // 値の出所となるレイヤー名
+	Origin       string // 値の出所となるファイルのパス（ファイルでないレイヤーは空）
 	DefaultValue any    // デフォルト値（存在しない場合は nil）
 }
 
@@ -716,9 +1026,12 @@ func (s *Store) WalkEx(fn WalkExFunc) {
 		}
 		// /profile/default/space → profile.default.space
 		key := strings.ReplaceAll(ctx.Path[1:], "/", ".")
-		layerName := ""
+		layerName, origin := "", ""
 		if rv.Layer != nil {
 			layerName = string(rv.Layer.Name())
+			if info := s.store.GetLayerInfo(rv.Layer.Name()); info != nil {
+				origin = info.Path()
+			}
 		}
 
 		// デフォルト値を取得
@@ -734,6 +1047,7 @@ func (s *Store) WalkEx(fn WalkExFunc) {
 			Path:         key,
 			Value:        rv.Value,
 			Layer:        layerName,
+			Origin:       origin,
 			DefaultValue: defaultValue,
 		})
 	})
@@ -458,3 +458,143 @@ func TestSpaceNormalizationSurvivesFlagLayer(t *testing.T) {
 		t.Errorf("after SetFlagsLayer (Resolved): Space = %q, want %q", p.Space, want)
 	}
 }
+
+func TestIssueHookIgnoresProjectConfig(t *testing.T) {
+	ctx := t.Context()
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore failed: %v", err)
+	}
+	if err := store.LoadAll(ctx); err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+
+	if err := store.SetToLayer(LayerProject, "hooks.issue.create.post", "./from-repo.sh"); err != nil {
+		t.Fatalf("SetToLayer(project) failed: %v", err)
+	}
+	if err := store.SetToLayer(LayerArgs, "hooks.issue.close.pre", "./check.sh {{.Key}}"); err != nil {
+		t.Fatalf("SetToLayer(args) failed: %v", err)
+	}
+
+	if hook, ignored := store.IssueHook("create", "post"); hook != "" || !ignored {
+		t.Errorf("IssueHook(create, post) = (%q, %v), want (\"\", true)", hook, ignored)
+	}
+	if hook, ignored := store.IssueHook("close", "pre"); hook != "./check.sh {{.Key}}" || ignored {
+		t.Errorf("IssueHook(close, pre) = (%q, %v), want (\"./check.sh {{.Key}}\", false)", hook, ignored)
+	}
+	if hook, ignored := store.IssueHook("edit", "post"); hook != "" || ignored {
+		t.Errorf("IssueHook(edit, post) = (%q, %v), want (\"\", false)", hook, ignored)
+	}
+}
+
+func TestAttachmentPolicyIgnoresProjectScanCommand(t *testing.T) {
+	ctx := t.Context()
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore failed: %v", err)
+	}
+	if err := store.LoadAll(ctx); err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+
+	if policy, ignored := store.AttachmentPolicy(); policy.Enabled || policy.ScanCommand != "" || ignored {
+		t.Errorf("default AttachmentPolicy() = (%+v, %v), want disabled without scan command", policy, ignored)
+	}
+
+	if err := store.SetToLayer(LayerProject, "security.attachment.scan_command", "./from-repo.sh"); err != nil {
+		t.Fatalf("SetToLayer(project) failed: %v", err)
+	}
+	if policy, ignored := store.AttachmentPolicy(); policy.ScanCommand != "" || !ignored {
+		t.Errorf("AttachmentPolicy() = (%q, %v), want project scan command ignored", policy.ScanCommand, ignored)
+	}
+
+	if err := store.SetToLayer(LayerArgs, "security.attachment.scan_command", "clamscan"); err != nil {
+		t.Fatalf("SetToLayer(args) failed: %v", err)
+	}
+	if policy, ignored := store.AttachmentPolicy(); policy.ScanCommand != "clamscan" || ignored {
+		t.Errorf("AttachmentPolicy() = (%q, %v), want (\"clamscan\", false)", policy.ScanCommand, ignored)
+	}
+}
+
+func TestTranslateCommandIgnoresProjectConfig(t *testing.T) {
+	ctx := t.Context()
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore failed: %v", err)
+	}
+	if err := store.LoadAll(ctx); err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+
+	if err := store.SetToLayer(LayerProject, "translate.command", "./from-repo.sh"); err != nil {
+		t.Fatalf("SetToLayer(project) failed: %v", err)
+	}
+	if err := store.SetToLayer(LayerArgs, "translate.translators.deepl", "deepl-cli --to {lang}"); err != nil {
+		t.Fatalf("SetToLayer(args) failed: %v", err)
+	}
+
+	if cmd, ok, ignored := store.TranslateCommand(""); cmd != "" || !ok || !ignored {
+		t.Errorf("TranslateCommand(\"\") = (%q, %v, %v), want (\"\", true, true)", cmd, ok, ignored)
+	}
+	if cmd, ok, ignored := store.TranslateCommand("deepl"); cmd != "deepl-cli --to {lang}" || !ok || ignored {
+		t.Errorf("TranslateCommand(deepl) = (%q, %v, %v), want (\"deepl-cli --to {lang}\", true, false)", cmd, ok, ignored)
+	}
+	if cmd, ok, ignored := store.TranslateCommand("missing"); cmd != "" || ok || ignored {
+		t.Errorf("TranslateCommand(missing) = (%q, %v, %v), want (\"\", false, false)", cmd, ok, ignored)
+	}
+}
+
+func TestProfileBrowserIgnoresProjectConfig(t *testing.T) {
+	ctx := t.Context()
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore failed: %v", err)
+	}
+	if err := store.LoadAll(ctx); err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+
+	if err := store.SetToLayer(LayerProject, "profile.default.browser", "./from-repo.sh"); err != nil {
+		t.Fatalf("SetToLayer(project) failed: %v", err)
+	}
+	if browser, ignored := store.ProfileBrowser(); browser != "" || !ignored {
+		t.Errorf("ProfileBrowser() = (%q, %v), want (\"\", true)", browser, ignored)
+	}
+
+	if err := store.SetToLayer(LayerArgs, "profile.default.browser", "firefox -P work"); err != nil {
+		t.Fatalf("SetToLayer(args) failed: %v", err)
+	}
+	if browser, ignored := store.ProfileBrowser(); browser != "firefox -P work" || ignored {
+		t.Errorf("ProfileBrowser() = (%q, %v), want (\"firefox -P work\", false)", browser, ignored)
+	}
+}
+
+func TestProfileEditorIgnoresProjectConfig(t *testing.T) {
+	ctx := t.Context()
+
+	store, err := newConfigStore()
+	if err != nil {
+		t.Fatalf("newConfigStore failed: %v", err)
+	}
+	if err := store.LoadAll(ctx); err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+
+	if err := store.SetToLayer(LayerProject, "profile.default.editor", "./from-repo.sh"); err != nil {
+		t.Fatalf("SetToLayer(project) failed: %v", err)
+	}
+	if editor, ignored := store.ProfileEditor(); editor != "" || !ignored {
+		t.Errorf("ProfileEditor() = (%q, %v), want (\"\", true)", editor, ignored)
+	}
+
+	if err := store.SetToLayer(LayerArgs, "profile.default.editor", "code --wait"); err != nil {
+		t.Fatalf("SetToLayer(args) failed: %v", err)
+	}
+	if editor, ignored := store.ProfileEditor(); editor != "code --wait" || ignored {
+		t.Errorf("ProfileEditor() = (%q, %v), want (\"code --wait\", false)", editor, ignored)
+	}
+}
@@ -1,5 +1,13 @@
 package config
 
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/relaysig"
+)
+
 // ResolvedClient はマージ済みのクライアント設定
 type ResolvedClient struct {
 	Trust ResolvedClientTrust `json:"trust" jubako:"/client/trust"`
@@ -23,6 +31,10 @@ type TrustedBundle struct {
 	CertsCacheTTL int               `json:"certs_cache_ttl" yaml:"certs_cache_ttl"`
 	Source        BundleSource      `json:"source" yaml:"source"`
 	ImportedAt    string            `json:"imported_at" yaml:"imported_at"`
+	// Policy はバンドルに同梱された policy.yaml（無ければ nil）
+	Policy *BundlePolicy `json:"policy,omitempty" yaml:"policy,omitempty"`
+	// OrgDefaults は今回のインポートで settings.yaml を組織デフォルトとして取り込んだか（保存しない）
+	OrgDefaults bool `json:"-" yaml:"-"`
 
 	// Deprecated: v1 互換のための読み込み専用フィールド。
 	// 旧 config.yaml の id / allowed_domain を Name に移送するためだけに用いる。
@@ -84,3 +96,38 @@ func FindTrustedBundleByRelayURL(store *Store, relayURL string) *TrustedBundle {
 	}
 	return nil
 }
+
+// RelaySignerForProfile はプロファイルの relay_signing_key から中継サーバー向けの
+// リクエスト署名器を作成する。鍵が未設定の場合は nil を返す。
+// プロファイルがバンドルを参照していれば、その bundle_token も併せて送信する。
+func RelaySignerForProfile(store *Store, profile *ResolvedProfile) (*relaysig.Signer, error) {
+	if profile == nil || strings.TrimSpace(profile.RelaySigningKey) == "" {
+		return nil, nil
+	}
+	bundleToken := ""
+	if b := FindTrustedBundleByName(store, profile.Bundle); b != nil {
+		bundleToken = b.BundleToken
+	}
+	return relaysig.LoadSigner(profile.RelaySigningKey, bundleToken)
+}
+
+// RelayTLSConfigForProfile はプロファイルの relay_client_cert / relay_client_key から
+// 中継サーバーへの接続に使う TLS 設定（クライアント証明書）を作成する。未設定の場合は nil を返す。
+func RelayTLSConfigForProfile(profile *ResolvedProfile) (*tls.Config, error) {
+	if profile == nil {
+		return nil, nil
+	}
+	certFile := strings.TrimSpace(profile.RelayClientCert)
+	keyFile := strings.TrimSpace(profile.RelayClientKey)
+	if certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("relay_client_cert and relay_client_key must be set together")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load relay client certificate: %w", err)
+	}
+	return &tls.Config{Certificates: []tls.Certificate{cert}}, nil
+}
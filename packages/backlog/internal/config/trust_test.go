@@ -1,7 +1,17 @@
 package config
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestFindTrustedBundleByName(t *testing.T) {
@@ -128,3 +138,48 @@ func TestFindTrustedBundleByName(t *testing.T) {
 		}
 	})
 }
+
+func TestRelayTLSConfigForProfile(t *testing.T) {
+	dir := t.TempDir()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "alice"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg, err := RelayTLSConfigForProfile(&ResolvedProfile{}); err != nil || cfg != nil {
+		t.Errorf("unset profile = %v, %v; want nil, nil", cfg, err)
+	}
+	if _, err := RelayTLSConfigForProfile(&ResolvedProfile{RelayClientCert: certFile}); err == nil {
+		t.Error("expected an error when relay_client_key is missing")
+	}
+	cfg, err := RelayTLSConfigForProfile(&ResolvedProfile{RelayClientCert: certFile, RelayClientKey: keyFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Certificates) != 1 {
+		t.Errorf("certificates = %d, want 1", len(cfg.Certificates))
+	}
+}
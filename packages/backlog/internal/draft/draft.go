@@ -0,0 +1,152 @@
+// Package draft は投稿できなかったコメントをローカルに下書きとして保存し、
+// 後で再開するための保存先を扱う。
+//
+// 下書きはディレクトリ内の JSON ファイル（1件1ファイル）で、
+// `backlog draft resume` でエディタに読み込み直して投稿する。
+package draft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Draft は保存した下書き
+type Draft struct {
+	ID       int       `json:"id"`
+	Space    string    `json:"space"`
+	IssueKey string    `json:"issueKey"`
+	Content  string    `json:"content"`
+	SavedAt  time.Time `json:"savedAt"`
+	// Reason は下書きとして保存した理由（エディタの異常終了や API エラーなど）
+	Reason string `json:"reason,omitempty"`
+}
+
+// Summary は一覧表示用に下書きの先頭行を返す
+func (d *Draft) Summary() string {
+	if line, _, found := strings.Cut(d.Content, "\n"); found {
+		return line + " ..."
+	}
+	return d.Content
+}
+
+// Dir は下書きの保存ディレクトリ
+type Dir struct {
+	path string
+}
+
+// Open は path の下書きディレクトリを扱う Dir を返す（ディレクトリは書き込み時に作成する）
+func Open(path string) *Dir {
+	return &Dir{path: path}
+}
+
+// Path は下書きディレクトリのパスを返す
+func (d *Dir) Path() string {
+	return d.path
+}
+
+func (d *Dir) file(id int) string {
+	return filepath.Join(d.path, strconv.Itoa(id)+".json")
+}
+
+// List は下書きを古い順に返す
+func (d *Dir) List() ([]Draft, error) {
+	entries, err := os.ReadDir(d.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read drafts: %w", err)
+	}
+	var drafts []Draft
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			continue
+		}
+		dr, err := d.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		drafts = append(drafts, *dr)
+	}
+	sort.Slice(drafts, func(i, j int) bool { return drafts[i].ID < drafts[j].ID })
+	return drafts, nil
+}
+
+// Get は ID の下書きを返す
+func (d *Dir) Get(id int) (*Draft, error) {
+	data, err := os.ReadFile(d.file(id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("draft #%d not found", id)
+		}
+		return nil, fmt.Errorf("read draft: %w", err)
+	}
+	var dr Draft
+	if err := json.Unmarshal(data, &dr); err != nil {
+		return nil, fmt.Errorf("parse draft %s: %w", d.file(id), err)
+	}
+	dr.ID = id
+	return &dr, nil
+}
+
+// Add は下書きを保存し、採番した ID を設定して返す
+func (d *Dir) Add(dr Draft) (Draft, error) {
+	drafts, err := d.List()
+	if err != nil {
+		return Draft{}, err
+	}
+	dr.ID = 1
+	for _, existing := range drafts {
+		if existing.ID >= dr.ID {
+			dr.ID = existing.ID + 1
+		}
+	}
+	if err := d.Save(dr); err != nil {
+		return Draft{}, err
+	}
+	return dr, nil
+}
+
+// Save は下書きを ID のファイルに書き込む（既存の下書きは上書きする）
+func (d *Dir) Save(dr Draft) error {
+	data, err := json.MarshalIndent(dr, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode draft: %w", err)
+	}
+	if err := os.MkdirAll(d.path, 0o700); err != nil {
+		return fmt.Errorf("create drafts directory: %w", err)
+	}
+	// 書きかけで中断しても既存の下書きを壊さないよう、一時ファイルから置き換える
+	path := d.file(dr.ID)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write draft: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write draft: %w", err)
+	}
+	return nil
+}
+
+// Delete は ID の下書きを削除する
+func (d *Dir) Delete(id int) error {
+	if err := os.Remove(d.file(id)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("draft #%d not found", id)
+		}
+		return fmt.Errorf("remove draft: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package draft
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDir_AddListDelete(t *testing.T) {
+	d := Open(filepath.Join(t.TempDir(), "drafts"))
+
+	drafts, err := d.List()
+	if err != nil || len(drafts) != 0 {
+		t.Fatalf("List() on missing dir = %v, %v", drafts, err)
+	}
+
+	first, err := d.Add(Draft{Space: "example.backlog.jp", IssueKey: "PROJ-1", Content: "line1\nline2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := d.Add(Draft{Space: "example.backlog.jp", IssueKey: "PROJ-2", Content: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID != 1 || second.ID != 2 {
+		t.Errorf("IDs = %d, %d, want 1, 2", first.ID, second.ID)
+	}
+
+	// 一時ファイルや関係ないファイルは無視する
+	if err := os.WriteFile(filepath.Join(d.Path(), "note.txt"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	drafts, err = d.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(drafts) != 2 || drafts[0].IssueKey != "PROJ-1" || drafts[1].IssueKey != "PROJ-2" {
+		t.Fatalf("List() = %+v", drafts)
+	}
+	if got := drafts[0].Summary(); got != "line1 ..." {
+		t.Errorf("Summary() = %q", got)
+	}
+
+	// 上書き保存
+	second.Content = "edited"
+	if err := d.Save(second); err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.Get(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Content != "edited" {
+		t.Errorf("Content = %q, want edited", got.Content)
+	}
+
+	if err := d.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Delete(1); err == nil {
+		t.Error("Delete() of missing draft should fail")
+	}
+	if _, err := d.Get(1); err == nil {
+		t.Error("Get() of deleted draft should fail")
+	}
+
+	// 削除済みでも最大の ID の次を採番する
+	third, err := d.Add(Draft{IssueKey: "PROJ-3", Content: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.ID != 3 {
+		t.Errorf("ID = %d, want 3", third.ID)
+	}
+}
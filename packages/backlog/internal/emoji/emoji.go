@@ -0,0 +1,385 @@
+// Package emoji は本文中の :shortcode: と Unicode 絵文字を相互に変換する。
+//
+// 対応する shortcode は GitHub / Slack で広く使われている名前のうち、課題やコメントで
+// よく使うものに絞っている。表にない shortcode や絵文字はそのまま残す。
+package emoji
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// shortcodeTable は shortcode と絵文字の対応
+// 同じ絵文字に複数の名前がある場合、逆変換（Shorten）では表の先頭の名前を使う
+var shortcodeTable = []struct {
+	name  string
+	emoji string
+}{
+	// 表情
+	{"smile", "😄"},
+	{"smiley", "😃"},
+	{"grinning", "😀"},
+	{"grin", "😁"},
+	{"laughing", "😆"},
+	{"satisfied", "😆"},
+	{"sweat_smile", "😅"},
+	{"joy", "😂"},
+	{"rofl", "🤣"},
+	{"slightly_smiling_face", "🙂"},
+	{"upside_down_face", "🙃"},
+	{"wink", "😉"},
+	{"blush", "😊"},
+	{"innocent", "😇"},
+	{"heart_eyes", "😍"},
+	{"star_struck", "🤩"},
+	{"kissing_heart", "😘"},
+	{"yum", "😋"},
+	{"stuck_out_tongue", "😛"},
+	{"thinking", "🤔"},
+	{"thinking_face", "🤔"},
+	{"neutral_face", "😐"},
+	{"expressionless", "😑"},
+	{"no_mouth", "😶"},
+	{"smirk", "😏"},
+	{"unamused", "😒"},
+	{"roll_eyes", "🙄"},
+	{"grimacing", "😬"},
+	{"relieved", "😌"},
+	{"pensive", "😔"},
+	{"sleepy", "😪"},
+	{"sleeping", "😴"},
+	{"mask", "😷"},
+	{"nerd_face", "🤓"},
+	{"sunglasses", "😎"},
+	{"confused", "😕"},
+	{"worried", "😟"},
+	{"slightly_frowning_face", "🙁"},
+	{"open_mouth", "😮"},
+	{"astonished", "😲"},
+	{"flushed", "😳"},
+	{"pleading_face", "🥺"},
+	{"fearful", "😨"},
+	{"cold_sweat", "😰"},
+	{"cry", "😢"},
+	{"sob", "😭"},
+	{"scream", "😱"},
+	{"confounded", "😖"},
+	{"persevere", "😣"},
+	{"disappointed", "😞"},
+	{"sweat", "😓"},
+	{"weary", "😩"},
+	{"tired_face", "😫"},
+	{"triumph", "😤"},
+	{"rage", "😡"},
+	{"angry", "😠"},
+	{"exploding_head", "🤯"},
+	{"partying_face", "🥳"},
+	{"skull", "💀"},
+	{"poop", "💩"},
+	{"hankey", "💩"},
+	{"ghost", "👻"},
+	{"robot", "🤖"},
+	{"see_no_evil", "🙈"},
+	// 手・人
+	{"+1", "👍"},
+	{"thumbsup", "👍"},
+	{"-1", "👎"},
+	{"thumbsdown", "👎"},
+	{"ok_hand", "👌"},
+	{"clap", "👏"},
+	{"raised_hands", "🙌"},
+	{"pray", "🙏"},
+	{"wave", "👋"},
+	{"muscle", "💪"},
+	{"point_up", "☝️"},
+	{"point_right", "👉"},
+	{"point_left", "👈"},
+	{"point_down", "👇"},
+	{"v", "✌️"},
+	{"crossed_fingers", "🤞"},
+	{"handshake", "🤝"},
+	{"raised_hand", "✋"},
+	{"hand", "✋"},
+	{"fist", "✊"},
+	{"facepalm", "🤦"},
+	{"man_shrugging", "🤷‍♂️"},
+	{"woman_shrugging", "🤷‍♀️"},
+	{"shrug", "🤷"},
+	{"eyes", "👀"},
+	{"bow", "🙇"},
+	// 記号・ハート
+	{"heart", "❤️"},
+	{"broken_heart", "💔"},
+	{"sparkling_heart", "💖"},
+	{"blue_heart", "💙"},
+	{"green_heart", "💚"},
+	{"yellow_heart", "💛"},
+	{"purple_heart", "💜"},
+	{"100", "💯"},
+	{"white_check_mark", "✅"},
+	{"heavy_check_mark", "✔️"},
+	{"ballot_box_with_check", "☑️"},
+	{"x", "❌"},
+	{"negative_squared_cross_mark", "❎"},
+	{"heavy_multiplication_x", "✖️"},
+	{"o", "⭕"},
+	{"warning", "⚠️"},
+	{"no_entry", "⛔"},
+	{"no_entry_sign", "🚫"},
+	{"exclamation", "❗"},
+	{"heavy_exclamation_mark", "❗"},
+	{"question", "❓"},
+	{"grey_question", "❔"},
+	{"bangbang", "‼️"},
+	{"interrobang", "⁉️"},
+	{"information_source", "ℹ️"},
+	{"red_circle", "🔴"},
+	{"large_blue_circle", "🔵"},
+	{"green_circle", "🟢"},
+	{"yellow_circle", "🟡"},
+	{"white_circle", "⚪"},
+	{"black_circle", "⚫"},
+	{"arrow_right", "➡️"},
+	{"arrow_left", "⬅️"},
+	{"arrow_up", "⬆️"},
+	{"arrow_down", "⬇️"},
+	{"arrows_counterclockwise", "🔄"},
+	{"repeat", "🔁"},
+	{"new", "🆕"},
+	{"free", "🆓"},
+	{"up", "🆙"},
+	{"cool", "🆒"},
+	{"ok", "🆗"},
+	{"sos", "🆘"},
+	{"heavy_plus_sign", "➕"},
+	{"heavy_minus_sign", "➖"},
+	{"zzz", "💤"},
+	{"boom", "💥"},
+	{"collision", "💥"},
+	{"sparkles", "✨"},
+	{"star", "⭐"},
+	{"star2", "🌟"},
+	{"dizzy", "💫"},
+	{"fire", "🔥"},
+	{"zap", "⚡"},
+	{"droplet", "💧"},
+	{"speech_balloon", "💬"},
+	{"thought_balloon", "💭"},
+	// 物・作業
+	{"tada", "🎉"},
+	{"confetti_ball", "🎊"},
+	{"balloon", "🎈"},
+	{"gift", "🎁"},
+	{"trophy", "🏆"},
+	{"medal", "🏅"},
+	{"rocket", "🚀"},
+	{"construction", "🚧"},
+	{"rotating_light", "🚨"},
+	{"bug", "🐛"},
+	{"beetle", "🐞"},
+	{"lady_beetle", "🐞"},
+	{"ant", "🐜"},
+	{"wrench", "🔧"},
+	{"hammer", "🔨"},
+	{"hammer_and_wrench", "🛠️"},
+	{"gear", "⚙️"},
+	{"mag", "🔍"},
+	{"mag_right", "🔎"},
+	{"lock", "🔒"},
+	{"unlock", "🔓"},
+	{"key", "🔑"},
+	{"link", "🔗"},
+	{"paperclip", "📎"},
+	{"pushpin", "📌"},
+	{"round_pushpin", "📍"},
+	{"memo", "📝"},
+	{"pencil", "📝"},
+	{"pencil2", "✏️"},
+	{"book", "📖"},
+	{"books", "📚"},
+	{"bookmark", "🔖"},
+	{"clipboard", "📋"},
+	{"calendar", "📅"},
+	{"date", "📅"},
+	{"chart_with_upwards_trend", "📈"},
+	{"chart_with_downwards_trend", "📉"},
+	{"bar_chart", "📊"},
+	{"file_folder", "📁"},
+	{"open_file_folder", "📂"},
+	{"package", "📦"},
+	{"email", "📧"},
+	{"envelope", "✉️"},
+	{"inbox_tray", "📥"},
+	{"outbox_tray", "📤"},
+	{"bell", "🔔"},
+	{"no_bell", "🔕"},
+	{"loudspeaker", "📢"},
+	{"mega", "📣"},
+	{"bulb", "💡"},
+	{"computer", "💻"},
+	{"desktop_computer", "🖥️"},
+	{"keyboard", "⌨️"},
+	{"iphone", "📱"},
+	{"phone", "☎️"},
+	{"telephone", "☎️"},
+	{"camera", "📷"},
+	{"hourglass", "⌛"},
+	{"hourglass_flowing_sand", "⏳"},
+	{"alarm_clock", "⏰"},
+	{"stopwatch", "⏱️"},
+	{"watch", "⌚"},
+	{"moneybag", "💰"},
+	{"dollar", "💵"},
+	{"yen", "💴"},
+	{"credit_card", "💳"},
+	{"recycle", "♻️"},
+	{"triangular_flag_on_post", "🚩"},
+	{"checkered_flag", "🏁"},
+	{"dart", "🎯"},
+	{"art", "🎨"},
+	{"lipstick", "💄"},
+	{"truck", "🚚"},
+	{"ambulance", "🚑"},
+	{"fire_engine", "🚒"},
+	{"racehorse", "🐎"},
+	{"turtle", "🐢"},
+	{"snail", "🐌"},
+	{"coffee", "☕"},
+	{"beer", "🍺"},
+	{"beers", "🍻"},
+	{"pizza", "🍕"},
+	{"cake", "🍰"},
+	{"birthday", "🎂"},
+	{"sushi", "🍣"},
+	{"ramen", "🍜"},
+	{"rice_ball", "🍙"},
+	// 自然
+	{"sunny", "☀️"},
+	{"cloud", "☁️"},
+	{"umbrella", "☔"},
+	{"snowflake", "❄️"},
+	{"rainbow", "🌈"},
+	{"ocean", "🌊"},
+	{"earth_asia", "🌏"},
+	{"seedling", "🌱"},
+	{"four_leaf_clover", "🍀"},
+	{"cherry_blossom", "🌸"},
+	{"tulip", "🌷"},
+	{"rose", "🌹"},
+	{"sunflower", "🌻"},
+	{"cat", "🐱"},
+	{"dog", "🐶"},
+	{"penguin", "🐧"},
+	{"frog", "🐸"},
+	{"tiger", "🐯"},
+	{"panda_face", "🐼"},
+	{"unicorn", "🦄"},
+	{"whale", "🐳"},
+	{"octopus", "🐙"},
+	{"bee", "🐝"},
+	{"honeybee", "🐝"},
+}
+
+var (
+	byName  map[string]string
+	byEmoji map[string]string
+	// emojiPattern は表にある絵文字の正規表現（長いものを優先して照合する）
+	emojiPattern *regexp.Regexp
+
+	reShortcode = regexp.MustCompile(`:([a-z0-9_+\-]+):`)
+	reFence     = regexp.MustCompile("(?m)^(```|~~~)")
+)
+
+func init() {
+	byName = make(map[string]string, len(shortcodeTable))
+	byEmoji = make(map[string]string, len(shortcodeTable))
+	emojis := make([]string, 0, len(shortcodeTable))
+	for _, e := range shortcodeTable {
+		byName[e.name] = e.emoji
+		bare := strings.TrimSuffix(e.emoji, variationSelector)
+		if _, ok := byEmoji[bare]; !ok {
+			byEmoji[bare] = e.name
+			emojis = append(emojis, regexp.QuoteMeta(bare))
+		}
+	}
+	slices.SortFunc(emojis, func(a, b string) int { return len(b) - len(a) })
+	emojiPattern = regexp.MustCompile("(?:" + strings.Join(emojis, "|") + ")" + variationSelector + "?")
+}
+
+// variationSelector は直前の文字を絵文字として表示させる異体字セレクタ (U+FE0F)
+const variationSelector = "\uFE0F"
+
+// Expand は本文中の :shortcode: を絵文字に変換する
+// コードブロック（``` / ~~~ / {code}）とインラインコード内は変換しない
+func Expand(text string) string {
+	return transformOutsideCode(text, func(s string) string {
+		return reShortcode.ReplaceAllStringFunc(s, func(m string) string {
+			if e, ok := byName[m[1:len(m)-1]]; ok {
+				return e
+			}
+			return m
+		})
+	})
+}
+
+// Shorten は本文中の絵文字を :shortcode: に戻す
+// 絵文字を表示できない端末向けのフォールバックで、表にない絵文字はそのまま残す
+func Shorten(text string) string {
+	return emojiPattern.ReplaceAllStringFunc(text, func(m string) string {
+		if name, ok := byEmoji[strings.TrimSuffix(m, variationSelector)]; ok {
+			return ":" + name + ":"
+		}
+		return m
+	})
+}
+
+// transformOutsideCode はコードブロックとインラインコードを除いた部分に fn を適用する
+func transformOutsideCode(text string, fn func(string) string) string {
+	lines := strings.SplitAfter(text, "\n")
+	var b strings.Builder
+	var fence string
+	inBacklogCode := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case fence != "":
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			b.WriteString(line)
+			continue
+		case inBacklogCode:
+			if strings.Contains(trimmed, "{/code}") {
+				inBacklogCode = false
+			}
+			b.WriteString(line)
+			continue
+		}
+		if m := reFence.FindString(trimmed); m != "" {
+			fence = m
+			b.WriteString(line)
+			continue
+		}
+		if strings.HasPrefix(trimmed, "{code") && !strings.Contains(trimmed, "{/code}") {
+			inBacklogCode = true
+			b.WriteString(line)
+			continue
+		}
+		b.WriteString(transformOutsideInlineCode(line, fn))
+	}
+	return b.String()
+}
+
+// transformOutsideInlineCode は `...` で囲まれた部分を除いて fn を適用する
+func transformOutsideInlineCode(line string, fn func(string) string) string {
+	parts := strings.Split(line, "`")
+	// 閉じられていない ` は通常の文字として扱う
+	if len(parts)%2 == 0 {
+		return fn(line)
+	}
+	for i := 0; i < len(parts); i += 2 {
+		parts[i] = fn(parts[i])
+	}
+	return strings.Join(parts, "`")
+}
@@ -0,0 +1,70 @@
+package emoji
+
+import "testing"
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "shortcodes", in: "リリースしました :tada: :+1:", want: "リリースしました 🎉 👍"},
+		{name: "unknown shortcode", in: "see :not_an_emoji: here", want: "see :not_an_emoji: here"},
+		{name: "time is not a shortcode", in: "10:30:00 に開始", want: "10:30:00 に開始"},
+		{name: "inline code", in: "`:tada:` は :tada: になる", want: "`:tada:` は 🎉 になる"},
+		{name: "fenced code", in: "```\n:tada:\n```\n:tada:", want: "```\n:tada:\n```\n🎉"},
+		{name: "backlog code", in: "{code}\n:tada:\n{/code}\n:tada:", want: "{code}\n:tada:\n{/code}\n🎉"},
+	}
+	for _, tt := range tests {
+		if got := Expand(tt.in); got != tt.want {
+			t.Errorf("%s: Expand(%q) = %q, want %q", tt.name, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestShorten(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "リリース 🎉 👍", want: "リリース :tada: :+1:"},
+		// 異体字セレクタの有無にかかわらず同じ shortcode に戻す
+		{in: "⚠️ と ⚠", want: ":warning: と :warning:"},
+		// ZWJ シーケンスは構成要素に分解せずに戻す
+		{in: "🤷‍♂️", want: ":man_shrugging:"},
+		{in: "表にない絵文字 🫠", want: "表にない絵文字 🫠"},
+	}
+	for _, tt := range tests {
+		if got := Shorten(tt.in); got != tt.want {
+			t.Errorf("Shorten(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, e := range shortcodeTable {
+		if got := Expand(Shorten(e.emoji)); got != e.emoji {
+			t.Errorf("round trip of :%s: = %q, want %q", e.name, got, e.emoji)
+		}
+	}
+}
+
+func TestTerminalSupported(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_CTYPE", "")
+	t.Setenv("LANG", "ja_JP.UTF-8")
+	t.Setenv("WT_SESSION", "1")
+	if !TerminalSupported() {
+		t.Error("UTF-8 locale should support emoji")
+	}
+	t.Setenv("LC_ALL", "C")
+	if TerminalSupported() {
+		t.Error("C locale should not support emoji")
+	}
+	t.Setenv("LC_ALL", "")
+	t.Setenv("TERM", "linux")
+	if TerminalSupported() {
+		t.Error("Linux console should not support emoji")
+	}
+}
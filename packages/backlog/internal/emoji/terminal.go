@@ -0,0 +1,27 @@
+package emoji
+
+import (
+	"os"
+	"runtime"
+	"strings"
+)
+
+// TerminalSupported は端末が絵文字を表示できそうかを環境変数から推定する
+// ロケールが UTF-8 でない場合や Linux コンソール（TERM=linux）では表示できないとみなす
+func TerminalSupported() bool {
+	term := os.Getenv("TERM")
+	if term == "linux" || term == "dumb" {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		// 従来のコンソールホストは絵文字を表示できないため、Windows Terminal だけを対象にする
+		return os.Getenv("WT_SESSION") != ""
+	}
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(name); v != "" {
+			v = strings.ToLower(v)
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	return false
+}
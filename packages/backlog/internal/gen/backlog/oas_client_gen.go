@@ -34,12 +34,30 @@ type Invoker interface {
 	//
 	// POST /issues/{issueIdOrKey}/comments
 	AddComment(ctx context.Context, request OptAddCommentReq, params AddCommentParams) (*Comment, error)
+	// AddCustomFieldItem invokes addCustomFieldItem operation.
+	//
+	// Add list item for list type custom field.
+	//
+	// POST /projects/{projectIdOrKey}/customFields/{customFieldId}/items
+	AddCustomFieldItem(ctx context.Context, request OptAddCustomFieldItemReq, params AddCustomFieldItemParams) (*CustomField, error)
 	// AddDocumentTags invokes addDocumentTags operation.
 	//
 	// Add document tags.
 	//
 	// POST /documents/{documentId}/tags
 	AddDocumentTags(ctx context.Context, request OptAddDocumentTagsReq, params AddDocumentTagsParams) ([]DocumentTag, error)
+	// AddProjectAdministrator invokes addProjectAdministrator operation.
+	//
+	// Add project administrator.
+	//
+	// POST /projects/{projectIdOrKey}/administrators
+	AddProjectAdministrator(ctx context.Context, request OptAddProjectAdministratorReq, params AddProjectAdministratorParams) (*User, error)
+	// AddProjectUser invokes addProjectUser operation.
+	//
+	// Add project user.
+	//
+	// POST /projects/{projectIdOrKey}/users
+	AddProjectUser(ctx context.Context, request OptAddProjectUserReq, params AddProjectUserParams) (*User, error)
 	// AttachFileToWiki invokes attachFileToWiki operation.
 	//
 	// Add attachments to wiki.
@@ -52,6 +70,12 @@ type Invoker interface {
 	//
 	// POST /projects/{projectIdOrKey}/categories
 	CreateCategory(ctx context.Context, request OptCreateCategoryReq, params CreateCategoryParams) (*Category, error)
+	// CreateCustomField invokes createCustomField operation.
+	//
+	// Add custom field.
+	//
+	// POST /projects/{projectIdOrKey}/customFields
+	CreateCustomField(ctx context.Context, request OptCreateCustomFieldReq, params CreateCustomFieldParams) (*CustomField, error)
 	// CreateDocument invokes createDocument operation.
 	//
 	// Add document.
@@ -76,6 +100,12 @@ type Invoker interface {
 	//
 	// DELETE /projects/{projectIdOrKey}/categories/{categoryId}
 	DeleteCategory(ctx context.Context, params DeleteCategoryParams) (*Category, error)
+	// DeleteCustomFieldItem invokes deleteCustomFieldItem operation.
+	//
+	// Delete list item for list type custom field.
+	//
+	// DELETE /projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}
+	DeleteCustomFieldItem(ctx context.Context, params DeleteCustomFieldItemParams) (*CustomField, error)
 	// DeleteDocument invokes deleteDocument operation.
 	//
 	// Delete document.
@@ -94,6 +124,18 @@ type Invoker interface {
 	//
 	// DELETE /issues/{issueIdOrKey}/attachments/{attachmentId}
 	DeleteIssueAttachment(ctx context.Context, params DeleteIssueAttachmentParams) (*Attachment, error)
+	// DeleteProjectAdministrator invokes deleteProjectAdministrator operation.
+	//
+	// Delete project administrator.
+	//
+	// DELETE /projects/{projectIdOrKey}/administrators
+	DeleteProjectAdministrator(ctx context.Context, request OptDeleteProjectAdministratorReq, params DeleteProjectAdministratorParams) (*User, error)
+	// DeleteProjectUser invokes deleteProjectUser operation.
+	//
+	// Delete project user.
+	//
+	// DELETE /projects/{projectIdOrKey}/users
+	DeleteProjectUser(ctx context.Context, request OptDeleteProjectUserReq, params DeleteProjectUserParams) (*User, error)
 	// DeletePullRequestAttachments invokes deletePullRequestAttachments operation.
 	//
 	// Delete pull request attachment.
@@ -250,6 +292,18 @@ type Invoker interface {
 	//
 	// GET /projects/{projectIdOrKey}
 	GetProject(ctx context.Context, params GetProjectParams) (*Project, error)
+	// GetProjectAdministrators invokes getProjectAdministrators operation.
+	//
+	// Get project administrators.
+	//
+	// GET /projects/{projectIdOrKey}/administrators
+	GetProjectAdministrators(ctx context.Context, params GetProjectAdministratorsParams) ([]User, error)
+	// GetProjectRecentUpdates invokes getProjectRecentUpdates operation.
+	//
+	// Get project recent updates.
+	//
+	// GET /projects/{projectIdOrKey}/activities
+	GetProjectRecentUpdates(ctx context.Context, params GetProjectRecentUpdatesParams) ([]Activity, error)
 	// GetProjectUsers invokes getProjectUsers operation.
 	//
 	// Get project users.
@@ -328,12 +382,24 @@ type Invoker interface {
 	//
 	// GET /projects/{projectIdOrKey}/versions
 	GetVersions(ctx context.Context, params GetVersionsParams) ([]Version, error)
+	// GetWebhooks invokes getWebhooks operation.
+	//
+	// Get webhooks.
+	//
+	// GET /projects/{projectIdOrKey}/webhooks
+	GetWebhooks(ctx context.Context, params GetWebhooksParams) ([]Webhook, error)
 	// GetWiki invokes getWiki operation.
 	//
 	// Get wiki.
 	//
 	// GET /wikis/{wikiId}
 	GetWiki(ctx context.Context, params GetWikiParams) (*Wiki, error)
+	// GetWikiTags invokes getWikiTags operation.
+	//
+	// Get wiki page tag list.
+	//
+	// GET /wikis/tags
+	GetWikiTags(ctx context.Context, params GetWikiTagsParams) ([]WikiTag, error)
 	// GetWikis invokes getWikis operation.
 	//
 	// Get wikis.
@@ -388,12 +454,24 @@ type Invoker interface {
 	//
 	// PATCH /issues/{issueIdOrKey}/comments/{commentId}
 	UpdateComment(ctx context.Context, request OptUpdateCommentReq, params UpdateCommentParams) (*Comment, error)
+	// UpdateCustomField invokes updateCustomField operation.
+	//
+	// Update custom field.
+	//
+	// PATCH /projects/{projectIdOrKey}/customFields/{customFieldId}
+	UpdateCustomField(ctx context.Context, request OptUpdateCustomFieldReq, params UpdateCustomFieldParams) (*CustomField, error)
 	// UpdateIssue invokes updateIssue operation.
 	//
 	// Update issue.
 	//
 	// PATCH /issues/{issueIdOrKey}
 	UpdateIssue(ctx context.Context, request OptUpdateIssueReq, params UpdateIssueParams) (*Issue, error)
+	// UpdateWebhook invokes updateWebhook operation.
+	//
+	// Update webhook.
+	//
+	// PATCH /projects/{projectIdOrKey}/webhooks/{webhookId}
+	UpdateWebhook(ctx context.Context, request OptUpdateWebhookReq, params UpdateWebhookParams) (*Webhook, error)
 	// UpdateWiki invokes updateWiki operation.
 	//
 	// Update wiki.
@@ -587,21 +665,21 @@ func (c *Client) sendAddComment(ctx context.Context, request OptAddCommentReq, p
 	return result, nil
 }
 
-// AddDocumentTags invokes addDocumentTags operation.
+// AddCustomFieldItem invokes addCustomFieldItem operation.
 //
-// Add document tags.
+// Add list item for list type custom field.
 //
-// POST /documents/{documentId}/tags
-func (c *Client) AddDocumentTags(ctx context.Context, request OptAddDocumentTagsReq, params AddDocumentTagsParams) ([]DocumentTag, error) {
-	res, err := c.sendAddDocumentTags(ctx, request, params)
+// POST /projects/{projectIdOrKey}/customFields/{customFieldId}/items
+func (c *Client) AddCustomFieldItem(ctx context.Context, request OptAddCustomFieldItemReq, params AddCustomFieldItemParams) (*CustomField, error) {
+	res, err := c.sendAddCustomFieldItem(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocumentTagsReq, params AddDocumentTagsParams) (res []DocumentTag, err error) {
+func (c *Client) sendAddCustomFieldItem(ctx context.Context, request OptAddCustomFieldItemReq, params AddCustomFieldItemParams) (res *CustomField, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("addDocumentTags"),
+		otelogen.OperationID("addCustomFieldItem"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/documents/{documentId}/tags"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/customFields/{customFieldId}/items"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -617,7 +695,7 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AddDocumentTagsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddCustomFieldItemOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -634,17 +712,17 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/documents/"
+	var pathParts [5]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "documentId" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "documentId",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.DocumentId))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -654,7 +732,26 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/tags"
+	pathParts[2] = "/customFields/"
+	{
+		// Encode "customFieldId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "customFieldId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CustomFieldId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/items"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -662,7 +759,7 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAddDocumentTagsRequest(request, r); err != nil {
+	if err := encodeAddCustomFieldItemRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -671,7 +768,7 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, AddDocumentTagsOperation, r); {
+			switch err := c.securityOAuth2(ctx, AddCustomFieldItemOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -682,7 +779,7 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, AddDocumentTagsOperation, r); {
+			switch err := c.securityApiKey(ctx, AddCustomFieldItemOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -719,7 +816,7 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAddDocumentTagsResponse(resp)
+	result, err := decodeAddCustomFieldItemResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -727,21 +824,21 @@ func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocument
 	return result, nil
 }
 
-// AttachFileToWiki invokes attachFileToWiki operation.
+// AddDocumentTags invokes addDocumentTags operation.
 //
-// Add attachments to wiki.
+// Add document tags.
 //
-// POST /wikis/{wikiId}/attachments
-func (c *Client) AttachFileToWiki(ctx context.Context, request OptAttachFileToWikiReq, params AttachFileToWikiParams) ([]Attachment, error) {
-	res, err := c.sendAttachFileToWiki(ctx, request, params)
+// POST /documents/{documentId}/tags
+func (c *Client) AddDocumentTags(ctx context.Context, request OptAddDocumentTagsReq, params AddDocumentTagsParams) ([]DocumentTag, error) {
+	res, err := c.sendAddDocumentTags(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFileToWikiReq, params AttachFileToWikiParams) (res []Attachment, err error) {
+func (c *Client) sendAddDocumentTags(ctx context.Context, request OptAddDocumentTagsReq, params AddDocumentTagsParams) (res []DocumentTag, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("attachFileToWiki"),
+		otelogen.OperationID("addDocumentTags"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}/attachments"),
+		semconv.URLTemplateKey.String("/documents/{documentId}/tags"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -757,7 +854,7 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AttachFileToWikiOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddDocumentTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -775,16 +872,16 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/wikis/"
+	pathParts[0] = "/documents/"
 	{
-		// Encode "wikiId" parameter.
+		// Encode "documentId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
+			Param:   "documentId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
+			return e.EncodeValue(conv.StringToString(params.DocumentId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -794,7 +891,7 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/attachments"
+	pathParts[2] = "/tags"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -802,7 +899,7 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAttachFileToWikiRequest(request, r); err != nil {
+	if err := encodeAddDocumentTagsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -811,7 +908,7 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, AttachFileToWikiOperation, r); {
+			switch err := c.securityOAuth2(ctx, AddDocumentTagsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -822,7 +919,7 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, AttachFileToWikiOperation, r); {
+			switch err := c.securityApiKey(ctx, AddDocumentTagsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -859,7 +956,7 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAttachFileToWikiResponse(resp)
+	result, err := decodeAddDocumentTagsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -867,21 +964,21 @@ func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFile
 	return result, nil
 }
 
-// CreateCategory invokes createCategory operation.
+// AddProjectAdministrator invokes addProjectAdministrator operation.
 //
-// Create category.
+// Add project administrator.
 //
-// POST /projects/{projectIdOrKey}/categories
-func (c *Client) CreateCategory(ctx context.Context, request OptCreateCategoryReq, params CreateCategoryParams) (*Category, error) {
-	res, err := c.sendCreateCategory(ctx, request, params)
+// POST /projects/{projectIdOrKey}/administrators
+func (c *Client) AddProjectAdministrator(ctx context.Context, request OptAddProjectAdministratorReq, params AddProjectAdministratorParams) (*User, error) {
+	res, err := c.sendAddProjectAdministrator(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCategoryReq, params CreateCategoryParams) (res *Category, err error) {
+func (c *Client) sendAddProjectAdministrator(ctx context.Context, request OptAddProjectAdministratorReq, params AddProjectAdministratorParams) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createCategory"),
+		otelogen.OperationID("addProjectAdministrator"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/categories"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/administrators"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -897,7 +994,7 @@ func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCatego
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateCategoryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddProjectAdministratorOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -934,7 +1031,7 @@ func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCatego
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/categories"
+	pathParts[2] = "/administrators"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -942,7 +1039,7 @@ func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCatego
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateCategoryRequest(request, r); err != nil {
+	if err := encodeAddProjectAdministratorRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -951,7 +1048,7 @@ func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCatego
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, CreateCategoryOperation, r); {
+			switch err := c.securityOAuth2(ctx, AddProjectAdministratorOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -962,7 +1059,7 @@ func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCatego
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, CreateCategoryOperation, r); {
+			switch err := c.securityApiKey(ctx, AddProjectAdministratorOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -999,7 +1096,7 @@ func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCatego
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateCategoryResponse(resp)
+	result, err := decodeAddProjectAdministratorResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1007,21 +1104,21 @@ func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCatego
 	return result, nil
 }
 
-// CreateDocument invokes createDocument operation.
+// AddProjectUser invokes addProjectUser operation.
 //
-// Add document.
+// Add project user.
 //
-// POST /documents
-func (c *Client) CreateDocument(ctx context.Context, request OptCreateDocumentReq) (*Document, error) {
-	res, err := c.sendCreateDocument(ctx, request)
+// POST /projects/{projectIdOrKey}/users
+func (c *Client) AddProjectUser(ctx context.Context, request OptAddProjectUserReq, params AddProjectUserParams) (*User, error) {
+	res, err := c.sendAddProjectUser(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocumentReq) (res *Document, err error) {
+func (c *Client) sendAddProjectUser(ctx context.Context, request OptAddProjectUserReq, params AddProjectUserParams) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createDocument"),
+		otelogen.OperationID("addProjectUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/documents"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/users"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1037,7 +1134,7 @@ func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocume
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateDocumentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddProjectUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1054,8 +1151,27 @@ func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocume
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/documents"
+	var pathParts [3]string
+	pathParts[0] = "/projects/"
+	{
+		// Encode "projectIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "projectIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1063,7 +1179,7 @@ func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocume
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateDocumentRequest(request, r); err != nil {
+	if err := encodeAddProjectUserRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1072,7 +1188,7 @@ func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocume
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, CreateDocumentOperation, r); {
+			switch err := c.securityOAuth2(ctx, AddProjectUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1083,7 +1199,7 @@ func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocume
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, CreateDocumentOperation, r); {
+			switch err := c.securityApiKey(ctx, AddProjectUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1120,7 +1236,7 @@ func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocume
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateDocumentResponse(resp)
+	result, err := decodeAddProjectUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1128,21 +1244,21 @@ func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocume
 	return result, nil
 }
 
-// CreateIssue invokes createIssue operation.
+// AttachFileToWiki invokes attachFileToWiki operation.
 //
-// Create issue.
+// Add attachments to wiki.
 //
-// POST /issues
-func (c *Client) CreateIssue(ctx context.Context, request OptCreateIssueReq) (*Issue, error) {
-	res, err := c.sendCreateIssue(ctx, request)
+// POST /wikis/{wikiId}/attachments
+func (c *Client) AttachFileToWiki(ctx context.Context, request OptAttachFileToWikiReq, params AttachFileToWikiParams) ([]Attachment, error) {
+	res, err := c.sendAttachFileToWiki(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq) (res *Issue, err error) {
+func (c *Client) sendAttachFileToWiki(ctx context.Context, request OptAttachFileToWikiReq, params AttachFileToWikiParams) (res []Attachment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createIssue"),
+		otelogen.OperationID("attachFileToWiki"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/issues"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}/attachments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1158,7 +1274,7 @@ func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateIssueOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AttachFileToWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1175,8 +1291,27 @@ func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/issues"
+	var pathParts [3]string
+	pathParts[0] = "/wikis/"
+	{
+		// Encode "wikiId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "wikiId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.WikiId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/attachments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1184,7 +1319,7 @@ func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateIssueRequest(request, r); err != nil {
+	if err := encodeAttachFileToWikiRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1193,7 +1328,7 @@ func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq)
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, CreateIssueOperation, r); {
+			switch err := c.securityOAuth2(ctx, AttachFileToWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1204,7 +1339,7 @@ func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, CreateIssueOperation, r); {
+			switch err := c.securityApiKey(ctx, AttachFileToWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1241,7 +1376,7 @@ func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateIssueResponse(resp)
+	result, err := decodeAttachFileToWikiResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1249,21 +1384,21 @@ func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq)
 	return result, nil
 }
 
-// CreateWiki invokes createWiki operation.
+// CreateCategory invokes createCategory operation.
 //
-// Create wiki.
+// Create category.
 //
-// POST /wikis
-func (c *Client) CreateWiki(ctx context.Context, request OptCreateWikiReq) (*Wiki, error) {
-	res, err := c.sendCreateWiki(ctx, request)
+// POST /projects/{projectIdOrKey}/categories
+func (c *Client) CreateCategory(ctx context.Context, request OptCreateCategoryReq, params CreateCategoryParams) (*Category, error) {
+	res, err := c.sendCreateCategory(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (res *Wiki, err error) {
+func (c *Client) sendCreateCategory(ctx context.Context, request OptCreateCategoryReq, params CreateCategoryParams) (res *Category, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createWiki"),
+		otelogen.OperationID("createCategory"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/wikis"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/categories"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1279,7 +1414,7 @@ func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateWikiOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCategoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1296,8 +1431,27 @@ func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/wikis"
+	var pathParts [3]string
+	pathParts[0] = "/projects/"
+	{
+		// Encode "projectIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "projectIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/categories"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1305,7 +1459,7 @@ func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateWikiRequest(request, r); err != nil {
+	if err := encodeCreateCategoryRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1314,7 +1468,7 @@ func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, CreateWikiOperation, r); {
+			switch err := c.securityOAuth2(ctx, CreateCategoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1325,7 +1479,7 @@ func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, CreateWikiOperation, r); {
+			switch err := c.securityApiKey(ctx, CreateCategoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1362,7 +1516,7 @@ func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateWikiResponse(resp)
+	result, err := decodeCreateCategoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1370,21 +1524,21 @@ func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (
 	return result, nil
 }
 
-// DeleteCategory invokes deleteCategory operation.
+// CreateCustomField invokes createCustomField operation.
 //
-// Delete category.
+// Add custom field.
 //
-// DELETE /projects/{projectIdOrKey}/categories/{categoryId}
-func (c *Client) DeleteCategory(ctx context.Context, params DeleteCategoryParams) (*Category, error) {
-	res, err := c.sendDeleteCategory(ctx, params)
+// POST /projects/{projectIdOrKey}/customFields
+func (c *Client) CreateCustomField(ctx context.Context, request OptCreateCustomFieldReq, params CreateCustomFieldParams) (*CustomField, error) {
+	res, err := c.sendCreateCustomField(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryParams) (res *Category, err error) {
+func (c *Client) sendCreateCustomField(ctx context.Context, request OptCreateCustomFieldReq, params CreateCustomFieldParams) (res *CustomField, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteCategory"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/categories/{categoryId}"),
+		otelogen.OperationID("createCustomField"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/customFields"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1400,7 +1554,7 @@ func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCategoryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCustomFieldOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1417,7 +1571,7 @@ func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [3]string
 	pathParts[0] = "/projects/"
 	{
 		// Encode "projectIdOrKey" parameter.
@@ -1437,39 +1591,24 @@ func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryPa
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/categories/"
-	{
-		// Encode "categoryId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "categoryId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.CategoryId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	pathParts[2] = "/customFields"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateCustomFieldRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, DeleteCategoryOperation, r); {
+			switch err := c.securityOAuth2(ctx, CreateCustomFieldOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1480,7 +1619,7 @@ func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryPa
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteCategoryOperation, r); {
+			switch err := c.securityApiKey(ctx, CreateCustomFieldOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1517,7 +1656,7 @@ func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteCategoryResponse(resp)
+	result, err := decodeCreateCustomFieldResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1525,21 +1664,21 @@ func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryPa
 	return result, nil
 }
 
-// DeleteDocument invokes deleteDocument operation.
+// CreateDocument invokes createDocument operation.
 //
-// Delete document.
+// Add document.
 //
-// DELETE /documents/{documentId}
-func (c *Client) DeleteDocument(ctx context.Context, params DeleteDocumentParams) (*Document, error) {
-	res, err := c.sendDeleteDocument(ctx, params)
+// POST /documents
+func (c *Client) CreateDocument(ctx context.Context, request OptCreateDocumentReq) (*Document, error) {
+	res, err := c.sendCreateDocument(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteDocument(ctx context.Context, params DeleteDocumentParams) (res *Document, err error) {
+func (c *Client) sendCreateDocument(ctx context.Context, request OptCreateDocumentReq) (res *Document, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteDocument"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/documents/{documentId}"),
+		otelogen.OperationID("createDocument"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/documents"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1555,7 +1694,7 @@ func (c *Client) sendDeleteDocument(ctx context.Context, params DeleteDocumentPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteDocumentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateDocumentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1572,40 +1711,25 @@ func (c *Client) sendDeleteDocument(ctx context.Context, params DeleteDocumentPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/documents/"
-	{
-		// Encode "documentId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "documentId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.DocumentId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/documents"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateDocumentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, DeleteDocumentOperation, r); {
+			switch err := c.securityOAuth2(ctx, CreateDocumentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1616,7 +1740,7 @@ func (c *Client) sendDeleteDocument(ctx context.Context, params DeleteDocumentPa
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteDocumentOperation, r); {
+			switch err := c.securityApiKey(ctx, CreateDocumentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1653,7 +1777,7 @@ func (c *Client) sendDeleteDocument(ctx context.Context, params DeleteDocumentPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteDocumentResponse(resp)
+	result, err := decodeCreateDocumentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1661,21 +1785,21 @@ func (c *Client) sendDeleteDocument(ctx context.Context, params DeleteDocumentPa
 	return result, nil
 }
 
-// DeleteIssue invokes deleteIssue operation.
+// CreateIssue invokes createIssue operation.
 //
-// Delete issue.
+// Create issue.
 //
-// DELETE /issues/{issueIdOrKey}
-func (c *Client) DeleteIssue(ctx context.Context, params DeleteIssueParams) (*Issue, error) {
-	res, err := c.sendDeleteIssue(ctx, params)
+// POST /issues
+func (c *Client) CreateIssue(ctx context.Context, request OptCreateIssueReq) (*Issue, error) {
+	res, err := c.sendCreateIssue(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteIssue(ctx context.Context, params DeleteIssueParams) (res *Issue, err error) {
+func (c *Client) sendCreateIssue(ctx context.Context, request OptCreateIssueReq) (res *Issue, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteIssue"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}"),
+		otelogen.OperationID("createIssue"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/issues"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1691,7 +1815,7 @@ func (c *Client) sendDeleteIssue(ctx context.Context, params DeleteIssueParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteIssueOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1708,40 +1832,25 @@ func (c *Client) sendDeleteIssue(ctx context.Context, params DeleteIssueParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/issues/"
-	{
-		// Encode "issueIdOrKey" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/issues"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateIssueRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, DeleteIssueOperation, r); {
+			switch err := c.securityOAuth2(ctx, CreateIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1752,7 +1861,7 @@ func (c *Client) sendDeleteIssue(ctx context.Context, params DeleteIssueParams)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteIssueOperation, r); {
+			switch err := c.securityApiKey(ctx, CreateIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1789,7 +1898,7 @@ func (c *Client) sendDeleteIssue(ctx context.Context, params DeleteIssueParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteIssueResponse(resp)
+	result, err := decodeCreateIssueResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1797,21 +1906,21 @@ func (c *Client) sendDeleteIssue(ctx context.Context, params DeleteIssueParams)
 	return result, nil
 }
 
-// DeleteIssueAttachment invokes deleteIssueAttachment operation.
+// CreateWiki invokes createWiki operation.
 //
-// Delete issue attachment.
+// Create wiki.
 //
-// DELETE /issues/{issueIdOrKey}/attachments/{attachmentId}
-func (c *Client) DeleteIssueAttachment(ctx context.Context, params DeleteIssueAttachmentParams) (*Attachment, error) {
-	res, err := c.sendDeleteIssueAttachment(ctx, params)
+// POST /wikis
+func (c *Client) CreateWiki(ctx context.Context, request OptCreateWikiReq) (*Wiki, error) {
+	res, err := c.sendCreateWiki(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteIssueAttachment(ctx context.Context, params DeleteIssueAttachmentParams) (res *Attachment, err error) {
+func (c *Client) sendCreateWiki(ctx context.Context, request OptCreateWikiReq) (res *Wiki, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteIssueAttachment"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/attachments/{attachmentId}"),
+		otelogen.OperationID("createWiki"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/wikis"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1827,7 +1936,7 @@ func (c *Client) sendDeleteIssueAttachment(ctx context.Context, params DeleteIss
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteIssueAttachmentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1844,59 +1953,25 @@ func (c *Client) sendDeleteIssueAttachment(ctx context.Context, params DeleteIss
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/issues/"
-	{
-		// Encode "issueIdOrKey" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/attachments/"
-	{
-		// Encode "attachmentId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "attachmentId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.AttachmentId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/wikis"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateWikiRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, DeleteIssueAttachmentOperation, r); {
+			switch err := c.securityOAuth2(ctx, CreateWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1907,7 +1982,7 @@ func (c *Client) sendDeleteIssueAttachment(ctx context.Context, params DeleteIss
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteIssueAttachmentOperation, r); {
+			switch err := c.securityApiKey(ctx, CreateWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1944,7 +2019,7 @@ func (c *Client) sendDeleteIssueAttachment(ctx context.Context, params DeleteIss
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteIssueAttachmentResponse(resp)
+	result, err := decodeCreateWikiResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1952,21 +2027,21 @@ func (c *Client) sendDeleteIssueAttachment(ctx context.Context, params DeleteIss
 	return result, nil
 }
 
-// DeletePullRequestAttachments invokes deletePullRequestAttachments operation.
+// DeleteCategory invokes deleteCategory operation.
 //
-// Delete pull request attachment.
+// Delete category.
 //
-// DELETE /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}
-func (c *Client) DeletePullRequestAttachments(ctx context.Context, params DeletePullRequestAttachmentsParams) (*Attachment, error) {
-	res, err := c.sendDeletePullRequestAttachments(ctx, params)
+// DELETE /projects/{projectIdOrKey}/categories/{categoryId}
+func (c *Client) DeleteCategory(ctx context.Context, params DeleteCategoryParams) (*Category, error) {
+	res, err := c.sendDeleteCategory(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params DeletePullRequestAttachmentsParams) (res *Attachment, err error) {
+func (c *Client) sendDeleteCategory(ctx context.Context, params DeleteCategoryParams) (res *Category, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePullRequestAttachments"),
+		otelogen.OperationID("deleteCategory"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/categories/{categoryId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1982,7 +2057,7 @@ func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params De
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeletePullRequestAttachmentsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCategoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1999,7 +2074,7 @@ func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params De
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [8]string
+	var pathParts [4]string
 	pathParts[0] = "/projects/"
 	{
 		// Encode "projectIdOrKey" parameter.
@@ -2019,16 +2094,16 @@ func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params De
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/git/repositories/"
+	pathParts[2] = "/categories/"
 	{
-		// Encode "repoIdOrName" parameter.
+		// Encode "categoryId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "repoIdOrName",
+			Param:   "categoryId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
+			return e.EncodeValue(conv.IntToString(params.CategoryId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2038,58 +2113,20 @@ func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params De
 		}
 		pathParts[3] = encoded
 	}
-	pathParts[4] = "/pullRequests/"
-	{
-		// Encode "number" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "number",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.Number))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
-	pathParts[6] = "/attachments/"
-	{
-		// Encode "attachmentId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "attachmentId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.AttachmentId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[7] = encoded
-	}
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, DeletePullRequestAttachmentsOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteCategoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2100,7 +2137,7 @@ func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params De
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeletePullRequestAttachmentsOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteCategoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2137,7 +2174,7 @@ func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params De
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeletePullRequestAttachmentsResponse(resp)
+	result, err := decodeDeleteCategoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2145,21 +2182,21 @@ func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params De
 	return result, nil
 }
 
-// DeleteWiki invokes deleteWiki operation.
+// DeleteCustomFieldItem invokes deleteCustomFieldItem operation.
 //
-// Delete wiki.
+// Delete list item for list type custom field.
 //
-// DELETE /wikis/{wikiId}
-func (c *Client) DeleteWiki(ctx context.Context, params DeleteWikiParams) (*Wiki, error) {
-	res, err := c.sendDeleteWiki(ctx, params)
+// DELETE /projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}
+func (c *Client) DeleteCustomFieldItem(ctx context.Context, params DeleteCustomFieldItemParams) (*CustomField, error) {
+	res, err := c.sendDeleteCustomFieldItem(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (res *Wiki, err error) {
+func (c *Client) sendDeleteCustomFieldItem(ctx context.Context, params DeleteCustomFieldItemParams) (res *CustomField, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteWiki"),
+		otelogen.OperationID("deleteCustomFieldItem"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2175,7 +2212,7 @@ func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteWikiOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCustomFieldItemOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2192,17 +2229,17 @@ func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/wikis/"
+	var pathParts [6]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "wikiId" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2212,6 +2249,44 @@ func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/customFields/"
+	{
+		// Encode "customFieldId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "customFieldId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CustomFieldId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/items/"
+	{
+		// Encode "itemId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "itemId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ItemId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2225,7 +2300,7 @@ func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, DeleteWikiOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteCustomFieldItemOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2236,7 +2311,7 @@ func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (r
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteWikiOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteCustomFieldItemOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2273,7 +2348,7 @@ func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteWikiResponse(resp)
+	result, err := decodeDeleteCustomFieldItemResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2281,21 +2356,21 @@ func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (r
 	return result, nil
 }
 
-// DownloadDocumentAttachment invokes downloadDocumentAttachment operation.
+// DeleteDocument invokes deleteDocument operation.
 //
-// Download document attachment.
+// Delete document.
 //
-// GET /documents/{documentId}/attachments/{attachmentId}
-func (c *Client) DownloadDocumentAttachment(ctx context.Context, params DownloadDocumentAttachmentParams) (DownloadDocumentAttachmentOK, error) {
-	res, err := c.sendDownloadDocumentAttachment(ctx, params)
+// DELETE /documents/{documentId}
+func (c *Client) DeleteDocument(ctx context.Context, params DeleteDocumentParams) (*Document, error) {
+	res, err := c.sendDeleteDocument(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params DownloadDocumentAttachmentParams) (res DownloadDocumentAttachmentOK, err error) {
+func (c *Client) sendDeleteDocument(ctx context.Context, params DeleteDocumentParams) (res *Document, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("downloadDocumentAttachment"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/documents/{documentId}/attachments/{attachmentId}"),
+		otelogen.OperationID("deleteDocument"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/documents/{documentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2311,7 +2386,7 @@ func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params Down
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DownloadDocumentAttachmentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteDocumentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2328,7 +2403,7 @@ func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params Down
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [2]string
 	pathParts[0] = "/documents/"
 	{
 		// Encode "documentId" parameter.
@@ -2348,29 +2423,10 @@ func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params Down
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/attachments/"
-	{
-		// Encode "attachmentId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "attachmentId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.AttachmentId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2380,7 +2436,7 @@ func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params Down
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, DownloadDocumentAttachmentOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteDocumentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2391,7 +2447,7 @@ func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params Down
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DownloadDocumentAttachmentOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteDocumentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2428,7 +2484,7 @@ func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params Down
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDownloadDocumentAttachmentResponse(resp)
+	result, err := decodeDeleteDocumentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2436,21 +2492,21 @@ func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params Down
 	return result, nil
 }
 
-// GetCategories invokes getCategories operation.
+// DeleteIssue invokes deleteIssue operation.
 //
-// Get categories.
+// Delete issue.
 //
-// GET /projects/{projectIdOrKey}/categories
-func (c *Client) GetCategories(ctx context.Context, params GetCategoriesParams) ([]Category, error) {
-	res, err := c.sendGetCategories(ctx, params)
+// DELETE /issues/{issueIdOrKey}
+func (c *Client) DeleteIssue(ctx context.Context, params DeleteIssueParams) (*Issue, error) {
+	res, err := c.sendDeleteIssue(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesParams) (res []Category, err error) {
+func (c *Client) sendDeleteIssue(ctx context.Context, params DeleteIssueParams) (res *Issue, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCategories"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/categories"),
+		otelogen.OperationID("deleteIssue"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2466,7 +2522,7 @@ func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCategoriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2483,17 +2539,17 @@ func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesPara
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/projects/"
+	var pathParts [2]string
+	pathParts[0] = "/issues/"
 	{
-		// Encode "projectIdOrKey" parameter.
+		// Encode "issueIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "projectIdOrKey",
+			Param:   "issueIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2503,11 +2559,10 @@ func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesPara
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/categories"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2517,7 +2572,7 @@ func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesPara
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetCategoriesOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2528,7 +2583,7 @@ func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesPara
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetCategoriesOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2565,7 +2620,7 @@ func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCategoriesResponse(resp)
+	result, err := decodeDeleteIssueResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2573,21 +2628,21 @@ func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesPara
 	return result, nil
 }
 
-// GetComment invokes getComment operation.
+// DeleteIssueAttachment invokes deleteIssueAttachment operation.
 //
-// Get comment.
+// Delete issue attachment.
 //
-// GET /issues/{issueIdOrKey}/comments/{commentId}
-func (c *Client) GetComment(ctx context.Context, params GetCommentParams) (*Comment, error) {
-	res, err := c.sendGetComment(ctx, params)
+// DELETE /issues/{issueIdOrKey}/attachments/{attachmentId}
+func (c *Client) DeleteIssueAttachment(ctx context.Context, params DeleteIssueAttachmentParams) (*Attachment, error) {
+	res, err := c.sendDeleteIssueAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (res *Comment, err error) {
+func (c *Client) sendDeleteIssueAttachment(ctx context.Context, params DeleteIssueAttachmentParams) (res *Attachment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getComment"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
+		otelogen.OperationID("deleteIssueAttachment"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/attachments/{attachmentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2603,7 +2658,7 @@ func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCommentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteIssueAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2640,16 +2695,16 @@ func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (r
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/comments/"
+	pathParts[2] = "/attachments/"
 	{
-		// Encode "commentId" parameter.
+		// Encode "attachmentId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "commentId",
+			Param:   "attachmentId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.CommentId))
+			return e.EncodeValue(conv.IntToString(params.AttachmentId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2662,7 +2717,7 @@ func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (r
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2672,7 +2727,7 @@ func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetCommentOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteIssueAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2683,7 +2738,7 @@ func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (r
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetCommentOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteIssueAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2720,7 +2775,7 @@ func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCommentResponse(resp)
+	result, err := decodeDeleteIssueAttachmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2728,21 +2783,21 @@ func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (r
 	return result, nil
 }
 
-// GetComments invokes getComments operation.
+// DeleteProjectAdministrator invokes deleteProjectAdministrator operation.
 //
-// Get comments.
+// Delete project administrator.
 //
-// GET /issues/{issueIdOrKey}/comments
-func (c *Client) GetComments(ctx context.Context, params GetCommentsParams) ([]Comment, error) {
-	res, err := c.sendGetComments(ctx, params)
+// DELETE /projects/{projectIdOrKey}/administrators
+func (c *Client) DeleteProjectAdministrator(ctx context.Context, request OptDeleteProjectAdministratorReq, params DeleteProjectAdministratorParams) (*User, error) {
+	res, err := c.sendDeleteProjectAdministrator(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams) (res []Comment, err error) {
+func (c *Client) sendDeleteProjectAdministrator(ctx context.Context, request OptDeleteProjectAdministratorReq, params DeleteProjectAdministratorParams) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getComments"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments"),
+		otelogen.OperationID("deleteProjectAdministrator"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/administrators"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2758,7 +2813,7 @@ func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCommentsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteProjectAdministratorOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2776,16 +2831,16 @@ func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/issues/"
+	pathParts[0] = "/projects/"
 	{
-		// Encode "issueIdOrKey" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2795,93 +2850,24 @@ func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams)
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/comments"
+	pathParts[2] = "/administrators"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "minId" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "minId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.MinId.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "maxId" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "maxId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.MaxId.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "count" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Count.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "order" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "order",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Order.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDeleteProjectAdministratorRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetCommentsOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteProjectAdministratorOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2892,7 +2878,7 @@ func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetCommentsOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteProjectAdministratorOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2929,7 +2915,7 @@ func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCommentsResponse(resp)
+	result, err := decodeDeleteProjectAdministratorResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2937,21 +2923,21 @@ func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams)
 	return result, nil
 }
 
-// GetCommentsCount invokes getCommentsCount operation.
+// DeleteProjectUser invokes deleteProjectUser operation.
 //
-// Get comments count.
+// Delete project user.
 //
-// GET /issues/{issueIdOrKey}/comments/count
-func (c *Client) GetCommentsCount(ctx context.Context, params GetCommentsCountParams) (*GetCommentsCountOK, error) {
-	res, err := c.sendGetCommentsCount(ctx, params)
+// DELETE /projects/{projectIdOrKey}/users
+func (c *Client) DeleteProjectUser(ctx context.Context, request OptDeleteProjectUserReq, params DeleteProjectUserParams) (*User, error) {
+	res, err := c.sendDeleteProjectUser(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCountParams) (res *GetCommentsCountOK, err error) {
+func (c *Client) sendDeleteProjectUser(ctx context.Context, request OptDeleteProjectUserReq, params DeleteProjectUserParams) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCommentsCount"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments/count"),
+		otelogen.OperationID("deleteProjectUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/users"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2967,7 +2953,7 @@ func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCou
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCommentsCountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteProjectUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2985,16 +2971,16 @@ func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCou
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/issues/"
+	pathParts[0] = "/projects/"
 	{
-		// Encode "issueIdOrKey" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3004,21 +2990,24 @@ func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCou
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/comments/count"
+	pathParts[2] = "/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteProjectUserRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetCommentsCountOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteProjectUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3029,7 +3018,7 @@ func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCou
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetCommentsCountOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteProjectUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3066,7 +3055,7 @@ func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCou
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCommentsCountResponse(resp)
+	result, err := decodeDeleteProjectUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3074,21 +3063,21 @@ func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCou
 	return result, nil
 }
 
-// GetCurrentUser invokes getCurrentUser operation.
+// DeletePullRequestAttachments invokes deletePullRequestAttachments operation.
 //
-// Get current user.
+// Delete pull request attachment.
 //
-// GET /users/myself
-func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
-	res, err := c.sendGetCurrentUser(ctx)
+// DELETE /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}
+func (c *Client) DeletePullRequestAttachments(ctx context.Context, params DeletePullRequestAttachmentsParams) (*Attachment, error) {
+	res, err := c.sendDeletePullRequestAttachments(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error) {
+func (c *Client) sendDeletePullRequestAttachments(ctx context.Context, params DeletePullRequestAttachmentsParams) (res *Attachment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUser"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users/myself"),
+		otelogen.OperationID("deletePullRequestAttachments"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3104,7 +3093,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePullRequestAttachmentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3121,12 +3110,87 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/users/myself"
+	var pathParts [8]string
+	pathParts[0] = "/projects/"
+	{
+		// Encode "projectIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "projectIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/git/repositories/"
+	{
+		// Encode "repoIdOrName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "repoIdOrName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/pullRequests/"
+	{
+		// Encode "number" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "number",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Number))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/attachments/"
+	{
+		// Encode "attachmentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachmentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[7] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3136,7 +3200,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetCurrentUserOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeletePullRequestAttachmentsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3147,7 +3211,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetCurrentUserOperation, r); {
+			switch err := c.securityApiKey(ctx, DeletePullRequestAttachmentsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3184,7 +3248,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCurrentUserResponse(resp)
+	result, err := decodeDeletePullRequestAttachmentsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3192,21 +3256,21 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error)
 	return result, nil
 }
 
-// GetCustomFields invokes getCustomFields operation.
+// DeleteWiki invokes deleteWiki operation.
 //
-// Get custom fields.
+// Delete wiki.
 //
-// GET /projects/{projectIdOrKey}/customFields
-func (c *Client) GetCustomFields(ctx context.Context, params GetCustomFieldsParams) ([]CustomField, error) {
-	res, err := c.sendGetCustomFields(ctx, params)
+// DELETE /wikis/{wikiId}
+func (c *Client) DeleteWiki(ctx context.Context, params DeleteWikiParams) (*Wiki, error) {
+	res, err := c.sendDeleteWiki(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFieldsParams) (res []CustomField, err error) {
+func (c *Client) sendDeleteWiki(ctx context.Context, params DeleteWikiParams) (res *Wiki, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCustomFields"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/customFields"),
+		otelogen.OperationID("deleteWiki"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3222,7 +3286,7 @@ func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFields
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCustomFieldsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3239,17 +3303,17 @@ func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFields
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/projects/"
+	var pathParts [2]string
+	pathParts[0] = "/wikis/"
 	{
-		// Encode "projectIdOrKey" parameter.
+		// Encode "wikiId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "projectIdOrKey",
+			Param:   "wikiId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+			return e.EncodeValue(conv.IntToString(params.WikiId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3259,11 +3323,10 @@ func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFields
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/customFields"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3273,7 +3336,7 @@ func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFields
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetCustomFieldsOperation, r); {
+			switch err := c.securityOAuth2(ctx, DeleteWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3284,7 +3347,7 @@ func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFields
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetCustomFieldsOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3321,7 +3384,7 @@ func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFields
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCustomFieldsResponse(resp)
+	result, err := decodeDeleteWikiResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3329,21 +3392,21 @@ func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFields
 	return result, nil
 }
 
-// GetDocument invokes getDocument operation.
+// DownloadDocumentAttachment invokes downloadDocumentAttachment operation.
 //
-// Get document.
+// Download document attachment.
 //
-// GET /documents/{documentId}
-func (c *Client) GetDocument(ctx context.Context, params GetDocumentParams) (*DocumentDetail, error) {
-	res, err := c.sendGetDocument(ctx, params)
+// GET /documents/{documentId}/attachments/{attachmentId}
+func (c *Client) DownloadDocumentAttachment(ctx context.Context, params DownloadDocumentAttachmentParams) (DownloadDocumentAttachmentOK, error) {
+	res, err := c.sendDownloadDocumentAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams) (res *DocumentDetail, err error) {
+func (c *Client) sendDownloadDocumentAttachment(ctx context.Context, params DownloadDocumentAttachmentParams) (res DownloadDocumentAttachmentOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocument"),
+		otelogen.OperationID("downloadDocumentAttachment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/documents/{documentId}"),
+		semconv.URLTemplateKey.String("/documents/{documentId}/attachments/{attachmentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3359,7 +3422,7 @@ func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DownloadDocumentAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3376,7 +3439,7 @@ func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [4]string
 	pathParts[0] = "/documents/"
 	{
 		// Encode "documentId" parameter.
@@ -3396,6 +3459,25 @@ func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams)
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/attachments/"
+	{
+		// Encode "attachmentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachmentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3409,7 +3491,7 @@ func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams)
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetDocumentOperation, r); {
+			switch err := c.securityOAuth2(ctx, DownloadDocumentAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3420,7 +3502,7 @@ func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetDocumentOperation, r); {
+			switch err := c.securityApiKey(ctx, DownloadDocumentAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3457,7 +3539,7 @@ func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDocumentResponse(resp)
+	result, err := decodeDownloadDocumentAttachmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3465,21 +3547,21 @@ func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams)
 	return result, nil
 }
 
-// GetDocumentComments invokes getDocumentComments operation.
+// GetCategories invokes getCategories operation.
 //
-// Get document comments.
+// Get categories.
 //
-// GET /documents/{documentId}/comments
-func (c *Client) GetDocumentComments(ctx context.Context, params GetDocumentCommentsParams) ([]DocumentComment, error) {
-	res, err := c.sendGetDocumentComments(ctx, params)
+// GET /projects/{projectIdOrKey}/categories
+func (c *Client) GetCategories(ctx context.Context, params GetCategoriesParams) ([]Category, error) {
+	res, err := c.sendGetCategories(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocumentCommentsParams) (res []DocumentComment, err error) {
+func (c *Client) sendGetCategories(ctx context.Context, params GetCategoriesParams) (res []Category, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocumentComments"),
+		otelogen.OperationID("getCategories"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/documents/{documentId}/comments"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/categories"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3495,7 +3577,7 @@ func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocument
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentCommentsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCategoriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3513,16 +3595,16 @@ func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocument
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/documents/"
+	pathParts[0] = "/projects/"
 	{
-		// Encode "documentId" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "documentId",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.DocumentId))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3532,7 +3614,7 @@ func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocument
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/comments"
+	pathParts[2] = "/categories"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3546,7 +3628,7 @@ func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocument
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetDocumentCommentsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetCategoriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3557,7 +3639,7 @@ func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocument
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetDocumentCommentsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCategoriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3594,7 +3676,7 @@ func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocument
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDocumentCommentsResponse(resp)
+	result, err := decodeGetCategoriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3602,21 +3684,21 @@ func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocument
 	return result, nil
 }
 
-// GetDocumentTree invokes getDocumentTree operation.
+// GetComment invokes getComment operation.
 //
-// Get document tree.
+// Get comment.
 //
-// GET /documents/tree
-func (c *Client) GetDocumentTree(ctx context.Context, params GetDocumentTreeParams) (*DocumentTree, error) {
-	res, err := c.sendGetDocumentTree(ctx, params)
+// GET /issues/{issueIdOrKey}/comments/{commentId}
+func (c *Client) GetComment(ctx context.Context, params GetCommentParams) (*Comment, error) {
+	res, err := c.sendGetComment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTreeParams) (res *DocumentTree, err error) {
+func (c *Client) sendGetComment(ctx context.Context, params GetCommentParams) (res *Comment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocumentTree"),
+		otelogen.OperationID("getComment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/documents/tree"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3632,7 +3714,7 @@ func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTree
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentTreeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3649,27 +3731,46 @@ func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTree
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/documents/tree"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [4]string
+	pathParts[0] = "/issues/"
 	{
-		// Encode "projectIdOrKey" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "projectIdOrKey",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "issueIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issueIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/comments/"
+	{
+		// Encode "commentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "commentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CommentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -3682,7 +3783,7 @@ func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTree
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetDocumentTreeOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3693,7 +3794,7 @@ func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTree
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetDocumentTreeOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3730,7 +3831,7 @@ func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTree
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDocumentTreeResponse(resp)
+	result, err := decodeGetCommentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3738,21 +3839,21 @@ func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTree
 	return result, nil
 }
 
-// GetDocuments invokes getDocuments operation.
+// GetComments invokes getComments operation.
 //
-// Get document list.
+// Get comments.
 //
-// GET /documents
-func (c *Client) GetDocuments(ctx context.Context, params GetDocumentsParams) ([]Document, error) {
-	res, err := c.sendGetDocuments(ctx, params)
+// GET /issues/{issueIdOrKey}/comments
+func (c *Client) GetComments(ctx context.Context, params GetCommentsParams) ([]Comment, error) {
+	res, err := c.sendGetComments(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams) (res []Document, err error) {
+func (c *Client) sendGetComments(ctx context.Context, params GetCommentsParams) (res []Comment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocuments"),
+		otelogen.OperationID("getComments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/documents"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3768,7 +3869,7 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCommentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3785,32 +3886,42 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/documents"
+	var pathParts [3]string
+	pathParts[0] = "/issues/"
+	{
+		// Encode "issueIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issueIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/comments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "projectId[]" parameter.
+		// Encode "minId" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "projectId[]",
+			Name:    "minId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ProjectId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ProjectId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+			if val, ok := params.MinId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -3818,16 +3929,16 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 		}
 	}
 	{
-		// Encode "keyword" parameter.
+		// Encode "maxId" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "keyword",
+			Name:    "maxId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Keyword.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.MaxId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -3835,16 +3946,16 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 		}
 	}
 	{
-		// Encode "sort" parameter.
+		// Encode "count" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sort",
+			Name:    "count",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sort.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -3868,37 +3979,6 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.IntToString(params.Offset))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "count" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Count.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
@@ -3912,7 +3992,7 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetDocumentsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetCommentsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3923,7 +4003,7 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetDocumentsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCommentsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3960,7 +4040,7 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDocumentsResponse(resp)
+	result, err := decodeGetCommentsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3968,21 +4048,21 @@ func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams
 	return result, nil
 }
 
-// GetDocumentsCount invokes getDocumentsCount operation.
+// GetCommentsCount invokes getCommentsCount operation.
 //
-// Get document count.
+// Get comments count.
 //
-// GET /documents/count
-func (c *Client) GetDocumentsCount(ctx context.Context, params GetDocumentsCountParams) (*GetDocumentsCountOK, error) {
-	res, err := c.sendGetDocumentsCount(ctx, params)
+// GET /issues/{issueIdOrKey}/comments/count
+func (c *Client) GetCommentsCount(ctx context.Context, params GetCommentsCountParams) (*GetCommentsCountOK, error) {
+	res, err := c.sendGetCommentsCount(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsCountParams) (res *GetDocumentsCountOK, err error) {
+func (c *Client) sendGetCommentsCount(ctx context.Context, params GetCommentsCountParams) (res *GetCommentsCountOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocumentsCount"),
+		otelogen.OperationID("getCommentsCount"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/documents/count"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments/count"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3998,7 +4078,7 @@ func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsC
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentsCountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCommentsCountOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4015,27 +4095,28 @@ func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsC
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/documents/count"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/issues/"
 	{
-		// Encode "projectIdOrKey" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "projectIdOrKey",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "issueIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issueIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/comments/count"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4048,7 +4129,7 @@ func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsC
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetDocumentsCountOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetCommentsCountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4059,7 +4140,7 @@ func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsC
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetDocumentsCountOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCommentsCountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4096,7 +4177,7 @@ func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsC
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDocumentsCountResponse(resp)
+	result, err := decodeGetCommentsCountResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4104,21 +4185,21 @@ func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsC
 	return result, nil
 }
 
-// GetIssue invokes getIssue operation.
+// GetCurrentUser invokes getCurrentUser operation.
 //
-// Get issue.
+// Get current user.
 //
-// GET /issues/{issueIdOrKey}
-func (c *Client) GetIssue(ctx context.Context, params GetIssueParams) (*Issue, error) {
-	res, err := c.sendGetIssue(ctx, params)
+// GET /users/myself
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	res, err := c.sendGetCurrentUser(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *Issue, err error) {
+func (c *Client) sendGetCurrentUser(ctx context.Context) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssue"),
+		otelogen.OperationID("getCurrentUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}"),
+		semconv.URLTemplateKey.String("/users/myself"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4134,7 +4215,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIssueOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4151,26 +4232,8 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/issues/"
-	{
-		// Encode "issueIdOrKey" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/users/myself"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4184,7 +4247,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetIssueOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetCurrentUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4195,7 +4258,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetIssueOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCurrentUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4232,7 +4295,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIssueResponse(resp)
+	result, err := decodeGetCurrentUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4240,21 +4303,21 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *
 	return result, nil
 }
 
-// GetIssueTypes invokes getIssueTypes operation.
+// GetCustomFields invokes getCustomFields operation.
 //
-// Get issue types.
+// Get custom fields.
 //
-// GET /projects/{projectIdOrKey}/issueTypes
-func (c *Client) GetIssueTypes(ctx context.Context, params GetIssueTypesParams) ([]IssueType, error) {
-	res, err := c.sendGetIssueTypes(ctx, params)
+// GET /projects/{projectIdOrKey}/customFields
+func (c *Client) GetCustomFields(ctx context.Context, params GetCustomFieldsParams) ([]CustomField, error) {
+	res, err := c.sendGetCustomFields(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesParams) (res []IssueType, err error) {
+func (c *Client) sendGetCustomFields(ctx context.Context, params GetCustomFieldsParams) (res []CustomField, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssueTypes"),
+		otelogen.OperationID("getCustomFields"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/issueTypes"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/customFields"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4270,7 +4333,7 @@ func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIssueTypesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCustomFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4307,7 +4370,7 @@ func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesPara
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/issueTypes"
+	pathParts[2] = "/customFields"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4321,7 +4384,7 @@ func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesPara
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetIssueTypesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetCustomFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4332,7 +4395,7 @@ func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesPara
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetIssueTypesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCustomFieldsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4369,7 +4432,7 @@ func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIssueTypesResponse(resp)
+	result, err := decodeGetCustomFieldsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4377,21 +4440,21 @@ func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesPara
 	return result, nil
 }
 
-// GetIssues invokes getIssues operation.
+// GetDocument invokes getDocument operation.
 //
-// Get issues.
+// Get document.
 //
-// GET /issues
-func (c *Client) GetIssues(ctx context.Context, params GetIssuesParams) ([]Issue, error) {
-	res, err := c.sendGetIssues(ctx, params)
+// GET /documents/{documentId}
+func (c *Client) GetDocument(ctx context.Context, params GetDocumentParams) (*DocumentDetail, error) {
+	res, err := c.sendGetDocument(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res []Issue, err error) {
+func (c *Client) sendGetDocument(ctx context.Context, params GetDocumentParams) (res *DocumentDetail, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssues"),
+		otelogen.OperationID("getDocument"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues"),
+		semconv.URLTemplateKey.String("/documents/{documentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4407,7 +4470,7 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIssuesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4424,56 +4487,1810 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/issues"
+	var pathParts [2]string
+	pathParts[0] = "/documents/"
+	{
+		// Encode "documentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "documentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.DocumentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "projectId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "projectId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetDocumentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetDocumentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ProjectId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ProjectId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
 					}
-					return nil
-				})
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "issueTypeId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "issueTypeId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.IssueTypeId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.IssueTypeId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDocumentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetDocumentComments invokes getDocumentComments operation.
+//
+// Get document comments.
+//
+// GET /documents/{documentId}/comments
+func (c *Client) GetDocumentComments(ctx context.Context, params GetDocumentCommentsParams) ([]DocumentComment, error) {
+	res, err := c.sendGetDocumentComments(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetDocumentComments(ctx context.Context, params GetDocumentCommentsParams) (res []DocumentComment, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDocumentComments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/documents/{documentId}/comments"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentCommentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/documents/"
+	{
+		// Encode "documentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "documentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.DocumentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/comments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetDocumentCommentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetDocumentCommentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDocumentCommentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetDocumentTree invokes getDocumentTree operation.
+//
+// Get document tree.
+//
+// GET /documents/tree
+func (c *Client) GetDocumentTree(ctx context.Context, params GetDocumentTreeParams) (*DocumentTree, error) {
+	res, err := c.sendGetDocumentTree(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetDocumentTree(ctx context.Context, params GetDocumentTreeParams) (res *DocumentTree, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDocumentTree"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/documents/tree"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentTreeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/documents/tree"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "projectIdOrKey" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetDocumentTreeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetDocumentTreeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDocumentTreeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetDocuments invokes getDocuments operation.
+//
+// Get document list.
+//
+// GET /documents
+func (c *Client) GetDocuments(ctx context.Context, params GetDocumentsParams) ([]Document, error) {
+	res, err := c.sendGetDocuments(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetDocuments(ctx context.Context, params GetDocumentsParams) (res []Document, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDocuments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/documents"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/documents"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "projectId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ProjectId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ProjectId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "keyword" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "keyword",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Keyword.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.Offset))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "count" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetDocumentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetDocumentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDocumentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetDocumentsCount invokes getDocumentsCount operation.
+//
+// Get document count.
+//
+// GET /documents/count
+func (c *Client) GetDocumentsCount(ctx context.Context, params GetDocumentsCountParams) (*GetDocumentsCountOK, error) {
+	res, err := c.sendGetDocumentsCount(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetDocumentsCount(ctx context.Context, params GetDocumentsCountParams) (res *GetDocumentsCountOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDocumentsCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/documents/count"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDocumentsCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/documents/count"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "projectIdOrKey" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetDocumentsCountOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetDocumentsCountOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDocumentsCountResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetIssue invokes getIssue operation.
+//
+// Get issue.
+//
+// GET /issues/{issueIdOrKey}
+func (c *Client) GetIssue(ctx context.Context, params GetIssueParams) (*Issue, error) {
+	res, err := c.sendGetIssue(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res *Issue, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssue"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIssueOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/issues/"
+	{
+		// Encode "issueIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issueIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetIssueOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetIssueOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetIssueResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetIssueTypes invokes getIssueTypes operation.
+//
+// Get issue types.
+//
+// GET /projects/{projectIdOrKey}/issueTypes
+func (c *Client) GetIssueTypes(ctx context.Context, params GetIssueTypesParams) ([]IssueType, error) {
+	res, err := c.sendGetIssueTypes(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetIssueTypes(ctx context.Context, params GetIssueTypesParams) (res []IssueType, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssueTypes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/issueTypes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIssueTypesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/projects/"
+	{
+		// Encode "projectIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "projectIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issueTypes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetIssueTypesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetIssueTypesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetIssueTypesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetIssues invokes getIssues operation.
+//
+// Get issues.
+//
+// GET /issues
+func (c *Client) GetIssues(ctx context.Context, params GetIssuesParams) ([]Issue, error) {
+	res, err := c.sendGetIssues(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res []Issue, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/issues"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/issues"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "projectId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ProjectId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ProjectId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "issueTypeId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "issueTypeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.IssueTypeId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.IssueTypeId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "categoryId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "categoryId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.CategoryId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.CategoryId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "versionId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "versionId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.VersionId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.VersionId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "milestoneId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "milestoneId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.MilestoneId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.MilestoneId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "statusId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "statusId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.StatusId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.StatusId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "priorityId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "priorityId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.PriorityId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.PriorityId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "assigneeId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "assigneeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.AssigneeId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.AssigneeId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "createdUserId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "createdUserId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.CreatedUserId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.CreatedUserId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resolutionId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resolutionId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ResolutionId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ResolutionId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "parentChild" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "parentChild",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ParentChild.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "attachment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "attachment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Attachment.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sharedFile" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sharedFile",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SharedFile.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "count" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "keyword" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "keyword",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Keyword.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "createdSince" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "createdSince",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreatedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "createdUntil" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "createdUntil",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreatedUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "updatedSince" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "updatedSince",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UpdatedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "updatedUntil" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "updatedUntil",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UpdatedUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "startDateSince" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "startDateSince",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.StartDateSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "startDateUntil" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "startDateUntil",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.StartDateUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dueDateSince" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dueDateSince",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DueDateSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dueDateUntil" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dueDateUntil",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DueDateUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "id[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "id[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ID != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ID {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "parentIssueId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "parentIssueId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ParentIssueId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ParentIssueId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetIssuesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetIssuesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetIssuesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetIssuesCount invokes getIssuesCount operation.
+//
+// Get issues count.
+//
+// GET /issues/count
+func (c *Client) GetIssuesCount(ctx context.Context, params GetIssuesCountParams) (*GetIssuesCountOK, error) {
+	res, err := c.sendGetIssuesCount(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountParams) (res *GetIssuesCountOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssuesCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/issues/count"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIssuesCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/issues/count"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "projectId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ProjectId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ProjectId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "issueTypeId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "issueTypeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.IssueTypeId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.IssueTypeId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
 					return nil
 				})
 			}
@@ -4561,25 +6378,214 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "statusId[]" parameter.
+		// Encode "statusId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "statusId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.StatusId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.StatusId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "priorityId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "priorityId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.PriorityId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.PriorityId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "assigneeId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "assigneeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.AssigneeId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.AssigneeId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "createdUserId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "createdUserId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.CreatedUserId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.CreatedUserId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resolutionId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resolutionId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ResolutionId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ResolutionId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "parentChild" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "parentChild",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ParentChild.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "attachment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "attachment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Attachment.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sharedFile" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sharedFile",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SharedFile.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "keyword" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "keyword",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Keyword.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "createdSince" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "statusId[]",
+			Name:    "createdSince",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.StatusId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.StatusId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+			if val, ok := params.CreatedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4587,25 +6593,16 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "priorityId[]" parameter.
+		// Encode "createdUntil" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "priorityId[]",
+			Name:    "createdUntil",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.PriorityId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.PriorityId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+			if val, ok := params.CreatedUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4613,25 +6610,16 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "assigneeId[]" parameter.
+		// Encode "updatedSince" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "assigneeId[]",
+			Name:    "updatedSince",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.AssigneeId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.AssigneeId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+			if val, ok := params.UpdatedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4639,25 +6627,16 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "createdUserId[]" parameter.
+		// Encode "updatedUntil" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "createdUserId[]",
+			Name:    "updatedUntil",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.CreatedUserId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.CreatedUserId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+			if val, ok := params.UpdatedUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4665,25 +6644,16 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "resolutionId[]" parameter.
+		// Encode "startDateSince" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "resolutionId[]",
+			Name:    "startDateSince",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ResolutionId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ResolutionId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+			if val, ok := params.StartDateSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4691,16 +6661,16 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "parentChild" parameter.
+		// Encode "startDateUntil" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "parentChild",
+			Name:    "startDateUntil",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ParentChild.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
+			if val, ok := params.StartDateUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4708,16 +6678,16 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "attachment" parameter.
+		// Encode "dueDateSince" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "attachment",
+			Name:    "dueDateSince",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Attachment.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
+			if val, ok := params.DueDateSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4725,16 +6695,16 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "sharedFile" parameter.
+		// Encode "dueDateUntil" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sharedFile",
+			Name:    "dueDateUntil",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.SharedFile.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
+			if val, ok := params.DueDateUntil.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4742,16 +6712,25 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "sort" parameter.
+		// Encode "id[]" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sort",
+			Name:    "id[]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sort.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if params.ID != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ID {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
 			}
 			return nil
 		}); err != nil {
@@ -4759,262 +6738,306 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 	}
 	{
-		// Encode "order" parameter.
+		// Encode "parentIssueId[]" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "order",
+			Name:    "parentIssueId[]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Order.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if params.ParentIssueId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ParentIssueId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
 			}
 			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetIssuesCountOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
+			}
+		}
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetIssuesCountOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetIssuesCountResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetListOfIssueAttachments invokes getListOfIssueAttachments operation.
+//
+// Get issue attachments.
+//
+// GET /issues/{issueIdOrKey}/attachments
+func (c *Client) GetListOfIssueAttachments(ctx context.Context, params GetListOfIssueAttachmentsParams) ([]Attachment, error) {
+	res, err := c.sendGetListOfIssueAttachments(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetListOfIssueAttachmentsParams) (res []Attachment, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfIssueAttachments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/attachments"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfIssueAttachmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/issues/"
 	{
-		// Encode "count" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "issueIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issueIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Count.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	{
-		// Encode "keyword" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "keyword",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	pathParts[2] = "/attachments"
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Keyword.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
-	{
-		// Encode "createdSince" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "createdSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CreatedSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
 	{
-		// Encode "createdUntil" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "createdUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CreatedUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetListOfIssueAttachmentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
 		}
-	}
-	{
-		// Encode "updatedSince" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "updatedSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.UpdatedSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetListOfIssueAttachmentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "updatedUntil" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "updatedUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.UpdatedUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "startDateSince" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "startDateSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StartDateSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "startDateUntil" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "startDateUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	defer resp.Body.Close()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StartDateUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "DecodeResponse"
+	result, err := decodeGetListOfIssueAttachmentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	{
-		// Encode "dueDateSince" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "dueDateSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.DueDateSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	return result, nil
+}
+
+// GetListOfLinkedSharedFiles invokes getListOfLinkedSharedFiles operation.
+//
+// Get shared files linked to issue.
+//
+// GET /issues/{issueIdOrKey}/sharedFiles
+func (c *Client) GetListOfLinkedSharedFiles(ctx context.Context, params GetListOfLinkedSharedFilesParams) ([]SharedFile, error) {
+	res, err := c.sendGetListOfLinkedSharedFiles(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetListOfLinkedSharedFilesParams) (res []SharedFile, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfLinkedSharedFiles"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/sharedFiles"),
 	}
-	{
-		// Encode "dueDateUntil" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "dueDateUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.DueDateUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "id[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "id[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfLinkedSharedFilesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ID != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ID {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/issues/"
 	{
-		// Encode "parentIssueId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "parentIssueId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "issueIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issueIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ParentIssueId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ParentIssueId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/sharedFiles"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -5027,7 +7050,7 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetIssuesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetListOfLinkedSharedFilesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5038,7 +7061,7 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetIssuesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetListOfLinkedSharedFilesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5075,7 +7098,7 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIssuesResponse(resp)
+	result, err := decodeGetListOfLinkedSharedFilesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5083,21 +7106,21 @@ func (c *Client) sendGetIssues(ctx context.Context, params GetIssuesParams) (res
 	return result, nil
 }
 
-// GetIssuesCount invokes getIssuesCount operation.
+// GetListOfPullRequestAttachment invokes getListOfPullRequestAttachment operation.
 //
-// Get issues count.
+// Get pull request attachments.
 //
-// GET /issues/count
-func (c *Client) GetIssuesCount(ctx context.Context, params GetIssuesCountParams) (*GetIssuesCountOK, error) {
-	res, err := c.sendGetIssuesCount(ctx, params)
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments
+func (c *Client) GetListOfPullRequestAttachment(ctx context.Context, params GetListOfPullRequestAttachmentParams) ([]Attachment, error) {
+	res, err := c.sendGetListOfPullRequestAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountParams) (res *GetIssuesCountOK, err error) {
+func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params GetListOfPullRequestAttachmentParams) (res []Attachment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssuesCount"),
+		otelogen.OperationID("getListOfPullRequestAttachment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues/count"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5113,7 +7136,7 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIssuesCountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfPullRequestAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5130,333 +7153,197 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/issues/count"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "projectId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "projectId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ProjectId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ProjectId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "issueTypeId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "issueTypeId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.IssueTypeId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.IssueTypeId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "categoryId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "categoryId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.CategoryId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.CategoryId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "versionId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "versionId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.VersionId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.VersionId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [7]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "milestoneId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "milestoneId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.MilestoneId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.MilestoneId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		// Encode "projectIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "projectIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/git/repositories/"
 	{
-		// Encode "statusId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "statusId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "repoIdOrName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "repoIdOrName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.StatusId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.StatusId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[3] = encoded
 	}
+	pathParts[4] = "/pullRequests/"
 	{
-		// Encode "priorityId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "priorityId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "number" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "number",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Number))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.PriorityId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.PriorityId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[5] = encoded
 	}
-	{
-		// Encode "assigneeId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "assigneeId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	pathParts[6] = "/attachments"
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.AssigneeId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.AssigneeId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
-	{
-		// Encode "createdUserId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "createdUserId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.CreatedUserId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.CreatedUserId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetListOfPullRequestAttachmentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
 		}
-	}
-	{
-		// Encode "resolutionId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "resolutionId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetListOfPullRequestAttachmentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ResolutionId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ResolutionId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
 					}
-					return nil
-				})
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "parentChild" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "parentChild",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ParentChild.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "attachment" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "attachment",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetListOfPullRequestAttachmentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetListOfRecentlyViewedIssues invokes getListOfRecentlyViewedIssues operation.
+//
+// Get list of recently viewed issues.
+//
+// GET /users/myself/recentlyViewedIssues
+func (c *Client) GetListOfRecentlyViewedIssues(ctx context.Context, params GetListOfRecentlyViewedIssuesParams) ([]RecentlyViewedIssue, error) {
+	res, err := c.sendGetListOfRecentlyViewedIssues(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params GetListOfRecentlyViewedIssuesParams) (res []RecentlyViewedIssue, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfRecentlyViewedIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/users/myself/recentlyViewedIssues"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Attachment.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "sharedFile" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sharedFile",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfRecentlyViewedIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.SharedFile.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/users/myself/recentlyViewedIssues"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "keyword" parameter.
+		// Encode "order" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "keyword",
+			Name:    "order",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Keyword.Get(); ok {
+			if val, ok := params.Order.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -5465,16 +7352,16 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 		}
 	}
 	{
-		// Encode "createdSince" parameter.
+		// Encode "offset" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "createdSince",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CreatedSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -5482,177 +7369,160 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 		}
 	}
 	{
-		// Encode "createdUntil" parameter.
+		// Encode "count" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "createdUntil",
+			Name:    "count",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CreatedUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "updatedSince" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "updatedSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	u.RawQuery = q.Values().Encode()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.UpdatedSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
-	{
-		// Encode "updatedUntil" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "updatedUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.UpdatedUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetListOfRecentlyViewedIssuesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
 		}
-	}
-	{
-		// Encode "startDateSince" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "startDateSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetListOfRecentlyViewedIssuesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StartDateSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "startDateUntil" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "startDateUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StartDateUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "dueDateSince" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "dueDateSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	defer resp.Body.Close()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.DueDateSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "DecodeResponse"
+	result, err := decodeGetListOfRecentlyViewedIssuesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	{
-		// Encode "dueDateUntil" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "dueDateUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.DueDateUntil.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	return result, nil
+}
+
+// GetListOfSharedFilesOnWiki invokes getListOfSharedFilesOnWiki operation.
+//
+// Get shared files linked to wiki.
+//
+// GET /wikis/{wikiId}/sharedFiles
+func (c *Client) GetListOfSharedFilesOnWiki(ctx context.Context, params GetListOfSharedFilesOnWikiParams) ([]SharedFile, error) {
+	res, err := c.sendGetListOfSharedFilesOnWiki(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetListOfSharedFilesOnWikiParams) (res []SharedFile, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfSharedFilesOnWiki"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}/sharedFiles"),
 	}
-	{
-		// Encode "id[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "id[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ID != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ID {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfSharedFilesOnWikiOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-	}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/wikis/"
 	{
-		// Encode "parentIssueId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "parentIssueId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "wikiId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "wikiId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.WikiId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ParentIssueId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ParentIssueId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/sharedFiles"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -5665,7 +7535,7 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetIssuesCountOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetListOfSharedFilesOnWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5676,7 +7546,7 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetIssuesCountOperation, r); {
+			switch err := c.securityApiKey(ctx, GetListOfSharedFilesOnWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5713,7 +7583,7 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIssuesCountResponse(resp)
+	result, err := decodeGetListOfSharedFilesOnWikiResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5721,21 +7591,21 @@ func (c *Client) sendGetIssuesCount(ctx context.Context, params GetIssuesCountPa
 	return result, nil
 }
 
-// GetListOfIssueAttachments invokes getListOfIssueAttachments operation.
+// GetListOfWikiAttachments invokes getListOfWikiAttachments operation.
 //
-// Get issue attachments.
+// Get wiki attachments.
 //
-// GET /issues/{issueIdOrKey}/attachments
-func (c *Client) GetListOfIssueAttachments(ctx context.Context, params GetListOfIssueAttachmentsParams) ([]Attachment, error) {
-	res, err := c.sendGetListOfIssueAttachments(ctx, params)
+// GET /wikis/{wikiId}/attachments
+func (c *Client) GetListOfWikiAttachments(ctx context.Context, params GetListOfWikiAttachmentsParams) ([]Attachment, error) {
+	res, err := c.sendGetListOfWikiAttachments(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetListOfIssueAttachmentsParams) (res []Attachment, err error) {
+func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetListOfWikiAttachmentsParams) (res []Attachment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfIssueAttachments"),
+		otelogen.OperationID("getListOfWikiAttachments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/attachments"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}/attachments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5751,7 +7621,7 @@ func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetLi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfIssueAttachmentsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfWikiAttachmentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5769,16 +7639,16 @@ func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetLi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/issues/"
+	pathParts[0] = "/wikis/"
 	{
-		// Encode "issueIdOrKey" parameter.
+		// Encode "wikiId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
+			Param:   "wikiId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+			return e.EncodeValue(conv.IntToString(params.WikiId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5802,7 +7672,7 @@ func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetLi
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetListOfIssueAttachmentsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetListOfWikiAttachmentsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5813,7 +7683,7 @@ func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetLi
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetListOfIssueAttachmentsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetListOfWikiAttachmentsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5850,7 +7720,7 @@ func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetLi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetListOfIssueAttachmentsResponse(resp)
+	result, err := decodeGetListOfWikiAttachmentsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5858,21 +7728,21 @@ func (c *Client) sendGetListOfIssueAttachments(ctx context.Context, params GetLi
 	return result, nil
 }
 
-// GetListOfLinkedSharedFiles invokes getListOfLinkedSharedFiles operation.
+// GetPriorities invokes getPriorities operation.
 //
-// Get shared files linked to issue.
+// Get all priorities.
 //
-// GET /issues/{issueIdOrKey}/sharedFiles
-func (c *Client) GetListOfLinkedSharedFiles(ctx context.Context, params GetListOfLinkedSharedFilesParams) ([]SharedFile, error) {
-	res, err := c.sendGetListOfLinkedSharedFiles(ctx, params)
+// GET /priorities
+func (c *Client) GetPriorities(ctx context.Context) ([]Priority, error) {
+	res, err := c.sendGetPriorities(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetListOfLinkedSharedFilesParams) (res []SharedFile, err error) {
+func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfLinkedSharedFiles"),
+		otelogen.OperationID("getPriorities"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/sharedFiles"),
+		semconv.URLTemplateKey.String("/priorities"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5888,7 +7758,7 @@ func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetL
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfLinkedSharedFilesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPrioritiesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5905,27 +7775,8 @@ func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetL
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/issues/"
-	{
-		// Encode "issueIdOrKey" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/sharedFiles"
+	var pathParts [1]string
+	pathParts[0] = "/priorities"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5939,7 +7790,7 @@ func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetL
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetListOfLinkedSharedFilesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetPrioritiesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5950,7 +7801,7 @@ func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetL
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetListOfLinkedSharedFilesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetPrioritiesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5987,7 +7838,7 @@ func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetL
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetListOfLinkedSharedFilesResponse(resp)
+	result, err := decodeGetPrioritiesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5995,21 +7846,21 @@ func (c *Client) sendGetListOfLinkedSharedFiles(ctx context.Context, params GetL
 	return result, nil
 }
 
-// GetListOfPullRequestAttachment invokes getListOfPullRequestAttachment operation.
+// GetProject invokes getProject operation.
 //
-// Get pull request attachments.
+// Get project.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments
-func (c *Client) GetListOfPullRequestAttachment(ctx context.Context, params GetListOfPullRequestAttachmentParams) ([]Attachment, error) {
-	res, err := c.sendGetListOfPullRequestAttachment(ctx, params)
+// GET /projects/{projectIdOrKey}
+func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (*Project, error) {
+	res, err := c.sendGetProject(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params GetListOfPullRequestAttachmentParams) (res []Attachment, err error) {
+func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (res *Project, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfPullRequestAttachment"),
+		otelogen.OperationID("getProject"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6025,7 +7876,7 @@ func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfPullRequestAttachmentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6042,7 +7893,7 @@ func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [7]string
+	var pathParts [2]string
 	pathParts[0] = "/projects/"
 	{
 		// Encode "projectIdOrKey" parameter.
@@ -6062,45 +7913,6 @@ func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/git/repositories/"
-	{
-		// Encode "repoIdOrName" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "repoIdOrName",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/pullRequests/"
-	{
-		// Encode "number" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "number",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.Number))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
-	pathParts[6] = "/attachments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6114,7 +7926,7 @@ func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetListOfPullRequestAttachmentOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetProjectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6125,7 +7937,7 @@ func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetListOfPullRequestAttachmentOperation, r); {
+			switch err := c.securityApiKey(ctx, GetProjectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6162,7 +7974,7 @@ func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetListOfPullRequestAttachmentResponse(resp)
+	result, err := decodeGetProjectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6170,21 +7982,21 @@ func (c *Client) sendGetListOfPullRequestAttachment(ctx context.Context, params
 	return result, nil
 }
 
-// GetListOfRecentlyViewedIssues invokes getListOfRecentlyViewedIssues operation.
+// GetProjectAdministrators invokes getProjectAdministrators operation.
 //
-// Get list of recently viewed issues.
+// Get project administrators.
 //
-// GET /users/myself/recentlyViewedIssues
-func (c *Client) GetListOfRecentlyViewedIssues(ctx context.Context, params GetListOfRecentlyViewedIssuesParams) ([]RecentlyViewedIssue, error) {
-	res, err := c.sendGetListOfRecentlyViewedIssues(ctx, params)
+// GET /projects/{projectIdOrKey}/administrators
+func (c *Client) GetProjectAdministrators(ctx context.Context, params GetProjectAdministratorsParams) ([]User, error) {
+	res, err := c.sendGetProjectAdministrators(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params GetListOfRecentlyViewedIssuesParams) (res []RecentlyViewedIssue, err error) {
+func (c *Client) sendGetProjectAdministrators(ctx context.Context, params GetProjectAdministratorsParams) (res []User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfRecentlyViewedIssues"),
+		otelogen.OperationID("getProjectAdministrators"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users/myself/recentlyViewedIssues"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/administrators"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6200,7 +8012,7 @@ func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params G
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfRecentlyViewedIssuesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectAdministratorsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6210,71 +8022,35 @@ func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params G
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
-		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/users/myself/recentlyViewedIssues"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "order" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "order",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Order.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "count" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "projectIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "projectIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Count.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/administrators"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -6287,7 +8063,7 @@ func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params G
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetListOfRecentlyViewedIssuesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetProjectAdministratorsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6298,7 +8074,7 @@ func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params G
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetListOfRecentlyViewedIssuesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetProjectAdministratorsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6335,7 +8111,7 @@ func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params G
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetListOfRecentlyViewedIssuesResponse(resp)
+	result, err := decodeGetProjectAdministratorsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6343,21 +8119,21 @@ func (c *Client) sendGetListOfRecentlyViewedIssues(ctx context.Context, params G
 	return result, nil
 }
 
-// GetListOfSharedFilesOnWiki invokes getListOfSharedFilesOnWiki operation.
+// GetProjectRecentUpdates invokes getProjectRecentUpdates operation.
 //
-// Get shared files linked to wiki.
+// Get project recent updates.
 //
-// GET /wikis/{wikiId}/sharedFiles
-func (c *Client) GetListOfSharedFilesOnWiki(ctx context.Context, params GetListOfSharedFilesOnWikiParams) ([]SharedFile, error) {
-	res, err := c.sendGetListOfSharedFilesOnWiki(ctx, params)
+// GET /projects/{projectIdOrKey}/activities
+func (c *Client) GetProjectRecentUpdates(ctx context.Context, params GetProjectRecentUpdatesParams) ([]Activity, error) {
+	res, err := c.sendGetProjectRecentUpdates(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetListOfSharedFilesOnWikiParams) (res []SharedFile, err error) {
+func (c *Client) sendGetProjectRecentUpdates(ctx context.Context, params GetProjectRecentUpdatesParams) (res []Activity, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfSharedFilesOnWiki"),
+		otelogen.OperationID("getProjectRecentUpdates"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}/sharedFiles"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/activities"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6373,7 +8149,7 @@ func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetL
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfSharedFilesOnWikiOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectRecentUpdatesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6391,16 +8167,16 @@ func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetL
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/wikis/"
+	pathParts[0] = "/projects/"
 	{
-		// Encode "wikiId" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -6410,9 +8186,107 @@ func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetL
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/sharedFiles"
+	pathParts[2] = "/activities"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "activityTypeId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "activityTypeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ActivityTypeId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ActivityTypeId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "minId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "minId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MinId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "maxId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "maxId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "count" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -6424,7 +8298,7 @@ func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetL
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetListOfSharedFilesOnWikiOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetProjectRecentUpdatesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6435,7 +8309,7 @@ func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetL
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetListOfSharedFilesOnWikiOperation, r); {
+			switch err := c.securityApiKey(ctx, GetProjectRecentUpdatesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6472,7 +8346,7 @@ func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetL
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetListOfSharedFilesOnWikiResponse(resp)
+	result, err := decodeGetProjectRecentUpdatesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6480,21 +8354,21 @@ func (c *Client) sendGetListOfSharedFilesOnWiki(ctx context.Context, params GetL
 	return result, nil
 }
 
-// GetListOfWikiAttachments invokes getListOfWikiAttachments operation.
+// GetProjectUsers invokes getProjectUsers operation.
 //
-// Get wiki attachments.
+// Get project users.
 //
-// GET /wikis/{wikiId}/attachments
-func (c *Client) GetListOfWikiAttachments(ctx context.Context, params GetListOfWikiAttachmentsParams) ([]Attachment, error) {
-	res, err := c.sendGetListOfWikiAttachments(ctx, params)
+// GET /projects/{projectIdOrKey}/users
+func (c *Client) GetProjectUsers(ctx context.Context, params GetProjectUsersParams) ([]User, error) {
+	res, err := c.sendGetProjectUsers(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetListOfWikiAttachmentsParams) (res []Attachment, err error) {
+func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsersParams) (res []User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfWikiAttachments"),
+		otelogen.OperationID("getProjectUsers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}/attachments"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/users"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6510,7 +8384,7 @@ func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetLis
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetListOfWikiAttachmentsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6528,16 +8402,16 @@ func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetLis
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/wikis/"
+	pathParts[0] = "/projects/"
 	{
-		// Encode "wikiId" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -6547,7 +8421,7 @@ func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetLis
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/attachments"
+	pathParts[2] = "/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6561,7 +8435,7 @@ func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetLis
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetListOfWikiAttachmentsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetProjectUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6572,7 +8446,7 @@ func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetLis
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetListOfWikiAttachmentsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetProjectUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6609,7 +8483,7 @@ func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetLis
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetListOfWikiAttachmentsResponse(resp)
+	result, err := decodeGetProjectUsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6617,21 +8491,21 @@ func (c *Client) sendGetListOfWikiAttachments(ctx context.Context, params GetLis
 	return result, nil
 }
 
-// GetPriorities invokes getPriorities operation.
+// GetProjects invokes getProjects operation.
 //
-// Get all priorities.
+// Get projects.
 //
-// GET /priorities
-func (c *Client) GetPriorities(ctx context.Context) ([]Priority, error) {
-	res, err := c.sendGetPriorities(ctx)
+// GET /projects
+func (c *Client) GetProjects(ctx context.Context, params GetProjectsParams) ([]Project, error) {
+	res, err := c.sendGetProjects(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err error) {
+func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams) (res []Project, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPriorities"),
+		otelogen.OperationID("getProjects"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/priorities"),
+		semconv.URLTemplateKey.String("/projects"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6647,7 +8521,7 @@ func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPrioritiesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6665,9 +8539,30 @@ func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err err
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/priorities"
+	pathParts[0] = "/projects"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "archived" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "archived",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Archived.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -6679,7 +8574,7 @@ func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err err
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetPrioritiesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetProjectsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6690,7 +8585,7 @@ func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err err
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetPrioritiesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetProjectsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6727,7 +8622,7 @@ func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPrioritiesResponse(resp)
+	result, err := decodeGetProjectsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6735,21 +8630,21 @@ func (c *Client) sendGetPriorities(ctx context.Context) (res []Priority, err err
 	return result, nil
 }
 
-// GetProject invokes getProject operation.
-//
-// Get project.
+// GetPullRequest invokes getPullRequest operation.
 //
-// GET /projects/{projectIdOrKey}
-func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (*Project, error) {
-	res, err := c.sendGetProject(ctx, params)
+// Get pull request.
+//
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}
+func (c *Client) GetPullRequest(ctx context.Context, params GetPullRequestParams) (*PullRequest, error) {
+	res, err := c.sendGetPullRequest(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (res *Project, err error) {
+func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestParams) (res *PullRequest, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProject"),
+		otelogen.OperationID("getPullRequest"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6765,7 +8660,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPullRequestOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6782,7 +8677,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [6]string
 	pathParts[0] = "/projects/"
 	{
 		// Encode "projectIdOrKey" parameter.
@@ -6802,6 +8697,44 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/git/repositories/"
+	{
+		// Encode "repoIdOrName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "repoIdOrName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/pullRequests/"
+	{
+		// Encode "number" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "number",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Number))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6815,7 +8748,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetProjectOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetPullRequestOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6826,7 +8759,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetProjectOperation, r); {
+			switch err := c.securityApiKey(ctx, GetPullRequestOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6863,7 +8796,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectResponse(resp)
+	result, err := decodeGetPullRequestResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6871,21 +8804,21 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	return result, nil
 }
 
-// GetProjectUsers invokes getProjectUsers operation.
+// GetPullRequests invokes getPullRequests operation.
 //
-// Get project users.
+// Get pull requests.
 //
-// GET /projects/{projectIdOrKey}/users
-func (c *Client) GetProjectUsers(ctx context.Context, params GetProjectUsersParams) ([]User, error) {
-	res, err := c.sendGetProjectUsers(ctx, params)
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests
+func (c *Client) GetPullRequests(ctx context.Context, params GetPullRequestsParams) ([]PullRequest, error) {
+	res, err := c.sendGetPullRequests(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsersParams) (res []User, err error) {
+func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequestsParams) (res []PullRequest, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProjectUsers"),
+		otelogen.OperationID("getPullRequests"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/users"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6901,7 +8834,7 @@ func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsers
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPullRequestsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6918,7 +8851,7 @@ func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsers
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [5]string
 	pathParts[0] = "/projects/"
 	{
 		// Encode "projectIdOrKey" parameter.
@@ -6938,9 +8871,170 @@ func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsers
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/users"
+	pathParts[2] = "/git/repositories/"
+	{
+		// Encode "repoIdOrName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "repoIdOrName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/pullRequests"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "statusId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "statusId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.StatusId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.StatusId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "assigneeId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "assigneeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.AssigneeId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.AssigneeId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "issueId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "issueId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.IssueId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.IssueId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "createdUserId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "createdUserId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.CreatedUserId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.CreatedUserId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "count" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -6952,7 +9046,7 @@ func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsers
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetProjectUsersOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetPullRequestsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6963,7 +9057,7 @@ func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsers
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetProjectUsersOperation, r); {
+			switch err := c.securityApiKey(ctx, GetPullRequestsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7000,7 +9094,7 @@ func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsers
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectUsersResponse(resp)
+	result, err := decodeGetPullRequestsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7008,21 +9102,21 @@ func (c *Client) sendGetProjectUsers(ctx context.Context, params GetProjectUsers
 	return result, nil
 }
 
-// GetProjects invokes getProjects operation.
-//
-// Get projects.
+// GetPullRequestsCount invokes getPullRequestsCount operation.
 //
-// GET /projects
-func (c *Client) GetProjects(ctx context.Context, params GetProjectsParams) ([]Project, error) {
-	res, err := c.sendGetProjects(ctx, params)
+// Get pull requests count.
+//
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count
+func (c *Client) GetPullRequestsCount(ctx context.Context, params GetPullRequestsCountParams) (*GetPullRequestsCountOK, error) {
+	res, err := c.sendGetPullRequestsCount(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams) (res []Project, err error) {
+func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullRequestsCountParams) (res *GetPullRequestsCountOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProjects"),
+		otelogen.OperationID("getPullRequestsCount"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7038,7 +9132,7 @@ func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPullRequestsCountOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7055,23 +9149,70 @@ func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/projects"
+	var pathParts [5]string
+	pathParts[0] = "/projects/"
+	{
+		// Encode "projectIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "projectIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/git/repositories/"
+	{
+		// Encode "repoIdOrName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "repoIdOrName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/pullRequests/count"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "archived" parameter.
+		// Encode "statusId[]" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "archived",
+			Name:    "statusId[]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Archived.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
+			if params.StatusId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.StatusId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
 			}
 			return nil
 		}); err != nil {
@@ -7091,7 +9232,7 @@ func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams)
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetProjectsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetPullRequestsCountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7102,7 +9243,7 @@ func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetProjectsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetPullRequestsCountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7139,7 +9280,7 @@ func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectsResponse(resp)
+	result, err := decodeGetPullRequestsCountResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7147,21 +9288,21 @@ func (c *Client) sendGetProjects(ctx context.Context, params GetProjectsParams)
 	return result, nil
 }
 
-// GetPullRequest invokes getPullRequest operation.
+// GetRepositories invokes getRepositories operation.
 //
-// Get pull request.
+// Get git repositories.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}
-func (c *Client) GetPullRequest(ctx context.Context, params GetPullRequestParams) (*PullRequest, error) {
-	res, err := c.sendGetPullRequest(ctx, params)
+// GET /projects/{projectIdOrKey}/git/repositories
+func (c *Client) GetRepositories(ctx context.Context, params GetRepositoriesParams) ([]Repository, error) {
+	res, err := c.sendGetRepositories(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestParams) (res *PullRequest, err error) {
+func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositoriesParams) (res []Repository, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPullRequest"),
+		otelogen.OperationID("getRepositories"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7177,7 +9318,7 @@ func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPullRequestOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRepositoriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7194,7 +9335,7 @@ func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
+	var pathParts [3]string
 	pathParts[0] = "/projects/"
 	{
 		// Encode "projectIdOrKey" parameter.
@@ -7214,44 +9355,7 @@ func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestPa
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/git/repositories/"
-	{
-		// Encode "repoIdOrName" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "repoIdOrName",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/pullRequests/"
-	{
-		// Encode "number" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "number",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.Number))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
+	pathParts[2] = "/git/repositories"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7265,7 +9369,7 @@ func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestPa
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetPullRequestOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetRepositoriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7276,7 +9380,7 @@ func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestPa
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetPullRequestOperation, r); {
+			switch err := c.securityApiKey(ctx, GetRepositoriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7313,7 +9417,7 @@ func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPullRequestResponse(resp)
+	result, err := decodeGetRepositoriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7321,21 +9425,21 @@ func (c *Client) sendGetPullRequest(ctx context.Context, params GetPullRequestPa
 	return result, nil
 }
 
-// GetPullRequests invokes getPullRequests operation.
+// GetResolutions invokes getResolutions operation.
 //
-// Get pull requests.
+// Get all resolutions.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests
-func (c *Client) GetPullRequests(ctx context.Context, params GetPullRequestsParams) ([]PullRequest, error) {
-	res, err := c.sendGetPullRequests(ctx, params)
+// GET /resolutions
+func (c *Client) GetResolutions(ctx context.Context) ([]Resolution, error) {
+	res, err := c.sendGetResolutions(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequestsParams) (res []PullRequest, err error) {
+func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPullRequests"),
+		otelogen.OperationID("getResolutions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests"),
+		semconv.URLTemplateKey.String("/resolutions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7351,7 +9455,7 @@ func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequests
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPullRequestsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetResolutionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7368,189 +9472,127 @@ func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequests
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [5]string
-	pathParts[0] = "/projects/"
-	{
-		// Encode "projectIdOrKey" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "projectIdOrKey",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/git/repositories/"
-	{
-		// Encode "repoIdOrName" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "repoIdOrName",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/pullRequests"
+	var pathParts [1]string
+	pathParts[0] = "/resolutions"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "statusId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "statusId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.StatusId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.StatusId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
-	{
-		// Encode "assigneeId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "assigneeId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.AssigneeId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.AssigneeId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:OAuth2"
+			switch err := c.securityOAuth2(ctx, GetResolutionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"OAuth2\"")
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
 		}
-	}
-	{
-		// Encode "issueId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "issueId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetResolutionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.IssueId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.IssueId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
 					}
-					return nil
-				})
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "createdUserId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "createdUserId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.CreatedUserId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.CreatedUserId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	defer resp.Body.Close()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "DecodeResponse"
+	result, err := decodeGetResolutionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	{
-		// Encode "count" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Count.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	return result, nil
+}
+
+// GetSpace invokes getSpace operation.
+//
+// Get space information.
+//
+// GET /space
+func (c *Client) GetSpace(ctx context.Context) (*Space, error) {
+	res, err := c.sendGetSpace(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getSpace"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/space"),
 	}
-	u.RawQuery = q.Values().Encode()
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSpaceOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/space"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -7563,7 +9605,7 @@ func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequests
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetPullRequestsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetSpaceOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7574,7 +9616,7 @@ func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequests
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetPullRequestsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetSpaceOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7611,7 +9653,7 @@ func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequests
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPullRequestsResponse(resp)
+	result, err := decodeGetSpaceResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7619,21 +9661,21 @@ func (c *Client) sendGetPullRequests(ctx context.Context, params GetPullRequests
 	return result, nil
 }
 
-// GetPullRequestsCount invokes getPullRequestsCount operation.
+// GetStatuses invokes getStatuses operation.
 //
-// Get pull requests count.
+// Get statuses.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count
-func (c *Client) GetPullRequestsCount(ctx context.Context, params GetPullRequestsCountParams) (*GetPullRequestsCountOK, error) {
-	res, err := c.sendGetPullRequestsCount(ctx, params)
+// GET /projects/{projectIdOrKey}/statuses
+func (c *Client) GetStatuses(ctx context.Context, params GetStatusesParams) ([]Status, error) {
+	res, err := c.sendGetStatuses(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullRequestsCountParams) (res *GetPullRequestsCountOK, err error) {
+func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams) (res []Status, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPullRequestsCount"),
+		otelogen.OperationID("getStatuses"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/statuses"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7649,7 +9691,7 @@ func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullReq
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPullRequestsCountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetStatusesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7666,7 +9708,7 @@ func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullReq
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [5]string
+	var pathParts [3]string
 	pathParts[0] = "/projects/"
 	{
 		// Encode "projectIdOrKey" parameter.
@@ -7686,58 +9728,9 @@ func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullReq
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/git/repositories/"
-	{
-		// Encode "repoIdOrName" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "repoIdOrName",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.RepoIdOrName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/pullRequests/count"
+	pathParts[2] = "/statuses"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "statusId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "statusId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.StatusId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.StatusId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -7749,7 +9742,7 @@ func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullReq
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetPullRequestsCountOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetStatusesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7760,7 +9753,7 @@ func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullReq
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetPullRequestsCountOperation, r); {
+			switch err := c.securityApiKey(ctx, GetStatusesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7797,7 +9790,7 @@ func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullReq
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPullRequestsCountResponse(resp)
+	result, err := decodeGetStatusesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7805,21 +9798,21 @@ func (c *Client) sendGetPullRequestsCount(ctx context.Context, params GetPullReq
 	return result, nil
 }
 
-// GetRepositories invokes getRepositories operation.
+// GetUser invokes getUser operation.
 //
-// Get git repositories.
+// Get user.
 //
-// GET /projects/{projectIdOrKey}/git/repositories
-func (c *Client) GetRepositories(ctx context.Context, params GetRepositoriesParams) ([]Repository, error) {
-	res, err := c.sendGetRepositories(ctx, params)
+// GET /users/{userId}
+func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*User, error) {
+	res, err := c.sendGetUser(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositoriesParams) (res []Repository, err error) {
+func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRepositories"),
+		otelogen.OperationID("getUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/git/repositories"),
+		semconv.URLTemplateKey.String("/users/{userId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7835,7 +9828,7 @@ func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositories
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetRepositoriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7852,17 +9845,17 @@ func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositories
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/projects/"
+	var pathParts [2]string
+	pathParts[0] = "/users/"
 	{
-		// Encode "projectIdOrKey" parameter.
+		// Encode "userId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "projectIdOrKey",
+			Param:   "userId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+			return e.EncodeValue(conv.IntToString(params.UserId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -7872,7 +9865,6 @@ func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositories
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/git/repositories"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7886,7 +9878,7 @@ func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositories
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetRepositoriesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7897,7 +9889,7 @@ func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositories
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetRepositoriesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7934,7 +9926,7 @@ func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositories
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetRepositoriesResponse(resp)
+	result, err := decodeGetUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7942,21 +9934,21 @@ func (c *Client) sendGetRepositories(ctx context.Context, params GetRepositories
 	return result, nil
 }
 
-// GetResolutions invokes getResolutions operation.
+// GetUserRecentUpdates invokes getUserRecentUpdates operation.
 //
-// Get all resolutions.
+// Get user recent updates.
 //
-// GET /resolutions
-func (c *Client) GetResolutions(ctx context.Context) ([]Resolution, error) {
-	res, err := c.sendGetResolutions(ctx)
+// GET /users/{userId}/activities
+func (c *Client) GetUserRecentUpdates(ctx context.Context, params GetUserRecentUpdatesParams) ([]Activity, error) {
+	res, err := c.sendGetUserRecentUpdates(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err error) {
+func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRecentUpdatesParams) (res []Activity, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getResolutions"),
+		otelogen.OperationID("getUserRecentUpdates"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/resolutions"),
+		semconv.URLTemplateKey.String("/users/{userId}/activities"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7972,7 +9964,7 @@ func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetResolutionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserRecentUpdatesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7989,10 +9981,127 @@ func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/resolutions"
+	var pathParts [3]string
+	pathParts[0] = "/users/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/activities"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "activityTypeId[]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "activityTypeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.ActivityTypeId != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.ActivityTypeId {
+						if err := func() error {
+							return e.EncodeValue(conv.IntToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "minId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "minId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MinId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "maxId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "maxId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "count" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Count.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -8004,7 +10113,7 @@ func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetResolutionsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetUserRecentUpdatesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8015,7 +10124,7 @@ func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetResolutionsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetUserRecentUpdatesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8052,7 +10161,7 @@ func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetResolutionsResponse(resp)
+	result, err := decodeGetUserRecentUpdatesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8060,21 +10169,21 @@ func (c *Client) sendGetResolutions(ctx context.Context) (res []Resolution, err
 	return result, nil
 }
 
-// GetSpace invokes getSpace operation.
+// GetUsers invokes getUsers operation.
 //
-// Get space information.
+// Get all users.
 //
-// GET /space
-func (c *Client) GetSpace(ctx context.Context) (*Space, error) {
-	res, err := c.sendGetSpace(ctx)
+// GET /users
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	res, err := c.sendGetUsers(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
+func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSpace"),
+		otelogen.OperationID("getUsers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/space"),
+		semconv.URLTemplateKey.String("/users"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8090,7 +10199,7 @@ func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetSpaceOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8108,7 +10217,7 @@ func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/space"
+	pathParts[0] = "/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -8122,7 +10231,7 @@ func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetSpaceOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8133,7 +10242,7 @@ func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetSpaceOperation, r); {
+			switch err := c.securityApiKey(ctx, GetUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8170,7 +10279,7 @@ func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetSpaceResponse(resp)
+	result, err := decodeGetUsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8178,21 +10287,21 @@ func (c *Client) sendGetSpace(ctx context.Context) (res *Space, err error) {
 	return result, nil
 }
 
-// GetStatuses invokes getStatuses operation.
+// GetVersions invokes getVersions operation.
 //
-// Get statuses.
+// Get versions.
 //
-// GET /projects/{projectIdOrKey}/statuses
-func (c *Client) GetStatuses(ctx context.Context, params GetStatusesParams) ([]Status, error) {
-	res, err := c.sendGetStatuses(ctx, params)
+// GET /projects/{projectIdOrKey}/versions
+func (c *Client) GetVersions(ctx context.Context, params GetVersionsParams) ([]Version, error) {
+	res, err := c.sendGetVersions(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams) (res []Status, err error) {
+func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams) (res []Version, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getStatuses"),
+		otelogen.OperationID("getVersions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/statuses"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/versions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8208,7 +10317,7 @@ func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetStatusesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetVersionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8245,7 +10354,7 @@ func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams)
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/statuses"
+	pathParts[2] = "/versions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -8259,7 +10368,7 @@ func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams)
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetStatusesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetVersionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8270,7 +10379,7 @@ func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetStatusesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetVersionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8307,7 +10416,7 @@ func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetStatusesResponse(resp)
+	result, err := decodeGetVersionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8315,21 +10424,21 @@ func (c *Client) sendGetStatuses(ctx context.Context, params GetStatusesParams)
 	return result, nil
 }
 
-// GetUser invokes getUser operation.
+// GetWebhooks invokes getWebhooks operation.
 //
-// Get user.
+// Get webhooks.
 //
-// GET /users/{userId}
-func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*User, error) {
-	res, err := c.sendGetUser(ctx, params)
+// GET /projects/{projectIdOrKey}/webhooks
+func (c *Client) GetWebhooks(ctx context.Context, params GetWebhooksParams) ([]Webhook, error) {
+	res, err := c.sendGetWebhooks(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *User, err error) {
+func (c *Client) sendGetWebhooks(ctx context.Context, params GetWebhooksParams) (res []Webhook, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUser"),
+		otelogen.OperationID("getWebhooks"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users/{userId}"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/webhooks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8345,7 +10454,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetWebhooksOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8362,17 +10471,17 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/users/"
+	var pathParts [3]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "userId" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "userId",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.UserId))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8382,6 +10491,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/webhooks"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -8395,7 +10505,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetUserOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetWebhooksOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8406,7 +10516,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetUserOperation, r); {
+			switch err := c.securityApiKey(ctx, GetWebhooksOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8443,7 +10553,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetUserResponse(resp)
+	result, err := decodeGetWebhooksResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8451,21 +10561,21 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	return result, nil
 }
 
-// GetUserRecentUpdates invokes getUserRecentUpdates operation.
+// GetWiki invokes getWiki operation.
 //
-// Get user recent updates.
+// Get wiki.
 //
-// GET /users/{userId}/activities
-func (c *Client) GetUserRecentUpdates(ctx context.Context, params GetUserRecentUpdatesParams) ([]Activity, error) {
-	res, err := c.sendGetUserRecentUpdates(ctx, params)
+// GET /wikis/{wikiId}
+func (c *Client) GetWiki(ctx context.Context, params GetWikiParams) (*Wiki, error) {
+	res, err := c.sendGetWiki(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRecentUpdatesParams) (res []Activity, err error) {
+func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wiki, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserRecentUpdates"),
+		otelogen.OperationID("getWiki"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users/{userId}/activities"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8481,7 +10591,7 @@ func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRec
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUserRecentUpdatesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8498,17 +10608,17 @@ func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRec
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/users/"
+	var pathParts [2]string
+	pathParts[0] = "/wikis/"
 	{
-		// Encode "userId" parameter.
+		// Encode "wikiId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "userId",
+			Param:   "wikiId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.UserId))
+			return e.EncodeValue(conv.IntToString(params.WikiId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8518,107 +10628,8 @@ func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRec
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/activities"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "activityTypeId[]" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "activityTypeId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.ActivityTypeId != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.ActivityTypeId {
-						if err := func() error {
-							return e.EncodeValue(conv.IntToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "minId" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "minId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.MinId.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "maxId" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "maxId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.MaxId.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "count" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Count.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "order" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "order",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Order.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -8630,7 +10641,7 @@ func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRec
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetUserRecentUpdatesOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8641,7 +10652,7 @@ func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRec
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetUserRecentUpdatesOperation, r); {
+			switch err := c.securityApiKey(ctx, GetWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8678,7 +10689,7 @@ func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRec
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetUserRecentUpdatesResponse(resp)
+	result, err := decodeGetWikiResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8686,21 +10697,21 @@ func (c *Client) sendGetUserRecentUpdates(ctx context.Context, params GetUserRec
 	return result, nil
 }
 
-// GetUsers invokes getUsers operation.
+// GetWikiTags invokes getWikiTags operation.
 //
-// Get all users.
+// Get wiki page tag list.
 //
-// GET /users
-func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
-	res, err := c.sendGetUsers(ctx)
+// GET /wikis/tags
+func (c *Client) GetWikiTags(ctx context.Context, params GetWikiTagsParams) ([]WikiTag, error) {
+	res, err := c.sendGetWikiTags(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
+func (c *Client) sendGetWikiTags(ctx context.Context, params GetWikiTagsParams) (res []WikiTag, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUsers"),
+		otelogen.OperationID("getWikiTags"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users"),
+		semconv.URLTemplateKey.String("/wikis/tags"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8716,7 +10727,7 @@ func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetWikiTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8734,9 +10745,27 @@ func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/users"
+	pathParts[0] = "/wikis/tags"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "projectIdOrKey" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -8748,7 +10777,7 @@ func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetUsersOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetWikiTagsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8759,7 +10788,7 @@ func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetUsersOperation, r); {
+			switch err := c.securityApiKey(ctx, GetWikiTagsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8796,7 +10825,7 @@ func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetUsersResponse(resp)
+	result, err := decodeGetWikiTagsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8804,21 +10833,21 @@ func (c *Client) sendGetUsers(ctx context.Context) (res []User, err error) {
 	return result, nil
 }
 
-// GetVersions invokes getVersions operation.
+// GetWikis invokes getWikis operation.
 //
-// Get versions.
+// Get wikis.
 //
-// GET /projects/{projectIdOrKey}/versions
-func (c *Client) GetVersions(ctx context.Context, params GetVersionsParams) ([]Version, error) {
-	res, err := c.sendGetVersions(ctx, params)
+// GET /wikis
+func (c *Client) GetWikis(ctx context.Context, params GetWikisParams) ([]Wiki, error) {
+	res, err := c.sendGetWikis(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams) (res []Version, err error) {
+func (c *Client) sendGetWikis(ctx context.Context, params GetWikisParams) (res []Wiki, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getVersions"),
+		otelogen.OperationID("getWikis"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/versions"),
+		semconv.URLTemplateKey.String("/wikis"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8834,7 +10863,7 @@ func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetVersionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetWikisOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8851,28 +10880,27 @@ func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/projects/"
+	var pathParts [1]string
+	pathParts[0] = "/wikis"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
 		// Encode "projectIdOrKey" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "projectIdOrKey",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/versions"
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -8885,7 +10913,7 @@ func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams)
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetVersionsOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetWikisOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8896,7 +10924,7 @@ func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams)
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetVersionsOperation, r); {
+			switch err := c.securityApiKey(ctx, GetWikisOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8933,7 +10961,7 @@ func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetVersionsResponse(resp)
+	result, err := decodeGetWikisResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8941,21 +10969,21 @@ func (c *Client) sendGetVersions(ctx context.Context, params GetVersionsParams)
 	return result, nil
 }
 
-// GetWiki invokes getWiki operation.
+// GetWikisCount invokes getWikisCount operation.
 //
-// Get wiki.
+// Get wikis count.
 //
-// GET /wikis/{wikiId}
-func (c *Client) GetWiki(ctx context.Context, params GetWikiParams) (*Wiki, error) {
-	res, err := c.sendGetWiki(ctx, params)
+// GET /wikis/count
+func (c *Client) GetWikisCount(ctx context.Context, params GetWikisCountParams) (*GetWikisCountOK, error) {
+	res, err := c.sendGetWikisCount(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wiki, err error) {
+func (c *Client) sendGetWikisCount(ctx context.Context, params GetWikisCountParams) (res *GetWikisCountOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWiki"),
+		otelogen.OperationID("getWikisCount"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}"),
+		semconv.URLTemplateKey.String("/wikis/count"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -8971,7 +10999,7 @@ func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetWikiOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetWikisCountOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8988,27 +11016,27 @@ func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wi
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/wikis/"
+	var pathParts [1]string
+	pathParts[0] = "/wikis/count"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "wikiId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "projectIdOrKey" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -9021,7 +11049,7 @@ func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wi
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetWikiOperation, r); {
+			switch err := c.securityOAuth2(ctx, GetWikisCountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9032,7 +11060,7 @@ func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wi
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetWikiOperation, r); {
+			switch err := c.securityApiKey(ctx, GetWikisCountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9069,7 +11097,7 @@ func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetWikiResponse(resp)
+	result, err := decodeGetWikisCountResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9077,21 +11105,21 @@ func (c *Client) sendGetWiki(ctx context.Context, params GetWikiParams) (res *Wi
 	return result, nil
 }
 
-// GetWikis invokes getWikis operation.
+// LinkSharedFilesToIssue invokes linkSharedFilesToIssue operation.
 //
-// Get wikis.
+// Link shared files to issue.
 //
-// GET /wikis
-func (c *Client) GetWikis(ctx context.Context, params GetWikisParams) ([]Wiki, error) {
-	res, err := c.sendGetWikis(ctx, params)
+// POST /issues/{issueIdOrKey}/sharedFiles
+func (c *Client) LinkSharedFilesToIssue(ctx context.Context, request OptLinkSharedFilesToIssueReq, params LinkSharedFilesToIssueParams) ([]SharedFile, error) {
+	res, err := c.sendLinkSharedFilesToIssue(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetWikis(ctx context.Context, params GetWikisParams) (res []Wiki, err error) {
+func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLinkSharedFilesToIssueReq, params LinkSharedFilesToIssueParams) (res []SharedFile, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWikis"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/wikis"),
+		otelogen.OperationID("linkSharedFilesToIssue"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/sharedFiles"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9107,7 +11135,7 @@ func (c *Client) sendGetWikis(ctx context.Context, params GetWikisParams) (res [
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetWikisOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, LinkSharedFilesToIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9124,40 +11152,44 @@ func (c *Client) sendGetWikis(ctx context.Context, params GetWikisParams) (res [
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/wikis"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/issues/"
 	{
-		// Encode "projectIdOrKey" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "projectIdOrKey",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "issueIdOrKey" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issueIdOrKey",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/sharedFiles"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeLinkSharedFilesToIssueRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetWikisOperation, r); {
+			switch err := c.securityOAuth2(ctx, LinkSharedFilesToIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9168,7 +11200,7 @@ func (c *Client) sendGetWikis(ctx context.Context, params GetWikisParams) (res [
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetWikisOperation, r); {
+			switch err := c.securityApiKey(ctx, LinkSharedFilesToIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9205,7 +11237,7 @@ func (c *Client) sendGetWikis(ctx context.Context, params GetWikisParams) (res [
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetWikisResponse(resp)
+	result, err := decodeLinkSharedFilesToIssueResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9213,21 +11245,21 @@ func (c *Client) sendGetWikis(ctx context.Context, params GetWikisParams) (res [
 	return result, nil
 }
 
-// GetWikisCount invokes getWikisCount operation.
+// LinkSharedFilesToWiki invokes linkSharedFilesToWiki operation.
 //
-// Get wikis count.
+// Link shared files to wiki.
 //
-// GET /wikis/count
-func (c *Client) GetWikisCount(ctx context.Context, params GetWikisCountParams) (*GetWikisCountOK, error) {
-	res, err := c.sendGetWikisCount(ctx, params)
+// POST /wikis/{wikiId}/sharedFiles
+func (c *Client) LinkSharedFilesToWiki(ctx context.Context, request OptLinkSharedFilesToWikiReq, params LinkSharedFilesToWikiParams) ([]SharedFile, error) {
+	res, err := c.sendLinkSharedFilesToWiki(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetWikisCount(ctx context.Context, params GetWikisCountParams) (res *GetWikisCountOK, err error) {
+func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkSharedFilesToWikiReq, params LinkSharedFilesToWikiParams) (res []SharedFile, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWikisCount"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/wikis/count"),
+		otelogen.OperationID("linkSharedFilesToWiki"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}/sharedFiles"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9243,7 +11275,7 @@ func (c *Client) sendGetWikisCount(ctx context.Context, params GetWikisCountPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetWikisCountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, LinkSharedFilesToWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9260,40 +11292,44 @@ func (c *Client) sendGetWikisCount(ctx context.Context, params GetWikisCountPara
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/wikis/count"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/wikis/"
 	{
-		// Encode "projectIdOrKey" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "projectIdOrKey",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "wikiId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "wikiId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.WikiId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/sharedFiles"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeLinkSharedFilesToWikiRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, GetWikisCountOperation, r); {
+			switch err := c.securityOAuth2(ctx, LinkSharedFilesToWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9304,7 +11340,7 @@ func (c *Client) sendGetWikisCount(ctx context.Context, params GetWikisCountPara
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetWikisCountOperation, r); {
+			switch err := c.securityApiKey(ctx, LinkSharedFilesToWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9341,7 +11377,7 @@ func (c *Client) sendGetWikisCount(ctx context.Context, params GetWikisCountPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetWikisCountResponse(resp)
+	result, err := decodeLinkSharedFilesToWikiResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9349,21 +11385,21 @@ func (c *Client) sendGetWikisCount(ctx context.Context, params GetWikisCountPara
 	return result, nil
 }
 
-// LinkSharedFilesToIssue invokes linkSharedFilesToIssue operation.
+// RemoveDocumentTags invokes removeDocumentTags operation.
 //
-// Link shared files to issue.
+// Remove document tags.
 //
-// POST /issues/{issueIdOrKey}/sharedFiles
-func (c *Client) LinkSharedFilesToIssue(ctx context.Context, request OptLinkSharedFilesToIssueReq, params LinkSharedFilesToIssueParams) ([]SharedFile, error) {
-	res, err := c.sendLinkSharedFilesToIssue(ctx, request, params)
+// DELETE /documents/{documentId}/tags
+func (c *Client) RemoveDocumentTags(ctx context.Context, request OptRemoveDocumentTagsReq, params RemoveDocumentTagsParams) ([]DocumentTag, error) {
+	res, err := c.sendRemoveDocumentTags(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLinkSharedFilesToIssueReq, params LinkSharedFilesToIssueParams) (res []SharedFile, err error) {
+func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDocumentTagsReq, params RemoveDocumentTagsParams) (res []DocumentTag, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("linkSharedFilesToIssue"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/sharedFiles"),
+		otelogen.OperationID("removeDocumentTags"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/documents/{documentId}/tags"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9379,7 +11415,7 @@ func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLink
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LinkSharedFilesToIssueOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveDocumentTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9397,16 +11433,16 @@ func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLink
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/issues/"
+	pathParts[0] = "/documents/"
 	{
-		// Encode "issueIdOrKey" parameter.
+		// Encode "documentId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
+			Param:   "documentId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+			return e.EncodeValue(conv.StringToString(params.DocumentId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9416,15 +11452,15 @@ func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLink
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/sharedFiles"
+	pathParts[2] = "/tags"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeLinkSharedFilesToIssueRequest(request, r); err != nil {
+	if err := encodeRemoveDocumentTagsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -9433,7 +11469,7 @@ func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLink
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, LinkSharedFilesToIssueOperation, r); {
+			switch err := c.securityOAuth2(ctx, RemoveDocumentTagsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9444,7 +11480,7 @@ func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLink
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, LinkSharedFilesToIssueOperation, r); {
+			switch err := c.securityApiKey(ctx, RemoveDocumentTagsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9481,7 +11517,7 @@ func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLink
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLinkSharedFilesToIssueResponse(resp)
+	result, err := decodeRemoveDocumentTagsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9489,21 +11525,21 @@ func (c *Client) sendLinkSharedFilesToIssue(ctx context.Context, request OptLink
 	return result, nil
 }
 
-// LinkSharedFilesToWiki invokes linkSharedFilesToWiki operation.
+// RemoveLinkToSharedFileFromIssue invokes removeLinkToSharedFileFromIssue operation.
 //
-// Link shared files to wiki.
+// Unlink shared file from issue.
 //
-// POST /wikis/{wikiId}/sharedFiles
-func (c *Client) LinkSharedFilesToWiki(ctx context.Context, request OptLinkSharedFilesToWikiReq, params LinkSharedFilesToWikiParams) ([]SharedFile, error) {
-	res, err := c.sendLinkSharedFilesToWiki(ctx, request, params)
+// DELETE /issues/{issueIdOrKey}/sharedFiles/{id}
+func (c *Client) RemoveLinkToSharedFileFromIssue(ctx context.Context, params RemoveLinkToSharedFileFromIssueParams) (*SharedFile, error) {
+	res, err := c.sendRemoveLinkToSharedFileFromIssue(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkSharedFilesToWikiReq, params LinkSharedFilesToWikiParams) (res []SharedFile, err error) {
+func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params RemoveLinkToSharedFileFromIssueParams) (res *SharedFile, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("linkSharedFilesToWiki"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}/sharedFiles"),
+		otelogen.OperationID("removeLinkToSharedFileFromIssue"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/sharedFiles/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9519,7 +11555,7 @@ func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkS
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LinkSharedFilesToWikiOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveLinkToSharedFileFromIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9536,17 +11572,17 @@ func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkS
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/wikis/"
+	var pathParts [4]string
+	pathParts[0] = "/issues/"
 	{
-		// Encode "wikiId" parameter.
+		// Encode "issueIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
+			Param:   "issueIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9556,24 +11592,39 @@ func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkS
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/sharedFiles"
+	pathParts[2] = "/sharedFiles/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeLinkSharedFilesToWikiRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, LinkSharedFilesToWikiOperation, r); {
+			switch err := c.securityOAuth2(ctx, RemoveLinkToSharedFileFromIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9584,7 +11635,7 @@ func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkS
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, LinkSharedFilesToWikiOperation, r); {
+			switch err := c.securityApiKey(ctx, RemoveLinkToSharedFileFromIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9621,7 +11672,7 @@ func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkS
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLinkSharedFilesToWikiResponse(resp)
+	result, err := decodeRemoveLinkToSharedFileFromIssueResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9629,21 +11680,21 @@ func (c *Client) sendLinkSharedFilesToWiki(ctx context.Context, request OptLinkS
 	return result, nil
 }
 
-// RemoveDocumentTags invokes removeDocumentTags operation.
+// RemoveLinkToSharedFileFromWiki invokes removeLinkToSharedFileFromWiki operation.
 //
-// Remove document tags.
+// Unlink shared file from wiki.
 //
-// DELETE /documents/{documentId}/tags
-func (c *Client) RemoveDocumentTags(ctx context.Context, request OptRemoveDocumentTagsReq, params RemoveDocumentTagsParams) ([]DocumentTag, error) {
-	res, err := c.sendRemoveDocumentTags(ctx, request, params)
+// DELETE /wikis/{wikiId}/sharedFiles/{id}
+func (c *Client) RemoveLinkToSharedFileFromWiki(ctx context.Context, params RemoveLinkToSharedFileFromWikiParams) (*SharedFile, error) {
+	res, err := c.sendRemoveLinkToSharedFileFromWiki(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDocumentTagsReq, params RemoveDocumentTagsParams) (res []DocumentTag, err error) {
+func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params RemoveLinkToSharedFileFromWikiParams) (res *SharedFile, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeDocumentTags"),
+		otelogen.OperationID("removeLinkToSharedFileFromWiki"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/documents/{documentId}/tags"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}/sharedFiles/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9659,7 +11710,7 @@ func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveDocumentTagsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveLinkToSharedFileFromWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9676,17 +11727,17 @@ func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDo
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/documents/"
+	var pathParts [4]string
+	pathParts[0] = "/wikis/"
 	{
-		// Encode "documentId" parameter.
+		// Encode "wikiId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "documentId",
+			Param:   "wikiId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.DocumentId))
+			return e.EncodeValue(conv.IntToString(params.WikiId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9696,7 +11747,25 @@ func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDo
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/tags"
+	pathParts[2] = "/sharedFiles/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -9704,16 +11773,13 @@ func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDo
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemoveDocumentTagsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, RemoveDocumentTagsOperation, r); {
+			switch err := c.securityOAuth2(ctx, RemoveLinkToSharedFileFromWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9724,7 +11790,7 @@ func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDo
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, RemoveDocumentTagsOperation, r); {
+			switch err := c.securityApiKey(ctx, RemoveLinkToSharedFileFromWikiOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9761,7 +11827,7 @@ func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveDocumentTagsResponse(resp)
+	result, err := decodeRemoveLinkToSharedFileFromWikiResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9769,21 +11835,21 @@ func (c *Client) sendRemoveDocumentTags(ctx context.Context, request OptRemoveDo
 	return result, nil
 }
 
-// RemoveLinkToSharedFileFromIssue invokes removeLinkToSharedFileFromIssue operation.
+// RemoveWikiAttachment invokes removeWikiAttachment operation.
 //
-// Unlink shared file from issue.
+// Delete wiki attachment.
 //
-// DELETE /issues/{issueIdOrKey}/sharedFiles/{id}
-func (c *Client) RemoveLinkToSharedFileFromIssue(ctx context.Context, params RemoveLinkToSharedFileFromIssueParams) (*SharedFile, error) {
-	res, err := c.sendRemoveLinkToSharedFileFromIssue(ctx, params)
+// DELETE /wikis/{wikiId}/attachments/{attachmentId}
+func (c *Client) RemoveWikiAttachment(ctx context.Context, params RemoveWikiAttachmentParams) (*Attachment, error) {
+	res, err := c.sendRemoveWikiAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params RemoveLinkToSharedFileFromIssueParams) (res *SharedFile, err error) {
+func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWikiAttachmentParams) (res *Attachment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeLinkToSharedFileFromIssue"),
+		otelogen.OperationID("removeWikiAttachment"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/sharedFiles/{id}"),
+		semconv.URLTemplateKey.String("/wikis/{wikiId}/attachments/{attachmentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9799,7 +11865,7 @@ func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveLinkToSharedFileFromIssueOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveWikiAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9817,16 +11883,16 @@ func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
-	pathParts[0] = "/issues/"
+	pathParts[0] = "/wikis/"
 	{
-		// Encode "issueIdOrKey" parameter.
+		// Encode "wikiId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
+			Param:   "wikiId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+			return e.EncodeValue(conv.IntToString(params.WikiId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9836,16 +11902,16 @@ func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/sharedFiles/"
+	pathParts[2] = "/attachments/"
 	{
-		// Encode "id" parameter.
+		// Encode "attachmentId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "attachmentId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.IntToString(params.AttachmentId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9868,7 +11934,7 @@ func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, RemoveLinkToSharedFileFromIssueOperation, r); {
+			switch err := c.securityOAuth2(ctx, RemoveWikiAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9879,7 +11945,7 @@ func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, RemoveLinkToSharedFileFromIssueOperation, r); {
+			switch err := c.securityApiKey(ctx, RemoveWikiAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -9916,7 +11982,7 @@ func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveLinkToSharedFileFromIssueResponse(resp)
+	result, err := decodeRemoveWikiAttachmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -9924,21 +11990,21 @@ func (c *Client) sendRemoveLinkToSharedFileFromIssue(ctx context.Context, params
 	return result, nil
 }
 
-// RemoveLinkToSharedFileFromWiki invokes removeLinkToSharedFileFromWiki operation.
+// UpdateComment invokes updateComment operation.
 //
-// Unlink shared file from wiki.
+// Update comment.
 //
-// DELETE /wikis/{wikiId}/sharedFiles/{id}
-func (c *Client) RemoveLinkToSharedFileFromWiki(ctx context.Context, params RemoveLinkToSharedFileFromWikiParams) (*SharedFile, error) {
-	res, err := c.sendRemoveLinkToSharedFileFromWiki(ctx, params)
+// PATCH /issues/{issueIdOrKey}/comments/{commentId}
+func (c *Client) UpdateComment(ctx context.Context, request OptUpdateCommentReq, params UpdateCommentParams) (*Comment, error) {
+	res, err := c.sendUpdateComment(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params RemoveLinkToSharedFileFromWikiParams) (res *SharedFile, err error) {
+func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateCommentReq, params UpdateCommentParams) (res *Comment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeLinkToSharedFileFromWiki"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}/sharedFiles/{id}"),
+		otelogen.OperationID("updateComment"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9954,7 +12020,7 @@ func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveLinkToSharedFileFromWikiOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9972,16 +12038,16 @@ func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
-	pathParts[0] = "/wikis/"
+	pathParts[0] = "/issues/"
 	{
-		// Encode "wikiId" parameter.
+		// Encode "issueIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
+			Param:   "issueIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
+			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9991,16 +12057,16 @@ func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/sharedFiles/"
+	pathParts[2] = "/comments/"
 	{
-		// Encode "id" parameter.
+		// Encode "commentId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "commentId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.IntToString(params.CommentId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -10013,17 +12079,20 @@ func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateCommentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, RemoveLinkToSharedFileFromWikiOperation, r); {
+			switch err := c.securityOAuth2(ctx, UpdateCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10034,7 +12103,7 @@ func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, RemoveLinkToSharedFileFromWikiOperation, r); {
+			switch err := c.securityApiKey(ctx, UpdateCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10071,7 +12140,7 @@ func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveLinkToSharedFileFromWikiResponse(resp)
+	result, err := decodeUpdateCommentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10079,21 +12148,21 @@ func (c *Client) sendRemoveLinkToSharedFileFromWiki(ctx context.Context, params
 	return result, nil
 }
 
-// RemoveWikiAttachment invokes removeWikiAttachment operation.
+// UpdateCustomField invokes updateCustomField operation.
 //
-// Delete wiki attachment.
+// Update custom field.
 //
-// DELETE /wikis/{wikiId}/attachments/{attachmentId}
-func (c *Client) RemoveWikiAttachment(ctx context.Context, params RemoveWikiAttachmentParams) (*Attachment, error) {
-	res, err := c.sendRemoveWikiAttachment(ctx, params)
+// PATCH /projects/{projectIdOrKey}/customFields/{customFieldId}
+func (c *Client) UpdateCustomField(ctx context.Context, request OptUpdateCustomFieldReq, params UpdateCustomFieldParams) (*CustomField, error) {
+	res, err := c.sendUpdateCustomField(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWikiAttachmentParams) (res *Attachment, err error) {
+func (c *Client) sendUpdateCustomField(ctx context.Context, request OptUpdateCustomFieldReq, params UpdateCustomFieldParams) (res *CustomField, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeWikiAttachment"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/wikis/{wikiId}/attachments/{attachmentId}"),
+		otelogen.OperationID("updateCustomField"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/customFields/{customFieldId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -10109,7 +12178,7 @@ func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWiki
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveWikiAttachmentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateCustomFieldOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10127,16 +12196,16 @@ func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWiki
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
-	pathParts[0] = "/wikis/"
+	pathParts[0] = "/projects/"
 	{
-		// Encode "wikiId" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "wikiId",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.WikiId))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -10146,16 +12215,16 @@ func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWiki
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/attachments/"
+	pathParts[2] = "/customFields/"
 	{
-		// Encode "attachmentId" parameter.
+		// Encode "customFieldId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "attachmentId",
+			Param:   "customFieldId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.AttachmentId))
+			return e.EncodeValue(conv.IntToString(params.CustomFieldId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -10168,17 +12237,20 @@ func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWiki
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateCustomFieldRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, RemoveWikiAttachmentOperation, r); {
+			switch err := c.securityOAuth2(ctx, UpdateCustomFieldOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10189,7 +12261,7 @@ func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWiki
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, RemoveWikiAttachmentOperation, r); {
+			switch err := c.securityApiKey(ctx, UpdateCustomFieldOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10226,7 +12298,7 @@ func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWiki
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveWikiAttachmentResponse(resp)
+	result, err := decodeUpdateCustomFieldResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10234,21 +12306,21 @@ func (c *Client) sendRemoveWikiAttachment(ctx context.Context, params RemoveWiki
 	return result, nil
 }
 
-// UpdateComment invokes updateComment operation.
+// UpdateIssue invokes updateIssue operation.
 //
-// Update comment.
+// Update issue.
 //
-// PATCH /issues/{issueIdOrKey}/comments/{commentId}
-func (c *Client) UpdateComment(ctx context.Context, request OptUpdateCommentReq, params UpdateCommentParams) (*Comment, error) {
-	res, err := c.sendUpdateComment(ctx, request, params)
+// PATCH /issues/{issueIdOrKey}
+func (c *Client) UpdateIssue(ctx context.Context, request OptUpdateIssueReq, params UpdateIssueParams) (*Issue, error) {
+	res, err := c.sendUpdateIssue(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateCommentReq, params UpdateCommentParams) (res *Comment, err error) {
+func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq, params UpdateIssueParams) (res *Issue, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateComment"),
+		otelogen.OperationID("updateIssue"),
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
+		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -10264,7 +12336,7 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateCommentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10281,7 +12353,7 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [2]string
 	pathParts[0] = "/issues/"
 	{
 		// Encode "issueIdOrKey" parameter.
@@ -10301,25 +12373,6 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/comments/"
-	{
-		// Encode "commentId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "commentId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.CommentId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -10327,7 +12380,7 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateCommentRequest(request, r); err != nil {
+	if err := encodeUpdateIssueRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -10336,7 +12389,7 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, UpdateCommentOperation, r); {
+			switch err := c.securityOAuth2(ctx, UpdateIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10347,7 +12400,7 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, UpdateCommentOperation, r); {
+			switch err := c.securityApiKey(ctx, UpdateIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10384,7 +12437,7 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateCommentResponse(resp)
+	result, err := decodeUpdateIssueResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -10392,21 +12445,21 @@ func (c *Client) sendUpdateComment(ctx context.Context, request OptUpdateComment
 	return result, nil
 }
 
-// UpdateIssue invokes updateIssue operation.
+// UpdateWebhook invokes updateWebhook operation.
 //
-// Update issue.
+// Update webhook.
 //
-// PATCH /issues/{issueIdOrKey}
-func (c *Client) UpdateIssue(ctx context.Context, request OptUpdateIssueReq, params UpdateIssueParams) (*Issue, error) {
-	res, err := c.sendUpdateIssue(ctx, request, params)
+// PATCH /projects/{projectIdOrKey}/webhooks/{webhookId}
+func (c *Client) UpdateWebhook(ctx context.Context, request OptUpdateWebhookReq, params UpdateWebhookParams) (*Webhook, error) {
+	res, err := c.sendUpdateWebhook(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq, params UpdateIssueParams) (res *Issue, err error) {
+func (c *Client) sendUpdateWebhook(ctx context.Context, request OptUpdateWebhookReq, params UpdateWebhookParams) (res *Webhook, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateIssue"),
+		otelogen.OperationID("updateWebhook"),
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/issues/{issueIdOrKey}"),
+		semconv.URLTemplateKey.String("/projects/{projectIdOrKey}/webhooks/{webhookId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -10422,7 +12475,7 @@ func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateIssueOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateWebhookOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -10439,17 +12492,17 @@ func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq,
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/issues/"
+	var pathParts [4]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "issueIdOrKey" parameter.
+		// Encode "projectIdOrKey" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issueIdOrKey",
+			Param:   "projectIdOrKey",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.IssueIdOrKey))
+			return e.EncodeValue(conv.StringToString(params.ProjectIdOrKey))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -10459,6 +12512,25 @@ func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq,
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/webhooks/"
+	{
+		// Encode "webhookId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "webhookId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.WebhookId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -10466,7 +12538,7 @@ func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq,
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateIssueRequest(request, r); err != nil {
+	if err := encodeUpdateWebhookRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -10475,7 +12547,7 @@ func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq,
 		var satisfied bitset
 		{
 			stage = "Security:OAuth2"
-			switch err := c.securityOAuth2(ctx, UpdateIssueOperation, r); {
+			switch err := c.securityOAuth2(ctx, UpdateWebhookOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10486,7 +12558,7 @@ func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq,
 		}
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, UpdateIssueOperation, r); {
+			switch err := c.securityApiKey(ctx, UpdateWebhookOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -10523,7 +12595,7 @@ func (c *Client) sendUpdateIssue(ctx context.Context, request OptUpdateIssueReq,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateIssueResponse(resp)
+	result, err := decodeUpdateWebhookResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -251,22 +251,22 @@ func (s *Server) handleAddCommentRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleAddDocumentTagsRequest handles addDocumentTags operation.
+// handleAddCustomFieldItemRequest handles addCustomFieldItem operation.
 //
-// Add document tags.
+// Add list item for list type custom field.
 //
-// POST /documents/{documentId}/tags
-func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /projects/{projectIdOrKey}/customFields/{customFieldId}/items
+func (s *Server) handleAddCustomFieldItemRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("addDocumentTags"),
+		otelogen.OperationID("addCustomFieldItem"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/documents/{documentId}/tags"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/customFields/{customFieldId}/items"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), AddDocumentTagsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddCustomFieldItemOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -321,15 +321,15 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: AddDocumentTagsOperation,
-			ID:   "addDocumentTags",
+			Name: AddCustomFieldItemOperation,
+			ID:   "addCustomFieldItem",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, AddDocumentTagsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, AddCustomFieldItemOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -346,7 +346,7 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, AddDocumentTagsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, AddCustomFieldItemOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -387,7 +387,7 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeAddDocumentTagsParams(args, argsEscaped, r)
+	params, err := decodeAddCustomFieldItemParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -399,7 +399,7 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeAddDocumentTagsRequest(r)
+	request, rawBody, close, err := s.decodeAddCustomFieldItemRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -415,28 +415,32 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response []DocumentTag
+	var response *CustomField
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    AddDocumentTagsOperation,
-			OperationSummary: "Add document tags",
-			OperationID:      "addDocumentTags",
+			OperationName:    AddCustomFieldItemOperation,
+			OperationSummary: "Add list item for list type custom field",
+			OperationID:      "addCustomFieldItem",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "documentId",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.DocumentId,
+				}: params.ProjectIdOrKey,
+				{
+					Name: "customFieldId",
+					In:   "path",
+				}: params.CustomFieldId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptAddDocumentTagsReq
-			Params   = AddDocumentTagsParams
-			Response = []DocumentTag
+			Request  = OptAddCustomFieldItemReq
+			Params   = AddCustomFieldItemParams
+			Response = *CustomField
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -445,14 +449,14 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackAddDocumentTagsParams,
+			unpackAddCustomFieldItemParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AddDocumentTags(ctx, request, params)
+				response, err = s.h.AddCustomFieldItem(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AddDocumentTags(ctx, request, params)
+		response, err = s.h.AddCustomFieldItem(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -460,7 +464,7 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeAddDocumentTagsResponse(response, w, span); err != nil {
+	if err := encodeAddCustomFieldItemResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -469,22 +473,22 @@ func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleAttachFileToWikiRequest handles attachFileToWiki operation.
+// handleAddDocumentTagsRequest handles addDocumentTags operation.
 //
-// Add attachments to wiki.
+// Add document tags.
 //
-// POST /wikis/{wikiId}/attachments
-func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /documents/{documentId}/tags
+func (s *Server) handleAddDocumentTagsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("attachFileToWiki"),
+		otelogen.OperationID("addDocumentTags"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}/attachments"),
+		semconv.HTTPRouteKey.String("/documents/{documentId}/tags"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), AttachFileToWikiOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddDocumentTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -539,15 +543,15 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: AttachFileToWikiOperation,
-			ID:   "attachFileToWiki",
+			Name: AddDocumentTagsOperation,
+			ID:   "addDocumentTags",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, AttachFileToWikiOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, AddDocumentTagsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -564,7 +568,7 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, AttachFileToWikiOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, AddDocumentTagsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -605,7 +609,7 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeAttachFileToWikiParams(args, argsEscaped, r)
+	params, err := decodeAddDocumentTagsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -617,7 +621,7 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeAttachFileToWikiRequest(r)
+	request, rawBody, close, err := s.decodeAddDocumentTagsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -633,28 +637,28 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response []Attachment
+	var response []DocumentTag
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    AttachFileToWikiOperation,
-			OperationSummary: "Add attachments to wiki",
-			OperationID:      "attachFileToWiki",
+			OperationName:    AddDocumentTagsOperation,
+			OperationSummary: "Add document tags",
+			OperationID:      "addDocumentTags",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
+					Name: "documentId",
 					In:   "path",
-				}: params.WikiId,
+				}: params.DocumentId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptAttachFileToWikiReq
-			Params   = AttachFileToWikiParams
-			Response = []Attachment
+			Request  = OptAddDocumentTagsReq
+			Params   = AddDocumentTagsParams
+			Response = []DocumentTag
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -663,14 +667,14 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackAttachFileToWikiParams,
+			unpackAddDocumentTagsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AttachFileToWiki(ctx, request, params)
+				response, err = s.h.AddDocumentTags(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AttachFileToWiki(ctx, request, params)
+		response, err = s.h.AddDocumentTags(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -678,7 +682,7 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeAttachFileToWikiResponse(response, w, span); err != nil {
+	if err := encodeAddDocumentTagsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -687,22 +691,22 @@ func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleCreateCategoryRequest handles createCategory operation.
+// handleAddProjectAdministratorRequest handles addProjectAdministrator operation.
 //
-// Create category.
+// Add project administrator.
 //
-// POST /projects/{projectIdOrKey}/categories
-func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /projects/{projectIdOrKey}/administrators
+func (s *Server) handleAddProjectAdministratorRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createCategory"),
+		otelogen.OperationID("addProjectAdministrator"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/categories"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/administrators"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateCategoryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddProjectAdministratorOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -757,15 +761,15 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateCategoryOperation,
-			ID:   "createCategory",
+			Name: AddProjectAdministratorOperation,
+			ID:   "addProjectAdministrator",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, CreateCategoryOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, AddProjectAdministratorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -782,7 +786,7 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, CreateCategoryOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, AddProjectAdministratorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -823,7 +827,7 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeCreateCategoryParams(args, argsEscaped, r)
+	params, err := decodeAddProjectAdministratorParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -835,7 +839,7 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateCategoryRequest(r)
+	request, rawBody, close, err := s.decodeAddProjectAdministratorRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -851,13 +855,13 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Category
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateCategoryOperation,
-			OperationSummary: "Create category",
-			OperationID:      "createCategory",
+			OperationName:    AddProjectAdministratorOperation,
+			OperationSummary: "Add project administrator",
+			OperationID:      "addProjectAdministrator",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -870,9 +874,9 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = OptCreateCategoryReq
-			Params   = CreateCategoryParams
-			Response = *Category
+			Request  = OptAddProjectAdministratorReq
+			Params   = AddProjectAdministratorParams
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -881,14 +885,14 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackCreateCategoryParams,
+			unpackAddProjectAdministratorParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateCategory(ctx, request, params)
+				response, err = s.h.AddProjectAdministrator(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateCategory(ctx, request, params)
+		response, err = s.h.AddProjectAdministrator(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -896,7 +900,7 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeCreateCategoryResponse(response, w, span); err != nil {
+	if err := encodeAddProjectAdministratorResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -905,22 +909,22 @@ func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleCreateDocumentRequest handles createDocument operation.
+// handleAddProjectUserRequest handles addProjectUser operation.
 //
-// Add document.
+// Add project user.
 //
-// POST /documents
-func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /projects/{projectIdOrKey}/users
+func (s *Server) handleAddProjectUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createDocument"),
+		otelogen.OperationID("addProjectUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/documents"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/users"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateDocumentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddProjectUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -975,15 +979,15 @@ func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateDocumentOperation,
-			ID:   "createDocument",
+			Name: AddProjectUserOperation,
+			ID:   "addProjectUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, CreateDocumentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, AddProjectUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1000,7 +1004,7 @@ func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, CreateDocumentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, AddProjectUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1041,9 +1045,19 @@ func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeAddProjectUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateDocumentRequest(r)
+	request, rawBody, close, err := s.decodeAddProjectUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1059,23 +1073,28 @@ func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Document
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateDocumentOperation,
-			OperationSummary: "Add document",
-			OperationID:      "createDocument",
+			OperationName:    AddProjectUserOperation,
+			OperationSummary: "Add project user",
+			OperationID:      "addProjectUser",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = OptCreateDocumentReq
-			Params   = struct{}
-			Response = *Document
+			Request  = OptAddProjectUserReq
+			Params   = AddProjectUserParams
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1084,14 +1103,14 @@ func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackAddProjectUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateDocument(ctx, request)
+				response, err = s.h.AddProjectUser(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateDocument(ctx, request)
+		response, err = s.h.AddProjectUser(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1099,7 +1118,7 @@ func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeCreateDocumentResponse(response, w, span); err != nil {
+	if err := encodeAddProjectUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1108,22 +1127,22 @@ func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleCreateIssueRequest handles createIssue operation.
+// handleAttachFileToWikiRequest handles attachFileToWiki operation.
 //
-// Create issue.
+// Add attachments to wiki.
 //
-// POST /issues
-func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /wikis/{wikiId}/attachments
+func (s *Server) handleAttachFileToWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createIssue"),
+		otelogen.OperationID("attachFileToWiki"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/issues"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}/attachments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateIssueOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AttachFileToWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1178,15 +1197,15 @@ func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateIssueOperation,
-			ID:   "createIssue",
+			Name: AttachFileToWikiOperation,
+			ID:   "attachFileToWiki",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, CreateIssueOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, AttachFileToWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1203,7 +1222,7 @@ func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, CreateIssueOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, AttachFileToWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1244,9 +1263,19 @@ func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
+	params, err := decodeAttachFileToWikiParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateIssueRequest(r)
+	request, rawBody, close, err := s.decodeAttachFileToWikiRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1262,23 +1291,28 @@ func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w ht
 		}
 	}()
 
-	var response *Issue
+	var response []Attachment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateIssueOperation,
-			OperationSummary: "Create issue",
-			OperationID:      "createIssue",
+			OperationName:    AttachFileToWikiOperation,
+			OperationSummary: "Add attachments to wiki",
+			OperationID:      "attachFileToWiki",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "wikiId",
+					In:   "path",
+				}: params.WikiId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = OptCreateIssueReq
-			Params   = struct{}
-			Response = *Issue
+			Request  = OptAttachFileToWikiReq
+			Params   = AttachFileToWikiParams
+			Response = []Attachment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1287,14 +1321,14 @@ func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackAttachFileToWikiParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateIssue(ctx, request)
+				response, err = s.h.AttachFileToWiki(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateIssue(ctx, request)
+		response, err = s.h.AttachFileToWiki(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1302,7 +1336,7 @@ func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeCreateIssueResponse(response, w, span); err != nil {
+	if err := encodeAttachFileToWikiResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1311,22 +1345,22 @@ func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleCreateWikiRequest handles createWiki operation.
+// handleCreateCategoryRequest handles createCategory operation.
 //
-// Create wiki.
+// Create category.
 //
-// POST /wikis
-func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /projects/{projectIdOrKey}/categories
+func (s *Server) handleCreateCategoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createWiki"),
+		otelogen.OperationID("createCategory"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/wikis"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/categories"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateWikiOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateCategoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1381,15 +1415,15 @@ func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateWikiOperation,
-			ID:   "createWiki",
+			Name: CreateCategoryOperation,
+			ID:   "createCategory",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, CreateWikiOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, CreateCategoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1406,7 +1440,7 @@ func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, CreateWikiOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, CreateCategoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1447,9 +1481,19 @@ func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
+	params, err := decodeCreateCategoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateWikiRequest(r)
+	request, rawBody, close, err := s.decodeCreateCategoryRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1465,23 +1509,28 @@ func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response *Wiki
+	var response *Category
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateWikiOperation,
-			OperationSummary: "Create wiki",
-			OperationID:      "createWiki",
+			OperationName:    CreateCategoryOperation,
+			OperationSummary: "Create category",
+			OperationID:      "createCategory",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = OptCreateWikiReq
-			Params   = struct{}
-			Response = *Wiki
+			Request  = OptCreateCategoryReq
+			Params   = CreateCategoryParams
+			Response = *Category
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1490,14 +1539,14 @@ func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackCreateCategoryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateWiki(ctx, request)
+				response, err = s.h.CreateCategory(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateWiki(ctx, request)
+		response, err = s.h.CreateCategory(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1505,7 +1554,7 @@ func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreateWikiResponse(response, w, span); err != nil {
+	if err := encodeCreateCategoryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1514,22 +1563,22 @@ func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeleteCategoryRequest handles deleteCategory operation.
+// handleCreateCustomFieldRequest handles createCustomField operation.
 //
-// Delete category.
+// Add custom field.
 //
-// DELETE /projects/{projectIdOrKey}/categories/{categoryId}
-func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /projects/{projectIdOrKey}/customFields
+func (s *Server) handleCreateCustomFieldRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteCategory"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/categories/{categoryId}"),
+		otelogen.OperationID("createCustomField"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/customFields"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteCategoryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateCustomFieldOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1584,15 +1633,15 @@ func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteCategoryOperation,
-			ID:   "deleteCategory",
+			Name: CreateCustomFieldOperation,
+			ID:   "createCustomField",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, DeleteCategoryOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, CreateCustomFieldOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1609,7 +1658,7 @@ func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, DeleteCategoryOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, CreateCustomFieldOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1650,7 +1699,7 @@ func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeDeleteCategoryParams(args, argsEscaped, r)
+	params, err := decodeCreateCustomFieldParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1662,33 +1711,44 @@ func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateCustomFieldRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *Category
+	var response *CustomField
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteCategoryOperation,
-			OperationSummary: "Delete category",
-			OperationID:      "deleteCategory",
-			Body:             nil,
+			OperationName:    CreateCustomFieldOperation,
+			OperationSummary: "Add custom field",
+			OperationID:      "createCustomField",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
 					Name: "projectIdOrKey",
 					In:   "path",
 				}: params.ProjectIdOrKey,
-				{
-					Name: "categoryId",
-					In:   "path",
-				}: params.CategoryId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteCategoryParams
-			Response = *Category
+			Request  = OptCreateCustomFieldReq
+			Params   = CreateCustomFieldParams
+			Response = *CustomField
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1697,14 +1757,14 @@ func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackDeleteCategoryParams,
+			unpackCreateCustomFieldParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteCategory(ctx, params)
+				response, err = s.h.CreateCustomField(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteCategory(ctx, params)
+		response, err = s.h.CreateCustomField(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1712,7 +1772,7 @@ func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDeleteCategoryResponse(response, w, span); err != nil {
+	if err := encodeCreateCustomFieldResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1721,22 +1781,22 @@ func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w
 	}
 }
 
-// handleDeleteDocumentRequest handles deleteDocument operation.
+// handleCreateDocumentRequest handles createDocument operation.
 //
-// Delete document.
+// Add document.
 //
-// DELETE /documents/{documentId}
-func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /documents
+func (s *Server) handleCreateDocumentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteDocument"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/documents/{documentId}"),
+		otelogen.OperationID("createDocument"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/documents"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteDocumentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateDocumentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1791,15 +1851,15 @@ func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteDocumentOperation,
-			ID:   "deleteDocument",
+			Name: CreateDocumentOperation,
+			ID:   "createDocument",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, DeleteDocumentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, CreateDocumentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1816,7 +1876,7 @@ func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, DeleteDocumentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, CreateDocumentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1857,40 +1917,40 @@ func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeDeleteDocumentParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateDocumentRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-
-	var rawBody []byte
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
 	var response *Document
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteDocumentOperation,
-			OperationSummary: "Delete document",
-			OperationID:      "deleteDocument",
-			Body:             nil,
+			OperationName:    CreateDocumentOperation,
+			OperationSummary: "Add document",
+			OperationID:      "createDocument",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "documentId",
-					In:   "path",
-				}: params.DocumentId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteDocumentParams
+			Request  = OptCreateDocumentReq
+			Params   = struct{}
 			Response = *Document
 		)
 		response, err = middleware.HookMiddleware[
@@ -1900,14 +1960,14 @@ func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackDeleteDocumentParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteDocument(ctx, params)
+				response, err = s.h.CreateDocument(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteDocument(ctx, params)
+		response, err = s.h.CreateDocument(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1915,7 +1975,7 @@ func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDeleteDocumentResponse(response, w, span); err != nil {
+	if err := encodeCreateDocumentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1924,22 +1984,22 @@ func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleDeleteIssueRequest handles deleteIssue operation.
+// handleCreateIssueRequest handles createIssue operation.
 //
-// Delete issue.
+// Create issue.
 //
-// DELETE /issues/{issueIdOrKey}
-func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /issues
+func (s *Server) handleCreateIssueRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteIssue"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}"),
+		otelogen.OperationID("createIssue"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/issues"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteIssueOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1994,15 +2054,15 @@ func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteIssueOperation,
-			ID:   "deleteIssue",
+			Name: CreateIssueOperation,
+			ID:   "createIssue",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, DeleteIssueOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, CreateIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2019,7 +2079,7 @@ func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, DeleteIssueOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, CreateIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2060,40 +2120,40 @@ func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeDeleteIssueParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateIssueRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-
-	var rawBody []byte
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
 	var response *Issue
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteIssueOperation,
-			OperationSummary: "Delete issue",
-			OperationID:      "deleteIssue",
-			Body:             nil,
+			OperationName:    CreateIssueOperation,
+			OperationSummary: "Create issue",
+			OperationID:      "createIssue",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "issueIdOrKey",
-					In:   "path",
-				}: params.IssueIdOrKey,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteIssueParams
+			Request  = OptCreateIssueReq
+			Params   = struct{}
 			Response = *Issue
 		)
 		response, err = middleware.HookMiddleware[
@@ -2103,14 +2163,14 @@ func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackDeleteIssueParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteIssue(ctx, params)
+				response, err = s.h.CreateIssue(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteIssue(ctx, params)
+		response, err = s.h.CreateIssue(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2118,7 +2178,7 @@ func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeDeleteIssueResponse(response, w, span); err != nil {
+	if err := encodeCreateIssueResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2127,22 +2187,22 @@ func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleDeleteIssueAttachmentRequest handles deleteIssueAttachment operation.
+// handleCreateWikiRequest handles createWiki operation.
 //
-// Delete issue attachment.
+// Create wiki.
 //
-// DELETE /issues/{issueIdOrKey}/attachments/{attachmentId}
-func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /wikis
+func (s *Server) handleCreateWikiRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteIssueAttachment"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/attachments/{attachmentId}"),
+		otelogen.OperationID("createWiki"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/wikis"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteIssueAttachmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2197,15 +2257,15 @@ func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteIssueAttachmentOperation,
-			ID:   "deleteIssueAttachment",
+			Name: CreateWikiOperation,
+			ID:   "createWiki",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, DeleteIssueAttachmentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, CreateWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2222,7 +2282,7 @@ func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, DeleteIssueAttachmentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, CreateWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2263,45 +2323,41 @@ func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDeleteIssueAttachmentParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateWikiRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response *Attachment
+	var response *Wiki
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteIssueAttachmentOperation,
-			OperationSummary: "Delete issue attachment",
-			OperationID:      "deleteIssueAttachment",
-			Body:             nil,
-			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "issueIdOrKey",
-					In:   "path",
-				}: params.IssueIdOrKey,
-				{
-					Name: "attachmentId",
-					In:   "path",
-				}: params.AttachmentId,
-			},
-			Raw: r,
+			OperationName:    CreateWikiOperation,
+			OperationSummary: "Create wiki",
+			OperationID:      "createWiki",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteIssueAttachmentParams
-			Response = *Attachment
+			Request  = OptCreateWikiReq
+			Params   = struct{}
+			Response = *Wiki
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2310,14 +2366,14 @@ func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDeleteIssueAttachmentParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteIssueAttachment(ctx, params)
+				response, err = s.h.CreateWiki(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteIssueAttachment(ctx, params)
+		response, err = s.h.CreateWiki(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2325,7 +2381,7 @@ func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped
 		return
 	}
 
-	if err := encodeDeleteIssueAttachmentResponse(response, w, span); err != nil {
+	if err := encodeCreateWikiResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2334,22 +2390,22 @@ func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped
 	}
 }
 
-// handleDeletePullRequestAttachmentsRequest handles deletePullRequestAttachments operation.
+// handleDeleteCategoryRequest handles deleteCategory operation.
 //
-// Delete pull request attachment.
+// Delete category.
 //
-// DELETE /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}
-func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /projects/{projectIdOrKey}/categories/{categoryId}
+func (s *Server) handleDeleteCategoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePullRequestAttachments"),
+		otelogen.OperationID("deleteCategory"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/categories/{categoryId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePullRequestAttachmentsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteCategoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2404,15 +2460,15 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeletePullRequestAttachmentsOperation,
-			ID:   "deletePullRequestAttachments",
+			Name: DeleteCategoryOperation,
+			ID:   "deleteCategory",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, DeletePullRequestAttachmentsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteCategoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2429,7 +2485,7 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, DeletePullRequestAttachmentsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteCategoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2470,7 +2526,7 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 			return
 		}
 	}
-	params, err := decodeDeletePullRequestAttachmentsParams(args, argsEscaped, r)
+	params, err := decodeDeleteCategoryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2483,13 +2539,13 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 
 	var rawBody []byte
 
-	var response *Attachment
+	var response *Category
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeletePullRequestAttachmentsOperation,
-			OperationSummary: "Delete pull request attachment",
-			OperationID:      "deletePullRequestAttachments",
+			OperationName:    DeleteCategoryOperation,
+			OperationSummary: "Delete category",
+			OperationID:      "deleteCategory",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -2498,25 +2554,17 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 					In:   "path",
 				}: params.ProjectIdOrKey,
 				{
-					Name: "repoIdOrName",
-					In:   "path",
-				}: params.RepoIdOrName,
-				{
-					Name: "number",
-					In:   "path",
-				}: params.Number,
-				{
-					Name: "attachmentId",
+					Name: "categoryId",
 					In:   "path",
-				}: params.AttachmentId,
+				}: params.CategoryId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeletePullRequestAttachmentsParams
-			Response = *Attachment
+			Params   = DeleteCategoryParams
+			Response = *Category
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2525,14 +2573,14 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 		](
 			m,
 			mreq,
-			unpackDeletePullRequestAttachmentsParams,
+			unpackDeleteCategoryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeletePullRequestAttachments(ctx, params)
+				response, err = s.h.DeleteCategory(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeletePullRequestAttachments(ctx, params)
+		response, err = s.h.DeleteCategory(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2540,7 +2588,7 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 		return
 	}
 
-	if err := encodeDeletePullRequestAttachmentsResponse(response, w, span); err != nil {
+	if err := encodeDeleteCategoryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2549,22 +2597,22 @@ func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsE
 	}
 }
 
-// handleDeleteWikiRequest handles deleteWiki operation.
+// handleDeleteCustomFieldItemRequest handles deleteCustomFieldItem operation.
 //
-// Delete wiki.
+// Delete list item for list type custom field.
 //
-// DELETE /wikis/{wikiId}
-func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}
+func (s *Server) handleDeleteCustomFieldItemRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteWiki"),
+		otelogen.OperationID("deleteCustomFieldItem"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteWikiOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteCustomFieldItemOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2619,15 +2667,15 @@ func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteWikiOperation,
-			ID:   "deleteWiki",
+			Name: DeleteCustomFieldItemOperation,
+			ID:   "deleteCustomFieldItem",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, DeleteWikiOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteCustomFieldItemOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2644,7 +2692,7 @@ func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, DeleteWikiOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteCustomFieldItemOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2685,7 +2733,7 @@ func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteWikiParams(args, argsEscaped, r)
+	params, err := decodeDeleteCustomFieldItemParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2698,28 +2746,36 @@ func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response *Wiki
+	var response *CustomField
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteWikiOperation,
-			OperationSummary: "Delete wiki",
-			OperationID:      "deleteWiki",
+			OperationName:    DeleteCustomFieldItemOperation,
+			OperationSummary: "Delete list item for list type custom field",
+			OperationID:      "deleteCustomFieldItem",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.WikiId,
+				}: params.ProjectIdOrKey,
+				{
+					Name: "customFieldId",
+					In:   "path",
+				}: params.CustomFieldId,
+				{
+					Name: "itemId",
+					In:   "path",
+				}: params.ItemId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteWikiParams
-			Response = *Wiki
+			Params   = DeleteCustomFieldItemParams
+			Response = *CustomField
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2728,14 +2784,14 @@ func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteWikiParams,
+			unpackDeleteCustomFieldItemParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteWiki(ctx, params)
+				response, err = s.h.DeleteCustomFieldItem(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteWiki(ctx, params)
+		response, err = s.h.DeleteCustomFieldItem(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2743,7 +2799,7 @@ func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteWikiResponse(response, w, span); err != nil {
+	if err := encodeDeleteCustomFieldItemResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2752,22 +2808,22 @@ func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDownloadDocumentAttachmentRequest handles downloadDocumentAttachment operation.
+// handleDeleteDocumentRequest handles deleteDocument operation.
 //
-// Download document attachment.
+// Delete document.
 //
-// GET /documents/{documentId}/attachments/{attachmentId}
-func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /documents/{documentId}
+func (s *Server) handleDeleteDocumentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("downloadDocumentAttachment"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/documents/{documentId}/attachments/{attachmentId}"),
+		otelogen.OperationID("deleteDocument"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/documents/{documentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadDocumentAttachmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteDocumentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2822,15 +2878,15 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DownloadDocumentAttachmentOperation,
-			ID:   "downloadDocumentAttachment",
+			Name: DeleteDocumentOperation,
+			ID:   "deleteDocument",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, DownloadDocumentAttachmentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteDocumentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2847,7 +2903,7 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, DownloadDocumentAttachmentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteDocumentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2888,7 +2944,7 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeDownloadDocumentAttachmentParams(args, argsEscaped, r)
+	params, err := decodeDeleteDocumentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2901,13 +2957,13 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 
 	var rawBody []byte
 
-	var response DownloadDocumentAttachmentOK
+	var response *Document
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DownloadDocumentAttachmentOperation,
-			OperationSummary: "Download document attachment",
-			OperationID:      "downloadDocumentAttachment",
+			OperationName:    DeleteDocumentOperation,
+			OperationSummary: "Delete document",
+			OperationID:      "deleteDocument",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -2915,18 +2971,14 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 					Name: "documentId",
 					In:   "path",
 				}: params.DocumentId,
-				{
-					Name: "attachmentId",
-					In:   "path",
-				}: params.AttachmentId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DownloadDocumentAttachmentParams
-			Response = DownloadDocumentAttachmentOK
+			Params   = DeleteDocumentParams
+			Response = *Document
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2935,14 +2987,14 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackDownloadDocumentAttachmentParams,
+			unpackDeleteDocumentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DownloadDocumentAttachment(ctx, params)
+				response, err = s.h.DeleteDocument(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DownloadDocumentAttachment(ctx, params)
+		response, err = s.h.DeleteDocument(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2950,7 +3002,7 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 		return
 	}
 
-	if err := encodeDownloadDocumentAttachmentResponse(response, w, span); err != nil {
+	if err := encodeDeleteDocumentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2959,22 +3011,22 @@ func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEsc
 	}
 }
 
-// handleGetCategoriesRequest handles getCategories operation.
+// handleDeleteIssueRequest handles deleteIssue operation.
 //
-// Get categories.
+// Delete issue.
 //
-// GET /projects/{projectIdOrKey}/categories
-func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /issues/{issueIdOrKey}
+func (s *Server) handleDeleteIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCategories"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/categories"),
+		otelogen.OperationID("deleteIssue"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCategoriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3029,15 +3081,15 @@ func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCategoriesOperation,
-			ID:   "getCategories",
+			Name: DeleteIssueOperation,
+			ID:   "deleteIssue",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetCategoriesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3054,7 +3106,7 @@ func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetCategoriesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3095,7 +3147,7 @@ func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetCategoriesParams(args, argsEscaped, r)
+	params, err := decodeDeleteIssueParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3108,28 +3160,28 @@ func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response []Category
+	var response *Issue
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCategoriesOperation,
-			OperationSummary: "Get categories",
-			OperationID:      "getCategories",
+			OperationName:    DeleteIssueOperation,
+			OperationSummary: "Delete issue",
+			OperationID:      "deleteIssue",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectIdOrKey",
+					Name: "issueIdOrKey",
 					In:   "path",
-				}: params.ProjectIdOrKey,
+				}: params.IssueIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetCategoriesParams
-			Response = []Category
+			Params   = DeleteIssueParams
+			Response = *Issue
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3138,14 +3190,14 @@ func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetCategoriesParams,
+			unpackDeleteIssueParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCategories(ctx, params)
+				response, err = s.h.DeleteIssue(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCategories(ctx, params)
+		response, err = s.h.DeleteIssue(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3153,7 +3205,7 @@ func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetCategoriesResponse(response, w, span); err != nil {
+	if err := encodeDeleteIssueResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3162,22 +3214,22 @@ func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetCommentRequest handles getComment operation.
+// handleDeleteIssueAttachmentRequest handles deleteIssueAttachment operation.
 //
-// Get comment.
+// Delete issue attachment.
 //
-// GET /issues/{issueIdOrKey}/comments/{commentId}
-func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /issues/{issueIdOrKey}/attachments/{attachmentId}
+func (s *Server) handleDeleteIssueAttachmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getComment"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
+		otelogen.OperationID("deleteIssueAttachment"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/attachments/{attachmentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCommentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteIssueAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3232,15 +3284,15 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCommentOperation,
-			ID:   "getComment",
+			Name: DeleteIssueAttachmentOperation,
+			ID:   "deleteIssueAttachment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetCommentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteIssueAttachmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3257,7 +3309,7 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetCommentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteIssueAttachmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3298,7 +3350,7 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeGetCommentParams(args, argsEscaped, r)
+	params, err := decodeDeleteIssueAttachmentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3311,13 +3363,13 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response *Comment
+	var response *Attachment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCommentOperation,
-			OperationSummary: "Get comment",
-			OperationID:      "getComment",
+			OperationName:    DeleteIssueAttachmentOperation,
+			OperationSummary: "Delete issue attachment",
+			OperationID:      "deleteIssueAttachment",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -3326,17 +3378,17 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 					In:   "path",
 				}: params.IssueIdOrKey,
 				{
-					Name: "commentId",
+					Name: "attachmentId",
 					In:   "path",
-				}: params.CommentId,
+				}: params.AttachmentId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetCommentParams
-			Response = *Comment
+			Params   = DeleteIssueAttachmentParams
+			Response = *Attachment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3345,14 +3397,14 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetCommentParams,
+			unpackDeleteIssueAttachmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetComment(ctx, params)
+				response, err = s.h.DeleteIssueAttachment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetComment(ctx, params)
+		response, err = s.h.DeleteIssueAttachment(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3360,7 +3412,7 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetCommentResponse(response, w, span); err != nil {
+	if err := encodeDeleteIssueAttachmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3369,22 +3421,22 @@ func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetCommentsRequest handles getComments operation.
+// handleDeleteProjectAdministratorRequest handles deleteProjectAdministrator operation.
 //
-// Get comments.
+// Delete project administrator.
 //
-// GET /issues/{issueIdOrKey}/comments
-func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /projects/{projectIdOrKey}/administrators
+func (s *Server) handleDeleteProjectAdministratorRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getComments"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments"),
+		otelogen.OperationID("deleteProjectAdministrator"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/administrators"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCommentsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteProjectAdministratorOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3439,15 +3491,15 @@ func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCommentsOperation,
-			ID:   "getComments",
+			Name: DeleteProjectAdministratorOperation,
+			ID:   "deleteProjectAdministrator",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetCommentsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteProjectAdministratorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3464,7 +3516,7 @@ func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetCommentsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteProjectAdministratorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3505,7 +3557,7 @@ func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetCommentsParams(args, argsEscaped, r)
+	params, err := decodeDeleteProjectAdministratorParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3517,45 +3569,44 @@ func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w ht
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteProjectAdministratorRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []Comment
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCommentsOperation,
-			OperationSummary: "Get comments",
-			OperationID:      "getComments",
-			Body:             nil,
+			OperationName:    DeleteProjectAdministratorOperation,
+			OperationSummary: "Delete project administrator",
+			OperationID:      "deleteProjectAdministrator",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "issueIdOrKey",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.IssueIdOrKey,
-				{
-					Name: "minId",
-					In:   "query",
-				}: params.MinId,
-				{
-					Name: "maxId",
-					In:   "query",
-				}: params.MaxId,
-				{
-					Name: "count",
-					In:   "query",
-				}: params.Count,
-				{
-					Name: "order",
-					In:   "query",
-				}: params.Order,
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetCommentsParams
-			Response = []Comment
+			Request  = OptDeleteProjectAdministratorReq
+			Params   = DeleteProjectAdministratorParams
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3564,14 +3615,14 @@ func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetCommentsParams,
+			unpackDeleteProjectAdministratorParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetComments(ctx, params)
+				response, err = s.h.DeleteProjectAdministrator(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetComments(ctx, params)
+		response, err = s.h.DeleteProjectAdministrator(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3579,7 +3630,7 @@ func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetCommentsResponse(response, w, span); err != nil {
+	if err := encodeDeleteProjectAdministratorResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3588,22 +3639,22 @@ func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetCommentsCountRequest handles getCommentsCount operation.
+// handleDeleteProjectUserRequest handles deleteProjectUser operation.
 //
-// Get comments count.
+// Delete project user.
 //
-// GET /issues/{issueIdOrKey}/comments/count
-func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /projects/{projectIdOrKey}/users
+func (s *Server) handleDeleteProjectUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCommentsCount"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments/count"),
+		otelogen.OperationID("deleteProjectUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/users"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCommentsCountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteProjectUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3658,15 +3709,15 @@ func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCommentsCountOperation,
-			ID:   "getCommentsCount",
+			Name: DeleteProjectUserOperation,
+			ID:   "deleteProjectUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetCommentsCountOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteProjectUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3683,7 +3734,7 @@ func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetCommentsCountOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteProjectUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3724,7 +3775,7 @@ func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetCommentsCountParams(args, argsEscaped, r)
+	params, err := decodeDeleteProjectUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3736,29 +3787,44 @@ func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteProjectUserRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *GetCommentsCountOK
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCommentsCountOperation,
-			OperationSummary: "Get comments count",
-			OperationID:      "getCommentsCount",
-			Body:             nil,
+			OperationName:    DeleteProjectUserOperation,
+			OperationSummary: "Delete project user",
+			OperationID:      "deleteProjectUser",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "issueIdOrKey",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.IssueIdOrKey,
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetCommentsCountParams
-			Response = *GetCommentsCountOK
+			Request  = OptDeleteProjectUserReq
+			Params   = DeleteProjectUserParams
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3767,14 +3833,14 @@ func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetCommentsCountParams,
+			unpackDeleteProjectUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCommentsCount(ctx, params)
+				response, err = s.h.DeleteProjectUser(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCommentsCount(ctx, params)
+		response, err = s.h.DeleteProjectUser(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3782,7 +3848,7 @@ func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetCommentsCountResponse(response, w, span); err != nil {
+	if err := encodeDeleteProjectUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3791,22 +3857,22 @@ func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetCurrentUserRequest handles getCurrentUser operation.
+// handleDeletePullRequestAttachmentsRequest handles deletePullRequestAttachments operation.
 //
-// Get current user.
+// Delete pull request attachment.
 //
-// GET /users/myself
-func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}
+func (s *Server) handleDeletePullRequestAttachmentsRequest(args [4]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUser"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/myself"),
+		otelogen.OperationID("deletePullRequestAttachments"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments/{attachmentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePullRequestAttachmentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3861,15 +3927,15 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCurrentUserOperation,
-			ID:   "getCurrentUser",
+			Name: DeletePullRequestAttachmentsOperation,
+			ID:   "deletePullRequestAttachments",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetCurrentUserOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeletePullRequestAttachmentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3886,7 +3952,7 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetCurrentUserOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeletePullRequestAttachmentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3927,26 +3993,53 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeDeletePullRequestAttachmentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response *User
+	var response *Attachment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCurrentUserOperation,
-			OperationSummary: "Get current user",
-			OperationID:      "getCurrentUser",
+			OperationName:    DeletePullRequestAttachmentsOperation,
+			OperationSummary: "Delete pull request attachment",
+			OperationID:      "deletePullRequestAttachments",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
+				{
+					Name: "repoIdOrName",
+					In:   "path",
+				}: params.RepoIdOrName,
+				{
+					Name: "number",
+					In:   "path",
+				}: params.Number,
+				{
+					Name: "attachmentId",
+					In:   "path",
+				}: params.AttachmentId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *User
+			Params   = DeletePullRequestAttachmentsParams
+			Response = *Attachment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3955,14 +4048,14 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeletePullRequestAttachmentsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCurrentUser(ctx)
+				response, err = s.h.DeletePullRequestAttachments(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCurrentUser(ctx)
+		response, err = s.h.DeletePullRequestAttachments(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3970,7 +4063,7 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
+	if err := encodeDeletePullRequestAttachmentsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3979,22 +4072,22 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetCustomFieldsRequest handles getCustomFields operation.
+// handleDeleteWikiRequest handles deleteWiki operation.
 //
-// Get custom fields.
+// Delete wiki.
 //
-// GET /projects/{projectIdOrKey}/customFields
-func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /wikis/{wikiId}
+func (s *Server) handleDeleteWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCustomFields"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/customFields"),
+		otelogen.OperationID("deleteWiki"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCustomFieldsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4049,15 +4142,15 @@ func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCustomFieldsOperation,
-			ID:   "getCustomFields",
+			Name: DeleteWikiOperation,
+			ID:   "deleteWiki",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetCustomFieldsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DeleteWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4074,7 +4167,7 @@ func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetCustomFieldsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DeleteWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4115,7 +4208,7 @@ func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetCustomFieldsParams(args, argsEscaped, r)
+	params, err := decodeDeleteWikiParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4128,28 +4221,28 @@ func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response []CustomField
+	var response *Wiki
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCustomFieldsOperation,
-			OperationSummary: "Get custom fields",
-			OperationID:      "getCustomFields",
+			OperationName:    DeleteWikiOperation,
+			OperationSummary: "Delete wiki",
+			OperationID:      "deleteWiki",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectIdOrKey",
+					Name: "wikiId",
 					In:   "path",
-				}: params.ProjectIdOrKey,
+				}: params.WikiId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetCustomFieldsParams
-			Response = []CustomField
+			Params   = DeleteWikiParams
+			Response = *Wiki
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4158,14 +4251,14 @@ func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetCustomFieldsParams,
+			unpackDeleteWikiParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCustomFields(ctx, params)
+				response, err = s.h.DeleteWiki(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCustomFields(ctx, params)
+		response, err = s.h.DeleteWiki(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4173,7 +4266,7 @@ func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetCustomFieldsResponse(response, w, span); err != nil {
+	if err := encodeDeleteWikiResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4182,22 +4275,22 @@ func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetDocumentRequest handles getDocument operation.
+// handleDownloadDocumentAttachmentRequest handles downloadDocumentAttachment operation.
 //
-// Get document.
+// Download document attachment.
 //
-// GET /documents/{documentId}
-func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /documents/{documentId}/attachments/{attachmentId}
+func (s *Server) handleDownloadDocumentAttachmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocument"),
+		otelogen.OperationID("downloadDocumentAttachment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/documents/{documentId}"),
+		semconv.HTTPRouteKey.String("/documents/{documentId}/attachments/{attachmentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadDocumentAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4252,15 +4345,15 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDocumentOperation,
-			ID:   "getDocument",
+			Name: DownloadDocumentAttachmentOperation,
+			ID:   "downloadDocumentAttachment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, DownloadDocumentAttachmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4277,7 +4370,7 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetDocumentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, DownloadDocumentAttachmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4318,7 +4411,7 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetDocumentParams(args, argsEscaped, r)
+	params, err := decodeDownloadDocumentAttachmentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4331,13 +4424,13 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response *DocumentDetail
+	var response DownloadDocumentAttachmentOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDocumentOperation,
-			OperationSummary: "Get document",
-			OperationID:      "getDocument",
+			OperationName:    DownloadDocumentAttachmentOperation,
+			OperationSummary: "Download document attachment",
+			OperationID:      "downloadDocumentAttachment",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -4345,14 +4438,18 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 					Name: "documentId",
 					In:   "path",
 				}: params.DocumentId,
+				{
+					Name: "attachmentId",
+					In:   "path",
+				}: params.AttachmentId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetDocumentParams
-			Response = *DocumentDetail
+			Params   = DownloadDocumentAttachmentParams
+			Response = DownloadDocumentAttachmentOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4361,14 +4458,14 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetDocumentParams,
+			unpackDownloadDocumentAttachmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDocument(ctx, params)
+				response, err = s.h.DownloadDocumentAttachment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDocument(ctx, params)
+		response, err = s.h.DownloadDocumentAttachment(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4376,7 +4473,7 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetDocumentResponse(response, w, span); err != nil {
+	if err := encodeDownloadDocumentAttachmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4385,22 +4482,22 @@ func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetDocumentCommentsRequest handles getDocumentComments operation.
+// handleGetCategoriesRequest handles getCategories operation.
 //
-// Get document comments.
+// Get categories.
 //
-// GET /documents/{documentId}/comments
-func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/categories
+func (s *Server) handleGetCategoriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocumentComments"),
+		otelogen.OperationID("getCategories"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/documents/{documentId}/comments"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/categories"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentCommentsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCategoriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4455,15 +4552,15 @@ func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDocumentCommentsOperation,
-			ID:   "getDocumentComments",
+			Name: GetCategoriesOperation,
+			ID:   "getCategories",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentCommentsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetCategoriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4480,7 +4577,7 @@ func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetDocumentCommentsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetCategoriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4521,7 +4618,7 @@ func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetDocumentCommentsParams(args, argsEscaped, r)
+	params, err := decodeGetCategoriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4534,28 +4631,28 @@ func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response []DocumentComment
+	var response []Category
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDocumentCommentsOperation,
-			OperationSummary: "Get document comments",
-			OperationID:      "getDocumentComments",
+			OperationName:    GetCategoriesOperation,
+			OperationSummary: "Get categories",
+			OperationID:      "getCategories",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "documentId",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.DocumentId,
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetDocumentCommentsParams
-			Response = []DocumentComment
+			Params   = GetCategoriesParams
+			Response = []Category
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4564,14 +4661,14 @@ func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetDocumentCommentsParams,
+			unpackGetCategoriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDocumentComments(ctx, params)
+				response, err = s.h.GetCategories(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDocumentComments(ctx, params)
+		response, err = s.h.GetCategories(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4579,7 +4676,7 @@ func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetDocumentCommentsResponse(response, w, span); err != nil {
+	if err := encodeGetCategoriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4588,22 +4685,22 @@ func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetDocumentTreeRequest handles getDocumentTree operation.
+// handleGetCommentRequest handles getComment operation.
 //
-// Get document tree.
+// Get comment.
 //
-// GET /documents/tree
-func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /issues/{issueIdOrKey}/comments/{commentId}
+func (s *Server) handleGetCommentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocumentTree"),
+		otelogen.OperationID("getComment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/documents/tree"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentTreeOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4658,15 +4755,15 @@ func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDocumentTreeOperation,
-			ID:   "getDocumentTree",
+			Name: GetCommentOperation,
+			ID:   "getComment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentTreeOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetCommentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4683,7 +4780,7 @@ func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetDocumentTreeOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetCommentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4724,7 +4821,7 @@ func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetDocumentTreeParams(args, argsEscaped, r)
+	params, err := decodeGetCommentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4737,28 +4834,32 @@ func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response *DocumentTree
+	var response *Comment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDocumentTreeOperation,
-			OperationSummary: "Get document tree",
-			OperationID:      "getDocumentTree",
+			OperationName:    GetCommentOperation,
+			OperationSummary: "Get comment",
+			OperationID:      "getComment",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectIdOrKey",
-					In:   "query",
-				}: params.ProjectIdOrKey,
+					Name: "issueIdOrKey",
+					In:   "path",
+				}: params.IssueIdOrKey,
+				{
+					Name: "commentId",
+					In:   "path",
+				}: params.CommentId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetDocumentTreeParams
-			Response = *DocumentTree
+			Params   = GetCommentParams
+			Response = *Comment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4767,14 +4868,14 @@ func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetDocumentTreeParams,
+			unpackGetCommentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDocumentTree(ctx, params)
+				response, err = s.h.GetComment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDocumentTree(ctx, params)
+		response, err = s.h.GetComment(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4782,7 +4883,7 @@ func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetDocumentTreeResponse(response, w, span); err != nil {
+	if err := encodeGetCommentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4791,22 +4892,22 @@ func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetDocumentsRequest handles getDocuments operation.
+// handleGetCommentsRequest handles getComments operation.
 //
-// Get document list.
+// Get comments.
 //
-// GET /documents
-func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /issues/{issueIdOrKey}/comments
+func (s *Server) handleGetCommentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocuments"),
+		otelogen.OperationID("getComments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/documents"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCommentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4861,15 +4962,15 @@ func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDocumentsOperation,
-			ID:   "getDocuments",
+			Name: GetCommentsOperation,
+			ID:   "getComments",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetCommentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4886,7 +4987,7 @@ func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetDocumentsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetCommentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4927,7 +5028,7 @@ func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeGetDocumentsParams(args, argsEscaped, r)
+	params, err := decodeGetCommentsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4940,48 +5041,44 @@ func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w h
 
 	var rawBody []byte
 
-	var response []Document
+	var response []Comment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDocumentsOperation,
-			OperationSummary: "Get document list",
-			OperationID:      "getDocuments",
+			OperationName:    GetCommentsOperation,
+			OperationSummary: "Get comments",
+			OperationID:      "getComments",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectId[]",
+					Name: "issueIdOrKey",
+					In:   "path",
+				}: params.IssueIdOrKey,
+				{
+					Name: "minId",
 					In:   "query",
-				}: params.ProjectId,
+				}: params.MinId,
 				{
-					Name: "keyword",
+					Name: "maxId",
 					In:   "query",
-				}: params.Keyword,
+				}: params.MaxId,
 				{
-					Name: "sort",
+					Name: "count",
 					In:   "query",
-				}: params.Sort,
+				}: params.Count,
 				{
 					Name: "order",
 					In:   "query",
 				}: params.Order,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "count",
-					In:   "query",
-				}: params.Count,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetDocumentsParams
-			Response = []Document
+			Params   = GetCommentsParams
+			Response = []Comment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4990,14 +5087,14 @@ func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackGetDocumentsParams,
+			unpackGetCommentsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDocuments(ctx, params)
+				response, err = s.h.GetComments(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDocuments(ctx, params)
+		response, err = s.h.GetComments(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5005,7 +5102,7 @@ func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetDocumentsResponse(response, w, span); err != nil {
+	if err := encodeGetCommentsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5014,22 +5111,22 @@ func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetDocumentsCountRequest handles getDocumentsCount operation.
+// handleGetCommentsCountRequest handles getCommentsCount operation.
 //
-// Get document count.
+// Get comments count.
 //
-// GET /documents/count
-func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /issues/{issueIdOrKey}/comments/count
+func (s *Server) handleGetCommentsCountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDocumentsCount"),
+		otelogen.OperationID("getCommentsCount"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/documents/count"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments/count"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentsCountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCommentsCountOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5084,15 +5181,15 @@ func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDocumentsCountOperation,
-			ID:   "getDocumentsCount",
+			Name: GetCommentsCountOperation,
+			ID:   "getCommentsCount",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentsCountOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetCommentsCountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5109,7 +5206,7 @@ func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetDocumentsCountOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetCommentsCountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5150,7 +5247,7 @@ func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeGetDocumentsCountParams(args, argsEscaped, r)
+	params, err := decodeGetCommentsCountParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5163,28 +5260,28 @@ func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool
 
 	var rawBody []byte
 
-	var response *GetDocumentsCountOK
+	var response *GetCommentsCountOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDocumentsCountOperation,
-			OperationSummary: "Get document count",
-			OperationID:      "getDocumentsCount",
+			OperationName:    GetCommentsCountOperation,
+			OperationSummary: "Get comments count",
+			OperationID:      "getCommentsCount",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectIdOrKey",
-					In:   "query",
-				}: params.ProjectIdOrKey,
+					Name: "issueIdOrKey",
+					In:   "path",
+				}: params.IssueIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetDocumentsCountParams
-			Response = *GetDocumentsCountOK
+			Params   = GetCommentsCountParams
+			Response = *GetCommentsCountOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5193,14 +5290,14 @@ func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetDocumentsCountParams,
+			unpackGetCommentsCountParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDocumentsCount(ctx, params)
+				response, err = s.h.GetCommentsCount(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDocumentsCount(ctx, params)
+		response, err = s.h.GetCommentsCount(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5208,7 +5305,7 @@ func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetDocumentsCountResponse(response, w, span); err != nil {
+	if err := encodeGetCommentsCountResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5217,22 +5314,22 @@ func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleGetIssueRequest handles getIssue operation.
+// handleGetCurrentUserRequest handles getCurrentUser operation.
 //
-// Get issue.
+// Get current user.
 //
-// GET /issues/{issueIdOrKey}
-func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users/myself
+func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssue"),
+		otelogen.OperationID("getCurrentUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}"),
+		semconv.HTTPRouteKey.String("/users/myself"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssueOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5287,15 +5384,15 @@ func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIssueOperation,
-			ID:   "getIssue",
+			Name: GetCurrentUserOperation,
+			ID:   "getCurrentUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetIssueOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetCurrentUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5312,7 +5409,7 @@ func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetIssueOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetCurrentUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5353,41 +5450,26 @@ func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeGetIssueParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response *Issue
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIssueOperation,
-			OperationSummary: "Get issue",
-			OperationID:      "getIssue",
+			OperationName:    GetCurrentUserOperation,
+			OperationSummary: "Get current user",
+			OperationID:      "getCurrentUser",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "issueIdOrKey",
-					In:   "path",
-				}: params.IssueIdOrKey,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetIssueParams
-			Response = *Issue
+			Params   = struct{}
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5396,14 +5478,14 @@ func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetIssueParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIssue(ctx, params)
+				response, err = s.h.GetCurrentUser(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIssue(ctx, params)
+		response, err = s.h.GetCurrentUser(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5411,7 +5493,7 @@ func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetIssueResponse(response, w, span); err != nil {
+	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5420,22 +5502,22 @@ func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetIssueTypesRequest handles getIssueTypes operation.
+// handleGetCustomFieldsRequest handles getCustomFields operation.
 //
-// Get issue types.
+// Get custom fields.
 //
-// GET /projects/{projectIdOrKey}/issueTypes
-func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/customFields
+func (s *Server) handleGetCustomFieldsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssueTypes"),
+		otelogen.OperationID("getCustomFields"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/issueTypes"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/customFields"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssueTypesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCustomFieldsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5490,15 +5572,15 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIssueTypesOperation,
-			ID:   "getIssueTypes",
+			Name: GetCustomFieldsOperation,
+			ID:   "getCustomFields",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetIssueTypesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetCustomFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5515,7 +5597,7 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetIssueTypesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetCustomFieldsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5556,7 +5638,7 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetIssueTypesParams(args, argsEscaped, r)
+	params, err := decodeGetCustomFieldsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5569,13 +5651,13 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response []IssueType
+	var response []CustomField
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIssueTypesOperation,
-			OperationSummary: "Get issue types",
-			OperationID:      "getIssueTypes",
+			OperationName:    GetCustomFieldsOperation,
+			OperationSummary: "Get custom fields",
+			OperationID:      "getCustomFields",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -5589,8 +5671,8 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = GetIssueTypesParams
-			Response = []IssueType
+			Params   = GetCustomFieldsParams
+			Response = []CustomField
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5599,14 +5681,14 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetIssueTypesParams,
+			unpackGetCustomFieldsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIssueTypes(ctx, params)
+				response, err = s.h.GetCustomFields(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIssueTypes(ctx, params)
+		response, err = s.h.GetCustomFields(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5614,7 +5696,7 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetIssueTypesResponse(response, w, span); err != nil {
+	if err := encodeGetCustomFieldsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5623,22 +5705,22 @@ func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetIssuesRequest handles getIssues operation.
+// handleGetDocumentRequest handles getDocument operation.
 //
-// Get issues.
+// Get document.
 //
-// GET /issues
-func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /documents/{documentId}
+func (s *Server) handleGetDocumentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssues"),
+		otelogen.OperationID("getDocument"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues"),
+		semconv.HTTPRouteKey.String("/documents/{documentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssuesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5693,15 +5775,15 @@ func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIssuesOperation,
-			ID:   "getIssues",
+			Name: GetDocumentOperation,
+			ID:   "getDocument",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetIssuesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5718,7 +5800,7 @@ func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetIssuesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetDocumentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5759,7 +5841,7 @@ func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-	params, err := decodeGetIssuesParams(args, argsEscaped, r)
+	params, err := decodeGetDocumentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5772,137 +5854,29 @@ func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http
 
 	var rawBody []byte
 
-	var response []Issue
+	var response *DocumentDetail
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIssuesOperation,
-			OperationSummary: "Get issues",
-			OperationID:      "getIssues",
+			OperationName:    GetDocumentOperation,
+			OperationSummary: "Get document",
+			OperationID:      "getDocument",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectId[]",
-					In:   "query",
-				}: params.ProjectId,
-				{
-					Name: "issueTypeId[]",
-					In:   "query",
-				}: params.IssueTypeId,
-				{
-					Name: "categoryId[]",
-					In:   "query",
-				}: params.CategoryId,
-				{
-					Name: "versionId[]",
-					In:   "query",
-				}: params.VersionId,
-				{
-					Name: "milestoneId[]",
-					In:   "query",
-				}: params.MilestoneId,
-				{
-					Name: "statusId[]",
-					In:   "query",
-				}: params.StatusId,
-				{
-					Name: "priorityId[]",
-					In:   "query",
-				}: params.PriorityId,
-				{
-					Name: "assigneeId[]",
-					In:   "query",
-				}: params.AssigneeId,
-				{
-					Name: "createdUserId[]",
-					In:   "query",
-				}: params.CreatedUserId,
-				{
-					Name: "resolutionId[]",
-					In:   "query",
-				}: params.ResolutionId,
-				{
-					Name: "parentChild",
-					In:   "query",
-				}: params.ParentChild,
-				{
-					Name: "attachment",
-					In:   "query",
-				}: params.Attachment,
-				{
-					Name: "sharedFile",
-					In:   "query",
-				}: params.SharedFile,
-				{
-					Name: "sort",
-					In:   "query",
-				}: params.Sort,
-				{
-					Name: "order",
-					In:   "query",
-				}: params.Order,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "count",
-					In:   "query",
-				}: params.Count,
-				{
-					Name: "keyword",
-					In:   "query",
-				}: params.Keyword,
-				{
-					Name: "createdSince",
-					In:   "query",
-				}: params.CreatedSince,
-				{
-					Name: "createdUntil",
-					In:   "query",
-				}: params.CreatedUntil,
-				{
-					Name: "updatedSince",
-					In:   "query",
-				}: params.UpdatedSince,
-				{
-					Name: "updatedUntil",
-					In:   "query",
-				}: params.UpdatedUntil,
-				{
-					Name: "startDateSince",
-					In:   "query",
-				}: params.StartDateSince,
-				{
-					Name: "startDateUntil",
-					In:   "query",
-				}: params.StartDateUntil,
-				{
-					Name: "dueDateSince",
-					In:   "query",
-				}: params.DueDateSince,
-				{
-					Name: "dueDateUntil",
-					In:   "query",
-				}: params.DueDateUntil,
-				{
-					Name: "id[]",
-					In:   "query",
-				}: params.ID,
-				{
-					Name: "parentIssueId[]",
-					In:   "query",
-				}: params.ParentIssueId,
+					Name: "documentId",
+					In:   "path",
+				}: params.DocumentId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetIssuesParams
-			Response = []Issue
-		)
+			Params   = GetDocumentParams
+			Response = *DocumentDetail
+		)
 		response, err = middleware.HookMiddleware[
 			Request,
 			Params,
@@ -5910,14 +5884,14 @@ func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackGetIssuesParams,
+			unpackGetDocumentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIssues(ctx, params)
+				response, err = s.h.GetDocument(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIssues(ctx, params)
+		response, err = s.h.GetDocument(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5925,7 +5899,7 @@ func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetIssuesResponse(response, w, span); err != nil {
+	if err := encodeGetDocumentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5934,22 +5908,22 @@ func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleGetIssuesCountRequest handles getIssuesCount operation.
+// handleGetDocumentCommentsRequest handles getDocumentComments operation.
 //
-// Get issues count.
+// Get document comments.
 //
-// GET /issues/count
-func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /documents/{documentId}/comments
+func (s *Server) handleGetDocumentCommentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIssuesCount"),
+		otelogen.OperationID("getDocumentComments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues/count"),
+		semconv.HTTPRouteKey.String("/documents/{documentId}/comments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssuesCountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentCommentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6004,15 +5978,15 @@ func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIssuesCountOperation,
-			ID:   "getIssuesCount",
+			Name: GetDocumentCommentsOperation,
+			ID:   "getDocumentComments",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetIssuesCountOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentCommentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6029,7 +6003,7 @@ func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetIssuesCountOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetDocumentCommentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6070,7 +6044,7 @@ func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetIssuesCountParams(args, argsEscaped, r)
+	params, err := decodeGetDocumentCommentsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6083,120 +6057,2903 @@ func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response *GetIssuesCountOK
+	var response []DocumentComment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIssuesCountOperation,
-			OperationSummary: "Get issues count",
-			OperationID:      "getIssuesCount",
+			OperationName:    GetDocumentCommentsOperation,
+			OperationSummary: "Get document comments",
+			OperationID:      "getDocumentComments",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectId[]",
-					In:   "query",
-				}: params.ProjectId,
-				{
-					Name: "issueTypeId[]",
-					In:   "query",
-				}: params.IssueTypeId,
+					Name: "documentId",
+					In:   "path",
+				}: params.DocumentId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetDocumentCommentsParams
+			Response = []DocumentComment
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetDocumentCommentsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetDocumentComments(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetDocumentComments(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetDocumentCommentsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetDocumentTreeRequest handles getDocumentTree operation.
+//
+// Get document tree.
+//
+// GET /documents/tree
+func (s *Server) handleGetDocumentTreeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDocumentTree"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/documents/tree"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentTreeOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetDocumentTreeOperation,
+			ID:   "getDocumentTree",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentTreeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetDocumentTreeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetDocumentTreeParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *DocumentTree
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetDocumentTreeOperation,
+			OperationSummary: "Get document tree",
+			OperationID:      "getDocumentTree",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "categoryId[]",
+					Name: "projectIdOrKey",
 					In:   "query",
-				}: params.CategoryId,
-				{
-					Name: "versionId[]",
-					In:   "query",
-				}: params.VersionId,
-				{
-					Name: "milestoneId[]",
-					In:   "query",
-				}: params.MilestoneId,
-				{
-					Name: "statusId[]",
-					In:   "query",
-				}: params.StatusId,
-				{
-					Name: "priorityId[]",
-					In:   "query",
-				}: params.PriorityId,
-				{
-					Name: "assigneeId[]",
-					In:   "query",
-				}: params.AssigneeId,
-				{
-					Name: "createdUserId[]",
-					In:   "query",
-				}: params.CreatedUserId,
-				{
-					Name: "resolutionId[]",
-					In:   "query",
-				}: params.ResolutionId,
-				{
-					Name: "parentChild",
-					In:   "query",
-				}: params.ParentChild,
-				{
-					Name: "attachment",
-					In:   "query",
-				}: params.Attachment,
+				}: params.ProjectIdOrKey,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetDocumentTreeParams
+			Response = *DocumentTree
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetDocumentTreeParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetDocumentTree(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetDocumentTree(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetDocumentTreeResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetDocumentsRequest handles getDocuments operation.
+//
+// Get document list.
+//
+// GET /documents
+func (s *Server) handleGetDocumentsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDocuments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/documents"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetDocumentsOperation,
+			ID:   "getDocuments",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetDocumentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetDocumentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []Document
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetDocumentsOperation,
+			OperationSummary: "Get document list",
+			OperationID:      "getDocuments",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "sharedFile",
+					Name: "projectId[]",
 					In:   "query",
-				}: params.SharedFile,
+				}: params.ProjectId,
 				{
 					Name: "keyword",
 					In:   "query",
 				}: params.Keyword,
 				{
-					Name: "createdSince",
+					Name: "sort",
 					In:   "query",
-				}: params.CreatedSince,
+				}: params.Sort,
 				{
-					Name: "createdUntil",
+					Name: "order",
 					In:   "query",
-				}: params.CreatedUntil,
+				}: params.Order,
 				{
-					Name: "updatedSince",
+					Name: "offset",
 					In:   "query",
-				}: params.UpdatedSince,
+				}: params.Offset,
 				{
-					Name: "updatedUntil",
+					Name: "count",
 					In:   "query",
-				}: params.UpdatedUntil,
-				{
-					Name: "startDateSince",
-					In:   "query",
-				}: params.StartDateSince,
-				{
-					Name: "startDateUntil",
-					In:   "query",
-				}: params.StartDateUntil,
-				{
-					Name: "dueDateSince",
-					In:   "query",
-				}: params.DueDateSince,
-				{
-					Name: "dueDateUntil",
-					In:   "query",
-				}: params.DueDateUntil,
+				}: params.Count,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetDocumentsParams
+			Response = []Document
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetDocumentsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetDocuments(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetDocuments(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetDocumentsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetDocumentsCountRequest handles getDocumentsCount operation.
+//
+// Get document count.
+//
+// GET /documents/count
+func (s *Server) handleGetDocumentsCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDocumentsCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/documents/count"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDocumentsCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetDocumentsCountOperation,
+			ID:   "getDocumentsCount",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetDocumentsCountOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetDocumentsCountOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetDocumentsCountParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *GetDocumentsCountOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetDocumentsCountOperation,
+			OperationSummary: "Get document count",
+			OperationID:      "getDocumentsCount",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "id[]",
+					Name: "projectIdOrKey",
 					In:   "query",
-				}: params.ID,
+				}: params.ProjectIdOrKey,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetDocumentsCountParams
+			Response = *GetDocumentsCountOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetDocumentsCountParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetDocumentsCount(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetDocumentsCount(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetDocumentsCountResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetIssueRequest handles getIssue operation.
+//
+// Get issue.
+//
+// GET /issues/{issueIdOrKey}
+func (s *Server) handleGetIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssue"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssueOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetIssueOperation,
+			ID:   "getIssue",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetIssueOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetIssueOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetIssueParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *Issue
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetIssueOperation,
+			OperationSummary: "Get issue",
+			OperationID:      "getIssue",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "issueIdOrKey",
+					In:   "path",
+				}: params.IssueIdOrKey,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetIssueParams
+			Response = *Issue
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetIssueParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetIssue(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetIssue(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetIssueResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetIssueTypesRequest handles getIssueTypes operation.
+//
+// Get issue types.
+//
+// GET /projects/{projectIdOrKey}/issueTypes
+func (s *Server) handleGetIssueTypesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssueTypes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/issueTypes"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssueTypesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetIssueTypesOperation,
+			ID:   "getIssueTypes",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetIssueTypesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetIssueTypesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetIssueTypesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []IssueType
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetIssueTypesOperation,
+			OperationSummary: "Get issue types",
+			OperationID:      "getIssueTypes",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetIssueTypesParams
+			Response = []IssueType
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetIssueTypesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetIssueTypes(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetIssueTypes(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetIssueTypesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetIssuesRequest handles getIssues operation.
+//
+// Get issues.
+//
+// GET /issues
+func (s *Server) handleGetIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/issues"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetIssuesOperation,
+			ID:   "getIssues",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetIssuesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetIssuesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetIssuesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []Issue
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetIssuesOperation,
+			OperationSummary: "Get issues",
+			OperationID:      "getIssues",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "projectId[]",
+					In:   "query",
+				}: params.ProjectId,
+				{
+					Name: "issueTypeId[]",
+					In:   "query",
+				}: params.IssueTypeId,
+				{
+					Name: "categoryId[]",
+					In:   "query",
+				}: params.CategoryId,
+				{
+					Name: "versionId[]",
+					In:   "query",
+				}: params.VersionId,
+				{
+					Name: "milestoneId[]",
+					In:   "query",
+				}: params.MilestoneId,
+				{
+					Name: "statusId[]",
+					In:   "query",
+				}: params.StatusId,
+				{
+					Name: "priorityId[]",
+					In:   "query",
+				}: params.PriorityId,
+				{
+					Name: "assigneeId[]",
+					In:   "query",
+				}: params.AssigneeId,
+				{
+					Name: "createdUserId[]",
+					In:   "query",
+				}: params.CreatedUserId,
+				{
+					Name: "resolutionId[]",
+					In:   "query",
+				}: params.ResolutionId,
+				{
+					Name: "parentChild",
+					In:   "query",
+				}: params.ParentChild,
+				{
+					Name: "attachment",
+					In:   "query",
+				}: params.Attachment,
+				{
+					Name: "sharedFile",
+					In:   "query",
+				}: params.SharedFile,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "count",
+					In:   "query",
+				}: params.Count,
+				{
+					Name: "keyword",
+					In:   "query",
+				}: params.Keyword,
+				{
+					Name: "createdSince",
+					In:   "query",
+				}: params.CreatedSince,
+				{
+					Name: "createdUntil",
+					In:   "query",
+				}: params.CreatedUntil,
+				{
+					Name: "updatedSince",
+					In:   "query",
+				}: params.UpdatedSince,
+				{
+					Name: "updatedUntil",
+					In:   "query",
+				}: params.UpdatedUntil,
+				{
+					Name: "startDateSince",
+					In:   "query",
+				}: params.StartDateSince,
+				{
+					Name: "startDateUntil",
+					In:   "query",
+				}: params.StartDateUntil,
+				{
+					Name: "dueDateSince",
+					In:   "query",
+				}: params.DueDateSince,
+				{
+					Name: "dueDateUntil",
+					In:   "query",
+				}: params.DueDateUntil,
+				{
+					Name: "id[]",
+					In:   "query",
+				}: params.ID,
+				{
+					Name: "parentIssueId[]",
+					In:   "query",
+				}: params.ParentIssueId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetIssuesParams
+			Response = []Issue
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetIssuesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetIssues(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetIssues(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetIssuesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetIssuesCountRequest handles getIssuesCount operation.
+//
+// Get issues count.
+//
+// GET /issues/count
+func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getIssuesCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/issues/count"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssuesCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetIssuesCountOperation,
+			ID:   "getIssuesCount",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetIssuesCountOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetIssuesCountOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetIssuesCountParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *GetIssuesCountOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetIssuesCountOperation,
+			OperationSummary: "Get issues count",
+			OperationID:      "getIssuesCount",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "projectId[]",
+					In:   "query",
+				}: params.ProjectId,
+				{
+					Name: "issueTypeId[]",
+					In:   "query",
+				}: params.IssueTypeId,
+				{
+					Name: "categoryId[]",
+					In:   "query",
+				}: params.CategoryId,
+				{
+					Name: "versionId[]",
+					In:   "query",
+				}: params.VersionId,
+				{
+					Name: "milestoneId[]",
+					In:   "query",
+				}: params.MilestoneId,
+				{
+					Name: "statusId[]",
+					In:   "query",
+				}: params.StatusId,
+				{
+					Name: "priorityId[]",
+					In:   "query",
+				}: params.PriorityId,
+				{
+					Name: "assigneeId[]",
+					In:   "query",
+				}: params.AssigneeId,
+				{
+					Name: "createdUserId[]",
+					In:   "query",
+				}: params.CreatedUserId,
+				{
+					Name: "resolutionId[]",
+					In:   "query",
+				}: params.ResolutionId,
+				{
+					Name: "parentChild",
+					In:   "query",
+				}: params.ParentChild,
+				{
+					Name: "attachment",
+					In:   "query",
+				}: params.Attachment,
+				{
+					Name: "sharedFile",
+					In:   "query",
+				}: params.SharedFile,
+				{
+					Name: "keyword",
+					In:   "query",
+				}: params.Keyword,
+				{
+					Name: "createdSince",
+					In:   "query",
+				}: params.CreatedSince,
+				{
+					Name: "createdUntil",
+					In:   "query",
+				}: params.CreatedUntil,
+				{
+					Name: "updatedSince",
+					In:   "query",
+				}: params.UpdatedSince,
+				{
+					Name: "updatedUntil",
+					In:   "query",
+				}: params.UpdatedUntil,
+				{
+					Name: "startDateSince",
+					In:   "query",
+				}: params.StartDateSince,
+				{
+					Name: "startDateUntil",
+					In:   "query",
+				}: params.StartDateUntil,
+				{
+					Name: "dueDateSince",
+					In:   "query",
+				}: params.DueDateSince,
+				{
+					Name: "dueDateUntil",
+					In:   "query",
+				}: params.DueDateUntil,
+				{
+					Name: "id[]",
+					In:   "query",
+				}: params.ID,
+				{
+					Name: "parentIssueId[]",
+					In:   "query",
+				}: params.ParentIssueId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetIssuesCountParams
+			Response = *GetIssuesCountOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetIssuesCountParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetIssuesCount(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetIssuesCount(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetIssuesCountResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetListOfIssueAttachmentsRequest handles getListOfIssueAttachments operation.
+//
+// Get issue attachments.
+//
+// GET /issues/{issueIdOrKey}/attachments
+func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfIssueAttachments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/attachments"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfIssueAttachmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetListOfIssueAttachmentsOperation,
+			ID:   "getListOfIssueAttachments",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetListOfIssueAttachmentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetListOfIssueAttachmentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetListOfIssueAttachmentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []Attachment
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetListOfIssueAttachmentsOperation,
+			OperationSummary: "Get issue attachments",
+			OperationID:      "getListOfIssueAttachments",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "issueIdOrKey",
+					In:   "path",
+				}: params.IssueIdOrKey,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetListOfIssueAttachmentsParams
+			Response = []Attachment
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetListOfIssueAttachmentsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetListOfIssueAttachments(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetListOfIssueAttachments(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetListOfIssueAttachmentsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetListOfLinkedSharedFilesRequest handles getListOfLinkedSharedFiles operation.
+//
+// Get shared files linked to issue.
+//
+// GET /issues/{issueIdOrKey}/sharedFiles
+func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfLinkedSharedFiles"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/sharedFiles"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfLinkedSharedFilesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetListOfLinkedSharedFilesOperation,
+			ID:   "getListOfLinkedSharedFiles",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetListOfLinkedSharedFilesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetListOfLinkedSharedFilesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetListOfLinkedSharedFilesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []SharedFile
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetListOfLinkedSharedFilesOperation,
+			OperationSummary: "Get shared files linked to issue",
+			OperationID:      "getListOfLinkedSharedFiles",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "issueIdOrKey",
+					In:   "path",
+				}: params.IssueIdOrKey,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetListOfLinkedSharedFilesParams
+			Response = []SharedFile
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetListOfLinkedSharedFilesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetListOfLinkedSharedFiles(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetListOfLinkedSharedFiles(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetListOfLinkedSharedFilesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetListOfPullRequestAttachmentRequest handles getListOfPullRequestAttachment operation.
+//
+// Get pull request attachments.
+//
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments
+func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfPullRequestAttachment"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfPullRequestAttachmentOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetListOfPullRequestAttachmentOperation,
+			ID:   "getListOfPullRequestAttachment",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetListOfPullRequestAttachmentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetListOfPullRequestAttachmentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetListOfPullRequestAttachmentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []Attachment
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetListOfPullRequestAttachmentOperation,
+			OperationSummary: "Get pull request attachments",
+			OperationID:      "getListOfPullRequestAttachment",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
+				{
+					Name: "repoIdOrName",
+					In:   "path",
+				}: params.RepoIdOrName,
+				{
+					Name: "number",
+					In:   "path",
+				}: params.Number,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetListOfPullRequestAttachmentParams
+			Response = []Attachment
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetListOfPullRequestAttachmentParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetListOfPullRequestAttachment(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetListOfPullRequestAttachment(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetListOfPullRequestAttachmentResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetListOfRecentlyViewedIssuesRequest handles getListOfRecentlyViewedIssues operation.
+//
+// Get list of recently viewed issues.
+//
+// GET /users/myself/recentlyViewedIssues
+func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfRecentlyViewedIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/users/myself/recentlyViewedIssues"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfRecentlyViewedIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetListOfRecentlyViewedIssuesOperation,
+			ID:   "getListOfRecentlyViewedIssues",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetListOfRecentlyViewedIssuesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetListOfRecentlyViewedIssuesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetListOfRecentlyViewedIssuesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []RecentlyViewedIssue
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetListOfRecentlyViewedIssuesOperation,
+			OperationSummary: "Get list of recently viewed issues",
+			OperationID:      "getListOfRecentlyViewedIssues",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "count",
+					In:   "query",
+				}: params.Count,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetListOfRecentlyViewedIssuesParams
+			Response = []RecentlyViewedIssue
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetListOfRecentlyViewedIssuesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetListOfRecentlyViewedIssues(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetListOfRecentlyViewedIssues(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetListOfRecentlyViewedIssuesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetListOfSharedFilesOnWikiRequest handles getListOfSharedFilesOnWiki operation.
+//
+// Get shared files linked to wiki.
+//
+// GET /wikis/{wikiId}/sharedFiles
+func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfSharedFilesOnWiki"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}/sharedFiles"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfSharedFilesOnWikiOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetListOfSharedFilesOnWikiOperation,
+			ID:   "getListOfSharedFilesOnWiki",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetListOfSharedFilesOnWikiOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetListOfSharedFilesOnWikiOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetListOfSharedFilesOnWikiParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []SharedFile
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetListOfSharedFilesOnWikiOperation,
+			OperationSummary: "Get shared files linked to wiki",
+			OperationID:      "getListOfSharedFilesOnWiki",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "wikiId",
+					In:   "path",
+				}: params.WikiId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetListOfSharedFilesOnWikiParams
+			Response = []SharedFile
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetListOfSharedFilesOnWikiParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetListOfSharedFilesOnWiki(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetListOfSharedFilesOnWiki(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetListOfSharedFilesOnWikiResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetListOfWikiAttachmentsRequest handles getListOfWikiAttachments operation.
+//
+// Get wiki attachments.
+//
+// GET /wikis/{wikiId}/attachments
+func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getListOfWikiAttachments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}/attachments"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfWikiAttachmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetListOfWikiAttachmentsOperation,
+			ID:   "getListOfWikiAttachments",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityOAuth2(ctx, GetListOfWikiAttachmentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OAuth2",
+					Err:              err,
+				}
+				defer recordError("Security:OAuth2", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKey(ctx, GetListOfWikiAttachmentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKey",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKey", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetListOfWikiAttachmentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []Attachment
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetListOfWikiAttachmentsOperation,
+			OperationSummary: "Get wiki attachments",
+			OperationID:      "getListOfWikiAttachments",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "parentIssueId[]",
-					In:   "query",
-				}: params.ParentIssueId,
+					Name: "wikiId",
+					In:   "path",
+				}: params.WikiId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetIssuesCountParams
-			Response = *GetIssuesCountOK
+			Params   = GetListOfWikiAttachmentsParams
+			Response = []Attachment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6205,14 +8962,14 @@ func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetIssuesCountParams,
+			unpackGetListOfWikiAttachmentsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIssuesCount(ctx, params)
+				response, err = s.h.GetListOfWikiAttachments(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIssuesCount(ctx, params)
+		response, err = s.h.GetListOfWikiAttachments(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6220,7 +8977,7 @@ func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetIssuesCountResponse(response, w, span); err != nil {
+	if err := encodeGetListOfWikiAttachmentsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6229,22 +8986,22 @@ func (s *Server) handleGetIssuesCountRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetListOfIssueAttachmentsRequest handles getListOfIssueAttachments operation.
+// handleGetPrioritiesRequest handles getPriorities operation.
 //
-// Get issue attachments.
+// Get all priorities.
 //
-// GET /issues/{issueIdOrKey}/attachments
-func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /priorities
+func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfIssueAttachments"),
+		otelogen.OperationID("getPriorities"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/attachments"),
+		semconv.HTTPRouteKey.String("/priorities"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfIssueAttachmentsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPrioritiesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6299,15 +9056,15 @@ func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetListOfIssueAttachmentsOperation,
-			ID:   "getListOfIssueAttachments",
+			Name: GetPrioritiesOperation,
+			ID:   "getPriorities",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetListOfIssueAttachmentsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetPrioritiesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6324,7 +9081,7 @@ func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetListOfIssueAttachmentsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetPrioritiesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6365,41 +9122,26 @@ func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeGetListOfIssueAttachmentsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response []Attachment
+	var response []Priority
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetListOfIssueAttachmentsOperation,
-			OperationSummary: "Get issue attachments",
-			OperationID:      "getListOfIssueAttachments",
+			OperationName:    GetPrioritiesOperation,
+			OperationSummary: "Get all priorities",
+			OperationID:      "getPriorities",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "issueIdOrKey",
-					In:   "path",
-				}: params.IssueIdOrKey,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetListOfIssueAttachmentsParams
-			Response = []Attachment
+			Params   = struct{}
+			Response = []Priority
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6408,14 +9150,14 @@ func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetListOfIssueAttachmentsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetListOfIssueAttachments(ctx, params)
+				response, err = s.h.GetPriorities(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetListOfIssueAttachments(ctx, params)
+		response, err = s.h.GetPriorities(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6423,7 +9165,7 @@ func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetListOfIssueAttachmentsResponse(response, w, span); err != nil {
+	if err := encodeGetPrioritiesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6432,22 +9174,22 @@ func (s *Server) handleGetListOfIssueAttachmentsRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetListOfLinkedSharedFilesRequest handles getListOfLinkedSharedFiles operation.
+// handleGetProjectRequest handles getProject operation.
 //
-// Get shared files linked to issue.
+// Get project.
 //
-// GET /issues/{issueIdOrKey}/sharedFiles
-func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}
+func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfLinkedSharedFiles"),
+		otelogen.OperationID("getProject"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/sharedFiles"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfLinkedSharedFilesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6502,15 +9244,15 @@ func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetListOfLinkedSharedFilesOperation,
-			ID:   "getListOfLinkedSharedFiles",
+			Name: GetProjectOperation,
+			ID:   "getProject",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetListOfLinkedSharedFilesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetProjectOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6527,7 +9269,7 @@ func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetListOfLinkedSharedFilesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetProjectOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6568,7 +9310,7 @@ func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeGetListOfLinkedSharedFilesParams(args, argsEscaped, r)
+	params, err := decodeGetProjectParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6581,28 +9323,28 @@ func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEsc
 
 	var rawBody []byte
 
-	var response []SharedFile
+	var response *Project
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetListOfLinkedSharedFilesOperation,
-			OperationSummary: "Get shared files linked to issue",
-			OperationID:      "getListOfLinkedSharedFiles",
+			OperationName:    GetProjectOperation,
+			OperationSummary: "Get project",
+			OperationID:      "getProject",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "issueIdOrKey",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.IssueIdOrKey,
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetListOfLinkedSharedFilesParams
-			Response = []SharedFile
+			Params   = GetProjectParams
+			Response = *Project
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6611,14 +9353,14 @@ func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackGetListOfLinkedSharedFilesParams,
+			unpackGetProjectParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetListOfLinkedSharedFiles(ctx, params)
+				response, err = s.h.GetProject(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetListOfLinkedSharedFiles(ctx, params)
+		response, err = s.h.GetProject(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6626,7 +9368,7 @@ func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeGetListOfLinkedSharedFilesResponse(response, w, span); err != nil {
+	if err := encodeGetProjectResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6635,22 +9377,22 @@ func (s *Server) handleGetListOfLinkedSharedFilesRequest(args [1]string, argsEsc
 	}
 }
 
-// handleGetListOfPullRequestAttachmentRequest handles getListOfPullRequestAttachment operation.
+// handleGetProjectAdministratorsRequest handles getProjectAdministrators operation.
 //
-// Get pull request attachments.
+// Get project administrators.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments
-func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/administrators
+func (s *Server) handleGetProjectAdministratorsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfPullRequestAttachment"),
+		otelogen.OperationID("getProjectAdministrators"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}/attachments"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/administrators"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfPullRequestAttachmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectAdministratorsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6705,15 +9447,15 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetListOfPullRequestAttachmentOperation,
-			ID:   "getListOfPullRequestAttachment",
+			Name: GetProjectAdministratorsOperation,
+			ID:   "getProjectAdministrators",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetListOfPullRequestAttachmentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetProjectAdministratorsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6730,7 +9472,7 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetListOfPullRequestAttachmentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetProjectAdministratorsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6771,7 +9513,7 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 			return
 		}
 	}
-	params, err := decodeGetListOfPullRequestAttachmentParams(args, argsEscaped, r)
+	params, err := decodeGetProjectAdministratorsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6784,13 +9526,13 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 
 	var rawBody []byte
 
-	var response []Attachment
+	var response []User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetListOfPullRequestAttachmentOperation,
-			OperationSummary: "Get pull request attachments",
-			OperationID:      "getListOfPullRequestAttachment",
+			OperationName:    GetProjectAdministratorsOperation,
+			OperationSummary: "Get project administrators",
+			OperationID:      "getProjectAdministrators",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -6798,22 +9540,14 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 					Name: "projectIdOrKey",
 					In:   "path",
 				}: params.ProjectIdOrKey,
-				{
-					Name: "repoIdOrName",
-					In:   "path",
-				}: params.RepoIdOrName,
-				{
-					Name: "number",
-					In:   "path",
-				}: params.Number,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetListOfPullRequestAttachmentParams
-			Response = []Attachment
+			Params   = GetProjectAdministratorsParams
+			Response = []User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6822,14 +9556,14 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 		](
 			m,
 			mreq,
-			unpackGetListOfPullRequestAttachmentParams,
+			unpackGetProjectAdministratorsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetListOfPullRequestAttachment(ctx, params)
+				response, err = s.h.GetProjectAdministrators(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetListOfPullRequestAttachment(ctx, params)
+		response, err = s.h.GetProjectAdministrators(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6837,7 +9571,7 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 		return
 	}
 
-	if err := encodeGetListOfPullRequestAttachmentResponse(response, w, span); err != nil {
+	if err := encodeGetProjectAdministratorsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6846,22 +9580,22 @@ func (s *Server) handleGetListOfPullRequestAttachmentRequest(args [3]string, arg
 	}
 }
 
-// handleGetListOfRecentlyViewedIssuesRequest handles getListOfRecentlyViewedIssues operation.
+// handleGetProjectRecentUpdatesRequest handles getProjectRecentUpdates operation.
 //
-// Get list of recently viewed issues.
+// Get project recent updates.
 //
-// GET /users/myself/recentlyViewedIssues
-func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/activities
+func (s *Server) handleGetProjectRecentUpdatesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfRecentlyViewedIssues"),
+		otelogen.OperationID("getProjectRecentUpdates"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/myself/recentlyViewedIssues"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/activities"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfRecentlyViewedIssuesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectRecentUpdatesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6916,15 +9650,15 @@ func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetListOfRecentlyViewedIssuesOperation,
-			ID:   "getListOfRecentlyViewedIssues",
+			Name: GetProjectRecentUpdatesOperation,
+			ID:   "getProjectRecentUpdates",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetListOfRecentlyViewedIssuesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetProjectRecentUpdatesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6941,7 +9675,7 @@ func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, args
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetListOfRecentlyViewedIssuesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetProjectRecentUpdatesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6982,7 +9716,7 @@ func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, args
 			return
 		}
 	}
-	params, err := decodeGetListOfRecentlyViewedIssuesParams(args, argsEscaped, r)
+	params, err := decodeGetProjectRecentUpdatesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6995,36 +9729,48 @@ func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, args
 
 	var rawBody []byte
 
-	var response []RecentlyViewedIssue
+	var response []Activity
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetListOfRecentlyViewedIssuesOperation,
-			OperationSummary: "Get list of recently viewed issues",
-			OperationID:      "getListOfRecentlyViewedIssues",
+			OperationName:    GetProjectRecentUpdatesOperation,
+			OperationSummary: "Get project recent updates",
+			OperationID:      "getProjectRecentUpdates",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "order",
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
+				{
+					Name: "activityTypeId[]",
 					In:   "query",
-				}: params.Order,
+				}: params.ActivityTypeId,
 				{
-					Name: "offset",
+					Name: "minId",
 					In:   "query",
-				}: params.Offset,
+				}: params.MinId,
+				{
+					Name: "maxId",
+					In:   "query",
+				}: params.MaxId,
 				{
 					Name: "count",
 					In:   "query",
 				}: params.Count,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetListOfRecentlyViewedIssuesParams
-			Response = []RecentlyViewedIssue
+			Params   = GetProjectRecentUpdatesParams
+			Response = []Activity
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7033,14 +9779,14 @@ func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, args
 		](
 			m,
 			mreq,
-			unpackGetListOfRecentlyViewedIssuesParams,
+			unpackGetProjectRecentUpdatesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetListOfRecentlyViewedIssues(ctx, params)
+				response, err = s.h.GetProjectRecentUpdates(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetListOfRecentlyViewedIssues(ctx, params)
+		response, err = s.h.GetProjectRecentUpdates(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7048,7 +9794,7 @@ func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, args
 		return
 	}
 
-	if err := encodeGetListOfRecentlyViewedIssuesResponse(response, w, span); err != nil {
+	if err := encodeGetProjectRecentUpdatesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7057,22 +9803,22 @@ func (s *Server) handleGetListOfRecentlyViewedIssuesRequest(args [0]string, args
 	}
 }
 
-// handleGetListOfSharedFilesOnWikiRequest handles getListOfSharedFilesOnWiki operation.
+// handleGetProjectUsersRequest handles getProjectUsers operation.
 //
-// Get shared files linked to wiki.
+// Get project users.
 //
-// GET /wikis/{wikiId}/sharedFiles
-func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/users
+func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfSharedFilesOnWiki"),
+		otelogen.OperationID("getProjectUsers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}/sharedFiles"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/users"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfSharedFilesOnWikiOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7127,15 +9873,15 @@ func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetListOfSharedFilesOnWikiOperation,
-			ID:   "getListOfSharedFilesOnWiki",
+			Name: GetProjectUsersOperation,
+			ID:   "getProjectUsers",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetListOfSharedFilesOnWikiOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetProjectUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7152,7 +9898,7 @@ func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetListOfSharedFilesOnWikiOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetProjectUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7193,7 +9939,7 @@ func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeGetListOfSharedFilesOnWikiParams(args, argsEscaped, r)
+	params, err := decodeGetProjectUsersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7206,28 +9952,28 @@ func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEsc
 
 	var rawBody []byte
 
-	var response []SharedFile
+	var response []User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetListOfSharedFilesOnWikiOperation,
-			OperationSummary: "Get shared files linked to wiki",
-			OperationID:      "getListOfSharedFilesOnWiki",
+			OperationName:    GetProjectUsersOperation,
+			OperationSummary: "Get project users",
+			OperationID:      "getProjectUsers",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.WikiId,
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetListOfSharedFilesOnWikiParams
-			Response = []SharedFile
+			Params   = GetProjectUsersParams
+			Response = []User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7236,14 +9982,14 @@ func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackGetListOfSharedFilesOnWikiParams,
+			unpackGetProjectUsersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetListOfSharedFilesOnWiki(ctx, params)
+				response, err = s.h.GetProjectUsers(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetListOfSharedFilesOnWiki(ctx, params)
+		response, err = s.h.GetProjectUsers(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7251,7 +9997,7 @@ func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeGetListOfSharedFilesOnWikiResponse(response, w, span); err != nil {
+	if err := encodeGetProjectUsersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7260,22 +10006,22 @@ func (s *Server) handleGetListOfSharedFilesOnWikiRequest(args [1]string, argsEsc
 	}
 }
 
-// handleGetListOfWikiAttachmentsRequest handles getListOfWikiAttachments operation.
+// handleGetProjectsRequest handles getProjects operation.
 //
-// Get wiki attachments.
+// Get projects.
 //
-// GET /wikis/{wikiId}/attachments
-func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects
+func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getListOfWikiAttachments"),
+		otelogen.OperationID("getProjects"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}/attachments"),
+		semconv.HTTPRouteKey.String("/projects"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetListOfWikiAttachmentsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7330,15 +10076,15 @@ func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetListOfWikiAttachmentsOperation,
-			ID:   "getListOfWikiAttachments",
+			Name: GetProjectsOperation,
+			ID:   "getProjects",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetListOfWikiAttachmentsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetProjectsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7355,7 +10101,7 @@ func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscap
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetListOfWikiAttachmentsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetProjectsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7396,7 +10142,7 @@ func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeGetListOfWikiAttachmentsParams(args, argsEscaped, r)
+	params, err := decodeGetProjectsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7409,28 +10155,28 @@ func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscap
 
 	var rawBody []byte
 
-	var response []Attachment
+	var response []Project
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetListOfWikiAttachmentsOperation,
-			OperationSummary: "Get wiki attachments",
-			OperationID:      "getListOfWikiAttachments",
+			OperationName:    GetProjectsOperation,
+			OperationSummary: "Get projects",
+			OperationID:      "getProjects",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
-					In:   "path",
-				}: params.WikiId,
+					Name: "archived",
+					In:   "query",
+				}: params.Archived,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetListOfWikiAttachmentsParams
-			Response = []Attachment
+			Params   = GetProjectsParams
+			Response = []Project
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7439,14 +10185,14 @@ func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetListOfWikiAttachmentsParams,
+			unpackGetProjectsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetListOfWikiAttachments(ctx, params)
+				response, err = s.h.GetProjects(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetListOfWikiAttachments(ctx, params)
+		response, err = s.h.GetProjects(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7454,7 +10200,7 @@ func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeGetListOfWikiAttachmentsResponse(response, w, span); err != nil {
+	if err := encodeGetProjectsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7463,22 +10209,22 @@ func (s *Server) handleGetListOfWikiAttachmentsRequest(args [1]string, argsEscap
 	}
 }
 
-// handleGetPrioritiesRequest handles getPriorities operation.
+// handleGetPullRequestRequest handles getPullRequest operation.
 //
-// Get all priorities.
+// Get pull request.
 //
-// GET /priorities
-func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}
+func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPriorities"),
+		otelogen.OperationID("getPullRequest"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/priorities"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPrioritiesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPullRequestOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7533,15 +10279,15 @@ func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPrioritiesOperation,
-			ID:   "getPriorities",
+			Name: GetPullRequestOperation,
+			ID:   "getPullRequest",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetPrioritiesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetPullRequestOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7558,7 +10304,7 @@ func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetPrioritiesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetPullRequestOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7599,26 +10345,49 @@ func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeGetPullRequestParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []Priority
+	var response *PullRequest
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPrioritiesOperation,
-			OperationSummary: "Get all priorities",
-			OperationID:      "getPriorities",
+			OperationName:    GetPullRequestOperation,
+			OperationSummary: "Get pull request",
+			OperationID:      "getPullRequest",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
+				{
+					Name: "repoIdOrName",
+					In:   "path",
+				}: params.RepoIdOrName,
+				{
+					Name: "number",
+					In:   "path",
+				}: params.Number,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []Priority
+			Params   = GetPullRequestParams
+			Response = *PullRequest
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7627,14 +10396,14 @@ func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetPullRequestParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPriorities(ctx)
+				response, err = s.h.GetPullRequest(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPriorities(ctx)
+		response, err = s.h.GetPullRequest(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7642,7 +10411,7 @@ func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetPrioritiesResponse(response, w, span); err != nil {
+	if err := encodeGetPullRequestResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7651,22 +10420,22 @@ func (s *Server) handleGetPrioritiesRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetProjectRequest handles getProject operation.
+// handleGetPullRequestsRequest handles getPullRequests operation.
 //
-// Get project.
+// Get pull requests.
 //
-// GET /projects/{projectIdOrKey}
-func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests
+func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProject"),
+		otelogen.OperationID("getPullRequests"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPullRequestsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7721,15 +10490,15 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectOperation,
-			ID:   "getProject",
+			Name: GetPullRequestsOperation,
+			ID:   "getPullRequests",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetProjectOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetPullRequestsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7746,7 +10515,7 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetProjectOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetPullRequestsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7787,7 +10556,7 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeGetProjectParams(args, argsEscaped, r)
+	params, err := decodeGetPullRequestsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7800,13 +10569,13 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response *Project
+	var response []PullRequest
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectOperation,
-			OperationSummary: "Get project",
-			OperationID:      "getProject",
+			OperationName:    GetPullRequestsOperation,
+			OperationSummary: "Get pull requests",
+			OperationID:      "getPullRequests",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -7814,14 +10583,42 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 					Name: "projectIdOrKey",
 					In:   "path",
 				}: params.ProjectIdOrKey,
+				{
+					Name: "repoIdOrName",
+					In:   "path",
+				}: params.RepoIdOrName,
+				{
+					Name: "statusId[]",
+					In:   "query",
+				}: params.StatusId,
+				{
+					Name: "assigneeId[]",
+					In:   "query",
+				}: params.AssigneeId,
+				{
+					Name: "issueId[]",
+					In:   "query",
+				}: params.IssueId,
+				{
+					Name: "createdUserId[]",
+					In:   "query",
+				}: params.CreatedUserId,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "count",
+					In:   "query",
+				}: params.Count,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectParams
-			Response = *Project
+			Params   = GetPullRequestsParams
+			Response = []PullRequest
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7830,14 +10627,14 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetProjectParams,
+			unpackGetPullRequestsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProject(ctx, params)
+				response, err = s.h.GetPullRequests(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProject(ctx, params)
+		response, err = s.h.GetPullRequests(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7845,7 +10642,7 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetProjectResponse(response, w, span); err != nil {
+	if err := encodeGetPullRequestsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7854,22 +10651,22 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetProjectUsersRequest handles getProjectUsers operation.
+// handleGetPullRequestsCountRequest handles getPullRequestsCount operation.
 //
-// Get project users.
+// Get pull requests count.
 //
-// GET /projects/{projectIdOrKey}/users
-func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count
+func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProjectUsers"),
+		otelogen.OperationID("getPullRequestsCount"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/users"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPullRequestsCountOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7924,15 +10721,15 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectUsersOperation,
-			ID:   "getProjectUsers",
+			Name: GetPullRequestsCountOperation,
+			ID:   "getPullRequestsCount",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetProjectUsersOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetPullRequestsCountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7949,7 +10746,7 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetProjectUsersOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetPullRequestsCountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7990,7 +10787,7 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetProjectUsersParams(args, argsEscaped, r)
+	params, err := decodeGetPullRequestsCountParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8003,13 +10800,13 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response []User
+	var response *GetPullRequestsCountOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectUsersOperation,
-			OperationSummary: "Get project users",
-			OperationID:      "getProjectUsers",
+			OperationName:    GetPullRequestsCountOperation,
+			OperationSummary: "Get pull requests count",
+			OperationID:      "getPullRequestsCount",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -8017,14 +10814,22 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 					Name: "projectIdOrKey",
 					In:   "path",
 				}: params.ProjectIdOrKey,
+				{
+					Name: "repoIdOrName",
+					In:   "path",
+				}: params.RepoIdOrName,
+				{
+					Name: "statusId[]",
+					In:   "query",
+				}: params.StatusId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectUsersParams
-			Response = []User
+			Params   = GetPullRequestsCountParams
+			Response = *GetPullRequestsCountOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8033,14 +10838,14 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetProjectUsersParams,
+			unpackGetPullRequestsCountParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectUsers(ctx, params)
+				response, err = s.h.GetPullRequestsCount(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectUsers(ctx, params)
+		response, err = s.h.GetPullRequestsCount(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8048,7 +10853,7 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetProjectUsersResponse(response, w, span); err != nil {
+	if err := encodeGetPullRequestsCountResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8057,22 +10862,22 @@ func (s *Server) handleGetProjectUsersRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetProjectsRequest handles getProjects operation.
+// handleGetRepositoriesRequest handles getRepositories operation.
 //
-// Get projects.
+// Get git repositories.
 //
-// GET /projects
-func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/git/repositories
+func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProjects"),
+		otelogen.OperationID("getRepositories"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRepositoriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8127,15 +10932,15 @@ func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectsOperation,
-			ID:   "getProjects",
+			Name: GetRepositoriesOperation,
+			ID:   "getRepositories",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetProjectsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetRepositoriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8152,7 +10957,7 @@ func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetProjectsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetRepositoriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8193,7 +10998,7 @@ func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetProjectsParams(args, argsEscaped, r)
+	params, err := decodeGetRepositoriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8206,28 +11011,28 @@ func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response []Project
+	var response []Repository
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectsOperation,
-			OperationSummary: "Get projects",
-			OperationID:      "getProjects",
+			OperationName:    GetRepositoriesOperation,
+			OperationSummary: "Get git repositories",
+			OperationID:      "getRepositories",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "archived",
-					In:   "query",
-				}: params.Archived,
+					Name: "projectIdOrKey",
+					In:   "path",
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectsParams
-			Response = []Project
+			Params   = GetRepositoriesParams
+			Response = []Repository
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8236,14 +11041,14 @@ func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetProjectsParams,
+			unpackGetRepositoriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjects(ctx, params)
+				response, err = s.h.GetRepositories(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjects(ctx, params)
+		response, err = s.h.GetRepositories(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8251,7 +11056,7 @@ func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetProjectsResponse(response, w, span); err != nil {
+	if err := encodeGetRepositoriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8260,22 +11065,22 @@ func (s *Server) handleGetProjectsRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetPullRequestRequest handles getPullRequest operation.
+// handleGetResolutionsRequest handles getResolutions operation.
 //
-// Get pull request.
+// Get all resolutions.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}
-func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /resolutions
+func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPullRequest"),
+		otelogen.OperationID("getResolutions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/{number}"),
+		semconv.HTTPRouteKey.String("/resolutions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPullRequestOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetResolutionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8330,15 +11135,15 @@ func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPullRequestOperation,
-			ID:   "getPullRequest",
+			Name: GetResolutionsOperation,
+			ID:   "getResolutions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetPullRequestOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetResolutionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8355,7 +11160,7 @@ func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetPullRequestOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetResolutionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8396,49 +11201,26 @@ func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetPullRequestParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response *PullRequest
+	var response []Resolution
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPullRequestOperation,
-			OperationSummary: "Get pull request",
-			OperationID:      "getPullRequest",
+			OperationName:    GetResolutionsOperation,
+			OperationSummary: "Get all resolutions",
+			OperationID:      "getResolutions",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "projectIdOrKey",
-					In:   "path",
-				}: params.ProjectIdOrKey,
-				{
-					Name: "repoIdOrName",
-					In:   "path",
-				}: params.RepoIdOrName,
-				{
-					Name: "number",
-					In:   "path",
-				}: params.Number,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPullRequestParams
-			Response = *PullRequest
+			Params   = struct{}
+			Response = []Resolution
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8447,14 +11229,14 @@ func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetPullRequestParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPullRequest(ctx, params)
+				response, err = s.h.GetResolutions(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPullRequest(ctx, params)
+		response, err = s.h.GetResolutions(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8462,7 +11244,7 @@ func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetPullRequestResponse(response, w, span); err != nil {
+	if err := encodeGetResolutionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8471,22 +11253,22 @@ func (s *Server) handleGetPullRequestRequest(args [3]string, argsEscaped bool, w
 	}
 }
 
-// handleGetPullRequestsRequest handles getPullRequests operation.
+// handleGetSpaceRequest handles getSpace operation.
 //
-// Get pull requests.
+// Get space information.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests
-func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /space
+func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPullRequests"),
+		otelogen.OperationID("getSpace"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests"),
+		semconv.HTTPRouteKey.String("/space"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPullRequestsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSpaceOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8541,15 +11323,15 @@ func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPullRequestsOperation,
-			ID:   "getPullRequests",
+			Name: GetSpaceOperation,
+			ID:   "getSpace",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetPullRequestsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetSpaceOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8566,7 +11348,7 @@ func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetPullRequestsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetSpaceOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8607,69 +11389,26 @@ func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetPullRequestsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response []PullRequest
+	var response *Space
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPullRequestsOperation,
-			OperationSummary: "Get pull requests",
-			OperationID:      "getPullRequests",
+			OperationName:    GetSpaceOperation,
+			OperationSummary: "Get space information",
+			OperationID:      "getSpace",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "projectIdOrKey",
-					In:   "path",
-				}: params.ProjectIdOrKey,
-				{
-					Name: "repoIdOrName",
-					In:   "path",
-				}: params.RepoIdOrName,
-				{
-					Name: "statusId[]",
-					In:   "query",
-				}: params.StatusId,
-				{
-					Name: "assigneeId[]",
-					In:   "query",
-				}: params.AssigneeId,
-				{
-					Name: "issueId[]",
-					In:   "query",
-				}: params.IssueId,
-				{
-					Name: "createdUserId[]",
-					In:   "query",
-				}: params.CreatedUserId,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "count",
-					In:   "query",
-				}: params.Count,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPullRequestsParams
-			Response = []PullRequest
+			Params   = struct{}
+			Response = *Space
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8678,14 +11417,14 @@ func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetPullRequestsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPullRequests(ctx, params)
+				response, err = s.h.GetSpace(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPullRequests(ctx, params)
+		response, err = s.h.GetSpace(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8693,7 +11432,7 @@ func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetPullRequestsResponse(response, w, span); err != nil {
+	if err := encodeGetSpaceResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8702,22 +11441,22 @@ func (s *Server) handleGetPullRequestsRequest(args [2]string, argsEscaped bool,
 	}
 }
 
-// handleGetPullRequestsCountRequest handles getPullRequestsCount operation.
+// handleGetStatusesRequest handles getStatuses operation.
 //
-// Get pull requests count.
+// Get statuses.
 //
-// GET /projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count
-func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/statuses
+func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPullRequestsCount"),
+		otelogen.OperationID("getStatuses"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories/{repoIdOrName}/pullRequests/count"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/statuses"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPullRequestsCountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStatusesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8772,15 +11511,15 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPullRequestsCountOperation,
-			ID:   "getPullRequestsCount",
+			Name: GetStatusesOperation,
+			ID:   "getStatuses",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetPullRequestsCountOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetStatusesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8797,7 +11536,7 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetPullRequestsCountOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetStatusesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8838,7 +11577,7 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetPullRequestsCountParams(args, argsEscaped, r)
+	params, err := decodeGetStatusesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8851,13 +11590,13 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 
 	var rawBody []byte
 
-	var response *GetPullRequestsCountOK
+	var response []Status
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPullRequestsCountOperation,
-			OperationSummary: "Get pull requests count",
-			OperationID:      "getPullRequestsCount",
+			OperationName:    GetStatusesOperation,
+			OperationSummary: "Get statuses",
+			OperationID:      "getStatuses",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -8865,22 +11604,14 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 					Name: "projectIdOrKey",
 					In:   "path",
 				}: params.ProjectIdOrKey,
-				{
-					Name: "repoIdOrName",
-					In:   "path",
-				}: params.RepoIdOrName,
-				{
-					Name: "statusId[]",
-					In:   "query",
-				}: params.StatusId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPullRequestsCountParams
-			Response = *GetPullRequestsCountOK
+			Params   = GetStatusesParams
+			Response = []Status
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8889,14 +11620,14 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetPullRequestsCountParams,
+			unpackGetStatusesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPullRequestsCount(ctx, params)
+				response, err = s.h.GetStatuses(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPullRequestsCount(ctx, params)
+		response, err = s.h.GetStatuses(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8904,7 +11635,7 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetPullRequestsCountResponse(response, w, span); err != nil {
+	if err := encodeGetStatusesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8913,22 +11644,22 @@ func (s *Server) handleGetPullRequestsCountRequest(args [2]string, argsEscaped b
 	}
 }
 
-// handleGetRepositoriesRequest handles getRepositories operation.
+// handleGetUserRequest handles getUser operation.
 //
-// Get git repositories.
+// Get user.
 //
-// GET /projects/{projectIdOrKey}/git/repositories
-func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users/{userId}
+func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRepositories"),
+		otelogen.OperationID("getUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/git/repositories"),
+		semconv.HTTPRouteKey.String("/users/{userId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRepositoriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8983,15 +11714,15 @@ func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetRepositoriesOperation,
-			ID:   "getRepositories",
+			Name: GetUserOperation,
+			ID:   "getUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetRepositoriesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9008,7 +11739,7 @@ func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetRepositoriesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9049,7 +11780,7 @@ func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetRepositoriesParams(args, argsEscaped, r)
+	params, err := decodeGetUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9062,28 +11793,28 @@ func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response []Repository
+	var response *User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetRepositoriesOperation,
-			OperationSummary: "Get git repositories",
-			OperationID:      "getRepositories",
+			OperationName:    GetUserOperation,
+			OperationSummary: "Get user",
+			OperationID:      "getUser",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectIdOrKey",
+					Name: "userId",
 					In:   "path",
-				}: params.ProjectIdOrKey,
+				}: params.UserId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRepositoriesParams
-			Response = []Repository
+			Params   = GetUserParams
+			Response = *User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9092,14 +11823,14 @@ func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetRepositoriesParams,
+			unpackGetUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRepositories(ctx, params)
+				response, err = s.h.GetUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRepositories(ctx, params)
+		response, err = s.h.GetUser(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9107,7 +11838,7 @@ func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetRepositoriesResponse(response, w, span); err != nil {
+	if err := encodeGetUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9116,22 +11847,22 @@ func (s *Server) handleGetRepositoriesRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetResolutionsRequest handles getResolutions operation.
+// handleGetUserRecentUpdatesRequest handles getUserRecentUpdates operation.
 //
-// Get all resolutions.
+// Get user recent updates.
 //
-// GET /resolutions
-func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users/{userId}/activities
+func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getResolutions"),
+		otelogen.OperationID("getUserRecentUpdates"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/resolutions"),
+		semconv.HTTPRouteKey.String("/users/{userId}/activities"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetResolutionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserRecentUpdatesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9186,15 +11917,15 @@ func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetResolutionsOperation,
-			ID:   "getResolutions",
+			Name: GetUserRecentUpdatesOperation,
+			ID:   "getUserRecentUpdates",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetResolutionsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetUserRecentUpdatesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9211,7 +11942,7 @@ func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetResolutionsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetUserRecentUpdatesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9252,26 +11983,61 @@ func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeGetUserRecentUpdatesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []Resolution
+	var response []Activity
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetResolutionsOperation,
-			OperationSummary: "Get all resolutions",
-			OperationID:      "getResolutions",
+			OperationName:    GetUserRecentUpdatesOperation,
+			OperationSummary: "Get user recent updates",
+			OperationID:      "getUserRecentUpdates",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
+				{
+					Name: "activityTypeId[]",
+					In:   "query",
+				}: params.ActivityTypeId,
+				{
+					Name: "minId",
+					In:   "query",
+				}: params.MinId,
+				{
+					Name: "maxId",
+					In:   "query",
+				}: params.MaxId,
+				{
+					Name: "count",
+					In:   "query",
+				}: params.Count,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []Resolution
+			Params   = GetUserRecentUpdatesParams
+			Response = []Activity
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9280,14 +12046,14 @@ func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetUserRecentUpdatesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetResolutions(ctx)
+				response, err = s.h.GetUserRecentUpdates(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetResolutions(ctx)
+		response, err = s.h.GetUserRecentUpdates(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9295,7 +12061,7 @@ func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetResolutionsResponse(response, w, span); err != nil {
+	if err := encodeGetUserRecentUpdatesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9304,22 +12070,22 @@ func (s *Server) handleGetResolutionsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetSpaceRequest handles getSpace operation.
+// handleGetUsersRequest handles getUsers operation.
 //
-// Get space information.
+// Get all users.
 //
-// GET /space
-func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users
+func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSpace"),
+		otelogen.OperationID("getUsers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/space"),
+		semconv.HTTPRouteKey.String("/users"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSpaceOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9374,15 +12140,15 @@ func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetSpaceOperation,
-			ID:   "getSpace",
+			Name: GetUsersOperation,
+			ID:   "getUsers",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetSpaceOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9399,7 +12165,7 @@ func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetSpaceOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9443,13 +12209,13 @@ func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.
 
 	var rawBody []byte
 
-	var response *Space
+	var response []User
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetSpaceOperation,
-			OperationSummary: "Get space information",
-			OperationID:      "getSpace",
+			OperationName:    GetUsersOperation,
+			OperationSummary: "Get all users",
+			OperationID:      "getUsers",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -9459,7 +12225,7 @@ func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = *Space
+			Response = []User
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9470,12 +12236,12 @@ func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetSpace(ctx)
+				response, err = s.h.GetUsers(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetSpace(ctx)
+		response, err = s.h.GetUsers(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9483,7 +12249,7 @@ func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetSpaceResponse(response, w, span); err != nil {
+	if err := encodeGetUsersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9492,22 +12258,22 @@ func (s *Server) handleGetSpaceRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetStatusesRequest handles getStatuses operation.
+// handleGetVersionsRequest handles getVersions operation.
 //
-// Get statuses.
+// Get versions.
 //
-// GET /projects/{projectIdOrKey}/statuses
-func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/versions
+func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getStatuses"),
+		otelogen.OperationID("getVersions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/statuses"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/versions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStatusesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVersionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9562,15 +12328,15 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetStatusesOperation,
-			ID:   "getStatuses",
+			Name: GetVersionsOperation,
+			ID:   "getVersions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetStatusesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetVersionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9587,7 +12353,7 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetStatusesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetVersionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9628,7 +12394,7 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetStatusesParams(args, argsEscaped, r)
+	params, err := decodeGetVersionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9641,13 +12407,13 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response []Status
+	var response []Version
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetStatusesOperation,
-			OperationSummary: "Get statuses",
-			OperationID:      "getStatuses",
+			OperationName:    GetVersionsOperation,
+			OperationSummary: "Get versions",
+			OperationID:      "getVersions",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -9661,8 +12427,8 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 
 		type (
 			Request  = struct{}
-			Params   = GetStatusesParams
-			Response = []Status
+			Params   = GetVersionsParams
+			Response = []Version
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9671,14 +12437,14 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetStatusesParams,
+			unpackGetVersionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetStatuses(ctx, params)
+				response, err = s.h.GetVersions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetStatuses(ctx, params)
+		response, err = s.h.GetVersions(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9686,7 +12452,7 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetStatusesResponse(response, w, span); err != nil {
+	if err := encodeGetVersionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9695,22 +12461,22 @@ func (s *Server) handleGetStatusesRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetUserRequest handles getUser operation.
+// handleGetWebhooksRequest handles getWebhooks operation.
 //
-// Get user.
+// Get webhooks.
 //
-// GET /users/{userId}
-func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /projects/{projectIdOrKey}/webhooks
+func (s *Server) handleGetWebhooksRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUser"),
+		otelogen.OperationID("getWebhooks"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/{userId}"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/webhooks"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWebhooksOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9765,15 +12531,15 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserOperation,
-			ID:   "getUser",
+			Name: GetWebhooksOperation,
+			ID:   "getWebhooks",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetWebhooksOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9790,7 +12556,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetWebhooksOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9831,7 +12597,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetUserParams(args, argsEscaped, r)
+	params, err := decodeGetWebhooksParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9844,28 +12610,28 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 
 	var rawBody []byte
 
-	var response *User
+	var response []Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserOperation,
-			OperationSummary: "Get user",
-			OperationID:      "getUser",
+			OperationName:    GetWebhooksOperation,
+			OperationSummary: "Get webhooks",
+			OperationID:      "getWebhooks",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "userId",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.UserId,
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserParams
-			Response = *User
+			Params   = GetWebhooksParams
+			Response = []Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9874,14 +12640,14 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetUserParams,
+			unpackGetWebhooksParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUser(ctx, params)
+				response, err = s.h.GetWebhooks(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUser(ctx, params)
+		response, err = s.h.GetWebhooks(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9889,7 +12655,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetUserResponse(response, w, span); err != nil {
+	if err := encodeGetWebhooksResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9898,22 +12664,22 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetUserRecentUpdatesRequest handles getUserRecentUpdates operation.
+// handleGetWikiRequest handles getWiki operation.
 //
-// Get user recent updates.
+// Get wiki.
 //
-// GET /users/{userId}/activities
-func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /wikis/{wikiId}
+func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserRecentUpdates"),
+		otelogen.OperationID("getWiki"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/{userId}/activities"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserRecentUpdatesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9968,15 +12734,15 @@ func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserRecentUpdatesOperation,
-			ID:   "getUserRecentUpdates",
+			Name: GetWikiOperation,
+			ID:   "getWiki",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetUserRecentUpdatesOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9993,7 +12759,7 @@ func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetUserRecentUpdatesOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10034,7 +12800,7 @@ func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetUserRecentUpdatesParams(args, argsEscaped, r)
+	params, err := decodeGetWikiParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10047,48 +12813,28 @@ func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped b
 
 	var rawBody []byte
 
-	var response []Activity
+	var response *Wiki
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserRecentUpdatesOperation,
-			OperationSummary: "Get user recent updates",
-			OperationID:      "getUserRecentUpdates",
+			OperationName:    GetWikiOperation,
+			OperationSummary: "Get wiki",
+			OperationID:      "getWiki",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "userId",
+					Name: "wikiId",
 					In:   "path",
-				}: params.UserId,
-				{
-					Name: "activityTypeId[]",
-					In:   "query",
-				}: params.ActivityTypeId,
-				{
-					Name: "minId",
-					In:   "query",
-				}: params.MinId,
-				{
-					Name: "maxId",
-					In:   "query",
-				}: params.MaxId,
-				{
-					Name: "count",
-					In:   "query",
-				}: params.Count,
-				{
-					Name: "order",
-					In:   "query",
-				}: params.Order,
+				}: params.WikiId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserRecentUpdatesParams
-			Response = []Activity
+			Params   = GetWikiParams
+			Response = *Wiki
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10097,14 +12843,14 @@ func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetUserRecentUpdatesParams,
+			unpackGetWikiParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserRecentUpdates(ctx, params)
+				response, err = s.h.GetWiki(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserRecentUpdates(ctx, params)
+		response, err = s.h.GetWiki(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10112,7 +12858,7 @@ func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetUserRecentUpdatesResponse(response, w, span); err != nil {
+	if err := encodeGetWikiResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10121,22 +12867,22 @@ func (s *Server) handleGetUserRecentUpdatesRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetUsersRequest handles getUsers operation.
+// handleGetWikiTagsRequest handles getWikiTags operation.
 //
-// Get all users.
+// Get wiki page tag list.
 //
-// GET /users
-func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /wikis/tags
+func (s *Server) handleGetWikiTagsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUsers"),
+		otelogen.OperationID("getWikiTags"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users"),
+		semconv.HTTPRouteKey.String("/wikis/tags"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWikiTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10191,15 +12937,15 @@ func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUsersOperation,
-			ID:   "getUsers",
+			Name: GetWikiTagsOperation,
+			ID:   "getWikiTags",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetUsersOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetWikiTagsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10216,7 +12962,7 @@ func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetUsersOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetWikiTagsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10257,26 +13003,41 @@ func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.
 			return
 		}
 	}
+	params, err := decodeGetWikiTagsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []User
+	var response []WikiTag
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUsersOperation,
-			OperationSummary: "Get all users",
-			OperationID:      "getUsers",
+			OperationName:    GetWikiTagsOperation,
+			OperationSummary: "Get wiki page tag list",
+			OperationID:      "getWikiTags",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "projectIdOrKey",
+					In:   "query",
+				}: params.ProjectIdOrKey,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []User
+			Params   = GetWikiTagsParams
+			Response = []WikiTag
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10285,14 +13046,14 @@ func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetWikiTagsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUsers(ctx)
+				response, err = s.h.GetWikiTags(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUsers(ctx)
+		response, err = s.h.GetWikiTags(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10300,7 +13061,7 @@ func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetUsersResponse(response, w, span); err != nil {
+	if err := encodeGetWikiTagsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10309,22 +13070,22 @@ func (s *Server) handleGetUsersRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetVersionsRequest handles getVersions operation.
+// handleGetWikisRequest handles getWikis operation.
 //
-// Get versions.
+// Get wikis.
 //
-// GET /projects/{projectIdOrKey}/versions
-func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /wikis
+func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getVersions"),
+		otelogen.OperationID("getWikis"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/versions"),
+		semconv.HTTPRouteKey.String("/wikis"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVersionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWikisOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10379,15 +13140,15 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetVersionsOperation,
-			ID:   "getVersions",
+			Name: GetWikisOperation,
+			ID:   "getWikis",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetVersionsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetWikisOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10404,7 +13165,7 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetVersionsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetWikisOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10445,7 +13206,7 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetVersionsParams(args, argsEscaped, r)
+	params, err := decodeGetWikisParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10458,19 +13219,19 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response []Version
+	var response []Wiki
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetVersionsOperation,
-			OperationSummary: "Get versions",
-			OperationID:      "getVersions",
+			OperationName:    GetWikisOperation,
+			OperationSummary: "Get wikis",
+			OperationID:      "getWikis",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
 					Name: "projectIdOrKey",
-					In:   "path",
+					In:   "query",
 				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
@@ -10478,8 +13239,8 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 
 		type (
 			Request  = struct{}
-			Params   = GetVersionsParams
-			Response = []Version
+			Params   = GetWikisParams
+			Response = []Wiki
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10488,14 +13249,14 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetVersionsParams,
+			unpackGetWikisParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetVersions(ctx, params)
+				response, err = s.h.GetWikis(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetVersions(ctx, params)
+		response, err = s.h.GetWikis(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10503,7 +13264,7 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetVersionsResponse(response, w, span); err != nil {
+	if err := encodeGetWikisResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10512,22 +13273,22 @@ func (s *Server) handleGetVersionsRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetWikiRequest handles getWiki operation.
+// handleGetWikisCountRequest handles getWikisCount operation.
 //
-// Get wiki.
+// Get wikis count.
 //
-// GET /wikis/{wikiId}
-func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /wikis/count
+func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWiki"),
+		otelogen.OperationID("getWikisCount"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}"),
+		semconv.HTTPRouteKey.String("/wikis/count"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWikiOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWikisCountOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10582,15 +13343,15 @@ func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWikiOperation,
-			ID:   "getWiki",
+			Name: GetWikisCountOperation,
+			ID:   "getWikisCount",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetWikiOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, GetWikisCountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10607,7 +13368,7 @@ func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetWikiOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, GetWikisCountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10648,7 +13409,7 @@ func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetWikiParams(args, argsEscaped, r)
+	params, err := decodeGetWikisCountParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10661,28 +13422,28 @@ func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.R
 
 	var rawBody []byte
 
-	var response *Wiki
+	var response *GetWikisCountOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWikiOperation,
-			OperationSummary: "Get wiki",
-			OperationID:      "getWiki",
+			OperationName:    GetWikisCountOperation,
+			OperationSummary: "Get wikis count",
+			OperationID:      "getWikisCount",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
-					In:   "path",
-				}: params.WikiId,
+					Name: "projectIdOrKey",
+					In:   "query",
+				}: params.ProjectIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetWikiParams
-			Response = *Wiki
+			Params   = GetWikisCountParams
+			Response = *GetWikisCountOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10691,14 +13452,14 @@ func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetWikiParams,
+			unpackGetWikisCountParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWiki(ctx, params)
+				response, err = s.h.GetWikisCount(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWiki(ctx, params)
+		response, err = s.h.GetWikisCount(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10706,7 +13467,7 @@ func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetWikiResponse(response, w, span); err != nil {
+	if err := encodeGetWikisCountResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10715,22 +13476,22 @@ func (s *Server) handleGetWikiRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetWikisRequest handles getWikis operation.
+// handleLinkSharedFilesToIssueRequest handles linkSharedFilesToIssue operation.
 //
-// Get wikis.
+// Link shared files to issue.
 //
-// GET /wikis
-func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /issues/{issueIdOrKey}/sharedFiles
+func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWikis"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/wikis"),
+		otelogen.OperationID("linkSharedFilesToIssue"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/sharedFiles"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWikisOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LinkSharedFilesToIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10785,15 +13546,15 @@ func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWikisOperation,
-			ID:   "getWikis",
+			Name: LinkSharedFilesToIssueOperation,
+			ID:   "linkSharedFilesToIssue",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetWikisOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, LinkSharedFilesToIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10810,7 +13571,7 @@ func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetWikisOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, LinkSharedFilesToIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10851,7 +13612,7 @@ func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeGetWikisParams(args, argsEscaped, r)
+	params, err := decodeLinkSharedFilesToIssueParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10863,29 +13624,44 @@ func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeLinkSharedFilesToIssueRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []Wiki
+	var response []SharedFile
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWikisOperation,
-			OperationSummary: "Get wikis",
-			OperationID:      "getWikis",
-			Body:             nil,
+			OperationName:    LinkSharedFilesToIssueOperation,
+			OperationSummary: "Link shared files to issue",
+			OperationID:      "linkSharedFilesToIssue",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectIdOrKey",
-					In:   "query",
-				}: params.ProjectIdOrKey,
+					Name: "issueIdOrKey",
+					In:   "path",
+				}: params.IssueIdOrKey,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetWikisParams
-			Response = []Wiki
+			Request  = OptLinkSharedFilesToIssueReq
+			Params   = LinkSharedFilesToIssueParams
+			Response = []SharedFile
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10894,14 +13670,14 @@ func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetWikisParams,
+			unpackLinkSharedFilesToIssueParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWikis(ctx, params)
+				response, err = s.h.LinkSharedFilesToIssue(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWikis(ctx, params)
+		response, err = s.h.LinkSharedFilesToIssue(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10909,7 +13685,7 @@ func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetWikisResponse(response, w, span); err != nil {
+	if err := encodeLinkSharedFilesToIssueResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10918,22 +13694,22 @@ func (s *Server) handleGetWikisRequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetWikisCountRequest handles getWikisCount operation.
+// handleLinkSharedFilesToWikiRequest handles linkSharedFilesToWiki operation.
 //
-// Get wikis count.
+// Link shared files to wiki.
 //
-// GET /wikis/count
-func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /wikis/{wikiId}/sharedFiles
+func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWikisCount"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/wikis/count"),
+		otelogen.OperationID("linkSharedFilesToWiki"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}/sharedFiles"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWikisCountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LinkSharedFilesToWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10988,15 +13764,15 @@ func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWikisCountOperation,
-			ID:   "getWikisCount",
+			Name: LinkSharedFilesToWikiOperation,
+			ID:   "linkSharedFilesToWiki",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, GetWikisCountOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, LinkSharedFilesToWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11013,7 +13789,7 @@ func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, GetWikisCountOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, LinkSharedFilesToWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11054,7 +13830,7 @@ func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetWikisCountParams(args, argsEscaped, r)
+	params, err := decodeLinkSharedFilesToWikiParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11066,29 +13842,44 @@ func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeLinkSharedFilesToWikiRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *GetWikisCountOK
+	var response []SharedFile
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWikisCountOperation,
-			OperationSummary: "Get wikis count",
-			OperationID:      "getWikisCount",
-			Body:             nil,
+			OperationName:    LinkSharedFilesToWikiOperation,
+			OperationSummary: "Link shared files to wiki",
+			OperationID:      "linkSharedFilesToWiki",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "projectIdOrKey",
-					In:   "query",
-				}: params.ProjectIdOrKey,
+					Name: "wikiId",
+					In:   "path",
+				}: params.WikiId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetWikisCountParams
-			Response = *GetWikisCountOK
+			Request  = OptLinkSharedFilesToWikiReq
+			Params   = LinkSharedFilesToWikiParams
+			Response = []SharedFile
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11097,14 +13888,14 @@ func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetWikisCountParams,
+			unpackLinkSharedFilesToWikiParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWikisCount(ctx, params)
+				response, err = s.h.LinkSharedFilesToWiki(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWikisCount(ctx, params)
+		response, err = s.h.LinkSharedFilesToWiki(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11112,7 +13903,7 @@ func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetWikisCountResponse(response, w, span); err != nil {
+	if err := encodeLinkSharedFilesToWikiResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11121,22 +13912,22 @@ func (s *Server) handleGetWikisCountRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleLinkSharedFilesToIssueRequest handles linkSharedFilesToIssue operation.
+// handleRemoveDocumentTagsRequest handles removeDocumentTags operation.
 //
-// Link shared files to issue.
+// Remove document tags.
 //
-// POST /issues/{issueIdOrKey}/sharedFiles
-func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /documents/{documentId}/tags
+func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("linkSharedFilesToIssue"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/sharedFiles"),
+		otelogen.OperationID("removeDocumentTags"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/documents/{documentId}/tags"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LinkSharedFilesToIssueOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveDocumentTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11191,15 +13982,15 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LinkSharedFilesToIssueOperation,
-			ID:   "linkSharedFilesToIssue",
+			Name: RemoveDocumentTagsOperation,
+			ID:   "removeDocumentTags",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, LinkSharedFilesToIssueOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, RemoveDocumentTagsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11216,7 +14007,7 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, LinkSharedFilesToIssueOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, RemoveDocumentTagsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11257,7 +14048,7 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeLinkSharedFilesToIssueParams(args, argsEscaped, r)
+	params, err := decodeRemoveDocumentTagsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11269,7 +14060,7 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeLinkSharedFilesToIssueRequest(r)
+	request, rawBody, close, err := s.decodeRemoveDocumentTagsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11285,28 +14076,28 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 		}
 	}()
 
-	var response []SharedFile
+	var response []DocumentTag
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LinkSharedFilesToIssueOperation,
-			OperationSummary: "Link shared files to issue",
-			OperationID:      "linkSharedFilesToIssue",
+			OperationName:    RemoveDocumentTagsOperation,
+			OperationSummary: "Remove document tags",
+			OperationID:      "removeDocumentTags",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "issueIdOrKey",
+					Name: "documentId",
 					In:   "path",
-				}: params.IssueIdOrKey,
+				}: params.DocumentId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptLinkSharedFilesToIssueReq
-			Params   = LinkSharedFilesToIssueParams
-			Response = []SharedFile
+			Request  = OptRemoveDocumentTagsReq
+			Params   = RemoveDocumentTagsParams
+			Response = []DocumentTag
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11315,14 +14106,14 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackLinkSharedFilesToIssueParams,
+			unpackRemoveDocumentTagsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.LinkSharedFilesToIssue(ctx, request, params)
+				response, err = s.h.RemoveDocumentTags(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.LinkSharedFilesToIssue(ctx, request, params)
+		response, err = s.h.RemoveDocumentTags(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11330,7 +14121,7 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeLinkSharedFilesToIssueResponse(response, w, span); err != nil {
+	if err := encodeRemoveDocumentTagsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11339,22 +14130,22 @@ func (s *Server) handleLinkSharedFilesToIssueRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleLinkSharedFilesToWikiRequest handles linkSharedFilesToWiki operation.
+// handleRemoveLinkToSharedFileFromIssueRequest handles removeLinkToSharedFileFromIssue operation.
 //
-// Link shared files to wiki.
+// Unlink shared file from issue.
 //
-// POST /wikis/{wikiId}/sharedFiles
-func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /issues/{issueIdOrKey}/sharedFiles/{id}
+func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("linkSharedFilesToWiki"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}/sharedFiles"),
+		otelogen.OperationID("removeLinkToSharedFileFromIssue"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/sharedFiles/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LinkSharedFilesToWikiOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveLinkToSharedFileFromIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11409,15 +14200,15 @@ func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LinkSharedFilesToWikiOperation,
-			ID:   "linkSharedFilesToWiki",
+			Name: RemoveLinkToSharedFileFromIssueOperation,
+			ID:   "removeLinkToSharedFileFromIssue",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, LinkSharedFilesToWikiOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, RemoveLinkToSharedFileFromIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11434,7 +14225,7 @@ func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, LinkSharedFilesToWikiOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, RemoveLinkToSharedFileFromIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11475,7 +14266,7 @@ func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeLinkSharedFilesToWikiParams(args, argsEscaped, r)
+	params, err := decodeRemoveLinkToSharedFileFromIssueParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11487,44 +14278,33 @@ func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeLinkSharedFilesToWikiRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response []SharedFile
+	var response *SharedFile
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LinkSharedFilesToWikiOperation,
-			OperationSummary: "Link shared files to wiki",
-			OperationID:      "linkSharedFilesToWiki",
-			Body:             request,
+			OperationName:    RemoveLinkToSharedFileFromIssueOperation,
+			OperationSummary: "Unlink shared file from issue",
+			OperationID:      "removeLinkToSharedFileFromIssue",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
+					Name: "issueIdOrKey",
 					In:   "path",
-				}: params.WikiId,
+				}: params.IssueIdOrKey,
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptLinkSharedFilesToWikiReq
-			Params   = LinkSharedFilesToWikiParams
-			Response = []SharedFile
+			Request  = struct{}
+			Params   = RemoveLinkToSharedFileFromIssueParams
+			Response = *SharedFile
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11533,14 +14313,14 @@ func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackLinkSharedFilesToWikiParams,
+			unpackRemoveLinkToSharedFileFromIssueParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.LinkSharedFilesToWiki(ctx, request, params)
+				response, err = s.h.RemoveLinkToSharedFileFromIssue(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.LinkSharedFilesToWiki(ctx, request, params)
+		response, err = s.h.RemoveLinkToSharedFileFromIssue(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11548,7 +14328,7 @@ func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeLinkSharedFilesToWikiResponse(response, w, span); err != nil {
+	if err := encodeRemoveLinkToSharedFileFromIssueResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11557,22 +14337,22 @@ func (s *Server) handleLinkSharedFilesToWikiRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleRemoveDocumentTagsRequest handles removeDocumentTags operation.
+// handleRemoveLinkToSharedFileFromWikiRequest handles removeLinkToSharedFileFromWiki operation.
 //
-// Remove document tags.
+// Unlink shared file from wiki.
 //
-// DELETE /documents/{documentId}/tags
-func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /wikis/{wikiId}/sharedFiles/{id}
+func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeDocumentTags"),
+		otelogen.OperationID("removeLinkToSharedFileFromWiki"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/documents/{documentId}/tags"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}/sharedFiles/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveDocumentTagsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveLinkToSharedFileFromWikiOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11627,15 +14407,15 @@ func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveDocumentTagsOperation,
-			ID:   "removeDocumentTags",
+			Name: RemoveLinkToSharedFileFromWikiOperation,
+			ID:   "removeLinkToSharedFileFromWiki",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, RemoveDocumentTagsOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, RemoveLinkToSharedFileFromWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11652,7 +14432,7 @@ func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, RemoveDocumentTagsOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, RemoveLinkToSharedFileFromWikiOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11693,7 +14473,7 @@ func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeRemoveDocumentTagsParams(args, argsEscaped, r)
+	params, err := decodeRemoveLinkToSharedFileFromWikiParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11705,44 +14485,33 @@ func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped boo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemoveDocumentTagsRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response []DocumentTag
+	var response *SharedFile
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveDocumentTagsOperation,
-			OperationSummary: "Remove document tags",
-			OperationID:      "removeDocumentTags",
-			Body:             request,
+			OperationName:    RemoveLinkToSharedFileFromWikiOperation,
+			OperationSummary: "Unlink shared file from wiki",
+			OperationID:      "removeLinkToSharedFileFromWiki",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "documentId",
+					Name: "wikiId",
 					In:   "path",
-				}: params.DocumentId,
+				}: params.WikiId,
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptRemoveDocumentTagsReq
-			Params   = RemoveDocumentTagsParams
-			Response = []DocumentTag
+			Request  = struct{}
+			Params   = RemoveLinkToSharedFileFromWikiParams
+			Response = *SharedFile
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11751,14 +14520,14 @@ func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackRemoveDocumentTagsParams,
+			unpackRemoveLinkToSharedFileFromWikiParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveDocumentTags(ctx, request, params)
+				response, err = s.h.RemoveLinkToSharedFileFromWiki(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveDocumentTags(ctx, request, params)
+		response, err = s.h.RemoveLinkToSharedFileFromWiki(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11766,7 +14535,7 @@ func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeRemoveDocumentTagsResponse(response, w, span); err != nil {
+	if err := encodeRemoveLinkToSharedFileFromWikiResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11775,22 +14544,22 @@ func (s *Server) handleRemoveDocumentTagsRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleRemoveLinkToSharedFileFromIssueRequest handles removeLinkToSharedFileFromIssue operation.
+// handleRemoveWikiAttachmentRequest handles removeWikiAttachment operation.
 //
-// Unlink shared file from issue.
+// Delete wiki attachment.
 //
-// DELETE /issues/{issueIdOrKey}/sharedFiles/{id}
-func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /wikis/{wikiId}/attachments/{attachmentId}
+func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeLinkToSharedFileFromIssue"),
+		otelogen.OperationID("removeWikiAttachment"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/sharedFiles/{id}"),
+		semconv.HTTPRouteKey.String("/wikis/{wikiId}/attachments/{attachmentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveLinkToSharedFileFromIssueOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveWikiAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11845,15 +14614,15 @@ func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, ar
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveLinkToSharedFileFromIssueOperation,
-			ID:   "removeLinkToSharedFileFromIssue",
+			Name: RemoveWikiAttachmentOperation,
+			ID:   "removeWikiAttachment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, RemoveLinkToSharedFileFromIssueOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, RemoveWikiAttachmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11870,7 +14639,7 @@ func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, ar
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, RemoveLinkToSharedFileFromIssueOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, RemoveWikiAttachmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11911,7 +14680,7 @@ func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, ar
 			return
 		}
 	}
-	params, err := decodeRemoveLinkToSharedFileFromIssueParams(args, argsEscaped, r)
+	params, err := decodeRemoveWikiAttachmentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11924,32 +14693,32 @@ func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, ar
 
 	var rawBody []byte
 
-	var response *SharedFile
+	var response *Attachment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveLinkToSharedFileFromIssueOperation,
-			OperationSummary: "Unlink shared file from issue",
-			OperationID:      "removeLinkToSharedFileFromIssue",
+			OperationName:    RemoveWikiAttachmentOperation,
+			OperationSummary: "Delete wiki attachment",
+			OperationID:      "removeWikiAttachment",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "issueIdOrKey",
+					Name: "wikiId",
 					In:   "path",
-				}: params.IssueIdOrKey,
+				}: params.WikiId,
 				{
-					Name: "id",
+					Name: "attachmentId",
 					In:   "path",
-				}: params.ID,
+				}: params.AttachmentId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = RemoveLinkToSharedFileFromIssueParams
-			Response = *SharedFile
+			Params   = RemoveWikiAttachmentParams
+			Response = *Attachment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11958,14 +14727,14 @@ func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, ar
 		](
 			m,
 			mreq,
-			unpackRemoveLinkToSharedFileFromIssueParams,
+			unpackRemoveWikiAttachmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveLinkToSharedFileFromIssue(ctx, params)
+				response, err = s.h.RemoveWikiAttachment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveLinkToSharedFileFromIssue(ctx, params)
+		response, err = s.h.RemoveWikiAttachment(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11973,7 +14742,7 @@ func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, ar
 		return
 	}
 
-	if err := encodeRemoveLinkToSharedFileFromIssueResponse(response, w, span); err != nil {
+	if err := encodeRemoveWikiAttachmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11982,22 +14751,22 @@ func (s *Server) handleRemoveLinkToSharedFileFromIssueRequest(args [2]string, ar
 	}
 }
 
-// handleRemoveLinkToSharedFileFromWikiRequest handles removeLinkToSharedFileFromWiki operation.
+// handleUpdateCommentRequest handles updateComment operation.
 //
-// Unlink shared file from wiki.
+// Update comment.
 //
-// DELETE /wikis/{wikiId}/sharedFiles/{id}
-func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /issues/{issueIdOrKey}/comments/{commentId}
+func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeLinkToSharedFileFromWiki"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}/sharedFiles/{id}"),
+		otelogen.OperationID("updateComment"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveLinkToSharedFileFromWikiOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12052,15 +14821,15 @@ func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, arg
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveLinkToSharedFileFromWikiOperation,
-			ID:   "removeLinkToSharedFileFromWiki",
+			Name: UpdateCommentOperation,
+			ID:   "updateComment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, RemoveLinkToSharedFileFromWikiOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, UpdateCommentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12077,7 +14846,7 @@ func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, arg
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, RemoveLinkToSharedFileFromWikiOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, UpdateCommentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12118,7 +14887,7 @@ func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, arg
 			return
 		}
 	}
-	params, err := decodeRemoveLinkToSharedFileFromWikiParams(args, argsEscaped, r)
+	params, err := decodeUpdateCommentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12130,33 +14899,48 @@ func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, arg
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeUpdateCommentRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *SharedFile
+	var response *Comment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveLinkToSharedFileFromWikiOperation,
-			OperationSummary: "Unlink shared file from wiki",
-			OperationID:      "removeLinkToSharedFileFromWiki",
-			Body:             nil,
+			OperationName:    UpdateCommentOperation,
+			OperationSummary: "Update comment",
+			OperationID:      "updateComment",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
+					Name: "issueIdOrKey",
 					In:   "path",
-				}: params.WikiId,
+				}: params.IssueIdOrKey,
 				{
-					Name: "id",
+					Name: "commentId",
 					In:   "path",
-				}: params.ID,
+				}: params.CommentId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = RemoveLinkToSharedFileFromWikiParams
-			Response = *SharedFile
+			Request  = OptUpdateCommentReq
+			Params   = UpdateCommentParams
+			Response = *Comment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12165,14 +14949,14 @@ func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, arg
 		](
 			m,
 			mreq,
-			unpackRemoveLinkToSharedFileFromWikiParams,
+			unpackUpdateCommentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveLinkToSharedFileFromWiki(ctx, params)
+				response, err = s.h.UpdateComment(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveLinkToSharedFileFromWiki(ctx, params)
+		response, err = s.h.UpdateComment(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12180,7 +14964,7 @@ func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, arg
 		return
 	}
 
-	if err := encodeRemoveLinkToSharedFileFromWikiResponse(response, w, span); err != nil {
+	if err := encodeUpdateCommentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12189,22 +14973,22 @@ func (s *Server) handleRemoveLinkToSharedFileFromWikiRequest(args [2]string, arg
 	}
 }
 
-// handleRemoveWikiAttachmentRequest handles removeWikiAttachment operation.
+// handleUpdateCustomFieldRequest handles updateCustomField operation.
 //
-// Delete wiki attachment.
+// Update custom field.
 //
-// DELETE /wikis/{wikiId}/attachments/{attachmentId}
-func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /projects/{projectIdOrKey}/customFields/{customFieldId}
+func (s *Server) handleUpdateCustomFieldRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeWikiAttachment"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/wikis/{wikiId}/attachments/{attachmentId}"),
+		otelogen.OperationID("updateCustomField"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/customFields/{customFieldId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveWikiAttachmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateCustomFieldOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12259,15 +15043,15 @@ func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveWikiAttachmentOperation,
-			ID:   "removeWikiAttachment",
+			Name: UpdateCustomFieldOperation,
+			ID:   "updateCustomField",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, RemoveWikiAttachmentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, UpdateCustomFieldOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12284,7 +15068,7 @@ func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, RemoveWikiAttachmentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, UpdateCustomFieldOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12325,7 +15109,7 @@ func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeRemoveWikiAttachmentParams(args, argsEscaped, r)
+	params, err := decodeUpdateCustomFieldParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12337,33 +15121,48 @@ func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped b
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeUpdateCustomFieldRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *Attachment
+	var response *CustomField
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveWikiAttachmentOperation,
-			OperationSummary: "Delete wiki attachment",
-			OperationID:      "removeWikiAttachment",
-			Body:             nil,
+			OperationName:    UpdateCustomFieldOperation,
+			OperationSummary: "Update custom field",
+			OperationID:      "updateCustomField",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "wikiId",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.WikiId,
+				}: params.ProjectIdOrKey,
 				{
-					Name: "attachmentId",
+					Name: "customFieldId",
 					In:   "path",
-				}: params.AttachmentId,
+				}: params.CustomFieldId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = RemoveWikiAttachmentParams
-			Response = *Attachment
+			Request  = OptUpdateCustomFieldReq
+			Params   = UpdateCustomFieldParams
+			Response = *CustomField
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12372,14 +15171,14 @@ func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackRemoveWikiAttachmentParams,
+			unpackUpdateCustomFieldParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveWikiAttachment(ctx, params)
+				response, err = s.h.UpdateCustomField(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveWikiAttachment(ctx, params)
+		response, err = s.h.UpdateCustomField(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12387,7 +15186,7 @@ func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped b
 		return
 	}
 
-	if err := encodeRemoveWikiAttachmentResponse(response, w, span); err != nil {
+	if err := encodeUpdateCustomFieldResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12396,22 +15195,22 @@ func (s *Server) handleRemoveWikiAttachmentRequest(args [2]string, argsEscaped b
 	}
 }
 
-// handleUpdateCommentRequest handles updateComment operation.
+// handleUpdateIssueRequest handles updateIssue operation.
 //
-// Update comment.
+// Update issue.
 //
-// PATCH /issues/{issueIdOrKey}/comments/{commentId}
-func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /issues/{issueIdOrKey}
+func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateComment"),
+		otelogen.OperationID("updateIssue"),
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}/comments/{commentId}"),
+		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateCommentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12466,15 +15265,15 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateCommentOperation,
-			ID:   "updateComment",
+			Name: UpdateIssueOperation,
+			ID:   "updateIssue",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, UpdateCommentOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, UpdateIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12491,7 +15290,7 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, UpdateCommentOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, UpdateIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12532,7 +15331,7 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUpdateCommentParams(args, argsEscaped, r)
+	params, err := decodeUpdateIssueParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12544,7 +15343,7 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateCommentRequest(r)
+	request, rawBody, close, err := s.decodeUpdateIssueRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -12560,13 +15359,13 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *Comment
+	var response *Issue
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateCommentOperation,
-			OperationSummary: "Update comment",
-			OperationID:      "updateComment",
+			OperationName:    UpdateIssueOperation,
+			OperationSummary: "Update issue",
+			OperationID:      "updateIssue",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -12574,18 +15373,14 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 					Name: "issueIdOrKey",
 					In:   "path",
 				}: params.IssueIdOrKey,
-				{
-					Name: "commentId",
-					In:   "path",
-				}: params.CommentId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptUpdateCommentReq
-			Params   = UpdateCommentParams
-			Response = *Comment
+			Request  = OptUpdateIssueReq
+			Params   = UpdateIssueParams
+			Response = *Issue
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12594,14 +15389,14 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUpdateCommentParams,
+			unpackUpdateIssueParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateComment(ctx, request, params)
+				response, err = s.h.UpdateIssue(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateComment(ctx, request, params)
+		response, err = s.h.UpdateIssue(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12609,7 +15404,7 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUpdateCommentResponse(response, w, span); err != nil {
+	if err := encodeUpdateIssueResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12618,22 +15413,22 @@ func (s *Server) handleUpdateCommentRequest(args [2]string, argsEscaped bool, w
 	}
 }
 
-// handleUpdateIssueRequest handles updateIssue operation.
+// handleUpdateWebhookRequest handles updateWebhook operation.
 //
-// Update issue.
+// Update webhook.
 //
-// PATCH /issues/{issueIdOrKey}
-func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /projects/{projectIdOrKey}/webhooks/{webhookId}
+func (s *Server) handleUpdateWebhookRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateIssue"),
+		otelogen.OperationID("updateWebhook"),
 		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/issues/{issueIdOrKey}"),
+		semconv.HTTPRouteKey.String("/projects/{projectIdOrKey}/webhooks/{webhookId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateIssueOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateWebhookOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12688,15 +15483,15 @@ func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateIssueOperation,
-			ID:   "updateIssue",
+			Name: UpdateWebhookOperation,
+			ID:   "updateWebhook",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityOAuth2(ctx, UpdateIssueOperation, r)
+			sctx, ok, err := s.securityOAuth2(ctx, UpdateWebhookOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12713,7 +15508,7 @@ func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKey(ctx, UpdateIssueOperation, r)
+			sctx, ok, err := s.securityApiKey(ctx, UpdateWebhookOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12754,7 +15549,7 @@ func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeUpdateIssueParams(args, argsEscaped, r)
+	params, err := decodeUpdateWebhookParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12766,7 +15561,7 @@ func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w ht
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateIssueRequest(r)
+	request, rawBody, close, err := s.decodeUpdateWebhookRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -12782,28 +15577,32 @@ func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w ht
 		}
 	}()
 
-	var response *Issue
+	var response *Webhook
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateIssueOperation,
-			OperationSummary: "Update issue",
-			OperationID:      "updateIssue",
+			OperationName:    UpdateWebhookOperation,
+			OperationSummary: "Update webhook",
+			OperationID:      "updateWebhook",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "issueIdOrKey",
+					Name: "projectIdOrKey",
 					In:   "path",
-				}: params.IssueIdOrKey,
+				}: params.ProjectIdOrKey,
+				{
+					Name: "webhookId",
+					In:   "path",
+				}: params.WebhookId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptUpdateIssueReq
-			Params   = UpdateIssueParams
-			Response = *Issue
+			Request  = OptUpdateWebhookReq
+			Params   = UpdateWebhookParams
+			Response = *Webhook
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12812,14 +15611,14 @@ func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackUpdateIssueParams,
+			unpackUpdateWebhookParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateIssue(ctx, request, params)
+				response, err = s.h.UpdateWebhook(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateIssue(ctx, request, params)
+		response, err = s.h.UpdateWebhook(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12827,7 +15626,7 @@ func (s *Server) handleUpdateIssueRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeUpdateIssueResponse(response, w, span); err != nil {
+	if err := encodeUpdateWebhookResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
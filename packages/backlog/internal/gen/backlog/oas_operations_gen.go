@@ -7,16 +7,23 @@ type OperationName = string
 
 const (
 	AddCommentOperation                      OperationName = "AddComment"
+	AddCustomFieldItemOperation              OperationName = "AddCustomFieldItem"
 	AddDocumentTagsOperation                 OperationName = "AddDocumentTags"
+	AddProjectAdministratorOperation         OperationName = "AddProjectAdministrator"
+	AddProjectUserOperation                  OperationName = "AddProjectUser"
 	AttachFileToWikiOperation                OperationName = "AttachFileToWiki"
 	CreateCategoryOperation                  OperationName = "CreateCategory"
+	CreateCustomFieldOperation               OperationName = "CreateCustomField"
 	CreateDocumentOperation                  OperationName = "CreateDocument"
 	CreateIssueOperation                     OperationName = "CreateIssue"
 	CreateWikiOperation                      OperationName = "CreateWiki"
 	DeleteCategoryOperation                  OperationName = "DeleteCategory"
+	DeleteCustomFieldItemOperation           OperationName = "DeleteCustomFieldItem"
 	DeleteDocumentOperation                  OperationName = "DeleteDocument"
 	DeleteIssueOperation                     OperationName = "DeleteIssue"
 	DeleteIssueAttachmentOperation           OperationName = "DeleteIssueAttachment"
+	DeleteProjectAdministratorOperation      OperationName = "DeleteProjectAdministrator"
+	DeleteProjectUserOperation               OperationName = "DeleteProjectUser"
 	DeletePullRequestAttachmentsOperation    OperationName = "DeletePullRequestAttachments"
 	DeleteWikiOperation                      OperationName = "DeleteWiki"
 	DownloadDocumentAttachmentOperation      OperationName = "DownloadDocumentAttachment"
@@ -43,6 +50,8 @@ const (
 	GetListOfWikiAttachmentsOperation        OperationName = "GetListOfWikiAttachments"
 	GetPrioritiesOperation                   OperationName = "GetPriorities"
 	GetProjectOperation                      OperationName = "GetProject"
+	GetProjectAdministratorsOperation        OperationName = "GetProjectAdministrators"
+	GetProjectRecentUpdatesOperation         OperationName = "GetProjectRecentUpdates"
 	GetProjectUsersOperation                 OperationName = "GetProjectUsers"
 	GetProjectsOperation                     OperationName = "GetProjects"
 	GetPullRequestOperation                  OperationName = "GetPullRequest"
@@ -56,7 +65,9 @@ const (
 	GetUserRecentUpdatesOperation            OperationName = "GetUserRecentUpdates"
 	GetUsersOperation                        OperationName = "GetUsers"
 	GetVersionsOperation                     OperationName = "GetVersions"
+	GetWebhooksOperation                     OperationName = "GetWebhooks"
 	GetWikiOperation                         OperationName = "GetWiki"
+	GetWikiTagsOperation                     OperationName = "GetWikiTags"
 	GetWikisOperation                        OperationName = "GetWikis"
 	GetWikisCountOperation                   OperationName = "GetWikisCount"
 	LinkSharedFilesToIssueOperation          OperationName = "LinkSharedFilesToIssue"
@@ -66,6 +77,8 @@ const (
 	RemoveLinkToSharedFileFromWikiOperation  OperationName = "RemoveLinkToSharedFileFromWiki"
 	RemoveWikiAttachmentOperation            OperationName = "RemoveWikiAttachment"
 	UpdateCommentOperation                   OperationName = "UpdateComment"
+	UpdateCustomFieldOperation               OperationName = "UpdateCustomField"
 	UpdateIssueOperation                     OperationName = "UpdateIssue"
+	UpdateWebhookOperation                   OperationName = "UpdateWebhook"
 	UpdateWikiOperation                      OperationName = "UpdateWiki"
 )
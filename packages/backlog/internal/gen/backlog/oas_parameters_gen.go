@@ -79,24 +79,32 @@ func decodeAddCommentParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// AddDocumentTagsParams is parameters of addDocumentTags operation.
-type AddDocumentTagsParams struct {
-	DocumentId string
+// AddCustomFieldItemParams is parameters of addCustomFieldItem operation.
+type AddCustomFieldItemParams struct {
+	ProjectIdOrKey string
+	CustomFieldId  int
 }
 
-func unpackAddDocumentTagsParams(packed middleware.Parameters) (params AddDocumentTagsParams) {
+func unpackAddCustomFieldItemParams(packed middleware.Parameters) (params AddCustomFieldItemParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "documentId",
+			Name: "projectIdOrKey",
 			In:   "path",
 		}
-		params.DocumentId = packed[key].(string)
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "customFieldId",
+			In:   "path",
+		}
+		params.CustomFieldId = packed[key].(int)
 	}
 	return params
 }
 
-func decodeAddDocumentTagsParams(args [1]string, argsEscaped bool, r *http.Request) (params AddDocumentTagsParams, _ error) {
-	// Decode path: documentId.
+func decodeAddCustomFieldItemParams(args [2]string, argsEscaped bool, r *http.Request) (params AddCustomFieldItemParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -108,7 +116,7 @@ func decodeAddDocumentTagsParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "documentId",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -125,7 +133,7 @@ func decodeAddDocumentTagsParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.DocumentId = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -136,7 +144,52 @@ func decodeAddDocumentTagsParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "documentId",
+			Name: "projectIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: customFieldId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "customFieldId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.CustomFieldId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "customFieldId",
 			In:   "path",
 			Err:  err,
 		}
@@ -144,24 +197,24 @@ func decodeAddDocumentTagsParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// AttachFileToWikiParams is parameters of attachFileToWiki operation.
-type AttachFileToWikiParams struct {
-	WikiId int
+// AddDocumentTagsParams is parameters of addDocumentTags operation.
+type AddDocumentTagsParams struct {
+	DocumentId string
 }
 
-func unpackAttachFileToWikiParams(packed middleware.Parameters) (params AttachFileToWikiParams) {
+func unpackAddDocumentTagsParams(packed middleware.Parameters) (params AddDocumentTagsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "wikiId",
+			Name: "documentId",
 			In:   "path",
 		}
-		params.WikiId = packed[key].(int)
+		params.DocumentId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeAttachFileToWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params AttachFileToWikiParams, _ error) {
-	// Decode path: wikiId.
+func decodeAddDocumentTagsParams(args [1]string, argsEscaped bool, r *http.Request) (params AddDocumentTagsParams, _ error) {
+	// Decode path: documentId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -173,7 +226,7 @@ func decodeAttachFileToWikiParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "wikiId",
+				Param:   "documentId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -185,12 +238,12 @@ func decodeAttachFileToWikiParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.WikiId = c
+				params.DocumentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -201,7 +254,7 @@ func decodeAttachFileToWikiParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "wikiId",
+			Name: "documentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -209,12 +262,12 @@ func decodeAttachFileToWikiParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// CreateCategoryParams is parameters of createCategory operation.
-type CreateCategoryParams struct {
+// AddProjectAdministratorParams is parameters of addProjectAdministrator operation.
+type AddProjectAdministratorParams struct {
 	ProjectIdOrKey string
 }
 
-func unpackCreateCategoryParams(packed middleware.Parameters) (params CreateCategoryParams) {
+func unpackAddProjectAdministratorParams(packed middleware.Parameters) (params AddProjectAdministratorParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "projectIdOrKey",
@@ -225,7 +278,7 @@ func unpackCreateCategoryParams(packed middleware.Parameters) (params CreateCate
 	return params
 }
 
-func decodeCreateCategoryParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCategoryParams, _ error) {
+func decodeAddProjectAdministratorParams(args [1]string, argsEscaped bool, r *http.Request) (params AddProjectAdministratorParams, _ error) {
 	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
@@ -274,13 +327,12 @@ func decodeCreateCategoryParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// DeleteCategoryParams is parameters of deleteCategory operation.
-type DeleteCategoryParams struct {
+// AddProjectUserParams is parameters of addProjectUser operation.
+type AddProjectUserParams struct {
 	ProjectIdOrKey string
-	CategoryId     int
 }
 
-func unpackDeleteCategoryParams(packed middleware.Parameters) (params DeleteCategoryParams) {
+func unpackAddProjectUserParams(packed middleware.Parameters) (params AddProjectUserParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "projectIdOrKey",
@@ -288,17 +340,10 @@ func unpackDeleteCategoryParams(packed middleware.Parameters) (params DeleteCate
 		}
 		params.ProjectIdOrKey = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "categoryId",
-			In:   "path",
-		}
-		params.CategoryId = packed[key].(int)
-	}
 	return params
 }
 
-func decodeDeleteCategoryParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteCategoryParams, _ error) {
+func decodeAddProjectUserParams(args [1]string, argsEscaped bool, r *http.Request) (params AddProjectUserParams, _ error) {
 	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
@@ -344,11 +389,31 @@ func decodeDeleteCategoryParams(args [2]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
-	// Decode path: categoryId.
+	return params, nil
+}
+
+// AttachFileToWikiParams is parameters of attachFileToWiki operation.
+type AttachFileToWikiParams struct {
+	WikiId int
+}
+
+func unpackAttachFileToWikiParams(packed middleware.Parameters) (params AttachFileToWikiParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "wikiId",
+			In:   "path",
+		}
+		params.WikiId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeAttachFileToWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params AttachFileToWikiParams, _ error) {
+	// Decode path: wikiId.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -356,7 +421,7 @@ func decodeDeleteCategoryParams(args [2]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "categoryId",
+				Param:   "wikiId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -373,7 +438,7 @@ func decodeDeleteCategoryParams(args [2]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.CategoryId = c
+				params.WikiId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -384,7 +449,7 @@ func decodeDeleteCategoryParams(args [2]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "categoryId",
+			Name: "wikiId",
 			In:   "path",
 			Err:  err,
 		}
@@ -392,24 +457,24 @@ func decodeDeleteCategoryParams(args [2]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// DeleteDocumentParams is parameters of deleteDocument operation.
-type DeleteDocumentParams struct {
-	DocumentId string
+// CreateCategoryParams is parameters of createCategory operation.
+type CreateCategoryParams struct {
+	ProjectIdOrKey string
 }
 
-func unpackDeleteDocumentParams(packed middleware.Parameters) (params DeleteDocumentParams) {
+func unpackCreateCategoryParams(packed middleware.Parameters) (params CreateCategoryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "documentId",
+			Name: "projectIdOrKey",
 			In:   "path",
 		}
-		params.DocumentId = packed[key].(string)
+		params.ProjectIdOrKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteDocumentParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteDocumentParams, _ error) {
-	// Decode path: documentId.
+func decodeCreateCategoryParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCategoryParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -421,7 +486,7 @@ func decodeDeleteDocumentParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "documentId",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -438,7 +503,7 @@ func decodeDeleteDocumentParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.DocumentId = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -449,7 +514,7 @@ func decodeDeleteDocumentParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "documentId",
+			Name: "projectIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
@@ -457,24 +522,24 @@ func decodeDeleteDocumentParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// DeleteIssueParams is parameters of deleteIssue operation.
-type DeleteIssueParams struct {
-	IssueIdOrKey string
+// CreateCustomFieldParams is parameters of createCustomField operation.
+type CreateCustomFieldParams struct {
+	ProjectIdOrKey string
 }
 
-func unpackDeleteIssueParams(packed middleware.Parameters) (params DeleteIssueParams) {
+func unpackCreateCustomFieldParams(packed middleware.Parameters) (params CreateCustomFieldParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
+			Name: "projectIdOrKey",
 			In:   "path",
 		}
-		params.IssueIdOrKey = packed[key].(string)
+		params.ProjectIdOrKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteIssueParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteIssueParams, _ error) {
-	// Decode path: issueIdOrKey.
+func decodeCreateCustomFieldParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCustomFieldParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -486,7 +551,7 @@ func decodeDeleteIssueParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -503,7 +568,7 @@ func decodeDeleteIssueParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -514,7 +579,7 @@ func decodeDeleteIssueParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "projectIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
@@ -522,32 +587,32 @@ func decodeDeleteIssueParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// DeleteIssueAttachmentParams is parameters of deleteIssueAttachment operation.
-type DeleteIssueAttachmentParams struct {
-	IssueIdOrKey string
-	AttachmentId int
+// DeleteCategoryParams is parameters of deleteCategory operation.
+type DeleteCategoryParams struct {
+	ProjectIdOrKey string
+	CategoryId     int
 }
 
-func unpackDeleteIssueAttachmentParams(packed middleware.Parameters) (params DeleteIssueAttachmentParams) {
+func unpackDeleteCategoryParams(packed middleware.Parameters) (params DeleteCategoryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
+			Name: "projectIdOrKey",
 			In:   "path",
 		}
-		params.IssueIdOrKey = packed[key].(string)
+		params.ProjectIdOrKey = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "attachmentId",
+			Name: "categoryId",
 			In:   "path",
 		}
-		params.AttachmentId = packed[key].(int)
+		params.CategoryId = packed[key].(int)
 	}
 	return params
 }
 
-func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteIssueAttachmentParams, _ error) {
-	// Decode path: issueIdOrKey.
+func decodeDeleteCategoryParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteCategoryParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -559,7 +624,7 @@ func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -576,7 +641,7 @@ func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -587,12 +652,12 @@ func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "projectIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: attachmentId.
+	// Decode path: categoryId.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -604,7 +669,7 @@ func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "attachmentId",
+				Param:   "categoryId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -621,7 +686,7 @@ func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.AttachmentId = c
+				params.CategoryId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -632,7 +697,7 @@ func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "attachmentId",
+			Name: "categoryId",
 			In:   "path",
 			Err:  err,
 		}
@@ -640,15 +705,14 @@ func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// DeletePullRequestAttachmentsParams is parameters of deletePullRequestAttachments operation.
-type DeletePullRequestAttachmentsParams struct {
+// DeleteCustomFieldItemParams is parameters of deleteCustomFieldItem operation.
+type DeleteCustomFieldItemParams struct {
 	ProjectIdOrKey string
-	RepoIdOrName   string
-	Number         int
-	AttachmentId   int
+	CustomFieldId  int
+	ItemId         int
 }
 
-func unpackDeletePullRequestAttachmentsParams(packed middleware.Parameters) (params DeletePullRequestAttachmentsParams) {
+func unpackDeleteCustomFieldItemParams(packed middleware.Parameters) (params DeleteCustomFieldItemParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "projectIdOrKey",
@@ -658,29 +722,22 @@ func unpackDeletePullRequestAttachmentsParams(packed middleware.Parameters) (par
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "repoIdOrName",
-			In:   "path",
-		}
-		params.RepoIdOrName = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "number",
+			Name: "customFieldId",
 			In:   "path",
 		}
-		params.Number = packed[key].(int)
+		params.CustomFieldId = packed[key].(int)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "attachmentId",
+			Name: "itemId",
 			In:   "path",
 		}
-		params.AttachmentId = packed[key].(int)
+		params.ItemId = packed[key].(int)
 	}
 	return params
 }
 
-func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool, r *http.Request) (params DeletePullRequestAttachmentsParams, _ error) {
+func decodeDeleteCustomFieldItemParams(args [3]string, argsEscaped bool, r *http.Request) (params DeleteCustomFieldItemParams, _ error) {
 	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
@@ -726,7 +783,7 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 			Err:  err,
 		}
 	}
-	// Decode path: repoIdOrName.
+	// Decode path: customFieldId.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -738,7 +795,7 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "repoIdOrName",
+				Param:   "customFieldId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -750,12 +807,12 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.RepoIdOrName = c
+				params.CustomFieldId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -766,12 +823,12 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "repoIdOrName",
+			Name: "customFieldId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: number.
+	// Decode path: itemId.
 	if err := func() error {
 		param := args[2]
 		if argsEscaped {
@@ -783,7 +840,7 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "number",
+				Param:   "itemId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -800,7 +857,7 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 					return err
 				}
 
-				params.Number = c
+				params.ItemId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -811,27 +868,47 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "number",
+			Name: "itemId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: attachmentId.
-	if err := func() error {
-		param := args[3]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[3])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "attachmentId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
+	return params, nil
+}
+
+// DeleteDocumentParams is parameters of deleteDocument operation.
+type DeleteDocumentParams struct {
+	DocumentId string
+}
+
+func unpackDeleteDocumentParams(packed middleware.Parameters) (params DeleteDocumentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "documentId",
+			In:   "path",
+		}
+		params.DocumentId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDeleteDocumentParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteDocumentParams, _ error) {
+	// Decode path: documentId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "documentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
 			})
 
 			if err := func() error {
@@ -840,12 +917,12 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.AttachmentId = c
+				params.DocumentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -856,7 +933,7 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "attachmentId",
+			Name: "documentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -864,24 +941,24 @@ func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool,
 	return params, nil
 }
 
-// DeleteWikiParams is parameters of deleteWiki operation.
-type DeleteWikiParams struct {
-	WikiId int
+// DeleteIssueParams is parameters of deleteIssue operation.
+type DeleteIssueParams struct {
+	IssueIdOrKey string
 }
 
-func unpackDeleteWikiParams(packed middleware.Parameters) (params DeleteWikiParams) {
+func unpackDeleteIssueParams(packed middleware.Parameters) (params DeleteIssueParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "wikiId",
+			Name: "issueIdOrKey",
 			In:   "path",
 		}
-		params.WikiId = packed[key].(int)
+		params.IssueIdOrKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWikiParams, _ error) {
-	// Decode path: wikiId.
+func decodeDeleteIssueParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteIssueParams, _ error) {
+	// Decode path: issueIdOrKey.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -893,7 +970,7 @@ func decodeDeleteWikiParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "wikiId",
+				Param:   "issueIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -905,12 +982,12 @@ func decodeDeleteWikiParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.WikiId = c
+				params.IssueIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -921,7 +998,7 @@ func decodeDeleteWikiParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "wikiId",
+			Name: "issueIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
@@ -929,19 +1006,19 @@ func decodeDeleteWikiParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DownloadDocumentAttachmentParams is parameters of downloadDocumentAttachment operation.
-type DownloadDocumentAttachmentParams struct {
-	DocumentId   string
+// DeleteIssueAttachmentParams is parameters of deleteIssueAttachment operation.
+type DeleteIssueAttachmentParams struct {
+	IssueIdOrKey string
 	AttachmentId int
 }
 
-func unpackDownloadDocumentAttachmentParams(packed middleware.Parameters) (params DownloadDocumentAttachmentParams) {
+func unpackDeleteIssueAttachmentParams(packed middleware.Parameters) (params DeleteIssueAttachmentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "documentId",
+			Name: "issueIdOrKey",
 			In:   "path",
 		}
-		params.DocumentId = packed[key].(string)
+		params.IssueIdOrKey = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -953,8 +1030,8 @@ func unpackDownloadDocumentAttachmentParams(packed middleware.Parameters) (param
 	return params
 }
 
-func decodeDownloadDocumentAttachmentParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadDocumentAttachmentParams, _ error) {
-	// Decode path: documentId.
+func decodeDeleteIssueAttachmentParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteIssueAttachmentParams, _ error) {
+	// Decode path: issueIdOrKey.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -966,7 +1043,7 @@ func decodeDownloadDocumentAttachmentParams(args [2]string, argsEscaped bool, r
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "documentId",
+				Param:   "issueIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -983,7 +1060,7 @@ func decodeDownloadDocumentAttachmentParams(args [2]string, argsEscaped bool, r
 					return err
 				}
 
-				params.DocumentId = c
+				params.IssueIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -994,7 +1071,7 @@ func decodeDownloadDocumentAttachmentParams(args [2]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "documentId",
+			Name: "issueIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
@@ -1047,12 +1124,12 @@ func decodeDownloadDocumentAttachmentParams(args [2]string, argsEscaped bool, r
 	return params, nil
 }
 
-// GetCategoriesParams is parameters of getCategories operation.
-type GetCategoriesParams struct {
+// DeleteProjectAdministratorParams is parameters of deleteProjectAdministrator operation.
+type DeleteProjectAdministratorParams struct {
 	ProjectIdOrKey string
 }
 
-func unpackGetCategoriesParams(packed middleware.Parameters) (params GetCategoriesParams) {
+func unpackDeleteProjectAdministratorParams(packed middleware.Parameters) (params DeleteProjectAdministratorParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "projectIdOrKey",
@@ -1063,7 +1140,7 @@ func unpackGetCategoriesParams(packed middleware.Parameters) (params GetCategori
 	return params
 }
 
-func decodeGetCategoriesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCategoriesParams, _ error) {
+func decodeDeleteProjectAdministratorParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteProjectAdministratorParams, _ error) {
 	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
@@ -1112,32 +1189,24 @@ func decodeGetCategoriesParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetCommentParams is parameters of getComment operation.
-type GetCommentParams struct {
-	IssueIdOrKey string
-	CommentId    int
+// DeleteProjectUserParams is parameters of deleteProjectUser operation.
+type DeleteProjectUserParams struct {
+	ProjectIdOrKey string
 }
 
-func unpackGetCommentParams(packed middleware.Parameters) (params GetCommentParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
-			In:   "path",
-		}
-		params.IssueIdOrKey = packed[key].(string)
-	}
+func unpackDeleteProjectUserParams(packed middleware.Parameters) (params DeleteProjectUserParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "commentId",
+			Name: "projectIdOrKey",
 			In:   "path",
 		}
-		params.CommentId = packed[key].(int)
+		params.ProjectIdOrKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (params GetCommentParams, _ error) {
-	// Decode path: issueIdOrKey.
+func decodeDeleteProjectUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteProjectUserParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1149,7 +1218,7 @@ func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1166,7 +1235,7 @@ func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1177,16 +1246,60 @@ func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "projectIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: commentId.
+	return params, nil
+}
+
+// DeletePullRequestAttachmentsParams is parameters of deletePullRequestAttachments operation.
+type DeletePullRequestAttachmentsParams struct {
+	ProjectIdOrKey string
+	RepoIdOrName   string
+	Number         int
+	AttachmentId   int
+}
+
+func unpackDeletePullRequestAttachmentsParams(packed middleware.Parameters) (params DeletePullRequestAttachmentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "repoIdOrName",
+			In:   "path",
+		}
+		params.RepoIdOrName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "number",
+			In:   "path",
+		}
+		params.Number = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "attachmentId",
+			In:   "path",
+		}
+		params.AttachmentId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeletePullRequestAttachmentsParams(args [4]string, argsEscaped bool, r *http.Request) (params DeletePullRequestAttachmentsParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1194,7 +1307,7 @@ func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "commentId",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1206,12 +1319,12 @@ func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.CommentId = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1222,77 +1335,16 @@ func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "commentId",
+			Name: "projectIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetCommentsParams is parameters of getComments operation.
-type GetCommentsParams struct {
-	IssueIdOrKey string
-	MinId        OptInt    `json:",omitempty,omitzero"`
-	MaxId        OptInt    `json:",omitempty,omitzero"`
-	Count        OptInt    `json:",omitempty,omitzero"`
-	Order        OptString `json:",omitempty,omitzero"`
-}
-
-func unpackGetCommentsParams(packed middleware.Parameters) (params GetCommentsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
-			In:   "path",
-		}
-		params.IssueIdOrKey = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "minId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.MinId = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "maxId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.MaxId = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "count",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Count = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "order",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Order = v.(OptString)
-		}
-	}
-	return params
-}
-
-func decodeGetCommentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCommentsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: issueIdOrKey.
+	// Decode path: repoIdOrName.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1300,7 +1352,7 @@ func decodeGetCommentsParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "repoIdOrName",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1317,7 +1369,7 @@ func decodeGetCommentsParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.RepoIdOrName = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1328,200 +1380,61 @@ func decodeGetCommentsParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "repoIdOrName",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: minId.
+	// Decode path: number.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "minId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotMinIdVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotMinIdVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.MinId.SetTo(paramsDotMinIdVal)
-				return nil
-			}); err != nil {
-				return err
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "minId",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: maxId.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "maxId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotMaxIdVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "number",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					paramsDotMaxIdVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.MaxId.SetTo(paramsDotMaxIdVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "maxId",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: count.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCountVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
 
-					paramsDotCountVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt(val)
+				if err != nil {
 					return err
 				}
-				params.Count.SetTo(paramsDotCountVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "count",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: order.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
 
-					paramsDotOrderVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Order.SetTo(paramsDotOrderVal)
+				params.Number = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetCommentsCountParams is parameters of getCommentsCount operation.
-type GetCommentsCountParams struct {
-	IssueIdOrKey string
-}
-
-func unpackGetCommentsCountParams(packed middleware.Parameters) (params GetCommentsCountParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
+			Name: "number",
 			In:   "path",
+			Err:  err,
 		}
-		params.IssueIdOrKey = packed[key].(string)
 	}
-	return params
-}
-
-func decodeGetCommentsCountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCommentsCountParams, _ error) {
-	// Decode path: issueIdOrKey.
+	// Decode path: attachmentId.
 	if err := func() error {
-		param := args[0]
+		param := args[3]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[3])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1529,7 +1442,7 @@ func decodeGetCommentsCountParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "attachmentId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1541,12 +1454,12 @@ func decodeGetCommentsCountParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.AttachmentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1557,7 +1470,7 @@ func decodeGetCommentsCountParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "attachmentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1565,24 +1478,24 @@ func decodeGetCommentsCountParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetCustomFieldsParams is parameters of getCustomFields operation.
-type GetCustomFieldsParams struct {
-	ProjectIdOrKey string
+// DeleteWikiParams is parameters of deleteWiki operation.
+type DeleteWikiParams struct {
+	WikiId int
 }
 
-func unpackGetCustomFieldsParams(packed middleware.Parameters) (params GetCustomFieldsParams) {
+func unpackDeleteWikiParams(packed middleware.Parameters) (params DeleteWikiParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "projectIdOrKey",
+			Name: "wikiId",
 			In:   "path",
 		}
-		params.ProjectIdOrKey = packed[key].(string)
+		params.WikiId = packed[key].(int)
 	}
 	return params
 }
 
-func decodeGetCustomFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCustomFieldsParams, _ error) {
-	// Decode path: projectIdOrKey.
+func decodeDeleteWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWikiParams, _ error) {
+	// Decode path: wikiId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1594,7 +1507,7 @@ func decodeGetCustomFieldsParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "projectIdOrKey",
+				Param:   "wikiId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1606,12 +1519,12 @@ func decodeGetCustomFieldsParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.ProjectIdOrKey = c
+				params.WikiId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1622,7 +1535,7 @@ func decodeGetCustomFieldsParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "projectIdOrKey",
+			Name: "wikiId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1630,12 +1543,13 @@ func decodeGetCustomFieldsParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetDocumentParams is parameters of getDocument operation.
-type GetDocumentParams struct {
-	DocumentId string
+// DownloadDocumentAttachmentParams is parameters of downloadDocumentAttachment operation.
+type DownloadDocumentAttachmentParams struct {
+	DocumentId   string
+	AttachmentId int
 }
 
-func unpackGetDocumentParams(packed middleware.Parameters) (params GetDocumentParams) {
+func unpackDownloadDocumentAttachmentParams(packed middleware.Parameters) (params DownloadDocumentAttachmentParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "documentId",
@@ -1643,10 +1557,17 @@ func unpackGetDocumentParams(packed middleware.Parameters) (params GetDocumentPa
 		}
 		params.DocumentId = packed[key].(string)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "attachmentId",
+			In:   "path",
+		}
+		params.AttachmentId = packed[key].(int)
+	}
 	return params
 }
 
-func decodeGetDocumentParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDocumentParams, _ error) {
+func decodeDownloadDocumentAttachmentParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadDocumentAttachmentParams, _ error) {
 	// Decode path: documentId.
 	if err := func() error {
 		param := args[0]
@@ -1692,31 +1613,11 @@ func decodeGetDocumentParams(args [1]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetDocumentCommentsParams is parameters of getDocumentComments operation.
-type GetDocumentCommentsParams struct {
-	DocumentId string
-}
-
-func unpackGetDocumentCommentsParams(packed middleware.Parameters) (params GetDocumentCommentsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "documentId",
-			In:   "path",
-		}
-		params.DocumentId = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetDocumentCommentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDocumentCommentsParams, _ error) {
-	// Decode path: documentId.
+	// Decode path: attachmentId.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1724,7 +1625,7 @@ func decodeGetDocumentCommentsParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "documentId",
+				Param:   "attachmentId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1736,12 +1637,12 @@ func decodeGetDocumentCommentsParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.DocumentId = c
+				params.AttachmentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1752,7 +1653,7 @@ func decodeGetDocumentCommentsParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "documentId",
+			Name: "attachmentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1760,34 +1661,42 @@ func decodeGetDocumentCommentsParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetDocumentTreeParams is parameters of getDocumentTree operation.
-type GetDocumentTreeParams struct {
+// GetCategoriesParams is parameters of getCategories operation.
+type GetCategoriesParams struct {
 	ProjectIdOrKey string
 }
 
-func unpackGetDocumentTreeParams(packed middleware.Parameters) (params GetDocumentTreeParams) {
+func unpackGetCategoriesParams(packed middleware.Parameters) (params GetCategoriesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "projectIdOrKey",
-			In:   "query",
+			In:   "path",
 		}
 		params.ProjectIdOrKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetDocumentTreeParams(args [0]string, argsEscaped bool, r *http.Request) (params GetDocumentTreeParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: projectIdOrKey.
+func decodeGetCategoriesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCategoriesParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "projectIdOrKey",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "projectIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -1800,162 +1709,272 @@ func decodeGetDocumentTreeParams(args [0]string, argsEscaped bool, r *http.Reque
 
 				params.ProjectIdOrKey = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
 			Name: "projectIdOrKey",
-			In:   "query",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetDocumentsParams is parameters of getDocuments operation.
-type GetDocumentsParams struct {
-	ProjectId []int     `json:",omitempty"`
-	Keyword   OptString `json:",omitempty,omitzero"`
-	Sort      OptString `json:",omitempty,omitzero"`
-	Order     OptString `json:",omitempty,omitzero"`
-	Offset    int
-	Count     OptInt `json:",omitempty,omitzero"`
+// GetCommentParams is parameters of getComment operation.
+type GetCommentParams struct {
+	IssueIdOrKey string
+	CommentId    int
 }
 
-func unpackGetDocumentsParams(packed middleware.Parameters) (params GetDocumentsParams) {
+func unpackGetCommentParams(packed middleware.Parameters) (params GetCommentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "projectId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ProjectId = v.([]int)
+			Name: "issueIdOrKey",
+			In:   "path",
 		}
+		params.IssueIdOrKey = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "keyword",
-			In:   "query",
+			Name: "commentId",
+			In:   "path",
 		}
-		if v, ok := packed[key]; ok {
-			params.Keyword = v.(OptString)
+		params.CommentId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetCommentParams(args [2]string, argsEscaped bool, r *http.Request) (params GetCommentParams, _ error) {
+	// Decode path: issueIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issueIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueIdOrKey = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issueIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: commentId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "commentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.CommentId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "commentId",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetCommentsParams is parameters of getComments operation.
+type GetCommentsParams struct {
+	IssueIdOrKey string
+	MinId        OptInt    `json:",omitempty,omitzero"`
+	MaxId        OptInt    `json:",omitempty,omitzero"`
+	Count        OptInt    `json:",omitempty,omitzero"`
+	Order        OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetCommentsParams(packed middleware.Parameters) (params GetCommentsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "sort",
+			Name: "issueIdOrKey",
+			In:   "path",
+		}
+		params.IssueIdOrKey = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "minId",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Sort = v.(OptString)
+			params.MinId = v.(OptInt)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "order",
+			Name: "maxId",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Order = v.(OptString)
+			params.MaxId = v.(OptInt)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "count",
 			In:   "query",
 		}
-		params.Offset = packed[key].(int)
+		if v, ok := packed[key]; ok {
+			params.Count = v.(OptInt)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "count",
+			Name: "order",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Count = v.(OptInt)
+			params.Order = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetDocumentsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetDocumentsParams, _ error) {
+func decodeGetCommentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCommentsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: projectId[].
+	// Decode path: issueIdOrKey.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "projectId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issueIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotProjectIdVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
 
-						paramsDotProjectIdVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.ProjectId = append(params.ProjectId, paramsDotProjectIdVal)
-					return nil
-				})
-			}); err != nil {
+				params.IssueIdOrKey = c
+				return nil
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "projectId[]",
-			In:   "query",
+			Name: "issueIdOrKey",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: keyword.
+	// Decode query: minId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "keyword",
+			Name:    "minId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotKeywordVal string
+				var paramsDotMinIdVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotKeywordVal = c
+					paramsDotMinIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Keyword.SetTo(paramsDotKeywordVal)
+				params.MinId.SetTo(paramsDotMinIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1964,39 +1983,39 @@ func decodeGetDocumentsParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "keyword",
+			Name: "minId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sort.
+	// Decode query: maxId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort",
+			Name:    "maxId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortVal string
+				var paramsDotMaxIdVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSortVal = c
+					paramsDotMaxIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sort.SetTo(paramsDotSortVal)
+				params.MaxId.SetTo(paramsDotMaxIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2005,39 +2024,39 @@ func decodeGetDocumentsParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort",
+			Name: "maxId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: order.
+	// Decode query: count.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order",
+			Name:    "count",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderVal string
+				var paramsDotCountVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOrderVal = c
+					paramsDotCountVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Order.SetTo(paramsDotOrderVal)
+				params.Count.SetTo(paramsDotCountVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2046,119 +2065,156 @@ func decodeGetDocumentsParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order",
+			Name: "count",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
+	// Decode query: order.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "order",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotOrderVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Offset = c
+				params.Order.SetTo(paramsDotOrderVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "order",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: count.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "count",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// GetCommentsCountParams is parameters of getCommentsCount operation.
+type GetCommentsCountParams struct {
+	IssueIdOrKey string
+}
+
+func unpackGetCommentsCountParams(packed middleware.Parameters) (params GetCommentsCountParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "issueIdOrKey",
+			In:   "path",
 		}
+		params.IssueIdOrKey = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCountVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetCommentsCountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCommentsCountParams, _ error) {
+	// Decode path: issueIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issueIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotCountVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Count.SetTo(paramsDotCountVal)
+
+				params.IssueIdOrKey = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "count",
-			In:   "query",
+			Name: "issueIdOrKey",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetDocumentsCountParams is parameters of getDocumentsCount operation.
-type GetDocumentsCountParams struct {
+// GetCustomFieldsParams is parameters of getCustomFields operation.
+type GetCustomFieldsParams struct {
 	ProjectIdOrKey string
 }
 
-func unpackGetDocumentsCountParams(packed middleware.Parameters) (params GetDocumentsCountParams) {
+func unpackGetCustomFieldsParams(packed middleware.Parameters) (params GetCustomFieldsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "projectIdOrKey",
-			In:   "query",
+			In:   "path",
 		}
 		params.ProjectIdOrKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetDocumentsCountParams(args [0]string, argsEscaped bool, r *http.Request) (params GetDocumentsCountParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: projectIdOrKey.
+func decodeGetCustomFieldsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCustomFieldsParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "projectIdOrKey",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "projectIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -2171,41 +2227,41 @@ func decodeGetDocumentsCountParams(args [0]string, argsEscaped bool, r *http.Req
 
 				params.ProjectIdOrKey = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
 			Name: "projectIdOrKey",
-			In:   "query",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetIssueParams is parameters of getIssue operation.
-type GetIssueParams struct {
-	IssueIdOrKey string
+// GetDocumentParams is parameters of getDocument operation.
+type GetDocumentParams struct {
+	DocumentId string
 }
 
-func unpackGetIssueParams(packed middleware.Parameters) (params GetIssueParams) {
+func unpackGetDocumentParams(packed middleware.Parameters) (params GetDocumentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
+			Name: "documentId",
 			In:   "path",
 		}
-		params.IssueIdOrKey = packed[key].(string)
+		params.DocumentId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetIssueParams(args [1]string, argsEscaped bool, r *http.Request) (params GetIssueParams, _ error) {
-	// Decode path: issueIdOrKey.
+func decodeGetDocumentParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDocumentParams, _ error) {
+	// Decode path: documentId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2217,7 +2273,7 @@ func decodeGetIssueParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "documentId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2234,7 +2290,7 @@ func decodeGetIssueParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.DocumentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2245,7 +2301,7 @@ func decodeGetIssueParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "documentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -2253,24 +2309,24 @@ func decodeGetIssueParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetIssueTypesParams is parameters of getIssueTypes operation.
-type GetIssueTypesParams struct {
-	ProjectIdOrKey string
+// GetDocumentCommentsParams is parameters of getDocumentComments operation.
+type GetDocumentCommentsParams struct {
+	DocumentId string
 }
 
-func unpackGetIssueTypesParams(packed middleware.Parameters) (params GetIssueTypesParams) {
+func unpackGetDocumentCommentsParams(packed middleware.Parameters) (params GetDocumentCommentsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "projectIdOrKey",
+			Name: "documentId",
 			In:   "path",
 		}
-		params.ProjectIdOrKey = packed[key].(string)
+		params.DocumentId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetIssueTypesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetIssueTypesParams, _ error) {
-	// Decode path: projectIdOrKey.
+func decodeGetDocumentCommentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDocumentCommentsParams, _ error) {
+	// Decode path: documentId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2282,7 +2338,7 @@ func decodeGetIssueTypesParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "projectIdOrKey",
+				Param:   "documentId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2299,7 +2355,7 @@ func decodeGetIssueTypesParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.ProjectIdOrKey = c
+				params.DocumentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2310,7 +2366,7 @@ func decodeGetIssueTypesParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "projectIdOrKey",
+			Name: "documentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -2318,154 +2374,90 @@ func decodeGetIssueTypesParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetIssuesParams is parameters of getIssues operation.
-type GetIssuesParams struct {
-	ProjectId      []int     `json:",omitempty"`
-	IssueTypeId    []int     `json:",omitempty"`
-	CategoryId     []int     `json:",omitempty"`
-	VersionId      []int     `json:",omitempty"`
-	MilestoneId    []int     `json:",omitempty"`
-	StatusId       []int     `json:",omitempty"`
-	PriorityId     []int     `json:",omitempty"`
-	AssigneeId     []int     `json:",omitempty"`
-	CreatedUserId  []int     `json:",omitempty"`
-	ResolutionId   []int     `json:",omitempty"`
-	ParentChild    OptInt    `json:",omitempty,omitzero"`
-	Attachment     OptBool   `json:",omitempty,omitzero"`
-	SharedFile     OptBool   `json:",omitempty,omitzero"`
-	Sort           OptString `json:",omitempty,omitzero"`
-	Order          OptString `json:",omitempty,omitzero"`
-	Offset         OptInt    `json:",omitempty,omitzero"`
-	Count          OptInt    `json:",omitempty,omitzero"`
-	Keyword        OptString `json:",omitempty,omitzero"`
-	CreatedSince   OptString `json:",omitempty,omitzero"`
-	CreatedUntil   OptString `json:",omitempty,omitzero"`
-	UpdatedSince   OptString `json:",omitempty,omitzero"`
-	UpdatedUntil   OptString `json:",omitempty,omitzero"`
-	StartDateSince OptString `json:",omitempty,omitzero"`
-	StartDateUntil OptString `json:",omitempty,omitzero"`
-	DueDateSince   OptString `json:",omitempty,omitzero"`
-	DueDateUntil   OptString `json:",omitempty,omitzero"`
-	ID             []int     `json:",omitempty"`
-	ParentIssueId  []int     `json:",omitempty"`
+// GetDocumentTreeParams is parameters of getDocumentTree operation.
+type GetDocumentTreeParams struct {
+	ProjectIdOrKey string
 }
 
-func unpackGetIssuesParams(packed middleware.Parameters) (params GetIssuesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "projectId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ProjectId = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "issueTypeId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IssueTypeId = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "categoryId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.CategoryId = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "versionId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.VersionId = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "milestoneId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.MilestoneId = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "statusId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StatusId = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "priorityId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PriorityId = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "assigneeId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.AssigneeId = v.([]int)
-		}
-	}
+func unpackGetDocumentTreeParams(packed middleware.Parameters) (params GetDocumentTreeParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "createdUserId[]",
+			Name: "projectIdOrKey",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.CreatedUserId = v.([]int)
-		}
+		params.ProjectIdOrKey = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "resolutionId[]",
-			In:   "query",
+	return params
+}
+
+func decodeGetDocumentTreeParams(args [0]string, argsEscaped bool, r *http.Request) (params GetDocumentTreeParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: projectIdOrKey.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if v, ok := packed[key]; ok {
-			params.ResolutionId = v.([]int)
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectIdOrKey = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "parentChild",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectIdOrKey",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ParentChild = v.(OptInt)
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetDocumentsParams is parameters of getDocuments operation.
+type GetDocumentsParams struct {
+	ProjectId []int     `json:",omitempty"`
+	Keyword   OptString `json:",omitempty,omitzero"`
+	Sort      OptString `json:",omitempty,omitzero"`
+	Order     OptString `json:",omitempty,omitzero"`
+	Offset    int
+	Count     OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetDocumentsParams(packed middleware.Parameters) (params GetDocumentsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "attachment",
+			Name: "projectId[]",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Attachment = v.(OptBool)
+			params.ProjectId = v.([]int)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sharedFile",
+			Name: "keyword",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SharedFile = v.(OptBool)
+			params.Keyword = v.(OptString)
 		}
 	}
 	{
@@ -2491,9 +2483,7 @@ func unpackGetIssuesParams(packed middleware.Parameters) (params GetIssuesParams
 			Name: "offset",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
+		params.Offset = packed[key].(int)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -2504,141 +2494,83 @@ func unpackGetIssuesParams(packed middleware.Parameters) (params GetIssuesParams
 			params.Count = v.(OptInt)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "keyword",
-			In:   "query",
+	return params
+}
+
+func decodeGetDocumentsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetDocumentsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: projectId[].
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "projectId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if v, ok := packed[key]; ok {
-			params.Keyword = v.(OptString)
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotProjectIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotProjectIdVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.ProjectId = append(params.ProjectId, paramsDotProjectIdVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "createdSince",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectId[]",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.CreatedSince = v.(OptString)
+			Err:  err,
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "createdUntil",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.CreatedUntil = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "updatedSince",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UpdatedSince = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "updatedUntil",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UpdatedUntil = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "startDateSince",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StartDateSince = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "startDateUntil",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StartDateUntil = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "dueDateSince",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.DueDateSince = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "dueDateUntil",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.DueDateUntil = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "id[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ID = v.([]int)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "parentIssueId[]",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ParentIssueId = v.([]int)
-		}
-	}
-	return params
-}
-
-func decodeGetIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetIssuesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: projectId[].
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "projectId[]",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	// Decode query: keyword.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "keyword",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotProjectIdVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotKeywordVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotProjectIdVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					params.ProjectId = append(params.ProjectId, paramsDotProjectIdVal)
+
+					paramsDotKeywordVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Keyword.SetTo(paramsDotKeywordVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -2646,42 +2578,40 @@ func decodeGetIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "projectId[]",
+			Name: "keyword",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: issueTypeId[].
+	// Decode query: sort.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "issueTypeId[]",
+			Name:    "sort",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotIssueTypeIdVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotSortVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotIssueTypeIdVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					params.IssueTypeId = append(params.IssueTypeId, paramsDotIssueTypeIdVal)
+
+					paramsDotSortVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -2689,42 +2619,40 @@ func decodeGetIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueTypeId[]",
+			Name: "sort",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: categoryId[].
+	// Decode query: order.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "categoryId[]",
+			Name:    "order",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotCategoryIdVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotOrderVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotCategoryIdVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					params.CategoryId = append(params.CategoryId, paramsDotCategoryIdVal)
+
+					paramsDotOrderVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Order.SetTo(paramsDotOrderVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -2732,84 +2660,770 @@ func decodeGetIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "categoryId[]",
+			Name: "order",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: versionId[].
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "versionId[]",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotVersionIdVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
 
-						paramsDotVersionIdVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.VersionId = append(params.VersionId, paramsDotVersionIdVal)
-					return nil
-				})
+				params.Offset = c
+				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "versionId[]",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: milestoneId[].
+	// Decode query: count.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "milestoneId[]",
+			Name:    "count",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotMilestoneIdVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
-
-						paramsDotMilestoneIdVal = c
-						return nil
-					}(); err != nil {
+				var paramsDotCountVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
 						return err
 					}
-					params.MilestoneId = append(params.MilestoneId, paramsDotMilestoneIdVal)
-					return nil
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCountVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Count.SetTo(paramsDotCountVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "count",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetDocumentsCountParams is parameters of getDocumentsCount operation.
+type GetDocumentsCountParams struct {
+	ProjectIdOrKey string
+}
+
+func unpackGetDocumentsCountParams(packed middleware.Parameters) (params GetDocumentsCountParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "query",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetDocumentsCountParams(args [0]string, argsEscaped bool, r *http.Request) (params GetDocumentsCountParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: projectIdOrKey.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectIdOrKey = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectIdOrKey",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetIssueParams is parameters of getIssue operation.
+type GetIssueParams struct {
+	IssueIdOrKey string
+}
+
+func unpackGetIssueParams(packed middleware.Parameters) (params GetIssueParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "issueIdOrKey",
+			In:   "path",
+		}
+		params.IssueIdOrKey = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetIssueParams(args [1]string, argsEscaped bool, r *http.Request) (params GetIssueParams, _ error) {
+	// Decode path: issueIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issueIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueIdOrKey = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issueIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetIssueTypesParams is parameters of getIssueTypes operation.
+type GetIssueTypesParams struct {
+	ProjectIdOrKey string
+}
+
+func unpackGetIssueTypesParams(packed middleware.Parameters) (params GetIssueTypesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetIssueTypesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetIssueTypesParams, _ error) {
+	// Decode path: projectIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "projectIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectIdOrKey = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetIssuesParams is parameters of getIssues operation.
+type GetIssuesParams struct {
+	ProjectId      []int     `json:",omitempty"`
+	IssueTypeId    []int     `json:",omitempty"`
+	CategoryId     []int     `json:",omitempty"`
+	VersionId      []int     `json:",omitempty"`
+	MilestoneId    []int     `json:",omitempty"`
+	StatusId       []int     `json:",omitempty"`
+	PriorityId     []int     `json:",omitempty"`
+	AssigneeId     []int     `json:",omitempty"`
+	CreatedUserId  []int     `json:",omitempty"`
+	ResolutionId   []int     `json:",omitempty"`
+	ParentChild    OptInt    `json:",omitempty,omitzero"`
+	Attachment     OptBool   `json:",omitempty,omitzero"`
+	SharedFile     OptBool   `json:",omitempty,omitzero"`
+	Sort           OptString `json:",omitempty,omitzero"`
+	Order          OptString `json:",omitempty,omitzero"`
+	Offset         OptInt    `json:",omitempty,omitzero"`
+	Count          OptInt    `json:",omitempty,omitzero"`
+	Keyword        OptString `json:",omitempty,omitzero"`
+	CreatedSince   OptString `json:",omitempty,omitzero"`
+	CreatedUntil   OptString `json:",omitempty,omitzero"`
+	UpdatedSince   OptString `json:",omitempty,omitzero"`
+	UpdatedUntil   OptString `json:",omitempty,omitzero"`
+	StartDateSince OptString `json:",omitempty,omitzero"`
+	StartDateUntil OptString `json:",omitempty,omitzero"`
+	DueDateSince   OptString `json:",omitempty,omitzero"`
+	DueDateUntil   OptString `json:",omitempty,omitzero"`
+	ID             []int     `json:",omitempty"`
+	ParentIssueId  []int     `json:",omitempty"`
+}
+
+func unpackGetIssuesParams(packed middleware.Parameters) (params GetIssuesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ProjectId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issueTypeId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IssueTypeId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "categoryId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CategoryId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "versionId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.VersionId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "milestoneId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MilestoneId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "statusId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StatusId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "priorityId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PriorityId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "assigneeId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AssigneeId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "createdUserId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedUserId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "resolutionId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ResolutionId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "parentChild",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ParentChild = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "attachment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Attachment = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sharedFile",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SharedFile = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "count",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Count = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "keyword",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Keyword = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "createdSince",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedSince = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "createdUntil",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CreatedUntil = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "updatedSince",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UpdatedSince = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "updatedUntil",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UpdatedUntil = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "startDateSince",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDateSince = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "startDateUntil",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDateUntil = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dueDateSince",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DueDateSince = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "dueDateUntil",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DueDateUntil = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ID = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "parentIssueId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ParentIssueId = v.([]int)
+		}
+	}
+	return params
+}
+
+func decodeGetIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetIssuesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: projectId[].
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "projectId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotProjectIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotProjectIdVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.ProjectId = append(params.ProjectId, paramsDotProjectIdVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectId[]",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: issueTypeId[].
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "issueTypeId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotIssueTypeIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotIssueTypeIdVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.IssueTypeId = append(params.IssueTypeId, paramsDotIssueTypeIdVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issueTypeId[]",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: categoryId[].
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "categoryId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotCategoryIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotCategoryIdVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.CategoryId = append(params.CategoryId, paramsDotCategoryIdVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "categoryId[]",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: versionId[].
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "versionId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotVersionIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotVersionIdVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.VersionId = append(params.VersionId, paramsDotVersionIdVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "versionId[]",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: milestoneId[].
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "milestoneId[]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotMilestoneIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotMilestoneIdVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.MilestoneId = append(params.MilestoneId, paramsDotMilestoneIdVal)
+					return nil
 				})
 			}); err != nil {
 				return err
@@ -4485,12 +5099,258 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotParentChildVal = c
+					paramsDotParentChildVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ParentChild.SetTo(paramsDotParentChildVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "parentChild",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: attachment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "attachment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAttachmentVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAttachmentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Attachment.SetTo(paramsDotAttachmentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "attachment",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sharedFile.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sharedFile",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSharedFileVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSharedFileVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SharedFile.SetTo(paramsDotSharedFileVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sharedFile",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: keyword.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "keyword",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKeywordVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKeywordVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Keyword.SetTo(paramsDotKeywordVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "keyword",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: createdSince.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "createdSince",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedSinceVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedSinceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedSince.SetTo(paramsDotCreatedSinceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "createdSince",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: createdUntil.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "createdUntil",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCreatedUntilVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCreatedUntilVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CreatedUntil.SetTo(paramsDotCreatedUntilVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "createdUntil",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: updatedSince.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "updatedSince",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUpdatedSinceVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUpdatedSinceVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ParentChild.SetTo(paramsDotParentChildVal)
+				params.UpdatedSince.SetTo(paramsDotUpdatedSinceVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4499,39 +5359,39 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "parentChild",
+			Name: "updatedSince",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: attachment.
+	// Decode query: updatedUntil.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "attachment",
+			Name:    "updatedUntil",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAttachmentVal bool
+				var paramsDotUpdatedUntilVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAttachmentVal = c
+					paramsDotUpdatedUntilVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Attachment.SetTo(paramsDotAttachmentVal)
+				params.UpdatedUntil.SetTo(paramsDotUpdatedUntilVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4540,39 +5400,39 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "attachment",
+			Name: "updatedUntil",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sharedFile.
+	// Decode query: startDateSince.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sharedFile",
+			Name:    "startDateSince",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSharedFileVal bool
+				var paramsDotStartDateSinceVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSharedFileVal = c
+					paramsDotStartDateSinceVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.SharedFile.SetTo(paramsDotSharedFileVal)
+				params.StartDateSince.SetTo(paramsDotStartDateSinceVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4581,22 +5441,22 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sharedFile",
+			Name: "startDateSince",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: keyword.
+	// Decode query: startDateUntil.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "keyword",
+			Name:    "startDateUntil",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotKeywordVal string
+				var paramsDotStartDateUntilVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4608,12 +5468,12 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotKeywordVal = c
+					paramsDotStartDateUntilVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Keyword.SetTo(paramsDotKeywordVal)
+				params.StartDateUntil.SetTo(paramsDotStartDateUntilVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4622,22 +5482,22 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "keyword",
+			Name: "startDateUntil",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: createdSince.
+	// Decode query: dueDateSince.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "createdSince",
+			Name:    "dueDateSince",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCreatedSinceVal string
+				var paramsDotDueDateSinceVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4649,12 +5509,12 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotCreatedSinceVal = c
+					paramsDotDueDateSinceVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.CreatedSince.SetTo(paramsDotCreatedSinceVal)
+				params.DueDateSince.SetTo(paramsDotDueDateSinceVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4663,22 +5523,22 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "createdSince",
+			Name: "dueDateSince",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: createdUntil.
+	// Decode query: dueDateUntil.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "createdUntil",
+			Name:    "dueDateUntil",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCreatedUntilVal string
+				var paramsDotDueDateUntilVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4690,12 +5550,12 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotCreatedUntilVal = c
+					paramsDotDueDateUntilVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.CreatedUntil.SetTo(paramsDotCreatedUntilVal)
+				params.DueDateUntil.SetTo(paramsDotDueDateUntilVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4704,40 +5564,42 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "createdUntil",
+			Name: "dueDateUntil",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: updatedSince.
+	// Decode query: id[].
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "updatedSince",
+			Name:    "id[]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUpdatedSinceVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotIDVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToString(val)
-					if err != nil {
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotIDVal = c
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotUpdatedSinceVal = c
+					params.ID = append(params.ID, paramsDotIDVal)
 					return nil
-				}(); err != nil {
-					return err
-				}
-				params.UpdatedSince.SetTo(paramsDotUpdatedSinceVal)
-				return nil
+				})
 			}); err != nil {
 				return err
 			}
@@ -4745,186 +5607,409 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "updatedSince",
+			Name: "id[]",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: updatedUntil.
+	// Decode query: parentIssueId[].
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "updatedUntil",
+			Name:    "parentIssueId[]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUpdatedUntilVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotParentIssueIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToString(val)
-					if err != nil {
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotParentIssueIdVal = c
+						return nil
+					}(); err != nil {
 						return err
 					}
+					params.ParentIssueId = append(params.ParentIssueId, paramsDotParentIssueIdVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "parentIssueId[]",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetListOfIssueAttachmentsParams is parameters of getListOfIssueAttachments operation.
+type GetListOfIssueAttachmentsParams struct {
+	IssueIdOrKey string
+}
+
+func unpackGetListOfIssueAttachmentsParams(packed middleware.Parameters) (params GetListOfIssueAttachmentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "issueIdOrKey",
+			In:   "path",
+		}
+		params.IssueIdOrKey = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetListOfIssueAttachmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfIssueAttachmentsParams, _ error) {
+	// Decode path: issueIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issueIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueIdOrKey = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issueIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetListOfLinkedSharedFilesParams is parameters of getListOfLinkedSharedFiles operation.
+type GetListOfLinkedSharedFilesParams struct {
+	IssueIdOrKey string
+}
+
+func unpackGetListOfLinkedSharedFilesParams(packed middleware.Parameters) (params GetListOfLinkedSharedFilesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "issueIdOrKey",
+			In:   "path",
+		}
+		params.IssueIdOrKey = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetListOfLinkedSharedFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfLinkedSharedFilesParams, _ error) {
+	// Decode path: issueIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issueIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotUpdatedUntilVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UpdatedUntil.SetTo(paramsDotUpdatedUntilVal)
+
+				params.IssueIdOrKey = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "updatedUntil",
-			In:   "query",
+			Name: "issueIdOrKey",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: startDateSince.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDateSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// GetListOfPullRequestAttachmentParams is parameters of getListOfPullRequestAttachment operation.
+type GetListOfPullRequestAttachmentParams struct {
+	ProjectIdOrKey string
+	RepoIdOrName   string
+	Number         int
+}
+
+func unpackGetListOfPullRequestAttachmentParams(packed middleware.Parameters) (params GetListOfPullRequestAttachmentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "repoIdOrName",
+			In:   "path",
+		}
+		params.RepoIdOrName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "number",
+			In:   "path",
 		}
+		params.Number = packed[key].(int)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateSinceVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool, r *http.Request) (params GetListOfPullRequestAttachmentParams, _ error) {
+	// Decode path: projectIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "projectIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotStartDateSinceVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.StartDateSince.SetTo(paramsDotStartDateSinceVal)
+
+				params.ProjectIdOrKey = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startDateSince",
-			In:   "query",
+			Name: "projectIdOrKey",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: startDateUntil.
+	// Decode path: repoIdOrName.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDateUntil",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "repoIdOrName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateUntilVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotStartDateUntilVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.StartDateUntil.SetTo(paramsDotStartDateUntilVal)
+
+				params.RepoIdOrName = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startDateUntil",
-			In:   "query",
+			Name: "repoIdOrName",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: dueDateSince.
+	// Decode path: number.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "dueDateSince",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "number",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDueDateSinceVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotDueDateSinceVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt(val)
+				if err != nil {
 					return err
 				}
-				params.DueDateSince.SetTo(paramsDotDueDateSinceVal)
+
+				params.Number = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "dueDateSince",
-			In:   "query",
+			Name: "number",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: dueDateUntil.
+	return params, nil
+}
+
+// GetListOfRecentlyViewedIssuesParams is parameters of getListOfRecentlyViewedIssues operation.
+type GetListOfRecentlyViewedIssuesParams struct {
+	Order  OptString `json:",omitempty,omitzero"`
+	Offset OptInt    `json:",omitempty,omitzero"`
+	Count  OptInt    `json:",omitempty,omitzero"`
+}
+
+func unpackGetListOfRecentlyViewedIssuesParams(packed middleware.Parameters) (params GetListOfRecentlyViewedIssuesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "count",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Count = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetListOfRecentlyViewedIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetListOfRecentlyViewedIssuesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: order.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "dueDateUntil",
+			Name:    "order",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDueDateUntilVal string
+				var paramsDotOrderVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4936,12 +6021,12 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotDueDateUntilVal = c
+					paramsDotOrderVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.DueDateUntil.SetTo(paramsDotDueDateUntilVal)
+				params.Order.SetTo(paramsDotOrderVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4950,42 +6035,40 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "dueDateUntil",
+			Name: "order",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: id[].
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "id[]",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotIDVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotIDVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
 						return err
 					}
-					params.ID = append(params.ID, paramsDotIDVal)
+
+					paramsDotOffsetVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -4993,42 +6076,40 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id[]",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: parentIssueId[].
+	// Decode query: count.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "parentIssueId[]",
+			Name:    "count",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotParentIssueIdVal int
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToInt(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotCountVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotParentIssueIdVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
 						return err
 					}
-					params.ParentIssueId = append(params.ParentIssueId, paramsDotParentIssueIdVal)
+
+					paramsDotCountVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.Count.SetTo(paramsDotCountVal)
+				return nil
 			}); err != nil {
 				return err
 			}
@@ -5036,7 +6117,7 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "parentIssueId[]",
+			Name: "count",
 			In:   "query",
 			Err:  err,
 		}
@@ -5044,24 +6125,24 @@ func decodeGetIssuesCountParams(args [0]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetListOfIssueAttachmentsParams is parameters of getListOfIssueAttachments operation.
-type GetListOfIssueAttachmentsParams struct {
-	IssueIdOrKey string
+// GetListOfSharedFilesOnWikiParams is parameters of getListOfSharedFilesOnWiki operation.
+type GetListOfSharedFilesOnWikiParams struct {
+	WikiId int
 }
 
-func unpackGetListOfIssueAttachmentsParams(packed middleware.Parameters) (params GetListOfIssueAttachmentsParams) {
+func unpackGetListOfSharedFilesOnWikiParams(packed middleware.Parameters) (params GetListOfSharedFilesOnWikiParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
+			Name: "wikiId",
 			In:   "path",
 		}
-		params.IssueIdOrKey = packed[key].(string)
+		params.WikiId = packed[key].(int)
 	}
 	return params
 }
 
-func decodeGetListOfIssueAttachmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfIssueAttachmentsParams, _ error) {
-	// Decode path: issueIdOrKey.
+func decodeGetListOfSharedFilesOnWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfSharedFilesOnWikiParams, _ error) {
+	// Decode path: wikiId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5073,7 +6154,7 @@ func decodeGetListOfIssueAttachmentsParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "wikiId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5085,12 +6166,12 @@ func decodeGetListOfIssueAttachmentsParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.WikiId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5101,7 +6182,7 @@ func decodeGetListOfIssueAttachmentsParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "wikiId",
 			In:   "path",
 			Err:  err,
 		}
@@ -5109,24 +6190,24 @@ func decodeGetListOfIssueAttachmentsParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// GetListOfLinkedSharedFilesParams is parameters of getListOfLinkedSharedFiles operation.
-type GetListOfLinkedSharedFilesParams struct {
-	IssueIdOrKey string
+// GetListOfWikiAttachmentsParams is parameters of getListOfWikiAttachments operation.
+type GetListOfWikiAttachmentsParams struct {
+	WikiId int
 }
 
-func unpackGetListOfLinkedSharedFilesParams(packed middleware.Parameters) (params GetListOfLinkedSharedFilesParams) {
+func unpackGetListOfWikiAttachmentsParams(packed middleware.Parameters) (params GetListOfWikiAttachmentsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "issueIdOrKey",
+			Name: "wikiId",
 			In:   "path",
 		}
-		params.IssueIdOrKey = packed[key].(string)
+		params.WikiId = packed[key].(int)
 	}
 	return params
 }
 
-func decodeGetListOfLinkedSharedFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfLinkedSharedFilesParams, _ error) {
-	// Decode path: issueIdOrKey.
+func decodeGetListOfWikiAttachmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfWikiAttachmentsParams, _ error) {
+	// Decode path: wikiId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5138,7 +6219,7 @@ func decodeGetListOfLinkedSharedFilesParams(args [1]string, argsEscaped bool, r
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issueIdOrKey",
+				Param:   "wikiId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5150,12 +6231,12 @@ func decodeGetListOfLinkedSharedFilesParams(args [1]string, argsEscaped bool, r
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.IssueIdOrKey = c
+				params.WikiId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5166,7 +6247,7 @@ func decodeGetListOfLinkedSharedFilesParams(args [1]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issueIdOrKey",
+			Name: "wikiId",
 			In:   "path",
 			Err:  err,
 		}
@@ -5174,14 +6255,12 @@ func decodeGetListOfLinkedSharedFilesParams(args [1]string, argsEscaped bool, r
 	return params, nil
 }
 
-// GetListOfPullRequestAttachmentParams is parameters of getListOfPullRequestAttachment operation.
-type GetListOfPullRequestAttachmentParams struct {
+// GetProjectParams is parameters of getProject operation.
+type GetProjectParams struct {
 	ProjectIdOrKey string
-	RepoIdOrName   string
-	Number         int
 }
 
-func unpackGetListOfPullRequestAttachmentParams(packed middleware.Parameters) (params GetListOfPullRequestAttachmentParams) {
+func unpackGetProjectParams(packed middleware.Parameters) (params GetProjectParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "projectIdOrKey",
@@ -5189,24 +6268,10 @@ func unpackGetListOfPullRequestAttachmentParams(packed middleware.Parameters) (p
 		}
 		params.ProjectIdOrKey = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "repoIdOrName",
-			In:   "path",
-		}
-		params.RepoIdOrName = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "number",
-			In:   "path",
-		}
-		params.Number = packed[key].(int)
-	}
 	return params
 }
 
-func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool, r *http.Request) (params GetListOfPullRequestAttachmentParams, _ error) {
+func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectParams, _ error) {
 	// Decode path: projectIdOrKey.
 	if err := func() error {
 		param := args[0]
@@ -5252,11 +6317,31 @@ func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool
 			Err:  err,
 		}
 	}
-	// Decode path: repoIdOrName.
+	return params, nil
+}
+
+// GetProjectAdministratorsParams is parameters of getProjectAdministrators operation.
+type GetProjectAdministratorsParams struct {
+	ProjectIdOrKey string
+}
+
+func unpackGetProjectAdministratorsParams(packed middleware.Parameters) (params GetProjectAdministratorsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetProjectAdministratorsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectAdministratorsParams, _ error) {
+	// Decode path: projectIdOrKey.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5264,7 +6349,7 @@ func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "repoIdOrName",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5281,7 +6366,7 @@ func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool
 					return err
 				}
 
-				params.RepoIdOrName = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5289,19 +6374,90 @@ func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool
 		} else {
 			return validate.ErrFieldRequired
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "repoIdOrName",
-			In:   "path",
-			Err:  err,
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectRecentUpdatesParams is parameters of getProjectRecentUpdates operation.
+type GetProjectRecentUpdatesParams struct {
+	ProjectIdOrKey string
+	ActivityTypeId []int     `json:",omitempty"`
+	MinId          OptInt    `json:",omitempty,omitzero"`
+	MaxId          OptInt    `json:",omitempty,omitzero"`
+	Count          OptInt    `json:",omitempty,omitzero"`
+	Order          OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetProjectRecentUpdatesParams(packed middleware.Parameters) (params GetProjectRecentUpdatesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "activityTypeId[]",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ActivityTypeId = v.([]int)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "minId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MinId = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "maxId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MaxId = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "count",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Count = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptString)
 		}
 	}
-	// Decode path: number.
+	return params
+}
+
+func decodeGetProjectRecentUpdatesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectRecentUpdatesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: projectIdOrKey.
 	if err := func() error {
-		param := args[2]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5309,7 +6465,7 @@ func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "number",
+				Param:   "projectIdOrKey",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5321,12 +6477,12 @@ func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.Number = c
+				params.ProjectIdOrKey = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5337,83 +6493,42 @@ func decodeGetListOfPullRequestAttachmentParams(args [3]string, argsEscaped bool
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "number",
+			Name: "projectIdOrKey",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetListOfRecentlyViewedIssuesParams is parameters of getListOfRecentlyViewedIssues operation.
-type GetListOfRecentlyViewedIssuesParams struct {
-	Order  OptString `json:",omitempty,omitzero"`
-	Offset OptInt    `json:",omitempty,omitzero"`
-	Count  OptInt    `json:",omitempty,omitzero"`
-}
-
-func unpackGetListOfRecentlyViewedIssuesParams(packed middleware.Parameters) (params GetListOfRecentlyViewedIssuesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "order",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Order = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "count",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Count = v.(OptInt)
-		}
-	}
-	return params
-}
-
-func decodeGetListOfRecentlyViewedIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetListOfRecentlyViewedIssuesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: order.
+	// Decode query: activityTypeId[].
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order",
+			Name:    "activityTypeId[]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotActivityTypeIdVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToString(val)
-					if err != nil {
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotActivityTypeIdVal = c
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotOrderVal = c
+					params.ActivityTypeId = append(params.ActivityTypeId, paramsDotActivityTypeIdVal)
 					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Order.SetTo(paramsDotOrderVal)
-				return nil
+				})
 			}); err != nil {
 				return err
 			}
@@ -5421,22 +6536,22 @@ func decodeGetListOfRecentlyViewedIssuesParams(args [0]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order",
+			Name: "activityTypeId[]",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
+	// Decode query: minId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "minId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotMinIdVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -5448,12 +6563,12 @@ func decodeGetListOfRecentlyViewedIssuesParams(args [0]string, argsEscaped bool,
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotMinIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.MinId.SetTo(paramsDotMinIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5462,244 +6577,131 @@ func decodeGetListOfRecentlyViewedIssuesParams(args [0]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "minId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: count.
+	// Decode query: maxId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "count",
+			Name:    "maxId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCountVal int
+				var paramsDotMaxIdVal int
 				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotCountVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Count.SetTo(paramsDotCountVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "count",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetListOfSharedFilesOnWikiParams is parameters of getListOfSharedFilesOnWiki operation.
-type GetListOfSharedFilesOnWikiParams struct {
-	WikiId int
-}
-
-func unpackGetListOfSharedFilesOnWikiParams(packed middleware.Parameters) (params GetListOfSharedFilesOnWikiParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "wikiId",
-			In:   "path",
-		}
-		params.WikiId = packed[key].(int)
-	}
-	return params
-}
-
-func decodeGetListOfSharedFilesOnWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfSharedFilesOnWikiParams, _ error) {
-	// Decode path: wikiId.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "wikiId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToInt(val)
-				if err != nil {
-					return err
-				}
-
-				params.WikiId = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "wikiId",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetListOfWikiAttachmentsParams is parameters of getListOfWikiAttachments operation.
-type GetListOfWikiAttachmentsParams struct {
-	WikiId int
-}
-
-func unpackGetListOfWikiAttachmentsParams(packed middleware.Parameters) (params GetListOfWikiAttachmentsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "wikiId",
-			In:   "path",
-		}
-		params.WikiId = packed[key].(int)
-	}
-	return params
-}
-
-func decodeGetListOfWikiAttachmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetListOfWikiAttachmentsParams, _ error) {
-	// Decode path: wikiId.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "wikiId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt(val)
-				if err != nil {
+					paramsDotMaxIdVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.WikiId = c
+				params.MaxId.SetTo(paramsDotMaxIdVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "wikiId",
-			In:   "path",
+			Name: "maxId",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: count.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "count",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// GetProjectParams is parameters of getProject operation.
-type GetProjectParams struct {
-	ProjectIdOrKey string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCountVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackGetProjectParams(packed middleware.Parameters) (params GetProjectParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "projectIdOrKey",
-			In:   "path",
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCountVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Count.SetTo(paramsDotCountVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "count",
+			In:   "query",
+			Err:  err,
 		}
-		params.ProjectIdOrKey = packed[key].(string)
 	}
-	return params
-}
-
-func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectParams, _ error) {
-	// Decode path: projectIdOrKey.
+	// Decode query: order.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "projectIdOrKey",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ProjectIdOrKey = c
+				params.Order.SetTo(paramsDotOrderVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "projectIdOrKey",
-			In:   "path",
+			Name: "order",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -7194,6 +8196,71 @@ func decodeGetVersionsParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
+// GetWebhooksParams is parameters of getWebhooks operation.
+type GetWebhooksParams struct {
+	ProjectIdOrKey string
+}
+
+func unpackGetWebhooksParams(packed middleware.Parameters) (params GetWebhooksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetWebhooksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWebhooksParams, _ error) {
+	// Decode path: projectIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "projectIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectIdOrKey = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // GetWikiParams is parameters of getWiki operation.
 type GetWikiParams struct {
 	WikiId int
@@ -7205,54 +8272,111 @@ func unpackGetWikiParams(packed middleware.Parameters) (params GetWikiParams) {
 			Name: "wikiId",
 			In:   "path",
 		}
-		params.WikiId = packed[key].(int)
+		params.WikiId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWikiParams, _ error) {
+	// Decode path: wikiId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "wikiId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.WikiId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "wikiId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWikiTagsParams is parameters of getWikiTags operation.
+type GetWikiTagsParams struct {
+	ProjectIdOrKey string
+}
+
+func unpackGetWikiTagsParams(packed middleware.Parameters) (params GetWikiTagsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "query",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetWikiParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWikiParams, _ error) {
-	// Decode path: wikiId.
+func decodeGetWikiTagsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetWikiTagsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: projectIdOrKey.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "projectIdOrKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "wikiId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.WikiId = c
+				params.ProjectIdOrKey = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "wikiId",
-			In:   "path",
+			Name: "projectIdOrKey",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -8040,6 +9164,124 @@ func decodeUpdateCommentParams(args [2]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
+// UpdateCustomFieldParams is parameters of updateCustomField operation.
+type UpdateCustomFieldParams struct {
+	ProjectIdOrKey string
+	CustomFieldId  int
+}
+
+func unpackUpdateCustomFieldParams(packed middleware.Parameters) (params UpdateCustomFieldParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "customFieldId",
+			In:   "path",
+		}
+		params.CustomFieldId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeUpdateCustomFieldParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateCustomFieldParams, _ error) {
+	// Decode path: projectIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "projectIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectIdOrKey = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: customFieldId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "customFieldId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.CustomFieldId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "customFieldId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // UpdateIssueParams is parameters of updateIssue operation.
 type UpdateIssueParams struct {
 	IssueIdOrKey string
@@ -8105,6 +9347,124 @@ func decodeUpdateIssueParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
+// UpdateWebhookParams is parameters of updateWebhook operation.
+type UpdateWebhookParams struct {
+	ProjectIdOrKey string
+	WebhookId      int
+}
+
+func unpackUpdateWebhookParams(packed middleware.Parameters) (params UpdateWebhookParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "projectIdOrKey",
+			In:   "path",
+		}
+		params.ProjectIdOrKey = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "webhookId",
+			In:   "path",
+		}
+		params.WebhookId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeUpdateWebhookParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateWebhookParams, _ error) {
+	// Decode path: projectIdOrKey.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "projectIdOrKey",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectIdOrKey = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "projectIdOrKey",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: webhookId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "webhookId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.WebhookId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "webhookId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // UpdateWikiParams is parameters of updateWiki operation.
 type UpdateWikiParams struct {
 	WikiId int
@@ -663,6 +663,72 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
+							case 'a': // Prefix: "a"
+
+								if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "ctivities"
+
+									if l := len("ctivities"); len(elem) >= l && elem[0:l] == "ctivities" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetProjectRecentUpdatesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'd': // Prefix: "dministrators"
+
+									if l := len("dministrators"); len(elem) >= l && elem[0:l] == "dministrators" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteProjectAdministratorRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetProjectAdministratorsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleAddProjectAdministratorRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,POST")
+										}
+
+										return
+									}
+
+								}
+
 							case 'c': // Prefix: "c"
 
 								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
@@ -743,18 +809,113 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
 										case "GET":
 											s.handleGetCustomFieldsRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateCustomFieldRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "GET,POST")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "customFieldId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "PATCH":
+												s.handleUpdateCustomFieldRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "PATCH")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/items"
+
+											if l := len("/items"); len(elem) >= l && elem[0:l] == "/items" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "POST":
+													s.handleAddCustomFieldItemRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "itemId"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[2] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleDeleteCustomFieldItemRequest([3]string{
+															args[0],
+															args[1],
+															args[2],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE")
+													}
+
+													return
+												}
+
+											}
+
+										}
+
+									}
 
 								}
 
@@ -1005,12 +1166,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
+									case "DELETE":
+										s.handleDeleteProjectUserRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									case "GET":
 										s.handleGetProjectUsersRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleAddProjectUserRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "GET")
+										s.notAllowed(w, r, "DELETE,GET,POST")
 									}
 
 									return
@@ -1038,6 +1207,61 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
+							case 'w': // Prefix: "webhooks"
+
+								if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetWebhooksRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "webhookId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "PATCH":
+											s.handleUpdateWebhookRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PATCH")
+										}
+
+										return
+									}
+
+								}
+
 							}
 
 						}
@@ -1261,6 +1485,27 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
+						elem = origElem
+					case 't': // Prefix: "tags"
+						origElem := elem
+						if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetWikiTagsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
 						elem = origElem
 					}
 					// Param: "wikiId"
@@ -2249,6 +2494,88 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
+							case 'a': // Prefix: "a"
+
+								if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "ctivities"
+
+									if l := len("ctivities"); len(elem) >= l && elem[0:l] == "ctivities" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetProjectRecentUpdatesOperation
+											r.summary = "Get project recent updates"
+											r.operationID = "getProjectRecentUpdates"
+											r.operationGroup = ""
+											r.pathPattern = "/projects/{projectIdOrKey}/activities"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'd': // Prefix: "dministrators"
+
+									if l := len("dministrators"); len(elem) >= l && elem[0:l] == "dministrators" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteProjectAdministratorOperation
+											r.summary = "Delete project administrator"
+											r.operationID = "deleteProjectAdministrator"
+											r.operationGroup = ""
+											r.pathPattern = "/projects/{projectIdOrKey}/administrators"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetProjectAdministratorsOperation
+											r.summary = "Get project administrators"
+											r.operationID = "getProjectAdministrators"
+											r.operationGroup = ""
+											r.pathPattern = "/projects/{projectIdOrKey}/administrators"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = AddProjectAdministratorOperation
+											r.summary = "Add project administrator"
+											r.operationID = "addProjectAdministrator"
+											r.operationGroup = ""
+											r.pathPattern = "/projects/{projectIdOrKey}/administrators"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
 							case 'c': // Prefix: "c"
 
 								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
@@ -2339,7 +2666,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch method {
 										case "GET":
 											r.name = GetCustomFieldsOperation
@@ -2350,10 +2676,116 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 											r.args = args
 											r.count = 1
 											return r, true
+										case "POST":
+											r.name = CreateCustomFieldOperation
+											r.summary = "Add custom field"
+											r.operationID = "createCustomField"
+											r.operationGroup = ""
+											r.pathPattern = "/projects/{projectIdOrKey}/customFields"
+											r.args = args
+											r.count = 1
+											return r, true
 										default:
 											return
 										}
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "customFieldId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "PATCH":
+												r.name = UpdateCustomFieldOperation
+												r.summary = "Update custom field"
+												r.operationID = "updateCustomField"
+												r.operationGroup = ""
+												r.pathPattern = "/projects/{projectIdOrKey}/customFields/{customFieldId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/items"
+
+											if l := len("/items"); len(elem) >= l && elem[0:l] == "/items" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "POST":
+													r.name = AddCustomFieldItemOperation
+													r.summary = "Add list item for list type custom field"
+													r.operationID = "addCustomFieldItem"
+													r.operationGroup = ""
+													r.pathPattern = "/projects/{projectIdOrKey}/customFields/{customFieldId}/items"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "itemId"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[2] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "DELETE":
+														r.name = DeleteCustomFieldItemOperation
+														r.summary = "Delete list item for list type custom field"
+														r.operationID = "deleteCustomFieldItem"
+														r.operationGroup = ""
+														r.pathPattern = "/projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}"
+														r.args = args
+														r.count = 3
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									}
 
 								}
 
@@ -2619,6 +3051,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
+									case "DELETE":
+										r.name = DeleteProjectUserOperation
+										r.summary = "Delete project user"
+										r.operationID = "deleteProjectUser"
+										r.operationGroup = ""
+										r.pathPattern = "/projects/{projectIdOrKey}/users"
+										r.args = args
+										r.count = 1
+										return r, true
 									case "GET":
 										r.name = GetProjectUsersOperation
 										r.summary = "Get project users"
@@ -2628,6 +3069,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										r.args = args
 										r.count = 1
 										return r, true
+									case "POST":
+										r.name = AddProjectUserOperation
+										r.summary = "Add project user"
+										r.operationID = "addProjectUser"
+										r.operationGroup = ""
+										r.pathPattern = "/projects/{projectIdOrKey}/users"
+										r.args = args
+										r.count = 1
+										return r, true
 									default:
 										return
 									}
@@ -2658,6 +3108,66 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 								}
 
+							case 'w': // Prefix: "webhooks"
+
+								if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetWebhooksOperation
+										r.summary = "Get webhooks"
+										r.operationID = "getWebhooks"
+										r.operationGroup = ""
+										r.pathPattern = "/projects/{projectIdOrKey}/webhooks"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "webhookId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "PATCH":
+											r.name = UpdateWebhookOperation
+											r.summary = "Update webhook"
+											r.operationID = "updateWebhook"
+											r.operationGroup = ""
+											r.pathPattern = "/projects/{projectIdOrKey}/webhooks/{webhookId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
 							}
 
 						}
@@ -2929,6 +3439,32 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+						elem = origElem
+					case 't': // Prefix: "tags"
+						origElem := elem
+						if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetWikiTagsOperation
+								r.summary = "Get wiki page tag list"
+								r.operationID = "getWikiTags"
+								r.operationGroup = ""
+								r.pathPattern = "/wikis/tags"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 						elem = origElem
 					}
 					// Param: "wikiId"
@@ -82,6 +82,7 @@ type ActivityContent struct {
 	KeyID       OptNilInt    `json:"key_id"`
 	Summary     OptNilString `json:"summary"`
 	Description OptNilString `json:"description"`
+	Name        OptNilString `json:"name"`
 }
 
 // GetID returns the value of ID.
@@ -104,6 +105,11 @@ func (s *ActivityContent) GetDescription() OptNilString {
 	return s.Description
 }
 
+// GetName returns the value of Name.
+func (s *ActivityContent) GetName() OptNilString {
+	return s.Name
+}
+
 // SetID sets the value of ID.
 func (s *ActivityContent) SetID(val OptNilInt) {
 	s.ID = val
@@ -124,6 +130,11 @@ func (s *ActivityContent) SetDescription(val OptNilString) {
 	s.Description = val
 }
 
+// SetName sets the value of Name.
+func (s *ActivityContent) SetName(val OptNilString) {
+	s.Name = val
+}
+
 type AddCommentReq struct {
 	Content        string `json:"content"`
 	NotifiedUserId []int  `json:"notifiedUserId[]"`
@@ -149,6 +160,20 @@ func (s *AddCommentReq) SetNotifiedUserId(val []int) {
 	s.NotifiedUserId = val
 }
 
+type AddCustomFieldItemReq struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *AddCustomFieldItemReq) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *AddCustomFieldItemReq) SetName(val string) {
+	s.Name = val
+}
+
 type AddDocumentTagsReq struct {
 	TagNames []string `json:"tagNames[]"`
 }
@@ -163,6 +188,34 @@ func (s *AddDocumentTagsReq) SetTagNames(val []string) {
 	s.TagNames = val
 }
 
+type AddProjectAdministratorReq struct {
+	UserId int `json:"userId"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *AddProjectAdministratorReq) GetUserId() int {
+	return s.UserId
+}
+
+// SetUserId sets the value of UserId.
+func (s *AddProjectAdministratorReq) SetUserId(val int) {
+	s.UserId = val
+}
+
+type AddProjectUserReq struct {
+	UserId int `json:"userId"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *AddProjectUserReq) GetUserId() int {
+	return s.UserId
+}
+
+// SetUserId sets the value of UserId.
+func (s *AddProjectUserReq) SetUserId(val int) {
+	s.UserId = val
+}
+
 type ApiKey struct {
 	APIKey string
 	Roles  []string
@@ -441,6 +494,86 @@ func (s *CreateCategoryReq) SetName(val string) {
 	s.Name = val
 }
 
+type CreateCustomFieldReq struct {
+	TypeId               int       `json:"typeId"`
+	Name                 string    `json:"name"`
+	Description          OptString `json:"description"`
+	Required             OptBool   `json:"required"`
+	ApplicableIssueTypes []int     `json:"applicableIssueTypes[]"`
+	Items                []string  `json:"items[]"`
+	AllowAddItem         OptBool   `json:"allowAddItem"`
+}
+
+// GetTypeId returns the value of TypeId.
+func (s *CreateCustomFieldReq) GetTypeId() int {
+	return s.TypeId
+}
+
+// GetName returns the value of Name.
+func (s *CreateCustomFieldReq) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateCustomFieldReq) GetDescription() OptString {
+	return s.Description
+}
+
+// GetRequired returns the value of Required.
+func (s *CreateCustomFieldReq) GetRequired() OptBool {
+	return s.Required
+}
+
+// GetApplicableIssueTypes returns the value of ApplicableIssueTypes.
+func (s *CreateCustomFieldReq) GetApplicableIssueTypes() []int {
+	return s.ApplicableIssueTypes
+}
+
+// GetItems returns the value of Items.
+func (s *CreateCustomFieldReq) GetItems() []string {
+	return s.Items
+}
+
+// GetAllowAddItem returns the value of AllowAddItem.
+func (s *CreateCustomFieldReq) GetAllowAddItem() OptBool {
+	return s.AllowAddItem
+}
+
+// SetTypeId sets the value of TypeId.
+func (s *CreateCustomFieldReq) SetTypeId(val int) {
+	s.TypeId = val
+}
+
+// SetName sets the value of Name.
+func (s *CreateCustomFieldReq) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateCustomFieldReq) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetRequired sets the value of Required.
+func (s *CreateCustomFieldReq) SetRequired(val OptBool) {
+	s.Required = val
+}
+
+// SetApplicableIssueTypes sets the value of ApplicableIssueTypes.
+func (s *CreateCustomFieldReq) SetApplicableIssueTypes(val []int) {
+	s.ApplicableIssueTypes = val
+}
+
+// SetItems sets the value of Items.
+func (s *CreateCustomFieldReq) SetItems(val []string) {
+	s.Items = val
+}
+
+// SetAllowAddItem sets the value of AllowAddItem.
+func (s *CreateCustomFieldReq) SetAllowAddItem(val OptBool) {
+	s.AllowAddItem = val
+}
+
 type CreateDocumentReq struct {
 	ProjectId int       `json:"projectId"`
 	Title     OptString `json:"title"`
@@ -525,6 +658,7 @@ type CreateIssueReq struct {
 	MilestoneId    []int      `json:"milestoneId[]"`
 	AssigneeId     OptInt     `json:"assigneeId"`
 	ParentIssueId  OptInt     `json:"parentIssueId"`
+	NotifiedUserId []int      `json:"notifiedUserId[]"`
 	AttachmentId   []int      `json:"attachmentId[]"`
 }
 
@@ -598,6 +732,11 @@ func (s *CreateIssueReq) GetParentIssueId() OptInt {
 	return s.ParentIssueId
 }
 
+// GetNotifiedUserId returns the value of NotifiedUserId.
+func (s *CreateIssueReq) GetNotifiedUserId() []int {
+	return s.NotifiedUserId
+}
+
 // GetAttachmentId returns the value of AttachmentId.
 func (s *CreateIssueReq) GetAttachmentId() []int {
 	return s.AttachmentId
@@ -673,6 +812,11 @@ func (s *CreateIssueReq) SetParentIssueId(val OptInt) {
 	s.ParentIssueId = val
 }
 
+// SetNotifiedUserId sets the value of NotifiedUserId.
+func (s *CreateIssueReq) SetNotifiedUserId(val []int) {
+	s.NotifiedUserId = val
+}
+
 // SetAttachmentId sets the value of AttachmentId.
 func (s *CreateIssueReq) SetAttachmentId(val []int) {
 	s.AttachmentId = val
@@ -854,6 +998,34 @@ func (s *CustomFieldItem) SetDisplayOrder(val OptInt) {
 	s.DisplayOrder = val
 }
 
+type DeleteProjectAdministratorReq struct {
+	UserId int `json:"userId"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *DeleteProjectAdministratorReq) GetUserId() int {
+	return s.UserId
+}
+
+// SetUserId sets the value of UserId.
+func (s *DeleteProjectAdministratorReq) SetUserId(val int) {
+	s.UserId = val
+}
+
+type DeleteProjectUserReq struct {
+	UserId int `json:"userId"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *DeleteProjectUserReq) GetUserId() int {
+	return s.UserId
+}
+
+// SetUserId sets the value of UserId.
+func (s *DeleteProjectUserReq) SetUserId(val int) {
+	s.UserId = val
+}
+
 // Ref: #/components/schemas/Document
 type Document struct {
 	ID          OptString     `json:"id"`
@@ -1980,6 +2152,52 @@ func (o OptAddCommentReq) Or(d AddCommentReq) AddCommentReq {
 	return d
 }
 
+// NewOptAddCustomFieldItemReq returns new OptAddCustomFieldItemReq with value set to v.
+func NewOptAddCustomFieldItemReq(v AddCustomFieldItemReq) OptAddCustomFieldItemReq {
+	return OptAddCustomFieldItemReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddCustomFieldItemReq is optional AddCustomFieldItemReq.
+type OptAddCustomFieldItemReq struct {
+	Value AddCustomFieldItemReq
+	Set   bool
+}
+
+// IsSet returns true if OptAddCustomFieldItemReq was set.
+func (o OptAddCustomFieldItemReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddCustomFieldItemReq) Reset() {
+	var v AddCustomFieldItemReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddCustomFieldItemReq) SetTo(v AddCustomFieldItemReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddCustomFieldItemReq) Get() (v AddCustomFieldItemReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddCustomFieldItemReq) Or(d AddCustomFieldItemReq) AddCustomFieldItemReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptAddDocumentTagsReq returns new OptAddDocumentTagsReq with value set to v.
 func NewOptAddDocumentTagsReq(v AddDocumentTagsReq) OptAddDocumentTagsReq {
 	return OptAddDocumentTagsReq{
@@ -2026,6 +2244,98 @@ func (o OptAddDocumentTagsReq) Or(d AddDocumentTagsReq) AddDocumentTagsReq {
 	return d
 }
 
+// NewOptAddProjectAdministratorReq returns new OptAddProjectAdministratorReq with value set to v.
+func NewOptAddProjectAdministratorReq(v AddProjectAdministratorReq) OptAddProjectAdministratorReq {
+	return OptAddProjectAdministratorReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddProjectAdministratorReq is optional AddProjectAdministratorReq.
+type OptAddProjectAdministratorReq struct {
+	Value AddProjectAdministratorReq
+	Set   bool
+}
+
+// IsSet returns true if OptAddProjectAdministratorReq was set.
+func (o OptAddProjectAdministratorReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddProjectAdministratorReq) Reset() {
+	var v AddProjectAdministratorReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddProjectAdministratorReq) SetTo(v AddProjectAdministratorReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddProjectAdministratorReq) Get() (v AddProjectAdministratorReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddProjectAdministratorReq) Or(d AddProjectAdministratorReq) AddProjectAdministratorReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAddProjectUserReq returns new OptAddProjectUserReq with value set to v.
+func NewOptAddProjectUserReq(v AddProjectUserReq) OptAddProjectUserReq {
+	return OptAddProjectUserReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddProjectUserReq is optional AddProjectUserReq.
+type OptAddProjectUserReq struct {
+	Value AddProjectUserReq
+	Set   bool
+}
+
+// IsSet returns true if OptAddProjectUserReq was set.
+func (o OptAddProjectUserReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddProjectUserReq) Reset() {
+	var v AddProjectUserReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddProjectUserReq) SetTo(v AddProjectUserReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddProjectUserReq) Get() (v AddProjectUserReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddProjectUserReq) Or(d AddProjectUserReq) AddProjectUserReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptAttachFileToWikiReq returns new OptAttachFileToWikiReq with value set to v.
 func NewOptAttachFileToWikiReq(v AttachFileToWikiReq) OptAttachFileToWikiReq {
 	return OptAttachFileToWikiReq{
@@ -2164,6 +2474,52 @@ func (o OptCreateCategoryReq) Or(d CreateCategoryReq) CreateCategoryReq {
 	return d
 }
 
+// NewOptCreateCustomFieldReq returns new OptCreateCustomFieldReq with value set to v.
+func NewOptCreateCustomFieldReq(v CreateCustomFieldReq) OptCreateCustomFieldReq {
+	return OptCreateCustomFieldReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateCustomFieldReq is optional CreateCustomFieldReq.
+type OptCreateCustomFieldReq struct {
+	Value CreateCustomFieldReq
+	Set   bool
+}
+
+// IsSet returns true if OptCreateCustomFieldReq was set.
+func (o OptCreateCustomFieldReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateCustomFieldReq) Reset() {
+	var v CreateCustomFieldReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateCustomFieldReq) SetTo(v CreateCustomFieldReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateCustomFieldReq) Get() (v CreateCustomFieldReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateCustomFieldReq) Or(d CreateCustomFieldReq) CreateCustomFieldReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptCreateDocumentReq returns new OptCreateDocumentReq with value set to v.
 func NewOptCreateDocumentReq(v CreateDocumentReq) OptCreateDocumentReq {
 	return OptCreateDocumentReq{
@@ -2172,30 +2528,122 @@ func NewOptCreateDocumentReq(v CreateDocumentReq) OptCreateDocumentReq {
 	}
 }
 
-// OptCreateDocumentReq is optional CreateDocumentReq.
-type OptCreateDocumentReq struct {
-	Value CreateDocumentReq
+// OptCreateDocumentReq is optional CreateDocumentReq.
+type OptCreateDocumentReq struct {
+	Value CreateDocumentReq
+	Set   bool
+}
+
+// IsSet returns true if OptCreateDocumentReq was set.
+func (o OptCreateDocumentReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateDocumentReq) Reset() {
+	var v CreateDocumentReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateDocumentReq) SetTo(v CreateDocumentReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateDocumentReq) Get() (v CreateDocumentReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateDocumentReq) Or(d CreateDocumentReq) CreateDocumentReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateIssueReq returns new OptCreateIssueReq with value set to v.
+func NewOptCreateIssueReq(v CreateIssueReq) OptCreateIssueReq {
+	return OptCreateIssueReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateIssueReq is optional CreateIssueReq.
+type OptCreateIssueReq struct {
+	Value CreateIssueReq
+	Set   bool
+}
+
+// IsSet returns true if OptCreateIssueReq was set.
+func (o OptCreateIssueReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateIssueReq) Reset() {
+	var v CreateIssueReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateIssueReq) SetTo(v CreateIssueReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateIssueReq) Get() (v CreateIssueReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateIssueReq) Or(d CreateIssueReq) CreateIssueReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateWikiReq returns new OptCreateWikiReq with value set to v.
+func NewOptCreateWikiReq(v CreateWikiReq) OptCreateWikiReq {
+	return OptCreateWikiReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateWikiReq is optional CreateWikiReq.
+type OptCreateWikiReq struct {
+	Value CreateWikiReq
 	Set   bool
 }
 
-// IsSet returns true if OptCreateDocumentReq was set.
-func (o OptCreateDocumentReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptCreateWikiReq was set.
+func (o OptCreateWikiReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCreateDocumentReq) Reset() {
-	var v CreateDocumentReq
+func (o *OptCreateWikiReq) Reset() {
+	var v CreateWikiReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCreateDocumentReq) SetTo(v CreateDocumentReq) {
+func (o *OptCreateWikiReq) SetTo(v CreateWikiReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCreateDocumentReq) Get() (v CreateDocumentReq, ok bool) {
+func (o OptCreateWikiReq) Get() (v CreateWikiReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2203,45 +2651,45 @@ func (o OptCreateDocumentReq) Get() (v CreateDocumentReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCreateDocumentReq) Or(d CreateDocumentReq) CreateDocumentReq {
+func (o OptCreateWikiReq) Or(d CreateWikiReq) CreateWikiReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCreateIssueReq returns new OptCreateIssueReq with value set to v.
-func NewOptCreateIssueReq(v CreateIssueReq) OptCreateIssueReq {
-	return OptCreateIssueReq{
+// NewOptDeleteProjectAdministratorReq returns new OptDeleteProjectAdministratorReq with value set to v.
+func NewOptDeleteProjectAdministratorReq(v DeleteProjectAdministratorReq) OptDeleteProjectAdministratorReq {
+	return OptDeleteProjectAdministratorReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCreateIssueReq is optional CreateIssueReq.
-type OptCreateIssueReq struct {
-	Value CreateIssueReq
+// OptDeleteProjectAdministratorReq is optional DeleteProjectAdministratorReq.
+type OptDeleteProjectAdministratorReq struct {
+	Value DeleteProjectAdministratorReq
 	Set   bool
 }
 
-// IsSet returns true if OptCreateIssueReq was set.
-func (o OptCreateIssueReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptDeleteProjectAdministratorReq was set.
+func (o OptDeleteProjectAdministratorReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCreateIssueReq) Reset() {
-	var v CreateIssueReq
+func (o *OptDeleteProjectAdministratorReq) Reset() {
+	var v DeleteProjectAdministratorReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCreateIssueReq) SetTo(v CreateIssueReq) {
+func (o *OptDeleteProjectAdministratorReq) SetTo(v DeleteProjectAdministratorReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCreateIssueReq) Get() (v CreateIssueReq, ok bool) {
+func (o OptDeleteProjectAdministratorReq) Get() (v DeleteProjectAdministratorReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2249,45 +2697,45 @@ func (o OptCreateIssueReq) Get() (v CreateIssueReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCreateIssueReq) Or(d CreateIssueReq) CreateIssueReq {
+func (o OptDeleteProjectAdministratorReq) Or(d DeleteProjectAdministratorReq) DeleteProjectAdministratorReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCreateWikiReq returns new OptCreateWikiReq with value set to v.
-func NewOptCreateWikiReq(v CreateWikiReq) OptCreateWikiReq {
-	return OptCreateWikiReq{
+// NewOptDeleteProjectUserReq returns new OptDeleteProjectUserReq with value set to v.
+func NewOptDeleteProjectUserReq(v DeleteProjectUserReq) OptDeleteProjectUserReq {
+	return OptDeleteProjectUserReq{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCreateWikiReq is optional CreateWikiReq.
-type OptCreateWikiReq struct {
-	Value CreateWikiReq
+// OptDeleteProjectUserReq is optional DeleteProjectUserReq.
+type OptDeleteProjectUserReq struct {
+	Value DeleteProjectUserReq
 	Set   bool
 }
 
-// IsSet returns true if OptCreateWikiReq was set.
-func (o OptCreateWikiReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptDeleteProjectUserReq was set.
+func (o OptDeleteProjectUserReq) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCreateWikiReq) Reset() {
-	var v CreateWikiReq
+func (o *OptDeleteProjectUserReq) Reset() {
+	var v DeleteProjectUserReq
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCreateWikiReq) SetTo(v CreateWikiReq) {
+func (o *OptDeleteProjectUserReq) SetTo(v DeleteProjectUserReq) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCreateWikiReq) Get() (v CreateWikiReq, ok bool) {
+func (o OptDeleteProjectUserReq) Get() (v DeleteProjectUserReq, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2295,7 +2743,7 @@ func (o OptCreateWikiReq) Get() (v CreateWikiReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCreateWikiReq) Or(d CreateWikiReq) CreateWikiReq {
+func (o OptDeleteProjectUserReq) Or(d DeleteProjectUserReq) DeleteProjectUserReq {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -3421,6 +3869,52 @@ func (o OptUpdateCommentReq) Or(d UpdateCommentReq) UpdateCommentReq {
 	return d
 }
 
+// NewOptUpdateCustomFieldReq returns new OptUpdateCustomFieldReq with value set to v.
+func NewOptUpdateCustomFieldReq(v UpdateCustomFieldReq) OptUpdateCustomFieldReq {
+	return OptUpdateCustomFieldReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateCustomFieldReq is optional UpdateCustomFieldReq.
+type OptUpdateCustomFieldReq struct {
+	Value UpdateCustomFieldReq
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateCustomFieldReq was set.
+func (o OptUpdateCustomFieldReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateCustomFieldReq) Reset() {
+	var v UpdateCustomFieldReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateCustomFieldReq) SetTo(v UpdateCustomFieldReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateCustomFieldReq) Get() (v UpdateCustomFieldReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateCustomFieldReq) Or(d UpdateCustomFieldReq) UpdateCustomFieldReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptUpdateIssueReq returns new OptUpdateIssueReq with value set to v.
 func NewOptUpdateIssueReq(v UpdateIssueReq) OptUpdateIssueReq {
 	return OptUpdateIssueReq{
@@ -3467,6 +3961,52 @@ func (o OptUpdateIssueReq) Or(d UpdateIssueReq) UpdateIssueReq {
 	return d
 }
 
+// NewOptUpdateWebhookReq returns new OptUpdateWebhookReq with value set to v.
+func NewOptUpdateWebhookReq(v UpdateWebhookReq) OptUpdateWebhookReq {
+	return OptUpdateWebhookReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateWebhookReq is optional UpdateWebhookReq.
+type OptUpdateWebhookReq struct {
+	Value UpdateWebhookReq
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateWebhookReq was set.
+func (o OptUpdateWebhookReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateWebhookReq) Reset() {
+	var v UpdateWebhookReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateWebhookReq) SetTo(v UpdateWebhookReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateWebhookReq) Get() (v UpdateWebhookReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateWebhookReq) Or(d UpdateWebhookReq) UpdateWebhookReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptUpdateWikiReq returns new OptUpdateWikiReq with value set to v.
 func NewOptUpdateWikiReq(v UpdateWikiReq) OptUpdateWikiReq {
 	return OptUpdateWikiReq{
@@ -4555,6 +5095,53 @@ func (s *UpdateCommentReq) SetContent(val OptString) {
 	s.Content = val
 }
 
+type UpdateCustomFieldReq struct {
+	Name                 OptString `json:"name"`
+	Description          OptString `json:"description"`
+	Required             OptBool   `json:"required"`
+	ApplicableIssueTypes []int     `json:"applicableIssueTypes[]"`
+}
+
+// GetName returns the value of Name.
+func (s *UpdateCustomFieldReq) GetName() OptString {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *UpdateCustomFieldReq) GetDescription() OptString {
+	return s.Description
+}
+
+// GetRequired returns the value of Required.
+func (s *UpdateCustomFieldReq) GetRequired() OptBool {
+	return s.Required
+}
+
+// GetApplicableIssueTypes returns the value of ApplicableIssueTypes.
+func (s *UpdateCustomFieldReq) GetApplicableIssueTypes() []int {
+	return s.ApplicableIssueTypes
+}
+
+// SetName sets the value of Name.
+func (s *UpdateCustomFieldReq) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *UpdateCustomFieldReq) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetRequired sets the value of Required.
+func (s *UpdateCustomFieldReq) SetRequired(val OptBool) {
+	s.Required = val
+}
+
+// SetApplicableIssueTypes sets the value of ApplicableIssueTypes.
+func (s *UpdateCustomFieldReq) SetApplicableIssueTypes(val []int) {
+	s.ApplicableIssueTypes = val
+}
+
 type UpdateIssueReq struct {
 	Summary        OptString  `json:"summary"`
 	Description    OptString  `json:"description"`
@@ -4567,6 +5154,7 @@ type UpdateIssueReq struct {
 	EstimatedHours OptFloat64 `json:"estimatedHours"`
 	ActualHours    OptFloat64 `json:"actualHours"`
 	AssigneeId     OptInt     `json:"assigneeId"`
+	ParentIssueId  OptInt     `json:"parentIssueId"`
 	CategoryId     []int      `json:"categoryId[]"`
 	VersionId      []int      `json:"versionId[]"`
 	MilestoneId    []int      `json:"milestoneId[]"`
@@ -4629,6 +5217,11 @@ func (s *UpdateIssueReq) GetAssigneeId() OptInt {
 	return s.AssigneeId
 }
 
+// GetParentIssueId returns the value of ParentIssueId.
+func (s *UpdateIssueReq) GetParentIssueId() OptInt {
+	return s.ParentIssueId
+}
+
 // GetCategoryId returns the value of CategoryId.
 func (s *UpdateIssueReq) GetCategoryId() []int {
 	return s.CategoryId
@@ -4709,6 +5302,11 @@ func (s *UpdateIssueReq) SetAssigneeId(val OptInt) {
 	s.AssigneeId = val
 }
 
+// SetParentIssueId sets the value of ParentIssueId.
+func (s *UpdateIssueReq) SetParentIssueId(val OptInt) {
+	s.ParentIssueId = val
+}
+
 // SetCategoryId sets the value of CategoryId.
 func (s *UpdateIssueReq) SetCategoryId(val []int) {
 	s.CategoryId = val
@@ -4734,6 +5332,42 @@ func (s *UpdateIssueReq) SetAttachmentId(val []int) {
 	s.AttachmentId = val
 }
 
+type UpdateWebhookReq struct {
+	Name        OptString `json:"name"`
+	Description OptString `json:"description"`
+	HookUrl     OptString `json:"hookUrl"`
+}
+
+// GetName returns the value of Name.
+func (s *UpdateWebhookReq) GetName() OptString {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *UpdateWebhookReq) GetDescription() OptString {
+	return s.Description
+}
+
+// GetHookUrl returns the value of HookUrl.
+func (s *UpdateWebhookReq) GetHookUrl() OptString {
+	return s.HookUrl
+}
+
+// SetName sets the value of Name.
+func (s *UpdateWebhookReq) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *UpdateWebhookReq) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetHookUrl sets the value of HookUrl.
+func (s *UpdateWebhookReq) SetHookUrl(val OptString) {
+	s.HookUrl = val
+}
+
 type UpdateWikiReq struct {
 	Name       OptString `json:"name"`
 	Content    OptString `json:"content"`
@@ -4954,6 +5588,120 @@ func (s *Version) SetDisplayOrder(val OptInt) {
 	s.DisplayOrder = val
 }
 
+// Ref: #/components/schemas/Webhook
+type Webhook struct {
+	ID              OptInt     `json:"id"`
+	Name            OptString  `json:"name"`
+	Description     OptString  `json:"description"`
+	HookUrl         OptString  `json:"hookUrl"`
+	AllEvent        OptBool    `json:"allEvent"`
+	ActivityTypeIds []int      `json:"activityTypeIds"`
+	CreatedUser     OptUser    `json:"createdUser"`
+	Created         OptString  `json:"created"`
+	UpdatedUser     OptNilUser `json:"updatedUser"`
+	Updated         OptString  `json:"updated"`
+}
+
+// GetID returns the value of ID.
+func (s *Webhook) GetID() OptInt {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Webhook) GetName() OptString {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *Webhook) GetDescription() OptString {
+	return s.Description
+}
+
+// GetHookUrl returns the value of HookUrl.
+func (s *Webhook) GetHookUrl() OptString {
+	return s.HookUrl
+}
+
+// GetAllEvent returns the value of AllEvent.
+func (s *Webhook) GetAllEvent() OptBool {
+	return s.AllEvent
+}
+
+// GetActivityTypeIds returns the value of ActivityTypeIds.
+func (s *Webhook) GetActivityTypeIds() []int {
+	return s.ActivityTypeIds
+}
+
+// GetCreatedUser returns the value of CreatedUser.
+func (s *Webhook) GetCreatedUser() OptUser {
+	return s.CreatedUser
+}
+
+// GetCreated returns the value of Created.
+func (s *Webhook) GetCreated() OptString {
+	return s.Created
+}
+
+// GetUpdatedUser returns the value of UpdatedUser.
+func (s *Webhook) GetUpdatedUser() OptNilUser {
+	return s.UpdatedUser
+}
+
+// GetUpdated returns the value of Updated.
+func (s *Webhook) GetUpdated() OptString {
+	return s.Updated
+}
+
+// SetID sets the value of ID.
+func (s *Webhook) SetID(val OptInt) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Webhook) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Webhook) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetHookUrl sets the value of HookUrl.
+func (s *Webhook) SetHookUrl(val OptString) {
+	s.HookUrl = val
+}
+
+// SetAllEvent sets the value of AllEvent.
+func (s *Webhook) SetAllEvent(val OptBool) {
+	s.AllEvent = val
+}
+
+// SetActivityTypeIds sets the value of ActivityTypeIds.
+func (s *Webhook) SetActivityTypeIds(val []int) {
+	s.ActivityTypeIds = val
+}
+
+// SetCreatedUser sets the value of CreatedUser.
+func (s *Webhook) SetCreatedUser(val OptUser) {
+	s.CreatedUser = val
+}
+
+// SetCreated sets the value of Created.
+func (s *Webhook) SetCreated(val OptString) {
+	s.Created = val
+}
+
+// SetUpdatedUser sets the value of UpdatedUser.
+func (s *Webhook) SetUpdatedUser(val OptNilUser) {
+	s.UpdatedUser = val
+}
+
+// SetUpdated sets the value of Updated.
+func (s *Webhook) SetUpdated(val OptString) {
+	s.Updated = val
+}
+
 // Ref: #/components/schemas/Wiki
 type Wiki struct {
 	ID          OptInt       `json:"id"`
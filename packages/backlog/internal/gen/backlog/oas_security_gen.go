@@ -36,16 +36,23 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 
 var operationRolesApiKey = map[string][]string{
 	AddCommentOperation:                      []string{},
+	AddCustomFieldItemOperation:              []string{},
 	AddDocumentTagsOperation:                 []string{},
+	AddProjectAdministratorOperation:         []string{},
+	AddProjectUserOperation:                  []string{},
 	AttachFileToWikiOperation:                []string{},
 	CreateCategoryOperation:                  []string{},
+	CreateCustomFieldOperation:               []string{},
 	CreateDocumentOperation:                  []string{},
 	CreateIssueOperation:                     []string{},
 	CreateWikiOperation:                      []string{},
 	DeleteCategoryOperation:                  []string{},
+	DeleteCustomFieldItemOperation:           []string{},
 	DeleteDocumentOperation:                  []string{},
 	DeleteIssueOperation:                     []string{},
 	DeleteIssueAttachmentOperation:           []string{},
+	DeleteProjectAdministratorOperation:      []string{},
+	DeleteProjectUserOperation:               []string{},
 	DeletePullRequestAttachmentsOperation:    []string{},
 	DeleteWikiOperation:                      []string{},
 	DownloadDocumentAttachmentOperation:      []string{},
@@ -72,6 +79,8 @@ var operationRolesApiKey = map[string][]string{
 	GetListOfWikiAttachmentsOperation:        []string{},
 	GetPrioritiesOperation:                   []string{},
 	GetProjectOperation:                      []string{},
+	GetProjectAdministratorsOperation:        []string{},
+	GetProjectRecentUpdatesOperation:         []string{},
 	GetProjectUsersOperation:                 []string{},
 	GetProjectsOperation:                     []string{},
 	GetPullRequestOperation:                  []string{},
@@ -85,7 +94,9 @@ var operationRolesApiKey = map[string][]string{
 	GetUserRecentUpdatesOperation:            []string{},
 	GetUsersOperation:                        []string{},
 	GetVersionsOperation:                     []string{},
+	GetWebhooksOperation:                     []string{},
 	GetWikiOperation:                         []string{},
+	GetWikiTagsOperation:                     []string{},
 	GetWikisOperation:                        []string{},
 	GetWikisCountOperation:                   []string{},
 	LinkSharedFilesToIssueOperation:          []string{},
@@ -95,7 +106,9 @@ var operationRolesApiKey = map[string][]string{
 	RemoveLinkToSharedFileFromWikiOperation:  []string{},
 	RemoveWikiAttachmentOperation:            []string{},
 	UpdateCommentOperation:                   []string{},
+	UpdateCustomFieldOperation:               []string{},
 	UpdateIssueOperation:                     []string{},
+	UpdateWebhookOperation:                   []string{},
 	UpdateWikiOperation:                      []string{},
 }
 
@@ -120,16 +133,23 @@ func (s *Server) securityApiKey(ctx context.Context, operationName OperationName
 
 var oauth2ScopesOAuth2 = map[string][]string{
 	AddCommentOperation:                      []string{},
+	AddCustomFieldItemOperation:              []string{},
 	AddDocumentTagsOperation:                 []string{},
+	AddProjectAdministratorOperation:         []string{},
+	AddProjectUserOperation:                  []string{},
 	AttachFileToWikiOperation:                []string{},
 	CreateCategoryOperation:                  []string{},
+	CreateCustomFieldOperation:               []string{},
 	CreateDocumentOperation:                  []string{},
 	CreateIssueOperation:                     []string{},
 	CreateWikiOperation:                      []string{},
 	DeleteCategoryOperation:                  []string{},
+	DeleteCustomFieldItemOperation:           []string{},
 	DeleteDocumentOperation:                  []string{},
 	DeleteIssueOperation:                     []string{},
 	DeleteIssueAttachmentOperation:           []string{},
+	DeleteProjectAdministratorOperation:      []string{},
+	DeleteProjectUserOperation:               []string{},
 	DeletePullRequestAttachmentsOperation:    []string{},
 	DeleteWikiOperation:                      []string{},
 	DownloadDocumentAttachmentOperation:      []string{},
@@ -156,6 +176,8 @@ var oauth2ScopesOAuth2 = map[string][]string{
 	GetListOfWikiAttachmentsOperation:        []string{},
 	GetPrioritiesOperation:                   []string{},
 	GetProjectOperation:                      []string{},
+	GetProjectAdministratorsOperation:        []string{},
+	GetProjectRecentUpdatesOperation:         []string{},
 	GetProjectUsersOperation:                 []string{},
 	GetProjectsOperation:                     []string{},
 	GetPullRequestOperation:                  []string{},
@@ -169,7 +191,9 @@ var oauth2ScopesOAuth2 = map[string][]string{
 	GetUserRecentUpdatesOperation:            []string{},
 	GetUsersOperation:                        []string{},
 	GetVersionsOperation:                     []string{},
+	GetWebhooksOperation:                     []string{},
 	GetWikiOperation:                         []string{},
+	GetWikiTagsOperation:                     []string{},
 	GetWikisOperation:                        []string{},
 	GetWikisCountOperation:                   []string{},
 	LinkSharedFilesToIssueOperation:          []string{},
@@ -179,7 +203,9 @@ var oauth2ScopesOAuth2 = map[string][]string{
 	RemoveLinkToSharedFileFromWikiOperation:  []string{},
 	RemoveWikiAttachmentOperation:            []string{},
 	UpdateCommentOperation:                   []string{},
+	UpdateCustomFieldOperation:               []string{},
 	UpdateIssueOperation:                     []string{},
+	UpdateWebhookOperation:                   []string{},
 	UpdateWikiOperation:                      []string{},
 }
 
@@ -14,12 +14,30 @@ type Handler interface {
 	//
 	// POST /issues/{issueIdOrKey}/comments
 	AddComment(ctx context.Context, req OptAddCommentReq, params AddCommentParams) (*Comment, error)
+	// AddCustomFieldItem implements addCustomFieldItem operation.
+	//
+	// Add list item for list type custom field.
+	//
+	// POST /projects/{projectIdOrKey}/customFields/{customFieldId}/items
+	AddCustomFieldItem(ctx context.Context, req OptAddCustomFieldItemReq, params AddCustomFieldItemParams) (*CustomField, error)
 	// AddDocumentTags implements addDocumentTags operation.
 	//
 	// Add document tags.
 	//
 	// POST /documents/{documentId}/tags
 	AddDocumentTags(ctx context.Context, req OptAddDocumentTagsReq, params AddDocumentTagsParams) ([]DocumentTag, error)
+	// AddProjectAdministrator implements addProjectAdministrator operation.
+	//
+	// Add project administrator.
+	//
+	// POST /projects/{projectIdOrKey}/administrators
+	AddProjectAdministrator(ctx context.Context, req OptAddProjectAdministratorReq, params AddProjectAdministratorParams) (*User, error)
+	// AddProjectUser implements addProjectUser operation.
+	//
+	// Add project user.
+	//
+	// POST /projects/{projectIdOrKey}/users
+	AddProjectUser(ctx context.Context, req OptAddProjectUserReq, params AddProjectUserParams) (*User, error)
 	// AttachFileToWiki implements attachFileToWiki operation.
 	//
 	// Add attachments to wiki.
@@ -32,6 +50,12 @@ type Handler interface {
 	//
 	// POST /projects/{projectIdOrKey}/categories
 	CreateCategory(ctx context.Context, req OptCreateCategoryReq, params CreateCategoryParams) (*Category, error)
+	// CreateCustomField implements createCustomField operation.
+	//
+	// Add custom field.
+	//
+	// POST /projects/{projectIdOrKey}/customFields
+	CreateCustomField(ctx context.Context, req OptCreateCustomFieldReq, params CreateCustomFieldParams) (*CustomField, error)
 	// CreateDocument implements createDocument operation.
 	//
 	// Add document.
@@ -56,6 +80,12 @@ type Handler interface {
 	//
 	// DELETE /projects/{projectIdOrKey}/categories/{categoryId}
 	DeleteCategory(ctx context.Context, params DeleteCategoryParams) (*Category, error)
+	// DeleteCustomFieldItem implements deleteCustomFieldItem operation.
+	//
+	// Delete list item for list type custom field.
+	//
+	// DELETE /projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}
+	DeleteCustomFieldItem(ctx context.Context, params DeleteCustomFieldItemParams) (*CustomField, error)
 	// DeleteDocument implements deleteDocument operation.
 	//
 	// Delete document.
@@ -74,6 +104,18 @@ type Handler interface {
 	//
 	// DELETE /issues/{issueIdOrKey}/attachments/{attachmentId}
 	DeleteIssueAttachment(ctx context.Context, params DeleteIssueAttachmentParams) (*Attachment, error)
+	// DeleteProjectAdministrator implements deleteProjectAdministrator operation.
+	//
+	// Delete project administrator.
+	//
+	// DELETE /projects/{projectIdOrKey}/administrators
+	DeleteProjectAdministrator(ctx context.Context, req OptDeleteProjectAdministratorReq, params DeleteProjectAdministratorParams) (*User, error)
+	// DeleteProjectUser implements deleteProjectUser operation.
+	//
+	// Delete project user.
+	//
+	// DELETE /projects/{projectIdOrKey}/users
+	DeleteProjectUser(ctx context.Context, req OptDeleteProjectUserReq, params DeleteProjectUserParams) (*User, error)
 	// DeletePullRequestAttachments implements deletePullRequestAttachments operation.
 	//
 	// Delete pull request attachment.
@@ -230,6 +272,18 @@ type Handler interface {
 	//
 	// GET /projects/{projectIdOrKey}
 	GetProject(ctx context.Context, params GetProjectParams) (*Project, error)
+	// GetProjectAdministrators implements getProjectAdministrators operation.
+	//
+	// Get project administrators.
+	//
+	// GET /projects/{projectIdOrKey}/administrators
+	GetProjectAdministrators(ctx context.Context, params GetProjectAdministratorsParams) ([]User, error)
+	// GetProjectRecentUpdates implements getProjectRecentUpdates operation.
+	//
+	// Get project recent updates.
+	//
+	// GET /projects/{projectIdOrKey}/activities
+	GetProjectRecentUpdates(ctx context.Context, params GetProjectRecentUpdatesParams) ([]Activity, error)
 	// GetProjectUsers implements getProjectUsers operation.
 	//
 	// Get project users.
@@ -308,12 +362,24 @@ type Handler interface {
 	//
 	// GET /projects/{projectIdOrKey}/versions
 	GetVersions(ctx context.Context, params GetVersionsParams) ([]Version, error)
+	// GetWebhooks implements getWebhooks operation.
+	//
+	// Get webhooks.
+	//
+	// GET /projects/{projectIdOrKey}/webhooks
+	GetWebhooks(ctx context.Context, params GetWebhooksParams) ([]Webhook, error)
 	// GetWiki implements getWiki operation.
 	//
 	// Get wiki.
 	//
 	// GET /wikis/{wikiId}
 	GetWiki(ctx context.Context, params GetWikiParams) (*Wiki, error)
+	// GetWikiTags implements getWikiTags operation.
+	//
+	// Get wiki page tag list.
+	//
+	// GET /wikis/tags
+	GetWikiTags(ctx context.Context, params GetWikiTagsParams) ([]WikiTag, error)
 	// GetWikis implements getWikis operation.
 	//
 	// Get wikis.
@@ -368,12 +434,24 @@ type Handler interface {
 	//
 	// PATCH /issues/{issueIdOrKey}/comments/{commentId}
 	UpdateComment(ctx context.Context, req OptUpdateCommentReq, params UpdateCommentParams) (*Comment, error)
+	// UpdateCustomField implements updateCustomField operation.
+	//
+	// Update custom field.
+	//
+	// PATCH /projects/{projectIdOrKey}/customFields/{customFieldId}
+	UpdateCustomField(ctx context.Context, req OptUpdateCustomFieldReq, params UpdateCustomFieldParams) (*CustomField, error)
 	// UpdateIssue implements updateIssue operation.
 	//
 	// Update issue.
 	//
 	// PATCH /issues/{issueIdOrKey}
 	UpdateIssue(ctx context.Context, req OptUpdateIssueReq, params UpdateIssueParams) (*Issue, error)
+	// UpdateWebhook implements updateWebhook operation.
+	//
+	// Update webhook.
+	//
+	// PATCH /projects/{projectIdOrKey}/webhooks/{webhookId}
+	UpdateWebhook(ctx context.Context, req OptUpdateWebhookReq, params UpdateWebhookParams) (*Webhook, error)
 	// UpdateWiki implements updateWiki operation.
 	//
 	// Update wiki.
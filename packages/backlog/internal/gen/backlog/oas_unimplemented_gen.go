@@ -22,6 +22,15 @@ func (UnimplementedHandler) AddComment(ctx context.Context, req OptAddCommentReq
 	return r, ht.ErrNotImplemented
 }
 
+// AddCustomFieldItem implements addCustomFieldItem operation.
+//
+// Add list item for list type custom field.
+//
+// POST /projects/{projectIdOrKey}/customFields/{customFieldId}/items
+func (UnimplementedHandler) AddCustomFieldItem(ctx context.Context, req OptAddCustomFieldItemReq, params AddCustomFieldItemParams) (r *CustomField, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AddDocumentTags implements addDocumentTags operation.
 //
 // Add document tags.
@@ -31,6 +40,24 @@ func (UnimplementedHandler) AddDocumentTags(ctx context.Context, req OptAddDocum
 	return r, ht.ErrNotImplemented
 }
 
+// AddProjectAdministrator implements addProjectAdministrator operation.
+//
+// Add project administrator.
+//
+// POST /projects/{projectIdOrKey}/administrators
+func (UnimplementedHandler) AddProjectAdministrator(ctx context.Context, req OptAddProjectAdministratorReq, params AddProjectAdministratorParams) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddProjectUser implements addProjectUser operation.
+//
+// Add project user.
+//
+// POST /projects/{projectIdOrKey}/users
+func (UnimplementedHandler) AddProjectUser(ctx context.Context, req OptAddProjectUserReq, params AddProjectUserParams) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AttachFileToWiki implements attachFileToWiki operation.
 //
 // Add attachments to wiki.
@@ -49,6 +76,15 @@ func (UnimplementedHandler) CreateCategory(ctx context.Context, req OptCreateCat
 	return r, ht.ErrNotImplemented
 }
 
+// CreateCustomField implements createCustomField operation.
+//
+// Add custom field.
+//
+// POST /projects/{projectIdOrKey}/customFields
+func (UnimplementedHandler) CreateCustomField(ctx context.Context, req OptCreateCustomFieldReq, params CreateCustomFieldParams) (r *CustomField, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateDocument implements createDocument operation.
 //
 // Add document.
@@ -85,6 +121,15 @@ func (UnimplementedHandler) DeleteCategory(ctx context.Context, params DeleteCat
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteCustomFieldItem implements deleteCustomFieldItem operation.
+//
+// Delete list item for list type custom field.
+//
+// DELETE /projects/{projectIdOrKey}/customFields/{customFieldId}/items/{itemId}
+func (UnimplementedHandler) DeleteCustomFieldItem(ctx context.Context, params DeleteCustomFieldItemParams) (r *CustomField, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteDocument implements deleteDocument operation.
 //
 // Delete document.
@@ -112,6 +157,24 @@ func (UnimplementedHandler) DeleteIssueAttachment(ctx context.Context, params De
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteProjectAdministrator implements deleteProjectAdministrator operation.
+//
+// Delete project administrator.
+//
+// DELETE /projects/{projectIdOrKey}/administrators
+func (UnimplementedHandler) DeleteProjectAdministrator(ctx context.Context, req OptDeleteProjectAdministratorReq, params DeleteProjectAdministratorParams) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteProjectUser implements deleteProjectUser operation.
+//
+// Delete project user.
+//
+// DELETE /projects/{projectIdOrKey}/users
+func (UnimplementedHandler) DeleteProjectUser(ctx context.Context, req OptDeleteProjectUserReq, params DeleteProjectUserParams) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeletePullRequestAttachments implements deletePullRequestAttachments operation.
 //
 // Delete pull request attachment.
@@ -346,6 +409,24 @@ func (UnimplementedHandler) GetProject(ctx context.Context, params GetProjectPar
 	return r, ht.ErrNotImplemented
 }
 
+// GetProjectAdministrators implements getProjectAdministrators operation.
+//
+// Get project administrators.
+//
+// GET /projects/{projectIdOrKey}/administrators
+func (UnimplementedHandler) GetProjectAdministrators(ctx context.Context, params GetProjectAdministratorsParams) (r []User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjectRecentUpdates implements getProjectRecentUpdates operation.
+//
+// Get project recent updates.
+//
+// GET /projects/{projectIdOrKey}/activities
+func (UnimplementedHandler) GetProjectRecentUpdates(ctx context.Context, params GetProjectRecentUpdatesParams) (r []Activity, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetProjectUsers implements getProjectUsers operation.
 //
 // Get project users.
@@ -463,6 +544,15 @@ func (UnimplementedHandler) GetVersions(ctx context.Context, params GetVersionsP
 	return r, ht.ErrNotImplemented
 }
 
+// GetWebhooks implements getWebhooks operation.
+//
+// Get webhooks.
+//
+// GET /projects/{projectIdOrKey}/webhooks
+func (UnimplementedHandler) GetWebhooks(ctx context.Context, params GetWebhooksParams) (r []Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetWiki implements getWiki operation.
 //
 // Get wiki.
@@ -472,6 +562,15 @@ func (UnimplementedHandler) GetWiki(ctx context.Context, params GetWikiParams) (
 	return r, ht.ErrNotImplemented
 }
 
+// GetWikiTags implements getWikiTags operation.
+//
+// Get wiki page tag list.
+//
+// GET /wikis/tags
+func (UnimplementedHandler) GetWikiTags(ctx context.Context, params GetWikiTagsParams) (r []WikiTag, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetWikis implements getWikis operation.
 //
 // Get wikis.
@@ -553,6 +652,15 @@ func (UnimplementedHandler) UpdateComment(ctx context.Context, req OptUpdateComm
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateCustomField implements updateCustomField operation.
+//
+// Update custom field.
+//
+// PATCH /projects/{projectIdOrKey}/customFields/{customFieldId}
+func (UnimplementedHandler) UpdateCustomField(ctx context.Context, req OptUpdateCustomFieldReq, params UpdateCustomFieldParams) (r *CustomField, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateIssue implements updateIssue operation.
 //
 // Update issue.
@@ -562,6 +670,15 @@ func (UnimplementedHandler) UpdateIssue(ctx context.Context, req OptUpdateIssueR
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateWebhook implements updateWebhook operation.
+//
+// Update webhook.
+//
+// PATCH /projects/{projectIdOrKey}/webhooks/{webhookId}
+func (UnimplementedHandler) UpdateWebhook(ctx context.Context, req OptUpdateWebhookReq, params UpdateWebhookParams) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateWiki implements updateWiki operation.
 //
 // Update wiki.
@@ -48,7 +48,7 @@ func Convert(input string, opts ConvertOptions) ConvertResult {
 		lineBreak = "<br>"
 	}
 
-	allowUnsafe := result.Mode == ModeBacklog || opts.Force
+	allowUnsafe := result.Mode == ModeBacklog || opts.Force || (opts.ConvertUnknown && result.Mode == ModeUnknown)
 	converted, rules, warnings := applyConversion(input, lineBreak, result.Warnings, opts.AttachmentNames, allowUnsafe, opts.UnsafeRules)
 	result.Output = converted
 	result.Rules = rules
@@ -64,7 +64,7 @@ func applyConversion(input, lineBreak string, warnings map[WarningType]int, atta
 		if !allowUnsafe {
 			return false
 		}
-		if len(unsafeRules) == 0 {
+		if unsafeRules == nil {
 			return true
 		}
 		return unsafeRules[rule]
@@ -0,0 +1,342 @@
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// HTMLOptions controls RenderHTML.
+type HTMLOptions struct {
+	// Space is the Backlog space host used for issue key links (e.g. example.backlog.jp).
+	// Issue keys are not linked when empty.
+	Space string
+}
+
+var (
+	reHTMLHeading   = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	reHTMLRule      = regexp.MustCompile(`^\s{0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	reHTMLListItem  = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	reHTMLTask      = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	reHTMLTableSep  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	reHTMLCodeSpan  = regexp.MustCompile("`([^`]+)`")
+	reHTMLImage     = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	reHTMLLink      = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	reHTMLAutolink  = regexp.MustCompile(`https?://[^\s<>()]+[^\s<>().,;:!?'"]`)
+	reHTMLBold      = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*|__(\S(?:.*?\S)?)__`)
+	reHTMLItalic    = regexp.MustCompile(`\*(\S(?:.*?\S)?)\*|\b_(\S(?:.*?\S)?)_\b`)
+	reHTMLStrike    = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+	reHTMLEmoji     = regexp.MustCompile(`:([a-z0-9_+-]+):`)
+	reHTMLIssueKey  = regexp.MustCompile(`\b([A-Z][A-Z0-9_]*-[0-9]+)\b`)
+	reHTMLHolder    = regexp.MustCompile("\x00([0-9]+)\x00")
+	reHTMLUnsafeURL = regexp.MustCompile(`(?i)^\s*(javascript|vbscript|data):`)
+)
+
+// emojiShortcodes maps commonly used shortcodes to their characters.
+var emojiShortcodes = map[string]string{
+	"smile": "😄", "smiley": "😃", "grin": "😁", "laughing": "😆", "wink": "😉",
+	"blush": "😊", "heart_eyes": "😍", "sweat_smile": "😅", "joy": "😂", "cry": "😢",
+	"sob": "😭", "angry": "😠", "rage": "😡", "thinking": "🤔", "scream": "😱",
+	"innocent": "😇", "sunglasses": "😎", "pray": "🙏", "clap": "👏", "wave": "👋",
+	"+1": "👍", "thumbsup": "👍", "-1": "👎", "thumbsdown": "👎", "ok_hand": "👌",
+	"muscle": "💪", "eyes": "👀", "heart": "❤️", "star": "⭐", "sparkles": "✨",
+	"fire": "🔥", "tada": "🎉", "rocket": "🚀", "bulb": "💡", "memo": "📝",
+	"warning": "⚠️", "x": "❌", "white_check_mark": "✅", "heavy_check_mark": "✔️",
+	"question": "❓", "exclamation": "❗", "bug": "🐛", "lock": "🔒", "key": "🔑",
+	"calendar": "📅", "clock": "🕐", "hourglass": "⌛", "coffee": "☕", "beer": "🍺",
+	"sunny": "☀️", "cloud": "☁️", "umbrella": "☂️", "zap": "⚡", "snowflake": "❄️",
+	"construction": "🚧", "no_entry": "⛔", "link": "🔗", "pushpin": "📌", "mag": "🔍",
+}
+
+// RenderHTML renders GFM content to an HTML fragment resembling Backlog's
+// markdown rendering: line breaks within paragraphs become <br>, emoji
+// shortcodes are replaced and issue keys are linked to the space.
+//
+// Raw HTML in the source is escaped rather than passed through.
+func RenderHTML(content string, opts HTMLOptions) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	var b strings.Builder
+	renderBlocks(&b, lines, opts)
+	return b.String()
+}
+
+func renderBlocks(b *strings.Builder, lines []string, opts HTMLOptions) {
+	var para []string
+	flush := func() {
+		if len(para) == 0 {
+			return
+		}
+		parts := make([]string, len(para))
+		for i, l := range para {
+			parts[i] = renderInline(strings.TrimSpace(l), opts)
+		}
+		b.WriteString("<p>" + strings.Join(parts, "<br>\n") + "</p>\n")
+		para = nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			flush()
+
+		case isFenceLine(trimmed):
+			flush()
+			fence := trimmed[:3]
+			lang := strings.TrimSpace(strings.TrimLeft(trimmed, fence[:1]))
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence); i++ {
+				code = append(code, lines[i])
+			}
+			class := ""
+			if lang != "" {
+				class = ` class="language-` + html.EscapeString(strings.Fields(lang)[0]) + `"`
+			}
+			b.WriteString("<pre><code" + class + ">" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+
+		case reHTMLHeading.MatchString(trimmed):
+			flush()
+			m := reHTMLHeading.FindStringSubmatch(trimmed)
+			level := len(m[1])
+			fmt.Fprintf(b, "<h%d>%s</h%d>\n", level, renderInline(m[2], opts), level)
+
+		case reHTMLRule.MatchString(line):
+			flush()
+			b.WriteString("<hr>\n")
+
+		case strings.HasPrefix(trimmed, ">"):
+			flush()
+			var quoted []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				q := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quoted = append(quoted, strings.TrimPrefix(q, " "))
+			}
+			i--
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quoted, opts)
+			b.WriteString("</blockquote>\n")
+
+		case strings.Contains(line, "|") && i+1 < len(lines) && reHTMLTableSep.MatchString(lines[i+1]) && strings.Contains(lines[i+1], "-"):
+			flush()
+			i = renderTable(b, lines, i, opts)
+
+		case reHTMLListItem.MatchString(line):
+			flush()
+			i = renderList(b, lines, i, opts)
+
+		default:
+			para = append(para, line)
+		}
+	}
+	flush()
+}
+
+// renderTable renders a GFM table starting at lines[start] and returns the
+// index of its last line.
+func renderTable(b *strings.Builder, lines []string, start int, opts HTMLOptions) int {
+	header := splitTableRow(lines[start])
+	var aligns []string
+	for _, cell := range splitTableRow(lines[start+1]) {
+		switch {
+		case strings.HasPrefix(cell, ":") && strings.HasSuffix(cell, ":"):
+			aligns = append(aligns, "center")
+		case strings.HasSuffix(cell, ":"):
+			aligns = append(aligns, "right")
+		case strings.HasPrefix(cell, ":"):
+			aligns = append(aligns, "left")
+		default:
+			aligns = append(aligns, "")
+		}
+	}
+	cell := func(tag string, idx int, content string) string {
+		attr := ""
+		if idx < len(aligns) && aligns[idx] != "" {
+			attr = ` style="text-align:` + aligns[idx] + `"`
+		}
+		return "<" + tag + attr + ">" + renderInline(content, opts) + "</" + tag + ">"
+	}
+
+	b.WriteString("<table>\n<thead><tr>")
+	for idx, h := range header {
+		b.WriteString(cell("th", idx, h))
+	}
+	b.WriteString("</tr></thead>\n<tbody>\n")
+	i := start + 2
+	for ; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "" || !strings.Contains(lines[i], "|") {
+			break
+		}
+		b.WriteString("<tr>")
+		for idx, c := range splitTableRow(lines[i]) {
+			b.WriteString(cell("td", idx, c))
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	return i - 1
+}
+
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+	cells := strings.Split(line, "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return cells
+}
+
+// renderList renders a (possibly nested) list starting at lines[start] and
+// returns the index of its last line.
+func renderList(b *strings.Builder, lines []string, start int, opts HTMLOptions) int {
+	first := reHTMLListItem.FindStringSubmatch(lines[start])
+	indent := len(first[1])
+	ordered := first[2][0] >= '0' && first[2][0] <= '9'
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+		if n, err := strconv.Atoi(strings.TrimRight(first[2], ".)")); err == nil && n != 1 {
+			tag = fmt.Sprintf(`ol start="%d"`, n)
+		}
+	}
+	b.WriteString("<" + tag + ">\n")
+
+	i := start
+	for i < len(lines) {
+		m := reHTMLListItem.FindStringSubmatch(lines[i])
+		if m == nil || len(m[1]) != indent {
+			break
+		}
+		text := m[3]
+		var nested []string
+		j := i + 1
+		for ; j < len(lines); j++ {
+			l := lines[j]
+			if strings.TrimSpace(l) == "" {
+				break
+			}
+			if sub := reHTMLListItem.FindStringSubmatch(l); sub != nil && len(sub[1]) <= indent {
+				break
+			}
+			if len(l)-len(strings.TrimLeft(l, " \t")) <= indent && len(nested) == 0 {
+				// lazy continuation of the item text
+				text += "\n" + strings.TrimSpace(l)
+				continue
+			}
+			nested = append(nested, l)
+		}
+
+		b.WriteString("<li>")
+		if tm := reHTMLTask.FindStringSubmatch(text); tm != nil {
+			checked := ""
+			if tm[1] != " " {
+				checked = " checked"
+			}
+			b.WriteString(`<input type="checkbox" disabled` + checked + `> `)
+			text = tm[2]
+		}
+		parts := strings.Split(text, "\n")
+		for k := range parts {
+			parts[k] = renderInline(strings.TrimSpace(parts[k]), opts)
+		}
+		b.WriteString(strings.Join(parts, "<br>\n"))
+		if len(nested) > 0 {
+			b.WriteString("\n")
+			renderBlocks(b, dedent(nested), opts)
+		}
+		b.WriteString("</li>\n")
+		i = j
+	}
+	b.WriteString("</" + strings.Fields(tag)[0] + ">\n")
+	return i - 1
+}
+
+func dedent(lines []string) []string {
+	min := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if min < 0 || n < min {
+			min = n
+		}
+	}
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		if len(l) >= min && min > 0 {
+			out[i] = l[min:]
+		} else {
+			out[i] = l
+		}
+	}
+	return out
+}
+
+// renderInline renders inline markup. Code spans, images and links are
+// replaced by placeholders first so that later rules do not touch them.
+func renderInline(text string, opts HTMLOptions) string {
+	var holders []string
+	hold := func(s string) string {
+		holders = append(holders, s)
+		return "\x00" + strconv.Itoa(len(holders)-1) + "\x00"
+	}
+
+	text = reHTMLCodeSpan.ReplaceAllStringFunc(text, func(s string) string {
+		m := reHTMLCodeSpan.FindStringSubmatch(s)
+		return hold("<code>" + html.EscapeString(m[1]) + "</code>")
+	})
+	text = reHTMLImage.ReplaceAllStringFunc(text, func(s string) string {
+		m := reHTMLImage.FindStringSubmatch(s)
+		return hold(`<img src="` + safeURL(m[2]) + `" alt="` + html.EscapeString(m[1]) + `">`)
+	})
+	text = reHTMLLink.ReplaceAllStringFunc(text, func(s string) string {
+		m := reHTMLLink.FindStringSubmatch(s)
+		return hold(`<a href="` + safeURL(m[2]) + `">` + renderInline(m[1], HTMLOptions{}) + `</a>`)
+	})
+	text = reHTMLAutolink.ReplaceAllStringFunc(text, func(s string) string {
+		return hold(`<a href="` + safeURL(s) + `">` + html.EscapeString(s) + `</a>`)
+	})
+
+	text = html.EscapeString(text)
+	text = reHTMLBold.ReplaceAllStringFunc(text, func(s string) string {
+		m := reHTMLBold.FindStringSubmatch(s)
+		return "<strong>" + m[1] + m[2] + "</strong>"
+	})
+	text = reHTMLItalic.ReplaceAllStringFunc(text, func(s string) string {
+		m := reHTMLItalic.FindStringSubmatch(s)
+		return "<em>" + m[1] + m[2] + "</em>"
+	})
+	text = reHTMLStrike.ReplaceAllString(text, "<del>$1</del>")
+	text = reHTMLEmoji.ReplaceAllStringFunc(text, func(s string) string {
+		if e, ok := emojiShortcodes[strings.Trim(s, ":")]; ok {
+			return e
+		}
+		return s
+	})
+	if opts.Space != "" {
+		text = reHTMLIssueKey.ReplaceAllStringFunc(text, func(key string) string {
+			return `<a class="issue-key" href="https://` + html.EscapeString(opts.Space) + `/view/` + key + `">` + key + `</a>`
+		})
+	}
+
+	for reHTMLHolder.MatchString(text) {
+		text = reHTMLHolder.ReplaceAllStringFunc(text, func(s string) string {
+			idx, _ := strconv.Atoi(strings.Trim(s, "\x00"))
+			return holders[idx]
+		})
+	}
+	return text
+}
+
+func safeURL(u string) string {
+	if reHTMLUnsafeURL.MatchString(u) {
+		return "#"
+	}
+	return html.EscapeString(u)
+}
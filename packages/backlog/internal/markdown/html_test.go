@@ -0,0 +1,79 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderHTML(t *testing.T) {
+	opts := HTMLOptions{Space: "example.backlog.jp"}
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+		not   []string
+	}{
+		{
+			name:  "heading and paragraph with line break",
+			input: "# Title\n\nline1\nline2",
+			want:  []string{"<h1>Title</h1>", "<p>line1<br>\nline2</p>"},
+		},
+		{
+			name:  "emphasis and code",
+			input: "**bold** *it* ~~del~~ `a*b*c`",
+			want:  []string{"<strong>bold</strong>", "<em>it</em>", "<del>del</del>", "<code>a*b*c</code>"},
+		},
+		{
+			name:  "issue key and emoji",
+			input: "See PROJ-123 :tada:",
+			want:  []string{`<a class="issue-key" href="https://example.backlog.jp/view/PROJ-123">PROJ-123</a>`, "🎉"},
+		},
+		{
+			name:  "issue key inside link is not relinked",
+			input: "[docs](https://example.com/PROJ-1)",
+			want:  []string{`<a href="https://example.com/PROJ-1">docs</a>`},
+			not:   []string{"issue-key"},
+		},
+		{
+			name:  "fenced code is escaped",
+			input: "```go\nif a < b {}\n```",
+			want:  []string{`<pre><code class="language-go">if a &lt; b {}</code></pre>`},
+		},
+		{
+			name:  "nested and task lists",
+			input: "- a\n  - b\n- [x] done\n\n1. one\n2. two",
+			want:  []string{"<ul>\n<li>a\n<ul>\n<li>b</li>", `<input type="checkbox" disabled checked> done`, "<ol>\n<li>one</li>\n<li>two</li>\n</ol>"},
+		},
+		{
+			name:  "table",
+			input: "| a | b |\n|:--|--:|\n| 1 | 2 |",
+			want:  []string{`<th style="text-align:left">a</th>`, `<td style="text-align:right">2</td>`},
+		},
+		{
+			name:  "blockquote and rule",
+			input: "> quoted\n\n---",
+			want:  []string{"<blockquote>\n<p>quoted</p>\n</blockquote>", "<hr>"},
+		},
+		{
+			name:  "raw html and unsafe urls are neutralized",
+			input: "<script>x</script> [a](javascript:alert(1))",
+			want:  []string{"&lt;script&gt;", `<a href="#">a</a>`},
+			not:   []string{"<script>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderHTML(tt.input, opts)
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("RenderHTML() missing %q\n%s", w, got)
+				}
+			}
+			for _, n := range tt.not {
+				if strings.Contains(got, n) {
+					t.Errorf("RenderHTML() should not contain %q\n%s", n, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SafeRules are always applied because they only match Backlog notation.
+var SafeRules = []RuleID{
+	RuleQuoteBlock,
+	RuleCodeBlock,
+	RuleBacklogLink,
+	RuleTOC,
+	RuleLineBreak,
+	RuleImageMacro,
+}
+
+// UnsafeRules may change text that is already GFM, so they are applied only
+// to content detected as Backlog notation (or forced).
+var UnsafeRules = []RuleID{
+	RuleHeadingAsterisk,
+	RuleListPlus,
+	RuleListDashSpace,
+	RuleTableSeparator,
+	RuleEmphasisBold,
+	RuleEmphasisItalic,
+	RuleStrikethrough,
+}
+
+// RuleProfile is a preset of unsafe rules.
+type RuleProfile struct {
+	Name        string
+	Description string
+	// Unsafe lists the unsafe rules to apply. nil means all unsafe rules.
+	Unsafe []RuleID
+	// ConvertUnknown applies the unsafe rules to content whose mode is unknown.
+	ConvertUnknown bool
+}
+
+// Rule profile names.
+const (
+	ProfileSafe       = "safe"
+	ProfileStandard   = "standard"
+	ProfileAggressive = "aggressive"
+)
+
+// RuleProfiles are the built-in presets, from the most conservative.
+var RuleProfiles = []RuleProfile{
+	{
+		Name:        ProfileSafe,
+		Description: "Only rules that match Backlog-specific notation",
+		Unsafe:      []RuleID{},
+	},
+	{
+		Name:        ProfileStandard,
+		Description: "Also convert headings, lists and tables of content detected as Backlog notation",
+		Unsafe:      []RuleID{RuleHeadingAsterisk, RuleListPlus, RuleListDashSpace, RuleTableSeparator},
+	},
+	{
+		Name:           ProfileAggressive,
+		Description:    "All rules, also applied to content whose notation cannot be detected",
+		Unsafe:         UnsafeRules,
+		ConvertUnknown: true,
+	},
+}
+
+// RuleProfileNames returns the names of the built-in presets.
+func RuleProfileNames() []string {
+	names := make([]string, len(RuleProfiles))
+	for i, p := range RuleProfiles {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// LookupRuleProfile returns the built-in preset with the given name.
+func LookupRuleProfile(name string) (*RuleProfile, error) {
+	for i := range RuleProfiles {
+		if RuleProfiles[i].Name == name {
+			p := RuleProfiles[i]
+			return &p, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown markdown conversion profile %q (must be one of: %s)", name, strings.Join(RuleProfileNames(), ", "))
+}
+
+// CustomRuleProfile builds a profile from a list of unsafe rule names
+// (display.markdown_unsafe_rules). An empty list allows all unsafe rules.
+func CustomRuleProfile(names []string) *RuleProfile {
+	p := &RuleProfile{Name: "custom", Description: "display.markdown_unsafe_rules"}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			p.Unsafe = append(p.Unsafe, RuleID(name))
+		}
+	}
+	return p
+}
+
+// ResolveRuleProfile returns the preset named by name, or the custom profile
+// built from unsafeRules when name is empty.
+func ResolveRuleProfile(name string, unsafeRules []string) (*RuleProfile, error) {
+	if name == "" {
+		return CustomRuleProfile(unsafeRules), nil
+	}
+	return LookupRuleProfile(name)
+}
+
+// Allows reports whether the profile applies the rule to content detected as
+// Backlog notation.
+func (p *RuleProfile) Allows(rule RuleID) bool {
+	for _, r := range SafeRules {
+		if r == rule {
+			return true
+		}
+	}
+	if p.Unsafe == nil {
+		return true
+	}
+	for _, r := range p.Unsafe {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply sets the unsafe rule options of opts from the profile.
+func (p *RuleProfile) Apply(opts *ConvertOptions) {
+	if p == nil {
+		return
+	}
+	opts.ConvertUnknown = p.ConvertUnknown
+	if p.Unsafe == nil {
+		opts.UnsafeRules = nil
+		return
+	}
+	opts.UnsafeRules = make(map[RuleID]bool, len(p.Unsafe))
+	for _, r := range p.Unsafe {
+		opts.UnsafeRules[r] = true
+	}
+}
@@ -0,0 +1,68 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func convertWithProfile(t *testing.T, name, input string) string {
+	t.Helper()
+	p, err := LookupRuleProfile(name)
+	if err != nil {
+		t.Fatalf("LookupRuleProfile(%q) error: %v", name, err)
+	}
+	opts := ConvertOptions{}
+	p.Apply(&opts)
+	return Convert(input, opts).Output
+}
+
+func TestRuleProfiles(t *testing.T) {
+	input := "* Title\n''bold''\n{code}x{/code}\n#contents"
+
+	safe := convertWithProfile(t, ProfileSafe, input)
+	if !strings.Contains(safe, "* Title") || !strings.Contains(safe, "''bold''") || !strings.Contains(safe, "[toc]") {
+		t.Errorf("safe output = %q, want only safe rules applied", safe)
+	}
+
+	standard := convertWithProfile(t, ProfileStandard, input)
+	if !strings.Contains(standard, "# Title") || !strings.Contains(standard, "''bold''") {
+		t.Errorf("standard output = %q, want headings but not emphasis converted", standard)
+	}
+
+	aggressive := convertWithProfile(t, ProfileAggressive, input)
+	if !strings.Contains(aggressive, "# Title") || !strings.Contains(aggressive, "**bold**") {
+		t.Errorf("aggressive output = %q, want all rules applied", aggressive)
+	}
+}
+
+func TestRuleProfileConvertUnknown(t *testing.T) {
+	input := "+ item"
+	if mode := Detect(input).Mode; mode != ModeUnknown {
+		t.Fatalf("Detect(%q) = %s, want unknown", input, mode)
+	}
+	if got := convertWithProfile(t, ProfileStandard, input); got != input {
+		t.Errorf("standard output = %q, want unchanged", got)
+	}
+	if got := convertWithProfile(t, ProfileAggressive, input); got != "1. item" {
+		t.Errorf("aggressive output = %q, want 1. item", got)
+	}
+}
+
+func TestResolveRuleProfile(t *testing.T) {
+	custom, err := ResolveRuleProfile("", []string{"heading_asterisk", " "})
+	if err != nil {
+		t.Fatalf("ResolveRuleProfile() error: %v", err)
+	}
+	if custom.Name != "custom" || !custom.Allows(RuleHeadingAsterisk) || custom.Allows(RuleEmphasisBold) || !custom.Allows(RuleCodeBlock) {
+		t.Errorf("custom profile = %+v", custom)
+	}
+
+	all, _ := ResolveRuleProfile("", nil)
+	if !all.Allows(RuleStrikethrough) {
+		t.Error("empty markdown_unsafe_rules should allow all unsafe rules")
+	}
+
+	if _, err := ResolveRuleProfile("extreme", nil); err == nil || !strings.Contains(err.Error(), "safe, standard, aggressive") {
+		t.Errorf("ResolveRuleProfile(extreme) error = %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+// RefIssueType represents an attachment reference problem category.
+type RefIssueType string
+
+const (
+	// RefIssueMissingAttachment is a reference-style image whose target is
+	// neither an attachment nor a link definition in the document.
+	RefIssueMissingAttachment RefIssueType = "missing_attachment"
+	// RefIssueUnresolvedMacro is a Backlog attachment macro left unconverted.
+	RefIssueUnresolvedMacro RefIssueType = "unresolved_macro"
+)
+
+// RefIssue is a single attachment reference problem found in converted content.
+type RefIssue struct {
+	Type   RefIssueType `json:"type"`
+	Line   int          `json:"line"`
+	Target string       `json:"target"`
+	Text   string       `json:"text"`
+}
+
+var (
+	reRefImage      = regexp.MustCompile(`!\[[^\]]*\]\[([^\]]+)\]`)
+	reRefDefinition = regexp.MustCompile(`^\s{0,3}\[([^\]]+)\]:\s*\S`)
+	reAttachMacro   = regexp.MustCompile(`#(image|thumbnail|attach)\(([^)]*)\)`)
+)
+
+// CheckAttachmentRefs inspects converted content and reports reference-style
+// images that do not resolve to an attachment, and attachment macros
+// (#image/#thumbnail/#attach) that were left unconverted.
+// Lines inside fenced code blocks are ignored.
+func CheckAttachmentRefs(content string, attachments []string) []RefIssue {
+	attachmentSet := buildAttachmentSet(attachments)
+	lines := strings.Split(content, "\n")
+
+	definitions := map[string]bool{}
+	inFence := false
+	for _, line := range lines {
+		if isFenceLine(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if m := reRefDefinition.FindStringSubmatch(line); len(m) == 2 {
+			definitions[strings.ToLower(strings.TrimSpace(m[1]))] = true
+		}
+	}
+
+	var issues []RefIssue
+	inFence = false
+	for idx, line := range lines {
+		if isFenceLine(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		lineNo := idx + 1
+		for _, m := range reRefImage.FindAllStringSubmatch(line, -1) {
+			target := strings.TrimSpace(m[1])
+			if attachmentSet[target] || definitions[strings.ToLower(target)] {
+				continue
+			}
+			issues = append(issues, RefIssue{
+				Type:   RefIssueMissingAttachment,
+				Line:   lineNo,
+				Target: target,
+				Text:   m[0],
+			})
+		}
+		for _, m := range reAttachMacro.FindAllStringSubmatch(line, -1) {
+			issues = append(issues, RefIssue{
+				Type:   RefIssueUnresolvedMacro,
+				Line:   lineNo,
+				Target: strings.TrimSpace(m[2]),
+				Text:   m[0],
+			})
+		}
+	}
+	return issues
+}
+
+func isFenceLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
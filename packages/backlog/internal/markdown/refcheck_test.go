@@ -0,0 +1,57 @@
+package markdown
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckAttachmentRefs(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		attachments []string
+		want        []RefIssue
+	}{
+		{
+			name:        "resolved attachment",
+			content:     "![image][logo.png]",
+			attachments: []string{"logo.png"},
+			want:        nil,
+		},
+		{
+			name:    "missing attachment",
+			content: "text\n![image][gone.png]",
+			want: []RefIssue{
+				{Type: RefIssueMissingAttachment, Line: 2, Target: "gone.png", Text: "![image][gone.png]"},
+			},
+		},
+		{
+			name:    "link definition resolves reference",
+			content: "![alt][logo]\n\n[logo]: https://example.com/logo.png",
+			want:    nil,
+		},
+		{
+			name:    "unresolved macros",
+			content: "#image(missing.png)\n#thumbnail(1) #attach(doc.pdf)",
+			want: []RefIssue{
+				{Type: RefIssueUnresolvedMacro, Line: 1, Target: "missing.png", Text: "#image(missing.png)"},
+				{Type: RefIssueUnresolvedMacro, Line: 2, Target: "1", Text: "#thumbnail(1)"},
+				{Type: RefIssueUnresolvedMacro, Line: 2, Target: "doc.pdf", Text: "#attach(doc.pdf)"},
+			},
+		},
+		{
+			name:    "ignore fenced code",
+			content: "```\n#image(a.png)\n![x][b.png]\n```",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckAttachmentRefs(tt.content, tt.attachments)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckAttachmentRefs() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -63,7 +63,11 @@ type ConvertOptions struct {
 	ItemKey         string
 	URL             string
 	AttachmentNames []string
-	UnsafeRules     map[RuleID]bool
+	// UnsafeRules limits the unsafe rules to apply. nil allows all of them,
+	// an empty map none of them.
+	UnsafeRules map[RuleID]bool
+	// ConvertUnknown applies the unsafe rules to content whose mode is unknown.
+	ConvertUnknown bool
 }
 
 // ConvertResult represents conversion output.
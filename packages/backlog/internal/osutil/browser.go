@@ -0,0 +1,38 @@
+package osutil
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/browser"
+)
+
+// ResolveBrowser は URL を開くブラウザコマンドを決める
+// 優先順: 設定値（--browser / profile.browser）> $BROWSER。どちらも無ければ空文字（OS の既定）を返す
+func ResolveBrowser(configured string) string {
+	for _, command := range []string{configured, os.Getenv("BROWSER")} {
+		if command = strings.TrimSpace(command); command != "" {
+			return command
+		}
+	}
+	return ""
+}
+
+// OpenURL は URL をブラウザで開く
+// configured（profile.browser など）か $BROWSER が設定されていればそのコマンドで開き
+// （"google-chrome --profile-directory=Work" のような引数付きの指定や WSL・リモート環境向け）、
+// なければ OS の既定の方法（open / xdg-open / rundll32）で開く
+func OpenURL(configured, url string) error {
+	if command := ResolveBrowser(configured); command != "" {
+		cmd := shellCommand(command, url)
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+		// GUI ブラウザは終了まで戻らないことがあるため、起動だけして待たない
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		go func() { _ = cmd.Wait() }()
+		return nil
+	}
+	return browser.OpenURL(url)
+}
@@ -0,0 +1,25 @@
+package osutil
+
+import "testing"
+
+func TestResolveBrowser(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		env        string
+		want       string
+	}{
+		{name: "configured wins", configured: "google-chrome --profile-directory=Work", env: "firefox", want: "google-chrome --profile-directory=Work"},
+		{name: "browser env", env: "wslview", want: "wslview"},
+		{name: "blank values are ignored", configured: "  ", env: "firefox", want: "firefox"},
+		{name: "os default", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BROWSER", tt.env)
+			if got := ResolveBrowser(tt.configured); got != tt.want {
+				t.Errorf("ResolveBrowser(%q) = %q, want %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package osutil
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNoClipboard はクリップボードにコピーするコマンドが見つからないことを表す
+var ErrNoClipboard = errors.New("no clipboard command found (install wl-copy, xclip or xsel)")
+
+// clipboardCommands は OS ごとのクリップボードへの書き込みコマンドの候補を優先順に返す
+func clipboardCommands(goos string, getenv func(string) string) [][]string {
+	switch goos {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		// clip.exe は UTF-8 の日本語が文字化けするため PowerShell で書き込む
+		return [][]string{{"powershell", "-NoProfile", "-Command",
+			"[Console]::InputEncoding = [Text.Encoding]::UTF8; Set-Clipboard -Value ([Console]::In.ReadToEnd())"}}
+	}
+	var cmds [][]string
+	if getenv("WAYLAND_DISPLAY") != "" {
+		cmds = append(cmds, []string{"wl-copy"})
+	}
+	cmds = append(cmds,
+		[]string{"xclip", "-selection", "clipboard"},
+		[]string{"xsel", "--clipboard", "--input"},
+	)
+	// WSL では Windows 側のクリップボードに書き込む
+	if getenv("WSL_DISTRO_NAME") != "" {
+		cmds = append(cmds, []string{"clip.exe"})
+	}
+	return cmds
+}
+
+// CopyToClipboard は text をクリップボードにコピーする
+// 見つかった最初のクリップボードコマンドを使い、どれも無ければ ErrNoClipboard を返す
+func CopyToClipboard(text string) error {
+	for _, args := range clipboardCommands(runtime.GOOS, os.Getenv) {
+		if _, err := exec.LookPath(args[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		cmd.Stderr = os.Stderr
+		return cmd.Run()
+	}
+	return ErrNoClipboard
+}
@@ -0,0 +1,36 @@
+package osutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClipboardCommands(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+	first := func(cmds [][]string) []string {
+		if len(cmds) == 0 {
+			return nil
+		}
+		return cmds[0]
+	}
+
+	if got := first(clipboardCommands("darwin", env(nil))); !reflect.DeepEqual(got, []string{"pbcopy"}) {
+		t.Errorf("darwin = %v, want pbcopy", got)
+	}
+	if got := first(clipboardCommands("windows", env(nil))); len(got) == 0 || got[0] != "powershell" {
+		t.Errorf("windows = %v, want powershell", got)
+	}
+	if got := first(clipboardCommands("linux", env(map[string]string{"WAYLAND_DISPLAY": "wayland-0"}))); !reflect.DeepEqual(got, []string{"wl-copy"}) {
+		t.Errorf("wayland = %v, want wl-copy", got)
+	}
+	if got := first(clipboardCommands("linux", env(nil))); got[0] != "xclip" {
+		t.Errorf("x11 = %v, want xclip first", got)
+	}
+
+	wsl := clipboardCommands("linux", env(map[string]string{"WSL_DISTRO_NAME": "Ubuntu"}))
+	if got := wsl[len(wsl)-1]; !reflect.DeepEqual(got, []string{"clip.exe"}) {
+		t.Errorf("wsl fallback = %v, want clip.exe", got)
+	}
+}
@@ -0,0 +1,62 @@
+// Package osutil は OS ごとに挙動が異なる処理（エディタ・ブラウザの起動、
+// ファイルロックなど）を抽象化する
+package osutil
+
+import (
+	"os"
+	"strings"
+)
+
+// ResolveEditor は使用するエディタコマンドを決める
+// 優先順: 設定値（profile.editor）> $VISUAL > $EDITOR > OS の既定（Windows: notepad, その他: vi）
+func ResolveEditor(configured string) string {
+	for _, editor := range []string{configured, os.Getenv("VISUAL"), os.Getenv("EDITOR")} {
+		if editor = strings.TrimSpace(editor); editor != "" {
+			return editor
+		}
+	}
+	return defaultEditor
+}
+
+// EditFile はエディタでファイルを開き、エディタの終了を待つ
+// editor は引数付き（例: "code --wait"）でもよく、OS のシェル経由で起動する
+func EditFile(editor, path string) error {
+	cmd := shellCommand(editor, path)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// EditText は initial を書き込んだ一時ファイルをエディタで開き、編集後の内容を返す
+// pattern は一時ファイル名のパターン（os.CreateTemp と同じ形式）
+// エディタが異常終了した場合も、書きかけの内容を失わないよう読み取れた内容をエラーと一緒に返す
+func EditText(editor, initial, pattern string) (string, error) {
+	tmpfile, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = os.Remove(tmpfile.Name()) }()
+
+	if initial != "" {
+		if _, err := tmpfile.WriteString(initial); err != nil {
+			_ = tmpfile.Close()
+			return "", err
+		}
+	}
+	// Windows では開いたままのファイルを他プロセスが書き換えられないため、起動前に閉じる
+	if err := tmpfile.Close(); err != nil {
+		return "", err
+	}
+
+	if err := EditFile(editor, tmpfile.Name()); err != nil {
+		content, _ := os.ReadFile(tmpfile.Name())
+		return string(content), err
+	}
+
+	content, err := os.ReadFile(tmpfile.Name())
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
@@ -0,0 +1,28 @@
+package osutil
+
+import "testing"
+
+func TestResolveEditor(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		visual     string
+		editor     string
+		want       string
+	}{
+		{name: "configured wins", configured: "nano", visual: "vim", editor: "emacs", want: "nano"},
+		{name: "visual before editor", visual: "code --wait", editor: "emacs", want: "code --wait"},
+		{name: "editor", editor: "emacs", want: "emacs"},
+		{name: "blank values are ignored", configured: "  ", editor: "emacs", want: "emacs"},
+		{name: "os default", want: defaultEditor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VISUAL", tt.visual)
+			t.Setenv("EDITOR", tt.editor)
+			if got := ResolveEditor(tt.configured); got != tt.want {
+				t.Errorf("ResolveEditor(%q) = %q, want %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
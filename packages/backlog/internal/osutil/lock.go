@@ -0,0 +1,105 @@
+package osutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// LockInfo はロックファイルに記録する保持プロセスの情報
+type LockInfo struct {
+	PID  int    `json:"pid"`
+	Time string `json:"time"`
+}
+
+// AcquireLock は path のロックファイルを OS のファイルロック（flock / LockFileEx）で排他的にロックし、解放関数を返す
+// ロックは保持プロセスが終了すると OS が解放するため、クラッシュ等で残ったファイルはそのまま取得できる
+// force が true の場合は既存のロックファイルを無条件に取り除く
+func AcquireLock(path string, force bool) (func() error, error) {
+	if force {
+		_ = os.Remove(path)
+	}
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("create lock: %w", err)
+		}
+		if err := lockFile(file); err != nil {
+			_ = file.Close()
+			if errors.Is(err, errLockHeld) {
+				holder, _ := ReadLock(path)
+				return nil, lockHeldError(path, holder)
+			}
+			return nil, fmt.Errorf("lock %s: %w", path, err)
+		}
+		// 開いてからロックするまでの間に前の保持プロセスが削除したファイルなら、作り直して再試行する
+		if current, err := os.Stat(path); err == nil {
+			if opened, err := file.Stat(); err == nil && os.SameFile(current, opened) {
+				if err := writeLockInfo(file); err != nil {
+					_ = releaseLock(file, path)
+					return nil, err
+				}
+				return func() error { return releaseLock(file, path) }, nil
+			}
+		}
+		_ = unlockFile(file)
+		_ = file.Close()
+	}
+}
+
+// writeLockInfo は保持プロセスの PID と取得時刻を書き込む
+func writeLockInfo(file *os.File) error {
+	data, _ := json.Marshal(LockInfo{PID: os.Getpid(), Time: time.Now().Format(time.RFC3339)})
+	if err := file.Truncate(0); err != nil {
+		return fmt.Errorf("write lock: %w", err)
+	}
+	if _, err := file.WriteAt(append(data, '\n'), 0); err != nil {
+		return fmt.Errorf("write lock: %w", err)
+	}
+	return nil
+}
+
+// ErrLocked は他のプロセスがロックを保持していることを示す
+var ErrLocked = errors.New("lock already exists")
+
+// errLockHeld は lockFile が他のプロセスの保持するロックで失敗したことを示す
+var errLockHeld = errors.New("lock held by another process")
+
+// AcquireLockWait は AcquireLock を他のプロセスがロックを解放するまで interval ごとに再試行する
+// ctx が終了した場合は最後のエラーを返す
+func AcquireLockWait(ctx context.Context, path string, interval time.Duration) (func() error, error) {
+	for {
+		release, err := AcquireLock(path, false)
+		if err == nil || !errors.Is(err, ErrLocked) {
+			return release, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", err, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ReadLock はロックファイルの内容を読み込む
+func ReadLock(path string) (*LockInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var info LockInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, fmt.Errorf("parse lock: %w", err)
+	}
+	return &info, nil
+}
+
+func lockHeldError(path string, holder *LockInfo) error {
+	if holder == nil || holder.PID <= 0 {
+		return fmt.Errorf("%w: %s", ErrLocked, path)
+	}
+	return fmt.Errorf("%w: %s (held by pid %d since %s)", ErrLocked, path, holder.PID, holder.Time)
+}
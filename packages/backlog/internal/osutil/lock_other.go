@@ -0,0 +1,24 @@
+//go:build !unix && !windows
+
+package osutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ファイルロックの手段がない環境では、誤って同時に実行しないようロックを取得できないものとする
+
+func lockFile(*os.File) error {
+	return fmt.Errorf("file locking: %w", errors.ErrUnsupported)
+}
+
+func unlockFile(*os.File) error {
+	return nil
+}
+
+func releaseLock(file *os.File, path string) error {
+	_ = file.Close()
+	return os.Remove(path)
+}
@@ -0,0 +1,177 @@
+package osutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func writeLock(t *testing.T, path string, pid int) {
+	t.Helper()
+	data, _ := json.Marshal(LockInfo{PID: pid, Time: "2026-01-01T00:00:00Z"})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// exitedPID は終了済みプロセスの PID を返す
+func exitedPID(t *testing.T) int {
+	t.Helper()
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^$")
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	return cmd.ProcessState.Pid()
+}
+
+func TestAcquireLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock")
+
+	release, err := AcquireLock(path, false)
+	if err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	info, err := ReadLock(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.PID != os.Getpid() {
+		t.Errorf("PID = %d, want %d", info.PID, os.Getpid())
+	}
+
+	// 実行中のプロセス（自分自身）が保持するロックは取得できない
+	if _, err := AcquireLock(path, false); err == nil || !strings.Contains(err.Error(), "lock already exists") {
+		t.Errorf("second AcquireLock() error = %v, want lock already exists", err)
+	}
+
+	if err := release(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("lock file remains after release: %v", err)
+	}
+}
+
+func TestAcquireLockStale(t *testing.T) {
+	// 終了したプロセスが残したロックファイル（OS のロックは解放済み）は取得できる
+	path := filepath.Join(t.TempDir(), "lock")
+	writeLock(t, path, exitedPID(t))
+	release, err := AcquireLock(path, false)
+	if err != nil {
+		t.Fatalf("AcquireLock() error = %v", err)
+	}
+	if info, err := ReadLock(path); err != nil || info.PID != os.Getpid() {
+		t.Errorf("ReadLock() = %+v, %v, want the current pid", info, err)
+	}
+
+	// force はロックを保持中でも取り除く
+	release2, err := AcquireLock(path, true)
+	if err != nil {
+		t.Fatalf("AcquireLock(force) error = %v", err)
+	}
+	_ = release2()
+	_ = release()
+}
+
+func TestAcquireLockFileMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock")
+	release, err := AcquireLock(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = release() }()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if len(fields) != 2 || fields["pid"] == nil || fields["time"] == nil {
+		t.Errorf("lock file = %s, want only pid and time", data)
+	}
+	if runtime.GOOS != "windows" {
+		if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+			t.Errorf("lock file mode = %v, %v, want 0600", info.Mode().Perm(), err)
+		}
+	}
+}
+
+// 解放と取得が競合しても、同時に保持するのは 1 つだけ
+func TestAcquireLockExclusive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock")
+	var (
+		wg       sync.WaitGroup
+		holders  atomic.Int32
+		acquired atomic.Int32
+	)
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				release, err := AcquireLock(path, false)
+				if err != nil {
+					if !errors.Is(err, ErrLocked) {
+						t.Error(err)
+						return
+					}
+					continue
+				}
+				if holders.Add(1) != 1 {
+					t.Error("lock is held by more than one holder")
+				}
+				acquired.Add(1)
+				holders.Add(-1)
+				if err := release(); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if acquired.Load() == 0 {
+		t.Error("lock was never acquired")
+	}
+}
+
+func TestAcquireLockWait(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock")
+	release, err := AcquireLock(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 保持中はタイムアウトまで待って ErrLocked を返す
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := AcquireLockWait(ctx, path, 5*time.Millisecond); !errors.Is(err, ErrLocked) {
+		t.Fatalf("AcquireLockWait() error = %v, want ErrLocked", err)
+	}
+
+	// 待機中に解放されれば取得できる
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = release()
+	}()
+	release2, err := AcquireLockWait(context.Background(), path, 5*time.Millisecond)
+	if err != nil {
+		t.Fatalf("AcquireLockWait() error = %v", err)
+	}
+	_ = release2()
+}
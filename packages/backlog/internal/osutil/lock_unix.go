@@ -0,0 +1,33 @@
+//go:build unix
+
+package osutil
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+func lockFile(file *os.File) error {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return errLockHeld
+	}
+	return err
+}
+
+func unlockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
+
+// releaseLock はロックを保持したままファイルを削除してから解放する
+// 解放を待っていたプロセスは削除済みのファイルをロックしたことに気づき、作り直したファイルで取得し直す
+func releaseLock(file *os.File, path string) error {
+	err := os.Remove(path)
+	_ = unlockFile(file)
+	_ = file.Close()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
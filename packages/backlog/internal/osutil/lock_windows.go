@@ -0,0 +1,40 @@
+//go:build windows
+
+package osutil
+
+import (
+	"errors"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockOffset はロックする範囲の先頭
+// LockFileEx でロックした範囲は他のハンドルから読めないため、保持プロセスの情報を書く先頭部分は避ける
+const lockOffset = 0x7fffffff
+
+func lockFile(file *os.File) error {
+	ol := windows.Overlapped{OffsetHigh: lockOffset}
+	err := windows.LockFileEx(windows.Handle(file.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, &ol)
+	if errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
+		return errLockHeld
+	}
+	return err
+}
+
+func unlockFile(file *os.File) error {
+	ol := windows.Overlapped{OffsetHigh: lockOffset}
+	return windows.UnlockFileEx(windows.Handle(file.Fd()), 0, 1, 0, &ol)
+}
+
+// releaseLock はロックを解放してからファイルを削除する
+// Windows では開いているファイルを削除できないため、他のプロセスが開いている間は削除に失敗する。
+// 残ったファイルはロックされていないので、次の AcquireLock がそのまま使う
+func releaseLock(file *os.File, path string) error {
+	_ = unlockFile(file)
+	_ = file.Close()
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) && !errors.Is(err, windows.ERROR_SHARING_VIOLATION) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+
+package osutil
+
+import "os/exec"
+
+const defaultEditor = "vi"
+
+// shellCommand は command（引数を含んでよい）に args を付けて sh 経由で実行するコマンドを作る
+// args はシェルで解釈されないよう位置パラメータとして渡す
+func shellCommand(command string, args ...string) *exec.Cmd {
+	shArgs := append([]string{"-c", command + ` "$@"`, command}, args...)
+	return exec.Command("sh", shArgs...)
+}
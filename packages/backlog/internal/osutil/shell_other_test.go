@@ -0,0 +1,49 @@
+//go:build !windows
+
+package osutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestShellCommandKeepsArguments(t *testing.T) {
+	out, err := shellCommand("printf '%s|'", "a b", "$HOME", "it's").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(out), "a b|$HOME|it's|"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestEditText(t *testing.T) {
+	// 引数付きのエディタ指定でも一時ファイルのパスが最後の引数として渡される
+	script := filepath.Join(t.TempDir(), "editor.sh")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\nprintf '%s edited' \"$1\" >> \"$2\"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	got, err := EditText(script+" prefix", "initial\n", "backlog-*.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "initial\nprefix edited"; got != want {
+		t.Errorf("EditText() = %q, want %q", got, want)
+	}
+}
+
+func TestEditTextKeepsContentOnFailure(t *testing.T) {
+	// エディタが異常終了しても書きかけの内容を返す
+	script := filepath.Join(t.TempDir(), "editor.sh")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\nprintf 'draft' >> \"$1\"\nexit 1\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	got, err := EditText(script, "", "backlog-*.md")
+	if err == nil {
+		t.Fatal("EditText() error = nil, want exit error")
+	}
+	if got != "draft" {
+		t.Errorf("EditText() = %q, want %q", got, "draft")
+	}
+}
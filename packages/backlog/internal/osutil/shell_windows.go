@@ -0,0 +1,28 @@
+//go:build windows
+
+package osutil
+
+import (
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+const defaultEditor = "notepad"
+
+// shellCommand は command（引数を含んでよい）に args を付けて cmd.exe 経由で実行するコマンドを作る
+// CmdLine は argv[0] を含むコマンドライン全体として扱われる
+// cmd /c は先頭と末尾の引用符を1組取り除くため、全体をさらに引用符で囲む
+// （"C:\Program Files\...\code.exe" --wait のような指定をそのまま扱える）
+func shellCommand(command string, args ...string) *exec.Cmd {
+	var b strings.Builder
+	b.WriteString(command)
+	for _, arg := range args {
+		b.WriteString(` "`)
+		b.WriteString(arg)
+		b.WriteString(`"`)
+	}
+	cmd := exec.Command("cmd")
+	cmd.SysProcAttr = &syscall.SysProcAttr{CmdLine: `cmd /c "` + b.String() + `"`}
+	return cmd
+}
@@ -0,0 +1,203 @@
+// Package outputs はレポート系コマンドの出力を日時付きのファイルに自動保存し、
+// 保存した出力の履歴を扱う。
+//
+// 出力は display.auto_save_dir に 1 実行 1 ファイルで保存し、
+// 履歴は状態ディレクトリの JSON Lines ファイルに 1 行ずつ追記する。
+package outputs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Entry は保存した出力の履歴の 1 行
+type Entry struct {
+	// ID は履歴ファイル内の通し番号（1 始まり）。読み込み時に行の順に振るため記録はしない
+	ID   int       `json:"id,omitempty"`
+	Time time.Time `json:"time"`
+	// Command はルートコマンド名を除いたコマンドパス（例: "user report"）
+	Command string   `json:"command"`
+	Args    []string `json:"args,omitempty"`
+	Profile string   `json:"profile,omitempty"`
+	// Format は出力形式（table / json / csv など）
+	Format string `json:"format"`
+	Path   string `json:"path"`
+	Size   int    `json:"size"`
+}
+
+// Append は履歴ファイルにエントリを 1 行追記する
+func Append(historyPath string, e Entry) error {
+	e.ID = 0
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode output history entry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(historyPath), 0o700); err != nil {
+		return fmt.Errorf("failed to create output history directory: %w", err)
+	}
+	f, err := os.OpenFile(historyPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open output history: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write output history: %w", err)
+	}
+	return f.Close()
+}
+
+// Load は履歴を古い順に読み込む。ファイルが無ければ空を返し、壊れた行は読み飛ばす
+func Load(historyPath string) ([]Entry, error) {
+	f, err := os.Open(historyPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open output history: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		e.ID = len(entries) + 1
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read output history: %w", err)
+	}
+	return entries, nil
+}
+
+// Find は ID のエントリを返す
+func Find(entries []Entry, id int) (Entry, bool) {
+	if id < 1 || id > len(entries) {
+		return Entry{}, false
+	}
+	return entries[id-1], true
+}
+
+// ExpandDir は保存先ディレクトリの先頭の ~ をホームディレクトリに展開する
+func ExpandDir(dir string) (string, error) {
+	if dir != "~" && !strings.HasPrefix(dir, "~/") {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, strings.TrimPrefix(dir, "~")), nil
+}
+
+// Extension は出力形式に対応するファイルの拡張子を返す
+func Extension(format string) string {
+	switch strings.ToLower(format) {
+	case "json", "csv", "tsv":
+		return strings.ToLower(format)
+	case "markdown", "md":
+		return "md"
+	case "dot":
+		return "dot"
+	case "mermaid":
+		return "mmd"
+	default:
+		return "txt"
+	}
+}
+
+// FileName は保存するファイルの名前を返す（例: user_report_20240601-093000.csv）
+func FileName(commandKey, format string, t time.Time) string {
+	return fmt.Sprintf("%s_%s.%s", commandKey, t.Format("20060102-150405"), Extension(format))
+}
+
+// escapeRegex は端末向けの色指定と OSC 8 ハイパーリンクのエスケープシーケンスにマッチする
+var escapeRegex = regexp.MustCompile(`\x1b\[[0-9;]*m|\x1b\]8;;[^\x1b]*\x1b\\`)
+
+// StripEscapes は出力から端末向けのエスケープシーケンスを取り除く
+func StripEscapes(data []byte) []byte {
+	return escapeRegex.ReplaceAll(data, nil)
+}
+
+// Save は出力を dir に保存し、保存したファイルのパスを返す
+// 同じ秒に同じコマンドを実行した場合は末尾に番号を付けて上書きを避ける
+func Save(dir, commandKey, format string, t time.Time, data []byte) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create auto save directory: %w", err)
+	}
+	name := FileName(commandKey, format, t)
+	path := filepath.Join(dir, name)
+	for i := 2; ; i++ {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if os.IsExist(err) {
+			ext := filepath.Ext(name)
+			path = filepath.Join(dir, fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), i, ext))
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to save output: %w", err)
+		}
+		if _, err := f.Write(data); err != nil {
+			_ = f.Close()
+			return "", fmt.Errorf("failed to save output: %w", err)
+		}
+		return path, f.Close()
+	}
+}
+
+// Capture は標準出力への書き込みを端末に流しつつ記録する
+type Capture struct {
+	orig *os.File
+	w    *os.File
+	buf  bytes.Buffer
+	done chan struct{}
+}
+
+// StartCapture は os.Stdout をパイプに差し替えて記録を始める
+// パイプに書かれた出力はそのまま元の標準出力にも流す（tee）。os.Stdout が端末でなくなるため、
+// 呼び出し側は差し替え前に端末判定の基準を元の標準出力に固定しておく（ui.SetStdoutTerminal）
+func StartCapture() (*Capture, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	c := &Capture{orig: os.Stdout, w: w, done: make(chan struct{})}
+	os.Stdout = w
+	go func() {
+		defer close(c.done)
+		// 端末側の書き込みに失敗しても（| head など）記録は続ける
+		_, _ = io.Copy(io.MultiWriter(&c.buf, ignoreErrorWriter{c.orig}), r)
+		_ = r.Close()
+	}()
+	return c, nil
+}
+
+// Stop は os.Stdout を元に戻し、記録した出力を返す
+func (c *Capture) Stop() []byte {
+	os.Stdout = c.orig
+	_ = c.w.Close()
+	<-c.done
+	return c.buf.Bytes()
+}
+
+// ignoreErrorWriter は書き込みエラーを無視する io.Writer
+type ignoreErrorWriter struct {
+	w io.Writer
+}
+
+func (w ignoreErrorWriter) Write(p []byte) (int, error) {
+	_, _ = w.w.Write(p)
+	return len(p), nil
+}
@@ -0,0 +1,101 @@
+package outputs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveAndHistory(t *testing.T) {
+	dir := t.TempDir()
+	at := time.Date(2024, 6, 1, 9, 30, 0, 0, time.Local)
+
+	first, err := Save(filepath.Join(dir, "reports"), "user_report", "csv", at, []byte("month,created\n"))
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if want := filepath.Join(dir, "reports", "user_report_20240601-093000.csv"); first != want {
+		t.Errorf("Save() = %q, want %q", first, want)
+	}
+	// 同じ秒の2回目は上書きせず番号を付ける
+	second, err := Save(filepath.Join(dir, "reports"), "user_report", "csv", at, []byte("second\n"))
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if filepath.Base(second) != "user_report_20240601-093000-2.csv" {
+		t.Errorf("second Save() = %q, want a numbered file", second)
+	}
+
+	historyPath := filepath.Join(dir, "state", "outputs.jsonl")
+	for _, p := range []string{first, second} {
+		if err := Append(historyPath, Entry{Time: at, Command: "user report", Format: "csv", Path: p}); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+	// 壊れた行は読み飛ばす
+	f, _ := os.OpenFile(historyPath, os.O_APPEND|os.O_WRONLY, 0o600)
+	_, _ = f.WriteString("{broken\n")
+	_ = f.Close()
+
+	entries, err := Load(historyPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].ID != 1 || entries[1].ID != 2 || entries[1].Path != second {
+		t.Fatalf("Load() = %+v, want 2 entries with IDs", entries)
+	}
+	if e, ok := Find(entries, 2); !ok || e.Path != second {
+		t.Errorf("Find(2) = %+v, %v", e, ok)
+	}
+	if _, ok := Find(entries, 3); ok {
+		t.Error("Find(3) found an entry")
+	}
+
+	missing, err := Load(filepath.Join(dir, "none.jsonl"))
+	if err != nil || missing != nil {
+		t.Errorf("Load(missing) = %v, %v, want empty", missing, err)
+	}
+}
+
+func TestExtension(t *testing.T) {
+	for format, want := range map[string]string{"json": "json", "CSV": "csv", "table": "txt", "text": "txt", "markdown": "md", "dot": "dot", "mermaid": "mmd"} {
+		if got := Extension(format); got != want {
+			t.Errorf("Extension(%q) = %q, want %q", format, got, want)
+		}
+	}
+}
+
+func TestStripEscapes(t *testing.T) {
+	in := "\x1b[31mOpen\x1b[0m \x1b]8;;https://example.com/view/PROJ-1\x1b\\PROJ-1\x1b]8;;\x1b\\\n"
+	if got := string(StripEscapes([]byte(in))); got != "Open PROJ-1\n" {
+		t.Errorf("StripEscapes() = %q", got)
+	}
+}
+
+func TestCapture(t *testing.T) {
+	orig := os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = devNull.Close() }()
+	os.Stdout = devNull
+	defer func() { os.Stdout = orig }()
+
+	c, err := StartCapture()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println("line 1")
+	fmt.Fprintln(os.Stdout, "line 2")
+	got := string(c.Stop())
+
+	if got != "line 1\nline 2\n" {
+		t.Errorf("Capture = %q", got)
+	}
+	if os.Stdout != devNull {
+		t.Error("Stop() did not restore os.Stdout")
+	}
+}
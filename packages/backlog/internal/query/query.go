@@ -0,0 +1,293 @@
+// Package query は list 系コマンドの --query で使う検索 DSL をパースする。
+//
+// クエリは空白区切りの語の並びで、各語は次のいずれか:
+//
+//	key:value        値が一致（カンマ区切りでいずれかに一致）
+//	key:<value       比較（<, <=, >, >=。主に日付に使う）
+//	-key:value       否定（値が一致しない。ValidateNegatable で許可したキーのみ）
+//	text / "a b"     キーワード（key: を含まない語。引用符で空白を含められる）
+//
+// 例: `status:Open assignee:@me due:<7d priority:High login`
+//
+// 使えるキーと値の意味は各コマンドが決める。このパッケージは構文の解析と
+// 日付の解決だけを担う。
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Op は比較演算子
+type Op string
+
+const (
+	OpEq Op = ":"
+	OpLt Op = "<"
+	OpLe Op = "<="
+	OpGt Op = ">"
+	OpGe Op = ">="
+)
+
+// Term は key:value 形式の条件
+type Term struct {
+	Key   string
+	Op    Op
+	Value string
+	// Negated は -key:value の形で否定されているかどうか
+	Negated bool
+}
+
+// Query はパース済みのクエリ
+type Query struct {
+	Terms []Term
+	// Text は key: を持たない語（キーワード検索に使う）
+	Text []string
+}
+
+var keyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z_-]*$`)
+
+// Parse はクエリ文字列をパースする
+func Parse(input string) (*Query, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	q := &Query{}
+	for _, tok := range tokens {
+		if tok.quoted {
+			q.Text = append(q.Text, tok.text)
+			continue
+		}
+		text := tok.text
+		negated := strings.HasPrefix(text, "-")
+		if negated {
+			text = text[1:]
+		}
+		idx := strings.Index(text, ":")
+		// URL（https://...）は key:value ではなくキーワードとして扱う
+		if idx <= 0 || !keyPattern.MatchString(text[:idx]) || strings.HasPrefix(text[idx+1:], "//") {
+			q.Text = append(q.Text, tok.text)
+			continue
+		}
+		term := Term{Key: strings.ToLower(text[:idx]), Op: OpEq, Negated: negated}
+		value := text[idx+1:]
+		for _, op := range []Op{OpLe, OpGe, OpLt, OpGt} {
+			if strings.HasPrefix(value, string(op)) {
+				term.Op = op
+				value = value[len(op):]
+				break
+			}
+		}
+		term.Value = unquote(value)
+		if term.Value == "" {
+			return nil, fmt.Errorf("invalid query %q: %s has no value", input, term.Key)
+		}
+		q.Terms = append(q.Terms, term)
+	}
+	return q, nil
+}
+
+type token struct {
+	text string
+	// quoted は語全体が引用符で囲まれていたかどうか
+	quoted bool
+}
+
+// tokenize は引用符の外の空白で分割する
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	var b strings.Builder
+	var quote rune
+	inToken, quotedStart := false, false
+	flush := func() {
+		if inToken {
+			text := b.String()
+			quoted := quotedStart && len(text) >= 2 && (text[0] == '"' || text[0] == '\'') && text[len(text)-1] == text[0]
+			if quoted {
+				text = text[1 : len(text)-1]
+			}
+			tokens = append(tokens, token{text: text, quoted: quoted})
+		}
+		b.Reset()
+		inToken, quotedStart = false, false
+	}
+	for _, r := range input {
+		switch {
+		case quote != 0:
+			b.WriteRune(r)
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			if !inToken {
+				quotedStart = true
+			}
+			inToken = true
+			quote = r
+			b.WriteRune(r)
+		case r == ' ' || r == '\t' || r == '\n':
+			flush()
+		default:
+			inToken = true
+			b.WriteRune(r)
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("invalid query %q: unterminated quote", input)
+	}
+	flush()
+	return tokens, nil
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// Keyword はキーワード部分を空白で連結して返す
+func (q *Query) Keyword() string {
+	return strings.Join(q.Text, " ")
+}
+
+// Validate はクエリが allowed 以外のキーと否定（-key:value）を含まないことを確認する
+func (q *Query) Validate(allowed ...string) error {
+	return q.ValidateNegatable(allowed, nil)
+}
+
+// ValidateNegatable は Validate と同様に確認し、negatable のキーに限って否定を許す
+func (q *Query) ValidateNegatable(allowed, negatable []string) error {
+	set := make(map[string]bool, len(allowed))
+	for _, k := range allowed {
+		set[k] = true
+	}
+	for _, t := range q.Terms {
+		if !set[t.Key] {
+			sorted := append([]string(nil), allowed...)
+			sort.Strings(sorted)
+			if len(sorted) == 0 {
+				return fmt.Errorf("unknown query key %q (only keywords are supported)", t.Key)
+			}
+			return fmt.Errorf("unknown query key %q (allowed: %s)", t.Key, strings.Join(sorted, ", "))
+		}
+		if t.Negated && !slices.Contains(negatable, t.Key) {
+			return fmt.Errorf("%s does not support negation (-%s:value)", t.Key, t.Key)
+		}
+	}
+	return nil
+}
+
+// Lookup は key の条件をすべて返す
+func (q *Query) Lookup(key string) []Term {
+	var terms []Term
+	for _, t := range q.Terms {
+		if t.Key == key {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// Values は key の一致条件の値を返す
+// 同じキーを複数回指定した場合やカンマ区切りの値はまとめて返す。比較演算子を使った場合はエラー
+// 否定された条件（-key:value）は含めない（NegatedValues で取得する）
+func (q *Query) Values(key string) ([]string, error) {
+	return q.values(key, false)
+}
+
+// NegatedValues は key の否定条件（-key:value）の値を Values と同じ形で返す
+func (q *Query) NegatedValues(key string) ([]string, error) {
+	return q.values(key, true)
+}
+
+func (q *Query) values(key string, negated bool) ([]string, error) {
+	var values []string
+	for _, t := range q.Lookup(key) {
+		if t.Negated != negated {
+			continue
+		}
+		if t.Op != OpEq {
+			return nil, fmt.Errorf("%s does not support %q (use %s:value)", key, t.Op, key)
+		}
+		for _, v := range strings.Split(t.Value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values, nil
+}
+
+// DateRange は key の条件を日付範囲（YYYY-MM-DD、両端を含む）に変換する
+// 条件がない側は空文字列を返す。値は ResolveDate の形式を受け付ける
+func (q *Query) DateRange(key string, now time.Time) (since, until string, err error) {
+	const layout = "2006-01-02"
+	for _, t := range q.Lookup(key) {
+		d, err := ResolveDate(t.Value, now)
+		if err != nil {
+			return "", "", fmt.Errorf("%s: %w", key, err)
+		}
+		switch t.Op {
+		case OpEq:
+			since, until = d.Format(layout), d.Format(layout)
+		case OpLt:
+			until = d.AddDate(0, 0, -1).Format(layout)
+		case OpLe:
+			until = d.Format(layout)
+		case OpGt:
+			since = d.AddDate(0, 0, 1).Format(layout)
+		case OpGe:
+			since = d.Format(layout)
+		}
+	}
+	return since, until, nil
+}
+
+var relativeDatePattern = regexp.MustCompile(`^([+-]?)(\d+)([dwmy])$`)
+
+// ResolveDate は日付の指定を解決する
+//
+//   - YYYY-MM-DD
+//   - today / yesterday / tomorrow
+//   - 相対指定: 7d（7日後）, -7d（7日前）, 2w, 1m, 1y
+//
+// 相対指定は now の日付を基準にする（期日の「7日以内」を due:<=7d と書けるよう、符号なしは未来）
+func ResolveDate(value string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch strings.ToLower(value) {
+	case "today":
+		return today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+	if m := relativeDatePattern.FindStringSubmatch(strings.ToLower(value)); m != nil {
+		n, _ := strconv.Atoi(m[2])
+		if m[1] == "-" {
+			n = -n
+		}
+		switch m[3] {
+		case "d":
+			return today.AddDate(0, 0, n), nil
+		case "w":
+			return today.AddDate(0, 0, 7*n), nil
+		case "m":
+			return today.AddDate(0, n, 0), nil
+		default:
+			return today.AddDate(n, 0, 0), nil
+		}
+	}
+	d, err := time.ParseInLocation("2006-01-02", value, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (expected YYYY-MM-DD, today, or a relative date such as 7d / -2w)", value)
+	}
+	return d, nil
+}
@@ -0,0 +1,186 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantTerms []Term
+		wantText  []string
+		wantErr   bool
+	}{
+		{
+			name:  "keys and keyword",
+			input: `status:Open assignee:@me due:<7d Priority:High login`,
+			wantTerms: []Term{
+				{Key: "status", Op: OpEq, Value: "Open"},
+				{Key: "assignee", Op: OpEq, Value: "@me"},
+				{Key: "due", Op: OpLt, Value: "7d"},
+				{Key: "priority", Op: OpEq, Value: "High"},
+			},
+			wantText: []string{"login"},
+		},
+		{
+			name:  "quoted value and quoted keyword",
+			input: `status:"In Progress" "login error" created:>=2026-01-01`,
+			wantTerms: []Term{
+				{Key: "status", Op: OpEq, Value: "In Progress"},
+				{Key: "created", Op: OpGe, Value: "2026-01-01"},
+			},
+			wantText: []string{"login error"},
+		},
+		{
+			name:     "quoted key-like text is keyword",
+			input:    `"status:Open"`,
+			wantText: []string{"status:Open"},
+		},
+		{
+			name:     "url-like text is keyword",
+			input:    `https://example.com 12:30`,
+			wantText: []string{"https://example.com", "12:30"},
+		},
+		{
+			name:  "negated key",
+			input: `-status:Closed -7d -`,
+			wantTerms: []Term{
+				{Key: "status", Op: OpEq, Value: "Closed", Negated: true},
+			},
+			wantText: []string{"-7d", "-"},
+		},
+		{name: "empty value", input: `status:`, wantErr: true},
+		{name: "unterminated quote", input: `status:"Open`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(q.Terms, tt.wantTerms) {
+				t.Errorf("Terms = %#v, want %#v", q.Terms, tt.wantTerms)
+			}
+			if !reflect.DeepEqual(q.Text, tt.wantText) {
+				t.Errorf("Text = %#v, want %#v", q.Text, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestQuery_Values(t *testing.T) {
+	q, err := Parse(`status:Open,"In Progress" status:Resolved due:<7d`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := q.Values("status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"Open", "\"In Progress\"", "Resolved"}
+	// 値の途中の引用符はそのまま残る（先頭と末尾が対になる場合のみ外す）
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Values(status) = %q, want %q", got, want)
+	}
+	if _, err := q.Values("due"); err == nil {
+		t.Error("Values(due) should reject comparison operators")
+	}
+}
+
+func TestQuery_Validate(t *testing.T) {
+	q, err := Parse(`status:Open foo:bar`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Validate("status", "foo"); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if err := q.Validate("status"); err == nil {
+		t.Error("Validate() should reject unknown key")
+	}
+
+	q, err = Parse(`-status:Closed priority:High`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Validate("status", "priority"); err == nil {
+		t.Error("Validate() should reject negation")
+	}
+	if err := q.ValidateNegatable([]string{"status", "priority"}, []string{"status"}); err != nil {
+		t.Errorf("ValidateNegatable() error = %v", err)
+	}
+	if got, _ := q.Values("status"); len(got) != 0 {
+		t.Errorf("Values(status) = %q, want negated terms excluded", got)
+	}
+	if got, _ := q.NegatedValues("status"); !reflect.DeepEqual(got, []string{"Closed"}) {
+		t.Errorf("NegatedValues(status) = %q, want [Closed]", got)
+	}
+}
+
+func TestQuery_DateRange(t *testing.T) {
+	now := time.Date(2026, 4, 10, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input     string
+		wantSince string
+		wantUntil string
+	}{
+		{input: "due:<7d", wantUntil: "2026-04-16"},
+		{input: "due:<=7d", wantUntil: "2026-04-17"},
+		{input: "due:>today", wantSince: "2026-04-11"},
+		{input: "due:>=-1w", wantSince: "2026-04-03"},
+		{input: "due:2026-05-01", wantSince: "2026-05-01", wantUntil: "2026-05-01"},
+		{input: "due:>=2026-04-01 due:<2026-05-01", wantSince: "2026-04-01", wantUntil: "2026-04-30"},
+		{input: "status:Open"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			q, err := Parse(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			since, until, err := q.DateRange("due", now)
+			if err != nil {
+				t.Fatalf("DateRange() error = %v", err)
+			}
+			if since != tt.wantSince || until != tt.wantUntil {
+				t.Errorf("DateRange() = (%q, %q), want (%q, %q)", since, until, tt.wantSince, tt.wantUntil)
+			}
+		})
+	}
+}
+
+func TestResolveDate(t *testing.T) {
+	now := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "today", want: "2026-01-31"},
+		{value: "Yesterday", want: "2026-01-30"},
+		{value: "tomorrow", want: "2026-02-01"},
+		{value: "3d", want: "2026-02-03"},
+		{value: "+2w", want: "2026-02-14"},
+		{value: "-1y", want: "2025-01-31"},
+		{value: "2026-03-15", want: "2026-03-15"},
+		{value: "next week", wantErr: true},
+		{value: "2026/03/15", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ResolveDate(tt.value, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Format("2006-01-02") != tt.want {
+				t.Errorf("ResolveDate() = %s, want %s", got.Format("2006-01-02"), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Package queue はネットワークに接続できないときの書き込み操作をローカルに保留し、
+// 後で再送するためのキューを扱う。
+//
+// キューは JSON ファイル 1 つで、`backlog queue flush` で先頭から順に再送する。
+// 保留中に対象が他の人に更新された場合は競合として再送せずに残す。
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+// Kind は保留した操作の種類
+type Kind string
+
+const (
+	// KindIssueComment は課題へのコメント追加
+	KindIssueComment Kind = "issue.comment"
+	// KindIssueEdit は課題の更新
+	KindIssueEdit Kind = "issue.edit"
+)
+
+// Entry は保留した操作
+type Entry struct {
+	ID       int       `json:"id"`
+	Kind     Kind      `json:"kind"`
+	Space    string    `json:"space"`
+	IssueKey string    `json:"issueKey"`
+	QueuedAt time.Time `json:"queuedAt"`
+	// Comment は追加するコメント（KindIssueComment）
+	Comment string `json:"comment,omitempty"`
+	// Update は課題の更新内容（KindIssueEdit）
+	Update *api.UpdateIssueInput `json:"update,omitempty"`
+	// LastError は直近の再送で失敗した理由
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Summary は一覧表示用に操作の内容を短く表す
+func (e *Entry) Summary() string {
+	switch e.Kind {
+	case KindIssueComment:
+		return "comment: " + firstLine(e.Comment)
+	case KindIssueEdit:
+		return "edit: " + updateFields(e.Update)
+	default:
+		return string(e.Kind)
+	}
+}
+
+// Conflicts は保留後に対象が更新されているかどうかを返す
+// updated は課題の更新日時（RFC3339）。解釈できない場合は安全側に倒して競合とする
+func (e *Entry) Conflicts(updated string) bool {
+	t, err := time.Parse(time.RFC3339, updated)
+	if err != nil {
+		return true
+	}
+	return t.After(e.QueuedAt)
+}
+
+// File はキューファイル
+type File struct {
+	path string
+}
+
+// Open は path のキューファイルを扱う File を返す（ファイルは書き込み時に作成する）
+func Open(path string) *File {
+	return &File{path: path}
+}
+
+// Path はキューファイルのパスを返す
+func (f *File) Path() string {
+	return f.path
+}
+
+type fileContent struct {
+	Entries []Entry `json:"entries"`
+}
+
+// Load は保留中の操作を古い順に返す
+func (f *File) Load() ([]Entry, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read queue: %w", err)
+	}
+	var content fileContent
+	if err := json.Unmarshal(data, &content); err != nil {
+		// 壊れたファイルを上書きすると保留中の操作が失われるため、エラーにして手当てを促す
+		return nil, fmt.Errorf("parse queue %s: %w", f.path, err)
+	}
+	return content.Entries, nil
+}
+
+// Save は保留中の操作を書き込む（空の場合はファイルを削除する）
+func (f *File) Save(entries []Entry) error {
+	if len(entries) == 0 {
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove queue: %w", err)
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(fileContent{Entries: entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode queue: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return fmt.Errorf("create queue directory: %w", err)
+	}
+	// 書きかけで中断しても既存の内容を壊さないよう、一時ファイルから置き換える
+	tmp := f.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write queue: %w", err)
+	}
+	if err := os.Rename(tmp, f.path); err != nil {
+		return fmt.Errorf("write queue: %w", err)
+	}
+	return nil
+}
+
+// Add は操作を末尾に追加し、採番した ID を設定して返す
+func (f *File) Add(e Entry) (Entry, error) {
+	entries, err := f.Load()
+	if err != nil {
+		return Entry{}, err
+	}
+	e.ID = 1
+	for _, existing := range entries {
+		if existing.ID >= e.ID {
+			e.ID = existing.ID + 1
+		}
+	}
+	if err := f.Save(append(entries, e)); err != nil {
+		return Entry{}, err
+	}
+	return e, nil
+}
+
+// IsNetworkError はエラーがネットワークに接続できないことによるものかどうかを返す
+// 名前解決や接続の失敗のように、リクエストがサーバーに届いていないことが確実な場合のみ true。
+// 送信後のタイムアウトなどはサーバー側で処理済みの可能性があり、再送すると二重になるため含めない
+func IsNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func firstLine(s string) string {
+	if line, _, found := strings.Cut(s, "\n"); found {
+		return line + " ..."
+	}
+	return s
+}
+
+func updateFields(in *api.UpdateIssueInput) string {
+	if in == nil {
+		return ""
+	}
+	var fields []string
+	add := func(set bool, name string) {
+		if set {
+			fields = append(fields, name)
+		}
+	}
+	add(in.Summary != nil, "title")
+	add(in.Description != nil, "body")
+	add(in.StatusID != nil, "status")
+	add(in.ResolutionID != nil, "resolution")
+	add(in.StartDate != nil, "start")
+	add(in.DueDate != nil, "due")
+	add(in.EstimatedHours != nil, "estimated")
+	add(in.ActualHours != nil, "actual")
+	add(in.AssigneeID != nil, "assignee")
+	add(in.CategoryIDs != nil, "category")
+	add(in.VersionIDs != nil, "version")
+	add(in.MilestoneIDs != nil, "milestone")
+	add(in.PriorityID != nil, "priority")
+	add(in.IssueTypeID != nil, "type")
+	add(in.Comment != nil, "comment")
+	return strings.Join(fields, ", ")
+}
@@ -0,0 +1,113 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/api"
+)
+
+func TestFile_AddLoadSave(t *testing.T) {
+	f := Open(filepath.Join(t.TempDir(), "queue.json"))
+
+	entries, err := f.Load()
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Load() on missing file = %v, %v", entries, err)
+	}
+
+	title := "new title"
+	first, err := f.Add(Entry{Kind: KindIssueComment, IssueKey: "PROJ-1", Comment: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := f.Add(Entry{Kind: KindIssueEdit, IssueKey: "PROJ-2", Update: &api.UpdateIssueInput{Summary: &title, MilestoneIDs: []int{}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID != 1 || second.ID != 2 {
+		t.Errorf("IDs = %d, %d, want 1, 2", first.ID, second.ID)
+	}
+
+	entries, err = f.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2", len(entries))
+	}
+	up := entries[1].Update
+	if up == nil || up.Summary == nil || *up.Summary != title {
+		t.Errorf("Update.Summary not restored: %+v", up)
+	}
+	// 空配列（マイルストーン解除）と未指定を区別して保存する
+	if up.MilestoneIDs == nil || up.CategoryIDs != nil {
+		t.Errorf("MilestoneIDs = %#v, CategoryIDs = %#v", up.MilestoneIDs, up.CategoryIDs)
+	}
+
+	// 削除済みの ID は再利用しない
+	if err := f.Save(entries[1:]); err != nil {
+		t.Fatal(err)
+	}
+	third, err := f.Add(Entry{Kind: KindIssueComment, IssueKey: "PROJ-3", Comment: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.ID != 3 {
+		t.Errorf("ID = %d, want 3", third.ID)
+	}
+
+	if err := f.Save(nil); err != nil {
+		t.Fatal(err)
+	}
+	if entries, err := f.Load(); err != nil || len(entries) != 0 {
+		t.Errorf("Load() after clearing = %v, %v", entries, err)
+	}
+}
+
+func TestEntry_Conflicts(t *testing.T) {
+	queuedAt := time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC)
+	e := Entry{QueuedAt: queuedAt}
+	tests := []struct {
+		updated string
+		want    bool
+	}{
+		{updated: "2026-04-01T09:59:59Z", want: false},
+		{updated: "2026-04-01T19:00:01+09:00", want: true},
+		{updated: "2026-04-01T10:00:00Z", want: false},
+		{updated: "", want: true},
+	}
+	for _, tt := range tests {
+		if got := e.Conflicts(tt.updated); got != tt.want {
+			t.Errorf("Conflicts(%q) = %v, want %v", tt.updated, got, tt.want)
+		}
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	dial := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connect: network is unreachable")}
+	read := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "dns", err: &url.Error{Op: "Post", URL: "https://example.com", Err: &net.DNSError{Err: "no such host", Name: "example.com"}}, want: true},
+		{name: "dial", err: fmt.Errorf("failed: %w", &url.Error{Op: "Post", Err: dial}), want: true},
+		{name: "read after send", err: &url.Error{Op: "Post", Err: read}, want: false},
+		{name: "api error", err: &api.APIError{StatusCode: 404}, want: false},
+		{name: "other", err: errors.New("boom"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsNetworkError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Package relaysig は CLI から中継サーバーへのリクエストに Ed25519 署名を付与する。
+//
+// bundle_token に加えて CLI 側が保持する秘密鍵で署名することで、
+// bundle_token 単体が漏洩しても中継サーバーを悪用できないようにする（相互認証）。
+// 署名対象の正規化文字列は中継サーバー（relay-core の request-signature
+// ミドルウェア）と一致させる必要がある。
+package relaysig
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/jwk"
+)
+
+const (
+	// Version は正規化文字列の先頭に付与するバージョン識別子
+	Version = "BACKLOG-RELAY-SIG-V1"
+
+	// HeaderKeyID は署名鍵の kid を格納するヘッダー
+	HeaderKeyID = "X-Backlog-Relay-Key-Id"
+	// HeaderTimestamp は署名時刻（Unix秒）を格納するヘッダー
+	HeaderTimestamp = "X-Backlog-Relay-Timestamp"
+	// HeaderSignature は base64url（パディングなし）の署名を格納するヘッダー
+	HeaderSignature = "X-Backlog-Relay-Signature"
+)
+
+// JWK は Ed25519 鍵の JWK 表現
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	X   string `json:"x"`
+	D   string `json:"d,omitempty"`
+}
+
+// Signer はリクエスト署名を行う
+type Signer struct {
+	keyID       string
+	key         ed25519.PrivateKey
+	bundleToken string
+	now         func() time.Time
+}
+
+// NewSigner は秘密鍵と bundle_token から Signer を作成する
+// bundleToken が空の場合は Authorization ヘッダーを付与しない。
+func NewSigner(keyID string, key ed25519.PrivateKey, bundleToken string) *Signer {
+	return &Signer{
+		keyID:       keyID,
+		key:         key,
+		bundleToken: bundleToken,
+		now:         time.Now,
+	}
+}
+
+// LoadSigner は秘密鍵 JWK ファイルを読み込んで Signer を作成する
+func LoadSigner(keyPath, bundleToken string) (*Signer, error) {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read relay signing key: %w", err)
+	}
+	var k JWK
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, fmt.Errorf("failed to parse relay signing key: %w", err)
+	}
+	if k.Kid == "" {
+		return nil, fmt.Errorf("relay signing key has no kid")
+	}
+	priv, err := jwk.Ed25519PrivateKeyFromJWK(k.Kty, k.Crv, k.Kid, k.D)
+	if err != nil {
+		return nil, err
+	}
+	return NewSigner(k.Kid, priv, bundleToken), nil
+}
+
+// KeyID は署名鍵の kid を返す
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// Sign はリクエストに署名ヘッダーを付与する
+// body はリクエストボディそのもの（署名対象のハッシュ計算に使用）。
+func (s *Signer) Sign(req *http.Request, body []byte) {
+	ts := strconv.FormatInt(s.now().Unix(), 10)
+	msg := CanonicalString(req.Method, req.URL.EscapedPath(), ts, body)
+	sig := ed25519.Sign(s.key, []byte(msg))
+
+	req.Header.Set(HeaderKeyID, s.keyID)
+	req.Header.Set(HeaderTimestamp, ts)
+	req.Header.Set(HeaderSignature, base64.RawURLEncoding.EncodeToString(sig))
+	if s.bundleToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.bundleToken)
+	}
+}
+
+// CanonicalString は署名対象の正規化文字列を組み立てる
+//
+//	BACKLOG-RELAY-SIG-V1\n{METHOD}\n{PATH}\n{TIMESTAMP}\n{hex(sha256(body))}
+func CanonicalString(method, path, timestamp string, body []byte) string {
+	sum := sha256.Sum256(body)
+	return strings.Join([]string{
+		Version,
+		strings.ToUpper(method),
+		path,
+		timestamp,
+		hex.EncodeToString(sum[:]),
+	}, "\n")
+}
+
+// GenerateKey は新しい Ed25519 鍵ペアを生成し、秘密鍵・公開鍵の JWK を返す
+// kid が空の場合は公開鍵の SHA-256 から導出する。
+func GenerateKey(kid string) (private JWK, public JWK, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return JWK{}, JWK{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+	if kid == "" {
+		sum := sha256.Sum256(pub)
+		kid = "cli-" + hex.EncodeToString(sum[:8])
+	}
+	public = JWK{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		Kid: kid,
+		Alg: "EdDSA",
+		Use: "sig",
+		X:   base64.RawURLEncoding.EncodeToString(pub),
+	}
+	private = public
+	private.D = base64.RawURLEncoding.EncodeToString(priv.Seed())
+	return private, public, nil
+}
@@ -0,0 +1,87 @@
+package relaysig
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCanonicalString(t *testing.T) {
+	got := CanonicalString("post", "/auth/token", "1700000000", []byte(""))
+	want := strings.Join([]string{
+		"BACKLOG-RELAY-SIG-V1",
+		"POST",
+		"/auth/token",
+		"1700000000",
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}, "\n")
+	if got != want {
+		t.Errorf("CanonicalString() = %q, want %q", got, want)
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	priv, pub, err := GenerateKey("test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "key.json")
+	data, _ := json.Marshal(priv)
+	if err := os.WriteFile(keyPath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := LoadSigner(keyPath, "bundle-token")
+	if err != nil {
+		t.Fatalf("LoadSigner() error = %v", err)
+	}
+	signer.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	body := []byte(`{"grant_type":"refresh_token"}`)
+	req, _ := http.NewRequest(http.MethodPost, "https://relay.example.com/auth/token", nil)
+	signer.Sign(req, body)
+
+	if got := req.Header.Get(HeaderKeyID); got != "test-key" {
+		t.Errorf("key id = %q", got)
+	}
+	if got := req.Header.Get(HeaderTimestamp); got != "1700000000" {
+		t.Errorf("timestamp = %q", got)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer bundle-token" {
+		t.Errorf("authorization = %q", got)
+	}
+
+	pubBytes, _ := base64.RawURLEncoding.DecodeString(pub.X)
+	sig, err := base64.RawURLEncoding.DecodeString(req.Header.Get(HeaderSignature))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := CanonicalString("POST", "/auth/token", "1700000000", body)
+	if !ed25519.Verify(ed25519.PublicKey(pubBytes), []byte(msg), sig) {
+		t.Error("signature verification failed")
+	}
+	if ed25519.Verify(ed25519.PublicKey(pubBytes), []byte(CanonicalString("POST", "/auth/token", "1700000000", []byte("{}"))), sig) {
+		t.Error("signature should not verify for different body")
+	}
+}
+
+func TestGenerateKeyDerivesKid(t *testing.T) {
+	priv, pub, err := GenerateKey("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(pub.Kid, "cli-") || priv.Kid != pub.Kid {
+		t.Errorf("kid = %q / %q", priv.Kid, pub.Kid)
+	}
+	if pub.D != "" {
+		t.Error("public JWK must not contain d")
+	}
+}
@@ -0,0 +1,113 @@
+// Package secretscan は本文テキスト中の秘密情報らしき文字列を検出する。
+//
+// 課題本文・コメント・Wiki を送信する前の pre-flight チェックに使用する。
+// 検出は正規表現ベースのヒューリスティックであり、誤検知・見逃しがありうる。
+package secretscan
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Mode は検出時の動作モード
+type Mode string
+
+const (
+	// ModeOff は検出を行わない
+	ModeOff Mode = "off"
+	// ModeWarn は検出結果を警告として表示し、送信を続行する
+	ModeWarn Mode = "warn"
+	// ModeBlock は検出時に送信を中止する
+	ModeBlock Mode = "block"
+)
+
+// ParseMode は設定値をModeに変換する。未知の値は ModeWarn として扱う。
+func ParseMode(s string) Mode {
+	switch Mode(strings.ToLower(strings.TrimSpace(s))) {
+	case ModeOff:
+		return ModeOff
+	case ModeBlock:
+		return ModeBlock
+	default:
+		return ModeWarn
+	}
+}
+
+// Finding は検出結果
+type Finding struct {
+	Rule  string // 検出ルール名
+	Line  int    // 1始まりの行番号
+	Match string // マスク済みの一致文字列
+}
+
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+	// group は秘密値部分のサブマッチ番号（0 = マッチ全体）
+	group int
+}
+
+var rules = []rule{
+	{name: "aws_access_key_id", pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{name: "aws_secret_access_key", pattern: regexp.MustCompile(`(?i)aws_?secret_?access_?key\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})\b`), group: 1},
+	{name: "github_token", pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{name: "slack_token", pattern: regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}\b`)},
+	{name: "google_api_key", pattern: regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`)},
+	{name: "private_key", pattern: regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )?PRIVATE KEY-----`)},
+	{name: "jwt", pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\b`)},
+	{name: "bearer_token", pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9._~+/-]{20,}=*)`), group: 1},
+	{name: "password_assignment", pattern: regexp.MustCompile(`(?i)\b(?:password|passwd|pwd|secret|api_?key|access_?token)\s*[:=]\s*["']?([^\s"']{6,})`), group: 1},
+}
+
+// Scan はテキストから秘密情報らしき文字列を検出する
+func Scan(text string) []Finding {
+	if text == "" {
+		return nil
+	}
+
+	var findings []Finding
+	for i, line := range strings.Split(text, "\n") {
+		for _, r := range rules {
+			for _, m := range r.pattern.FindAllStringSubmatch(line, -1) {
+				value := m[0]
+				if r.group > 0 && r.group < len(m) {
+					value = m[r.group]
+				}
+				if isPlaceholder(value) {
+					continue
+				}
+				findings = append(findings, Finding{
+					Rule:  r.name,
+					Line:  i + 1,
+					Match: Mask(value),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// Mask は秘密値を先頭4文字のみ残してマスクする
+func Mask(s string) string {
+	runes := []rune(s)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:4]) + strings.Repeat("*", min(len(runes)-4, 8))
+}
+
+// isPlaceholder はドキュメント上のプレースホルダー表記を除外する
+func isPlaceholder(value string) bool {
+	v := strings.ToLower(value)
+	if strings.HasPrefix(v, "<") || strings.HasPrefix(v, "${") || strings.HasPrefix(v, "{{") {
+		return true
+	}
+	if strings.Trim(v, "*x.") == "" {
+		return true
+	}
+	switch v {
+	case "password", "changeme", "example", "dummy", "redacted":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,393 @@
+// Package selfupdate は GitHub Releases を使った CLI の更新確認と自己更新を行う。
+//
+// リリース成果物は .goreleaser.yaml の命名（backlog-cli_<version>_<os>_<arch>.tar.gz、
+// Windows は .zip）と checksums.txt を前提とする。
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRepo は更新を確認する GitHub リポジトリ
+	DefaultRepo = "yacchi/backlog-cli"
+	// DefaultAPIBaseURL は GitHub API のベース URL
+	DefaultAPIBaseURL = "https://api.github.com"
+
+	// EnvRepo はリポジトリ（owner/name）を上書きする環境変数（社内フォーク向け）
+	EnvRepo = "BACKLOG_UPDATE_REPO"
+	// EnvAPIBaseURL は GitHub API のベース URL を上書きする環境変数（GitHub Enterprise 向け）
+	EnvAPIBaseURL = "BACKLOG_UPDATE_API_URL"
+
+	checksumsName = "checksums.txt"
+	binaryName    = "backlog"
+	// backupSuffix は更新前のバイナリを退避するファイルの接尾辞
+	backupSuffix = ".old"
+	// maxDownloadSize はダウンロードする成果物の上限
+	maxDownloadSize = 200 << 20
+)
+
+// ErrNoBackup はロールバック用のバイナリが存在しない場合のエラー
+var ErrNoBackup = errors.New("no previous binary to roll back to")
+
+// Asset はリリースの成果物
+type Asset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+// Release は GitHub Release の情報
+type Release struct {
+	TagName     string  `json:"tag_name"`
+	Name        string  `json:"name"`
+	HTMLURL     string  `json:"html_url"`
+	PublishedAt string  `json:"published_at"`
+	Assets      []Asset `json:"assets"`
+}
+
+// Version はタグから先頭の v を除いたバージョンを返す
+func (r *Release) Version() string {
+	return strings.TrimPrefix(r.TagName, "v")
+}
+
+// Asset は名前が一致する成果物を返す
+func (r *Release) Asset(name string) (*Asset, bool) {
+	for i := range r.Assets {
+		if r.Assets[i].Name == name {
+			return &r.Assets[i], true
+		}
+	}
+	return nil, false
+}
+
+// Updater は更新確認と自己更新を行う
+type Updater struct {
+	HTTPClient *http.Client
+	APIBaseURL string
+	Repo       string
+	GOOS       string
+	GOARCH     string
+}
+
+// New は環境変数の上書きを反映した Updater を返す
+func New() *Updater {
+	u := &Updater{
+		HTTPClient: &http.Client{Timeout: 60 * time.Second},
+		APIBaseURL: DefaultAPIBaseURL,
+		Repo:       DefaultRepo,
+		GOOS:       runtime.GOOS,
+		GOARCH:     runtime.GOARCH,
+	}
+	if v := strings.TrimSpace(os.Getenv(EnvRepo)); v != "" {
+		u.Repo = v
+	}
+	if v := strings.TrimSpace(os.Getenv(EnvAPIBaseURL)); v != "" {
+		u.APIBaseURL = strings.TrimRight(v, "/")
+	}
+	return u
+}
+
+// LatestRelease は最新リリースを取得する
+func (u *Updater) LatestRelease(ctx context.Context) (*Release, error) {
+	return u.fetchRelease(ctx, fmt.Sprintf("%s/repos/%s/releases/latest", u.APIBaseURL, u.Repo))
+}
+
+// ReleaseByTag は指定タグのリリースを取得する（v の有無は問わない）
+func (u *Updater) ReleaseByTag(ctx context.Context, tag string) (*Release, error) {
+	if !strings.HasPrefix(tag, "v") {
+		tag = "v" + tag
+	}
+	return u.fetchRelease(ctx, fmt.Sprintf("%s/repos/%s/releases/tags/%s", u.APIBaseURL, u.Repo, tag))
+}
+
+func (u *Updater) fetchRelease(ctx context.Context, url string) (*Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := u.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch release: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch release: %s", resp.Status)
+	}
+	var rel Release
+	if err := json.NewDecoder(resp.Body).Decode(&rel); err != nil {
+		return nil, fmt.Errorf("failed to parse release: %w", err)
+	}
+	if rel.TagName == "" {
+		return nil, errors.New("release has no tag")
+	}
+	return &rel, nil
+}
+
+// ArchiveName は実行環境向けのアーカイブ名を返す
+func (u *Updater) ArchiveName(version string) string {
+	ext := ".tar.gz"
+	if u.GOOS == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("backlog-cli_%s_%s_%s%s", strings.TrimPrefix(version, "v"), u.GOOS, u.GOARCH, ext)
+}
+
+// DownloadBinary はリリースから実行環境向けのバイナリを取得し、チェックサムを検証して返す
+func (u *Updater) DownloadBinary(ctx context.Context, rel *Release) ([]byte, error) {
+	name := u.ArchiveName(rel.Version())
+	archive, ok := rel.Asset(name)
+	if !ok {
+		return nil, fmt.Errorf("release %s has no asset for %s/%s (%s)", rel.TagName, u.GOOS, u.GOARCH, name)
+	}
+	sums, ok := rel.Asset(checksumsName)
+	if !ok {
+		return nil, fmt.Errorf("release %s has no %s", rel.TagName, checksumsName)
+	}
+
+	checksums, err := u.download(ctx, sums.BrowserDownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := u.download(ctx, archive.BrowserDownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyChecksum(checksums, name, data); err != nil {
+		return nil, err
+	}
+	return ExtractBinary(data, name)
+}
+
+func (u *Updater) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := u.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	if len(data) > maxDownloadSize {
+		return nil, fmt.Errorf("download too large: %s", url)
+	}
+	return data, nil
+}
+
+// VerifyChecksum は checksums.txt（sha256sum 形式）で data を検証する
+func VerifyChecksum(checksums []byte, name string, data []byte) error {
+	var expected string
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			expected = strings.ToLower(fields[0])
+			break
+		}
+	}
+	if expected == "" {
+		return fmt.Errorf("checksum not found for %s", name)
+	}
+	sum := sha256.Sum256(data)
+	if actual := hex.EncodeToString(sum[:]); actual != expected {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", name, expected, actual)
+	}
+	return nil
+}
+
+// ExtractBinary はアーカイブから backlog バイナリを取り出す
+func ExtractBinary(archive []byte, archiveName string) ([]byte, error) {
+	if strings.HasSuffix(archiveName, ".zip") {
+		zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open archive: %w", err)
+		}
+		for _, f := range zr.File {
+			if filepath.Base(f.Name) != binaryName+".exe" {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer func() { _ = rc.Close() }()
+			return io.ReadAll(io.LimitReader(rc, maxDownloadSize))
+		}
+		return nil, fmt.Errorf("%s.exe not found in %s", binaryName, archiveName)
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && filepath.Base(hdr.Name) == binaryName {
+			return io.ReadAll(io.LimitReader(tr, maxDownloadSize))
+		}
+	}
+	return nil, fmt.Errorf("%s not found in %s", binaryName, archiveName)
+}
+
+// CompareVersions は semver 形式のバージョンを比較する（a<b: -1, a==b: 0, a>b: 1）
+// プレリリース（-rc.1 等）は同じ番号の正式版より小さいものとして扱う。
+func CompareVersions(a, b string) int {
+	coreA, preA := splitVersion(a)
+	coreB, preB := splitVersion(b)
+	for i := 0; i < 3; i++ {
+		if coreA[i] != coreB[i] {
+			if coreA[i] < coreB[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case preA == preB:
+		return 0
+	case preA == "":
+		return 1
+	case preB == "":
+		return -1
+	case preA < preB:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func splitVersion(v string) ([3]int, string) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		v = v[:i]
+	}
+	var pre string
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		v, pre = v[:i], v[i+1:]
+	}
+	var core [3]int
+	for i, part := range strings.SplitN(v, ".", 3) {
+		n, _ := strconv.Atoi(part)
+		core[i] = n
+	}
+	return core, pre
+}
+
+// IsDevVersion はリリースビルドではない（バージョン比較できない）かどうかを返す
+func IsDevVersion(v string) bool {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	return v == "" || v == "dev" || v[0] < '0' || v[0] > '9'
+}
+
+// IsHomebrewInstall は実行ファイルが Homebrew 管理下にあるかどうかを返す
+func IsHomebrewInstall(exePath string) bool {
+	p := filepath.ToSlash(exePath)
+	return strings.Contains(p, "/Cellar/") || strings.Contains(p, "/homebrew/")
+}
+
+// BackupPath は更新前のバイナリの退避先を返す
+func BackupPath(exePath string) string {
+	return exePath + backupSuffix
+}
+
+// Apply は exePath のバイナリを newBinary に置き換える
+// 既存のバイナリは BackupPath に退避し、置き換えに失敗した場合は元に戻す。
+func Apply(exePath string, newBinary []byte) error {
+	info, err := os.Stat(exePath)
+	if err != nil {
+		return fmt.Errorf("failed to stat executable: %w", err)
+	}
+	dir := filepath.Dir(exePath)
+	tmp, err := os.CreateTemp(dir, ".backlog-update-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file in %s: %w", dir, err)
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+	if _, err := tmp.Write(newBinary); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write new binary: %w", err)
+	}
+	if err := os.Chmod(tmpPath, info.Mode().Perm()|0o111); err != nil {
+		return fmt.Errorf("failed to chmod new binary: %w", err)
+	}
+	return swap(exePath, tmpPath)
+}
+
+// Rollback は BackupPath に退避したバイナリを元に戻す
+// 戻す前のバイナリは BackupPath に入れ替えるため、再度実行すると元に戻る。
+func Rollback(exePath string) error {
+	backup := BackupPath(exePath)
+	if _, err := os.Stat(backup); err != nil {
+		if os.IsNotExist(err) {
+			return ErrNoBackup
+		}
+		return err
+	}
+	tmp := exePath + ".rollback"
+	if err := os.Rename(backup, tmp); err != nil {
+		return fmt.Errorf("failed to prepare rollback: %w", err)
+	}
+	if err := swap(exePath, tmp); err != nil {
+		_ = os.Rename(tmp, backup)
+		return err
+	}
+	return nil
+}
+
+// swap は exePath を BackupPath に退避して newPath を exePath に移動する
+// Windows でも実行中のファイルはリネームできるため、削除ではなくリネームで退避する。
+func swap(exePath, newPath string) error {
+	backup := BackupPath(exePath)
+	_ = os.Remove(backup)
+	if err := os.Rename(exePath, backup); err != nil {
+		return fmt.Errorf("failed to back up current binary: %w", err)
+	}
+	if err := os.Rename(newPath, exePath); err != nil {
+		if rerr := os.Rename(backup, exePath); rerr != nil {
+			return fmt.Errorf("failed to install new binary: %w (rollback also failed: %v)", err, rerr)
+		}
+		return fmt.Errorf("failed to install new binary: %w", err)
+	}
+	return nil
+}
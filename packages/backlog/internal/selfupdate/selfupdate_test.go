@@ -0,0 +1,166 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"0.30.1", "0.30.1", 0},
+		{"v0.30.1", "0.30.1", 0},
+		{"0.30.1", "0.31.0", -1},
+		{"1.0.0", "0.99.9", 1},
+		{"0.31.0-rc.1", "0.31.0", -1},
+		{"0.31.0", "0.31.0-rc.1", 1},
+		{"0.31.0-rc.1", "0.31.0-rc.2", -1},
+		{"0.30.10", "0.30.9", 1},
+	}
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestIsDevVersion(t *testing.T) {
+	for v, want := range map[string]bool{"dev": true, "": true, "0.30.1": false, "v1.0.0": false} {
+		if got := IsDevVersion(v); got != want {
+			t.Errorf("IsDevVersion(%q) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestArchiveName(t *testing.T) {
+	u := &Updater{GOOS: "linux", GOARCH: "amd64"}
+	if got := u.ArchiveName("v0.30.1"); got != "backlog-cli_0.30.1_linux_amd64.tar.gz" {
+		t.Errorf("ArchiveName() = %s", got)
+	}
+	u = &Updater{GOOS: "windows", GOARCH: "arm64"}
+	if got := u.ArchiveName("0.30.1"); got != "backlog-cli_0.30.1_windows_arm64.zip" {
+		t.Errorf("ArchiveName() = %s", got)
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("archive")
+	sum := sha256.Sum256(data)
+	checksums := []byte(fmt.Sprintf("%s  other.tar.gz\n%s  a.tar.gz\n", hex.EncodeToString(make([]byte, 32)), hex.EncodeToString(sum[:])))
+
+	if err := VerifyChecksum(checksums, "a.tar.gz", data); err != nil {
+		t.Errorf("VerifyChecksum() = %v", err)
+	}
+	if err := VerifyChecksum(checksums, "other.tar.gz", data); err == nil {
+		t.Error("expected checksum mismatch")
+	}
+	if err := VerifyChecksum(checksums, "missing.tar.gz", data); err == nil {
+		t.Error("expected missing checksum error")
+	}
+}
+
+func makeTarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDownloadBinary(t *testing.T) {
+	archive := makeTarGz(t, map[string]string{"README.md": "readme", "backlog": "new-binary"})
+	sum := sha256.Sum256(archive)
+	name := "backlog-cli_0.31.0_linux_amd64.tar.gz"
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	mux.HandleFunc("/repos/o/r/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"tag_name":"v0.31.0","assets":[{"name":%q,"browser_download_url":"%s/a"},{"name":"checksums.txt","browser_download_url":"%s/c"}]}`, name, srv.URL, srv.URL)
+	})
+	mux.HandleFunc("/a", func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write(archive) })
+	mux.HandleFunc("/c", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, "%s  %s\n", hex.EncodeToString(sum[:]), name)
+	})
+
+	u := &Updater{HTTPClient: srv.Client(), APIBaseURL: srv.URL, Repo: "o/r", GOOS: "linux", GOARCH: "amd64"}
+	rel, err := u.LatestRelease(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel.Version() != "0.31.0" {
+		t.Errorf("Version() = %s", rel.Version())
+	}
+	bin, err := u.DownloadBinary(context.Background(), rel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bin) != "new-binary" {
+		t.Errorf("binary = %q", bin)
+	}
+
+	u.GOARCH = "386"
+	if _, err := u.DownloadBinary(context.Background(), rel); err == nil {
+		t.Error("expected missing asset error")
+	}
+}
+
+func TestApplyAndRollback(t *testing.T) {
+	exe := filepath.Join(t.TempDir(), "backlog")
+	if err := os.WriteFile(exe, []byte("v1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Rollback(exe); !errors.Is(err, ErrNoBackup) {
+		t.Fatalf("Rollback() without backup = %v", err)
+	}
+	if err := Apply(exe, []byte("v2")); err != nil {
+		t.Fatal(err)
+	}
+	assertFile(t, exe, "v2")
+	assertFile(t, BackupPath(exe), "v1")
+
+	if err := Rollback(exe); err != nil {
+		t.Fatal(err)
+	}
+	assertFile(t, exe, "v1")
+	assertFile(t, BackupPath(exe), "v2")
+}
+
+func assertFile(t *testing.T, path, want string) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != want {
+		t.Errorf("%s = %q, want %q", filepath.Base(path), data, want)
+	}
+}
@@ -0,0 +1,222 @@
+// Package share は課題などの情報を Slack / Microsoft Teams の Incoming Webhook 向けに
+// 整形して送信する。
+//
+// Slack は Block Kit、Teams は Adaptive Card の形式で送る。
+package share
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Target は共有先のサービス
+type Target string
+
+const (
+	// TargetSlack は Slack Incoming Webhook（Block Kit）
+	TargetSlack Target = "slack"
+	// TargetTeams は Microsoft Teams の Webhook（Adaptive Card）
+	TargetTeams Target = "teams"
+)
+
+// ParseTarget は共有先の名前を解釈する
+func ParseTarget(s string) (Target, error) {
+	switch t := Target(strings.ToLower(strings.TrimSpace(s))); t {
+	case TargetSlack, TargetTeams:
+		return t, nil
+	default:
+		return "", fmt.Errorf("unsupported share target %q (allowed: slack, teams)", s)
+	}
+}
+
+// DisplayName は表示用のサービス名を返す
+func (t Target) DisplayName() string {
+	switch t {
+	case TargetSlack:
+		return "Slack"
+	case TargetTeams:
+		return "Teams"
+	default:
+		return string(t)
+	}
+}
+
+// Message は共有する内容
+type Message struct {
+	// Title は見出し（URL がある場合はリンクになる）
+	Title string
+	URL   string
+	// Fields は「ステータス: 処理中」のような項目
+	Fields []Field
+	// Text は本文（長い場合は切り詰める）
+	Text string
+}
+
+// Field は見出しと値の組
+type Field struct {
+	Name  string
+	Value string
+}
+
+const (
+	// maxTextRunes は本文の最大文字数（Slack の section text 上限 3000 文字に収める）
+	maxTextRunes = 1000
+	// maxSlackFields は Slack の section fields の上限
+	maxSlackFields = 10
+)
+
+// Payload は共有先に応じた Webhook のリクエストボディを作る
+func Payload(t Target, m Message) (map[string]any, error) {
+	switch t {
+	case TargetSlack:
+		return slackPayload(m), nil
+	case TargetTeams:
+		return teamsPayload(m), nil
+	default:
+		return nil, fmt.Errorf("unsupported share target %q", t)
+	}
+}
+
+func slackPayload(m Message) map[string]any {
+	title := escapeSlack(m.Title)
+	if m.URL != "" {
+		title = fmt.Sprintf("<%s|%s>", m.URL, title)
+	}
+	blocks := []any{
+		map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": "*" + title + "*"},
+		},
+	}
+	if len(m.Fields) > 0 {
+		fields := make([]any, 0, len(m.Fields))
+		for i, f := range m.Fields {
+			if i >= maxSlackFields {
+				break
+			}
+			fields = append(fields, map[string]any{
+				"type": "mrkdwn",
+				"text": fmt.Sprintf("*%s*\n%s", escapeSlack(f.Name), escapeSlack(f.Value)),
+			})
+		}
+		blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+	}
+	if text := truncate(m.Text, maxTextRunes); text != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": escapeSlack(text)},
+		})
+	}
+	// text は通知やブロック非対応クライアント向けのフォールバック
+	fallback := m.Title
+	if m.URL != "" {
+		fallback += " " + m.URL
+	}
+	return map[string]any{"text": fallback, "blocks": blocks}
+}
+
+func teamsPayload(m Message) map[string]any {
+	body := []any{
+		map[string]any{
+			"type":   "TextBlock",
+			"text":   m.Title,
+			"weight": "Bolder",
+			"size":   "Medium",
+			"wrap":   true,
+		},
+	}
+	if len(m.Fields) > 0 {
+		facts := make([]any, 0, len(m.Fields))
+		for _, f := range m.Fields {
+			facts = append(facts, map[string]any{"title": f.Name, "value": f.Value})
+		}
+		body = append(body, map[string]any{"type": "FactSet", "facts": facts})
+	}
+	if text := truncate(m.Text, maxTextRunes); text != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": text, "wrap": true})
+	}
+	card := map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if m.URL != "" {
+		card["actions"] = []any{
+			map[string]any{"type": "Action.OpenUrl", "title": "Open in Backlog", "url": m.URL},
+		}
+	}
+	return map[string]any{
+		"type": "message",
+		"attachments": []any{
+			map[string]any{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     card,
+			},
+		},
+	}
+}
+
+// Send は Webhook URL にメッセージを送信する
+func Send(ctx context.Context, client *http.Client, t Target, webhookURL string, m Message) error {
+	if err := ValidateWebhookURL(webhookURL); err != nil {
+		return err
+	}
+	payload, err := Payload(t, m)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook returned HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// ValidateWebhookURL は Webhook URL が http(s) の絶対 URL であることを確認する
+func ValidateWebhookURL(webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q (expected http(s)://...)", webhookURL)
+	}
+	return nil
+}
+
+// escapeSlack は Slack mrkdwn の制御文字をエスケープする
+func escapeSlack(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// truncate は文字数で切り詰め、切り詰めた場合は末尾に … を付ける
+func truncate(s string, maxRunes int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:maxRunes])) + "…"
+}
@@ -0,0 +1,136 @@
+package share
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Target
+		wantErr bool
+	}{
+		{in: "slack", want: TargetSlack},
+		{in: "Teams", want: TargetTeams},
+		{in: " slack ", want: TargetSlack},
+		{in: "discord", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTarget(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTarget(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseTarget(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+var testMessage = Message{
+	Title:  "PROJ-1 Fix <login> & logout",
+	URL:    "https://example.backlog.jp/view/PROJ-1",
+	Fields: []Field{{Name: "Status", Value: "Open"}, {Name: "Assignee", Value: "Alice"}},
+	Text:   "Steps to reproduce",
+}
+
+func TestSlackPayload(t *testing.T) {
+	payload, err := Payload(TargetSlack, testMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := payload["text"], "PROJ-1 Fix <login> & logout https://example.backlog.jp/view/PROJ-1"; got != want {
+		t.Errorf("fallback text = %q, want %q", got, want)
+	}
+	blocks := payload["blocks"].([]any)
+	if len(blocks) != 3 {
+		t.Fatalf("blocks = %d, want 3", len(blocks))
+	}
+	title := blocks[0].(map[string]any)["text"].(map[string]any)["text"]
+	if want := "*<https://example.backlog.jp/view/PROJ-1|PROJ-1 Fix &lt;login&gt; &amp; logout>*"; title != want {
+		t.Errorf("title = %q, want %q", title, want)
+	}
+	fields := blocks[1].(map[string]any)["fields"].([]any)
+	if got := fields[0].(map[string]any)["text"]; got != "*Status*\nOpen" {
+		t.Errorf("field = %q", got)
+	}
+	text := blocks[2].(map[string]any)["text"].(map[string]any)["text"]
+	if text != "Steps to reproduce" {
+		t.Errorf("text = %q", text)
+	}
+}
+
+func TestTeamsPayload(t *testing.T) {
+	payload, err := Payload(TargetTeams, testMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attachments := payload["attachments"].([]any)
+	card := attachments[0].(map[string]any)["content"].(map[string]any)
+	if card["type"] != "AdaptiveCard" {
+		t.Errorf("card type = %v", card["type"])
+	}
+	body := card["body"].([]any)
+	if len(body) != 3 {
+		t.Fatalf("body = %d elements, want 3", len(body))
+	}
+	if title := body[0].(map[string]any)["text"]; title != testMessage.Title {
+		t.Errorf("title = %v, want raw title", title)
+	}
+	facts := body[1].(map[string]any)["facts"].([]any)
+	if len(facts) != 2 {
+		t.Errorf("facts = %d, want 2", len(facts))
+	}
+	actions := card["actions"].([]any)
+	if url := actions[0].(map[string]any)["url"]; url != testMessage.URL {
+		t.Errorf("action url = %v", url)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := truncate("  short  ", 10); got != "short" {
+		t.Errorf("truncate() = %q", got)
+	}
+	if got := truncate("あいうえお", 3); got != "あいう…" {
+		t.Errorf("truncate() = %q, want あいう…", got)
+	}
+}
+
+func TestSend(t *testing.T) {
+	var received map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q", ct)
+		}
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &received)
+		if strings.HasSuffix(r.URL.Path, "/fail") {
+			http.Error(w, "invalid_payload", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	if err := Send(t.Context(), srv.Client(), TargetSlack, srv.URL+"/ok", testMessage); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if _, ok := received["blocks"]; !ok {
+		t.Errorf("received payload without blocks: %v", received)
+	}
+
+	err := Send(t.Context(), srv.Client(), TargetSlack, srv.URL+"/fail", testMessage)
+	if err == nil || !strings.Contains(err.Error(), "HTTP 400") || !strings.Contains(err.Error(), "invalid_payload") {
+		t.Errorf("Send() error = %v, want HTTP 400 with detail", err)
+	}
+
+	if err := Send(t.Context(), srv.Client(), TargetSlack, "hooks.slack.com/x", testMessage); err == nil {
+		t.Error("Send() with invalid URL should fail")
+	}
+}
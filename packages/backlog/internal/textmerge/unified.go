@@ -0,0 +1,120 @@
+package textmerge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffOp は行単位の編集操作（' ' = 共通, '-' = 削除, '+' = 追加）
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// UnifiedDiff は before から after への差分を unified diff 形式で返す。
+// 差分がない場合は空文字列を返す。context は変更箇所の前後に含める行数。
+func UnifiedDiff(before, after, fromLabel, toLabel string, context int) string {
+	if before == after {
+		return ""
+	}
+	if context < 0 {
+		context = 0
+	}
+
+	base, mod := splitLines(before), splitLines(after)
+	ops := editScript(base, mod)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", fromLabel, toLabel)
+
+	// 変更箇所を context 行のマージンでまとめて hunk にする
+	i := 0
+	for i < len(ops) {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		start := max(i-context, 0)
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			// 次の変更までの共通行が 2*context 以下なら同じ hunk に含める
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next == len(ops) || next-end > 2*context {
+				end = min(end+context, len(ops))
+				break
+			}
+			end = next
+		}
+
+		baseStart, modStart := position(ops, start)
+		baseLen, modLen := 0, 0
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				baseLen++
+			}
+			if op.kind != '-' {
+				modLen++
+			}
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(baseStart, baseLen), hunkRange(modStart, modLen))
+		for _, op := range ops[start:end] {
+			b.WriteByte(op.kind)
+			b.WriteString(op.text)
+			b.WriteByte('\n')
+		}
+		i = end
+	}
+	return b.String()
+}
+
+// editScript は LCS から行単位の編集操作列を組み立てる
+func editScript(base, mod []string) []diffOp {
+	var ops []diffOp
+	bi := 0
+	for _, h := range computeHunks(base, mod) {
+		for ; bi < h.BaseStart; bi++ {
+			ops = append(ops, diffOp{' ', base[bi]})
+		}
+		for ; bi < h.BaseEnd; bi++ {
+			ops = append(ops, diffOp{'-', base[bi]})
+		}
+		for _, l := range h.Lines {
+			ops = append(ops, diffOp{'+', l})
+		}
+	}
+	for ; bi < len(base); bi++ {
+		ops = append(ops, diffOp{' ', base[bi]})
+	}
+	return ops
+}
+
+// position は ops[idx] 時点での base / mod の 0 始まり行番号を返す
+func position(ops []diffOp, idx int) (int, int) {
+	b, m := 0, 0
+	for _, op := range ops[:idx] {
+		if op.kind != '+' {
+			b++
+		}
+		if op.kind != '-' {
+			m++
+		}
+	}
+	return b, m
+}
+
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
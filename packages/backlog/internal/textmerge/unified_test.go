@@ -0,0 +1,50 @@
+package textmerge
+
+import "testing"
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		before  string
+		after   string
+		context int
+		want    string
+	}{
+		{
+			name:   "identical",
+			before: "a\nb",
+			after:  "a\nb",
+			want:   "",
+		},
+		{
+			name:    "single change",
+			before:  "a\nb\nc\nd\ne",
+			after:   "a\nb\nC\nd\ne",
+			context: 1,
+			want:    "--- before\n+++ after\n@@ -2,3 +2,3 @@\n b\n-c\n+C\n d\n",
+		},
+		{
+			name:    "separate hunks",
+			before:  "1\n2\n3\n4\n5\n6\n7\n8",
+			after:   "one\n2\n3\n4\n5\n6\n7\neight",
+			context: 1,
+			want:    "--- before\n+++ after\n@@ -1,2 +1,2 @@\n-1\n+one\n 2\n@@ -7,2 +7,2 @@\n 7\n-8\n+eight\n",
+		},
+		{
+			name:    "append to empty",
+			before:  "",
+			after:   "new",
+			context: 3,
+			want:    "--- before\n+++ after\n@@ -0,0 +1 @@\n+new\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UnifiedDiff(tt.before, tt.after, "before", "after", tt.context)
+			if got != tt.want {
+				t.Errorf("UnifiedDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Package translate は課題や Wiki の本文を外部の翻訳コマンドで翻訳する
+package translate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/cmdutil"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/debug"
+)
+
+// LangEnv は翻訳先の言語を翻訳コマンドに渡す環境変数
+const LangEnv = "BACKLOG_TRANSLATE_LANG"
+
+// defaultTimeout は translate.timeout が 0 の場合のタイムアウト
+const defaultTimeout = 60 * time.Second
+
+// Translator は外部コマンドで翻訳する
+// コマンドはシェルで実行し、原文を標準入力に渡して標準出力を訳文として受け取る
+type Translator struct {
+	Command  string
+	Language string
+	Timeout  time.Duration
+}
+
+// New は --translator の値から Translator を作成する
+// name が translate.translators に定義された名前ならそのコマンドを、それ以外はコマンドそのものとして使う
+// name が空の場合は translate.command を使う
+func New(cfg *config.Store, name, language string) (*Translator, error) {
+	if language == "" {
+		return nil, fmt.Errorf("target language is required")
+	}
+
+	command, ok, ignored := cfg.TranslateCommand(name)
+	if ignored {
+		return nil, fmt.Errorf("translator defined in project config (.backlog.yaml) is ignored for security; set it in your user config or pass --translator")
+	}
+	if !ok {
+		command = name
+	}
+	if command == "" {
+		return nil, fmt.Errorf("no translator configured; pass --translator or set translate.command")
+	}
+
+	timeout := cfg.Translate().TimeoutDuration()
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &Translator{Command: command, Language: language, Timeout: timeout}, nil
+}
+
+// Translate は text を翻訳する。空白だけのテキストはコマンドを実行せずそのまま返す
+func (t *Translator) Translate(ctx context.Context, text string) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return text, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.Timeout)
+	defer cancel()
+
+	command := t.expandCommand()
+	debug.Log("translate: executing command",
+		"command", command,
+		"language", t.Language,
+		"input_length", len(text),
+	)
+
+	var stdout, stderr bytes.Buffer
+	env := []string{LangEnv + "=" + t.Language}
+	if err := cmdutil.RunShellIO(ctx, command, env, strings.NewReader(text), &stdout, &stderr); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("translator timed out after %v", t.Timeout)
+		}
+		return "", fmt.Errorf("translator failed: %w\nstderr: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+// expandCommand はコマンド中の {lang} を翻訳先の言語（シェル引数としてクォート済み）に置き換える
+func (t *Translator) expandCommand() string {
+	return strings.ReplaceAll(t.Command, "{lang}", cmdutil.ShellQuote(t.Language))
+}
@@ -0,0 +1,55 @@
+package translate
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExpandCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		language string
+		want     string
+	}{
+		{"deepl-cli --to {lang}", "en", "deepl-cli --to en"},
+		{"trans -b :{lang}", "ja", "trans -b :ja"},
+		{"translate --to {lang}", "en; rm -rf /", "translate --to 'en; rm -rf /'"},
+		{"cat", "en", "cat"},
+	}
+	for _, tt := range tests {
+		tr := &Translator{Command: tt.command, Language: tt.language}
+		if got := tr.expandCommand(); got != tt.want {
+			t.Errorf("expandCommand(%q, %q) = %q, want %q", tt.command, tt.language, got, tt.want)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+	ctx := context.Background()
+
+	tr := &Translator{Command: `tr a-z A-Z; printf '[%s]' "$BACKLOG_TRANSLATE_LANG"`, Language: "en", Timeout: 5 * time.Second}
+	got, err := tr.Translate(ctx, "hello\n")
+	if err != nil {
+		t.Fatalf("Translate() error: %v", err)
+	}
+	if got != "HELLO\n[en]" {
+		t.Errorf("Translate() = %q, want %q", got, "HELLO\n[en]")
+	}
+
+	// 空のテキストではコマンドを実行しない
+	failing := &Translator{Command: "exit 1", Language: "en", Timeout: 5 * time.Second}
+	if got, err := failing.Translate(ctx, "  "); err != nil || got != "  " {
+		t.Errorf("Translate(blank) = (%q, %v), want (\"  \", nil)", got, err)
+	}
+
+	_, err = failing.Translate(ctx, "hello")
+	if err == nil || !strings.Contains(err.Error(), "translator failed") {
+		t.Errorf("Translate() error = %v, want translator failed", err)
+	}
+}
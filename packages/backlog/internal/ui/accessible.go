@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// accessible はスクリーンリーダー向けのプレーン出力モード（--accessible / BACKLOG_ACCESSIBLE）
+// 色・罫線・スピナーを使わず、表は「ラベル: 値」の行で出力する
+var accessible = false
+
+// SetAccessible はプレーン出力モードを設定する
+// 有効にすると色とハイパーリンクを無効にし、進捗表示も出さない
+func SetAccessible(enabled bool) {
+	accessible = enabled
+	if enabled {
+		colorEnabled = false
+		hyperlinkEnabled = false
+	}
+}
+
+// IsAccessible はプレーン出力モードかどうかを返す
+func IsAccessible() bool {
+	return accessible
+}
+
+// Rule は区切り線を返す。プレーン出力モードでは読み上げられないよう空文字を返す
+func Rule(width int) string {
+	if accessible {
+		return ""
+	}
+	return strings.Repeat("─", width)
+}
+
+// TreeBranch はツリー表示の接続記号と子要素のインデントを返す
+// プレーン出力モードでは罫線の代わりに空白のインデントと "-" を使う
+func TreeBranch(prefix string, last bool) (connector, childPrefix string) {
+	switch {
+	case accessible:
+		return "-", prefix + "  "
+	case last:
+		return "└─", prefix + "   "
+	default:
+		return "├─", prefix + "│  "
+	}
+}
+
+// statusPrefix は Success/Error などの記号をプレーン出力モードでは単語に置き換える
+func statusPrefix(symbol, word string, colorize func(string) string) string {
+	if accessible {
+		return word + ": "
+	}
+	return colorize(symbol + " ")
+}
+
+// renderRecords は表を1行ずつ「ヘッダー: 値」の形式で出力する（プレーン出力モード）
+// セルの区切りが読み上げで分からなくなるのを避けるため、行の間は空行で区切る
+func (t *Table) renderRecords(w io.Writer) {
+	for i, row := range t.rows {
+		if i > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+		for j, cell := range row {
+			label := ""
+			if j < len(t.headers) {
+				label = t.headers[j]
+			}
+			value := strings.TrimSpace(ansiEscapeRegex.ReplaceAllString(osc8Regex.ReplaceAllString(cell, ""), ""))
+			if value == "" {
+				value = "(none)"
+			}
+			if label == "" {
+				_, _ = fmt.Fprintln(w, value)
+				continue
+			}
+			_, _ = fmt.Fprintf(w, "%s: %s\n", label, value)
+		}
+	}
+}
+
+// OKMark は成功を表す記号（✓）を返す。プレーン出力モードでは "OK" を返す
+func OKMark() string {
+	if accessible {
+		return "OK:"
+	}
+	return Green("✓")
+}
+
+// FailMark は失敗を表す記号（✗）を返す。プレーン出力モードでは "Failed" を返す
+func FailMark() string {
+	if accessible {
+		return "Failed:"
+	}
+	return Red("✗")
+}
+
+// ProgressBar は ratio（0〜1）を width 文字の進捗バーで返す
+// プレーン出力モードでは読み上げられないよう空文字を返す（割合は呼び出し側で数値として表示する）
+func ProgressBar(ratio float64, width int) string {
+	if accessible || width <= 0 {
+		return ""
+	}
+	ratio = math.Max(0, math.Min(1, ratio))
+	filled := int(math.Round(ratio * float64(width)))
+	return Green(strings.Repeat("█", filled)) + Gray(strings.Repeat("░", width-filled))
+}
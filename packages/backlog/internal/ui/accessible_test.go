@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+)
+
+func enableAccessible(t *testing.T) {
+	t.Helper()
+	prevColor, prevHyperlink := colorEnabled, hyperlinkEnabled
+	SetAccessible(true)
+	t.Cleanup(func() {
+		accessible = false
+		colorEnabled, hyperlinkEnabled = prevColor, prevHyperlink
+	})
+}
+
+func TestAccessibleTable(t *testing.T) {
+	enableAccessible(t)
+	if IsColorEnabled() {
+		t.Error("color is enabled in accessible mode")
+	}
+	if err := ApplyColorMode("always"); err != nil || IsColorEnabled() {
+		t.Errorf("ApplyColorMode(always) enabled color in accessible mode (err: %v)", err)
+	}
+
+	table := NewTable("KEY", "SUMMARY", "ASSIGNEE")
+	table.AddRow("PROJ-1", "\x1b[31mFix login\x1b[0m", "alice")
+	table.AddRow("PROJ-2", "Add docs", "")
+	table.SetMaxWidth(20)
+
+	var buf bytes.Buffer
+	table.RenderWithColor(&buf, true)
+	want := "KEY: PROJ-1\nSUMMARY: Fix login\nASSIGNEE: alice\n\nKEY: PROJ-2\nSUMMARY: Add docs\nASSIGNEE: (none)\n"
+	if got := buf.String(); got != want {
+		t.Errorf("table = %q, want %q", got, want)
+	}
+}
+
+func TestAccessibleSymbols(t *testing.T) {
+	if Rule(3) != "───" {
+		t.Errorf("Rule(3) = %q", Rule(3))
+	}
+	if connector, child := TreeBranch("", true); connector != "└─" || child != "   " {
+		t.Errorf("TreeBranch() = %q, %q", connector, child)
+	}
+
+	enableAccessible(t)
+	if Rule(3) != "" {
+		t.Errorf("Rule(3) in accessible mode = %q, want empty", Rule(3))
+	}
+	if connector, child := TreeBranch("  ", false); connector != "-" || child != "    " {
+		t.Errorf("TreeBranch() in accessible mode = %q, %q", connector, child)
+	}
+	if got := statusPrefix("✓", "Success", Green); got != "Success: " {
+		t.Errorf("statusPrefix() = %q, want %q", got, "Success: ")
+	}
+	if showProgress() {
+		t.Error("progress is shown in accessible mode")
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	prev := colorEnabled
+	colorEnabled = false
+	t.Cleanup(func() { colorEnabled = prev })
+
+	tests := []struct {
+		ratio float64
+		want  string
+	}{
+		{0, "░░░░░░░░░░"},
+		{0.34, "███░░░░░░░"},
+		{1, "██████████"},
+		{1.5, "██████████"},
+		{-1, "░░░░░░░░░░"},
+	}
+	for _, tt := range tests {
+		if got := ProgressBar(tt.ratio, 10); got != tt.want {
+			t.Errorf("ProgressBar(%v) = %q, want %q", tt.ratio, got, tt.want)
+		}
+	}
+
+	enableAccessible(t)
+	if got := ProgressBar(0.5, 10); got != "" {
+		t.Errorf("ProgressBar() in accessible mode = %q, want empty", got)
+	}
+}
@@ -1,9 +1,14 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/term"
 )
@@ -13,7 +18,34 @@ var hyperlinkEnabled = false
 
 func init() {
 	// 色が使えるかチェック
-	colorEnabled = term.IsTerminal(int(os.Stdout.Fd()))
+	colorEnabled = autoColorEnabled()
+}
+
+// autoColorEnabled は auto モードで色を使うかどうかを返す
+// NO_COLOR（https://no-color.org/）が空でなければ使わない
+func autoColorEnabled() bool {
+	return os.Getenv("NO_COLOR") == "" && IsStdoutTerminal()
+}
+
+// ApplyColorMode はカラー出力のモード（auto, always, never）を適用する
+// auto（または空）は標準出力が端末で、NO_COLOR が設定されていない場合に色を使う
+func ApplyColorMode(mode string) error {
+	if accessible {
+		// プレーン出力モードでは常に色を使わない
+		colorEnabled = false
+		return nil
+	}
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "auto":
+		colorEnabled = autoColorEnabled()
+	case "always":
+		colorEnabled = true
+	case "never":
+		colorEnabled = false
+	default:
+		return fmt.Errorf("invalid color mode %q (must be auto, always, or never)", mode)
+	}
+	return nil
 }
 
 // SetColorEnabled は色の有効/無効を設定する
@@ -37,16 +69,57 @@ func IsHyperlinkEnabled() bool {
 }
 
 const (
-	reset  = "\033[0m"
-	bold   = "\033[1m"
-	red    = "\033[31m"
-	green  = "\033[32m"
-	yellow = "\033[33m"
-	blue   = "\033[34m"
-	cyan   = "\033[36m"
-	gray   = "\033[90m"
+	reset   = "\033[0m"
+	bold    = "\033[1m"
+	red     = "\033[31m"
+	green   = "\033[32m"
+	yellow  = "\033[33m"
+	blue    = "\033[34m"
+	magenta = "\033[35m"
+	cyan    = "\033[36m"
+	white   = "\033[37m"
+	gray    = "\033[90m"
 )
 
+// namedColors は設定で使える色名とエスケープシーケンス
+var namedColors = map[string]string{
+	"bold":    bold,
+	"red":     red,
+	"green":   green,
+	"yellow":  yellow,
+	"blue":    blue,
+	"magenta": magenta,
+	"cyan":    cyan,
+	"white":   white,
+	"gray":    gray,
+	"grey":    gray,
+	"none":    "",
+}
+
+var hexColorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// ValidateColor は色の指定（色名または #RRGGBB）が正しいかを確認する
+func ValidateColor(spec string) error {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if _, ok := namedColors[spec]; ok || hexColorPattern.MatchString(spec) {
+		return nil
+	}
+	return fmt.Errorf("invalid color %q (use red, green, yellow, blue, magenta, cyan, white, gray, bold, none, or #RRGGBB)", spec)
+}
+
+// Colorize は色の指定（色名または #RRGGBB）で文字色を付ける。不正な指定の場合はそのまま返す
+func Colorize(spec, s string) string {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if hexColorPattern.MatchString(spec) {
+		return HexColor(spec, s)
+	}
+	code := namedColors[spec]
+	if !colorEnabled || code == "" {
+		return s
+	}
+	return code + s + reset
+}
+
 // Bold は太字にする
 func Bold(s string) string {
 	if !colorEnabled {
@@ -103,8 +176,56 @@ func Gray(s string) string {
 	return gray + s + reset
 }
 
+var (
+	statusColors   map[string]string
+	priorityColors map[string]string
+	prStatusColors map[string]string
+)
+
+// SetStatusColors はステータス名ごとの表示色を設定する（display.colors.status）
+// 不正な色の指定は無視し、エラーとして返す
+func SetStatusColors(colors map[string]string) error {
+	var err error
+	statusColors, err = normalizeColorMap("display.colors.status", colors)
+	return err
+}
+
+// SetPriorityColors は優先度名ごとの表示色を設定する（display.colors.priority）
+// 不正な色の指定は無視し、エラーとして返す
+func SetPriorityColors(colors map[string]string) error {
+	var err error
+	priorityColors, err = normalizeColorMap("display.colors.priority", colors)
+	return err
+}
+
+// SetPRStatusColors はプルリクエストの状態ごとの表示色を設定する（display.colors.pr_status）
+// 不正な色の指定は無視し、エラーとして返す
+func SetPRStatusColors(colors map[string]string) error {
+	var err error
+	prStatusColors, err = normalizeColorMap("display.colors.pr_status", colors)
+	return err
+}
+
+// normalizeColorMap はキーを小文字にそろえ、不正な色の指定を取り除く
+func normalizeColorMap(name string, colors map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(colors))
+	var errs []error
+	for key, spec := range colors {
+		if err := ValidateColor(spec); err != nil {
+			errs = append(errs, fmt.Errorf("%s.%s: %w", name, key, err))
+			continue
+		}
+		normalized[strings.ToLower(key)] = spec
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return normalized, errors.Join(errs...)
+}
+
 // StatusColor はステータスに応じた色を返す
 func StatusColor(status string) string {
+	if spec, ok := statusColors[strings.ToLower(status)]; ok {
+		return Colorize(spec, status)
+	}
 	switch status {
 	case "完了", "Closed", "Done":
 		return Green(status)
@@ -117,8 +238,30 @@ func StatusColor(status string) string {
 	}
 }
 
+// PRStatusColor はプルリクエストの状態に応じた色を返す
+// id は Backlog の PR ステータス ID（1=Open, 2=Closed, 3=Merged）
+func PRStatusColor(id int, name string) string {
+	label := name
+	builtin := ""
+	switch id {
+	case 1:
+		label, builtin = "Open", "green"
+	case 2:
+		label, builtin = "Closed", "red"
+	case 3:
+		label, builtin = "Merged", "blue"
+	}
+	if spec, ok := prStatusColors[strings.ToLower(label)]; ok {
+		return Colorize(spec, label)
+	}
+	return Colorize(builtin, label)
+}
+
 // PriorityColor は優先度に応じた色を返す
 func PriorityColor(priority string) string {
+	if spec, ok := priorityColors[strings.ToLower(priority)]; ok {
+		return Colorize(spec, priority)
+	}
 	switch priority {
 	case "高", "High":
 		return Red(priority)
@@ -133,22 +276,22 @@ func PriorityColor(priority string) string {
 
 // Success は成功メッセージを出力する
 func Success(format string, args ...interface{}) {
-	fmt.Printf(Green("✓ ")+format+"\n", args...)
+	fmt.Printf(statusPrefix("✓", "Success", Green)+format+"\n", args...)
 }
 
 // Error はエラーメッセージを出力する
 func Error(format string, args ...interface{}) {
-	fmt.Fprintf(os.Stderr, Red("✗ ")+format+"\n", args...)
+	fmt.Fprintf(os.Stderr, statusPrefix("✗", "Error", Red)+format+"\n", args...)
 }
 
 // Warning は警告メッセージを出力する
 func Warning(format string, args ...interface{}) {
-	fmt.Printf(Yellow("! ")+format+"\n", args...)
+	fmt.Printf(statusPrefix("!", "Warning", Yellow)+format+"\n", args...)
 }
 
 // Info は情報メッセージを出力する
 func Info(format string, args ...interface{}) {
-	fmt.Printf(Blue("ℹ ")+format+"\n", args...)
+	fmt.Printf(statusPrefix("ℹ", "Info", Blue)+format+"\n", args...)
 }
 
 // Hyperlink はターミナルハイパーリンク（OSC 8）を生成する
@@ -197,19 +340,48 @@ func IsStderrTTY() bool {
 	return term.IsTerminal(int(os.Stderr.Fd()))
 }
 
-// StartProgress は進捗メッセージをstderrに表示する（TTYの場合のみ）
-// 戻り値の関数を呼ぶとメッセージをクリアする
+// progressEnabled が false の場合はスピナーやプログレスバーを表示しない（--quiet）
+var progressEnabled = true
+
+// SetProgressEnabled は進捗表示の有効/無効を設定する
+func SetProgressEnabled(enabled bool) {
+	progressEnabled = enabled
+}
+
+// showProgress は進捗表示を出すかどうかを返す（stderr が TTY の場合のみ。プレーン出力モードでは出さない）
+func showProgress() bool {
+	return progressEnabled && !accessible && IsStderrTTY()
+}
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// StartProgress はスピナー付きの進捗メッセージをstderrに表示する（TTYの場合のみ）
+// 戻り値の関数を呼ぶとスピナーを止めてメッセージをクリアする（複数回呼んでもよい）
 func StartProgress(message string) func() {
-	if !IsStderrTTY() {
+	if !showProgress() {
 		return func() {} // no-op
 	}
-	// メッセージを表示（改行なしでカーソルを行頭に戻す準備）
-	displayMsg := Gray(message)
-	fmt.Fprint(os.Stderr, displayMsg)
-	// 表示した文字数を記録（エスケープシーケンスを除いた実際の表示文字数）
-	msgLen := len(message)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			fmt.Fprintf(os.Stderr, "\r%s %s", Cyan(spinnerFrames[i%len(spinnerFrames)]), Gray(message))
+			select {
+			case <-done:
+				clearLine()
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	var once sync.Once
 	return func() {
-		// カーソルを行頭に戻し、スペースで上書きしてから再度行頭に戻る
-		fmt.Fprintf(os.Stderr, "\r%s\r", strings.Repeat(" ", msgLen))
+		once.Do(func() {
+			close(done)
+			<-stopped
+		})
 	}
 }
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyColorMode(t *testing.T) {
+	defer SetColorEnabled(colorEnabled)
+
+	tests := []struct {
+		name    string
+		mode    string
+		noColor string
+		want    bool
+		wantErr bool
+	}{
+		{name: "always", mode: "always", want: true},
+		{name: "always overrides NO_COLOR", mode: "Always", noColor: "1", want: true},
+		{name: "never", mode: "never", want: false},
+		// テスト実行時の標準出力は端末ではないため auto は常に無効
+		{name: "auto", mode: "auto", want: false},
+		{name: "auto with NO_COLOR", mode: "", noColor: "1", want: false},
+		{name: "invalid", mode: "sometimes", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", tt.noColor)
+			err := ApplyColorMode(tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyColorMode(%q) error = %v, wantErr %v", tt.mode, err, tt.wantErr)
+			}
+			if !tt.wantErr && IsColorEnabled() != tt.want {
+				t.Errorf("IsColorEnabled() = %v, want %v", IsColorEnabled(), tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusColorOverrides(t *testing.T) {
+	defer SetColorEnabled(colorEnabled)
+	defer func() { _ = SetStatusColors(nil) }()
+	SetColorEnabled(true)
+
+	err := SetStatusColors(map[string]string{
+		"Open":   "green",
+		"レビュー待ち": "#e07b39",
+		"完了":     "none",
+		"Broken": "pink",
+	})
+	if err == nil || !strings.Contains(err.Error(), "display.colors.status.Broken") {
+		t.Fatalf("SetStatusColors() error = %v, want invalid color for Broken", err)
+	}
+
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{status: "open", want: green + "open" + reset},
+		{status: "レビュー待ち", want: "\033[38;2;224;123;57mレビュー待ち" + reset},
+		{status: "完了", want: "完了"},
+		{status: "処理中", want: blue + "処理中" + reset},
+		{status: "Broken", want: "Broken"},
+	}
+	for _, tt := range tests {
+		if got := StatusColor(tt.status); got != tt.want {
+			t.Errorf("StatusColor(%q) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestPRStatusColor(t *testing.T) {
+	defer SetColorEnabled(colorEnabled)
+	defer func() { _ = SetPRStatusColors(nil) }()
+	SetColorEnabled(true)
+
+	if got := PRStatusColor(3, "Merged"); got != blue+"Merged"+reset {
+		t.Errorf("PRStatusColor(3) = %q", got)
+	}
+	if err := SetPRStatusColors(map[string]string{"merged": "magenta"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := PRStatusColor(3, "Merged"); got != magenta+"Merged"+reset {
+		t.Errorf("PRStatusColor(3) with override = %q", got)
+	}
+	if got := PRStatusColor(9, "Draft"); got != "Draft" {
+		t.Errorf("PRStatusColor(9) = %q, want Draft", got)
+	}
+}
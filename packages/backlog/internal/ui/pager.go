@@ -0,0 +1,378 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// PagerAction は一覧ページャーで選択中の行に対して実行できる操作
+type PagerAction struct {
+	Key   rune
+	Label string
+}
+
+// PagerPage は一覧ページャーが順次読み込む 1 ページ分の行
+type PagerPage struct {
+	Rows [][]string
+	// Done はこれ以上読み込む行が無いことを表す
+	Done bool
+}
+
+type pagerResult struct {
+	page PagerPage
+	err  error
+}
+
+// ListPager は行を順次読み込みながらスクロール表示する軽量の TUI ページャー。
+// カーソルが読み込み済みの末尾に近づくと次のページを裏で読み込むため、
+// 全件の取得を待たずに先頭から閲覧できる。
+type ListPager struct {
+	Title   string
+	Headers []string
+	// Fetch は offset 行目以降の行を読み込む
+	Fetch func(offset int) (PagerPage, error)
+	// Actions は行に対する操作。Enter は先頭の操作として扱う
+	Actions []PagerAction
+
+	rows    [][]string
+	done    bool
+	err     error
+	cursor  int
+	top     int
+	pending chan pagerResult
+
+	in   io.Reader
+	out  io.Writer
+	size func() (int, int)
+}
+
+// pagerPrefetchScreens は先読みを始める残り行数（画面の高さの倍数）
+const pagerPrefetchScreens = 2
+
+type pagerKey int
+
+const (
+	keyNone pagerKey = iota
+	keyUp
+	keyDown
+	keyPageUp
+	keyPageDown
+	keyHome
+	keyEnd
+	keyEnter
+	keyQuit
+	keyRune
+)
+
+// parsePagerKey は端末から読んだバイト列をキーに変換する
+func parsePagerKey(b []byte) (pagerKey, rune) {
+	if len(b) == 0 {
+		return keyNone, 0
+	}
+	if b[0] == 0x1b {
+		if len(b) == 1 {
+			return keyQuit, 0
+		}
+		switch string(b[1:]) {
+		case "[A", "OA":
+			return keyUp, 0
+		case "[B", "OB":
+			return keyDown, 0
+		case "[5~":
+			return keyPageUp, 0
+		case "[6~":
+			return keyPageDown, 0
+		case "[H", "OH", "[1~":
+			return keyHome, 0
+		case "[F", "OF", "[4~":
+			return keyEnd, 0
+		}
+		return keyNone, 0
+	}
+	switch b[0] {
+	case 3, 'q':
+		return keyQuit, 0
+	case '\r', '\n':
+		return keyEnter, 0
+	case 'k':
+		return keyUp, 0
+	case 'j':
+		return keyDown, 0
+	case 'b', 2:
+		return keyPageUp, 0
+	case ' ', 6:
+		return keyPageDown, 0
+	case 'g':
+		return keyHome, 0
+	case 'G':
+		return keyEnd, 0
+	}
+	return keyRune, rune(b[0])
+}
+
+// Run はページャーを表示し、操作キーが押されたら操作のキーと行番号を返す。
+// q / Esc / Ctrl+C で終了した場合は 0 を返す。
+// 読み込み済みの行とカーソル位置は保持されるため、操作の後に Run を呼ぶと続きから再開できる
+func (p *ListPager) Run() (rune, int, error) {
+	in, out := p.in, p.out
+	if in == nil {
+		in = os.Stdin
+	}
+	if out == nil {
+		out = os.Stdout
+	}
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return 0, 0, err
+		}
+		defer func() { _ = term.Restore(int(f.Fd()), state) }()
+	}
+	// 代替スクリーンに切り替え、カーソルを隠す
+	_, _ = fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer func() { _, _ = fmt.Fprint(out, "\x1b[?25h\x1b[?1049l") }()
+
+	if len(p.rows) == 0 && !p.done {
+		p.render(out, true)
+		p.startFetch()
+		p.waitFetch()
+	}
+
+	buf := make([]byte, 16)
+	for {
+		p.collectFetch()
+		p.prefetch()
+		p.render(out, false)
+
+		n, err := in.Read(buf)
+		if err != nil {
+			if err == io.EOF {
+				return 0, 0, nil
+			}
+			return 0, 0, err
+		}
+		key, r := parsePagerKey(buf[:n])
+		switch key {
+		case keyQuit:
+			return 0, 0, nil
+		case keyUp:
+			p.moveTo(p.cursor - 1)
+		case keyDown:
+			p.moveTo(p.cursor + 1)
+		case keyPageUp:
+			p.moveTo(p.cursor - p.pageSize())
+		case keyPageDown:
+			p.moveTo(p.cursor + p.pageSize())
+		case keyHome:
+			p.moveTo(0)
+		case keyEnd:
+			// 読み込み済みの末尾にいる場合は次のページを読み込む
+			if p.cursor == len(p.rows)-1 {
+				p.moveTo(len(p.rows))
+			} else {
+				p.moveTo(len(p.rows) - 1)
+			}
+		case keyEnter:
+			if len(p.Actions) > 0 && len(p.rows) > 0 {
+				return p.Actions[0].Key, p.cursor, nil
+			}
+		case keyRune:
+			for _, a := range p.Actions {
+				if a.Key == r && len(p.rows) > 0 {
+					return a.Key, p.cursor, nil
+				}
+			}
+		}
+	}
+}
+
+// moveTo はカーソルを移動する。未読み込みの行へ進む場合は読み込みを待つ
+func (p *ListPager) moveTo(index int) {
+	if index >= len(p.rows) && !p.done {
+		if p.pending == nil {
+			p.startFetch()
+		}
+		p.render(p.writer(), true)
+		p.waitFetch()
+	}
+	p.cursor = max(0, min(index, len(p.rows)-1))
+	height := p.pageSize()
+	if p.cursor < p.top {
+		p.top = p.cursor
+	}
+	if p.cursor >= p.top+height {
+		p.top = p.cursor - height + 1
+	}
+}
+
+func (p *ListPager) writer() io.Writer {
+	if p.out == nil {
+		return os.Stdout
+	}
+	return p.out
+}
+
+// prefetch はカーソルが読み込み済みの末尾に近づいたら次のページを裏で読み込む
+func (p *ListPager) prefetch() {
+	if p.done || p.pending != nil {
+		return
+	}
+	if len(p.rows)-p.cursor <= p.pageSize()*pagerPrefetchScreens {
+		p.startFetch()
+	}
+}
+
+func (p *ListPager) startFetch() {
+	ch := make(chan pagerResult, 1)
+	p.pending = ch
+	offset := len(p.rows)
+	go func() {
+		page, err := p.Fetch(offset)
+		ch <- pagerResult{page: page, err: err}
+	}()
+}
+
+// collectFetch は完了済みの読み込み結果を反映する（待たない）
+func (p *ListPager) collectFetch() {
+	if p.pending == nil {
+		return
+	}
+	select {
+	case res := <-p.pending:
+		p.apply(res)
+	default:
+	}
+}
+
+// waitFetch は読み込み中のページを待って反映する
+func (p *ListPager) waitFetch() {
+	if p.pending == nil {
+		return
+	}
+	p.apply(<-p.pending)
+}
+
+func (p *ListPager) apply(res pagerResult) {
+	p.pending = nil
+	if res.err != nil {
+		p.err = res.err
+		p.done = true
+		return
+	}
+	p.rows = append(p.rows, res.page.Rows...)
+	if res.page.Done || len(res.page.Rows) == 0 {
+		p.done = true
+	}
+}
+
+func (p *ListPager) termSize() (int, int) {
+	if p.size != nil {
+		return p.size()
+	}
+	width, height, err := term.GetSize(stdoutFd())
+	if err != nil {
+		return 80, 24
+	}
+	return width, height
+}
+
+// pageSize は 1 画面に表示できる行数（タイトル・ヘッダー・ステータス行を除く）
+func (p *ListPager) pageSize() int {
+	_, height := p.termSize()
+	return max(1, height-3)
+}
+
+func (p *ListPager) render(w io.Writer, loading bool) {
+	width, _ := p.termSize()
+	height := p.pageSize()
+
+	widths := make([]int, len(p.Headers))
+	for i, h := range p.Headers {
+		widths[i] = displayWidth(h)
+	}
+	for _, row := range p.rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], displayWidth(cell))
+			}
+		}
+	}
+	// 選択マーカー分を除いた幅に収める
+	mins := make([]int, len(p.Headers))
+	for i, h := range p.Headers {
+		mins[i] = max(displayWidth(h), minColumnWidth)
+	}
+	widths = fitColumnWidths(widths, mins, width-2)
+
+	var b strings.Builder
+	b.WriteString("\x1b[H\x1b[2J")
+	b.WriteString(Bold(truncateDisplay(p.Title, width)))
+	b.WriteString("\r\n")
+	b.WriteString("  " + Bold(formatPagerRow(p.Headers, widths)))
+	b.WriteString("\r\n")
+	for i := p.top; i < len(p.rows) && i < p.top+height; i++ {
+		line := formatPagerRow(p.rows[i], widths)
+		if i == p.cursor {
+			// 選択行は反転表示（セル内の色指定で反転が解除されないよう色を外す）
+			plain := ansiEscapeRegex.ReplaceAllString(osc8Regex.ReplaceAllString(line, ""), "")
+			line = "> \x1b[7m" + plain + "\x1b[0m"
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line)
+		b.WriteString("\r\n")
+	}
+	for i := len(p.rows) - p.top; i < height; i++ {
+		b.WriteString("\r\n")
+	}
+	b.WriteString(truncateDisplay(p.statusLine(loading), width))
+	_, _ = io.WriteString(w, b.String())
+}
+
+func formatPagerRow(cells []string, widths []int) string {
+	parts := make([]string, len(widths))
+	for i := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		cell = truncateDisplay(cell, widths[i])
+		parts[i] = padRight(cell, widths[i], displayWidth(cell))
+	}
+	return strings.TrimRight(strings.Join(parts, strings.Repeat(" ", columnGap)), " ")
+}
+
+func (p *ListPager) statusLine(loading bool) string {
+	var pos string
+	switch {
+	case len(p.rows) == 0 && loading:
+		pos = "Loading..."
+	case len(p.rows) == 0:
+		pos = "No rows"
+	default:
+		pos = fmt.Sprintf("%d/%d", p.cursor+1, len(p.rows))
+		if loading {
+			pos += " Loading..."
+		} else if !p.done {
+			pos += "+"
+		}
+	}
+	if p.err != nil {
+		pos += " " + Red(fmt.Sprintf("error: %v", p.err))
+	}
+
+	help := []string{"↑↓ move"}
+	for i, a := range p.Actions {
+		label := string(a.Key) + " " + a.Label
+		if i == 0 {
+			label = "Enter/" + label
+		}
+		help = append(help, label)
+	}
+	help = append(help, "q quit")
+	return pos + "  " + Gray(strings.Join(help, "  "))
+}
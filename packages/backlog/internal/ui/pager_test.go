@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// keyReader は Read 1 回につき 1 キー分のバイト列を返す
+type keyReader struct {
+	keys []string
+}
+
+func (r *keyReader) Read(b []byte) (int, error) {
+	if len(r.keys) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(b, r.keys[0])
+	r.keys = r.keys[1:]
+	return n, nil
+}
+
+func newTestPager(total, pageSize int, keys ...string) (*ListPager, *[]int) {
+	var offsets []int
+	p := &ListPager{
+		Title:   "Issues",
+		Headers: []string{"KEY", "SUMMARY"},
+		Actions: []PagerAction{{Key: 'v', Label: "view"}, {Key: 'c', Label: "comment"}},
+		Fetch: func(offset int) (PagerPage, error) {
+			offsets = append(offsets, offset)
+			var page PagerPage
+			for i := offset; i < total && i < offset+pageSize; i++ {
+				page.Rows = append(page.Rows, []string{fmt.Sprintf("PROJ-%d", i+1), "summary"})
+			}
+			page.Done = offset+pageSize >= total
+			return page, nil
+		},
+		in:   &keyReader{keys: keys},
+		out:  &bytes.Buffer{},
+		size: func() (int, int) { return 80, 6 },
+	}
+	return p, &offsets
+}
+
+func TestParsePagerKey(t *testing.T) {
+	tests := []struct {
+		in   string
+		key  pagerKey
+		char rune
+	}{
+		{"\x1b[A", keyUp, 0},
+		{"j", keyDown, 0},
+		{"\x1b[6~", keyPageDown, 0},
+		{"G", keyEnd, 0},
+		{"\r", keyEnter, 0},
+		{"\x1b", keyQuit, 0},
+		{"\x03", keyQuit, 0},
+		{"e", keyRune, 'e'},
+	}
+	for _, tt := range tests {
+		key, char := parsePagerKey([]byte(tt.in))
+		if key != tt.key || char != tt.char {
+			t.Errorf("parsePagerKey(%q) = %v, %q; want %v, %q", tt.in, key, char, tt.key, tt.char)
+		}
+	}
+}
+
+func TestListPagerRun(t *testing.T) {
+	p, offsets := newTestPager(7, 3, "j", "j", "j", "j", "c")
+	action, index, err := p.Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if action != 'c' || index != 4 {
+		t.Errorf("Run() = %q, %d; want 'c', 4", action, index)
+	}
+	if len(p.rows) < 5 {
+		t.Errorf("loaded %d rows, want at least 5", len(p.rows))
+	}
+	if (*offsets)[0] != 0 || (*offsets)[1] != 3 {
+		t.Errorf("fetch offsets = %v, want pages to be fetched in order", *offsets)
+	}
+
+	// 再開するとカーソル位置を保ったまま続きから操作できる
+	p.in = &keyReader{keys: []string{"G", "G", "G", "G", "\r"}}
+	action, index, err = p.Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if action != 'v' || index != 6 {
+		t.Errorf("Run() = %q, %d; want 'v' (Enter), 6", action, index)
+	}
+}
+
+func TestListPagerQuit(t *testing.T) {
+	p, _ := newTestPager(2, 10, "x", "q")
+	action, _, err := p.Run()
+	if err != nil || action != 0 {
+		t.Errorf("Run() = %q, %v; want quit", action, err)
+	}
+	out := p.out.(*bytes.Buffer).String()
+	if !strings.Contains(out, "PROJ-2") || !strings.Contains(out, "2/2") && !strings.Contains(out, "1/2") {
+		t.Errorf("output does not contain the rows:\n%q", out)
+	}
+	if !strings.HasSuffix(out, "\x1b[?25h\x1b[?1049l") {
+		t.Error("terminal state is not restored")
+	}
+}
+
+func TestListPagerFetchError(t *testing.T) {
+	p, _ := newTestPager(0, 3, "q")
+	p.Fetch = func(int) (PagerPage, error) { return PagerPage{}, fmt.Errorf("boom") }
+	if _, _, err := p.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(p.out.(*bytes.Buffer).String(), "boom") {
+		t.Error("fetch error is not shown")
+	}
+}
@@ -8,7 +8,7 @@ import (
 )
 
 // ProgressReader は io.Reader をラップし、stderr に転送量を表示する
-// TTY でない場合は表示をスキップする
+// TTY でない場合や --quiet の場合は表示をスキップする
 type ProgressReader struct {
 	r       io.Reader
 	label   string
@@ -68,7 +68,7 @@ func (p *ProgressWriter) Finish() {
 }
 
 func (p *ProgressReader) printProgress() {
-	if !IsStderrTTY() {
+	if !showProgress() {
 		return
 	}
 	if p.total > 0 {
@@ -84,7 +84,7 @@ func (p *ProgressReader) clearLine() {
 }
 
 func (p *ProgressWriter) printProgress() {
-	if !IsStderrTTY() {
+	if !showProgress() {
 		return
 	}
 	if p.total > 0 {
@@ -96,7 +96,7 @@ func (p *ProgressWriter) printProgress() {
 }
 
 func clearLine() {
-	if !IsStderrTTY() {
+	if !showProgress() {
 		return
 	}
 	fmt.Fprint(os.Stderr, "\r\033[K")
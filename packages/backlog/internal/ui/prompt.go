@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
 	"golang.org/x/term"
 )
 
@@ -85,6 +86,26 @@ func Confirm(message string, defaultValue bool) (bool, error) {
 	return result, nil
 }
 
+// ReadKey は1文字のキー入力を Enter なしで受け付ける
+// Ctrl+C が押された場合は terminal.InterruptErr を返す
+func ReadKey() (rune, error) {
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = term.Restore(fd, state) }()
+
+	buf := make([]byte, 1)
+	if _, err := os.Stdin.Read(buf); err != nil {
+		return 0, err
+	}
+	if buf[0] == 3 {
+		return 0, terminal.InterruptErr
+	}
+	return rune(buf[0]), nil
+}
+
 // Password はパスワード入力を受け付ける（入力は非表示）
 func Password(message string) (string, error) {
 	var result string
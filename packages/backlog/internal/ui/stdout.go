@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// stdoutTerminal は端末判定と端末サイズの取得に使う標準出力（nil なら os.Stdout）
+// 出力の自動保存で os.Stdout をパイプに差し替えている間も、元の端末を基準に色や幅を決めるために使う
+var stdoutTerminal *os.File
+
+// SetStdoutTerminal は端末判定に使う標準出力を設定する。nil を渡すと os.Stdout に戻す
+func SetStdoutTerminal(f *os.File) {
+	stdoutTerminal = f
+}
+
+// stdoutFd は端末判定に使う標準出力のファイルディスクリプタを返す
+func stdoutFd() int {
+	if stdoutTerminal != nil {
+		return int(stdoutTerminal.Fd())
+	}
+	return int(os.Stdout.Fd())
+}
+
+// IsStdoutTerminal は標準出力が端末かどうかを返す
+func IsStdoutTerminal() bool {
+	return term.IsTerminal(stdoutFd())
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSetStdoutTerminal(t *testing.T) {
+	t.Cleanup(func() { SetStdoutTerminal(nil) })
+
+	f, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	SetStdoutTerminal(f)
+	if got := stdoutFd(); got != int(f.Fd()) {
+		t.Errorf("stdoutFd() = %d, want %d", got, f.Fd())
+	}
+	if IsStdoutTerminal() {
+		t.Error("IsStdoutTerminal() = true for a regular file")
+	}
+
+	SetStdoutTerminal(nil)
+	if got := stdoutFd(); got != int(os.Stdout.Fd()) {
+		t.Errorf("stdoutFd() after reset = %d, want os.Stdout", got)
+	}
+}
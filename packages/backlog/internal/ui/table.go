@@ -14,6 +14,10 @@ import (
 type Table struct {
 	headers []string
 	rows    [][]string
+	// maxWidth は出力全体の最大表示幅（0 = 制限なし）
+	maxWidth int
+	// wrap は折り返して表示するカラムと最大行数（0 = 行数無制限）
+	wrap map[int]int
 }
 
 // NewTable は新しいテーブルを作成する
@@ -29,11 +33,33 @@ func (t *Table) AddRow(values ...string) {
 	t.rows = append(t.rows, values)
 }
 
+// SetMaxWidth は出力全体の最大表示幅を設定する（0 = 制限なし）
+// 収まらない場合は幅の広いカラムから縮め、はみ出したセルは切り詰めるか折り返す
+func (t *Table) SetMaxWidth(width int) {
+	t.maxWidth = width
+}
+
+// SetWrap は指定カラムを切り詰めずに折り返し、最大 lines 行で表示する（0 = 行数無制限）
+func (t *Table) SetWrap(col, lines int) {
+	if t.wrap == nil {
+		t.wrap = make(map[int]int)
+	}
+	t.wrap[col] = lines
+}
+
 // Render はテーブルを出力する
 func (t *Table) Render(w io.Writer) {
 	if w == nil {
 		w = os.Stdout
 	}
+	if accessible {
+		t.renderRecords(w)
+		return
+	}
+	if t.hasLayout() {
+		t.renderLayout(w, false)
+		return
+	}
 
 	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
 
@@ -51,7 +77,7 @@ func (t *Table) Render(w io.Writer) {
 // RenderWithColor は色付きでテーブルを出力する
 // ANSIエスケープシーケンスを考慮してカラム幅を揃える
 func (t *Table) RenderWithColor(w io.Writer, colorEnabled bool) {
-	if !colorEnabled {
+	if !colorEnabled || accessible {
 		t.Render(w)
 		return
 	}
@@ -59,6 +85,10 @@ func (t *Table) RenderWithColor(w io.Writer, colorEnabled bool) {
 	if w == nil {
 		w = os.Stdout
 	}
+	if t.hasLayout() {
+		t.renderLayout(w, true)
+		return
+	}
 
 	// 各カラムの最大表示幅を計算
 	colWidths := t.calculateColumnWidths()
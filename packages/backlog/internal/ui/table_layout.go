@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// columnGap はカラム間の空白幅
+const columnGap = 2
+
+// minColumnWidth は幅を縮めるときのカラムの最小表示幅（ヘッダーの方が広ければヘッダー幅）
+const minColumnWidth = 6
+
+// TerminalWidth は標準出力の端末幅を返す（端末でない場合は 0）
+func TerminalWidth() int {
+	fd := stdoutFd()
+	if !term.IsTerminal(fd) {
+		return 0
+	}
+	width, _, err := term.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+func (t *Table) hasLayout() bool {
+	return t.maxWidth > 0 || len(t.wrap) > 0
+}
+
+// renderLayout は最大幅と折り返し設定に従ってテーブルを出力する
+func (t *Table) renderLayout(w io.Writer, colorEnabled bool) {
+	widths := t.calculateColumnWidths()
+	if t.maxWidth > 0 {
+		mins := make([]int, len(t.headers))
+		for i, h := range t.headers {
+			mins[i] = max(displayWidth(h), minColumnWidth)
+		}
+		widths = fitColumnWidths(widths, mins, t.maxWidth)
+	}
+
+	header := make([][]string, len(t.headers))
+	for i, h := range t.headers {
+		header[i] = []string{h}
+		if colorEnabled {
+			header[i] = []string{Bold(h)}
+		}
+	}
+	t.writeLayoutRow(w, header, widths)
+
+	for _, row := range t.rows {
+		cells := make([][]string, len(row))
+		for i, cell := range row {
+			if i >= len(widths) || displayWidth(cell) <= widths[i] {
+				cells[i] = []string{cell}
+				continue
+			}
+			if lines, ok := t.wrap[i]; ok {
+				cells[i] = wrapDisplay(cell, widths[i], lines)
+				continue
+			}
+			cells[i] = []string{truncateDisplay(cell, widths[i])}
+		}
+		t.writeLayoutRow(w, cells, widths)
+	}
+}
+
+// writeLayoutRow は複数行に折り返したセルを 1 行分として出力する
+func (t *Table) writeLayoutRow(w io.Writer, cells [][]string, widths []int) {
+	height := 1
+	for _, lines := range cells {
+		height = max(height, len(lines))
+	}
+	for line := 0; line < height; line++ {
+		var b strings.Builder
+		for i, lines := range cells {
+			if i > 0 {
+				b.WriteString(strings.Repeat(" ", columnGap))
+			}
+			text := ""
+			if line < len(lines) {
+				text = lines[line]
+			}
+			if i < len(cells)-1 && i < len(widths) {
+				text = padRight(text, widths[i], displayWidth(text))
+			}
+			b.WriteString(text)
+		}
+		_, _ = fmt.Fprintln(w, strings.TrimRight(b.String(), " "))
+	}
+}
+
+// fitColumnWidths は合計幅が maxWidth に収まるよう、最も広いカラムから 1 ずつ縮める
+// すべてのカラムが最小幅に達した場合はそれ以上縮めない
+func fitColumnWidths(widths, mins []int, maxWidth int) []int {
+	fitted := make([]int, len(widths))
+	copy(fitted, widths)
+	total := columnGap * max(len(fitted)-1, 0)
+	for _, w := range fitted {
+		total += w
+	}
+	for total > maxWidth {
+		widest := -1
+		for i, w := range fitted {
+			if w > mins[i] && (widest < 0 || w > fitted[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		fitted[widest]--
+		total--
+	}
+	return fitted
+}
+
+// escapeAt は s[i:] の先頭にあるエスケープシーケンスの長さを返す（無ければ 0）
+func escapeAt(s string, i int) int {
+	if s[i] != '\x1b' {
+		return 0
+	}
+	if loc := osc8Regex.FindStringIndex(s[i:]); loc != nil && loc[0] == 0 {
+		return loc[1]
+	}
+	if loc := ansiEscapeRegex.FindStringIndex(s[i:]); loc != nil && loc[0] == 0 {
+		return loc[1]
+	}
+	return 0
+}
+
+func runeWidth(r rune) int {
+	if isWideRune(r) {
+		return 2
+	}
+	return 1
+}
+
+// truncateDisplay は表示幅 width に収まるよう末尾を "..." に置き換える
+// 色やハイパーリンクが閉じられるよう、切り捨てた部分のエスケープシーケンスは残す
+func truncateDisplay(s string, width int) string {
+	if displayWidth(s) <= width {
+		return s
+	}
+	ellipsis := "..."
+	budget := width - len(ellipsis)
+	if budget < 0 {
+		budget, ellipsis = width, ""
+	}
+
+	var b strings.Builder
+	used := 0
+	cut := false
+	for i := 0; i < len(s); {
+		if n := escapeAt(s, i); n > 0 {
+			b.WriteString(s[i : i+n])
+			i += n
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		if cut {
+			continue
+		}
+		if used+runeWidth(r) > budget {
+			b.WriteString(ellipsis)
+			cut = true
+			continue
+		}
+		b.WriteRune(r)
+		used += runeWidth(r)
+	}
+	return b.String()
+}
+
+// wrapDisplay はエスケープシーケンスを除いたテキストを表示幅 width ごとに折り返す
+// 可能なら空白で区切り、maxLines を超える分は最終行を "..." で切り詰める（0 = 行数無制限）
+func wrapDisplay(s string, width, maxLines int) []string {
+	text := ansiEscapeRegex.ReplaceAllString(osc8Regex.ReplaceAllString(s, ""), "")
+	if width <= 0 {
+		return []string{text}
+	}
+
+	lines := make([]string, 0)
+	runes := []rune(text)
+	for len(runes) > 0 {
+		if maxLines > 0 && len(lines) == maxLines-1 {
+			lines = append(lines, truncateDisplay(string(runes), width))
+			break
+		}
+		end, used, lastSpace := 0, 0, -1
+		for end < len(runes) && used+runeWidth(runes[end]) <= width {
+			if runes[end] == ' ' {
+				lastSpace = end
+			}
+			used += runeWidth(runes[end])
+			end++
+		}
+		if end == 0 {
+			end = 1 // width より広い 1 文字は単独で置く
+		}
+		next := end
+		if end < len(runes) && lastSpace > 0 && runes[end] != ' ' {
+			end, next = lastSpace, lastSpace+1
+		}
+		lines = append(lines, strings.TrimRight(string(runes[:end]), " "))
+		runes = []rune(strings.TrimLeft(string(runes[next:]), " "))
+	}
+	return lines
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFitColumnWidths(t *testing.T) {
+	tests := []struct {
+		name     string
+		widths   []int
+		mins     []int
+		maxWidth int
+		want     []int
+	}{
+		{name: "fits", widths: []int{5, 10}, mins: []int{6, 6}, maxWidth: 80, want: []int{5, 10}},
+		{name: "shrink widest", widths: []int{8, 50, 10}, mins: []int{6, 7, 6}, maxWidth: 40, want: []int{8, 18, 10}},
+		{name: "shrink evenly", widths: []int{30, 30}, mins: []int{6, 6}, maxWidth: 42, want: []int{20, 20}},
+		{name: "stop at minimum", widths: []int{10, 30}, mins: []int{8, 8}, maxWidth: 10, want: []int{8, 8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fitColumnWidths(tt.widths, tt.mins, tt.maxWidth); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fitColumnWidths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateDisplay(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{name: "fits", s: "hello", width: 5, want: "hello"},
+		{name: "ascii", s: "hello world", width: 8, want: "hello..."},
+		{name: "wide", s: "日本語のタイトル", width: 9, want: "日本語..."},
+		{name: "keeps escapes", s: "\x1b[31mhello world\x1b[0m", width: 8, want: "\x1b[31mhello...\x1b[0m"},
+		{name: "narrow", s: "hello", width: 2, want: "he"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateDisplay(tt.s, tt.width); got != tt.want {
+				t.Errorf("truncateDisplay() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapDisplay(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		width    int
+		maxLines int
+		want     []string
+	}{
+		{name: "word wrap", s: "fix login error on safari", width: 10, want: []string{"fix login", "error on", "safari"}},
+		{name: "wide runes", s: "ログイン画面でエラー", width: 8, want: []string{"ログイン", "画面でエ", "ラー"}},
+		{name: "line limit", s: "fix login error on safari", width: 10, maxLines: 2, want: []string{"fix login", "error o..."}},
+		{name: "long word", s: "abcdefghij", width: 4, want: []string{"abcd", "efgh", "ij"}},
+		{name: "strips escapes", s: "\x1b[31mred text\x1b[0m", width: 4, want: []string{"red", "text"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wrapDisplay(tt.s, tt.width, tt.maxLines); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("wrapDisplay() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableRenderLayout(t *testing.T) {
+	table := NewTable("KEY", "SUMMARY", "STATUS")
+	table.AddRow("PROJ-1", "a long summary that does not fit", "Open")
+	table.SetMaxWidth(32)
+	table.SetWrap(1, 2)
+
+	var buf bytes.Buffer
+	table.Render(&buf)
+	want := strings.Join([]string{
+		"KEY     SUMMARY           STATUS",
+		"PROJ-1  a long summary    Open",
+		"        that does not...",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,454 @@
+// Package testutil は backlog-cli の認証フローを使うコードをテストするためのヘルパーを提供する。
+//
+// FakeRelay は OAuth 中継サーバーを模擬し、Backlog にアクセスせずに
+// `backlog auth login --relay-server <FakeRelay.URL>` やコールバックサーバーとの
+// やり取りを自動テストできるようにする。
+package testutil
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/relaysig"
+)
+
+// FakeRelayKeyID はフェイク中継サーバーの署名鍵の kid
+const FakeRelayKeyID = "fake-relay-key"
+
+// AuthStart は /auth/start への要求
+type AuthStart struct {
+	Port    int
+	State   string
+	Space   string
+	Host    string
+	Project string
+}
+
+// TokenRequest は /auth/token への要求
+type TokenRequest struct {
+	GrantType    string
+	Code         string
+	RefreshToken string
+	Space        string
+	State        string
+	// SignatureKeyID は署名付きリクエストの kid（署名なしの場合は空）
+	SignatureKeyID string
+}
+
+// Token はフェイク中継サーバーが発行したトークン
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	Space        string
+}
+
+// FakeRelay は OAuth 中継サーバーのフェイク
+//
+// 次のエンドポイントを提供する:
+//
+//	GET  /.well-known/backlog-oauth-relay
+//	GET  /auth/start                          Backlog の認可画面を経由せず、認可コードを付けてコールバックへリダイレクト
+//	POST /auth/token                          authorization_code / refresh_token
+//	GET  /v1/relay/tenants/{name}/certs
+//	GET  /v1/relay/tenants/{name}/info        署名付きのリレー情報（要 bundle_token）
+//	GET  /v1/relay/tenants/{name}/bundle      信頼済みバンドル（要 bundle_token）
+type FakeRelay struct {
+	// URL はフェイク中継サーバーのベース URL
+	URL string
+	// Name はテナント名（バンドル名）
+	Name string
+
+	server    *httptest.Server
+	tenant    *config.ResolvedTenant
+	domains   []string
+	authError string
+	expiresIn int
+
+	mu            sync.Mutex
+	seq           int
+	codes         map[string]AuthStart
+	refreshTokens map[string]string
+	authStarts    []AuthStart
+	tokenRequests []TokenRequest
+	tokens        []Token
+}
+
+// FakeRelayOption は FakeRelay の設定
+type FakeRelayOption func(*FakeRelay)
+
+// WithTenantName はテナント名を設定する（既定: fake.backlog.jp）
+func WithTenantName(name string) FakeRelayOption {
+	return func(r *FakeRelay) {
+		r.Name = name
+	}
+}
+
+// WithSupportedDomains は対応する Backlog ドメインを設定する（既定: backlog.jp, backlog.com）
+func WithSupportedDomains(domains ...string) FakeRelayOption {
+	return func(r *FakeRelay) {
+		r.domains = domains
+	}
+}
+
+// WithAuthError は /auth/start で認可を拒否し、error パラメータ付きでコールバックへ戻す
+func WithAuthError(code string) FakeRelayOption {
+	return func(r *FakeRelay) {
+		r.authError = code
+	}
+}
+
+// WithTokenExpiresIn はアクセストークンの有効期間（秒）を設定する（既定: 3600）
+func WithTokenExpiresIn(seconds int) FakeRelayOption {
+	return func(r *FakeRelay) {
+		r.expiresIn = seconds
+	}
+}
+
+// NewFakeRelay はフェイク中継サーバーを起動する。テスト終了時に停止する
+func NewFakeRelay(t testing.TB, opts ...FakeRelayOption) *FakeRelay {
+	t.Helper()
+
+	r := &FakeRelay{
+		Name:          "fake.backlog.jp",
+		domains:       []string{"backlog.jp", "backlog.com"},
+		expiresIn:     3600,
+		codes:         make(map[string]AuthStart),
+		refreshTokens: make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	tenant, err := newFakeTenant()
+	if err != nil {
+		t.Fatalf("testutil: create fake relay key: %v", err)
+	}
+	r.tenant = tenant
+
+	r.server = httptest.NewServer(r.routes())
+	r.URL = r.server.URL
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+// Close はフェイク中継サーバーを停止する
+func (r *FakeRelay) Close() {
+	r.server.Close()
+}
+
+// AuthStarts はこれまでの /auth/start への要求を返す
+func (r *FakeRelay) AuthStarts() []AuthStart {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]AuthStart(nil), r.authStarts...)
+}
+
+// TokenRequests はこれまでの /auth/token への要求を返す
+func (r *FakeRelay) TokenRequests() []TokenRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]TokenRequest(nil), r.tokenRequests...)
+}
+
+// Tokens はこれまでに発行したトークンを返す
+func (r *FakeRelay) Tokens() []Token {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Token(nil), r.tokens...)
+}
+
+// Bundle は /v1/relay/tenants/{name}/bundle と同じ信頼済みバンドル（zip）を返す
+// `backlog config import` に渡すとフェイク中継サーバーを信頼済みとして登録できる
+func (r *FakeRelay) Bundle() ([]byte, error) {
+	return config.CreatePortalBundle(r.tenant, r.Name, r.URL)
+}
+
+func (r *FakeRelay) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/backlog-oauth-relay", r.handleWellKnown)
+	mux.HandleFunc("GET /auth/start", r.handleAuthStart)
+	mux.HandleFunc("POST /auth/token", r.handleToken)
+	mux.HandleFunc("GET /v1/relay/tenants/{name}/certs", r.handleCerts)
+	mux.HandleFunc("GET /v1/relay/tenants/{name}/info", r.handleInfo)
+	mux.HandleFunc("GET /v1/relay/tenants/{name}/bundle", r.handleBundle)
+	return mux
+}
+
+func (r *FakeRelay) handleWellKnown(w http.ResponseWriter, req *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"version":           "1.0",
+		"capabilities":      []string{"oauth2", "token-exchange", "token-refresh"},
+		"supported_domains": r.domains,
+	})
+}
+
+func (r *FakeRelay) handleAuthStart(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	port, err := strconv.Atoi(q.Get("port"))
+	if err != nil || port <= 0 || port > 65535 {
+		http.Error(w, "invalid port", http.StatusBadRequest)
+		return
+	}
+	start := AuthStart{
+		Port:    port,
+		State:   q.Get("state"),
+		Space:   q.Get("space"),
+		Host:    q.Get("host"),
+		Project: q.Get("project"),
+	}
+	if start.State == "" || start.Space == "" {
+		http.Error(w, "state and space are required", http.StatusBadRequest)
+		return
+	}
+	if !r.supportsSpace(start.Space) {
+		http.Error(w, "unsupported domain", http.StatusBadRequest)
+		return
+	}
+
+	host := start.Host
+	if host == "" {
+		host = "localhost"
+	}
+	callback := url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:   "/callback",
+	}
+	params := url.Values{"state": {start.State}}
+
+	r.mu.Lock()
+	r.authStarts = append(r.authStarts, start)
+	if r.authError != "" {
+		params.Set("error", r.authError)
+		params.Set("error_description", "rejected by fake relay")
+	} else {
+		code := r.nextLocked("code")
+		r.codes[code] = start
+		params.Set("code", code)
+	}
+	r.mu.Unlock()
+
+	callback.RawQuery = params.Encode()
+	http.Redirect(w, req, callback.String(), http.StatusFound)
+}
+
+func (r *FakeRelay) handleToken(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		GrantType    string `json:"grant_type"`
+		Code         string `json:"code"`
+		RefreshToken string `json:"refresh_token"`
+		Space        string `json:"space"`
+		State        string `json:"state"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeTokenError(w, "invalid_request", "invalid JSON body")
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokenRequests = append(r.tokenRequests, TokenRequest{
+		GrantType:      body.GrantType,
+		Code:           body.Code,
+		RefreshToken:   body.RefreshToken,
+		Space:          body.Space,
+		State:          body.State,
+		SignatureKeyID: req.Header.Get(relaysig.HeaderKeyID),
+	})
+
+	switch body.GrantType {
+	case "authorization_code":
+		start, ok := r.codes[body.Code]
+		if !ok {
+			writeTokenError(w, "invalid_grant", "unknown or already used authorization code")
+			return
+		}
+		// 認可コードは1回限り
+		delete(r.codes, body.Code)
+		if body.Space != start.Space {
+			writeTokenError(w, "invalid_grant", "space does not match the authorization request")
+			return
+		}
+		if body.State != "" && body.State != start.State {
+			writeTokenError(w, "invalid_grant", "state does not match the authorization request")
+			return
+		}
+	case "refresh_token":
+		space, ok := r.refreshTokens[body.RefreshToken]
+		if !ok || space != body.Space {
+			writeTokenError(w, "invalid_grant", "unknown refresh token")
+			return
+		}
+		// リフレッシュトークンはローテーションする
+		delete(r.refreshTokens, body.RefreshToken)
+	default:
+		writeTokenError(w, "unsupported_grant_type", body.GrantType)
+		return
+	}
+
+	token := Token{
+		AccessToken:  r.nextLocked("access"),
+		RefreshToken: r.nextLocked("refresh"),
+		Space:        body.Space,
+	}
+	r.refreshTokens[token.RefreshToken] = token.Space
+	r.tokens = append(r.tokens, token)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token":  token.AccessToken,
+		"token_type":    "Bearer",
+		"expires_in":    r.expiresIn,
+		"refresh_token": token.RefreshToken,
+	})
+}
+
+func (r *FakeRelay) handleCerts(w http.ResponseWriter, req *http.Request) {
+	if req.PathValue("name") != r.Name {
+		http.Error(w, "tenant not found", http.StatusNotFound)
+		return
+	}
+	var jwks struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(r.tenant.JWKS), &jwks); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, key := range jwks.Keys {
+		delete(key, "d")
+	}
+	writeJSON(w, http.StatusOK, jwks)
+}
+
+func (r *FakeRelay) handleInfo(w http.ResponseWriter, req *http.Request) {
+	if req.PathValue("name") != r.Name {
+		http.Error(w, "tenant not found", http.StatusNotFound)
+		return
+	}
+	if !r.authorizedBundle(req) {
+		http.Error(w, "invalid bundle token", http.StatusUnauthorized)
+		return
+	}
+	now := time.Now().UTC()
+	payload, err := json.Marshal(config.RelayInfoPayload{
+		Version:   2,
+		Name:      r.Name,
+		RelayURL:  r.URL,
+		IssuedAt:  now.Format(time.RFC3339),
+		ExpiresAt: now.Add(time.Hour).Format(time.RFC3339),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	payloadB64 := base64.RawURLEncoding.EncodeToString(payload)
+	protected, _ := json.Marshal(map[string]string{"alg": "EdDSA", "kid": FakeRelayKeyID})
+	protectedB64 := base64.RawURLEncoding.EncodeToString(protected)
+	signature := ed25519.Sign(fakeRelayPrivateKey(r.tenant), []byte(protectedB64+"."+payloadB64))
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"payload": payloadB64,
+		"signatures": []map[string]string{{
+			"protected": protectedB64,
+			"signature": base64.RawURLEncoding.EncodeToString(signature),
+		}},
+	})
+}
+
+func (r *FakeRelay) handleBundle(w http.ResponseWriter, req *http.Request) {
+	if req.PathValue("name") != r.Name {
+		http.Error(w, "tenant not found", http.StatusNotFound)
+		return
+	}
+	if !r.authorizedBundle(req) {
+		http.Error(w, "invalid bundle token", http.StatusUnauthorized)
+		return
+	}
+	bundle, err := r.Bundle()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	_, _ = w.Write(bundle)
+}
+
+// authorizedBundle は Authorization ヘッダーの bundle_token を検証する
+func (r *FakeRelay) authorizedBundle(req *http.Request) bool {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	return ok && config.VerifyBundleTokenWithTenant(token, r.Name, r.tenant) == nil
+}
+
+func (r *FakeRelay) supportsSpace(space string) bool {
+	_, domain, ok := strings.Cut(space, ".")
+	if !ok {
+		return false
+	}
+	for _, d := range r.domains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// nextLocked は推測されにくい連番付きの値を返す（r.mu を保持して呼ぶ）
+func (r *FakeRelay) nextLocked(prefix string) string {
+	r.seq++
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("fake-%s-%d-%s", prefix, r.seq, hex.EncodeToString(b))
+}
+
+// newFakeTenant は署名鍵を生成し、中継サーバーのテナント設定を作る
+func newFakeTenant() (*config.ResolvedTenant, error) {
+	private, _, err := relaysig.GenerateKey(FakeRelayKeyID)
+	if err != nil {
+		return nil, err
+	}
+	jwks, err := json.Marshal(map[string]any{"keys": []any{private}})
+	if err != nil {
+		return nil, err
+	}
+	return &config.ResolvedTenant{
+		JWKS:       string(jwks),
+		ActiveKeys: FakeRelayKeyID,
+	}, nil
+}
+
+func fakeRelayPrivateKey(tenant *config.ResolvedTenant) ed25519.PrivateKey {
+	var jwks struct {
+		Keys []struct {
+			D string `json:"d"`
+		} `json:"keys"`
+	}
+	_ = json.Unmarshal([]byte(tenant.JWKS), &jwks)
+	seed, _ := base64.RawURLEncoding.DecodeString(jwks.Keys[0].D)
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeTokenError(w http.ResponseWriter, code, description string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
@@ -0,0 +1,180 @@
+package testutil
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/auth"
+	"github.com/yacchi/backlog-cli/packages/backlog/internal/config"
+)
+
+// authorize は /auth/start を呼び、コールバック先の URL を返す
+func authorize(t *testing.T, relay *FakeRelay, query string) *url.URL {
+	t.Helper()
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(relay.URL + "/auth/start?" + query)
+	if err != nil {
+		t.Fatalf("GET /auth/start: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("GET /auth/start status = %d, want 302", resp.StatusCode)
+	}
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("parse Location: %v", err)
+	}
+	return loc
+}
+
+func TestFakeRelayTokenFlow(t *testing.T) {
+	relay := NewFakeRelay(t)
+
+	loc := authorize(t, relay, "port=18080&state=st&space=demo.backlog.jp&project=PROJ")
+	if loc.Host != "localhost:18080" || loc.Path != "/callback" {
+		t.Fatalf("callback = %s, want http://localhost:18080/callback", loc)
+	}
+	if got := loc.Query().Get("state"); got != "st" {
+		t.Errorf("state = %q, want st", got)
+	}
+	code := loc.Query().Get("code")
+	if code == "" {
+		t.Fatal("callback has no code")
+	}
+	starts := relay.AuthStarts()
+	if len(starts) != 1 || starts[0].Project != "PROJ" || starts[0].Space != "demo.backlog.jp" {
+		t.Errorf("AuthStarts() = %+v", starts)
+	}
+
+	client := auth.NewClient(relay.URL)
+	tok, err := client.ExchangeToken(auth.TokenRequest{GrantType: "authorization_code", Code: code, Space: "demo.backlog.jp", State: "st"})
+	if err != nil {
+		t.Fatalf("ExchangeToken: %v", err)
+	}
+	if tok.AccessToken == "" || tok.RefreshToken == "" || tok.ExpiresIn != 3600 {
+		t.Errorf("token = %+v", tok)
+	}
+
+	// 認可コードは再利用できない
+	if _, err := client.ExchangeToken(auth.TokenRequest{GrantType: "authorization_code", Code: code, Space: "demo.backlog.jp", State: "st"}); err == nil {
+		t.Error("ExchangeToken with used code succeeded")
+	}
+
+	refreshed, err := client.RefreshToken("demo.backlog.jp", tok.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if refreshed.AccessToken == tok.AccessToken {
+		t.Error("RefreshToken returned the same access token")
+	}
+	// リフレッシュトークンはローテーションされる
+	if _, err := client.RefreshToken("demo.backlog.jp", tok.RefreshToken); err == nil {
+		t.Error("RefreshToken with rotated token succeeded")
+	}
+
+	if got := len(relay.TokenRequests()); got != 4 {
+		t.Errorf("len(TokenRequests()) = %d, want 4", got)
+	}
+	if got := len(relay.Tokens()); got != 2 {
+		t.Errorf("len(Tokens()) = %d, want 2", got)
+	}
+}
+
+func TestFakeRelayAuthStart(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []FakeRelayOption
+		query     string
+		wantHost  string
+		wantError string
+	}{
+		{
+			name:     "custom callback host",
+			query:    "port=9000&state=s&space=demo.backlog.com&host=127.0.0.1",
+			wantHost: "127.0.0.1:9000",
+		},
+		{
+			name:      "auth error",
+			opts:      []FakeRelayOption{WithAuthError("access_denied")},
+			query:     "port=9000&state=s&space=demo.backlog.jp",
+			wantHost:  "localhost:9000",
+			wantError: "access_denied",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relay := NewFakeRelay(t, tt.opts...)
+			loc := authorize(t, relay, tt.query)
+			if loc.Host != tt.wantHost {
+				t.Errorf("host = %q, want %q", loc.Host, tt.wantHost)
+			}
+			if got := loc.Query().Get("error"); got != tt.wantError {
+				t.Errorf("error = %q, want %q", got, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestFakeRelayRejectsUnsupportedDomain(t *testing.T) {
+	relay := NewFakeRelay(t, WithSupportedDomains("backlog.com"))
+	resp, err := http.Get(relay.URL + "/auth/start?port=9000&state=s&space=demo.backlog.jp")
+	if err != nil {
+		t.Fatalf("GET /auth/start: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400", resp.StatusCode)
+	}
+
+	wk, err := auth.NewClient(relay.URL).FetchWellKnown()
+	if err != nil {
+		t.Fatalf("FetchWellKnown: %v", err)
+	}
+	if len(wk.SupportedDomains) != 1 || wk.SupportedDomains[0] != "backlog.com" {
+		t.Errorf("SupportedDomains = %v", wk.SupportedDomains)
+	}
+}
+
+func TestFakeRelayBundleImport(t *testing.T) {
+	config.ResetConfig()
+	defer config.ResetConfig()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	relay := NewFakeRelay(t)
+	bundle, err := relay.Bundle()
+	if err != nil {
+		t.Fatalf("Bundle: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), relay.Name+".backlog-cli.zip")
+	if err := os.WriteFile(path, bundle, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := config.Load(t.Context())
+	if err != nil {
+		t.Fatalf("config.Load: %v", err)
+	}
+	trusted, err := config.ImportRelayBundle(t.Context(), store, path, config.BundleImportOptions{})
+	if err != nil {
+		t.Fatalf("ImportRelayBundle: %v", err)
+	}
+	if !strings.HasPrefix(trusted.RelayURL, relay.URL) {
+		t.Errorf("RelayURL = %q, want %q", trusted.RelayURL, relay.URL)
+	}
+
+	// /info は取り込んだバンドルの鍵で検証できる
+	if _, err := config.VerifyRelayInfo(t.Context(), relay.URL, relay.Name, trusted.BundleToken, trusted.RelayKeys, config.RelayInfoOptions{}); err != nil {
+		t.Errorf("VerifyRelayInfo: %v", err)
+	}
+	if _, err := config.VerifyRelayInfo(t.Context(), relay.URL, relay.Name, "invalid", trusted.RelayKeys, config.RelayInfoOptions{}); err == nil {
+		t.Error("VerifyRelayInfo with invalid bundle token succeeded")
+	}
+}
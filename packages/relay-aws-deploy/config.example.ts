@@ -74,4 +74,12 @@ export const config: RelayConfig = {
   //   // audit: { collect_user_info: true },
   //   // logging: { input: false, output: false },
   // },
+
+  // ============================================================
+  // コールドスタートの短縮（オプション）
+  // ============================================================
+  // configSnapshot: true で設定 JSON を Lambda の環境変数に埋め込み、
+  // コールドスタート時の SSM 読み込みを省く（SSM の手動編集は再デプロイまで反映されない）。
+  //
+  // lambda: { configSnapshot: true },
 };
@@ -66,6 +66,8 @@ export class RelayStack extends cdk.Stack {
   public readonly functionUrl: lambda.FunctionUrl;
   public readonly distribution?: cloudfront.Distribution;
   private configParameter: ssm.IParameter;
+  /** SSM パラメータに保存した設定 JSON（設定スナップショットにも使う）。 */
+  private configParameterValue = "";
   private relaySecretsSecret?: secretsmanager.Secret;
   private readonly config: RelayConfig;
   private lambdaFunction: lambda.Function;
@@ -260,6 +262,7 @@ export class RelayStack extends cdk.Stack {
     }
 
     const parameterValue = JSON.stringify(finalValue);
+    this.configParameterValue = parameterValue;
 
     return new ssm.StringParameter(this, "ConfigParameter", {
       parameterName,
@@ -325,6 +328,8 @@ export class RelayStack extends cdk.Stack {
       imageArch: ["arm64"],
     });
 
+    const snapshotEnv = this.configSnapshotEnv();
+
     const fn = new lambda.DockerImageFunction(this, "RelayFunction", {
       code: lambda.DockerImageCode.fromEcr(repository, {
         tagOrDigest: imageTag,
@@ -343,6 +348,7 @@ export class RelayStack extends cdk.Stack {
         AWS_LWA_INVOKE_MODE: "response_stream",
         DEPLOY_VERSION: "2026-06-22-container",
         ...relaySecretsEnv,
+        ...snapshotEnv,
       },
       description: mcpEnabled
         ? "Backlog CLI Relay + MCP Server (container)"
@@ -393,6 +399,29 @@ export class RelayStack extends cdk.Stack {
     return fn;
   }
 
+  /**
+   * 設定スナップショット（lambda.configSnapshot）の環境変数を返す。
+   *
+   * SSM パラメータと同じ JSON を合成時に環境変数へ埋め込み、コールドスタート時の
+   * GetParameter を省く。Lambda の環境変数は合計 4 KB までのため、超える場合は
+   * デプロイ時ではなく合成時にエラーにする。
+   */
+  private configSnapshotEnv(): Record<string, string> {
+    if (!this.config.lambda?.configSnapshot) {
+      return {};
+    }
+    // 他の環境変数の分を残すため、スナップショットの上限は 3 KB とする
+    const limit = 3 * 1024;
+    const size = Buffer.byteLength(this.configParameterValue, "utf-8");
+    if (size > limit) {
+      throw new Error(
+        `lambda.configSnapshot: config is ${size} bytes, which exceeds the ${limit} byte limit ` +
+          "for Lambda environment variables. Disable configSnapshot to read the config from SSM.",
+      );
+    }
+    return { CONFIG_SNAPSHOT: this.configParameterValue };
+  }
+
   /**
    * Function URL を作成
    * CloudFront 有効時は IAM 認証を使用し、直接アクセスを防止
@@ -152,6 +152,12 @@ export interface ParameterStoreValue {
   access_control?: {
     allowed_space_patterns?: string;
     allowed_project_patterns?: string;
+    /** トークン交換を許可するクライアント IP（セミコロン区切りの CIDR）。 */
+    allowed_cidrs?: string;
+    /** allowed_cidrs の判定に使うクライアント IP のヘッダー（信頼できるプロキシが付与するもの）。 */
+    client_ip_header?: string;
+    /** client_ip_header の右端からたどる際に読み飛ばすプロキシ（セミコロン区切りの CIDR）。 */
+    trusted_proxies?: string;
   };
   rate_limit?: {
     requests_per_minute: number;
@@ -209,6 +215,21 @@ export interface ContainerImageConfig {
   prerelease?: boolean;
 }
 
+/**
+ * Lambda runtime tuning.
+ */
+export interface LambdaConfig {
+  /**
+   * Embed the non-secret config (the same JSON as the SSM parameter) in the
+   * function's environment at synth time, so cold starts skip the SSM
+   * GetParameter call (secrets are still read from Secrets Manager).
+   *
+   * While enabled, manual edits to the SSM parameter are ignored until the
+   * next deploy. Lambda limits environment variables to 4 KB in total.
+   */
+  configSnapshot?: boolean;
+}
+
 /**
  * Relay server CDK configuration.
  */
@@ -217,6 +238,8 @@ export interface RelayConfig extends ParameterStoreConfig {
   mcp?: McpConfig;
   /** Container image source/tag (defaults to the published GHCR image). */
   image?: ContainerImageConfig;
+  /** Lambda runtime tuning (cold start). */
+  lambda?: LambdaConfig;
 }
 
 /**
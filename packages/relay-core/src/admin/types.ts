@@ -45,3 +45,80 @@ export interface PassphraseManager {
     generatePassphrase(tenantName: string): Promise<{ passphrase: string }>;
     clearPassphrase(tenantName: string): Promise<void>;
 }
+
+export type ServiceTokenStatus = "pending" | "active" | "revoked";
+
+/**
+ * A long-lived service token for CI and bots.
+ *
+ * A portal user (typically logged in as a dedicated bot account) requests a
+ * token, handing over the Backlog refresh token of their portal session. A
+ * tenant admin approves the request, which issues the token secret. The CLI
+ * exchanges the token at /auth/token for a short-lived access token.
+ */
+export interface ServiceTokenRecord {
+    id: string;
+    tenant: string;
+    /** Backlog space host the token acts on */
+    space: string;
+    /** Label chosen by the requester (e.g. "ci-deploy") */
+    name: string;
+    status: ServiceTokenStatus;
+    /** Backlog account the token acts as */
+    userId: string;
+    userName: string;
+    userEmail?: string;
+    /** Backlog refresh token sealed with the server key (same format as the portal refresh cookie) */
+    encryptedRefreshToken: string;
+    /** SHA-256 (hex) of the token secret, set on approval */
+    secretHash?: string;
+    /** Requested validity in days, applied on approval */
+    validityDays: number;
+    requestedAt: string;
+    approvedAt?: string;
+    approvedBy?: string;
+    expiresAt?: string;
+    lastUsedAt?: string;
+    revokedAt?: string;
+    revokedBy?: string;
+}
+
+export interface ServiceTokenStore {
+    get(id: string): Promise<ServiceTokenRecord | undefined>;
+    list(tenantName: string): Promise<ServiceTokenRecord[]>;
+    put(record: ServiceTokenRecord): Promise<void>;
+}
+
+/**
+ * A CLI login session: one `backlog auth login` on one device.
+ *
+ * The relay follows the rotating Backlog refresh token of each session so that
+ * users can list their devices and revoke one (e.g. a lost laptop). The Backlog
+ * client secret never leaves the relay, so a revoked session cannot obtain new
+ * access tokens and ends when its current access token expires.
+ */
+export interface CliSessionRecord {
+    id: string;
+    /** Backlog space host */
+    space: string;
+    /** Backlog numeric user ID (the owner of the session) */
+    userId: number;
+    userName: string;
+    userEmail?: string;
+    /** Device name reported by the CLI (host name) */
+    device?: string;
+    /** SHA-256 (hex) of the latest Backlog refresh token of the session */
+    refreshTokenHash: string;
+    createdAt: string;
+    lastUsedAt: string;
+    lastIp?: string;
+    userAgent?: string;
+    revokedAt?: string;
+}
+
+export interface CliSessionStore {
+    get(id: string): Promise<CliSessionRecord | undefined>;
+    findByRefreshToken(refreshTokenHash: string): Promise<CliSessionRecord | undefined>;
+    list(space: string, userId: number): Promise<CliSessionRecord[]>;
+    put(record: CliSessionRecord): Promise<void>;
+}
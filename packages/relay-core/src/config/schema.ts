@@ -24,6 +24,8 @@ export const TenantConfigSchema = z.object({
   passphrase_hash: z.string().optional(),
   default_space: z.string().optional(),
   info_ttl: z.number().positive().optional(),
+  /** SHA-256 hex digest of the admin token for tenant admin APIs (e.g. stats) */
+  admin_token_hash: z.string().optional(),
 });
 
 /**
@@ -32,6 +34,22 @@ export const TenantConfigSchema = z.object({
 export const AccessControlConfigSchema = z.object({
   allowed_space_patterns: z.string().optional(),
   allowed_project_patterns: z.string().optional(),
+  /**
+   * Client IP ranges (semicolon-separated CIDRs) allowed to exchange tokens.
+   * When `server.tls.client_ca` is also set, clients outside these ranges
+   * must present a certificate signed by the CA instead.
+   */
+  allowed_cidrs: z.string().optional(),
+  /**
+   * Header set by a trusted reverse proxy that carries the client IP for
+   * `allowed_cidrs` (e.g. X-Forwarded-For, CF-Connecting-IP, X-Viewer-IP).
+   * When unset, only the socket address passed by the runtime is used.
+   * Comma-separated values are read from the right, skipping
+   * `trusted_proxies`, so entries prepended by the client are ignored.
+   */
+  client_ip_header: z.string().optional(),
+  /** Proxy addresses (semicolon-separated CIDRs) skipped in client_ip_header. */
+  trusted_proxies: z.string().optional(),
 });
 
 /**
@@ -47,13 +65,30 @@ export const RateLimitConfigSchema = z.object({
  */
 export const DEFAULT_SERVER_PORT = 8080;
 
+/**
+ * TLS configuration schema for runtimes that terminate TLS themselves
+ * (e.g. the resident Docker runtime). Values are PEM file paths.
+ */
+export const ServerTLSConfigSchema = z.object({
+  cert_file: z.string().min(1, "cert_file is required"),
+  key_file: z.string().min(1, "key_file is required"),
+  /** CA bundle used to verify client certificates (enables mTLS) */
+  client_ca: z.string().optional(),
+});
+
 /**
  * Server configuration schema.
  */
 export const ServerConfigSchema = z.object({
   base_url: z.string().url().optional(),
   allowed_host_patterns: z.string().optional(),
+  /**
+   * Additional hosts (semicolon-separated, wildcard allowed) the relay may
+   * redirect the OAuth callback to, besides the built-in loopback hosts.
+   */
+  allowed_callback_hosts: z.string().optional(),
   port: z.number().int().min(1).max(65535).default(DEFAULT_SERVER_PORT),
+  tls: ServerTLSConfigSchema.optional(),
 });
 
 /**
@@ -64,6 +99,20 @@ export const CacheConfigSchema = z.object({
   info_cache_ttl: z.number().positive(),
 });
 
+/**
+ * Request signature (CLI -> relay mutual authentication) configuration schema.
+ */
+export const RequestSignatureConfigSchema = z.object({
+  /** Reject token requests without a valid signature */
+  required: z.boolean().optional(),
+  /** JWKS (JSON string) of client public keys allowed to sign requests */
+  client_keys: z.string().optional(),
+  /** Allowed clock skew in seconds (default: 300) */
+  max_skew_seconds: z.number().positive().optional(),
+  /** Also require a valid bundle token in the Authorization header */
+  require_bundle_token: z.boolean().optional(),
+});
+
 /**
  * Full relay server configuration schema.
  */
@@ -75,6 +124,7 @@ export const RelayConfigSchema = z.object({
   access_control: AccessControlConfigSchema.optional(),
   rate_limit: RateLimitConfigSchema.optional(),
   cache: CacheConfigSchema.optional(),
+  request_signature: RequestSignatureConfigSchema.optional(),
 });
 
 /**
@@ -150,6 +200,11 @@ export type ServerConfig = z.output<typeof ServerConfigSchema>;
  */
 export type ServerConfigInput = z.input<typeof ServerConfigSchema>;
 
+/**
+ * Server TLS configuration.
+ */
+export type ServerTLSConfig = z.infer<typeof ServerTLSConfigSchema>;
+
 /**
  * Backlog app configuration.
  */
@@ -175,5 +230,10 @@ export type RateLimitConfig = z.infer<typeof RateLimitConfigSchema>;
  */
 export type CacheConfig = z.infer<typeof CacheConfigSchema>;
 
+/**
+ * Request signature configuration.
+ */
+export type RequestSignatureConfig = z.infer<typeof RequestSignatureConfigSchema>;
+
 // Legacy alias for backwards compatibility
 export type RelayConfigParsed = RelayConfig;
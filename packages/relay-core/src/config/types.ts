@@ -11,11 +11,13 @@ export type {
   RelayConfigInput,
   ServerConfig,
   ServerConfigInput,
+  ServerTLSConfig,
   BacklogAppConfig,
   TenantConfig,
   AccessControlConfig,
   RateLimitConfig,
   CacheConfig,
+  RequestSignatureConfig,
 } from "./schema.js";
 
 import type { RelayConfig } from "./schema.js";
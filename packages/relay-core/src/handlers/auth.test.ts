@@ -1,5 +1,6 @@
 import { describe, it, expect } from "vitest";
-import { createAuthHandlers } from "./auth.js";
+import { createAuthHandlers, isCallbackHostAllowed } from "./auth.js";
+import { decodeState, encodeState } from "../utils/state.js";
 import { NoopAuditLogger } from "../middleware/audit.js";
 import type { RelayConfig } from "../config/types.js";
 
@@ -26,3 +27,57 @@ describe("GET /auth/callback error page", () => {
     expect(html).toContain("&lt;script&gt;alert(document.domain)&lt;/script&gt;");
   });
 });
+
+describe("callback host", () => {
+  it("allows loopback and docker host names by default", () => {
+    expect(isCallbackHostAllowed("localhost")).toBe(true);
+    expect(isCallbackHostAllowed("127.0.0.1")).toBe(true);
+    expect(isCallbackHostAllowed("[::1]")).toBe(true);
+    expect(isCallbackHostAllowed("host.docker.internal")).toBe(true);
+    expect(isCallbackHostAllowed("evil.example.com")).toBe(false);
+  });
+
+  it("rejects values that are not plain host names", () => {
+    expect(isCallbackHostAllowed("evil.example.com/localhost")).toBe(false);
+    expect(isCallbackHostAllowed("localhost@evil.example.com")).toBe(false);
+    expect(isCallbackHostAllowed("")).toBe(false);
+  });
+
+  it("allows configured extra patterns", () => {
+    expect(isCallbackHostAllowed("dev.wsl.internal", "*.wsl.internal")).toBe(true);
+    expect(isCallbackHostAllowed("dev.example.com", "*.wsl.internal")).toBe(false);
+  });
+
+  it("rejects /auth/start with a disallowed host", async () => {
+    const app = createAuthHandlers(makeConfig(), new NoopAuditLogger());
+    const res = await app.request(
+      "/auth/start?space=example.backlog.jp&port=52000&state=abc&host=evil.example.com",
+    );
+    expect(res.status).toBe(400);
+  });
+
+  it("encodes the callback host into the state", async () => {
+    const app = createAuthHandlers(makeConfig(), new NoopAuditLogger());
+    const res = await app.request(
+      "/auth/start?space=example.backlog.jp&port=52000&state=abc&host=host.docker.internal",
+    );
+    expect(res.status).toBe(302);
+    const location = new URL(res.headers.get("location") ?? "");
+    const claims = decodeState(location.searchParams.get("state") ?? "");
+    expect(claims.host).toBe("host.docker.internal");
+    expect(claims.port).toBe(52000);
+  });
+
+  it("refuses to redirect the callback to a tampered host", async () => {
+    const app = createAuthHandlers(makeConfig(), new NoopAuditLogger());
+    const state = encodeState({
+      port: 52000,
+      host: "evil.example.com",
+      cliState: "abc",
+      space: "example.backlog.jp",
+    });
+    const res = await app.request(`/auth/callback?code=secret&state=${state}`);
+    expect(res.headers.get("location") ?? "").not.toContain("evil.example.com");
+    expect(res.status).not.toBe(302);
+  });
+});
@@ -25,6 +25,53 @@ function normalizeSpace(space: string, domain?: string): string {
   return space;
 }
 
+/**
+ * Check if a host (without port) matches semicolon-separated glob patterns.
+ */
+function matchesHostPatterns(host: string, patterns: string): boolean {
+  for (const pattern of patterns.split(";")) {
+    const trimmed = pattern.trim();
+    if (!trimmed) continue;
+
+    // Convert glob pattern to regex
+    const regexPattern = trimmed
+      .replace(/[.+^${}()|[\]\\]/g, "\\$&")
+      .replace(/\*/g, ".*");
+
+    const regex = new RegExp(`^${regexPattern}$`);
+    if (regex.test(host)) {
+      return true;
+    }
+  }
+
+  return false;
+}
+
+/**
+ * Hosts the OAuth callback may always be redirected to. They all point back
+ * to the user's own machine (host.docker.internal is the host as seen from
+ * Docker / devcontainers).
+ */
+const BUILTIN_CALLBACK_HOSTS = "localhost;127.0.0.1;[::1];host.docker.internal";
+
+/**
+ * Check if the CLI callback host is allowed.
+ *
+ * The encoded state is not signed, so this must be checked both when the
+ * flow starts and when the callback is redirected; otherwise the one-shot
+ * authorization code could be sent to an arbitrary host.
+ */
+export function isCallbackHostAllowed(host: string, extraPatterns?: string): boolean {
+  if (!/^(\[[0-9a-fA-F:]+\]|[A-Za-z0-9.-]+)$/.test(host)) {
+    return false;
+  }
+  host = host.toLowerCase();
+  if (matchesHostPatterns(host, BUILTIN_CALLBACK_HOSTS)) {
+    return true;
+  }
+  return extraPatterns ? matchesHostPatterns(host, extraPatterns) : false;
+}
+
 /**
  * Error response type.
  */
@@ -56,7 +103,9 @@ export function createAuthHandlers(
 
     // Validate host if patterns are configured
     if (config.server.allowed_host_patterns) {
-      if (!isHostAllowed(reqCtx.host, config.server.allowed_host_patterns)) {
+      // Remove port from host
+      const hostOnly = reqCtx.host.split(":")[0];
+      if (!matchesHostPatterns(hostOnly, config.server.allowed_host_patterns)) {
         // Fall back to localhost
         return `http://localhost:${config.server.port}/auth/callback`;
       }
@@ -65,31 +114,6 @@ export function createAuthHandlers(
     return `${reqCtx.baseUrl}/auth/callback`;
   }
 
-  /**
-   * Check if a host matches the allowed patterns.
-   */
-  function isHostAllowed(host: string, patterns: string): boolean {
-    // Remove port from host
-    const hostOnly = host.split(":")[0];
-
-    for (const pattern of patterns.split(";")) {
-      const trimmed = pattern.trim();
-      if (!trimmed) continue;
-
-      // Convert glob pattern to regex
-      const regexPattern = trimmed
-        .replace(/[.+^${}()|[\]\\]/g, "\\$&")
-        .replace(/\*/g, ".*");
-
-      const regex = new RegExp(`^${regexPattern}$`);
-      if (regex.test(hostOnly)) {
-        return true;
-      }
-    }
-
-    return false;
-  }
-
   /**
    * Write JSON error response.
    */
@@ -153,6 +177,7 @@ export function createAuthHandlers(
     const spaceParam = c.req.query("space");
     const domainParam = c.req.query("domain");
     const portStr = c.req.query("port");
+    const hostParam = c.req.query("host");
     const cliState = c.req.query("state");
     const project = c.req.query("project");
 
@@ -178,6 +203,21 @@ export function createAuthHandlers(
       );
     }
 
+    // The callback host defaults to localhost; WSL / devcontainer users can
+    // point it to a host reachable from the browser.
+    const callbackHost = hostParam && hostParam !== "localhost" ? hostParam : undefined;
+    if (
+      callbackHost &&
+      !isCallbackHostAllowed(callbackHost, config.server.allowed_callback_hosts)
+    ) {
+      return writeError(
+        c,
+        400,
+        "invalid_request",
+        "host is not an allowed callback host"
+      );
+    }
+
     // Normalize space: if it doesn't contain a dot but domain is provided, combine them
     const spaceHost = normalizeSpace(spaceParam, domainParam);
 
@@ -219,6 +259,7 @@ export function createAuthHandlers(
     // Encode state - only include normalized space, not separate domain
     const encodedState = encodeState({
       port,
+      host: callbackHost,
       cliState,
       space: spaceHost,
       project,
@@ -332,6 +373,27 @@ export function createAuthHandlers(
       );
     }
 
+    const callbackHost = claims.host ?? "localhost";
+    if (!isCallbackHostAllowed(callbackHost, config.server.allowed_callback_hosts)) {
+      auditLogger.log(
+        createAuditEvent({
+          sessionId: extractSessionId(claims.cliState),
+          action: AuditActions.AUTH_CALLBACK,
+          space: claims.space,
+          project: claims.project,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "error",
+          error: `callback host not allowed: ${callbackHost}`,
+        })
+      );
+      return renderErrorPage(
+        c,
+        "Session Invalid",
+        "Please try logging in again"
+      );
+    }
+
     // Log success
     auditLogger.log(
       createAuditEvent({
@@ -346,7 +408,7 @@ export function createAuthHandlers(
     );
 
     // Redirect to CLI local server
-    const localUrl = new URL(`http://localhost:${claims.port}/callback`);
+    const localUrl = new URL(`http://${callbackHost}:${claims.port}/callback`);
     localUrl.searchParams.set("code", code);
     localUrl.searchParams.set("state", claims.cliState);
 
@@ -0,0 +1,152 @@
+/**
+ * CLI session handlers.
+ *
+ * Lets users list the devices logged in through this relay and revoke one.
+ * Requests are authenticated with the caller's Backlog access token, which is
+ * verified against the Backlog API; only the owner's sessions are visible.
+ *
+ * - GET /auth/sessions?space=<host> - List the caller's sessions
+ * - POST /auth/sessions/:id/revoke?space=<host> - Revoke one of them
+ */
+
+import { Hono } from "hono";
+import type { Context } from "hono";
+import type { RelayConfig, AuditLogger } from "../config/types.js";
+import type { CliSessionStore } from "../admin/types.js";
+import { AccessControl } from "../middleware/access-control.js";
+import { AuditActions, createAuditEvent } from "../middleware/audit.js";
+import { extractRequestContext } from "../utils/request.js";
+import { toPublicCliSession } from "../utils/cli-session.js";
+
+/**
+ * Fetch the owner of an access token from the Backlog API.
+ */
+async function fetchCurrentUser(
+  spaceHost: string,
+  accessToken: string
+): Promise<{ id: number; name: string; mailAddress: string } | null> {
+  try {
+    const response = await fetch(`https://${spaceHost}/api/v2/users/myself`, {
+      headers: { Authorization: `Bearer ${accessToken}` },
+    });
+    if (!response.ok) return null;
+
+    const data = (await response.json()) as {
+      id?: number;
+      name?: string;
+      mailAddress?: string;
+    };
+    if (!data.id) return null;
+    return {
+      id: data.id,
+      name: data.name ?? "",
+      mailAddress: data.mailAddress ?? "",
+    };
+  } catch {
+    return null;
+  }
+}
+
+export function createCliSessionHandlers(
+  config: RelayConfig,
+  auditLogger: AuditLogger,
+  store: CliSessionStore
+): Hono {
+  const app = new Hono();
+  const accessControl = new AccessControl(config.access_control);
+
+  app.use("/auth/sessions/*", async (c, next) => {
+    c.header("Cache-Control", "no-store");
+    await next();
+  });
+  app.use("/auth/sessions", async (c, next) => {
+    c.header("Cache-Control", "no-store");
+    await next();
+  });
+
+  /**
+   * Resolve the space and the caller from the request.
+   * Returns an error response if either is missing or invalid.
+   */
+  async function authenticate(
+    c: Context
+  ): Promise<{ space: string; user: { id: number; name: string; mailAddress: string } } | Response> {
+    const space = c.req.query("space");
+    if (!space || !space.includes(".")) {
+      return c.json({ error: "invalid_request", error_description: "space (host) is required" }, 400);
+    }
+    try {
+      accessControl.checkSpace(space);
+    } catch (err) {
+      return c.json({ error: "access_denied", error_description: (err as Error).message }, 403);
+    }
+
+    const auth = c.req.header("Authorization");
+    const accessToken = auth?.startsWith("Bearer ") ? auth.slice("Bearer ".length) : "";
+    const user = accessToken ? await fetchCurrentUser(space, accessToken) : null;
+    if (!user) {
+      return c.json({ error: "authentication_required" }, 401);
+    }
+    return { space, user };
+  }
+
+  app.get("/auth/sessions", async (c) => {
+    const result = await authenticate(c);
+    if (result instanceof Response) return result;
+
+    const sessions = await store.list(result.space, result.user.id);
+    sessions.sort((a, b) => b.lastUsedAt.localeCompare(a.lastUsedAt));
+    return c.json({ sessions: sessions.map(toPublicCliSession) });
+  });
+
+  app.post("/auth/sessions/:id/revoke", async (c) => {
+    const reqCtx = extractRequestContext(c);
+    const result = await authenticate(c);
+    if (result instanceof Response) return result;
+    const { space, user } = result;
+
+    const record = await store.get(c.req.param("id"));
+    if (!record || record.space !== space || record.userId !== user.id) {
+      return c.json({ error: "not_found" }, 404);
+    }
+    if (record.revokedAt) {
+      return c.json(toPublicCliSession(record));
+    }
+
+    const revoked = { ...record, revokedAt: new Date().toISOString() };
+    try {
+      await store.put(revoked);
+    } catch (err) {
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.CLI_SESSION_REVOKE,
+          sessionId: record.id,
+          space,
+          userName: user.name,
+          userEmail: user.mailAddress,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "error",
+          error: (err as Error).message,
+        })
+      );
+      return c.json({ error: "failed" }, 500);
+    }
+
+    auditLogger.log(
+      createAuditEvent({
+        action: AuditActions.CLI_SESSION_REVOKE,
+        sessionId: record.id,
+        space,
+        userName: user.name,
+        userEmail: user.mailAddress,
+        clientIp: reqCtx.clientIp,
+        userAgent: reqCtx.userAgent,
+        result: "success",
+      })
+    );
+    return c.json(toPublicCliSession(revoked));
+  });
+
+  return app;
+}
@@ -23,7 +23,11 @@ interface AdminContext {
     tenantName: string;
 }
 
-async function verifyAdminSession(
+/**
+ * Verify that the session cookie belongs to a recently authenticated
+ * Backlog admin of the tenant. Returns an error response otherwise.
+ */
+export async function verifyAdminSession(
     c: { req: { param: (name: string) => string; header: (name: string) => string | undefined }; json: (data: unknown, status?: number) => Response },
     jwksJson: string,
     sessionCookieValue: string | undefined,
@@ -5,11 +5,21 @@
 import { Hono } from "hono";
 import { getCookie, setCookie } from "hono/cookie";
 import type { Context } from "hono";
-import type { RelayConfig, AuditLogger, TenantConfig } from "../config/types.js";
+import type { RelayConfig, AuditLogger, TenantConfig, CacheProvider } from "../config/types.js";
 import { AuditActions, createAuditEvent } from "../middleware/audit.js";
 import { extractRequestContext } from "../utils/request.js";
 import { verifyPortalSessionToken, refreshPortalSession, type PortalSessionClaims } from "../utils/portal-session.js";
 import type { IssuedByInfo } from "../utils/bundle.js";
+import {
+  BUNDLE_DOWNLOAD_MAX_TTL_SECONDS,
+  BUNDLE_DOWNLOAD_TTL_SECONDS,
+  MemoryCacheProvider,
+  consumeBundleDownloadToken,
+  createBundleDownloadToken,
+  verifyBundleDownloadToken,
+  type BundleDownloadClaims,
+} from "../utils/bundle-download.js";
+import { verifyAdminSession } from "./portal-admin.js";
 
 /**
  * Portal verify request.
@@ -43,6 +53,10 @@ const SESSION_COOKIE = "portal_session";
 const REFRESH_COOKIE = "portal_refresh";
 const REFRESH_COOKIE_MAX_AGE = 30 * 24 * 3600;
 
+// Shared across app instances so that platforms creating the app per request
+// (e.g. Cloudflare Workers) still reject reuse within the same isolate.
+const defaultDownloadTokenStore = new MemoryCacheProvider();
+
 interface AuthResult {
   method: "oauth" | "bearer" | "passphrase";
   user: IssuedByInfo | null;
@@ -187,7 +201,9 @@ export function createPortalHandlers(
     relayUrl: string,
     issuedBy?: IssuedByInfo,
   ) => Promise<string>,
-  portalAssets?: PortalAssets
+  portalAssets?: PortalAssets,
+  downloadTokenStore: CacheProvider = defaultDownloadTokenStore,
+  serviceTokensEnabled = false,
 ): Hono {
   const app = new Hono();
   const jwksJson = config.jwks;
@@ -364,6 +380,7 @@ export function createPortalHandlers(
       name: tenant.name,
       has_passphrase: !!tenant.passphrase_hash,
       oauth_enabled: !!config.backlog_app?.client_id && !!config.jwks,
+      service_tokens_enabled: serviceTokensEnabled,
       ...(tenant.default_space ? { default_space: tenant.default_space } : {}),
     });
   });
@@ -517,14 +534,7 @@ export function createPortalHandlers(
         }),
       );
 
-      const filename = `${name}.backlog-cli.zip`;
-      return new Response(bundleData, {
-        headers: {
-          "Content-Type": "application/zip",
-          "Content-Disposition": `attachment; filename="${filename}"`,
-          "Cache-Control": "no-store",
-        },
-      });
+      return bundleResponse(name, bundleData);
     } catch (err) {
       auditLogger.log(
         createAuditEvent({
@@ -540,6 +550,184 @@ export function createPortalHandlers(
     }
   });
 
+  /**
+   * Send a bundle as a zip attachment.
+   */
+  function bundleResponse(name: string, bundleData: Uint8Array): Response {
+    const filename = `${name}.backlog-cli.zip`;
+    return new Response(bundleData, {
+      headers: {
+        "Content-Type": "application/zip",
+        "Content-Disposition": `attachment; filename="${filename}"`,
+        "Cache-Control": "no-store",
+      },
+    });
+  }
+
+  if (jwksJson) {
+    /**
+     * POST /api/v1/portal/:name/admin/bundle-url - Issue a one-time bundle download URL.
+     * Requires an OAuth session of a recently authenticated Backlog admin of the tenant's space.
+     */
+    app.post("/api/v1/portal/:name/admin/bundle-url", async (c) => {
+      c.header("Cache-Control", "no-store");
+      const reqCtx = extractRequestContext(c);
+      const name = c.req.param("name");
+
+      const tenant = findTenant(name);
+      if (!tenant) {
+        return c.json({ success: false, error: "tenant not found" }, 404);
+      }
+
+      const result = await verifyAdminSession(c, jwksJson, getCookie(c, SESSION_COOKIE));
+      if (result instanceof Response) {
+        auditLogger.log(
+          createAuditEvent({
+            action: AuditActions.PORTAL_BUNDLE_URL,
+            domain: name,
+            clientIp: reqCtx.clientIp,
+            userAgent: reqCtx.userAgent,
+            result: "error",
+            error: "admin authentication failed",
+          }),
+        );
+        return result;
+      }
+      const { claims } = result;
+
+      // The session must come from the tenant's own space, not just any space the user administers
+      if (tenant.default_space && claims.space !== tenant.default_space) {
+        auditLogger.log(
+          createAuditEvent({
+            action: AuditActions.PORTAL_BUNDLE_URL,
+            domain: name,
+            space: claims.space,
+            userId: claims.sub,
+            userName: claims.name,
+            userEmail: claims.email,
+            clientIp: reqCtx.clientIp,
+            userAgent: reqCtx.userAgent,
+            result: "error",
+            error: "space mismatch",
+          }),
+        );
+        return c.json({ success: false, error: "admin_required" }, 403);
+      }
+
+      let body: { ttl?: unknown } = {};
+      try { body = await c.req.json(); } catch { /* empty body is ok */ }
+      let ttl = BUNDLE_DOWNLOAD_TTL_SECONDS;
+      if (body.ttl !== undefined) {
+        if (typeof body.ttl !== "number" || !Number.isInteger(body.ttl) || body.ttl < 60 || body.ttl > BUNDLE_DOWNLOAD_MAX_TTL_SECONDS) {
+          return c.json({ success: false, error: "invalid_ttl" }, 400);
+        }
+        ttl = body.ttl;
+      }
+
+      const { token, claims: tokenClaims } = await createBundleDownloadToken(
+        { userId: claims.sub, name: claims.name, email: claims.email },
+        name,
+        claims.space,
+        jwksJson,
+        ttl,
+      );
+      const relayUrl = buildRelayUrl(config.server.base_url, reqCtx.baseUrl);
+
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.PORTAL_BUNDLE_URL,
+          domain: name,
+          space: claims.space,
+          userId: claims.sub,
+          userName: claims.name,
+          userEmail: claims.email,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "success",
+        }),
+      );
+
+      return c.json({
+        success: true,
+        url: `${relayUrl}/api/v1/portal/${encodeURIComponent(name)}/bundle/${token}`,
+        expires_at: new Date(tokenClaims.exp * 1000).toISOString(),
+      });
+    });
+
+    /**
+     * GET /api/v1/portal/:name/bundle/:token - Download a bundle with a one-time URL.
+     */
+    app.get("/api/v1/portal/:name/bundle/:token", async (c) => {
+      c.header("Cache-Control", "no-store");
+      const reqCtx = extractRequestContext(c);
+      const name = c.req.param("name");
+
+      const logError = (error: string, claims?: BundleDownloadClaims) =>
+        auditLogger.log(
+          createAuditEvent({
+            action: AuditActions.PORTAL_DOWNLOAD,
+            domain: name,
+            userId: claims?.sub,
+            userName: claims?.name,
+            userEmail: claims?.email,
+            clientIp: reqCtx.clientIp,
+            userAgent: reqCtx.userAgent,
+            result: "error",
+            error,
+          }),
+        );
+
+      const tenant = findTenant(name);
+      if (!tenant) {
+        logError("tenant not found");
+        return c.json({ success: false, error: "tenant not found" }, 404);
+      }
+
+      let claims: BundleDownloadClaims;
+      try {
+        claims = await verifyBundleDownloadToken(c.req.param("token"), jwksJson);
+      } catch (err) {
+        logError(`invalid download token: ${(err as Error).message}`);
+        return c.json({ success: false, error: "invalid_download_url" }, 401);
+      }
+      if (claims.tenant !== tenant.name) {
+        logError("download token tenant mismatch", claims);
+        return c.json({ success: false, error: "invalid_download_url" }, 401);
+      }
+      if (!(await consumeBundleDownloadToken(downloadTokenStore, claims))) {
+        logError("download URL already used", claims);
+        return c.json({ success: false, error: "download_url_used" }, 410);
+      }
+
+      const relayUrl = buildRelayUrl(config.server.base_url, reqCtx.baseUrl);
+      try {
+        const bundleData = await createBundle(tenant, name, relayUrl, {
+          user_id: claims.sub,
+          name: claims.name,
+          email: claims.email,
+        });
+
+        auditLogger.log(
+          createAuditEvent({
+            action: AuditActions.PORTAL_DOWNLOAD,
+            domain: name,
+            space: claims.space,
+            userId: claims.sub,
+            userName: claims.name,
+            userEmail: claims.email,
+            clientIp: reqCtx.clientIp,
+            userAgent: reqCtx.userAgent,
+            result: "success",
+          }),
+        );
+        return bundleResponse(name, bundleData);
+      } catch (err) {
+        logError((err as Error).message, claims);
+        return c.json({ success: false, error: "failed to create bundle" }, 500);
+      }
+    });
+  }
+
   /**
    * POST /api/v1/portal/:name/provision - Generate a provisioning key for CLI setup.
    * Supports: session cookie, Bearer token, or passphrase.
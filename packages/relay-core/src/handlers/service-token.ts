@@ -0,0 +1,312 @@
+/**
+ * Service token handlers.
+ *
+ * Long-lived tokens for CI and bots, so that API keys do not have to be shared.
+ *
+ * 1. A portal user (typically logged in as a dedicated bot account) requests a
+ *    token. The Backlog refresh token of the portal session is handed over to
+ *    the request and the portal session is ended.
+ * 2. A recently authenticated Backlog admin of the tenant approves the request
+ *    in the portal admin page. The token is shown to the admin only once.
+ * 3. The CLI exchanges the token at POST /auth/token (grant_type=service_token)
+ *    for a short-lived access token.
+ */
+
+import { Hono } from "hono";
+import { getCookie, setCookie } from "hono/cookie";
+import type { RelayConfig, AuditLogger } from "../config/types.js";
+import type { ServiceTokenStore } from "../admin/types.js";
+import { AuditActions, createAuditEvent } from "../middleware/audit.js";
+import { extractRequestContext } from "../utils/request.js";
+import { verifyPortalSessionToken, decryptRefreshToken, type PortalSessionClaims } from "../utils/portal-session.js";
+import {
+    generateServiceTokenId,
+    issueServiceTokenSecret,
+    toPublicServiceToken,
+    SERVICE_TOKEN_DEFAULT_VALIDITY_DAYS,
+    SERVICE_TOKEN_MAX_VALIDITY_DAYS,
+} from "../utils/service-token.js";
+import { verifyAdminSession } from "./portal-admin.js";
+
+const SESSION_COOKIE = "portal_session";
+const REFRESH_COOKIE = "portal_refresh";
+const MAX_NAME_LENGTH = 64;
+
+export function createServiceTokenHandlers(
+    config: RelayConfig,
+    auditLogger: AuditLogger,
+    store: ServiceTokenStore,
+): Hono {
+    const app = new Hono();
+    const jwksJson = config.jwks;
+
+    if (!jwksJson) {
+        return app;
+    }
+
+    app.use("/api/v1/portal/:name/service-tokens", async (c, next) => {
+        c.header("Cache-Control", "no-store");
+        await next();
+    });
+    app.use("/api/v1/portal/:name/admin/service-tokens/*", async (c, next) => {
+        c.header("Cache-Control", "no-store");
+        await next();
+    });
+    app.use("/api/v1/portal/:name/admin/service-tokens", async (c, next) => {
+        c.header("Cache-Control", "no-store");
+        await next();
+    });
+
+    /**
+     * POST /api/v1/portal/:name/service-tokens - Request a service token.
+     * Requires a portal OAuth session. The session's refresh token is handed
+     * over to the request, so the portal session is ended on success.
+     */
+    app.post("/api/v1/portal/:name/service-tokens", async (c) => {
+        const reqCtx = extractRequestContext(c);
+        const tenantName = c.req.param("name");
+
+        const sessionCookie = getCookie(c, SESSION_COOKIE);
+        const refreshCookie = getCookie(c, REFRESH_COOKIE);
+        if (!sessionCookie || !refreshCookie) {
+            return c.json({ error: "authentication_required" }, 401);
+        }
+
+        let claims: PortalSessionClaims;
+        let refresh: { space: string; tenant: string };
+        try {
+            claims = await verifyPortalSessionToken(sessionCookie, jwksJson);
+            refresh = await decryptRefreshToken(refreshCookie, jwksJson);
+        } catch {
+            return c.json({ error: "authentication_required" }, 401);
+        }
+        if (claims.tenant !== tenantName || refresh.tenant !== tenantName || refresh.space !== claims.space) {
+            return c.json({ error: "forbidden" }, 403);
+        }
+
+        let body: { name?: unknown; validity_days?: unknown };
+        try {
+            body = await c.req.json();
+        } catch {
+            return c.json({ error: "invalid_request" }, 400);
+        }
+        if (typeof body.name !== "string" || body.name.trim() === "" || body.name.length > MAX_NAME_LENGTH) {
+            return c.json({ error: "invalid_name" }, 400);
+        }
+        let validityDays = SERVICE_TOKEN_DEFAULT_VALIDITY_DAYS;
+        if (body.validity_days !== undefined) {
+            if (
+                typeof body.validity_days !== "number" ||
+                !Number.isInteger(body.validity_days) ||
+                body.validity_days < 1 ||
+                body.validity_days > SERVICE_TOKEN_MAX_VALIDITY_DAYS
+            ) {
+                return c.json({ error: "invalid_validity_days" }, 400);
+            }
+            validityDays = body.validity_days;
+        }
+
+        const record = {
+            id: generateServiceTokenId(),
+            tenant: tenantName,
+            space: claims.space,
+            name: body.name.trim(),
+            status: "pending" as const,
+            userId: claims.sub,
+            userName: claims.name,
+            userEmail: claims.email,
+            encryptedRefreshToken: refreshCookie,
+            validityDays,
+            requestedAt: new Date().toISOString(),
+        };
+
+        try {
+            await store.put(record);
+        } catch (err) {
+            auditLogger.log(
+                createAuditEvent({
+                    action: AuditActions.SERVICE_TOKEN_REQUEST,
+                    domain: tenantName,
+                    space: claims.space,
+                    userId: claims.sub,
+                    userEmail: claims.email,
+                    clientIp: reqCtx.clientIp,
+                    userAgent: reqCtx.userAgent,
+                    result: "error",
+                    error: (err as Error).message,
+                }),
+            );
+            return c.json({ error: "failed" }, 500);
+        }
+
+        auditLogger.log(
+            createAuditEvent({
+                action: AuditActions.SERVICE_TOKEN_REQUEST,
+                domain: tenantName,
+                space: claims.space,
+                userId: claims.sub,
+                userName: claims.name,
+                userEmail: claims.email,
+                clientIp: reqCtx.clientIp,
+                userAgent: reqCtx.userAgent,
+                result: "success",
+            }),
+        );
+
+        // The refresh token now belongs to the service token; end the portal session
+        setCookie(c, SESSION_COOKIE, "", { maxAge: 0, path: "/" });
+        setCookie(c, REFRESH_COOKIE, "", { maxAge: 0, path: "/" });
+
+        return c.json({ ...toPublicServiceToken(record), logged_out: true }, 201);
+    });
+
+    /**
+     * GET /api/v1/portal/:name/admin/service-tokens - List service tokens of the tenant.
+     */
+    app.get("/api/v1/portal/:name/admin/service-tokens", async (c) => {
+        const result = await verifyAdminSession(c, jwksJson, getCookie(c, SESSION_COOKIE));
+        if (result instanceof Response) return result;
+
+        try {
+            const records = await store.list(result.tenantName);
+            records.sort((a, b) => b.requestedAt.localeCompare(a.requestedAt));
+            return c.json({ tokens: records.map(toPublicServiceToken) });
+        } catch {
+            return c.json({ error: "failed" }, 500);
+        }
+    });
+
+    /**
+     * POST /api/v1/portal/:name/admin/service-tokens/:id/approve - Approve a request and issue the token.
+     * The token is returned only in this response.
+     */
+    app.post("/api/v1/portal/:name/admin/service-tokens/:id/approve", async (c) => {
+        const reqCtx = extractRequestContext(c);
+        const result = await verifyAdminSession(c, jwksJson, getCookie(c, SESSION_COOKIE));
+        if (result instanceof Response) return result;
+        const { claims, tenantName } = result;
+
+        const record = await store.get(c.req.param("id"));
+        if (!record || record.tenant !== tenantName) {
+            return c.json({ error: "not_found" }, 404);
+        }
+        // Admins can only approve tokens acting on the space they administer
+        if (record.space !== claims.space) {
+            return c.json({ error: "admin_required" }, 403);
+        }
+        if (record.status !== "pending") {
+            return c.json({ error: "not_pending" }, 409);
+        }
+
+        const { token, secretHash } = await issueServiceTokenSecret(record.id);
+        const now = new Date();
+        const approved = {
+            ...record,
+            status: "active" as const,
+            secretHash,
+            approvedAt: now.toISOString(),
+            approvedBy: claims.email || claims.sub,
+            expiresAt: new Date(now.getTime() + record.validityDays * 24 * 3600 * 1000).toISOString(),
+        };
+
+        try {
+            await store.put(approved);
+        } catch (err) {
+            auditLogger.log(
+                createAuditEvent({
+                    action: AuditActions.ADMIN_SERVICE_TOKEN_APPROVE,
+                    domain: tenantName,
+                    space: record.space,
+                    userId: claims.sub,
+                    userEmail: claims.email,
+                    clientIp: reqCtx.clientIp,
+                    userAgent: reqCtx.userAgent,
+                    result: "error",
+                    error: (err as Error).message,
+                }),
+            );
+            return c.json({ error: "failed" }, 500);
+        }
+
+        auditLogger.log(
+            createAuditEvent({
+                action: AuditActions.ADMIN_SERVICE_TOKEN_APPROVE,
+                domain: tenantName,
+                space: record.space,
+                userId: claims.sub,
+                userName: claims.name,
+                userEmail: claims.email,
+                clientIp: reqCtx.clientIp,
+                userAgent: reqCtx.userAgent,
+                result: "success",
+            }),
+        );
+
+        return c.json({ ...toPublicServiceToken(approved), token });
+    });
+
+    /**
+     * POST /api/v1/portal/:name/admin/service-tokens/:id/revoke - Revoke a request or token.
+     */
+    app.post("/api/v1/portal/:name/admin/service-tokens/:id/revoke", async (c) => {
+        const reqCtx = extractRequestContext(c);
+        const result = await verifyAdminSession(c, jwksJson, getCookie(c, SESSION_COOKIE));
+        if (result instanceof Response) return result;
+        const { claims, tenantName } = result;
+
+        const record = await store.get(c.req.param("id"));
+        if (!record || record.tenant !== tenantName) {
+            return c.json({ error: "not_found" }, 404);
+        }
+        if (record.space !== claims.space) {
+            return c.json({ error: "admin_required" }, 403);
+        }
+        if (record.status === "revoked") {
+            return c.json(toPublicServiceToken(record));
+        }
+
+        const revoked = {
+            ...record,
+            status: "revoked" as const,
+            revokedAt: new Date().toISOString(),
+            revokedBy: claims.email || claims.sub,
+        };
+
+        try {
+            await store.put(revoked);
+        } catch (err) {
+            auditLogger.log(
+                createAuditEvent({
+                    action: AuditActions.ADMIN_SERVICE_TOKEN_REVOKE,
+                    domain: tenantName,
+                    space: record.space,
+                    userId: claims.sub,
+                    userEmail: claims.email,
+                    clientIp: reqCtx.clientIp,
+                    userAgent: reqCtx.userAgent,
+                    result: "error",
+                    error: (err as Error).message,
+                }),
+            );
+            return c.json({ error: "failed" }, 500);
+        }
+
+        auditLogger.log(
+            createAuditEvent({
+                action: AuditActions.ADMIN_SERVICE_TOKEN_REVOKE,
+                domain: tenantName,
+                space: record.space,
+                userId: claims.sub,
+                userName: claims.name,
+                userEmail: claims.email,
+                clientIp: reqCtx.clientIp,
+                userAgent: reqCtx.userAgent,
+                result: "success",
+            }),
+        );
+
+        return c.json(toPublicServiceToken(revoked));
+    });
+
+    return app;
+}
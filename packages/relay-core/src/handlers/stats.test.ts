@@ -0,0 +1,94 @@
+import { describe, it, expect } from "vitest";
+import { createHash } from "node:crypto";
+import { aggregateTokenStats, createStatsHandlers } from "./stats.js";
+import { NoopAuditLogger } from "../middleware/audit.js";
+import type { RelayConfig } from "../config/types.js";
+import type { AuditLogEntry, AuditLogQuery, AuditLogReader } from "../admin/types.js";
+
+const ADMIN_TOKEN = "admin-secret";
+const NOW = new Date("2026-10-16T00:00:00Z");
+
+function makeConfig(): RelayConfig {
+  return {
+    backlog_app: { client_id: "test-client", client_secret: "test-secret" },
+    server: { port: 8787 },
+    tenants: [
+      {
+        name: "acme",
+        default_space: "acme.backlog.jp",
+        admin_token_hash: createHash("sha256").update(ADMIN_TOKEN).digest("hex"),
+      },
+      { name: "noadmin" },
+    ],
+  } as unknown as RelayConfig;
+}
+
+const entries: AuditLogEntry[] = [
+  { timestamp: "2026-10-14T00:00:00Z", action: "token_exchange", result: "success", userId: "1", userName: "alice", space: "acme.backlog.jp" },
+  { timestamp: "2026-10-15T00:00:00Z", action: "token_refresh", result: "success", userId: "1", userName: "alice", space: "acme.backlog.jp" },
+  { timestamp: "2026-10-15T12:00:00Z", action: "token_exchange", result: "success", userId: "2", userName: "bob", space: "acme.backlog.jp" },
+  { timestamp: "2026-10-15T13:00:00Z", action: "token_exchange", result: "success", userId: "3", userName: "carol", space: "other.backlog.jp" },
+];
+
+class FakeReader implements AuditLogReader {
+  queries: AuditLogQuery[] = [];
+  async query(params: AuditLogQuery): Promise<AuditLogEntry[]> {
+    this.queries.push(params);
+    return entries.filter((e) => e.action === params.action);
+  }
+}
+
+describe("aggregateTokenStats", () => {
+  it("counts tokens and last use per user within the space", () => {
+    const stats = aggregateTokenStats(entries, "acme", "acme.backlog.jp", new Date(0), NOW);
+    expect(stats.token_issued).toBe(3);
+    expect(stats.token_exchanges).toBe(2);
+    expect(stats.token_refreshes).toBe(1);
+    expect(stats.active_users).toBe(2);
+    expect(stats.users[0]).toMatchObject({ user_id: "2", token_count: 1 });
+    expect(stats.users[1]).toMatchObject({ user_id: "1", token_count: 2, last_used_at: "2026-10-15T00:00:00Z" });
+  });
+});
+
+describe("GET /v1/relay/tenants/:name/stats", () => {
+  it("requires a valid admin token", async () => {
+    const app = createStatsHandlers(makeConfig(), new NoopAuditLogger(), new FakeReader(), () => NOW);
+
+    expect((await app.request("/v1/relay/tenants/acme/stats")).status).toBe(401);
+    const wrong = await app.request("/v1/relay/tenants/acme/stats", {
+      headers: { Authorization: "Bearer wrong" },
+    });
+    expect(wrong.status).toBe(401);
+    const noHash = await app.request("/v1/relay/tenants/noadmin/stats", {
+      headers: { Authorization: `Bearer ${ADMIN_TOKEN}` },
+    });
+    expect(noHash.status).toBe(401);
+    const missing = await app.request("/v1/relay/tenants/missing/stats", {
+      headers: { Authorization: `Bearer ${ADMIN_TOKEN}` },
+    });
+    expect(missing.status).toBe(404);
+  });
+
+  it("returns stats for the tenant default space", async () => {
+    const reader = new FakeReader();
+    const app = createStatsHandlers(makeConfig(), new NoopAuditLogger(), reader, () => NOW);
+
+    const res = await app.request("/v1/relay/tenants/acme/stats?hours=72", {
+      headers: { Authorization: `Bearer ${ADMIN_TOKEN}` },
+    });
+    expect(res.status).toBe(200);
+    const body = await res.json();
+    expect(body.space).toBe("acme.backlog.jp");
+    expect(body.active_users).toBe(2);
+    expect(body.start_time).toBe("2026-10-13T00:00:00.000Z");
+    expect(reader.queries).toHaveLength(2);
+  });
+
+  it("rejects an invalid window", async () => {
+    const app = createStatsHandlers(makeConfig(), new NoopAuditLogger(), new FakeReader(), () => NOW);
+    const res = await app.request("/v1/relay/tenants/acme/stats?hours=0", {
+      headers: { Authorization: `Bearer ${ADMIN_TOKEN}` },
+    });
+    expect(res.status).toBe(400);
+  });
+});
@@ -0,0 +1,253 @@
+/**
+ * Tenant token usage stats handlers.
+ *
+ * Aggregates token exchange/refresh audit events into a usage summary for
+ * tenant administrators. Requires an admin token whose SHA-256 digest is
+ * configured as `tenants[].admin_token_hash`.
+ */
+
+import { Hono } from "hono";
+import type { RelayConfig, AuditLogger, TenantConfig } from "../config/types.js";
+import type { AuditLogEntry, AuditLogReader } from "../admin/types.js";
+import { AuditActions, createAuditEvent } from "../middleware/audit.js";
+import { extractRequestContext } from "../utils/request.js";
+
+const DEFAULT_WINDOW_HOURS = 24 * 7;
+const MAX_WINDOW_HOURS = 24 * 90;
+const QUERY_LIMIT = 10000;
+
+/**
+ * Per-user usage in the stats response.
+ */
+export interface UserTokenStats {
+  user_id?: string;
+  user_name?: string;
+  user_email?: string;
+  space?: string;
+  token_count: number;
+  last_used_at: string;
+}
+
+/**
+ * Stats response body.
+ */
+export interface TenantTokenStats {
+  tenant: string;
+  space?: string;
+  start_time: string;
+  end_time: string;
+  token_issued: number;
+  token_exchanges: number;
+  token_refreshes: number;
+  active_users: number;
+  users: UserTokenStats[];
+}
+
+/**
+ * Find tenant by name.
+ */
+function findTenant(
+  tenants: TenantConfig[] | undefined,
+  name: string
+): TenantConfig | undefined {
+  return tenants?.find(
+    (t) => t.name.toLowerCase() === name.toLowerCase()
+  );
+}
+
+async function sha256Hex(value: string): Promise<string> {
+  const digest = await crypto.subtle.digest("SHA-256", new TextEncoder().encode(value));
+  return Array.from(new Uint8Array(digest))
+    .map((b) => b.toString(16).padStart(2, "0"))
+    .join("");
+}
+
+function timingSafeEqual(a: string, b: string): boolean {
+  if (a.length !== b.length) return false;
+  let diff = 0;
+  for (let i = 0; i < a.length; i++) {
+    diff |= a.charCodeAt(i) ^ b.charCodeAt(i);
+  }
+  return diff === 0;
+}
+
+/**
+ * Verify the admin token against the tenant's configured hash.
+ */
+export async function verifyAdminToken(
+  tenant: TenantConfig,
+  token: string | undefined
+): Promise<boolean> {
+  if (!tenant.admin_token_hash || !token) return false;
+  const hash = await sha256Hex(token);
+  return timingSafeEqual(hash, tenant.admin_token_hash.toLowerCase());
+}
+
+/**
+ * Aggregate token audit entries into usage stats.
+ */
+export function aggregateTokenStats(
+  entries: AuditLogEntry[],
+  tenant: string,
+  space: string | undefined,
+  startTime: Date,
+  endTime: Date
+): TenantTokenStats {
+  const users = new Map<string, UserTokenStats>();
+  let exchanges = 0;
+  let refreshes = 0;
+
+  for (const entry of entries) {
+    if (entry.result !== "success") continue;
+    if (space && entry.space?.toLowerCase() !== space.toLowerCase()) continue;
+    if (entry.action === AuditActions.TOKEN_EXCHANGE) {
+      exchanges++;
+    } else if (entry.action === AuditActions.TOKEN_REFRESH) {
+      refreshes++;
+    } else {
+      continue;
+    }
+
+    const key = entry.userId ?? entry.userEmail;
+    if (!key) continue;
+    const userKey = `${entry.space ?? ""}\u0000${key}`;
+    const existing = users.get(userKey);
+    if (!existing) {
+      users.set(userKey, {
+        user_id: entry.userId,
+        user_name: entry.userName,
+        user_email: entry.userEmail,
+        space: entry.space,
+        token_count: 1,
+        last_used_at: entry.timestamp,
+      });
+      continue;
+    }
+    existing.token_count++;
+    if (Date.parse(entry.timestamp) > Date.parse(existing.last_used_at)) {
+      existing.last_used_at = entry.timestamp;
+      existing.user_name = entry.userName ?? existing.user_name;
+      existing.user_email = entry.userEmail ?? existing.user_email;
+    }
+  }
+
+  const userList = [...users.values()].sort(
+    (a, b) => Date.parse(b.last_used_at) - Date.parse(a.last_used_at)
+  );
+
+  return {
+    tenant,
+    space,
+    start_time: startTime.toISOString(),
+    end_time: endTime.toISOString(),
+    token_issued: exchanges + refreshes,
+    token_exchanges: exchanges,
+    token_refreshes: refreshes,
+    active_users: userList.length,
+    users: userList,
+  };
+}
+
+/**
+ * Create tenant stats handlers.
+ */
+export function createStatsHandlers(
+  config: RelayConfig,
+  auditLogger: AuditLogger,
+  auditLogReader: AuditLogReader,
+  now: () => Date = () => new Date()
+): Hono {
+  const app = new Hono();
+
+  /**
+   * GET /v1/relay/tenants/:name/stats - Token usage stats (admin token required).
+   *
+   * Query parameters:
+   * - hours: aggregation window in hours (default: 168, max: 2160)
+   * - space: restrict to a Backlog space host (default: tenant default_space)
+   */
+  app.get("/v1/relay/tenants/:name/stats", async (c) => {
+    c.header("Cache-Control", "no-store");
+    const reqCtx = extractRequestContext(c);
+    const name = c.req.param("name")?.trim();
+    if (!name) {
+      return c.json({ error: "invalid_request", error_description: "name is required" }, 400);
+    }
+
+    const tenant = findTenant(config.tenants, name);
+    if (!tenant) {
+      return c.json({ error: "not_found", error_description: "tenant not found" }, 404);
+    }
+
+    const authHeader = c.req.header("Authorization");
+    const token = authHeader?.startsWith("Bearer ") ? authHeader.slice(7).trim() : undefined;
+    if (!(await verifyAdminToken(tenant, token))) {
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.ADMIN_STATS_QUERY,
+          domain: tenant.name,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "error",
+          error: tenant.admin_token_hash ? "invalid admin token" : "admin token not configured",
+        })
+      );
+      return c.json({ error: "unauthorized", error_description: "valid admin token is required" }, 401);
+    }
+
+    const hoursParam = c.req.query("hours");
+    const hours = hoursParam ? Number(hoursParam) : DEFAULT_WINDOW_HOURS;
+    if (!Number.isFinite(hours) || hours <= 0 || hours > MAX_WINDOW_HOURS) {
+      return c.json(
+        { error: "invalid_request", error_description: `hours must be between 1 and ${MAX_WINDOW_HOURS}` },
+        400
+      );
+    }
+    const space = c.req.query("space") || tenant.default_space || undefined;
+
+    const endTime = now();
+    const startTime = new Date(endTime.getTime() - hours * 3600 * 1000);
+
+    try {
+      const entries: AuditLogEntry[] = [];
+      for (const action of [AuditActions.TOKEN_EXCHANGE, AuditActions.TOKEN_REFRESH]) {
+        entries.push(
+          ...(await auditLogReader.query({
+            startTime,
+            endTime,
+            action,
+            result: "success",
+            limit: QUERY_LIMIT,
+          }))
+        );
+      }
+
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.ADMIN_STATS_QUERY,
+          domain: tenant.name,
+          space,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "success",
+        })
+      );
+
+      return c.json(aggregateTokenStats(entries, tenant.name, space, startTime, endTime));
+    } catch (err) {
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.ADMIN_STATS_QUERY,
+          domain: tenant.name,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "error",
+          error: (err as Error).message,
+        })
+      );
+      return c.json({ error: "query_failed" }, 500);
+    }
+  });
+
+  return app;
+}
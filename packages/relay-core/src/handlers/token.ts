@@ -11,6 +11,14 @@ import type {
 import { AuditActions, createAuditEvent } from "../middleware/audit.js";
 import { extractRequestContext } from "../utils/request.js";
 import { extractSessionId } from "../utils/state.js";
+import type { CliSessionRecord, CliSessionStore, ServiceTokenStore } from "../admin/types.js";
+import { decryptRefreshToken, encryptRefreshToken } from "../utils/portal-session.js";
+import {
+  parseServiceToken,
+  verifyServiceTokenSecret,
+  serviceTokenUnusableReason,
+} from "../utils/service-token.js";
+import { generateCliSessionId, hashRefreshToken } from "../utils/cli-session.js";
 
 /**
  * Token request body.
@@ -19,9 +27,12 @@ interface TokenRequest {
   grant_type: string;
   code?: string;
   refresh_token?: string;
+  service_token?: string;
   space: string;
   domain?: string;
   state?: string;
+  /** Device name of the CLI (recorded with the login session) */
+  device?: string;
 }
 
 /**
@@ -42,6 +53,9 @@ interface ErrorResponse {
   error_description?: string;
 }
 
+/** Maximum length of the device name recorded with a CLI session */
+const MAX_DEVICE_LENGTH = 64;
+
 /**
  * Normalize space to a full host (e.g., "myspace.backlog.jp").
  * If space already contains a dot, it's the full host.
@@ -58,7 +72,9 @@ function normalizeSpace(space: string, domain?: string): string {
  */
 export function createTokenHandlers(
   config: RelayConfig,
-  auditLogger: AuditLogger
+  auditLogger: AuditLogger,
+  serviceTokenStore?: ServiceTokenStore,
+  cliSessionStore?: CliSessionStore
 ): Hono {
   const app = new Hono();
 
@@ -189,7 +205,7 @@ export function createTokenHandlers(
   async function fetchCurrentUser(
     spaceHost: string,
     accessToken: string
-  ): Promise<{ userId: string; name: string; mailAddress: string } | null> {
+  ): Promise<{ id: number; userId: string; name: string; mailAddress: string } | null> {
     try {
       const url = `https://${spaceHost}/api/v2/users/myself`;
       const response = await fetch(url, {
@@ -203,11 +219,13 @@ export function createTokenHandlers(
       }
 
       const data = (await response.json()) as {
+        id?: number;
         userId?: string;
         name?: string;
         mailAddress?: string;
       };
       return {
+        id: data.id ?? 0,
         userId: data.userId ?? "",
         name: data.name ?? "",
         mailAddress: data.mailAddress ?? "",
@@ -218,7 +236,174 @@ export function createTokenHandlers(
   }
 
   /**
-   * POST /auth/token - Exchange code or refresh token.
+   * Record a successful code exchange or refresh in the CLI session store.
+   * Refreshes of logins made before sessions were tracked start a new session.
+   * Returns the session ID, or undefined when sessions are not tracked.
+   */
+  async function recordCliSession(
+    c: Context,
+    spaceHost: string,
+    existing: CliSessionRecord | undefined,
+    user: { id: number; name: string; mailAddress: string } | null,
+    token: TokenResponse,
+    device?: string
+  ): Promise<string | undefined> {
+    if (!cliSessionStore) {
+      return undefined;
+    }
+    const reqCtx = extractRequestContext(c);
+    const now = new Date().toISOString();
+    let record: CliSessionRecord;
+    if (existing) {
+      record = { ...existing };
+    } else if (user?.id) {
+      record = {
+        id: generateCliSessionId(),
+        space: spaceHost,
+        userId: user.id,
+        userName: user.name,
+        userEmail: user.mailAddress || undefined,
+        device: typeof device === "string" && device ? device.slice(0, MAX_DEVICE_LENGTH) : undefined,
+        refreshTokenHash: "",
+        createdAt: now,
+        lastUsedAt: now,
+      };
+    } else {
+      // The owner is unknown, so the session could not be listed by anyone
+      return undefined;
+    }
+    record.refreshTokenHash = await hashRefreshToken(token.refresh_token);
+    record.lastUsedAt = now;
+    record.lastIp = reqCtx.clientIp;
+    record.userAgent = reqCtx.userAgent;
+    try {
+      await cliSessionStore.put(record);
+    } catch (err) {
+      // Backlog has already rotated the refresh token, so the new token must
+      // reach the CLI even if the session cannot be recorded
+      console.error("[recordCliSession] Failed to record CLI session:", (err as Error).message);
+      return undefined;
+    }
+    return record.id;
+  }
+
+  // Exchanges of the same service token are serialized so that concurrent
+  // CI jobs do not race on the rotating Backlog refresh token
+  const serviceTokenInflight = new Map<string, Promise<TokenRequestResult>>();
+
+  /**
+   * Exchange a service token for a short-lived access token.
+   * The Backlog refresh token stored with the service token is rotated and
+   * never returned to the client.
+   */
+  async function exchangeServiceToken(
+    c: Context,
+    spaceHost: string,
+    token: string
+  ): Promise<Response> {
+    const reqCtx = extractRequestContext(c);
+    const jwksJson = config.jwks;
+    if (!serviceTokenStore || !jwksJson) {
+      return writeError(c, 400, "unsupported_grant_type", "Service tokens are not enabled on this relay");
+    }
+
+    const parsed = parseServiceToken(token);
+    const record = parsed ? await serviceTokenStore.get(parsed.id) : undefined;
+    let unusable: string | undefined;
+    if (!parsed || !record) {
+      unusable = "invalid_token";
+    } else {
+      unusable = serviceTokenUnusableReason(record);
+      if (!unusable && !(await verifyServiceTokenSecret(parsed.secret, record.secretHash ?? ""))) {
+        unusable = "invalid_token";
+      }
+      if (!unusable && record.space !== spaceHost) {
+        unusable = "space_mismatch";
+      }
+    }
+    if (unusable || !parsed || !record) {
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.SERVICE_TOKEN_EXCHANGE,
+          space: spaceHost,
+          userId: record?.userId,
+          userName: record?.userName,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "error",
+          error: unusable,
+        })
+      );
+      return writeError(c, 400, "invalid_grant", unusable);
+    }
+    const id = parsed.id;
+
+    let pending = serviceTokenInflight.get(id);
+    if (!pending) {
+      pending = (async () => {
+        // Re-read inside the critical section to pick up the latest refresh token
+        const latest = (await serviceTokenStore.get(id)) ?? record;
+        const { refreshToken: rt, tenant } = await decryptRefreshToken(latest.encryptedRefreshToken, jwksJson);
+        const result = await refreshToken(spaceHost, rt);
+        await serviceTokenStore.put({
+          ...latest,
+          encryptedRefreshToken: await encryptRefreshToken(result.token.refresh_token, spaceHost, tenant, jwksJson),
+          lastUsedAt: new Date().toISOString(),
+        });
+        return result;
+      })().finally(() => serviceTokenInflight.delete(id));
+      serviceTokenInflight.set(id, pending);
+    }
+
+    let result: TokenRequestResult;
+    try {
+      result = await pending;
+    } catch (err) {
+      const isClientError = err instanceof UpstreamError && err.statusCode >= 400 && err.statusCode < 500;
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.SERVICE_TOKEN_EXCHANGE,
+          space: spaceHost,
+          userId: record.userId,
+          userName: record.userName,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "error",
+          error: (err as Error).message,
+        })
+      );
+      if (isClientError) {
+        // The Backlog refresh token is no longer valid (e.g. the bot account was removed)
+        return writeError(c, 400, "invalid_grant", "Backlog authorization of the service token is no longer valid");
+      }
+      return writeError(c, 502, "upstream_error", (err as Error).message);
+    }
+
+    auditLogger.log(
+      createAuditEvent({
+        action: AuditActions.SERVICE_TOKEN_EXCHANGE,
+        space: spaceHost,
+        userId: record.userId,
+        userName: record.userName,
+        userEmail: record.userEmail,
+        clientIp: reqCtx.clientIp,
+        userAgent: reqCtx.userAgent,
+        result: "success",
+        durationMs: result.durationMs,
+      })
+    );
+
+    c.header("Cache-Control", "no-store");
+    c.header("Pragma", "no-cache");
+    return c.json({
+      access_token: result.token.access_token,
+      token_type: result.token.token_type,
+      expires_in: result.token.expires_in,
+    });
+  }
+
+  /**
+   * POST /auth/token - Exchange code, refresh token or service token.
    */
   app.post("/auth/token", async (c) => {
     const reqCtx = extractRequestContext(c);
@@ -243,8 +428,21 @@ export function createTokenHandlers(
     // Normalize space: if it doesn't contain a dot but domain is provided, combine them
     const spaceHost = normalizeSpace(req.space, req.domain);
 
+    if (req.grant_type === "service_token") {
+      if (!req.service_token) {
+        return writeError(
+          c,
+          400,
+          "invalid_request",
+          "service_token is required for service_token grant"
+        );
+      }
+      return exchangeServiceToken(c, spaceHost, req.service_token);
+    }
+
     let result: TokenRequestResult;
     let auditAction: string;
+    let cliSession: CliSessionRecord | undefined;
 
     try {
       switch (req.grant_type) {
@@ -271,6 +469,31 @@ export function createTokenHandlers(
               "refresh_token is required for refresh_token grant"
             );
           }
+          if (cliSessionStore) {
+            cliSession = await cliSessionStore.findByRefreshToken(
+              await hashRefreshToken(req.refresh_token)
+            );
+            if (cliSession?.revokedAt) {
+              auditLogger.log(
+                createAuditEvent({
+                  action: auditAction,
+                  userName: cliSession.userName,
+                  userEmail: cliSession.userEmail,
+                  space: spaceHost,
+                  clientIp: reqCtx.clientIp,
+                  userAgent: reqCtx.userAgent,
+                  result: "error",
+                  error: `session ${cliSession.id} revoked`,
+                })
+              );
+              return writeError(
+                c,
+                400,
+                "invalid_grant",
+                "This login session has been revoked. Run 'backlog auth login' again."
+              );
+            }
+          }
           result = await refreshToken(
             spaceHost,
             req.refresh_token
@@ -282,7 +505,7 @@ export function createTokenHandlers(
             c,
             400,
             "unsupported_grant_type",
-            "Supported: authorization_code, refresh_token"
+            "Supported: authorization_code, refresh_token, service_token"
           );
       }
     } catch (err) {
@@ -335,11 +558,22 @@ export function createTokenHandlers(
       })
     );
 
+    const cliSessionId = await recordCliSession(
+      c,
+      spaceHost,
+      cliSession,
+      user,
+      result.token,
+      req.device
+    );
+
     // Set cache headers
     c.header("Cache-Control", "no-store");
     c.header("Pragma", "no-cache");
 
-    return c.json(result.token);
+    return c.json(
+      cliSessionId ? { ...result.token, session_id: cliSessionId } : result.token
+    );
   });
 
   return app;
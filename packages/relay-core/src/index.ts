@@ -7,9 +7,11 @@
  */
 
 import { Hono } from "hono";
-import type { RelayConfig, AuditLogger, TenantConfig } from "./config/types.js";
+import type { RelayConfig, AuditLogger, TenantConfig, CacheProvider } from "./config/types.js";
 import type { IssuedByInfo } from "./utils/bundle.js";
 import { ConsoleAuditLogger } from "./middleware/audit.js";
+import { createRequestSignatureMiddleware } from "./middleware/request-signature.js";
+import { createClientAccessMiddleware } from "./middleware/client-access.js";
 import { createAuthHandlers } from "./handlers/auth.js";
 import { createTokenHandlers } from "./handlers/token.js";
 import { createWellKnownHandlers } from "./handlers/wellknown.js";
@@ -21,7 +23,10 @@ import { createInstallHandlers } from "./handlers/install.js";
 import { createPortalAuthHandlers, handlePortalCallback as handlePortalCallbackImpl } from "./handlers/portal-auth.js";
 import type { PortalCallbackHandler } from "./handlers/portal-auth.js";
 import { createPortalAdminHandlers } from "./handlers/portal-admin.js";
-import type { AuditLogReader, PassphraseManager } from "./admin/types.js";
+import { createStatsHandlers } from "./handlers/stats.js";
+import { createServiceTokenHandlers } from "./handlers/service-token.js";
+import { createCliSessionHandlers } from "./handlers/cli-session.js";
+import type { AuditLogReader, CliSessionStore, PassphraseManager, ServiceTokenStore } from "./admin/types.js";
 
 // Re-export types
 export type {
@@ -31,9 +36,11 @@ export type {
   TenantConfig,
   ServerConfig,
   ServerConfigInput,
+  ServerTLSConfig,
   AccessControlConfig,
   RateLimitConfig,
   CacheConfig,
+  RequestSignatureConfig,
   ConfigProvider,
   CacheProvider,
   AuditEvent,
@@ -48,6 +55,8 @@ export {
   BacklogAppConfigSchema,
   TenantConfigSchema,
   ServerConfigSchema,
+  ServerTLSConfigSchema,
+  RequestSignatureConfigSchema,
   DEFAULT_SERVER_PORT,
 } from "./config/schema.js";
 
@@ -63,6 +72,12 @@ export { deriveEncKey, seal, open, DecryptError } from "./utils/crypto.js";
 export type { TokenUse } from "./utils/crypto.js";
 export { createPortalSessionToken, verifyPortalSessionToken, encryptRefreshToken, decryptRefreshToken, refreshPortalSession } from "./utils/portal-session.js";
 export type { PortalSessionClaims, RefreshResult } from "./utils/portal-session.js";
+export { createBundleDownloadToken, verifyBundleDownloadToken, consumeBundleDownloadToken, MemoryCacheProvider } from "./utils/bundle-download.js";
+export type { BundleDownloadClaims } from "./utils/bundle-download.js";
+export { MemoryServiceTokenStore, parseServiceToken, toPublicServiceToken } from "./utils/service-token.js";
+export type { PublicServiceToken } from "./utils/service-token.js";
+export { MemoryCliSessionStore, toPublicCliSession } from "./utils/cli-session.js";
+export type { PublicCliSession } from "./utils/cli-session.js";
 
 // Re-export middleware
 export { AccessControl } from "./middleware/access-control.js";
@@ -73,8 +88,25 @@ export {
   AuditActions,
   createAuditEvent,
 } from "./middleware/audit.js";
+export {
+  createClientAccessMiddleware,
+  parseCidrs,
+  ipInCidrs,
+  resolveClientIp,
+  CLIENT_CERT_HEADER,
+  CLIENT_ADDR_HEADER,
+} from "./middleware/client-access.js";
+export type { ClientAccessOptions, ClientIpOptions } from "./middleware/client-access.js";
 export { createBundleAuthMiddleware } from "./middleware/bundle-auth.js";
 export type { BundleAuthOptions, BundleAuthTenantConfig } from "./middleware/bundle-auth.js";
+export {
+  createRequestSignatureMiddleware,
+  buildCanonicalString,
+  HEADER_KEY_ID,
+  HEADER_TIMESTAMP,
+  HEADER_SIGNATURE,
+} from "./middleware/request-signature.js";
+export type { RequestSignatureOptions } from "./middleware/request-signature.js";
 
 // Re-export handlers
 export { createAuthHandlers } from "./handlers/auth.js";
@@ -89,9 +121,24 @@ export { createInstallHandlers } from "./handlers/install.js";
 export { createPortalAuthHandlers, handlePortalCallback } from "./handlers/portal-auth.js";
 export type { PortalCallbackHandler } from "./handlers/portal-auth.js";
 export { createPortalAdminHandlers } from "./handlers/portal-admin.js";
+export { createStatsHandlers, aggregateTokenStats, verifyAdminToken } from "./handlers/stats.js";
+export type { TenantTokenStats, UserTokenStats } from "./handlers/stats.js";
+export { createServiceTokenHandlers } from "./handlers/service-token.js";
+export { createCliSessionHandlers } from "./handlers/cli-session.js";
 
 // Re-export admin types
-export type { AuditLogReader, AuditLogQuery, AuditLogEntry, PassphraseManager, PassphraseInfo } from "./admin/types.js";
+export type {
+  AuditLogReader,
+  AuditLogQuery,
+  AuditLogEntry,
+  PassphraseManager,
+  PassphraseInfo,
+  ServiceTokenStore,
+  ServiceTokenRecord,
+  ServiceTokenStatus,
+  CliSessionStore,
+  CliSessionRecord,
+} from "./admin/types.js";
 
 /**
  * Options for creating the relay app.
@@ -125,6 +172,34 @@ export interface CreateRelayAppOptions {
   auditLogReader?: AuditLogReader;
   /** Passphrase manager for admin passphrase management (pluggable) */
   passphraseManager?: PassphraseManager;
+  /**
+   * Cache used to enforce single use of bundle download URLs.
+   * Defaults to an in-memory cache (suitable for single-instance deployments).
+   */
+  cacheProvider?: CacheProvider;
+  /**
+   * Store for service tokens (long-lived tokens for CI and bots).
+   * Service tokens are enabled only when a store is provided and portal OAuth is enabled.
+   */
+  serviceTokenStore?: ServiceTokenStore;
+  /**
+   * Store for CLI login sessions. When provided, the relay tracks each login
+   * so that users can list and revoke them (`backlog auth sessions`).
+   */
+  cliSessionStore?: CliSessionStore;
+  /**
+   * Set by runtimes that terminate TLS themselves: they verify client
+   * certificates against `server.tls.client_ca`, strip CLIENT_CERT_HEADER from
+   * incoming requests and set it for verified clients. Otherwise the header
+   * is ignored and only `access_control.allowed_cidrs` grants access.
+   */
+  trustClientCertHeader?: boolean;
+  /**
+   * Set by runtimes that see the client connection: they strip
+   * CLIENT_ADDR_HEADER from incoming requests and set it to the socket
+   * address. Otherwise allowed_cidrs relies on `access_control.client_ip_header`.
+   */
+  trustClientAddrHeader?: boolean;
 }
 
 /**
@@ -139,9 +214,18 @@ export interface CreateRelayAppOptions {
  * - GET /v1/relay/tenants/:domain/certs - Get public JWKS
  * - GET /v1/relay/tenants/:domain/info - Get signed relay info
  * - GET /v1/relay/tenants/:domain/bundle - Download config bundle (no auth)
+ * - GET /v1/relay/tenants/:domain/stats - Token usage stats (admin token, optional)
  * - GET /install.sh - Install script with relay URL injected
  * - POST /portal/verify - Verify portal passphrase (optional)
  * - POST /portal/bundle/:domain - Download config bundle with auth (optional)
+ * - POST /api/v1/portal/:name/admin/bundle-url - Issue a one-time bundle download URL (admin, optional)
+ * - GET /api/v1/portal/:name/bundle/:token - Download config bundle with a one-time URL (optional)
+ * - POST /api/v1/portal/:name/service-tokens - Request a service token (optional)
+ * - GET /api/v1/portal/:name/admin/service-tokens - List service tokens (admin, optional)
+ * - POST /api/v1/portal/:name/admin/service-tokens/:id/approve - Approve and issue a service token (admin, optional)
+ * - POST /api/v1/portal/:name/admin/service-tokens/:id/revoke - Revoke a service token (admin, optional)
+ * - GET /auth/sessions - List the caller's CLI sessions (optional)
+ * - POST /auth/sessions/:id/revoke - Revoke a CLI session (optional)
  */
 export function createRelayApp(options: CreateRelayAppOptions): Hono {
   const { config, auditLogger = new ConsoleAuditLogger() } = options;
@@ -158,8 +242,41 @@ export function createRelayApp(options: CreateRelayAppOptions): Hono {
       : undefined;
   app.route("/", createAuthHandlers(config, auditLogger, portalCallback));
 
+  // Restrict token exchange/refresh to allowed networks or mTLS clients if configured
+  const clientAccess = createClientAccessMiddleware({
+    config: config.access_control,
+    clientCertificate: !!config.server.tls?.client_ca,
+    trustClientCertHeader: options.trustClientCertHeader ?? false,
+    trustClientAddrHeader: options.trustClientAddrHeader ?? false,
+    auditLogger,
+  });
+  if (clientAccess) {
+    app.use("/auth/token", clientAccess);
+  }
+
+  // Verify CLI request signatures on token exchange/refresh if configured
+  if (config.request_signature) {
+    app.use(
+      "/auth/token",
+      createRequestSignatureMiddleware({
+        config: config.request_signature,
+        jwks: config.jwks,
+        auditLogger,
+      })
+    );
+  }
+
+  // Service tokens are requested and approved through portal OAuth sessions
+  const serviceTokenStore =
+    options.enablePortalOAuth && config.jwks ? options.serviceTokenStore : undefined;
+
   // Mount token handlers
-  app.route("/", createTokenHandlers(config, auditLogger));
+  app.route("/", createTokenHandlers(config, auditLogger, serviceTokenStore, options.cliSessionStore));
+
+  // Mount CLI session handlers if a session store is provided
+  if (options.cliSessionStore) {
+    app.route("/", createCliSessionHandlers(config, auditLogger, options.cliSessionStore));
+  }
 
   // Mount certs handlers (for JWKS distribution)
   app.route("/", createCertsHandlers(config));
@@ -183,6 +300,11 @@ export function createRelayApp(options: CreateRelayAppOptions): Hono {
     app.route("/", createPortalAuthHandlers(config, auditLogger));
   }
 
+  // Mount service token handlers if a store is provided
+  if (serviceTokenStore) {
+    app.route("/", createServiceTokenHandlers(config, auditLogger, serviceTokenStore));
+  }
+
   // Mount portal admin handlers if admin features are provided
   if (options.auditLogReader || options.passphraseManager) {
     app.route(
@@ -196,6 +318,14 @@ export function createRelayApp(options: CreateRelayAppOptions): Hono {
     );
   }
 
+  // Mount tenant stats handlers if audit logs are readable
+  if (options.auditLogReader) {
+    app.route(
+      "/",
+      createStatsHandlers(config, auditLogger, options.auditLogReader),
+    );
+  }
+
   // Mount portal handlers when bundle creation is available or portal OAuth is enabled
   if (options.createBundle || options.enablePortalOAuth) {
     const noopVerify = async () => false;
@@ -210,6 +340,8 @@ export function createRelayApp(options: CreateRelayAppOptions): Hono {
         options.createBundle ?? noopBundle,
         options.generateProvisionToken ?? noopProvision,
         options.portalAssets,
+        options.cacheProvider,
+        !!serviceTokenStore,
       ),
     );
   }
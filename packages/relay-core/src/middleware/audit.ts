@@ -13,17 +13,25 @@ export const AuditActions = {
   ACCESS_DENIED: "access_denied",
   PORTAL_VERIFY: "portal_verify",
   PORTAL_DOWNLOAD: "portal_download",
+  PORTAL_BUNDLE_URL: "portal_bundle_url",
   PORTAL_PROVISION: "portal_provision",
   PORTAL_OAUTH_START: "portal_oauth_start",
   PORTAL_OAUTH_LOGIN: "portal_oauth_login",
   PORTAL_LOGOUT: "portal_logout",
   RELAY_BUNDLE: "relay_bundle",
   BUNDLE_AUTH: "bundle_auth",
+  REQUEST_SIGNATURE: "request_signature",
   ADMIN_AUDIT_QUERY: "admin_audit_query",
+  ADMIN_STATS_QUERY: "admin_stats_query",
   ADMIN_PASSPHRASE_VIEW: "admin_passphrase_view",
   ADMIN_PASSPHRASE_SET: "admin_passphrase_set",
   ADMIN_PASSPHRASE_GENERATE: "admin_passphrase_generate",
   ADMIN_PASSPHRASE_CLEAR: "admin_passphrase_clear",
+  SERVICE_TOKEN_REQUEST: "service_token_request",
+  SERVICE_TOKEN_EXCHANGE: "service_token_exchange",
+  ADMIN_SERVICE_TOKEN_APPROVE: "admin_service_token_approve",
+  ADMIN_SERVICE_TOKEN_REVOKE: "admin_service_token_revoke",
+  CLI_SESSION_REVOKE: "cli_session_revoke",
 } as const;
 
 /**
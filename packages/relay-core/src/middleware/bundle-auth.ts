@@ -44,7 +44,7 @@ interface BundleTokenClaims {
 /**
  * JWK structure for Ed25519 keys.
  */
-interface JWK {
+export interface JWK {
   kty: string;
   crv: string;
   kid: string;
@@ -55,7 +55,7 @@ interface JWK {
 /**
  * JWKS structure.
  */
-interface JWKS {
+export interface JWKS {
   keys: JWK[];
 }
 
@@ -76,7 +76,7 @@ export interface BundleAuthOptions {
 /**
  * Base64URL decode.
  */
-function base64UrlDecode(str: string): Uint8Array {
+export function base64UrlDecode(str: string): Uint8Array {
   const padded = str + "=".repeat((4 - (str.length % 4)) % 4);
   const base64 = padded.replace(/-/g, "+").replace(/_/g, "/");
   const binary = atob(base64);
@@ -91,7 +91,7 @@ function base64UrlDecode(str: string): Uint8Array {
  * Verify Ed25519 signature using Web Crypto API.
  * The key is imported and verified in a single operation to avoid type leakage.
  */
-async function verifyEd25519SignatureWithJWK(
+export async function verifyEd25519SignatureWithJWK(
   jwk: JWK,
   data: Uint8Array,
   signature: Uint8Array
@@ -125,10 +125,11 @@ async function verifyEd25519SignatureWithJWK(
 
 /**
  * Verify bundle token JWT.
+ * If name is omitted, the subject claim is not checked.
  */
-async function verifyBundleToken(
+export async function verifyBundleToken(
   token: string,
-  name: string,
+  name: string | undefined,
   jwksJson: string
 ): Promise<void> {
   const parts = token.split(".");
@@ -166,7 +167,7 @@ async function verifyBundleToken(
   const claimsJson = new TextDecoder().decode(base64UrlDecode(claimsB64));
   const claims: BundleTokenClaims = JSON.parse(claimsJson);
 
-  if (claims.sub !== name) {
+  if (name !== undefined && claims.sub !== name) {
     throw new Error(
       `JWT subject mismatch: expected ${name}, got ${claims.sub}`
     );
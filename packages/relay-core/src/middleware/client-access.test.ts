@@ -0,0 +1,143 @@
+import { describe, it, expect } from "vitest";
+import { Hono } from "hono";
+import {
+  createClientAccessMiddleware,
+  ipInCidrs,
+  parseCidrs,
+  CLIENT_ADDR_HEADER,
+  CLIENT_CERT_HEADER,
+} from "./client-access.js";
+import { NoopAuditLogger } from "./audit.js";
+
+function makeApp(options: {
+  allowedCidrs?: string;
+  clientIpHeader?: string;
+  trustedProxies?: string;
+  clientCertificate?: boolean;
+  trustClientCertHeader?: boolean;
+  trustClientAddrHeader?: boolean;
+}): Hono {
+  const app = new Hono();
+  const middleware = createClientAccessMiddleware({
+    config: {
+      allowed_cidrs: options.allowedCidrs,
+      client_ip_header: options.clientIpHeader,
+      trusted_proxies: options.trustedProxies,
+    },
+    clientCertificate: options.clientCertificate ?? false,
+    trustClientCertHeader: options.trustClientCertHeader ?? true,
+    trustClientAddrHeader: options.trustClientAddrHeader ?? true,
+    auditLogger: new NoopAuditLogger(),
+  });
+  if (middleware) {
+    app.use("/auth/token", middleware);
+  }
+  app.post("/auth/token", (c) => c.json({ ok: true }));
+  return app;
+}
+
+function post(app: Hono, ip: string, cert?: string) {
+  const headers: Record<string, string> = { [CLIENT_ADDR_HEADER]: ip };
+  if (cert) {
+    headers[CLIENT_CERT_HEADER] = cert;
+  }
+  return app.request("/auth/token", { method: "POST", headers });
+}
+
+function postWithHeaders(app: Hono, headers: Record<string, string>) {
+  return app.request("/auth/token", { method: "POST", headers });
+}
+
+describe("ipInCidrs", () => {
+  const cidrs = parseCidrs("10.0.0.0/8; 192.168.1.10; fd00::/8");
+
+  it.each([
+    ["10.1.2.3", true],
+    ["11.0.0.1", false],
+    ["192.168.1.10", true],
+    ["192.168.1.11", false],
+    ["::ffff:10.0.0.1", true],
+    ["fd12:3456::1", true],
+    ["fe80::1", false],
+    ["unknown", false],
+  ])("%s -> %s", (ip, want) => {
+    expect(ipInCidrs(ip, cidrs)).toBe(want);
+  });
+
+  it("rejects invalid CIDRs", () => {
+    expect(() => parseCidrs("10.0.0.0/33")).toThrow(/invalid CIDR/);
+    expect(() => parseCidrs("example.com")).toThrow(/invalid CIDR/);
+  });
+});
+
+describe("client access middleware", () => {
+  it("is not installed without allowed_cidrs or a client CA", () => {
+    expect(
+      createClientAccessMiddleware({
+        clientCertificate: false,
+        trustClientCertHeader: true,
+        auditLogger: new NoopAuditLogger(),
+      })
+    ).toBeUndefined();
+  });
+
+  it("allows only listed networks without a client CA", async () => {
+    const app = makeApp({ allowedCidrs: "10.0.0.0/8" });
+    expect((await post(app, "10.0.0.5")).status).toBe(200);
+    expect((await post(app, "203.0.113.1", "CN=alice")).status).toBe(403);
+  });
+
+  it("accepts a verified client certificate outside listed networks", async () => {
+    const app = makeApp({ allowedCidrs: "10.0.0.0/8", clientCertificate: true });
+    expect((await post(app, "10.0.0.5")).status).toBe(200);
+    expect((await post(app, "203.0.113.1", "CN=alice")).status).toBe(200);
+
+    const res = await post(app, "203.0.113.1");
+    expect(res.status).toBe(403);
+    expect(await res.json()).toMatchObject({ error: "access_denied" });
+  });
+
+  it("ignores the certificate header when the runtime does not verify certificates", async () => {
+    const app = makeApp({ clientCertificate: true, trustClientCertHeader: false });
+    expect((await post(app, "203.0.113.1", "CN=alice")).status).toBe(403);
+  });
+
+  it("rejects a spoofed X-Forwarded-For from outside the allowed networks", async () => {
+    const app = makeApp({ allowedCidrs: "10.0.0.0/8", clientCertificate: true });
+    const res = await postWithHeaders(app, {
+      [CLIENT_ADDR_HEADER]: "203.0.113.1",
+      "X-Forwarded-For": "10.0.0.1",
+      "X-Real-IP": "10.0.0.1",
+      "CF-Connecting-IP": "10.0.0.1",
+      "X-Viewer-IP": "10.0.0.1",
+    });
+    expect(res.status).toBe(403);
+  });
+
+  it("fails closed without a socket address or a configured header", async () => {
+    const app = makeApp({ allowedCidrs: "10.0.0.0/8", trustClientAddrHeader: false });
+    expect(
+      (await postWithHeaders(app, { [CLIENT_ADDR_HEADER]: "10.0.0.1", "X-Forwarded-For": "10.0.0.1" })).status
+    ).toBe(403);
+  });
+
+  it("reads the configured header from the right-most untrusted hop", async () => {
+    const app = makeApp({
+      allowedCidrs: "10.0.0.0/8",
+      clientIpHeader: "X-Forwarded-For",
+      trustedProxies: "192.0.2.0/24",
+      trustClientAddrHeader: false,
+    });
+    // The client prepends 10.0.0.1; the proxy appends the address it saw
+    expect(
+      (await postWithHeaders(app, { "X-Forwarded-For": "10.0.0.1, 203.0.113.1, 192.0.2.10" })).status
+    ).toBe(403);
+    expect(
+      (await postWithHeaders(app, { "X-Forwarded-For": "203.0.113.1, 10.0.0.5, 192.0.2.10" })).status
+    ).toBe(200);
+    // Other forwarding headers are ignored
+    expect(
+      (await postWithHeaders(app, { "X-Real-IP": "10.0.0.1", "X-Forwarded-For": "203.0.113.1" })).status
+    ).toBe(403);
+  });
+});
@@ -0,0 +1,250 @@
+/**
+ * Client access middleware for token exchange.
+ *
+ * Restricts POST /auth/token to clients inside `access_control.allowed_cidrs`
+ * or, when `server.tls.client_ca` is configured, to clients presenting a
+ * certificate signed by that CA (mTLS). Either condition is sufficient, so an
+ * internal network can keep working without certificates while remote
+ * clients are required to present one.
+ *
+ * Certificate verification happens in the runtime that terminates TLS. It
+ * passes the verified subject to the app in {@link CLIENT_CERT_HEADER} and
+ * must strip that header from incoming requests.
+ *
+ * The client IP is never taken from the usual forwarding headers, which the
+ * client can set. It comes from the socket address passed by the runtime in
+ * {@link CLIENT_ADDR_HEADER}, or from the single header named by
+ * `access_control.client_ip_header` that the operator's proxy sets.
+ */
+
+import type { Context, MiddlewareHandler } from "hono";
+import type { AccessControlConfig, AuditLogger } from "../config/types.js";
+import { AuditActions, createAuditEvent } from "./audit.js";
+import { extractRequestContext } from "../utils/request.js";
+
+/** Header carrying the subject of a client certificate verified by the runtime. */
+export const CLIENT_CERT_HEADER = "X-Backlog-Relay-Client-Cert";
+
+/** Header carrying the socket address of the connection, set by the runtime. */
+export const CLIENT_ADDR_HEADER = "X-Backlog-Relay-Client-Addr";
+
+/**
+ * Options for creating client access middleware.
+ */
+export interface ClientAccessOptions {
+  /** Access control configuration (allowed_cidrs) */
+  config?: AccessControlConfig;
+  /** Whether a client certificate is accepted (server.tls.client_ca is set) */
+  clientCertificate: boolean;
+  /**
+   * Whether the runtime sets {@link CLIENT_CERT_HEADER} after verifying the
+   * certificate. When false the header is ignored (fail closed).
+   */
+  trustClientCertHeader: boolean;
+  /**
+   * Whether the runtime sets {@link CLIENT_ADDR_HEADER} from the socket and
+   * strips it from incoming requests. When false the header is ignored.
+   */
+  trustClientAddrHeader?: boolean;
+  /** Audit logger */
+  auditLogger: AuditLogger;
+}
+
+interface ParsedCidr {
+  version: 4 | 6;
+  network: bigint;
+  prefix: number;
+}
+
+/**
+ * Parse an IPv4 or IPv6 address into its version and numeric value.
+ * IPv4-mapped IPv6 addresses (::ffff:a.b.c.d) are treated as IPv4.
+ */
+export function parseIp(ip: string): { version: 4 | 6; value: bigint } | undefined {
+  let addr = ip.trim();
+  if (addr.startsWith("[") && addr.endsWith("]")) {
+    addr = addr.slice(1, -1);
+  }
+  // Drop the zone index (fe80::1%eth0)
+  addr = addr.split("%")[0];
+
+  const mapped = /^::ffff:(\d+\.\d+\.\d+\.\d+)$/i.exec(addr);
+  if (mapped) {
+    addr = mapped[1];
+  }
+
+  if (addr.includes(".") && !addr.includes(":")) {
+    const parts = addr.split(".");
+    if (parts.length !== 4) {
+      return undefined;
+    }
+    let value = 0n;
+    for (const part of parts) {
+      if (!/^\d{1,3}$/.test(part) || Number(part) > 255) {
+        return undefined;
+      }
+      value = (value << 8n) | BigInt(part);
+    }
+    return { version: 4, value };
+  }
+
+  if (!addr.includes(":")) {
+    return undefined;
+  }
+  const halves = addr.split("::");
+  if (halves.length > 2) {
+    return undefined;
+  }
+  const head = halves[0] ? halves[0].split(":") : [];
+  const tail = halves.length === 2 && halves[1] ? halves[1].split(":") : [];
+  const missing = 8 - head.length - tail.length;
+  if (halves.length === 1 ? missing !== 0 : missing < 1) {
+    return undefined;
+  }
+  const groups = [...head, ...Array<string>(halves.length === 2 ? missing : 0).fill("0"), ...tail];
+  let value = 0n;
+  for (const group of groups) {
+    if (!/^[0-9a-f]{1,4}$/i.test(group)) {
+      return undefined;
+    }
+    value = (value << 16n) | BigInt(parseInt(group, 16));
+  }
+  return { version: 6, value };
+}
+
+/**
+ * Parse semicolon-separated CIDRs. A bare address is treated as a single host.
+ * @throws Error if an entry is not a valid CIDR
+ */
+export function parseCidrs(cidrs?: string, key = "access_control.allowed_cidrs"): ParsedCidr[] {
+  if (!cidrs) {
+    return [];
+  }
+  return cidrs
+    .split(";")
+    .map((c) => c.trim())
+    .filter((c) => c.length > 0)
+    .map((cidr) => {
+      const [addr, prefixStr] = cidr.split("/");
+      const ip = parseIp(addr);
+      const bits = ip?.version === 4 ? 32 : 128;
+      const prefix = prefixStr === undefined ? bits : Number(prefixStr);
+      if (!ip || (prefixStr !== undefined && !/^\d+$/.test(prefixStr)) || prefix > bits) {
+        throw new Error(`invalid CIDR in ${key}: ${cidr}`);
+      }
+      const shift = BigInt(bits - prefix);
+      return { version: ip.version, network: (ip.value >> shift) << shift, prefix };
+    });
+}
+
+/**
+ * Check whether an IP address is inside any of the given CIDRs.
+ */
+export function ipInCidrs(ip: string, cidrs: ParsedCidr[]): boolean {
+  const parsed = parseIp(ip);
+  if (!parsed) {
+    return false;
+  }
+  const bits = parsed.version === 4 ? 32 : 128;
+  return cidrs.some((cidr) => {
+    if (cidr.version !== parsed.version) {
+      return false;
+    }
+    const shift = BigInt(bits - cidr.prefix);
+    return (parsed.value >> shift) << shift === cidr.network;
+  });
+}
+
+/**
+ * Options for {@link resolveClientIp}.
+ */
+export interface ClientIpOptions {
+  /** Header set by a trusted proxy (access_control.client_ip_header) */
+  header?: string;
+  /** Proxy hops to skip when reading the header from the right */
+  trustedProxies: ParsedCidr[];
+  /** Whether {@link CLIENT_ADDR_HEADER} is set by the runtime */
+  trustClientAddrHeader: boolean;
+}
+
+/**
+ * Resolve the client IP used for allowed_cidrs.
+ *
+ * With a configured header the values are read from the right and the first
+ * address outside trusted_proxies wins: a proxy appends the address it saw,
+ * so only the entries on the left can be forged by the client. Without a
+ * header the socket address from the runtime is used. Returns undefined when
+ * the address cannot be determined (the check then fails closed).
+ */
+export function resolveClientIp(c: Context, options: ClientIpOptions): string | undefined {
+  if (options.header) {
+    const hops = (c.req.header(options.header) ?? "")
+      .split(",")
+      .map((h) => h.trim())
+      .filter((h) => h.length > 0);
+    for (let i = hops.length - 1; i >= 0; i--) {
+      if (!ipInCidrs(hops[i], options.trustedProxies)) {
+        return hops[i];
+      }
+    }
+    return undefined;
+  }
+  if (options.trustClientAddrHeader) {
+    return c.req.header(CLIENT_ADDR_HEADER)?.trim() || undefined;
+  }
+  return undefined;
+}
+
+/**
+ * Create client access middleware.
+ *
+ * Returns undefined when neither allowed_cidrs nor a client CA is configured.
+ */
+export function createClientAccessMiddleware(
+  options: ClientAccessOptions
+): MiddlewareHandler | undefined {
+  const { config, clientCertificate, trustClientCertHeader, auditLogger } = options;
+  const cidrs = parseCidrs(config?.allowed_cidrs);
+  if (cidrs.length === 0 && !clientCertificate) {
+    return undefined;
+  }
+  const ipOptions: ClientIpOptions = {
+    header: config?.client_ip_header?.trim() || undefined,
+    trustedProxies: parseCidrs(config?.trusted_proxies, "access_control.trusted_proxies"),
+    trustClientAddrHeader: options.trustClientAddrHeader ?? false,
+  };
+
+  return async (c: Context, next: () => Promise<void>) => {
+    const reqCtx = extractRequestContext(c);
+    const clientIp = resolveClientIp(c, ipOptions);
+    if (cidrs.length > 0 && clientIp && ipInCidrs(clientIp, cidrs)) {
+      await next();
+      return;
+    }
+    if (clientCertificate && trustClientCertHeader && c.req.header(CLIENT_CERT_HEADER)) {
+      await next();
+      return;
+    }
+
+    auditLogger.log(
+      createAuditEvent({
+        action: AuditActions.ACCESS_DENIED,
+        clientIp: clientIp ?? reqCtx.clientIp,
+        userAgent: reqCtx.userAgent,
+        result: "error",
+        error: clientCertificate
+          ? "client certificate required outside allowed networks"
+          : "client IP not allowed",
+      })
+    );
+    return c.json(
+      {
+        error: "access_denied",
+        error_description: clientCertificate
+          ? "a client certificate is required to exchange tokens from this network"
+          : "token exchange is not allowed from this network",
+      },
+      403
+    );
+  };
+}
@@ -0,0 +1,129 @@
+import { describe, it, expect } from "vitest";
+import { Hono } from "hono";
+import {
+  createRequestSignatureMiddleware,
+  buildCanonicalString,
+  HEADER_KEY_ID,
+  HEADER_TIMESTAMP,
+  HEADER_SIGNATURE,
+} from "./request-signature.js";
+import { NoopAuditLogger } from "./audit.js";
+import type { RequestSignatureConfig } from "../config/types.js";
+
+const NOW = 1_700_000_000_000;
+
+function base64UrlEncode(bytes: Uint8Array): string {
+  let binary = "";
+  for (const b of bytes) binary += String.fromCharCode(b);
+  return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+}
+
+async function makeKey(kid: string) {
+  const pair = (await crypto.subtle.generateKey({ name: "Ed25519" }, true, [
+    "sign",
+    "verify",
+  ])) as CryptoKeyPair;
+  const jwk = await crypto.subtle.exportKey("jwk", pair.publicKey);
+  const jwks = JSON.stringify({ keys: [{ kty: "OKP", crv: "Ed25519", kid, x: jwk.x }] });
+  return { privateKey: pair.privateKey, jwks };
+}
+
+async function sign(privateKey: CryptoKey, body: string, timestamp: string): Promise<string> {
+  const message = await buildCanonicalString(
+    "POST",
+    "/auth/token",
+    timestamp,
+    new TextEncoder().encode(body)
+  );
+  const sig = await crypto.subtle.sign("Ed25519", privateKey, new TextEncoder().encode(message));
+  return base64UrlEncode(new Uint8Array(sig));
+}
+
+function makeApp(config: RequestSignatureConfig): Hono {
+  const app = new Hono();
+  app.use(
+    "/auth/token",
+    createRequestSignatureMiddleware({
+      config,
+      auditLogger: new NoopAuditLogger(),
+      now: () => NOW,
+    })
+  );
+  app.post("/auth/token", async (c) => c.json(await c.req.json()));
+  return app;
+}
+
+describe("request signature middleware", () => {
+  const body = JSON.stringify({ grant_type: "refresh_token", space: "a.backlog.jp" });
+  const timestamp = String(NOW / 1000);
+
+  it("accepts a valid signature and keeps the body readable", async () => {
+    const { privateKey, jwks } = await makeKey("k1");
+    const app = makeApp({ required: true, client_keys: jwks });
+
+    const res = await app.request("/auth/token", {
+      method: "POST",
+      body,
+      headers: {
+        "Content-Type": "application/json",
+        [HEADER_KEY_ID]: "k1",
+        [HEADER_TIMESTAMP]: timestamp,
+        [HEADER_SIGNATURE]: await sign(privateKey, body, timestamp),
+      },
+    });
+
+    expect(res.status).toBe(200);
+    expect(await res.json()).toEqual(JSON.parse(body));
+  });
+
+  it("rejects a tampered body", async () => {
+    const { privateKey, jwks } = await makeKey("k1");
+    const app = makeApp({ client_keys: jwks });
+
+    const res = await app.request("/auth/token", {
+      method: "POST",
+      body: body.replace("refresh_token", "authorization_code"),
+      headers: {
+        [HEADER_KEY_ID]: "k1",
+        [HEADER_TIMESTAMP]: timestamp,
+        [HEADER_SIGNATURE]: await sign(privateKey, body, timestamp),
+      },
+    });
+
+    expect(res.status).toBe(401);
+  });
+
+  it("rejects a stale timestamp", async () => {
+    const { privateKey, jwks } = await makeKey("k1");
+    const app = makeApp({ client_keys: jwks, max_skew_seconds: 60 });
+    const stale = String(NOW / 1000 - 120);
+
+    const res = await app.request("/auth/token", {
+      method: "POST",
+      body,
+      headers: {
+        [HEADER_KEY_ID]: "k1",
+        [HEADER_TIMESTAMP]: stale,
+        [HEADER_SIGNATURE]: await sign(privateKey, body, stale),
+      },
+    });
+
+    expect(res.status).toBe(401);
+  });
+
+  it("rejects unsigned requests only when required", async () => {
+    const { jwks } = await makeKey("k1");
+
+    const optional = await makeApp({ client_keys: jwks }).request("/auth/token", {
+      method: "POST",
+      body,
+    });
+    expect(optional.status).toBe(200);
+
+    const required = await makeApp({ required: true, client_keys: jwks }).request("/auth/token", {
+      method: "POST",
+      body,
+    });
+    expect(required.status).toBe(401);
+  });
+});
@@ -0,0 +1,165 @@
+/**
+ * Request signature verification middleware.
+ *
+ * Verifies Ed25519 signatures attached by the CLI to token requests
+ * (CLI -> relay mutual authentication). Combined with bundle tokens this
+ * prevents a leaked bundle token alone from being abused.
+ *
+ * Canonical string (must match the CLI's relaysig package):
+ *
+ *   BACKLOG-RELAY-SIG-V1\n{METHOD}\n{PATH}\n{TIMESTAMP}\n{hex(sha256(body))}
+ */
+
+import type { Context, MiddlewareHandler } from "hono";
+import type { AuditLogger, RequestSignatureConfig } from "../config/types.js";
+import { AuditActions, createAuditEvent } from "./audit.js";
+import { extractRequestContext } from "../utils/request.js";
+import {
+  base64UrlDecode,
+  verifyBundleToken,
+  verifyEd25519SignatureWithJWK,
+  type JWKS,
+} from "./bundle-auth.js";
+
+export const SIGNATURE_VERSION = "BACKLOG-RELAY-SIG-V1";
+export const HEADER_KEY_ID = "X-Backlog-Relay-Key-Id";
+export const HEADER_TIMESTAMP = "X-Backlog-Relay-Timestamp";
+export const HEADER_SIGNATURE = "X-Backlog-Relay-Signature";
+
+const DEFAULT_MAX_SKEW_SECONDS = 300;
+
+/**
+ * Options for creating request signature middleware.
+ */
+export interface RequestSignatureOptions {
+  /** Request signature configuration */
+  config: RequestSignatureConfig;
+  /** Server-level JWKS used to verify bundle tokens */
+  jwks?: string;
+  /** Audit logger */
+  auditLogger: AuditLogger;
+  /** Current time provider in milliseconds (for testing) */
+  now?: () => number;
+}
+
+/**
+ * Build the canonical string to be signed.
+ */
+export async function buildCanonicalString(
+  method: string,
+  path: string,
+  timestamp: string,
+  body: Uint8Array
+): Promise<string> {
+  const digest = await crypto.subtle.digest("SHA-256", body);
+  const hex = Array.from(new Uint8Array(digest))
+    .map((b) => b.toString(16).padStart(2, "0"))
+    .join("");
+  return [SIGNATURE_VERSION, method.toUpperCase(), path, timestamp, hex].join("\n");
+}
+
+class SignatureError extends Error {}
+
+/**
+ * Create request signature verification middleware.
+ *
+ * Requests carrying signature headers are always verified. Unsigned requests
+ * are rejected only when `required` is true.
+ */
+export function createRequestSignatureMiddleware(
+  options: RequestSignatureOptions
+): MiddlewareHandler {
+  const { config, jwks: serverJwks, auditLogger, now = Date.now } = options;
+  const maxSkew = config.max_skew_seconds ?? DEFAULT_MAX_SKEW_SECONDS;
+
+  async function verify(c: Context): Promise<string> {
+    const keyId = c.req.header(HEADER_KEY_ID);
+    const timestamp = c.req.header(HEADER_TIMESTAMP);
+    const signature = c.req.header(HEADER_SIGNATURE);
+    if (!keyId || !timestamp || !signature) {
+      throw new SignatureError("missing signature headers");
+    }
+
+    const ts = Number(timestamp);
+    if (!/^\d+$/.test(timestamp) || !Number.isFinite(ts)) {
+      throw new SignatureError("invalid timestamp");
+    }
+    if (Math.abs(now() / 1000 - ts) > maxSkew) {
+      throw new SignatureError("timestamp outside allowed window");
+    }
+
+    if (!config.client_keys) {
+      throw new SignatureError("client keys not configured");
+    }
+    const keys: JWKS = JSON.parse(config.client_keys);
+    const jwk = keys.keys.find((k) => k.kid === keyId);
+    if (!jwk) {
+      throw new SignatureError(`unknown key ID: ${keyId}`);
+    }
+
+    // Clone so downstream handlers can still read the body
+    const body = new Uint8Array(await c.req.raw.clone().arrayBuffer());
+    const message = await buildCanonicalString(
+      c.req.method,
+      new URL(c.req.url).pathname,
+      timestamp,
+      body
+    );
+    const valid = await verifyEd25519SignatureWithJWK(
+      jwk,
+      new TextEncoder().encode(message),
+      base64UrlDecode(signature)
+    );
+    if (!valid) {
+      throw new SignatureError("signature verification failed");
+    }
+
+    if (config.require_bundle_token) {
+      const authHeader = c.req.header("Authorization");
+      if (!authHeader?.startsWith("Bearer ")) {
+        throw new SignatureError("missing bundle token");
+      }
+      if (!serverJwks) {
+        throw new SignatureError("server jwks not configured");
+      }
+      try {
+        await verifyBundleToken(authHeader.slice(7), undefined, serverJwks);
+      } catch (err) {
+        throw new SignatureError(`invalid bundle token: ${(err as Error).message}`);
+      }
+    }
+
+    return keyId;
+  }
+
+  return async (c: Context, next: () => Promise<void>) => {
+    const signed = c.req.header(HEADER_SIGNATURE) !== undefined;
+    if (!signed && !config.required) {
+      await next();
+      return;
+    }
+
+    const reqCtx = extractRequestContext(c);
+    try {
+      await verify(c);
+    } catch (err) {
+      const message = err instanceof SignatureError ? err.message : "invalid signature";
+      auditLogger.log(
+        createAuditEvent({
+          action: AuditActions.REQUEST_SIGNATURE,
+          clientIp: reqCtx.clientIp,
+          userAgent: reqCtx.userAgent,
+          result: "error",
+          error: message,
+        })
+      );
+      return c.json(
+        { error: "invalid_client", error_description: "request signature verification failed" },
+        401
+      );
+    }
+
+    await next();
+    return;
+  };
+}
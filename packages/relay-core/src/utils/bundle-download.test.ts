@@ -0,0 +1,78 @@
+import { describe, it, expect } from "vitest";
+import {
+    createBundleDownloadToken,
+    verifyBundleDownloadToken,
+    consumeBundleDownloadToken,
+    MemoryCacheProvider,
+} from "./bundle-download.js";
+import { createPortalSessionToken } from "./portal-session.js";
+import {
+    base64UrlEncode,
+    deriveEd25519PublicKey,
+} from "./crypto.js";
+
+async function makeTestJWKS(kid = "test-kid-1"): Promise<string> {
+    const seed = crypto.getRandomValues(new Uint8Array(32));
+    const pubBytes = await deriveEd25519PublicKey(seed);
+    return JSON.stringify({
+        keys: [
+            {
+                kty: "OKP",
+                crv: "Ed25519",
+                kid,
+                d: base64UrlEncode(seed),
+                x: base64UrlEncode(pubBytes),
+            },
+        ],
+    });
+}
+
+const admin = { userId: "admin", name: "Admin", email: "admin@example.com" };
+
+describe("bundle download token", () => {
+    it("round-trips create → verify", async () => {
+        const jwksJson = await makeTestJWKS();
+        const { token, claims } = await createBundleDownloadToken(admin, "tenant1", "space.backlog.jp", jwksJson, 120);
+        const verified = await verifyBundleDownloadToken(token, jwksJson);
+        expect(verified.sub).toBe("admin");
+        expect(verified.tenant).toBe("tenant1");
+        expect(verified.space).toBe("space.backlog.jp");
+        expect(verified.jti).toBe(claims.jti);
+        expect(verified.exp - verified.iat).toBe(120);
+    });
+
+    it("rejects expired tokens", async () => {
+        const jwksJson = await makeTestJWKS();
+        const { token } = await createBundleDownloadToken(admin, "tenant1", "space.backlog.jp", jwksJson, -1);
+        await expect(verifyBundleDownloadToken(token, jwksJson)).rejects.toThrow("Token expired");
+    });
+
+    it("rejects tokens signed by another key", async () => {
+        const { token } = await createBundleDownloadToken(admin, "tenant1", "space.backlog.jp", await makeTestJWKS());
+        await expect(verifyBundleDownloadToken(token, await makeTestJWKS())).rejects.toThrow();
+    });
+
+    it("rejects portal session tokens", async () => {
+        const jwksJson = await makeTestJWKS();
+        const session = await createPortalSessionToken(
+            { userId: "admin", name: "Admin", email: "admin@example.com", roleType: 1 },
+            "tenant1",
+            "space.backlog.jp",
+            jwksJson,
+        );
+        await expect(verifyBundleDownloadToken(session, jwksJson)).rejects.toThrow("Invalid token purpose");
+    });
+});
+
+describe("consumeBundleDownloadToken", () => {
+    it("allows a token only once", async () => {
+        const jwksJson = await makeTestJWKS();
+        const store = new MemoryCacheProvider();
+        const { claims } = await createBundleDownloadToken(admin, "tenant1", "space.backlog.jp", jwksJson);
+        expect(await consumeBundleDownloadToken(store, claims)).toBe(true);
+        expect(await consumeBundleDownloadToken(store, claims)).toBe(false);
+
+        const other = await createBundleDownloadToken(admin, "tenant1", "space.backlog.jp", jwksJson);
+        expect(await consumeBundleDownloadToken(store, other.claims)).toBe(true);
+    });
+});
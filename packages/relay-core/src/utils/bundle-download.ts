@@ -0,0 +1,181 @@
+/**
+ * One-time bundle download tokens.
+ *
+ * Portal admins issue short-lived download URLs for configuration bundles.
+ * The token is an Ed25519-signed JWT using the server JWKS; single use is
+ * enforced by recording the token ID (jti) in a CacheProvider until it expires.
+ */
+
+import type { CacheProvider } from "../config/types.js";
+import {
+  type JWKS,
+  base64UrlEncode,
+  base64UrlDecode,
+  randomBytes,
+  signEd25519,
+  verifyEd25519,
+  normalizeJWKS,
+  getFirstSigningKey,
+} from "./crypto.js";
+
+/** Default lifetime of a download URL */
+export const BUNDLE_DOWNLOAD_TTL_SECONDS = 600; // 10 minutes
+
+/** Upper bound for the lifetime requested by an admin */
+export const BUNDLE_DOWNLOAD_MAX_TTL_SECONDS = 86400; // 24 hours
+
+export interface BundleDownloadClaims {
+  /** Backlog userId of the admin who issued the URL */
+  sub: string;
+  name: string;
+  email: string;
+  tenant: string;
+  space: string;
+  purpose: "bundle_download";
+  iat: number;
+  exp: number;
+  jti: string;
+}
+
+export async function createBundleDownloadToken(
+  issuer: { userId: string; name: string; email: string },
+  tenant: string,
+  space: string,
+  jwksJson: string,
+  ttlSeconds: number = BUNDLE_DOWNLOAD_TTL_SECONDS,
+): Promise<{ token: string; claims: BundleDownloadClaims }> {
+  const jwks: JWKS = JSON.parse(jwksJson);
+  const jwkByKid = await normalizeJWKS(jwks);
+  const { kid, jwk } = getFirstSigningKey(jwks, jwkByKid);
+
+  const now = Math.floor(Date.now() / 1000);
+  const claims: BundleDownloadClaims = {
+    sub: issuer.userId,
+    name: issuer.name,
+    email: issuer.email,
+    tenant,
+    space,
+    purpose: "bundle_download",
+    iat: now,
+    exp: now + ttlSeconds,
+    jti: base64UrlEncode(randomBytes(16)),
+  };
+
+  const header = { alg: "EdDSA", typ: "JWT", kid };
+  const signingInput =
+    base64UrlEncode(JSON.stringify(header)) + "." + base64UrlEncode(JSON.stringify(claims));
+  const signature = await signEd25519(
+    base64UrlDecode(jwk.d!),
+    new TextEncoder().encode(signingInput),
+  );
+
+  return { token: signingInput + "." + base64UrlEncode(signature), claims };
+}
+
+export async function verifyBundleDownloadToken(
+  token: string,
+  jwksJson: string,
+): Promise<BundleDownloadClaims> {
+  const parts = token.split(".");
+  if (parts.length !== 3) {
+    throw new Error("Invalid JWT format");
+  }
+  const [headerB64, claimsB64, signatureB64] = parts;
+
+  const header = JSON.parse(
+    new TextDecoder().decode(base64UrlDecode(headerB64)),
+  ) as { alg: string; kid?: string };
+  if (header.alg !== "EdDSA") {
+    throw new Error(`Unsupported algorithm: ${header.alg}`);
+  }
+  if (!header.kid) {
+    throw new Error("Missing kid in JWT header");
+  }
+
+  const jwks: JWKS = JSON.parse(jwksJson);
+  const jwkByKid = await normalizeJWKS(jwks);
+  const jwk = jwkByKid.get(header.kid);
+  if (!jwk || !jwk.x) {
+    throw new Error(`Unknown key: ${header.kid}`);
+  }
+
+  const valid = await verifyEd25519(
+    base64UrlDecode(jwk.x),
+    base64UrlDecode(signatureB64),
+    new TextEncoder().encode(`${headerB64}.${claimsB64}`),
+  );
+  if (!valid) {
+    throw new Error("Invalid signature");
+  }
+
+  const claims = JSON.parse(
+    new TextDecoder().decode(base64UrlDecode(claimsB64)),
+  ) as BundleDownloadClaims;
+  if (claims.purpose !== "bundle_download") {
+    throw new Error("Invalid token purpose");
+  }
+  const now = Math.floor(Date.now() / 1000);
+  if (!claims.exp || claims.exp < now) {
+    throw new Error("Token expired");
+  }
+  return claims;
+}
+
+/**
+ * Mark a download token as used.
+ *
+ * Returns false if the token has already been used. The check-and-set is not
+ * atomic across instances; deployments with multiple instances should pass a
+ * shared CacheProvider so that reuse is at least detected after the first write.
+ */
+export async function consumeBundleDownloadToken(
+  store: CacheProvider,
+  claims: BundleDownloadClaims,
+): Promise<boolean> {
+  const key = `bundle-download:${claims.jti}`;
+  if (await store.get(key)) {
+    return false;
+  }
+  const ttl = Math.max(1, claims.exp - Math.floor(Date.now() / 1000));
+  await store.set(key, "1", ttl);
+  return true;
+}
+
+/**
+ * In-memory CacheProvider used when the platform does not provide one.
+ * Suitable for single-instance deployments (e.g. Docker).
+ */
+export class MemoryCacheProvider implements CacheProvider {
+  private entries = new Map<string, { value: string; expiresAt?: number }>();
+
+  async get(key: string): Promise<string | undefined> {
+    const entry = this.entries.get(key);
+    if (!entry) return undefined;
+    if (entry.expiresAt !== undefined && entry.expiresAt <= Date.now()) {
+      this.entries.delete(key);
+      return undefined;
+    }
+    return entry.value;
+  }
+
+  async set(key: string, value: string, ttl?: number): Promise<void> {
+    this.sweep();
+    this.entries.set(key, {
+      value,
+      expiresAt: ttl !== undefined ? Date.now() + ttl * 1000 : undefined,
+    });
+  }
+
+  async delete(key: string): Promise<void> {
+    this.entries.delete(key);
+  }
+
+  private sweep(): void {
+    const now = Date.now();
+    for (const [key, entry] of this.entries) {
+      if (entry.expiresAt !== undefined && entry.expiresAt <= now) {
+        this.entries.delete(key);
+      }
+    }
+  }
+}
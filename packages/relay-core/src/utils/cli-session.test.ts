@@ -0,0 +1,44 @@
+import { describe, it, expect } from "vitest";
+import type { CliSessionRecord } from "../admin/types.js";
+import { hashRefreshToken, MemoryCliSessionStore, toPublicCliSession } from "./cli-session.js";
+
+function makeRecord(overrides: Partial<CliSessionRecord> = {}): CliSessionRecord {
+    return {
+        id: "s1",
+        space: "space.backlog.jp",
+        userId: 1,
+        userName: "Alice",
+        device: "laptop",
+        refreshTokenHash: "hash1",
+        createdAt: "2026-10-01T00:00:00Z",
+        lastUsedAt: "2026-10-01T00:00:00Z",
+        ...overrides,
+    };
+}
+
+describe("MemoryCliSessionStore", () => {
+    it("follows the rotated refresh token", async () => {
+        const store = new MemoryCliSessionStore();
+        await store.put(makeRecord());
+        await store.put(makeRecord({ refreshTokenHash: "hash2" }));
+        expect(await store.findByRefreshToken("hash1")).toBeUndefined();
+        expect((await store.findByRefreshToken("hash2"))?.id).toBe("s1");
+    });
+
+    it("lists sessions of one user in one space", async () => {
+        const store = new MemoryCliSessionStore();
+        await store.put(makeRecord({ id: "a" }));
+        await store.put(makeRecord({ id: "b", userId: 2, refreshTokenHash: "h2" }));
+        await store.put(makeRecord({ id: "c", space: "other.backlog.jp", refreshTokenHash: "h3" }));
+        expect((await store.list("space.backlog.jp", 1)).map((r) => r.id)).toEqual(["a"]);
+    });
+});
+
+describe("cli session", () => {
+    it("hashes refresh tokens and hides the hash from the public view", async () => {
+        const hash = await hashRefreshToken("refresh");
+        expect(hash).toMatch(/^[0-9a-f]{64}$/);
+        expect(hash).not.toBe(await hashRefreshToken("other"));
+        expect(toPublicCliSession(makeRecord())).not.toHaveProperty("refreshTokenHash");
+    });
+});
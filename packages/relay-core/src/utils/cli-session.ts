@@ -0,0 +1,69 @@
+/**
+ * CLI session utilities.
+ *
+ * Sessions are looked up by the SHA-256 hash of the current Backlog refresh
+ * token, so the store never holds a usable token.
+ */
+
+import type { CliSessionRecord, CliSessionStore } from "../admin/types.js";
+import { base64UrlEncode, randomBytes } from "./crypto.js";
+import { hashServiceTokenSecret } from "./service-token.js";
+
+/**
+ * Generate a new session ID.
+ */
+export function generateCliSessionId(): string {
+  return base64UrlEncode(randomBytes(9));
+}
+
+/**
+ * Hash a Backlog refresh token for session lookup.
+ */
+export function hashRefreshToken(refreshToken: string): Promise<string> {
+  return hashServiceTokenSecret(refreshToken);
+}
+
+/**
+ * Public view of a session (without the refresh token hash).
+ */
+export type PublicCliSession = Omit<CliSessionRecord, "refreshTokenHash">;
+
+export function toPublicCliSession(record: CliSessionRecord): PublicCliSession {
+  const { refreshTokenHash: _hash, ...rest } = record;
+  return rest;
+}
+
+/**
+ * In-memory CLI session store.
+ * Sessions are lost on restart (existing logins are tracked again on their
+ * next refresh); provide a persistent store for production.
+ */
+export class MemoryCliSessionStore implements CliSessionStore {
+  private records = new Map<string, CliSessionRecord>();
+  private byRefreshToken = new Map<string, string>();
+
+  async get(id: string): Promise<CliSessionRecord | undefined> {
+    const record = this.records.get(id);
+    return record ? { ...record } : undefined;
+  }
+
+  async findByRefreshToken(refreshTokenHash: string): Promise<CliSessionRecord | undefined> {
+    const id = this.byRefreshToken.get(refreshTokenHash);
+    return id ? this.get(id) : undefined;
+  }
+
+  async list(space: string, userId: number): Promise<CliSessionRecord[]> {
+    return [...this.records.values()]
+      .filter((r) => r.space === space && r.userId === userId)
+      .map((r) => ({ ...r }));
+  }
+
+  async put(record: CliSessionRecord): Promise<void> {
+    const prev = this.records.get(record.id);
+    if (prev && prev.refreshTokenHash !== record.refreshTokenHash) {
+      this.byRefreshToken.delete(prev.refreshTokenHash);
+    }
+    this.records.set(record.id, { ...record });
+    this.byRefreshToken.set(record.refreshTokenHash, record.id);
+  }
+}
@@ -0,0 +1,72 @@
+import { describe, it, expect } from "vitest";
+import type { ServiceTokenRecord } from "../admin/types.js";
+import {
+    generateServiceTokenId,
+    issueServiceTokenSecret,
+    parseServiceToken,
+    verifyServiceTokenSecret,
+    serviceTokenUnusableReason,
+    toPublicServiceToken,
+    MemoryServiceTokenStore,
+} from "./service-token.js";
+
+function makeRecord(overrides: Partial<ServiceTokenRecord> = {}): ServiceTokenRecord {
+    return {
+        id: "id1",
+        tenant: "tenant1",
+        space: "space.backlog.jp",
+        name: "ci",
+        status: "active",
+        userId: "bot",
+        userName: "Bot",
+        encryptedRefreshToken: "sealed",
+        secretHash: "hash",
+        validityDays: 90,
+        requestedAt: "2026-01-01T00:00:00Z",
+        ...overrides,
+    };
+}
+
+describe("service token", () => {
+    it("round-trips issue → parse → verify", async () => {
+        const id = generateServiceTokenId();
+        const { token, secretHash } = await issueServiceTokenSecret(id);
+        const parsed = parseServiceToken(token);
+        expect(parsed?.id).toBe(id);
+        expect(await verifyServiceTokenSecret(parsed!.secret, secretHash)).toBe(true);
+        expect(await verifyServiceTokenSecret("wrong", secretHash)).toBe(false);
+    });
+
+    it("rejects malformed tokens", () => {
+        expect(parseServiceToken("abc")).toBeUndefined();
+        expect(parseServiceToken("bst_")).toBeUndefined();
+        expect(parseServiceToken("bst_.secret")).toBeUndefined();
+        expect(parseServiceToken("bst_id.")).toBeUndefined();
+    });
+
+    it("reports why a record is unusable", () => {
+        const now = new Date("2026-06-01T00:00:00Z");
+        expect(serviceTokenUnusableReason(makeRecord(), now)).toBeUndefined();
+        expect(serviceTokenUnusableReason(makeRecord({ status: "pending", secretHash: undefined }), now)).toBe("token_not_approved");
+        expect(serviceTokenUnusableReason(makeRecord({ status: "revoked" }), now)).toBe("token_revoked");
+        expect(serviceTokenUnusableReason(makeRecord({ expiresAt: "2026-05-01T00:00:00Z" }), now)).toBe("token_expired");
+    });
+
+    it("hides secrets in the public view", () => {
+        const view = toPublicServiceToken(makeRecord());
+        expect(view).not.toHaveProperty("encryptedRefreshToken");
+        expect(view).not.toHaveProperty("secretHash");
+        expect(view.name).toBe("ci");
+    });
+});
+
+describe("MemoryServiceTokenStore", () => {
+    it("lists records per tenant", async () => {
+        const store = new MemoryServiceTokenStore();
+        await store.put(makeRecord({ id: "a" }));
+        await store.put(makeRecord({ id: "b", tenant: "tenant2" }));
+        expect((await store.list("tenant1")).map((r) => r.id)).toEqual(["a"]);
+        expect(await store.get("b")).toMatchObject({ tenant: "tenant2" });
+        expect(await store.get("missing")).toBeUndefined();
+    });
+});
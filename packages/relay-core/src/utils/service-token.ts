@@ -0,0 +1,124 @@
+/**
+ * Service token utilities.
+ *
+ * Service tokens have the form "bst_<id>.<secret>". Only the SHA-256 hash of
+ * the secret is stored, so a leaked store does not leak usable tokens.
+ */
+
+import type { ServiceTokenRecord, ServiceTokenStore } from "../admin/types.js";
+import { base64UrlEncode, randomBytes } from "./crypto.js";
+
+export const SERVICE_TOKEN_PREFIX = "bst_";
+export const SERVICE_TOKEN_DEFAULT_VALIDITY_DAYS = 90;
+export const SERVICE_TOKEN_MAX_VALIDITY_DAYS = 365;
+
+/**
+ * Generate a new service token ID.
+ */
+export function generateServiceTokenId(): string {
+  return base64UrlEncode(randomBytes(12));
+}
+
+/**
+ * Issue a token secret for the given ID.
+ * Returns the full token (shown to the admin once) and the hash to store.
+ */
+export async function issueServiceTokenSecret(
+  id: string,
+): Promise<{ token: string; secretHash: string }> {
+  const secret = base64UrlEncode(randomBytes(32));
+  return {
+    token: `${SERVICE_TOKEN_PREFIX}${id}.${secret}`,
+    secretHash: await hashServiceTokenSecret(secret),
+  };
+}
+
+/**
+ * Split a service token into its ID and secret.
+ * Returns undefined if the token is malformed.
+ */
+export function parseServiceToken(
+  token: string,
+): { id: string; secret: string } | undefined {
+  if (!token.startsWith(SERVICE_TOKEN_PREFIX)) return undefined;
+  const rest = token.slice(SERVICE_TOKEN_PREFIX.length);
+  const dot = rest.indexOf(".");
+  if (dot <= 0 || dot === rest.length - 1) return undefined;
+  return { id: rest.slice(0, dot), secret: rest.slice(dot + 1) };
+}
+
+export async function hashServiceTokenSecret(secret: string): Promise<string> {
+  const digest = await crypto.subtle.digest(
+    "SHA-256",
+    new TextEncoder().encode(secret),
+  );
+  return Array.from(new Uint8Array(digest))
+    .map((b) => b.toString(16).padStart(2, "0"))
+    .join("");
+}
+
+/**
+ * Verify a token secret against the stored hash in constant time.
+ */
+export async function verifyServiceTokenSecret(
+  secret: string,
+  secretHash: string,
+): Promise<boolean> {
+  const actual = await hashServiceTokenSecret(secret);
+  if (actual.length !== secretHash.length) return false;
+  let diff = 0;
+  for (let i = 0; i < actual.length; i++) {
+    diff |= actual.charCodeAt(i) ^ secretHash.charCodeAt(i);
+  }
+  return diff === 0;
+}
+
+/**
+ * Check whether a record can be exchanged for an access token now.
+ * Returns an error code, or undefined if the token is usable.
+ */
+export function serviceTokenUnusableReason(
+  record: ServiceTokenRecord,
+  now: Date = new Date(),
+): string | undefined {
+  if (record.status === "pending") return "token_not_approved";
+  if (record.status === "revoked") return "token_revoked";
+  if (!record.secretHash) return "token_not_approved";
+  if (record.expiresAt && new Date(record.expiresAt).getTime() <= now.getTime()) {
+    return "token_expired";
+  }
+  return undefined;
+}
+
+/**
+ * Public view of a record (without the sealed refresh token and secret hash).
+ */
+export type PublicServiceToken = Omit<ServiceTokenRecord, "encryptedRefreshToken" | "secretHash">;
+
+export function toPublicServiceToken(record: ServiceTokenRecord): PublicServiceToken {
+  const { encryptedRefreshToken: _rt, secretHash: _hash, ...rest } = record;
+  return rest;
+}
+
+/**
+ * In-memory service token store.
+ * Tokens are lost on restart; provide a persistent store for production.
+ */
+export class MemoryServiceTokenStore implements ServiceTokenStore {
+  private records = new Map<string, ServiceTokenRecord>();
+
+  async get(id: string): Promise<ServiceTokenRecord | undefined> {
+    const record = this.records.get(id);
+    return record ? { ...record } : undefined;
+  }
+
+  async list(tenantName: string): Promise<ServiceTokenRecord[]> {
+    return [...this.records.values()]
+      .filter((r) => r.tenant === tenantName)
+      .map((r) => ({ ...r }));
+  }
+
+  async put(record: ServiceTokenRecord): Promise<void> {
+    this.records.set(record.id, { ...record });
+  }
+}
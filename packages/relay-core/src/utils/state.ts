@@ -11,6 +11,8 @@
 export interface EncodedStateClaims {
   /** CLI callback port */
   port: number;
+  /** CLI callback host as seen from the browser (default: localhost) */
+  host?: string;
   /** CLI-generated state for CSRF protection */
   cliState: string;
   /** Backlog space host (e.g., "myspace.backlog.jp") */
@@ -56,6 +58,10 @@ export function decodeState(encoded: string): EncodedStateClaims {
     throw new Error("Invalid state claims");
   }
 
+  if (claims.host !== undefined && typeof claims.host !== "string") {
+    throw new Error("Invalid state claims");
+  }
+
   // domain is optional (for backward compat with old encoded states)
 
   return claims;
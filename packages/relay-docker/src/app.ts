@@ -26,6 +26,8 @@ import {
   type AuditLogReader,
   type PassphraseManager,
   type PortalAssets,
+  type ServiceTokenStore,
+  type CliSessionStore,
 } from "@yacchi/backlog-relay-core";
 import { CloudWatchLogsAuditReader } from "./audit-reader.js";
 import { SecretsManagerPassphraseManager } from "./passphrase-manager.js";
@@ -106,6 +108,10 @@ export interface CreateUnifiedAppOptions {
   secretName?: string;
   /** 設定キャッシュ無効化コールバック（パスフレーズ更新後に呼ばれる）。 */
   onConfigInvalidate?: () => void;
+  /** サービストークン（CI / bot 向けの長期トークン）のストア。省略時はサービストークンを無効化する。 */
+  serviceTokenStore?: ServiceTokenStore;
+  /** CLI ログインセッションのストア。省略時は `backlog auth sessions` を無効化する。 */
+  cliSessionStore?: CliSessionStore;
   /** MCP の `backlog` ツール用の Backlog CLI バイナリパス。 */
   binPath?: string;
   /**
@@ -268,6 +274,12 @@ export async function createUnifiedApp(
     enablePortalOAuth: !!serverJwks,
     auditLogReader,
     passphraseManager,
+    serviceTokenStore: options.serviceTokenStore,
+    cliSessionStore: options.cliSessionStore,
+    // クライアント証明書は index.ts のリスナーで検証し、ヘッダーを付け直している
+    trustClientCertHeader: true,
+    // 接続元のソケットアドレスも index.ts のリスナーで付け直している
+    trustClientAddrHeader: true,
   });
 
   // Request ID middleware — reuse Lambda Web Adapter's x-amzn-request-id when
@@ -1,6 +1,10 @@
 import { describe, it, expect } from "vitest";
+import { mkdtemp, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
 import {
   EnvConfigSource,
+  FileConfigSource,
   AwsConfigSource,
   selectConfigSource,
   mergeSecrets,
@@ -23,6 +27,19 @@ describe("EnvConfigSource", () => {
   });
 });
 
+describe("FileConfigSource", () => {
+  it("re-reads the file on every load", async () => {
+    const dir = await mkdtemp(join(tmpdir(), "relay-config-"));
+    const path = join(dir, "config.json");
+    await writeFile(path, JSON.stringify({ server: { port: 9000 } }));
+    const source = new FileConfigSource(path);
+    expect(await source.loadRawConfig()).toEqual({ server: { port: 9000 } });
+
+    await writeFile(path, JSON.stringify({ server: { port: 9001 } }));
+    expect(await source.loadRawConfig()).toEqual({ server: { port: 9001 } });
+  });
+});
+
 describe("mergeSecrets", () => {
   it("injects client_secret into backlog_app", () => {
     const raw: Record<string, unknown> = {
@@ -106,7 +123,69 @@ describe("selectConfigSource", () => {
     expect(source).toBeInstanceOf(EnvConfigSource);
   });
 
+  it("selects FileConfigSource when RELAY_CONFIG_FILE is set", () => {
+    const source = selectConfigSource({
+      [CONFIG_ENV_VARS.RELAY_CONFIG_FILE]: "/etc/relay/config.json",
+      [CONFIG_ENV_VARS.CONFIG_PARAMETER_NAME]: "/x",
+    } as NodeJS.ProcessEnv);
+    expect(source).toBeInstanceOf(FileConfigSource);
+  });
+
   it("throws when neither is set", () => {
     expect(() => selectConfigSource({} as NodeJS.ProcessEnv)).toThrow();
   });
 });
+
+describe("AwsConfigSource", () => {
+  it("uses the snapshot instead of SSM and parses it on every load", async () => {
+    const snapshot = JSON.stringify({ backlog_app: { client_id: "cid" } });
+    const source = new AwsConfigSource("/backlog-relay/config", undefined, {
+      snapshot,
+    });
+    const first = await source.loadRawConfig();
+    expect(first).toEqual({ backlog_app: { client_id: "cid" } });
+
+    first.mutated = true;
+    source.invalidateCache();
+    expect(await source.loadRawConfig()).toEqual({
+      backlog_app: { client_id: "cid" },
+    });
+  });
+
+  it("reads the parameter and secret through the Lambda extension", async () => {
+    const requested: string[] = [];
+    const fakeFetch = (async (input: string | URL | Request) => {
+      const url = String(input);
+      requested.push(url);
+      const body = url.includes("/systemsmanager/")
+        ? { Parameter: { Value: JSON.stringify({ backlog_app: { client_id: "cid" } }) } }
+        : { SecretString: JSON.stringify({ app: { client_secret: "shhh" } }) };
+      return new Response(JSON.stringify(body), { status: 200 });
+    }) as typeof fetch;
+
+    const source = new AwsConfigSource("/backlog-relay/config", "relay-secrets", {
+      extensionPort: 2773,
+      fetch: fakeFetch,
+    });
+    const raw = await source.loadRawConfig();
+
+    expect(raw.backlog_app).toEqual({ client_id: "cid", client_secret: "shhh" });
+    expect(requested.sort()).toEqual([
+      "http://localhost:2773/secretsmanager/get?secretId=relay-secrets",
+      "http://localhost:2773/systemsmanager/parameters/get?name=%2Fbacklog-relay%2Fconfig&withDecryption=true",
+    ]);
+  });
+});
+
+describe("selectConfigSource (AWS options)", () => {
+  it("enables the extension with the default port", async () => {
+    const source = selectConfigSource({
+      [CONFIG_ENV_VARS.CONFIG_PARAMETER_NAME]: "/x",
+      [CONFIG_ENV_VARS.CONFIG_USE_EXTENSION]: "true",
+      [CONFIG_ENV_VARS.CONFIG_SNAPSHOT]: JSON.stringify({ foo: "bar" }),
+    } as NodeJS.ProcessEnv);
+    expect(source).toBeInstanceOf(AwsConfigSource);
+    // スナップショットがあれば SSM（Extension を含む）は読まない
+    expect(await source.loadRawConfig()).toEqual({ foo: "bar" });
+  });
+});
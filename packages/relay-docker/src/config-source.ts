@@ -5,6 +5,8 @@
  *
  * - {@link EnvConfigSource}: `RELAY_CONFIG`（JSON、secrets インライン）を読む。
  *   Docker / ローカル実行で使用。
+ * - {@link FileConfigSource}: `RELAY_CONFIG_FILE` の JSON ファイルを読む。K8s の ConfigMap / Secret
+ *   をマウントする常駐デプロイ向けで、読み込みのたびにファイルを読み直すため設定リロードに追従する。
  * - {@link AwsConfigSource}: SSM Parameter Store + Secrets Manager を読み、
  *   secrets を raw 設定にマージする。同一イメージを Lambda コンテナとして動かす際に使用
  *   （`CONFIG_PARAMETER_NAME` / `RELAY_SECRETS_NAME` 設定時）。
@@ -14,10 +16,16 @@
  * `mcp_default_spaces`）も保持する。これらは Zod が `RelayConfig` から除去するが、
  * {@link ./app.buildMcpConfig} が直接読む。
  *
+ * AWS モードではコールドスタートの待ち時間を減らすため、SSM と Secrets Manager を並列に読む。
+ * CDK が合成時に埋め込んだ設定スナップショット（`CONFIG_SNAPSHOT`）があれば SSM は読まず、
+ * `CONFIG_USE_EXTENSION=true` なら AWS Parameters and Secrets Lambda Extension 経由で読む
+ * （失敗時は SDK にフォールバック）。
+ *
  * AWS SDK クライアントは static import する。コンテナイメージは常に依存を同梱するため、
  * dynamic import の利得は env モード起動時の数十ms 程度に留まり、コードの複雑さに見合わない。
  */
 
+import { readFile } from "node:fs/promises";
 import { SSMClient, GetParameterCommand } from "@aws-sdk/client-ssm";
 import {
   SecretsManagerClient,
@@ -30,12 +38,35 @@ import {
 export const CONFIG_ENV_VARS = {
   /** インライン JSON 設定（Docker / ローカル）。secrets はインライン前提。 */
   RELAY_CONFIG: "RELAY_CONFIG",
+  /** JSON 設定ファイルのパス（K8s / ECS の常駐デプロイ）。secrets はインライン前提。 */
+  RELAY_CONFIG_FILE: "RELAY_CONFIG_FILE",
   /** 非機密設定を保持する SSM Parameter Store 名（AWS）。 */
   CONFIG_PARAMETER_NAME: "CONFIG_PARAMETER_NAME",
   /** client_secret / jwks / passphrase_hash を保持する Secrets Manager 名（AWS）。 */
   RELAY_SECRETS_NAME: "RELAY_SECRETS_NAME",
+  /** SSM パラメータと同じ内容のスナップショット（JSON、AWS）。あれば SSM を読まない。 */
+  CONFIG_SNAPSHOT: "CONFIG_SNAPSHOT",
+  /** `true` で Parameters and Secrets Lambda Extension 経由で読む（AWS）。 */
+  CONFIG_USE_EXTENSION: "CONFIG_USE_EXTENSION",
+  /** Extension の HTTP ポート（Extension 自体の設定と共通、既定 2773）。 */
+  EXTENSION_HTTP_PORT: "PARAMETERS_SECRETS_EXTENSION_HTTP_PORT",
 } as const;
 
+/** Parameters and Secrets Lambda Extension の既定ポート。 */
+const DEFAULT_EXTENSION_PORT = 2773;
+
+/**
+ * {@link AwsConfigSource} の読み込み方法のオプション。
+ */
+export interface AwsConfigSourceOptions {
+  /** SSM パラメータの代わりに使うスナップショット（JSON 文字列）。 */
+  snapshot?: string;
+  /** 指定時は localhost のこのポートの Lambda Extension 経由で読む。 */
+  extensionPort?: number;
+  /** Extension への HTTP リクエストに使う fetch（テスト用）。 */
+  fetch?: typeof fetch;
+}
+
 /**
  * secrets をマージ済みの raw relay 設定のソース。
  */
@@ -109,17 +140,44 @@ export class EnvConfigSource implements ConfigSource {
 }
 
 /**
- * 非機密設定を SSM から読み、Secrets Manager の secrets をマージする。
- * 結果はインスタンスの生存期間中キャッシュする。
+ * `RELAY_CONFIG_FILE` の JSON 設定ファイルを読む。
+ * キャッシュせず毎回読み直すため、ファイルを差し替えてからリロードすれば新しい設定が反映される。
+ */
+export class FileConfigSource implements ConfigSource {
+  readonly path: string;
+
+  constructor(path: string) {
+    this.path = path;
+  }
+
+  async loadRawConfig(): Promise<Record<string, unknown>> {
+    const json = await readFile(this.path, "utf8");
+    return JSON.parse(json) as Record<string, unknown>;
+  }
+}
+
+// SDK クライアントはプロセス内で共有する（認証情報の解決と TLS 接続を使い回す）。
+let ssmClient: SSMClient | undefined;
+let secretsClient: SecretsManagerClient | undefined;
+
+/**
+ * 非機密設定を SSM（またはスナップショット）から読み、Secrets Manager の secrets をマージする。
+ * 両者は並列に読む。結果はインスタンスの生存期間中キャッシュする。
  */
 export class AwsConfigSource implements ConfigSource {
   private cached: Record<string, unknown> | null = null;
   private readonly parameterName: string;
   readonly secretName?: string;
+  private readonly options: AwsConfigSourceOptions;
 
-  constructor(parameterName: string, secretName?: string) {
+  constructor(
+    parameterName: string,
+    secretName?: string,
+    options: AwsConfigSourceOptions = {},
+  ) {
     this.parameterName = parameterName;
     this.secretName = secretName;
+    this.options = options;
   }
 
   invalidateCache(): void {
@@ -131,9 +189,11 @@ export class AwsConfigSource implements ConfigSource {
       return this.cached;
     }
 
-    const raw = await this.loadParameter();
-    if (this.secretName) {
-      const secrets = await this.loadSecrets(this.secretName);
+    const [raw, secrets] = await Promise.all([
+      this.loadParameter(),
+      this.secretName ? this.loadSecrets(this.secretName) : undefined,
+    ]);
+    if (secrets) {
       mergeSecrets(raw, secrets);
     }
 
@@ -142,38 +202,93 @@ export class AwsConfigSource implements ConfigSource {
   }
 
   private async loadParameter(): Promise<Record<string, unknown>> {
-    const client = new SSMClient({});
-    const response = await client.send(
-      new GetParameterCommand({
-        Name: this.parameterName,
-        WithDecryption: true,
-      }),
+    // mergeSecrets が破壊的に書き換えるため、スナップショットも毎回パースし直す
+    if (this.options.snapshot) {
+      return JSON.parse(this.options.snapshot) as Record<string, unknown>;
+    }
+
+    const value = await this.viaExtension(
+      `/systemsmanager/parameters/get?name=${encodeURIComponent(this.parameterName)}&withDecryption=true`,
+      (body) => (body as { Parameter?: { Value?: string } }).Parameter?.Value,
+      async () => {
+        ssmClient ??= new SSMClient({});
+        const response = await ssmClient.send(
+          new GetParameterCommand({
+            Name: this.parameterName,
+            WithDecryption: true,
+          }),
+        );
+        return response.Parameter?.Value;
+      },
     );
-    if (!response.Parameter?.Value) {
+    if (!value) {
       throw new Error(
         `SSM parameter ${this.parameterName} not found or empty`,
       );
     }
-    return JSON.parse(response.Parameter.Value) as Record<string, unknown>;
+    return JSON.parse(value) as Record<string, unknown>;
   }
 
   private async loadSecrets(secretName: string): Promise<RelaySecrets> {
-    const client = new SecretsManagerClient({});
-    const response = await client.send(
-      new GetSecretValueCommand({ SecretId: secretName }),
+    const value = await this.viaExtension(
+      `/secretsmanager/get?secretId=${encodeURIComponent(secretName)}`,
+      (body) => (body as { SecretString?: string }).SecretString,
+      async () => {
+        secretsClient ??= new SecretsManagerClient({});
+        const response = await secretsClient.send(
+          new GetSecretValueCommand({ SecretId: secretName }),
+        );
+        return response.SecretString;
+      },
     );
-    if (!response.SecretString) {
+    if (!value) {
       throw new Error(`Secret ${secretName} not found or empty`);
     }
-    return JSON.parse(response.SecretString) as RelaySecrets;
+    return JSON.parse(value) as RelaySecrets;
+  }
+
+  /**
+   * Extension が有効なら Extension の HTTP API から値を読み、無効または失敗時は SDK で読む。
+   */
+  private async viaExtension(
+    path: string,
+    extract: (body: unknown) => string | undefined,
+    viaSdk: () => Promise<string | undefined>,
+  ): Promise<string | undefined> {
+    const port = this.options.extensionPort;
+    if (!port) {
+      return viaSdk();
+    }
+    try {
+      const doFetch = this.options.fetch ?? fetch;
+      const response = await doFetch(`http://localhost:${port}${path}`, {
+        headers: {
+          "X-Aws-Parameters-Secrets-Token":
+            process.env["AWS_SESSION_TOKEN"] ?? "",
+        },
+      });
+      if (!response.ok) {
+        throw new Error(`status ${response.status}`);
+      }
+      const value = extract(await response.json());
+      if (value) {
+        return value;
+      }
+      throw new Error("empty response");
+    } catch (err) {
+      console.warn(
+        `Parameters and Secrets Lambda Extension unavailable (${String(err)}); falling back to AWS SDK`,
+      );
+      return viaSdk();
+    }
   }
 }
 
 /**
  * 環境変数から適切な設定ソースを選択する。
  *
- * `RELAY_CONFIG` があれば優先（env モード）、無ければ `CONFIG_PARAMETER_NAME` で
- * AWS モードを選ぶ。どちらも無ければエラー。
+ * `RELAY_CONFIG` があれば優先（env モード）、次に `RELAY_CONFIG_FILE`（file モード）、
+ * 無ければ `CONFIG_PARAMETER_NAME` で AWS モードを選ぶ。いずれも無ければエラー。
  */
 export function selectConfigSource(
   env: NodeJS.ProcessEnv = process.env,
@@ -183,15 +298,28 @@ export function selectConfigSource(
     return new EnvConfigSource(envConfig);
   }
 
+  const configFile = env[CONFIG_ENV_VARS.RELAY_CONFIG_FILE];
+  if (configFile) {
+    return new FileConfigSource(configFile);
+  }
+
   const parameterName = env[CONFIG_ENV_VARS.CONFIG_PARAMETER_NAME];
   if (parameterName) {
+    const useExtension = env[CONFIG_ENV_VARS.CONFIG_USE_EXTENSION] === "true";
     return new AwsConfigSource(
       parameterName,
       env[CONFIG_ENV_VARS.RELAY_SECRETS_NAME],
+      {
+        snapshot: env[CONFIG_ENV_VARS.CONFIG_SNAPSHOT] || undefined,
+        extensionPort: useExtension
+          ? Number(env[CONFIG_ENV_VARS.EXTENSION_HTTP_PORT]) ||
+            DEFAULT_EXTENSION_PORT
+          : undefined,
+      },
     );
   }
 
   throw new Error(
-    `Either ${CONFIG_ENV_VARS.RELAY_CONFIG} or ${CONFIG_ENV_VARS.CONFIG_PARAMETER_NAME} environment variable is required`,
+    `One of ${CONFIG_ENV_VARS.RELAY_CONFIG}, ${CONFIG_ENV_VARS.RELAY_CONFIG_FILE} or ${CONFIG_ENV_VARS.CONFIG_PARAMETER_NAME} environment variable is required`,
   );
 }
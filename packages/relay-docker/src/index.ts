@@ -9,9 +9,14 @@
  *   （`CONFIG_PARAMETER_NAME` / `RELAY_SECRETS_NAME`）、Lambda Web Adapter 経由
  *
  * 設定ソースは自動選択される — {@link ./config-source} を参照。
+ * 常駐デプロイ（ECS / K8s）では SIGHUP または `POST /admin/reload` で設定をホットリロードできる
+ * — {@link ./reloader} を参照。
+ * `server.tls` を設定すると HTTPS を終端し、`server.tls.client_ca` でクライアント証明書を検証する
+ * — {@link ./tls} を参照。
  */
 
-import { serve } from "@hono/node-server";
+import { serve, type HttpBindings, type Http2Bindings } from "@hono/node-server";
+import { createServer as createHttpsServer } from "node:https";
 import { dirname, resolve } from "node:path";
 import { fileURLToPath } from "node:url";
 import {
@@ -19,9 +24,16 @@ import {
   type McpServerConfig,
   type CreateMcpAppOptions,
 } from "@yacchi/backlog-mcp-server";
+import {
+  MemoryCliSessionStore,
+  MemoryServiceTokenStore,
+  type ServerTLSConfig,
+} from "@yacchi/backlog-relay-core";
 import { loadPortalAssets } from "./portal-assets.js";
 import { selectConfigSource, AwsConfigSource } from "./config-source.js";
 import { createUnifiedApp, restoreMcpAuthorization } from "./app.js";
+import { AppReloader, handleReloadRequest } from "./reloader.js";
+import { applyConnectionInfo, loadTLSOptions } from "./tls.js";
 
 const __dirname = dirname(fileURLToPath(import.meta.url));
 
@@ -34,6 +46,12 @@ export const ENV_VARS = {
   WEB_DIST_PATH: "WEB_DIST_PATH",
   BACKLOG_BIN_PATH: "BACKLOG_BIN_PATH",
   SANDBOX_WORKER_PATH: "SANDBOX_WORKER_PATH",
+  /** サービストークンのストア（"memory" で有効化。単一インスタンス・再起動で消える前提） */
+  SERVICE_TOKEN_STORE: "SERVICE_TOKEN_STORE",
+  /** CLI ログインセッションのストア（"memory" で有効化。再起動で消えるが、既存のログインは次回の更新で再登録される） */
+  SESSION_STORE: "SESSION_STORE",
+  /** 管理エンドポイント `POST /admin/reload` の Bearer トークン（未設定ならエンドポイント無効） */
+  RELOAD_TOKEN: "RELOAD_TOKEN",
 } as const;
 
 /**
@@ -114,29 +132,55 @@ export async function startServer(): Promise<void> {
   const onConfigInvalidate = configSource instanceof AwsConfigSource
     ? () => configSource.invalidateCache()
     : undefined;
-
-  const app = await createUnifiedApp({
-    rawConfig,
-    portalAssets,
-    binPath: process.env[ENV_VARS.BACKLOG_BIN_PATH],
-    createRunScript,
-    auditLogGroupName,
-    secretName,
-    onConfigInvalidate,
+  // サービストークンと CLI セッションはメモリにしか無いため、リロードをまたいで同じストアを使う
+  const serviceTokenStore =
+    process.env[ENV_VARS.SERVICE_TOKEN_STORE] === "memory"
+      ? new MemoryServiceTokenStore()
+      : undefined;
+  const cliSessionStore =
+    process.env[ENV_VARS.SESSION_STORE] === "memory"
+      ? new MemoryCliSessionStore()
+      : undefined;
+
+  const buildApp = (config: Record<string, unknown>) =>
+    createUnifiedApp({
+      rawConfig: config,
+      portalAssets,
+      binPath: process.env[ENV_VARS.BACKLOG_BIN_PATH],
+      createRunScript,
+      auditLogGroupName,
+      secretName,
+      onConfigInvalidate,
+      serviceTokenStore,
+      cliSessionStore,
+    });
+
+  const reloader = new AppReloader(await buildApp(rawConfig), async () => {
+    onConfigInvalidate?.();
+    return buildApp(await configSource.loadRawConfig());
   });
+  process.on("SIGHUP", () => {
+    console.log("SIGHUP received, reloading configuration");
+    void reloader.reload();
+  });
+  const reloadToken = process.env[ENV_VARS.RELOAD_TOKEN] || undefined;
 
   // ポートの優先順位: PORT 環境変数（Lambda Web Adapter が設定）> config > 8080。
-  const serverConfig = (rawConfig.server ?? {}) as { port?: number };
+  // リロードではリスナーを作り直さないため、host / port / tls の変更には再起動が必要。
+  const serverConfig = (rawConfig.server ?? {}) as { port?: number; tls?: ServerTLSConfig };
   const port =
     Number(process.env[ENV_VARS.PORT]) || serverConfig.port || 8080;
   const host = process.env[ENV_VARS.HOST] || "0.0.0.0";
 
+  const tlsOptions = await loadTLSOptions(serverConfig.tls);
+
   const mcpEnabled = Array.isArray(rawConfig.mcp_spaces)
     ? (rawConfig.mcp_spaces as unknown[]).length > 0
     : false;
 
   console.log(
-    `Starting Backlog Relay${mcpEnabled ? " + MCP" : ""} server on ${host}:${port}`,
+    `Starting Backlog Relay${mcpEnabled ? " + MCP" : ""} server on ${host}:${port}` +
+      (tlsOptions ? ` (TLS${tlsOptions.requestCert ? ", client certificates" : ""})` : ""),
   );
   if (portalAssets) {
     console.log(`Portal assets loaded from: ${webDistPath}`);
@@ -144,13 +188,26 @@ export async function startServer(): Promise<void> {
     console.log("Portal assets not available (build web package first)");
   }
 
-  serve({
-    // restoreMcpAuthorization は CloudFront 外では no-op。同一イメージが OAC 配下の
-    // Lambda コンテナでも動くよう組み込んでいる。
-    fetch: (request: Request) => app.fetch(restoreMcpAuthorization(request)),
-    port,
-    hostname: host,
-  });
+  // restoreMcpAuthorization は CloudFront 外では no-op。同一イメージが OAC 配下の
+  // Lambda コンテナでも動くよう組み込んでいる。
+  const fetch = async (request: Request, env: HttpBindings | Http2Bindings) => {
+    const req = applyConnectionInfo(request, env.incoming, !!tlsOptions);
+    return (
+      (await handleReloadRequest(req, reloader, reloadToken)) ??
+      reloader.fetch(restoreMcpAuthorization(req))
+    );
+  };
+  if (tlsOptions) {
+    serve({
+      fetch,
+      port,
+      hostname: host,
+      createServer: createHttpsServer,
+      serverOptions: tlsOptions,
+    });
+  } else {
+    serve({ fetch, port, hostname: host });
+  }
 }
 
 // 直接実行時（= コンテナのエントリポイント）に自動起動する。
@@ -165,5 +222,7 @@ export { createUnifiedApp } from "./app.js";
 export {
   selectConfigSource,
   EnvConfigSource,
+  FileConfigSource,
   AwsConfigSource,
 } from "./config-source.js";
+export { AppReloader, handleReloadRequest } from "./reloader.js";
@@ -0,0 +1,83 @@
+import { describe, it, expect } from "vitest";
+import { Hono } from "hono";
+import { AppReloader, handleReloadRequest, RELOAD_PATH } from "./reloader.js";
+
+function appReturning(body: string): Hono {
+  const app = new Hono();
+  app.get("/", (c) => c.text(body));
+  return app;
+}
+
+describe("AppReloader", () => {
+  it("swaps the app after a successful reload", async () => {
+    let version = 1;
+    const reloader = new AppReloader(appReturning("v1"), async () =>
+      appReturning(`v${++version}`),
+    );
+    expect(await (await reloader.fetch(new Request("http://relay/"))).text()).toBe("v1");
+
+    const result = await reloader.reload();
+    expect(result.ok).toBe(true);
+    expect(await (await reloader.fetch(new Request("http://relay/"))).text()).toBe("v2");
+  });
+
+  it("keeps the current app when the new config is invalid", async () => {
+    const original = appReturning("v1");
+    const reloader = new AppReloader(original, async () => {
+      throw new Error("invalid config");
+    });
+
+    const result = await reloader.reload();
+    expect(result).toMatchObject({ ok: false, error: "invalid config" });
+    expect(reloader.current).toBe(original);
+  });
+
+  it("runs reloads one after another", async () => {
+    const order: string[] = [];
+    let count = 0;
+    const reloader = new AppReloader(appReturning("v0"), async () => {
+      const n = ++count;
+      order.push(`start${n}`);
+      await new Promise((r) => setTimeout(r, 5));
+      order.push(`end${n}`);
+      return appReturning(`v${n}`);
+    });
+
+    await Promise.all([reloader.reload(), reloader.reload()]);
+    expect(order).toEqual(["start1", "end1", "start2", "end2"]);
+    expect(await (await reloader.fetch(new Request("http://relay/"))).text()).toBe("v2");
+  });
+});
+
+describe("handleReloadRequest", () => {
+  const reloader = new AppReloader(appReturning("v1"), async () => appReturning("v2"));
+  const url = `http://relay${RELOAD_PATH}`;
+
+  it("ignores other paths", async () => {
+    expect(await handleReloadRequest(new Request("http://relay/"), reloader, "secret")).toBeUndefined();
+  });
+
+  it("is disabled without a token", async () => {
+    const res = await handleReloadRequest(new Request(url, { method: "POST" }), reloader, undefined);
+    expect(res?.status).toBe(404);
+  });
+
+  it("rejects a wrong token", async () => {
+    const res = await handleReloadRequest(
+      new Request(url, { method: "POST", headers: { Authorization: "Bearer wrong" } }),
+      reloader,
+      "secret",
+    );
+    expect(res?.status).toBe(401);
+  });
+
+  it("reloads with the right token", async () => {
+    const res = await handleReloadRequest(
+      new Request(url, { method: "POST", headers: { Authorization: "Bearer secret" } }),
+      reloader,
+      "secret",
+    );
+    expect(res?.status).toBe(200);
+    expect(await res?.json()).toMatchObject({ ok: true });
+  });
+});
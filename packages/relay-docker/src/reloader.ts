@@ -0,0 +1,111 @@
+/**
+ * 設定のホットリロード（常駐デプロイ向け）。
+ *
+ * ECS / K8s などの常駐プロセスで、テナント追加・鍵更新・レートリミット変更を再起動なしで
+ * 反映する。リロードは SIGHUP または管理エンドポイント（`POST /admin/reload`）で起動する。
+ *
+ * 新しい設定でアプリを丸ごと組み立て直し、成功したときだけ差し替える。
+ * 組み立て中も既存のアプリがリクエストを処理し続け、処理中のリクエストは古いアプリで完了するため
+ * ダウンタイムは発生しない。設定が不正な場合は差し替えず、既存の設定で動作を続ける。
+ *
+ * 制約:
+ * - host / port は再起動が必要（リスナーは作り直さない）
+ * - レートリミットのカウンタなど、アプリ内のメモリ状態はリロードで初期化される
+ */
+
+import { timingSafeEqual } from "node:crypto";
+import type { Hono } from "hono";
+
+/** 管理エンドポイントのパス。 */
+export const RELOAD_PATH = "/admin/reload";
+
+/**
+ * リロードの結果。
+ */
+export interface ReloadResult {
+  ok: boolean;
+  /** 失敗時のエラーメッセージ。 */
+  error?: string;
+  /** リロードを完了（または失敗）した時刻（ISO 8601）。 */
+  at: string;
+}
+
+/**
+ * 現在のアプリを保持し、リロード時に差し替える。
+ */
+export class AppReloader {
+  private app: Hono;
+  private readonly build: () => Promise<Hono>;
+  // リロードは直列に実行する（連続した SIGHUP でも最後の設定が必ず反映される）
+  private queue: Promise<unknown> = Promise.resolve();
+
+  constructor(app: Hono, build: () => Promise<Hono>) {
+    this.app = app;
+    this.build = build;
+  }
+
+  /** 現在のアプリ。 */
+  get current(): Hono {
+    return this.app;
+  }
+
+  /** 現在のアプリでリクエストを処理する。 */
+  fetch(request: Request): Response | Promise<Response> {
+    return this.app.fetch(request);
+  }
+
+  /**
+   * 設定を読み直してアプリを差し替える。失敗した場合は既存のアプリを使い続ける。
+   */
+  reload(): Promise<ReloadResult> {
+    const result = this.queue.then(() => this.doReload());
+    this.queue = result;
+    return result;
+  }
+
+  private async doReload(): Promise<ReloadResult> {
+    try {
+      this.app = await this.build();
+      console.log("Configuration reloaded");
+      return { ok: true, at: new Date().toISOString() };
+    } catch (err) {
+      const message = err instanceof Error ? err.message : String(err);
+      console.error(`Configuration reload failed; keeping the current configuration: ${message}`);
+      return { ok: false, error: message, at: new Date().toISOString() };
+    }
+  }
+}
+
+/**
+ * 管理エンドポイント `POST /admin/reload` を処理する。
+ *
+ * `Authorization: Bearer <token>` が `token` と一致する場合だけリロードする。
+ * `token` が未設定ならエンドポイントは無効（404）。対象外のリクエストには undefined を返す。
+ */
+export async function handleReloadRequest(
+  request: Request,
+  reloader: AppReloader,
+  token: string | undefined,
+): Promise<Response | undefined> {
+  if (new URL(request.url).pathname !== RELOAD_PATH) {
+    return undefined;
+  }
+  if (!token) {
+    return Response.json({ error: "not_found" }, { status: 404 });
+  }
+  if (request.method !== "POST") {
+    return Response.json({ error: "method_not_allowed" }, { status: 405 });
+  }
+  const auth = request.headers.get("authorization") ?? "";
+  if (!auth.startsWith("Bearer ") || !safeEqual(auth.slice("Bearer ".length), token)) {
+    return Response.json({ error: "unauthorized" }, { status: 401 });
+  }
+  const result = await reloader.reload();
+  return Response.json(result, { status: result.ok ? 200 : 500 });
+}
+
+function safeEqual(a: string, b: string): boolean {
+  const bufA = Buffer.from(a);
+  const bufB = Buffer.from(b);
+  return bufA.length === bufB.length && timingSafeEqual(bufA, bufB);
+}
@@ -0,0 +1,81 @@
+import { describe, it, expect } from "vitest";
+import { mkdtemp, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import type { Socket } from "node:net";
+import { CLIENT_ADDR_HEADER, CLIENT_CERT_HEADER } from "@yacchi/backlog-relay-core";
+import { applyConnectionInfo, loadTLSOptions } from "./tls.js";
+
+function fakeIncoming(socket: Record<string, unknown>): { socket: Socket } {
+  return { socket: socket as unknown as Socket };
+}
+
+describe("loadTLSOptions", () => {
+  it("returns undefined without server.tls", async () => {
+    expect(await loadTLSOptions(undefined)).toBeUndefined();
+  });
+
+  it("requests client certificates only when client_ca is set", async () => {
+    const dir = await mkdtemp(join(tmpdir(), "relay-tls-"));
+    for (const name of ["cert.pem", "key.pem", "ca.pem"]) {
+      await writeFile(join(dir, name), name);
+    }
+    const files = { cert_file: join(dir, "cert.pem"), key_file: join(dir, "key.pem") };
+
+    const plain = await loadTLSOptions(files);
+    expect(plain?.requestCert).toBeUndefined();
+
+    const mtls = await loadTLSOptions({ ...files, client_ca: join(dir, "ca.pem") });
+    expect(mtls).toMatchObject({ requestCert: true, rejectUnauthorized: false });
+    expect(String(mtls?.ca)).toBe("ca.pem");
+  });
+});
+
+describe("applyConnectionInfo", () => {
+  it("strips a forged certificate header on plain HTTP", () => {
+    const req = new Request("http://relay/auth/token", {
+      headers: { [CLIENT_CERT_HEADER]: "forged", "x-forwarded-for": "10.0.0.1" },
+    });
+    const out = applyConnectionInfo(req, undefined, false);
+    expect(out.headers.get(CLIENT_CERT_HEADER)).toBeNull();
+    expect(out.headers.get("x-forwarded-for")).toBe("10.0.0.1");
+  });
+
+  it("passes the socket address and strips a forged one on plain HTTP", () => {
+    const req = new Request("http://relay/auth/token", {
+      headers: { [CLIENT_ADDR_HEADER]: "10.0.0.1", "x-forwarded-for": "10.0.0.1" },
+    });
+    const out = applyConnectionInfo(req, fakeIncoming({ remoteAddress: "203.0.113.5" }), false);
+    expect(out.headers.get(CLIENT_ADDR_HEADER)).toBe("203.0.113.5");
+  });
+
+  it("sets the verified subject and socket address when terminating TLS", () => {
+    const req = new Request("https://relay/auth/token", {
+      headers: { "x-forwarded-for": "10.0.0.1" },
+    });
+    const out = applyConnectionInfo(
+      req,
+      fakeIncoming({
+        remoteAddress: "203.0.113.5",
+        authorized: true,
+        getPeerCertificate: () => ({ subject: { CN: "alice" } }),
+      }),
+      true,
+    );
+    expect(out.headers.get(CLIENT_CERT_HEADER)).toBe("alice");
+    expect(out.headers.get("x-real-ip")).toBe("203.0.113.5");
+    expect(out.headers.get("x-forwarded-for")).toBeNull();
+  });
+
+  it("does not mark unverified certificates", () => {
+    const req = new Request("https://relay/auth/token", {
+      headers: { [CLIENT_CERT_HEADER]: "forged" },
+    });
+    const out = applyConnectionInfo(
+      req,
+      fakeIncoming({ remoteAddress: "203.0.113.5", authorized: false }),
+      true,
+    );
+    expect(out.headers.get(CLIENT_CERT_HEADER)).toBeNull();
+  });
+});
@@ -0,0 +1,94 @@
+/**
+ * 常駐デプロイ向けの TLS 終端と mTLS（クライアント証明書認証）。
+ *
+ * `server.tls` が設定されている場合、ロードバランサーを挟まずにこのプロセスで HTTPS を終端する。
+ * `server.tls.client_ca` を指定するとクライアント証明書を要求し、CA で検証できたクライアントの
+ * subject を {@link CLIENT_CERT_HEADER} でアプリに渡す。証明書の無い接続も TLS としては受け付け、
+ * トークン交換を許可するかどうかは relay-core の client access ミドルウェアが
+ * `access_control.allowed_cidrs` と合わせて判断する（社内ネットワークからは証明書不要）。
+ *
+ * 制約:
+ * - 証明書ファイルは起動時に読み込む（ホットリロードの対象外。変更には再起動が必要）
+ * - TLS を終端する構成ではプロキシを挟まない前提で、クライアント IP はソケットから取る
+ */
+
+import { readFile } from "node:fs/promises";
+import type { ServerOptions } from "node:https";
+import type { Socket } from "node:net";
+import type { TLSSocket } from "node:tls";
+import {
+  CLIENT_ADDR_HEADER,
+  CLIENT_CERT_HEADER,
+  type ServerTLSConfig,
+} from "@yacchi/backlog-relay-core";
+
+/** クライアント IP を表すヘッダー（relay-core の extractRequestContext が参照する）。 */
+const CLIENT_IP_HEADERS = [
+  "x-viewer-ip",
+  "cf-connecting-ip",
+  "x-real-ip",
+  "x-forwarded-for",
+];
+
+/**
+ * `server.tls` から HTTPS サーバーのオプションを組み立てる。未設定なら undefined。
+ */
+export async function loadTLSOptions(
+  tls: ServerTLSConfig | undefined,
+): Promise<ServerOptions | undefined> {
+  if (!tls) {
+    return undefined;
+  }
+  const [cert, key, ca] = await Promise.all([
+    readFile(tls.cert_file),
+    readFile(tls.key_file),
+    tls.client_ca ? readFile(tls.client_ca) : undefined,
+  ]);
+  if (!ca) {
+    return { cert, key };
+  }
+  return {
+    cert,
+    key,
+    ca,
+    requestCert: true,
+    // 証明書の無い接続も受け付け、許可はアプリ側で判断する（allowed_cidrs との併用）
+    rejectUnauthorized: false,
+  };
+}
+
+/**
+ * 接続元の情報をリクエストヘッダーに反映する。
+ *
+ * {@link CLIENT_CERT_HEADER} と {@link CLIENT_ADDR_HEADER} は外部から偽装できないよう常に取り除き、
+ * ソケットのアドレスを {@link CLIENT_ADDR_HEADER} に設定する（allowed_cidrs の判定に使われる）。
+ * TLS を終端している場合は検証済みのクライアント証明書の subject を設定し、
+ * 監査ログ用のクライアント IP もソケットのアドレスで上書きする。
+ */
+export function applyConnectionInfo(
+  request: Request,
+  incoming: { socket: Socket } | undefined,
+  tlsEnabled: boolean,
+): Request {
+  const headers = new Headers(request.headers);
+  headers.delete(CLIENT_CERT_HEADER);
+  headers.delete(CLIENT_ADDR_HEADER);
+  if (incoming?.socket.remoteAddress) {
+    headers.set(CLIENT_ADDR_HEADER, incoming.socket.remoteAddress);
+  }
+
+  if (tlsEnabled && incoming) {
+    const socket = incoming.socket as TLSSocket;
+    for (const name of CLIENT_IP_HEADERS) {
+      headers.delete(name);
+    }
+    if (socket.remoteAddress) {
+      headers.set("x-real-ip", socket.remoteAddress);
+    }
+    if (socket.authorized) {
+      const subject = socket.getPeerCertificate?.()?.subject;
+      headers.set(CLIENT_CERT_HEADER, subject?.CN || "verified");
+    }
+  }
+  return new Request(request, { headers });
+}
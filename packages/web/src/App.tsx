@@ -6,6 +6,7 @@ import LoginApiKey from "./pages/LoginApiKey";
 import LoginConfirm from "./pages/LoginConfirm";
 import LoginMethodSelect from "./pages/LoginMethodSelect";
 import LoginSetup from "./pages/LoginSetup";
+import MigratePreview from "./pages/MigratePreview";
 import Portal from "./pages/Portal";
 import PortalAdmin from "./pages/PortalAdmin";
 
@@ -17,6 +18,9 @@ export default function App() {
         <Route path="/portal/:name/admin" element={<PortalAdmin />} />
         <Route path="/portal/:name" element={<Portal />} />
 
+        {/* markdown migrate preview --serve */}
+        <Route path="/migrate/preview" element={<MigratePreview />} />
+
         {/* Auth routes */}
         <Route
           path="/auth/*"
@@ -25,6 +25,7 @@ const ACTION_OPTIONS = [
     { value: "", label: "すべて" },
     { value: "portal_verify", label: "パスフレーズ検証" },
     { value: "portal_download", label: "バンドルダウンロード" },
+    { value: "portal_bundle_url", label: "ダウンロードURL発行" },
     { value: "portal_provision", label: "プロビジョニング" },
     { value: "portal_oauth_start", label: "OAuth開始" },
     { value: "portal_oauth_login", label: "OAuthログイン" },
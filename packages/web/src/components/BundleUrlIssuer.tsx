@@ -0,0 +1,104 @@
+import { useState } from "react";
+import Button from "./Button";
+
+interface IssuedUrl {
+    url: string;
+    expires_at: string;
+}
+
+interface Props {
+    tenantName: string;
+    onApiError: (status: number, data: { error?: string }) => boolean;
+}
+
+const TTL_OPTIONS = [
+    { value: 600, label: "10分" },
+    { value: 3600, label: "1時間" },
+    { value: 86400, label: "24時間" },
+];
+
+export default function BundleUrlIssuer({ tenantName, onApiError }: Props) {
+    const [ttl, setTtl] = useState(TTL_OPTIONS[0].value);
+    const [issued, setIssued] = useState<IssuedUrl | null>(null);
+    const [issuing, setIssuing] = useState(false);
+    const [error, setError] = useState<string | null>(null);
+
+    const handleIssue = async () => {
+        setIssuing(true);
+        setError(null);
+        setIssued(null);
+        try {
+            const resp = await fetch(
+                `/api/v1/portal/${encodeURIComponent(tenantName)}/admin/bundle-url`,
+                {
+                    method: "POST",
+                    credentials: "same-origin",
+                    headers: { "Content-Type": "application/json" },
+                    body: JSON.stringify({ ttl }),
+                },
+            );
+            const data = await resp.json().catch(() => ({}));
+            if (!resp.ok) {
+                if (onApiError(resp.status, data)) return;
+                throw new Error(data.error || "発行に失敗しました");
+            }
+            setIssued(data as IssuedUrl);
+        } catch (err) {
+            setError(err instanceof Error ? err.message : "発行に失敗しました");
+        } finally {
+            setIssuing(false);
+        }
+    };
+
+    return (
+        <div className="space-y-5">
+            {error && (
+                <div className="rounded-2xl border border-rose-200 bg-rose-50 px-4 py-3 text-sm text-rose-700">
+                    {error}
+                </div>
+            )}
+
+            <div className="rounded-2xl border border-outline/60 bg-white/50 p-4">
+                <h3 className="mb-2 text-sm font-medium text-ink">ワンタイムダウンロードURLの発行</h3>
+                <p className="mb-3 text-xs text-ink/60">
+                    設定バンドルを1回だけダウンロードできるURLを発行します。URLは有効期限内でも一度使用すると無効になります。
+                </p>
+                <div className="flex items-center gap-3">
+                    <select
+                        value={ttl}
+                        onChange={(e) => setTtl(Number(e.target.value))}
+                        className="rounded-xl border border-outline/60 bg-white px-3 py-2 text-sm focus:border-brand focus:outline-none focus:ring-2 focus:ring-brand/20"
+                    >
+                        {TTL_OPTIONS.map((opt) => (
+                            <option key={opt.value} value={opt.value}>
+                                有効期限: {opt.label}
+                            </option>
+                        ))}
+                    </select>
+                    <Button onClick={handleIssue} disabled={issuing}>
+                        {issuing ? "発行中..." : "URLを発行"}
+                    </Button>
+                </div>
+            </div>
+
+            {issued && (
+                <div className="rounded-2xl border border-emerald-200 bg-emerald-50/50 p-4">
+                    <h3 className="mb-2 text-sm font-medium text-emerald-700">発行済みURL</h3>
+                    <div className="flex items-center gap-2">
+                        <code className="flex-1 rounded-lg bg-ink/5 px-3 py-2 text-sm break-all">{issued.url}</code>
+                        <button
+                            type="button"
+                            className="shrink-0 rounded-lg border border-outline/60 bg-white px-3 py-2 text-xs font-medium text-ink/70 hover:bg-ink/5"
+                            onClick={() => navigator.clipboard.writeText(issued.url)}
+                        >
+                            Copy
+                        </button>
+                    </div>
+                    <p className="mt-2 text-xs text-ink/60">
+                        有効期限: {new Date(issued.expires_at).toLocaleString()}
+                    </p>
+                </div>
+            )}
+        </div>
+    );
+}
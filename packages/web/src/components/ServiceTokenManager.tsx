@@ -0,0 +1,181 @@
+import { useState, useEffect, useCallback } from "react";
+import Button from "./Button";
+
+interface ServiceToken {
+    id: string;
+    name: string;
+    status: "pending" | "active" | "revoked";
+    userName: string;
+    userEmail?: string;
+    validityDays: number;
+    requestedAt: string;
+    approvedAt?: string;
+    approvedBy?: string;
+    expiresAt?: string;
+    lastUsedAt?: string;
+    revokedAt?: string;
+}
+
+interface IssuedToken {
+    name: string;
+    token: string;
+}
+
+interface Props {
+    tenantName: string;
+    onApiError: (status: number, data: { error?: string }) => boolean;
+}
+
+const statusLabels: Record<ServiceToken["status"], string> = {
+    pending: "承認待ち",
+    active: "有効",
+    revoked: "失効",
+};
+
+function formatDate(value?: string): string {
+    return value ? new Date(value).toLocaleString() : "-";
+}
+
+export default function ServiceTokenManager({ tenantName, onApiError }: Props) {
+    const [tokens, setTokens] = useState<ServiceToken[]>([]);
+    const [loading, setLoading] = useState(true);
+    const [error, setError] = useState<string | null>(null);
+    const [issued, setIssued] = useState<IssuedToken | null>(null);
+    const [busyId, setBusyId] = useState<string | null>(null);
+
+    const baseUrl = `/api/v1/portal/${encodeURIComponent(tenantName)}/admin/service-tokens`;
+
+    const fetchTokens = useCallback(async () => {
+        setLoading(true);
+        setError(null);
+        try {
+            const resp = await fetch(baseUrl, { credentials: "same-origin" });
+            const data = await resp.json().catch(() => ({}));
+            if (!resp.ok) {
+                if (onApiError(resp.status, data)) return;
+                throw new Error(data.error || "読み込みに失敗しました");
+            }
+            setTokens(data.tokens ?? []);
+        } catch (err) {
+            setError(err instanceof Error ? err.message : "読み込みに失敗しました");
+        } finally {
+            setLoading(false);
+        }
+    }, [baseUrl, onApiError]);
+
+    useEffect(() => {
+        fetchTokens();
+    }, [fetchTokens]);
+
+    const handleAction = async (token: ServiceToken, action: "approve" | "revoke") => {
+        setBusyId(token.id);
+        setError(null);
+        try {
+            const resp = await fetch(`${baseUrl}/${encodeURIComponent(token.id)}/${action}`, {
+                method: "POST",
+                credentials: "same-origin",
+            });
+            const data = await resp.json().catch(() => ({}));
+            if (!resp.ok) {
+                if (onApiError(resp.status, data)) return;
+                throw new Error(data.error || "操作に失敗しました");
+            }
+            if (action === "approve") {
+                setIssued({ name: token.name, token: data.token });
+            }
+            await fetchTokens();
+        } catch (err) {
+            setError(err instanceof Error ? err.message : "操作に失敗しました");
+        } finally {
+            setBusyId(null);
+        }
+    };
+
+    if (loading) {
+        return <p className="py-4 text-center text-sm text-ink/50">読み込み中...</p>;
+    }
+
+    return (
+        <div className="space-y-5">
+            {error && (
+                <div className="rounded-2xl border border-rose-200 bg-rose-50 px-4 py-3 text-sm text-rose-700">
+                    {error}
+                </div>
+            )}
+
+            {issued && (
+                <div className="rounded-2xl border border-emerald-200 bg-emerald-50/50 p-4">
+                    <h3 className="mb-2 text-sm font-medium text-emerald-700">
+                        {issued.name} のトークンを発行しました
+                    </h3>
+                    <div className="flex items-center gap-2">
+                        <code className="flex-1 rounded-lg bg-ink/5 px-3 py-2 text-sm break-all">{issued.token}</code>
+                        <button
+                            type="button"
+                            className="shrink-0 rounded-lg border border-outline/60 bg-white px-3 py-2 text-xs font-medium text-ink/70 hover:bg-ink/5"
+                            onClick={() => navigator.clipboard.writeText(issued.token)}
+                        >
+                            Copy
+                        </button>
+                    </div>
+                    <p className="mt-2 text-xs text-ink/60">
+                        トークンはこの画面でのみ表示されます。CI のシークレットに登録し、
+                        <code className="mx-1 rounded bg-ink/10 px-1">backlog auth login --service-token</code>
+                        または環境変数 BACKLOG_SERVICE_TOKEN で使用してください。
+                    </p>
+                </div>
+            )}
+
+            <div className="rounded-2xl border border-outline/60 bg-white/50 p-4">
+                <h3 className="mb-3 text-sm font-medium text-ink">サービストークン</h3>
+                {tokens.length === 0 ? (
+                    <p className="text-sm text-ink/50">申請はありません</p>
+                ) : (
+                    <div className="overflow-x-auto">
+                        <table className="w-full text-left text-xs">
+                            <thead className="text-ink/60">
+                                <tr>
+                                    <th className="px-2 py-1">名前</th>
+                                    <th className="px-2 py-1">アカウント</th>
+                                    <th className="px-2 py-1">状態</th>
+                                    <th className="px-2 py-1">申請日時</th>
+                                    <th className="px-2 py-1">有効期限</th>
+                                    <th className="px-2 py-1">最終使用</th>
+                                    <th className="px-2 py-1"></th>
+                                </tr>
+                            </thead>
+                            <tbody>
+                                {tokens.map((t) => (
+                                    <tr key={t.id} className="border-t border-outline/40">
+                                        <td className="px-2 py-2 font-medium text-ink">{t.name}</td>
+                                        <td className="px-2 py-2">{t.userName}{t.userEmail ? ` (${t.userEmail})` : ""}</td>
+                                        <td className="px-2 py-2">{statusLabels[t.status]}</td>
+                                        <td className="px-2 py-2">{formatDate(t.requestedAt)}</td>
+                                        <td className="px-2 py-2">
+                                            {t.status === "pending" ? `承認から${t.validityDays}日` : formatDate(t.expiresAt)}
+                                        </td>
+                                        <td className="px-2 py-2">{formatDate(t.lastUsedAt)}</td>
+                                        <td className="px-2 py-2">
+                                            <div className="flex justify-end gap-2">
+                                                {t.status === "pending" && (
+                                                    <Button onClick={() => handleAction(t, "approve")} disabled={busyId === t.id}>
+                                                        承認
+                                                    </Button>
+                                                )}
+                                                {t.status !== "revoked" && (
+                                                    <Button variant="secondary" onClick={() => handleAction(t, "revoke")} disabled={busyId === t.id}>
+                                                        {t.status === "pending" ? "却下" : "失効"}
+                                                    </Button>
+                                                )}
+                                            </div>
+                                        </td>
+                                    </tr>
+                                ))}
+                            </tbody>
+                        </table>
+                    </div>
+                )}
+            </div>
+        </div>
+    );
+}
@@ -0,0 +1,103 @@
+import { useState } from "react";
+import Button from "./Button";
+
+interface Props {
+    tenantName: string;
+    /** Called after the request is submitted (the portal session is ended by the server) */
+    onRequested: () => void;
+}
+
+const VALIDITY_OPTIONS = [
+    { value: 30, label: "30日" },
+    { value: 90, label: "90日" },
+    { value: 365, label: "365日" },
+];
+
+export default function ServiceTokenRequest({ tenantName, onRequested }: Props) {
+    const [tokenName, setTokenName] = useState("");
+    const [validityDays, setValidityDays] = useState(90);
+    const [confirming, setConfirming] = useState(false);
+    const [submitting, setSubmitting] = useState(false);
+    const [error, setError] = useState<string | null>(null);
+
+    const handleSubmit = async () => {
+        setSubmitting(true);
+        setError(null);
+        try {
+            const resp = await fetch(
+                `/api/v1/portal/${encodeURIComponent(tenantName)}/service-tokens`,
+                {
+                    method: "POST",
+                    credentials: "same-origin",
+                    headers: { "Content-Type": "application/json" },
+                    body: JSON.stringify({ name: tokenName.trim(), validity_days: validityDays }),
+                },
+            );
+            const data = await resp.json().catch(() => ({}));
+            if (!resp.ok) {
+                throw new Error(data.error === "invalid_name" ? "名前は1〜64文字で指定してください" : data.error || "申請に失敗しました");
+            }
+            onRequested();
+        } catch (err) {
+            setError(err instanceof Error ? err.message : "申請に失敗しました");
+        } finally {
+            setSubmitting(false);
+            setConfirming(false);
+        }
+    };
+
+    return (
+        <div className="space-y-3 rounded-2xl border border-outline/60 bg-white/50 p-4">
+            <h3 className="text-sm font-medium text-ink">サービストークンの申請</h3>
+            <p className="text-xs text-ink/60">
+                CI などから API キーを共有せずに Backlog API を使うための長期トークンを申請します。
+                bot 用のアカウントでログインして申請してください。管理者が承認するとトークンが発行されます。
+            </p>
+            {error && (
+                <div className="rounded-xl border border-rose-200 bg-rose-50 px-3 py-2 text-xs text-rose-700">
+                    {error}
+                </div>
+            )}
+            <div className="flex flex-wrap items-center gap-3">
+                <input
+                    type="text"
+                    value={tokenName}
+                    onChange={(e) => setTokenName(e.target.value)}
+                    placeholder="名前（例: ci-deploy）"
+                    maxLength={64}
+                    className="flex-1 rounded-xl border border-outline/60 bg-white px-3 py-2 text-sm focus:border-brand focus:outline-none focus:ring-2 focus:ring-brand/20"
+                />
+                <select
+                    value={validityDays}
+                    onChange={(e) => setValidityDays(Number(e.target.value))}
+                    className="rounded-xl border border-outline/60 bg-white px-3 py-2 text-sm focus:border-brand focus:outline-none focus:ring-2 focus:ring-brand/20"
+                >
+                    {VALIDITY_OPTIONS.map((opt) => (
+                        <option key={opt.value} value={opt.value}>
+                            有効期間: {opt.label}
+                        </option>
+                    ))}
+                </select>
+            </div>
+            {!confirming ? (
+                <Button onClick={() => setConfirming(true)} disabled={!tokenName.trim()}>
+                    申請
+                </Button>
+            ) : (
+                <div className="space-y-2">
+                    <p className="text-xs text-amber-700">
+                        申請すると、このログインの認可はサービストークンに引き継がれ、ポータルからはログアウトします。
+                    </p>
+                    <div className="flex items-center gap-3">
+                        <Button onClick={handleSubmit} disabled={submitting}>
+                            {submitting ? "申請中..." : "申請する"}
+                        </Button>
+                        <Button variant="secondary" onClick={() => setConfirming(false)} disabled={submitting}>
+                            キャンセル
+                        </Button>
+                    </div>
+                </div>
+            )}
+        </div>
+    );
+}
@@ -0,0 +1,310 @@
+import { useCallback, useEffect, useMemo, useState } from "react";
+import ErrorMessage from "../components/ErrorMessage";
+import { lineDiff } from "../utils/lineDiff";
+
+// backlog markdown migrate preview --serve の API レスポンス
+type Decision = "approved" | "rejected";
+
+interface PreviewItem {
+  id: string;
+  item_type: string;
+  item_key: string;
+  url: string;
+  status: string;
+  score: number;
+  rules?: string[];
+  warnings?: Record<string, number>;
+  review?: Decision;
+  reviewed_at?: string;
+  stale?: boolean;
+}
+
+interface PreviewDetail extends PreviewItem {
+  before: string;
+  after: string;
+  warning_lines?: Record<string, number[]>;
+}
+
+interface PreviewList {
+  project_key: string;
+  items: PreviewItem[];
+}
+
+type Filter = "unreviewed" | "approved" | "rejected" | "all";
+
+const filterLabels: Record<Filter, string> = {
+  unreviewed: "未レビュー",
+  approved: "承認",
+  rejected: "却下",
+  all: "すべて",
+};
+
+// reviewState は一覧での表示状態（内容が変わったレビューは未レビュー扱い）
+function reviewState(item: PreviewItem): Filter {
+  if (!item.review || item.stale) {
+    return "unreviewed";
+  }
+  return item.review;
+}
+
+async function readJSON<T>(res: Response): Promise<T> {
+  const data = await res.json();
+  if (!res.ok) {
+    throw new Error(data.error ?? `HTTP ${res.status}`);
+  }
+  return data as T;
+}
+
+export default function MigratePreview() {
+  const [projectKey, setProjectKey] = useState("");
+  const [items, setItems] = useState<PreviewItem[]>([]);
+  const [filter, setFilter] = useState<Filter>("unreviewed");
+  const [selectedId, setSelectedId] = useState<string | null>(null);
+  const [detail, setDetail] = useState<PreviewDetail | null>(null);
+  const [loading, setLoading] = useState(true);
+  const [saving, setSaving] = useState(false);
+  const [error, setError] = useState("");
+
+  useEffect(() => {
+    fetch("/api/v1/migrate/items")
+      .then((res) => readJSON<PreviewList>(res))
+      .then((list) => {
+        setProjectKey(list.project_key);
+        setItems(list.items);
+        const first = list.items.find((item) => reviewState(item) === "unreviewed") ?? list.items[0];
+        setSelectedId(first?.id ?? null);
+      })
+      .catch((err: Error) => setError(err.message))
+      .finally(() => setLoading(false));
+  }, []);
+
+  useEffect(() => {
+    if (!selectedId) {
+      setDetail(null);
+      return;
+    }
+    let canceled = false;
+    fetch(`/api/v1/migrate/items/${encodeURIComponent(selectedId)}`)
+      .then((res) => readJSON<PreviewDetail>(res))
+      .then((data) => {
+        if (!canceled) setDetail(data);
+      })
+      .catch((err: Error) => {
+        if (!canceled) setError(err.message);
+      });
+    return () => {
+      canceled = true;
+    };
+  }, [selectedId]);
+
+  const visible = useMemo(
+    () => items.filter((item) => filter === "all" || reviewState(item) === filter),
+    [items, filter],
+  );
+
+  const counts = useMemo(() => {
+    const result: Record<Filter, number> = { unreviewed: 0, approved: 0, rejected: 0, all: items.length };
+    for (const item of items) {
+      result[reviewState(item)]++;
+    }
+    return result;
+  }, [items]);
+
+  const rows = useMemo(() => (detail ? lineDiff(detail.before, detail.after) : []), [detail]);
+
+  const record = useCallback(
+    async (decision: Decision | "") => {
+      if (!detail) return;
+      setSaving(true);
+      setError("");
+      try {
+        const res = await fetch(`/api/v1/migrate/items/${encodeURIComponent(detail.id)}/review`, {
+          method: "POST",
+          headers: { "Content-Type": "application/json" },
+          body: JSON.stringify({ decision }),
+        });
+        const updated = await readJSON<PreviewDetail>(res);
+        setDetail(updated);
+        const next = items.map((item) => (item.id === updated.id ? { ...item, ...updated } : item));
+        setItems(next);
+        // レビューしたら次の未レビュー項目へ進む
+        if (decision !== "") {
+          const index = next.findIndex((item) => item.id === updated.id);
+          const following = [...next.slice(index + 1), ...next.slice(0, index)];
+          const nextItem = following.find((item) => reviewState(item) === "unreviewed");
+          if (nextItem) setSelectedId(nextItem.id);
+        }
+      } catch (err) {
+        setError((err as Error).message);
+      } finally {
+        setSaving(false);
+      }
+    },
+    [detail, items],
+  );
+
+  return (
+    <main className="min-h-screen px-6 py-8">
+      <div className="mx-auto max-w-[1600px] space-y-6">
+        <header className="space-y-1">
+          <p className="text-xs font-semibold uppercase tracking-[0.3em] text-ink/60">Backlog CLI</p>
+          <h1 className="text-2xl font-semibold text-ink">記法移行のレビュー {projectKey}</h1>
+          <p className="text-sm text-ink/70">
+            変換前後を確認して承認/却下を記録します。記録は <code>migrate apply</code> で使われます。
+          </p>
+        </header>
+
+        <ErrorMessage message={error} />
+
+        <div className="flex gap-6">
+          <aside className="w-80 shrink-0 space-y-3">
+            <div className="flex flex-wrap gap-2">
+              {(Object.keys(filterLabels) as Filter[]).map((key) => (
+                <button
+                  key={key}
+                  type="button"
+                  onClick={() => setFilter(key)}
+                  className={`rounded-full px-3 py-1 text-xs font-semibold ${
+                    filter === key ? "bg-brand text-white" : "border border-outline bg-white text-ink/70"
+                  }`}
+                >
+                  {filterLabels[key]} ({counts[key]})
+                </button>
+              ))}
+            </div>
+            <ul className="max-h-[75vh] overflow-y-auto rounded-2xl border border-outline bg-white/85">
+              {loading && <li className="p-4 text-sm text-ink/60">読み込み中...</li>}
+              {!loading && visible.length === 0 && (
+                <li className="p-4 text-sm text-ink/60">該当する項目はありません</li>
+              )}
+              {visible.map((item) => (
+                <li key={item.id}>
+                  <button
+                    type="button"
+                    onClick={() => setSelectedId(item.id)}
+                    className={`flex w-full items-center justify-between gap-2 border-b border-outline px-4 py-2 text-left text-sm ${
+                      item.id === selectedId ? "bg-brand/10" : "hover:bg-surface"
+                    }`}
+                  >
+                    <span className="truncate">
+                      <span className="text-xs text-ink/50">{item.item_type}</span> {item.item_key}
+                    </span>
+                    <ReviewBadge item={item} />
+                  </button>
+                </li>
+              ))}
+            </ul>
+          </aside>
+
+          <section className="min-w-0 flex-1 space-y-4">
+            {detail ? (
+              <>
+                <div className="flex flex-wrap items-center justify-between gap-3">
+                  <div>
+                    <h2 className="text-lg font-semibold">
+                      {detail.item_type} {detail.item_key}
+                    </h2>
+                    {detail.url && (
+                      <a href={detail.url} target="_blank" rel="noreferrer" className="text-xs text-brand underline">
+                        {detail.url}
+                      </a>
+                    )}
+                    <p className="text-xs text-ink/60">
+                      rules: {detail.rules?.join(", ") || "-"} / warnings:{" "}
+                      {Object.entries(detail.warnings ?? {})
+                        .map(([name, count]) => `${name}=${count}`)
+                        .join(", ") || "-"}
+                    </p>
+                  </div>
+                  <div className="flex items-center gap-2">
+                    <ReviewBadge item={detail} />
+                    <button
+                      type="button"
+                      disabled={saving}
+                      onClick={() => record("approved")}
+                      className="rounded-full bg-brand px-5 py-2 text-sm font-semibold text-white disabled:opacity-50"
+                    >
+                      承認
+                    </button>
+                    <button
+                      type="button"
+                      disabled={saving}
+                      onClick={() => record("rejected")}
+                      className="rounded-full bg-red-600 px-5 py-2 text-sm font-semibold text-white disabled:opacity-50"
+                    >
+                      却下
+                    </button>
+                    {detail.review && (
+                      <button
+                        type="button"
+                        disabled={saving}
+                        onClick={() => record("")}
+                        className="rounded-full border border-outline bg-white px-4 py-2 text-sm disabled:opacity-50"
+                      >
+                        取り消し
+                      </button>
+                    )}
+                  </div>
+                </div>
+                <div className="overflow-x-auto rounded-2xl border border-outline bg-white">
+                  <table className="w-full table-fixed font-mono text-xs">
+                    <thead>
+                      <tr className="border-b border-outline text-left text-ink/60">
+                        <th className="w-10" />
+                        <th className="px-2 py-1">変換前 (Backlog 記法)</th>
+                        <th className="w-10" />
+                        <th className="px-2 py-1">変換後 (Markdown)</th>
+                      </tr>
+                    </thead>
+                    <tbody>
+                      {rows.map((row, index) => (
+                        <tr key={index} className="align-top">
+                          <td className="select-none px-1 text-right text-ink/40">{row.leftNo}</td>
+                          <td
+                            className={`whitespace-pre-wrap break-all px-2 ${
+                              row.kind === "changed" && row.left !== undefined ? "bg-red-50" : ""
+                            }`}
+                          >
+                            {row.left}
+                          </td>
+                          <td className="select-none px-1 text-right text-ink/40">{row.rightNo}</td>
+                          <td
+                            className={`whitespace-pre-wrap break-all px-2 ${
+                              row.kind === "changed" && row.right !== undefined ? "bg-emerald-50" : ""
+                            }`}
+                          >
+                            {row.right}
+                          </td>
+                        </tr>
+                      ))}
+                    </tbody>
+                  </table>
+                </div>
+              </>
+            ) : (
+              !loading && <p className="text-sm text-ink/60">項目を選択してください</p>
+            )}
+          </section>
+        </div>
+      </div>
+    </main>
+  );
+}
+
+function ReviewBadge({ item }: { item: PreviewItem }) {
+  if (!item.review) {
+    return null;
+  }
+  if (item.stale) {
+    return (
+      <span className="shrink-0 rounded-full bg-amber-100 px-2 py-0.5 text-xs text-amber-800" title="レビュー後に内容が変わりました">
+        要再確認
+      </span>
+    );
+  }
+  return item.review === "approved" ? (
+    <span className="shrink-0 rounded-full bg-emerald-100 px-2 py-0.5 text-xs text-emerald-800">承認</span>
+  ) : (
+    <span className="shrink-0 rounded-full bg-red-100 px-2 py-0.5 text-xs text-red-800">却下</span>
+  );
+}
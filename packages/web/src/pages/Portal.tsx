@@ -4,6 +4,7 @@ import Button from "../components/Button";
 import Container from "../components/Container";
 import Input from "../components/Input";
 import InfoBox from "../components/InfoBox";
+import ServiceTokenRequest from "../components/ServiceTokenRequest";
 
 interface TenantInfo {
   name: string;
@@ -23,6 +24,7 @@ interface PortalInfo {
   has_passphrase: boolean;
   oauth_enabled: boolean;
   default_space?: string;
+  service_tokens_enabled?: boolean;
 }
 
 type SetupMethod = "quickstart" | "provision" | "bundle";
@@ -46,6 +48,7 @@ export default function Portal() {
   const { name } = useParams<{ name: string }>();
   const [passphrase, setPassphrase] = useState("");
   const [error, setError] = useState<string | null>(null);
+  const [notice, setNotice] = useState<string | null>(null);
   const [tenantInfo, setTenantInfo] = useState<TenantInfo | null>(null);
   const [loading, setLoading] = useState(false);
   const [downloading, setDownloading] = useState(false);
@@ -325,6 +328,12 @@ export default function Portal() {
             </div>
           )}
 
+          {notice && (
+            <div className="rounded-2xl border border-emerald-200 bg-emerald-50 px-4 py-3 text-sm text-emerald-800">
+              {notice}
+            </div>
+          )}
+
           {!tenantInfo && !isAuthenticated ? (
             <div className="space-y-5">
               {/* Login method tabs */}
@@ -582,6 +591,18 @@ export default function Portal() {
                   </p>
                 </div>
               )}
+
+              {isAuthenticated && portalInfo?.service_tokens_enabled && (
+                <ServiceTokenRequest
+                  tenantName={name ?? ""}
+                  onRequested={() => {
+                    setSession({ authenticated: false });
+                    setTenantInfo(null);
+                    setProvisioningKey(null);
+                    setNotice("サービストークンを申請しました。管理者の承認後にトークンが発行されます。");
+                  }}
+                />
+              )}
             </div>
           )}
         </div>
@@ -2,8 +2,10 @@ import { useState, useEffect, useCallback } from "react";
 import { useParams, useNavigate } from "react-router-dom";
 import AuditLogViewer from "../components/AuditLogViewer";
 import PassphraseManagerView from "../components/PassphraseManager";
+import BundleUrlIssuer from "../components/BundleUrlIssuer";
+import ServiceTokenManager from "../components/ServiceTokenManager";
 
-type AdminTab = "audit" | "passphrase";
+type AdminTab = "audit" | "passphrase" | "bundle" | "service-token";
 
 interface SessionInfo {
     authenticated: boolean;
@@ -145,19 +147,54 @@ export default function PortalAdmin() {
                                     >
                                         パスフレーズ管理
                                     </button>
+                                    <button
+                                        type="button"
+                                        className={`flex-1 rounded-xl px-3 py-2 text-sm font-medium transition-colors ${
+                                            tab === "bundle"
+                                                ? "bg-white text-ink shadow-sm"
+                                                : "text-ink/60 hover:text-ink/80"
+                                        }`}
+                                        onClick={() => setTab("bundle")}
+                                    >
+                                        バンドル配布
+                                    </button>
+                                    <button
+                                        type="button"
+                                        className={`flex-1 rounded-xl px-3 py-2 text-sm font-medium transition-colors ${
+                                            tab === "service-token"
+                                                ? "bg-white text-ink shadow-sm"
+                                                : "text-ink/60 hover:text-ink/80"
+                                        }`}
+                                        onClick={() => setTab("service-token")}
+                                    >
+                                        サービストークン
+                                    </button>
                                 </div>
 
-                                {tab === "audit" ? (
+                                {tab === "audit" && (
                                     <AuditLogViewer
                                         tenantName={name ?? ""}
                                         onApiError={handleApiError}
                                     />
-                                ) : (
+                                )}
+                                {tab === "passphrase" && (
                                     <PassphraseManagerView
                                         tenantName={name ?? ""}
                                         onApiError={handleApiError}
                                     />
                                 )}
+                                {tab === "bundle" && (
+                                    <BundleUrlIssuer
+                                        tenantName={name ?? ""}
+                                        onApiError={handleApiError}
+                                    />
+                                )}
+                                {tab === "service-token" && (
+                                    <ServiceTokenManager
+                                        tenantName={name ?? ""}
+                                        onApiError={handleApiError}
+                                    />
+                                )}
                             </>
                         )}
                     </div>
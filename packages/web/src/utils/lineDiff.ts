@@ -0,0 +1,57 @@
+// 変換前後の行を左右に並べるための行単位の差分
+export type DiffRow =
+  | { kind: "same"; left: string; right: string; leftNo: number; rightNo: number }
+  | { kind: "changed"; left?: string; right?: string; leftNo?: number; rightNo?: number };
+
+// lineDiff は LCS で一致する行をそろえ、一致しない区間を左右に並べる
+export function lineDiff(before: string, after: string): DiffRow[] {
+  const a = before.split("\n");
+  const b = after.split("\n");
+  const n = a.length;
+  const m = b.length;
+
+  // lcs[i][j] は a[i:] と b[j:] の最長共通部分列の長さ
+  const lcs: Uint32Array[] = Array.from({ length: n + 1 }, () => new Uint32Array(m + 1));
+  for (let i = n - 1; i >= 0; i--) {
+    for (let j = m - 1; j >= 0; j--) {
+      lcs[i][j] = a[i] === b[j] ? lcs[i + 1][j + 1] + 1 : Math.max(lcs[i + 1][j], lcs[i][j + 1]);
+    }
+  }
+
+  const rows: DiffRow[] = [];
+  let removed: number[] = [];
+  let added: number[] = [];
+  const flush = () => {
+    const len = Math.max(removed.length, added.length);
+    for (let k = 0; k < len; k++) {
+      const li = removed[k];
+      const ri = added[k];
+      rows.push({
+        kind: "changed",
+        left: li === undefined ? undefined : a[li],
+        right: ri === undefined ? undefined : b[ri],
+        leftNo: li === undefined ? undefined : li + 1,
+        rightNo: ri === undefined ? undefined : ri + 1,
+      });
+    }
+    removed = [];
+    added = [];
+  };
+
+  let i = 0;
+  let j = 0;
+  while (i < n || j < m) {
+    if (i < n && j < m && a[i] === b[j]) {
+      flush();
+      rows.push({ kind: "same", left: a[i], right: b[j], leftNo: i + 1, rightNo: j + 1 });
+      i++;
+      j++;
+    } else if (j >= m || (i < n && lcs[i + 1][j] >= lcs[i][j + 1])) {
+      removed.push(i++);
+    } else {
+      added.push(j++);
+    }
+  }
+  flush();
+  return rows;
+}